@@ -6,8 +6,11 @@ package api
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import duration "github.com/golang/protobuf/ptypes/duration"
 import empty "github.com/golang/protobuf/ptypes/empty"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
+import field_mask "google.golang.org/genproto/protobuf/field_mask"
 
 import (
 	context "golang.org/x/net/context"
@@ -28,18 +31,30 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 type IntegrationKind int32
 
 const (
-	IntegrationKind_HTTP     IntegrationKind = 0
-	IntegrationKind_INFLUXDB IntegrationKind = 1
+	IntegrationKind_HTTP        IntegrationKind = 0
+	IntegrationKind_INFLUXDB    IntegrationKind = 1
+	IntegrationKind_AZURE       IntegrationKind = 2
+	IntegrationKind_THINGSBOARD IntegrationKind = 3
+	IntegrationKind_MY_DEVICES  IntegrationKind = 4
+	IntegrationKind_LORA_CLOUD  IntegrationKind = 5
 )
 
 var IntegrationKind_name = map[int32]string{
 	0: "HTTP",
 	1: "INFLUXDB",
+	2: "AZURE",
+	3: "THINGSBOARD",
+	4: "MY_DEVICES",
+	5: "LORA_CLOUD",
 }
 
 var IntegrationKind_value = map[string]int32{
-	"HTTP":     0,
-	"INFLUXDB": 1,
+	"HTTP":        0,
+	"INFLUXDB":    1,
+	"AZURE":       2,
+	"THINGSBOARD": 3,
+	"MY_DEVICES":  4,
+	"LORA_CLOUD":  5,
 }
 
 func (x IntegrationKind) String() string {
@@ -104,7 +119,33 @@ type Application struct {
 	// Payload encoder script.
 	PayloadEncoderScript string `protobuf:"bytes,7,opt,name=payload_encoder_script,json=payloadEncoderScript,proto3" json:"payload_encoder_script,omitempty"`
 	// Payload decoder script.
-	PayloadDecoderScript string   `protobuf:"bytes,8,opt,name=payload_decoder_script,json=payloadDecoderScript,proto3" json:"payload_decoder_script,omitempty"`
+	PayloadDecoderScript string `protobuf:"bytes,8,opt,name=payload_decoder_script,json=payloadDecoderScript,proto3" json:"payload_decoder_script,omitempty"`
+	// Number of frames to buffer before resolving the device location.
+	// When set to 0 or 1, the location is resolved on every frame.
+	GeolocationBufferFrames uint32 `protobuf:"varint,9,opt,name=geolocation_buffer_frames,json=geolocationBufferFrames,proto3" json:"geolocation_buffer_frames,omitempty"`
+	// Minimum interval (in seconds) between two location resolves of a
+	// device. When set to 0, there is no limit.
+	GeolocationMinInterval uint32 `protobuf:"varint,10,opt,name=geolocation_min_interval,json=geolocationMinInterval,proto3" json:"geolocation_min_interval,omitempty"`
+	// Disable the integrations inherited from the organization.
+	// Integrations configured for the application itself always override
+	// the organization integration of the same kind.
+	DisableOrganizationIntegrations bool `protobuf:"varint,11,opt,name=disable_organization_integrations,json=disableOrganizationIntegrations,proto3" json:"disable_organization_integrations,omitempty"`
+	// The application is archived (read-only, see Archive and Unarchive).
+	Archived bool `protobuf:"varint,12,opt,name=archived,proto3" json:"archived,omitempty"`
+	// Field mappings applied (in order) to the decoded object, before it is
+	// sent to the integrations.
+	FieldMappings []*ApplicationFieldMapping `protobuf:"bytes,13,rep,name=field_mappings,json=fieldMappings,proto3" json:"field_mappings,omitempty"`
+	// Uplink filter script (optional).
+	// The Filter function is evaluated before an uplink is sent to the
+	// integrations, uplinks for which it returns false are dropped.
+	UplinkFilterScript string `protobuf:"bytes,14,opt,name=uplink_filter_script,json=uplinkFilterScript,proto3" json:"uplink_filter_script,omitempty"`
+	// MQTT topic templates (optional).
+	// These templates override the globally configured MQTT topic templates
+	// for this application. Empty templates fall back to the global template.
+	MqttTopicTemplates *ApplicationMQTTTopicTemplates `protobuf:"bytes,15,opt,name=mqtt_topic_templates,json=mqttTopicTemplates,proto3" json:"mqtt_topic_templates,omitempty"`
+	// The application is under legal hold (read-only, see SetLegalHold and
+	// ClearLegalHold).
+	LegalHold            bool     `protobuf:"varint,16,opt,name=legal_hold,json=legalHold,proto3" json:"legal_hold,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -190,6 +231,260 @@ func (m *Application) GetPayloadDecoderScript() string {
 	return ""
 }
 
+func (m *Application) GetGeolocationBufferFrames() uint32 {
+	if m != nil {
+		return m.GeolocationBufferFrames
+	}
+	return 0
+}
+
+func (m *Application) GetGeolocationMinInterval() uint32 {
+	if m != nil {
+		return m.GeolocationMinInterval
+	}
+	return 0
+}
+
+func (m *Application) GetDisableOrganizationIntegrations() bool {
+	if m != nil {
+		return m.DisableOrganizationIntegrations
+	}
+	return false
+}
+
+func (m *Application) GetArchived() bool {
+	if m != nil {
+		return m.Archived
+	}
+	return false
+}
+
+func (m *Application) GetFieldMappings() []*ApplicationFieldMapping {
+	if m != nil {
+		return m.FieldMappings
+	}
+	return nil
+}
+
+func (m *Application) GetUplinkFilterScript() string {
+	if m != nil {
+		return m.UplinkFilterScript
+	}
+	return ""
+}
+
+func (m *Application) GetMqttTopicTemplates() *ApplicationMQTTTopicTemplates {
+	if m != nil {
+		return m.MqttTopicTemplates
+	}
+	return nil
+}
+
+func (m *Application) GetLegalHold() bool {
+	if m != nil {
+		return m.LegalHold
+	}
+	return false
+}
+
+type ApplicationFieldMapping struct {
+	// Path of the field in the decoded object, using dots as separator
+	// (e.g. temperatureSensor.1). Array elements are addressed by index.
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// New name of the field (optional).
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Scale factor (value * scale + offset).
+	// When set to 0, the value is not scaled.
+	Scale float64 `protobuf:"fixed64,3,opt,name=scale,proto3" json:"scale,omitempty"`
+	// Offset (value * scale + offset).
+	Offset float64 `protobuf:"fixed64,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Unit of the (scaled) value (optional, e.g. F).
+	FromUnit string `protobuf:"bytes,5,opt,name=from_unit,json=fromUnit,proto3" json:"from_unit,omitempty"`
+	// Unit to convert the value to (optional, e.g. C).
+	ToUnit string `protobuf:"bytes,6,opt,name=to_unit,json=toUnit,proto3" json:"to_unit,omitempty"`
+	// Round the value to the given precision.
+	Round bool `protobuf:"varint,7,opt,name=round,proto3" json:"round,omitempty"`
+	// Number of decimals to round to (0 - 15).
+	Precision            uint32   `protobuf:"varint,8,opt,name=precision,proto3" json:"precision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationFieldMapping) Reset()         { *m = ApplicationFieldMapping{} }
+func (m *ApplicationFieldMapping) String() string { return proto.CompactTextString(m) }
+func (*ApplicationFieldMapping) ProtoMessage()    {}
+func (*ApplicationFieldMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{1}
+}
+func (m *ApplicationFieldMapping) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationFieldMapping.Unmarshal(m, b)
+}
+func (m *ApplicationFieldMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationFieldMapping.Marshal(b, m, deterministic)
+}
+func (dst *ApplicationFieldMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationFieldMapping.Merge(dst, src)
+}
+func (m *ApplicationFieldMapping) XXX_Size() int {
+	return xxx_messageInfo_ApplicationFieldMapping.Size(m)
+}
+func (m *ApplicationFieldMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationFieldMapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationFieldMapping proto.InternalMessageInfo
+
+func (m *ApplicationFieldMapping) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *ApplicationFieldMapping) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationFieldMapping) GetScale() float64 {
+	if m != nil {
+		return m.Scale
+	}
+	return 0
+}
+
+func (m *ApplicationFieldMapping) GetOffset() float64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ApplicationFieldMapping) GetFromUnit() string {
+	if m != nil {
+		return m.FromUnit
+	}
+	return ""
+}
+
+func (m *ApplicationFieldMapping) GetToUnit() string {
+	if m != nil {
+		return m.ToUnit
+	}
+	return ""
+}
+
+func (m *ApplicationFieldMapping) GetRound() bool {
+	if m != nil {
+		return m.Round
+	}
+	return false
+}
+
+func (m *ApplicationFieldMapping) GetPrecision() uint32 {
+	if m != nil {
+		return m.Precision
+	}
+	return 0
+}
+
+type ApplicationMQTTTopicTemplates struct {
+	// Uplink topic template.
+	Uplink string `protobuf:"bytes,1,opt,name=uplink,proto3" json:"uplink,omitempty"`
+	// Downlink topic template.
+	// This template must contain the DevEUI.
+	Downlink string `protobuf:"bytes,2,opt,name=downlink,proto3" json:"downlink,omitempty"`
+	// Join notification topic template.
+	Join string `protobuf:"bytes,3,opt,name=join,proto3" json:"join,omitempty"`
+	// ACK notification topic template.
+	Ack string `protobuf:"bytes,4,opt,name=ack,proto3" json:"ack,omitempty"`
+	// Error notification topic template.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// Status notification topic template.
+	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	// Location notification topic template.
+	Location             string   `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationMQTTTopicTemplates) Reset()         { *m = ApplicationMQTTTopicTemplates{} }
+func (m *ApplicationMQTTTopicTemplates) String() string { return proto.CompactTextString(m) }
+func (*ApplicationMQTTTopicTemplates) ProtoMessage()    {}
+func (*ApplicationMQTTTopicTemplates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{2}
+}
+func (m *ApplicationMQTTTopicTemplates) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationMQTTTopicTemplates.Unmarshal(m, b)
+}
+func (m *ApplicationMQTTTopicTemplates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationMQTTTopicTemplates.Marshal(b, m, deterministic)
+}
+func (dst *ApplicationMQTTTopicTemplates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationMQTTTopicTemplates.Merge(dst, src)
+}
+func (m *ApplicationMQTTTopicTemplates) XXX_Size() int {
+	return xxx_messageInfo_ApplicationMQTTTopicTemplates.Size(m)
+}
+func (m *ApplicationMQTTTopicTemplates) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationMQTTTopicTemplates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationMQTTTopicTemplates proto.InternalMessageInfo
+
+func (m *ApplicationMQTTTopicTemplates) GetUplink() string {
+	if m != nil {
+		return m.Uplink
+	}
+	return ""
+}
+
+func (m *ApplicationMQTTTopicTemplates) GetDownlink() string {
+	if m != nil {
+		return m.Downlink
+	}
+	return ""
+}
+
+func (m *ApplicationMQTTTopicTemplates) GetJoin() string {
+	if m != nil {
+		return m.Join
+	}
+	return ""
+}
+
+func (m *ApplicationMQTTTopicTemplates) GetAck() string {
+	if m != nil {
+		return m.Ack
+	}
+	return ""
+}
+
+func (m *ApplicationMQTTTopicTemplates) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ApplicationMQTTTopicTemplates) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ApplicationMQTTTopicTemplates) GetLocation() string {
+	if m != nil {
+		return m.Location
+	}
+	return ""
+}
+
 type ApplicationListItem struct {
 	// Application ID.
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -202,7 +497,9 @@ type ApplicationListItem struct {
 	// ID of the service profile.
 	ServiceProfileId string `protobuf:"bytes,5,opt,name=service_profile_id,json=serviceProfileID,proto3" json:"service_profile_id,omitempty"`
 	// Service-profile name.
-	ServiceProfileName   string   `protobuf:"bytes,6,opt,name=service_profile_name,json=serviceProfileName,proto3" json:"service_profile_name,omitempty"`
+	ServiceProfileName string `protobuf:"bytes,6,opt,name=service_profile_name,json=serviceProfileName,proto3" json:"service_profile_name,omitempty"`
+	// The application is archived.
+	Archived             bool     `protobuf:"varint,7,opt,name=archived,proto3" json:"archived,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -212,7 +509,7 @@ func (m *ApplicationListItem) Reset()         { *m = ApplicationListItem{} }
 func (m *ApplicationListItem) String() string { return proto.CompactTextString(m) }
 func (*ApplicationListItem) ProtoMessage()    {}
 func (*ApplicationListItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{1}
+	return fileDescriptor_fc846aced8fe6ea6, []int{3}
 }
 func (m *ApplicationListItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ApplicationListItem.Unmarshal(m, b)
@@ -274,6 +571,13 @@ func (m *ApplicationListItem) GetServiceProfileName() string {
 	return ""
 }
 
+func (m *ApplicationListItem) GetArchived() bool {
+	if m != nil {
+		return m.Archived
+	}
+	return false
+}
+
 type CreateApplicationRequest struct {
 	// Application object to create.
 	Application          *Application `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
@@ -286,7 +590,7 @@ func (m *CreateApplicationRequest) Reset()         { *m = CreateApplicationReque
 func (m *CreateApplicationRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateApplicationRequest) ProtoMessage()    {}
 func (*CreateApplicationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{2}
+	return fileDescriptor_fc846aced8fe6ea6, []int{4}
 }
 func (m *CreateApplicationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateApplicationRequest.Unmarshal(m, b)
@@ -325,7 +629,7 @@ func (m *CreateApplicationResponse) Reset()         { *m = CreateApplicationResp
 func (m *CreateApplicationResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateApplicationResponse) ProtoMessage()    {}
 func (*CreateApplicationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{3}
+	return fileDescriptor_fc846aced8fe6ea6, []int{5}
 }
 func (m *CreateApplicationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateApplicationResponse.Unmarshal(m, b)
@@ -364,7 +668,7 @@ func (m *GetApplicationRequest) Reset()         { *m = GetApplicationRequest{} }
 func (m *GetApplicationRequest) String() string { return proto.CompactTextString(m) }
 func (*GetApplicationRequest) ProtoMessage()    {}
 func (*GetApplicationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{4}
+	return fileDescriptor_fc846aced8fe6ea6, []int{6}
 }
 func (m *GetApplicationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetApplicationRequest.Unmarshal(m, b)
@@ -393,17 +697,19 @@ func (m *GetApplicationRequest) GetId() int64 {
 
 type GetApplicationResponse struct {
 	// Application object.
-	Application          *Application `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Application *Application `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	// Number of uplinks dropped by the uplink filter script.
+	DroppedUplinkCount   int64    `protobuf:"varint,2,opt,name=dropped_uplink_count,json=droppedUplinkCount,proto3" json:"dropped_uplink_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetApplicationResponse) Reset()         { *m = GetApplicationResponse{} }
 func (m *GetApplicationResponse) String() string { return proto.CompactTextString(m) }
 func (*GetApplicationResponse) ProtoMessage()    {}
 func (*GetApplicationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{5}
+	return fileDescriptor_fc846aced8fe6ea6, []int{7}
 }
 func (m *GetApplicationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetApplicationResponse.Unmarshal(m, b)
@@ -430,19 +736,30 @@ func (m *GetApplicationResponse) GetApplication() *Application {
 	return nil
 }
 
+func (m *GetApplicationResponse) GetDroppedUplinkCount() int64 {
+	if m != nil {
+		return m.DroppedUplinkCount
+	}
+	return 0
+}
+
 type UpdateApplicationRequest struct {
 	// Application object to update.
-	Application          *Application `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Application *Application `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	// Fields to update (e.g. "name").
+	// When set, only the given fields are updated, all other fields are left
+	// unchanged. When not set, all fields are updated.
+	UpdateMask           *field_mask.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *UpdateApplicationRequest) Reset()         { *m = UpdateApplicationRequest{} }
 func (m *UpdateApplicationRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateApplicationRequest) ProtoMessage()    {}
 func (*UpdateApplicationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{6}
+	return fileDescriptor_fc846aced8fe6ea6, []int{8}
 }
 func (m *UpdateApplicationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateApplicationRequest.Unmarshal(m, b)
@@ -469,6 +786,13 @@ func (m *UpdateApplicationRequest) GetApplication() *Application {
 	return nil
 }
 
+func (m *UpdateApplicationRequest) GetUpdateMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
 type DeleteApplicationRequest struct {
 	// Application ID.
 	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -481,7 +805,7 @@ func (m *DeleteApplicationRequest) Reset()         { *m = DeleteApplicationReque
 func (m *DeleteApplicationRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteApplicationRequest) ProtoMessage()    {}
 func (*DeleteApplicationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{7}
+	return fileDescriptor_fc846aced8fe6ea6, []int{9}
 }
 func (m *DeleteApplicationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteApplicationRequest.Unmarshal(m, b)
@@ -508,6 +832,84 @@ func (m *DeleteApplicationRequest) GetId() int64 {
 	return 0
 }
 
+type ArchiveApplicationRequest struct {
+	// Application ID.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchiveApplicationRequest) Reset()         { *m = ArchiveApplicationRequest{} }
+func (m *ArchiveApplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*ArchiveApplicationRequest) ProtoMessage()    {}
+func (*ArchiveApplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{10}
+}
+func (m *ArchiveApplicationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveApplicationRequest.Unmarshal(m, b)
+}
+func (m *ArchiveApplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveApplicationRequest.Marshal(b, m, deterministic)
+}
+func (dst *ArchiveApplicationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveApplicationRequest.Merge(dst, src)
+}
+func (m *ArchiveApplicationRequest) XXX_Size() int {
+	return xxx_messageInfo_ArchiveApplicationRequest.Size(m)
+}
+func (m *ArchiveApplicationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveApplicationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveApplicationRequest proto.InternalMessageInfo
+
+func (m *ArchiveApplicationRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type UnarchiveApplicationRequest struct {
+	// Application ID.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnarchiveApplicationRequest) Reset()         { *m = UnarchiveApplicationRequest{} }
+func (m *UnarchiveApplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*UnarchiveApplicationRequest) ProtoMessage()    {}
+func (*UnarchiveApplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{11}
+}
+func (m *UnarchiveApplicationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnarchiveApplicationRequest.Unmarshal(m, b)
+}
+func (m *UnarchiveApplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnarchiveApplicationRequest.Marshal(b, m, deterministic)
+}
+func (dst *UnarchiveApplicationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnarchiveApplicationRequest.Merge(dst, src)
+}
+func (m *UnarchiveApplicationRequest) XXX_Size() int {
+	return xxx_messageInfo_UnarchiveApplicationRequest.Size(m)
+}
+func (m *UnarchiveApplicationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnarchiveApplicationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnarchiveApplicationRequest proto.InternalMessageInfo
+
+func (m *UnarchiveApplicationRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
 type ListApplicationRequest struct {
 	// Max number of applications to return in the result-test.
 	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -516,7 +918,9 @@ type ListApplicationRequest struct {
 	// ID of the organization to filter on.
 	OrganizationId int64 `protobuf:"varint,3,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
 	// Search on name (optional).
-	Search               string   `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
+	Search string `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
+	// Include the archived applications.
+	IncludeArchived      bool     `protobuf:"varint,5,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -526,7 +930,7 @@ func (m *ListApplicationRequest) Reset()         { *m = ListApplicationRequest{}
 func (m *ListApplicationRequest) String() string { return proto.CompactTextString(m) }
 func (*ListApplicationRequest) ProtoMessage()    {}
 func (*ListApplicationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{8}
+	return fileDescriptor_fc846aced8fe6ea6, []int{14}
 }
 func (m *ListApplicationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListApplicationRequest.Unmarshal(m, b)
@@ -574,6 +978,13 @@ func (m *ListApplicationRequest) GetSearch() string {
 	return ""
 }
 
+func (m *ListApplicationRequest) GetIncludeArchived() bool {
+	if m != nil {
+		return m.IncludeArchived
+	}
+	return false
+}
+
 type ListApplicationResponse struct {
 	// Total number of applications available within the result-set.
 	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
@@ -588,7 +999,7 @@ func (m *ListApplicationResponse) Reset()         { *m = ListApplicationResponse
 func (m *ListApplicationResponse) String() string { return proto.CompactTextString(m) }
 func (*ListApplicationResponse) ProtoMessage()    {}
 func (*ListApplicationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{9}
+	return fileDescriptor_fc846aced8fe6ea6, []int{15}
 }
 func (m *ListApplicationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListApplicationResponse.Unmarshal(m, b)
@@ -626,7 +1037,10 @@ type HTTPIntegrationHeader struct {
 	// Key
 	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	// Value
-	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// URL to which the header applies.
+	// When empty, the header applies to all URLs of the integration.
+	Url                  string   `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -636,7 +1050,7 @@ func (m *HTTPIntegrationHeader) Reset()         { *m = HTTPIntegrationHeader{} }
 func (m *HTTPIntegrationHeader) String() string { return proto.CompactTextString(m) }
 func (*HTTPIntegrationHeader) ProtoMessage()    {}
 func (*HTTPIntegrationHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{10}
+	return fileDescriptor_fc846aced8fe6ea6, []int{16}
 }
 func (m *HTTPIntegrationHeader) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HTTPIntegrationHeader.Unmarshal(m, b)
@@ -670,6 +1084,13 @@ func (m *HTTPIntegrationHeader) GetValue() string {
 	return ""
 }
 
+func (m *HTTPIntegrationHeader) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
 type HTTPIntegration struct {
 	// The id of the application.
 	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
@@ -686,17 +1107,42 @@ type HTTPIntegration struct {
 	// The URL to call for device-status notifications.
 	StatusNotificationUrl string `protobuf:"bytes,7,opt,name=status_notification_url,json=statusNotificationURL,proto3" json:"status_notification_url,omitempty"`
 	// The URL to call for location notifications.
-	LocationNotificationUrl string   `protobuf:"bytes,8,opt,name=location_notification_url,json=locationNotificationURL,proto3" json:"location_notification_url,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_unrecognized        []byte   `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	LocationNotificationUrl string `protobuf:"bytes,8,opt,name=location_notification_url,json=locationNotificationURL,proto3" json:"location_notification_url,omitempty"`
+	// The URL to call for admin-plane events related to the application
+	// (e.g. a device being created or deleted).
+	AdminEventUrl string `protobuf:"bytes,9,opt,name=admin_event_url,json=adminEventURL,proto3" json:"admin_event_url,omitempty"`
+	// The id of the organization.
+	// This is only used for organization integrations, in which case the
+	// application id must be left blank.
+	OrganizationId int64 `protobuf:"varint,10,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Proxy URL (e.g. http://proxy:3128 or socks5://proxy:1080).
+	// When not set, the globally configured proxy is used (if any).
+	ProxyUrl string `protobuf:"bytes,11,opt,name=proxy_url,json=proxyURL,proto3" json:"proxy_url,omitempty"`
+	// Only include the decoded object fields which changed since the
+	// previous uplink of the device (sent to this integration).
+	ChangedFieldsOnly bool `protobuf:"varint,12,opt,name=changed_fields_only,json=changedFieldsOnly,proto3" json:"changed_fields_only,omitempty"`
+	// The URL to call for security events related to the organization
+	// (e.g. failed logins or permission changes).
+	// This is only used for organization integrations.
+	SecurityEventUrl string `protobuf:"bytes,13,opt,name=security_event_url,json=securityEventURL,proto3" json:"security_event_url,omitempty"`
+	// Secret used to sign the payloads (HMAC-SHA256). When set, the
+	// signature is sent as X-Signature header.
+	// This secret is returned masked. Set it to the masked value to keep
+	// the current secret.
+	SigningSecret string `protobuf:"bytes,14,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
+	// Event types (e.g. up and join) which are forwarded by the integration (up, join,
+	// ack, error, status and location). When empty, all events are forwarded.
+	Events               []string `protobuf:"bytes,15,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *HTTPIntegration) Reset()         { *m = HTTPIntegration{} }
 func (m *HTTPIntegration) String() string { return proto.CompactTextString(m) }
 func (*HTTPIntegration) ProtoMessage()    {}
 func (*HTTPIntegration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{11}
+	return fileDescriptor_fc846aced8fe6ea6, []int{17}
 }
 func (m *HTTPIntegration) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HTTPIntegration.Unmarshal(m, b)
@@ -772,19 +1218,68 @@ func (m *HTTPIntegration) GetLocationNotificationUrl() string {
 	return ""
 }
 
-type CreateHTTPIntegrationRequest struct {
-	// Integration object to create.
-	Integration          *HTTPIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *HTTPIntegration) GetAdminEventUrl() string {
+	if m != nil {
+		return m.AdminEventUrl
+	}
+	return ""
 }
 
-func (m *CreateHTTPIntegrationRequest) Reset()         { *m = CreateHTTPIntegrationRequest{} }
+func (m *HTTPIntegration) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *HTTPIntegration) GetProxyUrl() string {
+	if m != nil {
+		return m.ProxyUrl
+	}
+	return ""
+}
+
+func (m *HTTPIntegration) GetChangedFieldsOnly() bool {
+	if m != nil {
+		return m.ChangedFieldsOnly
+	}
+	return false
+}
+
+func (m *HTTPIntegration) GetSecurityEventUrl() string {
+	if m != nil {
+		return m.SecurityEventUrl
+	}
+	return ""
+}
+
+func (m *HTTPIntegration) GetSigningSecret() string {
+	if m != nil {
+		return m.SigningSecret
+	}
+	return ""
+}
+
+func (m *HTTPIntegration) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type CreateHTTPIntegrationRequest struct {
+	// Integration object to create.
+	Integration          *HTTPIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CreateHTTPIntegrationRequest) Reset()         { *m = CreateHTTPIntegrationRequest{} }
 func (m *CreateHTTPIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateHTTPIntegrationRequest) ProtoMessage()    {}
 func (*CreateHTTPIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{12}
+	return fileDescriptor_fc846aced8fe6ea6, []int{18}
 }
 func (m *CreateHTTPIntegrationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateHTTPIntegrationRequest.Unmarshal(m, b)
@@ -823,7 +1318,7 @@ func (m *GetHTTPIntegrationRequest) Reset()         { *m = GetHTTPIntegrationReq
 func (m *GetHTTPIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*GetHTTPIntegrationRequest) ProtoMessage()    {}
 func (*GetHTTPIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{13}
+	return fileDescriptor_fc846aced8fe6ea6, []int{19}
 }
 func (m *GetHTTPIntegrationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetHTTPIntegrationRequest.Unmarshal(m, b)
@@ -862,7 +1357,7 @@ func (m *GetHTTPIntegrationResponse) Reset()         { *m = GetHTTPIntegrationRe
 func (m *GetHTTPIntegrationResponse) String() string { return proto.CompactTextString(m) }
 func (*GetHTTPIntegrationResponse) ProtoMessage()    {}
 func (*GetHTTPIntegrationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{14}
+	return fileDescriptor_fc846aced8fe6ea6, []int{20}
 }
 func (m *GetHTTPIntegrationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetHTTPIntegrationResponse.Unmarshal(m, b)
@@ -901,7 +1396,7 @@ func (m *UpdateHTTPIntegrationRequest) Reset()         { *m = UpdateHTTPIntegrat
 func (m *UpdateHTTPIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateHTTPIntegrationRequest) ProtoMessage()    {}
 func (*UpdateHTTPIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{15}
+	return fileDescriptor_fc846aced8fe6ea6, []int{21}
 }
 func (m *UpdateHTTPIntegrationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateHTTPIntegrationRequest.Unmarshal(m, b)
@@ -940,7 +1435,7 @@ func (m *DeleteHTTPIntegrationRequest) Reset()         { *m = DeleteHTTPIntegrat
 func (m *DeleteHTTPIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteHTTPIntegrationRequest) ProtoMessage()    {}
 func (*DeleteHTTPIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{16}
+	return fileDescriptor_fc846aced8fe6ea6, []int{22}
 }
 func (m *DeleteHTTPIntegrationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteHTTPIntegrationRequest.Unmarshal(m, b)
@@ -979,7 +1474,7 @@ func (m *ListIntegrationRequest) Reset()         { *m = ListIntegrationRequest{}
 func (m *ListIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*ListIntegrationRequest) ProtoMessage()    {}
 func (*ListIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{17}
+	return fileDescriptor_fc846aced8fe6ea6, []int{23}
 }
 func (m *ListIntegrationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListIntegrationRequest.Unmarshal(m, b)
@@ -1008,17 +1503,19 @@ func (m *ListIntegrationRequest) GetApplicationId() int64 {
 
 type IntegrationListItem struct {
 	// Integration kind.
-	Kind                 IntegrationKind `protobuf:"varint,1,opt,name=kind,proto3,enum=api.IntegrationKind" json:"kind,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Kind IntegrationKind `protobuf:"varint,1,opt,name=kind,proto3,enum=api.IntegrationKind" json:"kind,omitempty"`
+	// Integration is inherited from the organization.
+	Inherited            bool     `protobuf:"varint,2,opt,name=inherited,proto3" json:"inherited,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *IntegrationListItem) Reset()         { *m = IntegrationListItem{} }
 func (m *IntegrationListItem) String() string { return proto.CompactTextString(m) }
 func (*IntegrationListItem) ProtoMessage()    {}
 func (*IntegrationListItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{18}
+	return fileDescriptor_fc846aced8fe6ea6, []int{24}
 }
 func (m *IntegrationListItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IntegrationListItem.Unmarshal(m, b)
@@ -1045,6 +1542,13 @@ func (m *IntegrationListItem) GetKind() IntegrationKind {
 	return IntegrationKind_HTTP
 }
 
+func (m *IntegrationListItem) GetInherited() bool {
+	if m != nil {
+		return m.Inherited
+	}
+	return false
+}
+
 type ListIntegrationResponse struct {
 	// Total number of integrations available within the result-set.
 	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
@@ -1059,7 +1563,7 @@ func (m *ListIntegrationResponse) Reset()         { *m = ListIntegrationResponse
 func (m *ListIntegrationResponse) String() string { return proto.CompactTextString(m) }
 func (*ListIntegrationResponse) ProtoMessage()    {}
 func (*ListIntegrationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{19}
+	return fileDescriptor_fc846aced8fe6ea6, []int{25}
 }
 func (m *ListIntegrationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListIntegrationResponse.Unmarshal(m, b)
@@ -1107,17 +1611,30 @@ type InfluxDBIntegration struct {
 	// InfluxDB retention policy name.
 	RetentionPolicyName string `protobuf:"bytes,6,opt,name=retention_policy_name,json=retentionPolicyName,proto3" json:"retention_policy_name,omitempty"`
 	// InfluxDB timestamp precision.
-	Precision            InfluxDBPrecision `protobuf:"varint,7,opt,name=precision,proto3,enum=api.InfluxDBPrecision" json:"precision,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Precision InfluxDBPrecision `protobuf:"varint,7,opt,name=precision,proto3,enum=api.InfluxDBPrecision" json:"precision,omitempty"`
+	// Organization ID.
+	// This is only used for organization integrations, in which case the
+	// application id must be left blank.
+	OrganizationId int64 `protobuf:"varint,8,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Proxy URL (e.g. http://proxy:3128 or socks5://proxy:1080).
+	// When not set, the globally configured proxy is used (if any).
+	ProxyUrl string `protobuf:"bytes,9,opt,name=proxy_url,json=proxyURL,proto3" json:"proxy_url,omitempty"`
+	// Only include the decoded object fields which changed since the
+	// previous uplink of the device (sent to this integration).
+	ChangedFieldsOnly bool `protobuf:"varint,10,opt,name=changed_fields_only,json=changedFieldsOnly,proto3" json:"changed_fields_only,omitempty"`
+	// Event types (e.g. up and status) which are forwarded by the integration (up, join,
+	// ack, error, status and location). When empty, all events are forwarded.
+	Events               []string `protobuf:"bytes,11,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *InfluxDBIntegration) Reset()         { *m = InfluxDBIntegration{} }
 func (m *InfluxDBIntegration) String() string { return proto.CompactTextString(m) }
 func (*InfluxDBIntegration) ProtoMessage()    {}
 func (*InfluxDBIntegration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{20}
+	return fileDescriptor_fc846aced8fe6ea6, []int{26}
 }
 func (m *InfluxDBIntegration) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InfluxDBIntegration.Unmarshal(m, b)
@@ -1186,6 +1703,34 @@ func (m *InfluxDBIntegration) GetPrecision() InfluxDBPrecision {
 	return InfluxDBPrecision_NS
 }
 
+func (m *InfluxDBIntegration) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *InfluxDBIntegration) GetProxyUrl() string {
+	if m != nil {
+		return m.ProxyUrl
+	}
+	return ""
+}
+
+func (m *InfluxDBIntegration) GetChangedFieldsOnly() bool {
+	if m != nil {
+		return m.ChangedFieldsOnly
+	}
+	return false
+}
+
+func (m *InfluxDBIntegration) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 type CreateInfluxDBIntegrationRequest struct {
 	// Integration object to create.
 	Integration          *InfluxDBIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
@@ -1198,7 +1743,7 @@ func (m *CreateInfluxDBIntegrationRequest) Reset()         { *m = CreateInfluxDB
 func (m *CreateInfluxDBIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateInfluxDBIntegrationRequest) ProtoMessage()    {}
 func (*CreateInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{21}
+	return fileDescriptor_fc846aced8fe6ea6, []int{27}
 }
 func (m *CreateInfluxDBIntegrationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateInfluxDBIntegrationRequest.Unmarshal(m, b)
@@ -1237,7 +1782,7 @@ func (m *GetInfluxDBIntegrationRequest) Reset()         { *m = GetInfluxDBIntegr
 func (m *GetInfluxDBIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*GetInfluxDBIntegrationRequest) ProtoMessage()    {}
 func (*GetInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{22}
+	return fileDescriptor_fc846aced8fe6ea6, []int{28}
 }
 func (m *GetInfluxDBIntegrationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetInfluxDBIntegrationRequest.Unmarshal(m, b)
@@ -1276,7 +1821,7 @@ func (m *GetInfluxDBIntegrationResponse) Reset()         { *m = GetInfluxDBInteg
 func (m *GetInfluxDBIntegrationResponse) String() string { return proto.CompactTextString(m) }
 func (*GetInfluxDBIntegrationResponse) ProtoMessage()    {}
 func (*GetInfluxDBIntegrationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{23}
+	return fileDescriptor_fc846aced8fe6ea6, []int{29}
 }
 func (m *GetInfluxDBIntegrationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetInfluxDBIntegrationResponse.Unmarshal(m, b)
@@ -1315,7 +1860,7 @@ func (m *UpdateInfluxDBIntegrationRequest) Reset()         { *m = UpdateInfluxDB
 func (m *UpdateInfluxDBIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateInfluxDBIntegrationRequest) ProtoMessage()    {}
 func (*UpdateInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{24}
+	return fileDescriptor_fc846aced8fe6ea6, []int{30}
 }
 func (m *UpdateInfluxDBIntegrationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateInfluxDBIntegrationRequest.Unmarshal(m, b)
@@ -1354,7 +1899,7 @@ func (m *DeleteInfluxDBIntegrationRequest) Reset()         { *m = DeleteInfluxDB
 func (m *DeleteInfluxDBIntegrationRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteInfluxDBIntegrationRequest) ProtoMessage()    {}
 func (*DeleteInfluxDBIntegrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fc846aced8fe6ea6, []int{25}
+	return fileDescriptor_fc846aced8fe6ea6, []int{31}
 }
 func (m *DeleteInfluxDBIntegrationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteInfluxDBIntegrationRequest.Unmarshal(m, b)
@@ -1381,497 +1926,4199 @@ func (m *DeleteInfluxDBIntegrationRequest) GetApplicationId() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Application)(nil), "api.Application")
-	proto.RegisterType((*ApplicationListItem)(nil), "api.ApplicationListItem")
-	proto.RegisterType((*CreateApplicationRequest)(nil), "api.CreateApplicationRequest")
-	proto.RegisterType((*CreateApplicationResponse)(nil), "api.CreateApplicationResponse")
-	proto.RegisterType((*GetApplicationRequest)(nil), "api.GetApplicationRequest")
-	proto.RegisterType((*GetApplicationResponse)(nil), "api.GetApplicationResponse")
-	proto.RegisterType((*UpdateApplicationRequest)(nil), "api.UpdateApplicationRequest")
-	proto.RegisterType((*DeleteApplicationRequest)(nil), "api.DeleteApplicationRequest")
-	proto.RegisterType((*ListApplicationRequest)(nil), "api.ListApplicationRequest")
-	proto.RegisterType((*ListApplicationResponse)(nil), "api.ListApplicationResponse")
-	proto.RegisterType((*HTTPIntegrationHeader)(nil), "api.HTTPIntegrationHeader")
-	proto.RegisterType((*HTTPIntegration)(nil), "api.HTTPIntegration")
-	proto.RegisterType((*CreateHTTPIntegrationRequest)(nil), "api.CreateHTTPIntegrationRequest")
-	proto.RegisterType((*GetHTTPIntegrationRequest)(nil), "api.GetHTTPIntegrationRequest")
-	proto.RegisterType((*GetHTTPIntegrationResponse)(nil), "api.GetHTTPIntegrationResponse")
-	proto.RegisterType((*UpdateHTTPIntegrationRequest)(nil), "api.UpdateHTTPIntegrationRequest")
-	proto.RegisterType((*DeleteHTTPIntegrationRequest)(nil), "api.DeleteHTTPIntegrationRequest")
-	proto.RegisterType((*ListIntegrationRequest)(nil), "api.ListIntegrationRequest")
-	proto.RegisterType((*IntegrationListItem)(nil), "api.IntegrationListItem")
-	proto.RegisterType((*ListIntegrationResponse)(nil), "api.ListIntegrationResponse")
-	proto.RegisterType((*InfluxDBIntegration)(nil), "api.InfluxDBIntegration")
-	proto.RegisterType((*CreateInfluxDBIntegrationRequest)(nil), "api.CreateInfluxDBIntegrationRequest")
-	proto.RegisterType((*GetInfluxDBIntegrationRequest)(nil), "api.GetInfluxDBIntegrationRequest")
-	proto.RegisterType((*GetInfluxDBIntegrationResponse)(nil), "api.GetInfluxDBIntegrationResponse")
-	proto.RegisterType((*UpdateInfluxDBIntegrationRequest)(nil), "api.UpdateInfluxDBIntegrationRequest")
-	proto.RegisterType((*DeleteInfluxDBIntegrationRequest)(nil), "api.DeleteInfluxDBIntegrationRequest")
-	proto.RegisterEnum("api.IntegrationKind", IntegrationKind_name, IntegrationKind_value)
-	proto.RegisterEnum("api.InfluxDBPrecision", InfluxDBPrecision_name, InfluxDBPrecision_value)
+type AzureIntegration struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Connection string.
+	// This is either the connection string of a Service Bus queue or topic
+	// (containing the EntityPath) or the connection string of an IoT Hub
+	// shared access policy with the DeviceConnect permission. In the latter
+	// case, the events are sent on behalf of the IoT Hub device with the
+	// DevEUI as device ID.
+	ConnectionString string `protobuf:"bytes,2,opt,name=connection_string,json=connectionString,proto3" json:"connection_string,omitempty"`
+	// Proxy URL (e.g. http://proxy:3128 or socks5://proxy:1080).
+	// When not set, the globally configured proxy is used (if any).
+	ProxyUrl string `protobuf:"bytes,3,opt,name=proxy_url,json=proxyURL,proto3" json:"proxy_url,omitempty"`
+	// Only include the decoded object fields which changed since the
+	// previous uplink of the device (sent to this integration).
+	ChangedFieldsOnly bool `protobuf:"varint,4,opt,name=changed_fields_only,json=changedFieldsOnly,proto3" json:"changed_fields_only,omitempty"`
+	// Event types (e.g. up and join) which are forwarded by the integration (up, join,
+	// ack, error, status and location). When empty, all events are forwarded.
+	Events               []string `protobuf:"bytes,5,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// ApplicationServiceClient is the client API for ApplicationService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ApplicationServiceClient interface {
-	// Create creates the given application.
-	Create(ctx context.Context, in *CreateApplicationRequest, opts ...grpc.CallOption) (*CreateApplicationResponse, error)
-	// Get returns the requested application.
-	Get(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*GetApplicationResponse, error)
-	// Update updates the given application.
-	Update(ctx context.Context, in *UpdateApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Delete deletes the given application.
-	Delete(ctx context.Context, in *DeleteApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// List lists the available applications.
-	List(ctx context.Context, in *ListApplicationRequest, opts ...grpc.CallOption) (*ListApplicationResponse, error)
-	// CreateHTTPIntegration creates a HTTP application-integration.
-	CreateHTTPIntegration(ctx context.Context, in *CreateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// GetHTTPIntegration returns the HTTP application-integration.
-	GetHTTPIntegration(ctx context.Context, in *GetHTTPIntegrationRequest, opts ...grpc.CallOption) (*GetHTTPIntegrationResponse, error)
-	// UpdateHTTPIntegration updates the HTTP application-integration.
-	UpdateHTTPIntegration(ctx context.Context, in *UpdateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// DeleteIntegration deletes the HTTP application-integration.
-	DeleteHTTPIntegration(ctx context.Context, in *DeleteHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// CreateInfluxDBIntegration create an InfluxDB application-integration.
-	CreateInfluxDBIntegration(ctx context.Context, in *CreateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// GetInfluxDBIntegration returns the InfluxDB application-integration.
-	GetInfluxDBIntegration(ctx context.Context, in *GetInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*GetInfluxDBIntegrationResponse, error)
-	// UpdateInfluxDBIntegration updates the InfluxDB application-integration.
-	UpdateInfluxDBIntegration(ctx context.Context, in *UpdateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// DeleteInfluxDBIntegration deletes the InfluxDB application-integration.
-	DeleteInfluxDBIntegration(ctx context.Context, in *DeleteInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// ListIntegrations lists all configured integrations.
-	ListIntegrations(ctx context.Context, in *ListIntegrationRequest, opts ...grpc.CallOption) (*ListIntegrationResponse, error)
+func (m *AzureIntegration) Reset()         { *m = AzureIntegration{} }
+func (m *AzureIntegration) String() string { return proto.CompactTextString(m) }
+func (*AzureIntegration) ProtoMessage()    {}
+func (*AzureIntegration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{32}
 }
-
-type applicationServiceClient struct {
-	cc *grpc.ClientConn
+func (m *AzureIntegration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AzureIntegration.Unmarshal(m, b)
 }
-
-func NewApplicationServiceClient(cc *grpc.ClientConn) ApplicationServiceClient {
-	return &applicationServiceClient{cc}
+func (m *AzureIntegration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AzureIntegration.Marshal(b, m, deterministic)
+}
+func (dst *AzureIntegration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AzureIntegration.Merge(dst, src)
+}
+func (m *AzureIntegration) XXX_Size() int {
+	return xxx_messageInfo_AzureIntegration.Size(m)
+}
+func (m *AzureIntegration) XXX_DiscardUnknown() {
+	xxx_messageInfo_AzureIntegration.DiscardUnknown(m)
 }
 
-func (c *applicationServiceClient) Create(ctx context.Context, in *CreateApplicationRequest, opts ...grpc.CallOption) (*CreateApplicationResponse, error) {
-	out := new(CreateApplicationResponse)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/Create", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AzureIntegration proto.InternalMessageInfo
+
+func (m *AzureIntegration) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *applicationServiceClient) Get(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*GetApplicationResponse, error) {
-	out := new(GetApplicationResponse)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AzureIntegration) GetConnectionString() string {
+	if m != nil {
+		return m.ConnectionString
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) Update(ctx context.Context, in *UpdateApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/Update", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AzureIntegration) GetProxyUrl() string {
+	if m != nil {
+		return m.ProxyUrl
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) Delete(ctx context.Context, in *DeleteApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AzureIntegration) GetChangedFieldsOnly() bool {
+	if m != nil {
+		return m.ChangedFieldsOnly
 	}
-	return out, nil
+	return false
 }
 
-func (c *applicationServiceClient) List(ctx context.Context, in *ListApplicationRequest, opts ...grpc.CallOption) (*ListApplicationResponse, error) {
-	out := new(ListApplicationResponse)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/List", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AzureIntegration) GetEvents() []string {
+	if m != nil {
+		return m.Events
 	}
-	return out, nil
+	return nil
 }
 
-func (c *applicationServiceClient) CreateHTTPIntegration(ctx context.Context, in *CreateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/CreateHTTPIntegration", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type CreateAzureIntegrationRequest struct {
+	// Integration object to create.
+	Integration          *AzureIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (c *applicationServiceClient) GetHTTPIntegration(ctx context.Context, in *GetHTTPIntegrationRequest, opts ...grpc.CallOption) (*GetHTTPIntegrationResponse, error) {
-	out := new(GetHTTPIntegrationResponse)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/GetHTTPIntegration", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *CreateAzureIntegrationRequest) Reset()         { *m = CreateAzureIntegrationRequest{} }
+func (m *CreateAzureIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAzureIntegrationRequest) ProtoMessage()    {}
+func (*CreateAzureIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{33}
+}
+func (m *CreateAzureIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAzureIntegrationRequest.Unmarshal(m, b)
+}
+func (m *CreateAzureIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAzureIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateAzureIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAzureIntegrationRequest.Merge(dst, src)
+}
+func (m *CreateAzureIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateAzureIntegrationRequest.Size(m)
+}
+func (m *CreateAzureIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAzureIntegrationRequest.DiscardUnknown(m)
 }
 
-func (c *applicationServiceClient) UpdateHTTPIntegration(ctx context.Context, in *UpdateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/UpdateHTTPIntegration", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CreateAzureIntegrationRequest proto.InternalMessageInfo
+
+func (m *CreateAzureIntegrationRequest) GetIntegration() *AzureIntegration {
+	if m != nil {
+		return m.Integration
 	}
-	return out, nil
+	return nil
 }
 
-func (c *applicationServiceClient) DeleteHTTPIntegration(ctx context.Context, in *DeleteHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/DeleteHTTPIntegration", in, out, opts...)
-	if err != nil {
-		return nil, err
+type GetAzureIntegrationRequest struct {
+	// Application ID.
+	ApplicationId        int64    `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAzureIntegrationRequest) Reset()         { *m = GetAzureIntegrationRequest{} }
+func (m *GetAzureIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAzureIntegrationRequest) ProtoMessage()    {}
+func (*GetAzureIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{34}
+}
+func (m *GetAzureIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAzureIntegrationRequest.Unmarshal(m, b)
+}
+func (m *GetAzureIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAzureIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetAzureIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAzureIntegrationRequest.Merge(dst, src)
+}
+func (m *GetAzureIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAzureIntegrationRequest.Size(m)
+}
+func (m *GetAzureIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAzureIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAzureIntegrationRequest proto.InternalMessageInfo
+
+func (m *GetAzureIntegrationRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+type GetAzureIntegrationResponse struct {
+	// Integration object.
+	Integration          *AzureIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetAzureIntegrationResponse) Reset()         { *m = GetAzureIntegrationResponse{} }
+func (m *GetAzureIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAzureIntegrationResponse) ProtoMessage()    {}
+func (*GetAzureIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{35}
+}
+func (m *GetAzureIntegrationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAzureIntegrationResponse.Unmarshal(m, b)
+}
+func (m *GetAzureIntegrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAzureIntegrationResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetAzureIntegrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAzureIntegrationResponse.Merge(dst, src)
+}
+func (m *GetAzureIntegrationResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAzureIntegrationResponse.Size(m)
+}
+func (m *GetAzureIntegrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAzureIntegrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAzureIntegrationResponse proto.InternalMessageInfo
+
+func (m *GetAzureIntegrationResponse) GetIntegration() *AzureIntegration {
+	if m != nil {
+		return m.Integration
+	}
+	return nil
+}
+
+type UpdateAzureIntegrationRequest struct {
+	// Integration object.
+	Integration          *AzureIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UpdateAzureIntegrationRequest) Reset()         { *m = UpdateAzureIntegrationRequest{} }
+func (m *UpdateAzureIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateAzureIntegrationRequest) ProtoMessage()    {}
+func (*UpdateAzureIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{36}
+}
+func (m *UpdateAzureIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateAzureIntegrationRequest.Unmarshal(m, b)
+}
+func (m *UpdateAzureIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateAzureIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateAzureIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAzureIntegrationRequest.Merge(dst, src)
+}
+func (m *UpdateAzureIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateAzureIntegrationRequest.Size(m)
+}
+func (m *UpdateAzureIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAzureIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateAzureIntegrationRequest proto.InternalMessageInfo
+
+func (m *UpdateAzureIntegrationRequest) GetIntegration() *AzureIntegration {
+	if m != nil {
+		return m.Integration
+	}
+	return nil
+}
+
+type DeleteAzureIntegrationRequest struct {
+	// Application ID.
+	ApplicationId        int64    `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAzureIntegrationRequest) Reset()         { *m = DeleteAzureIntegrationRequest{} }
+func (m *DeleteAzureIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAzureIntegrationRequest) ProtoMessage()    {}
+func (*DeleteAzureIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{37}
+}
+func (m *DeleteAzureIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAzureIntegrationRequest.Unmarshal(m, b)
+}
+func (m *DeleteAzureIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAzureIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteAzureIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAzureIntegrationRequest.Merge(dst, src)
+}
+func (m *DeleteAzureIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteAzureIntegrationRequest.Size(m)
+}
+func (m *DeleteAzureIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAzureIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAzureIntegrationRequest proto.InternalMessageInfo
+
+func (m *DeleteAzureIntegrationRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+type ThingsBoardIntegration struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// ThingsBoard server (e.g. https://thingsboard.example.com).
+	// Each device must have a ThingsBoardAccessToken variable containing
+	// the ThingsBoard device access token.
+	Server string `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	// Proxy URL (e.g. http://proxy:3128 or socks5://proxy:1080).
+	// When not set, the globally configured proxy is used (if any).
+	ProxyUrl string `protobuf:"bytes,3,opt,name=proxy_url,json=proxyURL,proto3" json:"proxy_url,omitempty"`
+	// Event types (e.g. up and join) which are forwarded by the integration (up, join,
+	// ack, error, status and location). When empty, all events are forwarded.
+	Events               []string `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThingsBoardIntegration) Reset()         { *m = ThingsBoardIntegration{} }
+func (m *ThingsBoardIntegration) String() string { return proto.CompactTextString(m) }
+func (*ThingsBoardIntegration) ProtoMessage()    {}
+func (*ThingsBoardIntegration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{38}
+}
+func (m *ThingsBoardIntegration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThingsBoardIntegration.Unmarshal(m, b)
+}
+func (m *ThingsBoardIntegration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThingsBoardIntegration.Marshal(b, m, deterministic)
+}
+func (dst *ThingsBoardIntegration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThingsBoardIntegration.Merge(dst, src)
+}
+func (m *ThingsBoardIntegration) XXX_Size() int {
+	return xxx_messageInfo_ThingsBoardIntegration.Size(m)
+}
+func (m *ThingsBoardIntegration) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThingsBoardIntegration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThingsBoardIntegration proto.InternalMessageInfo
+
+func (m *ThingsBoardIntegration) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *ThingsBoardIntegration) GetServer() string {
+	if m != nil {
+		return m.Server
+	}
+	return ""
+}
+
+func (m *ThingsBoardIntegration) GetProxyUrl() string {
+	if m != nil {
+		return m.ProxyUrl
+	}
+	return ""
+}
+
+func (m *ThingsBoardIntegration) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type CreateThingsBoardIntegrationRequest struct {
+	// Integration object to create.
+	Integration          *ThingsBoardIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *CreateThingsBoardIntegrationRequest) Reset()         { *m = CreateThingsBoardIntegrationRequest{} }
+func (m *CreateThingsBoardIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateThingsBoardIntegrationRequest) ProtoMessage()    {}
+func (*CreateThingsBoardIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{39}
+}
+func (m *CreateThingsBoardIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateThingsBoardIntegrationRequest.Unmarshal(m, b)
+}
+func (m *CreateThingsBoardIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateThingsBoardIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateThingsBoardIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateThingsBoardIntegrationRequest.Merge(dst, src)
+}
+func (m *CreateThingsBoardIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateThingsBoardIntegrationRequest.Size(m)
+}
+func (m *CreateThingsBoardIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateThingsBoardIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateThingsBoardIntegrationRequest proto.InternalMessageInfo
+
+func (m *CreateThingsBoardIntegrationRequest) GetIntegration() *ThingsBoardIntegration {
+	if m != nil {
+		return m.Integration
+	}
+	return nil
+}
+
+type GetThingsBoardIntegrationRequest struct {
+	// Application ID.
+	ApplicationId        int64    `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetThingsBoardIntegrationRequest) Reset()         { *m = GetThingsBoardIntegrationRequest{} }
+func (m *GetThingsBoardIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetThingsBoardIntegrationRequest) ProtoMessage()    {}
+func (*GetThingsBoardIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{40}
+}
+func (m *GetThingsBoardIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetThingsBoardIntegrationRequest.Unmarshal(m, b)
+}
+func (m *GetThingsBoardIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetThingsBoardIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetThingsBoardIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetThingsBoardIntegrationRequest.Merge(dst, src)
+}
+func (m *GetThingsBoardIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_GetThingsBoardIntegrationRequest.Size(m)
+}
+func (m *GetThingsBoardIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetThingsBoardIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetThingsBoardIntegrationRequest proto.InternalMessageInfo
+
+func (m *GetThingsBoardIntegrationRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+type GetThingsBoardIntegrationResponse struct {
+	// Integration object.
+	Integration          *ThingsBoardIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GetThingsBoardIntegrationResponse) Reset()         { *m = GetThingsBoardIntegrationResponse{} }
+func (m *GetThingsBoardIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*GetThingsBoardIntegrationResponse) ProtoMessage()    {}
+func (*GetThingsBoardIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{41}
+}
+func (m *GetThingsBoardIntegrationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetThingsBoardIntegrationResponse.Unmarshal(m, b)
+}
+func (m *GetThingsBoardIntegrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetThingsBoardIntegrationResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetThingsBoardIntegrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetThingsBoardIntegrationResponse.Merge(dst, src)
+}
+func (m *GetThingsBoardIntegrationResponse) XXX_Size() int {
+	return xxx_messageInfo_GetThingsBoardIntegrationResponse.Size(m)
+}
+func (m *GetThingsBoardIntegrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetThingsBoardIntegrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetThingsBoardIntegrationResponse proto.InternalMessageInfo
+
+func (m *GetThingsBoardIntegrationResponse) GetIntegration() *ThingsBoardIntegration {
+	if m != nil {
+		return m.Integration
+	}
+	return nil
+}
+
+type UpdateThingsBoardIntegrationRequest struct {
+	// Integration object.
+	Integration          *ThingsBoardIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *UpdateThingsBoardIntegrationRequest) Reset()         { *m = UpdateThingsBoardIntegrationRequest{} }
+func (m *UpdateThingsBoardIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateThingsBoardIntegrationRequest) ProtoMessage()    {}
+func (*UpdateThingsBoardIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{42}
+}
+func (m *UpdateThingsBoardIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateThingsBoardIntegrationRequest.Unmarshal(m, b)
+}
+func (m *UpdateThingsBoardIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateThingsBoardIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateThingsBoardIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateThingsBoardIntegrationRequest.Merge(dst, src)
+}
+func (m *UpdateThingsBoardIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateThingsBoardIntegrationRequest.Size(m)
+}
+func (m *UpdateThingsBoardIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateThingsBoardIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateThingsBoardIntegrationRequest proto.InternalMessageInfo
+
+func (m *UpdateThingsBoardIntegrationRequest) GetIntegration() *ThingsBoardIntegration {
+	if m != nil {
+		return m.Integration
+	}
+	return nil
+}
+
+type DeleteThingsBoardIntegrationRequest struct {
+	// Application ID.
+	ApplicationId        int64    `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteThingsBoardIntegrationRequest) Reset()         { *m = DeleteThingsBoardIntegrationRequest{} }
+func (m *DeleteThingsBoardIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteThingsBoardIntegrationRequest) ProtoMessage()    {}
+func (*DeleteThingsBoardIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{43}
+}
+func (m *DeleteThingsBoardIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteThingsBoardIntegrationRequest.Unmarshal(m, b)
+}
+func (m *DeleteThingsBoardIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteThingsBoardIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteThingsBoardIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteThingsBoardIntegrationRequest.Merge(dst, src)
+}
+func (m *DeleteThingsBoardIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteThingsBoardIntegrationRequest.Size(m)
+}
+func (m *DeleteThingsBoardIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteThingsBoardIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteThingsBoardIntegrationRequest proto.InternalMessageInfo
+
+func (m *DeleteThingsBoardIntegrationRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+type MyDevicesIntegration struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Endpoint to which the uplinks are forwarded (e.g. the Cayenne endpoint
+	// https://lora.mydevices.com/v1/networks/loraserverio/uplink).
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Proxy URL (e.g. http://proxy:3128 or socks5://proxy:1080).
+	// When not set, the globally configured proxy is used (if any).
+	ProxyUrl string `protobuf:"bytes,3,opt,name=proxy_url,json=proxyURL,proto3" json:"proxy_url,omitempty"`
+	// Event types (e.g. up and join) which are forwarded by the integration (up, join,
+	// ack, error, status and location). When empty, all events are forwarded.
+	Events               []string `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MyDevicesIntegration) Reset()         { *m = MyDevicesIntegration{} }
+func (m *MyDevicesIntegration) String() string { return proto.CompactTextString(m) }
+func (*MyDevicesIntegration) ProtoMessage()    {}
+func (*MyDevicesIntegration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{44}
+}
+func (m *MyDevicesIntegration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MyDevicesIntegration.Unmarshal(m, b)
+}
+func (m *MyDevicesIntegration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MyDevicesIntegration.Marshal(b, m, deterministic)
+}
+func (dst *MyDevicesIntegration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MyDevicesIntegration.Merge(dst, src)
+}
+func (m *MyDevicesIntegration) XXX_Size() int {
+	return xxx_messageInfo_MyDevicesIntegration.Size(m)
+}
+func (m *MyDevicesIntegration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MyDevicesIntegration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MyDevicesIntegration proto.InternalMessageInfo
+
+func (m *MyDevicesIntegration) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *MyDevicesIntegration) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *MyDevicesIntegration) GetProxyUrl() string {
+	if m != nil {
+		return m.ProxyUrl
+	}
+	return ""
+}
+
+func (m *MyDevicesIntegration) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type CreateMyDevicesIntegrationRequest struct {
+	// Integration object to create.
+	Integration          *MyDevicesIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CreateMyDevicesIntegrationRequest) Reset()         { *m = CreateMyDevicesIntegrationRequest{} }
+func (m *CreateMyDevicesIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateMyDevicesIntegrationRequest) ProtoMessage()    {}
+func (*CreateMyDevicesIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{45}
+}
+func (m *CreateMyDevicesIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateMyDevicesIntegrationRequest.Unmarshal(m, b)
+}
+func (m *CreateMyDevicesIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateMyDevicesIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateMyDevicesIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateMyDevicesIntegrationRequest.Merge(dst, src)
+}
+func (m *CreateMyDevicesIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateMyDevicesIntegrationRequest.Size(m)
+}
+func (m *CreateMyDevicesIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateMyDevicesIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateMyDevicesIntegrationRequest proto.InternalMessageInfo
+
+func (m *CreateMyDevicesIntegrationRequest) GetIntegration() *MyDevicesIntegration {
+	if m != nil {
+		return m.Integration
+	}
+	return nil
+}
+
+type GetMyDevicesIntegrationRequest struct {
+	// Application ID.
+	ApplicationId        int64    `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMyDevicesIntegrationRequest) Reset()         { *m = GetMyDevicesIntegrationRequest{} }
+func (m *GetMyDevicesIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMyDevicesIntegrationRequest) ProtoMessage()    {}
+func (*GetMyDevicesIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{46}
+}
+func (m *GetMyDevicesIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMyDevicesIntegrationRequest.Unmarshal(m, b)
+}
+func (m *GetMyDevicesIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMyDevicesIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetMyDevicesIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMyDevicesIntegrationRequest.Merge(dst, src)
+}
+func (m *GetMyDevicesIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMyDevicesIntegrationRequest.Size(m)
+}
+func (m *GetMyDevicesIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMyDevicesIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMyDevicesIntegrationRequest proto.InternalMessageInfo
+
+func (m *GetMyDevicesIntegrationRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+type GetMyDevicesIntegrationResponse struct {
+	// Integration object.
+	Integration          *MyDevicesIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetMyDevicesIntegrationResponse) Reset()         { *m = GetMyDevicesIntegrationResponse{} }
+func (m *GetMyDevicesIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMyDevicesIntegrationResponse) ProtoMessage()    {}
+func (*GetMyDevicesIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{47}
+}
+func (m *GetMyDevicesIntegrationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMyDevicesIntegrationResponse.Unmarshal(m, b)
+}
+func (m *GetMyDevicesIntegrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMyDevicesIntegrationResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetMyDevicesIntegrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMyDevicesIntegrationResponse.Merge(dst, src)
+}
+func (m *GetMyDevicesIntegrationResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMyDevicesIntegrationResponse.Size(m)
+}
+func (m *GetMyDevicesIntegrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMyDevicesIntegrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMyDevicesIntegrationResponse proto.InternalMessageInfo
+
+func (m *GetMyDevicesIntegrationResponse) GetIntegration() *MyDevicesIntegration {
+	if m != nil {
+		return m.Integration
+	}
+	return nil
+}
+
+type UpdateMyDevicesIntegrationRequest struct {
+	// Integration object.
+	Integration          *MyDevicesIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpdateMyDevicesIntegrationRequest) Reset()         { *m = UpdateMyDevicesIntegrationRequest{} }
+func (m *UpdateMyDevicesIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateMyDevicesIntegrationRequest) ProtoMessage()    {}
+func (*UpdateMyDevicesIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{48}
+}
+func (m *UpdateMyDevicesIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateMyDevicesIntegrationRequest.Unmarshal(m, b)
+}
+func (m *UpdateMyDevicesIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateMyDevicesIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateMyDevicesIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateMyDevicesIntegrationRequest.Merge(dst, src)
+}
+func (m *UpdateMyDevicesIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateMyDevicesIntegrationRequest.Size(m)
+}
+func (m *UpdateMyDevicesIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateMyDevicesIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateMyDevicesIntegrationRequest proto.InternalMessageInfo
+
+func (m *UpdateMyDevicesIntegrationRequest) GetIntegration() *MyDevicesIntegration {
+	if m != nil {
+		return m.Integration
+	}
+	return nil
+}
+
+type DeleteMyDevicesIntegrationRequest struct {
+	// Application ID.
+	ApplicationId        int64    `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteMyDevicesIntegrationRequest) Reset()         { *m = DeleteMyDevicesIntegrationRequest{} }
+func (m *DeleteMyDevicesIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteMyDevicesIntegrationRequest) ProtoMessage()    {}
+func (*DeleteMyDevicesIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{49}
+}
+func (m *DeleteMyDevicesIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteMyDevicesIntegrationRequest.Unmarshal(m, b)
+}
+func (m *DeleteMyDevicesIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteMyDevicesIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteMyDevicesIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteMyDevicesIntegrationRequest.Merge(dst, src)
+}
+func (m *DeleteMyDevicesIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteMyDevicesIntegrationRequest.Size(m)
+}
+func (m *DeleteMyDevicesIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteMyDevicesIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteMyDevicesIntegrationRequest proto.InternalMessageInfo
+
+func (m *DeleteMyDevicesIntegrationRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+type LoRaCloudIntegration struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// LoRa Cloud device & application services API token.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// LoRa Cloud device & application services server.
+	// When not set, https://das.loracloud.com is used.
+	Server string `protobuf:"bytes,3,opt,name=server,proto3" json:"server,omitempty"`
+	// FPort of the modem stream (e.g. 199).
+	// Uplinks received on this FPort are forwarded as modem uplinks (0 = disabled).
+	ModemPort uint32 `protobuf:"varint,4,opt,name=modem_port,json=modemPort,proto3" json:"modem_port,omitempty"`
+	// FPort of the GNSS scans.
+	// Uplinks received on this FPort are forwarded as GNSS scans (0 = disabled).
+	GnssPort uint32 `protobuf:"varint,5,opt,name=gnss_port,json=gnssPort,proto3" json:"gnss_port,omitempty"`
+	// FPort of the WiFi scans.
+	// Uplinks received on this FPort are forwarded as WiFi scans (0 = disabled).
+	WifiPort uint32 `protobuf:"varint,6,opt,name=wifi_port,json=wifiPort,proto3" json:"wifi_port,omitempty"`
+	// Proxy URL (e.g. http://proxy:3128 or socks5://proxy:1080).
+	// When not set, the globally configured proxy is used (if any).
+	ProxyUrl             string   `protobuf:"bytes,7,opt,name=proxy_url,json=proxyURL,proto3" json:"proxy_url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoRaCloudIntegration) Reset()         { *m = LoRaCloudIntegration{} }
+func (m *LoRaCloudIntegration) String() string { return proto.CompactTextString(m) }
+func (*LoRaCloudIntegration) ProtoMessage()    {}
+func (*LoRaCloudIntegration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{50}
+}
+func (m *LoRaCloudIntegration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoRaCloudIntegration.Unmarshal(m, b)
+}
+func (m *LoRaCloudIntegration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoRaCloudIntegration.Marshal(b, m, deterministic)
+}
+func (dst *LoRaCloudIntegration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoRaCloudIntegration.Merge(dst, src)
+}
+func (m *LoRaCloudIntegration) XXX_Size() int {
+	return xxx_messageInfo_LoRaCloudIntegration.Size(m)
+}
+func (m *LoRaCloudIntegration) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoRaCloudIntegration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoRaCloudIntegration proto.InternalMessageInfo
+
+func (m *LoRaCloudIntegration) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *LoRaCloudIntegration) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *LoRaCloudIntegration) GetServer() string {
+	if m != nil {
+		return m.Server
+	}
+	return ""
+}
+
+func (m *LoRaCloudIntegration) GetModemPort() uint32 {
+	if m != nil {
+		return m.ModemPort
+	}
+	return 0
+}
+
+func (m *LoRaCloudIntegration) GetGnssPort() uint32 {
+	if m != nil {
+		return m.GnssPort
+	}
+	return 0
+}
+
+func (m *LoRaCloudIntegration) GetWifiPort() uint32 {
+	if m != nil {
+		return m.WifiPort
+	}
+	return 0
+}
+
+func (m *LoRaCloudIntegration) GetProxyUrl() string {
+	if m != nil {
+		return m.ProxyUrl
+	}
+	return ""
+}
+
+type CreateLoRaCloudIntegrationRequest struct {
+	// Integration object to create.
+	Integration          *LoRaCloudIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CreateLoRaCloudIntegrationRequest) Reset()         { *m = CreateLoRaCloudIntegrationRequest{} }
+func (m *CreateLoRaCloudIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateLoRaCloudIntegrationRequest) ProtoMessage()    {}
+func (*CreateLoRaCloudIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{51}
+}
+func (m *CreateLoRaCloudIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateLoRaCloudIntegrationRequest.Unmarshal(m, b)
+}
+func (m *CreateLoRaCloudIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateLoRaCloudIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateLoRaCloudIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateLoRaCloudIntegrationRequest.Merge(dst, src)
+}
+func (m *CreateLoRaCloudIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateLoRaCloudIntegrationRequest.Size(m)
+}
+func (m *CreateLoRaCloudIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateLoRaCloudIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateLoRaCloudIntegrationRequest proto.InternalMessageInfo
+
+func (m *CreateLoRaCloudIntegrationRequest) GetIntegration() *LoRaCloudIntegration {
+	if m != nil {
+		return m.Integration
+	}
+	return nil
+}
+
+type GetLoRaCloudIntegrationRequest struct {
+	// Application ID.
+	ApplicationId        int64    `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLoRaCloudIntegrationRequest) Reset()         { *m = GetLoRaCloudIntegrationRequest{} }
+func (m *GetLoRaCloudIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLoRaCloudIntegrationRequest) ProtoMessage()    {}
+func (*GetLoRaCloudIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{52}
+}
+func (m *GetLoRaCloudIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoRaCloudIntegrationRequest.Unmarshal(m, b)
+}
+func (m *GetLoRaCloudIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoRaCloudIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetLoRaCloudIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoRaCloudIntegrationRequest.Merge(dst, src)
+}
+func (m *GetLoRaCloudIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLoRaCloudIntegrationRequest.Size(m)
+}
+func (m *GetLoRaCloudIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoRaCloudIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLoRaCloudIntegrationRequest proto.InternalMessageInfo
+
+func (m *GetLoRaCloudIntegrationRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+type GetLoRaCloudIntegrationResponse struct {
+	// Integration object.
+	Integration          *LoRaCloudIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetLoRaCloudIntegrationResponse) Reset()         { *m = GetLoRaCloudIntegrationResponse{} }
+func (m *GetLoRaCloudIntegrationResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLoRaCloudIntegrationResponse) ProtoMessage()    {}
+func (*GetLoRaCloudIntegrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{53}
+}
+func (m *GetLoRaCloudIntegrationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoRaCloudIntegrationResponse.Unmarshal(m, b)
+}
+func (m *GetLoRaCloudIntegrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoRaCloudIntegrationResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetLoRaCloudIntegrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoRaCloudIntegrationResponse.Merge(dst, src)
+}
+func (m *GetLoRaCloudIntegrationResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLoRaCloudIntegrationResponse.Size(m)
+}
+func (m *GetLoRaCloudIntegrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoRaCloudIntegrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLoRaCloudIntegrationResponse proto.InternalMessageInfo
+
+func (m *GetLoRaCloudIntegrationResponse) GetIntegration() *LoRaCloudIntegration {
+	if m != nil {
+		return m.Integration
+	}
+	return nil
+}
+
+type UpdateLoRaCloudIntegrationRequest struct {
+	// Integration object.
+	Integration          *LoRaCloudIntegration `protobuf:"bytes,1,opt,name=integration,proto3" json:"integration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpdateLoRaCloudIntegrationRequest) Reset()         { *m = UpdateLoRaCloudIntegrationRequest{} }
+func (m *UpdateLoRaCloudIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateLoRaCloudIntegrationRequest) ProtoMessage()    {}
+func (*UpdateLoRaCloudIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{54}
+}
+func (m *UpdateLoRaCloudIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateLoRaCloudIntegrationRequest.Unmarshal(m, b)
+}
+func (m *UpdateLoRaCloudIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateLoRaCloudIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateLoRaCloudIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateLoRaCloudIntegrationRequest.Merge(dst, src)
+}
+func (m *UpdateLoRaCloudIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateLoRaCloudIntegrationRequest.Size(m)
+}
+func (m *UpdateLoRaCloudIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateLoRaCloudIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateLoRaCloudIntegrationRequest proto.InternalMessageInfo
+
+func (m *UpdateLoRaCloudIntegrationRequest) GetIntegration() *LoRaCloudIntegration {
+	if m != nil {
+		return m.Integration
+	}
+	return nil
+}
+
+type DeleteLoRaCloudIntegrationRequest struct {
+	// Application ID.
+	ApplicationId        int64    `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteLoRaCloudIntegrationRequest) Reset()         { *m = DeleteLoRaCloudIntegrationRequest{} }
+func (m *DeleteLoRaCloudIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteLoRaCloudIntegrationRequest) ProtoMessage()    {}
+func (*DeleteLoRaCloudIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{55}
+}
+func (m *DeleteLoRaCloudIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteLoRaCloudIntegrationRequest.Unmarshal(m, b)
+}
+func (m *DeleteLoRaCloudIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteLoRaCloudIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteLoRaCloudIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteLoRaCloudIntegrationRequest.Merge(dst, src)
+}
+func (m *DeleteLoRaCloudIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteLoRaCloudIntegrationRequest.Size(m)
+}
+func (m *DeleteLoRaCloudIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteLoRaCloudIntegrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteLoRaCloudIntegrationRequest proto.InternalMessageInfo
+
+func (m *DeleteLoRaCloudIntegrationRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+type GetApplicationUplinkStatsRequest struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Timestamp to start from (default: 7 days before the end timestamp).
+	// The statistics are aggregated per day, the range covers the full days
+	// of the start and end timestamp.
+	StartTimestamp *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	// Timestamp until to get from (default: now).
+	EndTimestamp *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	// Max number of devices to return (default: 10).
+	Limit int64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Timezone (IANA, e.g. "Europe/Amsterdam") used to determine the days
+	// of the time range (default: UTC).
+	Timezone             string   `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetApplicationUplinkStatsRequest) Reset()         { *m = GetApplicationUplinkStatsRequest{} }
+func (m *GetApplicationUplinkStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetApplicationUplinkStatsRequest) ProtoMessage()    {}
+func (*GetApplicationUplinkStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{56}
+}
+func (m *GetApplicationUplinkStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetApplicationUplinkStatsRequest.Unmarshal(m, b)
+}
+func (m *GetApplicationUplinkStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetApplicationUplinkStatsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetApplicationUplinkStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetApplicationUplinkStatsRequest.Merge(dst, src)
+}
+func (m *GetApplicationUplinkStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetApplicationUplinkStatsRequest.Size(m)
+}
+func (m *GetApplicationUplinkStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetApplicationUplinkStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetApplicationUplinkStatsRequest proto.InternalMessageInfo
+
+func (m *GetApplicationUplinkStatsRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *GetApplicationUplinkStatsRequest) GetStartTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTimestamp
+	}
+	return nil
+}
+
+func (m *GetApplicationUplinkStatsRequest) GetEndTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTimestamp
+	}
+	return nil
+}
+
+func (m *GetApplicationUplinkStatsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetApplicationUplinkStatsRequest) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+type UplinkStatsCount struct {
+	// Value (e.g. the FPort or payload size).
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	// Number of uplinks.
+	UplinkCount int64 `protobuf:"varint,2,opt,name=uplink_count,json=uplinkCount,proto3" json:"uplink_count,omitempty"`
+	// Total number of payload bytes.
+	PayloadBytes int64 `protobuf:"varint,3,opt,name=payload_bytes,json=payloadBytes,proto3" json:"payload_bytes,omitempty"`
+	// Total airtime (in seconds).
+	Airtime              float64  `protobuf:"fixed64,4,opt,name=airtime,proto3" json:"airtime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UplinkStatsCount) Reset()         { *m = UplinkStatsCount{} }
+func (m *UplinkStatsCount) String() string { return proto.CompactTextString(m) }
+func (*UplinkStatsCount) ProtoMessage()    {}
+func (*UplinkStatsCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{57}
+}
+func (m *UplinkStatsCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UplinkStatsCount.Unmarshal(m, b)
+}
+func (m *UplinkStatsCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UplinkStatsCount.Marshal(b, m, deterministic)
+}
+func (dst *UplinkStatsCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UplinkStatsCount.Merge(dst, src)
+}
+func (m *UplinkStatsCount) XXX_Size() int {
+	return xxx_messageInfo_UplinkStatsCount.Size(m)
+}
+func (m *UplinkStatsCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_UplinkStatsCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UplinkStatsCount proto.InternalMessageInfo
+
+func (m *UplinkStatsCount) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *UplinkStatsCount) GetUplinkCount() int64 {
+	if m != nil {
+		return m.UplinkCount
+	}
+	return 0
+}
+
+func (m *UplinkStatsCount) GetPayloadBytes() int64 {
+	if m != nil {
+		return m.PayloadBytes
+	}
+	return 0
+}
+
+func (m *UplinkStatsCount) GetAirtime() float64 {
+	if m != nil {
+		return m.Airtime
+	}
+	return 0
+}
+
+type DeviceUplinkStats struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Name of the device.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of uplinks.
+	UplinkCount int64 `protobuf:"varint,3,opt,name=uplink_count,json=uplinkCount,proto3" json:"uplink_count,omitempty"`
+	// Total number of payload bytes.
+	PayloadBytes int64 `protobuf:"varint,4,opt,name=payload_bytes,json=payloadBytes,proto3" json:"payload_bytes,omitempty"`
+	// Average payload size (in bytes).
+	AvgPayloadSize float64 `protobuf:"fixed64,5,opt,name=avg_payload_size,json=avgPayloadSize,proto3" json:"avg_payload_size,omitempty"`
+	// Total airtime (in seconds).
+	Airtime float64 `protobuf:"fixed64,6,opt,name=airtime,proto3" json:"airtime,omitempty"`
+	// Highest spreading-factor used by the device (0 when unknown).
+	MaxSpreadingFactor   uint32   `protobuf:"varint,7,opt,name=max_spreading_factor,json=maxSpreadingFactor,proto3" json:"max_spreading_factor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceUplinkStats) Reset()         { *m = DeviceUplinkStats{} }
+func (m *DeviceUplinkStats) String() string { return proto.CompactTextString(m) }
+func (*DeviceUplinkStats) ProtoMessage()    {}
+func (*DeviceUplinkStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{58}
+}
+func (m *DeviceUplinkStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceUplinkStats.Unmarshal(m, b)
+}
+func (m *DeviceUplinkStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceUplinkStats.Marshal(b, m, deterministic)
+}
+func (dst *DeviceUplinkStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceUplinkStats.Merge(dst, src)
+}
+func (m *DeviceUplinkStats) XXX_Size() int {
+	return xxx_messageInfo_DeviceUplinkStats.Size(m)
+}
+func (m *DeviceUplinkStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceUplinkStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceUplinkStats proto.InternalMessageInfo
+
+func (m *DeviceUplinkStats) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *DeviceUplinkStats) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeviceUplinkStats) GetUplinkCount() int64 {
+	if m != nil {
+		return m.UplinkCount
+	}
+	return 0
+}
+
+func (m *DeviceUplinkStats) GetPayloadBytes() int64 {
+	if m != nil {
+		return m.PayloadBytes
+	}
+	return 0
+}
+
+func (m *DeviceUplinkStats) GetAvgPayloadSize() float64 {
+	if m != nil {
+		return m.AvgPayloadSize
+	}
+	return 0
+}
+
+func (m *DeviceUplinkStats) GetAirtime() float64 {
+	if m != nil {
+		return m.Airtime
+	}
+	return 0
+}
+
+func (m *DeviceUplinkStats) GetMaxSpreadingFactor() uint32 {
+	if m != nil {
+		return m.MaxSpreadingFactor
+	}
+	return 0
+}
+
+type GetApplicationUplinkStatsResponse struct {
+	// Total number of uplinks.
+	UplinkCount int64 `protobuf:"varint,1,opt,name=uplink_count,json=uplinkCount,proto3" json:"uplink_count,omitempty"`
+	// Total number of payload bytes.
+	PayloadBytes int64 `protobuf:"varint,2,opt,name=payload_bytes,json=payloadBytes,proto3" json:"payload_bytes,omitempty"`
+	// Total airtime (in seconds).
+	Airtime float64 `protobuf:"fixed64,3,opt,name=airtime,proto3" json:"airtime,omitempty"`
+	// Payload size distribution.
+	PayloadSizes []*UplinkStatsCount `protobuf:"bytes,4,rep,name=payload_sizes,json=payloadSizes,proto3" json:"payload_sizes,omitempty"`
+	// FPort distribution.
+	FPorts []*UplinkStatsCount `protobuf:"bytes,5,rep,name=f_ports,json=fPorts,proto3" json:"f_ports,omitempty"`
+	// Spreading-factor distribution (0 = unknown or FSK).
+	SpreadingFactors []*UplinkStatsCount `protobuf:"bytes,6,rep,name=spreading_factors,json=spreadingFactors,proto3" json:"spreading_factors,omitempty"`
+	// Devices using the most airtime.
+	Devices              []*DeviceUplinkStats `protobuf:"bytes,7,rep,name=devices,proto3" json:"devices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetApplicationUplinkStatsResponse) Reset()         { *m = GetApplicationUplinkStatsResponse{} }
+func (m *GetApplicationUplinkStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetApplicationUplinkStatsResponse) ProtoMessage()    {}
+func (*GetApplicationUplinkStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{59}
+}
+func (m *GetApplicationUplinkStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetApplicationUplinkStatsResponse.Unmarshal(m, b)
+}
+func (m *GetApplicationUplinkStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetApplicationUplinkStatsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetApplicationUplinkStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetApplicationUplinkStatsResponse.Merge(dst, src)
+}
+func (m *GetApplicationUplinkStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetApplicationUplinkStatsResponse.Size(m)
+}
+func (m *GetApplicationUplinkStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetApplicationUplinkStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetApplicationUplinkStatsResponse proto.InternalMessageInfo
+
+func (m *GetApplicationUplinkStatsResponse) GetUplinkCount() int64 {
+	if m != nil {
+		return m.UplinkCount
+	}
+	return 0
+}
+
+func (m *GetApplicationUplinkStatsResponse) GetPayloadBytes() int64 {
+	if m != nil {
+		return m.PayloadBytes
+	}
+	return 0
+}
+
+func (m *GetApplicationUplinkStatsResponse) GetAirtime() float64 {
+	if m != nil {
+		return m.Airtime
+	}
+	return 0
+}
+
+func (m *GetApplicationUplinkStatsResponse) GetPayloadSizes() []*UplinkStatsCount {
+	if m != nil {
+		return m.PayloadSizes
+	}
+	return nil
+}
+
+func (m *GetApplicationUplinkStatsResponse) GetFPorts() []*UplinkStatsCount {
+	if m != nil {
+		return m.FPorts
+	}
+	return nil
+}
+
+func (m *GetApplicationUplinkStatsResponse) GetSpreadingFactors() []*UplinkStatsCount {
+	if m != nil {
+		return m.SpreadingFactors
+	}
+	return nil
+}
+
+func (m *GetApplicationUplinkStatsResponse) GetDevices() []*DeviceUplinkStats {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
+type GetApplicationDeliveryReportRequest struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Timestamp to start from (default: 7 days before the end timestamp).
+	// The report is aggregated per day, the range covers the full days of
+	// the start and end timestamp.
+	StartTimestamp *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	// Timestamp until to get from (default: now).
+	EndTimestamp *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	// Max number of devices to return (default: 10).
+	Limit int64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Timezone (IANA, e.g. "Europe/Amsterdam") used to aggregate the report
+	// per day (default: UTC).
+	Timezone             string   `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetApplicationDeliveryReportRequest) Reset()         { *m = GetApplicationDeliveryReportRequest{} }
+func (m *GetApplicationDeliveryReportRequest) String() string { return proto.CompactTextString(m) }
+func (*GetApplicationDeliveryReportRequest) ProtoMessage()    {}
+func (*GetApplicationDeliveryReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{60}
+}
+func (m *GetApplicationDeliveryReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetApplicationDeliveryReportRequest.Unmarshal(m, b)
+}
+func (m *GetApplicationDeliveryReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetApplicationDeliveryReportRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetApplicationDeliveryReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetApplicationDeliveryReportRequest.Merge(dst, src)
+}
+func (m *GetApplicationDeliveryReportRequest) XXX_Size() int {
+	return xxx_messageInfo_GetApplicationDeliveryReportRequest.Size(m)
+}
+func (m *GetApplicationDeliveryReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetApplicationDeliveryReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetApplicationDeliveryReportRequest proto.InternalMessageInfo
+
+func (m *GetApplicationDeliveryReportRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *GetApplicationDeliveryReportRequest) GetStartTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTimestamp
+	}
+	return nil
+}
+
+func (m *GetApplicationDeliveryReportRequest) GetEndTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTimestamp
+	}
+	return nil
+}
+
+func (m *GetApplicationDeliveryReportRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetApplicationDeliveryReportRequest) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+type DeliveryRate struct {
+	// Date (start of the day, in the requested timezone).
+	Date *timestamp.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Number of received uplinks.
+	ReceivedCount int64 `protobuf:"varint,2,opt,name=received_count,json=receivedCount,proto3" json:"received_count,omitempty"`
+	// Number of expected uplinks (based on the uplink frame-counters).
+	ExpectedCount int64 `protobuf:"varint,3,opt,name=expected_count,json=expectedCount,proto3" json:"expected_count,omitempty"`
+	// Delivery-rate (received / expected, 0 - 1).
+	DeliveryRate         float64  `protobuf:"fixed64,4,opt,name=delivery_rate,json=deliveryRate,proto3" json:"delivery_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeliveryRate) Reset()         { *m = DeliveryRate{} }
+func (m *DeliveryRate) String() string { return proto.CompactTextString(m) }
+func (*DeliveryRate) ProtoMessage()    {}
+func (*DeliveryRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{61}
+}
+func (m *DeliveryRate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeliveryRate.Unmarshal(m, b)
+}
+func (m *DeliveryRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeliveryRate.Marshal(b, m, deterministic)
+}
+func (dst *DeliveryRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliveryRate.Merge(dst, src)
+}
+func (m *DeliveryRate) XXX_Size() int {
+	return xxx_messageInfo_DeliveryRate.Size(m)
+}
+func (m *DeliveryRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliveryRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliveryRate proto.InternalMessageInfo
+
+func (m *DeliveryRate) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *DeliveryRate) GetReceivedCount() int64 {
+	if m != nil {
+		return m.ReceivedCount
+	}
+	return 0
+}
+
+func (m *DeliveryRate) GetExpectedCount() int64 {
+	if m != nil {
+		return m.ExpectedCount
+	}
+	return 0
+}
+
+func (m *DeliveryRate) GetDeliveryRate() float64 {
+	if m != nil {
+		return m.DeliveryRate
+	}
+	return 0
+}
+
+type DeviceDeliveryRate struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Name of the device.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of received uplinks.
+	ReceivedCount int64 `protobuf:"varint,3,opt,name=received_count,json=receivedCount,proto3" json:"received_count,omitempty"`
+	// Number of expected uplinks (based on the uplink frame-counters).
+	ExpectedCount int64 `protobuf:"varint,4,opt,name=expected_count,json=expectedCount,proto3" json:"expected_count,omitempty"`
+	// Delivery-rate (received / expected, 0 - 1).
+	DeliveryRate         float64  `protobuf:"fixed64,5,opt,name=delivery_rate,json=deliveryRate,proto3" json:"delivery_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceDeliveryRate) Reset()         { *m = DeviceDeliveryRate{} }
+func (m *DeviceDeliveryRate) String() string { return proto.CompactTextString(m) }
+func (*DeviceDeliveryRate) ProtoMessage()    {}
+func (*DeviceDeliveryRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{62}
+}
+func (m *DeviceDeliveryRate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceDeliveryRate.Unmarshal(m, b)
+}
+func (m *DeviceDeliveryRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceDeliveryRate.Marshal(b, m, deterministic)
+}
+func (dst *DeviceDeliveryRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceDeliveryRate.Merge(dst, src)
+}
+func (m *DeviceDeliveryRate) XXX_Size() int {
+	return xxx_messageInfo_DeviceDeliveryRate.Size(m)
+}
+func (m *DeviceDeliveryRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceDeliveryRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceDeliveryRate proto.InternalMessageInfo
+
+func (m *DeviceDeliveryRate) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *DeviceDeliveryRate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeviceDeliveryRate) GetReceivedCount() int64 {
+	if m != nil {
+		return m.ReceivedCount
+	}
+	return 0
+}
+
+func (m *DeviceDeliveryRate) GetExpectedCount() int64 {
+	if m != nil {
+		return m.ExpectedCount
+	}
+	return 0
+}
+
+func (m *DeviceDeliveryRate) GetDeliveryRate() float64 {
+	if m != nil {
+		return m.DeliveryRate
+	}
+	return 0
+}
+
+type GetApplicationDeliveryReportResponse struct {
+	// Total number of received uplinks.
+	ReceivedCount int64 `protobuf:"varint,1,opt,name=received_count,json=receivedCount,proto3" json:"received_count,omitempty"`
+	// Total number of expected uplinks.
+	ExpectedCount int64 `protobuf:"varint,2,opt,name=expected_count,json=expectedCount,proto3" json:"expected_count,omitempty"`
+	// Delivery-rate (received / expected, 0 - 1).
+	DeliveryRate float64 `protobuf:"fixed64,3,opt,name=delivery_rate,json=deliveryRate,proto3" json:"delivery_rate,omitempty"`
+	// Delivery-rate per day.
+	Days []*DeliveryRate `protobuf:"bytes,4,rep,name=days,proto3" json:"days,omitempty"`
+	// Devices with the lowest delivery-rate.
+	Devices              []*DeviceDeliveryRate `protobuf:"bytes,5,rep,name=devices,proto3" json:"devices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetApplicationDeliveryReportResponse) Reset()         { *m = GetApplicationDeliveryReportResponse{} }
+func (m *GetApplicationDeliveryReportResponse) String() string { return proto.CompactTextString(m) }
+func (*GetApplicationDeliveryReportResponse) ProtoMessage()    {}
+func (*GetApplicationDeliveryReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{63}
+}
+func (m *GetApplicationDeliveryReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetApplicationDeliveryReportResponse.Unmarshal(m, b)
+}
+func (m *GetApplicationDeliveryReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetApplicationDeliveryReportResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetApplicationDeliveryReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetApplicationDeliveryReportResponse.Merge(dst, src)
+}
+func (m *GetApplicationDeliveryReportResponse) XXX_Size() int {
+	return xxx_messageInfo_GetApplicationDeliveryReportResponse.Size(m)
+}
+func (m *GetApplicationDeliveryReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetApplicationDeliveryReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetApplicationDeliveryReportResponse proto.InternalMessageInfo
+
+func (m *GetApplicationDeliveryReportResponse) GetReceivedCount() int64 {
+	if m != nil {
+		return m.ReceivedCount
+	}
+	return 0
+}
+
+func (m *GetApplicationDeliveryReportResponse) GetExpectedCount() int64 {
+	if m != nil {
+		return m.ExpectedCount
+	}
+	return 0
+}
+
+func (m *GetApplicationDeliveryReportResponse) GetDeliveryRate() float64 {
+	if m != nil {
+		return m.DeliveryRate
+	}
+	return 0
+}
+
+func (m *GetApplicationDeliveryReportResponse) GetDays() []*DeliveryRate {
+	if m != nil {
+		return m.Days
+	}
+	return nil
+}
+
+func (m *GetApplicationDeliveryReportResponse) GetDevices() []*DeviceDeliveryRate {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
+type ListApplicationDeliveryLogRequest struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Max number of entries to return (default: all logged entries).
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListApplicationDeliveryLogRequest) Reset()         { *m = ListApplicationDeliveryLogRequest{} }
+func (m *ListApplicationDeliveryLogRequest) String() string { return proto.CompactTextString(m) }
+func (*ListApplicationDeliveryLogRequest) ProtoMessage()    {}
+func (*ListApplicationDeliveryLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{64}
+}
+func (m *ListApplicationDeliveryLogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListApplicationDeliveryLogRequest.Unmarshal(m, b)
+}
+func (m *ListApplicationDeliveryLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListApplicationDeliveryLogRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListApplicationDeliveryLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApplicationDeliveryLogRequest.Merge(dst, src)
+}
+func (m *ListApplicationDeliveryLogRequest) XXX_Size() int {
+	return xxx_messageInfo_ListApplicationDeliveryLogRequest.Size(m)
+}
+func (m *ListApplicationDeliveryLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApplicationDeliveryLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApplicationDeliveryLogRequest proto.InternalMessageInfo
+
+func (m *ListApplicationDeliveryLogRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *ListApplicationDeliveryLogRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type DeliveryLogEntry struct {
+	// Timestamp of the delivery attempt.
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Integration kind (e.g. HTTP).
+	Integration string `protobuf:"bytes,2,opt,name=integration,proto3" json:"integration,omitempty"`
+	// Event type (e.g. up, join, ack, error, status, location).
+	EventType string `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// Device EUI (HEX encoded, empty for admin-plane events).
+	DevEui string `protobuf:"bytes,4,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Endpoint URL.
+	Url string `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	// HTTP status code (0 when no response was received).
+	StatusCode uint32 `protobuf:"varint,6,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// Latency of the delivery attempt.
+	Latency *duration.Duration `protobuf:"bytes,7,opt,name=latency,proto3" json:"latency,omitempty"`
+	// Response body (truncated to 1KB).
+	ResponseBody string `protobuf:"bytes,8,opt,name=response_body,json=responseBody,proto3" json:"response_body,omitempty"`
+	// Error (empty on success).
+	Error                string   `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeliveryLogEntry) Reset()         { *m = DeliveryLogEntry{} }
+func (m *DeliveryLogEntry) String() string { return proto.CompactTextString(m) }
+func (*DeliveryLogEntry) ProtoMessage()    {}
+func (*DeliveryLogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{65}
+}
+func (m *DeliveryLogEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeliveryLogEntry.Unmarshal(m, b)
+}
+func (m *DeliveryLogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeliveryLogEntry.Marshal(b, m, deterministic)
+}
+func (dst *DeliveryLogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliveryLogEntry.Merge(dst, src)
+}
+func (m *DeliveryLogEntry) XXX_Size() int {
+	return xxx_messageInfo_DeliveryLogEntry.Size(m)
+}
+func (m *DeliveryLogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliveryLogEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliveryLogEntry proto.InternalMessageInfo
+
+func (m *DeliveryLogEntry) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *DeliveryLogEntry) GetIntegration() string {
+	if m != nil {
+		return m.Integration
+	}
+	return ""
+}
+
+func (m *DeliveryLogEntry) GetEventType() string {
+	if m != nil {
+		return m.EventType
+	}
+	return ""
+}
+
+func (m *DeliveryLogEntry) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *DeliveryLogEntry) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *DeliveryLogEntry) GetStatusCode() uint32 {
+	if m != nil {
+		return m.StatusCode
+	}
+	return 0
+}
+
+func (m *DeliveryLogEntry) GetLatency() *duration.Duration {
+	if m != nil {
+		return m.Latency
+	}
+	return nil
+}
+
+func (m *DeliveryLogEntry) GetResponseBody() string {
+	if m != nil {
+		return m.ResponseBody
+	}
+	return ""
+}
+
+func (m *DeliveryLogEntry) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ListApplicationDeliveryLogResponse struct {
+	// Delivery attempts (newest first).
+	Result               []*DeliveryLogEntry `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListApplicationDeliveryLogResponse) Reset()         { *m = ListApplicationDeliveryLogResponse{} }
+func (m *ListApplicationDeliveryLogResponse) String() string { return proto.CompactTextString(m) }
+func (*ListApplicationDeliveryLogResponse) ProtoMessage()    {}
+func (*ListApplicationDeliveryLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{66}
+}
+func (m *ListApplicationDeliveryLogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListApplicationDeliveryLogResponse.Unmarshal(m, b)
+}
+func (m *ListApplicationDeliveryLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListApplicationDeliveryLogResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListApplicationDeliveryLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApplicationDeliveryLogResponse.Merge(dst, src)
+}
+func (m *ListApplicationDeliveryLogResponse) XXX_Size() int {
+	return xxx_messageInfo_ListApplicationDeliveryLogResponse.Size(m)
+}
+func (m *ListApplicationDeliveryLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApplicationDeliveryLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApplicationDeliveryLogResponse proto.InternalMessageInfo
+
+func (m *ListApplicationDeliveryLogResponse) GetResult() []*DeliveryLogEntry {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ListApplicationQuarantinedFramesRequest struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Max number of frames to return (default: all quarantined frames).
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListApplicationQuarantinedFramesRequest) Reset() {
+	*m = ListApplicationQuarantinedFramesRequest{}
+}
+func (m *ListApplicationQuarantinedFramesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListApplicationQuarantinedFramesRequest) ProtoMessage()    {}
+func (*ListApplicationQuarantinedFramesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{67}
+}
+func (m *ListApplicationQuarantinedFramesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListApplicationQuarantinedFramesRequest.Unmarshal(m, b)
+}
+func (m *ListApplicationQuarantinedFramesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListApplicationQuarantinedFramesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListApplicationQuarantinedFramesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApplicationQuarantinedFramesRequest.Merge(dst, src)
+}
+func (m *ListApplicationQuarantinedFramesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListApplicationQuarantinedFramesRequest.Size(m)
+}
+func (m *ListApplicationQuarantinedFramesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApplicationQuarantinedFramesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApplicationQuarantinedFramesRequest proto.InternalMessageInfo
+
+func (m *ListApplicationQuarantinedFramesRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *ListApplicationQuarantinedFramesRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type QuarantinedFrame struct {
+	// Timestamp of the uplink.
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,2,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Device address (HEX encoded, empty when the device-activation is missing).
+	DevAddr string `protobuf:"bytes,3,opt,name=dev_addr,json=devAddr,proto3" json:"dev_addr,omitempty"`
+	// Uplink frame-counter.
+	FCnt uint32 `protobuf:"varint,4,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	// FPort.
+	FPort uint32 `protobuf:"varint,5,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// Encrypted FRMPayload.
+	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	// Decryption error.
+	Error                string   `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuarantinedFrame) Reset()         { *m = QuarantinedFrame{} }
+func (m *QuarantinedFrame) String() string { return proto.CompactTextString(m) }
+func (*QuarantinedFrame) ProtoMessage()    {}
+func (*QuarantinedFrame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{68}
+}
+func (m *QuarantinedFrame) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuarantinedFrame.Unmarshal(m, b)
+}
+func (m *QuarantinedFrame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuarantinedFrame.Marshal(b, m, deterministic)
+}
+func (dst *QuarantinedFrame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedFrame.Merge(dst, src)
+}
+func (m *QuarantinedFrame) XXX_Size() int {
+	return xxx_messageInfo_QuarantinedFrame.Size(m)
+}
+func (m *QuarantinedFrame) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedFrame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantinedFrame proto.InternalMessageInfo
+
+func (m *QuarantinedFrame) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *QuarantinedFrame) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *QuarantinedFrame) GetDevAddr() string {
+	if m != nil {
+		return m.DevAddr
+	}
+	return ""
+}
+
+func (m *QuarantinedFrame) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+func (m *QuarantinedFrame) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *QuarantinedFrame) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *QuarantinedFrame) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ListApplicationQuarantinedFramesResponse struct {
+	// Quarantined frames (newest first).
+	Result               []*QuarantinedFrame `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListApplicationQuarantinedFramesResponse) Reset() {
+	*m = ListApplicationQuarantinedFramesResponse{}
+}
+func (m *ListApplicationQuarantinedFramesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListApplicationQuarantinedFramesResponse) ProtoMessage()    {}
+func (*ListApplicationQuarantinedFramesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{69}
+}
+func (m *ListApplicationQuarantinedFramesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListApplicationQuarantinedFramesResponse.Unmarshal(m, b)
+}
+func (m *ListApplicationQuarantinedFramesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListApplicationQuarantinedFramesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListApplicationQuarantinedFramesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApplicationQuarantinedFramesResponse.Merge(dst, src)
+}
+func (m *ListApplicationQuarantinedFramesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListApplicationQuarantinedFramesResponse.Size(m)
+}
+func (m *ListApplicationQuarantinedFramesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApplicationQuarantinedFramesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApplicationQuarantinedFramesResponse proto.InternalMessageInfo
+
+func (m *ListApplicationQuarantinedFramesResponse) GetResult() []*QuarantinedFrame {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ClearApplicationQuarantinedFramesRequest struct {
+	// Application ID.
+	ApplicationId        int64    `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClearApplicationQuarantinedFramesRequest) Reset() {
+	*m = ClearApplicationQuarantinedFramesRequest{}
+}
+func (m *ClearApplicationQuarantinedFramesRequest) String() string { return proto.CompactTextString(m) }
+func (*ClearApplicationQuarantinedFramesRequest) ProtoMessage()    {}
+func (*ClearApplicationQuarantinedFramesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{70}
+}
+func (m *ClearApplicationQuarantinedFramesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClearApplicationQuarantinedFramesRequest.Unmarshal(m, b)
+}
+func (m *ClearApplicationQuarantinedFramesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClearApplicationQuarantinedFramesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ClearApplicationQuarantinedFramesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearApplicationQuarantinedFramesRequest.Merge(dst, src)
+}
+func (m *ClearApplicationQuarantinedFramesRequest) XXX_Size() int {
+	return xxx_messageInfo_ClearApplicationQuarantinedFramesRequest.Size(m)
+}
+func (m *ClearApplicationQuarantinedFramesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearApplicationQuarantinedFramesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClearApplicationQuarantinedFramesRequest proto.InternalMessageInfo
+
+func (m *ClearApplicationQuarantinedFramesRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+type ListApplicationDeadLettersRequest struct {
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Max number of events to return (default: all dead-letters).
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListApplicationDeadLettersRequest) Reset()         { *m = ListApplicationDeadLettersRequest{} }
+func (m *ListApplicationDeadLettersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListApplicationDeadLettersRequest) ProtoMessage()    {}
+func (*ListApplicationDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{71}
+}
+func (m *ListApplicationDeadLettersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListApplicationDeadLettersRequest.Unmarshal(m, b)
+}
+func (m *ListApplicationDeadLettersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListApplicationDeadLettersRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListApplicationDeadLettersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApplicationDeadLettersRequest.Merge(dst, src)
+}
+func (m *ListApplicationDeadLettersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListApplicationDeadLettersRequest.Size(m)
+}
+func (m *ListApplicationDeadLettersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApplicationDeadLettersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApplicationDeadLettersRequest proto.InternalMessageInfo
+
+func (m *ListApplicationDeadLettersRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *ListApplicationDeadLettersRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type DeadLetter struct {
+	// Timestamp when the event was added to the dead-letter list.
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Integration kind (e.g. HTTP).
+	Integration string `protobuf:"bytes,2,opt,name=integration,proto3" json:"integration,omitempty"`
+	// Event type (e.g. up, join, ack, error, status, location).
+	EventType string `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// Event payload (JSON encoded).
+	PayloadJson string `protobuf:"bytes,4,opt,name=payload_json,json=payloadJSON,proto3" json:"payload_json,omitempty"`
+	// Number of delivery attempts.
+	Attempts uint32 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Error of the last delivery attempt.
+	Error                string   `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeadLetter) Reset()         { *m = DeadLetter{} }
+func (m *DeadLetter) String() string { return proto.CompactTextString(m) }
+func (*DeadLetter) ProtoMessage()    {}
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{72}
+}
+func (m *DeadLetter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeadLetter.Unmarshal(m, b)
+}
+func (m *DeadLetter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeadLetter.Marshal(b, m, deterministic)
+}
+func (dst *DeadLetter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadLetter.Merge(dst, src)
+}
+func (m *DeadLetter) XXX_Size() int {
+	return xxx_messageInfo_DeadLetter.Size(m)
+}
+func (m *DeadLetter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadLetter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadLetter proto.InternalMessageInfo
+
+func (m *DeadLetter) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *DeadLetter) GetIntegration() string {
+	if m != nil {
+		return m.Integration
+	}
+	return ""
+}
+
+func (m *DeadLetter) GetEventType() string {
+	if m != nil {
+		return m.EventType
+	}
+	return ""
+}
+
+func (m *DeadLetter) GetPayloadJson() string {
+	if m != nil {
+		return m.PayloadJson
+	}
+	return ""
+}
+
+func (m *DeadLetter) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *DeadLetter) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ListApplicationDeadLettersResponse struct {
+	// Dead-letters (newest first).
+	Result               []*DeadLetter `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListApplicationDeadLettersResponse) Reset()         { *m = ListApplicationDeadLettersResponse{} }
+func (m *ListApplicationDeadLettersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListApplicationDeadLettersResponse) ProtoMessage()    {}
+func (*ListApplicationDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{73}
+}
+func (m *ListApplicationDeadLettersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListApplicationDeadLettersResponse.Unmarshal(m, b)
+}
+func (m *ListApplicationDeadLettersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListApplicationDeadLettersResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListApplicationDeadLettersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApplicationDeadLettersResponse.Merge(dst, src)
+}
+func (m *ListApplicationDeadLettersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListApplicationDeadLettersResponse.Size(m)
+}
+func (m *ListApplicationDeadLettersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApplicationDeadLettersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApplicationDeadLettersResponse proto.InternalMessageInfo
+
+func (m *ListApplicationDeadLettersResponse) GetResult() []*DeadLetter {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ClearApplicationDeadLettersRequest struct {
+	// Application ID.
+	ApplicationId        int64    `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClearApplicationDeadLettersRequest) Reset()         { *m = ClearApplicationDeadLettersRequest{} }
+func (m *ClearApplicationDeadLettersRequest) String() string { return proto.CompactTextString(m) }
+func (*ClearApplicationDeadLettersRequest) ProtoMessage()    {}
+func (*ClearApplicationDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{74}
+}
+func (m *ClearApplicationDeadLettersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClearApplicationDeadLettersRequest.Unmarshal(m, b)
+}
+func (m *ClearApplicationDeadLettersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClearApplicationDeadLettersRequest.Marshal(b, m, deterministic)
+}
+func (dst *ClearApplicationDeadLettersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearApplicationDeadLettersRequest.Merge(dst, src)
+}
+func (m *ClearApplicationDeadLettersRequest) XXX_Size() int {
+	return xxx_messageInfo_ClearApplicationDeadLettersRequest.Size(m)
+}
+func (m *ClearApplicationDeadLettersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearApplicationDeadLettersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClearApplicationDeadLettersRequest proto.InternalMessageInfo
+
+func (m *ClearApplicationDeadLettersRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+type GenerateMQTTIntegrationClientCertificateRequest struct {
+	// Application ID.
+	ApplicationId        int64    `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenerateMQTTIntegrationClientCertificateRequest) Reset() {
+	*m = GenerateMQTTIntegrationClientCertificateRequest{}
+}
+func (m *GenerateMQTTIntegrationClientCertificateRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*GenerateMQTTIntegrationClientCertificateRequest) ProtoMessage() {}
+func (*GenerateMQTTIntegrationClientCertificateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{75}
+}
+func (m *GenerateMQTTIntegrationClientCertificateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenerateMQTTIntegrationClientCertificateRequest.Unmarshal(m, b)
+}
+func (m *GenerateMQTTIntegrationClientCertificateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenerateMQTTIntegrationClientCertificateRequest.Marshal(b, m, deterministic)
+}
+func (dst *GenerateMQTTIntegrationClientCertificateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateMQTTIntegrationClientCertificateRequest.Merge(dst, src)
+}
+func (m *GenerateMQTTIntegrationClientCertificateRequest) XXX_Size() int {
+	return xxx_messageInfo_GenerateMQTTIntegrationClientCertificateRequest.Size(m)
+}
+func (m *GenerateMQTTIntegrationClientCertificateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateMQTTIntegrationClientCertificateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateMQTTIntegrationClientCertificateRequest proto.InternalMessageInfo
+
+func (m *GenerateMQTTIntegrationClientCertificateRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+type GenerateMQTTIntegrationClientCertificateResponse struct {
+	// TLS certificate (PEM encoded).
+	TlsCert string `protobuf:"bytes,1,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
+	// TLS key (PEM encoded).
+	TlsKey string `protobuf:"bytes,2,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
+	// CA certificate (PEM encoded).
+	CaCert string `protobuf:"bytes,3,opt,name=ca_cert,json=caCert,proto3" json:"ca_cert,omitempty"`
+	// Expiration date of the certificate.
+	ExpiresAt            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GenerateMQTTIntegrationClientCertificateResponse) Reset() {
+	*m = GenerateMQTTIntegrationClientCertificateResponse{}
+}
+func (m *GenerateMQTTIntegrationClientCertificateResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*GenerateMQTTIntegrationClientCertificateResponse) ProtoMessage() {}
+func (*GenerateMQTTIntegrationClientCertificateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{76}
+}
+func (m *GenerateMQTTIntegrationClientCertificateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenerateMQTTIntegrationClientCertificateResponse.Unmarshal(m, b)
+}
+func (m *GenerateMQTTIntegrationClientCertificateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenerateMQTTIntegrationClientCertificateResponse.Marshal(b, m, deterministic)
+}
+func (dst *GenerateMQTTIntegrationClientCertificateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateMQTTIntegrationClientCertificateResponse.Merge(dst, src)
+}
+func (m *GenerateMQTTIntegrationClientCertificateResponse) XXX_Size() int {
+	return xxx_messageInfo_GenerateMQTTIntegrationClientCertificateResponse.Size(m)
+}
+func (m *GenerateMQTTIntegrationClientCertificateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateMQTTIntegrationClientCertificateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateMQTTIntegrationClientCertificateResponse proto.InternalMessageInfo
+
+func (m *GenerateMQTTIntegrationClientCertificateResponse) GetTlsCert() string {
+	if m != nil {
+		return m.TlsCert
+	}
+	return ""
+}
+
+func (m *GenerateMQTTIntegrationClientCertificateResponse) GetTlsKey() string {
+	if m != nil {
+		return m.TlsKey
+	}
+	return ""
+}
+
+func (m *GenerateMQTTIntegrationClientCertificateResponse) GetCaCert() string {
+	if m != nil {
+		return m.CaCert
+	}
+	return ""
+}
+
+func (m *GenerateMQTTIntegrationClientCertificateResponse) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+type GenerateMQTTIntegrationCredentialsRequest struct {
+	// Application ID.
+	ApplicationId        int64    `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenerateMQTTIntegrationCredentialsRequest) Reset() {
+	*m = GenerateMQTTIntegrationCredentialsRequest{}
+}
+func (m *GenerateMQTTIntegrationCredentialsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*GenerateMQTTIntegrationCredentialsRequest) ProtoMessage() {}
+func (*GenerateMQTTIntegrationCredentialsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{77}
+}
+func (m *GenerateMQTTIntegrationCredentialsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenerateMQTTIntegrationCredentialsRequest.Unmarshal(m, b)
+}
+func (m *GenerateMQTTIntegrationCredentialsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenerateMQTTIntegrationCredentialsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GenerateMQTTIntegrationCredentialsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateMQTTIntegrationCredentialsRequest.Merge(dst, src)
+}
+func (m *GenerateMQTTIntegrationCredentialsRequest) XXX_Size() int {
+	return xxx_messageInfo_GenerateMQTTIntegrationCredentialsRequest.Size(m)
+}
+func (m *GenerateMQTTIntegrationCredentialsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateMQTTIntegrationCredentialsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateMQTTIntegrationCredentialsRequest proto.InternalMessageInfo
+
+func (m *GenerateMQTTIntegrationCredentialsRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+type GenerateMQTTIntegrationCredentialsResponse struct {
+	// Username (the application ID).
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// Password.
+	// The password is not stored and can not be retrieved afterwards.
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenerateMQTTIntegrationCredentialsResponse) Reset() {
+	*m = GenerateMQTTIntegrationCredentialsResponse{}
+}
+func (m *GenerateMQTTIntegrationCredentialsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*GenerateMQTTIntegrationCredentialsResponse) ProtoMessage() {}
+func (*GenerateMQTTIntegrationCredentialsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{78}
+}
+func (m *GenerateMQTTIntegrationCredentialsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenerateMQTTIntegrationCredentialsResponse.Unmarshal(m, b)
+}
+func (m *GenerateMQTTIntegrationCredentialsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenerateMQTTIntegrationCredentialsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GenerateMQTTIntegrationCredentialsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateMQTTIntegrationCredentialsResponse.Merge(dst, src)
+}
+func (m *GenerateMQTTIntegrationCredentialsResponse) XXX_Size() int {
+	return xxx_messageInfo_GenerateMQTTIntegrationCredentialsResponse.Size(m)
+}
+func (m *GenerateMQTTIntegrationCredentialsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateMQTTIntegrationCredentialsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateMQTTIntegrationCredentialsResponse proto.InternalMessageInfo
+
+func (m *GenerateMQTTIntegrationCredentialsResponse) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *GenerateMQTTIntegrationCredentialsResponse) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type DeleteMQTTIntegrationCredentialsRequest struct {
+	// Application ID.
+	ApplicationId        int64    `protobuf:"varint,1,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteMQTTIntegrationCredentialsRequest) Reset() {
+	*m = DeleteMQTTIntegrationCredentialsRequest{}
+}
+func (m *DeleteMQTTIntegrationCredentialsRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteMQTTIntegrationCredentialsRequest) ProtoMessage()    {}
+func (*DeleteMQTTIntegrationCredentialsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{79}
+}
+func (m *DeleteMQTTIntegrationCredentialsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteMQTTIntegrationCredentialsRequest.Unmarshal(m, b)
+}
+func (m *DeleteMQTTIntegrationCredentialsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteMQTTIntegrationCredentialsRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteMQTTIntegrationCredentialsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteMQTTIntegrationCredentialsRequest.Merge(dst, src)
+}
+func (m *DeleteMQTTIntegrationCredentialsRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteMQTTIntegrationCredentialsRequest.Size(m)
+}
+func (m *DeleteMQTTIntegrationCredentialsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteMQTTIntegrationCredentialsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteMQTTIntegrationCredentialsRequest proto.InternalMessageInfo
+
+func (m *DeleteMQTTIntegrationCredentialsRequest) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+type SetApplicationLegalHoldRequest struct {
+	// Application ID.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetApplicationLegalHoldRequest) Reset()         { *m = SetApplicationLegalHoldRequest{} }
+func (m *SetApplicationLegalHoldRequest) String() string { return proto.CompactTextString(m) }
+func (*SetApplicationLegalHoldRequest) ProtoMessage()    {}
+func (*SetApplicationLegalHoldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{12}
+}
+func (m *SetApplicationLegalHoldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetApplicationLegalHoldRequest.Unmarshal(m, b)
+}
+func (m *SetApplicationLegalHoldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetApplicationLegalHoldRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetApplicationLegalHoldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetApplicationLegalHoldRequest.Merge(dst, src)
+}
+func (m *SetApplicationLegalHoldRequest) XXX_Size() int {
+	return xxx_messageInfo_SetApplicationLegalHoldRequest.Size(m)
+}
+func (m *SetApplicationLegalHoldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetApplicationLegalHoldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetApplicationLegalHoldRequest proto.InternalMessageInfo
+
+func (m *SetApplicationLegalHoldRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ClearApplicationLegalHoldRequest struct {
+	// Application ID.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClearApplicationLegalHoldRequest) Reset()         { *m = ClearApplicationLegalHoldRequest{} }
+func (m *ClearApplicationLegalHoldRequest) String() string { return proto.CompactTextString(m) }
+func (*ClearApplicationLegalHoldRequest) ProtoMessage()    {}
+func (*ClearApplicationLegalHoldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc846aced8fe6ea6, []int{13}
+}
+func (m *ClearApplicationLegalHoldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClearApplicationLegalHoldRequest.Unmarshal(m, b)
+}
+func (m *ClearApplicationLegalHoldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClearApplicationLegalHoldRequest.Marshal(b, m, deterministic)
+}
+func (dst *ClearApplicationLegalHoldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearApplicationLegalHoldRequest.Merge(dst, src)
+}
+func (m *ClearApplicationLegalHoldRequest) XXX_Size() int {
+	return xxx_messageInfo_ClearApplicationLegalHoldRequest.Size(m)
+}
+func (m *ClearApplicationLegalHoldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearApplicationLegalHoldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClearApplicationLegalHoldRequest proto.InternalMessageInfo
+
+func (m *ClearApplicationLegalHoldRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Application)(nil), "api.Application")
+	proto.RegisterType((*ApplicationFieldMapping)(nil), "api.ApplicationFieldMapping")
+	proto.RegisterType((*ApplicationMQTTTopicTemplates)(nil), "api.ApplicationMQTTTopicTemplates")
+	proto.RegisterType((*ApplicationListItem)(nil), "api.ApplicationListItem")
+	proto.RegisterType((*CreateApplicationRequest)(nil), "api.CreateApplicationRequest")
+	proto.RegisterType((*CreateApplicationResponse)(nil), "api.CreateApplicationResponse")
+	proto.RegisterType((*GetApplicationRequest)(nil), "api.GetApplicationRequest")
+	proto.RegisterType((*GetApplicationResponse)(nil), "api.GetApplicationResponse")
+	proto.RegisterType((*UpdateApplicationRequest)(nil), "api.UpdateApplicationRequest")
+	proto.RegisterType((*DeleteApplicationRequest)(nil), "api.DeleteApplicationRequest")
+	proto.RegisterType((*ArchiveApplicationRequest)(nil), "api.ArchiveApplicationRequest")
+	proto.RegisterType((*UnarchiveApplicationRequest)(nil), "api.UnarchiveApplicationRequest")
+	proto.RegisterType((*SetApplicationLegalHoldRequest)(nil), "api.SetApplicationLegalHoldRequest")
+	proto.RegisterType((*ClearApplicationLegalHoldRequest)(nil), "api.ClearApplicationLegalHoldRequest")
+	proto.RegisterType((*ListApplicationRequest)(nil), "api.ListApplicationRequest")
+	proto.RegisterType((*ListApplicationResponse)(nil), "api.ListApplicationResponse")
+	proto.RegisterType((*HTTPIntegrationHeader)(nil), "api.HTTPIntegrationHeader")
+	proto.RegisterType((*HTTPIntegration)(nil), "api.HTTPIntegration")
+	proto.RegisterType((*CreateHTTPIntegrationRequest)(nil), "api.CreateHTTPIntegrationRequest")
+	proto.RegisterType((*GetHTTPIntegrationRequest)(nil), "api.GetHTTPIntegrationRequest")
+	proto.RegisterType((*GetHTTPIntegrationResponse)(nil), "api.GetHTTPIntegrationResponse")
+	proto.RegisterType((*UpdateHTTPIntegrationRequest)(nil), "api.UpdateHTTPIntegrationRequest")
+	proto.RegisterType((*DeleteHTTPIntegrationRequest)(nil), "api.DeleteHTTPIntegrationRequest")
+	proto.RegisterType((*ListIntegrationRequest)(nil), "api.ListIntegrationRequest")
+	proto.RegisterType((*IntegrationListItem)(nil), "api.IntegrationListItem")
+	proto.RegisterType((*ListIntegrationResponse)(nil), "api.ListIntegrationResponse")
+	proto.RegisterType((*InfluxDBIntegration)(nil), "api.InfluxDBIntegration")
+	proto.RegisterType((*CreateInfluxDBIntegrationRequest)(nil), "api.CreateInfluxDBIntegrationRequest")
+	proto.RegisterType((*GetInfluxDBIntegrationRequest)(nil), "api.GetInfluxDBIntegrationRequest")
+	proto.RegisterType((*GetInfluxDBIntegrationResponse)(nil), "api.GetInfluxDBIntegrationResponse")
+	proto.RegisterType((*UpdateInfluxDBIntegrationRequest)(nil), "api.UpdateInfluxDBIntegrationRequest")
+	proto.RegisterType((*DeleteInfluxDBIntegrationRequest)(nil), "api.DeleteInfluxDBIntegrationRequest")
+	proto.RegisterType((*AzureIntegration)(nil), "api.AzureIntegration")
+	proto.RegisterType((*CreateAzureIntegrationRequest)(nil), "api.CreateAzureIntegrationRequest")
+	proto.RegisterType((*GetAzureIntegrationRequest)(nil), "api.GetAzureIntegrationRequest")
+	proto.RegisterType((*GetAzureIntegrationResponse)(nil), "api.GetAzureIntegrationResponse")
+	proto.RegisterType((*UpdateAzureIntegrationRequest)(nil), "api.UpdateAzureIntegrationRequest")
+	proto.RegisterType((*DeleteAzureIntegrationRequest)(nil), "api.DeleteAzureIntegrationRequest")
+	proto.RegisterType((*ThingsBoardIntegration)(nil), "api.ThingsBoardIntegration")
+	proto.RegisterType((*CreateThingsBoardIntegrationRequest)(nil), "api.CreateThingsBoardIntegrationRequest")
+	proto.RegisterType((*GetThingsBoardIntegrationRequest)(nil), "api.GetThingsBoardIntegrationRequest")
+	proto.RegisterType((*GetThingsBoardIntegrationResponse)(nil), "api.GetThingsBoardIntegrationResponse")
+	proto.RegisterType((*UpdateThingsBoardIntegrationRequest)(nil), "api.UpdateThingsBoardIntegrationRequest")
+	proto.RegisterType((*DeleteThingsBoardIntegrationRequest)(nil), "api.DeleteThingsBoardIntegrationRequest")
+	proto.RegisterType((*MyDevicesIntegration)(nil), "api.MyDevicesIntegration")
+	proto.RegisterType((*CreateMyDevicesIntegrationRequest)(nil), "api.CreateMyDevicesIntegrationRequest")
+	proto.RegisterType((*GetMyDevicesIntegrationRequest)(nil), "api.GetMyDevicesIntegrationRequest")
+	proto.RegisterType((*GetMyDevicesIntegrationResponse)(nil), "api.GetMyDevicesIntegrationResponse")
+	proto.RegisterType((*UpdateMyDevicesIntegrationRequest)(nil), "api.UpdateMyDevicesIntegrationRequest")
+	proto.RegisterType((*DeleteMyDevicesIntegrationRequest)(nil), "api.DeleteMyDevicesIntegrationRequest")
+	proto.RegisterType((*LoRaCloudIntegration)(nil), "api.LoRaCloudIntegration")
+	proto.RegisterType((*CreateLoRaCloudIntegrationRequest)(nil), "api.CreateLoRaCloudIntegrationRequest")
+	proto.RegisterType((*GetLoRaCloudIntegrationRequest)(nil), "api.GetLoRaCloudIntegrationRequest")
+	proto.RegisterType((*GetLoRaCloudIntegrationResponse)(nil), "api.GetLoRaCloudIntegrationResponse")
+	proto.RegisterType((*UpdateLoRaCloudIntegrationRequest)(nil), "api.UpdateLoRaCloudIntegrationRequest")
+	proto.RegisterType((*DeleteLoRaCloudIntegrationRequest)(nil), "api.DeleteLoRaCloudIntegrationRequest")
+	proto.RegisterType((*GetApplicationUplinkStatsRequest)(nil), "api.GetApplicationUplinkStatsRequest")
+	proto.RegisterType((*UplinkStatsCount)(nil), "api.UplinkStatsCount")
+	proto.RegisterType((*DeviceUplinkStats)(nil), "api.DeviceUplinkStats")
+	proto.RegisterType((*GetApplicationUplinkStatsResponse)(nil), "api.GetApplicationUplinkStatsResponse")
+	proto.RegisterType((*GetApplicationDeliveryReportRequest)(nil), "api.GetApplicationDeliveryReportRequest")
+	proto.RegisterType((*DeliveryRate)(nil), "api.DeliveryRate")
+	proto.RegisterType((*DeviceDeliveryRate)(nil), "api.DeviceDeliveryRate")
+	proto.RegisterType((*GetApplicationDeliveryReportResponse)(nil), "api.GetApplicationDeliveryReportResponse")
+	proto.RegisterType((*ListApplicationDeliveryLogRequest)(nil), "api.ListApplicationDeliveryLogRequest")
+	proto.RegisterType((*DeliveryLogEntry)(nil), "api.DeliveryLogEntry")
+	proto.RegisterType((*ListApplicationDeliveryLogResponse)(nil), "api.ListApplicationDeliveryLogResponse")
+	proto.RegisterType((*ListApplicationQuarantinedFramesRequest)(nil), "api.ListApplicationQuarantinedFramesRequest")
+	proto.RegisterType((*QuarantinedFrame)(nil), "api.QuarantinedFrame")
+	proto.RegisterType((*ListApplicationQuarantinedFramesResponse)(nil), "api.ListApplicationQuarantinedFramesResponse")
+	proto.RegisterType((*ClearApplicationQuarantinedFramesRequest)(nil), "api.ClearApplicationQuarantinedFramesRequest")
+	proto.RegisterType((*ListApplicationDeadLettersRequest)(nil), "api.ListApplicationDeadLettersRequest")
+	proto.RegisterType((*DeadLetter)(nil), "api.DeadLetter")
+	proto.RegisterType((*ListApplicationDeadLettersResponse)(nil), "api.ListApplicationDeadLettersResponse")
+	proto.RegisterType((*ClearApplicationDeadLettersRequest)(nil), "api.ClearApplicationDeadLettersRequest")
+	proto.RegisterType((*GenerateMQTTIntegrationClientCertificateRequest)(nil), "api.GenerateMQTTIntegrationClientCertificateRequest")
+	proto.RegisterType((*GenerateMQTTIntegrationClientCertificateResponse)(nil), "api.GenerateMQTTIntegrationClientCertificateResponse")
+	proto.RegisterType((*GenerateMQTTIntegrationCredentialsRequest)(nil), "api.GenerateMQTTIntegrationCredentialsRequest")
+	proto.RegisterType((*GenerateMQTTIntegrationCredentialsResponse)(nil), "api.GenerateMQTTIntegrationCredentialsResponse")
+	proto.RegisterType((*DeleteMQTTIntegrationCredentialsRequest)(nil), "api.DeleteMQTTIntegrationCredentialsRequest")
+	proto.RegisterEnum("api.IntegrationKind", IntegrationKind_name, IntegrationKind_value)
+	proto.RegisterEnum("api.InfluxDBPrecision", InfluxDBPrecision_name, InfluxDBPrecision_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ApplicationServiceClient is the client API for ApplicationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ApplicationServiceClient interface {
+	// Create creates the given application.
+	Create(ctx context.Context, in *CreateApplicationRequest, opts ...grpc.CallOption) (*CreateApplicationResponse, error)
+	// Get returns the requested application.
+	Get(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*GetApplicationResponse, error)
+	// Update updates the given application.
+	Update(ctx context.Context, in *UpdateApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Delete deletes the given application.
+	Delete(ctx context.Context, in *DeleteApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Archive archives the given application. The devices of an archived
+	// application no longer generate integration traffic (device events are
+	// still logged) and the application is hidden from the default listings.
+	Archive(ctx context.Context, in *ArchiveApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Unarchive unarchives the given application.
+	Unarchive(ctx context.Context, in *UnarchiveApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// SetLegalHold places the given application under legal hold. An application
+	// under legal hold (including its devices) can not be deleted and its data
+	// is excluded from the retention pruning. Only global admin users are
+	// allowed to set or clear the legal hold.
+	SetLegalHold(ctx context.Context, in *SetApplicationLegalHoldRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ClearLegalHold clears the legal hold of the given application.
+	ClearLegalHold(ctx context.Context, in *ClearApplicationLegalHoldRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// List lists the available applications.
+	List(ctx context.Context, in *ListApplicationRequest, opts ...grpc.CallOption) (*ListApplicationResponse, error)
+	// CreateHTTPIntegration creates a HTTP application-integration.
+	CreateHTTPIntegration(ctx context.Context, in *CreateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetHTTPIntegration returns the HTTP application-integration.
+	GetHTTPIntegration(ctx context.Context, in *GetHTTPIntegrationRequest, opts ...grpc.CallOption) (*GetHTTPIntegrationResponse, error)
+	// UpdateHTTPIntegration updates the HTTP application-integration.
+	UpdateHTTPIntegration(ctx context.Context, in *UpdateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// DeleteIntegration deletes the HTTP application-integration.
+	DeleteHTTPIntegration(ctx context.Context, in *DeleteHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// CreateInfluxDBIntegration create an InfluxDB application-integration.
+	CreateInfluxDBIntegration(ctx context.Context, in *CreateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetInfluxDBIntegration returns the InfluxDB application-integration.
+	GetInfluxDBIntegration(ctx context.Context, in *GetInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*GetInfluxDBIntegrationResponse, error)
+	// UpdateInfluxDBIntegration updates the InfluxDB application-integration.
+	UpdateInfluxDBIntegration(ctx context.Context, in *UpdateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// DeleteInfluxDBIntegration deletes the InfluxDB application-integration.
+	DeleteInfluxDBIntegration(ctx context.Context, in *DeleteInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// CreateAzureIntegration creates an Azure application-integration.
+	CreateAzureIntegration(ctx context.Context, in *CreateAzureIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetAzureIntegration returns the Azure application-integration.
+	GetAzureIntegration(ctx context.Context, in *GetAzureIntegrationRequest, opts ...grpc.CallOption) (*GetAzureIntegrationResponse, error)
+	// UpdateAzureIntegration updates the Azure application-integration.
+	UpdateAzureIntegration(ctx context.Context, in *UpdateAzureIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// DeleteAzureIntegration deletes the Azure application-integration.
+	DeleteAzureIntegration(ctx context.Context, in *DeleteAzureIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// CreateThingsBoardIntegration creates a ThingsBoard application-integration.
+	CreateThingsBoardIntegration(ctx context.Context, in *CreateThingsBoardIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetThingsBoardIntegration returns the ThingsBoard application-integration.
+	GetThingsBoardIntegration(ctx context.Context, in *GetThingsBoardIntegrationRequest, opts ...grpc.CallOption) (*GetThingsBoardIntegrationResponse, error)
+	// UpdateThingsBoardIntegration updates the ThingsBoard application-integration.
+	UpdateThingsBoardIntegration(ctx context.Context, in *UpdateThingsBoardIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// DeleteThingsBoardIntegration deletes the ThingsBoard application-integration.
+	DeleteThingsBoardIntegration(ctx context.Context, in *DeleteThingsBoardIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// CreateMyDevicesIntegration creates a myDevices application-integration.
+	CreateMyDevicesIntegration(ctx context.Context, in *CreateMyDevicesIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetMyDevicesIntegration returns the myDevices application-integration.
+	GetMyDevicesIntegration(ctx context.Context, in *GetMyDevicesIntegrationRequest, opts ...grpc.CallOption) (*GetMyDevicesIntegrationResponse, error)
+	// UpdateMyDevicesIntegration updates the myDevices application-integration.
+	UpdateMyDevicesIntegration(ctx context.Context, in *UpdateMyDevicesIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// DeleteMyDevicesIntegration deletes the myDevices application-integration.
+	DeleteMyDevicesIntegration(ctx context.Context, in *DeleteMyDevicesIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// CreateLoRaCloudIntegration creates a LoRa Cloud application-integration.
+	CreateLoRaCloudIntegration(ctx context.Context, in *CreateLoRaCloudIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetLoRaCloudIntegration returns the LoRa Cloud application-integration.
+	GetLoRaCloudIntegration(ctx context.Context, in *GetLoRaCloudIntegrationRequest, opts ...grpc.CallOption) (*GetLoRaCloudIntegrationResponse, error)
+	// UpdateLoRaCloudIntegration updates the LoRa Cloud application-integration.
+	UpdateLoRaCloudIntegration(ctx context.Context, in *UpdateLoRaCloudIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// DeleteLoRaCloudIntegration deletes the LoRa Cloud application-integration.
+	DeleteLoRaCloudIntegration(ctx context.Context, in *DeleteLoRaCloudIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ListIntegrations lists all configured integrations.
+	ListIntegrations(ctx context.Context, in *ListIntegrationRequest, opts ...grpc.CallOption) (*ListIntegrationResponse, error)
+	// GetUplinkStats returns the uplink statistics (payload size, FPort and
+	// spreading-factor distribution) of the devices of the application.
+	GetUplinkStats(ctx context.Context, in *GetApplicationUplinkStatsRequest, opts ...grpc.CallOption) (*GetApplicationUplinkStatsResponse, error)
+	// GetDeliveryReport returns the delivery-rate (received vs expected
+	// uplinks) of the devices of the application.
+	GetDeliveryReport(ctx context.Context, in *GetApplicationDeliveryReportRequest, opts ...grpc.CallOption) (*GetApplicationDeliveryReportResponse, error)
+	// ListDeliveryLog returns the recent delivery attempts of the HTTP
+	// integrations of the application (newest first).
+	ListDeliveryLog(ctx context.Context, in *ListApplicationDeliveryLogRequest, opts ...grpc.CallOption) (*ListApplicationDeliveryLogResponse, error)
+	// ListQuarantinedFrames returns the uplink frames of the application
+	// which have been quarantined because they could not be decrypted
+	// (newest first).
+	ListQuarantinedFrames(ctx context.Context, in *ListApplicationQuarantinedFramesRequest, opts ...grpc.CallOption) (*ListApplicationQuarantinedFramesResponse, error)
+	// ClearQuarantinedFrames removes the quarantined uplink frames of the
+	// application.
+	ClearQuarantinedFrames(ctx context.Context, in *ClearApplicationQuarantinedFramesRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ListDeadLetters returns the integration events of the application which
+	// could not be delivered within the max. number of delivery attempts
+	// (newest first).
+	ListDeadLetters(ctx context.Context, in *ListApplicationDeadLettersRequest, opts ...grpc.CallOption) (*ListApplicationDeadLettersResponse, error)
+	// ClearDeadLetters removes the dead-letters of the application.
+	ClearDeadLetters(ctx context.Context, in *ClearApplicationDeadLettersRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GenerateMQTTIntegrationClientCertificate generates an application ID specific
+	// TLS certificate to connect to the MQTT broker.
+	GenerateMQTTIntegrationClientCertificate(ctx context.Context, in *GenerateMQTTIntegrationClientCertificateRequest, opts ...grpc.CallOption) (*GenerateMQTTIntegrationClientCertificateResponse, error)
+	// GenerateMQTTIntegrationCredentials generates an application ID specific
+	// username and password to connect to the MQTT broker. This replaces the
+	// previous credentials of the application.
+	GenerateMQTTIntegrationCredentials(ctx context.Context, in *GenerateMQTTIntegrationCredentialsRequest, opts ...grpc.CallOption) (*GenerateMQTTIntegrationCredentialsResponse, error)
+	// DeleteMQTTIntegrationCredentials revokes the application ID specific
+	// username and password.
+	DeleteMQTTIntegrationCredentials(ctx context.Context, in *DeleteMQTTIntegrationCredentialsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type applicationServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApplicationServiceClient(cc *grpc.ClientConn) ApplicationServiceClient {
+	return &applicationServiceClient{cc}
+}
+
+func (c *applicationServiceClient) Create(ctx context.Context, in *CreateApplicationRequest, opts ...grpc.CallOption) (*CreateApplicationResponse, error) {
+	out := new(CreateApplicationResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) Get(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*GetApplicationResponse, error) {
+	out := new(GetApplicationResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) Update(ctx context.Context, in *UpdateApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) Delete(ctx context.Context, in *DeleteApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) Archive(ctx context.Context, in *ArchiveApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/Archive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) Unarchive(ctx context.Context, in *UnarchiveApplicationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/Unarchive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) SetLegalHold(ctx context.Context, in *SetApplicationLegalHoldRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/SetLegalHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ClearLegalHold(ctx context.Context, in *ClearApplicationLegalHoldRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/ClearLegalHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) List(ctx context.Context, in *ListApplicationRequest, opts ...grpc.CallOption) (*ListApplicationResponse, error) {
+	out := new(ListApplicationResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) CreateHTTPIntegration(ctx context.Context, in *CreateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/CreateHTTPIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetHTTPIntegration(ctx context.Context, in *GetHTTPIntegrationRequest, opts ...grpc.CallOption) (*GetHTTPIntegrationResponse, error) {
+	out := new(GetHTTPIntegrationResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/GetHTTPIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) UpdateHTTPIntegration(ctx context.Context, in *UpdateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/UpdateHTTPIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) DeleteHTTPIntegration(ctx context.Context, in *DeleteHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/DeleteHTTPIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) CreateInfluxDBIntegration(ctx context.Context, in *CreateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/CreateInfluxDBIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetInfluxDBIntegration(ctx context.Context, in *GetInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*GetInfluxDBIntegrationResponse, error) {
+	out := new(GetInfluxDBIntegrationResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/GetInfluxDBIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) UpdateInfluxDBIntegration(ctx context.Context, in *UpdateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/UpdateInfluxDBIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) DeleteInfluxDBIntegration(ctx context.Context, in *DeleteInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/DeleteInfluxDBIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) CreateAzureIntegration(ctx context.Context, in *CreateAzureIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/CreateAzureIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetAzureIntegration(ctx context.Context, in *GetAzureIntegrationRequest, opts ...grpc.CallOption) (*GetAzureIntegrationResponse, error) {
+	out := new(GetAzureIntegrationResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/GetAzureIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) UpdateAzureIntegration(ctx context.Context, in *UpdateAzureIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/UpdateAzureIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) DeleteAzureIntegration(ctx context.Context, in *DeleteAzureIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/DeleteAzureIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) CreateThingsBoardIntegration(ctx context.Context, in *CreateThingsBoardIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/CreateThingsBoardIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetThingsBoardIntegration(ctx context.Context, in *GetThingsBoardIntegrationRequest, opts ...grpc.CallOption) (*GetThingsBoardIntegrationResponse, error) {
+	out := new(GetThingsBoardIntegrationResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/GetThingsBoardIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) UpdateThingsBoardIntegration(ctx context.Context, in *UpdateThingsBoardIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/UpdateThingsBoardIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) DeleteThingsBoardIntegration(ctx context.Context, in *DeleteThingsBoardIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/DeleteThingsBoardIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) CreateMyDevicesIntegration(ctx context.Context, in *CreateMyDevicesIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/CreateMyDevicesIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetMyDevicesIntegration(ctx context.Context, in *GetMyDevicesIntegrationRequest, opts ...grpc.CallOption) (*GetMyDevicesIntegrationResponse, error) {
+	out := new(GetMyDevicesIntegrationResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/GetMyDevicesIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) UpdateMyDevicesIntegration(ctx context.Context, in *UpdateMyDevicesIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/UpdateMyDevicesIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) DeleteMyDevicesIntegration(ctx context.Context, in *DeleteMyDevicesIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/DeleteMyDevicesIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) CreateLoRaCloudIntegration(ctx context.Context, in *CreateLoRaCloudIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/CreateLoRaCloudIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetLoRaCloudIntegration(ctx context.Context, in *GetLoRaCloudIntegrationRequest, opts ...grpc.CallOption) (*GetLoRaCloudIntegrationResponse, error) {
+	out := new(GetLoRaCloudIntegrationResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/GetLoRaCloudIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) UpdateLoRaCloudIntegration(ctx context.Context, in *UpdateLoRaCloudIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/UpdateLoRaCloudIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) DeleteLoRaCloudIntegration(ctx context.Context, in *DeleteLoRaCloudIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/DeleteLoRaCloudIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ListIntegrations(ctx context.Context, in *ListIntegrationRequest, opts ...grpc.CallOption) (*ListIntegrationResponse, error) {
+	out := new(ListIntegrationResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/ListIntegrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetUplinkStats(ctx context.Context, in *GetApplicationUplinkStatsRequest, opts ...grpc.CallOption) (*GetApplicationUplinkStatsResponse, error) {
+	out := new(GetApplicationUplinkStatsResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/GetUplinkStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetDeliveryReport(ctx context.Context, in *GetApplicationDeliveryReportRequest, opts ...grpc.CallOption) (*GetApplicationDeliveryReportResponse, error) {
+	out := new(GetApplicationDeliveryReportResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/GetDeliveryReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ListDeliveryLog(ctx context.Context, in *ListApplicationDeliveryLogRequest, opts ...grpc.CallOption) (*ListApplicationDeliveryLogResponse, error) {
+	out := new(ListApplicationDeliveryLogResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/ListDeliveryLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ListQuarantinedFrames(ctx context.Context, in *ListApplicationQuarantinedFramesRequest, opts ...grpc.CallOption) (*ListApplicationQuarantinedFramesResponse, error) {
+	out := new(ListApplicationQuarantinedFramesResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/ListQuarantinedFrames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ClearQuarantinedFrames(ctx context.Context, in *ClearApplicationQuarantinedFramesRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/ClearQuarantinedFrames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ListDeadLetters(ctx context.Context, in *ListApplicationDeadLettersRequest, opts ...grpc.CallOption) (*ListApplicationDeadLettersResponse, error) {
+	out := new(ListApplicationDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/ListDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ClearDeadLetters(ctx context.Context, in *ClearApplicationDeadLettersRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/ClearDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GenerateMQTTIntegrationClientCertificate(ctx context.Context, in *GenerateMQTTIntegrationClientCertificateRequest, opts ...grpc.CallOption) (*GenerateMQTTIntegrationClientCertificateResponse, error) {
+	out := new(GenerateMQTTIntegrationClientCertificateResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/GenerateMQTTIntegrationClientCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GenerateMQTTIntegrationCredentials(ctx context.Context, in *GenerateMQTTIntegrationCredentialsRequest, opts ...grpc.CallOption) (*GenerateMQTTIntegrationCredentialsResponse, error) {
+	out := new(GenerateMQTTIntegrationCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/GenerateMQTTIntegrationCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) DeleteMQTTIntegrationCredentials(ctx context.Context, in *DeleteMQTTIntegrationCredentialsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.ApplicationService/DeleteMQTTIntegrationCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApplicationServiceServer is the server API for ApplicationService service.
+type ApplicationServiceServer interface {
+	// Create creates the given application.
+	Create(context.Context, *CreateApplicationRequest) (*CreateApplicationResponse, error)
+	// Get returns the requested application.
+	Get(context.Context, *GetApplicationRequest) (*GetApplicationResponse, error)
+	// Update updates the given application.
+	Update(context.Context, *UpdateApplicationRequest) (*empty.Empty, error)
+	// Delete deletes the given application.
+	Delete(context.Context, *DeleteApplicationRequest) (*empty.Empty, error)
+	// Archive archives the given application. The devices of an archived
+	// application no longer generate integration traffic (device events are
+	// still logged) and the application is hidden from the default listings.
+	Archive(context.Context, *ArchiveApplicationRequest) (*empty.Empty, error)
+	// Unarchive unarchives the given application.
+	Unarchive(context.Context, *UnarchiveApplicationRequest) (*empty.Empty, error)
+	// SetLegalHold places the given application under legal hold. An application
+	// under legal hold (including its devices) can not be deleted and its data
+	// is excluded from the retention pruning. Only global admin users are
+	// allowed to set or clear the legal hold.
+	SetLegalHold(context.Context, *SetApplicationLegalHoldRequest) (*empty.Empty, error)
+	// ClearLegalHold clears the legal hold of the given application.
+	ClearLegalHold(context.Context, *ClearApplicationLegalHoldRequest) (*empty.Empty, error)
+	// List lists the available applications.
+	List(context.Context, *ListApplicationRequest) (*ListApplicationResponse, error)
+	// CreateHTTPIntegration creates a HTTP application-integration.
+	CreateHTTPIntegration(context.Context, *CreateHTTPIntegrationRequest) (*empty.Empty, error)
+	// GetHTTPIntegration returns the HTTP application-integration.
+	GetHTTPIntegration(context.Context, *GetHTTPIntegrationRequest) (*GetHTTPIntegrationResponse, error)
+	// UpdateHTTPIntegration updates the HTTP application-integration.
+	UpdateHTTPIntegration(context.Context, *UpdateHTTPIntegrationRequest) (*empty.Empty, error)
+	// DeleteIntegration deletes the HTTP application-integration.
+	DeleteHTTPIntegration(context.Context, *DeleteHTTPIntegrationRequest) (*empty.Empty, error)
+	// CreateInfluxDBIntegration create an InfluxDB application-integration.
+	CreateInfluxDBIntegration(context.Context, *CreateInfluxDBIntegrationRequest) (*empty.Empty, error)
+	// GetInfluxDBIntegration returns the InfluxDB application-integration.
+	GetInfluxDBIntegration(context.Context, *GetInfluxDBIntegrationRequest) (*GetInfluxDBIntegrationResponse, error)
+	// UpdateInfluxDBIntegration updates the InfluxDB application-integration.
+	UpdateInfluxDBIntegration(context.Context, *UpdateInfluxDBIntegrationRequest) (*empty.Empty, error)
+	// DeleteInfluxDBIntegration deletes the InfluxDB application-integration.
+	DeleteInfluxDBIntegration(context.Context, *DeleteInfluxDBIntegrationRequest) (*empty.Empty, error)
+	// CreateAzureIntegration creates an Azure application-integration.
+	CreateAzureIntegration(context.Context, *CreateAzureIntegrationRequest) (*empty.Empty, error)
+	// GetAzureIntegration returns the Azure application-integration.
+	GetAzureIntegration(context.Context, *GetAzureIntegrationRequest) (*GetAzureIntegrationResponse, error)
+	// UpdateAzureIntegration updates the Azure application-integration.
+	UpdateAzureIntegration(context.Context, *UpdateAzureIntegrationRequest) (*empty.Empty, error)
+	// DeleteAzureIntegration deletes the Azure application-integration.
+	DeleteAzureIntegration(context.Context, *DeleteAzureIntegrationRequest) (*empty.Empty, error)
+	// CreateThingsBoardIntegration creates a ThingsBoard application-integration.
+	CreateThingsBoardIntegration(context.Context, *CreateThingsBoardIntegrationRequest) (*empty.Empty, error)
+	// GetThingsBoardIntegration returns the ThingsBoard application-integration.
+	GetThingsBoardIntegration(context.Context, *GetThingsBoardIntegrationRequest) (*GetThingsBoardIntegrationResponse, error)
+	// UpdateThingsBoardIntegration updates the ThingsBoard application-integration.
+	UpdateThingsBoardIntegration(context.Context, *UpdateThingsBoardIntegrationRequest) (*empty.Empty, error)
+	// DeleteThingsBoardIntegration deletes the ThingsBoard application-integration.
+	DeleteThingsBoardIntegration(context.Context, *DeleteThingsBoardIntegrationRequest) (*empty.Empty, error)
+	// CreateMyDevicesIntegration creates a myDevices application-integration.
+	CreateMyDevicesIntegration(context.Context, *CreateMyDevicesIntegrationRequest) (*empty.Empty, error)
+	// GetMyDevicesIntegration returns the myDevices application-integration.
+	GetMyDevicesIntegration(context.Context, *GetMyDevicesIntegrationRequest) (*GetMyDevicesIntegrationResponse, error)
+	// UpdateMyDevicesIntegration updates the myDevices application-integration.
+	UpdateMyDevicesIntegration(context.Context, *UpdateMyDevicesIntegrationRequest) (*empty.Empty, error)
+	// DeleteMyDevicesIntegration deletes the myDevices application-integration.
+	DeleteMyDevicesIntegration(context.Context, *DeleteMyDevicesIntegrationRequest) (*empty.Empty, error)
+	// CreateLoRaCloudIntegration creates a LoRa Cloud application-integration.
+	CreateLoRaCloudIntegration(context.Context, *CreateLoRaCloudIntegrationRequest) (*empty.Empty, error)
+	// GetLoRaCloudIntegration returns the LoRa Cloud application-integration.
+	GetLoRaCloudIntegration(context.Context, *GetLoRaCloudIntegrationRequest) (*GetLoRaCloudIntegrationResponse, error)
+	// UpdateLoRaCloudIntegration updates the LoRa Cloud application-integration.
+	UpdateLoRaCloudIntegration(context.Context, *UpdateLoRaCloudIntegrationRequest) (*empty.Empty, error)
+	// DeleteLoRaCloudIntegration deletes the LoRa Cloud application-integration.
+	DeleteLoRaCloudIntegration(context.Context, *DeleteLoRaCloudIntegrationRequest) (*empty.Empty, error)
+	// ListIntegrations lists all configured integrations.
+	ListIntegrations(context.Context, *ListIntegrationRequest) (*ListIntegrationResponse, error)
+	// GetUplinkStats returns the uplink statistics (payload size, FPort and
+	// spreading-factor distribution) of the devices of the application.
+	GetUplinkStats(context.Context, *GetApplicationUplinkStatsRequest) (*GetApplicationUplinkStatsResponse, error)
+	// GetDeliveryReport returns the delivery-rate (received vs expected
+	// uplinks) of the devices of the application.
+	GetDeliveryReport(context.Context, *GetApplicationDeliveryReportRequest) (*GetApplicationDeliveryReportResponse, error)
+	// ListDeliveryLog returns the recent delivery attempts of the HTTP
+	// integrations of the application (newest first).
+	ListDeliveryLog(context.Context, *ListApplicationDeliveryLogRequest) (*ListApplicationDeliveryLogResponse, error)
+	// ListQuarantinedFrames returns the uplink frames of the application
+	// which have been quarantined because they could not be decrypted
+	// (newest first).
+	ListQuarantinedFrames(context.Context, *ListApplicationQuarantinedFramesRequest) (*ListApplicationQuarantinedFramesResponse, error)
+	// ClearQuarantinedFrames removes the quarantined uplink frames of the
+	// application.
+	ClearQuarantinedFrames(context.Context, *ClearApplicationQuarantinedFramesRequest) (*empty.Empty, error)
+	// ListDeadLetters returns the integration events of the application which
+	// could not be delivered within the max. number of delivery attempts
+	// (newest first).
+	ListDeadLetters(context.Context, *ListApplicationDeadLettersRequest) (*ListApplicationDeadLettersResponse, error)
+	// ClearDeadLetters removes the dead-letters of the application.
+	ClearDeadLetters(context.Context, *ClearApplicationDeadLettersRequest) (*empty.Empty, error)
+	// GenerateMQTTIntegrationClientCertificate generates an application ID specific
+	// TLS certificate to connect to the MQTT broker.
+	GenerateMQTTIntegrationClientCertificate(context.Context, *GenerateMQTTIntegrationClientCertificateRequest) (*GenerateMQTTIntegrationClientCertificateResponse, error)
+	// GenerateMQTTIntegrationCredentials generates an application ID specific
+	// username and password to connect to the MQTT broker. This replaces the
+	// previous credentials of the application.
+	GenerateMQTTIntegrationCredentials(context.Context, *GenerateMQTTIntegrationCredentialsRequest) (*GenerateMQTTIntegrationCredentialsResponse, error)
+	// DeleteMQTTIntegrationCredentials revokes the application ID specific
+	// username and password.
+	DeleteMQTTIntegrationCredentials(context.Context, *DeleteMQTTIntegrationCredentialsRequest) (*empty.Empty, error)
+}
+
+func RegisterApplicationServiceServer(s *grpc.Server, srv ApplicationServiceServer) {
+	s.RegisterService(&_ApplicationService_serviceDesc, srv)
+}
+
+func _ApplicationService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Create(ctx, req.(*CreateApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Get(ctx, req.(*GetApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Update(ctx, req.(*UpdateApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Delete(ctx, req.(*DeleteApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_Archive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Archive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/Archive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Archive(ctx, req.(*ArchiveApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_Unarchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnarchiveApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Unarchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/Unarchive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Unarchive(ctx, req.(*UnarchiveApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_SetLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetApplicationLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).SetLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/SetLegalHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).SetLegalHold(ctx, req.(*SetApplicationLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_ClearLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearApplicationLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ClearLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/ClearLegalHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ClearLegalHold(ctx, req.(*ClearApplicationLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).List(ctx, req.(*ListApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_CreateHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHTTPIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).CreateHTTPIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/CreateHTTPIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).CreateHTTPIntegration(ctx, req.(*CreateHTTPIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHTTPIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetHTTPIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/GetHTTPIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetHTTPIntegration(ctx, req.(*GetHTTPIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_UpdateHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHTTPIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).UpdateHTTPIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/UpdateHTTPIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).UpdateHTTPIntegration(ctx, req.(*UpdateHTTPIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_DeleteHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHTTPIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).DeleteHTTPIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/DeleteHTTPIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).DeleteHTTPIntegration(ctx, req.(*DeleteHTTPIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_CreateInfluxDBIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInfluxDBIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).CreateInfluxDBIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/CreateInfluxDBIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).CreateInfluxDBIntegration(ctx, req.(*CreateInfluxDBIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetInfluxDBIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfluxDBIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetInfluxDBIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/GetInfluxDBIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetInfluxDBIntegration(ctx, req.(*GetInfluxDBIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_UpdateInfluxDBIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInfluxDBIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).UpdateInfluxDBIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/UpdateInfluxDBIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).UpdateInfluxDBIntegration(ctx, req.(*UpdateInfluxDBIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_DeleteInfluxDBIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInfluxDBIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).DeleteInfluxDBIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/DeleteInfluxDBIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).DeleteInfluxDBIntegration(ctx, req.(*DeleteInfluxDBIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_CreateAzureIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAzureIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).CreateAzureIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/CreateAzureIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).CreateAzureIntegration(ctx, req.(*CreateAzureIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetAzureIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAzureIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetAzureIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/GetAzureIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetAzureIntegration(ctx, req.(*GetAzureIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_UpdateAzureIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAzureIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).UpdateAzureIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/UpdateAzureIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).UpdateAzureIntegration(ctx, req.(*UpdateAzureIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_DeleteAzureIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAzureIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).DeleteAzureIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/DeleteAzureIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).DeleteAzureIntegration(ctx, req.(*DeleteAzureIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_CreateThingsBoardIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateThingsBoardIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).CreateThingsBoardIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/CreateThingsBoardIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).CreateThingsBoardIntegration(ctx, req.(*CreateThingsBoardIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetThingsBoardIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThingsBoardIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetThingsBoardIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/GetThingsBoardIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetThingsBoardIntegration(ctx, req.(*GetThingsBoardIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_UpdateThingsBoardIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateThingsBoardIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).UpdateThingsBoardIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/UpdateThingsBoardIntegration",
 	}
-	return out, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).UpdateThingsBoardIntegration(ctx, req.(*UpdateThingsBoardIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *applicationServiceClient) CreateInfluxDBIntegration(ctx context.Context, in *CreateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/CreateInfluxDBIntegration", in, out, opts...)
-	if err != nil {
+func _ApplicationService_DeleteThingsBoardIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteThingsBoardIntegrationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).DeleteThingsBoardIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/DeleteThingsBoardIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).DeleteThingsBoardIntegration(ctx, req.(*DeleteThingsBoardIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *applicationServiceClient) GetInfluxDBIntegration(ctx context.Context, in *GetInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*GetInfluxDBIntegrationResponse, error) {
-	out := new(GetInfluxDBIntegrationResponse)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/GetInfluxDBIntegration", in, out, opts...)
-	if err != nil {
+func _ApplicationService_CreateMyDevicesIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMyDevicesIntegrationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).CreateMyDevicesIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/CreateMyDevicesIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).CreateMyDevicesIntegration(ctx, req.(*CreateMyDevicesIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *applicationServiceClient) UpdateInfluxDBIntegration(ctx context.Context, in *UpdateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/UpdateInfluxDBIntegration", in, out, opts...)
-	if err != nil {
+func _ApplicationService_GetMyDevicesIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyDevicesIntegrationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetMyDevicesIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/GetMyDevicesIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetMyDevicesIntegration(ctx, req.(*GetMyDevicesIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *applicationServiceClient) DeleteInfluxDBIntegration(ctx context.Context, in *DeleteInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/DeleteInfluxDBIntegration", in, out, opts...)
-	if err != nil {
+func _ApplicationService_UpdateMyDevicesIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMyDevicesIntegrationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).UpdateMyDevicesIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/UpdateMyDevicesIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).UpdateMyDevicesIntegration(ctx, req.(*UpdateMyDevicesIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *applicationServiceClient) ListIntegrations(ctx context.Context, in *ListIntegrationRequest, opts ...grpc.CallOption) (*ListIntegrationResponse, error) {
-	out := new(ListIntegrationResponse)
-	err := c.cc.Invoke(ctx, "/api.ApplicationService/ListIntegrations", in, out, opts...)
-	if err != nil {
+func _ApplicationService_DeleteMyDevicesIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMyDevicesIntegrationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// ApplicationServiceServer is the server API for ApplicationService service.
-type ApplicationServiceServer interface {
-	// Create creates the given application.
-	Create(context.Context, *CreateApplicationRequest) (*CreateApplicationResponse, error)
-	// Get returns the requested application.
-	Get(context.Context, *GetApplicationRequest) (*GetApplicationResponse, error)
-	// Update updates the given application.
-	Update(context.Context, *UpdateApplicationRequest) (*empty.Empty, error)
-	// Delete deletes the given application.
-	Delete(context.Context, *DeleteApplicationRequest) (*empty.Empty, error)
-	// List lists the available applications.
-	List(context.Context, *ListApplicationRequest) (*ListApplicationResponse, error)
-	// CreateHTTPIntegration creates a HTTP application-integration.
-	CreateHTTPIntegration(context.Context, *CreateHTTPIntegrationRequest) (*empty.Empty, error)
-	// GetHTTPIntegration returns the HTTP application-integration.
-	GetHTTPIntegration(context.Context, *GetHTTPIntegrationRequest) (*GetHTTPIntegrationResponse, error)
-	// UpdateHTTPIntegration updates the HTTP application-integration.
-	UpdateHTTPIntegration(context.Context, *UpdateHTTPIntegrationRequest) (*empty.Empty, error)
-	// DeleteIntegration deletes the HTTP application-integration.
-	DeleteHTTPIntegration(context.Context, *DeleteHTTPIntegrationRequest) (*empty.Empty, error)
-	// CreateInfluxDBIntegration create an InfluxDB application-integration.
-	CreateInfluxDBIntegration(context.Context, *CreateInfluxDBIntegrationRequest) (*empty.Empty, error)
-	// GetInfluxDBIntegration returns the InfluxDB application-integration.
-	GetInfluxDBIntegration(context.Context, *GetInfluxDBIntegrationRequest) (*GetInfluxDBIntegrationResponse, error)
-	// UpdateInfluxDBIntegration updates the InfluxDB application-integration.
-	UpdateInfluxDBIntegration(context.Context, *UpdateInfluxDBIntegrationRequest) (*empty.Empty, error)
-	// DeleteInfluxDBIntegration deletes the InfluxDB application-integration.
-	DeleteInfluxDBIntegration(context.Context, *DeleteInfluxDBIntegrationRequest) (*empty.Empty, error)
-	// ListIntegrations lists all configured integrations.
-	ListIntegrations(context.Context, *ListIntegrationRequest) (*ListIntegrationResponse, error)
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).DeleteMyDevicesIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/DeleteMyDevicesIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).DeleteMyDevicesIntegration(ctx, req.(*DeleteMyDevicesIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterApplicationServiceServer(s *grpc.Server, srv ApplicationServiceServer) {
-	s.RegisterService(&_ApplicationService_serviceDesc, srv)
+func _ApplicationService_CreateLoRaCloudIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLoRaCloudIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).CreateLoRaCloudIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ApplicationService/CreateLoRaCloudIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).CreateLoRaCloudIntegration(ctx, req.(*CreateLoRaCloudIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateApplicationRequest)
+func _ApplicationService_GetLoRaCloudIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoRaCloudIntegrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).Create(ctx, in)
+		return srv.(ApplicationServiceServer).GetLoRaCloudIntegration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ApplicationService/Create",
+		FullMethod: "/api.ApplicationService/GetLoRaCloudIntegration",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).Create(ctx, req.(*CreateApplicationRequest))
+		return srv.(ApplicationServiceServer).GetLoRaCloudIntegration(ctx, req.(*GetLoRaCloudIntegrationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetApplicationRequest)
+func _ApplicationService_UpdateLoRaCloudIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLoRaCloudIntegrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).Get(ctx, in)
+		return srv.(ApplicationServiceServer).UpdateLoRaCloudIntegration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ApplicationService/Get",
+		FullMethod: "/api.ApplicationService/UpdateLoRaCloudIntegration",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).Get(ctx, req.(*GetApplicationRequest))
+		return srv.(ApplicationServiceServer).UpdateLoRaCloudIntegration(ctx, req.(*UpdateLoRaCloudIntegrationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateApplicationRequest)
+func _ApplicationService_DeleteLoRaCloudIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLoRaCloudIntegrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).Update(ctx, in)
+		return srv.(ApplicationServiceServer).DeleteLoRaCloudIntegration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ApplicationService/Update",
+		FullMethod: "/api.ApplicationService/DeleteLoRaCloudIntegration",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).Update(ctx, req.(*UpdateApplicationRequest))
+		return srv.(ApplicationServiceServer).DeleteLoRaCloudIntegration(ctx, req.(*DeleteLoRaCloudIntegrationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteApplicationRequest)
+func _ApplicationService_ListIntegrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIntegrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).Delete(ctx, in)
+		return srv.(ApplicationServiceServer).ListIntegrations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ApplicationService/Delete",
+		FullMethod: "/api.ApplicationService/ListIntegrations",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).Delete(ctx, req.(*DeleteApplicationRequest))
+		return srv.(ApplicationServiceServer).ListIntegrations(ctx, req.(*ListIntegrationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListApplicationRequest)
+func _ApplicationService_GetUplinkStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationUplinkStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).List(ctx, in)
+		return srv.(ApplicationServiceServer).GetUplinkStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ApplicationService/List",
+		FullMethod: "/api.ApplicationService/GetUplinkStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).List(ctx, req.(*ListApplicationRequest))
+		return srv.(ApplicationServiceServer).GetUplinkStats(ctx, req.(*GetApplicationUplinkStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_CreateHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateHTTPIntegrationRequest)
+func _ApplicationService_GetDeliveryReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationDeliveryReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).CreateHTTPIntegration(ctx, in)
+		return srv.(ApplicationServiceServer).GetDeliveryReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ApplicationService/CreateHTTPIntegration",
+		FullMethod: "/api.ApplicationService/GetDeliveryReport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).CreateHTTPIntegration(ctx, req.(*CreateHTTPIntegrationRequest))
+		return srv.(ApplicationServiceServer).GetDeliveryReport(ctx, req.(*GetApplicationDeliveryReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_GetHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHTTPIntegrationRequest)
+func _ApplicationService_ListDeliveryLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationDeliveryLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).GetHTTPIntegration(ctx, in)
+		return srv.(ApplicationServiceServer).ListDeliveryLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ApplicationService/GetHTTPIntegration",
+		FullMethod: "/api.ApplicationService/ListDeliveryLog",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).GetHTTPIntegration(ctx, req.(*GetHTTPIntegrationRequest))
+		return srv.(ApplicationServiceServer).ListDeliveryLog(ctx, req.(*ListApplicationDeliveryLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_UpdateHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateHTTPIntegrationRequest)
+func _ApplicationService_ListQuarantinedFrames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationQuarantinedFramesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).UpdateHTTPIntegration(ctx, in)
+		return srv.(ApplicationServiceServer).ListQuarantinedFrames(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ApplicationService/UpdateHTTPIntegration",
+		FullMethod: "/api.ApplicationService/ListQuarantinedFrames",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).UpdateHTTPIntegration(ctx, req.(*UpdateHTTPIntegrationRequest))
+		return srv.(ApplicationServiceServer).ListQuarantinedFrames(ctx, req.(*ListApplicationQuarantinedFramesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_DeleteHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteHTTPIntegrationRequest)
+func _ApplicationService_ClearQuarantinedFrames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearApplicationQuarantinedFramesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).DeleteHTTPIntegration(ctx, in)
+		return srv.(ApplicationServiceServer).ClearQuarantinedFrames(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ApplicationService/DeleteHTTPIntegration",
+		FullMethod: "/api.ApplicationService/ClearQuarantinedFrames",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).DeleteHTTPIntegration(ctx, req.(*DeleteHTTPIntegrationRequest))
+		return srv.(ApplicationServiceServer).ClearQuarantinedFrames(ctx, req.(*ClearApplicationQuarantinedFramesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
-
-func _ApplicationService_CreateInfluxDBIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateInfluxDBIntegrationRequest)
+func _ApplicationService_ListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationDeadLettersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).CreateInfluxDBIntegration(ctx, in)
+		return srv.(ApplicationServiceServer).ListDeadLetters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ApplicationService/CreateInfluxDBIntegration",
+		FullMethod: "/api.ApplicationService/ListDeadLetters",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).CreateInfluxDBIntegration(ctx, req.(*CreateInfluxDBIntegrationRequest))
+		return srv.(ApplicationServiceServer).ListDeadLetters(ctx, req.(*ListApplicationDeadLettersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_GetInfluxDBIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInfluxDBIntegrationRequest)
+func _ApplicationService_ClearDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearApplicationDeadLettersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).GetInfluxDBIntegration(ctx, in)
+		return srv.(ApplicationServiceServer).ClearDeadLetters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ApplicationService/GetInfluxDBIntegration",
+		FullMethod: "/api.ApplicationService/ClearDeadLetters",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).GetInfluxDBIntegration(ctx, req.(*GetInfluxDBIntegrationRequest))
+		return srv.(ApplicationServiceServer).ClearDeadLetters(ctx, req.(*ClearApplicationDeadLettersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_UpdateInfluxDBIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateInfluxDBIntegrationRequest)
+func _ApplicationService_GenerateMQTTIntegrationClientCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateMQTTIntegrationClientCertificateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).UpdateInfluxDBIntegration(ctx, in)
+		return srv.(ApplicationServiceServer).GenerateMQTTIntegrationClientCertificate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ApplicationService/UpdateInfluxDBIntegration",
+		FullMethod: "/api.ApplicationService/GenerateMQTTIntegrationClientCertificate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).UpdateInfluxDBIntegration(ctx, req.(*UpdateInfluxDBIntegrationRequest))
+		return srv.(ApplicationServiceServer).GenerateMQTTIntegrationClientCertificate(ctx, req.(*GenerateMQTTIntegrationClientCertificateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_DeleteInfluxDBIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteInfluxDBIntegrationRequest)
+func _ApplicationService_GenerateMQTTIntegrationCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateMQTTIntegrationCredentialsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).DeleteInfluxDBIntegration(ctx, in)
+		return srv.(ApplicationServiceServer).GenerateMQTTIntegrationCredentials(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ApplicationService/DeleteInfluxDBIntegration",
+		FullMethod: "/api.ApplicationService/GenerateMQTTIntegrationCredentials",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).DeleteInfluxDBIntegration(ctx, req.(*DeleteInfluxDBIntegrationRequest))
+		return srv.(ApplicationServiceServer).GenerateMQTTIntegrationCredentials(ctx, req.(*GenerateMQTTIntegrationCredentialsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_ListIntegrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListIntegrationRequest)
+func _ApplicationService_DeleteMQTTIntegrationCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMQTTIntegrationCredentialsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).ListIntegrations(ctx, in)
+		return srv.(ApplicationServiceServer).DeleteMQTTIntegrationCredentials(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.ApplicationService/ListIntegrations",
+		FullMethod: "/api.ApplicationService/DeleteMQTTIntegrationCredentials",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).ListIntegrations(ctx, req.(*ListIntegrationRequest))
+		return srv.(ApplicationServiceServer).DeleteMQTTIntegrationCredentials(ctx, req.(*DeleteMQTTIntegrationCredentialsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1896,6 +6143,22 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ApplicationService_Delete_Handler,
 		},
+		{
+			MethodName: "Archive",
+			Handler:    _ApplicationService_Archive_Handler,
+		},
+		{
+			MethodName: "Unarchive",
+			Handler:    _ApplicationService_Unarchive_Handler,
+		},
+		{
+			MethodName: "SetLegalHold",
+			Handler:    _ApplicationService_SetLegalHold_Handler,
+		},
+		{
+			MethodName: "ClearLegalHold",
+			Handler:    _ApplicationService_ClearLegalHold_Handler,
+		},
 		{
 			MethodName: "List",
 			Handler:    _ApplicationService_List_Handler,
@@ -1932,10 +6195,114 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteInfluxDBIntegration",
 			Handler:    _ApplicationService_DeleteInfluxDBIntegration_Handler,
 		},
+		{
+			MethodName: "CreateAzureIntegration",
+			Handler:    _ApplicationService_CreateAzureIntegration_Handler,
+		},
+		{
+			MethodName: "GetAzureIntegration",
+			Handler:    _ApplicationService_GetAzureIntegration_Handler,
+		},
+		{
+			MethodName: "UpdateAzureIntegration",
+			Handler:    _ApplicationService_UpdateAzureIntegration_Handler,
+		},
+		{
+			MethodName: "DeleteAzureIntegration",
+			Handler:    _ApplicationService_DeleteAzureIntegration_Handler,
+		},
+		{
+			MethodName: "CreateThingsBoardIntegration",
+			Handler:    _ApplicationService_CreateThingsBoardIntegration_Handler,
+		},
+		{
+			MethodName: "GetThingsBoardIntegration",
+			Handler:    _ApplicationService_GetThingsBoardIntegration_Handler,
+		},
+		{
+			MethodName: "UpdateThingsBoardIntegration",
+			Handler:    _ApplicationService_UpdateThingsBoardIntegration_Handler,
+		},
+		{
+			MethodName: "DeleteThingsBoardIntegration",
+			Handler:    _ApplicationService_DeleteThingsBoardIntegration_Handler,
+		},
+		{
+			MethodName: "CreateMyDevicesIntegration",
+			Handler:    _ApplicationService_CreateMyDevicesIntegration_Handler,
+		},
+		{
+			MethodName: "GetMyDevicesIntegration",
+			Handler:    _ApplicationService_GetMyDevicesIntegration_Handler,
+		},
+		{
+			MethodName: "UpdateMyDevicesIntegration",
+			Handler:    _ApplicationService_UpdateMyDevicesIntegration_Handler,
+		},
+		{
+			MethodName: "DeleteMyDevicesIntegration",
+			Handler:    _ApplicationService_DeleteMyDevicesIntegration_Handler,
+		},
+		{
+			MethodName: "CreateLoRaCloudIntegration",
+			Handler:    _ApplicationService_CreateLoRaCloudIntegration_Handler,
+		},
+		{
+			MethodName: "GetLoRaCloudIntegration",
+			Handler:    _ApplicationService_GetLoRaCloudIntegration_Handler,
+		},
+		{
+			MethodName: "UpdateLoRaCloudIntegration",
+			Handler:    _ApplicationService_UpdateLoRaCloudIntegration_Handler,
+		},
+		{
+			MethodName: "DeleteLoRaCloudIntegration",
+			Handler:    _ApplicationService_DeleteLoRaCloudIntegration_Handler,
+		},
 		{
 			MethodName: "ListIntegrations",
 			Handler:    _ApplicationService_ListIntegrations_Handler,
 		},
+		{
+			MethodName: "GetUplinkStats",
+			Handler:    _ApplicationService_GetUplinkStats_Handler,
+		},
+		{
+			MethodName: "GetDeliveryReport",
+			Handler:    _ApplicationService_GetDeliveryReport_Handler,
+		},
+		{
+			MethodName: "ListDeliveryLog",
+			Handler:    _ApplicationService_ListDeliveryLog_Handler,
+		},
+		{
+			MethodName: "ListQuarantinedFrames",
+			Handler:    _ApplicationService_ListQuarantinedFrames_Handler,
+		},
+		{
+			MethodName: "ClearQuarantinedFrames",
+			Handler:    _ApplicationService_ClearQuarantinedFrames_Handler,
+		},
+		{
+			MethodName: "ListDeadLetters",
+			Handler:    _ApplicationService_ListDeadLetters_Handler,
+		},
+		{
+			MethodName: "ClearDeadLetters",
+			Handler:    _ApplicationService_ClearDeadLetters_Handler,
+		},
+		{
+			MethodName: "GenerateMQTTIntegrationClientCertificate",
+			Handler:    _ApplicationService_GenerateMQTTIntegrationClientCertificate_Handler,
+		},
+		{
+			MethodName: "GenerateMQTTIntegrationCredentials",
+			Handler:    _ApplicationService_GenerateMQTTIntegrationCredentials_Handler,
+		},
+		{
+			MethodName: "DeleteMQTTIntegrationCredentials",
+			Handler:    _ApplicationService_DeleteMQTTIntegrationCredentials_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "application.proto",
@@ -1944,92 +6311,265 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("application.proto", fileDescriptor_fc846aced8fe6ea6) }
 
 var fileDescriptor_fc846aced8fe6ea6 = []byte{
-	// 1389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xdf, 0x6e, 0x1b, 0xc5,
-	0x17, 0xee, 0xda, 0x89, 0x9b, 0x1c, 0xb7, 0x89, 0x3b, 0x49, 0xdc, 0xcd, 0xd6, 0x4d, 0xf3, 0xdb,
-	0xea, 0x47, 0x83, 0x01, 0xbb, 0x84, 0xa8, 0xa0, 0x08, 0xa9, 0xa5, 0x75, 0x9a, 0x5a, 0x4d, 0x43,
-	0xb4, 0x69, 0x2a, 0x2e, 0xaa, 0x5a, 0x13, 0xef, 0x24, 0x1d, 0xb2, 0xd9, 0x5d, 0x76, 0xc7, 0x85,
-	0x80, 0x7a, 0xc3, 0x05, 0x48, 0x5c, 0x21, 0xf5, 0x16, 0x09, 0x21, 0x24, 0x6e, 0x78, 0x04, 0x1e,
-	0x83, 0x57, 0xe8, 0x83, 0xa0, 0xf9, 0xb3, 0xce, 0x66, 0x3d, 0x9b, 0xb4, 0x4e, 0x90, 0xb8, 0xb2,
-	0x67, 0xce, 0x37, 0x67, 0xbe, 0xf3, 0xcd, 0x99, 0x73, 0x66, 0xe1, 0x12, 0x0e, 0x43, 0x8f, 0x76,
-	0x31, 0xa3, 0x81, 0xdf, 0x08, 0xa3, 0x80, 0x05, 0xa8, 0x88, 0x43, 0x6a, 0xd5, 0x76, 0x83, 0x60,
-	0xd7, 0x23, 0x4d, 0x1c, 0xd2, 0x26, 0xf6, 0xfd, 0x80, 0x09, 0x44, 0x2c, 0x21, 0xd6, 0x15, 0x65,
-	0x15, 0xa3, 0xed, 0xde, 0x4e, 0x93, 0xec, 0x87, 0xec, 0x40, 0x1a, 0xed, 0xbf, 0x0a, 0x50, 0xfe,
-	0xec, 0xd0, 0x2b, 0x9a, 0x80, 0x02, 0x75, 0x4d, 0x63, 0xde, 0x58, 0x28, 0x3a, 0x05, 0xea, 0x22,
-	0x04, 0x23, 0x3e, 0xde, 0x27, 0x66, 0x61, 0xde, 0x58, 0x18, 0x77, 0xc4, 0x7f, 0x34, 0x0f, 0x65,
-	0x97, 0xc4, 0xdd, 0x88, 0x86, 0x7c, 0x89, 0x59, 0x14, 0xa6, 0xf4, 0x14, 0xba, 0x01, 0x93, 0x41,
-	0xb4, 0x8b, 0x7d, 0xfa, 0xad, 0xf0, 0xda, 0xa1, 0xae, 0x39, 0x22, 0x5c, 0x4e, 0xa4, 0xa7, 0xdb,
-	0x2d, 0xf4, 0x3e, 0xa0, 0x98, 0x44, 0x2f, 0x68, 0x97, 0x74, 0xc2, 0x28, 0xd8, 0xa1, 0x1e, 0xe1,
-	0xd8, 0x51, 0xe1, 0xb1, 0xa2, 0x2c, 0x1b, 0xd2, 0xd0, 0x6e, 0xa1, 0xeb, 0x70, 0x31, 0xc4, 0x07,
-	0x5e, 0x80, 0xdd, 0x4e, 0x37, 0x70, 0x49, 0xd7, 0x2c, 0x09, 0xe0, 0x05, 0x35, 0x79, 0x8f, 0xcf,
-	0xa1, 0x25, 0xa8, 0x26, 0x20, 0xe2, 0x73, 0x58, 0xd4, 0x91, 0xc4, 0xcc, 0xf3, 0x02, 0x3d, 0xad,
-	0xac, 0x2b, 0xd2, 0xb8, 0x29, 0x6c, 0xe9, 0x55, 0x2e, 0x39, 0xb2, 0x6a, 0xec, 0xc8, 0xaa, 0x16,
-	0x49, 0xad, 0xb2, 0x5f, 0x1b, 0x30, 0x95, 0x52, 0x6f, 0x8d, 0xc6, 0xac, 0xcd, 0xc8, 0xfe, 0x7f,
-	0x5b, 0xc5, 0x9b, 0x30, 0x9d, 0x45, 0x0b, 0x72, 0x52, 0x4c, 0x74, 0x14, 0xbf, 0x8e, 0xf7, 0x89,
-	0xbd, 0x0e, 0xe6, 0xbd, 0x88, 0x60, 0x46, 0x52, 0xb1, 0x3a, 0xe4, 0xab, 0x1e, 0x89, 0x19, 0x5a,
-	0x84, 0x72, 0x2a, 0x2b, 0x45, 0xcc, 0xe5, 0xc5, 0x4a, 0x03, 0x87, 0xb4, 0x91, 0x46, 0xa7, 0x41,
-	0xf6, 0x7b, 0x30, 0xab, 0xf1, 0x17, 0x87, 0x81, 0x1f, 0x93, 0xac, 0x76, 0xf6, 0x0d, 0x98, 0x59,
-	0x25, 0x4c, 0xb3, 0x73, 0x16, 0xb8, 0x06, 0xd5, 0x2c, 0x50, 0xb9, 0x1c, 0x86, 0xe3, 0x3a, 0x98,
-	0x5b, 0xa1, 0x7b, 0x76, 0x31, 0xd7, 0xc1, 0x6c, 0x11, 0x8f, 0x68, 0xfd, 0x65, 0x23, 0xf9, 0xd1,
-	0x80, 0x2a, 0xcf, 0x25, 0x0d, 0x74, 0x1a, 0x46, 0x3d, 0xba, 0x4f, 0x99, 0x42, 0xcb, 0x01, 0xaa,
-	0x42, 0x29, 0xd8, 0xd9, 0x89, 0x09, 0x13, 0x19, 0x56, 0x74, 0xd4, 0x48, 0x97, 0x41, 0x45, 0x6d,
-	0x06, 0x55, 0xa1, 0x14, 0x13, 0x1c, 0x75, 0x9f, 0x8b, 0x0c, 0x1b, 0x77, 0xd4, 0xc8, 0xf6, 0xe0,
-	0xf2, 0x00, 0x11, 0x25, 0xea, 0x35, 0x28, 0xb3, 0x80, 0x61, 0xaf, 0xd3, 0x0d, 0x7a, 0x7e, 0xc2,
-	0x07, 0xc4, 0xd4, 0x3d, 0x3e, 0x83, 0x6e, 0x42, 0x29, 0x22, 0x71, 0xcf, 0xe3, 0xa4, 0x8a, 0x0b,
-	0xe5, 0x45, 0x33, 0x2b, 0x50, 0x72, 0x5d, 0x1c, 0x85, 0xb3, 0x6f, 0xc3, 0xcc, 0x83, 0xc7, 0x8f,
-	0x37, 0xda, 0x3e, 0x23, 0xbb, 0x91, 0x80, 0x3c, 0x20, 0xd8, 0x25, 0x11, 0xaa, 0x40, 0x71, 0x8f,
-	0x1c, 0x88, 0x3d, 0xc6, 0x1d, 0xfe, 0x97, 0xeb, 0xf0, 0x02, 0x7b, 0xbd, 0xe4, 0x4a, 0xc9, 0x81,
-	0xfd, 0x47, 0x11, 0x26, 0x33, 0x1e, 0xd0, 0xff, 0x61, 0x22, 0x75, 0x0e, 0x9d, 0xbe, 0xd0, 0x17,
-	0x53, 0xb3, 0xed, 0x16, 0x5a, 0x82, 0xf3, 0xcf, 0xc5, 0x66, 0xb1, 0xa2, 0x6b, 0x09, 0xba, 0x5a,
-	0x3e, 0x4e, 0x02, 0x45, 0xef, 0xc0, 0x64, 0x2f, 0xf4, 0xa8, 0xbf, 0xd7, 0x71, 0x31, 0xc3, 0x9d,
-	0x5e, 0xe4, 0xa9, 0x8b, 0x7c, 0x51, 0x4e, 0xb7, 0x30, 0xc3, 0x5b, 0xce, 0x1a, 0x5a, 0x84, 0x99,
-	0x2f, 0x03, 0xea, 0x77, 0xfc, 0x80, 0xd1, 0x9d, 0x84, 0x0a, 0x47, 0x4b, 0xb9, 0xa7, 0xb8, 0x71,
-	0x3d, 0x65, 0xe3, 0x6b, 0x6e, 0xc2, 0x34, 0xee, 0xee, 0x0d, 0x2e, 0x91, 0xf7, 0x1a, 0xe1, 0xee,
-	0x5e, 0x76, 0xc5, 0x12, 0x54, 0x49, 0x14, 0x05, 0xd1, 0xe0, 0x1a, 0x79, 0xb7, 0xa7, 0x85, 0x35,
-	0xbb, 0xea, 0x16, 0x5c, 0x8e, 0x19, 0x66, 0xbd, 0x78, 0x70, 0x99, 0xac, 0x98, 0x33, 0xd2, 0x9c,
-	0x5d, 0xb7, 0x0c, 0xb3, 0x5e, 0xa0, 0xc0, 0x03, 0x2b, 0x65, 0xd5, 0xbc, 0x9c, 0x00, 0x32, 0x6b,
-	0xed, 0x27, 0x50, 0x93, 0x15, 0x20, 0xa3, 0x6f, 0x92, 0xe6, 0xb7, 0xa0, 0x4c, 0x0f, 0x67, 0xd5,
-	0x0d, 0x9b, 0xd6, 0x9d, 0x88, 0x93, 0x06, 0xda, 0x77, 0x61, 0x76, 0x95, 0xb0, 0x1c, 0xa7, 0x6f,
-	0x96, 0x09, 0xf6, 0x63, 0xb0, 0x74, 0x3e, 0x54, 0xda, 0x0f, 0xcb, 0xec, 0x09, 0xd4, 0x64, 0x3d,
-	0x39, 0xe3, 0x88, 0x57, 0xa0, 0x26, 0xeb, 0xca, 0xe9, 0x82, 0xbe, 0x2d, 0x2b, 0xce, 0x69, 0x1c,
-	0x4c, 0xa5, 0x16, 0xf7, 0x3b, 0xe1, 0x02, 0x8c, 0xec, 0x51, 0x5f, 0xae, 0x99, 0x50, 0xf1, 0xa4,
-	0x70, 0x0f, 0xa9, 0xef, 0x3a, 0x02, 0x91, 0x94, 0x1a, 0x9d, 0xe6, 0x43, 0x96, 0x1a, 0x0d, 0x9f,
-	0x7e, 0xa9, 0xf9, 0xa9, 0xc0, 0xf9, 0xee, 0x78, 0xbd, 0x6f, 0x5a, 0x77, 0x87, 0xa8, 0x16, 0x16,
-	0x8c, 0x11, 0xdf, 0x0d, 0x03, 0xea, 0x33, 0x55, 0x81, 0xfa, 0x63, 0x5e, 0xcd, 0xdd, 0x6d, 0x55,
-	0x06, 0x0a, 0xee, 0x36, 0xc7, 0xf6, 0x62, 0x12, 0x89, 0x1e, 0x2b, 0xaf, 0x7b, 0x7f, 0xcc, 0x6d,
-	0x21, 0x8e, 0xe3, 0xaf, 0x83, 0x28, 0xe9, 0xd7, 0xfd, 0x31, 0xaf, 0x19, 0x11, 0x61, 0xc4, 0x17,
-	0x44, 0xc2, 0xc0, 0xa3, 0xdd, 0x83, 0x74, 0xa3, 0x9e, 0xea, 0x1b, 0x37, 0x84, 0x8d, 0x77, 0x6a,
-	0xb4, 0x04, 0xe3, 0x61, 0x44, 0xba, 0x34, 0xe6, 0x39, 0x74, 0x5e, 0x68, 0x5e, 0x55, 0x5a, 0xc8,
-	0x58, 0x37, 0x12, 0xab, 0x73, 0x08, 0xb4, 0x9f, 0xc1, 0xbc, 0xbc, 0x8d, 0x1a, 0x45, 0x92, 0x34,
-	0x58, 0xd6, 0xe5, 0xa7, 0x79, 0xc4, 0x77, 0x6e, 0x8e, 0xde, 0x87, 0xab, 0xab, 0x84, 0x1d, 0xe3,
-	0xfc, 0x0d, 0x73, 0xec, 0x29, 0xcc, 0xe5, 0xf9, 0x51, 0x99, 0x72, 0x1a, 0x96, 0xcf, 0x60, 0x5e,
-	0xde, 0xd0, 0x7f, 0x49, 0x85, 0x36, 0xcc, 0xcb, 0x9b, 0x7a, 0x6a, 0x21, 0xea, 0xef, 0xc2, 0x64,
-	0xe6, 0x12, 0xa1, 0x31, 0x18, 0xe1, 0x15, 0xa0, 0x72, 0x0e, 0x5d, 0x80, 0xb1, 0xf6, 0xfa, 0xfd,
-	0xb5, 0xad, 0x2f, 0x5a, 0x77, 0x2b, 0x46, 0xfd, 0x36, 0x5c, 0x1a, 0x38, 0x7b, 0x54, 0x82, 0xc2,
-	0xfa, 0x66, 0xe5, 0x1c, 0x1a, 0x05, 0x63, 0xab, 0x62, 0xf0, 0xe1, 0xa3, 0xcd, 0x4a, 0x81, 0x0f,
-	0x37, 0x2b, 0x45, 0xfe, 0xf3, 0xa8, 0x32, 0xc2, 0x7f, 0x1e, 0x54, 0x46, 0x17, 0x7f, 0x9b, 0x04,
-	0x94, 0x6a, 0xda, 0x9b, 0xf2, 0x79, 0x88, 0x08, 0x94, 0x64, 0xce, 0xa0, 0xab, 0x22, 0xfc, 0xbc,
-	0x07, 0xa2, 0x35, 0x97, 0x67, 0x96, 0x47, 0x66, 0xd7, 0xbe, 0xff, 0xfb, 0xf5, 0xab, 0x42, 0xd5,
-	0xbe, 0x24, 0x3f, 0x5f, 0x0e, 0x11, 0xf1, 0xb2, 0x51, 0x47, 0xcf, 0xa0, 0xb8, 0x4a, 0x18, 0x92,
-	0xcd, 0x58, 0xfb, 0x0e, 0xb4, 0xae, 0x68, 0x6d, 0xca, 0xfb, 0x9c, 0xf0, 0x6e, 0xa2, 0xea, 0x80,
-	0xf7, 0xe6, 0x77, 0xd4, 0x7d, 0x89, 0x7c, 0x28, 0xc9, 0x43, 0x57, 0x61, 0xe4, 0xbd, 0xf9, 0xac,
-	0x6a, 0x43, 0x7e, 0x46, 0x35, 0x92, 0xcf, 0xa8, 0xc6, 0x0a, 0xff, 0x8c, 0xb2, 0x3f, 0x10, 0x1b,
-	0xdc, 0xb0, 0x6c, 0xcd, 0x06, 0xe9, 0xcf, 0x35, 0xea, 0xbe, 0xe4, 0xf1, 0x74, 0xa0, 0x24, 0x93,
-	0x40, 0xed, 0x97, 0xf7, 0x26, 0xcc, 0xdd, 0x4f, 0x05, 0x54, 0xcf, 0x0b, 0xe8, 0x29, 0x8c, 0xf0,
-	0x62, 0x87, 0xa4, 0x2a, 0xfa, 0x57, 0xa4, 0x55, 0xd3, 0x1b, 0x95, 0x66, 0xb3, 0x62, 0x8b, 0x29,
-	0x34, 0x78, 0x22, 0xe8, 0x57, 0x03, 0x66, 0xb4, 0x8d, 0x1b, 0xfd, 0x2f, 0x75, 0xcc, 0xfa, 0x56,
-	0x94, 0x1b, 0xd2, 0x43, 0xb1, 0xdf, 0x8a, 0x7d, 0x47, 0x17, 0xd2, 0xa1, 0x9b, 0xc6, 0xd1, 0x9b,
-	0xf1, 0xb2, 0x99, 0xb2, 0xc5, 0xcd, 0xe7, 0x8c, 0x85, 0x5c, 0xe0, 0x57, 0x06, 0xa0, 0xc1, 0xf6,
-	0x8d, 0xe6, 0x92, 0x24, 0xc9, 0xe1, 0x76, 0x2d, 0xd7, 0xae, 0x44, 0xf9, 0x54, 0x90, 0xbc, 0x85,
-	0x96, 0x8e, 0x3f, 0x67, 0x3d, 0x31, 0xa1, 0x9b, 0xb6, 0xfd, 0x2b, 0xdd, 0x8e, 0x7b, 0x1a, 0x9c,
-	0xa4, 0x9b, 0x75, 0x26, 0xba, 0xfd, 0x6c, 0xc0, 0x8c, 0xf6, 0x21, 0xa1, 0x18, 0x1e, 0xf7, 0xc8,
-	0xc8, 0x65, 0xa8, 0x44, 0xab, 0x0f, 0x27, 0xda, 0x9f, 0x46, 0xf2, 0x9d, 0xa8, 0xed, 0xd4, 0xa9,
-	0x84, 0xcb, 0xaf, 0xa8, 0xb9, 0xd4, 0x3e, 0x17, 0xd4, 0xda, 0x76, 0xeb, 0x34, 0xe2, 0x51, 0xb1,
-	0xaf, 0xbb, 0xcd, 0x05, 0xfc, 0xdd, 0x10, 0xdf, 0x9f, 0x3a, 0xaa, 0x76, 0x92, 0x5c, 0xc7, 0xf0,
-	0xbc, 0x7e, 0x2c, 0x46, 0x25, 0xe1, 0x1d, 0x41, 0x7a, 0x19, 0x7d, 0xf2, 0xb6, 0x7a, 0x26, 0x44,
-	0x85, 0xa6, 0xb9, 0x5d, 0x4e, 0x69, 0x7a, 0x52, 0x17, 0x3c, 0x49, 0x53, 0xeb, 0xcc, 0x34, 0xfd,
-	0xc5, 0x80, 0xd9, 0xdc, 0x9e, 0xa9, 0xd8, 0x9e, 0xd4, 0x53, 0x73, 0xd9, 0x2a, 0x31, 0xeb, 0xc3,
-	0x8b, 0xf9, 0x83, 0x01, 0x95, 0xcc, 0x9b, 0x35, 0x4e, 0x15, 0x5e, 0x0d, 0x97, 0x9a, 0xde, 0xa8,
-	0x8e, 0xf7, 0x63, 0xc1, 0xe8, 0x43, 0xd4, 0x7c, 0x4b, 0x46, 0xdb, 0x25, 0x11, 0xda, 0x47, 0xff,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x15, 0x7f, 0x76, 0x21, 0x14, 0x00, 0x00,
+	// 4155 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe5, 0x5b, 0x5b, 0x6f, 0x1c, 0x49,
+	0x15, 0xa6, 0x3d, 0xbe, 0x1e, 0xdf, 0xc6, 0x65, 0xc7, 0x99, 0x4c, 0x6e, 0x4e, 0x67, 0x73, 0xf3,
+	0xc6, 0xf6, 0x6e, 0x36, 0xd9, 0x4b, 0xc2, 0x6a, 0xd7, 0xb7, 0x24, 0x4e, 0xec, 0x24, 0xdb, 0xb6,
+	0x57, 0xbb, 0x68, 0xd9, 0xa1, 0x3d, 0xdd, 0xe3, 0xf4, 0x66, 0xdc, 0x3d, 0xe9, 0xee, 0xc9, 0x66,
+	0x82, 0x16, 0x01, 0x02, 0x1e, 0x60, 0x85, 0x90, 0x56, 0x82, 0x95, 0x40, 0x42, 0xe2, 0x22, 0x1e,
+	0x90, 0x40, 0xbb, 0xda, 0x27, 0x5e, 0x79, 0x42, 0x3c, 0xf0, 0x80, 0x04, 0x0f, 0xf0, 0xb2, 0x12,
+	0x12, 0x3f, 0x80, 0x77, 0xc4, 0xa9, 0x4b, 0xf7, 0xd4, 0xf4, 0x74, 0xf7, 0xcc, 0xd8, 0x13, 0x81,
+	0xc4, 0x93, 0xa7, 0xea, 0x9c, 0xaa, 0xfa, 0xea, 0xab, 0x73, 0x4e, 0x55, 0x57, 0x1d, 0xc3, 0x84,
+	0x5e, 0xa9, 0x94, 0xad, 0xa2, 0xee, 0x5b, 0x8e, 0x3d, 0x5f, 0x71, 0x1d, 0xdf, 0x21, 0x19, 0xbd,
+	0x62, 0xe5, 0x8f, 0xed, 0x3a, 0xce, 0x6e, 0xd9, 0x5c, 0xc0, 0xdf, 0x0b, 0xba, 0x6d, 0x3b, 0x3e,
+	0xd3, 0xf0, 0xb8, 0x4a, 0xfe, 0x84, 0x90, 0xb2, 0xd2, 0x4e, 0xb5, 0xb4, 0x60, 0x54, 0x5d, 0xa9,
+	0x8b, 0xfc, 0xd1, 0xa8, 0xdc, 0xdc, 0xab, 0xf8, 0x35, 0x21, 0x9c, 0x89, 0x0a, 0x4b, 0x96, 0x59,
+	0x36, 0x0a, 0x7b, 0xba, 0xf7, 0x40, 0x68, 0x9c, 0x8c, 0x6a, 0xf8, 0xd6, 0x9e, 0xe9, 0xf9, 0xfa,
+	0x5e, 0x85, 0x2b, 0xa8, 0xdf, 0xee, 0x87, 0xe1, 0xc5, 0x3a, 0x70, 0x32, 0x06, 0x3d, 0x96, 0x91,
+	0x53, 0x66, 0x94, 0xf3, 0x19, 0x0d, 0x7f, 0x11, 0x02, 0xbd, 0xb6, 0xbe, 0x67, 0xe6, 0x7a, 0xb0,
+	0x66, 0x48, 0x63, 0xbf, 0xc9, 0x0c, 0x0c, 0x1b, 0xa6, 0x57, 0x74, 0xad, 0x0a, 0x6d, 0x92, 0xcb,
+	0x30, 0x91, 0x5c, 0x45, 0xce, 0xc1, 0xb8, 0xe3, 0xee, 0xea, 0xb6, 0xf5, 0x84, 0xf5, 0x5a, 0xc0,
+	0x2e, 0x7b, 0x59, 0x97, 0x63, 0x72, 0xf5, 0xda, 0x0a, 0xb9, 0x08, 0xc4, 0x33, 0xdd, 0x47, 0x56,
+	0xd1, 0x2c, 0x20, 0x9e, 0x92, 0x55, 0x36, 0xa9, 0x6e, 0x1f, 0xeb, 0x31, 0x2b, 0x24, 0xf7, 0xb8,
+	0x00, 0xb5, 0x4f, 0xc3, 0x68, 0x45, 0xaf, 0x95, 0x1d, 0xdd, 0x28, 0x14, 0x1d, 0xc3, 0x2c, 0xe6,
+	0xfa, 0x99, 0xe2, 0x88, 0xa8, 0x5c, 0xa6, 0x75, 0xe4, 0x32, 0x4c, 0x07, 0x4a, 0xa6, 0x4d, 0xd5,
+	0xdc, 0x02, 0x07, 0x96, 0x1b, 0x60, 0xda, 0x53, 0x42, 0xba, 0xca, 0x85, 0x9b, 0x4c, 0x26, 0xb7,
+	0xc2, 0x4e, 0xe4, 0x56, 0x83, 0x0d, 0xad, 0x56, 0x4c, 0xb9, 0xd5, 0x55, 0x38, 0xb2, 0x6b, 0x3a,
+	0x65, 0x87, 0x93, 0x57, 0x40, 0x82, 0x4b, 0xd8, 0xb0, 0xe4, 0x22, 0x4b, 0x5e, 0x6e, 0x08, 0x1b,
+	0x8e, 0x6a, 0x87, 0x25, 0x85, 0x25, 0x26, 0xbf, 0xce, 0xc4, 0xe4, 0x65, 0xc8, 0xc9, 0x6d, 0xf7,
+	0x2c, 0xa4, 0xc9, 0xf6, 0x71, 0xca, 0x7a, 0x39, 0x07, 0xac, 0xe9, 0xb4, 0x24, 0xdf, 0xb0, 0xec,
+	0x35, 0x21, 0x25, 0xb7, 0xe0, 0x94, 0x61, 0x79, 0xfa, 0x0e, 0x92, 0xd5, 0xc8, 0x32, 0x2a, 0xec,
+	0x72, 0xeb, 0xf1, 0x72, 0xc3, 0xd8, 0xc5, 0xa0, 0x76, 0x52, 0x28, 0xde, 0x95, 0x69, 0x97, 0xd4,
+	0x48, 0x1e, 0x06, 0x75, 0xb7, 0x78, 0xdf, 0x7a, 0x64, 0x1a, 0xb9, 0x11, 0xd6, 0x24, 0x2c, 0x93,
+	0x65, 0x18, 0x0b, 0x0c, 0xaa, 0x52, 0xb1, 0xec, 0x5d, 0x2f, 0x37, 0x3a, 0x93, 0x39, 0x3f, 0x7c,
+	0xe9, 0xd8, 0x3c, 0xda, 0xf2, 0xbc, 0x64, 0x35, 0xd7, 0xa9, 0xd6, 0x06, 0x57, 0xd2, 0x46, 0x4b,
+	0x52, 0xc9, 0x23, 0xcf, 0xc1, 0x54, 0x15, 0x15, 0xed, 0x07, 0x05, 0x5c, 0x44, 0xbf, 0x4e, 0xeb,
+	0x18, 0xa3, 0x95, 0x70, 0xd9, 0x75, 0x26, 0x12, 0xa4, 0x6e, 0xc1, 0xd4, 0xde, 0x43, 0xdf, 0x2f,
+	0xf8, 0x4e, 0xc5, 0x2a, 0x16, 0x7c, 0x34, 0xf8, 0xb2, 0xee, 0x23, 0x9f, 0xe3, 0xd8, 0x62, 0xf8,
+	0x92, 0x1a, 0x1d, 0x7c, 0xe3, 0x8d, 0xad, 0xad, 0x2d, 0xaa, 0xba, 0x15, 0x68, 0x6a, 0x84, 0xb6,
+	0x6f, 0xac, 0x23, 0xc7, 0x01, 0xca, 0xe6, 0xae, 0x5e, 0x2e, 0xdc, 0x77, 0xca, 0x46, 0x2e, 0xcb,
+	0xa6, 0x3a, 0xc4, 0x6a, 0x6e, 0x62, 0x85, 0xfa, 0x37, 0x05, 0x0e, 0x27, 0xcc, 0x88, 0x4c, 0x41,
+	0x1f, 0x9b, 0x13, 0x73, 0x8b, 0x21, 0x8d, 0x17, 0x62, 0x3d, 0x03, 0x35, 0xbd, 0xa2, 0x5e, 0x36,
+	0x99, 0x4f, 0x28, 0x1a, 0x2f, 0x90, 0x69, 0xe8, 0x77, 0x4a, 0x25, 0xcf, 0xf4, 0x99, 0x13, 0x28,
+	0x9a, 0x28, 0x91, 0xa3, 0x30, 0x54, 0x72, 0x9d, 0xbd, 0x42, 0xd5, 0xb6, 0x7c, 0x61, 0xf3, 0x83,
+	0xb4, 0x62, 0x1b, 0xcb, 0xe4, 0x30, 0x0c, 0xf8, 0x0e, 0x17, 0x71, 0x2b, 0xef, 0xf7, 0x1d, 0x26,
+	0xc0, 0x31, 0x5c, 0xa7, 0x6a, 0x1b, 0xcc, 0x9c, 0x07, 0x35, 0x5e, 0x20, 0xc7, 0x60, 0xa8, 0xe2,
+	0x9a, 0x45, 0xcb, 0xa3, 0x1e, 0x39, 0xc8, 0xcc, 0xa7, 0x5e, 0xa1, 0xfe, 0x5e, 0x81, 0xe3, 0xa9,
+	0x94, 0x51, 0x8c, 0x7c, 0x29, 0xc4, 0x24, 0x45, 0x89, 0xda, 0x87, 0xe1, 0xbc, 0x6f, 0x33, 0x09,
+	0x9f, 0x69, 0x58, 0xa6, 0x0c, 0xbc, 0xe7, 0x58, 0x41, 0x00, 0x60, 0xbf, 0x49, 0x16, 0x32, 0x7a,
+	0xf1, 0x01, 0x9b, 0xe8, 0x90, 0x46, 0x7f, 0x52, 0xbc, 0xa6, 0xeb, 0x3a, 0xae, 0x98, 0x21, 0x2f,
+	0xd0, 0xf1, 0x30, 0x0c, 0xf9, 0x55, 0x2f, 0x98, 0x1d, 0x2f, 0xd1, 0xf1, 0x02, 0x93, 0x17, 0xfe,
+	0x1a, 0x96, 0xd5, 0xaf, 0xf7, 0xc0, 0xa4, 0x34, 0x8b, 0x75, 0xcb, 0xf3, 0xd7, 0xd0, 0x3c, 0xfe,
+	0xb7, 0x63, 0x16, 0xda, 0x7f, 0x54, 0x9b, 0x81, 0xe3, 0xd3, 0x26, 0x8d, 0xfa, 0x77, 0x28, 0x54,
+	0xd9, 0x25, 0x07, 0x1a, 0x5d, 0x52, 0xbd, 0x03, 0xb9, 0x65, 0xd7, 0xc4, 0x15, 0x93, 0x78, 0xd0,
+	0xcc, 0x87, 0x55, 0x8c, 0xe9, 0xe4, 0x12, 0x0c, 0x4b, 0x5b, 0x10, 0xe3, 0x63, 0xf8, 0x52, 0x36,
+	0xea, 0x2e, 0x9a, 0xac, 0xa4, 0x3e, 0x0b, 0x47, 0x62, 0xfa, 0xf3, 0x2a, 0x18, 0x1a, 0xcc, 0x28,
+	0xaf, 0xea, 0x39, 0x38, 0x74, 0xc3, 0xf4, 0x63, 0x46, 0x8e, 0x2a, 0x7e, 0x0d, 0xa6, 0xa3, 0x8a,
+	0xa2, 0xcb, 0x7d, 0x60, 0xa4, 0x0c, 0x1a, 0xae, 0x53, 0xa9, 0x98, 0x46, 0x41, 0x44, 0x92, 0x22,
+	0x9a, 0xbc, 0xcf, 0x96, 0x37, 0xa3, 0x11, 0x21, 0xdb, 0x66, 0xa2, 0x65, 0x2a, 0x51, 0xbf, 0xa7,
+	0x40, 0x6e, 0xbb, 0x62, 0x74, 0x8d, 0x26, 0x72, 0x0d, 0x86, 0xab, 0xac, 0x3f, 0xb6, 0xb7, 0xb2,
+	0x91, 0x87, 0x2f, 0xe5, 0xe7, 0xf9, 0xe6, 0x3a, 0x1f, 0x6c, 0xae, 0xf3, 0x22, 0x6a, 0x78, 0x0f,
+	0x34, 0xe0, 0xea, 0xf4, 0xb7, 0x3a, 0x0b, 0xb9, 0x15, 0xb3, 0x6c, 0xc6, 0x82, 0x89, 0x32, 0x87,
+	0xeb, 0xb1, 0xc8, 0xd7, 0xba, 0x0d, 0xe5, 0x39, 0x38, 0xba, 0x6d, 0xeb, 0x6d, 0xab, 0x3f, 0x07,
+	0x27, 0x36, 0x1b, 0x56, 0x65, 0x3d, 0x88, 0x7e, 0x49, 0x2d, 0x2e, 0xc1, 0xcc, 0x72, 0xd9, 0xd4,
+	0xdd, 0x4e, 0xda, 0x7c, 0xaa, 0xc0, 0x34, 0xf5, 0xcc, 0x18, 0x40, 0x18, 0x09, 0xca, 0xd6, 0x1e,
+	0x06, 0x34, 0xae, 0xcd, 0x0b, 0x52, 0x74, 0xe4, 0x0b, 0x1a, 0x44, 0xc7, 0x18, 0x7f, 0xcc, 0xc4,
+	0xfa, 0x23, 0x0d, 0x25, 0x26, 0xa5, 0x41, 0x44, 0x1d, 0x51, 0x22, 0x17, 0x20, 0x6b, 0xd9, 0xc5,
+	0x72, 0xd5, 0x30, 0x0b, 0xa1, 0x3f, 0xf5, 0x31, 0x7f, 0x1a, 0x17, 0xf5, 0x8b, 0x81, 0x5b, 0x95,
+	0xe1, 0x70, 0x13, 0x66, 0x61, 0xb1, 0x27, 0x61, 0xd8, 0xc7, 0x33, 0x5b, 0x59, 0x18, 0x1d, 0x87,
+	0x0e, 0xac, 0x8a, 0x19, 0x1b, 0x9a, 0x67, 0xbf, 0x6b, 0x7a, 0xd5, 0x32, 0xc5, 0x4f, 0x77, 0xc7,
+	0x5c, 0xd4, 0x94, 0x82, 0x38, 0xa5, 0x09, 0x3d, 0xf5, 0x0d, 0x38, 0x74, 0x73, 0x6b, 0xeb, 0x9e,
+	0xb4, 0x0f, 0xdf, 0x34, 0x75, 0x3c, 0x54, 0xd0, 0xe0, 0xf9, 0xc0, 0xac, 0x89, 0x08, 0x4c, 0x7f,
+	0x52, 0xca, 0x70, 0xc7, 0xaf, 0x06, 0xb1, 0x8c, 0x17, 0xa8, 0x5e, 0xd5, 0x2d, 0x8b, 0x20, 0x46,
+	0x7f, 0xaa, 0x9f, 0xf4, 0xc1, 0x78, 0xa4, 0x4f, 0x72, 0x06, 0xc6, 0x24, 0x1b, 0x2e, 0x84, 0xab,
+	0x34, 0x2a, 0xd5, 0x22, 0x7d, 0x97, 0x61, 0xe0, 0x3e, 0x1b, 0xde, 0x13, 0x13, 0xc8, 0xb3, 0x09,
+	0xc4, 0x22, 0xd4, 0x02, 0x55, 0x72, 0x16, 0xc6, 0x85, 0x33, 0xa2, 0x9d, 0xeb, 0x85, 0x3a, 0x9c,
+	0x51, 0x5e, 0xbd, 0x82, 0xb5, 0xdb, 0xda, 0x3a, 0x7a, 0xdb, 0x21, 0xba, 0x2f, 0x14, 0xf0, 0xdc,
+	0x6b, 0x95, 0x02, 0x28, 0x54, 0x9b, 0xaf, 0xd5, 0x24, 0x15, 0xde, 0x91, 0x64, 0xb4, 0x0d, 0x3a,
+	0x3c, 0x6e, 0x1c, 0xcd, 0x4d, 0x78, 0x88, 0x25, 0x28, 0x8b, 0xb6, 0xc0, 0xd3, 0x1b, 0xdb, 0x56,
+	0x9a, 0xdb, 0xf0, 0x30, 0x3b, 0xc5, 0xa4, 0xd1, 0x56, 0x2f, 0xc2, 0x61, 0xbe, 0xeb, 0x34, 0x37,
+	0xe3, 0x5b, 0xcf, 0x21, 0x2e, 0x8e, 0xb6, 0xc3, 0x53, 0x5f, 0x78, 0x6c, 0x6b, 0x6a, 0xc9, 0x8f,
+	0x8b, 0x87, 0x03, 0x85, 0x68, 0x5b, 0xe4, 0x4d, 0x37, 0xe8, 0x59, 0xcf, 0x7c, 0x64, 0xda, 0x3e,
+	0x6b, 0x31, 0xc4, 0x79, 0x63, 0xd5, 0xab, 0xb4, 0x96, 0xea, 0xc5, 0x58, 0x3f, 0xc4, 0x5a, 0xff,
+	0x51, 0xba, 0xf1, 0x3b, 0x8f, 0x6b, 0xac, 0xab, 0x61, 0xbe, 0x63, 0xb2, 0x0a, 0xda, 0xcb, 0x3c,
+	0x4c, 0x16, 0xef, 0xeb, 0xf6, 0x2e, 0x86, 0x4e, 0x76, 0x68, 0xf1, 0x0a, 0x8e, 0x5d, 0xae, 0x89,
+	0x83, 0xde, 0x84, 0x10, 0xb1, 0xa8, 0xe5, 0xdd, 0x45, 0x01, 0xdf, 0xda, 0x8a, 0x55, 0xd7, 0xf2,
+	0x6b, 0x12, 0xc0, 0xd1, 0x60, 0x6b, 0xe3, 0x92, 0x10, 0x23, 0x1a, 0x98, 0x67, 0xed, 0xda, 0x78,
+	0x44, 0x2a, 0xa0, 0xcc, 0x35, 0x83, 0x43, 0xdd, 0xa8, 0xa8, 0xdd, 0x64, 0x95, 0xd4, 0x3f, 0x59,
+	0x5f, 0xf4, 0x04, 0x97, 0xa1, 0xfe, 0xc9, 0x4b, 0xea, 0x9b, 0x70, 0x8c, 0xef, 0x3d, 0x11, 0x53,
+	0x0b, 0xc2, 0xc5, 0x8b, 0x30, 0x2c, 0x9d, 0x68, 0x45, 0xa0, 0x9e, 0x8a, 0x33, 0x4e, 0x4d, 0x56,
+	0x54, 0x97, 0xe0, 0x08, 0xee, 0x3e, 0x09, 0x9d, 0xb6, 0xe7, 0x14, 0xea, 0x16, 0xe4, 0xe3, 0xfa,
+	0x10, 0x31, 0x61, 0xbf, 0xc8, 0x70, 0xc6, 0x7c, 0x5b, 0xea, 0xf2, 0x8c, 0x57, 0xe1, 0x18, 0xdf,
+	0x61, 0x0e, 0x36, 0xe9, 0xd7, 0x78, 0xe4, 0xde, 0x7f, 0x07, 0x5f, 0x86, 0x49, 0xa9, 0x71, 0x78,
+	0x3e, 0x3b, 0x0f, 0xbd, 0x0f, 0x2c, 0x9b, 0xb7, 0x19, 0x13, 0xf3, 0x91, 0xf4, 0x6e, 0xa3, 0x4c,
+	0x63, 0x1a, 0xf4, 0x14, 0x6b, 0xd9, 0xf7, 0x4d, 0xb4, 0x32, 0x8c, 0xd5, 0x3d, 0xfc, 0x8c, 0x1e,
+	0x56, 0x04, 0x51, 0x3a, 0x6e, 0x45, 0xf6, 0x19, 0xa5, 0x63, 0xd0, 0x86, 0x51, 0xfa, 0xe3, 0x0c,
+	0x9d, 0x4d, 0xa9, 0x5c, 0x7d, 0xbc, 0xb2, 0xb4, 0x8f, 0xb0, 0x8a, 0xa7, 0x38, 0xd3, 0x36, 0x2a,
+	0x18, 0xde, 0xfc, 0xe0, 0xe0, 0x1c, 0x94, 0xe9, 0x9e, 0x69, 0xec, 0x88, 0x78, 0x89, 0xbf, 0xa8,
+	0x6e, 0x15, 0x0f, 0x82, 0xec, 0x5c, 0xc8, 0xe3, 0x62, 0x58, 0xa6, 0xb2, 0x8a, 0xee, 0x79, 0xef,
+	0x3b, 0x6e, 0x70, 0xc6, 0x0c, 0xcb, 0x34, 0xb8, 0xa2, 0x83, 0xa1, 0x33, 0x51, 0x20, 0x15, 0x07,
+	0x47, 0xaf, 0xc9, 0x87, 0xcb, 0xc9, 0x50, 0x78, 0x8f, 0xc9, 0xd8, 0xe9, 0xf2, 0xb2, 0xfc, 0xa1,
+	0x30, 0xc0, 0x56, 0x64, 0x5a, 0x70, 0xc1, 0xe7, 0x7a, 0x2f, 0x90, 0x4a, 0x1f, 0x10, 0x71, 0xe1,
+	0x68, 0xb0, 0x75, 0x38, 0x1a, 0x6a, 0x2f, 0x1c, 0x41, 0x52, 0x38, 0xaa, 0x47, 0x8e, 0xe1, 0x86,
+	0xc8, 0xf1, 0x2e, 0x9e, 0x4b, 0x58, 0xe4, 0x88, 0x59, 0x9f, 0xc0, 0x64, 0xaf, 0xc6, 0xf9, 0x52,
+	0xae, 0x61, 0xa6, 0x89, 0xfe, 0x74, 0x1d, 0x8e, 0xa3, 0xf7, 0xa7, 0x74, 0xde, 0xa6, 0x3f, 0xbc,
+	0x03, 0x27, 0x92, 0xfa, 0x11, 0x76, 0x7b, 0x10, 0x94, 0xc8, 0x02, 0x8f, 0x26, 0x4f, 0x89, 0x85,
+	0x35, 0x98, 0xe1, 0x51, 0xe5, 0xe0, 0x44, 0xfc, 0x51, 0x81, 0xec, 0xe2, 0x93, 0xaa, 0x6b, 0xee,
+	0xc3, 0x91, 0x9e, 0x85, 0x89, 0xa2, 0x63, 0xdb, 0x66, 0x91, 0x69, 0x79, 0xbe, 0x8b, 0x3b, 0x8b,
+	0xf0, 0xa8, 0x6c, 0x5d, 0xb0, 0xc9, 0xea, 0x1b, 0xcd, 0x2f, 0xd3, 0x9e, 0xf9, 0xf5, 0xb6, 0x36,
+	0xbf, 0xbe, 0x06, 0xf3, 0x7b, 0x0b, 0x8e, 0x8b, 0x8f, 0xa6, 0xc8, 0x94, 0x02, 0x56, 0x5e, 0x8a,
+	0x63, 0xfd, 0x10, 0x3f, 0x17, 0x46, 0x9b, 0x34, 0x50, 0xbe, 0xcc, 0xb6, 0x9d, 0xa4, 0x6e, 0xdb,
+	0x24, 0xfb, 0x4d, 0x38, 0x1a, 0xdb, 0x89, 0x30, 0xb9, 0x7d, 0x83, 0xc3, 0x69, 0x8b, 0x8f, 0xaa,
+	0x6e, 0x4f, 0x1b, 0xfd, 0x4d, 0x7c, 0x21, 0x1d, 0x6c, 0xe6, 0x1f, 0xe2, 0xb7, 0xc7, 0xd6, 0x7d,
+	0x7a, 0xed, 0xb4, 0xe4, 0xe8, 0xae, 0xb1, 0x0f, 0x63, 0x63, 0xdf, 0x12, 0xee, 0x23, 0xd3, 0x15,
+	0x16, 0x26, 0x4a, 0xe9, 0x76, 0x55, 0xb7, 0x93, 0xde, 0x06, 0x3b, 0x31, 0xe0, 0x34, 0xb7, 0x93,
+	0x78, 0x4c, 0xc1, 0xe4, 0x5e, 0x8d, 0xa3, 0xed, 0x28, 0xa3, 0x2d, 0xa1, 0x61, 0xd4, 0x4d, 0x71,
+	0xb9, 0xd3, 0x87, 0x68, 0x93, 0xbf, 0x1d, 0x38, 0x95, 0xd2, 0x95, 0xb0, 0x9f, 0x03, 0xc2, 0x45,
+	0x52, 0xb8, 0x15, 0x3d, 0x55, 0x52, 0xd6, 0xe1, 0x34, 0xb7, 0xa8, 0xae, 0xf0, 0xf2, 0x7d, 0x05,
+	0xa6, 0x36, 0x6a, 0x2b, 0x26, 0xbd, 0xa9, 0xf1, 0xba, 0x7c, 0x16, 0xd8, 0x97, 0x65, 0x7d, 0x05,
+	0x4e, 0x71, 0xcb, 0x8a, 0x43, 0x15, 0x4c, 0xee, 0x5a, 0x1c, 0x85, 0x47, 0x18, 0x85, 0xb1, 0xcd,
+	0x1a, 0x08, 0xbc, 0xc1, 0xb6, 0xae, 0xb4, 0xee, 0xdb, 0xe4, 0xee, 0x5d, 0x38, 0x99, 0xd8, 0x91,
+	0xb0, 0xa8, 0x03, 0x01, 0x45, 0x2a, 0xb8, 0x3d, 0x3d, 0x35, 0x2a, 0x6e, 0xc1, 0x29, 0x6e, 0x4b,
+	0x5d, 0x60, 0xe3, 0x73, 0xb4, 0xa4, 0x75, 0x47, 0xd3, 0x97, 0xcb, 0x4e, 0x75, 0x3f, 0xf1, 0x69,
+	0x0a, 0xfa, 0x7c, 0xe7, 0x81, 0x69, 0x07, 0xf7, 0x01, 0xac, 0x20, 0x45, 0xad, 0x4c, 0x43, 0xd4,
+	0x3a, 0x0e, 0xb0, 0xe7, 0x18, 0xe6, 0x1e, 0x9e, 0x0d, 0x5d, 0x7e, 0xf9, 0x3c, 0xaa, 0x0d, 0xb1,
+	0x9a, 0x7b, 0x58, 0x41, 0x4d, 0x6f, 0xd7, 0xf6, 0x3c, 0x2e, 0xed, 0x63, 0xd2, 0x41, 0x5a, 0x11,
+	0x08, 0xdf, 0xc7, 0x2f, 0x57, 0x2e, 0xec, 0xe7, 0x42, 0x5a, 0x11, 0x08, 0xeb, 0x46, 0x3b, 0xd0,
+	0x68, 0xb4, 0x75, 0xe3, 0x8c, 0x9b, 0x68, 0x1b, 0x2b, 0x12, 0xdb, 0x2c, 0xc6, 0x38, 0xd3, 0xba,
+	0xef, 0xc8, 0x38, 0xe3, 0x3b, 0x6a, 0x6d, 0x9c, 0xad, 0x81, 0x86, 0xc6, 0xf9, 0xd4, 0xa8, 0x08,
+	0x8d, 0xb3, 0x0b, 0x6c, 0x7c, 0xa3, 0x87, 0x6d, 0x25, 0xd2, 0xd5, 0x15, 0xbf, 0x54, 0xdd, 0xf4,
+	0x75, 0xdf, 0xeb, 0xac, 0x2f, 0xb2, 0x0c, 0xe3, 0x9e, 0xaf, 0xbb, 0x7e, 0x21, 0x7c, 0x70, 0x4c,
+	0xbc, 0x35, 0xdd, 0x0a, 0x34, 0xb4, 0x31, 0xd6, 0x24, 0x2c, 0x93, 0xd7, 0x60, 0x14, 0xe3, 0xa4,
+	0xd4, 0x45, 0xa6, 0x65, 0x17, 0x23, 0xd8, 0xa0, 0xde, 0x41, 0x78, 0xe3, 0xd8, 0x2b, 0xdf, 0x38,
+	0x62, 0x38, 0xa6, 0x5d, 0x3e, 0x71, 0x6c, 0x33, 0xf8, 0xa4, 0x0a, 0xca, 0xea, 0x77, 0xf1, 0xa4,
+	0x2a, 0xcd, 0x9a, 0x7f, 0x3c, 0x86, 0xb7, 0x70, 0xe2, 0xe2, 0x92, 0xdf, 0xc2, 0x9d, 0x82, 0x91,
+	0x98, 0xfb, 0xe8, 0xe1, 0x6a, 0xfd, 0x22, 0x5a, 0x7e, 0xb0, 0xdc, 0xa9, 0xd1, 0x37, 0x2c, 0x7e,
+	0x83, 0x19, 0x3c, 0x58, 0x2e, 0xd1, 0x3a, 0x92, 0x83, 0x01, 0xdd, 0x72, 0x29, 0x02, 0xf1, 0x3e,
+	0x14, 0x14, 0xd5, 0x7f, 0x2b, 0x30, 0xc1, 0x43, 0x8e, 0x04, 0x89, 0xbe, 0x0c, 0x19, 0xe6, 0xa3,
+	0x82, 0x59, 0xb5, 0x82, 0xb7, 0x1a, 0x2c, 0xae, 0x6e, 0xaf, 0xc5, 0xbe, 0x7b, 0x44, 0x41, 0x66,
+	0xda, 0x00, 0xd9, 0x1b, 0x03, 0xf2, 0x3c, 0x64, 0xf5, 0x47, 0xbb, 0x85, 0x40, 0xd1, 0xb3, 0x9e,
+	0x70, 0xee, 0x14, 0x6d, 0x0c, 0xeb, 0xef, 0xf1, 0xea, 0x4d, 0xac, 0x95, 0xa7, 0xd3, 0xdf, 0x30,
+	0x1d, 0x7a, 0xaf, 0xb7, 0xa7, 0x3f, 0x2e, 0x78, 0xf8, 0x59, 0xa9, 0x1b, 0xf4, 0xd6, 0xa8, 0xa4,
+	0x17, 0x7d, 0xc7, 0x65, 0x01, 0x64, 0x54, 0x23, 0x28, 0xdb, 0x0c, 0x44, 0xd7, 0x99, 0x44, 0xfd,
+	0x67, 0x0f, 0x3b, 0x91, 0x24, 0x59, 0xa4, 0x70, 0xd1, 0xe8, 0x1c, 0x95, 0x36, 0xe6, 0xd8, 0x93,
+	0xbe, 0x10, 0x99, 0x46, 0xe4, 0x57, 0xeb, 0xcd, 0xe9, 0xcc, 0xf9, 0x76, 0x1c, 0x9c, 0x6d, 0xa3,
+	0xe6, 0x12, 0xf6, 0x4a, 0xe9, 0xf0, 0xf0, 0xab, 0x63, 0xa0, 0xc4, 0xa2, 0x28, 0xff, 0x8c, 0x48,
+	0x6c, 0xd5, 0x5f, 0xa2, 0xa1, 0xd5, 0x23, 0x4b, 0x30, 0x11, 0x65, 0x88, 0x3e, 0x92, 0xa5, 0xb4,
+	0xcc, 0x7a, 0x8d, 0xb4, 0xd1, 0x47, 0x57, 0x6a, 0x22, 0x74, 0xab, 0x42, 0x72, 0x69, 0x4b, 0xfe,
+	0x89, 0xdf, 0x64, 0x4b, 0x5a, 0xa0, 0xa6, 0x7e, 0xab, 0x07, 0x4e, 0x37, 0x32, 0x8d, 0x61, 0xc5,
+	0xc2, 0x4d, 0xa4, 0xa6, 0x99, 0x14, 0xfc, 0xff, 0x89, 0xfb, 0x7f, 0xa2, 0xc0, 0x48, 0x38, 0x71,
+	0x8c, 0xdb, 0xb8, 0x7a, 0xbd, 0x34, 0x7e, 0x8b, 0xa8, 0x9c, 0x36, 0x34, 0xd3, 0xa3, 0xfc, 0xb8,
+	0x66, 0xd1, 0xa4, 0xaf, 0x0a, 0x0d, 0x61, 0x61, 0x34, 0xa8, 0xe5, 0xf6, 0x88, 0x6a, 0xe6, 0xe3,
+	0x0a, 0x7e, 0xba, 0x86, 0x6a, 0xdc, 0x31, 0x47, 0x83, 0xda, 0xd0, 0x6c, 0x0d, 0x81, 0xa6, 0xe0,
+	0x52, 0x18, 0x3c, 0x40, 0x8c, 0x18, 0x12, 0x44, 0xf5, 0x33, 0x05, 0x08, 0x5f, 0xd9, 0x06, 0xe4,
+	0x1d, 0x85, 0x89, 0x66, 0xd8, 0x99, 0xf6, 0x60, 0xf7, 0xb6, 0x05, 0xbb, 0x2f, 0x06, 0xf6, 0xbf,
+	0x14, 0x78, 0x26, 0xdd, 0xe2, 0x84, 0x7b, 0x37, 0x63, 0x53, 0xda, 0xc3, 0xd6, 0xd3, 0x16, 0xb6,
+	0x4c, 0x33, 0x36, 0xec, 0x0b, 0x57, 0xb3, 0x16, 0xb8, 0xf9, 0x84, 0x70, 0x9e, 0xba, 0x82, 0xc6,
+	0xc4, 0xe4, 0xf9, 0xba, 0x9b, 0x71, 0xd7, 0x3e, 0x2c, 0xb9, 0x59, 0x83, 0x7e, 0xe8, 0x67, 0x78,
+	0x22, 0x88, 0xbc, 0x34, 0x05, 0x7a, 0xeb, 0xce, 0x6e, 0x87, 0x4e, 0x16, 0x9a, 0x77, 0x8f, 0x64,
+	0xde, 0xea, 0x1f, 0x7a, 0x20, 0x2b, 0xf5, 0xb9, 0x6a, 0xfb, 0x6e, 0x8d, 0xbc, 0x0c, 0x43, 0x75,
+	0x37, 0x6a, 0x6d, 0xcb, 0x75, 0x65, 0xfa, 0x70, 0x2e, 0x9f, 0x4e, 0xb8, 0xd1, 0xc8, 0x55, 0xf4,
+	0x94, 0xc9, 0xdf, 0x0a, 0xfc, 0x5a, 0xc5, 0x14, 0x27, 0xd0, 0x21, 0x56, 0xb3, 0x85, 0x15, 0xb2,
+	0x1d, 0xf6, 0x36, 0xd8, 0xa1, 0x78, 0xc5, 0xea, 0x0b, 0x5f, 0xb1, 0xe8, 0x2d, 0xae, 0x78, 0x90,
+	0xa1, 0x49, 0x36, 0xe2, 0xd4, 0x09, 0xbc, 0x8a, 0x26, 0xf7, 0x90, 0x17, 0x60, 0x80, 0xa6, 0x2b,
+	0xd8, 0xc5, 0x1a, 0xdb, 0x34, 0xe8, 0x31, 0x29, 0x3a, 0x89, 0x15, 0x91, 0x3f, 0xa5, 0x05, 0x9a,
+	0x74, 0xc5, 0x5d, 0x61, 0x4b, 0x85, 0x1d, 0xc7, 0xa8, 0x89, 0x27, 0x9a, 0x91, 0xa0, 0x72, 0x09,
+	0xeb, 0xea, 0x59, 0x0a, 0x43, 0x52, 0x96, 0x82, 0xba, 0x09, 0x6a, 0xda, 0x6a, 0x09, 0x03, 0x9d,
+	0x0b, 0xef, 0x96, 0x15, 0x29, 0x4c, 0x47, 0xd7, 0x20, 0xbc, 0x58, 0x2e, 0xc1, 0xb9, 0x48, 0xa7,
+	0x6f, 0x54, 0x75, 0x57, 0xb7, 0x7d, 0xcb, 0x36, 0x0d, 0x9e, 0x1c, 0xd4, 0x15, 0x43, 0xf8, 0x0b,
+	0x1e, 0x65, 0xa2, 0x3d, 0x1f, 0xc0, 0x10, 0xa4, 0x75, 0xec, 0x69, 0x58, 0xc7, 0x23, 0x30, 0x48,
+	0x05, 0xba, 0x61, 0x04, 0xdf, 0x1f, 0x54, 0x71, 0x11, 0x8b, 0x64, 0x12, 0xfa, 0x4a, 0x85, 0xa2,
+	0x1d, 0x7c, 0x7b, 0xf4, 0x96, 0x96, 0xd1, 0x03, 0x0f, 0x41, 0x7f, 0x49, 0xfe, 0xe6, 0xe8, 0x63,
+	0x1b, 0x1f, 0x0d, 0x4b, 0xf4, 0x29, 0x91, 0xad, 0xfa, 0x08, 0x8b, 0xa6, 0x7a, 0x7d, 0x55, 0x06,
+	0xe4, 0x55, 0x79, 0x1b, 0xce, 0xb7, 0x26, 0x30, 0x75, 0x6d, 0xa2, 0xfa, 0xd2, 0xd3, 0xec, 0xf9,
+	0xe8, 0x8b, 0xf7, 0x01, 0x17, 0x27, 0xd6, 0xe3, 0x75, 0x63, 0xdd, 0xf4, 0x7d, 0xd3, 0xed, 0xce,
+	0x42, 0xff, 0x5d, 0x01, 0xa8, 0xf7, 0xf9, 0xdf, 0xf4, 0x75, 0x3c, 0x89, 0x05, 0xe7, 0xa4, 0xf7,
+	0x3c, 0xec, 0x81, 0x3b, 0xfc, 0xb0, 0xa8, 0xbb, 0xb5, 0x79, 0xf7, 0x0e, 0xcb, 0x6e, 0xf1, 0x69,
+	0x52, 0x17, 0x3b, 0x0f, 0xb1, 0xcf, 0xca, 0xa0, 0x5c, 0x5f, 0xee, 0x7e, 0x79, 0xb9, 0x37, 0x62,
+	0x9c, 0x50, 0x22, 0x50, 0x2c, 0xf4, 0xb9, 0xc8, 0x42, 0x8f, 0x0b, 0x27, 0x0c, 0x34, 0xc3, 0x25,
+	0xbe, 0x0d, 0x6a, 0x74, 0x89, 0xf7, 0xbd, 0x20, 0xea, 0x5b, 0xb0, 0x70, 0xc3, 0xb4, 0x4d, 0xba,
+	0x91, 0xd0, 0xa4, 0x2a, 0xe9, 0xdb, 0x6b, 0xb9, 0x6c, 0x21, 0x2b, 0xcb, 0xa6, 0x2b, 0xde, 0x7f,
+	0xcd, 0x0e, 0x7b, 0xfe, 0x9d, 0x02, 0xcf, 0xb5, 0xdf, 0xb5, 0x20, 0x01, 0x5d, 0xd1, 0x2f, 0x63,
+	0xf4, 0x44, 0x91, 0xd8, 0xf4, 0x07, 0xb0, 0x4c, 0x35, 0x59, 0x3e, 0x19, 0x8a, 0x68, 0x7e, 0x81,
+	0x70, 0x5f, 0x2c, 0xde, 0x36, 0x6b, 0x54, 0x50, 0xd4, 0x79, 0x13, 0x71, 0x7b, 0x50, 0xd4, 0x59,
+	0x8b, 0x57, 0x70, 0xad, 0x1f, 0x57, 0x2c, 0xa4, 0xad, 0xa0, 0x73, 0x0f, 0x6e, 0x61, 0x48, 0x42,
+	0x7b, 0xd1, 0x57, 0x35, 0xb8, 0x90, 0x84, 0xdd, 0x35, 0x0d, 0xfa, 0x26, 0xa5, 0x97, 0x3b, 0xa5,
+	0xda, 0x80, 0xd9, 0x76, 0xfa, 0x14, 0x4c, 0xc8, 0x4f, 0x6a, 0x4a, 0xca, 0x93, 0x5a, 0x4f, 0xe3,
+	0x93, 0x9a, 0x7a, 0x0f, 0xce, 0x89, 0xcb, 0x9e, 0x2e, 0xe1, 0x9e, 0x35, 0x61, 0x3c, 0xf2, 0xd8,
+	0x49, 0x06, 0xa1, 0x97, 0xbe, 0xd4, 0x66, 0xbf, 0x40, 0x46, 0x60, 0x70, 0xed, 0xce, 0xf5, 0xf5,
+	0xed, 0xb7, 0x56, 0x96, 0xb2, 0x0a, 0x19, 0x82, 0xbe, 0xc5, 0x2f, 0x6d, 0x6b, 0xab, 0xd9, 0x1e,
+	0x32, 0x0e, 0xc3, 0x5b, 0x37, 0xd7, 0xee, 0xdc, 0xd8, 0x5c, 0xba, 0xbb, 0xa8, 0xad, 0x64, 0x33,
+	0x64, 0x0c, 0x60, 0xe3, 0xed, 0xc2, 0xca, 0xea, 0x9b, 0x6b, 0xcb, 0xab, 0x9b, 0xd9, 0x5e, 0x5a,
+	0x5e, 0xbf, 0xab, 0x2d, 0x16, 0x96, 0xd7, 0xef, 0x6e, 0xaf, 0x64, 0xfb, 0x66, 0x5f, 0x83, 0x89,
+	0xa6, 0x17, 0x3c, 0xd2, 0x0f, 0x3d, 0x77, 0x36, 0x71, 0x98, 0x3e, 0x50, 0xb6, 0xb1, 0x7f, 0x2c,
+	0x6e, 0x6c, 0x62, 0xe7, 0x58, 0xdc, 0xc4, 0x2e, 0xf1, 0xcf, 0x06, 0xf6, 0x84, 0x7f, 0x6e, 0x66,
+	0xfb, 0x2e, 0xfd, 0xf5, 0x0a, 0x10, 0xc9, 0x27, 0x36, 0x79, 0x62, 0x1a, 0x31, 0xa1, 0x9f, 0xdf,
+	0xe6, 0x90, 0xe3, 0xcc, 0xa3, 0x92, 0xd2, 0xcf, 0xf2, 0x27, 0x92, 0xc4, 0x7c, 0x45, 0xd4, 0x63,
+	0xdf, 0xfc, 0xf3, 0x3f, 0x3e, 0xea, 0x99, 0x56, 0x27, 0x78, 0x26, 0x74, 0x5d, 0xc3, 0xbb, 0xaa,
+	0xcc, 0x92, 0x77, 0x21, 0x83, 0x87, 0x41, 0xc2, 0x73, 0x4f, 0x62, 0xb3, 0xcc, 0xf2, 0x47, 0x63,
+	0x65, 0xa2, 0xf7, 0x13, 0xac, 0xf7, 0x1c, 0x99, 0x6e, 0xea, 0x7d, 0xe1, 0xab, 0x96, 0xf1, 0x01,
+	0xb1, 0xa1, 0x9f, 0xdf, 0xc4, 0x88, 0x69, 0x24, 0xa5, 0x87, 0xe5, 0xa7, 0x9b, 0x2c, 0x7c, 0x95,
+	0x66, 0x5c, 0xab, 0x73, 0x6c, 0x80, 0x73, 0x79, 0x35, 0x66, 0x00, 0x39, 0xf3, 0x1b, 0x07, 0xa3,
+	0xf3, 0x29, 0x40, 0x3f, 0xb7, 0x23, 0x31, 0x5e, 0x52, 0x06, 0x58, 0xe2, 0x78, 0x62, 0x42, 0xb3,
+	0x49, 0x13, 0x2a, 0xc3, 0x80, 0x48, 0x5f, 0x22, 0x9c, 0xf9, 0xc4, 0xbc, 0xb1, 0xc4, 0x21, 0x2e,
+	0xb0, 0x21, 0x4e, 0xab, 0x27, 0xe2, 0x87, 0x58, 0x10, 0x59, 0x53, 0x74, 0x3a, 0x2e, 0x0c, 0x85,
+	0xa9, 0x66, 0x64, 0x86, 0x33, 0x98, 0x9c, 0x7a, 0x96, 0x38, 0xe2, 0xb3, 0x6c, 0xc4, 0x33, 0xea,
+	0x4c, 0xc2, 0x88, 0x55, 0x5b, 0x1a, 0xb3, 0x06, 0x23, 0x9b, 0xa6, 0x1f, 0x26, 0x9c, 0x91, 0xd3,
+	0x6c, 0xd8, 0xf4, 0x14, 0xb6, 0xc4, 0x91, 0x2f, 0xb2, 0x91, 0xcf, 0xaa, 0xa7, 0x12, 0x46, 0x66,
+	0x99, 0xc0, 0x73, 0x34, 0x37, 0x98, 0x0e, 0xfd, 0x04, 0xc6, 0xd8, 0x1e, 0x51, 0x1f, 0xfc, 0x0c,
+	0xb7, 0xee, 0x16, 0xd9, 0x70, 0xad, 0xa8, 0x9e, 0x6d, 0x3d, 0x3c, 0x79, 0x07, 0x7a, 0xe9, 0x76,
+	0x47, 0xb8, 0xb9, 0xc7, 0xa7, 0xd2, 0xe5, 0x8f, 0xc5, 0x0b, 0x85, 0x33, 0x1c, 0x61, 0xa3, 0x4d,
+	0x92, 0x66, 0x57, 0x23, 0x3f, 0x55, 0xe0, 0x50, 0x6c, 0xd6, 0x0d, 0x39, 0x25, 0xf9, 0x6f, 0x7c,
+	0x1e, 0x49, 0xe2, 0xec, 0x6e, 0xb3, 0xf1, 0x56, 0xd5, 0xd7, 0xe3, 0x66, 0x57, 0xef, 0x66, 0xbe,
+	0x31, 0x5c, 0x7e, 0xb0, 0x20, 0x27, 0xac, 0x2f, 0xdc, 0xf7, 0xfd, 0x0a, 0xe5, 0xfe, 0x23, 0xfc,
+	0x9c, 0x6d, 0xce, 0xbd, 0x11, 0x46, 0x9e, 0x98, 0xd8, 0x93, 0x3f, 0x99, 0x28, 0x17, 0xa4, 0x7c,
+	0x91, 0x81, 0x7c, 0x91, 0x5c, 0x4e, 0x77, 0xe0, 0x78, 0x60, 0x8c, 0xb7, 0xd8, 0xdc, 0x1d, 0xc1,
+	0x5b, 0x5a, 0x5e, 0x4f, 0x2b, 0xde, 0xf2, 0x5d, 0xe1, 0xed, 0x07, 0x88, 0x30, 0x36, 0x0b, 0x48,
+	0x20, 0x4c, 0xcb, 0x10, 0x4a, 0x44, 0x28, 0x48, 0x9b, 0xdd, 0x1f, 0x69, 0xbf, 0x56, 0x82, 0xf4,
+	0xe2, 0xd8, 0x44, 0x1a, 0xc9, 0xe0, 0x92, 0x53, 0x0c, 0x12, 0xa1, 0xdd, 0x65, 0xd0, 0xd6, 0xd4,
+	0x95, 0x83, 0x90, 0x67, 0xb1, 0x71, 0x8d, 0x1d, 0x4a, 0xe0, 0xcf, 0x15, 0x96, 0xb6, 0x1c, 0x07,
+	0x55, 0x0d, 0x8c, 0x2b, 0x05, 0xe7, 0xe9, 0x54, 0x1d, 0x61, 0x84, 0xaf, 0x33, 0xd0, 0x57, 0xc9,
+	0xcb, 0x9d, 0xf2, 0x19, 0x00, 0x65, 0x9c, 0x26, 0xa6, 0x7d, 0x08, 0x4e, 0x5b, 0xa5, 0x85, 0xb4,
+	0xe2, 0x34, 0xdf, 0x35, 0x4e, 0x7f, 0x82, 0x68, 0x13, 0x93, 0x48, 0x04, 0xda, 0x56, 0x49, 0x26,
+	0x89, 0x68, 0x05, 0x99, 0xb3, 0xfb, 0x27, 0xf3, 0x67, 0xb8, 0xe4, 0xf1, 0xa9, 0x1c, 0x62, 0xc9,
+	0x53, 0xf3, 0x3c, 0x12, 0x81, 0xad, 0x33, 0x60, 0xd7, 0xd5, 0xc5, 0x83, 0xd0, 0xa8, 0xd3, 0x41,
+	0x29, 0x87, 0x3f, 0x52, 0x60, 0x32, 0x26, 0xa1, 0x83, 0x84, 0x11, 0x2f, 0x09, 0xde, 0x4c, 0xb2,
+	0x82, 0x30, 0xc7, 0x57, 0x19, 0xd0, 0x97, 0xc8, 0x95, 0x4e, 0x19, 0x64, 0xe0, 0x18, 0x7d, 0xf1,
+	0x29, 0x21, 0x82, 0xbe, 0xd4, 0x7c, 0x91, 0x56, 0xf4, 0xe5, 0xbb, 0x43, 0x1f, 0xee, 0x27, 0xd3,
+	0xf1, 0xd9, 0x25, 0x02, 0x64, 0x6a, 0xea, 0x49, 0x22, 0x48, 0x41, 0xdd, 0xec, 0x3e, 0xa9, 0xfb,
+	0x4c, 0x09, 0xb2, 0x5f, 0x13, 0x12, 0x56, 0xce, 0x4b, 0xf6, 0x97, 0x9a, 0xc4, 0x90, 0x88, 0x50,
+	0x63, 0x08, 0xd7, 0xd5, 0x1b, 0x07, 0xa1, 0xd1, 0x67, 0x43, 0xef, 0xd0, 0xa1, 0x29, 0x99, 0xbf,
+	0x55, 0x58, 0x72, 0x6d, 0x52, 0x92, 0x4d, 0x60, 0x70, 0xe9, 0x80, 0xcf, 0xb6, 0x52, 0x13, 0xd6,
+	0xb9, 0xcc, 0x26, 0xf0, 0x2a, 0xb9, 0xd6, 0x29, 0xc5, 0x12, 0x68, 0x46, 0x74, 0x5a, 0xc2, 0x89,
+	0x20, 0xba, 0x8d, 0x9c, 0x94, 0x56, 0x44, 0xe7, 0xbb, 0x49, 0xf4, 0x2f, 0x95, 0x20, 0xa7, 0x37,
+	0x15, 0x76, 0x1b, 0x49, 0x2e, 0x89, 0xb0, 0x05, 0xbd, 0xb3, 0x07, 0xa2, 0xf7, 0x37, 0x0a, 0xe4,
+	0x93, 0x53, 0x51, 0xc8, 0x59, 0xc9, 0x8a, 0x53, 0xd2, 0x27, 0x12, 0x31, 0xde, 0x63, 0x18, 0x6f,
+	0xa9, 0xab, 0x07, 0xa1, 0x76, 0xaf, 0x26, 0x6e, 0xdf, 0x29, 0xb1, 0xbf, 0x52, 0xe0, 0x70, 0x42,
+	0x42, 0x0a, 0x09, 0xb7, 0xf0, 0x34, 0xa8, 0xcf, 0xa4, 0x2b, 0x09, 0xdb, 0x5d, 0x64, 0xc0, 0xaf,
+	0x91, 0x57, 0x3a, 0x25, 0x37, 0x04, 0xcb, 0xa8, 0x4d, 0x4e, 0x6d, 0x11, 0xd4, 0xb6, 0xcc, 0x7d,
+	0x69, 0x45, 0x6d, 0xbe, 0x7b, 0xd4, 0xe2, 0x19, 0x39, 0x9f, 0x9c, 0x29, 0x23, 0x00, 0xb7, 0x4c,
+	0xa5, 0x49, 0x04, 0x2c, 0x28, 0x9d, 0x3d, 0x20, 0xa5, 0xc9, 0xb9, 0x29, 0x0d, 0xd6, 0x9a, 0x92,
+	0x4f, 0xf1, 0x74, 0xad, 0xb5, 0xec, 0xb8, 0x7a, 0x91, 0x0e, 0x2c, 0x59, 0x6b, 0x2c, 0xda, 0xd0,
+	0x5a, 0xd3, 0xa0, 0x3e, 0x93, 0xae, 0x74, 0x50, 0x6b, 0x0d, 0xc1, 0x4a, 0xd6, 0x9a, 0x42, 0x6d,
+	0xcb, 0x64, 0x98, 0xa7, 0x6b, 0xad, 0x0d, 0xd4, 0xd6, 0xad, 0x35, 0x05, 0x70, 0xcb, 0xdc, 0x9a,
+	0xee, 0x5b, 0x6b, 0x9d, 0xd2, 0xef, 0x28, 0x90, 0x8d, 0xfc, 0xc3, 0x83, 0x27, 0x5d, 0x0b, 0xc4,
+	0x80, 0x39, 0x16, 0x2f, 0x14, 0xab, 0xfc, 0x12, 0x83, 0xf4, 0x3c, 0x59, 0xe8, 0x10, 0x12, 0xf9,
+	0x58, 0x81, 0x31, 0x34, 0x21, 0x39, 0x09, 0xe5, 0x4c, 0xcc, 0x65, 0x5c, 0x73, 0xb6, 0x50, 0x7d,
+	0xab, 0x4f, 0x4f, 0xe1, 0xe8, 0x08, 0x1a, 0xcf, 0xeb, 0x98, 0xf3, 0x18, 0x8e, 0x5f, 0x28, 0x30,
+	0x81, 0xdd, 0x37, 0x3e, 0x1d, 0x8b, 0xcd, 0xb1, 0x8d, 0x7c, 0x86, 0xfc, 0x85, 0x36, 0x34, 0x05,
+	0xc6, 0xab, 0x0c, 0xe3, 0x65, 0x72, 0xa9, 0x0d, 0x8c, 0xc1, 0x6b, 0xf2, 0x9c, 0xcb, 0x01, 0xfd,
+	0x58, 0x81, 0x71, 0xba, 0x2c, 0xd2, 0xa3, 0xa0, 0xb0, 0xb0, 0x96, 0xaf, 0xc1, 0xf9, 0x73, 0x2d,
+	0xf5, 0xf6, 0x41, 0x62, 0x08, 0xb0, 0x8c, 0x48, 0xf0, 0x9b, 0xf2, 0x10, 0xed, 0xbf, 0xe9, 0xa9,
+	0x8b, 0x5c, 0x8c, 0x1b, 0x3b, 0xe9, 0x45, 0x2c, 0x3f, 0xd7, 0xa6, 0xb6, 0xc0, 0x7b, 0x85, 0xe1,
+	0x5d, 0x20, 0x73, 0x6d, 0xe0, 0x7d, 0x18, 0xf6, 0x42, 0x7e, 0x48, 0x3f, 0xda, 0xe8, 0x45, 0x5c,
+	0x33, 0xdc, 0xb9, 0xd8, 0x5b, 0xba, 0x44, 0xbc, 0x49, 0xbe, 0x2b, 0x80, 0xcd, 0x76, 0x08, 0xac,
+	0xbe, 0xc8, 0xe1, 0x73, 0x52, 0xd2, 0x22, 0x47, 0xdf, 0x9b, 0x92, 0x16, 0xb9, 0xe9, 0x9d, 0xab,
+	0xc3, 0x45, 0xd6, 0x8d, 0xb9, 0xb2, 0x40, 0xf2, 0x21, 0x46, 0x13, 0xc6, 0x8c, 0x0c, 0xef, 0x5c,
+	0x2c, 0x61, 0x31, 0xf8, 0x92, 0xa8, 0x12, 0x70, 0x66, 0x3b, 0x86, 0xf3, 0xb9, 0x02, 0xe7, 0xdb,
+	0x7d, 0xdf, 0x22, 0x97, 0x85, 0x97, 0x76, 0xf4, 0xd2, 0x96, 0xbf, 0xd2, 0x61, 0x2b, 0xc1, 0xf0,
+	0x4d, 0x36, 0xa5, 0xa5, 0xd8, 0xdb, 0xcc, 0xf4, 0x73, 0xc6, 0x43, 0xdf, 0x5f, 0x28, 0x4a, 0xb0,
+	0xff, 0xa4, 0x80, 0xda, 0xfa, 0xcd, 0x8a, 0xcc, 0xa7, 0xe2, 0x6c, 0x7a, 0x78, 0xca, 0x2f, 0xb4,
+	0xad, 0xdf, 0x9d, 0x19, 0x49, 0x50, 0x3f, 0x55, 0x82, 0x7f, 0x0a, 0x4a, 0x99, 0xcf, 0x45, 0xf9,
+	0xa0, 0xd7, 0x72, 0x36, 0x49, 0x96, 0x25, 0x40, 0xcf, 0x1e, 0x18, 0xf4, 0x4e, 0x3f, 0xeb, 0xf9,
+	0x85, 0xff, 0x00, 0x55, 0x31, 0xa3, 0x4f, 0xa2, 0x47, 0x00, 0x00,
 }
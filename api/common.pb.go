@@ -53,7 +53,10 @@ type UplinkFrameLog struct {
 	// RX information of the uplink.
 	RxInfo []*UplinkRXInfo `protobuf:"bytes,2,rep,name=rx_info,json=rxInfo,proto3" json:"rx_info,omitempty"`
 	// LoRaWAN PHYPayload.
-	PhyPayloadJson       string   `protobuf:"bytes,3,opt,name=phy_payload_json,json=phyPayloadJSON,proto3" json:"phy_payload_json,omitempty"`
+	// This is not set when the PHYPayload could not be decoded.
+	PhyPayloadJson string `protobuf:"bytes,3,opt,name=phy_payload_json,json=phyPayloadJSON,proto3" json:"phy_payload_json,omitempty"`
+	// Raw (undecoded) PHYPayload.
+	PhyPayload           []byte   `protobuf:"bytes,4,opt,name=phy_payload,json=phyPayload,proto3" json:"phy_payload,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -104,11 +107,21 @@ func (m *UplinkFrameLog) GetPhyPayloadJson() string {
 	return ""
 }
 
+func (m *UplinkFrameLog) GetPhyPayload() []byte {
+	if m != nil {
+		return m.PhyPayload
+	}
+	return nil
+}
+
 type DownlinkFrameLog struct {
 	// TX information of the downlink.
 	TxInfo *DownlinkTXInfo `protobuf:"bytes,1,opt,name=tx_info,json=txInfo,proto3" json:"tx_info,omitempty"`
 	// LoRaWAN PHYPayload.
-	PhyPayloadJson       string   `protobuf:"bytes,2,opt,name=phy_payload_json,json=phyPayloadJSON,proto3" json:"phy_payload_json,omitempty"`
+	// This is not set when the PHYPayload could not be decoded.
+	PhyPayloadJson string `protobuf:"bytes,2,opt,name=phy_payload_json,json=phyPayloadJSON,proto3" json:"phy_payload_json,omitempty"`
+	// Raw (undecoded) PHYPayload.
+	PhyPayload           []byte   `protobuf:"bytes,3,opt,name=phy_payload,json=phyPayload,proto3" json:"phy_payload,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -152,6 +165,13 @@ func (m *DownlinkFrameLog) GetPhyPayloadJson() string {
 	return ""
 }
 
+func (m *DownlinkFrameLog) GetPhyPayload() []byte {
+	if m != nil {
+		return m.PhyPayload
+	}
+	return nil
+}
+
 // This is a copy of gw.UplinkRXInfo with the only change that the
 // gateway_id is of type string so that we can return it as HEX encoded
 // instead of base64.
@@ -709,55 +729,56 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor_555bd8c177793206) }
 
 var fileDescriptor_555bd8c177793206 = []byte{
-	// 795 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x51, 0x73, 0xda, 0x46,
-	0x10, 0x8e, 0x82, 0x31, 0xb0, 0x02, 0x0a, 0xe7, 0xd4, 0x55, 0xa8, 0xdb, 0x52, 0x9e, 0x68, 0x26,
-	0x15, 0x53, 0x3a, 0xfd, 0x03, 0xa9, 0x9d, 0xd4, 0x89, 0x9b, 0x66, 0x0e, 0x77, 0xe2, 0x37, 0xcd,
-	0x21, 0x9d, 0xc4, 0xc5, 0xe8, 0xee, 0x7a, 0x12, 0xc5, 0xfa, 0x05, 0x7d, 0xee, 0xef, 0xed, 0x4b,
-	0xe7, 0xee, 0x04, 0x18, 0x99, 0x8e, 0xdf, 0xfa, 0x24, 0xed, 0x77, 0xdf, 0x7e, 0xbb, 0xa7, 0xfd,
-	0x56, 0xd0, 0x0e, 0x45, 0x9a, 0x0a, 0xee, 0x4b, 0x25, 0x72, 0x81, 0x6a, 0x44, 0xb2, 0xc1, 0x37,
-	0x89, 0x10, 0xc9, 0x92, 0x4e, 0x0c, 0x34, 0x5f, 0xc5, 0x93, 0x9c, 0xa5, 0x34, 0xcb, 0x49, 0x2a,
-	0x2d, 0x6b, 0xf0, 0x75, 0x95, 0x10, 0xad, 0x14, 0xc9, 0xd9, 0x46, 0x65, 0xf0, 0x53, 0xc2, 0xf2,
-	0xc5, 0x6a, 0xee, 0x87, 0x22, 0x9d, 0xcc, 0x95, 0x08, 0x09, 0x51, 0x93, 0xa5, 0x50, 0x24, 0xa3,
-	0xea, 0x4f, 0xaa, 0x26, 0x44, 0xb2, 0x89, 0xad, 0x3a, 0xb9, 0x5f, 0x7c, 0xf0, 0xfd, 0xe3, 0x69,
-	0xc9, 0x7a, 0x92, 0xac, 0x2d, 0x7d, 0xf4, 0xb7, 0x03, 0xdd, 0xdf, 0xe5, 0x92, 0xf1, 0xdb, 0xd7,
-	0x8a, 0xa4, 0xf4, 0x4a, 0x24, 0xe8, 0x3b, 0x68, 0xe4, 0x77, 0x01, 0xe3, 0xb1, 0xf0, 0x9c, 0xa1,
-	0x33, 0x76, 0xa7, 0x3d, 0x3f, 0x59, 0xfb, 0x96, 0x74, 0x7d, 0x73, 0xc9, 0x63, 0x81, 0x8f, 0xf3,
-	0x3b, 0xfd, 0x44, 0x2f, 0xa0, 0xa1, 0x4a, 0xea, 0xd3, 0x61, 0x6d, 0xec, 0x4e, 0xfb, 0x3e, 0x91,
-	0xac, 0xe4, 0xe2, 0x92, 0xab, 0x2c, 0x77, 0x0c, 0x3d, 0xb9, 0x28, 0x02, 0x49, 0x8a, 0xa5, 0x20,
-	0x51, 0xf0, 0x29, 0x13, 0xdc, 0xab, 0x0d, 0x9d, 0x71, 0x0b, 0x77, 0xe5, 0xa2, 0xf8, 0x60, 0xe1,
-	0xb7, 0xb3, 0xdf, 0xde, 0x8f, 0x3e, 0x41, 0xef, 0x5c, 0xac, 0xf9, 0x5e, 0x53, 0x2f, 0xab, 0x4d,
-	0x9d, 0x98, 0x4a, 0x1b, 0x5e, 0xa5, 0xaf, 0x43, 0xb5, 0x9e, 0x1e, 0xac, 0xf5, 0x57, 0x1d, 0xda,
-	0xf7, 0xdb, 0x45, 0x5f, 0x01, 0x24, 0x24, 0xa7, 0x6b, 0x52, 0x04, 0x2c, 0x32, 0xb5, 0x5a, 0xb8,
-	0x55, 0x22, 0x97, 0x11, 0xf2, 0xe1, 0x48, 0x0f, 0xd2, 0xa8, 0xb9, 0xd3, 0x81, 0x6f, 0x87, 0xe8,
-	0x6f, 0x86, 0xe8, 0x5f, 0x6f, 0xa6, 0x8c, 0x0d, 0x0f, 0xbd, 0x85, 0x67, 0xfa, 0x19, 0x64, 0x8c,
-	0x87, 0x34, 0x48, 0x64, 0x16, 0x50, 0x29, 0xc2, 0x85, 0xb9, 0xb9, 0x3b, 0x7d, 0xfe, 0x20, 0xff,
-	0xbc, 0x34, 0x01, 0xee, 0xeb, 0xb4, 0x99, 0xce, 0x7a, 0x23, 0xb3, 0x0b, 0x9d, 0x83, 0xce, 0xa0,
-	0xb5, 0x35, 0x91, 0x77, 0x34, 0x74, 0xc6, 0x1d, 0xbc, 0x03, 0x10, 0x82, 0x23, 0x95, 0x65, 0xcc,
-	0xab, 0x0f, 0x9d, 0x71, 0x1d, 0x9b, 0x77, 0xf4, 0x1c, 0x9a, 0x7a, 0xf6, 0x41, 0xc6, 0x95, 0x77,
-	0x3c, 0x74, 0xc6, 0x0e, 0x6e, 0xe8, 0x78, 0xc6, 0x15, 0xf2, 0xa0, 0x11, 0x2e, 0x08, 0xe7, 0x74,
-	0xe9, 0x35, 0x8c, 0xd4, 0x26, 0xd4, 0x49, 0x2a, 0x0e, 0xc2, 0x05, 0x61, 0xdc, 0x6b, 0xda, 0x23,
-	0x15, 0xff, 0xac, 0x43, 0xf4, 0x0c, 0xea, 0x73, 0x41, 0x54, 0xe4, 0xb5, 0x0c, 0x6e, 0x03, 0x2d,
-	0x45, 0x78, 0x4e, 0x39, 0x27, 0x1e, 0x58, 0x7e, 0x19, 0xa2, 0x97, 0xba, 0x7e, 0x68, 0x2e, 0xe4,
-	0xb9, 0xa5, 0x97, 0x4a, 0xb7, 0x5e, 0x95, 0x38, 0xde, 0x32, 0xd0, 0x05, 0x9c, 0xc4, 0x8c, 0xd3,
-	0x60, 0x7b, 0xa7, 0x20, 0x2f, 0x24, 0xf5, 0xda, 0x43, 0x67, 0xdc, 0x9d, 0x7e, 0xae, 0x4d, 0xf8,
-	0x9a, 0x71, 0xba, 0xfd, 0xc2, 0xd7, 0x85, 0xa4, 0xb8, 0x1f, 0x57, 0x21, 0xf4, 0x11, 0x3c, 0xca,
-	0x43, 0x55, 0xc8, 0x9c, 0x46, 0xc1, 0xbe, 0xa0, 0xd7, 0x31, 0x4d, 0x7c, 0x69, 0xbc, 0x73, 0xb1,
-	0x21, 0xed, 0xa9, 0xfe, 0xf2, 0x04, 0x9f, 0xd2, 0x83, 0x27, 0x7a, 0x96, 0x72, 0x49, 0x18, 0xaf,
-	0x8a, 0x76, 0x8d, 0xe8, 0xa9, 0x6e, 0xf0, 0x83, 0x3e, 0xaf, 0xea, 0x21, 0xf9, 0x00, 0x7d, 0xd5,
-	0x83, 0xee, 0xbe, 0xca, 0xe8, 0x0e, 0x4e, 0x0f, 0x77, 0x84, 0x46, 0xd0, 0x21, 0x34, 0x0b, 0x6e,
-	0x69, 0x11, 0x30, 0x1e, 0xd1, 0x3b, 0xe3, 0xca, 0x0e, 0x76, 0x09, 0xcd, 0xde, 0xd1, 0xe2, 0x52,
-	0x43, 0xe8, 0x5b, 0x68, 0xef, 0x2e, 0xcd, 0x33, 0xe3, 0xcf, 0x36, 0x76, 0xb7, 0xd8, 0xfb, 0x19,
-	0xfa, 0x02, 0x1a, 0xb1, 0x4c, 0x88, 0xb6, 0xb5, 0xdd, 0xbb, 0x63, 0x1d, 0x5e, 0x9e, 0x8f, 0xfe,
-	0xa9, 0x41, 0x77, 0x7f, 0x91, 0x1e, 0xdb, 0x82, 0x21, 0xb8, 0x2c, 0x4d, 0x69, 0xc4, 0x48, 0x4e,
-	0x97, 0x85, 0x29, 0xd6, 0xc4, 0xf7, 0xa1, 0xff, 0xd1, 0xf7, 0x67, 0xd0, 0x8a, 0x15, 0xfd, 0x63,
-	0x45, 0x79, 0x58, 0x18, 0xf3, 0x77, 0xf0, 0x0e, 0xd0, 0x8e, 0x95, 0x62, 0x4d, 0xad, 0xfd, 0xeb,
-	0xd8, 0x06, 0x68, 0x0a, 0x90, 0x8a, 0x68, 0xb5, 0xb4, 0xce, 0x6c, 0x18, 0x83, 0xa1, 0x8d, 0x33,
-	0x7f, 0xdd, 0x9e, 0xe0, 0x7b, 0x2c, 0x7d, 0x23, 0xb3, 0x4b, 0x3b, 0xc8, 0xfe, 0x8e, 0x9a, 0xbb,
-	0xe9, 0x5f, 0x09, 0x4c, 0x76, 0xd9, 0xfa, 0x43, 0xea, 0xe9, 0xeb, 0xac, 0x7d, 0x14, 0xbd, 0x81,
-	0x93, 0x38, 0xbb, 0x7d, 0x20, 0xd5, 0x32, 0x52, 0xd6, 0xe9, 0xb3, 0x77, 0x0f, 0x94, 0xfa, 0x71,
-	0x76, 0x5b, 0x11, 0xda, 0x2e, 0x24, 0xfc, 0xc7, 0x42, 0xba, 0x7b, 0x0b, 0xf9, 0xaa, 0x0f, 0x9f,
-	0x55, 0x8a, 0xbe, 0x38, 0x83, 0x26, 0xbe, 0xf9, 0xc8, 0x78, 0x24, 0xd6, 0xa8, 0x01, 0x35, 0x7c,
-	0xf3, 0x43, 0xef, 0x89, 0x7d, 0x99, 0xf6, 0x9c, 0xf9, 0xb1, 0x99, 0xd0, 0x8f, 0xff, 0x06, 0x00,
-	0x00, 0xff, 0xff, 0x1b, 0x68, 0xe9, 0x51, 0xe2, 0x06, 0x00, 0x00,
+	// 809 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xdd, 0x92, 0xdb, 0x34,
+	0x18, 0xad, 0x9b, 0xcd, 0x26, 0xf9, 0x9c, 0x84, 0x44, 0x5b, 0x16, 0x37, 0x2c, 0x34, 0xe4, 0x2a,
+	0x74, 0x8a, 0x33, 0x84, 0xe1, 0x05, 0xca, 0x6e, 0xcb, 0xb6, 0x4b, 0xe9, 0x28, 0xcb, 0x74, 0xef,
+	0x3c, 0x8a, 0x2d, 0x3b, 0x62, 0x63, 0x49, 0xc8, 0x0e, 0x89, 0x9f, 0x80, 0x0b, 0xde, 0x86, 0xd7,
+	0xe3, 0x86, 0x91, 0xe4, 0xfc, 0x2f, 0x53, 0xae, 0xb8, 0x8a, 0xbf, 0xa3, 0xf3, 0x1d, 0x1d, 0x49,
+	0xe7, 0x0b, 0x34, 0x43, 0x91, 0xa6, 0x82, 0xfb, 0x52, 0x89, 0x5c, 0xa0, 0x0a, 0x91, 0xac, 0xf7,
+	0x2c, 0x11, 0x22, 0x99, 0xd3, 0x91, 0x81, 0xa6, 0x8b, 0x78, 0x94, 0xb3, 0x94, 0x66, 0x39, 0x49,
+	0xa5, 0x65, 0xf5, 0xbe, 0x3c, 0x24, 0x44, 0x0b, 0x45, 0x72, 0xb6, 0x56, 0xe9, 0x7d, 0x9f, 0xb0,
+	0x7c, 0xb6, 0x98, 0xfa, 0xa1, 0x48, 0x47, 0x53, 0x25, 0x42, 0x42, 0xd4, 0x68, 0x2e, 0x14, 0xc9,
+	0xa8, 0xfa, 0x9d, 0xaa, 0x11, 0x91, 0x6c, 0x64, 0x77, 0x1d, 0xed, 0x6e, 0xde, 0xfb, 0xe6, 0xe3,
+	0x6d, 0xc9, 0x72, 0x94, 0x2c, 0x2d, 0x7d, 0xf0, 0x97, 0x03, 0xed, 0x5f, 0xe4, 0x9c, 0xf1, 0xfb,
+	0x57, 0x8a, 0xa4, 0xf4, 0x46, 0x24, 0xe8, 0x6b, 0xa8, 0xe5, 0xab, 0x80, 0xf1, 0x58, 0x78, 0x4e,
+	0xdf, 0x19, 0xba, 0xe3, 0x8e, 0x9f, 0x2c, 0x7d, 0x4b, 0xba, 0xbd, 0xbb, 0xe6, 0xb1, 0xc0, 0xa7,
+	0xf9, 0x4a, 0xff, 0xa2, 0xe7, 0x50, 0x53, 0x25, 0xf5, 0x71, 0xbf, 0x32, 0x74, 0xc7, 0x5d, 0x9f,
+	0x48, 0x56, 0x72, 0x71, 0xc9, 0x55, 0x96, 0x3b, 0x84, 0x8e, 0x9c, 0x15, 0x81, 0x24, 0xc5, 0x5c,
+	0x90, 0x28, 0xf8, 0x35, 0x13, 0xdc, 0xab, 0xf4, 0x9d, 0x61, 0x03, 0xb7, 0xe5, 0xac, 0x78, 0x6f,
+	0xe1, 0x37, 0x93, 0x9f, 0xdf, 0xa1, 0x67, 0xe0, 0xee, 0x30, 0xbd, 0x93, 0xbe, 0x33, 0x6c, 0x62,
+	0xd8, 0x92, 0x06, 0x7f, 0x3a, 0xd0, 0xb9, 0x14, 0x4b, 0xbe, 0x67, 0xfb, 0xc5, 0xa1, 0xed, 0x33,
+	0xe3, 0x65, 0xcd, 0x3b, 0x70, 0xfe, 0x90, 0x9b, 0xc7, 0xff, 0xc5, 0x4d, 0xe5, 0xc8, 0xcd, 0x1f,
+	0x55, 0x68, 0xee, 0x9e, 0x18, 0x7d, 0x01, 0x90, 0x90, 0x9c, 0x2e, 0x49, 0x11, 0xb0, 0xc8, 0x98,
+	0x69, 0xe0, 0x46, 0x89, 0x5c, 0x47, 0xc8, 0x87, 0x13, 0x9d, 0x05, 0xb3, 0x9d, 0x3b, 0xee, 0xf9,
+	0x36, 0x07, 0xfe, 0x3a, 0x07, 0xfe, 0xed, 0x3a, 0x28, 0xd8, 0xf0, 0xd0, 0x1b, 0x78, 0xa2, 0x7f,
+	0x83, 0x8c, 0xf1, 0x90, 0x06, 0x89, 0xcc, 0x02, 0x2a, 0x45, 0x38, 0x33, 0x4e, 0xdc, 0xf1, 0xd3,
+	0xa3, 0xfe, 0xcb, 0x32, 0x47, 0xb8, 0xab, 0xdb, 0x26, 0xba, 0xeb, 0xb5, 0xcc, 0xae, 0x74, 0x0f,
+	0xba, 0x80, 0xc6, 0x26, 0x87, 0xe6, 0x62, 0x5b, 0x78, 0x0b, 0x20, 0x04, 0x27, 0x2a, 0xcb, 0x98,
+	0x57, 0xed, 0x3b, 0xc3, 0x2a, 0x36, 0xdf, 0xe8, 0x29, 0xd4, 0x75, 0x7c, 0x82, 0x8c, 0x2b, 0xef,
+	0xb4, 0xef, 0x0c, 0x1d, 0x5c, 0xd3, 0xf5, 0x84, 0x2b, 0xe4, 0x41, 0x2d, 0x9c, 0x11, 0xce, 0xe9,
+	0xdc, 0xab, 0x19, 0xa9, 0x75, 0xa9, 0x9b, 0x54, 0x1c, 0x84, 0x33, 0xc2, 0xb8, 0x57, 0xb7, 0x4b,
+	0x2a, 0xfe, 0x41, 0x97, 0xe8, 0x09, 0x54, 0xa7, 0x82, 0xa8, 0xc8, 0x6b, 0x18, 0xdc, 0x16, 0x5a,
+	0x8a, 0xf0, 0x9c, 0x72, 0x4e, 0x3c, 0xb0, 0xfc, 0xb2, 0x44, 0x2f, 0xf4, 0xfe, 0xa1, 0x39, 0x90,
+	0xe7, 0x96, 0x71, 0x2c, 0x03, 0x7f, 0x53, 0xe2, 0x78, 0xc3, 0x40, 0x57, 0x70, 0x16, 0x33, 0x4e,
+	0x83, 0xcd, 0x99, 0x82, 0xbc, 0x90, 0xd4, 0x6b, 0xf6, 0x9d, 0x61, 0x7b, 0xfc, 0xa9, 0xce, 0xf1,
+	0x2b, 0xc6, 0xe9, 0xe6, 0x86, 0x6f, 0x0b, 0x49, 0x71, 0x37, 0x3e, 0x84, 0xd0, 0x07, 0xf0, 0x28,
+	0x0f, 0x55, 0x21, 0x73, 0x1a, 0x05, 0xfb, 0x82, 0x5e, 0xcb, 0x98, 0xf8, 0xdc, 0x84, 0xeb, 0x6a,
+	0x4d, 0xda, 0x53, 0xfd, 0xf1, 0x11, 0x3e, 0xa7, 0x0f, 0xae, 0xe8, 0xb7, 0x94, 0x73, 0xc2, 0xf8,
+	0xa1, 0x68, 0xdb, 0x88, 0x9e, 0x6b, 0x83, 0xef, 0xf5, 0xfa, 0xa1, 0x1e, 0x92, 0x47, 0xe8, 0xcb,
+	0x0e, 0xb4, 0xf7, 0x55, 0x06, 0x2b, 0x38, 0x7f, 0xd8, 0x11, 0x1a, 0x40, 0x8b, 0xd0, 0x2c, 0xb8,
+	0xa7, 0x45, 0xc0, 0x78, 0x44, 0x57, 0x26, 0x95, 0x2d, 0xec, 0x12, 0x9a, 0xbd, 0xa5, 0xc5, 0xb5,
+	0x86, 0xd0, 0x57, 0xd0, 0xdc, 0x1e, 0x9a, 0x67, 0x26, 0x9f, 0x4d, 0xec, 0x6e, 0xb0, 0x77, 0x13,
+	0xf4, 0x19, 0xd4, 0x62, 0x99, 0x10, 0x1d, 0x6b, 0x3b, 0xba, 0xa7, 0xba, 0xbc, 0xbe, 0x1c, 0xfc,
+	0x5d, 0x81, 0xf6, 0xfe, 0xa4, 0x7d, 0x6c, 0x0a, 0xfa, 0xe0, 0xb2, 0x34, 0xa5, 0x11, 0x23, 0x39,
+	0x9d, 0x17, 0x66, 0xb3, 0x3a, 0xde, 0x85, 0xfe, 0xc7, 0xdc, 0x5f, 0x40, 0x23, 0x56, 0xf4, 0xb7,
+	0x05, 0xe5, 0x61, 0x61, 0xc2, 0xdf, 0xc2, 0x5b, 0x40, 0x27, 0x56, 0x8a, 0x25, 0xb5, 0xf1, 0xaf,
+	0x62, 0x5b, 0xa0, 0x31, 0x40, 0x2a, 0xa2, 0xc5, 0xdc, 0x26, 0xb3, 0x66, 0x02, 0x86, 0xd6, 0xc9,
+	0xfc, 0x69, 0xb3, 0x82, 0x77, 0x58, 0xfa, 0x44, 0x66, 0x96, 0xb6, 0x90, 0xfd, 0xbf, 0xaa, 0x6f,
+	0x5f, 0xff, 0x46, 0x60, 0xb2, 0xed, 0xd6, 0x17, 0xa9, 0x5f, 0x5f, 0x77, 0xed, 0xa3, 0xe8, 0x35,
+	0x9c, 0xc5, 0xd9, 0xfd, 0x91, 0x54, 0xc3, 0x48, 0xd9, 0xa4, 0x4f, 0xde, 0x1e, 0x29, 0x75, 0xe3,
+	0xec, 0xfe, 0x40, 0x68, 0x33, 0x90, 0xf0, 0x2f, 0x03, 0xe9, 0xee, 0x0d, 0xe4, 0xcb, 0x2e, 0x7c,
+	0x72, 0xb0, 0xe9, 0xf3, 0x0b, 0xa8, 0xe3, 0xbb, 0x0f, 0x8c, 0x47, 0x62, 0x89, 0x6a, 0x50, 0xc1,
+	0x77, 0xdf, 0x76, 0x1e, 0xd9, 0x8f, 0x71, 0xc7, 0x99, 0x9e, 0x9a, 0x17, 0xfa, 0xee, 0x9f, 0x01,
+	0x00, 0xee, 0x27, 0x52, 0xcc, 0x25, 0x07, 0x00, 0x00,
 }
@@ -8,8 +8,10 @@ import fmt "fmt"
 import math "math"
 import common "github.com/brocaar/loraserver/api/common"
 import empty "github.com/golang/protobuf/ptypes/empty"
+import _struct "github.com/golang/protobuf/ptypes/struct"
 import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
+import field_mask "google.golang.org/genproto/protobuf/field_mask"
 
 import (
 	context "golang.org/x/net/context"
@@ -44,10 +46,20 @@ type Device struct {
 	// When using geolocation, this altitude will be used as a reference
 	// (when supported by the geolocation-server) to increase geolocation
 	// accuracy.
-	ReferenceAltitude    float64  `protobuf:"fixed64,7,opt,name=reference_altitude,json=referenceAltitude,proto3" json:"reference_altitude,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ReferenceAltitude float64 `protobuf:"fixed64,7,opt,name=reference_altitude,json=referenceAltitude,proto3" json:"reference_altitude,omitempty"`
+	// Suppress the frame-counter anomaly events of this device (e.g. for
+	// devices which are known to reset their frame-counter).
+	SuppressFCntAnomalies bool `protobuf:"varint,8,opt,name=suppress_f_cnt_anomalies,json=suppressFCntAnomalies,proto3" json:"suppress_f_cnt_anomalies,omitempty"`
+	// The device is under legal hold (read-only, see SetLegalHold and
+	// ClearLegalHold).
+	LegalHold bool `protobuf:"varint,9,opt,name=legal_hold,json=legalHold,proto3" json:"legal_hold,omitempty"`
+	// Device variables.
+	// These are user-defined key / value pairs, e.g. the access token used
+	// by the ThingsBoard integration (ThingsBoardAccessToken).
+	Variables            map[string]string `protobuf:"bytes,10,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *Device) Reset()         { *m = Device{} }
@@ -123,6 +135,27 @@ func (m *Device) GetReferenceAltitude() float64 {
 	return 0
 }
 
+func (m *Device) GetSuppressFCntAnomalies() bool {
+	if m != nil {
+		return m.SuppressFCntAnomalies
+	}
+	return false
+}
+
+func (m *Device) GetLegalHold() bool {
+	if m != nil {
+		return m.LegalHold
+	}
+	return false
+}
+
+func (m *Device) GetVariables() map[string]string {
+	if m != nil {
+		return m.Variables
+	}
+	return nil
+}
+
 type DeviceListItem struct {
 	// Device EUI (HEX encoded).
 	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
@@ -396,10 +429,17 @@ type GetDeviceResponse struct {
 	// Device location.
 	// This will set when the network-server was able to resolve the location
 	// using the geolocation-server.
-	Location             *common.Location `protobuf:"bytes,21,opt,name=location,proto3" json:"location,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Location *common.Location `protobuf:"bytes,21,opt,name=location,proto3" json:"location,omitempty"`
+	// Region configured by the network-server serving the device (e.g.
+	// EU868).
+	Region string `protobuf:"bytes,22,opt,name=region,proto3" json:"region,omitempty"`
+	// LoRaWAN MAC version of the device-profile (e.g. 1.0.2).
+	MacVersion string `protobuf:"bytes,23,opt,name=mac_version,json=macVersion,proto3" json:"mac_version,omitempty"`
+	// Regional parameters revision of the device-profile (e.g. B).
+	RegParamsRevision    string   `protobuf:"bytes,24,opt,name=reg_params_revision,json=regParamsRevision,proto3" json:"reg_params_revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetDeviceResponse) Reset()         { *m = GetDeviceResponse{} }
@@ -461,6 +501,27 @@ func (m *GetDeviceResponse) GetLocation() *common.Location {
 	return nil
 }
 
+func (m *GetDeviceResponse) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *GetDeviceResponse) GetMacVersion() string {
+	if m != nil {
+		return m.MacVersion
+	}
+	return ""
+}
+
+func (m *GetDeviceResponse) GetRegParamsRevision() string {
+	if m != nil {
+		return m.RegParamsRevision
+	}
+	return ""
+}
+
 type ListDeviceRequest struct {
 	// Max number of devices to return in the result-set.
 	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -473,7 +534,10 @@ type ListDeviceRequest struct {
 	// Multicast-group ID to filter on (string formatted UUID).
 	MulticastGroupId string `protobuf:"bytes,5,opt,name=multicast_group_id,json=multicastGroupID,proto3" json:"multicast_group_id,omitempty"`
 	// Service-profile ID to filter on (string formatted UUID).
-	ServiceProfileId     string   `protobuf:"bytes,6,opt,name=service_profile_id,json=serviceProfileID,proto3" json:"service_profile_id,omitempty"`
+	ServiceProfileId string `protobuf:"bytes,6,opt,name=service_profile_id,json=serviceProfileID,proto3" json:"service_profile_id,omitempty"`
+	// Cursor of the result-set (for cursor-based pagination).
+	// When set, the offset is ignored.
+	Cursor               string   `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -545,14 +609,24 @@ func (m *ListDeviceRequest) GetServiceProfileId() string {
 	return ""
 }
 
+func (m *ListDeviceRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 type ListDeviceResponse struct {
 	// Total number of devices available within the result-set.
 	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	// Devices within this result-set.
-	Result               []*DeviceListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Result []*DeviceListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	// Cursor for retrieving the next page. This is empty when there are no
+	// more devices.
+	NextCursor           string   `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ListDeviceResponse) Reset()         { *m = ListDeviceResponse{} }
@@ -593,6 +667,13 @@ func (m *ListDeviceResponse) GetResult() []*DeviceListItem {
 	return nil
 }
 
+func (m *ListDeviceResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
 type DeleteDeviceRequest struct {
 	// Device EUI (HEX encoded).
 	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
@@ -634,10 +715,14 @@ func (m *DeleteDeviceRequest) GetDevEui() string {
 
 type UpdateDeviceRequest struct {
 	// Device object to update.
-	Device               *Device  `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Device *Device `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	// Fields to update (e.g. "description").
+	// When set, only the given fields are updated, all other fields are left
+	// unchanged. When not set, all fields are updated.
+	UpdateMask           *field_mask.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *UpdateDeviceRequest) Reset()         { *m = UpdateDeviceRequest{} }
@@ -671,6 +756,13 @@ func (m *UpdateDeviceRequest) GetDevice() *Device {
 	return nil
 }
 
+func (m *UpdateDeviceRequest) GetUpdateMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
 type CreateDeviceKeysRequest struct {
 	// Device-keys object to create.
 	DeviceKeys           *DeviceKeys `protobuf:"bytes,1,opt,name=device_keys,json=deviceKeys,proto3" json:"device_keys,omitempty"`
@@ -1093,7 +1185,7 @@ func (m *GetDeviceActivationResponse) GetDeviceActivation() *DeviceActivation {
 	return nil
 }
 
-type GetRandomDevAddrRequest struct {
+type DeactivateDeviceRequest struct {
 	// Device EUI (HEX encoded).
 	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1101,217 +1193,1519 @@ type GetRandomDevAddrRequest struct {
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetRandomDevAddrRequest) Reset()         { *m = GetRandomDevAddrRequest{} }
-func (m *GetRandomDevAddrRequest) String() string { return proto.CompactTextString(m) }
-func (*GetRandomDevAddrRequest) ProtoMessage()    {}
-func (*GetRandomDevAddrRequest) Descriptor() ([]byte, []int) {
+func (m *DeactivateDeviceRequest) Reset()         { *m = DeactivateDeviceRequest{} }
+func (m *DeactivateDeviceRequest) String() string { return proto.CompactTextString(m) }
+func (*DeactivateDeviceRequest) ProtoMessage()    {}
+func (*DeactivateDeviceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_870276a56ac00da5, []int{19}
 }
-func (m *GetRandomDevAddrRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRandomDevAddrRequest.Unmarshal(m, b)
+func (m *DeactivateDeviceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeactivateDeviceRequest.Unmarshal(m, b)
 }
-func (m *GetRandomDevAddrRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRandomDevAddrRequest.Marshal(b, m, deterministic)
+func (m *DeactivateDeviceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeactivateDeviceRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetRandomDevAddrRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRandomDevAddrRequest.Merge(dst, src)
+func (dst *DeactivateDeviceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeactivateDeviceRequest.Merge(dst, src)
 }
-func (m *GetRandomDevAddrRequest) XXX_Size() int {
-	return xxx_messageInfo_GetRandomDevAddrRequest.Size(m)
+func (m *DeactivateDeviceRequest) XXX_Size() int {
+	return xxx_messageInfo_DeactivateDeviceRequest.Size(m)
 }
-func (m *GetRandomDevAddrRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRandomDevAddrRequest.DiscardUnknown(m)
+func (m *DeactivateDeviceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeactivateDeviceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRandomDevAddrRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeactivateDeviceRequest proto.InternalMessageInfo
 
-func (m *GetRandomDevAddrRequest) GetDevEui() string {
+func (m *DeactivateDeviceRequest) GetDevEui() string {
 	if m != nil {
 		return m.DevEui
 	}
 	return ""
 }
 
-type GetRandomDevAddrResponse struct {
-	// Device address (HEX encoded).
-	DevAddr              string   `protobuf:"bytes,1,opt,name=dev_addr,json=devAddr,proto3" json:"dev_addr,omitempty"`
+type GetWrappedAppSKeyRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// KEK label.
+	// This label is returned as-is in the response.
+	KekLabel string `protobuf:"bytes,2,opt,name=kek_label,json=kekLabel,proto3" json:"kek_label,omitempty"`
+	// KEK (HEX encoded, 16, 24 or 32 bytes).
+	// When set, the AppSKey will be wrapped using the AES key wrap
+	// algorithm (RFC 3394).
+	Kek string `protobuf:"bytes,3,opt,name=kek,proto3" json:"kek,omitempty"`
+	// RSA public key (PEM encoded).
+	// When set, the AppSKey will be encrypted using RSA-OAEP (SHA-256).
+	// Either kek or public_key must be set.
+	PublicKey            string   `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetRandomDevAddrResponse) Reset()         { *m = GetRandomDevAddrResponse{} }
-func (m *GetRandomDevAddrResponse) String() string { return proto.CompactTextString(m) }
-func (*GetRandomDevAddrResponse) ProtoMessage()    {}
-func (*GetRandomDevAddrResponse) Descriptor() ([]byte, []int) {
+func (m *GetWrappedAppSKeyRequest) Reset()         { *m = GetWrappedAppSKeyRequest{} }
+func (m *GetWrappedAppSKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*GetWrappedAppSKeyRequest) ProtoMessage()    {}
+func (*GetWrappedAppSKeyRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_870276a56ac00da5, []int{20}
 }
-func (m *GetRandomDevAddrResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetRandomDevAddrResponse.Unmarshal(m, b)
+func (m *GetWrappedAppSKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetWrappedAppSKeyRequest.Unmarshal(m, b)
 }
-func (m *GetRandomDevAddrResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetRandomDevAddrResponse.Marshal(b, m, deterministic)
+func (m *GetWrappedAppSKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetWrappedAppSKeyRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetRandomDevAddrResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRandomDevAddrResponse.Merge(dst, src)
+func (dst *GetWrappedAppSKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWrappedAppSKeyRequest.Merge(dst, src)
 }
-func (m *GetRandomDevAddrResponse) XXX_Size() int {
-	return xxx_messageInfo_GetRandomDevAddrResponse.Size(m)
+func (m *GetWrappedAppSKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_GetWrappedAppSKeyRequest.Size(m)
 }
-func (m *GetRandomDevAddrResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRandomDevAddrResponse.DiscardUnknown(m)
+func (m *GetWrappedAppSKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWrappedAppSKeyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRandomDevAddrResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetWrappedAppSKeyRequest proto.InternalMessageInfo
 
-func (m *GetRandomDevAddrResponse) GetDevAddr() string {
+func (m *GetWrappedAppSKeyRequest) GetDevEui() string {
 	if m != nil {
-		return m.DevAddr
+		return m.DevEui
 	}
 	return ""
 }
 
-type StreamDeviceFrameLogsRequest struct {
-	// Device EUI (HEX encoded).
-	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+func (m *GetWrappedAppSKeyRequest) GetKekLabel() string {
+	if m != nil {
+		return m.KekLabel
+	}
+	return ""
+}
+
+func (m *GetWrappedAppSKeyRequest) GetKek() string {
+	if m != nil {
+		return m.Kek
+	}
+	return ""
+}
+
+func (m *GetWrappedAppSKeyRequest) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+type GetWrappedAppSKeyResponse struct {
+	// Device address (HEX encoded).
+	DevAddr string `protobuf:"bytes,1,opt,name=dev_addr,json=devAddr,proto3" json:"dev_addr,omitempty"`
+	// KEK label (as given in the request).
+	KekLabel string `protobuf:"bytes,2,opt,name=kek_label,json=kekLabel,proto3" json:"kek_label,omitempty"`
+	// Wrapped or encrypted AppSKey (HEX encoded).
+	AppSKey              string   `protobuf:"bytes,3,opt,name=app_s_key,json=appSKey,proto3" json:"app_s_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StreamDeviceFrameLogsRequest) Reset()         { *m = StreamDeviceFrameLogsRequest{} }
-func (m *StreamDeviceFrameLogsRequest) String() string { return proto.CompactTextString(m) }
-func (*StreamDeviceFrameLogsRequest) ProtoMessage()    {}
-func (*StreamDeviceFrameLogsRequest) Descriptor() ([]byte, []int) {
+func (m *GetWrappedAppSKeyResponse) Reset()         { *m = GetWrappedAppSKeyResponse{} }
+func (m *GetWrappedAppSKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*GetWrappedAppSKeyResponse) ProtoMessage()    {}
+func (*GetWrappedAppSKeyResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_870276a56ac00da5, []int{21}
 }
-func (m *StreamDeviceFrameLogsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StreamDeviceFrameLogsRequest.Unmarshal(m, b)
+func (m *GetWrappedAppSKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetWrappedAppSKeyResponse.Unmarshal(m, b)
 }
-func (m *StreamDeviceFrameLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StreamDeviceFrameLogsRequest.Marshal(b, m, deterministic)
+func (m *GetWrappedAppSKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetWrappedAppSKeyResponse.Marshal(b, m, deterministic)
 }
-func (dst *StreamDeviceFrameLogsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamDeviceFrameLogsRequest.Merge(dst, src)
+func (dst *GetWrappedAppSKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWrappedAppSKeyResponse.Merge(dst, src)
 }
-func (m *StreamDeviceFrameLogsRequest) XXX_Size() int {
-	return xxx_messageInfo_StreamDeviceFrameLogsRequest.Size(m)
+func (m *GetWrappedAppSKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_GetWrappedAppSKeyResponse.Size(m)
 }
-func (m *StreamDeviceFrameLogsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamDeviceFrameLogsRequest.DiscardUnknown(m)
+func (m *GetWrappedAppSKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWrappedAppSKeyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamDeviceFrameLogsRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetWrappedAppSKeyResponse proto.InternalMessageInfo
 
-func (m *StreamDeviceFrameLogsRequest) GetDevEui() string {
+func (m *GetWrappedAppSKeyResponse) GetDevAddr() string {
 	if m != nil {
-		return m.DevEui
+		return m.DevAddr
 	}
 	return ""
 }
 
-type StreamDeviceFrameLogsResponse struct {
-	// Types that are valid to be assigned to Frame:
-	//	*StreamDeviceFrameLogsResponse_UplinkFrame
-	//	*StreamDeviceFrameLogsResponse_DownlinkFrame
-	Frame                isStreamDeviceFrameLogsResponse_Frame `protobuf_oneof:"frame"`
-	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
-	XXX_unrecognized     []byte                                `json:"-"`
-	XXX_sizecache        int32                                 `json:"-"`
+func (m *GetWrappedAppSKeyResponse) GetKekLabel() string {
+	if m != nil {
+		return m.KekLabel
+	}
+	return ""
 }
 
-func (m *StreamDeviceFrameLogsResponse) Reset()         { *m = StreamDeviceFrameLogsResponse{} }
-func (m *StreamDeviceFrameLogsResponse) String() string { return proto.CompactTextString(m) }
-func (*StreamDeviceFrameLogsResponse) ProtoMessage()    {}
-func (*StreamDeviceFrameLogsResponse) Descriptor() ([]byte, []int) {
+func (m *GetWrappedAppSKeyResponse) GetAppSKey() string {
+	if m != nil {
+		return m.AppSKey
+	}
+	return ""
+}
+
+type DecryptDeviceUplinkRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Uplink frame-counter (32 bit).
+	// The uplink is looked up in the frame capture of the device and in the
+	// decryption error quarantine of the application.
+	FCnt uint32 `protobuf:"varint,2,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	// Number of (most recent) device-activations to use for decryption.
+	// When not set, only the current device-activation is used.
+	ActivationCount      uint32   `protobuf:"varint,5,opt,name=activation_count,json=activationCount,proto3" json:"activation_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecryptDeviceUplinkRequest) Reset()         { *m = DecryptDeviceUplinkRequest{} }
+func (m *DecryptDeviceUplinkRequest) String() string { return proto.CompactTextString(m) }
+func (*DecryptDeviceUplinkRequest) ProtoMessage()    {}
+func (*DecryptDeviceUplinkRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_870276a56ac00da5, []int{22}
 }
-func (m *StreamDeviceFrameLogsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StreamDeviceFrameLogsResponse.Unmarshal(m, b)
+func (m *DecryptDeviceUplinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecryptDeviceUplinkRequest.Unmarshal(m, b)
 }
-func (m *StreamDeviceFrameLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StreamDeviceFrameLogsResponse.Marshal(b, m, deterministic)
+func (m *DecryptDeviceUplinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecryptDeviceUplinkRequest.Marshal(b, m, deterministic)
 }
-func (dst *StreamDeviceFrameLogsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamDeviceFrameLogsResponse.Merge(dst, src)
+func (dst *DecryptDeviceUplinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecryptDeviceUplinkRequest.Merge(dst, src)
 }
-func (m *StreamDeviceFrameLogsResponse) XXX_Size() int {
-	return xxx_messageInfo_StreamDeviceFrameLogsResponse.Size(m)
+func (m *DecryptDeviceUplinkRequest) XXX_Size() int {
+	return xxx_messageInfo_DecryptDeviceUplinkRequest.Size(m)
 }
-func (m *StreamDeviceFrameLogsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamDeviceFrameLogsResponse.DiscardUnknown(m)
+func (m *DecryptDeviceUplinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecryptDeviceUplinkRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamDeviceFrameLogsResponse proto.InternalMessageInfo
+var xxx_messageInfo_DecryptDeviceUplinkRequest proto.InternalMessageInfo
 
-type isStreamDeviceFrameLogsResponse_Frame interface {
-	isStreamDeviceFrameLogsResponse_Frame()
+func (m *DecryptDeviceUplinkRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
 }
 
-type StreamDeviceFrameLogsResponse_UplinkFrame struct {
-	UplinkFrame *UplinkFrameLog `protobuf:"bytes,1,opt,name=uplink_frame,json=uplinkFrame,proto3,oneof"`
+func (m *DecryptDeviceUplinkRequest) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
 }
 
-type StreamDeviceFrameLogsResponse_DownlinkFrame struct {
-	DownlinkFrame *DownlinkFrameLog `protobuf:"bytes,2,opt,name=downlink_frame,json=downlinkFrame,proto3,oneof"`
+func (m *DecryptDeviceUplinkRequest) GetActivationCount() uint32 {
+	if m != nil {
+		return m.ActivationCount
+	}
+	return 0
 }
 
-func (*StreamDeviceFrameLogsResponse_UplinkFrame) isStreamDeviceFrameLogsResponse_Frame() {}
+type DecryptedDeviceUplink struct {
+	// Device address of the device-activation (HEX encoded).
+	DevAddr string `protobuf:"bytes,1,opt,name=dev_addr,json=devAddr,proto3" json:"dev_addr,omitempty"`
+	// Timestamp of the device-activation.
+	ActivatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=activated_at,json=activatedAt,proto3" json:"activated_at,omitempty"`
+	// Decrypted FRMPayload (HEX encoded).
+	FrmPayload string `protobuf:"bytes,3,opt,name=frm_payload,json=frmPayload,proto3" json:"frm_payload,omitempty"`
+	// Decoded payload (JSON encoded).
+	// This is only set when the application has a payload codec configured
+	// and the payload could be decoded.
+	ObjectJson string `protobuf:"bytes,4,opt,name=object_json,json=objectJSON,proto3" json:"object_json,omitempty"`
+	// Payload codec error.
+	CodecError string `protobuf:"bytes,5,opt,name=codec_error,json=codecError,proto3" json:"codec_error,omitempty"`
+	// Payload codec error details.
+	// This is only set when the payload codec script failed.
+	CodecErrorDetails    *CodecErrorDetails `protobuf:"bytes,6,opt,name=codec_error_details,json=codecErrorDetails,proto3" json:"codec_error_details,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DecryptedDeviceUplink) Reset()         { *m = DecryptedDeviceUplink{} }
+func (m *DecryptedDeviceUplink) String() string { return proto.CompactTextString(m) }
+func (*DecryptedDeviceUplink) ProtoMessage()    {}
+func (*DecryptedDeviceUplink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{23}
+}
+func (m *DecryptedDeviceUplink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecryptedDeviceUplink.Unmarshal(m, b)
+}
+func (m *DecryptedDeviceUplink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecryptedDeviceUplink.Marshal(b, m, deterministic)
+}
+func (dst *DecryptedDeviceUplink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecryptedDeviceUplink.Merge(dst, src)
+}
+func (m *DecryptedDeviceUplink) XXX_Size() int {
+	return xxx_messageInfo_DecryptedDeviceUplink.Size(m)
+}
+func (m *DecryptedDeviceUplink) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecryptedDeviceUplink.DiscardUnknown(m)
+}
 
-func (*StreamDeviceFrameLogsResponse_DownlinkFrame) isStreamDeviceFrameLogsResponse_Frame() {}
+var xxx_messageInfo_DecryptedDeviceUplink proto.InternalMessageInfo
 
-func (m *StreamDeviceFrameLogsResponse) GetFrame() isStreamDeviceFrameLogsResponse_Frame {
+func (m *DecryptedDeviceUplink) GetDevAddr() string {
 	if m != nil {
-		return m.Frame
+		return m.DevAddr
 	}
-	return nil
+	return ""
 }
 
-func (m *StreamDeviceFrameLogsResponse) GetUplinkFrame() *UplinkFrameLog {
-	if x, ok := m.GetFrame().(*StreamDeviceFrameLogsResponse_UplinkFrame); ok {
-		return x.UplinkFrame
+func (m *DecryptedDeviceUplink) GetActivatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ActivatedAt
 	}
 	return nil
 }
 
-func (m *StreamDeviceFrameLogsResponse) GetDownlinkFrame() *DownlinkFrameLog {
-	if x, ok := m.GetFrame().(*StreamDeviceFrameLogsResponse_DownlinkFrame); ok {
-		return x.DownlinkFrame
+func (m *DecryptedDeviceUplink) GetFrmPayload() string {
+	if m != nil {
+		return m.FrmPayload
 	}
-	return nil
+	return ""
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*StreamDeviceFrameLogsResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _StreamDeviceFrameLogsResponse_OneofMarshaler, _StreamDeviceFrameLogsResponse_OneofUnmarshaler, _StreamDeviceFrameLogsResponse_OneofSizer, []interface{}{
-		(*StreamDeviceFrameLogsResponse_UplinkFrame)(nil),
-		(*StreamDeviceFrameLogsResponse_DownlinkFrame)(nil),
+func (m *DecryptedDeviceUplink) GetObjectJson() string {
+	if m != nil {
+		return m.ObjectJson
 	}
+	return ""
 }
 
-func _StreamDeviceFrameLogsResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*StreamDeviceFrameLogsResponse)
-	// frame
-	switch x := m.Frame.(type) {
-	case *StreamDeviceFrameLogsResponse_UplinkFrame:
-		b.EncodeVarint(1<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.UplinkFrame); err != nil {
-			return err
-		}
-	case *StreamDeviceFrameLogsResponse_DownlinkFrame:
-		b.EncodeVarint(2<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.DownlinkFrame); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("StreamDeviceFrameLogsResponse.Frame has unexpected type %T", x)
+func (m *DecryptedDeviceUplink) GetCodecError() string {
+	if m != nil {
+		return m.CodecError
+	}
+	return ""
+}
+
+func (m *DecryptedDeviceUplink) GetCodecErrorDetails() *CodecErrorDetails {
+	if m != nil {
+		return m.CodecErrorDetails
 	}
 	return nil
 }
 
-func _StreamDeviceFrameLogsResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*StreamDeviceFrameLogsResponse)
+type CodecErrorDetails struct {
+	// Error message.
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// Line within the codec script (0 when unknown).
+	Line uint32 `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
+	// Column within the codec script (0 when unknown).
+	Column uint32 `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"`
+	// Stack trace (most recent call first).
+	StackTrace []string `protobuf:"bytes,4,rep,name=stack_trace,json=stackTrace,proto3" json:"stack_trace,omitempty"`
+	// Input bytes given to the codec (HEX encoded).
+	Input                string   `protobuf:"bytes,5,opt,name=input,proto3" json:"input,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CodecErrorDetails) Reset()         { *m = CodecErrorDetails{} }
+func (m *CodecErrorDetails) String() string { return proto.CompactTextString(m) }
+func (*CodecErrorDetails) ProtoMessage()    {}
+func (*CodecErrorDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{24}
+}
+func (m *CodecErrorDetails) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CodecErrorDetails.Unmarshal(m, b)
+}
+func (m *CodecErrorDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CodecErrorDetails.Marshal(b, m, deterministic)
+}
+func (dst *CodecErrorDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodecErrorDetails.Merge(dst, src)
+}
+func (m *CodecErrorDetails) XXX_Size() int {
+	return xxx_messageInfo_CodecErrorDetails.Size(m)
+}
+func (m *CodecErrorDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodecErrorDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodecErrorDetails proto.InternalMessageInfo
+
+func (m *CodecErrorDetails) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *CodecErrorDetails) GetLine() uint32 {
+	if m != nil {
+		return m.Line
+	}
+	return 0
+}
+
+func (m *CodecErrorDetails) GetColumn() uint32 {
+	if m != nil {
+		return m.Column
+	}
+	return 0
+}
+
+func (m *CodecErrorDetails) GetStackTrace() []string {
+	if m != nil {
+		return m.StackTrace
+	}
+	return nil
+}
+
+func (m *CodecErrorDetails) GetInput() string {
+	if m != nil {
+		return m.Input
+	}
+	return ""
+}
+
+type DecryptDeviceUplinkResponse struct {
+	// Decrypted uplink for each device-activation (most recent first).
+	Result               []*DecryptedDeviceUplink `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *DecryptDeviceUplinkResponse) Reset()         { *m = DecryptDeviceUplinkResponse{} }
+func (m *DecryptDeviceUplinkResponse) String() string { return proto.CompactTextString(m) }
+func (*DecryptDeviceUplinkResponse) ProtoMessage()    {}
+func (*DecryptDeviceUplinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{25}
+}
+func (m *DecryptDeviceUplinkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecryptDeviceUplinkResponse.Unmarshal(m, b)
+}
+func (m *DecryptDeviceUplinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecryptDeviceUplinkResponse.Marshal(b, m, deterministic)
+}
+func (dst *DecryptDeviceUplinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecryptDeviceUplinkResponse.Merge(dst, src)
+}
+func (m *DecryptDeviceUplinkResponse) XXX_Size() int {
+	return xxx_messageInfo_DecryptDeviceUplinkResponse.Size(m)
+}
+func (m *DecryptDeviceUplinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecryptDeviceUplinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecryptDeviceUplinkResponse proto.InternalMessageInfo
+
+func (m *DecryptDeviceUplinkResponse) GetResult() []*DecryptedDeviceUplink {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type GetRandomDevAddrRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRandomDevAddrRequest) Reset()         { *m = GetRandomDevAddrRequest{} }
+func (m *GetRandomDevAddrRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRandomDevAddrRequest) ProtoMessage()    {}
+func (*GetRandomDevAddrRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{26}
+}
+func (m *GetRandomDevAddrRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRandomDevAddrRequest.Unmarshal(m, b)
+}
+func (m *GetRandomDevAddrRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRandomDevAddrRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetRandomDevAddrRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRandomDevAddrRequest.Merge(dst, src)
+}
+func (m *GetRandomDevAddrRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRandomDevAddrRequest.Size(m)
+}
+func (m *GetRandomDevAddrRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRandomDevAddrRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRandomDevAddrRequest proto.InternalMessageInfo
+
+func (m *GetRandomDevAddrRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+type GetRandomDevAddrResponse struct {
+	// Device address (HEX encoded).
+	DevAddr              string   `protobuf:"bytes,1,opt,name=dev_addr,json=devAddr,proto3" json:"dev_addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRandomDevAddrResponse) Reset()         { *m = GetRandomDevAddrResponse{} }
+func (m *GetRandomDevAddrResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRandomDevAddrResponse) ProtoMessage()    {}
+func (*GetRandomDevAddrResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{27}
+}
+func (m *GetRandomDevAddrResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRandomDevAddrResponse.Unmarshal(m, b)
+}
+func (m *GetRandomDevAddrResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRandomDevAddrResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetRandomDevAddrResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRandomDevAddrResponse.Merge(dst, src)
+}
+func (m *GetRandomDevAddrResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRandomDevAddrResponse.Size(m)
+}
+func (m *GetRandomDevAddrResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRandomDevAddrResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRandomDevAddrResponse proto.InternalMessageInfo
+
+func (m *GetRandomDevAddrResponse) GetDevAddr() string {
+	if m != nil {
+		return m.DevAddr
+	}
+	return ""
+}
+
+type DeviceDevNonce struct {
+	// DevNonce.
+	DevNonce uint32 `protobuf:"varint,1,opt,name=dev_nonce,json=devNonce,proto3" json:"dev_nonce,omitempty"`
+	// Timestamp when the DevNonce was used.
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DeviceDevNonce) Reset()         { *m = DeviceDevNonce{} }
+func (m *DeviceDevNonce) String() string { return proto.CompactTextString(m) }
+func (*DeviceDevNonce) ProtoMessage()    {}
+func (*DeviceDevNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{28}
+}
+func (m *DeviceDevNonce) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceDevNonce.Unmarshal(m, b)
+}
+func (m *DeviceDevNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceDevNonce.Marshal(b, m, deterministic)
+}
+func (dst *DeviceDevNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceDevNonce.Merge(dst, src)
+}
+func (m *DeviceDevNonce) XXX_Size() int {
+	return xxx_messageInfo_DeviceDevNonce.Size(m)
+}
+func (m *DeviceDevNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceDevNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceDevNonce proto.InternalMessageInfo
+
+func (m *DeviceDevNonce) GetDevNonce() uint32 {
+	if m != nil {
+		return m.DevNonce
+	}
+	return 0
+}
+
+func (m *DeviceDevNonce) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type ListDeviceDevNoncesRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDeviceDevNoncesRequest) Reset()         { *m = ListDeviceDevNoncesRequest{} }
+func (m *ListDeviceDevNoncesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceDevNoncesRequest) ProtoMessage()    {}
+func (*ListDeviceDevNoncesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{29}
+}
+func (m *ListDeviceDevNoncesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeviceDevNoncesRequest.Unmarshal(m, b)
+}
+func (m *ListDeviceDevNoncesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeviceDevNoncesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListDeviceDevNoncesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceDevNoncesRequest.Merge(dst, src)
+}
+func (m *ListDeviceDevNoncesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDeviceDevNoncesRequest.Size(m)
+}
+func (m *ListDeviceDevNoncesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceDevNoncesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeviceDevNoncesRequest proto.InternalMessageInfo
+
+func (m *ListDeviceDevNoncesRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+type ListDeviceDevNoncesResponse struct {
+	// Used DevNonces.
+	Result               []*DeviceDevNonce `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListDeviceDevNoncesResponse) Reset()         { *m = ListDeviceDevNoncesResponse{} }
+func (m *ListDeviceDevNoncesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDeviceDevNoncesResponse) ProtoMessage()    {}
+func (*ListDeviceDevNoncesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{30}
+}
+func (m *ListDeviceDevNoncesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDeviceDevNoncesResponse.Unmarshal(m, b)
+}
+func (m *ListDeviceDevNoncesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDeviceDevNoncesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListDeviceDevNoncesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDeviceDevNoncesResponse.Merge(dst, src)
+}
+func (m *ListDeviceDevNoncesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDeviceDevNoncesResponse.Size(m)
+}
+func (m *ListDeviceDevNoncesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDeviceDevNoncesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDeviceDevNoncesResponse proto.InternalMessageInfo
+
+func (m *ListDeviceDevNoncesResponse) GetResult() []*DeviceDevNonce {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type DeleteDeviceDevNoncesRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteDeviceDevNoncesRequest) Reset()         { *m = DeleteDeviceDevNoncesRequest{} }
+func (m *DeleteDeviceDevNoncesRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteDeviceDevNoncesRequest) ProtoMessage()    {}
+func (*DeleteDeviceDevNoncesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{31}
+}
+func (m *DeleteDeviceDevNoncesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteDeviceDevNoncesRequest.Unmarshal(m, b)
+}
+func (m *DeleteDeviceDevNoncesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteDeviceDevNoncesRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteDeviceDevNoncesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteDeviceDevNoncesRequest.Merge(dst, src)
+}
+func (m *DeleteDeviceDevNoncesRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteDeviceDevNoncesRequest.Size(m)
+}
+func (m *DeleteDeviceDevNoncesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteDeviceDevNoncesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteDeviceDevNoncesRequest proto.InternalMessageInfo
+
+func (m *DeleteDeviceDevNoncesRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+type GetDeviceTwinRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceTwinRequest) Reset()         { *m = GetDeviceTwinRequest{} }
+func (m *GetDeviceTwinRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceTwinRequest) ProtoMessage()    {}
+func (*GetDeviceTwinRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{32}
+}
+func (m *GetDeviceTwinRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceTwinRequest.Unmarshal(m, b)
+}
+func (m *GetDeviceTwinRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceTwinRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceTwinRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceTwinRequest.Merge(dst, src)
+}
+func (m *GetDeviceTwinRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceTwinRequest.Size(m)
+}
+func (m *GetDeviceTwinRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceTwinRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceTwinRequest proto.InternalMessageInfo
+
+func (m *GetDeviceTwinRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+type GetDeviceTwinResponse struct {
+	// Reported state.
+	Reported *_struct.Struct `protobuf:"bytes,1,opt,name=reported,proto3" json:"reported,omitempty"`
+	// Reported state metadata, containing the timestamp of the last update
+	// of each reported field.
+	ReportedMetadata *_struct.Struct `protobuf:"bytes,2,opt,name=reported_metadata,json=reportedMetadata,proto3" json:"reported_metadata,omitempty"`
+	// Desired state.
+	Desired *_struct.Struct `protobuf:"bytes,3,opt,name=desired,proto3" json:"desired,omitempty"`
+	// Desired state metadata, containing the timestamp of the last update
+	// of each desired field.
+	DesiredMetadata *_struct.Struct `protobuf:"bytes,4,opt,name=desired_metadata,json=desiredMetadata,proto3" json:"desired_metadata,omitempty"`
+	// Fields of the desired state which differ from the reported state.
+	Delta *_struct.Struct `protobuf:"bytes,5,opt,name=delta,proto3" json:"delta,omitempty"`
+	// Version of the twin, incremented on every update.
+	Version int64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	// Last update timestamp (not set when the twin has not been updated).
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetDeviceTwinResponse) Reset()         { *m = GetDeviceTwinResponse{} }
+func (m *GetDeviceTwinResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceTwinResponse) ProtoMessage()    {}
+func (*GetDeviceTwinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{33}
+}
+func (m *GetDeviceTwinResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceTwinResponse.Unmarshal(m, b)
+}
+func (m *GetDeviceTwinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceTwinResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceTwinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceTwinResponse.Merge(dst, src)
+}
+func (m *GetDeviceTwinResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceTwinResponse.Size(m)
+}
+func (m *GetDeviceTwinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceTwinResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceTwinResponse proto.InternalMessageInfo
+
+func (m *GetDeviceTwinResponse) GetReported() *_struct.Struct {
+	if m != nil {
+		return m.Reported
+	}
+	return nil
+}
+
+func (m *GetDeviceTwinResponse) GetReportedMetadata() *_struct.Struct {
+	if m != nil {
+		return m.ReportedMetadata
+	}
+	return nil
+}
+
+func (m *GetDeviceTwinResponse) GetDesired() *_struct.Struct {
+	if m != nil {
+		return m.Desired
+	}
+	return nil
+}
+
+func (m *GetDeviceTwinResponse) GetDesiredMetadata() *_struct.Struct {
+	if m != nil {
+		return m.DesiredMetadata
+	}
+	return nil
+}
+
+func (m *GetDeviceTwinResponse) GetDelta() *_struct.Struct {
+	if m != nil {
+		return m.Delta
+	}
+	return nil
+}
+
+func (m *GetDeviceTwinResponse) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *GetDeviceTwinResponse) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type UpdateDeviceTwinDesiredStateRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Desired state to merge. Objects are merged recursively, a null value
+	// removes the field from the desired state.
+	Desired *_struct.Struct `protobuf:"bytes,2,opt,name=desired,proto3" json:"desired,omitempty"`
+	// FPort used for the downlink (must be > 0 when a downlink is needed).
+	FPort uint32 `protobuf:"varint,3,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// Enqueue the downlink as confirmed downlink.
+	Confirmed bool `protobuf:"varint,4,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// Expected version of the twin (optional). When set and the twin has
+	// been updated in the meantime, the update is rejected.
+	Version              int64    `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateDeviceTwinDesiredStateRequest) Reset()         { *m = UpdateDeviceTwinDesiredStateRequest{} }
+func (m *UpdateDeviceTwinDesiredStateRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateDeviceTwinDesiredStateRequest) ProtoMessage()    {}
+func (*UpdateDeviceTwinDesiredStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{34}
+}
+func (m *UpdateDeviceTwinDesiredStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateDeviceTwinDesiredStateRequest.Unmarshal(m, b)
+}
+func (m *UpdateDeviceTwinDesiredStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateDeviceTwinDesiredStateRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateDeviceTwinDesiredStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDeviceTwinDesiredStateRequest.Merge(dst, src)
+}
+func (m *UpdateDeviceTwinDesiredStateRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateDeviceTwinDesiredStateRequest.Size(m)
+}
+func (m *UpdateDeviceTwinDesiredStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDeviceTwinDesiredStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDeviceTwinDesiredStateRequest proto.InternalMessageInfo
+
+func (m *UpdateDeviceTwinDesiredStateRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *UpdateDeviceTwinDesiredStateRequest) GetDesired() *_struct.Struct {
+	if m != nil {
+		return m.Desired
+	}
+	return nil
+}
+
+func (m *UpdateDeviceTwinDesiredStateRequest) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *UpdateDeviceTwinDesiredStateRequest) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
+	}
+	return false
+}
+
+func (m *UpdateDeviceTwinDesiredStateRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type UpdateDeviceTwinDesiredStateResponse struct {
+	// Version of the twin after the update.
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Fields of the desired state which differ from the reported state.
+	Delta *_struct.Struct `protobuf:"bytes,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	// The delta has been enqueued as downlink.
+	Enqueued bool `protobuf:"varint,3,opt,name=enqueued,proto3" json:"enqueued,omitempty"`
+	// Frame-counter of the enqueued downlink.
+	FCnt                 uint32   `protobuf:"varint,4,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateDeviceTwinDesiredStateResponse) Reset()         { *m = UpdateDeviceTwinDesiredStateResponse{} }
+func (m *UpdateDeviceTwinDesiredStateResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateDeviceTwinDesiredStateResponse) ProtoMessage()    {}
+func (*UpdateDeviceTwinDesiredStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{35}
+}
+func (m *UpdateDeviceTwinDesiredStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateDeviceTwinDesiredStateResponse.Unmarshal(m, b)
+}
+func (m *UpdateDeviceTwinDesiredStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateDeviceTwinDesiredStateResponse.Marshal(b, m, deterministic)
+}
+func (dst *UpdateDeviceTwinDesiredStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDeviceTwinDesiredStateResponse.Merge(dst, src)
+}
+func (m *UpdateDeviceTwinDesiredStateResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateDeviceTwinDesiredStateResponse.Size(m)
+}
+func (m *UpdateDeviceTwinDesiredStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDeviceTwinDesiredStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDeviceTwinDesiredStateResponse proto.InternalMessageInfo
+
+func (m *UpdateDeviceTwinDesiredStateResponse) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *UpdateDeviceTwinDesiredStateResponse) GetDelta() *_struct.Struct {
+	if m != nil {
+		return m.Delta
+	}
+	return nil
+}
+
+func (m *UpdateDeviceTwinDesiredStateResponse) GetEnqueued() bool {
+	if m != nil {
+		return m.Enqueued
+	}
+	return false
+}
+
+func (m *UpdateDeviceTwinDesiredStateResponse) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+type GetDeviceJoinDiagnosticsRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceJoinDiagnosticsRequest) Reset()         { *m = GetDeviceJoinDiagnosticsRequest{} }
+func (m *GetDeviceJoinDiagnosticsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceJoinDiagnosticsRequest) ProtoMessage()    {}
+func (*GetDeviceJoinDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{36}
+}
+func (m *GetDeviceJoinDiagnosticsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceJoinDiagnosticsRequest.Unmarshal(m, b)
+}
+func (m *GetDeviceJoinDiagnosticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceJoinDiagnosticsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceJoinDiagnosticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceJoinDiagnosticsRequest.Merge(dst, src)
+}
+func (m *GetDeviceJoinDiagnosticsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceJoinDiagnosticsRequest.Size(m)
+}
+func (m *GetDeviceJoinDiagnosticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceJoinDiagnosticsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceJoinDiagnosticsRequest proto.InternalMessageInfo
+
+func (m *GetDeviceJoinDiagnosticsRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+type DeviceJoinAttempt struct {
+	// Timestamp of the attempt.
+	Time *timestamp.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// Join type (e.g. JoinRequestType).
+	JoinType string `protobuf:"bytes,2,opt,name=join_type,json=joinType,proto3" json:"join_type,omitempty"`
+	// DevNonce (or RJcount for rejoin-requests).
+	DevNonce uint32 `protobuf:"varint,3,opt,name=dev_nonce,json=devNonce,proto3" json:"dev_nonce,omitempty"`
+	// The DevNonce is set. This is not the case for network-server errors.
+	HasDevNonce bool `protobuf:"varint,4,opt,name=has_dev_nonce,json=hasDevNonce,proto3" json:"has_dev_nonce,omitempty"`
+	// Result of the attempt (ACCEPTED, INVALID_MIC, DEV_NONCE_REUSED,
+	// UNKNOWN_DEVICE, JS_ERROR or NS_ERROR).
+	Result string `protobuf:"bytes,5,opt,name=result,proto3" json:"result,omitempty"`
+	// Error (in case the attempt failed).
+	Error                string   `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceJoinAttempt) Reset()         { *m = DeviceJoinAttempt{} }
+func (m *DeviceJoinAttempt) String() string { return proto.CompactTextString(m) }
+func (*DeviceJoinAttempt) ProtoMessage()    {}
+func (*DeviceJoinAttempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{37}
+}
+func (m *DeviceJoinAttempt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceJoinAttempt.Unmarshal(m, b)
+}
+func (m *DeviceJoinAttempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceJoinAttempt.Marshal(b, m, deterministic)
+}
+func (dst *DeviceJoinAttempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceJoinAttempt.Merge(dst, src)
+}
+func (m *DeviceJoinAttempt) XXX_Size() int {
+	return xxx_messageInfo_DeviceJoinAttempt.Size(m)
+}
+func (m *DeviceJoinAttempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceJoinAttempt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceJoinAttempt proto.InternalMessageInfo
+
+func (m *DeviceJoinAttempt) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *DeviceJoinAttempt) GetJoinType() string {
+	if m != nil {
+		return m.JoinType
+	}
+	return ""
+}
+
+func (m *DeviceJoinAttempt) GetDevNonce() uint32 {
+	if m != nil {
+		return m.DevNonce
+	}
+	return 0
+}
+
+func (m *DeviceJoinAttempt) GetHasDevNonce() bool {
+	if m != nil {
+		return m.HasDevNonce
+	}
+	return false
+}
+
+func (m *DeviceJoinAttempt) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *DeviceJoinAttempt) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type DeviceJoinDiagnosis struct {
+	// Probable cause (NO_JOIN_REQUESTS, MISSING_KEYS, WRONG_KEY,
+	// DEV_NONCE_REUSED, JOIN_ACCEPT_NOT_RECEIVED, JS_ERROR or NS_ERROR).
+	Cause string `protobuf:"bytes,1,opt,name=cause,proto3" json:"cause,omitempty"`
+	// Number of attempts matching the cause.
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Human-readable description of the cause.
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceJoinDiagnosis) Reset()         { *m = DeviceJoinDiagnosis{} }
+func (m *DeviceJoinDiagnosis) String() string { return proto.CompactTextString(m) }
+func (*DeviceJoinDiagnosis) ProtoMessage()    {}
+func (*DeviceJoinDiagnosis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{38}
+}
+func (m *DeviceJoinDiagnosis) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceJoinDiagnosis.Unmarshal(m, b)
+}
+func (m *DeviceJoinDiagnosis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceJoinDiagnosis.Marshal(b, m, deterministic)
+}
+func (dst *DeviceJoinDiagnosis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceJoinDiagnosis.Merge(dst, src)
+}
+func (m *DeviceJoinDiagnosis) XXX_Size() int {
+	return xxx_messageInfo_DeviceJoinDiagnosis.Size(m)
+}
+func (m *DeviceJoinDiagnosis) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceJoinDiagnosis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceJoinDiagnosis proto.InternalMessageInfo
+
+func (m *DeviceJoinDiagnosis) GetCause() string {
+	if m != nil {
+		return m.Cause
+	}
+	return ""
+}
+
+func (m *DeviceJoinDiagnosis) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *DeviceJoinDiagnosis) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type GetDeviceJoinDiagnosticsResponse struct {
+	// The device has been activated.
+	Activated bool `protobuf:"varint,1,opt,name=activated,proto3" json:"activated,omitempty"`
+	// Last time the device was seen (sent an uplink).
+	LastSeenAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	// Recent join attempts, newest first.
+	Attempts []*DeviceJoinAttempt `protobuf:"bytes,3,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	// Probable causes of the failing join. This is empty when the device
+	// has joined successfully.
+	Diagnoses            []*DeviceJoinDiagnosis `protobuf:"bytes,4,rep,name=diagnoses,proto3" json:"diagnoses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GetDeviceJoinDiagnosticsResponse) Reset()         { *m = GetDeviceJoinDiagnosticsResponse{} }
+func (m *GetDeviceJoinDiagnosticsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceJoinDiagnosticsResponse) ProtoMessage()    {}
+func (*GetDeviceJoinDiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{39}
+}
+func (m *GetDeviceJoinDiagnosticsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceJoinDiagnosticsResponse.Unmarshal(m, b)
+}
+func (m *GetDeviceJoinDiagnosticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceJoinDiagnosticsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceJoinDiagnosticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceJoinDiagnosticsResponse.Merge(dst, src)
+}
+func (m *GetDeviceJoinDiagnosticsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceJoinDiagnosticsResponse.Size(m)
+}
+func (m *GetDeviceJoinDiagnosticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceJoinDiagnosticsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceJoinDiagnosticsResponse proto.InternalMessageInfo
+
+func (m *GetDeviceJoinDiagnosticsResponse) GetActivated() bool {
+	if m != nil {
+		return m.Activated
+	}
+	return false
+}
+
+func (m *GetDeviceJoinDiagnosticsResponse) GetLastSeenAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastSeenAt
+	}
+	return nil
+}
+
+func (m *GetDeviceJoinDiagnosticsResponse) GetAttempts() []*DeviceJoinAttempt {
+	if m != nil {
+		return m.Attempts
+	}
+	return nil
+}
+
+func (m *GetDeviceJoinDiagnosticsResponse) GetDiagnoses() []*DeviceJoinDiagnosis {
+	if m != nil {
+		return m.Diagnoses
+	}
+	return nil
+}
+
+type GetDeviceTrackRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Timestamp to start from (inclusive).
+	StartTimestamp *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	// Timestamp until to get from (exclusive).
+	EndTimestamp *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	// Max number of locations to return in the result-set (0 = no limit).
+	Limit int64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Cursor of the result-set (for cursor-based pagination).
+	Cursor               string   `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceTrackRequest) Reset()         { *m = GetDeviceTrackRequest{} }
+func (m *GetDeviceTrackRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceTrackRequest) ProtoMessage()    {}
+func (*GetDeviceTrackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{40}
+}
+func (m *GetDeviceTrackRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceTrackRequest.Unmarshal(m, b)
+}
+func (m *GetDeviceTrackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceTrackRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceTrackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceTrackRequest.Merge(dst, src)
+}
+func (m *GetDeviceTrackRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceTrackRequest.Size(m)
+}
+func (m *GetDeviceTrackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceTrackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceTrackRequest proto.InternalMessageInfo
+
+func (m *GetDeviceTrackRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *GetDeviceTrackRequest) GetStartTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTimestamp
+	}
+	return nil
+}
+
+func (m *GetDeviceTrackRequest) GetEndTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTimestamp
+	}
+	return nil
+}
+
+func (m *GetDeviceTrackRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetDeviceTrackRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type DeviceTrackPoint struct {
+	// Timestamp when the location was resolved or reported.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Location of the device.
+	Location             *common.Location `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DeviceTrackPoint) Reset()         { *m = DeviceTrackPoint{} }
+func (m *DeviceTrackPoint) String() string { return proto.CompactTextString(m) }
+func (*DeviceTrackPoint) ProtoMessage()    {}
+func (*DeviceTrackPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{41}
+}
+func (m *DeviceTrackPoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceTrackPoint.Unmarshal(m, b)
+}
+func (m *DeviceTrackPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceTrackPoint.Marshal(b, m, deterministic)
+}
+func (dst *DeviceTrackPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceTrackPoint.Merge(dst, src)
+}
+func (m *DeviceTrackPoint) XXX_Size() int {
+	return xxx_messageInfo_DeviceTrackPoint.Size(m)
+}
+func (m *DeviceTrackPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceTrackPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceTrackPoint proto.InternalMessageInfo
+
+func (m *DeviceTrackPoint) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *DeviceTrackPoint) GetLocation() *common.Location {
+	if m != nil {
+		return m.Location
+	}
+	return nil
+}
+
+type GetDeviceTrackResponse struct {
+	// Locations of the device.
+	Result []*DeviceTrackPoint `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	// GeoJSON Feature with LineString geometry. The coordinates are in
+	// [longitude, latitude, altitude] order. The properties contain the
+	// DevEUI and the timestamp, source and accuracy of each coordinate.
+	GeoJson *_struct.Struct `protobuf:"bytes,2,opt,name=geo_json,json=geoJSON,proto3" json:"geo_json,omitempty"`
+	// Cursor for retrieving the next page. This is empty when there are no
+	// more locations.
+	NextCursor           string   `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceTrackResponse) Reset()         { *m = GetDeviceTrackResponse{} }
+func (m *GetDeviceTrackResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceTrackResponse) ProtoMessage()    {}
+func (*GetDeviceTrackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{42}
+}
+func (m *GetDeviceTrackResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceTrackResponse.Unmarshal(m, b)
+}
+func (m *GetDeviceTrackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceTrackResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceTrackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceTrackResponse.Merge(dst, src)
+}
+func (m *GetDeviceTrackResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceTrackResponse.Size(m)
+}
+func (m *GetDeviceTrackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceTrackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceTrackResponse proto.InternalMessageInfo
+
+func (m *GetDeviceTrackResponse) GetResult() []*DeviceTrackPoint {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *GetDeviceTrackResponse) GetGeoJson() *_struct.Struct {
+	if m != nil {
+		return m.GeoJson
+	}
+	return nil
+}
+
+func (m *GetDeviceTrackResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type StreamDeviceFrameLogsRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamDeviceFrameLogsRequest) Reset()         { *m = StreamDeviceFrameLogsRequest{} }
+func (m *StreamDeviceFrameLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamDeviceFrameLogsRequest) ProtoMessage()    {}
+func (*StreamDeviceFrameLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{43}
+}
+func (m *StreamDeviceFrameLogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamDeviceFrameLogsRequest.Unmarshal(m, b)
+}
+func (m *StreamDeviceFrameLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamDeviceFrameLogsRequest.Marshal(b, m, deterministic)
+}
+func (dst *StreamDeviceFrameLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamDeviceFrameLogsRequest.Merge(dst, src)
+}
+func (m *StreamDeviceFrameLogsRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamDeviceFrameLogsRequest.Size(m)
+}
+func (m *StreamDeviceFrameLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamDeviceFrameLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamDeviceFrameLogsRequest proto.InternalMessageInfo
+
+func (m *StreamDeviceFrameLogsRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+type StreamDeviceFrameLogsResponse struct {
+	// Types that are valid to be assigned to Frame:
+	//	*StreamDeviceFrameLogsResponse_UplinkFrame
+	//	*StreamDeviceFrameLogsResponse_DownlinkFrame
+	Frame                isStreamDeviceFrameLogsResponse_Frame `protobuf_oneof:"frame"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
+}
+
+func (m *StreamDeviceFrameLogsResponse) Reset()         { *m = StreamDeviceFrameLogsResponse{} }
+func (m *StreamDeviceFrameLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamDeviceFrameLogsResponse) ProtoMessage()    {}
+func (*StreamDeviceFrameLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{44}
+}
+func (m *StreamDeviceFrameLogsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamDeviceFrameLogsResponse.Unmarshal(m, b)
+}
+func (m *StreamDeviceFrameLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamDeviceFrameLogsResponse.Marshal(b, m, deterministic)
+}
+func (dst *StreamDeviceFrameLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamDeviceFrameLogsResponse.Merge(dst, src)
+}
+func (m *StreamDeviceFrameLogsResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamDeviceFrameLogsResponse.Size(m)
+}
+func (m *StreamDeviceFrameLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamDeviceFrameLogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamDeviceFrameLogsResponse proto.InternalMessageInfo
+
+type isStreamDeviceFrameLogsResponse_Frame interface {
+	isStreamDeviceFrameLogsResponse_Frame()
+}
+
+type StreamDeviceFrameLogsResponse_UplinkFrame struct {
+	UplinkFrame *UplinkFrameLog `protobuf:"bytes,1,opt,name=uplink_frame,json=uplinkFrame,proto3,oneof"`
+}
+
+type StreamDeviceFrameLogsResponse_DownlinkFrame struct {
+	DownlinkFrame *DownlinkFrameLog `protobuf:"bytes,2,opt,name=downlink_frame,json=downlinkFrame,proto3,oneof"`
+}
+
+func (*StreamDeviceFrameLogsResponse_UplinkFrame) isStreamDeviceFrameLogsResponse_Frame() {}
+
+func (*StreamDeviceFrameLogsResponse_DownlinkFrame) isStreamDeviceFrameLogsResponse_Frame() {}
+
+func (m *StreamDeviceFrameLogsResponse) GetFrame() isStreamDeviceFrameLogsResponse_Frame {
+	if m != nil {
+		return m.Frame
+	}
+	return nil
+}
+
+func (m *StreamDeviceFrameLogsResponse) GetUplinkFrame() *UplinkFrameLog {
+	if x, ok := m.GetFrame().(*StreamDeviceFrameLogsResponse_UplinkFrame); ok {
+		return x.UplinkFrame
+	}
+	return nil
+}
+
+func (m *StreamDeviceFrameLogsResponse) GetDownlinkFrame() *DownlinkFrameLog {
+	if x, ok := m.GetFrame().(*StreamDeviceFrameLogsResponse_DownlinkFrame); ok {
+		return x.DownlinkFrame
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*StreamDeviceFrameLogsResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _StreamDeviceFrameLogsResponse_OneofMarshaler, _StreamDeviceFrameLogsResponse_OneofUnmarshaler, _StreamDeviceFrameLogsResponse_OneofSizer, []interface{}{
+		(*StreamDeviceFrameLogsResponse_UplinkFrame)(nil),
+		(*StreamDeviceFrameLogsResponse_DownlinkFrame)(nil),
+	}
+}
+
+func _StreamDeviceFrameLogsResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*StreamDeviceFrameLogsResponse)
+	// frame
+	switch x := m.Frame.(type) {
+	case *StreamDeviceFrameLogsResponse_UplinkFrame:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.UplinkFrame); err != nil {
+			return err
+		}
+	case *StreamDeviceFrameLogsResponse_DownlinkFrame:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.DownlinkFrame); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("StreamDeviceFrameLogsResponse.Frame has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _StreamDeviceFrameLogsResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*StreamDeviceFrameLogsResponse)
 	switch tag {
 	case 1: // frame.uplink_frame
 		if wire != proto.WireBytes {
@@ -1334,28 +2728,398 @@ func _StreamDeviceFrameLogsResponse_OneofUnmarshaler(msg proto.Message, tag, wir
 	}
 }
 
-func _StreamDeviceFrameLogsResponse_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*StreamDeviceFrameLogsResponse)
-	// frame
-	switch x := m.Frame.(type) {
-	case *StreamDeviceFrameLogsResponse_UplinkFrame:
-		s := proto.Size(x.UplinkFrame)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *StreamDeviceFrameLogsResponse_DownlinkFrame:
-		s := proto.Size(x.DownlinkFrame)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+func _StreamDeviceFrameLogsResponse_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*StreamDeviceFrameLogsResponse)
+	// frame
+	switch x := m.Frame.(type) {
+	case *StreamDeviceFrameLogsResponse_UplinkFrame:
+		s := proto.Size(x.UplinkFrame)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *StreamDeviceFrameLogsResponse_DownlinkFrame:
+		s := proto.Size(x.DownlinkFrame)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type StreamDeviceEventLogsRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Resume token of the last received event.
+	// When set, the buffered events after this event are sent first, so
+	// that a stream can be resumed after a disconnect without losing events.
+	ResumeToken          string   `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamDeviceEventLogsRequest) Reset()         { *m = StreamDeviceEventLogsRequest{} }
+func (m *StreamDeviceEventLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamDeviceEventLogsRequest) ProtoMessage()    {}
+func (*StreamDeviceEventLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{45}
+}
+func (m *StreamDeviceEventLogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamDeviceEventLogsRequest.Unmarshal(m, b)
+}
+func (m *StreamDeviceEventLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamDeviceEventLogsRequest.Marshal(b, m, deterministic)
+}
+func (dst *StreamDeviceEventLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamDeviceEventLogsRequest.Merge(dst, src)
+}
+func (m *StreamDeviceEventLogsRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamDeviceEventLogsRequest.Size(m)
+}
+func (m *StreamDeviceEventLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamDeviceEventLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamDeviceEventLogsRequest proto.InternalMessageInfo
+
+func (m *StreamDeviceEventLogsRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *StreamDeviceEventLogsRequest) GetResumeToken() string {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return ""
+}
+
+type StreamDeviceEventLogsResponse struct {
+	// The event type.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// The event payload in JSON encoding.
+	PayloadJson string `protobuf:"bytes,2,opt,name=payload_json,json=payloadJSON,proto3" json:"payload_json,omitempty"`
+	// Sequence number of the event.
+	// This number is incremented by one for every event of the device, a
+	// gap indicates that events were missed (e.g. when resuming after the
+	// events have been removed from the buffer).
+	Seq uint64 `protobuf:"varint,3,opt,name=seq,proto3" json:"seq,omitempty"`
+	// Resume token for resuming the stream after this event.
+	ResumeToken          string   `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamDeviceEventLogsResponse) Reset()         { *m = StreamDeviceEventLogsResponse{} }
+func (m *StreamDeviceEventLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamDeviceEventLogsResponse) ProtoMessage()    {}
+func (*StreamDeviceEventLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{46}
+}
+func (m *StreamDeviceEventLogsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamDeviceEventLogsResponse.Unmarshal(m, b)
+}
+func (m *StreamDeviceEventLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamDeviceEventLogsResponse.Marshal(b, m, deterministic)
+}
+func (dst *StreamDeviceEventLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamDeviceEventLogsResponse.Merge(dst, src)
+}
+func (m *StreamDeviceEventLogsResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamDeviceEventLogsResponse.Size(m)
+}
+func (m *StreamDeviceEventLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamDeviceEventLogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamDeviceEventLogsResponse proto.InternalMessageInfo
+
+func (m *StreamDeviceEventLogsResponse) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *StreamDeviceEventLogsResponse) GetPayloadJson() string {
+	if m != nil {
+		return m.PayloadJson
+	}
+	return ""
+}
+
+func (m *StreamDeviceEventLogsResponse) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *StreamDeviceEventLogsResponse) GetResumeToken() string {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return ""
+}
+
+type StartDeviceFrameCaptureRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Duration of the capture (in seconds).
+	// When not set or when exceeding the configured max. duration, the
+	// max. duration is used.
+	Duration             uint32   `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartDeviceFrameCaptureRequest) Reset()         { *m = StartDeviceFrameCaptureRequest{} }
+func (m *StartDeviceFrameCaptureRequest) String() string { return proto.CompactTextString(m) }
+func (*StartDeviceFrameCaptureRequest) ProtoMessage()    {}
+func (*StartDeviceFrameCaptureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{47}
+}
+func (m *StartDeviceFrameCaptureRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartDeviceFrameCaptureRequest.Unmarshal(m, b)
+}
+func (m *StartDeviceFrameCaptureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartDeviceFrameCaptureRequest.Marshal(b, m, deterministic)
+}
+func (dst *StartDeviceFrameCaptureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartDeviceFrameCaptureRequest.Merge(dst, src)
+}
+func (m *StartDeviceFrameCaptureRequest) XXX_Size() int {
+	return xxx_messageInfo_StartDeviceFrameCaptureRequest.Size(m)
+}
+func (m *StartDeviceFrameCaptureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartDeviceFrameCaptureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartDeviceFrameCaptureRequest proto.InternalMessageInfo
+
+func (m *StartDeviceFrameCaptureRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *StartDeviceFrameCaptureRequest) GetDuration() uint32 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type StopDeviceFrameCaptureRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StopDeviceFrameCaptureRequest) Reset()         { *m = StopDeviceFrameCaptureRequest{} }
+func (m *StopDeviceFrameCaptureRequest) String() string { return proto.CompactTextString(m) }
+func (*StopDeviceFrameCaptureRequest) ProtoMessage()    {}
+func (*StopDeviceFrameCaptureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{48}
+}
+func (m *StopDeviceFrameCaptureRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StopDeviceFrameCaptureRequest.Unmarshal(m, b)
+}
+func (m *StopDeviceFrameCaptureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StopDeviceFrameCaptureRequest.Marshal(b, m, deterministic)
+}
+func (dst *StopDeviceFrameCaptureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopDeviceFrameCaptureRequest.Merge(dst, src)
+}
+func (m *StopDeviceFrameCaptureRequest) XXX_Size() int {
+	return xxx_messageInfo_StopDeviceFrameCaptureRequest.Size(m)
+}
+func (m *StopDeviceFrameCaptureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopDeviceFrameCaptureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopDeviceFrameCaptureRequest proto.InternalMessageInfo
+
+func (m *StopDeviceFrameCaptureRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+type GetDeviceFrameCaptureRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceFrameCaptureRequest) Reset()         { *m = GetDeviceFrameCaptureRequest{} }
+func (m *GetDeviceFrameCaptureRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceFrameCaptureRequest) ProtoMessage()    {}
+func (*GetDeviceFrameCaptureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{49}
+}
+func (m *GetDeviceFrameCaptureRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceFrameCaptureRequest.Unmarshal(m, b)
+}
+func (m *GetDeviceFrameCaptureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceFrameCaptureRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceFrameCaptureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceFrameCaptureRequest.Merge(dst, src)
+}
+func (m *GetDeviceFrameCaptureRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceFrameCaptureRequest.Size(m)
+}
+func (m *GetDeviceFrameCaptureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceFrameCaptureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceFrameCaptureRequest proto.InternalMessageInfo
+
+func (m *GetDeviceFrameCaptureRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+type CapturedFrame struct {
+	// Time when the frame was captured.
+	ReceivedAt *timestamp.Timestamp `protobuf:"bytes,1,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	// Contains an uplink frame.
+	UplinkFrame *UplinkFrameLog `protobuf:"bytes,2,opt,name=uplink_frame,json=uplinkFrame,proto3" json:"uplink_frame,omitempty"`
+	// Contains a downlink frame.
+	DownlinkFrame        *DownlinkFrameLog `protobuf:"bytes,3,opt,name=downlink_frame,json=downlinkFrame,proto3" json:"downlink_frame,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CapturedFrame) Reset()         { *m = CapturedFrame{} }
+func (m *CapturedFrame) String() string { return proto.CompactTextString(m) }
+func (*CapturedFrame) ProtoMessage()    {}
+func (*CapturedFrame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{50}
+}
+func (m *CapturedFrame) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CapturedFrame.Unmarshal(m, b)
+}
+func (m *CapturedFrame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CapturedFrame.Marshal(b, m, deterministic)
+}
+func (dst *CapturedFrame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CapturedFrame.Merge(dst, src)
+}
+func (m *CapturedFrame) XXX_Size() int {
+	return xxx_messageInfo_CapturedFrame.Size(m)
+}
+func (m *CapturedFrame) XXX_DiscardUnknown() {
+	xxx_messageInfo_CapturedFrame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CapturedFrame proto.InternalMessageInfo
+
+func (m *CapturedFrame) GetReceivedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReceivedAt
+	}
+	return nil
+}
+
+func (m *CapturedFrame) GetUplinkFrame() *UplinkFrameLog {
+	if m != nil {
+		return m.UplinkFrame
+	}
+	return nil
+}
+
+func (m *CapturedFrame) GetDownlinkFrame() *DownlinkFrameLog {
+	if m != nil {
+		return m.DownlinkFrame
+	}
+	return nil
+}
+
+type GetDeviceFrameCaptureResponse struct {
+	// The capture is active.
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// Start of the capture.
+	StartedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// End of the capture window.
+	EndsAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	// Captured frames (oldest first).
+	Frames               []*CapturedFrame `protobuf:"bytes,4,rep,name=frames,proto3" json:"frames,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetDeviceFrameCaptureResponse) Reset()         { *m = GetDeviceFrameCaptureResponse{} }
+func (m *GetDeviceFrameCaptureResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceFrameCaptureResponse) ProtoMessage()    {}
+func (*GetDeviceFrameCaptureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{51}
+}
+func (m *GetDeviceFrameCaptureResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceFrameCaptureResponse.Unmarshal(m, b)
+}
+func (m *GetDeviceFrameCaptureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceFrameCaptureResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceFrameCaptureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceFrameCaptureResponse.Merge(dst, src)
+}
+func (m *GetDeviceFrameCaptureResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceFrameCaptureResponse.Size(m)
+}
+func (m *GetDeviceFrameCaptureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceFrameCaptureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceFrameCaptureResponse proto.InternalMessageInfo
+
+func (m *GetDeviceFrameCaptureResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *GetDeviceFrameCaptureResponse) GetStartedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartedAt
 	}
-	return n
+	return nil
 }
 
-type StreamDeviceEventLogsRequest struct {
+func (m *GetDeviceFrameCaptureResponse) GetEndsAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndsAt
+	}
+	return nil
+}
+
+func (m *GetDeviceFrameCaptureResponse) GetFrames() []*CapturedFrame {
+	if m != nil {
+		return m.Frames
+	}
+	return nil
+}
+
+type ExportDeviceDataRequest struct {
 	// Device EUI (HEX encoded).
 	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1363,87 +3127,196 @@ type StreamDeviceEventLogsRequest struct {
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StreamDeviceEventLogsRequest) Reset()         { *m = StreamDeviceEventLogsRequest{} }
-func (m *StreamDeviceEventLogsRequest) String() string { return proto.CompactTextString(m) }
-func (*StreamDeviceEventLogsRequest) ProtoMessage()    {}
-func (*StreamDeviceEventLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{23}
+func (m *ExportDeviceDataRequest) Reset()         { *m = ExportDeviceDataRequest{} }
+func (m *ExportDeviceDataRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportDeviceDataRequest) ProtoMessage()    {}
+func (*ExportDeviceDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{52}
 }
-func (m *StreamDeviceEventLogsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StreamDeviceEventLogsRequest.Unmarshal(m, b)
+func (m *ExportDeviceDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportDeviceDataRequest.Unmarshal(m, b)
 }
-func (m *StreamDeviceEventLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StreamDeviceEventLogsRequest.Marshal(b, m, deterministic)
+func (m *ExportDeviceDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportDeviceDataRequest.Marshal(b, m, deterministic)
 }
-func (dst *StreamDeviceEventLogsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamDeviceEventLogsRequest.Merge(dst, src)
+func (dst *ExportDeviceDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportDeviceDataRequest.Merge(dst, src)
 }
-func (m *StreamDeviceEventLogsRequest) XXX_Size() int {
-	return xxx_messageInfo_StreamDeviceEventLogsRequest.Size(m)
+func (m *ExportDeviceDataRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportDeviceDataRequest.Size(m)
 }
-func (m *StreamDeviceEventLogsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamDeviceEventLogsRequest.DiscardUnknown(m)
+func (m *ExportDeviceDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportDeviceDataRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamDeviceEventLogsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ExportDeviceDataRequest proto.InternalMessageInfo
 
-func (m *StreamDeviceEventLogsRequest) GetDevEui() string {
+func (m *ExportDeviceDataRequest) GetDevEui() string {
 	if m != nil {
 		return m.DevEui
 	}
 	return ""
 }
 
-type StreamDeviceEventLogsResponse struct {
-	// The event type.
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	// The event payload in JSON encoding.
-	PayloadJson          string   `protobuf:"bytes,2,opt,name=payload_json,json=payloadJSON,proto3" json:"payload_json,omitempty"`
+type ExportDeviceDataResponse struct {
+	// Exported data (JSON document).
+	DataJson             string   `protobuf:"bytes,1,opt,name=data_json,json=dataJSON,proto3" json:"data_json,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StreamDeviceEventLogsResponse) Reset()         { *m = StreamDeviceEventLogsResponse{} }
-func (m *StreamDeviceEventLogsResponse) String() string { return proto.CompactTextString(m) }
-func (*StreamDeviceEventLogsResponse) ProtoMessage()    {}
-func (*StreamDeviceEventLogsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_870276a56ac00da5, []int{24}
+func (m *ExportDeviceDataResponse) Reset()         { *m = ExportDeviceDataResponse{} }
+func (m *ExportDeviceDataResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportDeviceDataResponse) ProtoMessage()    {}
+func (*ExportDeviceDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{53}
 }
-func (m *StreamDeviceEventLogsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StreamDeviceEventLogsResponse.Unmarshal(m, b)
+func (m *ExportDeviceDataResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportDeviceDataResponse.Unmarshal(m, b)
 }
-func (m *StreamDeviceEventLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StreamDeviceEventLogsResponse.Marshal(b, m, deterministic)
+func (m *ExportDeviceDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportDeviceDataResponse.Marshal(b, m, deterministic)
 }
-func (dst *StreamDeviceEventLogsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamDeviceEventLogsResponse.Merge(dst, src)
+func (dst *ExportDeviceDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportDeviceDataResponse.Merge(dst, src)
 }
-func (m *StreamDeviceEventLogsResponse) XXX_Size() int {
-	return xxx_messageInfo_StreamDeviceEventLogsResponse.Size(m)
+func (m *ExportDeviceDataResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportDeviceDataResponse.Size(m)
 }
-func (m *StreamDeviceEventLogsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamDeviceEventLogsResponse.DiscardUnknown(m)
+func (m *ExportDeviceDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportDeviceDataResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StreamDeviceEventLogsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ExportDeviceDataResponse proto.InternalMessageInfo
 
-func (m *StreamDeviceEventLogsResponse) GetType() string {
+func (m *ExportDeviceDataResponse) GetDataJson() string {
 	if m != nil {
-		return m.Type
+		return m.DataJson
 	}
 	return ""
 }
 
-func (m *StreamDeviceEventLogsResponse) GetPayloadJson() string {
+type EraseDeviceDataRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EraseDeviceDataRequest) Reset()         { *m = EraseDeviceDataRequest{} }
+func (m *EraseDeviceDataRequest) String() string { return proto.CompactTextString(m) }
+func (*EraseDeviceDataRequest) ProtoMessage()    {}
+func (*EraseDeviceDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{54}
+}
+func (m *EraseDeviceDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EraseDeviceDataRequest.Unmarshal(m, b)
+}
+func (m *EraseDeviceDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EraseDeviceDataRequest.Marshal(b, m, deterministic)
+}
+func (dst *EraseDeviceDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EraseDeviceDataRequest.Merge(dst, src)
+}
+func (m *EraseDeviceDataRequest) XXX_Size() int {
+	return xxx_messageInfo_EraseDeviceDataRequest.Size(m)
+}
+func (m *EraseDeviceDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EraseDeviceDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EraseDeviceDataRequest proto.InternalMessageInfo
+
+func (m *EraseDeviceDataRequest) GetDevEui() string {
 	if m != nil {
-		return m.PayloadJson
+		return m.DevEui
+	}
+	return ""
+}
+
+type SetDeviceLegalHoldRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetDeviceLegalHoldRequest) Reset()         { *m = SetDeviceLegalHoldRequest{} }
+func (m *SetDeviceLegalHoldRequest) String() string { return proto.CompactTextString(m) }
+func (*SetDeviceLegalHoldRequest) ProtoMessage()    {}
+func (*SetDeviceLegalHoldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{55}
+}
+func (m *SetDeviceLegalHoldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetDeviceLegalHoldRequest.Unmarshal(m, b)
+}
+func (m *SetDeviceLegalHoldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetDeviceLegalHoldRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetDeviceLegalHoldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDeviceLegalHoldRequest.Merge(dst, src)
+}
+func (m *SetDeviceLegalHoldRequest) XXX_Size() int {
+	return xxx_messageInfo_SetDeviceLegalHoldRequest.Size(m)
+}
+func (m *SetDeviceLegalHoldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDeviceLegalHoldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDeviceLegalHoldRequest proto.InternalMessageInfo
+
+func (m *SetDeviceLegalHoldRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+type ClearDeviceLegalHoldRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClearDeviceLegalHoldRequest) Reset()         { *m = ClearDeviceLegalHoldRequest{} }
+func (m *ClearDeviceLegalHoldRequest) String() string { return proto.CompactTextString(m) }
+func (*ClearDeviceLegalHoldRequest) ProtoMessage()    {}
+func (*ClearDeviceLegalHoldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_870276a56ac00da5, []int{56}
+}
+func (m *ClearDeviceLegalHoldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClearDeviceLegalHoldRequest.Unmarshal(m, b)
+}
+func (m *ClearDeviceLegalHoldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClearDeviceLegalHoldRequest.Marshal(b, m, deterministic)
+}
+func (dst *ClearDeviceLegalHoldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearDeviceLegalHoldRequest.Merge(dst, src)
+}
+func (m *ClearDeviceLegalHoldRequest) XXX_Size() int {
+	return xxx_messageInfo_ClearDeviceLegalHoldRequest.Size(m)
+}
+func (m *ClearDeviceLegalHoldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearDeviceLegalHoldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClearDeviceLegalHoldRequest proto.InternalMessageInfo
+
+func (m *ClearDeviceLegalHoldRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
 	}
 	return ""
 }
 
 func init() {
 	proto.RegisterType((*Device)(nil), "api.Device")
+	proto.RegisterMapType((map[string]string)(nil), "api.Device.VariablesEntry")
 	proto.RegisterType((*DeviceListItem)(nil), "api.DeviceListItem")
 	proto.RegisterType((*DeviceKeys)(nil), "api.DeviceKeys")
 	proto.RegisterType((*CreateDeviceRequest)(nil), "api.CreateDeviceRequest")
@@ -1462,12 +3335,44 @@ func init() {
 	proto.RegisterType((*ActivateDeviceRequest)(nil), "api.ActivateDeviceRequest")
 	proto.RegisterType((*GetDeviceActivationRequest)(nil), "api.GetDeviceActivationRequest")
 	proto.RegisterType((*GetDeviceActivationResponse)(nil), "api.GetDeviceActivationResponse")
+	proto.RegisterType((*DeactivateDeviceRequest)(nil), "api.DeactivateDeviceRequest")
+	proto.RegisterType((*GetWrappedAppSKeyRequest)(nil), "api.GetWrappedAppSKeyRequest")
+	proto.RegisterType((*GetWrappedAppSKeyResponse)(nil), "api.GetWrappedAppSKeyResponse")
+	proto.RegisterType((*DecryptDeviceUplinkRequest)(nil), "api.DecryptDeviceUplinkRequest")
+	proto.RegisterType((*DecryptedDeviceUplink)(nil), "api.DecryptedDeviceUplink")
+	proto.RegisterType((*CodecErrorDetails)(nil), "api.CodecErrorDetails")
+	proto.RegisterType((*DecryptDeviceUplinkResponse)(nil), "api.DecryptDeviceUplinkResponse")
 	proto.RegisterType((*GetRandomDevAddrRequest)(nil), "api.GetRandomDevAddrRequest")
 	proto.RegisterType((*GetRandomDevAddrResponse)(nil), "api.GetRandomDevAddrResponse")
+	proto.RegisterType((*DeviceDevNonce)(nil), "api.DeviceDevNonce")
+	proto.RegisterType((*ListDeviceDevNoncesRequest)(nil), "api.ListDeviceDevNoncesRequest")
+	proto.RegisterType((*ListDeviceDevNoncesResponse)(nil), "api.ListDeviceDevNoncesResponse")
+	proto.RegisterType((*DeleteDeviceDevNoncesRequest)(nil), "api.DeleteDeviceDevNoncesRequest")
+	proto.RegisterType((*GetDeviceTwinRequest)(nil), "api.GetDeviceTwinRequest")
+	proto.RegisterType((*GetDeviceTwinResponse)(nil), "api.GetDeviceTwinResponse")
+	proto.RegisterType((*UpdateDeviceTwinDesiredStateRequest)(nil), "api.UpdateDeviceTwinDesiredStateRequest")
+	proto.RegisterType((*UpdateDeviceTwinDesiredStateResponse)(nil), "api.UpdateDeviceTwinDesiredStateResponse")
+	proto.RegisterType((*GetDeviceJoinDiagnosticsRequest)(nil), "api.GetDeviceJoinDiagnosticsRequest")
+	proto.RegisterType((*DeviceJoinAttempt)(nil), "api.DeviceJoinAttempt")
+	proto.RegisterType((*DeviceJoinDiagnosis)(nil), "api.DeviceJoinDiagnosis")
+	proto.RegisterType((*GetDeviceJoinDiagnosticsResponse)(nil), "api.GetDeviceJoinDiagnosticsResponse")
+	proto.RegisterType((*GetDeviceTrackRequest)(nil), "api.GetDeviceTrackRequest")
+	proto.RegisterType((*DeviceTrackPoint)(nil), "api.DeviceTrackPoint")
+	proto.RegisterType((*GetDeviceTrackResponse)(nil), "api.GetDeviceTrackResponse")
 	proto.RegisterType((*StreamDeviceFrameLogsRequest)(nil), "api.StreamDeviceFrameLogsRequest")
 	proto.RegisterType((*StreamDeviceFrameLogsResponse)(nil), "api.StreamDeviceFrameLogsResponse")
 	proto.RegisterType((*StreamDeviceEventLogsRequest)(nil), "api.StreamDeviceEventLogsRequest")
 	proto.RegisterType((*StreamDeviceEventLogsResponse)(nil), "api.StreamDeviceEventLogsResponse")
+	proto.RegisterType((*StartDeviceFrameCaptureRequest)(nil), "api.StartDeviceFrameCaptureRequest")
+	proto.RegisterType((*StopDeviceFrameCaptureRequest)(nil), "api.StopDeviceFrameCaptureRequest")
+	proto.RegisterType((*GetDeviceFrameCaptureRequest)(nil), "api.GetDeviceFrameCaptureRequest")
+	proto.RegisterType((*CapturedFrame)(nil), "api.CapturedFrame")
+	proto.RegisterType((*GetDeviceFrameCaptureResponse)(nil), "api.GetDeviceFrameCaptureResponse")
+	proto.RegisterType((*ExportDeviceDataRequest)(nil), "api.ExportDeviceDataRequest")
+	proto.RegisterType((*ExportDeviceDataResponse)(nil), "api.ExportDeviceDataResponse")
+	proto.RegisterType((*EraseDeviceDataRequest)(nil), "api.EraseDeviceDataRequest")
+	proto.RegisterType((*SetDeviceLegalHoldRequest)(nil), "api.SetDeviceLegalHoldRequest")
+	proto.RegisterType((*ClearDeviceLegalHoldRequest)(nil), "api.ClearDeviceLegalHoldRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1504,8 +3409,75 @@ type DeviceServiceClient interface {
 	Activate(ctx context.Context, in *ActivateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// GetActivation returns the current activation details of the device (OTAA and ABP).
 	GetActivation(ctx context.Context, in *GetDeviceActivationRequest, opts ...grpc.CallOption) (*GetDeviceActivationResponse, error)
+	// GetWrappedAppSKey returns the current AppSKey of the device, wrapped
+	// using the given KEK or encrypted using the given RSA public key.
+	// This can be used when the payload decryption happens in an external
+	// system. Each call is logged.
+	GetWrappedAppSKey(ctx context.Context, in *GetWrappedAppSKeyRequest, opts ...grpc.CallOption) (*GetWrappedAppSKeyResponse, error)
+	// Deactivate de-activates the device.
+	// This removes the activation from the application-server and instructs
+	// the network-server to drop the device-session (including the
+	// frame-counters), forcing the device to (re)join (OTAA) or to be
+	// re-activated (ABP).
+	Deactivate(ctx context.Context, in *DeactivateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// DecryptUplink decrypts the FRMPayload of the stored (captured or
+	// quarantined) uplink with the given frame-counter using the recorded
+	// AppSKey(s) of the device. This can be used to debug "garbage payload"
+	// issues caused by mismatching keys.
+	//   * This endpoint is intended for debugging only and is restricted to global admin users.
+	//   * Each call is logged.
+	DecryptUplink(ctx context.Context, in *DecryptDeviceUplinkRequest, opts ...grpc.CallOption) (*DecryptDeviceUplinkResponse, error)
 	// GetRandomDevAddr returns a random DevAddr taking the NwkID prefix into account.
 	GetRandomDevAddr(ctx context.Context, in *GetRandomDevAddrRequest, opts ...grpc.CallOption) (*GetRandomDevAddrResponse, error)
+	// ListDevNonces returns the DevNonces used by the device (OTAA).
+	ListDevNonces(ctx context.Context, in *ListDeviceDevNoncesRequest, opts ...grpc.CallOption) (*ListDeviceDevNoncesResponse, error)
+	// DeleteDevNonces deletes the DevNonces used by the device (OTAA).
+	// This must be used after a factory-reset of the device, as the device
+	// will re-use previously used DevNonces (which would else be rejected).
+	DeleteDevNonces(ctx context.Context, in *DeleteDeviceDevNoncesRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetJoinDiagnostics returns the recent join attempts of the given device
+	// (OTAA), together with the probable causes in case the device fails to
+	// join.
+	GetJoinDiagnostics(ctx context.Context, in *GetDeviceJoinDiagnosticsRequest, opts ...grpc.CallOption) (*GetDeviceJoinDiagnosticsResponse, error)
+	// GetTwin returns the device twin, containing the reported state (the
+	// latest value of each decoded field) and the desired state of the device.
+	GetTwin(ctx context.Context, in *GetDeviceTwinRequest, opts ...grpc.CallOption) (*GetDeviceTwinResponse, error)
+	// UpdateTwinDesiredState merges the given desired state into the desired
+	// state of the device twin. When the desired state differs from the
+	// reported state, the delta is encoded using the application codec and
+	// enqueued as downlink.
+	UpdateTwinDesiredState(ctx context.Context, in *UpdateDeviceTwinDesiredStateRequest, opts ...grpc.CallOption) (*UpdateDeviceTwinDesiredStateResponse, error)
+	// GetTrack returns the location track of the given device within the given
+	// time-range, ordered by time. The track is also returned as GeoJSON
+	// Feature containing a LineString geometry.
+	GetTrack(ctx context.Context, in *GetDeviceTrackRequest, opts ...grpc.CallOption) (*GetDeviceTrackResponse, error)
+	// StartFrameCapture starts a raw frame capture for the given device.
+	// During the capture window, the uplink and downlink frames of the device
+	// (PHYPayload and RX / TX information) are stored so that these can be
+	// downloaded using GetFrameCapture. This replaces the previous capture.
+	StartFrameCapture(ctx context.Context, in *StartDeviceFrameCaptureRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// StopFrameCapture stops the raw frame capture of the given device.
+	// The captured frames can still be downloaded until they expire.
+	StopFrameCapture(ctx context.Context, in *StopDeviceFrameCaptureRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetFrameCapture returns the raw frame capture of the given device,
+	// including the captured frames.
+	GetFrameCapture(ctx context.Context, in *GetDeviceFrameCaptureRequest, opts ...grpc.CallOption) (*GetDeviceFrameCaptureResponse, error)
+	// ExportData exports all data stored for the given device (data-subject
+	// access request), including the activations, locations, statistics and
+	// security events. The keys are only included when the user is allowed to
+	// read the device keys.
+	ExportData(ctx context.Context, in *ExportDeviceDataRequest, opts ...grpc.CallOption) (*ExportDeviceDataResponse, error)
+	// EraseData irreversibly erases all data stored for the given device
+	// (right to erasure). The device is deleted and the references in the
+	// security events are replaced by a random pseudonym.
+	EraseData(ctx context.Context, in *EraseDeviceDataRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// SetLegalHold places the given device under legal hold. A device under
+	// legal hold can not be deleted or erased and its data is excluded from the
+	// retention pruning. Only global admin users are allowed to set or clear
+	// the legal hold.
+	SetLegalHold(ctx context.Context, in *SetDeviceLegalHoldRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ClearLegalHold clears the legal hold of the given device.
+	ClearLegalHold(ctx context.Context, in *ClearDeviceLegalHoldRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// StreamFrameLogs streams the uplink and downlink frame-logs for the given DevEUI.
 	//   * These are the raw LoRaWAN frames and this endpoint is intended for debugging only.
 	//   * This endpoint does not work from a web-browser.
@@ -1587,45 +3559,189 @@ func (c *deviceServiceClient) GetKeys(ctx context.Context, in *GetDeviceKeysRequ
 	return out, nil
 }
 
-func (c *deviceServiceClient) UpdateKeys(ctx context.Context, in *UpdateDeviceKeysRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.DeviceService/UpdateKeys", in, out, opts...)
+func (c *deviceServiceClient) UpdateKeys(ctx context.Context, in *UpdateDeviceKeysRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/UpdateKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) DeleteKeys(ctx context.Context, in *DeleteDeviceKeysRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/DeleteKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) Activate(ctx context.Context, in *ActivateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/Activate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) GetActivation(ctx context.Context, in *GetDeviceActivationRequest, opts ...grpc.CallOption) (*GetDeviceActivationResponse, error) {
+	out := new(GetDeviceActivationResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/GetActivation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) GetWrappedAppSKey(ctx context.Context, in *GetWrappedAppSKeyRequest, opts ...grpc.CallOption) (*GetWrappedAppSKeyResponse, error) {
+	out := new(GetWrappedAppSKeyResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/GetWrappedAppSKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) Deactivate(ctx context.Context, in *DeactivateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/Deactivate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) DecryptUplink(ctx context.Context, in *DecryptDeviceUplinkRequest, opts ...grpc.CallOption) (*DecryptDeviceUplinkResponse, error) {
+	out := new(DecryptDeviceUplinkResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/DecryptUplink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) GetRandomDevAddr(ctx context.Context, in *GetRandomDevAddrRequest, opts ...grpc.CallOption) (*GetRandomDevAddrResponse, error) {
+	out := new(GetRandomDevAddrResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/GetRandomDevAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) ListDevNonces(ctx context.Context, in *ListDeviceDevNoncesRequest, opts ...grpc.CallOption) (*ListDeviceDevNoncesResponse, error) {
+	out := new(ListDeviceDevNoncesResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/ListDevNonces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) DeleteDevNonces(ctx context.Context, in *DeleteDeviceDevNoncesRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/DeleteDevNonces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) GetJoinDiagnostics(ctx context.Context, in *GetDeviceJoinDiagnosticsRequest, opts ...grpc.CallOption) (*GetDeviceJoinDiagnosticsResponse, error) {
+	out := new(GetDeviceJoinDiagnosticsResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/GetJoinDiagnostics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) GetTwin(ctx context.Context, in *GetDeviceTwinRequest, opts ...grpc.CallOption) (*GetDeviceTwinResponse, error) {
+	out := new(GetDeviceTwinResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/GetTwin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) UpdateTwinDesiredState(ctx context.Context, in *UpdateDeviceTwinDesiredStateRequest, opts ...grpc.CallOption) (*UpdateDeviceTwinDesiredStateResponse, error) {
+	out := new(UpdateDeviceTwinDesiredStateResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/UpdateTwinDesiredState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) GetTrack(ctx context.Context, in *GetDeviceTrackRequest, opts ...grpc.CallOption) (*GetDeviceTrackResponse, error) {
+	out := new(GetDeviceTrackResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/GetTrack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) StartFrameCapture(ctx context.Context, in *StartDeviceFrameCaptureRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/StartFrameCapture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) StopFrameCapture(ctx context.Context, in *StopDeviceFrameCaptureRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/StopFrameCapture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceServiceClient) GetFrameCapture(ctx context.Context, in *GetDeviceFrameCaptureRequest, opts ...grpc.CallOption) (*GetDeviceFrameCaptureResponse, error) {
+	out := new(GetDeviceFrameCaptureResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/GetFrameCapture", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *deviceServiceClient) DeleteKeys(ctx context.Context, in *DeleteDeviceKeysRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.DeviceService/DeleteKeys", in, out, opts...)
+func (c *deviceServiceClient) ExportData(ctx context.Context, in *ExportDeviceDataRequest, opts ...grpc.CallOption) (*ExportDeviceDataResponse, error) {
+	out := new(ExportDeviceDataResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/ExportData", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *deviceServiceClient) Activate(ctx context.Context, in *ActivateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+func (c *deviceServiceClient) EraseData(ctx context.Context, in *EraseDeviceDataRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.DeviceService/Activate", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/EraseData", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *deviceServiceClient) GetActivation(ctx context.Context, in *GetDeviceActivationRequest, opts ...grpc.CallOption) (*GetDeviceActivationResponse, error) {
-	out := new(GetDeviceActivationResponse)
-	err := c.cc.Invoke(ctx, "/api.DeviceService/GetActivation", in, out, opts...)
+func (c *deviceServiceClient) SetLegalHold(ctx context.Context, in *SetDeviceLegalHoldRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/SetLegalHold", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *deviceServiceClient) GetRandomDevAddr(ctx context.Context, in *GetRandomDevAddrRequest, opts ...grpc.CallOption) (*GetRandomDevAddrResponse, error) {
-	out := new(GetRandomDevAddrResponse)
-	err := c.cc.Invoke(ctx, "/api.DeviceService/GetRandomDevAddr", in, out, opts...)
+func (c *deviceServiceClient) ClearLegalHold(ctx context.Context, in *ClearDeviceLegalHoldRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.DeviceService/ClearLegalHold", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1720,8 +3836,75 @@ type DeviceServiceServer interface {
 	Activate(context.Context, *ActivateDeviceRequest) (*empty.Empty, error)
 	// GetActivation returns the current activation details of the device (OTAA and ABP).
 	GetActivation(context.Context, *GetDeviceActivationRequest) (*GetDeviceActivationResponse, error)
+	// GetWrappedAppSKey returns the current AppSKey of the device, wrapped
+	// using the given KEK or encrypted using the given RSA public key.
+	// This can be used when the payload decryption happens in an external
+	// system. Each call is logged.
+	GetWrappedAppSKey(context.Context, *GetWrappedAppSKeyRequest) (*GetWrappedAppSKeyResponse, error)
+	// Deactivate de-activates the device.
+	// This removes the activation from the application-server and instructs
+	// the network-server to drop the device-session (including the
+	// frame-counters), forcing the device to (re)join (OTAA) or to be
+	// re-activated (ABP).
+	Deactivate(context.Context, *DeactivateDeviceRequest) (*empty.Empty, error)
+	// DecryptUplink decrypts the FRMPayload of the stored (captured or
+	// quarantined) uplink with the given frame-counter using the recorded
+	// AppSKey(s) of the device. This can be used to debug "garbage payload"
+	// issues caused by mismatching keys.
+	//   * This endpoint is intended for debugging only and is restricted to global admin users.
+	//   * Each call is logged.
+	DecryptUplink(context.Context, *DecryptDeviceUplinkRequest) (*DecryptDeviceUplinkResponse, error)
 	// GetRandomDevAddr returns a random DevAddr taking the NwkID prefix into account.
 	GetRandomDevAddr(context.Context, *GetRandomDevAddrRequest) (*GetRandomDevAddrResponse, error)
+	// ListDevNonces returns the DevNonces used by the device (OTAA).
+	ListDevNonces(context.Context, *ListDeviceDevNoncesRequest) (*ListDeviceDevNoncesResponse, error)
+	// DeleteDevNonces deletes the DevNonces used by the device (OTAA).
+	// This must be used after a factory-reset of the device, as the device
+	// will re-use previously used DevNonces (which would else be rejected).
+	DeleteDevNonces(context.Context, *DeleteDeviceDevNoncesRequest) (*empty.Empty, error)
+	// GetJoinDiagnostics returns the recent join attempts of the given device
+	// (OTAA), together with the probable causes in case the device fails to
+	// join.
+	GetJoinDiagnostics(context.Context, *GetDeviceJoinDiagnosticsRequest) (*GetDeviceJoinDiagnosticsResponse, error)
+	// GetTwin returns the device twin, containing the reported state (the
+	// latest value of each decoded field) and the desired state of the device.
+	GetTwin(context.Context, *GetDeviceTwinRequest) (*GetDeviceTwinResponse, error)
+	// UpdateTwinDesiredState merges the given desired state into the desired
+	// state of the device twin. When the desired state differs from the
+	// reported state, the delta is encoded using the application codec and
+	// enqueued as downlink.
+	UpdateTwinDesiredState(context.Context, *UpdateDeviceTwinDesiredStateRequest) (*UpdateDeviceTwinDesiredStateResponse, error)
+	// GetTrack returns the location track of the given device within the given
+	// time-range, ordered by time. The track is also returned as GeoJSON
+	// Feature containing a LineString geometry.
+	GetTrack(context.Context, *GetDeviceTrackRequest) (*GetDeviceTrackResponse, error)
+	// StartFrameCapture starts a raw frame capture for the given device.
+	// During the capture window, the uplink and downlink frames of the device
+	// (PHYPayload and RX / TX information) are stored so that these can be
+	// downloaded using GetFrameCapture. This replaces the previous capture.
+	StartFrameCapture(context.Context, *StartDeviceFrameCaptureRequest) (*empty.Empty, error)
+	// StopFrameCapture stops the raw frame capture of the given device.
+	// The captured frames can still be downloaded until they expire.
+	StopFrameCapture(context.Context, *StopDeviceFrameCaptureRequest) (*empty.Empty, error)
+	// GetFrameCapture returns the raw frame capture of the given device,
+	// including the captured frames.
+	GetFrameCapture(context.Context, *GetDeviceFrameCaptureRequest) (*GetDeviceFrameCaptureResponse, error)
+	// ExportData exports all data stored for the given device (data-subject
+	// access request), including the activations, locations, statistics and
+	// security events. The keys are only included when the user is allowed to
+	// read the device keys.
+	ExportData(context.Context, *ExportDeviceDataRequest) (*ExportDeviceDataResponse, error)
+	// EraseData irreversibly erases all data stored for the given device
+	// (right to erasure). The device is deleted and the references in the
+	// security events are replaced by a random pseudonym.
+	EraseData(context.Context, *EraseDeviceDataRequest) (*empty.Empty, error)
+	// SetLegalHold places the given device under legal hold. A device under
+	// legal hold can not be deleted or erased and its data is excluded from the
+	// retention pruning. Only global admin users are allowed to set or clear
+	// the legal hold.
+	SetLegalHold(context.Context, *SetDeviceLegalHoldRequest) (*empty.Empty, error)
+	// ClearLegalHold clears the legal hold of the given device.
+	ClearLegalHold(context.Context, *ClearDeviceLegalHoldRequest) (*empty.Empty, error)
 	// StreamFrameLogs streams the uplink and downlink frame-logs for the given DevEUI.
 	//   * These are the raw LoRaWAN frames and this endpoint is intended for debugging only.
 	//   * This endpoint does not work from a web-browser.
@@ -1934,6 +4117,60 @@ func _DeviceService_GetActivation_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceService_GetWrappedAppSKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWrappedAppSKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).GetWrappedAppSKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/GetWrappedAppSKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).GetWrappedAppSKey(ctx, req.(*GetWrappedAppSKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_Deactivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).Deactivate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/Deactivate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).Deactivate(ctx, req.(*DeactivateDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_DecryptUplink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecryptDeviceUplinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).DecryptUplink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/DecryptUplink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).DecryptUplink(ctx, req.(*DecryptDeviceUplinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DeviceService_GetRandomDevAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRandomDevAddrRequest)
 	if err := dec(in); err != nil {
@@ -1952,6 +4189,240 @@ func _DeviceService_GetRandomDevAddr_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceService_ListDevNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeviceDevNoncesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).ListDevNonces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/ListDevNonces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).ListDevNonces(ctx, req.(*ListDeviceDevNoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_DeleteDevNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeviceDevNoncesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).DeleteDevNonces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/DeleteDevNonces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).DeleteDevNonces(ctx, req.(*DeleteDeviceDevNoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_GetJoinDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceJoinDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).GetJoinDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/GetJoinDiagnostics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).GetJoinDiagnostics(ctx, req.(*GetDeviceJoinDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_GetTwin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceTwinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).GetTwin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/GetTwin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).GetTwin(ctx, req.(*GetDeviceTwinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_UpdateTwinDesiredState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeviceTwinDesiredStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).UpdateTwinDesiredState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/UpdateTwinDesiredState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).UpdateTwinDesiredState(ctx, req.(*UpdateDeviceTwinDesiredStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_GetTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceTrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).GetTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/GetTrack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).GetTrack(ctx, req.(*GetDeviceTrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_StartFrameCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartDeviceFrameCaptureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).StartFrameCapture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/StartFrameCapture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).StartFrameCapture(ctx, req.(*StartDeviceFrameCaptureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_StopFrameCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopDeviceFrameCaptureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).StopFrameCapture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/StopFrameCapture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).StopFrameCapture(ctx, req.(*StopDeviceFrameCaptureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_GetFrameCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceFrameCaptureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).GetFrameCapture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/GetFrameCapture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).GetFrameCapture(ctx, req.(*GetDeviceFrameCaptureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_ExportData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportDeviceDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).ExportData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/ExportData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).ExportData(ctx, req.(*ExportDeviceDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_EraseData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseDeviceDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).EraseData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/EraseData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).EraseData(ctx, req.(*EraseDeviceDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_SetLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDeviceLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).SetLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/SetLegalHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).SetLegalHold(ctx, req.(*SetDeviceLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceService_ClearLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearDeviceLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceServiceServer).ClearLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceService/ClearLegalHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceServiceServer).ClearLegalHold(ctx, req.(*ClearDeviceLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DeviceService_StreamFrameLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(StreamDeviceFrameLogsRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2042,10 +4513,74 @@ var _DeviceService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetActivation",
 			Handler:    _DeviceService_GetActivation_Handler,
 		},
+		{
+			MethodName: "GetWrappedAppSKey",
+			Handler:    _DeviceService_GetWrappedAppSKey_Handler,
+		},
+		{
+			MethodName: "Deactivate",
+			Handler:    _DeviceService_Deactivate_Handler,
+		},
+		{
+			MethodName: "DecryptUplink",
+			Handler:    _DeviceService_DecryptUplink_Handler,
+		},
 		{
 			MethodName: "GetRandomDevAddr",
 			Handler:    _DeviceService_GetRandomDevAddr_Handler,
 		},
+		{
+			MethodName: "ListDevNonces",
+			Handler:    _DeviceService_ListDevNonces_Handler,
+		},
+		{
+			MethodName: "DeleteDevNonces",
+			Handler:    _DeviceService_DeleteDevNonces_Handler,
+		},
+		{
+			MethodName: "GetJoinDiagnostics",
+			Handler:    _DeviceService_GetJoinDiagnostics_Handler,
+		},
+		{
+			MethodName: "GetTwin",
+			Handler:    _DeviceService_GetTwin_Handler,
+		},
+		{
+			MethodName: "UpdateTwinDesiredState",
+			Handler:    _DeviceService_UpdateTwinDesiredState_Handler,
+		},
+		{
+			MethodName: "GetTrack",
+			Handler:    _DeviceService_GetTrack_Handler,
+		},
+		{
+			MethodName: "StartFrameCapture",
+			Handler:    _DeviceService_StartFrameCapture_Handler,
+		},
+		{
+			MethodName: "StopFrameCapture",
+			Handler:    _DeviceService_StopFrameCapture_Handler,
+		},
+		{
+			MethodName: "GetFrameCapture",
+			Handler:    _DeviceService_GetFrameCapture_Handler,
+		},
+		{
+			MethodName: "ExportData",
+			Handler:    _DeviceService_ExportData_Handler,
+		},
+		{
+			MethodName: "EraseData",
+			Handler:    _DeviceService_EraseData_Handler,
+		},
+		{
+			MethodName: "SetLegalHold",
+			Handler:    _DeviceService_SetLegalHold_Handler,
+		},
+		{
+			MethodName: "ClearLegalHold",
+			Handler:    _DeviceService_ClearLegalHold_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2065,100 +4600,212 @@ var _DeviceService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("device.proto", fileDescriptor_870276a56ac00da5) }
 
 var fileDescriptor_870276a56ac00da5 = []byte{
-	// 1508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcb, 0x73, 0xdb, 0xd4,
-	0x1a, 0xbf, 0x8a, 0x13, 0x27, 0xf9, 0x6c, 0xe7, 0x71, 0xf2, 0xb0, 0xeb, 0x36, 0x37, 0x8e, 0x7a,
-	0x3b, 0x75, 0xd3, 0x5e, 0x3b, 0x98, 0x29, 0x30, 0x9d, 0x0e, 0x33, 0x69, 0x92, 0x86, 0x90, 0xb6,
-	0x30, 0x72, 0x03, 0x33, 0xb0, 0xd0, 0x9c, 0x48, 0xc7, 0xae, 0xb0, 0x74, 0x24, 0xa4, 0xe3, 0x64,
-	0x3c, 0xd0, 0x19, 0xe8, 0x82, 0x05, 0xdb, 0xfe, 0x07, 0xec, 0xf9, 0x6b, 0xd8, 0xb2, 0xe4, 0x0f,
-	0x61, 0xce, 0xc3, 0xce, 0xf1, 0x43, 0x4d, 0x02, 0x6c, 0x58, 0xd9, 0xfa, 0xbe, 0xdf, 0xf7, 0x7e,
-	0x49, 0x90, 0x77, 0xc9, 0x99, 0xe7, 0x90, 0x5a, 0x14, 0x87, 0x2c, 0x44, 0x19, 0x1c, 0x79, 0xe5,
-	0x87, 0x6d, 0x8f, 0xbd, 0xea, 0x9e, 0xd6, 0x9c, 0x30, 0xa8, 0x9f, 0xc6, 0xa1, 0x83, 0x71, 0x5c,
-	0xf7, 0xc3, 0x18, 0x27, 0x24, 0x3e, 0x23, 0x71, 0x1d, 0x47, 0x5e, 0xdd, 0x09, 0x83, 0x20, 0xa4,
-	0xea, 0x47, 0xca, 0x96, 0x6f, 0xb5, 0xc3, 0xb0, 0xed, 0x13, 0xc1, 0xc7, 0x94, 0x86, 0x0c, 0x33,
-	0x2f, 0xa4, 0x89, 0xe2, 0x6e, 0x2a, 0xae, 0x78, 0x3a, 0xed, 0xb6, 0xea, 0xcc, 0x0b, 0x48, 0xc2,
-	0x70, 0x10, 0x29, 0xc0, 0xcd, 0x51, 0x00, 0x09, 0x22, 0xd6, 0x53, 0xcc, 0xbc, 0x6e, 0xc9, 0x7c,
-	0x33, 0x05, 0xd9, 0x7d, 0xe1, 0x36, 0x2a, 0xc2, 0xac, 0x4b, 0xce, 0x6c, 0xd2, 0xf5, 0x4a, 0x46,
-	0xc5, 0xa8, 0xce, 0x5b, 0x59, 0x97, 0x9c, 0x1d, 0x9c, 0x1c, 0x21, 0x04, 0xd3, 0x14, 0x07, 0xa4,
-	0x34, 0x25, 0xa8, 0xe2, 0x3f, 0xba, 0x03, 0x0b, 0x38, 0x8a, 0x7c, 0xcf, 0x11, 0x9e, 0xd9, 0x9e,
-	0x5b, 0xca, 0x54, 0x8c, 0x6a, 0xc6, 0x2a, 0x68, 0xd4, 0xa3, 0x7d, 0x54, 0x81, 0x9c, 0x4b, 0x12,
-	0x27, 0xf6, 0x22, 0x4e, 0x28, 0x4d, 0x0b, 0x0d, 0x3a, 0x09, 0x6d, 0xc3, 0xb2, 0x4c, 0x9b, 0x1d,
-	0xc5, 0x61, 0xcb, 0xf3, 0x09, 0xd7, 0x35, 0x23, 0x70, 0x8b, 0x92, 0xf1, 0xb9, 0xa4, 0x1f, 0xed,
-	0xa3, 0xbb, 0xb0, 0x94, 0x74, 0xbc, 0xc8, 0x6e, 0xd9, 0x0e, 0x65, 0xb6, 0xf3, 0x8a, 0x38, 0x9d,
-	0x52, 0xb6, 0x62, 0x54, 0xe7, 0xac, 0x02, 0xa7, 0x3f, 0xdd, 0xa3, 0x6c, 0x8f, 0x13, 0xd1, 0xff,
-	0x01, 0xc5, 0xa4, 0x45, 0x62, 0x42, 0x1d, 0x62, 0x63, 0x9f, 0x79, 0xac, 0xeb, 0x92, 0xd2, 0x6c,
-	0xc5, 0xa8, 0x1a, 0xd6, 0xf2, 0x80, 0xb3, 0xab, 0x18, 0xe6, 0x4f, 0x19, 0x58, 0x90, 0x49, 0x78,
-	0xe6, 0x25, 0xec, 0x88, 0x91, 0xe0, 0x5f, 0x90, 0x8c, 0x1a, 0xac, 0x8c, 0x60, 0x85, 0x5f, 0x59,
-	0x81, 0x5e, 0x1e, 0x42, 0xbf, 0xe0, 0x4e, 0x36, 0x60, 0x4d, 0xe1, 0x13, 0x86, 0x59, 0x37, 0xb1,
-	0x4f, 0x31, 0x63, 0x24, 0xee, 0x89, 0xb4, 0x14, 0x2c, 0xa5, 0xac, 0x29, 0x78, 0x4f, 0x24, 0x0b,
-	0xed, 0xc0, 0xea, 0xb0, 0x4c, 0x80, 0xe3, 0xb6, 0x47, 0x4b, 0x73, 0x15, 0xa3, 0x3a, 0x63, 0x21,
-	0x5d, 0xe4, 0xb9, 0xe0, 0xa0, 0xc7, 0x90, 0xf7, 0x71, 0xc2, 0xec, 0x84, 0x10, 0x6a, 0x63, 0x56,
-	0x9a, 0xaf, 0x18, 0xd5, 0x5c, 0xa3, 0x5c, 0x93, 0x1d, 0x59, 0xeb, 0x77, 0x64, 0xed, 0x65, 0xbf,
-	0x65, 0x2d, 0xe0, 0xf8, 0x26, 0x21, 0x74, 0x97, 0x99, 0x5f, 0x02, 0xc8, 0x3a, 0x1c, 0x93, 0x5e,
-	0x92, 0x5e, 0x83, 0x22, 0xcc, 0xd2, 0xf3, 0x8e, 0xdd, 0x21, 0x3d, 0x55, 0x86, 0x2c, 0x3d, 0xef,
-	0x1c, 0x93, 0x1e, 0x67, 0xe0, 0x28, 0x12, 0x8c, 0x8c, 0x64, 0xe0, 0x28, 0x3a, 0x26, 0x3d, 0xf3,
-	0x11, 0xac, 0xec, 0xc5, 0x04, 0x33, 0x22, 0xd5, 0x5b, 0xe4, 0xdb, 0x2e, 0x49, 0x18, 0xba, 0x0d,
-	0x59, 0x19, 0x83, 0x30, 0x90, 0x6b, 0xe4, 0x6a, 0x38, 0xf2, 0x6a, 0x0a, 0xa3, 0x58, 0xe6, 0x7d,
-	0x58, 0x3a, 0x24, 0x6c, 0x58, 0x30, 0xcd, 0x35, 0xf3, 0xe7, 0x29, 0x58, 0xd6, 0xd0, 0x49, 0x14,
-	0xd2, 0x84, 0x5c, 0xc9, 0xce, 0x58, 0xea, 0x66, 0xae, 0x93, 0xba, 0xf4, 0xf2, 0x66, 0xaf, 0x5f,
-	0xde, 0xd5, 0xd4, 0xf2, 0x3e, 0x80, 0x39, 0x3f, 0x94, 0x0d, 0x5d, 0x5a, 0x13, 0xfe, 0x2d, 0xd5,
-	0xd4, 0x3e, 0x79, 0xa6, 0xe8, 0xd6, 0x00, 0x61, 0xfe, 0x6e, 0xc0, 0x32, 0x9f, 0xa8, 0xe1, 0xdc,
-	0xad, 0xc2, 0x8c, 0xef, 0x05, 0x1e, 0x13, 0xb9, 0xc8, 0x58, 0xf2, 0x01, 0xad, 0x43, 0x36, 0x6c,
-	0xb5, 0x12, 0xc2, 0x44, 0x49, 0x33, 0x96, 0x7a, 0xba, 0xea, 0x6c, 0xad, 0x43, 0x36, 0x21, 0x38,
-	0x76, 0x5e, 0xa9, 0xb1, 0x52, 0x4f, 0xe8, 0x01, 0xa0, 0xa0, 0xeb, 0x33, 0xcf, 0xe1, 0x99, 0x6d,
-	0xc7, 0x61, 0x37, 0xba, 0x18, 0xa9, 0xa5, 0x01, 0xe7, 0x90, 0x33, 0x8e, 0xf6, 0x39, 0x9a, 0x6f,
-	0xe6, 0x91, 0x01, 0x94, 0x23, 0xb5, 0xa4, 0x38, 0x83, 0x09, 0x34, 0x4f, 0x01, 0xe9, 0xd1, 0xa9,
-	0x5a, 0x6f, 0x42, 0x8e, 0x85, 0x0c, 0xfb, 0xb6, 0x13, 0x76, 0x69, 0x3f, 0x48, 0x10, 0xa4, 0x3d,
-	0x4e, 0x41, 0xf7, 0x21, 0x1b, 0x93, 0xa4, 0xeb, 0xf3, 0x48, 0x33, 0xd5, 0x5c, 0x63, 0x45, 0x6b,
-	0x86, 0xfe, 0xfe, 0xb1, 0x14, 0xc4, 0xac, 0xc1, 0xca, 0x3e, 0xf1, 0xc9, 0x68, 0xe3, 0xa6, 0xf6,
-	0xdf, 0x23, 0x58, 0x39, 0x89, 0xdc, 0xbf, 0xd6, 0xe8, 0xc7, 0x50, 0xd4, 0x87, 0x84, 0xcf, 0x60,
-	0x5f, 0x7e, 0x87, 0xaf, 0x2e, 0x91, 0x97, 0x0e, 0xe9, 0x25, 0x4a, 0xc9, 0xa2, 0xa6, 0x44, 0x80,
-	0xc1, 0x1d, 0xfc, 0x37, 0xeb, 0xb0, 0x3a, 0x98, 0x03, 0x5d, 0x53, 0xaa, 0xe7, 0x47, 0xb0, 0x36,
-	0x22, 0xa0, 0x12, 0x7a, 0x7d, 0xdb, 0xc7, 0x50, 0xd4, 0x93, 0xf0, 0xf7, 0x02, 0x69, 0x40, 0x51,
-	0xaf, 0xc0, 0x95, 0x62, 0xf9, 0x75, 0x0a, 0x96, 0x24, 0x7c, 0xd7, 0x61, 0xde, 0x99, 0x68, 0xd2,
-	0xf4, 0x75, 0x76, 0x03, 0xe6, 0x38, 0x03, 0xbb, 0x6e, 0xac, 0xf6, 0x19, 0x07, 0xee, 0xba, 0x6e,
-	0x8c, 0xca, 0x30, 0xcf, 0x17, 0x5a, 0xa2, 0xad, 0x34, 0xbe, 0xe1, 0x9a, 0x7c, 0xd9, 0x6d, 0x41,
-	0x81, 0x6f, 0xc1, 0xc4, 0x26, 0xd4, 0x11, 0x7c, 0xd9, 0xf9, 0x40, 0xcf, 0x3b, 0xcd, 0x03, 0xea,
-	0x70, 0xc8, 0xff, 0x60, 0x31, 0xb1, 0x25, 0xc8, 0xa3, 0x4c, 0x80, 0xe6, 0xe4, 0xd5, 0x49, 0x5e,
-	0x9c, 0x77, 0x9a, 0x47, 0x94, 0x29, 0x54, 0x6b, 0x04, 0x35, 0x2f, 0x51, 0x2d, 0x0d, 0x55, 0x82,
-	0x39, 0x79, 0x77, 0xbb, 0x91, 0x98, 0x9f, 0x82, 0x95, 0x6d, 0xed, 0x51, 0x76, 0x12, 0xa1, 0x4d,
-	0xc8, 0x53, 0x75, 0x93, 0xdd, 0xf0, 0x9c, 0xaa, 0x8d, 0x33, 0x4f, 0xf9, 0x3d, 0xde, 0x0f, 0xcf,
-	0x29, 0x07, 0x60, 0x1d, 0x00, 0x12, 0x80, 0xfb, 0x00, 0xf3, 0x6b, 0x58, 0x53, 0x89, 0x1a, 0xe9,
-	0xdb, 0x27, 0x83, 0x83, 0x88, 0x07, 0x89, 0x54, 0x45, 0x5b, 0xd3, 0x8a, 0x76, 0x91, 0x65, 0x6b,
-	0xc9, 0x1d, 0xa1, 0x98, 0x0f, 0xa1, 0x3c, 0x68, 0x2c, 0x0d, 0x78, 0x59, 0x0d, 0x31, 0xdc, 0x9c,
-	0x28, 0xa6, 0xba, 0xf2, 0x9f, 0xf0, 0xac, 0x01, 0xc5, 0x43, 0xc2, 0x2c, 0x4c, 0xdd, 0x30, 0xd8,
-	0x97, 0x15, 0xbf, 0xd4, 0xad, 0x87, 0x50, 0x1a, 0x97, 0x51, 0x3e, 0xe9, 0x8d, 0x64, 0x0c, 0x35,
-	0x92, 0xf9, 0x21, 0xdc, 0x6a, 0xb2, 0x98, 0xe0, 0x40, 0xba, 0xf5, 0x34, 0xc6, 0x01, 0x79, 0x16,
-	0xb6, 0x2f, 0x6f, 0xe5, 0x5f, 0x0c, 0xd8, 0x48, 0x91, 0x54, 0x56, 0x3f, 0x82, 0x7c, 0x37, 0xf2,
-	0x3d, 0xda, 0xb1, 0x5b, 0x9c, 0xa7, 0x92, 0x20, 0xb7, 0xda, 0x89, 0x60, 0xf4, 0x65, 0x3e, 0xf9,
-	0x8f, 0x95, 0xeb, 0x5e, 0x50, 0xd0, 0xc7, 0xb0, 0xc0, 0xfb, 0x41, 0x93, 0x9d, 0xd2, 0x13, 0xa8,
-	0x58, 0x9a, 0x74, 0xc1, 0xd5, 0x69, 0x4f, 0x66, 0x61, 0x46, 0x88, 0x8d, 0x46, 0x77, 0x70, 0x46,
-	0x28, 0xbb, 0x52, 0x74, 0x5f, 0x0c, 0x07, 0xa7, 0x09, 0xaa, 0xe0, 0x10, 0x4c, 0xb3, 0x5e, 0x44,
-	0x94, 0x98, 0xf8, 0x8f, 0xb6, 0x20, 0x1f, 0xe1, 0x9e, 0x1f, 0x62, 0xd7, 0xfe, 0x26, 0x09, 0xa9,
-	0x9a, 0xd9, 0x9c, 0xa2, 0x7d, 0xda, 0xfc, 0xec, 0x45, 0xe3, 0x6d, 0x1e, 0x0a, 0x52, 0x65, 0x53,
-	0x5e, 0x0d, 0xd4, 0x84, 0xac, 0x5c, 0xae, 0xa8, 0x24, 0xa2, 0x9b, 0xf0, 0x3a, 0x52, 0x5e, 0x1f,
-	0xbb, 0xf5, 0x07, 0xfc, 0xc5, 0xdd, 0x2c, 0xbe, 0xf9, 0xed, 0x8f, 0xb7, 0x53, 0xcb, 0x66, 0x5e,
-	0x7c, 0x10, 0xc8, 0x36, 0x4a, 0x1e, 0x19, 0xdb, 0xe8, 0x25, 0x64, 0x0e, 0x09, 0x43, 0x32, 0x5f,
-	0xa3, 0x2f, 0x29, 0xe5, 0xf5, 0x51, 0xb2, 0x8c, 0xc9, 0xfc, 0xaf, 0x50, 0x57, 0x42, 0xeb, 0xba,
-	0xba, 0xfa, 0x77, 0x2a, 0x43, 0xaf, 0xd1, 0x73, 0x98, 0xe6, 0x77, 0x08, 0x49, 0xf9, 0xb1, 0x03,
-	0x5e, 0x2e, 0x8e, 0xd1, 0x95, 0xe2, 0x55, 0xa1, 0x78, 0x01, 0x0d, 0xf9, 0x89, 0xbe, 0xe2, 0x5f,
-	0x18, 0x7c, 0x81, 0xaa, 0xc8, 0x27, 0xdc, 0xb3, 0xd4, 0xc8, 0x95, 0xab, 0xdb, 0x69, 0xae, 0xba,
-	0x90, 0x95, 0x9b, 0x5e, 0xe9, 0x9e, 0x70, 0xfb, 0x52, 0x75, 0x57, 0x85, 0x6e, 0xb3, 0xbc, 0x31,
-	0xa6, 0x9b, 0x7f, 0xc4, 0xf5, 0x4d, 0xf0, 0x34, 0x9f, 0x01, 0xc8, 0x72, 0x89, 0xd7, 0xd2, 0x5b,
-	0x63, 0xf5, 0xd3, 0x6e, 0x42, 0xaa, 0xb5, 0x86, 0xb0, 0xf6, 0xc0, 0xbc, 0x3b, 0xc9, 0x9a, 0x38,
-	0x46, 0x03, 0x93, 0x75, 0xfe, 0xc4, 0xed, 0x12, 0x98, 0x3d, 0x24, 0x4c, 0x18, 0xbd, 0x31, 0x5c,
-	0x4b, 0xdd, 0x62, 0x79, 0x12, 0x4b, 0x55, 0xe4, 0xb6, 0xb0, 0xba, 0x81, 0x6e, 0x4e, 0xce, 0x9f,
-	0xb0, 0xc4, 0xc3, 0x93, 0x79, 0xd3, 0xc2, 0x4b, 0xb9, 0x9f, 0x97, 0x85, 0x57, 0xbe, 0x4e, 0x78,
-	0x6d, 0xfe, 0xb6, 0xcf, 0x7b, 0x41, 0xb3, 0x9b, 0x72, 0x6a, 0x53, 0xed, 0xaa, 0x00, 0xb7, 0xdf,
-	0x19, 0xe0, 0xf7, 0x30, 0xd7, 0x3f, 0x2f, 0x48, 0x66, 0x6b, 0xe2, 0xb5, 0x49, 0x35, 0xf2, 0x58,
-	0x18, 0xf9, 0xc0, 0x7c, 0x6f, 0x62, 0x70, 0x17, 0xfb, 0xff, 0x22, 0x44, 0x45, 0x23, 0x3c, 0xcc,
-	0xd7, 0x50, 0x38, 0x24, 0x4c, 0x7b, 0x11, 0xd8, 0x1c, 0x2e, 0xd8, 0xd8, 0x4d, 0x2a, 0x57, 0xd2,
-	0x01, 0xaa, 0xae, 0xf7, 0x84, 0x47, 0xb7, 0xd1, 0x56, 0x4a, 0xd8, 0x17, 0x3e, 0xa1, 0x1f, 0x0c,
-	0xf1, 0xfd, 0x32, 0x74, 0x31, 0x54, 0xb2, 0x53, 0x8e, 0x4f, 0x79, 0x23, 0x85, 0xab, 0x8c, 0xd7,
-	0x85, 0xf1, 0x7b, 0x13, 0x5a, 0x59, 0x1a, 0x6f, 0x8f, 0x5a, 0xfb, 0xd1, 0x80, 0x45, 0xb9, 0x66,
-	0x07, 0xd7, 0x03, 0x6d, 0x09, 0x1b, 0xef, 0xba, 0x49, 0x65, 0xf3, 0x5d, 0x10, 0xe5, 0xcb, 0x1d,
-	0xe1, 0xcb, 0x26, 0xda, 0x48, 0xf1, 0x45, 0xdc, 0x87, 0x64, 0xc7, 0xd0, 0x7c, 0x18, 0x2c, 0xf9,
-	0x09, 0x3e, 0x8c, 0x5e, 0x8e, 0x09, 0x3e, 0x8c, 0xdd, 0x88, 0x4b, 0x7d, 0x20, 0x5c, 0x22, 0xd9,
-	0x31, 0x4e, 0xb3, 0xa2, 0xaf, 0xde, 0xff, 0x33, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x67, 0xf8, 0x63,
-	0x29, 0x12, 0x00, 0x00,
+	// 3307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd5, 0x1a, 0x5d, 0x6f, 0x1b, 0xc7,
+	0xb1, 0x14, 0x29, 0x89, 0x1a, 0x7d, 0x51, 0x6b, 0x4b, 0xa2, 0x29, 0xcb, 0x1f, 0x67, 0xa7, 0xb1,
+	0x95, 0x48, 0x72, 0x94, 0xda, 0x71, 0x13, 0xb7, 0x85, 0x22, 0xc9, 0x89, 0x1d, 0xdb, 0x75, 0x4f,
+	0x76, 0x02, 0xa4, 0x0f, 0x87, 0xd3, 0xdd, 0x52, 0xbe, 0x88, 0xbc, 0x63, 0xee, 0x8e, 0x52, 0x84,
+	0x24, 0x40, 0xd3, 0x00, 0x7d, 0x29, 0x8a, 0x16, 0x2d, 0xd0, 0xa7, 0x14, 0x28, 0xfa, 0xde, 0xff,
+	0x50, 0xf4, 0x0f, 0x14, 0x68, 0xfe, 0x42, 0x9e, 0x0a, 0xf4, 0xa9, 0xfd, 0x01, 0x9d, 0xd9, 0xdd,
+	0x3b, 0x2e, 0x8f, 0x3c, 0x92, 0x4a, 0xfb, 0xd2, 0x17, 0x9b, 0xbb, 0x3b, 0xb3, 0xf3, 0x3d, 0xb3,
+	0x33, 0x27, 0x98, 0x71, 0xf9, 0xb1, 0xe7, 0xf0, 0x8d, 0x56, 0x18, 0xc4, 0x01, 0x2b, 0xda, 0x2d,
+	0xaf, 0x76, 0xfb, 0xd0, 0x8b, 0x5f, 0xb4, 0x0f, 0x36, 0x9c, 0xa0, 0xb9, 0x79, 0x10, 0x06, 0x8e,
+	0x6d, 0x87, 0x9b, 0x8d, 0x20, 0xb4, 0x23, 0x1e, 0x1e, 0xf3, 0x70, 0x13, 0x41, 0x36, 0xf1, 0xa8,
+	0x19, 0xf8, 0xea, 0x3f, 0x89, 0x5b, 0xbb, 0x78, 0x18, 0x04, 0x87, 0x0d, 0x2e, 0xce, 0x6d, 0xdf,
+	0x0f, 0x62, 0x3b, 0xf6, 0x02, 0x3f, 0x52, 0xa7, 0x97, 0xd5, 0xa9, 0x58, 0x1d, 0xb4, 0xeb, 0x9b,
+	0xb1, 0xd7, 0xe4, 0x51, 0x6c, 0x37, 0x5b, 0x0a, 0x60, 0x25, 0x0b, 0xc0, 0x9b, 0xad, 0xf8, 0x34,
+	0x73, 0x77, 0x7a, 0x18, 0xc5, 0x61, 0xdb, 0x89, 0xd5, 0xe9, 0x95, 0xec, 0x69, 0xdd, 0xe3, 0x0d,
+	0xd7, 0x6a, 0xda, 0xd1, 0x91, 0x82, 0x98, 0xd1, 0x39, 0x35, 0xfe, 0x5e, 0x84, 0x89, 0x5d, 0x21,
+	0x36, 0x5b, 0x86, 0x49, 0x54, 0x80, 0xc5, 0xdb, 0x5e, 0xb5, 0x70, 0xa5, 0x70, 0x63, 0xca, 0x9c,
+	0xc0, 0xe5, 0xde, 0xf3, 0x07, 0x8c, 0x41, 0xc9, 0xb7, 0x9b, 0xbc, 0x3a, 0x26, 0x76, 0xc5, 0x6f,
+	0xf6, 0x12, 0xcc, 0xd9, 0xad, 0x56, 0xc3, 0x73, 0x84, 0x64, 0x96, 0xe7, 0x56, 0x8b, 0x78, 0x5a,
+	0x34, 0x67, 0xb5, 0xdd, 0x07, 0xbb, 0xec, 0x0a, 0x4c, 0xbb, 0x3c, 0x72, 0x42, 0xaf, 0x45, 0x1b,
+	0xd5, 0x92, 0xb8, 0x41, 0xdf, 0x62, 0x6b, 0xb0, 0x20, 0xd5, 0x6e, 0x21, 0x43, 0x75, 0xaf, 0xc1,
+	0xe9, 0xae, 0x71, 0x01, 0x37, 0x2f, 0x0f, 0x9e, 0xca, 0x7d, 0xbc, 0xed, 0x65, 0xa8, 0x44, 0x47,
+	0x5e, 0xcb, 0xaa, 0x5b, 0x8e, 0x1f, 0x5b, 0xce, 0x0b, 0xee, 0x1c, 0x55, 0x27, 0x10, 0xb4, 0x6c,
+	0xce, 0xd2, 0xfe, 0xfd, 0x1d, 0x3f, 0xde, 0xa1, 0x4d, 0xb6, 0x0e, 0x2c, 0xe4, 0x75, 0x1e, 0x72,
+	0x1f, 0xef, 0xb5, 0x1b, 0xb1, 0x17, 0xb7, 0x5d, 0x5e, 0x9d, 0x44, 0xd0, 0x82, 0xb9, 0x90, 0x9e,
+	0x6c, 0xab, 0x03, 0xf6, 0x06, 0x54, 0xa3, 0x76, 0xab, 0x15, 0xf2, 0x28, 0x52, 0x77, 0xdb, 0x7e,
+	0xd0, 0xb4, 0x1b, 0x1e, 0x8f, 0xaa, 0x65, 0x71, 0xff, 0x62, 0x72, 0x4e, 0x34, 0xb6, 0x93, 0x43,
+	0xb6, 0x0a, 0xd0, 0xe0, 0x87, 0x76, 0xc3, 0x7a, 0x11, 0x34, 0xdc, 0xea, 0x94, 0x00, 0x9d, 0x12,
+	0x3b, 0xef, 0xe2, 0x06, 0xbb, 0x0b, 0x53, 0xc7, 0x76, 0xe8, 0xd9, 0x07, 0x0d, 0xbc, 0x08, 0xae,
+	0x14, 0x6f, 0x4c, 0x6f, 0xd5, 0x36, 0xd0, 0x27, 0x36, 0xa4, 0xc6, 0x37, 0xde, 0x4f, 0x0e, 0xf7,
+	0xfc, 0x38, 0x3c, 0x35, 0x3b, 0xc0, 0xb5, 0x7b, 0x30, 0xd7, 0x7d, 0xc8, 0x2a, 0x50, 0x3c, 0xe2,
+	0xa7, 0xca, 0x32, 0xf4, 0x93, 0x9d, 0x87, 0xf1, 0x63, 0xbb, 0xd1, 0x4e, 0xec, 0x22, 0x17, 0x6f,
+	0x8e, 0xdd, 0x2d, 0x18, 0xbf, 0x28, 0xc2, 0x9c, 0x24, 0xf1, 0xc8, 0x8b, 0xe2, 0x07, 0x31, 0x6f,
+	0xfe, 0x1f, 0x18, 0x77, 0x03, 0xce, 0x65, 0x60, 0x05, 0x5f, 0x13, 0x02, 0x7a, 0xa1, 0x0b, 0xfa,
+	0x09, 0x31, 0xb9, 0x05, 0x8b, 0x0a, 0x1e, 0x43, 0x27, 0x6e, 0x47, 0xd6, 0x81, 0x1d, 0xc7, 0x3c,
+	0x3c, 0x15, 0x66, 0x9e, 0x35, 0xd5, 0x65, 0xfb, 0xe2, 0xec, 0x6d, 0x79, 0xc4, 0x6e, 0xc1, 0xf9,
+	0x6e, 0x9c, 0xa6, 0x1d, 0x1e, 0x7a, 0xbe, 0x30, 0xf2, 0xb8, 0xc9, 0x74, 0x94, 0xc7, 0xe2, 0x84,
+	0xdd, 0x83, 0x99, 0x86, 0x1d, 0xc5, 0x56, 0xc4, 0xb9, 0x6f, 0xd9, 0xb1, 0xb0, 0x31, 0x59, 0x51,
+	0x86, 0xd9, 0x46, 0x12, 0x66, 0x1b, 0xcf, 0x92, 0x10, 0x36, 0x81, 0xe0, 0xf7, 0x11, 0x7c, 0x3b,
+	0x36, 0x3e, 0x00, 0x90, 0x76, 0x78, 0x8f, 0x9f, 0x46, 0xf9, 0x36, 0xc0, 0x03, 0xff, 0xe4, 0xc8,
+	0x22, 0xfb, 0x4a, 0x33, 0x4c, 0xe0, 0x12, 0x51, 0xe8, 0x00, 0x55, 0x2e, 0x0e, 0x8a, 0xf2, 0x00,
+	0x97, 0x78, 0x60, 0xbc, 0x09, 0xe7, 0x76, 0x42, 0x6e, 0xc7, 0x5c, 0x5e, 0x6f, 0xf2, 0x8f, 0xdb,
+	0x48, 0x9e, 0x5d, 0x83, 0x09, 0x29, 0x83, 0x20, 0x30, 0xbd, 0x35, 0xad, 0x79, 0x9b, 0xa9, 0x8e,
+	0x8c, 0x57, 0xa0, 0xf2, 0x0e, 0x8f, 0xbb, 0x11, 0xf3, 0x58, 0x33, 0xfe, 0x35, 0x06, 0x0b, 0x1a,
+	0x74, 0xd4, 0xc2, 0x34, 0xc6, 0x47, 0xa2, 0xd3, 0xa3, 0xba, 0xf1, 0xb3, 0xa8, 0x2e, 0xdf, 0xbc,
+	0x13, 0x67, 0x37, 0xef, 0xf9, 0x5c, 0xf3, 0xbe, 0x0a, 0xe5, 0x46, 0x20, 0x1d, 0xba, 0xba, 0x28,
+	0xf8, 0xab, 0x6c, 0xa8, 0xfc, 0xf8, 0x48, 0xed, 0x9b, 0x29, 0x04, 0x5b, 0x82, 0x89, 0x90, 0x1f,
+	0x12, 0xec, 0x92, 0x54, 0x92, 0x5c, 0xb1, 0xcb, 0x30, 0xdd, 0xb4, 0x1d, 0x0b, 0x2b, 0x42, 0x44,
+	0x87, 0xcb, 0xe2, 0x10, 0x70, 0xeb, 0x7d, 0xb9, 0x43, 0xbe, 0x8d, 0xa0, 0x56, 0xcb, 0x0e, 0xed,
+	0x66, 0x64, 0x85, 0xc8, 0x87, 0x00, 0xac, 0x4a, 0xdf, 0xc6, 0xa3, 0xa7, 0xe2, 0xc4, 0x54, 0x07,
+	0xc6, 0xbf, 0x0b, 0xb0, 0x40, 0xa1, 0xdb, 0x6d, 0x24, 0x0c, 0xf8, 0x86, 0xd7, 0xf4, 0x62, 0xa1,
+	0xf4, 0xa2, 0x29, 0x17, 0xc4, 0x54, 0x50, 0xaf, 0x47, 0x3c, 0x16, 0xbe, 0x53, 0x34, 0xd5, 0x6a,
+	0xd4, 0x20, 0x46, 0xf4, 0x88, 0xdb, 0xa1, 0xf3, 0x42, 0xc5, 0xaf, 0x5a, 0xa1, 0x66, 0x58, 0xb3,
+	0x8d, 0x09, 0xd2, 0x21, 0x13, 0x1e, 0x86, 0x41, 0xbb, 0xd5, 0x89, 0xdd, 0x4a, 0x7a, 0xf2, 0x0e,
+	0x1d, 0xe0, 0x2d, 0x08, 0x4d, 0x25, 0x31, 0x13, 0xe9, 0x32, 0x76, 0x2b, 0xea, 0xa4, 0x13, 0xea,
+	0x48, 0xd3, 0x69, 0x87, 0x51, 0x10, 0x8a, 0x58, 0x45, 0x9a, 0x72, 0x65, 0x7c, 0x59, 0x00, 0xa6,
+	0x8b, 0xad, 0xbc, 0x0d, 0xd5, 0x1b, 0x63, 0x09, 0x6d, 0x58, 0x4e, 0xd0, 0xf6, 0x13, 0xe9, 0x41,
+	0x6c, 0xed, 0xd0, 0x0e, 0x7b, 0x85, 0xec, 0x12, 0x21, 0x4f, 0xa8, 0x02, 0x4a, 0xb2, 0xe7, 0x34,
+	0x77, 0x4c, 0x32, 0xa0, 0xa9, 0x40, 0xe8, 0x36, 0x9f, 0x7f, 0x82, 0xe5, 0x43, 0x72, 0x20, 0xe3,
+	0x0a, 0x68, 0x6b, 0x47, 0x72, 0x81, 0xc6, 0xda, 0xe5, 0x0d, 0x9e, 0x8d, 0xad, 0xdc, 0x10, 0x39,
+	0x81, 0x73, 0xcf, 0x5b, 0xee, 0xb7, 0x8a, 0x45, 0xf6, 0x16, 0x4c, 0xb7, 0x05, 0xae, 0xa8, 0xd0,
+	0xc2, 0x82, 0xfd, 0x42, 0xe4, 0x3e, 0x15, 0xf1, 0xc7, 0x08, 0x61, 0x82, 0x04, 0xa7, 0xdf, 0xc6,
+	0x7b, 0xb0, 0xac, 0x27, 0x01, 0xca, 0x31, 0x09, 0xf1, 0x5b, 0x94, 0x9a, 0x85, 0x39, 0x30, 0x77,
+	0x44, 0x8a, 0x83, 0x79, 0x8d, 0x03, 0x01, 0x0c, 0x6e, 0xfa, 0xdb, 0xd8, 0x84, 0xf3, 0x69, 0x9c,
+	0xeb, 0x37, 0xe5, 0x8a, 0xfd, 0x00, 0x16, 0x33, 0x08, 0xca, 0x5c, 0x67, 0xa7, 0x8d, 0x82, 0xe8,
+	0x1a, 0xfc, 0xef, 0x04, 0xd9, 0x82, 0x65, 0xdd, 0x7c, 0x23, 0xc9, 0xf2, 0xe7, 0x31, 0xa8, 0x48,
+	0xf0, 0x6d, 0x27, 0xf6, 0x8e, 0x65, 0xb4, 0xe7, 0xa6, 0xeb, 0x0b, 0x50, 0xa6, 0x03, 0xdb, 0x75,
+	0x43, 0x95, 0xaf, 0x09, 0x70, 0x1b, 0x97, 0xac, 0x06, 0x53, 0x94, 0xb0, 0x23, 0x2d, 0x65, 0x53,
+	0x06, 0xdf, 0xa7, 0x64, 0x7e, 0x15, 0x66, 0x29, 0xcb, 0x47, 0x16, 0xbe, 0x3d, 0xc4, 0x79, 0x49,
+	0xb9, 0xde, 0xc9, 0xd1, 0xfe, 0x9e, 0xef, 0x10, 0xc8, 0x75, 0x98, 0x8f, 0x2c, 0x09, 0xe4, 0xe1,
+	0x2b, 0x84, 0x80, 0xca, 0xb2, 0xaa, 0x46, 0x4f, 0x10, 0xea, 0x81, 0x1f, 0x2b, 0xa8, 0x7a, 0x06,
+	0x6a, 0x4a, 0x42, 0xd5, 0x35, 0xa8, 0x2a, 0x94, 0xe5, 0x5b, 0xa6, 0xdd, 0x12, 0x61, 0x3b, 0x6b,
+	0x4e, 0xd4, 0xf1, 0xf1, 0xf2, 0xbc, 0x85, 0x11, 0x30, 0xe3, 0xab, 0x77, 0x8e, 0x1b, 0x9c, 0xf8,
+	0x2a, 0xa3, 0x4e, 0xf9, 0xf4, 0xb6, 0xd9, 0xc5, 0x0d, 0x02, 0xb0, 0x75, 0x00, 0x90, 0x00, 0x76,
+	0x02, 0x60, 0xfc, 0x14, 0x16, 0x95, 0xa2, 0x32, 0x4e, 0xff, 0x76, 0x5a, 0xf0, 0xed, 0x54, 0x91,
+	0xca, 0x68, 0x8b, 0x9a, 0xd1, 0x3a, 0x5a, 0x36, 0x2b, 0x6e, 0x66, 0xc7, 0xb8, 0x0d, 0xb5, 0xd4,
+	0xb1, 0x34, 0xc0, 0x61, 0x36, 0xb4, 0x61, 0xa5, 0x2f, 0x9a, 0xf2, 0xca, 0xff, 0x05, 0x67, 0xc2,
+	0xb5, 0xec, 0xbe, 0x82, 0xe7, 0xb2, 0xf5, 0x45, 0x01, 0xaa, 0xc8, 0xd7, 0x07, 0x21, 0xba, 0x01,
+	0x77, 0xb7, 0xa5, 0x2f, 0x0c, 0xc3, 0x62, 0x2b, 0x30, 0x75, 0xc4, 0x8f, 0xac, 0x86, 0x7d, 0xc0,
+	0x1b, 0xca, 0xc7, 0xca, 0xb8, 0xf1, 0x88, 0xd6, 0xf2, 0x29, 0x78, 0xa4, 0xdc, 0x8b, 0x7e, 0xd2,
+	0x3b, 0xb4, 0xd5, 0x3e, 0xc0, 0xac, 0xae, 0xf9, 0xd5, 0x94, 0xdc, 0xa1, 0xd7, 0x42, 0x00, 0x17,
+	0xfa, 0xb0, 0xa0, 0x14, 0xa3, 0x7b, 0x73, 0xa1, 0xdb, 0x9b, 0x07, 0x72, 0x31, 0xc0, 0xd5, 0x8d,
+	0xdf, 0x17, 0xa0, 0xb6, 0xcb, 0x9d, 0xf0, 0xb4, 0xa5, 0x0c, 0xf2, 0x1c, 0x4b, 0x8e, 0x7f, 0x34,
+	0x54, 0xec, 0x73, 0x30, 0x2e, 0xdc, 0x4e, 0x10, 0x9b, 0x35, 0x4b, 0xe4, 0xb0, 0xec, 0x26, 0x54,
+	0x3a, 0x26, 0x53, 0x35, 0x40, 0x3a, 0xf4, 0x7c, 0x67, 0x5f, 0x14, 0x82, 0x87, 0xa5, 0x72, 0xb1,
+	0x52, 0xc2, 0x7f, 0x4b, 0x95, 0x71, 0xf4, 0x76, 0xab, 0x15, 0x84, 0x31, 0x06, 0x45, 0xd8, 0xc4,
+	0xca, 0x7b, 0xda, 0x08, 0x6c, 0xd7, 0xf8, 0x6a, 0x0c, 0x16, 0x15, 0x63, 0xdc, 0xd5, 0x59, 0x1b,
+	0xa4, 0x86, 0x1f, 0x60, 0x38, 0x28, 0xa3, 0xbb, 0xf4, 0x90, 0x19, 0x1b, 0xfa, 0x90, 0x99, 0x4e,
+	0xe1, 0xb7, 0x45, 0xc1, 0xd1, 0x58, 0x48, 0x0a, 0x0e, 0x6e, 0x3d, 0x95, 0x3b, 0x04, 0x10, 0x1c,
+	0x7c, 0xc4, 0x9d, 0xd8, 0xfa, 0x28, 0x4a, 0xdf, 0xd1, 0x20, 0xb7, 0x1e, 0xee, 0xff, 0xf8, 0x09,
+	0x01, 0x38, 0x81, 0xcb, 0x1d, 0x8b, 0x87, 0x21, 0x96, 0x2c, 0x59, 0x84, 0x41, 0x6c, 0xed, 0xd1,
+	0x0e, 0xbb, 0x0f, 0xe7, 0x34, 0x00, 0xcb, 0xe5, 0xb1, 0xed, 0x35, 0x22, 0x11, 0xd8, 0xd3, 0x5b,
+	0x4b, 0xc2, 0xbd, 0x77, 0x52, 0xe8, 0x5d, 0x79, 0x6a, 0x2e, 0x38, 0xd9, 0x2d, 0xe3, 0x37, 0xf8,
+	0xee, 0xe8, 0x01, 0xc4, 0x4c, 0x32, 0x89, 0x82, 0x45, 0xf6, 0x21, 0x4f, 0x34, 0xa3, 0x96, 0xd4,
+	0x3c, 0xa0, 0xf2, 0x78, 0x62, 0x2e, 0xfa, 0x2d, 0x8a, 0x7b, 0xd0, 0x68, 0x37, 0x7d, 0x21, 0x29,
+	0x66, 0x1d, 0xb9, 0x22, 0x21, 0x50, 0x39, 0xce, 0x91, 0x15, 0x87, 0x36, 0x16, 0xc5, 0x12, 0x56,
+	0x6a, 0x14, 0x42, 0x6c, 0x3d, 0xa3, 0x1d, 0x7a, 0xde, 0x78, 0x7e, 0xab, 0x1d, 0x2b, 0xf9, 0xe4,
+	0xc2, 0xf8, 0x09, 0xac, 0xf4, 0xf5, 0x24, 0xe5, 0xbd, 0x5b, 0x69, 0xe9, 0x2f, 0x74, 0xf5, 0x57,
+	0x7d, 0x4c, 0x9c, 0xbc, 0x00, 0x28, 0x8c, 0x31, 0x1c, 0x4c, 0xdb, 0x77, 0x83, 0xe6, 0xae, 0xb4,
+	0xf1, 0xd0, 0x30, 0xbe, 0x2d, 0xa2, 0x38, 0x83, 0x33, 0x34, 0x82, 0x8c, 0x17, 0x49, 0x23, 0x86,
+	0xff, 0x3e, 0x09, 0xb0, 0xe7, 0xa4, 0x98, 0x22, 0x60, 0x9f, 0x16, 0x02, 0x7a, 0xd6, 0x24, 0x6c,
+	0x79, 0xf8, 0x7d, 0x00, 0x47, 0x54, 0xf4, 0x11, 0xfd, 0x6c, 0x4a, 0x41, 0x63, 0xab, 0x81, 0x59,
+	0xb3, 0xf3, 0x74, 0x4a, 0xa8, 0x0d, 0xaf, 0x7c, 0x0f, 0x61, 0xa5, 0x2f, 0x9a, 0x12, 0xed, 0x95,
+	0x8c, 0x7a, 0xf5, 0x97, 0x55, 0x02, 0x9d, 0xea, 0xf5, 0x0d, 0xb8, 0xa8, 0x57, 0xde, 0xd1, 0x99,
+	0xd0, 0xdf, 0x1e, 0xcf, 0x4e, 0xbc, 0xe1, 0xb9, 0xfe, 0x57, 0x45, 0xed, 0xf1, 0x21, 0x31, 0x14,
+	0xc3, 0xaf, 0x43, 0x39, 0xe4, 0x14, 0xf7, 0xdc, 0x55, 0xd9, 0x7d, 0xb9, 0x47, 0x7f, 0xfb, 0x62,
+	0x60, 0x62, 0xa6, 0x80, 0x6c, 0x17, 0x16, 0x92, 0xdf, 0x56, 0x13, 0x9d, 0x1e, 0x9f, 0x22, 0xb6,
+	0xd2, 0x7e, 0x2e, 0x76, 0x25, 0xc1, 0x78, 0xac, 0x10, 0xd8, 0x6b, 0xc4, 0x6d, 0xe4, 0x85, 0x5c,
+	0xc6, 0xf8, 0x00, 0xdc, 0x04, 0x0e, 0x8b, 0x52, 0x45, 0xfd, 0xec, 0xd0, 0x2d, 0x0d, 0xc6, 0x9d,
+	0x57, 0x08, 0x29, 0xd9, 0x75, 0x18, 0x77, 0x79, 0x03, 0x11, 0xc7, 0x07, 0x23, 0x4a, 0x28, 0x0a,
+	0xe6, 0xa4, 0x4f, 0x99, 0x10, 0x0f, 0xe9, 0x64, 0x49, 0xce, 0x27, 0x1f, 0x97, 0xc2, 0xf9, 0x26,
+	0x87, 0x3b, 0x9f, 0x82, 0x46, 0xe7, 0xfb, 0x4b, 0x01, 0xae, 0xe9, 0x2f, 0x38, 0x32, 0xc9, 0xae,
+	0xe4, 0x93, 0xda, 0xad, 0xa1, 0x55, 0x52, 0xd7, 0xdd, 0xd8, 0x88, 0xba, 0x5b, 0x04, 0x95, 0xe1,
+	0x55, 0x9e, 0x19, 0xaf, 0x3f, 0xc5, 0x05, 0xbb, 0x08, 0x53, 0x4e, 0xe0, 0xd7, 0xbd, 0xb0, 0x89,
+	0x77, 0x95, 0xe4, 0x44, 0x26, 0xdd, 0xd0, 0xa5, 0x1f, 0xef, 0x92, 0xde, 0xf8, 0x63, 0x01, 0xae,
+	0x0f, 0x16, 0x41, 0x79, 0x98, 0x76, 0x45, 0xa1, 0x5b, 0x81, 0xa9, 0x25, 0xc6, 0x46, 0xb2, 0x44,
+	0x0d, 0xca, 0xdc, 0x47, 0xbd, 0xb4, 0x95, 0xc3, 0x94, 0xcd, 0x74, 0xdd, 0x29, 0x84, 0xa5, 0x4e,
+	0x21, 0xc4, 0xa6, 0xff, 0x72, 0xea, 0xf4, 0x0f, 0x03, 0x64, 0xcf, 0xb3, 0x0f, 0xfd, 0x20, 0xc2,
+	0x4e, 0x6c, 0x78, 0x88, 0xfd, 0x0d, 0x33, 0x7b, 0x07, 0x73, 0x1b, 0xfb, 0xe5, 0x66, 0x2b, 0xc6,
+	0xbe, 0xb4, 0x44, 0xb3, 0x47, 0x15, 0x29, 0x83, 0x8c, 0x2d, 0xe0, 0x28, 0x79, 0x7d, 0x84, 0xe8,
+	0x56, 0x7c, 0xda, 0x4a, 0x26, 0x46, 0x65, 0xda, 0x78, 0x86, 0xeb, 0xee, 0xcc, 0x56, 0xcc, 0x64,
+	0x36, 0x03, 0x66, 0x5f, 0xd8, 0x91, 0xd5, 0x01, 0x90, 0xa6, 0x99, 0xc6, 0xcd, 0x34, 0x35, 0x2e,
+	0xa5, 0xc9, 0x66, 0x3c, 0x69, 0xaf, 0x45, 0xc7, 0x86, 0x85, 0x41, 0x16, 0x3e, 0xd9, 0x4f, 0xca,
+	0x85, 0xe1, 0x50, 0x9b, 0x96, 0x51, 0x85, 0x17, 0x11, 0xb0, 0x63, 0xb7, 0xa3, 0xa4, 0x54, 0xc9,
+	0x85, 0xd8, 0x15, 0x0f, 0x07, 0x59, 0xa9, 0xe4, 0x22, 0x3b, 0xc0, 0x2a, 0xf6, 0x0c, 0xb0, 0x8c,
+	0x7f, 0x14, 0xe0, 0x4a, 0xbe, 0xce, 0x95, 0x47, 0xa0, 0xcb, 0xa5, 0xf5, 0x5e, 0x90, 0x45, 0x97,
+	0x4b, 0x37, 0x7a, 0xc6, 0x20, 0x63, 0x67, 0x1c, 0x83, 0x94, 0x6d, 0x69, 0xac, 0x08, 0xf9, 0x2b,
+	0xa6, 0xe5, 0xbc, 0xc7, 0x96, 0x66, 0x0a, 0xc7, 0xee, 0xa0, 0x21, 0x24, 0x9b, 0x3c, 0x12, 0x75,
+	0x76, 0x7a, 0xab, 0x9a, 0x41, 0x4a, 0xf5, 0x65, 0x76, 0x40, 0x8d, 0x6f, 0x0a, 0x7a, 0x56, 0xc5,
+	0x9a, 0x3c, 0xfc, 0xc1, 0xb6, 0x83, 0x0d, 0x4b, 0x6c, 0x87, 0xb1, 0x95, 0x8e, 0xb0, 0x47, 0x90,
+	0x6f, 0x4e, 0xa0, 0xa4, 0x6b, 0xf6, 0x23, 0x98, 0xe5, 0xbe, 0xab, 0x5d, 0x51, 0x1c, 0x7a, 0xc5,
+	0x0c, 0x22, 0x74, 0x2e, 0x48, 0x07, 0x23, 0xa5, 0xcc, 0x60, 0x44, 0xf5, 0xf8, 0xe3, 0x5d, 0x53,
+	0x86, 0x4f, 0x93, 0x5e, 0x4f, 0x88, 0xf8, 0x14, 0xb5, 0x11, 0x67, 0x0a, 0x6f, 0xe1, 0x0c, 0x85,
+	0xb7, 0x6b, 0x84, 0x34, 0x36, 0x6c, 0x84, 0x64, 0x7c, 0x55, 0x80, 0xa5, 0xac, 0x8e, 0x95, 0x1b,
+	0xad, 0x67, 0x6a, 0xad, 0xde, 0x96, 0x74, 0x58, 0x4d, 0xa3, 0x02, 0x3d, 0xe3, 0x90, 0x07, 0xf2,
+	0xc9, 0x38, 0x2c, 0x67, 0x22, 0x60, 0xf2, 0x90, 0x1c, 0x3c, 0xfb, 0xc0, 0x12, 0x8e, 0x38, 0xdc,
+	0x6e, 0x4a, 0xb2, 0xf7, 0x43, 0xbb, 0xc9, 0x1f, 0x05, 0x87, 0xc3, 0xf3, 0xcb, 0x9f, 0x0a, 0xb0,
+	0x9a, 0x83, 0xa9, 0xc4, 0xbb, 0x0b, 0x33, 0x6d, 0xf1, 0x0e, 0xb3, 0xea, 0x74, 0xa6, 0x94, 0x2c,
+	0x1f, 0x14, 0xf2, 0x81, 0x96, 0xe0, 0xbc, 0xfb, 0x1d, 0x73, 0xba, 0xdd, 0xd9, 0x61, 0x3f, 0x84,
+	0x39, 0x6a, 0x43, 0x35, 0xdc, 0x31, 0xbd, 0x6f, 0x53, 0x47, 0x1a, 0xf6, 0xac, 0xab, 0xef, 0xbd,
+	0x3d, 0x89, 0xc9, 0x94, 0x7e, 0x18, 0x1f, 0x76, 0x4b, 0xb7, 0x77, 0xcc, 0xfd, 0x78, 0x14, 0xe9,
+	0xb0, 0x75, 0x9f, 0x21, 0xad, 0x37, 0xb9, 0x15, 0x07, 0x47, 0xdc, 0x57, 0xa9, 0x6f, 0x5a, 0xee,
+	0x3d, 0xa3, 0x2d, 0xe3, 0x97, 0x19, 0x05, 0x68, 0x97, 0x2b, 0x05, 0xe0, 0x63, 0x59, 0xe4, 0x4d,
+	0x79, 0xb5, 0xf8, 0x4d, 0x17, 0xab, 0xbe, 0xa0, 0x63, 0x48, 0xbc, 0x58, 0xed, 0x09, 0x9b, 0x61,
+	0xb7, 0x17, 0xf1, 0x8f, 0x85, 0xad, 0x4a, 0x26, 0xfd, 0xec, 0xe1, 0xa6, 0xd4, 0xcb, 0xcd, 0x73,
+	0xb8, 0xb4, 0x4f, 0x41, 0xa6, 0x19, 0x63, 0xc7, 0x6e, 0xc5, 0xed, 0x70, 0x78, 0x29, 0xc6, 0xb2,
+	0xe4, 0xb6, 0xc3, 0x8e, 0x3f, 0x53, 0x16, 0x57, 0x6b, 0xe3, 0x2e, 0xc9, 0x18, 0xb4, 0xce, 0x7e,
+	0x2b, 0x39, 0x56, 0xea, 0xf6, 0x67, 0x42, 0xfc, 0x6b, 0x01, 0x66, 0x15, 0xac, 0x2b, 0xdd, 0xe1,
+	0x2d, 0x40, 0x51, 0x1d, 0xee, 0x1d, 0x8f, 0x1a, 0xac, 0x90, 0x80, 0x63, 0xb4, 0xde, 0xc9, 0x78,
+	0xe1, 0x58, 0xae, 0x17, 0x76, 0xfb, 0xe0, 0xbd, 0x1e, 0x1f, 0x2c, 0x0e, 0xf0, 0xc1, 0x8c, 0x07,
+	0x1a, 0x5f, 0xa3, 0x73, 0xe4, 0x88, 0xaf, 0x9c, 0x03, 0x93, 0x95, 0x28, 0x19, 0x5c, 0x15, 0x10,
+	0xb5, 0xa2, 0xc4, 0x24, 0xb2, 0xe5, 0xc8, 0x1d, 0x81, 0x82, 0x46, 0x51, 0x5f, 0x87, 0x49, 0xcc,
+	0x92, 0x11, 0xe1, 0x0d, 0x4f, 0xa8, 0x13, 0x04, 0x8a, 0x48, 0x6b, 0xf8, 0xaa, 0x22, 0xfe, 0x92,
+	0xc2, 0xc1, 0x64, 0xf3, 0xa8, 0x1b, 0xc0, 0x54, 0x10, 0xd4, 0x47, 0xed, 0x7d, 0x42, 0x2f, 0x30,
+	0xf5, 0xde, 0xc7, 0xd7, 0xe8, 0x08, 0x7e, 0x50, 0xed, 0xc5, 0x51, 0x3a, 0xa0, 0x07, 0x04, 0xae,
+	0x65, 0x24, 0x48, 0xb4, 0x32, 0x6d, 0x50, 0x18, 0x18, 0xaf, 0xc1, 0xd2, 0x1e, 0x7d, 0x77, 0x3d,
+	0x03, 0xad, 0xef, 0xc1, 0x85, 0xfd, 0x44, 0xe9, 0x8f, 0x92, 0x8f, 0x72, 0x43, 0xb1, 0xee, 0xc0,
+	0xca, 0x4e, 0x83, 0xdb, 0xe1, 0x19, 0xf1, 0xb6, 0xfe, 0xb9, 0x02, 0xb3, 0x12, 0x67, 0x5f, 0xce,
+	0xbb, 0xd9, 0x3e, 0x4c, 0xc8, 0xf9, 0x2c, 0x93, 0xe5, 0xb7, 0xcf, 0x17, 0x9b, 0xda, 0x52, 0x8f,
+	0x4d, 0xf6, 0xe8, 0x5b, 0xaf, 0xb1, 0xfc, 0xf3, 0xaf, 0xbf, 0xf9, 0xdd, 0xd8, 0x82, 0x31, 0x23,
+	0xbe, 0x21, 0xcb, 0x49, 0x54, 0xf4, 0x66, 0x61, 0x8d, 0x3d, 0x83, 0x22, 0x7a, 0x12, 0x93, 0x7e,
+	0x97, 0xfd, 0x8e, 0x53, 0x5b, 0xca, 0x6e, 0x4b, 0xd5, 0x1a, 0x97, 0xc4, 0x75, 0x55, 0xb6, 0xa4,
+	0x5f, 0xb7, 0xf9, 0xa9, 0x92, 0xe4, 0x73, 0xf6, 0x18, 0x4a, 0xd4, 0x06, 0x32, 0x89, 0xdf, 0xf3,
+	0xe9, 0xa1, 0xb6, 0xdc, 0xb3, 0xaf, 0x2e, 0x3e, 0x2f, 0x2e, 0x9e, 0x63, 0x5d, 0x7c, 0xb2, 0x0f,
+	0xe9, 0xa3, 0x32, 0x75, 0x82, 0x2c, 0x79, 0x78, 0xf4, 0xcc, 0xd3, 0x73, 0x25, 0x57, 0xac, 0xae,
+	0xe5, 0xb1, 0xea, 0xc2, 0x84, 0x7c, 0xa7, 0xab, 0xbb, 0xfb, 0xcc, 0xde, 0x73, 0xef, 0xbe, 0x21,
+	0xee, 0x36, 0x6a, 0xab, 0x3d, 0x77, 0xd3, 0xe7, 0xd8, 0x84, 0x04, 0xa9, 0xf9, 0x18, 0x40, 0x9a,
+	0x4b, 0x7c, 0xb9, 0xbb, 0xd8, 0x63, 0x3f, 0x6d, 0xac, 0x9c, 0x4b, 0x6d, 0x4b, 0x50, 0x7b, 0xd5,
+	0x78, 0xb9, 0x1f, 0x35, 0x31, 0xcf, 0x4e, 0x49, 0x6e, 0xd2, 0x8a, 0xe8, 0x72, 0x98, 0x44, 0xeb,
+	0x09, 0xa2, 0x17, 0xba, 0x6d, 0xa9, 0x53, 0xac, 0xf5, 0x3b, 0x52, 0x16, 0xb9, 0x26, 0xa8, 0xae,
+	0xb2, 0x95, 0xfe, 0xfa, 0x13, 0x94, 0x48, 0x3c, 0xa9, 0x37, 0x4d, 0xbc, 0x9c, 0x11, 0xfc, 0x30,
+	0xf1, 0x6a, 0x67, 0x11, 0xef, 0x90, 0x3e, 0x88, 0x92, 0x2f, 0x68, 0x74, 0x73, 0xa6, 0xf5, 0xb9,
+	0x74, 0x95, 0x80, 0x6b, 0x03, 0x05, 0xfc, 0x0c, 0xca, 0xc9, 0x84, 0x9a, 0x49, 0x6d, 0xf5, 0x1d,
+	0x58, 0xe7, 0x12, 0xb9, 0x27, 0x88, 0xdc, 0x31, 0x5e, 0xeb, 0x2b, 0x5c, 0x67, 0xee, 0xd8, 0x11,
+	0x31, 0x79, 0xf1, 0x93, 0x98, 0x9f, 0xc3, 0x2c, 0x1a, 0x47, 0xfb, 0x96, 0x70, 0xb9, 0xdb, 0x60,
+	0x3d, 0x63, 0xed, 0xda, 0x95, 0x7c, 0x00, 0x65, 0xd7, 0x9b, 0x82, 0xa3, 0x6b, 0xec, 0x6a, 0x8e,
+	0xd8, 0x1d, 0x9e, 0xd8, 0xaf, 0x0b, 0xe2, 0xa3, 0x6d, 0xf7, 0xc0, 0x97, 0xad, 0x26, 0x24, 0xfa,
+	0xce, 0xa2, 0x6b, 0x97, 0xf2, 0x8e, 0x15, 0xfd, 0xb7, 0x04, 0xfd, 0xdb, 0xc6, 0xad, 0xa1, 0xf4,
+	0x37, 0x4f, 0xba, 0x6e, 0x20, 0x85, 0x34, 0xc9, 0xee, 0x89, 0x86, 0x52, 0xbb, 0xdb, 0x67, 0x32,
+	0x89, 0x52, 0xc0, 0xda, 0x08, 0x0a, 0xf8, 0xb2, 0x40, 0xb9, 0x58, 0xcc, 0x00, 0xd5, 0x78, 0xf7,
+	0xb2, 0x3e, 0x17, 0xec, 0x33, 0x93, 0x56, 0x06, 0x18, 0x30, 0x6a, 0x34, 0x36, 0x05, 0xfd, 0x9b,
+	0xc6, 0xf5, 0x1c, 0xfa, 0xae, 0x4e, 0x90, 0x84, 0xfe, 0x59, 0x41, 0x7c, 0x69, 0xef, 0x1a, 0x1a,
+	0x2a, 0xd9, 0x73, 0xe6, 0x8f, 0xb5, 0xd5, 0x9c, 0xd3, 0x0c, 0x0b, 0x2f, 0xe7, 0xb0, 0x70, 0x98,
+	0xa5, 0x86, 0x8e, 0xa8, 0x92, 0xb6, 0x1c, 0xc5, 0x29, 0x3d, 0xe4, 0x4f, 0x0a, 0x95, 0x1e, 0x06,
+	0xcc, 0x04, 0x87, 0x3a, 0x22, 0xfe, 0x58, 0xf7, 0x25, 0xb5, 0x13, 0x98, 0x4f, 0xa3, 0x5b, 0x31,
+	0x70, 0xb5, 0x27, 0xe6, 0x7b, 0x58, 0xf8, 0xb6, 0x0e, 0xa0, 0x11, 0xfe, 0x6d, 0x01, 0x18, 0x6a,
+	0x31, 0xd3, 0xb1, 0xb3, 0xeb, 0xdd, 0x51, 0xd6, 0x7f, 0x88, 0x52, 0x7b, 0x69, 0x08, 0x54, 0xb7,
+	0x31, 0x58, 0x9e, 0x31, 0x68, 0x30, 0xb2, 0xee, 0x6a, 0xd4, 0x65, 0x6e, 0xa7, 0xc1, 0x52, 0x36,
+	0xb7, 0x6b, 0x43, 0xcf, 0x6c, 0x6e, 0xd7, 0xa7, 0x9b, 0x43, 0x73, 0x7b, 0x4c, 0x77, 0xff, 0x01,
+	0x5b, 0x4c, 0x99, 0xcb, 0xb3, 0x33, 0x2c, 0x76, 0xa3, 0x27, 0xd1, 0xe7, 0x4c, 0xea, 0x6a, 0x37,
+	0x47, 0x80, 0x54, 0x4c, 0x6d, 0x08, 0xa6, 0x6e, 0xd4, 0xae, 0x0d, 0x60, 0x6a, 0x53, 0x4d, 0xed,
+	0x28, 0x2c, 0x3c, 0x28, 0x93, 0x1a, 0xa8, 0xa3, 0x65, 0x59, 0x61, 0xb5, 0xa1, 0x43, 0x6d, 0xa5,
+	0xef, 0x99, 0x22, 0x7a, 0x5d, 0x10, 0xbd, 0xc4, 0x2e, 0xe6, 0x11, 0x15, 0xd7, 0x7f, 0x81, 0x89,
+	0x50, 0xf4, 0x41, 0xfa, 0x9b, 0x9b, 0x5d, 0x13, 0x17, 0x0f, 0xee, 0x8f, 0x72, 0x9d, 0x70, 0x58,
+	0x16, 0x10, 0x6f, 0xe3, 0x75, 0x47, 0x5e, 0x46, 0xe2, 0x7e, 0x06, 0x15, 0xea, 0x99, 0xba, 0x38,
+	0x30, 0x14, 0x07, 0x03, 0x5a, 0xa9, 0x5c, 0x06, 0x5e, 0x15, 0x0c, 0x7c, 0x77, 0x6d, 0x24, 0x06,
+	0xd8, 0x2f, 0x0a, 0x30, 0x8f, 0x2a, 0xec, 0xa2, 0x7e, 0xb5, 0x5b, 0xb1, 0xfd, 0x88, 0x1b, 0x83,
+	0x40, 0x94, 0x09, 0x14, 0x23, 0x6c, 0x34, 0x46, 0xda, 0x00, 0xea, 0xe1, 0x4f, 0x43, 0x6b, 0x99,
+	0x05, 0x73, 0xba, 0x07, 0x95, 0x05, 0xf3, 0xfa, 0x04, 0x63, 0x4d, 0x10, 0xbe, 0xce, 0x8c, 0xbc,
+	0x3c, 0x80, 0xc0, 0xeb, 0x5c, 0x60, 0xb3, 0x3a, 0x4c, 0xc9, 0xb6, 0x81, 0xa8, 0x4a, 0x8f, 0xea,
+	0xdf, 0x46, 0xe4, 0xea, 0x5b, 0x79, 0x9a, 0x91, 0xe7, 0x69, 0x9c, 0xae, 0x63, 0x1f, 0xc3, 0x0c,
+	0xf6, 0x1a, 0x69, 0xb7, 0xc0, 0x64, 0x35, 0xcd, 0x6d, 0x3f, 0x86, 0xe5, 0x38, 0x23, 0x2f, 0xc7,
+	0x89, 0x3f, 0x2e, 0x5c, 0xa7, 0x3f, 0x37, 0x44, 0x8d, 0xce, 0x89, 0x46, 0xa5, 0x43, 0x54, 0xe6,
+	0xee, 0x01, 0xdd, 0xcb, 0xb7, 0x4e, 0xad, 0x1a, 0x59, 0x8c, 0xa9, 0x79, 0x39, 0xe8, 0x48, 0x67,
+	0x3c, 0xca, 0xa3, 0x06, 0x4d, 0x8e, 0x6a, 0xc6, 0x20, 0x10, 0x65, 0xd8, 0x97, 0x04, 0x17, 0x97,
+	0xd9, 0xea, 0x20, 0x8f, 0x8a, 0x6e, 0x15, 0x34, 0x1e, 0xd2, 0x31, 0x4b, 0x1f, 0x1e, 0xb2, 0xf3,
+	0x9d, 0x3e, 0x3c, 0xf4, 0x4c, 0x69, 0x86, 0xf2, 0xc0, 0x09, 0x03, 0x79, 0x38, 0x98, 0x10, 0x2a,
+	0x7c, 0xfd, 0x3f, 0xb3, 0xec, 0xa0, 0xb0, 0x36, 0x2c, 0x00, 0x00,
 }
@@ -7,6 +7,7 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import empty "github.com/golang/protobuf/ptypes/empty"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
 
 import (
@@ -233,7 +234,11 @@ func (m *FlushDeviceQueueRequest) GetDevEui() string {
 
 type ListDeviceQueueItemsRequest struct {
 	// Device EUI (HEX encoded).
-	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Max number of queue-items to return in the result-set (0 = no limit).
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Cursor of the result-set (for cursor-based pagination).
+	Cursor               string   `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -270,11 +275,28 @@ func (m *ListDeviceQueueItemsRequest) GetDevEui() string {
 	return ""
 }
 
+func (m *ListDeviceQueueItemsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListDeviceQueueItemsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 type ListDeviceQueueItemsResponse struct {
-	DeviceQueueItems     []*DeviceQueueItem `protobuf:"bytes,1,rep,name=device_queue_items,json=deviceQueueItems,proto3" json:"device_queue_items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	DeviceQueueItems []*DeviceQueueItem `protobuf:"bytes,1,rep,name=device_queue_items,json=deviceQueueItems,proto3" json:"device_queue_items,omitempty"`
+	// Cursor for retrieving the next page. This is empty when there are no
+	// more queue-items.
+	NextCursor           string   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ListDeviceQueueItemsResponse) Reset()         { *m = ListDeviceQueueItemsResponse{} }
@@ -308,6 +330,270 @@ func (m *ListDeviceQueueItemsResponse) GetDeviceQueueItems() []*DeviceQueueItem
 	return nil
 }
 
+func (m *ListDeviceQueueItemsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type GetDeviceQueueDiagnosticsRequest struct {
+	// Device EUI (HEX encoded).
+	DevEui               string   `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDeviceQueueDiagnosticsRequest) Reset()         { *m = GetDeviceQueueDiagnosticsRequest{} }
+func (m *GetDeviceQueueDiagnosticsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceQueueDiagnosticsRequest) ProtoMessage()    {}
+func (*GetDeviceQueueDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae6ff84951d6e0cf, []int{6}
+}
+func (m *GetDeviceQueueDiagnosticsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceQueueDiagnosticsRequest.Unmarshal(m, b)
+}
+func (m *GetDeviceQueueDiagnosticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceQueueDiagnosticsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceQueueDiagnosticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceQueueDiagnosticsRequest.Merge(dst, src)
+}
+func (m *GetDeviceQueueDiagnosticsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceQueueDiagnosticsRequest.Size(m)
+}
+func (m *GetDeviceQueueDiagnosticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceQueueDiagnosticsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceQueueDiagnosticsRequest proto.InternalMessageInfo
+
+func (m *GetDeviceQueueDiagnosticsRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+type DownlinkTraceEvent struct {
+	// Time of the event.
+	Time *timestamp.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// Type of the event (ENQUEUED, ENQUEUE_ERROR, NS_ERROR, ACK, NACK or
+	// FLUSHED).
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Error (in case of an error event).
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DownlinkTraceEvent) Reset()         { *m = DownlinkTraceEvent{} }
+func (m *DownlinkTraceEvent) String() string { return proto.CompactTextString(m) }
+func (*DownlinkTraceEvent) ProtoMessage()    {}
+func (*DownlinkTraceEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae6ff84951d6e0cf, []int{7}
+}
+func (m *DownlinkTraceEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownlinkTraceEvent.Unmarshal(m, b)
+}
+func (m *DownlinkTraceEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownlinkTraceEvent.Marshal(b, m, deterministic)
+}
+func (dst *DownlinkTraceEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownlinkTraceEvent.Merge(dst, src)
+}
+func (m *DownlinkTraceEvent) XXX_Size() int {
+	return xxx_messageInfo_DownlinkTraceEvent.Size(m)
+}
+func (m *DownlinkTraceEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownlinkTraceEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownlinkTraceEvent proto.InternalMessageInfo
+
+func (m *DownlinkTraceEvent) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *DownlinkTraceEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *DownlinkTraceEvent) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type DownlinkDiagnostics struct {
+	// Downlink frame-counter.
+	FCnt uint32 `protobuf:"varint,1,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	// FPort used.
+	FPort uint32 `protobuf:"varint,2,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// Confirmed downlink.
+	Confirmed bool `protobuf:"varint,3,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// Stage of the downlink path (AS_ERROR, NS_QUEUE, NS_ERROR, SENT,
+	// ACKNOWLEDGED, NOT_ACKNOWLEDGED or FLUSHED).
+	Stage string `protobuf:"bytes,4,opt,name=stage,proto3" json:"stage,omitempty"`
+	// Human-readable explanation of the stage.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Trace events of the downlink, oldest first.
+	Events               []*DownlinkTraceEvent `protobuf:"bytes,6,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DownlinkDiagnostics) Reset()         { *m = DownlinkDiagnostics{} }
+func (m *DownlinkDiagnostics) String() string { return proto.CompactTextString(m) }
+func (*DownlinkDiagnostics) ProtoMessage()    {}
+func (*DownlinkDiagnostics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae6ff84951d6e0cf, []int{8}
+}
+func (m *DownlinkDiagnostics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownlinkDiagnostics.Unmarshal(m, b)
+}
+func (m *DownlinkDiagnostics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownlinkDiagnostics.Marshal(b, m, deterministic)
+}
+func (dst *DownlinkDiagnostics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownlinkDiagnostics.Merge(dst, src)
+}
+func (m *DownlinkDiagnostics) XXX_Size() int {
+	return xxx_messageInfo_DownlinkDiagnostics.Size(m)
+}
+func (m *DownlinkDiagnostics) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownlinkDiagnostics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownlinkDiagnostics proto.InternalMessageInfo
+
+func (m *DownlinkDiagnostics) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+func (m *DownlinkDiagnostics) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *DownlinkDiagnostics) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
+	}
+	return false
+}
+
+func (m *DownlinkDiagnostics) GetStage() string {
+	if m != nil {
+		return m.Stage
+	}
+	return ""
+}
+
+func (m *DownlinkDiagnostics) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *DownlinkDiagnostics) GetEvents() []*DownlinkTraceEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type GetDeviceQueueDiagnosticsResponse struct {
+	// The device has been activated.
+	Activated bool `protobuf:"varint,1,opt,name=activated,proto3" json:"activated,omitempty"`
+	// The device only supports Class-A.
+	ClassA bool `protobuf:"varint,2,opt,name=class_a,json=classA,proto3" json:"class_a,omitempty"`
+	// Last time the device was seen (sent an uplink).
+	LastSeenAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	// Number of items in the network-server device-queue.
+	QueueSize uint32 `protobuf:"varint,4,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
+	// Recent downlinks, newest first.
+	Downlinks            []*DownlinkDiagnostics `protobuf:"bytes,5,rep,name=downlinks,proto3" json:"downlinks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GetDeviceQueueDiagnosticsResponse) Reset()         { *m = GetDeviceQueueDiagnosticsResponse{} }
+func (m *GetDeviceQueueDiagnosticsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDeviceQueueDiagnosticsResponse) ProtoMessage()    {}
+func (*GetDeviceQueueDiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae6ff84951d6e0cf, []int{9}
+}
+func (m *GetDeviceQueueDiagnosticsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDeviceQueueDiagnosticsResponse.Unmarshal(m, b)
+}
+func (m *GetDeviceQueueDiagnosticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDeviceQueueDiagnosticsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetDeviceQueueDiagnosticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDeviceQueueDiagnosticsResponse.Merge(dst, src)
+}
+func (m *GetDeviceQueueDiagnosticsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDeviceQueueDiagnosticsResponse.Size(m)
+}
+func (m *GetDeviceQueueDiagnosticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDeviceQueueDiagnosticsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDeviceQueueDiagnosticsResponse proto.InternalMessageInfo
+
+func (m *GetDeviceQueueDiagnosticsResponse) GetActivated() bool {
+	if m != nil {
+		return m.Activated
+	}
+	return false
+}
+
+func (m *GetDeviceQueueDiagnosticsResponse) GetClassA() bool {
+	if m != nil {
+		return m.ClassA
+	}
+	return false
+}
+
+func (m *GetDeviceQueueDiagnosticsResponse) GetLastSeenAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastSeenAt
+	}
+	return nil
+}
+
+func (m *GetDeviceQueueDiagnosticsResponse) GetQueueSize() uint32 {
+	if m != nil {
+		return m.QueueSize
+	}
+	return 0
+}
+
+func (m *GetDeviceQueueDiagnosticsResponse) GetDownlinks() []*DownlinkDiagnostics {
+	if m != nil {
+		return m.Downlinks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DeviceQueueItem)(nil), "api.DeviceQueueItem")
 	proto.RegisterType((*EnqueueDeviceQueueItemRequest)(nil), "api.EnqueueDeviceQueueItemRequest")
@@ -315,6 +601,10 @@ func init() {
 	proto.RegisterType((*FlushDeviceQueueRequest)(nil), "api.FlushDeviceQueueRequest")
 	proto.RegisterType((*ListDeviceQueueItemsRequest)(nil), "api.ListDeviceQueueItemsRequest")
 	proto.RegisterType((*ListDeviceQueueItemsResponse)(nil), "api.ListDeviceQueueItemsResponse")
+	proto.RegisterType((*GetDeviceQueueDiagnosticsRequest)(nil), "api.GetDeviceQueueDiagnosticsRequest")
+	proto.RegisterType((*DownlinkTraceEvent)(nil), "api.DownlinkTraceEvent")
+	proto.RegisterType((*DownlinkDiagnostics)(nil), "api.DownlinkDiagnostics")
+	proto.RegisterType((*GetDeviceQueueDiagnosticsResponse)(nil), "api.GetDeviceQueueDiagnosticsResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -335,6 +625,9 @@ type DeviceQueueServiceClient interface {
 	Flush(ctx context.Context, in *FlushDeviceQueueRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// List lists the items in the device-queue.
 	List(ctx context.Context, in *ListDeviceQueueItemsRequest, opts ...grpc.CallOption) (*ListDeviceQueueItemsResponse, error)
+	// GetDiagnostics returns the diagnostics of the downlink path, explaining
+	// for each of the recent downlinks where it is (or was) stuck.
+	GetDiagnostics(ctx context.Context, in *GetDeviceQueueDiagnosticsRequest, opts ...grpc.CallOption) (*GetDeviceQueueDiagnosticsResponse, error)
 }
 
 type deviceQueueServiceClient struct {
@@ -372,6 +665,15 @@ func (c *deviceQueueServiceClient) List(ctx context.Context, in *ListDeviceQueue
 	return out, nil
 }
 
+func (c *deviceQueueServiceClient) GetDiagnostics(ctx context.Context, in *GetDeviceQueueDiagnosticsRequest, opts ...grpc.CallOption) (*GetDeviceQueueDiagnosticsResponse, error) {
+	out := new(GetDeviceQueueDiagnosticsResponse)
+	err := c.cc.Invoke(ctx, "/api.DeviceQueueService/GetDiagnostics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeviceQueueServiceServer is the server API for DeviceQueueService service.
 type DeviceQueueServiceServer interface {
 	// Enqueue adds the given item to the device-queue.
@@ -380,6 +682,9 @@ type DeviceQueueServiceServer interface {
 	Flush(context.Context, *FlushDeviceQueueRequest) (*empty.Empty, error)
 	// List lists the items in the device-queue.
 	List(context.Context, *ListDeviceQueueItemsRequest) (*ListDeviceQueueItemsResponse, error)
+	// GetDiagnostics returns the diagnostics of the downlink path, explaining
+	// for each of the recent downlinks where it is (or was) stuck.
+	GetDiagnostics(context.Context, *GetDeviceQueueDiagnosticsRequest) (*GetDeviceQueueDiagnosticsResponse, error)
 }
 
 func RegisterDeviceQueueServiceServer(s *grpc.Server, srv DeviceQueueServiceServer) {
@@ -440,6 +745,24 @@ func _DeviceQueueService_List_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceQueueService_GetDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceQueueDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceQueueServiceServer).GetDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceQueueService/GetDiagnostics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceQueueServiceServer).GetDiagnostics(ctx, req.(*GetDeviceQueueDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DeviceQueueService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.DeviceQueueService",
 	HandlerType: (*DeviceQueueServiceServer)(nil),
@@ -456,6 +779,10 @@ var _DeviceQueueService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _DeviceQueueService_List_Handler,
 		},
+		{
+			MethodName: "GetDiagnostics",
+			Handler:    _DeviceQueueService_GetDiagnostics_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "deviceQueue.proto",
@@ -464,34 +791,54 @@ var _DeviceQueueService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("deviceQueue.proto", fileDescriptor_ae6ff84951d6e0cf) }
 
 var fileDescriptor_ae6ff84951d6e0cf = []byte{
-	// 462 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xd6, 0xe6, 0xaf, 0x74, 0x0a, 0x2a, 0x0c, 0x3f, 0xb5, 0x5c, 0x53, 0x8c, 0xe1, 0x10, 0xf5,
-	0x60, 0x4b, 0xa9, 0x40, 0x82, 0x13, 0x02, 0x82, 0x54, 0x09, 0x09, 0x30, 0xe2, 0x6c, 0x39, 0xf6,
-	0xb8, 0x6c, 0x95, 0xec, 0x3a, 0xde, 0x75, 0x24, 0x84, 0xb8, 0x70, 0xe7, 0xc4, 0x53, 0xf0, 0x3c,
-	0xbc, 0x02, 0x8f, 0xc1, 0x01, 0x79, 0xed, 0x28, 0x21, 0x21, 0xe6, 0xb6, 0xfe, 0x76, 0x66, 0xbf,
-	0x9f, 0xf1, 0xc0, 0x8d, 0x94, 0x16, 0x3c, 0xa1, 0x77, 0x25, 0x95, 0xe4, 0xe7, 0x85, 0xd4, 0x12,
-	0xbb, 0x71, 0xce, 0x6d, 0xe7, 0x42, 0xca, 0x8b, 0x29, 0x05, 0x71, 0xce, 0x83, 0x58, 0x08, 0xa9,
-	0x63, 0xcd, 0xa5, 0x50, 0x75, 0x89, 0x7d, 0xdc, 0xdc, 0x9a, 0xaf, 0x49, 0x99, 0x05, 0x34, 0xcb,
-	0xf5, 0xa7, 0xfa, 0xd2, 0xfb, 0xc1, 0xe0, 0xf0, 0xe5, 0xea, 0xd5, 0x73, 0x4d, 0x33, 0x3c, 0x82,
-	0xbd, 0x94, 0x16, 0x11, 0x95, 0xdc, 0x62, 0x2e, 0x1b, 0xee, 0x87, 0x83, 0x94, 0x16, 0xe3, 0x0f,
-	0xe7, 0xe8, 0xc0, 0x7e, 0x22, 0x45, 0xc6, 0x8b, 0x19, 0xa5, 0x56, 0xc7, 0x65, 0xc3, 0x2b, 0xe1,
-	0x0a, 0xc0, 0x9b, 0xd0, 0xcf, 0xa2, 0x44, 0x68, 0x6b, 0xe0, 0xb2, 0xe1, 0xb5, 0xb0, 0x97, 0xbd,
-	0x10, 0x1a, 0x6f, 0xc3, 0x20, 0x8b, 0x72, 0x59, 0x68, 0xab, 0x6b, 0xd0, 0x7e, 0xf6, 0x56, 0x16,
-	0x1a, 0x11, 0x7a, 0x69, 0xac, 0x63, 0xab, 0xe7, 0xb2, 0xe1, 0xd5, 0xd0, 0x9c, 0xf1, 0x1e, 0x1c,
-	0x5c, 0x2a, 0x29, 0x22, 0x39, 0xb9, 0xa4, 0x44, 0x5b, 0x7d, 0x43, 0x0d, 0x15, 0xf4, 0xc6, 0x20,
-	0x5e, 0x0c, 0x77, 0xc7, 0x62, 0x5e, 0xc9, 0xdc, 0x50, 0x1c, 0xd2, 0xbc, 0x24, 0xa5, 0xf1, 0xd9,
-	0x32, 0xa1, 0xc8, 0x54, 0x45, 0x5c, 0xd3, 0xcc, 0x58, 0x38, 0x18, 0xdd, 0xf2, 0xe3, 0x9c, 0xfb,
-	0x9b, 0x7d, 0x87, 0xe9, 0xdf, 0x80, 0xf7, 0x08, 0x4e, 0x76, 0x51, 0xa8, 0x5c, 0x0a, 0x45, 0x2b,
-	0x97, 0x6c, 0xe5, 0xd2, 0x1b, 0xc1, 0xd1, 0xab, 0x69, 0xa9, 0x3e, 0xae, 0x35, 0x2d, 0x35, 0xed,
-	0x0a, 0xd3, 0x7b, 0x0c, 0xc7, 0xaf, 0xb9, 0xd2, 0x1b, 0x3c, 0xea, 0xbf, 0x7d, 0x13, 0x70, 0xfe,
-	0xdd, 0xd7, 0x08, 0x7c, 0x0e, 0xb8, 0x15, 0x82, 0xb2, 0x98, 0xdb, 0xdd, 0x99, 0xc2, 0xf5, 0x8d,
-	0x14, 0xd4, 0xe8, 0x77, 0x07, 0x70, 0xad, 0xea, 0x3d, 0x15, 0xd5, 0x19, 0xbf, 0x31, 0xd8, 0x6b,
-	0xe2, 0x41, 0xcf, 0x3c, 0xd5, 0x3a, 0x0f, 0xfb, 0x41, 0x6b, 0x4d, 0xad, 0xd7, 0x7b, 0xf2, 0xf5,
-	0xe7, 0xaf, 0xef, 0x9d, 0x33, 0xcf, 0x37, 0xbf, 0x6f, 0x2d, 0x45, 0x05, 0x9f, 0xb7, 0x3c, 0xf8,
-	0x4d, 0x1c, 0x5f, 0x02, 0x83, 0x3d, 0x65, 0xa7, 0x98, 0x40, 0xdf, 0xc4, 0x8e, 0x8e, 0x21, 0xda,
-	0x31, 0x02, 0xfb, 0x8e, 0x5f, 0x6f, 0x80, 0xbf, 0xdc, 0x00, 0x7f, 0x5c, 0x6d, 0x80, 0xf7, 0xd0,
-	0x30, 0x9f, 0x9c, 0x3a, 0x5b, 0xcc, 0x6b, 0x3c, 0x38, 0x87, 0x5e, 0x95, 0x37, 0xba, 0x86, 0xa3,
-	0x65, 0x64, 0xf6, 0xfd, 0x96, 0x8a, 0xc6, 0x6c, 0x43, 0x89, 0xad, 0x94, 0x93, 0x81, 0x11, 0x7a,
-	0xf6, 0x27, 0x00, 0x00, 0xff, 0xff, 0x2e, 0x6a, 0x2a, 0x84, 0xf0, 0x03, 0x00, 0x00,
+	// 775 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x06, 0xf5, 0x43, 0x5b, 0x63, 0xbb, 0x6e, 0xd7, 0xae, 0x4d, 0xc8, 0xb2, 0x2d, 0xb3, 0x3f,
+	0x10, 0x7c, 0xa0, 0x0a, 0x1b, 0x2d, 0xd0, 0x9f, 0x43, 0x5d, 0x5b, 0x2d, 0x0c, 0x14, 0x68, 0x4b,
+	0xbb, 0x67, 0x62, 0x4d, 0x8e, 0xd4, 0x75, 0xa5, 0x5d, 0x9a, 0xbb, 0x52, 0x62, 0x07, 0xb9, 0x24,
+	0xe7, 0x00, 0x09, 0xf2, 0x14, 0x79, 0x89, 0xbc, 0x44, 0x5e, 0x21, 0xf7, 0xbc, 0x42, 0xc0, 0xe5,
+	0x2a, 0xa2, 0x25, 0x8b, 0xba, 0x71, 0x66, 0x67, 0xf9, 0xcd, 0xf7, 0xcd, 0x37, 0x0b, 0x5f, 0x44,
+	0x38, 0x62, 0x21, 0xfe, 0x33, 0xc4, 0x21, 0x7a, 0x71, 0x22, 0x94, 0x20, 0x65, 0x1a, 0xb3, 0x7a,
+	0xa3, 0x27, 0x44, 0xaf, 0x8f, 0x6d, 0x1a, 0xb3, 0x36, 0xe5, 0x5c, 0x28, 0xaa, 0x98, 0xe0, 0x32,
+	0x2b, 0xa9, 0xef, 0x98, 0x53, 0x1d, 0x5d, 0x0d, 0xbb, 0x6d, 0x1c, 0xc4, 0xea, 0xd6, 0x1c, 0xee,
+	0x4f, 0x1f, 0x2a, 0x36, 0x40, 0xa9, 0xe8, 0x20, 0xce, 0x0a, 0xdc, 0x37, 0x16, 0xac, 0x9f, 0x4d,
+	0x60, 0xcf, 0x15, 0x0e, 0xc8, 0x36, 0x2c, 0x45, 0x38, 0x0a, 0x70, 0xc8, 0x1c, 0xab, 0x69, 0xb5,
+	0x6a, 0xbe, 0x1d, 0xe1, 0xa8, 0xf3, 0xef, 0x39, 0x69, 0x40, 0x2d, 0x14, 0xbc, 0xcb, 0x92, 0x01,
+	0x46, 0x4e, 0xa9, 0x69, 0xb5, 0x96, 0xfd, 0x49, 0x82, 0x6c, 0x40, 0xb5, 0x1b, 0x84, 0x5c, 0x39,
+	0x76, 0xd3, 0x6a, 0xad, 0xf9, 0x95, 0xee, 0x29, 0x57, 0xe4, 0x4b, 0xb0, 0xbb, 0x41, 0x2c, 0x12,
+	0xe5, 0x94, 0x75, 0xb6, 0xda, 0xfd, 0x5b, 0x24, 0x8a, 0x10, 0xa8, 0x44, 0x54, 0x51, 0xa7, 0xd2,
+	0xb4, 0x5a, 0xab, 0xbe, 0xfe, 0x26, 0xfb, 0xb0, 0x72, 0x2d, 0x05, 0x0f, 0xc4, 0xd5, 0x35, 0x86,
+	0xca, 0xa9, 0x6a, 0x68, 0x48, 0x53, 0x7f, 0xe9, 0x8c, 0x4b, 0x61, 0xb7, 0xc3, 0x6f, 0xd2, 0x36,
+	0xa7, 0x3a, 0xf6, 0xf1, 0x66, 0x88, 0x52, 0x91, 0x5f, 0xc7, 0x12, 0x06, 0xba, 0x2a, 0x60, 0x0a,
+	0x07, 0x9a, 0xc2, 0xca, 0xd1, 0xa6, 0x47, 0x63, 0xe6, 0x4d, 0xdf, 0x5b, 0x8f, 0xee, 0x27, 0xdc,
+	0xef, 0x61, 0x6f, 0x1e, 0x84, 0x8c, 0x05, 0x97, 0x38, 0x61, 0x69, 0x4d, 0x58, 0xba, 0x47, 0xb0,
+	0xfd, 0x7b, 0x7f, 0x28, 0xff, 0xcb, 0x5d, 0x1a, 0xf7, 0x34, 0x4f, 0x4c, 0x37, 0x82, 0x9d, 0x3f,
+	0x99, 0x54, 0x53, 0x38, 0x72, 0xd1, 0x3d, 0xb2, 0x09, 0xd5, 0x3e, 0x1b, 0x30, 0xa5, 0x07, 0x50,
+	0xf6, 0xb3, 0x80, 0x6c, 0x81, 0x1d, 0x0e, 0x13, 0x29, 0x12, 0xad, 0x73, 0xcd, 0x37, 0x91, 0xfb,
+	0xdc, 0x82, 0xc6, 0xc3, 0x30, 0x86, 0xcf, 0x6f, 0x40, 0x66, 0x34, 0x93, 0x8e, 0xd5, 0x2c, 0xcf,
+	0x15, 0xed, 0xf3, 0x29, 0xd1, 0x64, 0x3a, 0x39, 0x8e, 0x8f, 0x55, 0x60, 0x3a, 0x28, 0x65, 0x93,
+	0x4b, 0x53, 0xa7, 0x59, 0x17, 0x3f, 0x43, 0xf3, 0x0f, 0xcc, 0xf7, 0x70, 0xc6, 0x68, 0x8f, 0x0b,
+	0xa9, 0x58, 0xb8, 0x90, 0xb0, 0xcb, 0x81, 0x9c, 0x89, 0x47, 0xbc, 0xcf, 0xf8, 0xff, 0x97, 0x09,
+	0x0d, 0xb1, 0x33, 0x42, 0xae, 0x88, 0x07, 0x95, 0xd4, 0xcb, 0x66, 0xbc, 0x75, 0x2f, 0x33, 0xba,
+	0x37, 0x36, 0xba, 0x77, 0x39, 0x36, 0xba, 0xaf, 0xeb, 0x52, 0xc7, 0xa9, 0xdb, 0x18, 0x4d, 0x73,
+	0xfa, 0x3b, 0x95, 0x12, 0x93, 0xe4, 0x93, 0x66, 0x59, 0xe0, 0xbe, 0xb5, 0x60, 0x63, 0x0c, 0x98,
+	0xeb, 0xf3, 0xc1, 0xc9, 0xe7, 0xfc, 0x5d, 0xca, 0xfb, 0xfb, 0xde, 0xa6, 0x94, 0xa7, 0x37, 0x65,
+	0x13, 0xaa, 0x52, 0xd1, 0x1e, 0x6a, 0xfb, 0xd7, 0xfc, 0x2c, 0x48, 0x47, 0x98, 0x20, 0x95, 0x82,
+	0x1b, 0xeb, 0x9b, 0x88, 0xb4, 0xc1, 0xc6, 0x94, 0xb2, 0x74, 0x6c, 0x3d, 0x95, 0xed, 0x6c, 0x2a,
+	0x33, 0x92, 0xf8, 0xa6, 0xcc, 0xfd, 0x60, 0xc1, 0x41, 0x81, 0xdc, 0x66, 0xf0, 0x0d, 0xa8, 0xd1,
+	0x50, 0xb1, 0x11, 0x55, 0x18, 0x69, 0x4a, 0xcb, 0xfe, 0x24, 0x91, 0x4e, 0x23, 0xec, 0x53, 0x29,
+	0x03, 0x6a, 0x16, 0xdd, 0xd6, 0xe1, 0x09, 0xf9, 0x05, 0x56, 0xfb, 0x54, 0xaa, 0x40, 0x22, 0xf2,
+	0x80, 0x66, 0x6b, 0x5d, 0xac, 0x3f, 0xa4, 0xf5, 0x17, 0x88, 0xfc, 0x44, 0x91, 0x5d, 0x80, 0xcc,
+	0x66, 0x92, 0xdd, 0x65, 0xf4, 0xd7, 0xfc, 0x9a, 0xce, 0x5c, 0xb0, 0x3b, 0x24, 0x3f, 0x40, 0x2d,
+	0x32, 0xbc, 0xa4, 0x53, 0xd5, 0x6c, 0x9d, 0x7b, 0x6c, 0xf3, 0x44, 0x26, 0xa5, 0x47, 0x2f, 0x2b,
+	0x40, 0x72, 0x74, 0x2f, 0x30, 0x49, 0xbf, 0xc9, 0x0b, 0x0b, 0x96, 0xcc, 0x3a, 0x13, 0x57, 0xff,
+	0xa7, 0xf0, 0xfd, 0xa8, 0x7f, 0x55, 0x58, 0x93, 0xe9, 0xe6, 0xfe, 0xf8, 0xec, 0xdd, 0xfb, 0xd7,
+	0xa5, 0x63, 0xd7, 0xd3, 0xef, 0x71, 0xb6, 0x0b, 0xb2, 0xfd, 0x64, 0x66, 0x89, 0x3c, 0xe3, 0xe6,
+	0xa7, 0x6d, 0x9d, 0xfb, 0xc9, 0x3a, 0x24, 0x21, 0x54, 0xf5, 0x33, 0x41, 0x1a, 0x1a, 0x68, 0xce,
+	0x93, 0x51, 0xdf, 0x9a, 0x11, 0xb3, 0x93, 0x3e, 0xe9, 0xee, 0xd7, 0x1a, 0x79, 0xef, 0xb0, 0x31,
+	0x83, 0x9c, 0xc3, 0x21, 0x37, 0x50, 0x49, 0x17, 0x9e, 0x34, 0x35, 0x46, 0xc1, 0x13, 0x53, 0x3f,
+	0x28, 0xa8, 0x30, 0x64, 0x0d, 0x24, 0x29, 0x86, 0x7c, 0x65, 0xc1, 0x67, 0xa9, 0xe1, 0x72, 0xcb,
+	0xf2, 0x8d, 0xfe, 0xf7, 0xa2, 0xa5, 0xaf, 0x7f, 0xbb, 0xa8, 0xcc, 0xf4, 0xf1, 0x9d, 0xee, 0xe3,
+	0x90, 0xb4, 0x8a, 0xfa, 0x68, 0x47, 0x93, 0x9b, 0x57, 0xb6, 0x16, 0xef, 0xf8, 0xe3, 0x00, 0x08,
+	0xef, 0x14, 0xda, 0x55, 0x07, 0x00, 0x00,
 }
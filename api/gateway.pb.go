@@ -10,6 +10,7 @@ import common "github.com/brocaar/loraserver/api/common"
 import empty "github.com/golang/protobuf/ptypes/empty"
 import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
+import field_mask "google.golang.org/genproto/protobuf/field_mask"
 
 import (
 	context "golang.org/x/net/context"
@@ -278,10 +279,13 @@ type GetGatewayResponse struct {
 	// First seen at timestamp.
 	FirstSeenAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=first_seen_at,json=firstSeenAt,proto3" json:"first_seen_at,omitempty"`
 	// Last seen at timestamp.
-	LastSeenAt           *timestamp.Timestamp `protobuf:"bytes,5,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	LastSeenAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	// Gateway metadata, as reported by the gateway stats.
+	// This is not set when no stats have been received yet.
+	Metadata             *GatewayMetadata `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *GetGatewayResponse) Reset()         { *m = GetGatewayResponse{} }
@@ -343,6 +347,116 @@ func (m *GetGatewayResponse) GetLastSeenAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *GetGatewayResponse) GetMetadata() *GatewayMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type GatewayMetadata struct {
+	// IP address of the gateway.
+	Ip string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	// Configuration version.
+	ConfigVersion string `protobuf:"bytes,2,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
+	// Packet-forwarder version.
+	PacketForwarderVersion string `protobuf:"bytes,3,opt,name=packet_forwarder_version,json=packetForwarderVersion,proto3" json:"packet_forwarder_version,omitempty"`
+	// Platform.
+	Platform string `protobuf:"bytes,4,opt,name=platform,proto3" json:"platform,omitempty"`
+	// Temperature is set.
+	// This is set when the gateway reports its temperature.
+	HasTemperature bool `protobuf:"varint,5,opt,name=has_temperature,json=hasTemperature,proto3" json:"has_temperature,omitempty"`
+	// Temperature (degrees Celsius).
+	Temperature float64 `protobuf:"fixed64,6,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	// All metadata key / value pairs as reported by the gateway.
+	Properties map[string]string `protobuf:"bytes,7,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Last update timestamp.
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GatewayMetadata) Reset()         { *m = GatewayMetadata{} }
+func (m *GatewayMetadata) String() string { return proto.CompactTextString(m) }
+func (*GatewayMetadata) ProtoMessage()    {}
+func (*GatewayMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a937782ebbded5, []int{5}
+}
+func (m *GatewayMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewayMetadata.Unmarshal(m, b)
+}
+func (m *GatewayMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewayMetadata.Marshal(b, m, deterministic)
+}
+func (dst *GatewayMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayMetadata.Merge(dst, src)
+}
+func (m *GatewayMetadata) XXX_Size() int {
+	return xxx_messageInfo_GatewayMetadata.Size(m)
+}
+func (m *GatewayMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayMetadata proto.InternalMessageInfo
+
+func (m *GatewayMetadata) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *GatewayMetadata) GetConfigVersion() string {
+	if m != nil {
+		return m.ConfigVersion
+	}
+	return ""
+}
+
+func (m *GatewayMetadata) GetPacketForwarderVersion() string {
+	if m != nil {
+		return m.PacketForwarderVersion
+	}
+	return ""
+}
+
+func (m *GatewayMetadata) GetPlatform() string {
+	if m != nil {
+		return m.Platform
+	}
+	return ""
+}
+
+func (m *GatewayMetadata) GetHasTemperature() bool {
+	if m != nil {
+		return m.HasTemperature
+	}
+	return false
+}
+
+func (m *GatewayMetadata) GetTemperature() float64 {
+	if m != nil {
+		return m.Temperature
+	}
+	return 0
+}
+
+func (m *GatewayMetadata) GetProperties() map[string]string {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+func (m *GatewayMetadata) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
 type DeleteGatewayRequest struct {
 	// Gateway ID (HEX encoded).
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -355,7 +469,7 @@ func (m *DeleteGatewayRequest) Reset()         { *m = DeleteGatewayRequest{} }
 func (m *DeleteGatewayRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteGatewayRequest) ProtoMessage()    {}
 func (*DeleteGatewayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1a937782ebbded5, []int{5}
+	return fileDescriptor_f1a937782ebbded5, []int{6}
 }
 func (m *DeleteGatewayRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteGatewayRequest.Unmarshal(m, b)
@@ -391,7 +505,10 @@ type ListGatewayRequest struct {
 	// response will return all gateways to which the user has access to.
 	OrganizationId int64 `protobuf:"varint,3,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
 	// Search on name or gateway MAC (optional).
-	Search               string   `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
+	Search string `protobuf:"bytes,4,opt,name=search,proto3" json:"search,omitempty"`
+	// Cursor of the result-set (for cursor-based pagination).
+	// When set, the offset is ignored.
+	Cursor               string   `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -401,7 +518,7 @@ func (m *ListGatewayRequest) Reset()         { *m = ListGatewayRequest{} }
 func (m *ListGatewayRequest) String() string { return proto.CompactTextString(m) }
 func (*ListGatewayRequest) ProtoMessage()    {}
 func (*ListGatewayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1a937782ebbded5, []int{6}
+	return fileDescriptor_f1a937782ebbded5, []int{7}
 }
 func (m *ListGatewayRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListGatewayRequest.Unmarshal(m, b)
@@ -449,6 +566,13 @@ func (m *ListGatewayRequest) GetSearch() string {
 	return ""
 }
 
+func (m *ListGatewayRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 type GatewayListItem struct {
 	// Gateway ID (HEX encoded).
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -473,7 +597,7 @@ func (m *GatewayListItem) Reset()         { *m = GatewayListItem{} }
 func (m *GatewayListItem) String() string { return proto.CompactTextString(m) }
 func (*GatewayListItem) ProtoMessage()    {}
 func (*GatewayListItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1a937782ebbded5, []int{7}
+	return fileDescriptor_f1a937782ebbded5, []int{8}
 }
 func (m *GatewayListItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatewayListItem.Unmarshal(m, b)
@@ -546,17 +670,20 @@ type ListGatewayResponse struct {
 	// Total number of nodes available within the result-set.
 	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	// Nodes within this result-set.
-	Result               []*GatewayListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Result []*GatewayListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	// Cursor for retrieving the next page. This is empty when there are no
+	// more gateways.
+	NextCursor           string   `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ListGatewayResponse) Reset()         { *m = ListGatewayResponse{} }
 func (m *ListGatewayResponse) String() string { return proto.CompactTextString(m) }
 func (*ListGatewayResponse) ProtoMessage()    {}
 func (*ListGatewayResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1a937782ebbded5, []int{8}
+	return fileDescriptor_f1a937782ebbded5, []int{9}
 }
 func (m *ListGatewayResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListGatewayResponse.Unmarshal(m, b)
@@ -590,19 +717,30 @@ func (m *ListGatewayResponse) GetResult() []*GatewayListItem {
 	return nil
 }
 
+func (m *ListGatewayResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
 type UpdateGatewayRequest struct {
 	// Gateway object to update.
-	Gateway              *Gateway `protobuf:"bytes,1,opt,name=gateway,proto3" json:"gateway,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Gateway *Gateway `protobuf:"bytes,1,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	// Fields to update (e.g. "name" or "location.altitude").
+	// When set, only the given fields are updated, all other fields are left
+	// unchanged. When not set, all fields are updated.
+	UpdateMask           *field_mask.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *UpdateGatewayRequest) Reset()         { *m = UpdateGatewayRequest{} }
 func (m *UpdateGatewayRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateGatewayRequest) ProtoMessage()    {}
 func (*UpdateGatewayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1a937782ebbded5, []int{9}
+	return fileDescriptor_f1a937782ebbded5, []int{10}
 }
 func (m *UpdateGatewayRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateGatewayRequest.Unmarshal(m, b)
@@ -629,6 +767,13 @@ func (m *UpdateGatewayRequest) GetGateway() *Gateway {
 	return nil
 }
 
+func (m *UpdateGatewayRequest) GetUpdateMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
 type GatewayStats struct {
 	// Timestamp of the (aggregated) measurement.
 	Timestamp *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
@@ -649,7 +794,7 @@ func (m *GatewayStats) Reset()         { *m = GatewayStats{} }
 func (m *GatewayStats) String() string { return proto.CompactTextString(m) }
 func (*GatewayStats) ProtoMessage()    {}
 func (*GatewayStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1a937782ebbded5, []int{10}
+	return fileDescriptor_f1a937782ebbded5, []int{11}
 }
 func (m *GatewayStats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatewayStats.Unmarshal(m, b)
@@ -713,17 +858,22 @@ type GetGatewayStatsRequest struct {
 	// Timestamp to start from.
 	StartTimestamp *timestamp.Timestamp `protobuf:"bytes,3,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
 	// Timestamp until to get from.
-	EndTimestamp         *timestamp.Timestamp `protobuf:"bytes,4,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	EndTimestamp *timestamp.Timestamp `protobuf:"bytes,4,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	// Timezone (IANA, e.g. "Europe/Amsterdam") used to aggregate the "day",
+	// "week", "month", "quarter" and "year" intervals (default: aggregated
+	// by the network-server). This requires the network-server to store
+	// the hourly aggregates for the requested time range.
+	Timezone             string   `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetGatewayStatsRequest) Reset()         { *m = GetGatewayStatsRequest{} }
 func (m *GetGatewayStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetGatewayStatsRequest) ProtoMessage()    {}
 func (*GetGatewayStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1a937782ebbded5, []int{11}
+	return fileDescriptor_f1a937782ebbded5, []int{12}
 }
 func (m *GetGatewayStatsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGatewayStatsRequest.Unmarshal(m, b)
@@ -771,6 +921,13 @@ func (m *GetGatewayStatsRequest) GetEndTimestamp() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *GetGatewayStatsRequest) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
 type GetGatewayStatsResponse struct {
 	Result               []*GatewayStats `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -782,7 +939,7 @@ func (m *GetGatewayStatsResponse) Reset()         { *m = GetGatewayStatsResponse
 func (m *GetGatewayStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetGatewayStatsResponse) ProtoMessage()    {}
 func (*GetGatewayStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1a937782ebbded5, []int{12}
+	return fileDescriptor_f1a937782ebbded5, []int{13}
 }
 func (m *GetGatewayStatsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGatewayStatsResponse.Unmarshal(m, b)
@@ -809,6 +966,489 @@ func (m *GetGatewayStatsResponse) GetResult() []*GatewayStats {
 	return nil
 }
 
+type ListGatewayDevicesRequest struct {
+	// Gateway ID (HEX encoded).
+	GatewayId string `protobuf:"bytes,1,opt,name=gateway_id,json=gatewayID,proto3" json:"gateway_id,omitempty"`
+	// Max number of devices to return in the result-set.
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Only return devices received since the given timestamp
+	// (default: 7 days ago).
+	Since                *timestamp.Timestamp `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListGatewayDevicesRequest) Reset()         { *m = ListGatewayDevicesRequest{} }
+func (m *ListGatewayDevicesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListGatewayDevicesRequest) ProtoMessage()    {}
+func (*ListGatewayDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a937782ebbded5, []int{14}
+}
+func (m *ListGatewayDevicesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGatewayDevicesRequest.Unmarshal(m, b)
+}
+func (m *ListGatewayDevicesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGatewayDevicesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListGatewayDevicesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGatewayDevicesRequest.Merge(dst, src)
+}
+func (m *ListGatewayDevicesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListGatewayDevicesRequest.Size(m)
+}
+func (m *ListGatewayDevicesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGatewayDevicesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGatewayDevicesRequest proto.InternalMessageInfo
+
+func (m *ListGatewayDevicesRequest) GetGatewayId() string {
+	if m != nil {
+		return m.GatewayId
+	}
+	return ""
+}
+
+func (m *ListGatewayDevicesRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListGatewayDevicesRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListGatewayDevicesRequest) GetSince() *timestamp.Timestamp {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+type GatewayDeviceListItem struct {
+	// Device EUI (HEX encoded).
+	DevEui string `protobuf:"bytes,1,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Name of the device.
+	DeviceName string `protobuf:"bytes,2,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	// Application ID.
+	ApplicationId int64 `protobuf:"varint,3,opt,name=application_id,json=applicationID,proto3" json:"application_id,omitempty"`
+	// Name of the application.
+	ApplicationName string `protobuf:"bytes,4,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	// Organization ID.
+	OrganizationId int64 `protobuf:"varint,5,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Last time the device was received by the gateway.
+	LastSeenAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	// RSSI of the last reception.
+	Rssi int32 `protobuf:"varint,7,opt,name=rssi,proto3" json:"rssi,omitempty"`
+	// LoRa SNR of the last reception.
+	LoraSnr              float64  `protobuf:"fixed64,8,opt,name=lora_snr,json=loraSnr,proto3" json:"lora_snr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayDeviceListItem) Reset()         { *m = GatewayDeviceListItem{} }
+func (m *GatewayDeviceListItem) String() string { return proto.CompactTextString(m) }
+func (*GatewayDeviceListItem) ProtoMessage()    {}
+func (*GatewayDeviceListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a937782ebbded5, []int{15}
+}
+func (m *GatewayDeviceListItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewayDeviceListItem.Unmarshal(m, b)
+}
+func (m *GatewayDeviceListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewayDeviceListItem.Marshal(b, m, deterministic)
+}
+func (dst *GatewayDeviceListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayDeviceListItem.Merge(dst, src)
+}
+func (m *GatewayDeviceListItem) XXX_Size() int {
+	return xxx_messageInfo_GatewayDeviceListItem.Size(m)
+}
+func (m *GatewayDeviceListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayDeviceListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayDeviceListItem proto.InternalMessageInfo
+
+func (m *GatewayDeviceListItem) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
+	}
+	return ""
+}
+
+func (m *GatewayDeviceListItem) GetDeviceName() string {
+	if m != nil {
+		return m.DeviceName
+	}
+	return ""
+}
+
+func (m *GatewayDeviceListItem) GetApplicationId() int64 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *GatewayDeviceListItem) GetApplicationName() string {
+	if m != nil {
+		return m.ApplicationName
+	}
+	return ""
+}
+
+func (m *GatewayDeviceListItem) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *GatewayDeviceListItem) GetLastSeenAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastSeenAt
+	}
+	return nil
+}
+
+func (m *GatewayDeviceListItem) GetRssi() int32 {
+	if m != nil {
+		return m.Rssi
+	}
+	return 0
+}
+
+func (m *GatewayDeviceListItem) GetLoraSnr() float64 {
+	if m != nil {
+		return m.LoraSnr
+	}
+	return 0
+}
+
+type ListGatewayDevicesResponse struct {
+	// Total number of devices (visible to the user).
+	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Devices within the result-set.
+	Result []*GatewayDeviceListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	// Number of devices received by the gateway which are not visible to
+	// the user (devices of other organizations).
+	HiddenCount          int64    `protobuf:"varint,3,opt,name=hidden_count,json=hiddenCount,proto3" json:"hidden_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListGatewayDevicesResponse) Reset()         { *m = ListGatewayDevicesResponse{} }
+func (m *ListGatewayDevicesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListGatewayDevicesResponse) ProtoMessage()    {}
+func (*ListGatewayDevicesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a937782ebbded5, []int{16}
+}
+func (m *ListGatewayDevicesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGatewayDevicesResponse.Unmarshal(m, b)
+}
+func (m *ListGatewayDevicesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGatewayDevicesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListGatewayDevicesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGatewayDevicesResponse.Merge(dst, src)
+}
+func (m *ListGatewayDevicesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListGatewayDevicesResponse.Size(m)
+}
+func (m *ListGatewayDevicesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGatewayDevicesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGatewayDevicesResponse proto.InternalMessageInfo
+
+func (m *ListGatewayDevicesResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListGatewayDevicesResponse) GetResult() []*GatewayDeviceListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *ListGatewayDevicesResponse) GetHiddenCount() int64 {
+	if m != nil {
+		return m.HiddenCount
+	}
+	return 0
+}
+
+type GetGatewaySignalHeatmapRequest struct {
+	// Gateway ID (HEX encoded).
+	GatewayId string `protobuf:"bytes,1,opt,name=gateway_id,json=gatewayID,proto3" json:"gateway_id,omitempty"`
+	// Timestamp to start from (default: 7 days before the end timestamp).
+	// The statistics are aggregated per day.
+	StartTimestamp *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	// Timestamp until to get from (default: now).
+	EndTimestamp *timestamp.Timestamp `protobuf:"bytes,3,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	// Geohash precision (length) of the returned cells (1 - 12).
+	// When not set, the cells are returned with the stored precision.
+	Precision            uint32   `protobuf:"varint,4,opt,name=precision,proto3" json:"precision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetGatewaySignalHeatmapRequest) Reset()         { *m = GetGatewaySignalHeatmapRequest{} }
+func (m *GetGatewaySignalHeatmapRequest) String() string { return proto.CompactTextString(m) }
+func (*GetGatewaySignalHeatmapRequest) ProtoMessage()    {}
+func (*GetGatewaySignalHeatmapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a937782ebbded5, []int{17}
+}
+func (m *GetGatewaySignalHeatmapRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGatewaySignalHeatmapRequest.Unmarshal(m, b)
+}
+func (m *GetGatewaySignalHeatmapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGatewaySignalHeatmapRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetGatewaySignalHeatmapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGatewaySignalHeatmapRequest.Merge(dst, src)
+}
+func (m *GetGatewaySignalHeatmapRequest) XXX_Size() int {
+	return xxx_messageInfo_GetGatewaySignalHeatmapRequest.Size(m)
+}
+func (m *GetGatewaySignalHeatmapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGatewaySignalHeatmapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGatewaySignalHeatmapRequest proto.InternalMessageInfo
+
+func (m *GetGatewaySignalHeatmapRequest) GetGatewayId() string {
+	if m != nil {
+		return m.GatewayId
+	}
+	return ""
+}
+
+func (m *GetGatewaySignalHeatmapRequest) GetStartTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTimestamp
+	}
+	return nil
+}
+
+func (m *GetGatewaySignalHeatmapRequest) GetEndTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTimestamp
+	}
+	return nil
+}
+
+func (m *GetGatewaySignalHeatmapRequest) GetPrecision() uint32 {
+	if m != nil {
+		return m.Precision
+	}
+	return 0
+}
+
+type GatewaySignalCell struct {
+	// Geohash of the cell.
+	Geohash string `protobuf:"bytes,1,opt,name=geohash,proto3" json:"geohash,omitempty"`
+	// Latitude of the cell center.
+	Latitude float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude of the cell center.
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Distance (meters) between the gateway and the cell center.
+	// This is only set when the gateway location is known.
+	Distance float64 `protobuf:"fixed64,4,opt,name=distance,proto3" json:"distance,omitempty"`
+	// Bearing (degrees, clockwise from north) from the gateway to the cell
+	// center. This is only set when the gateway location is known.
+	Bearing float64 `protobuf:"fixed64,5,opt,name=bearing,proto3" json:"bearing,omitempty"`
+	// Number of received uplinks.
+	UplinkCount int64 `protobuf:"varint,6,opt,name=uplink_count,json=uplinkCount,proto3" json:"uplink_count,omitempty"`
+	// Average RSSI.
+	RssiAvg float64 `protobuf:"fixed64,7,opt,name=rssi_avg,json=rssiAvg,proto3" json:"rssi_avg,omitempty"`
+	// Min. RSSI.
+	RssiMin int32 `protobuf:"varint,8,opt,name=rssi_min,json=rssiMin,proto3" json:"rssi_min,omitempty"`
+	// Max. RSSI.
+	RssiMax int32 `protobuf:"varint,9,opt,name=rssi_max,json=rssiMax,proto3" json:"rssi_max,omitempty"`
+	// Average LoRa SNR.
+	SnrAvg float64 `protobuf:"fixed64,10,opt,name=snr_avg,json=snrAvg,proto3" json:"snr_avg,omitempty"`
+	// Min. LoRa SNR.
+	SnrMin float64 `protobuf:"fixed64,11,opt,name=snr_min,json=snrMin,proto3" json:"snr_min,omitempty"`
+	// Max. LoRa SNR.
+	SnrMax               float64  `protobuf:"fixed64,12,opt,name=snr_max,json=snrMax,proto3" json:"snr_max,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewaySignalCell) Reset()         { *m = GatewaySignalCell{} }
+func (m *GatewaySignalCell) String() string { return proto.CompactTextString(m) }
+func (*GatewaySignalCell) ProtoMessage()    {}
+func (*GatewaySignalCell) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a937782ebbded5, []int{18}
+}
+func (m *GatewaySignalCell) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewaySignalCell.Unmarshal(m, b)
+}
+func (m *GatewaySignalCell) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewaySignalCell.Marshal(b, m, deterministic)
+}
+func (dst *GatewaySignalCell) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewaySignalCell.Merge(dst, src)
+}
+func (m *GatewaySignalCell) XXX_Size() int {
+	return xxx_messageInfo_GatewaySignalCell.Size(m)
+}
+func (m *GatewaySignalCell) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewaySignalCell.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewaySignalCell proto.InternalMessageInfo
+
+func (m *GatewaySignalCell) GetGeohash() string {
+	if m != nil {
+		return m.Geohash
+	}
+	return ""
+}
+
+func (m *GatewaySignalCell) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *GatewaySignalCell) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *GatewaySignalCell) GetDistance() float64 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *GatewaySignalCell) GetBearing() float64 {
+	if m != nil {
+		return m.Bearing
+	}
+	return 0
+}
+
+func (m *GatewaySignalCell) GetUplinkCount() int64 {
+	if m != nil {
+		return m.UplinkCount
+	}
+	return 0
+}
+
+func (m *GatewaySignalCell) GetRssiAvg() float64 {
+	if m != nil {
+		return m.RssiAvg
+	}
+	return 0
+}
+
+func (m *GatewaySignalCell) GetRssiMin() int32 {
+	if m != nil {
+		return m.RssiMin
+	}
+	return 0
+}
+
+func (m *GatewaySignalCell) GetRssiMax() int32 {
+	if m != nil {
+		return m.RssiMax
+	}
+	return 0
+}
+
+func (m *GatewaySignalCell) GetSnrAvg() float64 {
+	if m != nil {
+		return m.SnrAvg
+	}
+	return 0
+}
+
+func (m *GatewaySignalCell) GetSnrMin() float64 {
+	if m != nil {
+		return m.SnrMin
+	}
+	return 0
+}
+
+func (m *GatewaySignalCell) GetSnrMax() float64 {
+	if m != nil {
+		return m.SnrMax
+	}
+	return 0
+}
+
+type GetGatewaySignalHeatmapResponse struct {
+	// Location of the gateway (when known).
+	Location *common.Location `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// Heatmap cells.
+	Cells                []*GatewaySignalCell `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetGatewaySignalHeatmapResponse) Reset()         { *m = GetGatewaySignalHeatmapResponse{} }
+func (m *GetGatewaySignalHeatmapResponse) String() string { return proto.CompactTextString(m) }
+func (*GetGatewaySignalHeatmapResponse) ProtoMessage()    {}
+func (*GetGatewaySignalHeatmapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a937782ebbded5, []int{19}
+}
+func (m *GetGatewaySignalHeatmapResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGatewaySignalHeatmapResponse.Unmarshal(m, b)
+}
+func (m *GetGatewaySignalHeatmapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGatewaySignalHeatmapResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetGatewaySignalHeatmapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGatewaySignalHeatmapResponse.Merge(dst, src)
+}
+func (m *GetGatewaySignalHeatmapResponse) XXX_Size() int {
+	return xxx_messageInfo_GetGatewaySignalHeatmapResponse.Size(m)
+}
+func (m *GetGatewaySignalHeatmapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGatewaySignalHeatmapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGatewaySignalHeatmapResponse proto.InternalMessageInfo
+
+func (m *GetGatewaySignalHeatmapResponse) GetLocation() *common.Location {
+	if m != nil {
+		return m.Location
+	}
+	return nil
+}
+
+func (m *GetGatewaySignalHeatmapResponse) GetCells() []*GatewaySignalCell {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
 type PingRX struct {
 	// Gateway ID (HEX encoded).
 	GatewayId string `protobuf:"bytes,1,opt,name=gateway_id,json=gatewayID,proto3" json:"gateway_id,omitempty"`
@@ -831,7 +1471,7 @@ func (m *PingRX) Reset()         { *m = PingRX{} }
 func (m *PingRX) String() string { return proto.CompactTextString(m) }
 func (*PingRX) ProtoMessage()    {}
 func (*PingRX) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1a937782ebbded5, []int{13}
+	return fileDescriptor_f1a937782ebbded5, []int{20}
 }
 func (m *PingRX) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingRX.Unmarshal(m, b)
@@ -905,7 +1545,7 @@ func (m *GetLastPingRequest) Reset()         { *m = GetLastPingRequest{} }
 func (m *GetLastPingRequest) String() string { return proto.CompactTextString(m) }
 func (*GetLastPingRequest) ProtoMessage()    {}
 func (*GetLastPingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1a937782ebbded5, []int{14}
+	return fileDescriptor_f1a937782ebbded5, []int{21}
 }
 func (m *GetLastPingRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetLastPingRequest.Unmarshal(m, b)
@@ -950,7 +1590,7 @@ func (m *GetLastPingResponse) Reset()         { *m = GetLastPingResponse{} }
 func (m *GetLastPingResponse) String() string { return proto.CompactTextString(m) }
 func (*GetLastPingResponse) ProtoMessage()    {}
 func (*GetLastPingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1a937782ebbded5, []int{15}
+	return fileDescriptor_f1a937782ebbded5, []int{22}
 }
 func (m *GetLastPingResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetLastPingResponse.Unmarshal(m, b)
@@ -1010,7 +1650,7 @@ func (m *StreamGatewayFrameLogsRequest) Reset()         { *m = StreamGatewayFram
 func (m *StreamGatewayFrameLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*StreamGatewayFrameLogsRequest) ProtoMessage()    {}
 func (*StreamGatewayFrameLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1a937782ebbded5, []int{16}
+	return fileDescriptor_f1a937782ebbded5, []int{23}
 }
 func (m *StreamGatewayFrameLogsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamGatewayFrameLogsRequest.Unmarshal(m, b)
@@ -1051,7 +1691,7 @@ func (m *StreamGatewayFrameLogsResponse) Reset()         { *m = StreamGatewayFra
 func (m *StreamGatewayFrameLogsResponse) String() string { return proto.CompactTextString(m) }
 func (*StreamGatewayFrameLogsResponse) ProtoMessage()    {}
 func (*StreamGatewayFrameLogsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1a937782ebbded5, []int{17}
+	return fileDescriptor_f1a937782ebbded5, []int{24}
 }
 func (m *StreamGatewayFrameLogsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamGatewayFrameLogsResponse.Unmarshal(m, b)
@@ -1188,6 +1828,8 @@ func init() {
 	proto.RegisterType((*CreateGatewayRequest)(nil), "api.CreateGatewayRequest")
 	proto.RegisterType((*GetGatewayRequest)(nil), "api.GetGatewayRequest")
 	proto.RegisterType((*GetGatewayResponse)(nil), "api.GetGatewayResponse")
+	proto.RegisterType((*GatewayMetadata)(nil), "api.GatewayMetadata")
+	proto.RegisterMapType((map[string]string)(nil), "api.GatewayMetadata.PropertiesEntry")
 	proto.RegisterType((*DeleteGatewayRequest)(nil), "api.DeleteGatewayRequest")
 	proto.RegisterType((*ListGatewayRequest)(nil), "api.ListGatewayRequest")
 	proto.RegisterType((*GatewayListItem)(nil), "api.GatewayListItem")
@@ -1196,6 +1838,12 @@ func init() {
 	proto.RegisterType((*GatewayStats)(nil), "api.GatewayStats")
 	proto.RegisterType((*GetGatewayStatsRequest)(nil), "api.GetGatewayStatsRequest")
 	proto.RegisterType((*GetGatewayStatsResponse)(nil), "api.GetGatewayStatsResponse")
+	proto.RegisterType((*ListGatewayDevicesRequest)(nil), "api.ListGatewayDevicesRequest")
+	proto.RegisterType((*GatewayDeviceListItem)(nil), "api.GatewayDeviceListItem")
+	proto.RegisterType((*ListGatewayDevicesResponse)(nil), "api.ListGatewayDevicesResponse")
+	proto.RegisterType((*GetGatewaySignalHeatmapRequest)(nil), "api.GetGatewaySignalHeatmapRequest")
+	proto.RegisterType((*GatewaySignalCell)(nil), "api.GatewaySignalCell")
+	proto.RegisterType((*GetGatewaySignalHeatmapResponse)(nil), "api.GetGatewaySignalHeatmapResponse")
 	proto.RegisterType((*PingRX)(nil), "api.PingRX")
 	proto.RegisterType((*GetLastPingRequest)(nil), "api.GetLastPingRequest")
 	proto.RegisterType((*GetLastPingResponse)(nil), "api.GetLastPingResponse")
@@ -1229,6 +1877,12 @@ type GatewayServiceClient interface {
 	GetStats(ctx context.Context, in *GetGatewayStatsRequest, opts ...grpc.CallOption) (*GetGatewayStatsResponse, error)
 	// GetLastPing returns the last emitted ping and gateways receiving this ping.
 	GetLastPing(ctx context.Context, in *GetLastPingRequest, opts ...grpc.CallOption) (*GetLastPingResponse, error)
+	// GetSignalHeatmap returns the received-signal statistics (RSSI and SNR)
+	// of the gateway, aggregated per geohash cell of the device locations.
+	GetSignalHeatmap(ctx context.Context, in *GetGatewaySignalHeatmapRequest, opts ...grpc.CallOption) (*GetGatewaySignalHeatmapResponse, error)
+	// ListDevices lists the devices which were recently received by the
+	// gateway, newest first.
+	ListDevices(ctx context.Context, in *ListGatewayDevicesRequest, opts ...grpc.CallOption) (*ListGatewayDevicesResponse, error)
 	// StreamFrameLogs streams the uplink and downlink frame-logs for the given gateway ID.
 	// Notes:
 	//   * These are the raw LoRaWAN frames and this endpoint is intended for debugging only.
@@ -1307,6 +1961,24 @@ func (c *gatewayServiceClient) GetLastPing(ctx context.Context, in *GetLastPingR
 	return out, nil
 }
 
+func (c *gatewayServiceClient) GetSignalHeatmap(ctx context.Context, in *GetGatewaySignalHeatmapRequest, opts ...grpc.CallOption) (*GetGatewaySignalHeatmapResponse, error) {
+	out := new(GetGatewaySignalHeatmapResponse)
+	err := c.cc.Invoke(ctx, "/api.GatewayService/GetSignalHeatmap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) ListDevices(ctx context.Context, in *ListGatewayDevicesRequest, opts ...grpc.CallOption) (*ListGatewayDevicesResponse, error) {
+	out := new(ListGatewayDevicesResponse)
+	err := c.cc.Invoke(ctx, "/api.GatewayService/ListDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gatewayServiceClient) StreamFrameLogs(ctx context.Context, in *StreamGatewayFrameLogsRequest, opts ...grpc.CallOption) (GatewayService_StreamFrameLogsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_GatewayService_serviceDesc.Streams[0], "/api.GatewayService/StreamFrameLogs", opts...)
 	if err != nil {
@@ -1355,6 +2027,12 @@ type GatewayServiceServer interface {
 	GetStats(context.Context, *GetGatewayStatsRequest) (*GetGatewayStatsResponse, error)
 	// GetLastPing returns the last emitted ping and gateways receiving this ping.
 	GetLastPing(context.Context, *GetLastPingRequest) (*GetLastPingResponse, error)
+	// GetSignalHeatmap returns the received-signal statistics (RSSI and SNR)
+	// of the gateway, aggregated per geohash cell of the device locations.
+	GetSignalHeatmap(context.Context, *GetGatewaySignalHeatmapRequest) (*GetGatewaySignalHeatmapResponse, error)
+	// ListDevices lists the devices which were recently received by the
+	// gateway, newest first.
+	ListDevices(context.Context, *ListGatewayDevicesRequest) (*ListGatewayDevicesResponse, error)
 	// StreamFrameLogs streams the uplink and downlink frame-logs for the given gateway ID.
 	// Notes:
 	//   * These are the raw LoRaWAN frames and this endpoint is intended for debugging only.
@@ -1492,6 +2170,42 @@ func _GatewayService_GetLastPing_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GatewayService_GetSignalHeatmap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewaySignalHeatmapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).GetSignalHeatmap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GatewayService/GetSignalHeatmap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).GetSignalHeatmap(ctx, req.(*GetGatewaySignalHeatmapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_ListDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGatewayDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).ListDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.GatewayService/ListDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).ListDevices(ctx, req.(*ListGatewayDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GatewayService_StreamFrameLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(StreamGatewayFrameLogsRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1545,6 +2259,14 @@ var _GatewayService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetLastPing",
 			Handler:    _GatewayService_GetLastPing_Handler,
 		},
+		{
+			MethodName: "GetSignalHeatmap",
+			Handler:    _GatewayService_GetSignalHeatmap_Handler,
+		},
+		{
+			MethodName: "ListDevices",
+			Handler:    _GatewayService_ListDevices_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1559,89 +2281,133 @@ var _GatewayService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("gateway.proto", fileDescriptor_f1a937782ebbded5) }
 
 var fileDescriptor_f1a937782ebbded5 = []byte{
-	// 1341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4b, 0x73, 0x1b, 0xc5,
-	0x16, 0xbe, 0xa3, 0xc7, 0xc8, 0x3a, 0xb2, 0xfc, 0x68, 0x3b, 0x8e, 0xa2, 0x38, 0x37, 0xba, 0x93,
-	0x9b, 0xc4, 0xc9, 0xf5, 0x95, 0x28, 0xa7, 0xa8, 0x0a, 0x14, 0x65, 0x2a, 0x89, 0x82, 0x71, 0xc5,
-	0x05, 0xae, 0x31, 0x2e, 0xd8, 0x4d, 0xb5, 0x34, 0x2d, 0xa5, 0xcb, 0xa3, 0xe9, 0xa1, 0xbb, 0xe5,
-	0xc4, 0x50, 0xd9, 0xc0, 0x82, 0x05, 0x4b, 0xfe, 0x01, 0xb0, 0x64, 0xc1, 0x8a, 0x3f, 0xc2, 0x06,
-	0xf6, 0xfc, 0x10, 0xaa, 0x1f, 0x1a, 0x8f, 0xa5, 0x89, 0xed, 0xa4, 0x58, 0x69, 0xfa, 0xbc, 0xcf,
-	0x77, 0x1e, 0xdd, 0x82, 0xfa, 0x10, 0x4b, 0xf2, 0x02, 0x9f, 0xb4, 0x13, 0xce, 0x24, 0x43, 0x45,
-	0x9c, 0xd0, 0xe6, 0xfa, 0x90, 0xb1, 0x61, 0x44, 0x3a, 0x38, 0xa1, 0x1d, 0x1c, 0xc7, 0x4c, 0x62,
-	0x49, 0x59, 0x2c, 0x8c, 0x48, 0xf3, 0xa6, 0xe5, 0xea, 0x53, 0x6f, 0x3c, 0xe8, 0x48, 0x3a, 0x22,
-	0x42, 0xe2, 0x51, 0x62, 0x05, 0xae, 0x4f, 0x0b, 0x90, 0x51, 0x22, 0xad, 0x83, 0xe6, 0xbb, 0x43,
-	0x2a, 0x9f, 0x8f, 0x7b, 0xed, 0x3e, 0x1b, 0x75, 0x7a, 0x9c, 0xf5, 0x31, 0xe6, 0x9d, 0x88, 0x71,
-	0x2c, 0x08, 0x3f, 0x26, 0x5c, 0xbb, 0xec, 0xb3, 0xd1, 0x88, 0xc5, 0xf6, 0xc7, 0xaa, 0xcd, 0x67,
-	0x4f, 0xde, 0x1f, 0x05, 0xa8, 0xec, 0x98, 0xb8, 0xd1, 0x02, 0x14, 0x68, 0xd8, 0x70, 0x5a, 0xce,
-	0x46, 0xd5, 0x2f, 0xd0, 0x10, 0x21, 0x28, 0xc5, 0x78, 0x44, 0x1a, 0x05, 0x4d, 0xd1, 0xdf, 0xa8,
-	0x05, 0xb5, 0x90, 0x88, 0x3e, 0xa7, 0x89, 0x4a, 0xa4, 0x51, 0xd4, 0xac, 0x2c, 0x09, 0x6d, 0xc2,
-	0x5c, 0xc4, 0xfa, 0x3a, 0xcf, 0x46, 0xa9, 0xe5, 0x6c, 0xd4, 0xb6, 0x96, 0xda, 0xd6, 0xe5, 0x9e,
-	0xa5, 0xfb, 0xa9, 0x04, 0xba, 0x0b, 0x8b, 0x8c, 0x0f, 0x71, 0x4c, 0xbf, 0xd2, 0xe7, 0x80, 0x86,
-	0x8d, 0x72, 0xcb, 0xd9, 0x28, 0xfa, 0x0b, 0x59, 0xf2, 0x6e, 0x17, 0xfd, 0x0f, 0x96, 0x43, 0x2a,
-	0xfa, 0xec, 0x98, 0xf0, 0x93, 0x80, 0xc4, 0xb8, 0x17, 0x91, 0xb0, 0xe1, 0xb6, 0x9c, 0x8d, 0x39,
-	0x7f, 0x29, 0x65, 0x3c, 0x35, 0x74, 0x74, 0x1f, 0x96, 0x63, 0x22, 0x5f, 0x30, 0x7e, 0x14, 0x18,
-	0x34, 0x94, 0xdd, 0x8a, 0xb6, 0xbb, 0x68, 0x19, 0x07, 0x9a, 0xbe, 0xdb, 0x45, 0x9b, 0x80, 0x6c,
-	0xe1, 0x82, 0x84, 0xb3, 0x01, 0x8d, 0x88, 0x12, 0x9e, 0xd3, 0x89, 0x2d, 0x59, 0xce, 0xbe, 0x61,
-	0xec, 0x76, 0xd1, 0x3d, 0x70, 0x7b, 0x0c, 0xf3, 0x50, 0x34, 0xaa, 0xad, 0xe2, 0x46, 0x6d, 0x6b,
-	0xb9, 0x8d, 0x13, 0xda, 0xb6, 0x08, 0x3e, 0x56, 0x1c, 0xdf, 0x0a, 0x78, 0x87, 0x30, 0x9f, 0xa5,
-	0xa3, 0xab, 0x50, 0x19, 0x24, 0x43, 0x1c, 0xa4, 0x18, 0xbb, 0xea, 0x68, 0x22, 0x18, 0xd0, 0x98,
-	0x04, 0x69, 0xf5, 0x83, 0x23, 0x72, 0x62, 0x51, 0x5f, 0x52, 0x9c, 0xcf, 0x26, 0x8c, 0x67, 0xe4,
-	0xc4, 0xdb, 0x86, 0xd5, 0x27, 0x9c, 0x60, 0x49, 0xac, 0x71, 0x9f, 0x7c, 0x39, 0x26, 0x42, 0xa2,
-	0x3b, 0x50, 0xb1, 0xd1, 0x6a, 0xf3, 0xb5, 0xad, 0xf9, 0x6c, 0x68, 0xfe, 0x84, 0xe9, 0xdd, 0x82,
-	0xe5, 0x1d, 0x22, 0xa7, 0x94, 0xa7, 0x4a, 0xef, 0xfd, 0x5a, 0x00, 0x94, 0x95, 0x12, 0x09, 0x8b,
-	0x05, 0xb9, 0xac, 0x0f, 0xf4, 0x1e, 0x40, 0x5f, 0xc7, 0x18, 0x06, 0x58, 0xea, 0x4c, 0x6a, 0x5b,
-	0xcd, 0xb6, 0x69, 0xe6, 0xf6, 0xa4, 0x99, 0xdb, 0x69, 0x5a, 0x7e, 0xd5, 0x4a, 0x3f, 0x92, 0x4a,
-	0x75, 0x9c, 0x84, 0x13, 0xd5, 0xe2, 0xc5, 0xaa, 0x56, 0xfa, 0x91, 0x44, 0xdb, 0x50, 0x1f, 0x50,
-	0x2e, 0x64, 0x20, 0x08, 0x89, 0x95, 0x76, 0xe9, 0x42, 0xed, 0x9a, 0x56, 0x38, 0x20, 0x24, 0x7e,
-	0x24, 0xd1, 0x07, 0x30, 0x1f, 0xe1, 0x8c, 0x7a, 0xf9, 0x42, 0x75, 0x50, 0xf2, 0x46, 0xdb, 0xbb,
-	0x03, 0xab, 0x5d, 0x12, 0x91, 0x99, 0xba, 0x4c, 0x43, 0xfb, 0xad, 0x03, 0x68, 0x8f, 0x8a, 0xe9,
-	0x0a, 0xac, 0x42, 0x39, 0xa2, 0x23, 0x2a, 0xb5, 0x64, 0xd9, 0x37, 0x07, 0xb4, 0x06, 0x2e, 0x1b,
-	0x0c, 0x04, 0x31, 0x20, 0x96, 0x7d, 0x7b, 0xca, 0x1b, 0x9b, 0x62, 0xee, 0xd8, 0xac, 0x81, 0x2b,
-	0x08, 0xe6, 0xfd, 0xe7, 0x1a, 0x8c, 0xaa, 0x6f, 0x4f, 0xde, 0x8f, 0x05, 0x58, 0xb4, 0x11, 0xa8,
-	0x60, 0x76, 0x25, 0x19, 0xfd, 0x43, 0xf3, 0x7f, 0xb6, 0xf6, 0xa5, 0xb7, 0xaf, 0x7d, 0xf9, 0x4d,
-	0x6a, 0x9f, 0x03, 0x88, 0x9b, 0x0b, 0xc8, 0x1b, 0xac, 0x06, 0x2f, 0x84, 0x95, 0x33, 0x95, 0xb2,
-	0x53, 0x70, 0x13, 0x6a, 0x92, 0x49, 0x1c, 0x05, 0x7d, 0x36, 0x8e, 0x4d, 0xc1, 0x8a, 0x3e, 0x68,
-	0xd2, 0x13, 0x45, 0x41, 0x9b, 0xe0, 0x72, 0x22, 0xc6, 0x91, 0xaa, 0x9a, 0x5a, 0x12, 0xab, 0xd9,
-	0x29, 0x99, 0xc0, 0xed, 0x5b, 0x19, 0x35, 0xd0, 0x87, 0x3a, 0x8f, 0xb7, 0x1c, 0xe8, 0xef, 0x0b,
-	0xe9, 0xa2, 0x39, 0x90, 0x58, 0x0a, 0xf4, 0x10, 0xaa, 0xe9, 0x2a, 0xb1, 0xaa, 0xe7, 0xa2, 0x98,
-	0x0a, 0xa3, 0x36, 0xac, 0xf0, 0x97, 0x41, 0x82, 0xfb, 0x47, 0x44, 0x8a, 0x80, 0x93, 0x3e, 0xa1,
-	0xc7, 0x24, 0xb4, 0xbd, 0xb7, 0xcc, 0x5f, 0xee, 0x1b, 0x8e, 0x6f, 0x19, 0xe8, 0x01, 0xac, 0xe5,
-	0xc8, 0x07, 0xec, 0x48, 0x37, 0x46, 0xd9, 0x5f, 0x99, 0x51, 0xf9, 0xf4, 0x99, 0x72, 0x22, 0x73,
-	0x9c, 0x94, 0x8c, 0x13, 0x39, 0xe3, 0x64, 0x13, 0x50, 0x46, 0x9e, 0x8c, 0xa8, 0x94, 0xc4, 0xdc,
-	0x12, 0x65, 0x7f, 0x29, 0x15, 0x7f, 0x6a, 0xe8, 0xde, 0x9f, 0x0e, 0xac, 0x9d, 0x6e, 0x2e, 0x0d,
-	0xc8, 0x04, 0xd0, 0x1b, 0x00, 0x93, 0x4d, 0x9f, 0xf6, 0x79, 0xd5, 0x52, 0x76, 0xbb, 0xa8, 0x09,
-	0x73, 0x34, 0x96, 0x84, 0x1f, 0xe3, 0xc8, 0xb6, 0x7c, 0x7a, 0x46, 0x4f, 0x60, 0x51, 0x48, 0xcc,
-	0xe5, 0xe9, 0x8e, 0xbe, 0xc4, 0x6a, 0x5a, 0xd0, 0x2a, 0xe9, 0x19, 0x7d, 0x08, 0x75, 0x12, 0x87,
-	0x19, 0x13, 0x17, 0x0f, 0xc7, 0x3c, 0x89, 0xc3, 0xf4, 0xe4, 0x75, 0xe1, 0xea, 0x4c, 0x6a, 0xb6,
-	0x27, 0xef, 0xa5, 0x2d, 0xe7, 0xcc, 0xde, 0x4b, 0x46, 0x74, 0xd2, 0x6f, 0xbf, 0x38, 0xe0, 0xee,
-	0xd3, 0x78, 0xe8, 0x7f, 0x71, 0x11, 0x22, 0x08, 0x4a, 0x5c, 0x08, 0x6a, 0xeb, 0xaf, 0xbf, 0xd1,
-	0x35, 0x75, 0xbd, 0x73, 0x1c, 0x88, 0x98, 0x6b, 0x08, 0x1c, 0xbf, 0x12, 0x31, 0x1f, 0x1f, 0x7c,
-	0xe2, 0x2b, 0x00, 0x23, 0x2c, 0xa9, 0x1c, 0x87, 0x44, 0xa7, 0xe6, 0xf8, 0xe9, 0x19, 0xad, 0x43,
-	0x35, 0x62, 0xf1, 0xd0, 0x30, 0xcb, 0x9a, 0x79, 0x4a, 0x50, 0x9a, 0x38, 0xb2, 0x9a, 0xae, 0xd1,
-	0x9c, 0x9c, 0xbd, 0x07, 0xfa, 0x26, 0xda, 0xc3, 0x42, 0xea, 0xa0, 0x2f, 0x55, 0x4b, 0xef, 0x67,
-	0x07, 0x56, 0xce, 0x68, 0x59, 0x98, 0xce, 0x2e, 0x27, 0xe7, 0x4d, 0x96, 0xd3, 0x3a, 0x54, 0x07,
-	0x5c, 0x79, 0x8f, 0xfb, 0xe6, 0x72, 0xae, 0xfb, 0xa7, 0x04, 0xb5, 0x3b, 0x43, 0x03, 0x48, 0xdd,
-	0x2f, 0x84, 0x1c, 0xfd, 0x17, 0x2a, 0x09, 0x8d, 0x87, 0x01, 0x7f, 0xd9, 0x28, 0xe9, 0x82, 0xd4,
-	0x74, 0x41, 0x0c, 0xee, 0xbe, 0x9b, 0xe8, 0x5f, 0x6f, 0x1b, 0x6e, 0x1c, 0x48, 0x4e, 0xf0, 0xc8,
-	0x16, 0xea, 0x23, 0x8e, 0x47, 0x64, 0x8f, 0x0d, 0x2f, 0xd9, 0xb2, 0xde, 0x4f, 0x0e, 0xfc, 0xfb,
-	0x75, 0x06, 0x6c, 0xc6, 0x0f, 0x61, 0x7e, 0x9c, 0x44, 0x34, 0x3e, 0x0a, 0x06, 0x8a, 0x67, 0x73,
-	0x5e, 0xd1, 0xd1, 0x1c, 0x6a, 0xc6, 0x44, 0xe7, 0xe3, 0x7f, 0xf9, 0xb5, 0xf1, 0x29, 0x05, 0x6d,
-	0xc3, 0x42, 0xc8, 0x5e, 0xc4, 0x19, 0x5d, 0x73, 0x91, 0x5f, 0xd1, 0xba, 0x5d, 0xcb, 0xca, 0x68,
-	0xd7, 0xc3, 0x2c, 0xed, 0x71, 0x05, 0xca, 0x5a, 0x6d, 0xeb, 0x37, 0x17, 0x16, 0x26, 0x9d, 0x48,
-	0xf8, 0x31, 0xed, 0x13, 0x74, 0x08, 0xae, 0x79, 0xc4, 0xa0, 0x6b, 0xda, 0x5a, 0xde, 0x8b, 0xa6,
-	0xb9, 0x36, 0x53, 0x98, 0xa7, 0xea, 0xf9, 0xeb, 0x35, 0xbe, 0xf9, 0xfd, 0xaf, 0x1f, 0x0a, 0xc8,
-	0xab, 0xeb, 0x37, 0xae, 0x45, 0x43, 0xbc, 0xef, 0xdc, 0x47, 0x3e, 0x14, 0x77, 0x88, 0x44, 0x6b,
-	0xa6, 0xf9, 0xa7, 0x5f, 0x39, 0xcd, 0xab, 0x33, 0x74, 0x03, 0x92, 0xd7, 0xd4, 0x16, 0x57, 0x11,
-	0x3a, 0x63, 0xb1, 0xf3, 0x35, 0x0d, 0x5f, 0xa1, 0x1e, 0xb8, 0x66, 0x3d, 0xdb, 0x50, 0xf3, 0x76,
-	0xf5, 0x6b, 0x43, 0xbd, 0xad, 0x0d, 0xdf, 0x6c, 0x36, 0xa7, 0x0c, 0x4f, 0xfe, 0x2a, 0xd0, 0xf0,
-	0x95, 0x8a, 0xfb, 0x73, 0x70, 0xcd, 0xdb, 0xc1, 0xfa, 0xc8, 0x7b, 0x48, 0xbc, 0xd6, 0x87, 0x0d,
-	0xfe, 0x7e, 0x5e, 0xf0, 0xfb, 0x50, 0x52, 0xf7, 0x0d, 0x32, 0x99, 0xcf, 0x3e, 0x3b, 0x9a, 0x8d,
-	0x59, 0x86, 0xc5, 0xe4, 0x8a, 0x36, 0xbb, 0x88, 0xce, 0xa2, 0x8c, 0x18, 0xcc, 0xed, 0x10, 0x69,
-	0x2e, 0x9a, 0xeb, 0x53, 0x78, 0x66, 0xb7, 0x6d, 0x73, 0x3d, 0x9f, 0x69, 0xad, 0x6f, 0x68, 0xeb,
-	0x1e, 0x6a, 0xe5, 0x03, 0x13, 0xd0, 0xf0, 0x55, 0x47, 0x68, 0x27, 0x0c, 0x6a, 0x99, 0x49, 0x46,
-	0x69, 0x0d, 0xa7, 0x36, 0x82, 0xcd, 0x24, 0x67, 0xe8, 0xbd, 0xff, 0x6b, 0x5f, 0x77, 0xd1, 0xed,
-	0x73, 0x7c, 0xa9, 0x81, 0x14, 0x1d, 0xf5, 0x9c, 0x43, 0xdf, 0x39, 0xb0, 0x68, 0x86, 0x2a, 0x9d,
-	0x26, 0xe4, 0x69, 0xe3, 0xe7, 0xce, 0x6a, 0xf3, 0xd6, 0xb9, 0x32, 0x36, 0x96, 0x7b, 0x3a, 0x96,
-	0x5b, 0xe8, 0x3f, 0xe7, 0xc4, 0xa2, 0xa7, 0x46, 0xbc, 0xe3, 0xf4, 0x5c, 0x5d, 0xe9, 0x07, 0x7f,
-	0x07, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x8e, 0xbd, 0x9b, 0x5a, 0x0e, 0x00, 0x00,
+	// 2034 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x18, 0x4b, 0x73, 0x1b, 0x49,
+	0x99, 0x91, 0xac, 0xd7, 0x27, 0xcb, 0x8f, 0xb6, 0xe3, 0x28, 0xb3, 0xd9, 0xd8, 0x3b, 0x49, 0x76,
+	0x9d, 0xac, 0x57, 0x0e, 0x4e, 0x51, 0x15, 0x16, 0x08, 0x95, 0xb5, 0x9d, 0xac, 0x6b, 0x93, 0xc5,
+	0x35, 0x5e, 0x03, 0xb7, 0xa9, 0xf6, 0x4c, 0x4b, 0xee, 0xf2, 0x68, 0x66, 0xe8, 0x6e, 0x29, 0x76,
+	0xb6, 0x72, 0xa1, 0x8a, 0xe2, 0xc0, 0x85, 0x2a, 0x38, 0xc1, 0x0d, 0xb8, 0x50, 0xc5, 0x1f, 0xe0,
+	0x07, 0xf0, 0x0b, 0xb8, 0x70, 0xe0, 0x04, 0xfc, 0x05, 0xee, 0x54, 0x3f, 0x66, 0x3c, 0x1a, 0xc9,
+	0xb6, 0x1c, 0xf6, 0x24, 0x7d, 0xef, 0xaf, 0xbf, 0x57, 0x7f, 0x3d, 0xd0, 0xea, 0x61, 0x41, 0x5e,
+	0xe3, 0xb3, 0x4e, 0xc2, 0x62, 0x11, 0xa3, 0x32, 0x4e, 0xa8, 0x7d, 0xbb, 0x17, 0xc7, 0xbd, 0x90,
+	0x6c, 0xe2, 0x84, 0x6e, 0xe2, 0x28, 0x8a, 0x05, 0x16, 0x34, 0x8e, 0xb8, 0x66, 0xb1, 0x57, 0x0d,
+	0x55, 0x41, 0x47, 0x83, 0xee, 0xa6, 0xa0, 0x7d, 0xc2, 0x05, 0xee, 0x27, 0x86, 0xe1, 0xbd, 0x22,
+	0x03, 0xe9, 0x27, 0xc2, 0x18, 0xb0, 0xd7, 0x8a, 0xc4, 0x2e, 0x25, 0x61, 0xe0, 0xf5, 0x31, 0x3f,
+	0x31, 0x1c, 0xdf, 0xe9, 0x51, 0x71, 0x3c, 0x38, 0xea, 0xf8, 0x71, 0x7f, 0xf3, 0x88, 0xc5, 0x3e,
+	0xc6, 0x6c, 0x33, 0x8c, 0x19, 0xe6, 0x84, 0x0d, 0x09, 0x53, 0x4e, 0xf9, 0x71, 0xbf, 0x1f, 0x47,
+	0xe6, 0xc7, 0x88, 0xcd, 0xe6, 0x21, 0xe7, 0x1f, 0x25, 0xa8, 0xbd, 0xd0, 0x27, 0x43, 0x73, 0x50,
+	0xa2, 0x41, 0xdb, 0x5a, 0xb3, 0xd6, 0x1b, 0x6e, 0x89, 0x06, 0x08, 0xc1, 0x4c, 0x84, 0xfb, 0xa4,
+	0x5d, 0x52, 0x18, 0xf5, 0x1f, 0xad, 0x41, 0x33, 0x20, 0xdc, 0x67, 0x34, 0x91, 0x47, 0x6d, 0x97,
+	0x15, 0x29, 0x8f, 0x42, 0x1b, 0x50, 0x0f, 0x63, 0x5f, 0x45, 0xa2, 0x3d, 0xb3, 0x66, 0xad, 0x37,
+	0xb7, 0x16, 0x3a, 0xc6, 0xe4, 0x4b, 0x83, 0x77, 0x33, 0x0e, 0xf4, 0x11, 0xcc, 0xc7, 0xac, 0x87,
+	0x23, 0xfa, 0x46, 0xc1, 0x1e, 0x0d, 0xda, 0x95, 0x35, 0x6b, 0xbd, 0xec, 0xce, 0xe5, 0xd1, 0x7b,
+	0x3b, 0xe8, 0x63, 0x58, 0x0c, 0x28, 0xf7, 0xe3, 0x21, 0x61, 0x67, 0x1e, 0x89, 0xf0, 0x51, 0x48,
+	0x82, 0x76, 0x75, 0xcd, 0x5a, 0xaf, 0xbb, 0x0b, 0x19, 0x61, 0x57, 0xe3, 0xd1, 0x43, 0x58, 0x8c,
+	0x88, 0x78, 0x1d, 0xb3, 0x13, 0x4f, 0x47, 0x43, 0xea, 0xad, 0x29, 0xbd, 0xf3, 0x86, 0x70, 0xa0,
+	0xf0, 0x7b, 0x3b, 0x68, 0x03, 0x90, 0x49, 0xad, 0x97, 0xb0, 0xb8, 0x4b, 0x43, 0x22, 0x99, 0xeb,
+	0xea, 0x60, 0x0b, 0x86, 0xb2, 0xaf, 0x09, 0x7b, 0x3b, 0xe8, 0x01, 0x54, 0x8f, 0x62, 0xcc, 0x02,
+	0xde, 0x6e, 0xac, 0x95, 0xd7, 0x9b, 0x5b, 0x8b, 0x1d, 0x9c, 0xd0, 0x8e, 0x89, 0xe0, 0x67, 0x92,
+	0xe2, 0x1a, 0x06, 0xe7, 0x10, 0x66, 0xf3, 0x78, 0x74, 0x13, 0x6a, 0xdd, 0xa4, 0x87, 0xbd, 0x2c,
+	0xc6, 0x55, 0x09, 0x6a, 0x0f, 0xba, 0x34, 0x22, 0x5e, 0x56, 0x1f, 0xde, 0x09, 0x39, 0x33, 0x51,
+	0x5f, 0x90, 0x94, 0xaf, 0x52, 0xc2, 0x17, 0xe4, 0xcc, 0x79, 0x0a, 0xcb, 0xdb, 0x8c, 0x60, 0x41,
+	0x8c, 0x72, 0x97, 0xfc, 0x6c, 0x40, 0xb8, 0x40, 0x1f, 0x42, 0xcd, 0x78, 0xab, 0xd4, 0x37, 0xb7,
+	0x66, 0xf3, 0xae, 0xb9, 0x29, 0xd1, 0xb9, 0x0b, 0x8b, 0x2f, 0x88, 0x28, 0x08, 0x17, 0x52, 0xef,
+	0xfc, 0xab, 0x04, 0x28, 0xcf, 0xc5, 0x93, 0x38, 0xe2, 0x64, 0x5a, 0x1b, 0xe8, 0xbb, 0x00, 0xbe,
+	0xf2, 0x31, 0xf0, 0xb0, 0x50, 0x27, 0x69, 0x6e, 0xd9, 0x1d, 0x5d, 0xd1, 0x9d, 0xb4, 0xa2, 0x3b,
+	0xd9, 0xb1, 0xdc, 0x86, 0xe1, 0x7e, 0x26, 0xa4, 0xe8, 0x20, 0x09, 0x52, 0xd1, 0xf2, 0xd5, 0xa2,
+	0x86, 0xfb, 0x99, 0x40, 0x4f, 0xa1, 0xd5, 0xa5, 0x8c, 0x0b, 0x8f, 0x13, 0x12, 0x49, 0xe9, 0x99,
+	0x2b, 0xa5, 0x9b, 0x4a, 0xe0, 0x80, 0x90, 0xe8, 0x99, 0x40, 0xdf, 0x87, 0xd9, 0x10, 0xe7, 0xc4,
+	0x2b, 0x57, 0x8a, 0x43, 0x88, 0x33, 0xe9, 0x47, 0x50, 0xef, 0x13, 0x81, 0x03, 0x2c, 0xb0, 0xaa,
+	0xcb, 0xe6, 0xd6, 0x72, 0x3e, 0x38, 0xaf, 0x0c, 0xcd, 0xcd, 0xb8, 0x9c, 0x3f, 0x97, 0x61, 0xbe,
+	0x40, 0x55, 0x89, 0x48, 0xb2, 0x44, 0x24, 0xe8, 0x3e, 0xcc, 0xf9, 0x71, 0xd4, 0xa5, 0x3d, 0x6f,
+	0x48, 0x18, 0x97, 0x3d, 0xa5, 0xeb, 0xa2, 0xa5, 0xb1, 0x3f, 0xd6, 0x48, 0xf4, 0x04, 0xda, 0x09,
+	0xf6, 0x4f, 0x88, 0xf0, 0xba, 0x31, 0x7b, 0x8d, 0x59, 0x40, 0x58, 0x26, 0xa0, 0x7b, 0x74, 0x45,
+	0xd3, 0x9f, 0xa7, 0xe4, 0x54, 0xd2, 0x86, 0x7a, 0x12, 0x62, 0xd1, 0x8d, 0x59, 0x5f, 0xc5, 0xab,
+	0xe1, 0x66, 0xb0, 0x6c, 0xce, 0x63, 0xcc, 0x3d, 0x41, 0xfa, 0x09, 0x61, 0x58, 0x0c, 0x18, 0x51,
+	0x31, 0xa9, 0xbb, 0x73, 0xc7, 0x98, 0x7f, 0x75, 0x8e, 0x95, 0x53, 0x21, 0xcf, 0x24, 0x8f, 0x6f,
+	0xb9, 0x79, 0x14, 0xda, 0x01, 0x48, 0x58, 0x9c, 0x10, 0x26, 0x28, 0xe1, 0xed, 0x9a, 0xea, 0x9d,
+	0x7b, 0x93, 0xe2, 0xd3, 0xd9, 0xcf, 0xd8, 0x76, 0x23, 0xc1, 0xce, 0xdc, 0x9c, 0x5c, 0xa1, 0x38,
+	0xea, 0xd7, 0x28, 0x0e, 0xfb, 0x07, 0x30, 0x5f, 0xd0, 0x8c, 0x16, 0xa0, 0x2c, 0x1b, 0x4d, 0x07,
+	0x5b, 0xfe, 0x45, 0xcb, 0x50, 0x19, 0xe2, 0x70, 0x90, 0x8e, 0x3c, 0x0d, 0x7c, 0x5a, 0x7a, 0x62,
+	0x39, 0x1f, 0xc2, 0xf2, 0x0e, 0x09, 0xc9, 0x58, 0xd7, 0x15, 0x1b, 0xe7, 0xf7, 0x16, 0xa0, 0x97,
+	0x94, 0x17, 0xfb, 0x6b, 0x19, 0x2a, 0x21, 0xed, 0x53, 0xa1, 0x38, 0x2b, 0xae, 0x06, 0xd0, 0x0a,
+	0x54, 0xe3, 0x6e, 0x97, 0x13, 0xdd, 0x22, 0x15, 0xd7, 0x40, 0x93, 0x86, 0x62, 0x79, 0xe2, 0x50,
+	0x5c, 0x81, 0x2a, 0x27, 0x98, 0xf9, 0xc7, 0x26, 0x75, 0x06, 0x92, 0x78, 0x7f, 0xc0, 0x78, 0xcc,
+	0x54, 0xbe, 0x1a, 0xae, 0x81, 0x9c, 0x3f, 0x94, 0xb2, 0x8a, 0x93, 0x4e, 0xee, 0x09, 0xd2, 0xff,
+	0x86, 0xa6, 0xfe, 0x68, 0xc7, 0xcf, 0xbc, 0x7b, 0xc7, 0x57, 0xae, 0xd3, 0xf1, 0x13, 0x02, 0x55,
+	0x9d, 0x18, 0xa8, 0x6b, 0x5c, 0x08, 0xce, 0x2f, 0x2c, 0x58, 0x1a, 0x49, 0xa1, 0x19, 0x7e, 0xab,
+	0xd0, 0x14, 0xb1, 0xc0, 0xa1, 0xe7, 0xc7, 0x83, 0x48, 0x67, 0xb2, 0xec, 0x82, 0x42, 0x6d, 0x4b,
+	0x0c, 0xda, 0x80, 0x2a, 0x23, 0x7c, 0x10, 0xca, 0x74, 0x96, 0x8b, 0xfd, 0x9f, 0xc6, 0xdb, 0x35,
+	0x3c, 0x52, 0x5d, 0x44, 0x4e, 0x85, 0x67, 0x12, 0xa5, 0x63, 0x0a, 0x12, 0xb5, 0xad, 0x93, 0xf5,
+	0x35, 0x2c, 0x1f, 0xaa, 0x93, 0xbe, 0xdb, 0xa0, 0x47, 0xdf, 0x83, 0xa6, 0x8e, 0x94, 0x5a, 0x1a,
+	0x2e, 0x9c, 0xc2, 0xcf, 0xe5, 0x5e, 0xf1, 0x0a, 0xf3, 0x13, 0xd7, 0xa4, 0x41, 0xfe, 0x77, 0x7e,
+	0x55, 0xca, 0x6e, 0xaf, 0x03, 0x81, 0x05, 0x47, 0x4f, 0xa0, 0x91, 0xdd, 0x4f, 0x6d, 0xeb, 0x02,
+	0x5d, 0xb9, 0x24, 0x65, 0xcc, 0xa8, 0x03, 0x4b, 0xec, 0xd4, 0xd3, 0xe3, 0x87, 0x7b, 0x8c, 0xf8,
+	0x84, 0x0e, 0x49, 0x60, 0x4a, 0x7e, 0x91, 0x9d, 0xee, 0x6b, 0x8a, 0x6b, 0x08, 0xe8, 0x31, 0xac,
+	0x4c, 0xe0, 0xf7, 0xe2, 0x13, 0x15, 0xa3, 0x8a, 0xbb, 0x34, 0x26, 0xf2, 0xa3, 0x2f, 0xa4, 0x11,
+	0x31, 0xc1, 0xc8, 0x8c, 0x36, 0x22, 0xc6, 0x8c, 0x6c, 0x00, 0xca, 0xf1, 0x93, 0x3e, 0x15, 0x82,
+	0xe8, 0xd5, 0xa3, 0xe2, 0x2e, 0x64, 0xec, 0xbb, 0x1a, 0xef, 0xfc, 0xd7, 0x82, 0x95, 0xf3, 0xeb,
+	0x50, 0x05, 0x24, 0xcd, 0xc6, 0xfb, 0x00, 0xe9, 0xfa, 0x90, 0xb5, 0x51, 0xc3, 0x60, 0xf6, 0x76,
+	0xe4, 0x78, 0xa5, 0x91, 0x20, 0x6c, 0x88, 0x43, 0xd3, 0x51, 0x19, 0x8c, 0xb6, 0x61, 0x9e, 0x0b,
+	0xcc, 0xc4, 0xf9, 0xc5, 0x3f, 0xc5, 0x7d, 0x37, 0xa7, 0x44, 0x32, 0x18, 0xfd, 0x10, 0x5a, 0x24,
+	0x0a, 0x72, 0x2a, 0xae, 0xee, 0xbd, 0x59, 0x12, 0x05, 0xe7, 0x0a, 0x6c, 0xa8, 0x4b, 0xe1, 0x37,
+	0x71, 0x44, 0xcc, 0xb4, 0xc8, 0x60, 0x67, 0x07, 0x6e, 0x8e, 0x1d, 0xdb, 0x74, 0xc3, 0x83, 0xac,
+	0xd8, 0xad, 0xf1, 0x45, 0x48, 0xb3, 0x1a, 0x06, 0xe7, 0x77, 0x16, 0xdc, 0xca, 0x35, 0xd4, 0x0e,
+	0x19, 0x52, 0x9f, 0x4c, 0x1b, 0xc0, 0x6c, 0x72, 0x96, 0x54, 0xbf, 0x8d, 0x4d, 0x4e, 0x3d, 0x18,
+	0x0d, 0x84, 0x1e, 0x41, 0x85, 0xd3, 0xc8, 0x27, 0x53, 0x44, 0x41, 0x33, 0x3a, 0x7f, 0x2d, 0xc1,
+	0x8d, 0x11, 0xc7, 0xb2, 0xc1, 0x78, 0x13, 0x6a, 0x01, 0x19, 0x7a, 0x64, 0x40, 0xd3, 0x7d, 0x2d,
+	0x20, 0xc3, 0xdd, 0xc3, 0x3d, 0xd9, 0xb9, 0x81, 0x62, 0xf5, 0x72, 0x83, 0x12, 0x34, 0xea, 0x4b,
+	0x39, 0x2e, 0xef, 0xc3, 0x1c, 0x4e, 0x92, 0x90, 0xfa, 0x85, 0xf1, 0xdd, 0xca, 0x61, 0xd5, 0x2e,
+	0xb9, 0x90, 0x67, 0x53, 0xca, 0xf4, 0x1c, 0x9f, 0xcf, 0xe1, 0x95, 0xc6, 0xa9, 0xd7, 0xe4, 0xe2,
+	0x0e, 0x53, 0xbd, 0xd6, 0x0e, 0x83, 0x60, 0x86, 0x71, 0x4e, 0xd5, 0x64, 0xac, 0xb8, 0xea, 0x3f,
+	0xba, 0x25, 0xf7, 0x79, 0x86, 0x3d, 0x1e, 0x31, 0x75, 0xe3, 0x5a, 0x6e, 0x4d, 0xc2, 0x07, 0x11,
+	0x73, 0x7e, 0x6b, 0x81, 0x3d, 0x29, 0xb1, 0xd3, 0x0e, 0xcc, 0xad, 0xc2, 0xc0, 0xb4, 0xf3, 0x35,
+	0x34, 0x9a, 0x8d, 0x6c, 0x6c, 0x7e, 0x00, 0xb3, 0xc7, 0x34, 0x08, 0x48, 0x64, 0xb4, 0xea, 0xc8,
+	0x36, 0x35, 0x4e, 0xa9, 0x75, 0xfe, 0x6d, 0xc1, 0x9d, 0x5c, 0xd9, 0xd2, 0x5e, 0x84, 0xc3, 0xcf,
+	0x09, 0x16, 0x7d, 0x9c, 0x4c, 0x59, 0x74, 0x13, 0x3a, 0xb3, 0xf4, 0xff, 0x77, 0x66, 0xf9, 0x9a,
+	0x9d, 0x79, 0x1b, 0x1a, 0x09, 0x23, 0x3e, 0xe5, 0xe9, 0x53, 0xaa, 0xe5, 0x9e, 0x23, 0x9c, 0x7f,
+	0x96, 0x60, 0x71, 0xe4, 0x88, 0xdb, 0x24, 0x0c, 0x51, 0x1b, 0x6a, 0x3d, 0x12, 0x1f, 0x63, 0x7e,
+	0x6c, 0x4e, 0x95, 0x82, 0xb2, 0xcf, 0x43, 0x2c, 0xa8, 0x18, 0x04, 0xba, 0x64, 0x2d, 0x37, 0x83,
+	0xa5, 0xa5, 0x30, 0x8e, 0x7a, 0x9a, 0x58, 0x56, 0xc4, 0x73, 0x84, 0x94, 0x0c, 0x28, 0x17, 0x38,
+	0xed, 0x2b, 0xcb, 0xcd, 0x60, 0x69, 0xef, 0x88, 0x60, 0x46, 0xa3, 0x9e, 0x2a, 0x48, 0xcb, 0x4d,
+	0x41, 0x99, 0xa8, 0x41, 0x12, 0xd2, 0xe8, 0xc4, 0x24, 0x4a, 0x5f, 0xcc, 0x4d, 0x8d, 0xd3, 0xf9,
+	0xbf, 0x05, 0x75, 0x59, 0x62, 0x1e, 0x1e, 0xf6, 0x54, 0xc9, 0x59, 0x6e, 0x4d, 0xc2, 0xcf, 0x86,
+	0xbd, 0x8c, 0xd4, 0xa7, 0x91, 0xaa, 0xba, 0x8a, 0x26, 0xbd, 0xa2, 0xd1, 0x39, 0x09, 0x9f, 0xb6,
+	0x1b, 0x39, 0x12, 0x3e, 0x95, 0x2d, 0xcb, 0x23, 0xa6, 0xf4, 0x81, 0xd2, 0x57, 0xe5, 0x11, 0x93,
+	0xea, 0x0c, 0x41, 0x6a, 0x6b, 0x66, 0x04, 0xa9, 0x2c, 0x25, 0xe0, 0xd3, 0xf6, 0xec, 0x39, 0x01,
+	0x9f, 0x3a, 0x6f, 0x61, 0xf5, 0xc2, 0x1a, 0x32, 0xf5, 0x9d, 0x7f, 0xe9, 0x5a, 0x57, 0xbe, 0x74,
+	0x37, 0xa0, 0xe2, 0x93, 0x30, 0xe4, 0xa6, 0xd6, 0x57, 0x46, 0xe6, 0x65, 0x96, 0x40, 0x57, 0x33,
+	0x39, 0x7f, 0xb1, 0xa0, 0xba, 0x4f, 0xa3, 0x9e, 0xfb, 0xd3, 0xab, 0x6a, 0x35, 0xed, 0xd9, 0xd2,
+	0x05, 0x3d, 0x5b, 0x4e, 0x7b, 0xd6, 0xc5, 0x07, 0x5f, 0xba, 0x23, 0x65, 0x30, 0x73, 0x59, 0x19,
+	0x54, 0x26, 0x94, 0x01, 0x0e, 0x8d, 0xa4, 0xde, 0xf0, 0x33, 0xd8, 0x79, 0xac, 0x9e, 0x8b, 0x2f,
+	0x31, 0x17, 0xca, 0xe9, 0xa9, 0xba, 0xcc, 0xf9, 0x93, 0x05, 0x4b, 0x23, 0x52, 0x26, 0xae, 0xa3,
+	0xbb, 0xa4, 0x75, 0x9d, 0x5d, 0xf2, 0x36, 0x34, 0xba, 0x4c, 0x5a, 0x8f, 0x7c, 0xfd, 0x82, 0x6e,
+	0xb9, 0xe7, 0x08, 0xb9, 0xea, 0x06, 0x3a, 0x20, 0x2d, 0xb7, 0x14, 0x30, 0x74, 0x0f, 0x6a, 0x09,
+	0x8d, 0x7a, 0x1e, 0x3b, 0x6d, 0xcf, 0xa8, 0xa4, 0x34, 0x55, 0x52, 0x74, 0xdc, 0xdd, 0x6a, 0xa2,
+	0x7e, 0x9d, 0xa7, 0xf0, 0xfe, 0x81, 0x60, 0x04, 0xf7, 0x4d, 0xb2, 0x9e, 0x33, 0xdc, 0x27, 0x2f,
+	0xe3, 0xde, 0x94, 0x37, 0x98, 0xf3, 0x47, 0x0b, 0xee, 0x5c, 0xa4, 0xc0, 0x9c, 0xf8, 0x49, 0xd6,
+	0x2b, 0x5d, 0x49, 0x33, 0x67, 0x5e, 0x52, 0xde, 0x1c, 0x2a, 0x42, 0x2a, 0xf3, 0xf9, 0xb7, 0xd2,
+	0x16, 0x52, 0x18, 0xf4, 0x14, 0xe6, 0x82, 0xf8, 0x75, 0x94, 0x93, 0xd5, 0x83, 0xea, 0x86, 0x92,
+	0xdd, 0x31, 0xa4, 0x9c, 0x74, 0x2b, 0xc8, 0xe3, 0x3e, 0xab, 0x41, 0x45, 0x89, 0x6d, 0xfd, 0xad,
+	0x0e, 0x73, 0x69, 0x35, 0x12, 0x26, 0x47, 0x2f, 0x3a, 0x84, 0xaa, 0xfe, 0xd2, 0x80, 0x6e, 0x29,
+	0x6d, 0x93, 0x3e, 0x3b, 0xd8, 0x2b, 0x63, 0x89, 0xd9, 0x95, 0x5f, 0xb1, 0x9c, 0xf6, 0xcf, 0xff,
+	0xfe, 0x9f, 0xdf, 0x94, 0x90, 0xd3, 0x52, 0x1f, 0xa2, 0x4c, 0x34, 0xf8, 0xa7, 0xd6, 0x43, 0xe4,
+	0x42, 0xf9, 0x05, 0x11, 0xc8, 0x34, 0x40, 0xf1, 0x53, 0x84, 0x7d, 0x73, 0x0c, 0xaf, 0x83, 0xe4,
+	0xd8, 0x4a, 0xe3, 0x32, 0x42, 0x23, 0x1a, 0x37, 0xbf, 0xa6, 0xc1, 0x5b, 0x74, 0x04, 0x55, 0xbd,
+	0x2b, 0x1b, 0x57, 0x27, 0x2d, 0xce, 0x17, 0xba, 0x7a, 0x5f, 0x29, 0x5e, 0xb5, 0xed, 0x82, 0x62,
+	0xf3, 0xaf, 0x43, 0x83, 0xb7, 0xd2, 0xef, 0x9f, 0x40, 0x55, 0x3f, 0x01, 0x8d, 0x8d, 0x49, 0xef,
+	0xc1, 0x0b, 0x6d, 0x18, 0xe7, 0x1f, 0x4e, 0x72, 0x7e, 0x1f, 0x66, 0xe4, 0x35, 0x87, 0xf4, 0xc9,
+	0xc7, 0x5f, 0x8f, 0x76, 0x7b, 0x9c, 0x60, 0x62, 0x72, 0x43, 0xa9, 0x9d, 0x47, 0xa3, 0x51, 0x46,
+	0x31, 0xd4, 0x5f, 0x10, 0xa1, 0x17, 0xf7, 0xf7, 0x0a, 0xf1, 0xcc, 0x6f, 0xaf, 0xf6, 0xed, 0xc9,
+	0x44, 0xa3, 0x7d, 0x5d, 0x69, 0x77, 0xd0, 0xda, 0xe4, 0xc0, 0x78, 0x34, 0x78, 0xbb, 0xc9, 0x95,
+	0x91, 0x18, 0x9a, 0xb9, 0x4e, 0x46, 0x59, 0x0e, 0x0b, 0x13, 0xc1, 0x6e, 0x8f, 0x13, 0x8c, 0xad,
+	0x4f, 0x94, 0xad, 0x8f, 0xd0, 0xfd, 0x4b, 0x6c, 0xc9, 0x86, 0xe4, 0x9b, 0x72, 0x5f, 0x41, 0xbf,
+	0xb6, 0x60, 0x41, 0x1e, 0x31, 0x3f, 0x98, 0xd1, 0xdd, 0xe2, 0x69, 0x26, 0x5c, 0xfd, 0xf6, 0xbd,
+	0xcb, 0x99, 0x8c, 0x3b, 0xdf, 0x56, 0xee, 0x7c, 0x8c, 0x1e, 0x5c, 0x76, 0x74, 0x25, 0xf9, 0xc9,
+	0xb1, 0xb1, 0xfe, 0x06, 0x9a, 0x32, 0x45, 0x66, 0x0b, 0x42, 0x77, 0x8a, 0x49, 0x1b, 0xdd, 0x7b,
+	0xed, 0xd5, 0x0b, 0xe9, 0xc6, 0x85, 0x87, 0xca, 0x85, 0x7b, 0xc8, 0xb9, 0xc4, 0x85, 0xc0, 0x18,
+	0xfb, 0xa5, 0x05, 0xf3, 0x7a, 0xc6, 0x64, 0xc3, 0x05, 0x39, 0xca, 0xc0, 0xa5, 0xa3, 0xcb, 0xbe,
+	0x7b, 0x29, 0x8f, 0x71, 0xe4, 0x81, 0x72, 0xe4, 0x2e, 0xfa, 0xe0, 0x12, 0x47, 0xd4, 0x10, 0xe1,
+	0x8f, 0xac, 0xa3, 0xaa, 0x2a, 0xfc, 0xc7, 0xff, 0x1b, 0x00, 0xc7, 0x0b, 0x0e, 0x23, 0x30, 0x17,
+	0x00, 0x00,
 }
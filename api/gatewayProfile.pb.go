@@ -39,10 +39,16 @@ type GatewayProfile struct {
 	Channels []uint32 `protobuf:"varint,4,rep,packed,name=channels,proto3" json:"channels,omitempty"`
 	// Extra channels added to the channel-configuration (in case the LoRaWAN
 	// region supports adding custom channels).
-	ExtraChannels        []*GatewayProfileExtraChannel `protobuf:"bytes,5,rep,name=extra_channels,json=extraChannels,proto3" json:"extra_channels,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+	ExtraChannels []*GatewayProfileExtraChannel `protobuf:"bytes,5,rep,name=extra_channels,json=extraChannels,proto3" json:"extra_channels,omitempty"`
+	// Organization ID to which the gateway-profile is scoped.
+	// When left blank (0), this is a global gateway-profile which can be
+	// used by all organizations. Only global admin users are able to
+	// create or update global gateway-profiles. This field can not be
+	// changed after creation.
+	OrganizationId       int64    `protobuf:"varint,6,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GatewayProfile) Reset()         { *m = GatewayProfile{} }
@@ -104,6 +110,13 @@ func (m *GatewayProfile) GetExtraChannels() []*GatewayProfileExtraChannel {
 	return nil
 }
 
+func (m *GatewayProfile) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
 type GatewayProfileListItem struct {
 	// Gateway-profile ID (UUID string).
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -113,6 +126,9 @@ type GatewayProfileListItem struct {
 	NetworkServerId int64 `protobuf:"varint,3,opt,name=network_server_id,json=networkServerID,proto3" json:"network_server_id,omitempty"`
 	// Network-server name.
 	NetworkServerName string `protobuf:"bytes,7,opt,name=network_server_name,json=networkServerName,proto3" json:"network_server_name,omitempty"`
+	// Organization ID to which the gateway-profile is scoped (0 for global
+	// gateway-profiles).
+	OrganizationId int64 `protobuf:"varint,8,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
 	// Created at timestamp.
 	CreatedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// Last update timestamp.
@@ -174,6 +190,13 @@ func (m *GatewayProfileListItem) GetNetworkServerName() string {
 	return ""
 }
 
+func (m *GatewayProfileListItem) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
 func (m *GatewayProfileListItem) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
 		return m.CreatedAt
@@ -521,7 +544,13 @@ type ListGatewayProfilesRequest struct {
 	// Offset in the result-set (for pagination).
 	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
 	// Network-server ID to filter on (optional).
-	NetworkServerId      int64    `protobuf:"varint,3,opt,name=network_server_id,json=networkServerID,proto3" json:"network_server_id,omitempty"`
+	NetworkServerId int64 `protobuf:"varint,3,opt,name=network_server_id,json=networkServerID,proto3" json:"network_server_id,omitempty"`
+	// Organization ID to filter on (optional).
+	// When set, the global gateway-profiles and the gateway-profiles of the
+	// given organization are returned. When not set, global admin users
+	// will see all gateway-profiles, other users only the global
+	// gateway-profiles.
+	OrganizationId       int64    `protobuf:"varint,4,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -572,6 +601,13 @@ func (m *ListGatewayProfilesRequest) GetNetworkServerId() int64 {
 	return 0
 }
 
+func (m *ListGatewayProfilesRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
 type ListGatewayProfilesResponse struct {
 	// Total number of gateway-profiles.
 	TotalCount           int64                     `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
@@ -619,6 +655,159 @@ func (m *ListGatewayProfilesResponse) GetResult() []*GatewayProfileListItem {
 	return nil
 }
 
+type AssignGatewayProfileToGatewaysRequest struct {
+	// Gateway-profile ID (UUID string).
+	GatewayProfileId string `protobuf:"bytes,1,opt,name=gateway_profile_id,json=gatewayProfileID,proto3" json:"gateway_profile_id,omitempty"`
+	// Organization ID to filter on.
+	// This is required for non global admin users.
+	OrganizationId int64 `protobuf:"varint,2,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Search filter on the gateway name or ID (optional).
+	Search string `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	// Gateway IDs (HEX encoded) to filter on (optional).
+	GatewayIds           []string `protobuf:"bytes,4,rep,name=gateway_ids,json=gatewayIDs,proto3" json:"gateway_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AssignGatewayProfileToGatewaysRequest) Reset()         { *m = AssignGatewayProfileToGatewaysRequest{} }
+func (m *AssignGatewayProfileToGatewaysRequest) String() string { return proto.CompactTextString(m) }
+func (*AssignGatewayProfileToGatewaysRequest) ProtoMessage()    {}
+func (*AssignGatewayProfileToGatewaysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b20ad161b6cd9337, []int{11}
+}
+func (m *AssignGatewayProfileToGatewaysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AssignGatewayProfileToGatewaysRequest.Unmarshal(m, b)
+}
+func (m *AssignGatewayProfileToGatewaysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AssignGatewayProfileToGatewaysRequest.Marshal(b, m, deterministic)
+}
+func (dst *AssignGatewayProfileToGatewaysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignGatewayProfileToGatewaysRequest.Merge(dst, src)
+}
+func (m *AssignGatewayProfileToGatewaysRequest) XXX_Size() int {
+	return xxx_messageInfo_AssignGatewayProfileToGatewaysRequest.Size(m)
+}
+func (m *AssignGatewayProfileToGatewaysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignGatewayProfileToGatewaysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssignGatewayProfileToGatewaysRequest proto.InternalMessageInfo
+
+func (m *AssignGatewayProfileToGatewaysRequest) GetGatewayProfileId() string {
+	if m != nil {
+		return m.GatewayProfileId
+	}
+	return ""
+}
+
+func (m *AssignGatewayProfileToGatewaysRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *AssignGatewayProfileToGatewaysRequest) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
+func (m *AssignGatewayProfileToGatewaysRequest) GetGatewayIds() []string {
+	if m != nil {
+		return m.GatewayIds
+	}
+	return nil
+}
+
+type AssignGatewayProfileToGatewaysResponse struct {
+	// Gateway ID (HEX encoded).
+	GatewayId string `protobuf:"bytes,1,opt,name=gateway_id,json=gatewayID,proto3" json:"gateway_id,omitempty"`
+	// Gateway name.
+	GatewayName string `protobuf:"bytes,2,opt,name=gateway_name,json=gatewayName,proto3" json:"gateway_name,omitempty"`
+	// Error in case the gateway-profile could not be assigned to the gateway.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Number of processed gateways.
+	ProcessedCount int64 `protobuf:"varint,4,opt,name=processed_count,json=processedCount,proto3" json:"processed_count,omitempty"`
+	// Number of gateways for which the assignment failed.
+	FailedCount int64 `protobuf:"varint,5,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	// Total number of gateways matching the filters.
+	TotalCount           int64    `protobuf:"varint,6,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AssignGatewayProfileToGatewaysResponse) Reset() {
+	*m = AssignGatewayProfileToGatewaysResponse{}
+}
+func (m *AssignGatewayProfileToGatewaysResponse) String() string { return proto.CompactTextString(m) }
+func (*AssignGatewayProfileToGatewaysResponse) ProtoMessage()    {}
+func (*AssignGatewayProfileToGatewaysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b20ad161b6cd9337, []int{12}
+}
+func (m *AssignGatewayProfileToGatewaysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AssignGatewayProfileToGatewaysResponse.Unmarshal(m, b)
+}
+func (m *AssignGatewayProfileToGatewaysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AssignGatewayProfileToGatewaysResponse.Marshal(b, m, deterministic)
+}
+func (dst *AssignGatewayProfileToGatewaysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignGatewayProfileToGatewaysResponse.Merge(dst, src)
+}
+func (m *AssignGatewayProfileToGatewaysResponse) XXX_Size() int {
+	return xxx_messageInfo_AssignGatewayProfileToGatewaysResponse.Size(m)
+}
+func (m *AssignGatewayProfileToGatewaysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignGatewayProfileToGatewaysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssignGatewayProfileToGatewaysResponse proto.InternalMessageInfo
+
+func (m *AssignGatewayProfileToGatewaysResponse) GetGatewayId() string {
+	if m != nil {
+		return m.GatewayId
+	}
+	return ""
+}
+
+func (m *AssignGatewayProfileToGatewaysResponse) GetGatewayName() string {
+	if m != nil {
+		return m.GatewayName
+	}
+	return ""
+}
+
+func (m *AssignGatewayProfileToGatewaysResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *AssignGatewayProfileToGatewaysResponse) GetProcessedCount() int64 {
+	if m != nil {
+		return m.ProcessedCount
+	}
+	return 0
+}
+
+func (m *AssignGatewayProfileToGatewaysResponse) GetFailedCount() int64 {
+	if m != nil {
+		return m.FailedCount
+	}
+	return 0
+}
+
+func (m *AssignGatewayProfileToGatewaysResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GatewayProfile)(nil), "api.GatewayProfile")
 	proto.RegisterType((*GatewayProfileListItem)(nil), "api.GatewayProfileListItem")
@@ -631,6 +820,8 @@ func init() {
 	proto.RegisterType((*DeleteGatewayProfileRequest)(nil), "api.DeleteGatewayProfileRequest")
 	proto.RegisterType((*ListGatewayProfilesRequest)(nil), "api.ListGatewayProfilesRequest")
 	proto.RegisterType((*ListGatewayProfilesResponse)(nil), "api.ListGatewayProfilesResponse")
+	proto.RegisterType((*AssignGatewayProfileToGatewaysRequest)(nil), "api.AssignGatewayProfileToGatewaysRequest")
+	proto.RegisterType((*AssignGatewayProfileToGatewaysResponse)(nil), "api.AssignGatewayProfileToGatewaysResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -655,6 +846,15 @@ type GatewayProfileServiceClient interface {
 	Delete(ctx context.Context, in *DeleteGatewayProfileRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// List returns the existing gateway-profiles.
 	List(ctx context.Context, in *ListGatewayProfilesRequest, opts ...grpc.CallOption) (*ListGatewayProfilesResponse, error)
+	// AssignToGateways assigns the gateway-profile to all the gateways
+	// matching the given filters. For every processed gateway, a progress
+	// report is streamed.
+	// Notes:
+	//   * Only the gateways provisioned on the network-server of the
+	//     gateway-profile are updated.
+	//   * Organization gateway-profiles are only assigned to the gateways
+	//     of the organization.
+	AssignToGateways(ctx context.Context, in *AssignGatewayProfileToGatewaysRequest, opts ...grpc.CallOption) (GatewayProfileService_AssignToGatewaysClient, error)
 }
 
 type gatewayProfileServiceClient struct {
@@ -710,6 +910,38 @@ func (c *gatewayProfileServiceClient) List(ctx context.Context, in *ListGatewayP
 	return out, nil
 }
 
+func (c *gatewayProfileServiceClient) AssignToGateways(ctx context.Context, in *AssignGatewayProfileToGatewaysRequest, opts ...grpc.CallOption) (GatewayProfileService_AssignToGatewaysClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GatewayProfileService_serviceDesc.Streams[0], "/api.GatewayProfileService/AssignToGateways", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gatewayProfileServiceAssignToGatewaysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GatewayProfileService_AssignToGatewaysClient interface {
+	Recv() (*AssignGatewayProfileToGatewaysResponse, error)
+	grpc.ClientStream
+}
+
+type gatewayProfileServiceAssignToGatewaysClient struct {
+	grpc.ClientStream
+}
+
+func (x *gatewayProfileServiceAssignToGatewaysClient) Recv() (*AssignGatewayProfileToGatewaysResponse, error) {
+	m := new(AssignGatewayProfileToGatewaysResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // GatewayProfileServiceServer is the server API for GatewayProfileService service.
 type GatewayProfileServiceServer interface {
 	// Create creates the given gateway-profile.
@@ -722,6 +954,15 @@ type GatewayProfileServiceServer interface {
 	Delete(context.Context, *DeleteGatewayProfileRequest) (*empty.Empty, error)
 	// List returns the existing gateway-profiles.
 	List(context.Context, *ListGatewayProfilesRequest) (*ListGatewayProfilesResponse, error)
+	// AssignToGateways assigns the gateway-profile to all the gateways
+	// matching the given filters. For every processed gateway, a progress
+	// report is streamed.
+	// Notes:
+	//   * Only the gateways provisioned on the network-server of the
+	//     gateway-profile are updated.
+	//   * Organization gateway-profiles are only assigned to the gateways
+	//     of the organization.
+	AssignToGateways(*AssignGatewayProfileToGatewaysRequest, GatewayProfileService_AssignToGatewaysServer) error
 }
 
 func RegisterGatewayProfileServiceServer(s *grpc.Server, srv GatewayProfileServiceServer) {
@@ -818,6 +1059,27 @@ func _GatewayProfileService_List_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GatewayProfileService_AssignToGateways_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AssignGatewayProfileToGatewaysRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GatewayProfileServiceServer).AssignToGateways(m, &gatewayProfileServiceAssignToGatewaysServer{stream})
+}
+
+type GatewayProfileService_AssignToGatewaysServer interface {
+	Send(*AssignGatewayProfileToGatewaysResponse) error
+	grpc.ServerStream
+}
+
+type gatewayProfileServiceAssignToGatewaysServer struct {
+	grpc.ServerStream
+}
+
+func (x *gatewayProfileServiceAssignToGatewaysServer) Send(m *AssignGatewayProfileToGatewaysResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _GatewayProfileService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.GatewayProfileService",
 	HandlerType: (*GatewayProfileServiceServer)(nil),
@@ -843,62 +1105,81 @@ var _GatewayProfileService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _GatewayProfileService_List_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AssignToGateways",
+			Handler:       _GatewayProfileService_AssignToGateways_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "gatewayProfile.proto",
 }
 
 func init() { proto.RegisterFile("gatewayProfile.proto", fileDescriptor_b20ad161b6cd9337) }
 
 var fileDescriptor_b20ad161b6cd9337 = []byte{
-	// 786 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcb, 0x6e, 0xe3, 0x36,
-	0x14, 0x85, 0x2c, 0xc7, 0x69, 0xae, 0x61, 0xa7, 0x61, 0x1e, 0x55, 0x65, 0xa7, 0x51, 0xb5, 0x32,
-	0x5c, 0x44, 0x06, 0x1c, 0x64, 0xd1, 0xa2, 0x9b, 0x20, 0x2f, 0x04, 0x68, 0x8b, 0x42, 0x6d, 0x57,
-	0x59, 0x18, 0xb4, 0x44, 0xdb, 0x44, 0x25, 0x51, 0x95, 0xe8, 0xa4, 0xc6, 0x20, 0x9b, 0xf9, 0x80,
-	0xd9, 0xcc, 0x07, 0xcd, 0x66, 0xf6, 0xb3, 0xc8, 0x2f, 0xcc, 0x87, 0x0c, 0x44, 0xd2, 0x4e, 0xe4,
-	0x48, 0x1e, 0x64, 0x1e, 0x2b, 0xfb, 0xde, 0x7b, 0x78, 0xcf, 0x7d, 0x1c, 0x8a, 0xb0, 0x33, 0xc6,
-	0x9c, 0xdc, 0xe2, 0xd9, 0x9f, 0x09, 0x1b, 0xd1, 0x80, 0x38, 0x71, 0xc2, 0x38, 0x43, 0x3a, 0x8e,
-	0xa9, 0xd9, 0x1e, 0x33, 0x36, 0x0e, 0x48, 0x0f, 0xc7, 0xb4, 0x87, 0xa3, 0x88, 0x71, 0xcc, 0x29,
-	0x8b, 0x52, 0x09, 0x31, 0x0f, 0x54, 0x54, 0x58, 0xc3, 0xe9, 0xa8, 0xc7, 0x69, 0x48, 0x52, 0x8e,
-	0xc3, 0x58, 0x01, 0x5a, 0xcb, 0x00, 0x12, 0xc6, 0x7c, 0xa6, 0x82, 0xc7, 0x63, 0xca, 0x27, 0xd3,
-	0xa1, 0xe3, 0xb1, 0xb0, 0x37, 0x4c, 0x98, 0x87, 0x71, 0xd2, 0x0b, 0x58, 0x82, 0x53, 0x92, 0xdc,
-	0x90, 0x44, 0x50, 0x7a, 0x2c, 0x0c, 0x59, 0xa4, 0x7e, 0xe4, 0x31, 0xfb, 0xad, 0x06, 0xcd, 0xcb,
-	0x5c, 0xc1, 0xa8, 0x09, 0x15, 0xea, 0x1b, 0x9a, 0xa5, 0x75, 0x36, 0xdc, 0x0a, 0xf5, 0x11, 0x82,
-	0x6a, 0x84, 0x43, 0x62, 0x54, 0x84, 0x47, 0xfc, 0x47, 0x5d, 0xd8, 0x8a, 0x08, 0xbf, 0x65, 0xc9,
-	0xbf, 0x03, 0x49, 0x30, 0xa0, 0xbe, 0xa1, 0x5b, 0x5a, 0x47, 0x77, 0x37, 0x55, 0xe0, 0x2f, 0xe1,
-	0xbf, 0x3a, 0x43, 0x26, 0x7c, 0xe3, 0x4d, 0x70, 0x14, 0x91, 0x20, 0x35, 0xaa, 0x96, 0xde, 0x69,
-	0xb8, 0x0b, 0x1b, 0x5d, 0x40, 0x93, 0xfc, 0xcf, 0x13, 0x3c, 0x58, 0x20, 0xd6, 0x2c, 0xbd, 0x53,
-	0xef, 0x1f, 0x38, 0x38, 0xa6, 0x4e, 0xbe, 0xb0, 0xf3, 0x0c, 0x78, 0x2a, 0x71, 0x6e, 0x83, 0x3c,
-	0xb2, 0x52, 0xfb, 0x55, 0x05, 0xf6, 0xf2, 0xe8, 0xdf, 0x68, 0xca, 0xaf, 0x38, 0x09, 0xbf, 0x78,
-	0x3b, 0x0e, 0x6c, 0x2f, 0x61, 0x45, 0xba, 0x75, 0x91, 0x6e, 0x2b, 0x87, 0xfe, 0x23, 0xcb, 0xfd,
-	0x33, 0x80, 0x97, 0x10, 0xcc, 0x89, 0x3f, 0xc0, 0xdc, 0x58, 0xb3, 0xb4, 0x4e, 0xbd, 0x6f, 0x3a,
-	0x72, 0x95, 0xce, 0x7c, 0x95, 0xce, 0xdf, 0xf3, 0x5d, 0xbb, 0x1b, 0x0a, 0x7d, 0xc2, 0xb3, 0xa3,
-	0xd3, 0xd8, 0x9f, 0x1f, 0xad, 0x7d, 0xfc, 0xa8, 0x42, 0x9f, 0x70, 0xfb, 0x5e, 0x03, 0xb3, 0x7c,
-	0x7c, 0xa8, 0x0f, 0x10, 0x32, 0x7f, 0x1a, 0x08, 0x01, 0x8a, 0xe1, 0x34, 0xfb, 0xc8, 0x51, 0xca,
-	0xf8, 0x7d, 0x11, 0x71, 0x1f, 0xa1, 0x50, 0x1b, 0x36, 0x46, 0x09, 0xf9, 0x6f, 0x4a, 0x22, 0x6f,
-	0x26, 0xa6, 0xd7, 0x70, 0x1f, 0x1c, 0x59, 0x74, 0x88, 0x23, 0xff, 0x96, 0xfa, 0x7c, 0x22, 0x46,
-	0xd7, 0x70, 0x1f, 0x1c, 0xc8, 0x80, 0xf5, 0x21, 0xe5, 0x09, 0xe6, 0xc4, 0xa8, 0x8a, 0xd8, 0xdc,
-	0x44, 0x3f, 0xc1, 0x56, 0x1a, 0x27, 0x04, 0xfb, 0x34, 0x1a, 0x0f, 0x46, 0xd8, 0xe3, 0x2c, 0x91,
-	0x22, 0x68, 0xb8, 0xdf, 0x2e, 0x02, 0x17, 0xd2, 0x6f, 0x5f, 0x43, 0xeb, 0x54, 0x4c, 0x27, 0xdf,
-	0x9a, 0x9b, 0x15, 0x91, 0x72, 0xf4, 0x2b, 0x6c, 0xaa, 0xcb, 0x37, 0x88, 0x65, 0x44, 0xb4, 0x56,
-	0xef, 0x6f, 0x17, 0xc8, 0xc9, 0x6d, 0xe6, 0x2f, 0xaa, 0xed, 0x40, 0xbb, 0x38, 0x79, 0x1a, 0xb3,
-	0x28, 0x7d, 0x72, 0x2f, 0xec, 0x2e, 0x18, 0x97, 0x84, 0x17, 0x57, 0xb2, 0x8c, 0x7d, 0xa7, 0xc1,
-	0xf7, 0x05, 0x60, 0x95, 0xf9, 0xb3, 0xea, 0x5e, 0x12, 0x58, 0xe5, 0xd3, 0x05, 0xa6, 0x3f, 0x47,
-	0x60, 0xd7, 0xd0, 0xfa, 0x47, 0x18, 0x5f, 0x63, 0x15, 0x87, 0xd0, 0x3a, 0x23, 0x01, 0x29, 0x4b,
-	0xbe, 0x3c, 0xdd, 0x1b, 0x30, 0xb3, 0xeb, 0x9e, 0x07, 0xa7, 0x73, 0xf4, 0x0e, 0xac, 0x05, 0x34,
-	0xa4, 0x5c, 0x1c, 0xd0, 0x5d, 0x69, 0xa0, 0x3d, 0xa8, 0xb1, 0xd1, 0x28, 0x25, 0x72, 0x62, 0xba,
-	0xab, 0xac, 0xe7, 0x7c, 0x0a, 0xec, 0x14, 0x5a, 0x85, 0xbc, 0x6a, 0xad, 0x07, 0x50, 0xe7, 0x8c,
-	0xe3, 0x60, 0xe0, 0xb1, 0x69, 0x34, 0xa7, 0x07, 0xe1, 0x3a, 0xcd, 0x3c, 0xe8, 0x08, 0x6a, 0x09,
-	0x49, 0xa7, 0x41, 0x56, 0x43, 0xf6, 0xd5, 0x6b, 0x15, 0xcc, 0x66, 0xfe, 0x1d, 0x73, 0x15, 0xb4,
-	0xff, 0xa6, 0x0a, 0xbb, 0x79, 0x48, 0x56, 0x0f, 0xf5, 0x08, 0x62, 0x50, 0x93, 0x02, 0x46, 0x96,
-	0x48, 0xb4, 0xe2, 0xaa, 0x98, 0x3f, 0xae, 0x40, 0xc8, 0xf2, 0x6d, 0xeb, 0xe5, 0xfd, 0xfb, 0xd7,
-	0x15, 0xd3, 0xde, 0x15, 0x8f, 0x87, 0xda, 0xd0, 0xa1, 0xda, 0x66, 0xfa, 0x8b, 0xd6, 0x45, 0x13,
-	0xd0, 0x2f, 0x09, 0x47, 0xfb, 0xb2, 0xec, 0x92, 0xbb, 0x60, 0xfe, 0x50, 0x16, 0x56, 0x3c, 0xb6,
-	0xe0, 0x69, 0x23, 0xb3, 0x90, 0xa7, 0xf7, 0x82, 0xfa, 0x77, 0x68, 0x06, 0x35, 0xa9, 0x36, 0xd5,
-	0xda, 0x0a, 0xe9, 0x99, 0x7b, 0x4f, 0x04, 0x7c, 0x9e, 0xbd, 0x93, 0xf6, 0xb1, 0xe0, 0xe9, 0x99,
-	0xdd, 0x12, 0x9e, 0x25, 0xbd, 0x3a, 0xd4, 0xbf, 0xcb, 0x9a, 0x1c, 0x41, 0x4d, 0x6a, 0x51, 0x51,
-	0xaf, 0x10, 0x66, 0x29, 0xb5, 0x6a, 0xb1, 0xbb, 0xaa, 0xc5, 0x09, 0x54, 0xb3, 0x5d, 0x23, 0xf9,
-	0xf4, 0x95, 0xeb, 0xd9, 0xb4, 0xca, 0x01, 0x6a, 0xa2, 0xfb, 0x82, 0xee, 0x3b, 0x54, 0xbc, 0xb9,
-	0x61, 0x4d, 0x54, 0x77, 0xf4, 0x21, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x2b, 0xfa, 0x19, 0xaa, 0x08,
-	0x00, 0x00,
+	// 997 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xd6, 0xda, 0xf1, 0xb6, 0x3e, 0xae, 0x9d, 0x64, 0x9a, 0x06, 0xb3, 0x4e, 0x88, 0xb3, 0x12,
+	0x60, 0xb9, 0x74, 0x8d, 0x1c, 0x55, 0x02, 0x84, 0x90, 0xa2, 0xa4, 0x8d, 0x22, 0x01, 0x42, 0x4b,
+	0xb9, 0xea, 0x85, 0x35, 0xde, 0x1d, 0xdb, 0x23, 0x76, 0x77, 0x96, 0x99, 0x31, 0x21, 0xa0, 0xdc,
+	0xf0, 0x0a, 0x3c, 0x00, 0x4f, 0xc0, 0x75, 0x1f, 0x83, 0x8b, 0xbe, 0x02, 0xb7, 0x88, 0x57, 0x40,
+	0x3b, 0x33, 0xeb, 0x64, 0xdd, 0xb5, 0x9b, 0xf2, 0x73, 0x65, 0x9f, 0x73, 0xbe, 0x39, 0xff, 0xf3,
+	0xed, 0xc0, 0xce, 0x14, 0x4b, 0x72, 0x81, 0x2f, 0xbf, 0xe2, 0x6c, 0x42, 0x23, 0xe2, 0xa5, 0x9c,
+	0x49, 0x86, 0xaa, 0x38, 0xa5, 0xce, 0xde, 0x94, 0xb1, 0x69, 0x44, 0x06, 0x38, 0xa5, 0x03, 0x9c,
+	0x24, 0x4c, 0x62, 0x49, 0x59, 0x22, 0x34, 0xc4, 0x39, 0x30, 0x56, 0x25, 0x8d, 0xe7, 0x93, 0x81,
+	0xa4, 0x31, 0x11, 0x12, 0xc7, 0xa9, 0x01, 0x74, 0x96, 0x01, 0x24, 0x4e, 0xe5, 0xa5, 0x31, 0x3e,
+	0x9e, 0x52, 0x39, 0x9b, 0x8f, 0xbd, 0x80, 0xc5, 0x83, 0x31, 0x67, 0x01, 0xc6, 0x7c, 0x10, 0x31,
+	0x8e, 0x05, 0xe1, 0xdf, 0x13, 0xae, 0x42, 0x06, 0x2c, 0x8e, 0x59, 0x62, 0x7e, 0xf4, 0x31, 0xf7,
+	0x4f, 0x0b, 0x5a, 0x67, 0x85, 0x84, 0x51, 0x0b, 0x2a, 0x34, 0x6c, 0x5b, 0x5d, 0xab, 0x57, 0xf7,
+	0x2b, 0x34, 0x44, 0x08, 0x36, 0x12, 0x1c, 0x93, 0x76, 0x45, 0x69, 0xd4, 0x7f, 0xd4, 0x87, 0xed,
+	0x84, 0xc8, 0x0b, 0xc6, 0xbf, 0x1d, 0xe9, 0x00, 0x23, 0x1a, 0xb6, 0xab, 0x5d, 0xab, 0x57, 0xf5,
+	0x37, 0x8d, 0xe1, 0x6b, 0xa5, 0x3f, 0x3f, 0x45, 0x0e, 0xdc, 0x0d, 0x66, 0x38, 0x49, 0x48, 0x24,
+	0xda, 0x1b, 0xdd, 0x6a, 0xaf, 0xe9, 0x2f, 0x64, 0xf4, 0x14, 0x5a, 0xe4, 0x07, 0xc9, 0xf1, 0x68,
+	0x81, 0xa8, 0x75, 0xab, 0xbd, 0xc6, 0xf0, 0xc0, 0xc3, 0x29, 0xf5, 0x8a, 0x89, 0x3d, 0xc9, 0x80,
+	0x27, 0x1a, 0xe7, 0x37, 0xc9, 0x0d, 0x49, 0xa0, 0xf7, 0x61, 0x93, 0xf1, 0x29, 0x4e, 0xe8, 0x8f,
+	0xaa, 0xa5, 0x59, 0x36, 0xb6, 0xca, 0xa6, 0x75, 0x53, 0x7d, 0x7e, 0xea, 0xbe, 0xa8, 0xc0, 0x6e,
+	0xd1, 0xed, 0xe7, 0x54, 0xc8, 0x73, 0x49, 0xe2, 0xff, 0xbc, 0x6e, 0x0f, 0xee, 0x2f, 0x61, 0x95,
+	0xbb, 0x3b, 0xca, 0xdd, 0x76, 0x01, 0xfd, 0x65, 0xe6, 0xbb, 0xa4, 0x86, 0xbb, 0x65, 0x35, 0xa0,
+	0x8f, 0x01, 0x02, 0x4e, 0xb0, 0x24, 0xe1, 0x08, 0xcb, 0x76, 0xad, 0x6b, 0xf5, 0x1a, 0x43, 0xc7,
+	0xd3, 0xcb, 0xe1, 0xe5, 0xcb, 0xe1, 0x3d, 0xcb, 0xb7, 0xc7, 0xaf, 0x1b, 0xf4, 0xb1, 0xcc, 0x8e,
+	0xce, 0xd3, 0x30, 0x3f, 0x6a, 0xbf, 0xfe, 0xa8, 0x41, 0x1f, 0x4b, 0xf7, 0xa5, 0x05, 0xce, 0xea,
+	0x81, 0xa0, 0x21, 0x40, 0xcc, 0xc2, 0x79, 0xa4, 0x92, 0x54, 0x5d, 0x6c, 0x0d, 0x91, 0x67, 0x76,
+	0xed, 0x8b, 0x85, 0xc5, 0xbf, 0x81, 0x42, 0x7b, 0x50, 0x9f, 0x70, 0xf2, 0xdd, 0x9c, 0x24, 0xc1,
+	0xa5, 0x6a, 0x73, 0xd3, 0xbf, 0x56, 0x64, 0xd6, 0x31, 0x4e, 0xc2, 0x0b, 0x1a, 0xca, 0x99, 0xea,
+	0x71, 0xd3, 0xbf, 0x56, 0xa0, 0x36, 0xdc, 0x19, 0x53, 0xc9, 0xb1, 0x24, 0xed, 0x0d, 0x65, 0xcb,
+	0x45, 0xf4, 0x10, 0xb6, 0x45, 0xca, 0x09, 0x0e, 0x69, 0x32, 0x1d, 0x4d, 0x70, 0x20, 0x19, 0xd7,
+	0x6b, 0xd5, 0xf4, 0xb7, 0x16, 0x86, 0xa7, 0x5a, 0xef, 0x3e, 0x87, 0xce, 0x89, 0xea, 0x4e, 0xb1,
+	0x34, 0x3f, 0x4b, 0x42, 0x48, 0xf4, 0x29, 0x6c, 0x9a, 0xeb, 0x3c, 0x4a, 0xb5, 0x45, 0x95, 0xd6,
+	0x18, 0xde, 0x2f, 0x59, 0x50, 0xbf, 0x55, 0xbc, 0xfa, 0xae, 0x07, 0x7b, 0xe5, 0xce, 0x45, 0xca,
+	0x12, 0xf1, 0xca, 0x4d, 0x73, 0xfb, 0xd0, 0x3e, 0x23, 0xb2, 0x3c, 0x93, 0x65, 0xec, 0xef, 0x16,
+	0xbc, 0x5d, 0x02, 0x36, 0x9e, 0xff, 0x55, 0xde, 0x4b, 0x0b, 0x56, 0xf9, 0xe7, 0x0b, 0x56, 0x7d,
+	0x93, 0x05, 0x7b, 0x0e, 0x9d, 0x6f, 0x94, 0xf0, 0x7f, 0x8c, 0xe2, 0x11, 0x74, 0x4e, 0x49, 0x44,
+	0x24, 0xb9, 0x5d, 0x77, 0x7f, 0xb5, 0xc0, 0xc9, 0x88, 0xa1, 0x88, 0x16, 0x39, 0x7c, 0x07, 0x6a,
+	0x11, 0x8d, 0xa9, 0x54, 0x27, 0xaa, 0xbe, 0x16, 0xd0, 0x2e, 0xd8, 0x6c, 0x32, 0x11, 0x44, 0xb7,
+	0xac, 0xea, 0x1b, 0xe9, 0x8d, 0x48, 0xa3, 0x84, 0x04, 0x36, 0x4a, 0x89, 0x4c, 0x40, 0xa7, 0x34,
+	0x41, 0xb3, 0x00, 0x07, 0xd0, 0x90, 0x4c, 0xe2, 0x68, 0x14, 0xb0, 0x79, 0x92, 0xe7, 0x09, 0x4a,
+	0x75, 0x92, 0x69, 0xd0, 0x11, 0xd8, 0x9c, 0x88, 0x79, 0x94, 0x25, 0x9b, 0x31, 0x6e, 0xa7, 0xa4,
+	0x8b, 0x39, 0x35, 0xfa, 0x06, 0xea, 0xbe, 0xb0, 0xe0, 0xdd, 0x63, 0x21, 0xe8, 0x34, 0x29, 0x02,
+	0x9f, 0x31, 0x23, 0x2f, 0x3a, 0xf4, 0x01, 0xa0, 0xa5, 0x69, 0x8d, 0x16, 0x0d, 0xde, 0x2a, 0xce,
+	0xa6, 0xbc, 0xea, 0x4a, 0x29, 0xf5, 0xed, 0x82, 0x2d, 0x08, 0xe6, 0x81, 0x26, 0x84, 0xba, 0x6f,
+	0xa4, 0xac, 0xdc, 0x3c, 0x1c, 0x0d, 0xf5, 0x67, 0xa6, 0xee, 0x83, 0x51, 0x9d, 0x9f, 0x8a, 0xec,
+	0x3b, 0xf7, 0xde, 0xeb, 0x32, 0x37, 0xad, 0xdb, 0x07, 0xb8, 0xf6, 0x65, 0x52, 0xae, 0x2f, 0x5c,
+	0xa1, 0x43, 0xb8, 0x97, 0x9b, 0x6f, 0x7c, 0x1e, 0xf2, 0xf0, 0x8a, 0xc9, 0x77, 0xa0, 0x46, 0x38,
+	0x67, 0xdc, 0x24, 0xa9, 0x85, 0xac, 0xc8, 0x94, 0xb3, 0x80, 0x08, 0x41, 0x42, 0x33, 0x16, 0x33,
+	0xda, 0x85, 0x5a, 0x8f, 0xe6, 0x10, 0xee, 0x4d, 0x30, 0x8d, 0x16, 0xa8, 0x9a, 0x42, 0x35, 0xb4,
+	0x4e, 0x43, 0x96, 0xc6, 0x6b, 0x2f, 0x8f, 0x77, 0xf8, 0x57, 0x0d, 0x1e, 0x14, 0x2b, 0xcd, 0x56,
+	0x8c, 0x06, 0x04, 0x31, 0xb0, 0x35, 0x29, 0xa1, 0xae, 0x1a, 0xf9, 0x1a, 0xfa, 0x73, 0x0e, 0xd7,
+	0x20, 0x74, 0xb7, 0xdc, 0xee, 0xcf, 0x2f, 0xff, 0xf8, 0xa5, 0xe2, 0xb8, 0x0f, 0xd4, 0x13, 0xc3,
+	0x74, 0xe1, 0x91, 0x99, 0xb9, 0xf8, 0xc4, 0xea, 0xa3, 0x19, 0x54, 0xcf, 0x88, 0x44, 0xfb, 0x7a,
+	0xc1, 0x56, 0xf0, 0x9b, 0xf3, 0xce, 0x2a, 0xb3, 0x89, 0xe3, 0xaa, 0x38, 0x7b, 0xc8, 0x29, 0x8d,
+	0x33, 0xf8, 0x89, 0x86, 0x57, 0xe8, 0x12, 0x6c, 0xcd, 0x20, 0xa6, 0xb4, 0x35, 0x74, 0xe2, 0xec,
+	0xbe, 0x42, 0x4a, 0x4f, 0xb2, 0xd7, 0x94, 0xfb, 0x58, 0xc5, 0x19, 0x38, 0xfd, 0x15, 0x71, 0x96,
+	0xb6, 0xda, 0xa3, 0xe1, 0x55, 0x56, 0xe4, 0x04, 0x6c, 0xcd, 0x2f, 0x26, 0xf4, 0x1a, 0xb2, 0x59,
+	0x19, 0xda, 0x94, 0xd8, 0x5f, 0x57, 0xe2, 0x0c, 0x36, 0xb2, 0x5b, 0x89, 0xf4, 0x03, 0x69, 0x35,
+	0x45, 0x39, 0xdd, 0xd5, 0x00, 0xd3, 0xd1, 0x7d, 0x15, 0xee, 0x2d, 0x54, 0x3e, 0x39, 0xf4, 0x9b,
+	0x05, 0x5b, 0xfa, 0xc6, 0x5c, 0xdf, 0x11, 0xd4, 0x57, 0x5e, 0x6f, 0x45, 0x01, 0xce, 0xc3, 0x5b,
+	0x61, 0x4d, 0x32, 0x9f, 0xa9, 0x64, 0x3e, 0x72, 0x8f, 0x6e, 0xd7, 0xf6, 0x11, 0x0d, 0xaf, 0x72,
+	0x54, 0xb6, 0x64, 0x1f, 0x5a, 0x63, 0x5b, 0x75, 0xf3, 0xe8, 0xef, 0x01, 0x00, 0x4d, 0xb0, 0xc3,
+	0x27, 0x80, 0x0b, 0x00, 0x00,
 }
@@ -30,7 +30,10 @@ protoc -I../vendor -I/usr/local/include -I. ${GOPATHLIST} --go_out=plugins=grpc:
     deviceProfile.proto \
     gatewayProfile.proto \
     multicastGroup.proto \
-    internal.proto
+    registration.proto \
+    event.proto \
+    internal.proto \
+    plugin.proto
 
 # generate the JSON interface code
 protoc -I../vendor -I/usr/local/include -I. ${GOPATHLIST} --grpc-gateway_out=logtostderr=true:. \
@@ -47,6 +50,8 @@ protoc -I../vendor -I/usr/local/include -I. ${GOPATHLIST} --grpc-gateway_out=log
     deviceProfile.proto \
     gatewayProfile.proto \
     multicastGroup.proto \
+    registration.proto \
+    event.proto \
     internal.proto
 
 # generate the swagger definitions
@@ -64,7 +69,12 @@ protoc -I../vendor -I/usr/local/include -I. ${GOPATHLIST} --swagger_out=logtostd
     deviceProfile.proto \
     gatewayProfile.proto \
     multicastGroup.proto \
+    registration.proto \
+    event.proto \
     internal.proto
 
 # merge the swagger code into one file
 go run swagger/main.go swagger > ../static/swagger/api.swagger.json
+
+# generate the OpenAPI v3 document
+go run swagger/main.go -openapi swagger > ../static/swagger/api.openapi.json
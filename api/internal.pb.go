@@ -26,6 +26,69 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type ResourceType int32
+
+const (
+	// No resource (global permissions).
+	ResourceType_NONE ResourceType = 0
+	// Organization (ID).
+	ResourceType_ORGANIZATION ResourceType = 1
+	// Application (ID).
+	ResourceType_APPLICATION ResourceType = 2
+	// Device (DevEUI).
+	ResourceType_DEVICE ResourceType = 3
+	// Gateway (MAC).
+	ResourceType_GATEWAY ResourceType = 4
+	// Multicast-group (UUID).
+	ResourceType_MULTICAST_GROUP ResourceType = 5
+	// Service-profile (UUID).
+	ResourceType_SERVICE_PROFILE ResourceType = 6
+	// Device-profile (UUID).
+	ResourceType_DEVICE_PROFILE ResourceType = 7
+	// Network-server (ID).
+	ResourceType_NETWORK_SERVER ResourceType = 8
+	// User (ID).
+	ResourceType_USER ResourceType = 9
+	// Gateway-profile (UUID).
+	ResourceType_GATEWAY_PROFILE ResourceType = 10
+)
+
+var ResourceType_name = map[int32]string{
+	0:  "NONE",
+	1:  "ORGANIZATION",
+	2:  "APPLICATION",
+	3:  "DEVICE",
+	4:  "GATEWAY",
+	5:  "MULTICAST_GROUP",
+	6:  "SERVICE_PROFILE",
+	7:  "DEVICE_PROFILE",
+	8:  "NETWORK_SERVER",
+	9:  "USER",
+	10: "GATEWAY_PROFILE",
+}
+
+var ResourceType_value = map[string]int32{
+	"NONE":            0,
+	"ORGANIZATION":    1,
+	"APPLICATION":     2,
+	"DEVICE":          3,
+	"GATEWAY":         4,
+	"MULTICAST_GROUP": 5,
+	"SERVICE_PROFILE": 6,
+	"DEVICE_PROFILE":  7,
+	"NETWORK_SERVER":  8,
+	"USER":            9,
+	"GATEWAY_PROFILE": 10,
+}
+
+func (x ResourceType) String() string {
+	return proto.EnumName(ResourceType_name, int32(x))
+}
+
+func (ResourceType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{0}
+}
+
 type ProfileSettings struct {
 	// Existing users in the system can not be assigned to organizations and
 	// application and can not be listed by non global admin users.
@@ -286,6 +349,118 @@ func (m *ProfileResponse) GetSettings() *ProfileSettings {
 	return nil
 }
 
+type WhoAmIRequest struct {
+	// Type of the resource to return the permissions for.
+	// When not set, the global permissions are returned.
+	ResourceType ResourceType `protobuf:"varint,1,opt,name=resource_type,json=resourceType,proto3,enum=api.ResourceType" json:"resource_type,omitempty"`
+	// ID of the resource (e.g. the application ID, the DevEUI of a device
+	// or the MAC of a gateway).
+	ResourceId           string   `protobuf:"bytes,2,opt,name=resource_id,json=resourceID,proto3" json:"resource_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WhoAmIRequest) Reset()         { *m = WhoAmIRequest{} }
+func (m *WhoAmIRequest) String() string { return proto.CompactTextString(m) }
+func (*WhoAmIRequest) ProtoMessage()    {}
+func (*WhoAmIRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{5}
+}
+func (m *WhoAmIRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WhoAmIRequest.Unmarshal(m, b)
+}
+func (m *WhoAmIRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WhoAmIRequest.Marshal(b, m, deterministic)
+}
+func (dst *WhoAmIRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WhoAmIRequest.Merge(dst, src)
+}
+func (m *WhoAmIRequest) XXX_Size() int {
+	return xxx_messageInfo_WhoAmIRequest.Size(m)
+}
+func (m *WhoAmIRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WhoAmIRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WhoAmIRequest proto.InternalMessageInfo
+
+func (m *WhoAmIRequest) GetResourceType() ResourceType {
+	if m != nil {
+		return m.ResourceType
+	}
+	return ResourceType_NONE
+}
+
+func (m *WhoAmIRequest) GetResourceId() string {
+	if m != nil {
+		return m.ResourceId
+	}
+	return ""
+}
+
+type WhoAmIResponse struct {
+	// User object.
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Organizations to which the user is associated (including the admin
+	// role within each organization).
+	Organizations []*OrganizationLink `protobuf:"bytes,2,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	// Permissions the user has on the given resource (or the global
+	// permissions when no resource was given).
+	// Permissions are either an action on the resource itself (e.g. "read",
+	// "update" or "delete") or an action on a collection within the
+	// resource (e.g. "devices:create").
+	Permissions          []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WhoAmIResponse) Reset()         { *m = WhoAmIResponse{} }
+func (m *WhoAmIResponse) String() string { return proto.CompactTextString(m) }
+func (*WhoAmIResponse) ProtoMessage()    {}
+func (*WhoAmIResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{6}
+}
+func (m *WhoAmIResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WhoAmIResponse.Unmarshal(m, b)
+}
+func (m *WhoAmIResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WhoAmIResponse.Marshal(b, m, deterministic)
+}
+func (dst *WhoAmIResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WhoAmIResponse.Merge(dst, src)
+}
+func (m *WhoAmIResponse) XXX_Size() int {
+	return xxx_messageInfo_WhoAmIResponse.Size(m)
+}
+func (m *WhoAmIResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WhoAmIResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WhoAmIResponse proto.InternalMessageInfo
+
+func (m *WhoAmIResponse) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (m *WhoAmIResponse) GetOrganizations() []*OrganizationLink {
+	if m != nil {
+		return m.Organizations
+	}
+	return nil
+}
+
+func (m *WhoAmIResponse) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
 type GlobalSearchRequest struct {
 	// Search query.
 	Search string `protobuf:"bytes,1,opt,name=search,proto3" json:"search,omitempty"`
@@ -302,7 +477,7 @@ func (m *GlobalSearchRequest) Reset()         { *m = GlobalSearchRequest{} }
 func (m *GlobalSearchRequest) String() string { return proto.CompactTextString(m) }
 func (*GlobalSearchRequest) ProtoMessage()    {}
 func (*GlobalSearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{5}
+	return fileDescriptor_41f4a519b878ee3b, []int{7}
 }
 func (m *GlobalSearchRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GlobalSearchRequest.Unmarshal(m, b)
@@ -354,7 +529,7 @@ func (m *GlobalSearchResponse) Reset()         { *m = GlobalSearchResponse{} }
 func (m *GlobalSearchResponse) String() string { return proto.CompactTextString(m) }
 func (*GlobalSearchResponse) ProtoMessage()    {}
 func (*GlobalSearchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{6}
+	return fileDescriptor_41f4a519b878ee3b, []int{8}
 }
 func (m *GlobalSearchResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GlobalSearchResponse.Unmarshal(m, b)
@@ -411,7 +586,7 @@ func (m *GlobalSearchResult) Reset()         { *m = GlobalSearchResult{} }
 func (m *GlobalSearchResult) String() string { return proto.CompactTextString(m) }
 func (*GlobalSearchResult) ProtoMessage()    {}
 func (*GlobalSearchResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{7}
+	return fileDescriptor_41f4a519b878ee3b, []int{9}
 }
 func (m *GlobalSearchResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GlobalSearchResult.Unmarshal(m, b)
@@ -517,7 +692,7 @@ func (m *BrandingResponse) Reset()         { *m = BrandingResponse{} }
 func (m *BrandingResponse) String() string { return proto.CompactTextString(m) }
 func (*BrandingResponse) ProtoMessage()    {}
 func (*BrandingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{8}
+	return fileDescriptor_41f4a519b878ee3b, []int{10}
 }
 func (m *BrandingResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BrandingResponse.Unmarshal(m, b)
@@ -558,184 +733,1148 @@ func (m *BrandingResponse) GetFooter() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*ProfileSettings)(nil), "api.ProfileSettings")
-	proto.RegisterType((*OrganizationLink)(nil), "api.OrganizationLink")
-	proto.RegisterType((*LoginRequest)(nil), "api.LoginRequest")
-	proto.RegisterType((*LoginResponse)(nil), "api.LoginResponse")
-	proto.RegisterType((*ProfileResponse)(nil), "api.ProfileResponse")
-	proto.RegisterType((*GlobalSearchRequest)(nil), "api.GlobalSearchRequest")
-	proto.RegisterType((*GlobalSearchResponse)(nil), "api.GlobalSearchResponse")
-	proto.RegisterType((*GlobalSearchResult)(nil), "api.GlobalSearchResult")
-	proto.RegisterType((*BrandingResponse)(nil), "api.BrandingResponse")
+type ListSecurityEventsRequest struct {
+	// Max number of events to return in the result-set.
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Event type to filter on (optional).
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// Device EUI (HEX encoded) to filter on (optional).
+	DevEui               string   `protobuf:"bytes,4,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// InternalServiceClient is the client API for InternalService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type InternalServiceClient interface {
-	// Log in a user
-	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
-	// Get the current user's profile
-	Profile(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ProfileResponse, error)
-	// Get the branding for the UI
-	Branding(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BrandingResponse, error)
-	// Perform a global search.
-	GlobalSearch(ctx context.Context, in *GlobalSearchRequest, opts ...grpc.CallOption) (*GlobalSearchResponse, error)
+func (m *ListSecurityEventsRequest) Reset()         { *m = ListSecurityEventsRequest{} }
+func (m *ListSecurityEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSecurityEventsRequest) ProtoMessage()    {}
+func (*ListSecurityEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{11}
 }
-
-type internalServiceClient struct {
-	cc *grpc.ClientConn
+func (m *ListSecurityEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSecurityEventsRequest.Unmarshal(m, b)
 }
-
-func NewInternalServiceClient(cc *grpc.ClientConn) InternalServiceClient {
-	return &internalServiceClient{cc}
+func (m *ListSecurityEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSecurityEventsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListSecurityEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSecurityEventsRequest.Merge(dst, src)
+}
+func (m *ListSecurityEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSecurityEventsRequest.Size(m)
+}
+func (m *ListSecurityEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSecurityEventsRequest.DiscardUnknown(m)
 }
 
-func (c *internalServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
-	out := new(LoginResponse)
-	err := c.cc.Invoke(ctx, "/api.InternalService/Login", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListSecurityEventsRequest proto.InternalMessageInfo
+
+func (m *ListSecurityEventsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
 	}
-	return out, nil
+	return 0
 }
 
-func (c *internalServiceClient) Profile(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ProfileResponse, error) {
-	out := new(ProfileResponse)
-	err := c.cc.Invoke(ctx, "/api.InternalService/Profile", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListSecurityEventsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
 	}
-	return out, nil
+	return 0
 }
 
-func (c *internalServiceClient) Branding(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BrandingResponse, error) {
-	out := new(BrandingResponse)
-	err := c.cc.Invoke(ctx, "/api.InternalService/Branding", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListSecurityEventsRequest) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return out, nil
+	return ""
 }
 
-func (c *internalServiceClient) GlobalSearch(ctx context.Context, in *GlobalSearchRequest, opts ...grpc.CallOption) (*GlobalSearchResponse, error) {
-	out := new(GlobalSearchResponse)
-	err := c.cc.Invoke(ctx, "/api.InternalService/GlobalSearch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListSecurityEventsRequest) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
 	}
-	return out, nil
+	return ""
 }
 
-// InternalServiceServer is the server API for InternalService service.
-type InternalServiceServer interface {
-	// Log in a user
-	Login(context.Context, *LoginRequest) (*LoginResponse, error)
-	// Get the current user's profile
-	Profile(context.Context, *empty.Empty) (*ProfileResponse, error)
-	// Get the branding for the UI
-	Branding(context.Context, *empty.Empty) (*BrandingResponse, error)
-	// Perform a global search.
-	GlobalSearch(context.Context, *GlobalSearchRequest) (*GlobalSearchResponse, error)
+type SecurityEvent struct {
+	// Event ID.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Event type.
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// Severity (0 - 10).
+	Severity uint32 `protobuf:"varint,4,opt,name=severity,proto3" json:"severity,omitempty"`
+	// Username (if any).
+	Username string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	// Remote address (if any).
+	RemoteAddr string `protobuf:"bytes,6,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
+	// Device EUI (HEX encoded, if any).
+	DevEui string `protobuf:"bytes,7,opt,name=dev_eui,json=devEUI,proto3" json:"dev_eui,omitempty"`
+	// Description.
+	Description          string   `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func RegisterInternalServiceServer(s *grpc.Server, srv InternalServiceServer) {
-	s.RegisterService(&_InternalService_serviceDesc, srv)
+func (m *SecurityEvent) Reset()         { *m = SecurityEvent{} }
+func (m *SecurityEvent) String() string { return proto.CompactTextString(m) }
+func (*SecurityEvent) ProtoMessage()    {}
+func (*SecurityEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{12}
+}
+func (m *SecurityEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SecurityEvent.Unmarshal(m, b)
+}
+func (m *SecurityEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SecurityEvent.Marshal(b, m, deterministic)
+}
+func (dst *SecurityEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecurityEvent.Merge(dst, src)
+}
+func (m *SecurityEvent) XXX_Size() int {
+	return xxx_messageInfo_SecurityEvent.Size(m)
+}
+func (m *SecurityEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecurityEvent.DiscardUnknown(m)
 }
 
-func _InternalService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(InternalServiceServer).Login(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.InternalService/Login",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InternalServiceServer).Login(ctx, req.(*LoginRequest))
+var xxx_messageInfo_SecurityEvent proto.InternalMessageInfo
+
+func (m *SecurityEvent) GetId() int64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _InternalService_Profile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(InternalServiceServer).Profile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.InternalService/Profile",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InternalServiceServer).Profile(ctx, req.(*empty.Empty))
+func (m *SecurityEvent) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _InternalService_Branding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(InternalServiceServer).Branding(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.InternalService/Branding",
+func (m *SecurityEvent) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InternalServiceServer).Branding(ctx, req.(*empty.Empty))
+	return ""
+}
+
+func (m *SecurityEvent) GetSeverity() uint32 {
+	if m != nil {
+		return m.Severity
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _InternalService_GlobalSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GlobalSearchRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *SecurityEvent) GetUsername() string {
+	if m != nil {
+		return m.Username
 	}
-	if interceptor == nil {
-		return srv.(InternalServiceServer).GlobalSearch(ctx, in)
+	return ""
+}
+
+func (m *SecurityEvent) GetRemoteAddr() string {
+	if m != nil {
+		return m.RemoteAddr
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.InternalService/GlobalSearch",
+	return ""
+}
+
+func (m *SecurityEvent) GetDevEui() string {
+	if m != nil {
+		return m.DevEui
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InternalServiceServer).GlobalSearch(ctx, req.(*GlobalSearchRequest))
+	return ""
+}
+
+func (m *SecurityEvent) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _InternalService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "api.InternalService",
-	HandlerType: (*InternalServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Login",
-			Handler:    _InternalService_Login_Handler,
-		},
+type ListSecurityEventsResponse struct {
+	// Total number of security events.
+	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Result-set.
+	Result               []*SecurityEvent `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListSecurityEventsResponse) Reset()         { *m = ListSecurityEventsResponse{} }
+func (m *ListSecurityEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSecurityEventsResponse) ProtoMessage()    {}
+func (*ListSecurityEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{13}
+}
+func (m *ListSecurityEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSecurityEventsResponse.Unmarshal(m, b)
+}
+func (m *ListSecurityEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSecurityEventsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListSecurityEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSecurityEventsResponse.Merge(dst, src)
+}
+func (m *ListSecurityEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSecurityEventsResponse.Size(m)
+}
+func (m *ListSecurityEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSecurityEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSecurityEventsResponse proto.InternalMessageInfo
+
+func (m *ListSecurityEventsResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListSecurityEventsResponse) GetResult() []*SecurityEvent {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type GetStorageUsageRequest struct {
+	// Max number of organizations to return, ordered by size (0 = all).
+	Limit                int64    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetStorageUsageRequest) Reset()         { *m = GetStorageUsageRequest{} }
+func (m *GetStorageUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStorageUsageRequest) ProtoMessage()    {}
+func (*GetStorageUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{14}
+}
+func (m *GetStorageUsageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStorageUsageRequest.Unmarshal(m, b)
+}
+func (m *GetStorageUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStorageUsageRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetStorageUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStorageUsageRequest.Merge(dst, src)
+}
+func (m *GetStorageUsageRequest) XXX_Size() int {
+	return xxx_messageInfo_GetStorageUsageRequest.Size(m)
+}
+func (m *GetStorageUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStorageUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStorageUsageRequest proto.InternalMessageInfo
+
+func (m *GetStorageUsageRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type TableStorageUsage struct {
+	// Table name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of rows.
+	// For the tables of the response, this is an estimate of the PostgreSQL
+	// statistics collector.
+	RowCount int64 `protobuf:"varint,2,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	// Size (in bytes).
+	// For the tables of the response, this includes the indices. For the
+	// tables of an organization, this is approximated using the average
+	// row size of the table.
+	Size                 int64    `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TableStorageUsage) Reset()         { *m = TableStorageUsage{} }
+func (m *TableStorageUsage) String() string { return proto.CompactTextString(m) }
+func (*TableStorageUsage) ProtoMessage()    {}
+func (*TableStorageUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{15}
+}
+func (m *TableStorageUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TableStorageUsage.Unmarshal(m, b)
+}
+func (m *TableStorageUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TableStorageUsage.Marshal(b, m, deterministic)
+}
+func (dst *TableStorageUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TableStorageUsage.Merge(dst, src)
+}
+func (m *TableStorageUsage) XXX_Size() int {
+	return xxx_messageInfo_TableStorageUsage.Size(m)
+}
+func (m *TableStorageUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TableStorageUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TableStorageUsage proto.InternalMessageInfo
+
+func (m *TableStorageUsage) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TableStorageUsage) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *TableStorageUsage) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type OrganizationStorageUsageReport struct {
+	// Organization ID.
+	OrganizationId int64 `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Organization name.
+	OrganizationName string `protobuf:"bytes,2,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	// Approximate size (in bytes).
+	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// Storage usage per table.
+	Tables               []*TableStorageUsage `protobuf:"bytes,4,rep,name=tables,proto3" json:"tables,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *OrganizationStorageUsageReport) Reset()         { *m = OrganizationStorageUsageReport{} }
+func (m *OrganizationStorageUsageReport) String() string { return proto.CompactTextString(m) }
+func (*OrganizationStorageUsageReport) ProtoMessage()    {}
+func (*OrganizationStorageUsageReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{16}
+}
+func (m *OrganizationStorageUsageReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrganizationStorageUsageReport.Unmarshal(m, b)
+}
+func (m *OrganizationStorageUsageReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrganizationStorageUsageReport.Marshal(b, m, deterministic)
+}
+func (dst *OrganizationStorageUsageReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationStorageUsageReport.Merge(dst, src)
+}
+func (m *OrganizationStorageUsageReport) XXX_Size() int {
+	return xxx_messageInfo_OrganizationStorageUsageReport.Size(m)
+}
+func (m *OrganizationStorageUsageReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrganizationStorageUsageReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrganizationStorageUsageReport proto.InternalMessageInfo
+
+func (m *OrganizationStorageUsageReport) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *OrganizationStorageUsageReport) GetOrganizationName() string {
+	if m != nil {
+		return m.OrganizationName
+	}
+	return ""
+}
+
+func (m *OrganizationStorageUsageReport) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *OrganizationStorageUsageReport) GetTables() []*TableStorageUsage {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+type GetStorageUsageResponse struct {
+	// Storage usage per table, ordered by size.
+	Tables []*TableStorageUsage `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	// Storage usage per organization, ordered by size.
+	Organizations        []*OrganizationStorageUsageReport `protobuf:"bytes,2,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *GetStorageUsageResponse) Reset()         { *m = GetStorageUsageResponse{} }
+func (m *GetStorageUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStorageUsageResponse) ProtoMessage()    {}
+func (*GetStorageUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{17}
+}
+func (m *GetStorageUsageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStorageUsageResponse.Unmarshal(m, b)
+}
+func (m *GetStorageUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStorageUsageResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetStorageUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStorageUsageResponse.Merge(dst, src)
+}
+func (m *GetStorageUsageResponse) XXX_Size() int {
+	return xxx_messageInfo_GetStorageUsageResponse.Size(m)
+}
+func (m *GetStorageUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStorageUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStorageUsageResponse proto.InternalMessageInfo
+
+func (m *GetStorageUsageResponse) GetTables() []*TableStorageUsage {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
+func (m *GetStorageUsageResponse) GetOrganizations() []*OrganizationStorageUsageReport {
+	if m != nil {
+		return m.Organizations
+	}
+	return nil
+}
+
+type GetReadOnlyModeResponse struct {
+	// Read-only mode is enabled.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Reason (e.g. database maintenance).
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Timestamp since when the read-only mode is enabled.
+	Since                *timestamp.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetReadOnlyModeResponse) Reset()         { *m = GetReadOnlyModeResponse{} }
+func (m *GetReadOnlyModeResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReadOnlyModeResponse) ProtoMessage()    {}
+func (*GetReadOnlyModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{18}
+}
+func (m *GetReadOnlyModeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetReadOnlyModeResponse.Unmarshal(m, b)
+}
+func (m *GetReadOnlyModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetReadOnlyModeResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetReadOnlyModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReadOnlyModeResponse.Merge(dst, src)
+}
+func (m *GetReadOnlyModeResponse) XXX_Size() int {
+	return xxx_messageInfo_GetReadOnlyModeResponse.Size(m)
+}
+func (m *GetReadOnlyModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReadOnlyModeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReadOnlyModeResponse proto.InternalMessageInfo
+
+func (m *GetReadOnlyModeResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *GetReadOnlyModeResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *GetReadOnlyModeResponse) GetSince() *timestamp.Timestamp {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+type SetReadOnlyModeRequest struct {
+	// Enable the read-only mode.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Reason (e.g. database maintenance, optional).
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetReadOnlyModeRequest) Reset()         { *m = SetReadOnlyModeRequest{} }
+func (m *SetReadOnlyModeRequest) String() string { return proto.CompactTextString(m) }
+func (*SetReadOnlyModeRequest) ProtoMessage()    {}
+func (*SetReadOnlyModeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{19}
+}
+func (m *SetReadOnlyModeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetReadOnlyModeRequest.Unmarshal(m, b)
+}
+func (m *SetReadOnlyModeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetReadOnlyModeRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetReadOnlyModeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetReadOnlyModeRequest.Merge(dst, src)
+}
+func (m *SetReadOnlyModeRequest) XXX_Size() int {
+	return xxx_messageInfo_SetReadOnlyModeRequest.Size(m)
+}
+func (m *SetReadOnlyModeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetReadOnlyModeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetReadOnlyModeRequest proto.InternalMessageInfo
+
+func (m *SetReadOnlyModeRequest) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *SetReadOnlyModeRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type Certificate struct {
+	// Certificate name (e.g. the configuration option).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Expiry of the certificate.
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// The certificate expires within the configured warning threshold.
+	ExpiresSoon bool `protobuf:"varint,3,opt,name=expires_soon,json=expiresSoon,proto3" json:"expires_soon,omitempty"`
+	// The certificate has expired.
+	Expired              bool     `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Certificate) Reset()         { *m = Certificate{} }
+func (m *Certificate) String() string { return proto.CompactTextString(m) }
+func (*Certificate) ProtoMessage()    {}
+func (*Certificate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{20}
+}
+func (m *Certificate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Certificate.Unmarshal(m, b)
+}
+func (m *Certificate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Certificate.Marshal(b, m, deterministic)
+}
+func (dst *Certificate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Certificate.Merge(dst, src)
+}
+func (m *Certificate) XXX_Size() int {
+	return xxx_messageInfo_Certificate.Size(m)
+}
+func (m *Certificate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Certificate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Certificate proto.InternalMessageInfo
+
+func (m *Certificate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Certificate) GetExpiresAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *Certificate) GetExpiresSoon() bool {
+	if m != nil {
+		return m.ExpiresSoon
+	}
+	return false
+}
+
+func (m *Certificate) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
+}
+
+type ListCertificatesResponse struct {
+	// Configured certificates, ordered by expiry.
+	Result               []*Certificate `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListCertificatesResponse) Reset()         { *m = ListCertificatesResponse{} }
+func (m *ListCertificatesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCertificatesResponse) ProtoMessage()    {}
+func (*ListCertificatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{21}
+}
+func (m *ListCertificatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCertificatesResponse.Unmarshal(m, b)
+}
+func (m *ListCertificatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCertificatesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListCertificatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCertificatesResponse.Merge(dst, src)
+}
+func (m *ListCertificatesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCertificatesResponse.Size(m)
+}
+func (m *ListCertificatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCertificatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCertificatesResponse proto.InternalMessageInfo
+
+func (m *ListCertificatesResponse) GetResult() []*Certificate {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type GetBackPressureResponse struct {
+	// Uplinks are rejected because one of the thresholds is exceeded.
+	Overloaded bool `protobuf:"varint,1,opt,name=overloaded,proto3" json:"overloaded,omitempty"`
+	// Timestamp since when the thresholds are exceeded.
+	OverloadedSince *timestamp.Timestamp `protobuf:"bytes,2,opt,name=overloaded_since,json=overloadedSince,proto3" json:"overloaded_since,omitempty"`
+	// Usage (0 - 1) of the delivery queue.
+	QueueUsage float64 `protobuf:"fixed64,3,opt,name=queue_usage,json=queueUsage,proto3" json:"queue_usage,omitempty"`
+	// Number of events in the largest spool.
+	SpoolSize int64 `protobuf:"varint,4,opt,name=spool_size,json=spoolSize,proto3" json:"spool_size,omitempty"`
+	// Number of uplinks rejected since the start of this instance.
+	RejectedCount        int64    `protobuf:"varint,5,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBackPressureResponse) Reset()         { *m = GetBackPressureResponse{} }
+func (m *GetBackPressureResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBackPressureResponse) ProtoMessage()    {}
+func (*GetBackPressureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{22}
+}
+func (m *GetBackPressureResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBackPressureResponse.Unmarshal(m, b)
+}
+func (m *GetBackPressureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBackPressureResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetBackPressureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBackPressureResponse.Merge(dst, src)
+}
+func (m *GetBackPressureResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBackPressureResponse.Size(m)
+}
+func (m *GetBackPressureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBackPressureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBackPressureResponse proto.InternalMessageInfo
+
+func (m *GetBackPressureResponse) GetOverloaded() bool {
+	if m != nil {
+		return m.Overloaded
+	}
+	return false
+}
+
+func (m *GetBackPressureResponse) GetOverloadedSince() *timestamp.Timestamp {
+	if m != nil {
+		return m.OverloadedSince
+	}
+	return nil
+}
+
+func (m *GetBackPressureResponse) GetQueueUsage() float64 {
+	if m != nil {
+		return m.QueueUsage
+	}
+	return 0
+}
+
+func (m *GetBackPressureResponse) GetSpoolSize() int64 {
+	if m != nil {
+		return m.SpoolSize
+	}
+	return 0
+}
+
+func (m *GetBackPressureResponse) GetRejectedCount() int64 {
+	if m != nil {
+		return m.RejectedCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ProfileSettings)(nil), "api.ProfileSettings")
+	proto.RegisterType((*OrganizationLink)(nil), "api.OrganizationLink")
+	proto.RegisterType((*LoginRequest)(nil), "api.LoginRequest")
+	proto.RegisterType((*LoginResponse)(nil), "api.LoginResponse")
+	proto.RegisterType((*ProfileResponse)(nil), "api.ProfileResponse")
+	proto.RegisterType((*WhoAmIRequest)(nil), "api.WhoAmIRequest")
+	proto.RegisterType((*WhoAmIResponse)(nil), "api.WhoAmIResponse")
+	proto.RegisterType((*GlobalSearchRequest)(nil), "api.GlobalSearchRequest")
+	proto.RegisterType((*GlobalSearchResponse)(nil), "api.GlobalSearchResponse")
+	proto.RegisterType((*GlobalSearchResult)(nil), "api.GlobalSearchResult")
+	proto.RegisterType((*BrandingResponse)(nil), "api.BrandingResponse")
+	proto.RegisterType((*ListSecurityEventsRequest)(nil), "api.ListSecurityEventsRequest")
+	proto.RegisterType((*SecurityEvent)(nil), "api.SecurityEvent")
+	proto.RegisterType((*ListSecurityEventsResponse)(nil), "api.ListSecurityEventsResponse")
+	proto.RegisterType((*GetStorageUsageRequest)(nil), "api.GetStorageUsageRequest")
+	proto.RegisterType((*TableStorageUsage)(nil), "api.TableStorageUsage")
+	proto.RegisterType((*OrganizationStorageUsageReport)(nil), "api.OrganizationStorageUsageReport")
+	proto.RegisterType((*GetStorageUsageResponse)(nil), "api.GetStorageUsageResponse")
+	proto.RegisterType((*GetReadOnlyModeResponse)(nil), "api.GetReadOnlyModeResponse")
+	proto.RegisterType((*SetReadOnlyModeRequest)(nil), "api.SetReadOnlyModeRequest")
+	proto.RegisterType((*Certificate)(nil), "api.Certificate")
+	proto.RegisterType((*ListCertificatesResponse)(nil), "api.ListCertificatesResponse")
+	proto.RegisterType((*GetBackPressureResponse)(nil), "api.GetBackPressureResponse")
+	proto.RegisterEnum("api.ResourceType", ResourceType_name, ResourceType_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// InternalServiceClient is the client API for InternalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type InternalServiceClient interface {
+	// Log in a user
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// Get the current user's profile
+	Profile(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ProfileResponse, error)
+	// Get the authenticated user, its organization memberships and
+	// (optionally) its permissions on the given resource.
+	WhoAmI(ctx context.Context, in *WhoAmIRequest, opts ...grpc.CallOption) (*WhoAmIResponse, error)
+	// Get the branding for the UI
+	Branding(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BrandingResponse, error)
+	// Perform a global search.
+	GlobalSearch(ctx context.Context, in *GlobalSearchRequest, opts ...grpc.CallOption) (*GlobalSearchResponse, error)
+	// List the security events (global admin users only).
+	ListSecurityEvents(ctx context.Context, in *ListSecurityEventsRequest, opts ...grpc.CallOption) (*ListSecurityEventsResponse, error)
+	// Get the storage usage per table and per organization (global admin
+	// users only).
+	GetStorageUsage(ctx context.Context, in *GetStorageUsageRequest, opts ...grpc.CallOption) (*GetStorageUsageResponse, error)
+	// Get the read-only (maintenance) mode.
+	GetReadOnlyMode(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetReadOnlyModeResponse, error)
+	// Set the read-only (maintenance) mode (global admin users only).
+	// When enabled, all mutating API calls are rejected, while uplinks are
+	// still processed.
+	SetReadOnlyMode(ctx context.Context, in *SetReadOnlyModeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// List the configured TLS certificates and their expiry (global admin
+	// users only).
+	ListCertificates(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListCertificatesResponse, error)
+	// Get the back-pressure state of the integration delivery (global admin
+	// users only).
+	GetBackPressure(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetBackPressureResponse, error)
+}
+
+type internalServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewInternalServiceClient(cc *grpc.ClientConn) InternalServiceClient {
+	return &internalServiceClient{cc}
+}
+
+func (c *internalServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/api.InternalService/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalServiceClient) Profile(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ProfileResponse, error) {
+	out := new(ProfileResponse)
+	err := c.cc.Invoke(ctx, "/api.InternalService/Profile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalServiceClient) WhoAmI(ctx context.Context, in *WhoAmIRequest, opts ...grpc.CallOption) (*WhoAmIResponse, error) {
+	out := new(WhoAmIResponse)
+	err := c.cc.Invoke(ctx, "/api.InternalService/WhoAmI", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalServiceClient) Branding(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BrandingResponse, error) {
+	out := new(BrandingResponse)
+	err := c.cc.Invoke(ctx, "/api.InternalService/Branding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalServiceClient) GlobalSearch(ctx context.Context, in *GlobalSearchRequest, opts ...grpc.CallOption) (*GlobalSearchResponse, error) {
+	out := new(GlobalSearchResponse)
+	err := c.cc.Invoke(ctx, "/api.InternalService/GlobalSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalServiceClient) ListSecurityEvents(ctx context.Context, in *ListSecurityEventsRequest, opts ...grpc.CallOption) (*ListSecurityEventsResponse, error) {
+	out := new(ListSecurityEventsResponse)
+	err := c.cc.Invoke(ctx, "/api.InternalService/ListSecurityEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalServiceClient) GetStorageUsage(ctx context.Context, in *GetStorageUsageRequest, opts ...grpc.CallOption) (*GetStorageUsageResponse, error) {
+	out := new(GetStorageUsageResponse)
+	err := c.cc.Invoke(ctx, "/api.InternalService/GetStorageUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalServiceClient) GetReadOnlyMode(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetReadOnlyModeResponse, error) {
+	out := new(GetReadOnlyModeResponse)
+	err := c.cc.Invoke(ctx, "/api.InternalService/GetReadOnlyMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalServiceClient) SetReadOnlyMode(ctx context.Context, in *SetReadOnlyModeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.InternalService/SetReadOnlyMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalServiceClient) ListCertificates(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListCertificatesResponse, error) {
+	out := new(ListCertificatesResponse)
+	err := c.cc.Invoke(ctx, "/api.InternalService/ListCertificates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalServiceClient) GetBackPressure(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetBackPressureResponse, error) {
+	out := new(GetBackPressureResponse)
+	err := c.cc.Invoke(ctx, "/api.InternalService/GetBackPressure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InternalServiceServer is the server API for InternalService service.
+type InternalServiceServer interface {
+	// Log in a user
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// Get the current user's profile
+	Profile(context.Context, *empty.Empty) (*ProfileResponse, error)
+	// Get the authenticated user, its organization memberships and
+	// (optionally) its permissions on the given resource.
+	WhoAmI(context.Context, *WhoAmIRequest) (*WhoAmIResponse, error)
+	// Get the branding for the UI
+	Branding(context.Context, *empty.Empty) (*BrandingResponse, error)
+	// Perform a global search.
+	GlobalSearch(context.Context, *GlobalSearchRequest) (*GlobalSearchResponse, error)
+	// List the security events (global admin users only).
+	ListSecurityEvents(context.Context, *ListSecurityEventsRequest) (*ListSecurityEventsResponse, error)
+	// Get the storage usage per table and per organization (global admin
+	// users only).
+	GetStorageUsage(context.Context, *GetStorageUsageRequest) (*GetStorageUsageResponse, error)
+	// Get the read-only (maintenance) mode.
+	GetReadOnlyMode(context.Context, *empty.Empty) (*GetReadOnlyModeResponse, error)
+	// Set the read-only (maintenance) mode (global admin users only).
+	// When enabled, all mutating API calls are rejected, while uplinks are
+	// still processed.
+	SetReadOnlyMode(context.Context, *SetReadOnlyModeRequest) (*empty.Empty, error)
+	// List the configured TLS certificates and their expiry (global admin
+	// users only).
+	ListCertificates(context.Context, *empty.Empty) (*ListCertificatesResponse, error)
+	// Get the back-pressure state of the integration delivery (global admin
+	// users only).
+	GetBackPressure(context.Context, *empty.Empty) (*GetBackPressureResponse, error)
+}
+
+func RegisterInternalServiceServer(s *grpc.Server, srv InternalServiceServer) {
+	s.RegisterService(&_InternalService_serviceDesc, srv)
+}
+
+func _InternalService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.InternalService/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalService_Profile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServiceServer).Profile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.InternalService/Profile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServiceServer).Profile(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalService_WhoAmI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhoAmIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServiceServer).WhoAmI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.InternalService/WhoAmI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServiceServer).WhoAmI(ctx, req.(*WhoAmIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalService_Branding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServiceServer).Branding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.InternalService/Branding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServiceServer).Branding(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalService_GlobalSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GlobalSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServiceServer).GlobalSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.InternalService/GlobalSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServiceServer).GlobalSearch(ctx, req.(*GlobalSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalService_ListSecurityEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecurityEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServiceServer).ListSecurityEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.InternalService/ListSecurityEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServiceServer).ListSecurityEvents(ctx, req.(*ListSecurityEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalService_GetStorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServiceServer).GetStorageUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.InternalService/GetStorageUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServiceServer).GetStorageUsage(ctx, req.(*GetStorageUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalService_GetReadOnlyMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServiceServer).GetReadOnlyMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.InternalService/GetReadOnlyMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServiceServer).GetReadOnlyMode(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalService_SetReadOnlyMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReadOnlyModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServiceServer).SetReadOnlyMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.InternalService/SetReadOnlyMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServiceServer).SetReadOnlyMode(ctx, req.(*SetReadOnlyModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalService_ListCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServiceServer).ListCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.InternalService/ListCertificates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServiceServer).ListCertificates(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalService_GetBackPressure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServiceServer).GetBackPressure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.InternalService/GetBackPressure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServiceServer).GetBackPressure(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _InternalService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.InternalService",
+	HandlerType: (*InternalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Login",
+			Handler:    _InternalService_Login_Handler,
+		},
 		{
 			MethodName: "Profile",
 			Handler:    _InternalService_Profile_Handler,
 		},
+		{
+			MethodName: "WhoAmI",
+			Handler:    _InternalService_WhoAmI_Handler,
+		},
 		{
 			MethodName: "Branding",
 			Handler:    _InternalService_Branding_Handler,
@@ -744,6 +1883,30 @@ var _InternalService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GlobalSearch",
 			Handler:    _InternalService_GlobalSearch_Handler,
 		},
+		{
+			MethodName: "ListSecurityEvents",
+			Handler:    _InternalService_ListSecurityEvents_Handler,
+		},
+		{
+			MethodName: "GetStorageUsage",
+			Handler:    _InternalService_GetStorageUsage_Handler,
+		},
+		{
+			MethodName: "GetReadOnlyMode",
+			Handler:    _InternalService_GetReadOnlyMode_Handler,
+		},
+		{
+			MethodName: "SetReadOnlyMode",
+			Handler:    _InternalService_SetReadOnlyMode_Handler,
+		},
+		{
+			MethodName: "ListCertificates",
+			Handler:    _InternalService_ListCertificates_Handler,
+		},
+		{
+			MethodName: "GetBackPressure",
+			Handler:    _InternalService_GetBackPressure_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal.proto",
@@ -752,56 +1915,113 @@ var _InternalService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 810 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xd1, 0x8e, 0xdb, 0x44,
-	0x14, 0x86, 0xe5, 0x38, 0xbb, 0x9b, 0x9c, 0x64, 0x77, 0xd3, 0x69, 0x9a, 0xba, 0xa1, 0xcb, 0xa6,
-	0x16, 0x88, 0x00, 0x52, 0x82, 0xc2, 0x15, 0x70, 0x15, 0xd8, 0xa5, 0x8a, 0x54, 0x0a, 0xf2, 0xb6,
-	0x48, 0x88, 0x0b, 0x6b, 0x12, 0x4f, 0xcc, 0x50, 0x7b, 0xc6, 0x78, 0x26, 0xbb, 0x94, 0x4b, 0x5e,
-	0x81, 0x07, 0xe0, 0xa1, 0x78, 0x05, 0x5e, 0x80, 0x2b, 0xc4, 0x1d, 0x9a, 0xe3, 0xb1, 0x65, 0xa7,
-	0xbb, 0x82, 0x3b, 0x9f, 0x33, 0xdf, 0xfc, 0x3e, 0xf3, 0xcf, 0x39, 0x03, 0x27, 0x5c, 0x68, 0x96,
-	0x0b, 0x9a, 0xcc, 0xb2, 0x5c, 0x6a, 0x49, 0x5c, 0x9a, 0xf1, 0xf1, 0xe3, 0x58, 0xca, 0x38, 0x61,
-	0x73, 0x9a, 0xf1, 0x39, 0x15, 0x42, 0x6a, 0xaa, 0xb9, 0x14, 0xaa, 0x40, 0xc6, 0xe7, 0x76, 0x15,
-	0xa3, 0xf5, 0x6e, 0x3b, 0xd7, 0x3c, 0x65, 0x4a, 0xd3, 0x34, 0xb3, 0xc0, 0x5b, 0xfb, 0x00, 0x4b,
-	0x33, 0xfd, 0xda, 0x2e, 0xc2, 0x4e, 0xb1, 0xbc, 0xf8, 0xf6, 0x5f, 0xc0, 0xe9, 0x37, 0xb9, 0xdc,
-	0xf2, 0x84, 0x5d, 0x31, 0xad, 0xb9, 0x88, 0x15, 0x59, 0xc2, 0x59, 0xc4, 0x15, 0x5d, 0x27, 0x2c,
-	0xa4, 0x4a, 0xf1, 0x58, 0x84, 0xec, 0x67, 0xae, 0xcc, 0x5a, 0x68, 0x36, 0x2a, 0xcf, 0x99, 0x38,
-	0xd3, 0x4e, 0x30, 0xb6, 0xd0, 0x12, 0x99, 0x4b, 0x8b, 0xbc, 0x34, 0x84, 0xff, 0x8f, 0x03, 0x83,
-	0xaf, 0xf3, 0x98, 0x0a, 0xfe, 0x0b, 0xd6, 0xfd, 0x8c, 0x8b, 0x57, 0xe4, 0x3d, 0x38, 0x95, 0xb5,
-	0x5c, 0xc8, 0x23, 0x54, 0x72, 0x83, 0x93, 0x7a, 0x7a, 0x75, 0x41, 0x3e, 0x84, 0x7b, 0x0d, 0x50,
-	0xd0, 0x94, 0x79, 0xad, 0x89, 0x33, 0xed, 0x06, 0x83, 0xfa, 0xc2, 0x73, 0x9a, 0x32, 0xf2, 0x08,
-	0x3a, 0x5c, 0x85, 0x34, 0x4a, 0xb9, 0xf0, 0x5c, 0x2c, 0xec, 0x88, 0xab, 0xa5, 0x09, 0xc9, 0x27,
-	0x00, 0x9b, 0x9c, 0x51, 0xcd, 0xa2, 0x90, 0x6a, 0xaf, 0x3d, 0x71, 0xa6, 0xbd, 0xc5, 0x78, 0x56,
-	0x38, 0x33, 0x2b, 0x9d, 0x99, 0xbd, 0x28, 0xad, 0x0b, 0xba, 0x96, 0x5e, 0x6a, 0xb3, 0x75, 0x97,
-	0x45, 0xe5, 0xd6, 0x83, 0xff, 0xde, 0x6a, 0xe9, 0xa5, 0xf6, 0xbf, 0x84, 0xfe, 0x33, 0x19, 0x73,
-	0x11, 0xb0, 0x9f, 0x76, 0x4c, 0x69, 0x32, 0x86, 0x8e, 0xb1, 0x0d, 0x0f, 0xe1, 0xe0, 0x21, 0xaa,
-	0xd8, 0xac, 0x65, 0x54, 0xa9, 0x1b, 0x99, 0x47, 0xf6, 0x80, 0x55, 0xec, 0x3f, 0x81, 0x63, 0xab,
-	0xa3, 0x32, 0x29, 0x14, 0x23, 0x03, 0x70, 0x7f, 0xbc, 0xd1, 0x56, 0xc3, 0x7c, 0xfa, 0xbf, 0x3b,
-	0xd5, 0xed, 0x55, 0xd4, 0x19, 0xb4, 0x8d, 0x3c, 0x62, 0xbd, 0x45, 0x77, 0x46, 0x33, 0x3e, 0x33,
-	0x97, 0x12, 0x60, 0x9a, 0x7c, 0x06, 0xc7, 0x75, 0x0b, 0x95, 0xe7, 0x4e, 0xdc, 0x69, 0x6f, 0xf1,
-	0x00, 0xb9, 0xfd, 0x2b, 0x0b, 0x9a, 0x2c, 0xf9, 0x08, 0x3a, 0xca, 0x76, 0x89, 0xb5, 0x73, 0x88,
-	0xfb, 0xf6, 0x3a, 0x28, 0xa8, 0x28, 0xff, 0x7b, 0xb8, 0xff, 0x34, 0x91, 0x6b, 0x9a, 0x5c, 0x31,
-	0x9a, 0x6f, 0x7e, 0x28, 0x3d, 0x19, 0xc1, 0xa1, 0xc2, 0x84, 0x3d, 0x8d, 0x8d, 0xc8, 0x10, 0x0e,
-	0x12, 0x9e, 0x72, 0x8d, 0x66, 0xb8, 0x41, 0x11, 0x18, 0x5a, 0x6e, 0xb7, 0x8a, 0x69, 0xbc, 0x60,
-	0x37, 0xb0, 0x91, 0xff, 0x14, 0x86, 0x4d, 0x71, 0x6b, 0xc1, 0x1c, 0x0e, 0x73, 0xa6, 0x76, 0x89,
-	0xf1, 0xca, 0x1c, 0xee, 0x21, 0x16, 0xb9, 0x87, 0xee, 0x12, 0x1d, 0x58, 0xcc, 0xff, 0xab, 0x05,
-	0xe4, 0xcd, 0x65, 0x42, 0xa0, 0xfd, 0x8a, 0x8b, 0xc8, 0xd6, 0x88, 0xdf, 0xa6, 0x42, 0xb5, 0x91,
-	0x79, 0xd1, 0x8f, 0xad, 0xa0, 0x08, 0x6e, 0x6b, 0x6d, 0xf7, 0xff, 0xb7, 0x76, 0xfb, 0x8e, 0xd6,
-	0x7e, 0x17, 0x4e, 0x68, 0x96, 0x25, 0x7c, 0x53, 0x89, 0x1e, 0xa0, 0xe8, 0x71, 0x2d, 0xbb, 0xba,
-	0x20, 0xef, 0xc3, 0xa0, 0x8e, 0xa1, 0xe4, 0x21, 0x4a, 0x9e, 0xd6, 0xf2, 0xa8, 0xf8, 0x0e, 0x9c,
-	0x44, 0xec, 0x9a, 0x6f, 0x58, 0x18, 0xb1, 0xeb, 0x90, 0xed, 0xb8, 0x77, 0x84, 0x60, 0xbf, 0xc8,
-	0x5e, 0xb0, 0xeb, 0xcb, 0x97, 0x2b, 0x72, 0x0e, 0x3d, 0x4b, 0xa1, 0x56, 0x07, 0x11, 0x28, 0x52,
-	0x28, 0x73, 0x0e, 0xbd, 0x98, 0x6a, 0x76, 0x43, 0x5f, 0x87, 0x29, 0xdd, 0x78, 0xdd, 0x02, 0xb0,
-	0xa9, 0xaf, 0x96, 0x5f, 0x90, 0x27, 0xd0, 0x2f, 0x01, 0x94, 0x00, 0x24, 0xca, 0x4d, 0x46, 0xc3,
-	0x5f, 0xc3, 0xe0, 0xf3, 0x9c, 0x8a, 0x88, 0x8b, 0xb8, 0xba, 0x38, 0x02, 0xed, 0x44, 0xc6, 0xb2,
-	0x34, 0xdc, 0x7c, 0x13, 0x1f, 0xfa, 0x39, 0x8b, 0xb9, 0xd2, 0x39, 0x1e, 0xc3, 0x8e, 0x49, 0x23,
-	0x67, 0x1a, 0x64, 0x2b, 0xa5, 0x66, 0x39, 0xba, 0xde, 0x0d, 0x6c, 0xb4, 0xf8, 0xbb, 0x05, 0xa7,
-	0x2b, 0xfb, 0xb8, 0x5e, 0xb1, 0xdc, 0xd4, 0x4f, 0x9e, 0xc3, 0x01, 0x8e, 0x15, 0xb9, 0x87, 0x5d,
-	0x51, 0x1f, 0xd5, 0x31, 0xa9, 0xa7, 0x8a, 0x9a, 0xfc, 0xb7, 0x7f, 0xfd, 0xe3, 0xcf, 0xdf, 0x5a,
-	0x9e, 0x7f, 0x1f, 0x9f, 0xe2, 0xf2, 0xa9, 0x9e, 0x27, 0x06, 0xfa, 0xd4, 0xf9, 0x80, 0x7c, 0x0b,
-	0x47, 0xb6, 0xfd, 0xc9, 0xe8, 0x8d, 0x07, 0xe2, 0xd2, 0xbc, 0xba, 0xe3, 0xc6, 0x90, 0x54, 0xc2,
-	0x67, 0x28, 0xfc, 0x90, 0x3c, 0x68, 0x0a, 0x67, 0x56, 0xec, 0x3b, 0xe8, 0x94, 0xfe, 0xdc, 0x29,
-	0x5c, 0x4c, 0xed, 0xbe, 0x8d, 0x65, 0xc9, 0x64, 0xd4, 0x54, 0x5e, 0x97, 0x72, 0x14, 0xfa, 0xf5,
-	0x6e, 0x27, 0xde, 0x2d, 0xf3, 0x51, 0x18, 0xf2, 0xe8, 0xb6, 0xc9, 0x29, 0x7e, 0xf2, 0x18, 0x7f,
-	0x32, 0x22, 0xc3, 0xe6, 0x4f, 0x8a, 0x41, 0x5e, 0x1f, 0x62, 0xa5, 0x1f, 0xff, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0x59, 0x32, 0x94, 0x5a, 0xdc, 0x06, 0x00, 0x00,
+	// 1714 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x73, 0x1b, 0x49,
+	0x15, 0x67, 0x24, 0x59, 0x96, 0x9e, 0x6c, 0x69, 0xd2, 0xc9, 0x3a, 0x8a, 0x12, 0xc7, 0xde, 0x59,
+	0x52, 0x98, 0x50, 0xb1, 0xb7, 0x4c, 0x15, 0x55, 0x0b, 0x27, 0xad, 0xad, 0x75, 0x09, 0x1c, 0xcb,
+	0x35, 0x92, 0x37, 0x2c, 0x1c, 0xa6, 0xda, 0x9a, 0xb6, 0xdc, 0xc9, 0x68, 0x7a, 0xd2, 0xdd, 0xb2,
+	0xd7, 0x5b, 0x70, 0xd9, 0x0f, 0xc0, 0x05, 0x2e, 0x9c, 0xf8, 0x1e, 0xdc, 0xf9, 0x04, 0x7c, 0x05,
+	0xaa, 0xb8, 0x70, 0xe1, 0xc8, 0x8d, 0xea, 0x3f, 0x33, 0x9e, 0x91, 0xa5, 0xfc, 0xa1, 0x8a, 0xdb,
+	0xbc, 0xd7, 0xbf, 0x7e, 0xff, 0xdf, 0xeb, 0x37, 0xd0, 0xa4, 0xb1, 0x24, 0x3c, 0xc6, 0xd1, 0x6e,
+	0xc2, 0x99, 0x64, 0xa8, 0x8c, 0x13, 0xda, 0x79, 0x32, 0x61, 0x6c, 0x12, 0x91, 0x3d, 0x9c, 0xd0,
+	0x3d, 0x1c, 0xc7, 0x4c, 0x62, 0x49, 0x59, 0x2c, 0x0c, 0xa4, 0xb3, 0x65, 0x4f, 0x35, 0x75, 0x3e,
+	0xbb, 0xd8, 0x93, 0x74, 0x4a, 0x84, 0xc4, 0xd3, 0xc4, 0x02, 0x1e, 0xcf, 0x03, 0xc8, 0x34, 0x91,
+	0x37, 0xf6, 0x10, 0x66, 0x82, 0x70, 0xf3, 0xed, 0x8d, 0xa0, 0x75, 0xca, 0xd9, 0x05, 0x8d, 0xc8,
+	0x90, 0x48, 0x49, 0xe3, 0x89, 0x40, 0x5d, 0xd8, 0x0c, 0xa9, 0xc0, 0xe7, 0x11, 0x09, 0xb0, 0x10,
+	0x74, 0x12, 0x07, 0xe4, 0x5b, 0x2a, 0xd4, 0x59, 0xa0, 0x2e, 0x8a, 0xb6, 0xb3, 0xed, 0xec, 0xd4,
+	0xfc, 0x8e, 0x05, 0x75, 0x35, 0xa6, 0x67, 0x21, 0x67, 0x0a, 0xe1, 0xfd, 0xc7, 0x01, 0x77, 0xc0,
+	0x27, 0x38, 0xa6, 0xdf, 0x69, 0xbb, 0x8f, 0x69, 0xfc, 0x06, 0xfd, 0x08, 0x5a, 0x2c, 0xc7, 0x0b,
+	0x68, 0xa8, 0x25, 0x95, 0xfd, 0x66, 0x9e, 0xdd, 0x3f, 0x44, 0x3f, 0x81, 0x7b, 0x05, 0x60, 0x8c,
+	0xa7, 0xa4, 0x5d, 0xda, 0x76, 0x76, 0xea, 0xbe, 0x9b, 0x3f, 0x38, 0xc1, 0x53, 0x82, 0x1e, 0x41,
+	0x8d, 0x8a, 0x00, 0x87, 0x53, 0x1a, 0xb7, 0xcb, 0xda, 0xb0, 0x55, 0x2a, 0xba, 0x8a, 0x44, 0x5f,
+	0x00, 0x8c, 0x39, 0xc1, 0x92, 0x84, 0x01, 0x96, 0xed, 0xca, 0xb6, 0xb3, 0xd3, 0xd8, 0xef, 0xec,
+	0x9a, 0xc8, 0xec, 0xa6, 0x91, 0xd9, 0x1d, 0xa5, 0xa1, 0xf3, 0xeb, 0x16, 0xdd, 0x95, 0xea, 0xea,
+	0x2c, 0x09, 0xd3, 0xab, 0x2b, 0xef, 0xbf, 0x6a, 0xd1, 0x5d, 0xe9, 0x7d, 0x05, 0x6b, 0xc7, 0x6c,
+	0x42, 0x63, 0x9f, 0xbc, 0x9d, 0x11, 0x21, 0x51, 0x07, 0x6a, 0x2a, 0x6c, 0xda, 0x09, 0x47, 0x3b,
+	0x91, 0xd1, 0xea, 0x2c, 0xc1, 0x42, 0x5c, 0x33, 0x1e, 0x5a, 0x07, 0x33, 0xda, 0xfb, 0x14, 0xd6,
+	0xad, 0x1c, 0x91, 0xb0, 0x58, 0x10, 0xe4, 0x42, 0xf9, 0xf5, 0xb5, 0xb4, 0x32, 0xd4, 0xa7, 0xf7,
+	0x17, 0x27, 0xcb, 0x5e, 0x86, 0xda, 0x84, 0x8a, 0x12, 0xaf, 0x61, 0x8d, 0xfd, 0xfa, 0x2e, 0x4e,
+	0xe8, 0xae, 0x4a, 0x8a, 0xaf, 0xd9, 0xe8, 0x17, 0xb0, 0x9e, 0x0f, 0xa1, 0x68, 0x97, 0xb7, 0xcb,
+	0x3b, 0x8d, 0xfd, 0x4f, 0x34, 0x6e, 0x3e, 0x65, 0x7e, 0x11, 0x8b, 0x3e, 0x87, 0x9a, 0xb0, 0x55,
+	0x62, 0xc3, 0xf9, 0x40, 0xdf, 0x9b, 0xab, 0x20, 0x3f, 0x43, 0x79, 0x97, 0xb0, 0xfe, 0xea, 0x92,
+	0x75, 0xa7, 0xfd, 0x34, 0x1a, 0x3f, 0x83, 0x75, 0x4e, 0x04, 0x9b, 0xf1, 0x31, 0x09, 0xe4, 0x4d,
+	0x62, 0x42, 0xd2, 0xdc, 0xbf, 0xa7, 0xe5, 0xf8, 0xf6, 0x64, 0x74, 0x93, 0x10, 0x7f, 0x8d, 0xe7,
+	0x28, 0xb4, 0x05, 0x8d, 0xec, 0x1e, 0x4d, 0x83, 0x05, 0x29, 0xab, 0x7f, 0xe8, 0xfd, 0xc1, 0x81,
+	0x66, 0xaa, 0xea, 0x7f, 0x0c, 0x45, 0xe9, 0x23, 0x42, 0xb1, 0x0d, 0x8d, 0x84, 0xf0, 0x29, 0x15,
+	0x22, 0x8b, 0x62, 0xdd, 0xcf, 0xb3, 0xbc, 0xdf, 0xc2, 0xfd, 0xa3, 0x88, 0x9d, 0xe3, 0x68, 0x48,
+	0x30, 0x1f, 0x5f, 0xa6, 0x01, 0xd8, 0x80, 0xaa, 0xd0, 0x0c, 0x9b, 0x48, 0x4b, 0xa1, 0x07, 0xb0,
+	0x12, 0xd1, 0x29, 0x95, 0xda, 0xb5, 0xb2, 0x6f, 0x08, 0x85, 0x66, 0x17, 0x17, 0x82, 0x48, 0x5d,
+	0xdb, 0x65, 0xdf, 0x52, 0xde, 0x11, 0x3c, 0x28, 0x0a, 0xb7, 0x2e, 0xef, 0x41, 0x95, 0x13, 0x31,
+	0x8b, 0x54, 0x99, 0x28, 0x67, 0x1e, 0x6a, 0x67, 0xe6, 0xa0, 0xb3, 0x48, 0xfa, 0x16, 0xe6, 0xfd,
+	0xbb, 0x04, 0xe8, 0xee, 0x31, 0x42, 0x50, 0x79, 0x43, 0xe3, 0xd0, 0xda, 0xa8, 0xbf, 0x95, 0x85,
+	0x62, 0xcc, 0xb8, 0x69, 0xc5, 0x92, 0x6f, 0x88, 0x45, 0x5d, 0x5d, 0xfe, 0xf0, 0xae, 0xae, 0x2c,
+	0xe9, 0xea, 0x67, 0xd0, 0xc4, 0x49, 0x12, 0xd1, 0x71, 0x26, 0x74, 0x45, 0x0b, 0x5d, 0xcf, 0x71,
+	0xfb, 0x87, 0xe8, 0xc7, 0xe0, 0xe6, 0x61, 0x5a, 0x64, 0x55, 0x8b, 0x6c, 0xe5, 0xf8, 0x5a, 0xe2,
+	0x0f, 0xa1, 0x19, 0x92, 0x2b, 0x3a, 0x26, 0x41, 0x48, 0xae, 0x02, 0x32, 0xa3, 0xed, 0x55, 0x0d,
+	0x5c, 0x33, 0xdc, 0x43, 0x72, 0xd5, 0x3b, 0xeb, 0xab, 0x32, 0xb3, 0x28, 0x2d, 0xab, 0x66, 0xca,
+	0xcc, 0xb0, 0xb4, 0x98, 0x2d, 0x68, 0x4c, 0xb0, 0x24, 0xd7, 0xf8, 0x26, 0x98, 0xe2, 0x71, 0xbb,
+	0x6e, 0x00, 0x96, 0xf5, 0xb2, 0x7b, 0x80, 0x3e, 0x85, 0xb5, 0x14, 0xa0, 0x45, 0x80, 0x46, 0xa4,
+	0x97, 0x94, 0x0c, 0xef, 0x1c, 0xdc, 0x2f, 0x39, 0x8e, 0x43, 0x1a, 0x4f, 0xb2, 0xc4, 0x21, 0xa8,
+	0x44, 0x6c, 0xc2, 0xd2, 0x80, 0xab, 0x6f, 0xe4, 0xc1, 0x1a, 0x27, 0x13, 0x2a, 0x24, 0xd7, 0x6e,
+	0xd8, 0xa2, 0x2f, 0xf0, 0x54, 0x81, 0x5c, 0x30, 0x26, 0x09, 0xd7, 0x51, 0xaf, 0xfb, 0x96, 0xf2,
+	0xae, 0xe0, 0xd1, 0x31, 0x15, 0x72, 0x48, 0xc6, 0x33, 0x4e, 0xe5, 0x4d, 0xef, 0x8a, 0xc4, 0x52,
+	0xa4, 0x35, 0x98, 0xd5, 0x9a, 0xb3, 0xb8, 0xd6, 0x4a, 0xf9, 0x5a, 0x53, 0xa6, 0xe9, 0x4e, 0x35,
+	0x0a, 0xf4, 0x37, 0x7a, 0x08, 0xab, 0x69, 0x18, 0x4d, 0x0a, 0xab, 0xa1, 0x0e, 0xa0, 0xf7, 0x7d,
+	0x09, 0xd6, 0x0b, 0x4a, 0x51, 0x13, 0x4a, 0xd9, 0xa4, 0x2f, 0xd1, 0x70, 0x6e, 0x2a, 0x97, 0x3e,
+	0x66, 0x2a, 0x2f, 0xb2, 0xa4, 0xa3, 0x66, 0xd2, 0x15, 0x51, 0xfa, 0xb4, 0x29, 0xeb, 0x7e, 0x46,
+	0x17, 0x46, 0xef, 0xca, 0xdc, 0xe8, 0xd5, 0x03, 0x65, 0xca, 0x24, 0x09, 0x70, 0x18, 0x72, 0x5b,
+	0x35, 0x60, 0x58, 0xdd, 0x30, 0xe4, 0x79, 0x17, 0x57, 0xf3, 0x2e, 0xaa, 0xd6, 0x0f, 0x89, 0x18,
+	0x73, 0x9a, 0xe8, 0xac, 0x98, 0x1a, 0xc9, 0xb3, 0x3c, 0x0a, 0x9d, 0x45, 0xc1, 0xb7, 0xa9, 0xde,
+	0x82, 0x86, 0x64, 0x12, 0x47, 0xc1, 0x98, 0xcd, 0xe2, 0x34, 0x07, 0xa0, 0x59, 0x07, 0x8a, 0x83,
+	0x9e, 0x67, 0x4d, 0x6c, 0x26, 0x12, 0xd2, 0x4d, 0x5c, 0x90, 0x96, 0xf5, 0xef, 0x2e, 0x6c, 0x1c,
+	0x11, 0x39, 0x94, 0x8c, 0xe3, 0x09, 0x39, 0x13, 0x78, 0x42, 0xde, 0x99, 0x64, 0xef, 0xd7, 0x70,
+	0x6f, 0xa4, 0x5e, 0xed, 0xfc, 0x0d, 0x15, 0xd7, 0xdc, 0xf3, 0xa4, 0xbf, 0xd1, 0x63, 0xa8, 0x73,
+	0x76, 0x6d, 0x6d, 0x34, 0x05, 0x51, 0xe3, 0xec, 0xda, 0x58, 0x88, 0xa0, 0x22, 0xe8, 0x77, 0xc4,
+	0x76, 0xba, 0xfe, 0xf6, 0xfe, 0xea, 0xc0, 0xd3, 0xfc, 0xd4, 0x2c, 0xda, 0x94, 0x30, 0x2e, 0xff,
+	0x4f, 0x1b, 0xc0, 0x02, 0x63, 0xd0, 0x2e, 0x54, 0xa5, 0x72, 0x53, 0xbd, 0x53, 0x2a, 0x84, 0x1b,
+	0x3a, 0x84, 0x77, 0x3c, 0xf7, 0x2d, 0xca, 0xfb, 0x93, 0x03, 0x0f, 0xef, 0xc4, 0xd1, 0xe6, 0xeb,
+	0x56, 0x96, 0xf3, 0x21, 0xb2, 0x50, 0x7f, 0xf1, 0xbb, 0xf2, 0xd9, 0x9d, 0x77, 0xe5, 0x6e, 0x84,
+	0xe6, 0x5e, 0x19, 0xef, 0xf7, 0xda, 0x2a, 0x9f, 0xe0, 0x70, 0x10, 0x47, 0x37, 0x2f, 0x59, 0x78,
+	0x6b, 0x55, 0x1b, 0x56, 0x49, 0xac, 0x14, 0x86, 0x76, 0x1f, 0x4b, 0x49, 0xd5, 0xc7, 0x9c, 0x60,
+	0x91, 0x0d, 0x0c, 0x4b, 0xa1, 0xcf, 0x61, 0x45, 0xd0, 0x78, 0x6c, 0x02, 0xf5, 0xee, 0x9e, 0x33,
+	0x40, 0xef, 0x97, 0xb0, 0x31, 0x9c, 0x57, 0x6f, 0x8a, 0xeb, 0xa3, 0xb5, 0x7b, 0x7f, 0x76, 0xa0,
+	0x71, 0x40, 0xb8, 0xa4, 0x17, 0x6a, 0x2a, 0x2f, 0xae, 0xb9, 0x2f, 0x00, 0xc8, 0xb7, 0x09, 0xe5,
+	0x44, 0x7c, 0xe0, 0x68, 0xb0, 0xe8, 0xae, 0x54, 0x63, 0x37, 0xbd, 0x2a, 0x18, 0x4b, 0x57, 0xc1,
+	0x86, 0xe5, 0x0d, 0x19, 0x8b, 0xb5, 0xcd, 0x9a, 0x0c, 0xdb, 0x15, 0x6b, 0xb3, 0x21, 0xbd, 0x43,
+	0x68, 0xab, 0x7e, 0xcd, 0x99, 0x77, 0xdb, 0xad, 0x3b, 0x73, 0x2f, 0xaa, 0xab, 0xd3, 0x98, 0x83,
+	0x66, 0xad, 0xf8, 0x4f, 0x53, 0x43, 0x5f, 0xe2, 0xf1, 0x9b, 0x53, 0x4e, 0x84, 0x98, 0xf1, 0xdb,
+	0x6c, 0x3d, 0x05, 0x60, 0x57, 0x84, 0x47, 0x0c, 0x87, 0x59, 0xc8, 0x72, 0x1c, 0xd4, 0x03, 0xf7,
+	0x96, 0x0a, 0x4c, 0x9a, 0xde, 0xef, 0x7f, 0xeb, 0xf6, 0xce, 0x50, 0x5d, 0x51, 0xa3, 0xe5, 0xed,
+	0x8c, 0xcc, 0x48, 0x30, 0x53, 0x35, 0xa5, 0x83, 0xe0, 0xf8, 0xa0, 0x59, 0xa6, 0xd3, 0x37, 0x01,
+	0x44, 0xc2, 0x58, 0x14, 0xe8, 0x8e, 0xa9, 0xe8, 0x8e, 0xa9, 0x6b, 0xce, 0x50, 0xb5, 0xcd, 0x33,
+	0x68, 0x72, 0xf2, 0x9a, 0x8c, 0xd5, 0x70, 0x36, 0x9d, 0x6f, 0x9f, 0xdd, 0x94, 0xab, 0xdb, 0xff,
+	0xf9, 0xdf, 0x1c, 0x58, 0xcb, 0xef, 0x6a, 0xa8, 0x06, 0x95, 0x93, 0xc1, 0x49, 0xcf, 0xfd, 0x01,
+	0x72, 0x61, 0x6d, 0xe0, 0x1f, 0x75, 0x4f, 0xfa, 0xbf, 0xe9, 0x8e, 0xfa, 0x83, 0x13, 0xd7, 0x41,
+	0x2d, 0x68, 0x74, 0x4f, 0x4f, 0x8f, 0xfb, 0x07, 0x86, 0x51, 0x42, 0x00, 0xd5, 0xc3, 0xde, 0xd7,
+	0xfd, 0x83, 0x9e, 0x5b, 0x46, 0x0d, 0x58, 0x3d, 0xea, 0x8e, 0x7a, 0xaf, 0xba, 0xdf, 0xb8, 0x15,
+	0x74, 0x1f, 0x5a, 0x2f, 0xcf, 0x8e, 0x47, 0xfd, 0x83, 0xee, 0x70, 0x14, 0x1c, 0xf9, 0x83, 0xb3,
+	0x53, 0x77, 0x45, 0x31, 0x87, 0x3d, 0x5f, 0xc1, 0x83, 0x53, 0x7f, 0xf0, 0x55, 0xff, 0xb8, 0xe7,
+	0x56, 0x11, 0x82, 0xe6, 0x61, 0xaf, 0xc0, 0x5b, 0x55, 0xbc, 0x93, 0xde, 0xe8, 0xd5, 0xc0, 0xff,
+	0x55, 0xa0, 0x2e, 0xf4, 0x7c, 0xb7, 0xa6, 0xec, 0x3a, 0x1b, 0xf6, 0x7c, 0xb7, 0xae, 0xc4, 0x58,
+	0x45, 0xd9, 0x15, 0xd8, 0xff, 0x57, 0x0d, 0x5a, 0x7d, 0xfb, 0xf3, 0x35, 0x24, 0x5c, 0x3d, 0xf2,
+	0xe8, 0x04, 0x56, 0xf4, 0xda, 0x8d, 0xcc, 0x4a, 0x9a, 0x5f, 0xe5, 0x3b, 0x28, 0xcf, 0x32, 0x99,
+	0xf5, 0x9e, 0x7e, 0xff, 0xf7, 0x7f, 0xfc, 0xb1, 0xd4, 0xf6, 0xee, 0xeb, 0x5f, 0xb5, 0xf4, 0x57,
+	0x6e, 0x2f, 0x52, 0xa0, 0x9f, 0x3b, 0xcf, 0xd1, 0xd7, 0xb0, 0x6a, 0xd7, 0x63, 0xb4, 0x71, 0x27,
+	0x95, 0x3d, 0xf5, 0x57, 0xd6, 0x29, 0x2c, 0xd1, 0x99, 0xe0, 0x4d, 0x2d, 0xf8, 0x21, 0xfa, 0xa4,
+	0x28, 0x38, 0xb1, 0xc2, 0x06, 0x50, 0x35, 0xeb, 0x2e, 0x32, 0x56, 0x15, 0xd6, 0xec, 0xce, 0xfd,
+	0x02, 0xcf, 0x4a, 0x7c, 0xa2, 0x25, 0x6e, 0xa0, 0x07, 0x45, 0x89, 0xd7, 0x97, 0x0c, 0x4f, 0x29,
+	0xfa, 0x06, 0x6a, 0xe9, 0x56, 0xb2, 0xd4, 0x52, 0xb3, 0x1b, 0xcf, 0x2f, 0x2f, 0x69, 0x0c, 0xd0,
+	0x46, 0x51, 0xf0, 0x79, 0x2a, 0x0e, 0xc3, 0x5a, 0x7e, 0xc7, 0x44, 0xed, 0x05, 0x5b, 0xa9, 0xb1,
+	0xfb, 0xd1, 0x82, 0x93, 0x77, 0x5b, 0x6f, 0xd7, 0xe7, 0xdf, 0x01, 0xba, 0xfb, 0xe4, 0xa2, 0xa7,
+	0x26, 0x61, 0xcb, 0x16, 0xa1, 0xce, 0xd6, 0xd2, 0x73, 0xab, 0xf4, 0x99, 0x56, 0xba, 0x85, 0x36,
+	0xe7, 0x95, 0x1a, 0xf4, 0x0b, 0x62, 0xf4, 0xbc, 0x85, 0xd6, 0xdc, 0xeb, 0x81, 0x1e, 0x1b, 0x4f,
+	0x16, 0xbe, 0xcd, 0x9d, 0x27, 0x8b, 0x0f, 0xad, 0xd2, 0xcf, 0xb4, 0xd2, 0x4d, 0xf4, 0x78, 0x4e,
+	0xa9, 0xc1, 0xbe, 0xd0, 0xbd, 0x8d, 0x2e, 0xb5, 0xca, 0xfc, 0x6c, 0x5e, 0x9a, 0xb5, 0x4c, 0xdb,
+	0xa2, 0x87, 0xc4, 0xdb, 0xd2, 0xda, 0x1e, 0xa1, 0x87, 0x45, 0x6d, 0x9c, 0xe0, 0xf0, 0x05, 0x8b,
+	0xa3, 0x1b, 0xf4, 0x1a, 0x5a, 0x73, 0xaf, 0x80, 0x75, 0x6e, 0xf1, 0xdb, 0xd0, 0x59, 0x62, 0x86,
+	0xe7, 0x69, 0x45, 0x4f, 0x3a, 0xcb, 0x14, 0xa9, 0x6e, 0x99, 0x82, 0x3b, 0x3f, 0x89, 0x97, 0xba,
+	0xb5, 0x99, 0x25, 0x6f, 0xd1, 0xe0, 0x4e, 0xd5, 0xa1, 0x4e, 0x51, 0xdd, 0x38, 0x2f, 0x3a, 0x82,
+	0xd6, 0xdc, 0xc4, 0x7e, 0x7f, 0x10, 0x17, 0xcd, 0xf7, 0x65, 0x29, 0x3b, 0xc7, 0xe3, 0x37, 0x2f,
+	0x12, 0x0b, 0x3e, 0xaf, 0x6a, 0x91, 0x3f, 0xfd, 0xef, 0x00, 0x88, 0xb3, 0xe2, 0x73, 0xf1, 0x11,
+	0x00, 0x00,
 }
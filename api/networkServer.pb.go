@@ -57,7 +57,19 @@ type NetworkServer struct {
 	// The frequency (Hz) of the gateway discovery 'ping'.
 	GatewayDiscoveryTxFrequency uint32 `protobuf:"varint,12,opt,name=gateway_discovery_tx_frequency,json=gatewayDiscoveryTXFrequency,proto3" json:"gateway_discovery_tx_frequency,omitempty"`
 	// The data-rate of the gateway discovery 'ping'.
-	GatewayDiscoveryDr   uint32   `protobuf:"varint,13,opt,name=gateway_discovery_dr,json=gatewayDiscoveryDR,proto3" json:"gateway_discovery_dr,omitempty"`
+	GatewayDiscoveryDr uint32 `protobuf:"varint,13,opt,name=gateway_discovery_dr,json=gatewayDiscoveryDR,proto3" json:"gateway_discovery_dr,omitempty"`
+	// NetID of the network-server (HEX encoded, optional).
+	// This is used by the join-server to select the KEK for wrapping the
+	// network-server session-keys.
+	NetId string `protobuf:"bytes,14,opt,name=net_id,json=netID,proto3" json:"net_id,omitempty"`
+	// KEK label (optional).
+	// When set, this KEK is used to wrap the session-keys sent to this
+	// network-server and to unwrap the AppSKey received from this
+	// network-server with a matching KEK label.
+	KekLabel string `protobuf:"bytes,15,opt,name=kek_label,json=kekLabel,proto3" json:"kek_label,omitempty"`
+	// KEK (HEX encoded, optional).
+	// This value will not be returned by the API.
+	Kek                  string   `protobuf:"bytes,16,opt,name=kek,proto3" json:"kek,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -178,6 +190,27 @@ func (m *NetworkServer) GetGatewayDiscoveryDr() uint32 {
 	return 0
 }
 
+func (m *NetworkServer) GetNetId() string {
+	if m != nil {
+		return m.NetId
+	}
+	return ""
+}
+
+func (m *NetworkServer) GetKekLabel() string {
+	if m != nil {
+		return m.KekLabel
+	}
+	return ""
+}
+
+func (m *NetworkServer) GetKek() string {
+	if m != nil {
+		return m.Kek
+	}
+	return ""
+}
+
 type NetworkServerListItem struct {
 	// Network-server ID.
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -381,10 +414,13 @@ type GetNetworkServerResponse struct {
 	// The LoRa Server version.
 	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
 	// The LoRa Server region configured.
-	Region               string   `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Region string `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	// Connection statistics.
+	// This is not set when no connection has been made to the network-server.
+	Connection           *NetworkServerConnectionStats `protobuf:"bytes,6,opt,name=connection,proto3" json:"connection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
 func (m *GetNetworkServerResponse) Reset()         { *m = GetNetworkServerResponse{} }
@@ -446,6 +482,71 @@ func (m *GetNetworkServerResponse) GetRegion() string {
 	return ""
 }
 
+func (m *GetNetworkServerResponse) GetConnection() *NetworkServerConnectionStats {
+	if m != nil {
+		return m.Connection
+	}
+	return nil
+}
+
+type NetworkServerConnectionStats struct {
+	// Connection state (e.g. READY, CONNECTING or TRANSIENT_FAILURE).
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// Number of times the connection has been re-established after it was
+	// lost.
+	ReconnectCount uint32 `protobuf:"varint,2,opt,name=reconnect_count,json=reconnectCount,proto3" json:"reconnect_count,omitempty"`
+	// Timestamp of the last reconnect.
+	LastReconnectAt      *timestamp.Timestamp `protobuf:"bytes,3,opt,name=last_reconnect_at,json=lastReconnectAt,proto3" json:"last_reconnect_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *NetworkServerConnectionStats) Reset()         { *m = NetworkServerConnectionStats{} }
+func (m *NetworkServerConnectionStats) String() string { return proto.CompactTextString(m) }
+func (*NetworkServerConnectionStats) ProtoMessage()    {}
+func (*NetworkServerConnectionStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e41d9454685e7fd9, []int{6}
+}
+func (m *NetworkServerConnectionStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkServerConnectionStats.Unmarshal(m, b)
+}
+func (m *NetworkServerConnectionStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkServerConnectionStats.Marshal(b, m, deterministic)
+}
+func (dst *NetworkServerConnectionStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkServerConnectionStats.Merge(dst, src)
+}
+func (m *NetworkServerConnectionStats) XXX_Size() int {
+	return xxx_messageInfo_NetworkServerConnectionStats.Size(m)
+}
+func (m *NetworkServerConnectionStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkServerConnectionStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkServerConnectionStats proto.InternalMessageInfo
+
+func (m *NetworkServerConnectionStats) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *NetworkServerConnectionStats) GetReconnectCount() uint32 {
+	if m != nil {
+		return m.ReconnectCount
+	}
+	return 0
+}
+
+func (m *NetworkServerConnectionStats) GetLastReconnectAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastReconnectAt
+	}
+	return nil
+}
+
 type UpdateNetworkServerRequest struct {
 	// Network-server object to update.
 	NetworkServer        *NetworkServer `protobuf:"bytes,1,opt,name=network_server,json=networkServer,proto3" json:"network_server,omitempty"`
@@ -458,7 +559,7 @@ func (m *UpdateNetworkServerRequest) Reset()         { *m = UpdateNetworkServerR
 func (m *UpdateNetworkServerRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateNetworkServerRequest) ProtoMessage()    {}
 func (*UpdateNetworkServerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e41d9454685e7fd9, []int{6}
+	return fileDescriptor_e41d9454685e7fd9, []int{7}
 }
 func (m *UpdateNetworkServerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateNetworkServerRequest.Unmarshal(m, b)
@@ -497,7 +598,7 @@ func (m *DeleteNetworkServerRequest) Reset()         { *m = DeleteNetworkServerR
 func (m *DeleteNetworkServerRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteNetworkServerRequest) ProtoMessage()    {}
 func (*DeleteNetworkServerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e41d9454685e7fd9, []int{7}
+	return fileDescriptor_e41d9454685e7fd9, []int{8}
 }
 func (m *DeleteNetworkServerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteNetworkServerRequest.Unmarshal(m, b)
@@ -540,7 +641,7 @@ func (m *ListNetworkServerRequest) Reset()         { *m = ListNetworkServerReque
 func (m *ListNetworkServerRequest) String() string { return proto.CompactTextString(m) }
 func (*ListNetworkServerRequest) ProtoMessage()    {}
 func (*ListNetworkServerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e41d9454685e7fd9, []int{8}
+	return fileDescriptor_e41d9454685e7fd9, []int{9}
 }
 func (m *ListNetworkServerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListNetworkServerRequest.Unmarshal(m, b)
@@ -595,7 +696,7 @@ func (m *ListNetworkServerResponse) Reset()         { *m = ListNetworkServerResp
 func (m *ListNetworkServerResponse) String() string { return proto.CompactTextString(m) }
 func (*ListNetworkServerResponse) ProtoMessage()    {}
 func (*ListNetworkServerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e41d9454685e7fd9, []int{9}
+	return fileDescriptor_e41d9454685e7fd9, []int{10}
 }
 func (m *ListNetworkServerResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListNetworkServerResponse.Unmarshal(m, b)
@@ -636,6 +737,7 @@ func init() {
 	proto.RegisterType((*CreateNetworkServerResponse)(nil), "api.CreateNetworkServerResponse")
 	proto.RegisterType((*GetNetworkServerRequest)(nil), "api.GetNetworkServerRequest")
 	proto.RegisterType((*GetNetworkServerResponse)(nil), "api.GetNetworkServerResponse")
+	proto.RegisterType((*NetworkServerConnectionStats)(nil), "api.NetworkServerConnectionStats")
 	proto.RegisterType((*UpdateNetworkServerRequest)(nil), "api.UpdateNetworkServerRequest")
 	proto.RegisterType((*DeleteNetworkServerRequest)(nil), "api.DeleteNetworkServerRequest")
 	proto.RegisterType((*ListNetworkServerRequest)(nil), "api.ListNetworkServerRequest")
@@ -859,56 +961,64 @@ var _NetworkServerService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("networkServer.proto", fileDescriptor_e41d9454685e7fd9) }
 
 var fileDescriptor_e41d9454685e7fd9 = []byte{
-	// 810 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0x96, 0xe3, 0x34, 0x6d, 0x4f, 0x69, 0x91, 0x86, 0x6c, 0xe3, 0xb8, 0x65, 0x1b, 0x7c, 0x43,
-	0x58, 0xd1, 0x04, 0x75, 0x85, 0x10, 0x2b, 0x6e, 0xaa, 0x64, 0x59, 0x55, 0x54, 0x08, 0xb9, 0x41,
-	0x70, 0x67, 0x4d, 0xed, 0x93, 0x68, 0xb4, 0x8e, 0xc7, 0x3b, 0x9e, 0x64, 0x09, 0x68, 0x6f, 0x78,
-	0x05, 0xde, 0x03, 0xf1, 0x1a, 0x5c, 0xf3, 0x0a, 0xbc, 0x06, 0x12, 0x9a, 0x1f, 0xa3, 0x8d, 0x63,
-	0x83, 0x58, 0xb8, 0x89, 0x32, 0xfe, 0xbe, 0xef, 0x7c, 0x33, 0xe7, 0x7c, 0x63, 0xc3, 0x3b, 0x19,
-	0xca, 0x97, 0x5c, 0x3c, 0xbf, 0x43, 0xb1, 0x46, 0x31, 0xca, 0x05, 0x97, 0x9c, 0xb8, 0x34, 0x67,
-	0xfe, 0xf9, 0x82, 0xf3, 0x45, 0x8a, 0x63, 0x9a, 0xb3, 0x31, 0xcd, 0x32, 0x2e, 0xa9, 0x64, 0x3c,
-	0x2b, 0x0c, 0xc5, 0xbf, 0xb0, 0xa8, 0x5e, 0xdd, 0xaf, 0xe6, 0x63, 0xc9, 0x96, 0x58, 0x48, 0xba,
-	0xcc, 0x2d, 0xe1, 0xac, 0x4a, 0xc0, 0x65, 0x2e, 0x37, 0x06, 0x0c, 0x7e, 0x69, 0xc3, 0xf1, 0x97,
-	0xaf, 0x1b, 0x93, 0x13, 0x68, 0xb1, 0xc4, 0x73, 0x06, 0xce, 0xd0, 0x0d, 0x5b, 0x2c, 0x21, 0x04,
-	0xda, 0x19, 0x5d, 0xa2, 0xd7, 0x1a, 0x38, 0xc3, 0xc3, 0x50, 0xff, 0x27, 0xa7, 0xd0, 0x29, 0x34,
-	0xdb, 0x73, 0xf5, 0x53, 0xbb, 0x22, 0x3d, 0xd8, 0x8f, 0x69, 0x14, 0xa3, 0x90, 0x5e, 0xdb, 0x00,
-	0x31, 0x9d, 0xa0, 0x90, 0xa4, 0x0f, 0x07, 0x32, 0x2d, 0x0c, 0xb2, 0xa7, 0x91, 0x7d, 0x99, 0x16,
-	0x1a, 0xea, 0x81, 0xfa, 0x1b, 0x3d, 0xc7, 0x8d, 0xd7, 0x31, 0x1a, 0x99, 0x16, 0x5f, 0xe0, 0x86,
-	0x7c, 0x0c, 0x3d, 0xc1, 0x57, 0x92, 0x65, 0x8b, 0x28, 0x17, 0x7c, 0xce, 0x52, 0x8c, 0xca, 0xe2,
-	0xfb, 0x9a, 0xd8, 0xb5, 0xf0, 0x57, 0x06, 0x9d, 0x5c, 0xeb, 0x7a, 0x9f, 0x80, 0x57, 0x95, 0xfd,
-	0x65, 0x7d, 0xa0, 0x75, 0x0f, 0xb6, 0x75, 0xb3, 0xdb, 0x3b, 0x2d, 0xac, 0xf1, 0x2b, 0x37, 0x76,
-	0x58, 0xe7, 0x37, 0xbb, 0xbd, 0x53, 0xdb, 0x7c, 0x02, 0xfd, 0x05, 0x95, 0xf8, 0x92, 0x6e, 0xa2,
-	0x84, 0x15, 0x31, 0x5f, 0xa3, 0xd8, 0x44, 0x98, 0xd1, 0xfb, 0x14, 0x13, 0x0f, 0x06, 0xce, 0xf0,
-	0x20, 0xec, 0x59, 0xc2, 0xb4, 0xc4, 0x9f, 0x1a, 0x98, 0x7c, 0x06, 0xfe, 0xae, 0x96, 0x65, 0x12,
-	0xc5, 0x9a, 0xa6, 0xde, 0xd1, 0xc0, 0x19, 0x1e, 0x87, 0x5e, 0x55, 0x7c, 0x63, 0x71, 0x32, 0x81,
-	0x87, 0xbb, 0x6a, 0xf9, 0x5d, 0x34, 0x17, 0xf8, 0x62, 0x85, 0x59, 0xbc, 0xf1, 0xde, 0xd2, 0x15,
-	0xce, 0xaa, 0x15, 0x66, 0xdf, 0x7e, 0x5e, 0x52, 0xc8, 0x47, 0xd0, 0xdd, 0x2d, 0x92, 0x08, 0xef,
-	0x58, 0x4b, 0x49, 0x55, 0x3a, 0x0d, 0x83, 0x5f, 0x1d, 0x78, 0xb0, 0x15, 0x99, 0x5b, 0x56, 0xc8,
-	0x1b, 0x89, 0xcb, 0xff, 0x14, 0x9d, 0x4f, 0x01, 0x62, 0x81, 0x54, 0x62, 0x12, 0x51, 0x93, 0x9e,
-	0xa3, 0x2b, 0x7f, 0x64, 0xa2, 0x3b, 0x2a, 0xa3, 0x3b, 0x9a, 0x95, 0xd9, 0x0e, 0x0f, 0x2d, 0xfb,
-	0x5a, 0x2a, 0xe9, 0x2a, 0x4f, 0x4a, 0xe9, 0xde, 0x3f, 0x4b, 0x2d, 0xfb, 0x5a, 0x06, 0xdf, 0x80,
-	0x3f, 0xd1, 0x75, 0xb6, 0x0e, 0x14, 0xaa, 0xe6, 0x14, 0xaa, 0xf0, 0x89, 0xbd, 0x94, 0x91, 0xdd,
-	0xb3, 0xa3, 0x8b, 0x93, 0x11, 0xcd, 0xd9, 0x68, 0x5b, 0x72, 0xbc, 0x75, 0x7d, 0x83, 0x4b, 0x38,
-	0xab, 0x2d, 0x5c, 0xe4, 0x3c, 0x2b, 0xb0, 0xda, 0xa9, 0xe0, 0x03, 0xe8, 0x3d, 0x43, 0x59, 0xbb,
-	0x89, 0x2a, 0xf5, 0x0f, 0x07, 0xbc, 0x5d, 0xae, 0xad, 0xfb, 0xe6, 0x3b, 0xae, 0x0c, 0xa0, 0xf5,
-	0xe6, 0x03, 0x70, 0xff, 0xc5, 0x00, 0x88, 0x07, 0xfb, 0x6b, 0x14, 0x05, 0xe3, 0x99, 0x7d, 0x63,
-	0x94, 0x4b, 0x15, 0x14, 0x81, 0x0b, 0x05, 0x98, 0x17, 0x86, 0x5d, 0xa9, 0x91, 0x7d, 0xad, 0xe5,
-	0xff, 0xf7, 0xc8, 0x3e, 0x04, 0x7f, 0x8a, 0x29, 0x36, 0x14, 0xae, 0x8e, 0xe1, 0x05, 0x78, 0x2a,
-	0xf7, 0xb5, 0xdc, 0x2e, 0xec, 0xa5, 0x6c, 0xc9, 0xa4, 0xa5, 0x9b, 0x85, 0x3a, 0x10, 0x9f, 0xcf,
-	0x0b, 0x34, 0xcd, 0x75, 0x43, 0xbb, 0x22, 0xef, 0xc3, 0xdb, 0x5c, 0x2c, 0x68, 0xc6, 0xbe, 0xd7,
-	0xef, 0xf5, 0x88, 0x25, 0xba, 0x85, 0x6e, 0x78, 0xf2, 0xfa, 0xe3, 0x9b, 0x69, 0x90, 0x43, 0xbf,
-	0xc6, 0xd2, 0x4e, 0xfe, 0x02, 0x8e, 0x24, 0x97, 0x34, 0x8d, 0x62, 0xbe, 0xca, 0x4a, 0x67, 0xd0,
-	0x8f, 0x26, 0xea, 0x09, 0xb9, 0x52, 0xfd, 0x2c, 0x56, 0xa9, 0xb2, 0x77, 0xf5, 0x80, 0x76, 0x3a,
-	0x52, 0x5e, 0xe4, 0xd0, 0x32, 0xaf, 0x7e, 0x6e, 0x43, 0x77, 0x8b, 0xa1, 0x7e, 0x59, 0x8c, 0x24,
-	0x85, 0x8e, 0x89, 0x37, 0xb9, 0xd0, 0x65, 0x9a, 0x2f, 0x91, 0x3f, 0x68, 0x26, 0x98, 0xad, 0x07,
-	0x17, 0x3f, 0xfe, 0xf6, 0xfb, 0x4f, 0xad, 0x7e, 0xd0, 0xd5, 0x5f, 0x38, 0x3b, 0x94, 0x4b, 0x33,
-	0xbe, 0xe2, 0x89, 0xf3, 0x88, 0x20, 0xb8, 0xcf, 0x50, 0x92, 0x73, 0x5d, 0xa9, 0xe1, 0x9e, 0xf8,
-	0xef, 0x36, 0xa0, 0xd6, 0xe4, 0x3d, 0x6d, 0x72, 0x46, 0xfa, 0x75, 0x26, 0xe3, 0x1f, 0x58, 0xf2,
-	0x8a, 0xac, 0xa1, 0x63, 0x92, 0x65, 0x0f, 0xd5, 0x1c, 0x33, 0xff, 0x74, 0x27, 0xdd, 0x4f, 0xd5,
-	0x47, 0x35, 0x78, 0xac, 0x5d, 0x2e, 0xfd, 0x61, 0xbd, 0xcb, 0x76, 0x34, 0x47, 0x2c, 0x79, 0xa5,
-	0x8e, 0x97, 0x40, 0xc7, 0x04, 0xcf, 0xfa, 0x36, 0xa7, 0xb0, 0xd1, 0xd7, 0x9e, 0xee, 0xd1, 0xdf,
-	0x9c, 0x2e, 0x86, 0xb6, 0x9a, 0x2f, 0x31, 0x7d, 0x6a, 0xca, 0xae, 0xff, 0xb0, 0x09, 0xb6, 0x7d,
-	0x3c, 0xd7, 0x4e, 0xa7, 0xa4, 0x76, 0x58, 0xf7, 0x1d, 0xbd, 0xaf, 0xc7, 0x7f, 0x06, 0x00, 0x00,
-	0xff, 0xff, 0x2f, 0x2e, 0xd5, 0xe2, 0xcd, 0x08, 0x00, 0x00,
+	// 932 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x56, 0x7e, 0xdb, 0x9c, 0x92, 0x74, 0x19, 0xd2, 0xc6, 0x71, 0xca, 0x36, 0xeb, 0x1b, 0xc2,
+	0x8a, 0xa6, 0xa8, 0x2b, 0x84, 0x58, 0x71, 0x53, 0x25, 0xbb, 0xab, 0x8a, 0x0a, 0x21, 0xb7, 0x08,
+	0xee, 0xac, 0xa9, 0x7d, 0x12, 0x8d, 0xe2, 0xd8, 0x5e, 0xcf, 0x24, 0x4b, 0x40, 0x7b, 0xc3, 0x2b,
+	0xf0, 0x0c, 0xdc, 0xf2, 0x0c, 0xdc, 0x72, 0xcd, 0x2b, 0xf0, 0x0c, 0x5c, 0xa3, 0xf9, 0x71, 0x69,
+	0x12, 0x7b, 0x59, 0x0a, 0x37, 0x95, 0x67, 0xce, 0xf7, 0x9d, 0x6f, 0xe6, 0x9c, 0x6f, 0x4e, 0x03,
+	0xef, 0x45, 0x28, 0x5e, 0xc5, 0xe9, 0xec, 0x0a, 0xd3, 0x25, 0xa6, 0xc3, 0x24, 0x8d, 0x45, 0x4c,
+	0x2a, 0x34, 0x61, 0xf6, 0xd1, 0x34, 0x8e, 0xa7, 0x21, 0x9e, 0xd2, 0x84, 0x9d, 0xd2, 0x28, 0x8a,
+	0x05, 0x15, 0x2c, 0x8e, 0xb8, 0x86, 0xd8, 0xc7, 0x26, 0xaa, 0x56, 0x37, 0x8b, 0xc9, 0xa9, 0x60,
+	0x73, 0xe4, 0x82, 0xce, 0x13, 0x03, 0xe8, 0x6d, 0x02, 0x70, 0x9e, 0x88, 0x95, 0x0e, 0x3a, 0x7f,
+	0x56, 0xa1, 0xf9, 0xe5, 0x5d, 0x61, 0xd2, 0x82, 0x32, 0x0b, 0xac, 0x52, 0xbf, 0x34, 0xa8, 0xb8,
+	0x65, 0x16, 0x10, 0x02, 0xd5, 0x88, 0xce, 0xd1, 0x2a, 0xf7, 0x4b, 0x83, 0x86, 0xab, 0xbe, 0xc9,
+	0x21, 0xd4, 0xb9, 0x42, 0x5b, 0x15, 0xb5, 0x6b, 0x56, 0xa4, 0x03, 0x3b, 0x3e, 0xf5, 0x7c, 0x4c,
+	0x85, 0x55, 0xd5, 0x01, 0x9f, 0x8e, 0x30, 0x15, 0xa4, 0x0b, 0xbb, 0x22, 0xe4, 0x3a, 0x52, 0x53,
+	0x91, 0x1d, 0x11, 0x72, 0x15, 0xea, 0x80, 0xfc, 0xf4, 0x66, 0xb8, 0xb2, 0xea, 0x9a, 0x23, 0x42,
+	0xfe, 0x05, 0xae, 0xc8, 0x27, 0xd0, 0x49, 0xe3, 0x85, 0x60, 0xd1, 0xd4, 0x4b, 0xd2, 0x78, 0xc2,
+	0x42, 0xf4, 0xb2, 0xe4, 0x3b, 0x0a, 0xd8, 0x36, 0xe1, 0xaf, 0x74, 0x74, 0x74, 0xae, 0xf2, 0x7d,
+	0x0a, 0xd6, 0x26, 0xed, 0x56, 0x7a, 0x57, 0xf1, 0x0e, 0xd6, 0x79, 0xd7, 0x97, 0x57, 0x8a, 0x98,
+	0xa3, 0x97, 0x1d, 0xac, 0x91, 0xa7, 0x77, 0x7d, 0x79, 0x25, 0x8f, 0xf9, 0x14, 0xba, 0x53, 0x2a,
+	0xf0, 0x15, 0x5d, 0x79, 0x01, 0xe3, 0x7e, 0xbc, 0xc4, 0x74, 0xe5, 0x61, 0x44, 0x6f, 0x42, 0x0c,
+	0x2c, 0xe8, 0x97, 0x06, 0xbb, 0x6e, 0xc7, 0x00, 0xc6, 0x59, 0xfc, 0x99, 0x0e, 0x93, 0xcf, 0xc1,
+	0xde, 0xe6, 0xb2, 0x48, 0x60, 0xba, 0xa4, 0xa1, 0xb5, 0xd7, 0x2f, 0x0d, 0x9a, 0xae, 0xb5, 0x49,
+	0xbe, 0x30, 0x71, 0x32, 0x82, 0x87, 0xdb, 0x6c, 0xf1, 0x9d, 0x37, 0x49, 0xf1, 0xe5, 0x02, 0x23,
+	0x7f, 0x65, 0xbd, 0xa3, 0x32, 0xf4, 0x36, 0x33, 0x5c, 0x7f, 0xfb, 0x3c, 0x83, 0x90, 0x8f, 0xa1,
+	0xbd, 0x9d, 0x24, 0x48, 0xad, 0xa6, 0xa2, 0x92, 0x4d, 0xea, 0xd8, 0x25, 0x07, 0x50, 0x8f, 0x50,
+	0x78, 0x2c, 0xb0, 0x5a, 0xaa, 0x2c, 0xb5, 0x08, 0xc5, 0xc5, 0x98, 0xf4, 0xa0, 0x31, 0xc3, 0x99,
+	0x17, 0xd2, 0x1b, 0x0c, 0xad, 0x7d, 0x15, 0xd9, 0x9d, 0xe1, 0xec, 0x52, 0xae, 0xc9, 0x03, 0xa8,
+	0xcc, 0x70, 0x66, 0x3d, 0x50, 0xdb, 0xf2, 0xd3, 0xf9, 0xad, 0x04, 0x07, 0x6b, 0xc6, 0xbb, 0x64,
+	0x5c, 0x5c, 0x08, 0x9c, 0xff, 0x27, 0x03, 0x7e, 0x06, 0xe0, 0xa7, 0x48, 0x05, 0x06, 0x1e, 0xd5,
+	0x1e, 0xdc, 0x3b, 0xb3, 0x87, 0xfa, 0x01, 0x0c, 0xb3, 0x07, 0x30, 0xbc, 0xce, 0x5e, 0x88, 0xdb,
+	0x30, 0xe8, 0x73, 0x21, 0xa9, 0x8b, 0x24, 0xc8, 0xa8, 0xb5, 0x7f, 0xa6, 0x1a, 0xf4, 0xb9, 0x70,
+	0xbe, 0x01, 0x7b, 0xa4, 0xf2, 0xac, 0x5d, 0xc8, 0x95, 0x25, 0xe6, 0x32, 0x71, 0xcb, 0x3c, 0x6d,
+	0xcf, 0x9c, 0xb9, 0xa4, 0x92, 0x93, 0x21, 0x4d, 0xd8, 0x70, 0x9d, 0xd2, 0x5c, 0x1b, 0x02, 0xce,
+	0x09, 0xf4, 0x72, 0x13, 0xf3, 0x24, 0x8e, 0x38, 0x6e, 0x56, 0xca, 0xf9, 0x10, 0x3a, 0x2f, 0x50,
+	0xe4, 0x1e, 0x62, 0x13, 0xfa, 0x6b, 0x19, 0xac, 0x6d, 0xac, 0xc9, 0x7b, 0xff, 0x13, 0x6f, 0x34,
+	0xa0, 0x7c, 0xff, 0x06, 0x54, 0xfe, 0x45, 0x03, 0x88, 0x05, 0x3b, 0x4b, 0x4c, 0x39, 0x8b, 0x23,
+	0x33, 0x77, 0xb2, 0xa5, 0x34, 0x4a, 0x8a, 0x53, 0x19, 0xd0, 0x63, 0xc7, 0xac, 0xc8, 0x39, 0x80,
+	0x1f, 0x47, 0x11, 0xfa, 0x72, 0x94, 0xaa, 0xc1, 0xb3, 0x77, 0xf6, 0x68, 0xfb, 0x7a, 0xa3, 0x5b,
+	0xcc, 0x95, 0xa0, 0x82, 0xbb, 0x77, 0x48, 0xce, 0xcf, 0x25, 0x38, 0x7a, 0x13, 0x98, 0xb4, 0xa1,
+	0xc6, 0x05, 0x15, 0xa8, 0xaa, 0xd7, 0x70, 0xf5, 0x82, 0x7c, 0x00, 0xfb, 0x29, 0x9a, 0x34, 0x9e,
+	0x1f, 0x2f, 0x22, 0x5d, 0xa6, 0xa6, 0xdb, 0xba, 0xdd, 0x1e, 0xc9, 0x5d, 0xf2, 0x1c, 0xde, 0x0d,
+	0x29, 0x17, 0xde, 0xdf, 0xe8, 0xb7, 0x2a, 0xcb, 0xbe, 0x24, 0xb9, 0x19, 0x47, 0xbb, 0xf3, 0x6b,
+	0x55, 0xa9, 0xff, 0xdb, 0x9d, 0x1f, 0x81, 0x3d, 0xc6, 0x10, 0x05, 0xbe, 0x95, 0xe3, 0x5e, 0x82,
+	0x25, 0x9f, 0x78, 0x2e, 0xb6, 0x0d, 0xb5, 0x90, 0xcd, 0x99, 0x30, 0x70, 0xbd, 0x90, 0xbd, 0x8b,
+	0x27, 0x13, 0x8e, 0xba, 0x40, 0x15, 0xd7, 0xac, 0x64, 0x05, 0xe3, 0x74, 0x4a, 0x23, 0xf6, 0xbd,
+	0xfa, 0x47, 0x28, 0x27, 0x51, 0x45, 0x01, 0x5a, 0x77, 0xb7, 0x2f, 0xc6, 0x4e, 0x02, 0xdd, 0x1c,
+	0x49, 0x63, 0xf2, 0x63, 0xd8, 0x13, 0xb1, 0xa0, 0xa1, 0xe9, 0x81, 0x56, 0x06, 0xb5, 0xa5, 0xeb,
+	0x7f, 0x26, 0xad, 0xc3, 0x17, 0xa1, 0x94, 0xaf, 0xa8, 0xa2, 0x6f, 0x55, 0x24, 0x9b, 0x59, 0xae,
+	0x41, 0x9e, 0xfd, 0x52, 0x85, 0xf6, 0x1a, 0x42, 0xfe, 0x65, 0x3e, 0x92, 0x10, 0xea, 0xfa, 0x25,
+	0x93, 0x63, 0x95, 0xa6, 0x78, 0x5e, 0xd8, 0xfd, 0x62, 0x80, 0x3e, 0xba, 0x73, 0xfc, 0xe3, 0xef,
+	0x7f, 0xfc, 0x54, 0xee, 0x3a, 0x6d, 0xf5, 0x93, 0xc0, 0x34, 0xe5, 0x44, 0xb7, 0x8f, 0x3f, 0x2d,
+	0x3d, 0x26, 0x08, 0x95, 0x17, 0x28, 0xc8, 0x91, 0xca, 0x54, 0x30, 0x12, 0xec, 0xf7, 0x0b, 0xa2,
+	0x46, 0xe4, 0x91, 0x12, 0xe9, 0x91, 0x6e, 0x9e, 0xc8, 0xe9, 0x0f, 0x2c, 0x78, 0x4d, 0x96, 0x50,
+	0xd7, 0xce, 0x32, 0x97, 0x2a, 0xb6, 0x99, 0x7d, 0xb8, 0xe5, 0xd8, 0x67, 0xf2, 0x57, 0x88, 0xf3,
+	0x44, 0xa9, 0x9c, 0xd8, 0x83, 0x7c, 0x95, 0x75, 0x6b, 0x0e, 0x59, 0xf0, 0x5a, 0x5e, 0x2f, 0x80,
+	0xba, 0x36, 0x9e, 0xd1, 0x2d, 0x76, 0x61, 0xa1, 0xae, 0xb9, 0xdd, 0xe3, 0x37, 0xdc, 0xce, 0x87,
+	0xaa, 0xec, 0x2f, 0xd1, 0x75, 0x2a, 0xf2, 0xae, 0xfd, 0xb0, 0x28, 0x6c, 0xea, 0x78, 0xa4, 0x94,
+	0x0e, 0x49, 0x6e, 0xb3, 0x6e, 0xea, 0xea, 0x5c, 0x4f, 0xfe, 0x1a, 0x00, 0xfd, 0x24, 0xf5, 0xb3,
+	0xfe, 0x09, 0x00, 0x00,
 }
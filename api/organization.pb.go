@@ -34,7 +34,13 @@ type Organization struct {
 	// Organization display name.
 	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
 	// Can the organization create and "own" Gateways?
-	CanHaveGateways      bool     `protobuf:"varint,4,opt,name=can_have_gateways,json=canHaveGateways,proto3" json:"can_have_gateways,omitempty"`
+	CanHaveGateways bool `protobuf:"varint,4,opt,name=can_have_gateways,json=canHaveGateways,proto3" json:"can_have_gateways,omitempty"`
+	// Max number of devices (0 = unlimited).
+	// This can only be set by global admin users.
+	MaxDeviceCount int64 `protobuf:"varint,5,opt,name=max_device_count,json=maxDeviceCount,proto3" json:"max_device_count,omitempty"`
+	// Max number of gateways (0 = unlimited).
+	// This can only be set by global admin users.
+	MaxGatewayCount      int64    `protobuf:"varint,6,opt,name=max_gateway_count,json=maxGatewayCount,proto3" json:"max_gateway_count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -92,6 +98,20 @@ func (m *Organization) GetCanHaveGateways() bool {
 	return false
 }
 
+func (m *Organization) GetMaxDeviceCount() int64 {
+	if m != nil {
+		return m.MaxDeviceCount
+	}
+	return 0
+}
+
+func (m *Organization) GetMaxGatewayCount() int64 {
+	if m != nil {
+		return m.MaxGatewayCount
+	}
+	return 0
+}
+
 type OrganizationListItem struct {
 	// Organization ID.
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -541,7 +561,14 @@ type OrganizationUser struct {
 	// User is admin within the context of the organization.
 	IsAdmin bool `protobuf:"varint,3,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
 	// Username (only used on get).
-	Username             string   `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	// User is allowed to view the device (session) keys.
+	CanViewKeys bool `protobuf:"varint,5,opt,name=can_view_keys,json=canViewKeys,proto3" json:"can_view_keys,omitempty"`
+	// User is allowed to export bulk data (e.g. frame captures and
+	// location history).
+	CanExport bool `protobuf:"varint,6,opt,name=can_export,json=canExport,proto3" json:"can_export,omitempty"`
+	// User has read-only (auditor) access to the organization.
+	IsAuditor            bool     `protobuf:"varint,7,opt,name=is_auditor,json=isAuditor,proto3" json:"is_auditor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -599,6 +626,27 @@ func (m *OrganizationUser) GetUsername() string {
 	return ""
 }
 
+func (m *OrganizationUser) GetCanViewKeys() bool {
+	if m != nil {
+		return m.CanViewKeys
+	}
+	return false
+}
+
+func (m *OrganizationUser) GetCanExport() bool {
+	if m != nil {
+		return m.CanExport
+	}
+	return false
+}
+
+func (m *OrganizationUser) GetIsAuditor() bool {
+	if m != nil {
+		return m.IsAuditor
+	}
+	return false
+}
+
 type OrganizationUserListItem struct {
 	// User ID.
 	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userID,proto3" json:"user_id,omitempty"`
@@ -609,10 +657,17 @@ type OrganizationUserListItem struct {
 	// Created at timestamp.
 	CreatedAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// Last update timestamp.
-	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// User is allowed to view the device (session) keys.
+	CanViewKeys bool `protobuf:"varint,6,opt,name=can_view_keys,json=canViewKeys,proto3" json:"can_view_keys,omitempty"`
+	// User is allowed to export bulk data (e.g. frame captures and
+	// location history).
+	CanExport bool `protobuf:"varint,7,opt,name=can_export,json=canExport,proto3" json:"can_export,omitempty"`
+	// User has read-only (auditor) access to the organization.
+	IsAuditor            bool     `protobuf:"varint,8,opt,name=is_auditor,json=isAuditor,proto3" json:"is_auditor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *OrganizationUserListItem) Reset()         { *m = OrganizationUserListItem{} }
@@ -674,6 +729,27 @@ func (m *OrganizationUserListItem) GetUpdatedAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *OrganizationUserListItem) GetCanViewKeys() bool {
+	if m != nil {
+		return m.CanViewKeys
+	}
+	return false
+}
+
+func (m *OrganizationUserListItem) GetCanExport() bool {
+	if m != nil {
+		return m.CanExport
+	}
+	return false
+}
+
+func (m *OrganizationUserListItem) GetIsAuditor() bool {
+	if m != nil {
+		return m.IsAuditor
+	}
+	return false
+}
+
 type AddOrganizationUserRequest struct {
 	// Organization-user object to create.
 	OrganizationUser     *OrganizationUser `protobuf:"bytes,1,opt,name=organization_user,json=organizationUser,proto3" json:"organization_user,omitempty"`
@@ -1010,364 +1086,2379 @@ func (m *GetOrganizationUserResponse) GetUpdatedAt() *timestamp.Timestamp {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Organization)(nil), "api.Organization")
-	proto.RegisterType((*OrganizationListItem)(nil), "api.OrganizationListItem")
-	proto.RegisterType((*GetOrganizationRequest)(nil), "api.GetOrganizationRequest")
-	proto.RegisterType((*GetOrganizationResponse)(nil), "api.GetOrganizationResponse")
-	proto.RegisterType((*CreateOrganizationRequest)(nil), "api.CreateOrganizationRequest")
-	proto.RegisterType((*CreateOrganizationResponse)(nil), "api.CreateOrganizationResponse")
-	proto.RegisterType((*UpdateOrganizationRequest)(nil), "api.UpdateOrganizationRequest")
-	proto.RegisterType((*DeleteOrganizationRequest)(nil), "api.DeleteOrganizationRequest")
-	proto.RegisterType((*ListOrganizationRequest)(nil), "api.ListOrganizationRequest")
-	proto.RegisterType((*ListOrganizationResponse)(nil), "api.ListOrganizationResponse")
-	proto.RegisterType((*OrganizationUser)(nil), "api.OrganizationUser")
-	proto.RegisterType((*OrganizationUserListItem)(nil), "api.OrganizationUserListItem")
-	proto.RegisterType((*AddOrganizationUserRequest)(nil), "api.AddOrganizationUserRequest")
-	proto.RegisterType((*UpdateOrganizationUserRequest)(nil), "api.UpdateOrganizationUserRequest")
-	proto.RegisterType((*DeleteOrganizationUserRequest)(nil), "api.DeleteOrganizationUserRequest")
-	proto.RegisterType((*ListOrganizationUsersRequest)(nil), "api.ListOrganizationUsersRequest")
-	proto.RegisterType((*ListOrganizationUsersResponse)(nil), "api.ListOrganizationUsersResponse")
-	proto.RegisterType((*GetOrganizationUserRequest)(nil), "api.GetOrganizationUserRequest")
-	proto.RegisterType((*GetOrganizationUserResponse)(nil), "api.GetOrganizationUserResponse")
+type GetOrganizationIntegrationRequest struct {
+	// Organization ID.
+	OrganizationId       int64    `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *GetOrganizationIntegrationRequest) Reset()         { *m = GetOrganizationIntegrationRequest{} }
+func (m *GetOrganizationIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOrganizationIntegrationRequest) ProtoMessage()    {}
+func (*GetOrganizationIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{19}
+}
+func (m *GetOrganizationIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrganizationIntegrationRequest.Unmarshal(m, b)
+}
+func (m *GetOrganizationIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrganizationIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetOrganizationIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrganizationIntegrationRequest.Merge(dst, src)
+}
+func (m *GetOrganizationIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOrganizationIntegrationRequest.Size(m)
+}
+func (m *GetOrganizationIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrganizationIntegrationRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_GetOrganizationIntegrationRequest proto.InternalMessageInfo
 
-// OrganizationServiceClient is the client API for OrganizationService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type OrganizationServiceClient interface {
-	// Get organization list.
-	List(ctx context.Context, in *ListOrganizationRequest, opts ...grpc.CallOption) (*ListOrganizationResponse, error)
-	// Get data for a particular organization.
-	Get(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*GetOrganizationResponse, error)
-	// Create a new organization.
-	Create(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error)
-	// Update an existing organization.
-	Update(ctx context.Context, in *UpdateOrganizationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Delete an organization.
-	Delete(ctx context.Context, in *DeleteOrganizationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Get organization's user list.
-	ListUsers(ctx context.Context, in *ListOrganizationUsersRequest, opts ...grpc.CallOption) (*ListOrganizationUsersResponse, error)
-	// Get data for a particular organization user.
-	GetUser(ctx context.Context, in *GetOrganizationUserRequest, opts ...grpc.CallOption) (*GetOrganizationUserResponse, error)
-	// Add a new user to an organization.
-	AddUser(ctx context.Context, in *AddOrganizationUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Update a user in an organization.
-	UpdateUser(ctx context.Context, in *UpdateOrganizationUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Delete a user from an organization.
-	DeleteUser(ctx context.Context, in *DeleteOrganizationUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+func (m *GetOrganizationIntegrationRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
 }
 
-type organizationServiceClient struct {
-	cc *grpc.ClientConn
+type DeleteOrganizationIntegrationRequest struct {
+	// Organization ID.
+	OrganizationId       int64    `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewOrganizationServiceClient(cc *grpc.ClientConn) OrganizationServiceClient {
-	return &organizationServiceClient{cc}
+func (m *DeleteOrganizationIntegrationRequest) Reset()         { *m = DeleteOrganizationIntegrationRequest{} }
+func (m *DeleteOrganizationIntegrationRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteOrganizationIntegrationRequest) ProtoMessage()    {}
+func (*DeleteOrganizationIntegrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{20}
+}
+func (m *DeleteOrganizationIntegrationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteOrganizationIntegrationRequest.Unmarshal(m, b)
+}
+func (m *DeleteOrganizationIntegrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteOrganizationIntegrationRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteOrganizationIntegrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteOrganizationIntegrationRequest.Merge(dst, src)
+}
+func (m *DeleteOrganizationIntegrationRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteOrganizationIntegrationRequest.Size(m)
+}
+func (m *DeleteOrganizationIntegrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteOrganizationIntegrationRequest.DiscardUnknown(m)
 }
 
-func (c *organizationServiceClient) List(ctx context.Context, in *ListOrganizationRequest, opts ...grpc.CallOption) (*ListOrganizationResponse, error) {
-	out := new(ListOrganizationResponse)
-	err := c.cc.Invoke(ctx, "/api.OrganizationService/List", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteOrganizationIntegrationRequest proto.InternalMessageInfo
+
+func (m *DeleteOrganizationIntegrationRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *organizationServiceClient) Get(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*GetOrganizationResponse, error) {
-	out := new(GetOrganizationResponse)
-	err := c.cc.Invoke(ctx, "/api.OrganizationService/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+type ListOrganizationIntegrationsRequest struct {
+	// Organization ID.
+	OrganizationId       int64    `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListOrganizationIntegrationsRequest) Reset()         { *m = ListOrganizationIntegrationsRequest{} }
+func (m *ListOrganizationIntegrationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListOrganizationIntegrationsRequest) ProtoMessage()    {}
+func (*ListOrganizationIntegrationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{21}
+}
+func (m *ListOrganizationIntegrationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOrganizationIntegrationsRequest.Unmarshal(m, b)
+}
+func (m *ListOrganizationIntegrationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOrganizationIntegrationsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListOrganizationIntegrationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOrganizationIntegrationsRequest.Merge(dst, src)
+}
+func (m *ListOrganizationIntegrationsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListOrganizationIntegrationsRequest.Size(m)
+}
+func (m *ListOrganizationIntegrationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOrganizationIntegrationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOrganizationIntegrationsRequest proto.InternalMessageInfo
+
+func (m *ListOrganizationIntegrationsRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *organizationServiceClient) Create(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error) {
-	out := new(CreateOrganizationResponse)
-	err := c.cc.Invoke(ctx, "/api.OrganizationService/Create", in, out, opts...)
-	if err != nil {
-		return nil, err
+type OrganizationSettings struct {
+	// Organization ID.
+	OrganizationId int64 `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// URL of the organization logo.
+	LogoUrl string `protobuf:"bytes,2,opt,name=logo_url,json=logoURL,proto3" json:"logo_url,omitempty"`
+	// Primary (branding) color in #rrggbb format.
+	PrimaryColor string `protobuf:"bytes,3,opt,name=primary_color,json=primaryColor,proto3" json:"primary_color,omitempty"`
+	// Secondary (branding) color in #rrggbb format.
+	SecondaryColor string `protobuf:"bytes,4,opt,name=secondary_color,json=secondaryColor,proto3" json:"secondary_color,omitempty"`
+	// Default region of the organization (e.g. EU_863_870).
+	DefaultRegion string `protobuf:"bytes,5,opt,name=default_region,json=defaultRegion,proto3" json:"default_region,omitempty"`
+	// Name of the contact person.
+	ContactName string `protobuf:"bytes,6,opt,name=contact_name,json=contactName,proto3" json:"contact_name,omitempty"`
+	// E-mail address of the contact person.
+	ContactEmail string `protobuf:"bytes,7,opt,name=contact_email,json=contactEmail,proto3" json:"contact_email,omitempty"`
+	// Phone number of the contact person.
+	ContactPhone string `protobuf:"bytes,8,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
+	// Custom links (e.g. to documentation or support pages).
+	CustomLinks          []*OrganizationCustomLink `protobuf:"bytes,9,rep,name=custom_links,json=customLinks,proto3" json:"custom_links,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *OrganizationSettings) Reset()         { *m = OrganizationSettings{} }
+func (m *OrganizationSettings) String() string { return proto.CompactTextString(m) }
+func (*OrganizationSettings) ProtoMessage()    {}
+func (*OrganizationSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{22}
+}
+func (m *OrganizationSettings) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrganizationSettings.Unmarshal(m, b)
+}
+func (m *OrganizationSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrganizationSettings.Marshal(b, m, deterministic)
+}
+func (dst *OrganizationSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationSettings.Merge(dst, src)
+}
+func (m *OrganizationSettings) XXX_Size() int {
+	return xxx_messageInfo_OrganizationSettings.Size(m)
+}
+func (m *OrganizationSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrganizationSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrganizationSettings proto.InternalMessageInfo
+
+func (m *OrganizationSettings) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *organizationServiceClient) Update(ctx context.Context, in *UpdateOrganizationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.OrganizationService/Update", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OrganizationSettings) GetLogoUrl() string {
+	if m != nil {
+		return m.LogoUrl
 	}
-	return out, nil
+	return ""
 }
 
-func (c *organizationServiceClient) Delete(ctx context.Context, in *DeleteOrganizationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.OrganizationService/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OrganizationSettings) GetPrimaryColor() string {
+	if m != nil {
+		return m.PrimaryColor
 	}
-	return out, nil
+	return ""
 }
 
-func (c *organizationServiceClient) ListUsers(ctx context.Context, in *ListOrganizationUsersRequest, opts ...grpc.CallOption) (*ListOrganizationUsersResponse, error) {
-	out := new(ListOrganizationUsersResponse)
-	err := c.cc.Invoke(ctx, "/api.OrganizationService/ListUsers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OrganizationSettings) GetSecondaryColor() string {
+	if m != nil {
+		return m.SecondaryColor
 	}
-	return out, nil
+	return ""
 }
 
-func (c *organizationServiceClient) GetUser(ctx context.Context, in *GetOrganizationUserRequest, opts ...grpc.CallOption) (*GetOrganizationUserResponse, error) {
-	out := new(GetOrganizationUserResponse)
-	err := c.cc.Invoke(ctx, "/api.OrganizationService/GetUser", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OrganizationSettings) GetDefaultRegion() string {
+	if m != nil {
+		return m.DefaultRegion
 	}
-	return out, nil
+	return ""
 }
 
-func (c *organizationServiceClient) AddUser(ctx context.Context, in *AddOrganizationUserRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.OrganizationService/AddUser", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OrganizationSettings) GetContactName() string {
+	if m != nil {
+		return m.ContactName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *organizationServiceClient) UpdateUser(ctx context.Context, in *UpdateOrganizationUserRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.OrganizationService/UpdateUser", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OrganizationSettings) GetContactEmail() string {
+	if m != nil {
+		return m.ContactEmail
 	}
-	return out, nil
+	return ""
 }
 
-func (c *organizationServiceClient) DeleteUser(ctx context.Context, in *DeleteOrganizationUserRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/api.OrganizationService/DeleteUser", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *OrganizationSettings) GetContactPhone() string {
+	if m != nil {
+		return m.ContactPhone
 	}
-	return out, nil
+	return ""
 }
 
-// OrganizationServiceServer is the server API for OrganizationService service.
-type OrganizationServiceServer interface {
-	// Get organization list.
-	List(context.Context, *ListOrganizationRequest) (*ListOrganizationResponse, error)
-	// Get data for a particular organization.
-	Get(context.Context, *GetOrganizationRequest) (*GetOrganizationResponse, error)
-	// Create a new organization.
-	Create(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error)
-	// Update an existing organization.
-	Update(context.Context, *UpdateOrganizationRequest) (*empty.Empty, error)
-	// Delete an organization.
-	Delete(context.Context, *DeleteOrganizationRequest) (*empty.Empty, error)
-	// Get organization's user list.
-	ListUsers(context.Context, *ListOrganizationUsersRequest) (*ListOrganizationUsersResponse, error)
-	// Get data for a particular organization user.
-	GetUser(context.Context, *GetOrganizationUserRequest) (*GetOrganizationUserResponse, error)
-	// Add a new user to an organization.
-	AddUser(context.Context, *AddOrganizationUserRequest) (*empty.Empty, error)
-	// Update a user in an organization.
-	UpdateUser(context.Context, *UpdateOrganizationUserRequest) (*empty.Empty, error)
-	// Delete a user from an organization.
-	DeleteUser(context.Context, *DeleteOrganizationUserRequest) (*empty.Empty, error)
+func (m *OrganizationSettings) GetCustomLinks() []*OrganizationCustomLink {
+	if m != nil {
+		return m.CustomLinks
+	}
+	return nil
 }
 
-func RegisterOrganizationServiceServer(s *grpc.Server, srv OrganizationServiceServer) {
-	s.RegisterService(&_OrganizationService_serviceDesc, srv)
+type OrganizationCustomLink struct {
+	// Title of the link.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// URL of the link.
+	Url                  string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _OrganizationService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func (m *OrganizationCustomLink) Reset()         { *m = OrganizationCustomLink{} }
+func (m *OrganizationCustomLink) String() string { return proto.CompactTextString(m) }
+func (*OrganizationCustomLink) ProtoMessage()    {}
+func (*OrganizationCustomLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{23}
+}
+func (m *OrganizationCustomLink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrganizationCustomLink.Unmarshal(m, b)
+}
+func (m *OrganizationCustomLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrganizationCustomLink.Marshal(b, m, deterministic)
+}
+func (dst *OrganizationCustomLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationCustomLink.Merge(dst, src)
+}
+func (m *OrganizationCustomLink) XXX_Size() int {
+	return xxx_messageInfo_OrganizationCustomLink.Size(m)
+}
+func (m *OrganizationCustomLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrganizationCustomLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrganizationCustomLink proto.InternalMessageInfo
+
+func (m *OrganizationCustomLink) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *OrganizationCustomLink) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+type GetOrganizationSettingsRequest struct {
+	// Organization ID.
+	OrganizationId       int64    `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOrganizationSettingsRequest) Reset()         { *m = GetOrganizationSettingsRequest{} }
+func (m *GetOrganizationSettingsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOrganizationSettingsRequest) ProtoMessage()    {}
+func (*GetOrganizationSettingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{24}
+}
+func (m *GetOrganizationSettingsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrganizationSettingsRequest.Unmarshal(m, b)
+}
+func (m *GetOrganizationSettingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrganizationSettingsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetOrganizationSettingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrganizationSettingsRequest.Merge(dst, src)
+}
+func (m *GetOrganizationSettingsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOrganizationSettingsRequest.Size(m)
+}
+func (m *GetOrganizationSettingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrganizationSettingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrganizationSettingsRequest proto.InternalMessageInfo
+
+func (m *GetOrganizationSettingsRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+type GetOrganizationSettingsResponse struct {
+	// Organization settings.
+	Settings *OrganizationSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	// Created at timestamp (not set when the settings have never been
+	// updated).
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp (not set when the settings have never been
+	// updated).
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetOrganizationSettingsResponse) Reset()         { *m = GetOrganizationSettingsResponse{} }
+func (m *GetOrganizationSettingsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOrganizationSettingsResponse) ProtoMessage()    {}
+func (*GetOrganizationSettingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{25}
+}
+func (m *GetOrganizationSettingsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrganizationSettingsResponse.Unmarshal(m, b)
+}
+func (m *GetOrganizationSettingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrganizationSettingsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetOrganizationSettingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrganizationSettingsResponse.Merge(dst, src)
+}
+func (m *GetOrganizationSettingsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOrganizationSettingsResponse.Size(m)
+}
+func (m *GetOrganizationSettingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrganizationSettingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrganizationSettingsResponse proto.InternalMessageInfo
+
+func (m *GetOrganizationSettingsResponse) GetSettings() *OrganizationSettings {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+func (m *GetOrganizationSettingsResponse) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *GetOrganizationSettingsResponse) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type UpdateOrganizationSettingsRequest struct {
+	// Organization settings to update.
+	Settings             *OrganizationSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpdateOrganizationSettingsRequest) Reset()         { *m = UpdateOrganizationSettingsRequest{} }
+func (m *UpdateOrganizationSettingsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateOrganizationSettingsRequest) ProtoMessage()    {}
+func (*UpdateOrganizationSettingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{26}
+}
+func (m *UpdateOrganizationSettingsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateOrganizationSettingsRequest.Unmarshal(m, b)
+}
+func (m *UpdateOrganizationSettingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateOrganizationSettingsRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateOrganizationSettingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateOrganizationSettingsRequest.Merge(dst, src)
+}
+func (m *UpdateOrganizationSettingsRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateOrganizationSettingsRequest.Size(m)
+}
+func (m *UpdateOrganizationSettingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateOrganizationSettingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateOrganizationSettingsRequest proto.InternalMessageInfo
+
+func (m *UpdateOrganizationSettingsRequest) GetSettings() *OrganizationSettings {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+type OrganizationRetention struct {
+	// Organization ID.
+	OrganizationId int64 `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Retention of the security events (in days).
+	// When set to 0, the server default is used.
+	SecurityEventDays uint32 `protobuf:"varint,2,opt,name=security_event_days,json=securityEventDays,proto3" json:"security_event_days,omitempty"`
+	// Retention of the device locations (in days).
+	// When set to 0, the server default is used.
+	DeviceLocationDays uint32 `protobuf:"varint,3,opt,name=device_location_days,json=deviceLocationDays,proto3" json:"device_location_days,omitempty"`
+	// Retention of the gateway pings (in days).
+	// When set to 0, the server default is used.
+	GatewayPingDays      uint32   `protobuf:"varint,4,opt,name=gateway_ping_days,json=gatewayPingDays,proto3" json:"gateway_ping_days,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrganizationRetention) Reset()         { *m = OrganizationRetention{} }
+func (m *OrganizationRetention) String() string { return proto.CompactTextString(m) }
+func (*OrganizationRetention) ProtoMessage()    {}
+func (*OrganizationRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{27}
+}
+func (m *OrganizationRetention) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrganizationRetention.Unmarshal(m, b)
+}
+func (m *OrganizationRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrganizationRetention.Marshal(b, m, deterministic)
+}
+func (dst *OrganizationRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationRetention.Merge(dst, src)
+}
+func (m *OrganizationRetention) XXX_Size() int {
+	return xxx_messageInfo_OrganizationRetention.Size(m)
+}
+func (m *OrganizationRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrganizationRetention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrganizationRetention proto.InternalMessageInfo
+
+func (m *OrganizationRetention) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *OrganizationRetention) GetSecurityEventDays() uint32 {
+	if m != nil {
+		return m.SecurityEventDays
+	}
+	return 0
+}
+
+func (m *OrganizationRetention) GetDeviceLocationDays() uint32 {
+	if m != nil {
+		return m.DeviceLocationDays
+	}
+	return 0
+}
+
+func (m *OrganizationRetention) GetGatewayPingDays() uint32 {
+	if m != nil {
+		return m.GatewayPingDays
+	}
+	return 0
+}
+
+type OrganizationStorageUsage struct {
+	// Number of stored security events.
+	SecurityEventCount int64 `protobuf:"varint,1,opt,name=security_event_count,json=securityEventCount,proto3" json:"security_event_count,omitempty"`
+	// Number of stored device locations.
+	DeviceLocationCount int64 `protobuf:"varint,2,opt,name=device_location_count,json=deviceLocationCount,proto3" json:"device_location_count,omitempty"`
+	// Number of stored gateway pings.
+	GatewayPingCount     int64    `protobuf:"varint,3,opt,name=gateway_ping_count,json=gatewayPingCount,proto3" json:"gateway_ping_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrganizationStorageUsage) Reset()         { *m = OrganizationStorageUsage{} }
+func (m *OrganizationStorageUsage) String() string { return proto.CompactTextString(m) }
+func (*OrganizationStorageUsage) ProtoMessage()    {}
+func (*OrganizationStorageUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{28}
+}
+func (m *OrganizationStorageUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrganizationStorageUsage.Unmarshal(m, b)
+}
+func (m *OrganizationStorageUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrganizationStorageUsage.Marshal(b, m, deterministic)
+}
+func (dst *OrganizationStorageUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrganizationStorageUsage.Merge(dst, src)
+}
+func (m *OrganizationStorageUsage) XXX_Size() int {
+	return xxx_messageInfo_OrganizationStorageUsage.Size(m)
+}
+func (m *OrganizationStorageUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrganizationStorageUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrganizationStorageUsage proto.InternalMessageInfo
+
+func (m *OrganizationStorageUsage) GetSecurityEventCount() int64 {
+	if m != nil {
+		return m.SecurityEventCount
+	}
+	return 0
+}
+
+func (m *OrganizationStorageUsage) GetDeviceLocationCount() int64 {
+	if m != nil {
+		return m.DeviceLocationCount
+	}
+	return 0
+}
+
+func (m *OrganizationStorageUsage) GetGatewayPingCount() int64 {
+	if m != nil {
+		return m.GatewayPingCount
+	}
+	return 0
+}
+
+type GetOrganizationRetentionRequest struct {
+	// Organization ID.
+	OrganizationId       int64    `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOrganizationRetentionRequest) Reset()         { *m = GetOrganizationRetentionRequest{} }
+func (m *GetOrganizationRetentionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOrganizationRetentionRequest) ProtoMessage()    {}
+func (*GetOrganizationRetentionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{29}
+}
+func (m *GetOrganizationRetentionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrganizationRetentionRequest.Unmarshal(m, b)
+}
+func (m *GetOrganizationRetentionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrganizationRetentionRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetOrganizationRetentionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrganizationRetentionRequest.Merge(dst, src)
+}
+func (m *GetOrganizationRetentionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOrganizationRetentionRequest.Size(m)
+}
+func (m *GetOrganizationRetentionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrganizationRetentionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrganizationRetentionRequest proto.InternalMessageInfo
+
+func (m *GetOrganizationRetentionRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+type GetOrganizationRetentionResponse struct {
+	// Organization retention.
+	Retention *OrganizationRetention `protobuf:"bytes,1,opt,name=retention,proto3" json:"retention,omitempty"`
+	// Default retention (in days) of the server, used when the organization
+	// retention is set to 0. A default of 0 means that the data is kept
+	// forever.
+	DefaultRetention *OrganizationRetention `protobuf:"bytes,2,opt,name=default_retention,json=defaultRetention,proto3" json:"default_retention,omitempty"`
+	// Storage usage of the organization.
+	Usage *OrganizationStorageUsage `protobuf:"bytes,3,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Created at timestamp (not set when the retention has never been
+	// updated).
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp (not set when the retention has never been
+	// updated).
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetOrganizationRetentionResponse) Reset()         { *m = GetOrganizationRetentionResponse{} }
+func (m *GetOrganizationRetentionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOrganizationRetentionResponse) ProtoMessage()    {}
+func (*GetOrganizationRetentionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{30}
+}
+func (m *GetOrganizationRetentionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrganizationRetentionResponse.Unmarshal(m, b)
+}
+func (m *GetOrganizationRetentionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrganizationRetentionResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetOrganizationRetentionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrganizationRetentionResponse.Merge(dst, src)
+}
+func (m *GetOrganizationRetentionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOrganizationRetentionResponse.Size(m)
+}
+func (m *GetOrganizationRetentionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrganizationRetentionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrganizationRetentionResponse proto.InternalMessageInfo
+
+func (m *GetOrganizationRetentionResponse) GetRetention() *OrganizationRetention {
+	if m != nil {
+		return m.Retention
+	}
+	return nil
+}
+
+func (m *GetOrganizationRetentionResponse) GetDefaultRetention() *OrganizationRetention {
+	if m != nil {
+		return m.DefaultRetention
+	}
+	return nil
+}
+
+func (m *GetOrganizationRetentionResponse) GetUsage() *OrganizationStorageUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+func (m *GetOrganizationRetentionResponse) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *GetOrganizationRetentionResponse) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type UpdateOrganizationRetentionRequest struct {
+	// Organization retention to update.
+	Retention            *OrganizationRetention `protobuf:"bytes,1,opt,name=retention,proto3" json:"retention,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *UpdateOrganizationRetentionRequest) Reset()         { *m = UpdateOrganizationRetentionRequest{} }
+func (m *UpdateOrganizationRetentionRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateOrganizationRetentionRequest) ProtoMessage()    {}
+func (*UpdateOrganizationRetentionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{31}
+}
+func (m *UpdateOrganizationRetentionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateOrganizationRetentionRequest.Unmarshal(m, b)
+}
+func (m *UpdateOrganizationRetentionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateOrganizationRetentionRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateOrganizationRetentionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateOrganizationRetentionRequest.Merge(dst, src)
+}
+func (m *UpdateOrganizationRetentionRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateOrganizationRetentionRequest.Size(m)
+}
+func (m *UpdateOrganizationRetentionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateOrganizationRetentionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateOrganizationRetentionRequest proto.InternalMessageInfo
+
+func (m *UpdateOrganizationRetentionRequest) GetRetention() *OrganizationRetention {
+	if m != nil {
+		return m.Retention
+	}
+	return nil
+}
+
+type ServiceAccount struct {
+	// Service account ID (only used on get and update).
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Organization ID.
+	OrganizationId int64 `protobuf:"varint,2,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Name of the service account.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Description of the service account.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Client ID (generated on create).
+	// The service account authenticates as "service-account:<client_id>".
+	ClientId string `protobuf:"bytes,5,opt,name=client_id,json=clientID,proto3" json:"client_id,omitempty"`
+	// Public key (PEM encoded, RSA or ECDSA).
+	// When set, the service account is able to obtain a token using a JWT
+	// assertion signed with the matching private key.
+	PublicKey string `protobuf:"bytes,6,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// The service account is active.
+	IsActive bool `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// The service account is admin within the context of the organization.
+	IsAdmin bool `protobuf:"varint,8,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	// The service account is allowed to view the device (session) keys.
+	CanViewKeys bool `protobuf:"varint,9,opt,name=can_view_keys,json=canViewKeys,proto3" json:"can_view_keys,omitempty"`
+	// The service account is allowed to export bulk data.
+	CanExport bool `protobuf:"varint,10,opt,name=can_export,json=canExport,proto3" json:"can_export,omitempty"`
+	// The service account has read-only (auditor) access to the
+	// organization.
+	IsAuditor            bool     `protobuf:"varint,11,opt,name=is_auditor,json=isAuditor,proto3" json:"is_auditor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServiceAccount) Reset()         { *m = ServiceAccount{} }
+func (m *ServiceAccount) String() string { return proto.CompactTextString(m) }
+func (*ServiceAccount) ProtoMessage()    {}
+func (*ServiceAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{32}
+}
+func (m *ServiceAccount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceAccount.Unmarshal(m, b)
+}
+func (m *ServiceAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceAccount.Marshal(b, m, deterministic)
+}
+func (dst *ServiceAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceAccount.Merge(dst, src)
+}
+func (m *ServiceAccount) XXX_Size() int {
+	return xxx_messageInfo_ServiceAccount.Size(m)
+}
+func (m *ServiceAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceAccount proto.InternalMessageInfo
+
+func (m *ServiceAccount) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ServiceAccount) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *ServiceAccount) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ServiceAccount) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ServiceAccount) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *ServiceAccount) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *ServiceAccount) GetIsActive() bool {
+	if m != nil {
+		return m.IsActive
+	}
+	return false
+}
+
+func (m *ServiceAccount) GetIsAdmin() bool {
+	if m != nil {
+		return m.IsAdmin
+	}
+	return false
+}
+
+func (m *ServiceAccount) GetCanViewKeys() bool {
+	if m != nil {
+		return m.CanViewKeys
+	}
+	return false
+}
+
+func (m *ServiceAccount) GetCanExport() bool {
+	if m != nil {
+		return m.CanExport
+	}
+	return false
+}
+
+func (m *ServiceAccount) GetIsAuditor() bool {
+	if m != nil {
+		return m.IsAuditor
+	}
+	return false
+}
+
+type ServiceAccountListItem struct {
+	// Service account ID.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the service account.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Client ID.
+	ClientId string `protobuf:"bytes,3,opt,name=client_id,json=clientID,proto3" json:"client_id,omitempty"`
+	// The service account is active.
+	IsActive bool `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// The service account is admin within the context of the organization.
+	IsAdmin bool `protobuf:"varint,5,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ServiceAccountListItem) Reset()         { *m = ServiceAccountListItem{} }
+func (m *ServiceAccountListItem) String() string { return proto.CompactTextString(m) }
+func (*ServiceAccountListItem) ProtoMessage()    {}
+func (*ServiceAccountListItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{33}
+}
+func (m *ServiceAccountListItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServiceAccountListItem.Unmarshal(m, b)
+}
+func (m *ServiceAccountListItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServiceAccountListItem.Marshal(b, m, deterministic)
+}
+func (dst *ServiceAccountListItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceAccountListItem.Merge(dst, src)
+}
+func (m *ServiceAccountListItem) XXX_Size() int {
+	return xxx_messageInfo_ServiceAccountListItem.Size(m)
+}
+func (m *ServiceAccountListItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceAccountListItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceAccountListItem proto.InternalMessageInfo
+
+func (m *ServiceAccountListItem) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ServiceAccountListItem) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ServiceAccountListItem) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *ServiceAccountListItem) GetIsActive() bool {
+	if m != nil {
+		return m.IsActive
+	}
+	return false
+}
+
+func (m *ServiceAccountListItem) GetIsAdmin() bool {
+	if m != nil {
+		return m.IsAdmin
+	}
+	return false
+}
+
+func (m *ServiceAccountListItem) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *ServiceAccountListItem) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type CreateServiceAccountRequest struct {
+	// Service account to create.
+	ServiceAccount       *ServiceAccount `protobuf:"bytes,1,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CreateServiceAccountRequest) Reset()         { *m = CreateServiceAccountRequest{} }
+func (m *CreateServiceAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateServiceAccountRequest) ProtoMessage()    {}
+func (*CreateServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{34}
+}
+func (m *CreateServiceAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateServiceAccountRequest.Unmarshal(m, b)
+}
+func (m *CreateServiceAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateServiceAccountRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateServiceAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateServiceAccountRequest.Merge(dst, src)
+}
+func (m *CreateServiceAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateServiceAccountRequest.Size(m)
+}
+func (m *CreateServiceAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateServiceAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateServiceAccountRequest proto.InternalMessageInfo
+
+func (m *CreateServiceAccountRequest) GetServiceAccount() *ServiceAccount {
+	if m != nil {
+		return m.ServiceAccount
+	}
+	return nil
+}
+
+type CreateServiceAccountResponse struct {
+	// Service account ID.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Client ID.
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientID,proto3" json:"client_id,omitempty"`
+	// Client secret.
+	// The secret is not stored and can not be retrieved afterwards.
+	ClientSecret         string   `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateServiceAccountResponse) Reset()         { *m = CreateServiceAccountResponse{} }
+func (m *CreateServiceAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateServiceAccountResponse) ProtoMessage()    {}
+func (*CreateServiceAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{35}
+}
+func (m *CreateServiceAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateServiceAccountResponse.Unmarshal(m, b)
+}
+func (m *CreateServiceAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateServiceAccountResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateServiceAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateServiceAccountResponse.Merge(dst, src)
+}
+func (m *CreateServiceAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateServiceAccountResponse.Size(m)
+}
+func (m *CreateServiceAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateServiceAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateServiceAccountResponse proto.InternalMessageInfo
+
+func (m *CreateServiceAccountResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CreateServiceAccountResponse) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *CreateServiceAccountResponse) GetClientSecret() string {
+	if m != nil {
+		return m.ClientSecret
+	}
+	return ""
+}
+
+type GetServiceAccountRequest struct {
+	// Service account ID.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetServiceAccountRequest) Reset()         { *m = GetServiceAccountRequest{} }
+func (m *GetServiceAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*GetServiceAccountRequest) ProtoMessage()    {}
+func (*GetServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{36}
+}
+func (m *GetServiceAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetServiceAccountRequest.Unmarshal(m, b)
+}
+func (m *GetServiceAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetServiceAccountRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetServiceAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetServiceAccountRequest.Merge(dst, src)
+}
+func (m *GetServiceAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_GetServiceAccountRequest.Size(m)
+}
+func (m *GetServiceAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetServiceAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetServiceAccountRequest proto.InternalMessageInfo
+
+func (m *GetServiceAccountRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetServiceAccountResponse struct {
+	// Service account object.
+	ServiceAccount *ServiceAccount `protobuf:"bytes,1,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"`
+	// Created at timestamp.
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Last update timestamp.
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetServiceAccountResponse) Reset()         { *m = GetServiceAccountResponse{} }
+func (m *GetServiceAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*GetServiceAccountResponse) ProtoMessage()    {}
+func (*GetServiceAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{37}
+}
+func (m *GetServiceAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetServiceAccountResponse.Unmarshal(m, b)
+}
+func (m *GetServiceAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetServiceAccountResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetServiceAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetServiceAccountResponse.Merge(dst, src)
+}
+func (m *GetServiceAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_GetServiceAccountResponse.Size(m)
+}
+func (m *GetServiceAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetServiceAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetServiceAccountResponse proto.InternalMessageInfo
+
+func (m *GetServiceAccountResponse) GetServiceAccount() *ServiceAccount {
+	if m != nil {
+		return m.ServiceAccount
+	}
+	return nil
+}
+
+func (m *GetServiceAccountResponse) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *GetServiceAccountResponse) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type UpdateServiceAccountRequest struct {
+	// Service account object to update.
+	ServiceAccount       *ServiceAccount `protobuf:"bytes,1,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *UpdateServiceAccountRequest) Reset()         { *m = UpdateServiceAccountRequest{} }
+func (m *UpdateServiceAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateServiceAccountRequest) ProtoMessage()    {}
+func (*UpdateServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{38}
+}
+func (m *UpdateServiceAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateServiceAccountRequest.Unmarshal(m, b)
+}
+func (m *UpdateServiceAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateServiceAccountRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateServiceAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateServiceAccountRequest.Merge(dst, src)
+}
+func (m *UpdateServiceAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateServiceAccountRequest.Size(m)
+}
+func (m *UpdateServiceAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateServiceAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateServiceAccountRequest proto.InternalMessageInfo
+
+func (m *UpdateServiceAccountRequest) GetServiceAccount() *ServiceAccount {
+	if m != nil {
+		return m.ServiceAccount
+	}
+	return nil
+}
+
+type DeleteServiceAccountRequest struct {
+	// Service account ID.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteServiceAccountRequest) Reset()         { *m = DeleteServiceAccountRequest{} }
+func (m *DeleteServiceAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteServiceAccountRequest) ProtoMessage()    {}
+func (*DeleteServiceAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{39}
+}
+func (m *DeleteServiceAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteServiceAccountRequest.Unmarshal(m, b)
+}
+func (m *DeleteServiceAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteServiceAccountRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteServiceAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteServiceAccountRequest.Merge(dst, src)
+}
+func (m *DeleteServiceAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteServiceAccountRequest.Size(m)
+}
+func (m *DeleteServiceAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteServiceAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteServiceAccountRequest proto.InternalMessageInfo
+
+func (m *DeleteServiceAccountRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ListServiceAccountsRequest struct {
+	// Organization ID.
+	OrganizationId int64 `protobuf:"varint,1,opt,name=organization_id,json=organizationID,proto3" json:"organization_id,omitempty"`
+	// Max number of service accounts to return in the result-set.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset               int32    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListServiceAccountsRequest) Reset()         { *m = ListServiceAccountsRequest{} }
+func (m *ListServiceAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListServiceAccountsRequest) ProtoMessage()    {}
+func (*ListServiceAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{40}
+}
+func (m *ListServiceAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListServiceAccountsRequest.Unmarshal(m, b)
+}
+func (m *ListServiceAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListServiceAccountsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListServiceAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListServiceAccountsRequest.Merge(dst, src)
+}
+func (m *ListServiceAccountsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListServiceAccountsRequest.Size(m)
+}
+func (m *ListServiceAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListServiceAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListServiceAccountsRequest proto.InternalMessageInfo
+
+func (m *ListServiceAccountsRequest) GetOrganizationId() int64 {
+	if m != nil {
+		return m.OrganizationId
+	}
+	return 0
+}
+
+func (m *ListServiceAccountsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListServiceAccountsRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListServiceAccountsResponse struct {
+	// Total number of service accounts in the organization.
+	TotalCount           int64                     `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Result               []*ServiceAccountListItem `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ListServiceAccountsResponse) Reset()         { *m = ListServiceAccountsResponse{} }
+func (m *ListServiceAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListServiceAccountsResponse) ProtoMessage()    {}
+func (*ListServiceAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{41}
+}
+func (m *ListServiceAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListServiceAccountsResponse.Unmarshal(m, b)
+}
+func (m *ListServiceAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListServiceAccountsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListServiceAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListServiceAccountsResponse.Merge(dst, src)
+}
+func (m *ListServiceAccountsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListServiceAccountsResponse.Size(m)
+}
+func (m *ListServiceAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListServiceAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListServiceAccountsResponse proto.InternalMessageInfo
+
+func (m *ListServiceAccountsResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListServiceAccountsResponse) GetResult() []*ServiceAccountListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type RegenerateServiceAccountSecretRequest struct {
+	// Service account ID.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegenerateServiceAccountSecretRequest) Reset()         { *m = RegenerateServiceAccountSecretRequest{} }
+func (m *RegenerateServiceAccountSecretRequest) String() string { return proto.CompactTextString(m) }
+func (*RegenerateServiceAccountSecretRequest) ProtoMessage()    {}
+func (*RegenerateServiceAccountSecretRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{42}
+}
+func (m *RegenerateServiceAccountSecretRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegenerateServiceAccountSecretRequest.Unmarshal(m, b)
+}
+func (m *RegenerateServiceAccountSecretRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegenerateServiceAccountSecretRequest.Marshal(b, m, deterministic)
+}
+func (dst *RegenerateServiceAccountSecretRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegenerateServiceAccountSecretRequest.Merge(dst, src)
+}
+func (m *RegenerateServiceAccountSecretRequest) XXX_Size() int {
+	return xxx_messageInfo_RegenerateServiceAccountSecretRequest.Size(m)
+}
+func (m *RegenerateServiceAccountSecretRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegenerateServiceAccountSecretRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegenerateServiceAccountSecretRequest proto.InternalMessageInfo
+
+func (m *RegenerateServiceAccountSecretRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type RegenerateServiceAccountSecretResponse struct {
+	// Client ID.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientID,proto3" json:"client_id,omitempty"`
+	// Client secret.
+	// The secret is not stored and can not be retrieved afterwards.
+	ClientSecret         string   `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegenerateServiceAccountSecretResponse) Reset() {
+	*m = RegenerateServiceAccountSecretResponse{}
+}
+func (m *RegenerateServiceAccountSecretResponse) String() string { return proto.CompactTextString(m) }
+func (*RegenerateServiceAccountSecretResponse) ProtoMessage()    {}
+func (*RegenerateServiceAccountSecretResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d10c68ef159b9ed, []int{43}
+}
+func (m *RegenerateServiceAccountSecretResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegenerateServiceAccountSecretResponse.Unmarshal(m, b)
+}
+func (m *RegenerateServiceAccountSecretResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegenerateServiceAccountSecretResponse.Marshal(b, m, deterministic)
+}
+func (dst *RegenerateServiceAccountSecretResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegenerateServiceAccountSecretResponse.Merge(dst, src)
+}
+func (m *RegenerateServiceAccountSecretResponse) XXX_Size() int {
+	return xxx_messageInfo_RegenerateServiceAccountSecretResponse.Size(m)
+}
+func (m *RegenerateServiceAccountSecretResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegenerateServiceAccountSecretResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegenerateServiceAccountSecretResponse proto.InternalMessageInfo
+
+func (m *RegenerateServiceAccountSecretResponse) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *RegenerateServiceAccountSecretResponse) GetClientSecret() string {
+	if m != nil {
+		return m.ClientSecret
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Organization)(nil), "api.Organization")
+	proto.RegisterType((*OrganizationListItem)(nil), "api.OrganizationListItem")
+	proto.RegisterType((*GetOrganizationRequest)(nil), "api.GetOrganizationRequest")
+	proto.RegisterType((*GetOrganizationResponse)(nil), "api.GetOrganizationResponse")
+	proto.RegisterType((*CreateOrganizationRequest)(nil), "api.CreateOrganizationRequest")
+	proto.RegisterType((*CreateOrganizationResponse)(nil), "api.CreateOrganizationResponse")
+	proto.RegisterType((*UpdateOrganizationRequest)(nil), "api.UpdateOrganizationRequest")
+	proto.RegisterType((*DeleteOrganizationRequest)(nil), "api.DeleteOrganizationRequest")
+	proto.RegisterType((*ListOrganizationRequest)(nil), "api.ListOrganizationRequest")
+	proto.RegisterType((*ListOrganizationResponse)(nil), "api.ListOrganizationResponse")
+	proto.RegisterType((*OrganizationUser)(nil), "api.OrganizationUser")
+	proto.RegisterType((*OrganizationUserListItem)(nil), "api.OrganizationUserListItem")
+	proto.RegisterType((*AddOrganizationUserRequest)(nil), "api.AddOrganizationUserRequest")
+	proto.RegisterType((*UpdateOrganizationUserRequest)(nil), "api.UpdateOrganizationUserRequest")
+	proto.RegisterType((*DeleteOrganizationUserRequest)(nil), "api.DeleteOrganizationUserRequest")
+	proto.RegisterType((*ListOrganizationUsersRequest)(nil), "api.ListOrganizationUsersRequest")
+	proto.RegisterType((*ListOrganizationUsersResponse)(nil), "api.ListOrganizationUsersResponse")
+	proto.RegisterType((*GetOrganizationUserRequest)(nil), "api.GetOrganizationUserRequest")
+	proto.RegisterType((*GetOrganizationUserResponse)(nil), "api.GetOrganizationUserResponse")
+	proto.RegisterType((*GetOrganizationIntegrationRequest)(nil), "api.GetOrganizationIntegrationRequest")
+	proto.RegisterType((*DeleteOrganizationIntegrationRequest)(nil), "api.DeleteOrganizationIntegrationRequest")
+	proto.RegisterType((*ListOrganizationIntegrationsRequest)(nil), "api.ListOrganizationIntegrationsRequest")
+	proto.RegisterType((*OrganizationSettings)(nil), "api.OrganizationSettings")
+	proto.RegisterType((*OrganizationCustomLink)(nil), "api.OrganizationCustomLink")
+	proto.RegisterType((*GetOrganizationSettingsRequest)(nil), "api.GetOrganizationSettingsRequest")
+	proto.RegisterType((*GetOrganizationSettingsResponse)(nil), "api.GetOrganizationSettingsResponse")
+	proto.RegisterType((*UpdateOrganizationSettingsRequest)(nil), "api.UpdateOrganizationSettingsRequest")
+	proto.RegisterType((*OrganizationRetention)(nil), "api.OrganizationRetention")
+	proto.RegisterType((*OrganizationStorageUsage)(nil), "api.OrganizationStorageUsage")
+	proto.RegisterType((*GetOrganizationRetentionRequest)(nil), "api.GetOrganizationRetentionRequest")
+	proto.RegisterType((*GetOrganizationRetentionResponse)(nil), "api.GetOrganizationRetentionResponse")
+	proto.RegisterType((*UpdateOrganizationRetentionRequest)(nil), "api.UpdateOrganizationRetentionRequest")
+	proto.RegisterType((*ServiceAccount)(nil), "api.ServiceAccount")
+	proto.RegisterType((*ServiceAccountListItem)(nil), "api.ServiceAccountListItem")
+	proto.RegisterType((*CreateServiceAccountRequest)(nil), "api.CreateServiceAccountRequest")
+	proto.RegisterType((*CreateServiceAccountResponse)(nil), "api.CreateServiceAccountResponse")
+	proto.RegisterType((*GetServiceAccountRequest)(nil), "api.GetServiceAccountRequest")
+	proto.RegisterType((*GetServiceAccountResponse)(nil), "api.GetServiceAccountResponse")
+	proto.RegisterType((*UpdateServiceAccountRequest)(nil), "api.UpdateServiceAccountRequest")
+	proto.RegisterType((*DeleteServiceAccountRequest)(nil), "api.DeleteServiceAccountRequest")
+	proto.RegisterType((*ListServiceAccountsRequest)(nil), "api.ListServiceAccountsRequest")
+	proto.RegisterType((*ListServiceAccountsResponse)(nil), "api.ListServiceAccountsResponse")
+	proto.RegisterType((*RegenerateServiceAccountSecretRequest)(nil), "api.RegenerateServiceAccountSecretRequest")
+	proto.RegisterType((*RegenerateServiceAccountSecretResponse)(nil), "api.RegenerateServiceAccountSecretResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// OrganizationServiceClient is the client API for OrganizationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type OrganizationServiceClient interface {
+	// Get organization list.
+	List(ctx context.Context, in *ListOrganizationRequest, opts ...grpc.CallOption) (*ListOrganizationResponse, error)
+	// Get data for a particular organization.
+	Get(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*GetOrganizationResponse, error)
+	// Create a new organization.
+	Create(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error)
+	// Update an existing organization.
+	Update(ctx context.Context, in *UpdateOrganizationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Delete an organization.
+	Delete(ctx context.Context, in *DeleteOrganizationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Get organization's user list.
+	ListUsers(ctx context.Context, in *ListOrganizationUsersRequest, opts ...grpc.CallOption) (*ListOrganizationUsersResponse, error)
+	// Get data for a particular organization user.
+	GetUser(ctx context.Context, in *GetOrganizationUserRequest, opts ...grpc.CallOption) (*GetOrganizationUserResponse, error)
+	// Add a new user to an organization.
+	AddUser(ctx context.Context, in *AddOrganizationUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Update a user in an organization.
+	UpdateUser(ctx context.Context, in *UpdateOrganizationUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Delete a user from an organization.
+	DeleteUser(ctx context.Context, in *DeleteOrganizationUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// CreateHTTPIntegration creates a HTTP organization-integration.
+	// Organization integrations are inherited by all applications of the
+	// organization.
+	CreateHTTPIntegration(ctx context.Context, in *CreateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetHTTPIntegration returns the HTTP organization-integration.
+	GetHTTPIntegration(ctx context.Context, in *GetOrganizationIntegrationRequest, opts ...grpc.CallOption) (*GetHTTPIntegrationResponse, error)
+	// UpdateHTTPIntegration updates the HTTP organization-integration.
+	UpdateHTTPIntegration(ctx context.Context, in *UpdateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// DeleteHTTPIntegration deletes the HTTP organization-integration.
+	DeleteHTTPIntegration(ctx context.Context, in *DeleteOrganizationIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// CreateInfluxDBIntegration creates an InfluxDB organization-integration.
+	// Organization integrations are inherited by all applications of the
+	// organization.
+	CreateInfluxDBIntegration(ctx context.Context, in *CreateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetInfluxDBIntegration returns the InfluxDB organization-integration.
+	GetInfluxDBIntegration(ctx context.Context, in *GetOrganizationIntegrationRequest, opts ...grpc.CallOption) (*GetInfluxDBIntegrationResponse, error)
+	// UpdateInfluxDBIntegration updates the InfluxDB organization-integration.
+	UpdateInfluxDBIntegration(ctx context.Context, in *UpdateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// DeleteInfluxDBIntegration deletes the InfluxDB organization-integration.
+	DeleteInfluxDBIntegration(ctx context.Context, in *DeleteOrganizationIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ListIntegrations lists all configured organization-integrations.
+	ListIntegrations(ctx context.Context, in *ListOrganizationIntegrationsRequest, opts ...grpc.CallOption) (*ListIntegrationResponse, error)
+	// GetSettings returns the (white-label) settings of the organization.
+	GetSettings(ctx context.Context, in *GetOrganizationSettingsRequest, opts ...grpc.CallOption) (*GetOrganizationSettingsResponse, error)
+	// UpdateSettings updates the (white-label) settings of the organization.
+	UpdateSettings(ctx context.Context, in *UpdateOrganizationSettingsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetRetention returns the data retention and the storage usage of the
+	// organization.
+	GetRetention(ctx context.Context, in *GetOrganizationRetentionRequest, opts ...grpc.CallOption) (*GetOrganizationRetentionResponse, error)
+	// UpdateRetention updates the data retention of the organization.
+	UpdateRetention(ctx context.Context, in *UpdateOrganizationRetentionRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// CreateServiceAccount creates a service account within the organization.
+	// The client secret is only returned on create.
+	CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error)
+	// GetServiceAccount returns the service account for the given ID.
+	GetServiceAccount(ctx context.Context, in *GetServiceAccountRequest, opts ...grpc.CallOption) (*GetServiceAccountResponse, error)
+	// UpdateServiceAccount updates the given service account.
+	UpdateServiceAccount(ctx context.Context, in *UpdateServiceAccountRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// DeleteServiceAccount deletes the service account for the given ID.
+	DeleteServiceAccount(ctx context.Context, in *DeleteServiceAccountRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ListServiceAccounts lists the service accounts of the organization.
+	ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error)
+	// RegenerateServiceAccountSecret generates a new client secret for the
+	// given service account. The previous secret is revoked.
+	RegenerateServiceAccountSecret(ctx context.Context, in *RegenerateServiceAccountSecretRequest, opts ...grpc.CallOption) (*RegenerateServiceAccountSecretResponse, error)
+}
+
+type organizationServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewOrganizationServiceClient(cc *grpc.ClientConn) OrganizationServiceClient {
+	return &organizationServiceClient{cc}
+}
+
+func (c *organizationServiceClient) List(ctx context.Context, in *ListOrganizationRequest, opts ...grpc.CallOption) (*ListOrganizationResponse, error) {
+	out := new(ListOrganizationResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) Get(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*GetOrganizationResponse, error) {
+	out := new(GetOrganizationResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) Create(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error) {
+	out := new(CreateOrganizationResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) Update(ctx context.Context, in *UpdateOrganizationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) Delete(ctx context.Context, in *DeleteOrganizationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) ListUsers(ctx context.Context, in *ListOrganizationUsersRequest, opts ...grpc.CallOption) (*ListOrganizationUsersResponse, error) {
+	out := new(ListOrganizationUsersResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/ListUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetUser(ctx context.Context, in *GetOrganizationUserRequest, opts ...grpc.CallOption) (*GetOrganizationUserResponse, error) {
+	out := new(GetOrganizationUserResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/GetUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) AddUser(ctx context.Context, in *AddOrganizationUserRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/AddUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) UpdateUser(ctx context.Context, in *UpdateOrganizationUserRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/UpdateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) DeleteUser(ctx context.Context, in *DeleteOrganizationUserRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/DeleteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) CreateHTTPIntegration(ctx context.Context, in *CreateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/CreateHTTPIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetHTTPIntegration(ctx context.Context, in *GetOrganizationIntegrationRequest, opts ...grpc.CallOption) (*GetHTTPIntegrationResponse, error) {
+	out := new(GetHTTPIntegrationResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/GetHTTPIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) UpdateHTTPIntegration(ctx context.Context, in *UpdateHTTPIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/UpdateHTTPIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) DeleteHTTPIntegration(ctx context.Context, in *DeleteOrganizationIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/DeleteHTTPIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) CreateInfluxDBIntegration(ctx context.Context, in *CreateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/CreateInfluxDBIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetInfluxDBIntegration(ctx context.Context, in *GetOrganizationIntegrationRequest, opts ...grpc.CallOption) (*GetInfluxDBIntegrationResponse, error) {
+	out := new(GetInfluxDBIntegrationResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/GetInfluxDBIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) UpdateInfluxDBIntegration(ctx context.Context, in *UpdateInfluxDBIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/UpdateInfluxDBIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) DeleteInfluxDBIntegration(ctx context.Context, in *DeleteOrganizationIntegrationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/DeleteInfluxDBIntegration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) ListIntegrations(ctx context.Context, in *ListOrganizationIntegrationsRequest, opts ...grpc.CallOption) (*ListIntegrationResponse, error) {
+	out := new(ListIntegrationResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/ListIntegrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetSettings(ctx context.Context, in *GetOrganizationSettingsRequest, opts ...grpc.CallOption) (*GetOrganizationSettingsResponse, error) {
+	out := new(GetOrganizationSettingsResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/GetSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) UpdateSettings(ctx context.Context, in *UpdateOrganizationSettingsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/UpdateSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetRetention(ctx context.Context, in *GetOrganizationRetentionRequest, opts ...grpc.CallOption) (*GetOrganizationRetentionResponse, error) {
+	out := new(GetOrganizationRetentionResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/GetRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) UpdateRetention(ctx context.Context, in *UpdateOrganizationRetentionRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/UpdateRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error) {
+	out := new(CreateServiceAccountResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/CreateServiceAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetServiceAccount(ctx context.Context, in *GetServiceAccountRequest, opts ...grpc.CallOption) (*GetServiceAccountResponse, error) {
+	out := new(GetServiceAccountResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/GetServiceAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) UpdateServiceAccount(ctx context.Context, in *UpdateServiceAccountRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/UpdateServiceAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) DeleteServiceAccount(ctx context.Context, in *DeleteServiceAccountRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/DeleteServiceAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error) {
+	out := new(ListServiceAccountsResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/ListServiceAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) RegenerateServiceAccountSecret(ctx context.Context, in *RegenerateServiceAccountSecretRequest, opts ...grpc.CallOption) (*RegenerateServiceAccountSecretResponse, error) {
+	out := new(RegenerateServiceAccountSecretResponse)
+	err := c.cc.Invoke(ctx, "/api.OrganizationService/RegenerateServiceAccountSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrganizationServiceServer is the server API for OrganizationService service.
+type OrganizationServiceServer interface {
+	// Get organization list.
+	List(context.Context, *ListOrganizationRequest) (*ListOrganizationResponse, error)
+	// Get data for a particular organization.
+	Get(context.Context, *GetOrganizationRequest) (*GetOrganizationResponse, error)
+	// Create a new organization.
+	Create(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error)
+	// Update an existing organization.
+	Update(context.Context, *UpdateOrganizationRequest) (*empty.Empty, error)
+	// Delete an organization.
+	Delete(context.Context, *DeleteOrganizationRequest) (*empty.Empty, error)
+	// Get organization's user list.
+	ListUsers(context.Context, *ListOrganizationUsersRequest) (*ListOrganizationUsersResponse, error)
+	// Get data for a particular organization user.
+	GetUser(context.Context, *GetOrganizationUserRequest) (*GetOrganizationUserResponse, error)
+	// Add a new user to an organization.
+	AddUser(context.Context, *AddOrganizationUserRequest) (*empty.Empty, error)
+	// Update a user in an organization.
+	UpdateUser(context.Context, *UpdateOrganizationUserRequest) (*empty.Empty, error)
+	// Delete a user from an organization.
+	DeleteUser(context.Context, *DeleteOrganizationUserRequest) (*empty.Empty, error)
+	// CreateHTTPIntegration creates a HTTP organization-integration.
+	// Organization integrations are inherited by all applications of the
+	// organization.
+	CreateHTTPIntegration(context.Context, *CreateHTTPIntegrationRequest) (*empty.Empty, error)
+	// GetHTTPIntegration returns the HTTP organization-integration.
+	GetHTTPIntegration(context.Context, *GetOrganizationIntegrationRequest) (*GetHTTPIntegrationResponse, error)
+	// UpdateHTTPIntegration updates the HTTP organization-integration.
+	UpdateHTTPIntegration(context.Context, *UpdateHTTPIntegrationRequest) (*empty.Empty, error)
+	// DeleteHTTPIntegration deletes the HTTP organization-integration.
+	DeleteHTTPIntegration(context.Context, *DeleteOrganizationIntegrationRequest) (*empty.Empty, error)
+	// CreateInfluxDBIntegration creates an InfluxDB organization-integration.
+	// Organization integrations are inherited by all applications of the
+	// organization.
+	CreateInfluxDBIntegration(context.Context, *CreateInfluxDBIntegrationRequest) (*empty.Empty, error)
+	// GetInfluxDBIntegration returns the InfluxDB organization-integration.
+	GetInfluxDBIntegration(context.Context, *GetOrganizationIntegrationRequest) (*GetInfluxDBIntegrationResponse, error)
+	// UpdateInfluxDBIntegration updates the InfluxDB organization-integration.
+	UpdateInfluxDBIntegration(context.Context, *UpdateInfluxDBIntegrationRequest) (*empty.Empty, error)
+	// DeleteInfluxDBIntegration deletes the InfluxDB organization-integration.
+	DeleteInfluxDBIntegration(context.Context, *DeleteOrganizationIntegrationRequest) (*empty.Empty, error)
+	// ListIntegrations lists all configured organization-integrations.
+	ListIntegrations(context.Context, *ListOrganizationIntegrationsRequest) (*ListIntegrationResponse, error)
+	// GetSettings returns the (white-label) settings of the organization.
+	GetSettings(context.Context, *GetOrganizationSettingsRequest) (*GetOrganizationSettingsResponse, error)
+	// UpdateSettings updates the (white-label) settings of the organization.
+	UpdateSettings(context.Context, *UpdateOrganizationSettingsRequest) (*empty.Empty, error)
+	// GetRetention returns the data retention and the storage usage of the
+	// organization.
+	GetRetention(context.Context, *GetOrganizationRetentionRequest) (*GetOrganizationRetentionResponse, error)
+	// UpdateRetention updates the data retention of the organization.
+	UpdateRetention(context.Context, *UpdateOrganizationRetentionRequest) (*empty.Empty, error)
+	// CreateServiceAccount creates a service account within the organization.
+	// The client secret is only returned on create.
+	CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error)
+	// GetServiceAccount returns the service account for the given ID.
+	GetServiceAccount(context.Context, *GetServiceAccountRequest) (*GetServiceAccountResponse, error)
+	// UpdateServiceAccount updates the given service account.
+	UpdateServiceAccount(context.Context, *UpdateServiceAccountRequest) (*empty.Empty, error)
+	// DeleteServiceAccount deletes the service account for the given ID.
+	DeleteServiceAccount(context.Context, *DeleteServiceAccountRequest) (*empty.Empty, error)
+	// ListServiceAccounts lists the service accounts of the organization.
+	ListServiceAccounts(context.Context, *ListServiceAccountsRequest) (*ListServiceAccountsResponse, error)
+	// RegenerateServiceAccountSecret generates a new client secret for the
+	// given service account. The previous secret is revoked.
+	RegenerateServiceAccountSecret(context.Context, *RegenerateServiceAccountSecretRequest) (*RegenerateServiceAccountSecretResponse, error)
+}
+
+func RegisterOrganizationServiceServer(s *grpc.Server, srv OrganizationServiceServer) {
+	s.RegisterService(&_OrganizationService_serviceDesc, srv)
+}
+
+func _OrganizationService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListOrganizationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).List(ctx, in)
+		return srv.(OrganizationServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).List(ctx, req.(*ListOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).Get(ctx, req.(*GetOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).Create(ctx, req.(*CreateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).Update(ctx, req.(*UpdateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).Delete(ctx, req.(*DeleteOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/ListUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ListUsers(ctx, req.(*ListOrganizationUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/GetUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).GetUser(ctx, req.(*GetOrganizationUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_AddUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrganizationUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).AddUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.OrganizationService/List",
+		FullMethod: "/api.OrganizationService/AddUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).List(ctx, req.(*ListOrganizationRequest))
+		return srv.(OrganizationServiceServer).AddUser(ctx, req.(*AddOrganizationUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrganizationRequest)
+func _OrganizationService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).Get(ctx, in)
+		return srv.(OrganizationServiceServer).UpdateUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.OrganizationService/Get",
+		FullMethod: "/api.OrganizationService/UpdateUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).Get(ctx, req.(*GetOrganizationRequest))
+		return srv.(OrganizationServiceServer).UpdateUser(ctx, req.(*UpdateOrganizationUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateOrganizationRequest)
+func _OrganizationService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).Create(ctx, in)
+		return srv.(OrganizationServiceServer).DeleteUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.OrganizationService/Create",
+		FullMethod: "/api.OrganizationService/DeleteUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).Create(ctx, req.(*CreateOrganizationRequest))
+		return srv.(OrganizationServiceServer).DeleteUser(ctx, req.(*DeleteOrganizationUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateOrganizationRequest)
+func _OrganizationService_CreateHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHTTPIntegrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).Update(ctx, in)
+		return srv.(OrganizationServiceServer).CreateHTTPIntegration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.OrganizationService/Update",
+		FullMethod: "/api.OrganizationService/CreateHTTPIntegration",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).Update(ctx, req.(*UpdateOrganizationRequest))
+		return srv.(OrganizationServiceServer).CreateHTTPIntegration(ctx, req.(*CreateHTTPIntegrationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteOrganizationRequest)
+func _OrganizationService_GetHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationIntegrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).Delete(ctx, in)
+		return srv.(OrganizationServiceServer).GetHTTPIntegration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.OrganizationService/Delete",
+		FullMethod: "/api.OrganizationService/GetHTTPIntegration",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).Delete(ctx, req.(*DeleteOrganizationRequest))
+		return srv.(OrganizationServiceServer).GetHTTPIntegration(ctx, req.(*GetOrganizationIntegrationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListOrganizationUsersRequest)
+func _OrganizationService_UpdateHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHTTPIntegrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).ListUsers(ctx, in)
+		return srv.(OrganizationServiceServer).UpdateHTTPIntegration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.OrganizationService/ListUsers",
+		FullMethod: "/api.OrganizationService/UpdateHTTPIntegration",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).ListUsers(ctx, req.(*ListOrganizationUsersRequest))
+		return srv.(OrganizationServiceServer).UpdateHTTPIntegration(ctx, req.(*UpdateHTTPIntegrationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrganizationUserRequest)
+func _OrganizationService_DeleteHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationIntegrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).GetUser(ctx, in)
+		return srv.(OrganizationServiceServer).DeleteHTTPIntegration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.OrganizationService/GetUser",
+		FullMethod: "/api.OrganizationService/DeleteHTTPIntegration",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).GetUser(ctx, req.(*GetOrganizationUserRequest))
+		return srv.(OrganizationServiceServer).DeleteHTTPIntegration(ctx, req.(*DeleteOrganizationIntegrationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_AddUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddOrganizationUserRequest)
+func _OrganizationService_CreateInfluxDBIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInfluxDBIntegrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).AddUser(ctx, in)
+		return srv.(OrganizationServiceServer).CreateInfluxDBIntegration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.OrganizationService/AddUser",
+		FullMethod: "/api.OrganizationService/CreateInfluxDBIntegration",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).AddUser(ctx, req.(*AddOrganizationUserRequest))
+		return srv.(OrganizationServiceServer).CreateInfluxDBIntegration(ctx, req.(*CreateInfluxDBIntegrationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateOrganizationUserRequest)
+func _OrganizationService_GetInfluxDBIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationIntegrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).UpdateUser(ctx, in)
+		return srv.(OrganizationServiceServer).GetInfluxDBIntegration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.OrganizationService/UpdateUser",
+		FullMethod: "/api.OrganizationService/GetInfluxDBIntegration",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).UpdateUser(ctx, req.(*UpdateOrganizationUserRequest))
+		return srv.(OrganizationServiceServer).GetInfluxDBIntegration(ctx, req.(*GetOrganizationIntegrationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrganizationService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteOrganizationUserRequest)
+func _OrganizationService_UpdateInfluxDBIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInfluxDBIntegrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrganizationServiceServer).DeleteUser(ctx, in)
+		return srv.(OrganizationServiceServer).UpdateInfluxDBIntegration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.OrganizationService/DeleteUser",
+		FullMethod: "/api.OrganizationService/UpdateInfluxDBIntegration",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrganizationServiceServer).DeleteUser(ctx, req.(*DeleteOrganizationUserRequest))
+		return srv.(OrganizationServiceServer).UpdateInfluxDBIntegration(ctx, req.(*UpdateInfluxDBIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_DeleteInfluxDBIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).DeleteInfluxDBIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/DeleteInfluxDBIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).DeleteInfluxDBIntegration(ctx, req.(*DeleteOrganizationIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ListIntegrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationIntegrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ListIntegrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/ListIntegrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ListIntegrations(ctx, req.(*ListOrganizationIntegrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_GetSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).GetSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/GetSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).GetSettings(ctx, req.(*GetOrganizationSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_UpdateSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).UpdateSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/UpdateSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).UpdateSettings(ctx, req.(*UpdateOrganizationSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_GetRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).GetRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/GetRetention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).GetRetention(ctx, req.(*GetOrganizationRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_UpdateRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).UpdateRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/UpdateRetention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).UpdateRetention(ctx, req.(*UpdateOrganizationRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).CreateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/CreateServiceAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).CreateServiceAccount(ctx, req.(*CreateServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_GetServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).GetServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/GetServiceAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).GetServiceAccount(ctx, req.(*GetServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_UpdateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).UpdateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/UpdateServiceAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).UpdateServiceAccount(ctx, req.(*UpdateServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_DeleteServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).DeleteServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/DeleteServiceAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).DeleteServiceAccount(ctx, req.(*DeleteServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ListServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ListServiceAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/ListServiceAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ListServiceAccounts(ctx, req.(*ListServiceAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_RegenerateServiceAccountSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateServiceAccountSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).RegenerateServiceAccountSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.OrganizationService/RegenerateServiceAccountSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).RegenerateServiceAccountSecret(ctx, req.(*RegenerateServiceAccountSecretRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1416,6 +3507,82 @@ var _OrganizationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _OrganizationService_DeleteUser_Handler,
 		},
+		{
+			MethodName: "CreateHTTPIntegration",
+			Handler:    _OrganizationService_CreateHTTPIntegration_Handler,
+		},
+		{
+			MethodName: "GetHTTPIntegration",
+			Handler:    _OrganizationService_GetHTTPIntegration_Handler,
+		},
+		{
+			MethodName: "UpdateHTTPIntegration",
+			Handler:    _OrganizationService_UpdateHTTPIntegration_Handler,
+		},
+		{
+			MethodName: "DeleteHTTPIntegration",
+			Handler:    _OrganizationService_DeleteHTTPIntegration_Handler,
+		},
+		{
+			MethodName: "CreateInfluxDBIntegration",
+			Handler:    _OrganizationService_CreateInfluxDBIntegration_Handler,
+		},
+		{
+			MethodName: "GetInfluxDBIntegration",
+			Handler:    _OrganizationService_GetInfluxDBIntegration_Handler,
+		},
+		{
+			MethodName: "UpdateInfluxDBIntegration",
+			Handler:    _OrganizationService_UpdateInfluxDBIntegration_Handler,
+		},
+		{
+			MethodName: "DeleteInfluxDBIntegration",
+			Handler:    _OrganizationService_DeleteInfluxDBIntegration_Handler,
+		},
+		{
+			MethodName: "ListIntegrations",
+			Handler:    _OrganizationService_ListIntegrations_Handler,
+		},
+		{
+			MethodName: "GetSettings",
+			Handler:    _OrganizationService_GetSettings_Handler,
+		},
+		{
+			MethodName: "UpdateSettings",
+			Handler:    _OrganizationService_UpdateSettings_Handler,
+		},
+		{
+			MethodName: "GetRetention",
+			Handler:    _OrganizationService_GetRetention_Handler,
+		},
+		{
+			MethodName: "UpdateRetention",
+			Handler:    _OrganizationService_UpdateRetention_Handler,
+		},
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _OrganizationService_CreateServiceAccount_Handler,
+		},
+		{
+			MethodName: "GetServiceAccount",
+			Handler:    _OrganizationService_GetServiceAccount_Handler,
+		},
+		{
+			MethodName: "UpdateServiceAccount",
+			Handler:    _OrganizationService_UpdateServiceAccount_Handler,
+		},
+		{
+			MethodName: "DeleteServiceAccount",
+			Handler:    _OrganizationService_DeleteServiceAccount_Handler,
+		},
+		{
+			MethodName: "ListServiceAccounts",
+			Handler:    _OrganizationService_ListServiceAccounts_Handler,
+		},
+		{
+			MethodName: "RegenerateServiceAccountSecret",
+			Handler:    _OrganizationService_RegenerateServiceAccountSecret_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "organization.proto",
@@ -1424,66 +3591,156 @@ var _OrganizationService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("organization.proto", fileDescriptor_8d10c68ef159b9ed) }
 
 var fileDescriptor_8d10c68ef159b9ed = []byte{
-	// 971 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4d, 0x6f, 0x23, 0x35,
-	0x18, 0x96, 0x93, 0x34, 0x6d, 0xdf, 0x54, 0xbb, 0x5b, 0x53, 0x9a, 0x64, 0xda, 0x90, 0xac, 0x85,
-	0x44, 0x08, 0xab, 0x44, 0x04, 0x16, 0x09, 0xb4, 0x97, 0xb0, 0x45, 0xa1, 0x12, 0x02, 0x69, 0x60,
-	0x25, 0x2e, 0x30, 0x78, 0x33, 0x6e, 0x6b, 0x29, 0x99, 0x99, 0x8d, 0x3d, 0x5d, 0x95, 0x55, 0x0f,
-	0x70, 0xd8, 0x03, 0x7b, 0xe4, 0x77, 0xf0, 0x37, 0xf8, 0x03, 0x1c, 0xb8, 0x72, 0xe0, 0x37, 0x70,
-	0x65, 0x65, 0x8f, 0x53, 0x39, 0xf3, 0xd1, 0xaf, 0xad, 0xd4, 0xdb, 0xd8, 0x7e, 0xfc, 0x3e, 0xcf,
-	0xfb, 0xf8, 0x7d, 0xed, 0x01, 0x1c, 0xce, 0x0f, 0x69, 0xc0, 0x7f, 0xa6, 0x92, 0x87, 0x41, 0x3f,
-	0x9a, 0x87, 0x32, 0xc4, 0x65, 0x1a, 0x71, 0x67, 0xf7, 0x30, 0x0c, 0x0f, 0xa7, 0x6c, 0x40, 0x23,
-	0x3e, 0xa0, 0x41, 0x10, 0x4a, 0x8d, 0x10, 0x09, 0xc4, 0x69, 0x9b, 0x55, 0x3d, 0x7a, 0x1a, 0x1f,
-	0x0c, 0x24, 0x9f, 0x31, 0x21, 0xe9, 0x2c, 0x32, 0x80, 0x9d, 0x34, 0x80, 0xcd, 0x22, 0x79, 0x92,
-	0x2c, 0x92, 0x5f, 0x10, 0x6c, 0x7c, 0x63, 0xf1, 0xe2, 0x3b, 0x50, 0xe2, 0x7e, 0x03, 0x75, 0x50,
-	0xb7, 0xec, 0x96, 0xb8, 0x8f, 0x31, 0x54, 0x02, 0x3a, 0x63, 0x8d, 0x52, 0x07, 0x75, 0xd7, 0x5d,
-	0xfd, 0x8d, 0xef, 0xc3, 0x86, 0xcf, 0x45, 0x34, 0xa5, 0x27, 0x9e, 0x5e, 0x2b, 0xeb, 0xb5, 0x9a,
-	0x99, 0xfb, 0x5a, 0x41, 0x7a, 0xb0, 0x39, 0xa1, 0x81, 0x77, 0x44, 0x8f, 0x99, 0x77, 0x48, 0x25,
-	0x7b, 0x4e, 0x4f, 0x44, 0xa3, 0xd2, 0x41, 0xdd, 0x35, 0xf7, 0xee, 0x84, 0x06, 0x5f, 0xd2, 0x63,
-	0x36, 0x36, 0xd3, 0xe4, 0x7f, 0x04, 0x5b, 0xb6, 0x86, 0xaf, 0xb8, 0x90, 0xfb, 0x92, 0xcd, 0x6e,
-	0x41, 0x0b, 0xfe, 0x14, 0x60, 0x32, 0x67, 0x54, 0x32, 0xdf, 0xa3, 0xb2, 0xb1, 0xd2, 0x41, 0xdd,
-	0xda, 0xd0, 0xe9, 0x27, 0x0e, 0xf6, 0x17, 0x0e, 0xf6, 0xbf, 0x5b, 0x58, 0xec, 0xae, 0x1b, 0xf4,
-	0x48, 0xaa, 0xad, 0x71, 0xe4, 0x2f, 0xb6, 0x56, 0x2f, 0xde, 0x6a, 0xd0, 0x23, 0x49, 0xba, 0xb0,
-	0x3d, 0x66, 0xd2, 0xf6, 0xc0, 0x65, 0xcf, 0x62, 0x26, 0x64, 0xda, 0x02, 0xf2, 0x27, 0x82, 0x7a,
-	0x06, 0x2a, 0xa2, 0x30, 0x10, 0x0c, 0x3f, 0x84, 0x0d, 0xbb, 0x84, 0xf4, 0xae, 0xda, 0x70, 0xb3,
-	0x4f, 0x23, 0xde, 0x5f, 0xda, 0xb0, 0x04, 0x4b, 0xa5, 0x5c, 0xba, 0x7e, 0xca, 0xe5, 0xab, 0xa4,
-	0xec, 0x42, 0xf3, 0xb1, 0x8e, 0x93, 0x97, 0xf5, 0xf5, 0x32, 0x21, 0x0f, 0xc0, 0xc9, 0x8b, 0x69,
-	0xec, 0x49, 0x5b, 0xe9, 0x42, 0xf3, 0x89, 0x96, 0x73, 0x83, 0x0a, 0x3e, 0x80, 0xe6, 0x1e, 0x9b,
-	0xb2, 0xfc, 0x98, 0x69, 0x01, 0x1e, 0xd4, 0x55, 0xa9, 0xe7, 0x41, 0xb7, 0x60, 0x65, 0xca, 0x67,
-	0x5c, 0x1a, 0x74, 0x32, 0xc0, 0xdb, 0x50, 0x0d, 0x0f, 0x0e, 0x04, 0x4b, 0x4e, 0xa9, 0xec, 0x9a,
-	0x91, 0x9a, 0x17, 0x8c, 0xce, 0x27, 0x47, 0xa6, 0xfa, 0xcd, 0x88, 0x04, 0xd0, 0xc8, 0x12, 0x18,
-	0x37, 0xda, 0x50, 0x93, 0xa1, 0xa4, 0x53, 0x6f, 0x12, 0xc6, 0xc1, 0x82, 0x07, 0xf4, 0xd4, 0x63,
-	0x35, 0x83, 0x3f, 0x84, 0xea, 0x9c, 0x89, 0x78, 0xaa, 0xc8, 0xca, 0xdd, 0xda, 0xb0, 0x99, 0xc9,
-	0x7d, 0xd1, 0xa7, 0xae, 0x01, 0x92, 0x57, 0x08, 0xee, 0xd9, 0x80, 0x27, 0x82, 0xcd, 0xf1, 0x7b,
-	0x70, 0xd7, 0xb6, 0xc8, 0x3b, 0xb3, 0xe0, 0x8e, 0x3d, 0xbd, 0xbf, 0x87, 0xeb, 0xb0, 0x1a, 0x0b,
-	0x36, 0x57, 0x00, 0x93, 0x9e, 0x1a, 0xee, 0xef, 0xe1, 0x26, 0xac, 0x71, 0xe1, 0x51, 0x7f, 0xc6,
-	0x03, 0x9d, 0xe0, 0x9a, 0xbb, 0xca, 0xc5, 0x48, 0x0d, 0xb1, 0x03, 0x6b, 0x0a, 0xa4, 0x3b, 0xbf,
-	0xa2, 0x73, 0x3f, 0x1b, 0x93, 0x7f, 0x10, 0x34, 0xd2, 0x6a, 0xce, 0xae, 0x16, 0x8b, 0x0c, 0x2d,
-	0x91, 0xd9, 0x11, 0x4b, 0xcb, 0x11, 0xcf, 0x13, 0xb2, 0xdc, 0x44, 0x95, 0xeb, 0x37, 0xd1, 0xca,
-	0x55, 0x9a, 0xe8, 0x27, 0x70, 0x46, 0xbe, 0x9f, 0x4e, 0x72, 0x51, 0x44, 0x9f, 0xc3, 0xe6, 0x92,
-	0xf3, 0x2a, 0x0f, 0x53, 0xc8, 0x6f, 0x67, 0x0e, 0x53, 0x6f, 0xbc, 0x17, 0xa6, 0x66, 0xc8, 0x04,
-	0x5a, 0xd9, 0x26, 0xb9, 0x69, 0x12, 0x0a, 0xad, 0x6c, 0xd7, 0xd8, 0x24, 0x6f, 0x5c, 0x43, 0x24,
-	0x86, 0xdd, 0x74, 0x2b, 0x28, 0x02, 0x71, 0x65, 0x86, 0xb3, 0xce, 0x54, 0xf1, 0x57, 0xb2, 0x9d,
-	0x59, 0xd6, 0xd3, 0x66, 0x44, 0x9e, 0x43, 0xab, 0x80, 0xf6, 0xb2, 0x6d, 0xf8, 0x30, 0xd5, 0x86,
-	0xad, 0x5c, 0x53, 0x33, 0xad, 0xf8, 0x23, 0x38, 0xa9, 0x67, 0xe2, 0x66, 0xfd, 0xfc, 0x1b, 0xc1,
-	0x4e, 0x2e, 0x81, 0xc9, 0xeb, 0x06, 0xca, 0xe2, 0x76, 0x1e, 0xa6, 0xe1, 0x7f, 0xeb, 0xf0, 0x96,
-	0x2d, 0xee, 0x5b, 0x36, 0x3f, 0xe6, 0x13, 0x86, 0x3d, 0xa8, 0x28, 0x97, 0xf1, 0xae, 0x96, 0x5f,
-	0x70, 0x71, 0x3b, 0xad, 0x82, 0xd5, 0xc4, 0x16, 0xe2, 0xfc, 0xfa, 0xd7, 0xbf, 0xbf, 0x97, 0xb6,
-	0x30, 0xd6, 0x3f, 0x73, 0x76, 0xc6, 0x02, 0x53, 0x28, 0x8f, 0x99, 0xc4, 0x3b, 0x3a, 0x42, 0xfe,
-	0xef, 0x80, 0xb3, 0x9b, 0xbf, 0x68, 0xa2, 0xb7, 0x75, 0xf4, 0x26, 0xae, 0x67, 0xa3, 0x0f, 0x5e,
-	0x70, 0xff, 0x14, 0x1f, 0x41, 0x35, 0x79, 0x20, 0xf1, 0x3b, 0x3a, 0x50, 0xe1, 0x0b, 0xec, 0xb4,
-	0x0b, 0xd7, 0x0d, 0x57, 0x4b, 0x73, 0xd5, 0x49, 0x4e, 0x26, 0x9f, 0xa1, 0x1e, 0x7e, 0x06, 0xd5,
-	0xe4, 0xde, 0x30, 0x4c, 0x85, 0x2f, 0xad, 0xb3, 0x9d, 0x39, 0x96, 0x2f, 0xd4, 0xff, 0x29, 0x19,
-	0x68, 0x82, 0xf7, 0x9d, 0x77, 0xf3, 0x92, 0x59, 0xfa, 0x55, 0xe6, 0xfe, 0xa9, 0xa2, 0xa4, 0x50,
-	0x4d, 0x6e, 0x11, 0x43, 0x59, 0xf8, 0x10, 0x17, 0x52, 0x1a, 0xff, 0x7a, 0x85, 0xfe, 0xbd, 0x44,
-	0xb0, 0xae, 0xce, 0x56, 0xf7, 0x30, 0xbe, 0x9f, 0x7b, 0xd6, 0xf6, 0xb5, 0xe2, 0x90, 0xf3, 0x20,
-	0xc6, 0xc9, 0xa1, 0x66, 0x7d, 0x80, 0x7b, 0x17, 0x25, 0xea, 0x71, 0xff, 0x74, 0x10, 0x6b, 0xea,
-	0xdf, 0x10, 0xac, 0x8e, 0x99, 0xd6, 0x81, 0xdb, 0x79, 0x35, 0x61, 0x75, 0xbb, 0xd3, 0x29, 0x06,
-	0x18, 0x09, 0x8f, 0xb4, 0x84, 0x4f, 0xf0, 0xc7, 0x97, 0x97, 0x30, 0x78, 0x61, 0x2e, 0x86, 0x53,
-	0xfc, 0x0a, 0xc1, 0xea, 0xc8, 0xf7, 0x2d, 0x31, 0xc5, 0x8f, 0x52, 0xa1, 0xf7, 0x63, 0x2d, 0x61,
-	0x44, 0x1e, 0x5d, 0x28, 0x41, 0xf1, 0xf6, 0xf3, 0x45, 0xa9, 0x32, 0xf8, 0x03, 0x01, 0x24, 0xd5,
-	0xa6, 0x05, 0x91, 0x82, 0xf2, 0xbb, 0x8c, 0xa6, 0x89, 0xd6, 0xf4, 0x83, 0xf3, 0xfd, 0x9b, 0x68,
-	0xca, 0x43, 0x2e, 0xac, 0x53, 0x7a, 0x5f, 0x22, 0x80, 0xa4, 0x54, 0x2d, 0xbd, 0xe7, 0x3e, 0x87,
-	0x85, 0x7a, 0xcd, 0x31, 0xf6, 0xae, 0x75, 0x8c, 0x4f, 0xab, 0x3a, 0xda, 0x47, 0xaf, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x3c, 0xad, 0xf9, 0x9b, 0x88, 0x0e, 0x00, 0x00,
+	// 2401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc5, 0x59, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x57, 0xcf, 0xd8, 0xe3, 0x99, 0x37, 0x8e, 0x3f, 0xca, 0x8e, 0x3d, 0x6e, 0xdb, 0xb1, 0xdd,
+	0x4e, 0x42, 0x32, 0x9b, 0xcc, 0xec, 0x3a, 0x24, 0x9b, 0x5d, 0xb2, 0x2b, 0x1c, 0xdb, 0x78, 0xbd,
+	0x6b, 0x76, 0xc3, 0x24, 0x41, 0x08, 0x04, 0x43, 0x67, 0xa6, 0x3c, 0x6e, 0x76, 0xbe, 0xb6, 0xbb,
+	0xc7, 0x49, 0x58, 0xe5, 0x82, 0x04, 0x2b, 0xc1, 0x81, 0xc3, 0x6a, 0x85, 0x84, 0xc4, 0x01, 0xc4,
+	0x85, 0x03, 0xfc, 0x11, 0x1c, 0x38, 0x02, 0x12, 0x42, 0xfc, 0x03, 0x5c, 0xf9, 0x0f, 0x90, 0xa0,
+	0xea, 0x55, 0xf5, 0x4c, 0x7f, 0x54, 0xcf, 0x87, 0xed, 0x95, 0x2f, 0x96, 0xfb, 0xd5, 0xab, 0x7a,
+	0xbf, 0xf7, 0xab, 0xf7, 0xaa, 0x5e, 0xbd, 0x01, 0xd2, 0xb2, 0x6b, 0x66, 0xd3, 0xfa, 0xb1, 0xe9,
+	0x5a, 0xad, 0x66, 0xa1, 0x6d, 0xb7, 0xdc, 0x16, 0x49, 0x9a, 0x6d, 0x4b, 0x5f, 0xa9, 0xb5, 0x5a,
+	0xb5, 0x3a, 0x2d, 0xb2, 0xff, 0x8b, 0x66, 0xb3, 0xd9, 0x72, 0x51, 0xc3, 0x11, 0x2a, 0xfa, 0x9a,
+	0x1c, 0xc5, 0xaf, 0x67, 0x9d, 0xa3, 0xa2, 0x6b, 0x35, 0xa8, 0xe3, 0x9a, 0x8d, 0xb6, 0x54, 0x58,
+	0x0e, 0x2b, 0xd0, 0x46, 0xdb, 0x7d, 0x29, 0x07, 0x67, 0xcd, 0x76, 0xbb, 0x6e, 0x55, 0x7c, 0x36,
+	0x8d, 0x7f, 0x69, 0x30, 0xf9, 0x91, 0x0f, 0x0a, 0x99, 0x82, 0x84, 0x55, 0xcd, 0x69, 0xeb, 0xda,
+	0x8d, 0x64, 0x89, 0xfd, 0x47, 0x08, 0x8c, 0x35, 0xcd, 0x06, 0xcd, 0x25, 0x98, 0x24, 0x53, 0xc2,
+	0xff, 0xc9, 0x06, 0x4c, 0x56, 0x2d, 0xa7, 0x5d, 0x37, 0x5f, 0x96, 0x71, 0x2c, 0x89, 0x63, 0x59,
+	0x29, 0xfb, 0x90, 0xab, 0xe4, 0x61, 0xb6, 0x62, 0x36, 0xcb, 0xc7, 0xe6, 0x09, 0x2d, 0xd7, 0x4c,
+	0x97, 0x3e, 0x37, 0x5f, 0x3a, 0xb9, 0x31, 0xa6, 0x97, 0x2e, 0x4d, 0xb3, 0x81, 0xf7, 0x98, 0x7c,
+	0x5f, 0x8a, 0xc9, 0x0d, 0x98, 0x69, 0x98, 0x2f, 0xca, 0x55, 0x7a, 0x62, 0x55, 0x68, 0xb9, 0xd2,
+	0xea, 0x34, 0xdd, 0xdc, 0x38, 0x02, 0x98, 0x62, 0xf2, 0x5d, 0x14, 0xef, 0x70, 0x29, 0x5f, 0x95,
+	0x6b, 0xca, 0x05, 0xa5, 0x6a, 0x0a, 0x55, 0xa7, 0xd9, 0x80, 0x5c, 0x11, 0x75, 0x8d, 0xff, 0x69,
+	0x30, 0xef, 0xf7, 0xec, 0xd0, 0x72, 0xdc, 0x03, 0x97, 0x36, 0x2e, 0xc2, 0xc3, 0xb7, 0x00, 0x2a,
+	0x36, 0x65, 0x5f, 0xd5, 0xb2, 0x29, 0x7c, 0xcb, 0x6e, 0xe9, 0x05, 0xb1, 0x55, 0x05, 0x6f, 0xab,
+	0x0a, 0x4f, 0xbc, 0xbd, 0x2c, 0x65, 0xa4, 0xf6, 0xb6, 0xcb, 0xa7, 0x76, 0xda, 0x55, 0x6f, 0x6a,
+	0x6a, 0xf0, 0x54, 0xa9, 0xbd, 0xed, 0x1a, 0x37, 0x60, 0x61, 0x9f, 0xba, 0x7e, 0x0e, 0x4a, 0xf4,
+	0x93, 0x0e, 0xd3, 0x0b, 0x53, 0x60, 0xfc, 0x45, 0x83, 0xc5, 0x88, 0xaa, 0xd3, 0x66, 0x71, 0x47,
+	0xc9, 0x5d, 0x98, 0xf4, 0xc7, 0x2a, 0xce, 0xca, 0x6e, 0xcd, 0x16, 0x58, 0x80, 0x16, 0x02, 0x13,
+	0x02, 0x6a, 0x21, 0x97, 0x13, 0xa7, 0x77, 0x39, 0x39, 0x8a, 0xcb, 0x25, 0x58, 0xda, 0xc1, 0x75,
+	0x54, 0x5e, 0x9f, 0xce, 0x13, 0xe3, 0x16, 0xe8, 0xaa, 0x35, 0x25, 0x3d, 0x61, 0x2a, 0x19, 0x82,
+	0xa7, 0x08, 0xe7, 0x1c, 0x11, 0xbc, 0x06, 0x4b, 0xbb, 0xb4, 0x4e, 0xd5, 0x6b, 0x86, 0x01, 0x94,
+	0x61, 0x91, 0x87, 0xba, 0x4a, 0x75, 0x1e, 0xc6, 0xeb, 0x56, 0xc3, 0x72, 0xa5, 0xb6, 0xf8, 0x20,
+	0x0b, 0x90, 0x6a, 0x1d, 0x1d, 0x39, 0x54, 0xec, 0x52, 0xb2, 0x24, 0xbf, 0xb8, 0xdc, 0xa1, 0xa6,
+	0x5d, 0x39, 0x96, 0xd1, 0x2f, 0xbf, 0x8c, 0x26, 0xe4, 0xa2, 0x06, 0x24, 0x1b, 0x6b, 0x90, 0x75,
+	0xd9, 0x91, 0x55, 0x97, 0xa9, 0x29, 0xec, 0x00, 0x8a, 0x44, 0x06, 0xbf, 0x01, 0x29, 0x9b, 0x3a,
+	0x9d, 0x3a, 0x37, 0x96, 0x64, 0xbe, 0x2f, 0x45, 0x7c, 0xf7, 0xf2, 0xb4, 0x24, 0x15, 0x8d, 0xff,
+	0x68, 0x30, 0xe3, 0x57, 0x78, 0xea, 0x50, 0x9b, 0x7c, 0x05, 0xa6, 0xfd, 0x14, 0x95, 0xbb, 0x14,
+	0x4c, 0xf9, 0xc5, 0x07, 0xbb, 0x64, 0x11, 0x26, 0x3a, 0x6c, 0x02, 0x57, 0x90, 0xee, 0xf1, 0x4f,
+	0x36, 0xb0, 0x04, 0x69, 0xcb, 0x29, 0x9b, 0xd5, 0x86, 0xd5, 0x44, 0x07, 0xd3, 0xa5, 0x09, 0xcb,
+	0xd9, 0xe6, 0x9f, 0x44, 0x87, 0x34, 0x57, 0xc2, 0xcc, 0x1f, 0x43, 0xdf, 0xbb, 0xdf, 0xc4, 0x80,
+	0x4b, 0x3c, 0xed, 0x4f, 0x2c, 0xfa, 0xbc, 0xfc, 0x31, 0x65, 0x29, 0x3f, 0x8e, 0x73, 0xb3, 0x4c,
+	0xf8, 0x6d, 0x26, 0xfb, 0x80, 0x89, 0xc8, 0x2a, 0x8b, 0x7d, 0xa6, 0x43, 0x5f, 0xb4, 0x5b, 0xb6,
+	0xc8, 0xd9, 0x34, 0x8b, 0x6f, 0xb3, 0xb9, 0x87, 0x02, 0x3e, 0xcc, 0x2d, 0x77, 0xaa, 0x96, 0xdb,
+	0xb2, 0x73, 0x13, 0x62, 0x98, 0xd9, 0x16, 0x02, 0xe3, 0xcf, 0x09, 0xc8, 0x85, 0xfd, 0xed, 0x1e,
+	0x5e, 0x3e, 0x77, 0xb4, 0x80, 0x3b, 0x7e, 0xcc, 0x89, 0x10, 0xe6, 0x3e, 0xae, 0x06, 0xd3, 0x74,
+	0xec, 0xf4, 0x69, 0x3a, 0x3e, 0x42, 0x9a, 0x46, 0x49, 0x4c, 0x0d, 0x22, 0x71, 0xa2, 0x3f, 0x89,
+	0xe9, 0x30, 0x89, 0x3f, 0x04, 0x7d, 0xbb, 0x5a, 0x0d, 0xd3, 0xe8, 0x25, 0xc2, 0x43, 0x98, 0x0d,
+	0x44, 0x0f, 0x67, 0x4a, 0x26, 0xe3, 0xe5, 0x48, 0x40, 0xe2, 0xc4, 0x99, 0x56, 0x48, 0x62, 0x54,
+	0x60, 0x35, 0x9a, 0xe8, 0xe7, 0x6d, 0xc4, 0x84, 0xd5, 0x68, 0xe6, 0xfb, 0x8d, 0x9c, 0x39, 0x0f,
+	0x8c, 0x0e, 0xac, 0x84, 0xd3, 0x99, 0x1b, 0x70, 0x46, 0xb6, 0xd0, 0x3d, 0x5d, 0xf8, 0xfa, 0xe3,
+	0xd1, 0xd3, 0x25, 0x89, 0x62, 0xf9, 0x65, 0x3c, 0x87, 0xd5, 0x18, 0xb3, 0xc3, 0x1e, 0x25, 0x77,
+	0x43, 0x47, 0xc9, 0xaa, 0x92, 0xd4, 0xc8, 0x71, 0xf2, 0x03, 0xd0, 0x43, 0x57, 0xdd, 0xf9, 0xf2,
+	0xc9, 0x2a, 0xaa, 0x65, 0xa5, 0x01, 0xe9, 0xd7, 0x39, 0x84, 0xc5, 0x05, 0x5d, 0xae, 0x87, 0xb0,
+	0x11, 0x72, 0xec, 0xa0, 0xe9, 0xd2, 0x9a, 0x1d, 0xb8, 0x63, 0x86, 0x25, 0xd0, 0xf8, 0x08, 0xae,
+	0x46, 0x43, 0xfb, 0x2c, 0x0b, 0x7e, 0x08, 0x9b, 0xe1, 0x88, 0xf2, 0x2d, 0x37, 0x72, 0x3c, 0x1b,
+	0xff, 0x4d, 0x04, 0x0b, 0xc8, 0xc7, 0xd4, 0x75, 0xad, 0x66, 0xcd, 0x19, 0x3e, 0x46, 0xd8, 0xb9,
+	0x5b, 0x6f, 0xd5, 0x5a, 0xe5, 0x8e, 0x5d, 0x97, 0x67, 0xf2, 0x04, 0xff, 0x7e, 0x5a, 0x3a, 0x24,
+	0x9b, 0x70, 0xa9, 0x6d, 0x5b, 0x0d, 0xd3, 0xe6, 0x55, 0x6c, 0x9d, 0x9d, 0x60, 0xe2, 0x8e, 0x9d,
+	0x94, 0xc2, 0x1d, 0x2e, 0xe3, 0x86, 0x1c, 0x5a, 0x69, 0x35, 0xab, 0x3d, 0x35, 0x71, 0x1d, 0x4d,
+	0x75, 0xc5, 0x42, 0xf1, 0x1a, 0x4c, 0x55, 0xe9, 0x91, 0xc9, 0xc2, 0xbb, 0x6c, 0xd3, 0x1a, 0xaf,
+	0x2c, 0xc6, 0x51, 0xef, 0x92, 0x94, 0x96, 0x50, 0xc8, 0xab, 0x5a, 0x36, 0xcd, 0x35, 0x2b, 0xae,
+	0xa8, 0x6a, 0x53, 0xa2, 0xaa, 0x95, 0x32, 0xac, 0x6a, 0x19, 0x2e, 0x4f, 0x85, 0x36, 0x4c, 0xab,
+	0x8e, 0x07, 0x2f, 0xc3, 0x25, 0x85, 0x7b, 0x5c, 0xe6, 0x57, 0x6a, 0x1f, 0xb7, 0x9a, 0x14, 0x8f,
+	0xdf, 0x9e, 0xd2, 0x23, 0x2e, 0x23, 0xef, 0x32, 0x63, 0x1d, 0xc7, 0x6d, 0x35, 0xca, 0x75, 0xab,
+	0xf9, 0xb1, 0x93, 0xcb, 0x60, 0x92, 0x2e, 0x47, 0x42, 0x7c, 0x07, 0x95, 0x0e, 0x99, 0x0e, 0x43,
+	0xd2, 0xfd, 0xdf, 0x31, 0xbe, 0x0e, 0x0b, 0x6a, 0x35, 0x7e, 0xd0, 0xb8, 0x96, 0x5b, 0xa7, 0xc8,
+	0x7a, 0xa6, 0x24, 0x3e, 0xc8, 0x0c, 0x24, 0x7b, 0x3c, 0xf3, 0x7f, 0x8d, 0x03, 0xb8, 0x12, 0x8a,
+	0x57, 0x6f, 0x0b, 0x47, 0x8e, 0x85, 0xbf, 0x6a, 0xb0, 0x16, 0xbb, 0x56, 0xb7, 0x50, 0x4e, 0x3b,
+	0x52, 0x26, 0xf3, 0x39, 0x5a, 0xdc, 0x74, 0x27, 0x75, 0x55, 0x2f, 0x28, 0x97, 0xbf, 0x0b, 0x1b,
+	0xd1, 0xdb, 0x2b, 0x4c, 0xcf, 0xe9, 0x3c, 0xe2, 0x64, 0x5d, 0x0e, 0x56, 0x87, 0x2e, 0x6d, 0xe2,
+	0xa3, 0x60, 0xe8, 0xcc, 0x29, 0xc0, 0x1c, 0x0b, 0xf1, 0x8e, 0x6d, 0xb9, 0x2f, 0xcb, 0xf4, 0x84,
+	0xcd, 0x2e, 0x57, 0xf9, 0xf3, 0x8a, 0xb3, 0x73, 0xa9, 0x34, 0xeb, 0x0d, 0xed, 0xf1, 0x91, 0x5d,
+	0xfe, 0xc0, 0x7a, 0x1d, 0xe6, 0xe5, 0xf3, 0xb1, 0xde, 0x12, 0xef, 0x5b, 0x31, 0x21, 0x89, 0x13,
+	0x88, 0x18, 0x3b, 0x94, 0x43, 0x38, 0x83, 0x3d, 0xdf, 0xbc, 0x67, 0x64, 0x9b, 0xa1, 0x16, 0xea,
+	0x63, 0xa8, 0x3e, 0x2d, 0x07, 0x1e, 0x31, 0x39, 0xd7, 0x35, 0xfe, 0xa4, 0x05, 0x2b, 0xb2, 0xc7,
+	0xac, 0xc2, 0x30, 0x6b, 0xf4, 0xa9, 0xc3, 0xfe, 0x70, 0xd3, 0x21, 0xa8, 0xfe, 0x0b, 0x8b, 0x04,
+	0xb0, 0x8a, 0x8b, 0x6b, 0x0b, 0x2e, 0x87, 0xc1, 0x8a, 0x29, 0xe2, 0x22, 0x99, 0x0b, 0xa2, 0x15,
+	0x73, 0x6e, 0x01, 0x09, 0xc0, 0x15, 0x13, 0x92, 0x38, 0x61, 0xc6, 0x87, 0x57, 0xbc, 0x7d, 0xdf,
+	0x8f, 0x44, 0x6b, 0x77, 0x0f, 0x46, 0x0e, 0xfd, 0xbf, 0x27, 0x60, 0x3d, 0x7e, 0x31, 0x19, 0xfb,
+	0xf7, 0x21, 0x63, 0x7b, 0x42, 0x19, 0x2a, 0x7a, 0xf4, 0x55, 0xd3, 0x9d, 0xd6, 0x53, 0x26, 0xfb,
+	0x30, 0xdb, 0x3b, 0xba, 0xbc, 0x15, 0x12, 0x03, 0x57, 0x98, 0xe9, 0x9e, 0x6c, 0xde, 0x42, 0x77,
+	0x60, 0xbc, 0xc3, 0x37, 0x44, 0xe6, 0x41, 0xb4, 0x1a, 0xf0, 0xef, 0x5a, 0x49, 0xe8, 0x5e, 0x4c,
+	0xf9, 0xcb, 0x4a, 0x10, 0x43, 0xf5, 0x46, 0x0c, 0xed, 0xd0, 0xa9, 0x39, 0x35, 0xfe, 0x99, 0x80,
+	0xa9, 0xc7, 0xd4, 0xe6, 0x51, 0xb4, 0x5d, 0xc1, 0x48, 0x89, 0x34, 0x3d, 0x14, 0xdb, 0x9f, 0x50,
+	0x66, 0xa2, 0xd7, 0x1d, 0x49, 0xfa, 0xba, 0x23, 0xeb, 0x90, 0xad, 0x52, 0xa7, 0x62, 0x5b, 0x6d,
+	0xc4, 0x36, 0x26, 0x9b, 0x23, 0x3d, 0x11, 0x59, 0x86, 0x4c, 0xa5, 0x6e, 0xf1, 0x64, 0x60, 0x0b,
+	0x8b, 0xbb, 0x28, 0x2d, 0x04, 0x6c, 0x49, 0x56, 0xba, 0xb7, 0x3b, 0xcf, 0xea, 0x56, 0x85, 0xd7,
+	0xfe, 0xf2, 0x12, 0xca, 0x08, 0x09, 0xab, 0xfc, 0xf9, 0x5c, 0x5e, 0xd9, 0x57, 0x5c, 0xeb, 0x84,
+	0xca, 0xba, 0x9f, 0x3d, 0x5f, 0xb6, 0xf1, 0x3b, 0xf0, 0x94, 0x49, 0x07, 0x9f, 0x32, 0x91, 0x47,
+	0x45, 0x66, 0xd0, 0xa3, 0x02, 0xfa, 0x3f, 0x2a, 0xb2, 0xe1, 0x47, 0xc5, 0x67, 0x09, 0x58, 0x08,
+	0xf2, 0x3a, 0x52, 0x53, 0x29, 0x40, 0x4a, 0x32, 0x44, 0x4a, 0xc0, 0xeb, 0xb1, 0x3e, 0x5e, 0x8f,
+	0xf7, 0x7b, 0xc0, 0xa5, 0x4e, 0x1f, 0xc1, 0x13, 0xa3, 0x44, 0xf0, 0xf7, 0x60, 0x59, 0xf4, 0x44,
+	0x82, 0x74, 0x78, 0xa1, 0xfb, 0x80, 0x17, 0x2e, 0x38, 0xc0, 0x3c, 0xea, 0x1d, 0x87, 0xd9, 0xad,
+	0x39, 0x0c, 0xe0, 0xd0, 0xa4, 0x29, 0x27, 0xf0, 0x6d, 0xb4, 0x61, 0x45, 0xbd, 0xb8, 0xba, 0xe5,
+	0x12, 0xe4, 0x35, 0x11, 0xe2, 0x95, 0xd7, 0x2a, 0x62, 0x90, 0x9d, 0xc4, 0xb6, 0x7c, 0x86, 0xf0,
+	0x5a, 0x05, 0x85, 0x8f, 0x51, 0x66, 0xe4, 0x21, 0xc7, 0x8e, 0x38, 0xb5, 0x2f, 0xe1, 0xfe, 0xca,
+	0xdf, 0x34, 0x58, 0x52, 0x28, 0x4b, 0x6c, 0x67, 0xf2, 0xfc, 0x82, 0x6a, 0x01, 0xb6, 0x99, 0xe2,
+	0x38, 0xfa, 0x32, 0x36, 0xf3, 0x36, 0x2c, 0x8b, 0x32, 0x7f, 0x38, 0x76, 0x1d, 0xd0, 0x79, 0x4e,
+	0x05, 0x95, 0xbf, 0xec, 0xb7, 0xa8, 0x03, 0xcb, 0x4a, 0xa3, 0xc3, 0xbe, 0x44, 0xef, 0x84, 0x5e,
+	0xa2, 0xcb, 0x0a, 0x62, 0x22, 0xef, 0xd0, 0x37, 0xe1, 0x1a, 0x2b, 0xcb, 0x69, 0x93, 0xda, 0x11,
+	0xe6, 0x45, 0x54, 0xc6, 0x51, 0xf4, 0x23, 0xb8, 0x3e, 0x68, 0xa2, 0x04, 0x1e, 0x48, 0x0c, 0x6d,
+	0x50, 0x62, 0x24, 0xa2, 0x89, 0xb1, 0xf5, 0xd3, 0x0d, 0x98, 0x0b, 0x56, 0x7b, 0x68, 0x8e, 0x94,
+	0x61, 0x8c, 0x3b, 0x44, 0x56, 0xd0, 0xd3, 0x98, 0x7e, 0xa3, 0xbe, 0x1a, 0x33, 0x2a, 0xe0, 0x19,
+	0xfa, 0x4f, 0xfe, 0xf1, 0xef, 0xcf, 0x13, 0xf3, 0x84, 0xe0, 0x8f, 0x1d, 0xfe, 0x1d, 0x74, 0x88,
+	0x09, 0x49, 0x96, 0x64, 0x44, 0x30, 0xa9, 0xee, 0x62, 0xeb, 0x2b, 0xea, 0x41, 0xb9, 0xfa, 0x1a,
+	0xae, 0xbe, 0x44, 0x16, 0xa3, 0xab, 0x17, 0x3f, 0xb5, 0xaa, 0xaf, 0xc8, 0x31, 0xa4, 0xc4, 0x31,
+	0x43, 0xae, 0xe0, 0x42, 0xb1, 0x8d, 0x63, 0x7d, 0x2d, 0x76, 0x5c, 0xda, 0x5a, 0x45, 0x5b, 0x8b,
+	0x86, 0xc2, 0x93, 0xb7, 0xb5, 0x3c, 0xf9, 0x04, 0x52, 0x22, 0xc1, 0xa4, 0xa5, 0xd8, 0x06, 0xb1,
+	0xbe, 0x10, 0xc9, 0xd8, 0x3d, 0xfe, 0xfb, 0x8d, 0x51, 0x44, 0x03, 0x37, 0xf5, 0xab, 0x2a, 0x67,
+	0x02, 0x3f, 0x25, 0x31, 0xcf, 0xb8, 0x49, 0x13, 0x52, 0x22, 0xed, 0xa4, 0xc9, 0xd8, 0xfe, 0x71,
+	0xac, 0x49, 0xc9, 0x5f, 0x3e, 0x96, 0xbf, 0x9f, 0x69, 0x90, 0xe1, 0x7b, 0x8b, 0x6d, 0x1b, 0xb2,
+	0xa1, 0xdc, 0x6b, 0x7f, 0x27, 0x49, 0x37, 0xfa, 0xa9, 0x48, 0x26, 0xb7, 0xd0, 0xea, 0x2d, 0x92,
+	0x1f, 0xe4, 0x28, 0x0b, 0xe9, 0x57, 0xc5, 0x0e, 0x9a, 0xfe, 0xb9, 0x06, 0x13, 0x2c, 0x0a, 0xb0,
+	0x33, 0xb2, 0xa6, 0x8a, 0x09, 0x5f, 0x83, 0x47, 0x5f, 0x8f, 0x57, 0x90, 0x10, 0x1e, 0x20, 0x84,
+	0x7b, 0xe4, 0xab, 0xc3, 0x43, 0x28, 0x7e, 0x2a, 0x7b, 0x41, 0xaf, 0xc8, 0x2f, 0x18, 0x98, 0xed,
+	0x6a, 0xd5, 0x07, 0x26, 0xbe, 0x0f, 0x19, 0xcb, 0xfd, 0x3e, 0x42, 0xd8, 0x36, 0x1e, 0x0c, 0x84,
+	0xc0, 0xed, 0x16, 0xd4, 0xa0, 0x78, 0x18, 0xfc, 0x51, 0x03, 0x10, 0xd1, 0x86, 0x80, 0x8c, 0x98,
+	0xf0, 0x1b, 0x06, 0x53, 0x05, 0x31, 0x7d, 0x5f, 0xff, 0xce, 0x59, 0x30, 0xa9, 0x34, 0x3d, 0xea,
+	0x38, 0x5e, 0x16, 0x53, 0x20, 0x42, 0xd5, 0x87, 0xb7, 0x6f, 0x07, 0x34, 0x16, 0xaf, 0xdc, 0xc6,
+	0xfc, 0xe9, 0xb6, 0xf1, 0xb7, 0xec, 0x0d, 0x2b, 0x12, 0xfe, 0xbd, 0x27, 0x4f, 0x1e, 0xf9, 0xfa,
+	0x48, 0x32, 0xd0, 0x95, 0x63, 0x83, 0x20, 0x7d, 0x13, 0x21, 0xed, 0x1b, 0x0f, 0x95, 0x29, 0xd5,
+	0x5b, 0x27, 0x4a, 0x9e, 0x6f, 0xd0, 0x29, 0x1e, 0xbb, 0x6e, 0x9b, 0x93, 0xf5, 0x1b, 0x0d, 0x08,
+	0x0b, 0xe4, 0x30, 0xc0, 0xeb, 0xaa, 0x08, 0x57, 0xa0, 0xec, 0xa6, 0x4a, 0xc4, 0x0b, 0x99, 0x08,
+	0xef, 0x22, 0xdc, 0xfb, 0xe4, 0xde, 0x50, 0x0c, 0x46, 0x20, 0x22, 0x87, 0x22, 0xd6, 0xd4, 0x1c,
+	0x2a, 0xc7, 0x86, 0xe4, 0x50, 0x3f, 0x27, 0x0e, 0x7f, 0xcd, 0x30, 0x8a, 0xf8, 0x0a, 0x63, 0xbc,
+	0x19, 0x13, 0x7b, 0x23, 0x60, 0x95, 0x04, 0xe6, 0x4f, 0x4b, 0x20, 0xcb, 0x5e, 0xf9, 0x73, 0xe6,
+	0x41, 0xf3, 0xa8, 0xde, 0x79, 0xb1, 0xfb, 0xd0, 0x0f, 0xf0, 0x9a, 0x2f, 0x10, 0x15, 0xe3, 0x83,
+	0xc0, 0x7d, 0x0b, 0xc1, 0x7d, 0x60, 0x7c, 0xe3, 0x6c, 0x44, 0x5a, 0x68, 0xb9, 0xfa, 0x8c, 0x93,
+	0xf9, 0x07, 0x0d, 0x7f, 0x71, 0x56, 0x81, 0x1d, 0x36, 0x28, 0x37, 0x3d, 0x3d, 0xa5, 0x47, 0x32,
+	0x30, 0x1f, 0x22, 0xf4, 0x07, 0xe4, 0xed, 0xd1, 0x79, 0xf5, 0xe0, 0x22, 0xb7, 0x22, 0x00, 0xe3,
+	0xb9, 0x8d, 0x1d, 0x1f, 0x92, 0x5b, 0xfd, 0x1c, 0xb9, 0xfd, 0x9d, 0xe6, 0xfd, 0x08, 0xac, 0xc2,
+	0x7b, 0x0e, 0xc1, 0x2a, 0x49, 0xcd, 0x9f, 0x85, 0xd4, 0x2f, 0x34, 0x98, 0xc1, 0x42, 0xd7, 0x37,
+	0x4a, 0x6e, 0x28, 0xaf, 0x7d, 0x45, 0x6b, 0x5e, 0xef, 0x55, 0x93, 0xaa, 0x5d, 0x7f, 0x0b, 0x01,
+	0xde, 0x21, 0x6f, 0x8c, 0x0c, 0x90, 0xfc, 0x52, 0x83, 0x2c, 0xbe, 0xd9, 0x64, 0xcf, 0x75, 0x53,
+	0x15, 0x8d, 0xa1, 0xee, 0xa7, 0x7e, 0xb5, 0xbf, 0x92, 0x44, 0x75, 0x17, 0x51, 0x15, 0xc9, 0xed,
+	0xa1, 0x50, 0x75, 0xbb, 0xbe, 0x9f, 0x6b, 0x30, 0xe5, 0x3d, 0xba, 0xa4, 0xe8, 0x7a, 0xcc, 0xe5,
+	0x1c, 0xc6, 0x15, 0xb7, 0x81, 0xdb, 0x88, 0xe4, 0x6b, 0xba, 0xf2, 0xb4, 0xf1, 0x0c, 0x17, 0x62,
+	0x21, 0xf1, 0x20, 0x63, 0xa8, 0x26, 0xf7, 0xa9, 0xaf, 0xa9, 0x76, 0x55, 0x5d, 0x62, 0x07, 0x3b,
+	0x55, 0xfa, 0xb5, 0x01, 0x5a, 0x92, 0xaa, 0x7b, 0x08, 0xf0, 0x75, 0x52, 0x18, 0x8a, 0xaa, 0x5e,
+	0x8b, 0xf0, 0x57, 0x1a, 0x4c, 0x0b, 0x5a, 0x7c, 0x9d, 0xe4, 0xd8, 0x42, 0x3a, 0x84, 0x2d, 0x8e,
+	0xad, 0x1d, 0x04, 0xf3, 0x8e, 0x7e, 0x5f, 0x05, 0xa6, 0x6b, 0xbb, 0x10, 0x0f, 0x4b, 0x96, 0x57,
+	0xf3, 0xaa, 0x56, 0x05, 0x59, 0xf7, 0x9d, 0xcd, 0xca, 0x87, 0xaf, 0xbe, 0xd1, 0x47, 0x43, 0xf2,
+	0xf5, 0x08, 0x21, 0xbe, 0x6f, 0xec, 0xa9, 0x37, 0x34, 0xf0, 0x24, 0x57, 0xed, 0x2b, 0x2a, 0xdc,
+	0x96, 0x0a, 0xb8, 0xbf, 0x27, 0x30, 0x1b, 0x69, 0x5d, 0x90, 0x55, 0x6f, 0xf7, 0xd4, 0x40, 0xaf,
+	0xc4, 0x0d, 0x4b, 0x94, 0x06, 0xa2, 0x5c, 0x21, 0x3a, 0xa2, 0x0c, 0x5b, 0x15, 0x4f, 0x85, 0xcf,
+	0x18, 0x4f, 0xaa, 0x16, 0x83, 0xe4, 0xa9, 0x4f, 0xf7, 0x21, 0x76, 0xff, 0x64, 0xde, 0xe9, 0xf9,
+	0x18, 0xb3, 0x61, 0x7e, 0x64, 0x81, 0xe9, 0xc2, 0xbc, 0xaa, 0x1d, 0x21, 0x81, 0xf4, 0xe9, 0x54,
+	0xc4, 0x02, 0x91, 0xfe, 0xe7, 0xfb, 0xf9, 0xcf, 0x22, 0x78, 0x4e, 0xd1, 0x61, 0x90, 0x0f, 0x84,
+	0xf8, 0x86, 0x87, 0x7c, 0xad, 0xf4, 0x69, 0x4e, 0x18, 0xef, 0xa0, 0xf9, 0x37, 0xc9, 0xdd, 0x21,
+	0xcf, 0x9f, 0x20, 0x3c, 0xf2, 0x7b, 0x0d, 0xae, 0xf4, 0xef, 0x26, 0x90, 0x3c, 0x62, 0x18, 0xaa,
+	0x57, 0xa1, 0xbf, 0x36, 0x94, 0xae, 0x84, 0x7e, 0x13, 0xa1, 0x6f, 0x1a, 0x1b, 0xf1, 0xcc, 0x15,
+	0x45, 0x6f, 0xe2, 0x59, 0x0a, 0x49, 0xbf, 0xf3, 0x7f, 0x75, 0xde, 0xb1, 0x73, 0x37, 0x29, 0x00,
+	0x00,
 }
@@ -92,7 +92,18 @@ type ServiceProfile struct {
 	// Target Packet Error Rate.
 	TargetPer uint32 `protobuf:"varint,19,opt,name=target_per,json=targetPER,proto3" json:"target_per,omitempty"`
 	// Minimum number of receiving GWs (informative).
-	MinGwDiversity       uint32   `protobuf:"varint,20,opt,name=min_gw_diversity,json=minGWDiversity,proto3" json:"min_gw_diversity,omitempty"`
+	MinGwDiversity uint32 `protobuf:"varint,20,opt,name=min_gw_diversity,json=minGWDiversity,proto3" json:"min_gw_diversity,omitempty"`
+	// Max number of devices using this service-profile (0 = unlimited).
+	// This limit is enforced by LoRa App Server.
+	MaxDeviceCount uint32 `protobuf:"varint,24,opt,name=max_device_count,json=maxDeviceCount,proto3" json:"max_device_count,omitempty"`
+	// Max number of uplinks per device per hour (0 = unlimited).
+	// Uplinks exceeding this rate are not forwarded to the integrations.
+	// This limit is enforced by LoRa App Server.
+	MaxUplinkRate uint32 `protobuf:"varint,25,opt,name=max_uplink_rate,json=maxUplinkRate,proto3" json:"max_uplink_rate,omitempty"`
+	// Max uplink payload size in bytes (0 = unlimited).
+	// Uplinks exceeding this size are not forwarded to the integrations.
+	// This limit is enforced by LoRa App Server.
+	MaxPayloadSize       uint32   `protobuf:"varint,26,opt,name=max_payload_size,json=maxPayloadSize,proto3" json:"max_payload_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -283,6 +294,27 @@ func (m *ServiceProfile) GetMinGwDiversity() uint32 {
 	return 0
 }
 
+func (m *ServiceProfile) GetMaxDeviceCount() uint32 {
+	if m != nil {
+		return m.MaxDeviceCount
+	}
+	return 0
+}
+
+func (m *ServiceProfile) GetMaxUplinkRate() uint32 {
+	if m != nil {
+		return m.MaxUplinkRate
+	}
+	return 0
+}
+
+func (m *ServiceProfile) GetMaxPayloadSize() uint32 {
+	if m != nil {
+		return m.MaxPayloadSize
+	}
+	return 0
+}
+
 type DeviceProfile struct {
 	// Device-profile ID (UUID string).
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -329,10 +361,19 @@ type DeviceProfile struct {
 	// RF region name.
 	RfRegion string `protobuf:"bytes,19,opt,name=rf_region,json=rfRegion,proto3" json:"rf_region,omitempty"`
 	// End-Device uses 32bit FCnt (mandatory for LoRaWAN 1.0 End-Device).
-	Supports_32BitFCnt   bool     `protobuf:"varint,20,opt,name=supports_32bit_f_cnt,json=supports32BitFCnt,proto3" json:"supports_32bit_f_cnt,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Supports_32BitFCnt bool `protobuf:"varint,20,opt,name=supports_32bit_f_cnt,json=supports32BitFCnt,proto3" json:"supports_32bit_f_cnt,omitempty"`
+	// Join-accept parameters.
+	// When set, these parameters override the parameters requested by the
+	// network-server when LoRa App Server is acting as join-server (OTAA only).
+	JoinAccept *DeviceProfileJoinAccept `protobuf:"bytes,24,opt,name=join_accept,json=joinAccept,proto3" json:"join_accept,omitempty"`
+	// Expected uplink FPorts.
+	// When set, uplinks received on other FPorts generate an
+	// UNEXPECTED_FPORT_WARNING error notification. The label of the FPort
+	// is included in the uplink events.
+	FPorts               []*DeviceProfileFPort `protobuf:"bytes,25,rep,name=f_ports,json=fPorts,proto3" json:"f_ports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *DeviceProfile) Reset()         { *m = DeviceProfile{} }
@@ -520,72 +561,228 @@ func (m *DeviceProfile) GetSupports_32BitFCnt() bool {
 	return false
 }
 
+func (m *DeviceProfile) GetJoinAccept() *DeviceProfileJoinAccept {
+	if m != nil {
+		return m.JoinAccept
+	}
+	return nil
+}
+
+func (m *DeviceProfile) GetFPorts() []*DeviceProfileFPort {
+	if m != nil {
+		return m.FPorts
+	}
+	return nil
+}
+
+type DeviceProfileFPort struct {
+	// FPort (1 - 223).
+	FPort uint32 `protobuf:"varint,1,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// Label (e.g. telemetry, config-ack, fuota).
+	// This may only contain letters, digits, underscores and dashes.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// Description.
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceProfileFPort) Reset()         { *m = DeviceProfileFPort{} }
+func (m *DeviceProfileFPort) String() string { return proto.CompactTextString(m) }
+func (*DeviceProfileFPort) ProtoMessage()    {}
+func (*DeviceProfileFPort) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610db3cccb08234, []int{2}
+}
+func (m *DeviceProfileFPort) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceProfileFPort.Unmarshal(m, b)
+}
+func (m *DeviceProfileFPort) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceProfileFPort.Marshal(b, m, deterministic)
+}
+func (dst *DeviceProfileFPort) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceProfileFPort.Merge(dst, src)
+}
+func (m *DeviceProfileFPort) XXX_Size() int {
+	return xxx_messageInfo_DeviceProfileFPort.Size(m)
+}
+func (m *DeviceProfileFPort) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceProfileFPort.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceProfileFPort proto.InternalMessageInfo
+
+func (m *DeviceProfileFPort) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *DeviceProfileFPort) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *DeviceProfileFPort) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type DeviceProfileJoinAccept struct {
+	// RX delay (delay in seconds between the uplink and the RX1 window).
+	RxDelay uint32 `protobuf:"varint,1,opt,name=rx_delay,json=rxDelay,proto3" json:"rx_delay,omitempty"`
+	// RX1 data-rate offset.
+	Rx1DrOffset uint32 `protobuf:"varint,2,opt,name=rx1_dr_offset,json=rx1DROffset,proto3" json:"rx1_dr_offset,omitempty"`
+	// RX2 data-rate.
+	Rx2Dr uint32 `protobuf:"varint,3,opt,name=rx2_dr,json=rx2DR,proto3" json:"rx2_dr,omitempty"`
+	// CFList (HEX encoded, 16 bytes).
+	// This contains either the extra channels or the channel-mask (depending
+	// the CFList type), as defined by the LoRaWAN Regional Parameters.
+	// When left blank, the CFList requested by the network-server is used.
+	CfList               string   `protobuf:"bytes,4,opt,name=cf_list,json=cfList,proto3" json:"cf_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceProfileJoinAccept) Reset()         { *m = DeviceProfileJoinAccept{} }
+func (m *DeviceProfileJoinAccept) String() string { return proto.CompactTextString(m) }
+func (*DeviceProfileJoinAccept) ProtoMessage()    {}
+func (*DeviceProfileJoinAccept) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9610db3cccb08234, []int{3}
+}
+func (m *DeviceProfileJoinAccept) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeviceProfileJoinAccept.Unmarshal(m, b)
+}
+func (m *DeviceProfileJoinAccept) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeviceProfileJoinAccept.Marshal(b, m, deterministic)
+}
+func (dst *DeviceProfileJoinAccept) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceProfileJoinAccept.Merge(dst, src)
+}
+func (m *DeviceProfileJoinAccept) XXX_Size() int {
+	return xxx_messageInfo_DeviceProfileJoinAccept.Size(m)
+}
+func (m *DeviceProfileJoinAccept) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceProfileJoinAccept.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceProfileJoinAccept proto.InternalMessageInfo
+
+func (m *DeviceProfileJoinAccept) GetRxDelay() uint32 {
+	if m != nil {
+		return m.RxDelay
+	}
+	return 0
+}
+
+func (m *DeviceProfileJoinAccept) GetRx1DrOffset() uint32 {
+	if m != nil {
+		return m.Rx1DrOffset
+	}
+	return 0
+}
+
+func (m *DeviceProfileJoinAccept) GetRx2Dr() uint32 {
+	if m != nil {
+		return m.Rx2Dr
+	}
+	return 0
+}
+
+func (m *DeviceProfileJoinAccept) GetCfList() string {
+	if m != nil {
+		return m.CfList
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ServiceProfile)(nil), "api.ServiceProfile")
 	proto.RegisterType((*DeviceProfile)(nil), "api.DeviceProfile")
+	proto.RegisterType((*DeviceProfileFPort)(nil), "api.DeviceProfileFPort")
+	proto.RegisterType((*DeviceProfileJoinAccept)(nil), "api.DeviceProfileJoinAccept")
 	proto.RegisterEnum("api.RatePolicy", RatePolicy_name, RatePolicy_value)
 }
 
 func init() { proto.RegisterFile("profiles.proto", fileDescriptor_9610db3cccb08234) }
 
 var fileDescriptor_9610db3cccb08234 = []byte{
-	// 917 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x5d, 0x6f, 0xdb, 0x36,
-	0x14, 0x9d, 0x9b, 0xd4, 0xb1, 0x19, 0x4b, 0x76, 0x98, 0xa4, 0x55, 0xf7, 0xe9, 0xa5, 0xc3, 0x66,
-	0x14, 0x58, 0xb6, 0x38, 0x18, 0x86, 0x3d, 0x36, 0x51, 0x1b, 0x64, 0x5b, 0x50, 0x83, 0x19, 0xd6,
-	0x47, 0xe2, 0x5a, 0xa4, 0x1d, 0xce, 0x92, 0xa8, 0x50, 0x94, 0x2d, 0xe7, 0x8f, 0x6e, 0x3f, 0x67,
-	0xe0, 0x95, 0xfc, 0xd1, 0x14, 0x7b, 0xdf, 0x9b, 0x75, 0xce, 0xb9, 0x3c, 0xbc, 0xbc, 0x3c, 0x34,
-	0xf1, 0x33, 0xa3, 0x27, 0x2a, 0x96, 0xf9, 0x69, 0x66, 0xb4, 0xd5, 0x74, 0x07, 0x32, 0x75, 0xf2,
-	0x77, 0x93, 0xf8, 0xb7, 0xd2, 0xcc, 0x55, 0x24, 0x47, 0x15, 0x4d, 0x7d, 0xf2, 0x44, 0x89, 0xa0,
-	0xd1, 0x6f, 0x0c, 0xda, 0xec, 0x89, 0x12, 0x94, 0x92, 0xdd, 0x14, 0x12, 0x19, 0x1c, 0x23, 0x82,
-	0xbf, 0xe9, 0x77, 0xa4, 0xab, 0xcd, 0x14, 0x52, 0xf5, 0x00, 0x56, 0xe9, 0x94, 0x2b, 0x11, 0x3c,
-	0xeb, 0x37, 0x06, 0x3b, 0xcc, 0xdf, 0x86, 0xaf, 0x43, 0xfa, 0x8a, 0x1c, 0xa4, 0xd2, 0x2e, 0xb4,
-	0x99, 0xf1, 0x5c, 0x9a, 0xb9, 0x34, 0x4e, 0xfa, 0x1c, 0xa5, 0xdd, 0x9a, 0xb8, 0x45, 0xfc, 0x3a,
-	0xa4, 0xcf, 0xc9, 0x5e, 0x11, 0x73, 0x03, 0x56, 0x06, 0x4f, 0xfa, 0x8d, 0x81, 0xc7, 0x9a, 0x45,
-	0xcc, 0xc0, 0x4a, 0xfa, 0x0d, 0xf1, 0x8b, 0x98, 0x8f, 0x8b, 0x68, 0x26, 0x2d, 0xcf, 0xd5, 0x83,
-	0x0c, 0x76, 0x90, 0xef, 0x14, 0xf1, 0x05, 0x82, 0xb7, 0xea, 0x41, 0xd2, 0x9f, 0x50, 0xe5, 0xca,
-	0x79, 0xa6, 0x63, 0x15, 0x2d, 0x83, 0xdd, 0x7e, 0x63, 0xe0, 0x0f, 0xbb, 0xa7, 0x90, 0xa9, 0x53,
-	0xb7, 0xd0, 0x08, 0x61, 0x57, 0xb6, 0xf9, 0x72, 0xae, 0xa2, 0x76, 0x7d, 0x5a, 0xb9, 0x8a, 0xb5,
-	0xab, 0xf8, 0xd0, 0xb5, 0x59, 0xb9, 0x8a, 0x47, 0xae, 0xe2, 0x43, 0xd7, 0xbd, 0xff, 0x70, 0x15,
-	0xdb, 0xae, 0xdf, 0x92, 0x2e, 0x08, 0xc1, 0xa7, 0x0b, 0x9e, 0x48, 0x0b, 0x02, 0x2c, 0x04, 0xad,
-	0x7e, 0x63, 0xd0, 0x62, 0x1e, 0x08, 0x71, 0xf5, 0xfe, 0x46, 0x5a, 0x08, 0xc1, 0x02, 0xfd, 0x9e,
-	0x1c, 0x0a, 0x39, 0xe7, 0xb9, 0x05, 0x5b, 0xe4, 0xdc, 0xc8, 0x7b, 0x3e, 0x31, 0xf2, 0x3e, 0x68,
-	0xe3, 0x4e, 0x7a, 0x42, 0xce, 0x6f, 0x91, 0x61, 0xf2, 0xfe, 0xad, 0x91, 0xf7, 0xf4, 0x17, 0xf2,
-	0xc2, 0xc8, 0x4c, 0x1b, 0xcb, 0xb7, 0xaa, 0xc6, 0x60, 0xad, 0x34, 0xcb, 0x80, 0xa0, 0xc1, 0xb3,
-	0x4a, 0x10, 0xae, 0x4a, 0x2f, 0x2a, 0x96, 0xfe, 0x4c, 0x82, 0x8f, 0x4b, 0x13, 0x30, 0x53, 0x95,
-	0x06, 0xfb, 0x58, 0x79, 0xfc, 0xa8, 0xf2, 0x06, 0x49, 0x7a, 0x4c, 0x9a, 0xc2, 0xf0, 0x44, 0xa5,
-	0x41, 0x07, 0x77, 0xf5, 0x54, 0x98, 0x9b, 0x0d, 0x0c, 0x65, 0xe0, 0xad, 0x61, 0x28, 0xe9, 0xd7,
-	0xa4, 0x13, 0xdd, 0x41, 0x9a, 0xca, 0x98, 0x27, 0x90, 0xcf, 0x02, 0xbf, 0xdf, 0x18, 0x74, 0xd8,
-	0x7e, 0x8d, 0xdd, 0x40, 0x3e, 0xa3, 0x5f, 0x10, 0x92, 0x19, 0x0e, 0x71, 0xac, 0x17, 0x52, 0x04,
-	0x5d, 0xf4, 0x6e, 0x67, 0xe6, 0x75, 0x05, 0x38, 0xfa, 0x6e, 0x43, 0xf7, 0x2a, 0xfa, 0x6e, 0x9b,
-	0x36, 0xb0, 0xa6, 0x0f, 0x2a, 0xda, 0xc0, 0x8a, 0xfe, 0x92, 0xec, 0xa7, 0x8b, 0x19, 0x9f, 0x4a,
-	0xcd, 0x63, 0x1d, 0x05, 0xb4, 0xe2, 0xd3, 0xc5, 0xec, 0x4a, 0xea, 0xdf, 0x75, 0xe4, 0xca, 0x2d,
-	0x98, 0xa9, 0xb4, 0x3c, 0x93, 0x26, 0x38, 0xc4, 0xad, 0xb7, 0x2b, 0x64, 0xf4, 0x86, 0xd1, 0x01,
-	0xe9, 0x25, 0x2a, 0x75, 0x73, 0x13, 0x6a, 0x2e, 0x4d, 0xae, 0xec, 0x32, 0x38, 0x42, 0x91, 0x9f,
-	0xa8, 0xf4, 0xea, 0x7d, 0xb8, 0x42, 0x4f, 0xfe, 0x69, 0x12, 0x2f, 0x94, 0xff, 0x8b, 0x60, 0x0d,
-	0x48, 0x2f, 0x2f, 0x32, 0x37, 0xbb, 0x9c, 0x47, 0x31, 0xe4, 0x39, 0x1f, 0x63, 0xc2, 0x5a, 0xcc,
-	0x5f, 0xe1, 0x97, 0x0e, 0xbe, 0x70, 0xd7, 0xb2, 0x16, 0x70, 0xab, 0x12, 0xa9, 0x0b, 0x5b, 0x47,
-	0xcd, 0x43, 0xf8, 0xe2, 0x8f, 0x0a, 0x74, 0x2b, 0x66, 0x2a, 0x9d, 0xf2, 0x3c, 0xd6, 0x78, 0x50,
-	0x4a, 0x0b, 0x4c, 0x9b, 0xc7, 0x7c, 0x87, 0xdf, 0xc6, 0xda, 0x8e, 0x10, 0xa5, 0x7d, 0xd2, 0xd9,
-	0x28, 0x85, 0xa9, 0x33, 0x46, 0x56, 0xaa, 0x90, 0xb9, 0x9c, 0x6d, 0x14, 0x78, 0xbb, 0xeb, 0x9c,
-	0xad, 0x34, 0x78, 0xb3, 0x3f, 0xee, 0x21, 0xc2, 0xa4, 0x3d, 0xee, 0xe1, 0x72, 0xd3, 0x43, 0xb4,
-	0xee, 0xa1, 0xb5, 0xd5, 0xc3, 0xe5, 0xaa, 0x87, 0xaf, 0xc8, 0x7e, 0x02, 0x11, 0xc7, 0x79, 0xe9,
-	0x14, 0x23, 0xd5, 0x66, 0x24, 0x81, 0xe8, 0xcf, 0x0a, 0xa1, 0xa7, 0xe4, 0xd0, 0xc8, 0x29, 0xcf,
-	0xc0, 0x40, 0xe2, 0xb2, 0x37, 0x57, 0x28, 0x24, 0x28, 0x3c, 0x30, 0x72, 0x3a, 0x42, 0x86, 0xd5,
-	0x04, 0xfd, 0x9c, 0x10, 0x53, 0x72, 0x21, 0x63, 0x58, 0xf2, 0x33, 0xcc, 0x8c, 0xc7, 0x5a, 0xa6,
-	0x0c, 0x1d, 0x70, 0x46, 0x5f, 0x12, 0xdf, 0xb1, 0x86, 0xeb, 0xc9, 0x24, 0x97, 0x96, 0x9f, 0xd5,
-	0x71, 0xd9, 0x37, 0x65, 0xc8, 0xde, 0x21, 0x76, 0x46, 0x4f, 0x88, 0xe7, 0x44, 0x60, 0x01, 0x5f,
-	0x94, 0x61, 0x9d, 0x1d, 0xa7, 0x01, 0x0b, 0xee, 0xfd, 0x18, 0xd2, 0x4f, 0x49, 0xdb, 0x94, 0x78,
-	0x50, 0x7c, 0x88, 0xf1, 0xf1, 0xd8, 0x9e, 0x29, 0xdd, 0x21, 0x0d, 0xe9, 0x8f, 0xe4, 0x68, 0x02,
-	0x91, 0xd5, 0x66, 0xc9, 0x33, 0x23, 0x9d, 0x8d, 0xd3, 0xe5, 0x41, 0xb7, 0xbf, 0x33, 0xf0, 0x18,
-	0xad, 0xb9, 0x11, 0x52, 0xae, 0x22, 0xa7, 0x2f, 0x48, 0x2b, 0x81, 0x92, 0x4b, 0x65, 0x32, 0xcc,
-	0x92, 0xc7, 0xf6, 0x12, 0x28, 0xdf, 0x5c, 0xb3, 0x91, 0x1b, 0x8c, 0xa3, 0x44, 0x61, 0x97, 0x3c,
-	0x5a, 0x46, 0xb1, 0xc4, 0x34, 0x79, 0xac, 0x93, 0x40, 0x19, 0x16, 0x76, 0x79, 0xe9, 0x30, 0xfa,
-	0x92, 0x78, 0xeb, 0xc1, 0xfc, 0xa5, 0x55, 0x5a, 0x47, 0xaa, 0xb3, 0x02, 0x7f, 0xd5, 0x2a, 0xa5,
-	0x9f, 0x91, 0xb6, 0x99, 0x70, 0x23, 0xa7, 0xee, 0x00, 0x0f, 0xf1, 0x00, 0x5b, 0x66, 0xc2, 0xf0,
-	0x9b, 0xfe, 0x40, 0x8e, 0xd6, 0x2b, 0x9c, 0x0f, 0xc7, 0xca, 0xf2, 0x09, 0x8f, 0x52, 0x8b, 0xb9,
-	0x6a, 0xb1, 0x83, 0x15, 0x77, 0x3e, 0xbc, 0x50, 0xf6, 0xed, 0x65, 0x6a, 0x5f, 0xf5, 0x09, 0xd9,
-	0x7a, 0x4a, 0x5b, 0x64, 0x37, 0x64, 0xef, 0x46, 0xbd, 0x4f, 0xdc, 0xaf, 0x9b, 0xd7, 0xec, 0xb7,
-	0x5e, 0x63, 0xdc, 0xc4, 0xbf, 0xb8, 0xf3, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb5, 0xd8, 0xd7,
-	0x9e, 0xf4, 0x06, 0x00, 0x00,
+	// 1129 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x5d, 0x6f, 0xdb, 0x36,
+	0x14, 0x9d, 0x9b, 0x26, 0xb6, 0xaf, 0x2d, 0x27, 0x61, 0xd2, 0x86, 0xe9, 0xba, 0xcd, 0x4b, 0x87,
+	0xcd, 0x28, 0xb0, 0xac, 0x71, 0x31, 0x0c, 0x7b, 0xd8, 0x43, 0x13, 0xb7, 0x45, 0xb7, 0x06, 0x35,
+	0x98, 0x6d, 0x7d, 0x24, 0x18, 0x91, 0x72, 0x59, 0x4b, 0xa2, 0x42, 0x51, 0x8e, 0xdc, 0x5f, 0xb0,
+	0xbf, 0xb6, 0xe7, 0xfd, 0xa1, 0x81, 0x57, 0xf2, 0x47, 0x9a, 0xf5, 0x7d, 0x4f, 0x96, 0xce, 0x39,
+	0x97, 0x87, 0x1f, 0xf7, 0x88, 0x86, 0x5e, 0x66, 0x4d, 0xa4, 0x63, 0x95, 0x1f, 0x67, 0xd6, 0x38,
+	0x43, 0x36, 0x44, 0xa6, 0x8f, 0xfe, 0x69, 0x42, 0xef, 0x42, 0xd9, 0x99, 0x0e, 0xd5, 0xb8, 0xa2,
+	0x49, 0x0f, 0xee, 0x68, 0x49, 0x1b, 0xfd, 0xc6, 0xa0, 0xcd, 0xee, 0x68, 0x49, 0x08, 0xdc, 0x4d,
+	0x45, 0xa2, 0xe8, 0x3d, 0x44, 0xf0, 0x99, 0x7c, 0x07, 0xdb, 0xc6, 0x4e, 0x44, 0xaa, 0x3f, 0x08,
+	0xa7, 0x4d, 0xca, 0xb5, 0xa4, 0xf7, 0xfb, 0x8d, 0xc1, 0x06, 0xeb, 0xad, 0xc3, 0xaf, 0x46, 0xe4,
+	0x31, 0xec, 0xa6, 0xca, 0x5d, 0x1b, 0x3b, 0xe5, 0xb9, 0xb2, 0x33, 0x65, 0xbd, 0xf4, 0x00, 0xa5,
+	0xdb, 0x35, 0x71, 0x81, 0xf8, 0xab, 0x11, 0x39, 0x80, 0x66, 0x11, 0x73, 0x2b, 0x9c, 0xa2, 0x77,
+	0xfa, 0x8d, 0x41, 0xc0, 0xb6, 0x8a, 0x98, 0x09, 0xa7, 0xc8, 0x37, 0xd0, 0x2b, 0x62, 0x7e, 0x59,
+	0x84, 0x53, 0xe5, 0x78, 0xae, 0x3f, 0x28, 0xba, 0x81, 0x7c, 0xb7, 0x88, 0x4f, 0x11, 0xbc, 0xd0,
+	0x1f, 0x14, 0xf9, 0x11, 0x7a, 0x75, 0x39, 0xcf, 0x4c, 0xac, 0xc3, 0x39, 0xbd, 0xdb, 0x6f, 0x0c,
+	0x7a, 0xc3, 0xed, 0x63, 0x91, 0xe9, 0x63, 0x3f, 0xd0, 0x18, 0x61, 0x5f, 0xb6, 0x7a, 0xf3, 0xae,
+	0xb2, 0x76, 0xdd, 0xac, 0x5c, 0xe5, 0xd2, 0x55, 0xde, 0x74, 0xdd, 0xaa, 0x5c, 0xe5, 0x47, 0xae,
+	0xf2, 0xa6, 0x6b, 0xf3, 0x13, 0xae, 0x72, 0xdd, 0xf5, 0x5b, 0xd8, 0x16, 0x52, 0xf2, 0xc9, 0x35,
+	0x4f, 0x94, 0x13, 0x52, 0x38, 0x41, 0x5b, 0xfd, 0xc6, 0xa0, 0xc5, 0x02, 0x21, 0xe5, 0xcb, 0xb7,
+	0xe7, 0xca, 0x89, 0x91, 0x70, 0x82, 0x7c, 0x0f, 0x7b, 0x52, 0xcd, 0x78, 0xee, 0x84, 0x2b, 0x72,
+	0x6e, 0xd5, 0x15, 0x8f, 0xac, 0xba, 0xa2, 0x6d, 0x9c, 0xc9, 0x8e, 0x54, 0xb3, 0x0b, 0x64, 0x98,
+	0xba, 0x7a, 0x61, 0xd5, 0x15, 0xf9, 0x19, 0x0e, 0xad, 0xca, 0x8c, 0x75, 0x7c, 0xad, 0xea, 0x52,
+	0x38, 0xa7, 0xec, 0x9c, 0x02, 0x1a, 0xdc, 0xaf, 0x04, 0xa3, 0x45, 0xe9, 0x69, 0xc5, 0x92, 0x9f,
+	0x80, 0xde, 0x2e, 0x4d, 0x84, 0x9d, 0xe8, 0x94, 0x76, 0xb0, 0xf2, 0xde, 0x47, 0x95, 0xe7, 0x48,
+	0x92, 0x7b, 0xb0, 0x25, 0x2d, 0x4f, 0x74, 0x4a, 0xbb, 0x38, 0xab, 0x4d, 0x69, 0xcf, 0x57, 0xb0,
+	0x28, 0x69, 0xb0, 0x84, 0x45, 0x49, 0xbe, 0x86, 0x6e, 0xf8, 0x4e, 0xa4, 0xa9, 0x8a, 0x79, 0x22,
+	0xf2, 0x29, 0xed, 0xf5, 0x1b, 0x83, 0x2e, 0xeb, 0xd4, 0xd8, 0xb9, 0xc8, 0xa7, 0xe4, 0x0b, 0x80,
+	0xcc, 0x72, 0x11, 0xc7, 0xe6, 0x5a, 0x49, 0xba, 0x8d, 0xde, 0xed, 0xcc, 0x3e, 0xab, 0x00, 0x4f,
+	0xbf, 0x5b, 0xd1, 0x3b, 0x15, 0xfd, 0x6e, 0x9d, 0xb6, 0x62, 0x49, 0xef, 0x56, 0xb4, 0x15, 0x0b,
+	0xfa, 0x4b, 0xe8, 0xa4, 0xd7, 0x53, 0x3e, 0x51, 0x86, 0xc7, 0x26, 0xa4, 0xa4, 0xe2, 0xd3, 0xeb,
+	0xe9, 0x4b, 0x65, 0x5e, 0x9b, 0xd0, 0x97, 0x3b, 0x61, 0x27, 0xca, 0xf1, 0x4c, 0x59, 0xba, 0x87,
+	0x53, 0x6f, 0x57, 0xc8, 0xf8, 0x39, 0x23, 0x03, 0xd8, 0x49, 0x74, 0xea, 0xcf, 0x4d, 0xea, 0x99,
+	0xb2, 0xb9, 0x76, 0x73, 0xba, 0x8f, 0xa2, 0x5e, 0xa2, 0xd3, 0x97, 0x6f, 0x47, 0x0b, 0x14, 0x95,
+	0xa2, 0xf4, 0x9b, 0xa9, 0x43, 0xc5, 0x43, 0x53, 0xa4, 0x8e, 0xd2, 0x5a, 0x29, 0xca, 0x11, 0xc2,
+	0x67, 0x1e, 0xf5, 0xbd, 0xe0, 0x95, 0x45, 0x16, 0xeb, 0x74, 0x5a, 0x75, 0xe2, 0x21, 0x0a, 0x83,
+	0x44, 0x94, 0x7f, 0x20, 0x8a, 0x0d, 0x59, 0x8f, 0x98, 0x89, 0x79, 0x6c, 0x84, 0xac, 0x5a, 0xf2,
+	0xc1, 0x72, 0xc4, 0x71, 0x05, 0xfb, 0xa6, 0x3c, 0xfa, 0xbb, 0x09, 0xc1, 0x48, 0xfd, 0x2f, 0x42,
+	0x3d, 0x80, 0x9d, 0xbc, 0xc8, 0x7c, 0xdf, 0xe4, 0x3c, 0x8c, 0x45, 0x9e, 0xf3, 0x4b, 0x4c, 0x77,
+	0x8b, 0xf5, 0x16, 0xf8, 0x99, 0x87, 0x4f, 0xfd, 0x36, 0xd4, 0x02, 0xee, 0x74, 0xa2, 0x4c, 0xe1,
+	0xea, 0x98, 0x07, 0x08, 0x9f, 0xfe, 0x5e, 0x81, 0x7e, 0xc4, 0x4c, 0xa7, 0x13, 0x9e, 0xc7, 0x06,
+	0x0f, 0x49, 0x1b, 0x89, 0x49, 0x0f, 0x58, 0xcf, 0xe3, 0x17, 0xb1, 0x71, 0x63, 0x44, 0x49, 0x1f,
+	0xba, 0x2b, 0xa5, 0xb4, 0x75, 0xbe, 0x61, 0xa1, 0x1a, 0x31, 0x9f, 0xf1, 0x95, 0x02, 0x93, 0x55,
+	0x67, 0x7c, 0xa1, 0xc1, 0x54, 0xdd, 0x5e, 0x43, 0x48, 0x9b, 0xff, 0xb1, 0x86, 0xb3, 0xd5, 0x1a,
+	0xc2, 0xe5, 0x1a, 0x5a, 0x6b, 0x6b, 0x38, 0x5b, 0xac, 0xe1, 0x2b, 0xe8, 0x24, 0x22, 0xe4, 0xd8,
+	0x2b, 0x26, 0xc5, 0x38, 0xb7, 0x19, 0x24, 0x22, 0xfc, 0xb3, 0x42, 0xc8, 0x31, 0xec, 0x59, 0x35,
+	0xe1, 0x99, 0xb0, 0x22, 0xf1, 0xb9, 0x9f, 0x69, 0x14, 0x02, 0x0a, 0x77, 0xad, 0x9a, 0x8c, 0x91,
+	0x61, 0x35, 0x41, 0x1e, 0x02, 0x58, 0xdf, 0x6c, 0xb1, 0x98, 0xf3, 0x13, 0xcc, 0x6b, 0xc0, 0x5a,
+	0xb6, 0x1c, 0x79, 0xe0, 0x84, 0x3c, 0x82, 0x9e, 0x67, 0x2d, 0x37, 0x51, 0x94, 0x2b, 0xc7, 0x4f,
+	0xea, 0xa8, 0x76, 0x6c, 0x39, 0x62, 0x6f, 0x10, 0x3b, 0x21, 0x47, 0x10, 0x78, 0x91, 0x70, 0x02,
+	0xbf, 0x66, 0x43, 0x1a, 0x2c, 0x35, 0xc2, 0x09, 0xdf, 0x81, 0x43, 0xf2, 0x00, 0xda, 0xb6, 0xc4,
+	0x8d, 0xe2, 0x43, 0x8c, 0x6e, 0xc0, 0x9a, 0xb6, 0xf4, 0x9b, 0x34, 0x24, 0x4f, 0x60, 0x3f, 0x12,
+	0xa1, 0x33, 0x76, 0xce, 0x33, 0xab, 0xbc, 0x8d, 0xd7, 0xe5, 0x74, 0xbb, 0xbf, 0x31, 0x08, 0x18,
+	0xa9, 0xb9, 0x31, 0x52, 0xbe, 0x22, 0x27, 0x87, 0xd0, 0xf2, 0x0d, 0xad, 0xb4, 0xcd, 0x30, 0xc7,
+	0x01, 0x6b, 0x26, 0xa2, 0x7c, 0xfe, 0x8a, 0x8d, 0xfd, 0xc1, 0x60, 0x7a, 0x0a, 0x37, 0xe7, 0xe1,
+	0x3c, 0x8c, 0x15, 0x26, 0x39, 0x60, 0x5d, 0x9f, 0x9d, 0xc2, 0xcd, 0xcf, 0x3c, 0x46, 0x1e, 0x41,
+	0xb0, 0x3c, 0x98, 0xf7, 0x46, 0xa7, 0x75, 0x9c, 0xbb, 0x0b, 0xf0, 0x57, 0xa3, 0x53, 0xf2, 0x39,
+	0xb4, 0x6d, 0xc4, 0xad, 0x9a, 0xf8, 0x0d, 0xdc, 0xc3, 0x0d, 0x6c, 0xd9, 0x88, 0xe1, 0x3b, 0xf9,
+	0x01, 0xf6, 0x97, 0x23, 0x3c, 0x1d, 0x5e, 0x6a, 0xc7, 0x23, 0x1e, 0xa6, 0x0e, 0x33, 0xdd, 0x62,
+	0xbb, 0x0b, 0xee, 0xe9, 0xf0, 0x54, 0xbb, 0x17, 0x67, 0xa9, 0x23, 0xbf, 0x40, 0xc7, 0x3b, 0x71,
+	0x11, 0x86, 0x2a, 0xab, 0x12, 0xdd, 0x19, 0x3e, 0xc4, 0x8f, 0xfd, 0x8d, 0xc4, 0x79, 0xeb, 0x67,
+	0xa8, 0x61, 0xf0, 0x7e, 0xf9, 0x4c, 0x9e, 0x40, 0x33, 0xe2, 0x38, 0x24, 0x3d, 0xec, 0x6f, 0x0c,
+	0x3a, 0xc3, 0x83, 0xdb, 0xa5, 0x2f, 0xc6, 0xc6, 0x3a, 0xb6, 0x15, 0xf9, 0x9f, 0xfc, 0x28, 0x04,
+	0x72, 0x9b, 0xf5, 0x5f, 0xd7, 0x6a, 0x1c, 0xcc, 0x74, 0xc0, 0x36, 0x51, 0x4d, 0xf6, 0x61, 0x33,
+	0x16, 0x97, 0x2a, 0xc6, 0x88, 0xb5, 0x59, 0xf5, 0x42, 0xfa, 0xd0, 0x91, 0x2a, 0x0f, 0xad, 0xce,
+	0x7c, 0x80, 0x31, 0x55, 0x6d, 0xb6, 0x0e, 0x1d, 0xfd, 0xd5, 0x80, 0x83, 0x4f, 0x4c, 0xdf, 0x9f,
+	0xd2, 0xa2, 0xb5, 0x6a, 0xb3, 0x66, 0xdd, 0x58, 0x55, 0xcb, 0x9c, 0xac, 0x1a, 0xab, 0xbe, 0xb7,
+	0x3b, 0xb6, 0x3c, 0x59, 0xf4, 0x95, 0x9f, 0xa9, 0x2d, 0x87, 0x3e, 0x7e, 0x55, 0x9a, 0x37, 0x6d,
+	0x39, 0x1c, 0x31, 0x7f, 0xed, 0x86, 0x11, 0x8f, 0x75, 0xee, 0x30, 0xbc, 0x6d, 0xb6, 0x15, 0x46,
+	0xaf, 0x75, 0xee, 0x1e, 0xf7, 0x01, 0xd6, 0xee, 0xc9, 0x16, 0xdc, 0x1d, 0xb1, 0x37, 0xe3, 0x9d,
+	0xcf, 0xfc, 0xd3, 0xf9, 0x33, 0xf6, 0xdb, 0x4e, 0xe3, 0x72, 0x0b, 0xff, 0xbf, 0x3c, 0xfd, 0x77,
+	0x00, 0x31, 0x6a, 0x68, 0x80, 0xd1, 0x08, 0x00, 0x00,
 }
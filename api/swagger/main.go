@@ -1,8 +1,12 @@
 // simple tool to merge different swagger definition into a single file
+//
+// When the -openapi flag is set, the merged definition is converted into an
+// OpenAPI v3 document.
 package main
 
 import (
 	"encoding/json"
+	"flag"
 	"io/ioutil"
 	"log"
 	"os"
@@ -28,9 +32,14 @@ type model struct {
 }
 
 func main() {
-	if len(os.Args) != 2 {
-		log.Fatal("usage: go run main.go inputPath")
+	openAPI := flag.Bool("openapi", false, "output an OpenAPI v3 document")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		log.Fatal("usage: go run main.go [-openapi] inputPath")
 	}
+	inputPath := flag.Arg(0)
+
 	swagger := model{
 		Swagger:     "2.0",
 		Consumes:    []string{"application/json"},
@@ -45,7 +54,7 @@ For more information about the usage of the LoRa App Server (REST) API, see
 [https://docs.loraserver.io/lora-app-server/api/](https://docs.loraserver.io/lora-app-server/api/).
 `
 
-	fileInfos, err := ioutil.ReadDir(os.Args[1])
+	fileInfos, err := ioutil.ReadDir(inputPath)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -54,7 +63,7 @@ For more information about the usage of the LoRa App Server (REST) API, see
 			continue
 		}
 
-		b, err := ioutil.ReadFile(path.Join(os.Args[1], fileInfo.Name()))
+		b, err := ioutil.ReadFile(path.Join(inputPath, fileInfo.Name()))
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -76,9 +85,220 @@ For more information about the usage of the LoRa App Server (REST) API, see
 		}
 	}
 
+	var out interface{} = swagger
+	if *openAPI {
+		out = toOpenAPI(swagger)
+	}
+
 	enc := json.NewEncoder(os.Stdout)
-	err = enc.Encode(swagger)
+	err = enc.Encode(out)
 	if err != nil {
 		log.Fatal(err)
 	}
 }
+
+type openAPIModel struct {
+	OpenAPI string `json:"openapi"`
+	Info    struct {
+		Title       string `json:"title"`
+		Version     string `json:"version"`
+		Description string `json:"description"`
+	} `json:"info"`
+	Security   []map[string][]string  `json:"security"`
+	Paths      map[string]interface{} `json:"paths"`
+	Components map[string]interface{} `json:"components"`
+}
+
+// errorSchemas contains the schemas of the errors returned by the REST API
+// (grpc-gateway).
+var errorSchemas = map[string]interface{}{
+	"runtimeError": map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"error":   map[string]interface{}{"type": "string"},
+			"code":    map[string]interface{}{"type": "integer", "format": "int32"},
+			"details": map[string]interface{}{"type": "array", "items": map[string]interface{}{"$ref": "#/components/schemas/protobufAny"}},
+		},
+	},
+	"runtimeStreamError": map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"grpcCode":   map[string]interface{}{"type": "integer", "format": "int32"},
+			"httpCode":   map[string]interface{}{"type": "integer", "format": "int32"},
+			"message":    map[string]interface{}{"type": "string"},
+			"httpStatus": map[string]interface{}{"type": "string"},
+			"details":    map[string]interface{}{"type": "array", "items": map[string]interface{}{"$ref": "#/components/schemas/protobufAny"}},
+		},
+	},
+	"protobufAny": map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"typeUrl": map[string]interface{}{"type": "string"},
+			"value":   map[string]interface{}{"type": "string", "format": "byte"},
+		},
+	},
+}
+
+// toOpenAPI converts the given swagger (v2) definition into an OpenAPI v3
+// document. As the operation IDs generated by grpc-gateway are only unique
+// per service, these are prefixed with the service name.
+func toOpenAPI(swagger model) openAPIModel {
+	out := openAPIModel{
+		OpenAPI:  "3.0.1",
+		Security: []map[string][]string{{"bearerAuth": {}}},
+		Paths:    make(map[string]interface{}),
+	}
+	out.Info = swagger.Info
+
+	schemas := make(map[string]interface{})
+	for k, v := range swagger.Definitions {
+		schemas[k] = convertRefs(v)
+	}
+	for k, v := range errorSchemas {
+		schemas[k] = v
+	}
+
+	out.Components = map[string]interface{}{
+		"schemas": schemas,
+		"securitySchemes": map[string]interface{}{
+			"bearerAuth": map[string]interface{}{
+				"type":         "http",
+				"scheme":       "bearer",
+				"bearerFormat": "JWT",
+			},
+		},
+	}
+
+	for p, ops := range swagger.Paths {
+		pathItem := make(map[string]interface{})
+		for method, op := range ops.(map[string]interface{}) {
+			pathItem[method] = convertOperation(op.(map[string]interface{}))
+		}
+		out.Paths[p] = pathItem
+	}
+
+	return out
+}
+
+func convertOperation(op map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{})
+	for k, v := range op {
+		switch k {
+		case "parameters", "responses":
+		default:
+			out[k] = v
+		}
+	}
+
+	if tags, ok := op["tags"].([]interface{}); ok && len(tags) != 0 {
+		out["operationId"] = tags[0].(string) + "_" + op["operationId"].(string)
+	}
+
+	// the login endpoint is used to obtain the token
+	if op["operationId"] == "Login" {
+		out["security"] = []interface{}{}
+	}
+
+	var params []interface{}
+	opParams, _ := op["parameters"].([]interface{})
+	for _, p := range opParams {
+		param := p.(map[string]interface{})
+
+		if param["in"] == "body" {
+			out["requestBody"] = map[string]interface{}{
+				"required": true,
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{
+						"schema": convertRefs(param["schema"]),
+					},
+				},
+			}
+			continue
+		}
+
+		// in OpenAPI v3, the type information is part of the schema
+		schema := make(map[string]interface{})
+		converted := make(map[string]interface{})
+		for k, v := range param {
+			switch k {
+			case "type", "format", "enum", "items", "default":
+				schema[k] = v
+			default:
+				converted[k] = v
+			}
+		}
+		converted["schema"] = schema
+		params = append(params, converted)
+	}
+	if len(params) != 0 {
+		out["parameters"] = params
+	}
+
+	responses := make(map[string]interface{})
+	for code, r := range op["responses"].(map[string]interface{}) {
+		resp := r.(map[string]interface{})
+		schema := convertRefs(resp["schema"])
+
+		// streaming responses are returned as newline-delimited JSON
+		// objects, containing either the result or an error
+		if resp["description"] == "(streaming responses)" {
+			schema = map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"result": schema,
+					"error":  map[string]interface{}{"$ref": "#/components/schemas/runtimeStreamError"},
+				},
+			}
+		}
+
+		description, _ := resp["description"].(string)
+		if description == "" {
+			description = "A successful response."
+		}
+
+		responses[code] = map[string]interface{}{
+			"description": description,
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{
+					"schema": schema,
+				},
+			},
+		}
+	}
+	responses["default"] = map[string]interface{}{
+		"description": "An error response.",
+		"content": map[string]interface{}{
+			"application/json": map[string]interface{}{
+				"schema": map[string]interface{}{"$ref": "#/components/schemas/runtimeError"},
+			},
+		},
+	}
+	out["responses"] = responses
+
+	return out
+}
+
+// convertRefs replaces the swagger (v2) definition references by OpenAPI v3
+// schema references.
+func convertRefs(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			if s, ok := val.(string); ok && k == "$ref" {
+				out[k] = strings.Replace(s, "#/definitions/", "#/components/schemas/", 1)
+			} else {
+				out[k] = convertRefs(val)
+			}
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i := range v {
+			out[i] = convertRefs(v[i])
+		}
+		return out
+	default:
+		return v
+	}
+}
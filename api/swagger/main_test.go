@@ -0,0 +1,290 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertRefs(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Input    interface{}
+		Expected interface{}
+	}{
+		{
+			Name:     "nil",
+			Input:    nil,
+			Expected: nil,
+		},
+		{
+			Name:     "ref",
+			Input:    map[string]interface{}{"$ref": "#/definitions/apiDevice"},
+			Expected: map[string]interface{}{"$ref": "#/components/schemas/apiDevice"},
+		},
+		{
+			Name: "nested refs",
+			Input: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"device": map[string]interface{}{"$ref": "#/definitions/apiDevice"},
+					"items": map[string]interface{}{
+						"type":  "array",
+						"items": []interface{}{map[string]interface{}{"$ref": "#/definitions/apiDeviceKeys"}},
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"device": map[string]interface{}{"$ref": "#/components/schemas/apiDevice"},
+					"items": map[string]interface{}{
+						"type":  "array",
+						"items": []interface{}{map[string]interface{}{"$ref": "#/components/schemas/apiDeviceKeys"}},
+					},
+				},
+			},
+		},
+		{
+			Name:     "description containing a definition path",
+			Input:    map[string]interface{}{"description": "see #/definitions/apiDevice"},
+			Expected: map[string]interface{}{"description": "see #/definitions/apiDevice"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			require.Equal(t, test.Expected, convertRefs(test.Input))
+		})
+	}
+}
+
+func TestConvertOperation(t *testing.T) {
+	defaultResponse := map[string]interface{}{
+		"description": "An error response.",
+		"content": map[string]interface{}{
+			"application/json": map[string]interface{}{
+				"schema": map[string]interface{}{"$ref": "#/components/schemas/runtimeError"},
+			},
+		},
+	}
+
+	tests := []struct {
+		Name      string
+		Operation map[string]interface{}
+		Expected  map[string]interface{}
+	}{
+		{
+			Name: "path parameter and body",
+			Operation: map[string]interface{}{
+				"summary":     "Update updates the device.",
+				"operationId": "Update",
+				"tags":        []interface{}{"DeviceService"},
+				"parameters": []interface{}{
+					map[string]interface{}{
+						"name":     "device.dev_eui",
+						"in":       "path",
+						"required": true,
+						"type":     "string",
+					},
+					map[string]interface{}{
+						"name":     "body",
+						"in":       "body",
+						"required": true,
+						"schema":   map[string]interface{}{"$ref": "#/definitions/apiUpdateDeviceRequest"},
+					},
+				},
+				"responses": map[string]interface{}{
+					"200": map[string]interface{}{
+						"description": "",
+						"schema":      map[string]interface{}{"$ref": "#/definitions/protobufEmpty"},
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"summary":     "Update updates the device.",
+				"operationId": "DeviceService_Update",
+				"tags":        []interface{}{"DeviceService"},
+				"parameters": []interface{}{
+					map[string]interface{}{
+						"name":     "device.dev_eui",
+						"in":       "path",
+						"required": true,
+						"schema":   map[string]interface{}{"type": "string"},
+					},
+				},
+				"requestBody": map[string]interface{}{
+					"required": true,
+					"content": map[string]interface{}{
+						"application/json": map[string]interface{}{
+							"schema": map[string]interface{}{"$ref": "#/components/schemas/apiUpdateDeviceRequest"},
+						},
+					},
+				},
+				"responses": map[string]interface{}{
+					"200": map[string]interface{}{
+						"description": "A successful response.",
+						"content": map[string]interface{}{
+							"application/json": map[string]interface{}{
+								"schema": map[string]interface{}{"$ref": "#/components/schemas/protobufEmpty"},
+							},
+						},
+					},
+					"default": defaultResponse,
+				},
+			},
+		},
+		{
+			Name: "enum query parameter",
+			Operation: map[string]interface{}{
+				"operationId": "List",
+				"tags":        []interface{}{"GatewayService"},
+				"parameters": []interface{}{
+					map[string]interface{}{
+						"name":    "interval",
+						"in":      "query",
+						"type":    "string",
+						"enum":    []interface{}{"MINUTE", "HOUR"},
+						"default": "MINUTE",
+					},
+				},
+				"responses": map[string]interface{}{
+					"200": map[string]interface{}{
+						"description": "",
+						"schema":      map[string]interface{}{"$ref": "#/definitions/apiListGatewayResponse"},
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"operationId": "GatewayService_List",
+				"tags":        []interface{}{"GatewayService"},
+				"parameters": []interface{}{
+					map[string]interface{}{
+						"name": "interval",
+						"in":   "query",
+						"schema": map[string]interface{}{
+							"type":    "string",
+							"enum":    []interface{}{"MINUTE", "HOUR"},
+							"default": "MINUTE",
+						},
+					},
+				},
+				"responses": map[string]interface{}{
+					"200": map[string]interface{}{
+						"description": "A successful response.",
+						"content": map[string]interface{}{
+							"application/json": map[string]interface{}{
+								"schema": map[string]interface{}{"$ref": "#/components/schemas/apiListGatewayResponse"},
+							},
+						},
+					},
+					"default": defaultResponse,
+				},
+			},
+		},
+		{
+			Name: "streaming response",
+			Operation: map[string]interface{}{
+				"operationId": "StreamEventLogs",
+				"tags":        []interface{}{"DeviceService"},
+				"responses": map[string]interface{}{
+					"200": map[string]interface{}{
+						"description": "(streaming responses)",
+						"schema":      map[string]interface{}{"$ref": "#/definitions/apiStreamDeviceEventLogsResponse"},
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"operationId": "DeviceService_StreamEventLogs",
+				"tags":        []interface{}{"DeviceService"},
+				"responses": map[string]interface{}{
+					"200": map[string]interface{}{
+						"description": "(streaming responses)",
+						"content": map[string]interface{}{
+							"application/json": map[string]interface{}{
+								"schema": map[string]interface{}{
+									"type": "object",
+									"properties": map[string]interface{}{
+										"result": map[string]interface{}{"$ref": "#/components/schemas/apiStreamDeviceEventLogsResponse"},
+										"error":  map[string]interface{}{"$ref": "#/components/schemas/runtimeStreamError"},
+									},
+								},
+							},
+						},
+					},
+					"default": defaultResponse,
+				},
+			},
+		},
+		{
+			Name: "login without security",
+			Operation: map[string]interface{}{
+				"operationId": "Login",
+				"tags":        []interface{}{"InternalService"},
+				"responses":   map[string]interface{}{},
+			},
+			Expected: map[string]interface{}{
+				"operationId": "InternalService_Login",
+				"tags":        []interface{}{"InternalService"},
+				"security":    []interface{}{},
+				"responses": map[string]interface{}{
+					"default": defaultResponse,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			require.Equal(t, test.Expected, convertOperation(test.Operation))
+		})
+	}
+}
+
+func TestToOpenAPI(t *testing.T) {
+	assert := require.New(t)
+
+	var swagger model
+	swagger.Info.Title = "LoRa App Server REST API"
+	swagger.Info.Version = apiVersion
+	swagger.Paths = map[string]interface{}{
+		"/api/devices/{dev_eui}": map[string]interface{}{
+			"get": map[string]interface{}{
+				"operationId": "Get",
+				"tags":        []interface{}{"DeviceService"},
+				"responses": map[string]interface{}{
+					"200": map[string]interface{}{
+						"schema": map[string]interface{}{"$ref": "#/definitions/apiGetDeviceResponse"},
+					},
+				},
+			},
+		},
+	}
+	swagger.Definitions = map[string]interface{}{
+		"apiGetDeviceResponse": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"device": map[string]interface{}{"$ref": "#/definitions/apiDevice"},
+			},
+		},
+	}
+
+	out := toOpenAPI(swagger)
+	assert.Equal("3.0.1", out.OpenAPI)
+	assert.Equal(swagger.Info, out.Info)
+	assert.Equal([]map[string][]string{{"bearerAuth": {}}}, out.Security)
+
+	schemas := out.Components["schemas"].(map[string]interface{})
+	assert.Equal(map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"device": map[string]interface{}{"$ref": "#/components/schemas/apiDevice"},
+		},
+	}, schemas["apiGetDeviceResponse"])
+	for name := range errorSchemas {
+		assert.Contains(schemas, name)
+	}
+
+	op := out.Paths["/api/devices/{dev_eui}"].(map[string]interface{})["get"].(map[string]interface{})
+	assert.Equal("DeviceService_Get", op["operationId"])
+}
@@ -703,6 +703,123 @@ func (m *UpdateUserPasswordRequest) GetPassword() string {
 	return ""
 }
 
+type ExportUserDataRequest struct {
+	// User ID.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportUserDataRequest) Reset()         { *m = ExportUserDataRequest{} }
+func (m *ExportUserDataRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportUserDataRequest) ProtoMessage()    {}
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_116e343673f7ffaf, []int{12}
+}
+func (m *ExportUserDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportUserDataRequest.Unmarshal(m, b)
+}
+func (m *ExportUserDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportUserDataRequest.Marshal(b, m, deterministic)
+}
+func (dst *ExportUserDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportUserDataRequest.Merge(dst, src)
+}
+func (m *ExportUserDataRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportUserDataRequest.Size(m)
+}
+func (m *ExportUserDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportUserDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportUserDataRequest proto.InternalMessageInfo
+
+func (m *ExportUserDataRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type ExportUserDataResponse struct {
+	// Exported data (JSON document).
+	DataJson             string   `protobuf:"bytes,1,opt,name=data_json,json=dataJSON,proto3" json:"data_json,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportUserDataResponse) Reset()         { *m = ExportUserDataResponse{} }
+func (m *ExportUserDataResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportUserDataResponse) ProtoMessage()    {}
+func (*ExportUserDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_116e343673f7ffaf, []int{13}
+}
+func (m *ExportUserDataResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportUserDataResponse.Unmarshal(m, b)
+}
+func (m *ExportUserDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportUserDataResponse.Marshal(b, m, deterministic)
+}
+func (dst *ExportUserDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportUserDataResponse.Merge(dst, src)
+}
+func (m *ExportUserDataResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportUserDataResponse.Size(m)
+}
+func (m *ExportUserDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportUserDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportUserDataResponse proto.InternalMessageInfo
+
+func (m *ExportUserDataResponse) GetDataJson() string {
+	if m != nil {
+		return m.DataJson
+	}
+	return ""
+}
+
+type EraseUserDataRequest struct {
+	// User ID.
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EraseUserDataRequest) Reset()         { *m = EraseUserDataRequest{} }
+func (m *EraseUserDataRequest) String() string { return proto.CompactTextString(m) }
+func (*EraseUserDataRequest) ProtoMessage()    {}
+func (*EraseUserDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_116e343673f7ffaf, []int{14}
+}
+func (m *EraseUserDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EraseUserDataRequest.Unmarshal(m, b)
+}
+func (m *EraseUserDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EraseUserDataRequest.Marshal(b, m, deterministic)
+}
+func (dst *EraseUserDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EraseUserDataRequest.Merge(dst, src)
+}
+func (m *EraseUserDataRequest) XXX_Size() int {
+	return xxx_messageInfo_EraseUserDataRequest.Size(m)
+}
+func (m *EraseUserDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EraseUserDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EraseUserDataRequest proto.InternalMessageInfo
+
+func (m *EraseUserDataRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*User)(nil), "api.User")
 	proto.RegisterType((*UserListItem)(nil), "api.UserListItem")
@@ -716,6 +833,9 @@ func init() {
 	proto.RegisterType((*ListUserRequest)(nil), "api.ListUserRequest")
 	proto.RegisterType((*ListUserResponse)(nil), "api.ListUserResponse")
 	proto.RegisterType((*UpdateUserPasswordRequest)(nil), "api.UpdateUserPasswordRequest")
+	proto.RegisterType((*ExportUserDataRequest)(nil), "api.ExportUserDataRequest")
+	proto.RegisterType((*ExportUserDataResponse)(nil), "api.ExportUserDataResponse")
+	proto.RegisterType((*EraseUserDataRequest)(nil), "api.EraseUserDataRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -742,6 +862,14 @@ type UserServiceClient interface {
 	Delete(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// UpdatePassword updates a password.
 	UpdatePassword(ctx context.Context, in *UpdateUserPasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ExportData exports all data stored for the given user (data-subject
+	// access request), including the organization memberships and security
+	// events. The password hash is not included.
+	ExportData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error)
+	// EraseData irreversibly erases all data stored for the given user
+	// (right to erasure). The user is deleted and the username in the
+	// security events is replaced by a random pseudonym.
+	EraseData(ctx context.Context, in *EraseUserDataRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type userServiceClient struct {
@@ -806,6 +934,24 @@ func (c *userServiceClient) UpdatePassword(ctx context.Context, in *UpdateUserPa
 	return out, nil
 }
 
+func (c *userServiceClient) ExportData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error) {
+	out := new(ExportUserDataResponse)
+	err := c.cc.Invoke(ctx, "/api.UserService/ExportData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) EraseData(ctx context.Context, in *EraseUserDataRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/api.UserService/EraseData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 type UserServiceServer interface {
 	// Get user list.
@@ -820,6 +966,14 @@ type UserServiceServer interface {
 	Delete(context.Context, *DeleteUserRequest) (*empty.Empty, error)
 	// UpdatePassword updates a password.
 	UpdatePassword(context.Context, *UpdateUserPasswordRequest) (*empty.Empty, error)
+	// ExportData exports all data stored for the given user (data-subject
+	// access request), including the organization memberships and security
+	// events. The password hash is not included.
+	ExportData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error)
+	// EraseData irreversibly erases all data stored for the given user
+	// (right to erasure). The user is deleted and the username in the
+	// security events is replaced by a random pseudonym.
+	EraseData(context.Context, *EraseUserDataRequest) (*empty.Empty, error)
 }
 
 func RegisterUserServiceServer(s *grpc.Server, srv UserServiceServer) {
@@ -934,6 +1088,42 @@ func _UserService_UpdatePassword_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ExportData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ExportData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.UserService/ExportData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ExportData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_EraseData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).EraseData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.UserService/EraseData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).EraseData(ctx, req.(*EraseUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _UserService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.UserService",
 	HandlerType: (*UserServiceServer)(nil),
@@ -962,6 +1152,14 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePassword",
 			Handler:    _UserService_UpdatePassword_Handler,
 		},
+		{
+			MethodName: "ExportData",
+			Handler:    _UserService_ExportData_Handler,
+		},
+		{
+			MethodName: "EraseData",
+			Handler:    _UserService_EraseData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
@@ -970,53 +1168,59 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("user.proto", fileDescriptor_116e343673f7ffaf) }
 
 var fileDescriptor_116e343673f7ffaf = []byte{
-	// 760 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0x96, 0xe3, 0xc4, 0x4d, 0x26, 0xfd, 0x93, 0x66, 0xff, 0xb4, 0x75, 0xdd, 0xbf, 0x7f, 0x22,
-	0x83, 0x44, 0xe8, 0x21, 0x91, 0xc2, 0x89, 0x72, 0x8a, 0x5a, 0x54, 0x45, 0xaa, 0x44, 0x31, 0x2d,
-	0x88, 0x0b, 0xd1, 0x36, 0xde, 0x96, 0x95, 0x62, 0xaf, 0xf1, 0x6e, 0x8a, 0x00, 0xf5, 0xc2, 0x95,
-	0x23, 0xe2, 0x15, 0x78, 0x01, 0xc4, 0x93, 0xf0, 0x0a, 0x3c, 0x08, 0xda, 0xf5, 0x3a, 0x71, 0x1c,
-	0x95, 0x02, 0x37, 0x6e, 0x99, 0xd9, 0x99, 0x6f, 0xbe, 0xf9, 0xe6, 0x4b, 0x02, 0x30, 0xe5, 0x24,
-	0xee, 0x46, 0x31, 0x13, 0x0c, 0x99, 0x38, 0xa2, 0xce, 0x7f, 0x17, 0x8c, 0x5d, 0x4c, 0x48, 0x0f,
-	0x47, 0xb4, 0x87, 0xc3, 0x90, 0x09, 0x2c, 0x28, 0x0b, 0x79, 0x52, 0xe2, 0xb4, 0xf4, 0xab, 0x8a,
-	0xce, 0xa6, 0xe7, 0x3d, 0x41, 0x03, 0xc2, 0x05, 0x0e, 0x22, 0x5d, 0xb0, 0x9d, 0x2f, 0x20, 0x41,
-	0x24, 0xde, 0x24, 0x8f, 0xee, 0x57, 0x03, 0x8a, 0xa7, 0x9c, 0xc4, 0xa8, 0x06, 0x05, 0xea, 0xdb,
-	0x46, 0xdb, 0xe8, 0x98, 0x5e, 0x81, 0xfa, 0xc8, 0x81, 0xb2, 0xe4, 0x11, 0xe2, 0x80, 0xd8, 0x85,
-	0xb6, 0xd1, 0xa9, 0x78, 0xb3, 0x18, 0xb5, 0xa0, 0xca, 0x09, 0xe7, 0x94, 0x85, 0x23, 0x21, 0x26,
-	0xb6, 0xd9, 0x36, 0x3a, 0x25, 0x0f, 0x74, 0xea, 0xe4, 0xe4, 0x08, 0x6d, 0x41, 0x99, 0xf2, 0x11,
-	0xf6, 0x03, 0x1a, 0xda, 0xc5, 0xb6, 0xd1, 0x29, 0x7b, 0x2b, 0x94, 0x0f, 0x64, 0x88, 0xb6, 0xa1,
-	0x22, 0x9f, 0xc6, 0x82, 0x5e, 0x12, 0xbb, 0xa4, 0xde, 0xca, 0x94, 0x0f, 0x54, 0x8c, 0x9a, 0x50,
-	0x22, 0x01, 0xa6, 0x13, 0xdb, 0x52, 0x13, 0x93, 0x00, 0x21, 0x28, 0x86, 0x4c, 0x10, 0x7b, 0x45,
-	0x25, 0xd5, 0x67, 0xf7, 0x4b, 0x01, 0x56, 0x25, 0xef, 0x23, 0xca, 0xc5, 0x50, 0x90, 0xe0, 0x2f,
-	0xe3, 0x8f, 0xee, 0x03, 0x8c, 0x63, 0x82, 0x05, 0xf1, 0x47, 0x58, 0xd8, 0xe5, 0xb6, 0xd1, 0xa9,
-	0xf6, 0x9d, 0x6e, 0x72, 0xa9, 0x6e, 0x7a, 0xa9, 0xee, 0x49, 0x7a, 0x4a, 0xaf, 0xa2, 0xab, 0x07,
-	0x42, 0xb6, 0x4e, 0x23, 0x3f, 0x6d, 0xad, 0xdc, 0xdc, 0xaa, 0xab, 0x07, 0xc2, 0x7d, 0x0a, 0x6b,
-	0x52, 0xb4, 0x47, 0xf1, 0x05, 0x0e, 0xe9, 0x5b, 0x65, 0x23, 0x74, 0x07, 0xea, 0x2c, 0x13, 0x8f,
-	0x66, 0x2a, 0xd6, 0xb2, 0xe9, 0xe1, 0xc1, 0x82, 0x28, 0x85, 0x05, 0x51, 0xdc, 0x0f, 0x06, 0x34,
-	0xf6, 0x15, 0x41, 0x09, 0xef, 0x91, 0x57, 0x53, 0xc2, 0x05, 0xda, 0x81, 0xa2, 0x94, 0x5c, 0xc1,
-	0x55, 0xfb, 0x95, 0x2e, 0x8e, 0x68, 0x57, 0xbd, 0xab, 0xb4, 0xbc, 0x50, 0x84, 0x39, 0x7f, 0xcd,
-	0x62, 0x3f, 0xbd, 0x50, 0x1a, 0xa3, 0x07, 0xf0, 0x4f, 0x76, 0x3a, 0xb7, 0xcd, 0xb6, 0xd9, 0xa9,
-	0xf6, 0xd7, 0x67, 0x18, 0xd9, 0x15, 0xbc, 0xc5, 0x5a, 0xf7, 0x36, 0xa0, 0x2c, 0x19, 0x1e, 0xb1,
-	0x90, 0x93, 0xbc, 0x41, 0xdc, 0x36, 0xd4, 0x0e, 0x89, 0xc8, 0xf2, 0xcd, 0x57, 0x7c, 0x36, 0xa0,
-	0x3e, 0x2b, 0xd1, 0x28, 0x37, 0xec, 0xb4, 0x78, 0xd6, 0xc2, 0x9f, 0x9f, 0xd5, 0xfc, 0x9d, 0xb3,
-	0xf6, 0xa1, 0x71, 0xaa, 0x82, 0x5f, 0x57, 0xdf, 0xbd, 0x05, 0x8d, 0x03, 0x32, 0x21, 0x8b, 0x3d,
-	0x79, 0x05, 0x9e, 0x41, 0x5d, 0x7e, 0xc1, 0xb2, 0x25, 0x4d, 0x28, 0x4d, 0x68, 0x40, 0x85, 0xae,
-	0x4a, 0x02, 0xb4, 0x01, 0x16, 0x3b, 0x3f, 0xe7, 0x24, 0xd9, 0xd9, 0xf4, 0x74, 0x24, 0xf3, 0x9c,
-	0xe0, 0x78, 0xfc, 0x52, 0x2d, 0x54, 0xf1, 0x74, 0xe4, 0xbe, 0x80, 0xb5, 0x39, 0xb0, 0x96, 0xb6,
-	0x05, 0x55, 0xc1, 0x04, 0x9e, 0x8c, 0xc6, 0x6c, 0x1a, 0xa6, 0xf8, 0xa0, 0x52, 0xfb, 0x32, 0x83,
-	0xee, 0x82, 0x15, 0x13, 0x3e, 0x9d, 0xc8, 0x21, 0xd2, 0x0d, 0x8d, 0xd9, 0x4e, 0xe9, 0xaf, 0x80,
-	0xa7, 0x0b, 0xdc, 0x63, 0xd8, 0x9a, 0x2b, 0x72, 0xac, 0x5d, 0x95, 0xae, 0xb0, 0x09, 0x2b, 0x52,
-	0x82, 0xb9, 0xd3, 0x2d, 0x19, 0x0e, 0xfd, 0x9f, 0x39, 0xb2, 0xff, 0xa9, 0x08, 0x55, 0x09, 0xf6,
-	0x84, 0xc4, 0x97, 0x74, 0x4c, 0xd0, 0x21, 0x14, 0xe5, 0x54, 0xd4, 0x54, 0x24, 0x72, 0x2a, 0x39,
-	0xeb, 0xb9, 0x6c, 0xb2, 0xa2, 0x8b, 0xde, 0x7f, 0xfb, 0xfe, 0xb1, 0xb0, 0x8a, 0x40, 0xfd, 0x96,
-	0xcb, 0xa9, 0x1c, 0x0d, 0xc1, 0x3c, 0x24, 0x02, 0xfd, 0xab, 0x3a, 0x16, 0x1d, 0xe9, 0x34, 0x17,
-	0x93, 0x1a, 0x65, 0x53, 0xa1, 0x34, 0x50, 0x7d, 0x8e, 0xd2, 0x7b, 0x47, 0xfd, 0x2b, 0x74, 0x0c,
-	0x56, 0x62, 0x7c, 0xb4, 0xa1, 0x1a, 0x97, 0xbe, 0x92, 0xce, 0xe6, 0x52, 0x5e, 0x63, 0xae, 0x2b,
-	0xcc, 0xba, 0x9b, 0x61, 0xb6, 0x67, 0xec, 0xa2, 0xe7, 0x60, 0x25, 0x3a, 0x6a, 0xc4, 0x25, 0x9b,
-	0x39, 0x1b, 0x4b, 0x16, 0x7d, 0x28, 0xff, 0x5e, 0xdc, 0x96, 0x02, 0xdc, 0x72, 0x9a, 0x59, 0x92,
-	0xea, 0x9f, 0x8d, 0xfa, 0x57, 0x12, 0xfa, 0x31, 0x58, 0x89, 0x01, 0x35, 0xf4, 0x92, 0x1b, 0xaf,
-	0x85, 0xd6, 0xfb, 0xef, 0x2e, 0xed, 0x1f, 0x43, 0x2d, 0x21, 0x98, 0x5e, 0x1c, 0xfd, 0x9f, 0x63,
-	0x9d, 0xb3, 0xc2, 0xb5, 0x23, 0x3a, 0x6a, 0x84, 0xeb, 0xec, 0xe4, 0xd9, 0x8f, 0xa8, 0x7f, 0xd5,
-	0x4b, 0x4d, 0xb1, 0x67, 0xec, 0x9e, 0x59, 0xaa, 0xf3, 0xde, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x40, 0x20, 0xf3, 0xce, 0xb6, 0x07, 0x00, 0x00,
+	// 855 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd5, 0x55, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x55, 0x5e, 0x6e, 0x72, 0x53, 0x92, 0x66, 0xc8, 0xc3, 0x71, 0x08, 0xad, 0x5c, 0x44, 0x4b,
+	0x25, 0x12, 0x29, 0x88, 0x05, 0xb0, 0x8a, 0xda, 0xaa, 0x0a, 0xaa, 0x68, 0x31, 0x2d, 0x88, 0x05,
+	0x44, 0x6e, 0x32, 0x2d, 0xae, 0x12, 0xdb, 0x78, 0x26, 0xe5, 0xa5, 0x6e, 0xd8, 0xb2, 0xe4, 0x1f,
+	0xf8, 0x01, 0xc4, 0x97, 0xf0, 0x03, 0x2c, 0xf8, 0x10, 0x66, 0xc6, 0xe3, 0xc4, 0x71, 0x1a, 0x0a,
+	0xec, 0xd8, 0xf9, 0x3e, 0xe6, 0xdc, 0x73, 0xef, 0x3d, 0x33, 0x06, 0x18, 0x11, 0xec, 0x35, 0x5c,
+	0xcf, 0xa1, 0x0e, 0x4a, 0x98, 0xae, 0xa5, 0x5d, 0x3b, 0x71, 0x9c, 0x93, 0x01, 0x6e, 0xb2, 0xef,
+	0xa6, 0x69, 0xdb, 0x0e, 0x35, 0xa9, 0xe5, 0xd8, 0xc4, 0x4f, 0xd1, 0x96, 0x65, 0x54, 0x58, 0x47,
+	0xa3, 0xe3, 0x26, 0xb5, 0x86, 0x98, 0x50, 0x73, 0xe8, 0xca, 0x84, 0x5a, 0x34, 0x01, 0x0f, 0x5d,
+	0xfa, 0xce, 0x0f, 0xea, 0xdf, 0x62, 0x90, 0x3c, 0x64, 0xf5, 0x50, 0x0e, 0xe2, 0x56, 0x5f, 0x8d,
+	0xad, 0xc4, 0xd6, 0x13, 0x06, 0xfb, 0x42, 0x1a, 0xa4, 0x39, 0x0f, 0xdb, 0x1c, 0x62, 0x35, 0xce,
+	0xbc, 0x19, 0x63, 0x6c, 0xa3, 0x65, 0xc8, 0x12, 0x4c, 0x08, 0x23, 0xd1, 0xa5, 0x74, 0xa0, 0x26,
+	0x58, 0x38, 0x65, 0x80, 0x74, 0x1d, 0x1c, 0xec, 0xa2, 0x2a, 0xa4, 0x2d, 0xd2, 0x35, 0xfb, 0x43,
+	0xcb, 0x56, 0x93, 0x2c, 0x9a, 0x36, 0x16, 0x2c, 0xd2, 0xe6, 0x26, 0xaa, 0x41, 0x86, 0x87, 0x7a,
+	0xd4, 0x3a, 0xc3, 0x6a, 0x4a, 0xc4, 0x58, 0x6e, 0x5b, 0xd8, 0xa8, 0x08, 0x29, 0x3c, 0x34, 0xad,
+	0x81, 0xaa, 0x88, 0x8a, 0xbe, 0x81, 0x10, 0x24, 0x59, 0xd3, 0x58, 0x5d, 0x10, 0x4e, 0xf1, 0xad,
+	0x7f, 0x8d, 0xc3, 0x22, 0xe7, 0xbd, 0x6b, 0x11, 0xda, 0xa1, 0x78, 0xf8, 0x9f, 0xf1, 0x47, 0xf7,
+	0x00, 0x7a, 0x1e, 0x36, 0x29, 0xee, 0x77, 0x4d, 0xaa, 0xa6, 0x59, 0x24, 0xdb, 0xd2, 0x1a, 0xfe,
+	0xa6, 0x1a, 0xc1, 0xa6, 0x1a, 0x07, 0xc1, 0x2a, 0x8d, 0x8c, 0xcc, 0x6e, 0x53, 0x7e, 0x74, 0xe4,
+	0xf6, 0x83, 0xa3, 0x99, 0xcb, 0x8f, 0xca, 0xec, 0x36, 0xd5, 0x9f, 0xc2, 0x12, 0x1f, 0xda, 0x9e,
+	0x77, 0x62, 0xda, 0xd6, 0x7b, 0x21, 0x23, 0xb4, 0x06, 0x79, 0x27, 0x64, 0x77, 0xc7, 0x53, 0xcc,
+	0x85, 0xdd, 0x9d, 0xad, 0xa9, 0xa1, 0xc4, 0xa7, 0x86, 0xa2, 0x7f, 0x8a, 0x41, 0x61, 0x53, 0x10,
+	0xe4, 0xf0, 0x06, 0x7e, 0x3d, 0x62, 0xb5, 0x51, 0x1d, 0x92, 0x7c, 0xe4, 0x02, 0x2e, 0xdb, 0xca,
+	0x34, 0x98, 0x7e, 0x1b, 0x22, 0x2e, 0xdc, 0x7c, 0x43, 0xae, 0x49, 0xc8, 0x1b, 0xc7, 0xeb, 0x07,
+	0x1b, 0x0a, 0x6c, 0xf4, 0x00, 0xae, 0x84, 0xab, 0x13, 0xb6, 0xa3, 0x04, 0xc3, 0x28, 0x8d, 0x31,
+	0xc2, 0x2d, 0x18, 0xd3, 0xb9, 0xfa, 0x0d, 0x40, 0x61, 0x32, 0xc4, 0x65, 0x4e, 0x1c, 0x15, 0x88,
+	0xbe, 0x02, 0xb9, 0x1d, 0x4c, 0xc3, 0x7c, 0xa3, 0x19, 0x5f, 0x62, 0x90, 0x1f, 0xa7, 0x48, 0x94,
+	0x4b, 0x7a, 0x9a, 0x5e, 0x6b, 0xfc, 0xdf, 0xd7, 0x9a, 0xf8, 0x9b, 0xb5, 0xb6, 0xa0, 0x70, 0x28,
+	0x8c, 0x3f, 0x9f, 0xbe, 0xbe, 0x0a, 0x85, 0x2d, 0x3c, 0xc0, 0xd3, 0x67, 0xa2, 0x13, 0x78, 0x06,
+	0x79, 0x7e, 0xc1, 0xc2, 0x29, 0x4c, 0xe2, 0x03, 0x6b, 0x68, 0x51, 0x99, 0xe5, 0x1b, 0xa8, 0x0c,
+	0x8a, 0x73, 0x7c, 0x4c, 0xb0, 0xdf, 0x73, 0xc2, 0x90, 0x16, 0xf7, 0x13, 0x6c, 0x7a, 0xbd, 0x57,
+	0xa2, 0xa1, 0x8c, 0x21, 0x2d, 0xfd, 0x25, 0x2c, 0x4d, 0x80, 0xe5, 0x68, 0xd9, 0xad, 0xa4, 0xec,
+	0x6d, 0x1b, 0x74, 0x7b, 0xce, 0xc8, 0x0e, 0xf0, 0x41, 0xb8, 0x36, 0xb9, 0x07, 0xdd, 0x02, 0xc5,
+	0xc3, 0x64, 0x34, 0xe0, 0x45, 0xb8, 0x1a, 0x0a, 0xe3, 0x9e, 0x82, 0x57, 0xc0, 0x90, 0x09, 0xfa,
+	0x3e, 0x54, 0x27, 0x13, 0xd9, 0x97, 0xaa, 0x0a, 0x5a, 0xa8, 0xc0, 0x02, 0x1f, 0xc1, 0x44, 0xe9,
+	0x0a, 0x37, 0x3b, 0xfd, 0xdf, 0x29, 0x52, 0x5f, 0x83, 0xd2, 0xf6, 0x5b, 0xd7, 0xf1, 0x04, 0xe7,
+	0x2d, 0x93, 0x9a, 0xf3, 0x66, 0x76, 0x17, 0xca, 0xd1, 0x44, 0xd9, 0x20, 0x7b, 0x3a, 0x18, 0x25,
+	0xb3, 0x7b, 0x4a, 0x1c, 0x5b, 0x1c, 0x60, 0xf8, 0xdc, 0xf1, 0xf0, 0xc9, 0xde, 0x23, 0xfd, 0x26,
+	0x14, 0xb7, 0x3d, 0x93, 0xe0, 0x4b, 0xe0, 0x5b, 0x3f, 0x52, 0x90, 0xe5, 0x39, 0x4f, 0xb0, 0x77,
+	0x66, 0xf5, 0x30, 0xda, 0x81, 0x24, 0xef, 0x1e, 0x15, 0xc5, 0x30, 0x22, 0xdb, 0xd2, 0x4a, 0x11,
+	0xaf, 0xcf, 0x44, 0x47, 0x1f, 0xbf, 0xff, 0xfc, 0x1c, 0x5f, 0x44, 0x20, 0xfe, 0x29, 0xbc, 0x7b,
+	0x82, 0x3a, 0x90, 0x60, 0x62, 0x47, 0x57, 0xc5, 0x89, 0xe9, 0x9b, 0xa1, 0x15, 0xa7, 0x9d, 0x12,
+	0xa5, 0x22, 0x50, 0x0a, 0x28, 0x3f, 0x41, 0x69, 0x7e, 0xb0, 0xfa, 0xe7, 0x68, 0x1f, 0x14, 0xff,
+	0x02, 0xa2, 0xb2, 0x38, 0x38, 0xf3, 0x34, 0x68, 0x95, 0x19, 0xbf, 0xc4, 0x2c, 0x09, 0xcc, 0xbc,
+	0x1e, 0x62, 0x76, 0x3f, 0xb6, 0x81, 0x9e, 0x83, 0xe2, 0xef, 0x53, 0x22, 0xce, 0xc8, 0x5d, 0x2b,
+	0xcf, 0x5c, 0x95, 0x6d, 0xfe, 0x9b, 0xd3, 0x97, 0x05, 0x60, 0x55, 0x2b, 0x86, 0x49, 0x8a, 0x3f,
+	0x2c, 0x63, 0xca, 0xa1, 0x1f, 0x83, 0xe2, 0x5f, 0x04, 0x09, 0x3d, 0x73, 0x2b, 0xe6, 0x42, 0xcb,
+	0xfe, 0x37, 0x66, 0xfa, 0xf7, 0x20, 0xe7, 0x13, 0x0c, 0x94, 0x87, 0xae, 0x47, 0x58, 0x47, 0x24,
+	0x39, 0xb7, 0xc4, 0xba, 0x28, 0xa1, 0x6b, 0xf5, 0x28, 0x7b, 0x26, 0xde, 0xf3, 0x66, 0x20, 0x4e,
+	0xde, 0xc6, 0x29, 0x80, 0x2f, 0x3b, 0x2e, 0x1e, 0xa4, 0x89, 0x7a, 0x17, 0x0a, 0x56, 0xab, 0x5d,
+	0x18, 0x93, 0xf3, 0x5f, 0x15, 0x05, 0xeb, 0xa8, 0x16, 0xe9, 0xa9, 0xc9, 0x85, 0x7a, 0x1b, 0x8b,
+	0x43, 0xe8, 0x05, 0x64, 0x84, 0x56, 0x45, 0xa9, 0xaa, 0x0f, 0x77, 0x81, 0x76, 0xe7, 0x76, 0x55,
+	0x17, 0x45, 0x2a, 0x7a, 0x29, 0x5a, 0x04, 0x73, 0x94, 0x23, 0x45, 0xa4, 0xdf, 0xf9, 0x05, 0xa9,
+	0x7d, 0xc6, 0xf7, 0x09, 0x09, 0x00, 0x00,
 }
@@ -0,0 +1,277 @@
+package cmd
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/brocaar/lora-app-server/internal/benchmark"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/loraserver/api/as"
+)
+
+// benchmarkDegradationWarning defines the P95 latency ratio (under load
+// vs baseline) of a probe after which it is reported as possible bottleneck.
+const benchmarkDegradationWarning = 5
+
+var benchmarkOpts struct {
+	server          string
+	caCert          string
+	tlsCert         string
+	tlsKey          string
+	applicationID   int64
+	deviceProfileID string
+	deviceCount     int
+	rate            float64
+	duration        time.Duration
+	concurrency     int
+	fPort           int
+	payloadSizes    string
+	probeInterval   time.Duration
+	keepDevices     bool
+}
+
+var benchmarkCmd = &cobra.Command{
+	Use:   "benchmark",
+	Short: "Load-test the application-server API of a running instance",
+	Long: `Load-test the application-server API of a running instance.
+	The benchmark creates activated devices under the given application and
+	device-profile and sends uplinks for these devices to the application-server
+	API at the given rate, using the given payload size distribution. When done,
+	it reports the throughput, the latency percentiles and the PostgreSQL and
+	Redis round-trip times before and during the benchmark. Unless
+	--keep-devices is set, the benchmark devices are deleted afterwards.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		tasks := []func() error{
+			setLogLevel,
+			setPostgreSQLConnection,
+			setRedisPool,
+			setNetworkServerClient,
+			runBenchmark,
+		}
+
+		for _, t := range tasks {
+			if err := t(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	benchmarkCmd.Flags().StringVar(&benchmarkOpts.server, "server", "localhost:8001", "hostname:port of the application-server API")
+	benchmarkCmd.Flags().StringVar(&benchmarkOpts.caCert, "ca-cert", "", "ca certificate used by the application-server API client (optional)")
+	benchmarkCmd.Flags().StringVar(&benchmarkOpts.tlsCert, "tls-cert", "", "tls certificate used by the application-server API client (optional)")
+	benchmarkCmd.Flags().StringVar(&benchmarkOpts.tlsKey, "tls-key", "", "tls key used by the application-server API client (optional)")
+	benchmarkCmd.Flags().Int64Var(&benchmarkOpts.applicationID, "application-id", 0, "application id of the benchmark devices")
+	benchmarkCmd.Flags().StringVar(&benchmarkOpts.deviceProfileID, "device-profile-id", "", "device-profile id of the benchmark devices")
+	benchmarkCmd.Flags().IntVar(&benchmarkOpts.deviceCount, "devices", 100, "number of benchmark devices")
+	benchmarkCmd.Flags().Float64Var(&benchmarkOpts.rate, "rate", 10, "number of uplinks per second")
+	benchmarkCmd.Flags().DurationVar(&benchmarkOpts.duration, "duration", time.Minute, "duration of the benchmark")
+	benchmarkCmd.Flags().IntVar(&benchmarkOpts.concurrency, "concurrency", 10, "max. number of uplinks in flight")
+	benchmarkCmd.Flags().IntVar(&benchmarkOpts.fPort, "f-port", 1, "fPort of the uplinks")
+	benchmarkCmd.Flags().StringVar(&benchmarkOpts.payloadSizes, "payload-sizes", "11:50,24:35,51:15", "payload size distribution (size:weight,...)")
+	benchmarkCmd.Flags().DurationVar(&benchmarkOpts.probeInterval, "probe-interval", time.Second, "interval of the PostgreSQL and Redis round-trip probes")
+	benchmarkCmd.Flags().BoolVar(&benchmarkOpts.keepDevices, "keep-devices", false, "do not delete the benchmark devices when the benchmark ends")
+}
+
+func runBenchmark() error {
+	if benchmarkOpts.deviceCount < 1 {
+		return errors.New("at least one device is required")
+	}
+
+	if benchmarkOpts.fPort < 1 || benchmarkOpts.fPort > 223 {
+		return errors.New("f-port must be between 1 and 223")
+	}
+
+	payloadSizes, err := benchmark.ParsePayloadSizes(benchmarkOpts.payloadSizes)
+	if err != nil {
+		return errors.Wrap(err, "invalid payload-sizes")
+	}
+
+	dpID, err := uuid.FromString(benchmarkOpts.deviceProfileID)
+	if err != nil {
+		return errors.Wrap(err, "invalid device-profile id")
+	}
+
+	if _, err := storage.GetApplication(config.C.PostgreSQL.DB, benchmarkOpts.applicationID, false); err != nil {
+		return errors.Wrap(err, "get application error")
+	}
+
+	dialOpts := []grpc.DialOption{grpc.WithBlock()}
+	if benchmarkOpts.caCert != "" && benchmarkOpts.tlsCert != "" && benchmarkOpts.tlsKey != "" {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(
+			mustGetTransportCredentials(benchmarkOpts.tlsCert, benchmarkOpts.tlsKey, benchmarkOpts.caCert, false),
+		))
+	} else {
+		dialOpts = append(dialOpts, grpc.WithInsecure())
+	}
+
+	dialCtx, dialCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer dialCancel()
+	conn, err := grpc.DialContext(dialCtx, benchmarkOpts.server, dialOpts...)
+	if err != nil {
+		return errors.Wrap(err, "dial application-server api error")
+	}
+	defer conn.Close()
+
+	devices, err := createBenchmarkDevices(dpID)
+	if !benchmarkOpts.keepDevices {
+		defer deleteBenchmarkDevices(devices)
+	}
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		log.WithField("signal", <-sigChan).Info("signal received, stopping benchmark")
+		cancel()
+	}()
+
+	probes := []benchmark.Probe{
+		{
+			Name: "postgresql",
+			Func: func() error {
+				_, err := config.C.PostgreSQL.DB.Exec("select 1")
+				return err
+			},
+		},
+		{
+			Name: "redis",
+			Func: func() error {
+				c := config.C.Redis.Pool.Get()
+				defer c.Close()
+				_, err := c.Do("PING")
+				return err
+			},
+		},
+	}
+
+	log.WithFields(log.Fields{
+		"server":   benchmarkOpts.server,
+		"devices":  len(devices),
+		"rate":     benchmarkOpts.rate,
+		"duration": benchmarkOpts.duration,
+	}).Info("starting benchmark")
+
+	res, err := benchmark.Run(ctx, as.NewApplicationServerServiceClient(conn), devices, benchmark.Config{
+		Rate:          benchmarkOpts.rate,
+		Duration:      benchmarkOpts.duration,
+		Concurrency:   benchmarkOpts.concurrency,
+		FPort:         uint8(benchmarkOpts.fPort),
+		PayloadSizes:  payloadSizes,
+		ProbeInterval: benchmarkOpts.probeInterval,
+	}, probes)
+	if err != nil {
+		return errors.Wrap(err, "benchmark error")
+	}
+
+	printBenchmarkResult(res)
+
+	return nil
+}
+
+func printBenchmarkResult(res benchmark.Result) {
+	fmt.Printf("duration:    %s\n", res.Duration)
+	fmt.Printf("uplinks:     %d (%d errors)\n", res.Sent, res.Errors)
+	fmt.Printf("throughput:  %.2f uplinks/s\n", res.Throughput())
+	fmt.Printf("latency:     %s\n", formatBenchmarkLatency(res.Latency))
+
+	for code, count := range res.ErrorCodes {
+		fmt.Printf("errors:      %s: %d\n", code, count)
+	}
+
+	for _, p := range res.Probes {
+		fmt.Printf("\n%s round-trip (%d errors)\n", p.Name, p.Errors)
+		fmt.Printf("  baseline:   %s\n", formatBenchmarkLatency(p.Baseline))
+		fmt.Printf("  under load: %s\n", formatBenchmarkLatency(p.UnderLoad))
+
+		if p.Degradation() >= benchmarkDegradationWarning {
+			fmt.Printf("  possible bottleneck: p95 latency increased %.1fx under load\n", p.Degradation())
+		}
+	}
+}
+
+func formatBenchmarkLatency(l benchmark.Latency) string {
+	if l.Count == 0 {
+		return "no samples"
+	}
+
+	return fmt.Sprintf("min=%s p50=%s p90=%s p95=%s p99=%s max=%s",
+		l.Min, l.P50, l.P90, l.P95, l.P99, l.Max,
+	)
+}
+
+// createBenchmarkDevices creates the (ABP activated) benchmark devices,
+// using random DevEUIs, DevAddrs and AppSKeys.
+func createBenchmarkDevices(dpID uuid.UUID) ([]*benchmark.Device, error) {
+	var devices []*benchmark.Device
+
+	for i := 0; i < benchmarkOpts.deviceCount; i++ {
+		var d benchmark.Device
+
+		for _, b := range [][]byte{d.DevEUI[:], d.DevAddr[:], d.AppSKey[:]} {
+			if _, err := rand.Read(b); err != nil {
+				return devices, errors.Wrap(err, "read random bytes error")
+			}
+		}
+
+		err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+			if err := storage.CreateDevice(tx, &storage.Device{
+				DevEUI:          d.DevEUI,
+				ApplicationID:   benchmarkOpts.applicationID,
+				DeviceProfileID: dpID,
+				Name:            fmt.Sprintf("benchmark-%s", d.DevEUI),
+				Description:     "Benchmark device",
+			}); err != nil {
+				return errors.Wrap(err, "create device error")
+			}
+
+			if err := storage.CreateDeviceActivation(tx, &storage.DeviceActivation{
+				DevEUI:  d.DevEUI,
+				DevAddr: d.DevAddr,
+				AppSKey: d.AppSKey,
+			}); err != nil {
+				return errors.Wrap(err, "create device-activation error")
+			}
+
+			return nil
+		})
+		if err != nil {
+			return devices, err
+		}
+
+		devices = append(devices, &d)
+	}
+
+	return devices, nil
+}
+
+func deleteBenchmarkDevices(devices []*benchmark.Device) {
+	for _, d := range devices {
+		if err := storage.DeleteDevice(config.C.PostgreSQL.DB, d.DevEUI); err != nil {
+			log.WithError(err).WithField("dev_eui", d.DevEUI).Error("delete benchmark device error")
+		}
+	}
+
+	log.WithField("devices", len(devices)).Info("benchmark devices deleted")
+}
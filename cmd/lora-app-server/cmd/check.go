@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/brocaar/lora-app-server/internal/selfcheck"
+)
+
+var checkCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Validate the configuration and the connectivity to the external services",
+	Long: `Validate the configuration and the connectivity to the external services.
+	This validates the configuration (e.g. the KEK set and the certificates)
+	and the connectivity to PostgreSQL, Redis, the network-servers and the MQTT
+	broker. A report is printed and the command exits with a non-zero exit code
+	when one of the checks failed, e.g. for validating configuration changes in
+	a CI/CD pipeline.`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		tasks := []func() error{
+			setLogLevel,
+			runCheck,
+		}
+
+		for _, t := range tasks {
+			if err := t(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
+
+func runCheck() error {
+	report := selfcheck.Run()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "STATUS\tCHECK\tMESSAGE")
+	for _, r := range report {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", strings.ToUpper(r.Status), r.Check, r.Message)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if report.HasErrors() {
+		return errors.New("one or more checks failed")
+	}
+
+	return nil
+}
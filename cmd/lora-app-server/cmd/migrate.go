@@ -0,0 +1,216 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/dbmigrate"
+)
+
+var migrateOpts struct {
+	contract  bool
+	upSteps   int
+	downSteps int
+	dryRun    bool
+}
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Manage the database migrations",
+	Long: `Manage the database migrations.
+	Migrations are either expand migrations (backwards compatible) or contract
+	migrations (backwards incompatible). For rolling upgrades, only apply the
+	expand migrations (see postgresql.automigrate_contract) and apply the
+	contract migrations once all instances have been upgraded.`,
+}
+
+var migrateCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Report the pending migrations",
+	Long: `Report the pending migrations.
+	This exits with a non-zero exit code when there are pending migrations
+	which are incompatible with the previous version (contract migrations)
+	or when the database has been migrated by a newer version. In this case,
+	the previous version must be stopped before applying the migrations.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		tasks := []func() error{
+			setLogLevel,
+			setPostgreSQLConnection,
+			migrateCheck,
+		}
+
+		for _, t := range tasks {
+			if err := t(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:   "up",
+	Short: "Apply the pending migrations",
+	Long: `Apply the pending migrations.
+	Use --dry-run to print the SQL of the pending migrations without applying
+	these and --steps to apply the migrations step-by-step.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		tasks := []func() error{
+			setLogLevel,
+			setPostgreSQLConnection,
+			migrateUp,
+		}
+
+		for _, t := range tasks {
+			if err := t(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
+
+var migrateDownCmd = &cobra.Command{
+	Use:   "down",
+	Short: "Roll back the last applied migrations",
+	Long: `Roll back the last applied migrations.
+	By default, only the last applied migration is rolled back. Please note
+	that rolling back a migration might delete data (e.g. when it drops a
+	table), make sure to make a backup first. Use --dry-run to print the SQL
+	without rolling back the migrations.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		tasks := []func() error{
+			setLogLevel,
+			setPostgreSQLConnection,
+			migrateDown,
+		}
+
+		for _, t := range tasks {
+			if err := t(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	migrateUpCmd.Flags().BoolVar(&migrateOpts.contract, "contract", false, "also apply the contract (backwards incompatible) migrations")
+	migrateUpCmd.Flags().IntVar(&migrateOpts.upSteps, "steps", 0, "max. number of migrations to apply (0 = all pending migrations)")
+	migrateUpCmd.Flags().BoolVar(&migrateOpts.dryRun, "dry-run", false, "print the SQL of the migrations without applying these")
+
+	migrateDownCmd.Flags().IntVar(&migrateOpts.downSteps, "steps", 1, "number of migrations to roll back")
+	migrateDownCmd.Flags().BoolVar(&migrateOpts.dryRun, "dry-run", false, "print the SQL of the migrations without rolling back these")
+
+	migrateCmd.AddCommand(migrateCheckCmd)
+	migrateCmd.AddCommand(migrateUpCmd)
+	migrateCmd.AddCommand(migrateDownCmd)
+}
+
+func migrateCheck() error {
+	r, err := dbmigrate.GetReport(config.C.PostgreSQL.DB.DB.DB)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "MIGRATION\tPHASE\tSTATUS")
+	for _, m := range r.Pending() {
+		status := "pending"
+		if m.Phase == dbmigrate.Contract {
+			status = "pending (incompatible with previous version)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", m.ID, m.Phase, status)
+	}
+	for _, id := range r.Unknown {
+		fmt.Fprintf(w, "%s\t\tunknown (applied by a newer version)\n", id)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(r.Unknown) != 0 {
+		return errors.Errorf("database has been migrated by a newer version (%d unknown migrations)", len(r.Unknown))
+	}
+
+	if n := len(r.Incompatible()); n != 0 {
+		return errors.Errorf("%d pending migrations are incompatible with the previous version", n)
+	}
+
+	log.WithField("pending", len(r.Pending())).Info("pending migrations are compatible with the previous version")
+
+	return nil
+}
+
+func migrateUp() error {
+	if migrateOpts.dryRun {
+		planned, err := dbmigrate.PlanUp(config.C.PostgreSQL.DB.DB.DB, migrateOpts.contract, migrateOpts.upSteps)
+		if err != nil {
+			return err
+		}
+		return printPlannedMigrations(planned, "up")
+	}
+
+	log.WithFields(log.Fields{
+		"contract": migrateOpts.contract,
+		"steps":    migrateOpts.upSteps,
+	}).Info("applying database migrations")
+	n, err := dbmigrate.Up(config.C.PostgreSQL.DB.DB.DB, migrateOpts.contract, migrateOpts.upSteps)
+	if err != nil {
+		return err
+	}
+	log.WithField("count", n).Info("migrations applied")
+
+	return nil
+}
+
+func migrateDown() error {
+	if migrateOpts.downSteps < 1 {
+		return errors.New("steps must be at least 1")
+	}
+
+	if migrateOpts.dryRun {
+		planned, err := dbmigrate.PlanDown(config.C.PostgreSQL.DB.DB.DB, migrateOpts.downSteps)
+		if err != nil {
+			return err
+		}
+		return printPlannedMigrations(planned, "down")
+	}
+
+	log.WithField("steps", migrateOpts.downSteps).Warning("rolling back database migrations")
+	n, err := dbmigrate.Down(config.C.PostgreSQL.DB.DB.DB, migrateOpts.downSteps)
+	if err != nil {
+		return err
+	}
+	log.WithField("count", n).Info("migrations rolled back")
+
+	return nil
+}
+
+// printPlannedMigrations prints the SQL of the given planned migrations.
+func printPlannedMigrations(planned []dbmigrate.PlannedMigration, direction string) error {
+	if len(planned) == 0 {
+		log.Info("no migrations planned")
+		return nil
+	}
+
+	for _, m := range planned {
+		fmt.Printf("-- %s (%s, %s)\n", m.ID, m.Phase, direction)
+		for _, q := range m.Queries {
+			fmt.Println(strings.TrimSpace(q))
+		}
+		fmt.Println()
+	}
+
+	return nil
+}
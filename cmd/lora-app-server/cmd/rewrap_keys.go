@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/handler/multihandler"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+var rewrapKeysBatchSize int
+
+var rewrapKeysCmd = &cobra.Command{
+	Use:   "rewrap-keys",
+	Short: "Re-wrap the stored keys using the configured storage KEK",
+	Long: `Re-wrap the stored keys using the configured storage KEK (join_server.kek.storage_kek_label).
+	This is used to rotate the storage KEK. The previously used KEK must be
+	present in the KEK set until this command has completed. Keys are re-wrapped
+	in batches, so that LoRa App Server can keep running during the rotation.
+	This includes the device session-keys, the organization data-keys, the
+	network-server KEKs and the HTTP integration header values and signing
+	secrets.
+	When join_server.kek.organization_data_keys is enabled, the stored keys
+	are re-wrapped using the data-key of the organization they belong to.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		tasks := []func() error{
+			setLogLevel,
+			setPostgreSQLConnection,
+			runDatabaseMigrations,
+			rewrapKeys,
+		}
+
+		for _, t := range tasks {
+			if err := t(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	rewrapKeysCmd.Flags().IntVar(&rewrapKeysBatchSize, "batch-size", 100, "number of keys to re-wrap per transaction")
+}
+
+func rewrapKeys() error {
+	// the organization data-keys are re-wrapped first, as these are used
+	// for wrapping the device-activation keys
+	if err := rewrapKeysInBatches("organization data-keys", storage.GetOrganizationDataKeysToRewrapCount, storage.RewrapOrganizationDataKeys); err != nil {
+		return err
+	}
+
+	if err := rewrapKeysInBatches("network-server keks", storage.GetNetworkServerKEKsToRewrapCount, storage.RewrapNetworkServerKEKs); err != nil {
+		return err
+	}
+
+	if err := rewrapKeysInBatches("http integration secrets", multihandler.GetHTTPIntegrationSecretsToRewrapCount, multihandler.RewrapHTTPIntegrationSecrets); err != nil {
+		return err
+	}
+
+	return rewrapKeysInBatches("device-activation keys", storage.GetDeviceActivationKeysToRewrapCount, storage.RewrapDeviceActivationKeys)
+}
+
+func rewrapKeysInBatches(name string, countFunc func(sqlx.Queryer) (int, error), rewrapFunc func(sqlx.Ext, int) (int, error)) error {
+	total, err := countFunc(config.C.PostgreSQL.DB)
+	if err != nil {
+		return errors.Wrapf(err, "get %s to re-wrap count error", name)
+	}
+
+	log.WithFields(log.Fields{
+		"count":                  total,
+		"kek_label":              config.C.JoinServer.KEK.StorageKEKLabel,
+		"organization_data_keys": config.C.JoinServer.KEK.OrganizationDataKeys,
+	}).Infof("re-wrapping stored %s", name)
+
+	var done int
+	for {
+		var n int
+		err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+			var err error
+			n, err = rewrapFunc(tx, rewrapKeysBatchSize)
+			return err
+		})
+		if err != nil {
+			return errors.Wrapf(err, "re-wrap %s error", name)
+		}
+
+		if n == 0 {
+			break
+		}
+
+		done += n
+		log.WithFields(log.Fields{
+			"done":  done,
+			"total": total,
+		}).Infof("re-wrap %s progress", name)
+	}
+
+	log.WithField("count", done).Infof("re-wrapping stored %s completed", name)
+
+	return nil
+}
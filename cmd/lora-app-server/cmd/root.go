@@ -40,6 +40,7 @@ func init() {
 	viper.SetDefault("general.password_hash_iterations", 100000)
 	viper.SetDefault("postgresql.dsn", "postgres://localhost/loraserver_as?sslmode=disable")
 	viper.SetDefault("postgresql.automigrate", true)
+	viper.SetDefault("postgresql.automigrate_contract", true)
 	viper.SetDefault("redis.url", "redis://localhost:6379")
 	viper.SetDefault("redis.max_idle", 10)
 	viper.SetDefault("redis.idle_timeout", 5*time.Minute)
@@ -48,7 +49,54 @@ func init() {
 	viper.SetDefault("application_server.id", "6d5db27e-4ce2-4b2b-b5d7-91f069397978")
 	viper.SetDefault("application_server.api.bind", "0.0.0.0:8001")
 	viper.SetDefault("application_server.external_api.bind", "0.0.0.0:8080")
+	viper.SetDefault("application_server.external_api.session_key_policy", "view_keys")
+	viper.SetDefault("application_server.external_api.service_account_token_ttl", time.Hour)
+	viper.SetDefault("application_server.external_api.cors.allowed_methods", []string{"GET", "POST", "PUT", "DELETE"})
+	viper.SetDefault("application_server.external_api.cors.allowed_headers", []string{"Authorization", "Content-Type", "If-None-Match", "X-Grpc-Web", "X-User-Agent"})
+	viper.SetDefault("application_server.external_api.cors.exposed_headers", []string{"ETag", "Grpc-Status", "Grpc-Message"})
+	viper.SetDefault("application_server.external_api.cors.max_age", 10*time.Minute)
+	viper.SetDefault("application_server.external_api.compression.enabled", true)
+	viper.SetDefault("application_server.external_api.compression.level", -1)
+	viper.SetDefault("application_server.external_api.event_stream.resume_buffer_size", 100)
+	viper.SetDefault("application_server.external_api.event_stream.resume_buffer_ttl", 5*time.Minute)
+	viper.SetDefault("application_server.registration.mode", "disabled")
+	viper.SetDefault("application_server.registration.invite_ttl", 7*24*time.Hour)
+	viper.SetDefault("application_server.service_profile_limits.warning_threshold", 0.8)
+	viper.SetDefault("application_server.key_mismatch_detection.uplink_count", 3)
+	viper.SetDefault("application_server.decrypt_quarantine.error_count", 3)
+	viper.SetDefault("application_server.decrypt_quarantine.max_frames", 1000)
+	viper.SetDefault("application_server.decrypt_quarantine.ttl", 7*24*time.Hour)
+	viper.SetDefault("application_server.frame_capture.max_duration", time.Hour)
+	viper.SetDefault("application_server.frame_capture.max_frames", 1000)
+	viper.SetDefault("application_server.frame_capture.retention", 24*time.Hour)
+	viper.SetDefault("application_server.gateway_stats.stats_topic", "gateway/+/stats")
+	viper.SetDefault("application_server.gateway_stats.marshaler", "json")
+	viper.SetDefault("application_server.gateway_signal_stats.geohash_precision", 7)
+	viper.SetDefault("application_server.f_cnt_anomaly_detection.enabled", true)
+	viper.SetDefault("application_server.f_cnt_anomaly_detection.max_gap", 16384)
+	viper.SetDefault("application_server.f_cnt_anomaly_detection.suppression_interval", time.Hour)
+	viper.SetDefault("application_server.security_events.syslog.network", "udp")
+	viper.SetDefault("application_server.security_events.syslog.address", "localhost:514")
+	viper.SetDefault("application_server.security_events.syslog.tag", "lora-app-server")
+	viper.SetDefault("application_server.security_events.syslog.format", "cef")
+	viper.SetDefault("application_server.certificate_expiry.check_interval", 24*time.Hour)
+	viper.SetDefault("application_server.certificate_expiry.warning_threshold", 30*24*time.Hour)
+	viper.SetDefault("application_server.retention.prune_interval", time.Hour)
+	viper.SetDefault("application_server.retention.device_uplink_stats_days", 90)
+	viper.SetDefault("application_server.retention.device_availability_days", 90)
+	viper.SetDefault("application_server.retention.gateway_signal_stats_days", 90)
+	viper.SetDefault("general.address_family", "dual_stack")
 	viper.SetDefault("join_server.bind", "0.0.0.0:8003")
+	viper.SetDefault("network_server.mock.region", "EU868")
+	viper.SetDefault("network_server.client.dial_timeout", 500*time.Millisecond)
+	viper.SetDefault("network_server.client.keepalive_time", time.Minute)
+	viper.SetDefault("network_server.client.keepalive_timeout", 20*time.Second)
+	viper.SetDefault("network_server.client.retry.max_retries", 3)
+	viper.SetDefault("network_server.client.retry.backoff", 100*time.Millisecond)
+	viper.SetDefault("application_server.geolocation.request_timeout", time.Second)
+	viper.SetDefault("application_server.geolocation.rssi_fallback.path_loss_exponent", 2.7)
+	viper.SetDefault("application_server.geolocation.rssi_fallback.reference_rssi", -40)
+	viper.SetDefault("application_server.geolocation.rssi_fallback.min_gateways", 3)
 	viper.SetDefault("application_server.integration.mqtt.uplink_topic_template", "application/{{ .ApplicationID }}/device/{{ .DevEUI }}/rx")
 	viper.SetDefault("application_server.integration.mqtt.downlink_topic_template", "application/{{ .ApplicationID }}/device/{{ .DevEUI }}/tx")
 	viper.SetDefault("application_server.integration.mqtt.join_topic_template", "application/{{ .ApplicationID }}/device/{{ .DevEUI }}/join")
@@ -56,10 +104,35 @@ func init() {
 	viper.SetDefault("application_server.integration.mqtt.error_topic_template", "application/{{ .ApplicationID }}/device/{{ .DevEUI }}/error")
 	viper.SetDefault("application_server.integration.mqtt.status_topic_template", "application/{{ .ApplicationID }}/device/{{ .DevEUI }}/status")
 	viper.SetDefault("application_server.integration.mqtt.location_topic_template", "application/{{ .ApplicationID }}/device/{{ .DevEUI }}/location")
+	viper.SetDefault("application_server.integration.mqtt.admin_event_topic_template", "admin/{{ .Entity }}/{{ .ID }}/{{ .Action }}")
 	viper.SetDefault("application_server.integration.mqtt.clean_session", true)
+	viper.SetDefault("application_server.integration.mqtt.client.client_cert_lifetime", 365*24*time.Hour)
+	viper.SetDefault("application_server.integration.kafka.topic_template", "lora-app-server.{{ .EventType }}")
+	viper.SetDefault("application_server.integration.kafka.timeout", 10*time.Second)
+	viper.SetDefault("application_server.integration.amqp.exchange", "amq.topic")
+	viper.SetDefault("application_server.integration.amqp.routing_key_template", "application.{{ .ApplicationID }}.device.{{ .DevEUI }}.{{ .EventType }}")
+	viper.SetDefault("application_server.integration.amqp.timeout", 10*time.Second)
+	viper.SetDefault("application_server.integration.amqp.reconnect_interval", 5*time.Second)
+	viper.SetDefault("application_server.integration.delivery.workers", 16)
+	viper.SetDefault("application_server.integration.delivery.queue_size", 100)
+	viper.SetDefault("application_server.integration.delivery.retry_interval", 30*time.Second)
+	viper.SetDefault("application_server.integration.delivery.max_retry_interval", time.Hour)
+	viper.SetDefault("application_server.integration.delivery.max_spool_size", 10000)
+	viper.SetDefault("application_server.integration.delivery.back_pressure.queue_threshold", 0.9)
+	viper.SetDefault("application_server.integration.delivery.back_pressure.retry_after", 10*time.Second)
+	viper.SetDefault("application_server.integration.delivery.dead_letter.max_entries", 1000)
+	viper.SetDefault("application_server.integration.delivery.dead_letter.ttl", 7*24*time.Hour)
+	viper.SetDefault("application_server.integration.delivery_log.max_entries", 100)
+	viper.SetDefault("application_server.integration.delivery_log.ttl", 7*24*time.Hour)
 
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(configCmd)
+	rootCmd.AddCommand(rewrapKeysCmd)
+	rootCmd.AddCommand(simulateCmd)
+	rootCmd.AddCommand(benchmarkCmd)
+	rootCmd.AddCommand(tailCmd)
+	rootCmd.AddCommand(migrateCmd)
+	rootCmd.AddCommand(checkCmd)
 }
 
 // Execute executes the root command.
@@ -6,7 +6,6 @@ import (
 	"crypto/x509"
 	"fmt"
 	"io/ioutil"
-	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -22,7 +21,6 @@ import (
 	"github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/pkg/errors"
-	migrate "github.com/rubenv/sql-migrate"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/tmc/grpc-websocket-proxy/wsproxy"
@@ -31,17 +29,42 @@ import (
 
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/api"
+	"github.com/brocaar/lora-app-server/internal/api/accesslog"
 	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/api/compression"
+	"github.com/brocaar/lora-app-server/internal/api/cors"
+	"github.com/brocaar/lora-app-server/internal/api/etag"
+	"github.com/brocaar/lora-app-server/internal/api/graphql"
+	"github.com/brocaar/lora-app-server/internal/api/grpcweb"
+	"github.com/brocaar/lora-app-server/internal/api/jsonfields"
+	"github.com/brocaar/lora-app-server/internal/certexpiry"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/correlation"
+	"github.com/brocaar/lora-app-server/internal/dbmigrate"
 	"github.com/brocaar/lora-app-server/internal/downlink"
+	"github.com/brocaar/lora-app-server/internal/eventschema"
+	"github.com/brocaar/lora-app-server/internal/geolocation"
+	"github.com/brocaar/lora-app-server/internal/geolocation/httpresolver"
+	"github.com/brocaar/lora-app-server/internal/geolocation/loracloud"
 	"github.com/brocaar/lora-app-server/internal/gwping"
+	"github.com/brocaar/lora-app-server/internal/gwstats"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/handler/amqphandler"
+	"github.com/brocaar/lora-app-server/internal/handler/kafkahandler"
 	"github.com/brocaar/lora-app-server/internal/handler/mqtthandler"
 	"github.com/brocaar/lora-app-server/internal/handler/multihandler"
-	"github.com/brocaar/lora-app-server/internal/migrations"
+	"github.com/brocaar/lora-app-server/internal/handler/pluginhandler"
+	"github.com/brocaar/lora-app-server/internal/netutil"
 	"github.com/brocaar/lora-app-server/internal/nsclient"
+	"github.com/brocaar/lora-app-server/internal/oauth2"
+	"github.com/brocaar/lora-app-server/internal/readonly"
+	"github.com/brocaar/lora-app-server/internal/retention"
+	"github.com/brocaar/lora-app-server/internal/scim"
+	"github.com/brocaar/lora-app-server/internal/securityevent"
 	"github.com/brocaar/lora-app-server/internal/static"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/loraserver/api/as"
+	"github.com/brocaar/loraserver/api/common"
 )
 
 func run(cmd *cobra.Command, args []string) error {
@@ -52,17 +75,24 @@ func run(cmd *cobra.Command, args []string) error {
 	tasks := []func() error{
 		setLogLevel,
 		printStartMessage,
+		setAddressFamily,
 		setPostgreSQLConnection,
 		setRedisPool,
 		setHandler,
 		setNetworkServerClient,
+		setGeolocationBackend,
+		setSecurityEventExporter,
 		runDatabaseMigrations,
 		setJWTSecret,
 		setHashIterations,
 		setDisableAssignExistingUsers,
+		setSessionKeyPolicy,
 		handleDataDownPayloads,
 		startApplicationServerAPI,
 		startGatewayPing,
+		startRetentionPrune,
+		startCertificateExpiryCheck,
+		startGatewayStatsConsumer,
 		startJoinServerAPI,
 		startClientAPI(ctx),
 	}
@@ -80,6 +110,10 @@ func run(cmd *cobra.Command, args []string) error {
 	go func() {
 		log.Warning("stopping lora-app-server")
 		// todo: handle graceful shutdown?
+		// the queued integration events are delivered before exiting
+		if err := config.C.ApplicationServer.Integration.Handler.Close(); err != nil {
+			log.WithError(err).Error("close integration handler error")
+		}
 		exitChan <- struct{}{}
 	}()
 	select {
@@ -104,12 +138,33 @@ func printStartMessage() error {
 	return nil
 }
 
+func setAddressFamily() error {
+	if err := netutil.SetAddressFamily(config.C.General.AddressFamily); err != nil {
+		return err
+	}
+
+	// the integrations and geolocation backends use the default transport
+	http.DefaultTransport.(*http.Transport).DialContext = netutil.DialContext
+
+	return nil
+}
+
 func setPostgreSQLConnection() error {
 	log.Info("connecting to postgresql")
 	db, err := storage.OpenDatabase(config.C.PostgreSQL.DSN)
 	if err != nil {
 		return errors.Wrap(err, "database connection error")
 	}
+
+	if conf := config.C.FaultInjection.Storage; conf.Enabled {
+		log.WithFields(log.Fields{
+			"latency":      conf.Latency,
+			"jitter":       conf.Jitter,
+			"failure_rate": conf.FailureRate,
+		}).Warning("fault-injection enabled for storage calls")
+		db.FaultInjector = conf.Inject
+	}
+
 	config.C.PostgreSQL.DB = db
 	return nil
 }
@@ -122,33 +177,178 @@ func setRedisPool() error {
 }
 
 func setHandler() error {
+	if config.C.ApplicationServer.Integration.Delivery.AtLeastOnce {
+		conf := config.C.ApplicationServer.Integration.MQTT
+		if conf.QOS == 0 || conf.CleanSession || conf.ClientID == "" {
+			return errors.New("at-least-once delivery requires mqtt qos 1 or 2, clean_session=false and a client_id")
+		}
+	}
+
 	h, err := mqtthandler.NewHandler(
 		config.C.Redis.Pool,
 		config.C.ApplicationServer.Integration.MQTT,
+		mqttTopicTemplateStore{},
 	)
 	if err != nil {
 		return errors.Wrap(err, "setup mqtt handler error")
 	}
-	config.C.ApplicationServer.Integration.Handler = multihandler.NewHandler(h)
+
+	var integrations []handler.IntegrationHandler
+	if conf := config.C.ApplicationServer.Integration.Kafka; len(conf.Brokers) != 0 {
+		k, err := kafkahandler.NewHandler(conf)
+		if err != nil {
+			return errors.Wrap(err, "setup kafka handler error")
+		}
+		integrations = append(integrations, k)
+	}
+
+	if conf := config.C.ApplicationServer.Integration.AMQP; conf.URL != "" {
+		a, err := amqphandler.NewHandler(conf)
+		if err != nil {
+			return errors.Wrap(err, "setup amqp handler error")
+		}
+		integrations = append(integrations, a)
+	}
+
+	for _, conf := range config.C.ApplicationServer.Integration.Plugins {
+		p, err := pluginhandler.NewHandler(conf)
+		if err != nil {
+			return errors.Wrapf(err, "setup plugin %s error", conf.Name)
+		}
+		integrations = append(integrations, p)
+	}
+
+	config.C.ApplicationServer.Integration.Handler = multihandler.NewHandler(h, integrations...)
+
+	if conf := config.C.FaultInjection.Integration; conf.Enabled {
+		log.WithFields(log.Fields{
+			"latency":      conf.Latency,
+			"jitter":       conf.Jitter,
+			"failure_rate": conf.FailureRate,
+		}).Warning("fault-injection enabled for integrations")
+	}
+
 	return nil
 }
 
+// mqttTopicTemplateStore provides the application specific MQTT topic
+// templates to the MQTT handler.
+type mqttTopicTemplateStore struct{}
+
+func (mqttTopicTemplateStore) GetTopicTemplates(applicationID int64) (mqtthandler.TopicTemplates, error) {
+	app, err := storage.GetApplication(config.C.PostgreSQL.DB, applicationID, false)
+	if err != nil {
+		if errors.Cause(err) == storage.ErrDoesNotExist {
+			return mqtthandler.TopicTemplates{}, nil
+		}
+		return mqtthandler.TopicTemplates{}, err
+	}
+
+	t := app.MQTTTopicTemplates
+	return mqtthandler.TopicTemplates{
+		Uplink:   t.Uplink,
+		Downlink: t.Downlink,
+		Join:     t.Join,
+		Ack:      t.Ack,
+		Error:    t.Error,
+		Status:   t.Status,
+		Location: t.Location,
+	}, nil
+}
+
+func (mqttTopicTemplateStore) GetDownlinkTopicTemplates() (map[int64]string, error) {
+	return storage.GetApplicationMQTTDownlinkTopicTemplates(config.C.PostgreSQL.DB)
+}
+
 func setNetworkServerClient() error {
-	config.C.NetworkServer.Pool = nsclient.NewPool()
+	if conf := config.C.NetworkServer.Mock; conf.Enabled {
+		region, ok := common.Region_value[conf.Region]
+		if !ok {
+			return fmt.Errorf("unknown mock network-server region: %s", conf.Region)
+		}
+
+		log.WithField("region", conf.Region).Warning("using mock network-server, do not use this in production")
+		config.C.NetworkServer.Pool = nsclient.NewMockPool(common.Region(region))
+		return nil
+	}
+
+	config.C.NetworkServer.Pool = nsclient.NewPool(config.C.NetworkServer.Client)
+	return nil
+}
+
+func setGeolocationBackend() error {
+	conf := config.C.ApplicationServer.Geolocation
+
+	switch conf.Backend {
+	case "":
+		return nil
+	case "lora_cloud", "collos":
+		uri := conf.URI
+		if uri == "" && conf.Backend == "lora_cloud" {
+			uri = "https://gls.loracloud.com/api/v2"
+		}
+		gnssURI := conf.GNSSURI
+		if gnssURI == "" && conf.Backend == "lora_cloud" {
+			gnssURI = "https://gls.loracloud.com/api/v3/solve/gnss_lr1110_singleframe"
+		}
+		geolocation.SetBackend(loracloud.NewBackend(loracloud.Config{
+			URI:            uri,
+			GNSSURI:        gnssURI,
+			Token:          conf.Token,
+			RequestTimeout: conf.RequestTimeout,
+		}))
+	case "http":
+		headers := make(map[string]string)
+		if conf.Token != "" {
+			headers["Authorization"] = "Bearer " + conf.Token
+		}
+		geolocation.SetBackend(httpresolver.NewBackend(httpresolver.Config{
+			URL:            conf.URI,
+			Headers:        headers,
+			RequestTimeout: conf.RequestTimeout,
+		}))
+	default:
+		return fmt.Errorf("unknown geolocation backend: %s", conf.Backend)
+	}
+
+	log.WithField("backend", conf.Backend).Info("geolocation backend configured")
+
+	return nil
+}
+
+func setSecurityEventExporter() error {
+	conf := config.C.ApplicationServer.SecurityEvents.Syslog
+	if !conf.Enabled {
+		return nil
+	}
+
+	exp, err := securityevent.NewSyslogExporter(conf.Network, conf.Address, conf.Tag, conf.Format, version)
+	if err != nil {
+		return errors.Wrap(err, "setup security event syslog exporter error")
+	}
+	securityevent.SetExporter(exp)
+
+	log.WithFields(log.Fields{
+		"network": conf.Network,
+		"address": conf.Address,
+		"format":  conf.Format,
+	}).Info("security event syslog exporter configured")
+
 	return nil
 }
 
 func runDatabaseMigrations() error {
 	if config.C.PostgreSQL.Automigrate {
-		log.Info("applying database migrations")
-		m := &migrate.AssetMigrationSource{
-			Asset:    migrations.Asset,
-			AssetDir: migrations.AssetDir,
-			Dir:      "",
-		}
-		n, err := migrate.Exec(config.C.PostgreSQL.DB.DB.DB, "postgres", m, migrate.Up)
+		log.WithField("contract", config.C.PostgreSQL.AutomigrateContract).Info("applying database migrations")
+		n, err := dbmigrate.Up(config.C.PostgreSQL.DB.DB.DB, config.C.PostgreSQL.AutomigrateContract, 0)
 		if err != nil {
-			return errors.Wrap(err, "applying migrations error")
+			if err == dbmigrate.ErrUnknownMigrations {
+				// the database has been migrated by a newer version, e.g.
+				// during a rolling upgrade
+				log.WithError(err).Warning("skipping database migrations")
+				return nil
+			}
+			return err
 		}
 		log.WithField("count", n).Info("migrations applied")
 	}
@@ -171,6 +371,20 @@ func setDisableAssignExistingUsers() error {
 	return nil
 }
 
+func setSessionKeyPolicy() error {
+	switch p := config.C.ApplicationServer.ExternalAPI.SessionKeyPolicy; p {
+	case auth.SessionKeyPolicyViewKeys, auth.SessionKeyPolicyOrgAdmin, auth.SessionKeyPolicyAdmin, auth.SessionKeyPolicyNever:
+		auth.SessionKeyPolicy = p
+	case "":
+		auth.SessionKeyPolicy = auth.SessionKeyPolicyViewKeys
+	default:
+		return fmt.Errorf("invalid session_key_policy: %s", p)
+	}
+
+	log.WithField("policy", auth.SessionKeyPolicy).Info("session-key policy configured")
+	return nil
+}
+
 func handleDataDownPayloads() error {
 	go downlink.HandleDataDownPayloads()
 	return nil
@@ -184,7 +398,11 @@ func startApplicationServerAPI() error {
 		"tls-key":  config.C.ApplicationServer.API.TLSKey,
 	}).Info("starting application-server api")
 	apiServer := mustGetAPIServer()
-	ln, err := net.Listen("tcp", config.C.ApplicationServer.API.Bind)
+	socketMode, err := netutil.ParseSocketMode(config.C.ApplicationServer.API.SocketMode)
+	if err != nil {
+		return err
+	}
+	ln, err := netutil.Listen(config.C.ApplicationServer.API.Bind, socketMode)
 	if err != nil {
 		log.Fatalf("start application-server api listener error: %s", err)
 	}
@@ -198,6 +416,34 @@ func startGatewayPing() error {
 	return nil
 }
 
+func startRetentionPrune() error {
+	if config.C.ApplicationServer.Retention.PruneInterval == 0 {
+		return nil
+	}
+
+	go retention.PruneLoop()
+
+	return nil
+}
+
+func startCertificateExpiryCheck() error {
+	if config.C.ApplicationServer.CertificateExpiry.CheckInterval == 0 {
+		return nil
+	}
+
+	go certexpiry.CheckLoop()
+
+	return nil
+}
+
+func startGatewayStatsConsumer() error {
+	if err := gwstats.Setup(); err != nil {
+		return errors.Wrap(err, "setup gateway stats consumer error")
+	}
+
+	return nil
+}
+
 func startJoinServerAPI() error {
 	log.WithFields(log.Fields{
 		"bind":     config.C.JoinServer.Bind,
@@ -208,12 +454,16 @@ func startJoinServerAPI() error {
 
 	server := http.Server{
 		Handler: api.NewJoinServerAPI(),
-		Addr:    config.C.JoinServer.Bind,
+	}
+
+	ln, err := netutil.Listen(config.C.JoinServer.Bind, 0)
+	if err != nil {
+		return errors.Wrap(err, "start join-server api listener error")
 	}
 
 	if config.C.JoinServer.CACert == "" || config.C.JoinServer.TLSCert == "" || config.C.JoinServer.TLSKey == "" {
 		go func() {
-			err := server.ListenAndServe()
+			err := server.Serve(ln)
 			log.WithError(err).Error("join-server api error")
 		}()
 		return nil
@@ -235,7 +485,7 @@ func startJoinServerAPI() error {
 	}
 
 	go func() {
-		err := server.ListenAndServeTLS(config.C.JoinServer.TLSCert, config.C.JoinServer.TLSKey)
+		err := server.ServeTLS(ln, config.C.JoinServer.TLSCert, config.C.JoinServer.TLSKey)
 		log.WithError(err).Error("join-server api error")
 	}()
 
@@ -257,28 +507,57 @@ func startClientAPI(ctx context.Context) func() error {
 			return errors.Wrap(err, "application-server id to uuid error")
 		}
 
-		clientAPIHandler := grpc.NewServer(gRPCLoggingServerOptions()...)
-		pb.RegisterApplicationServiceServer(clientAPIHandler, api.NewApplicationAPI(validator))
+		applicationAPI := api.NewApplicationAPI(validator)
+		deviceAPI := api.NewDeviceAPI(validator)
+		gatewayAPI := api.NewGatewayAPI(validator)
+		organizationAPI := api.NewOrganizationAPI(validator)
+
+		var unaryInterceptors []grpc.UnaryServerInterceptor
+		var streamInterceptors []grpc.StreamServerInterceptor
+
+		// the access log comes first, so that rejected calls are logged too
+		if config.C.ApplicationServer.ExternalAPI.AccessLog.Enabled {
+			accessLogger, err := accesslog.NewLogger(config.C.ApplicationServer.ExternalAPI.AccessLog, validator)
+			if err != nil {
+				return errors.Wrap(err, "new access logger error")
+			}
+			unaryInterceptors = append(unaryInterceptors, accessLogger.UnaryServerInterceptor())
+			streamInterceptors = append(streamInterceptors, accessLogger.StreamServerInterceptor())
+		}
+
+		unaryInterceptors = append(unaryInterceptors, readonly.UnaryServerInterceptor(config.C.Redis.Pool))
+		streamInterceptors = append(streamInterceptors, readonly.StreamServerInterceptor(config.C.Redis.Pool))
+
+		clientAPIHandler := grpc.NewServer(gRPCLoggingServerOptions(unaryInterceptors, streamInterceptors)...)
+		pb.RegisterApplicationServiceServer(clientAPIHandler, applicationAPI)
 		pb.RegisterDeviceQueueServiceServer(clientAPIHandler, api.NewDeviceQueueAPI(validator))
-		pb.RegisterDeviceServiceServer(clientAPIHandler, api.NewDeviceAPI(validator))
+		pb.RegisterDeviceServiceServer(clientAPIHandler, deviceAPI)
 		pb.RegisterUserServiceServer(clientAPIHandler, api.NewUserAPI(validator))
 		pb.RegisterInternalServiceServer(clientAPIHandler, api.NewInternalUserAPI(validator))
-		pb.RegisterGatewayServiceServer(clientAPIHandler, api.NewGatewayAPI(validator))
+		pb.RegisterGatewayServiceServer(clientAPIHandler, gatewayAPI)
 		pb.RegisterGatewayProfileServiceServer(clientAPIHandler, api.NewGatewayProfileAPI(validator))
-		pb.RegisterOrganizationServiceServer(clientAPIHandler, api.NewOrganizationAPI(validator))
+		pb.RegisterOrganizationServiceServer(clientAPIHandler, organizationAPI)
 		pb.RegisterNetworkServerServiceServer(clientAPIHandler, api.NewNetworkServerAPI(validator))
 		pb.RegisterServiceProfileServiceServer(clientAPIHandler, api.NewServiceProfileServiceAPI(validator))
 		pb.RegisterDeviceProfileServiceServer(clientAPIHandler, api.NewDeviceProfileServiceAPI(validator))
 		pb.RegisterMulticastGroupServiceServer(clientAPIHandler, api.NewMulticastGroupAPI(validator, config.C.PostgreSQL.DB, rpID, config.C.NetworkServer.Pool))
+		pb.RegisterRegistrationServiceServer(clientAPIHandler, api.NewRegistrationAPI(validator))
+		pb.RegisterEventServiceServer(clientAPIHandler, api.NewEventAPI(validator))
 
 		// setup the client http interface variable
 		// we need to start the gRPC service first, as it is used by the
 		// grpc-gateway
 		var clientHTTPHandler http.Handler
 
-		// switch between gRPC and "plain" http handler
+		// gRPC-Web requests are translated into gRPC requests, so that the
+		// gRPC API can be used by browser applications
+		grpcWebHandler := grpcweb.NewHandler(clientAPIHandler)
+
+		// switch between gRPC, gRPC-Web and "plain" http handler
 		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if r.ProtoMajor == 2 && strings.Contains(r.Header.Get("Content-Type"), "application/grpc") {
+			if grpcweb.IsGRPCWebRequest(r) {
+				grpcWebHandler.ServeHTTP(w, r)
+			} else if r.ProtoMajor == 2 && strings.Contains(r.Header.Get("Content-Type"), "application/grpc") {
 				clientAPIHandler.ServeHTTP(w, r)
 			} else {
 				if clientHTTPHandler == nil {
@@ -299,14 +578,25 @@ func startClientAPI(ctx context.Context) func() error {
 				"tls-cert": config.C.ApplicationServer.ExternalAPI.TLSCert,
 				"tls-key":  config.C.ApplicationServer.ExternalAPI.TLSKey,
 			}).Info("starting client api server")
-			log.Fatal(http.ListenAndServeTLS(config.C.ApplicationServer.ExternalAPI.Bind, config.C.ApplicationServer.ExternalAPI.TLSCert, config.C.ApplicationServer.ExternalAPI.TLSKey, handler))
+			socketMode, err := netutil.ParseSocketMode(config.C.ApplicationServer.ExternalAPI.SocketMode)
+			if err != nil {
+				log.Fatal(err)
+			}
+			ln, err := netutil.Listen(config.C.ApplicationServer.ExternalAPI.Bind, socketMode)
+			if err != nil {
+				log.Fatalf("start client api listener error: %s", err)
+			}
+			log.Fatal(http.ServeTLS(ln, cors.NewHandler(config.C.ApplicationServer.ExternalAPI.CORS, handler), config.C.ApplicationServer.ExternalAPI.TLSCert, config.C.ApplicationServer.ExternalAPI.TLSKey))
 		}()
 
 		// give the http server some time to start
 		time.Sleep(time.Millisecond * 100)
 
 		// setup the HTTP handler
-		clientHTTPHandler, err = getHTTPHandler(ctx)
+		// the graphql api uses the same api services as the grpc and rest api
+		graphqlHandler := graphql.NewHandler(graphql.NewSchema(organizationAPI, applicationAPI, deviceAPI, gatewayAPI), validator)
+
+		clientHTTPHandler, err = getHTTPHandler(ctx, graphqlHandler)
 		if err != nil {
 			return err
 		}
@@ -315,26 +605,37 @@ func startClientAPI(ctx context.Context) func() error {
 	}
 }
 
-func gRPCLoggingServerOptions() []grpc.ServerOption {
+// gRPCLoggingServerOptions returns the gRPC server options for logging the
+// requests. The given unary and stream interceptors are chained after the
+// logging interceptors.
+func gRPCLoggingServerOptions(unary []grpc.UnaryServerInterceptor, stream []grpc.StreamServerInterceptor) []grpc.ServerOption {
 	logrusEntry := log.NewEntry(log.StandardLogger())
 	logrusOpts := []grpc_logrus.Option{
 		grpc_logrus.WithLevels(grpc_logrus.DefaultCodeToLevel),
 	}
 
+	unaryChain := []grpc.UnaryServerInterceptor{
+		grpc_ctxtags.UnaryServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
+		correlation.UnaryServerInterceptor(),
+		grpc_logrus.UnaryServerInterceptor(logrusEntry, logrusOpts...),
+	}
+	unaryChain = append(unaryChain, unary...)
+
+	streamChain := []grpc.StreamServerInterceptor{
+		grpc_ctxtags.StreamServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
+		correlation.StreamServerInterceptor(),
+		grpc_logrus.StreamServerInterceptor(logrusEntry, logrusOpts...),
+	}
+	streamChain = append(streamChain, stream...)
+
 	return []grpc.ServerOption{
-		grpc_middleware.WithUnaryServerChain(
-			grpc_ctxtags.UnaryServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
-			grpc_logrus.UnaryServerInterceptor(logrusEntry, logrusOpts...),
-		),
-		grpc_middleware.WithStreamServerChain(
-			grpc_ctxtags.StreamServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
-			grpc_logrus.StreamServerInterceptor(logrusEntry, logrusOpts...),
-		),
+		grpc_middleware.WithUnaryServerChain(unaryChain...),
+		grpc_middleware.WithStreamServerChain(streamChain...),
 	}
 }
 
 func mustGetAPIServer() *grpc.Server {
-	opts := gRPCLoggingServerOptions()
+	opts := gRPCLoggingServerOptions(nil, nil)
 	if config.C.ApplicationServer.API.CACert != "" && config.C.ApplicationServer.API.TLSCert != "" && config.C.ApplicationServer.API.TLSKey != "" {
 		creds := mustGetTransportCredentials(config.C.ApplicationServer.API.TLSCert, config.C.ApplicationServer.API.TLSKey, config.C.ApplicationServer.API.CACert, true)
 		opts = append(opts, grpc.Creds(creds))
@@ -345,7 +646,7 @@ func mustGetAPIServer() *grpc.Server {
 	return gs
 }
 
-func getHTTPHandler(ctx context.Context) (http.Handler, error) {
+func getHTTPHandler(ctx context.Context, graphqlHandler http.Handler) (http.Handler, error) {
 	r := mux.NewRouter()
 
 	// setup json api handler
@@ -354,7 +655,7 @@ func getHTTPHandler(ctx context.Context) (http.Handler, error) {
 		return nil, err
 	}
 
-	log.WithField("path", "/api").Info("registering rest api handler and documentation endpoint")
+	log.WithField("path", "/api").Info("registering rest api handler and documentation endpoints")
 	r.HandleFunc("/api", func(w http.ResponseWriter, r *http.Request) {
 		data, err := static.Asset("swagger/index.html")
 		if err != nil {
@@ -364,7 +665,29 @@ func getHTTPHandler(ctx context.Context) (http.Handler, error) {
 		}
 		w.Write(data)
 	}).Methods("get")
-	r.PathPrefix("/api").Handler(jsonHandler)
+	r.HandleFunc("/api/openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		data, err := static.Asset("swagger/api.openapi.json")
+		if err != nil {
+			log.Errorf("get openapi document error: %s", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	}).Methods("get")
+	log.WithField("path", "/api/schemas").Info("registering event schema endpoints")
+	r.PathPrefix("/api/schemas").Handler(eventschema.NewHandler("/api/schemas", version))
+	log.WithField("path", "/api/oauth2/token").Info("registering oauth2 token endpoint")
+	r.Path("/api/oauth2/token").Handler(oauth2.NewTokenHandler(config.C.ApplicationServer.ExternalAPI.ServiceAccountTokenTTL))
+	log.WithField("path", "/api/oauth2/introspect").Info("registering oauth2 token introspection endpoint")
+	r.Path("/api/oauth2/introspect").Handler(oauth2.NewIntrospectionHandler())
+	r.PathPrefix("/api").Handler(jsonfields.NewHandler(etag.NewHandler(jsonHandler)))
+
+	// setup scim provisioning api
+	if config.C.ApplicationServer.SCIM.BearerToken != "" {
+		log.WithField("path", "/scim/v2").Info("registering scim provisioning api handler")
+		r.PathPrefix("/scim/v2").Handler(scim.NewHandler("/scim/v2", config.C.ApplicationServer.SCIM.BearerToken))
+	}
 
 	// setup static file server
 	r.PathPrefix("/").Handler(http.FileServer(&assetfs.AssetFS{
@@ -374,7 +697,14 @@ func getHTTPHandler(ctx context.Context) (http.Handler, error) {
 		Prefix:    "",
 	}))
 
-	return wsproxy.WebsocketProxy(r), nil
+	// the graphql handler is registered outside the websocket proxy, as it
+	// implements its own websocket protocol for subscriptions
+	log.WithField("path", "/api/graphql").Info("registering graphql api handler")
+	root := mux.NewRouter()
+	root.Path("/api/graphql").Handler(graphqlHandler)
+	root.PathPrefix("/").Handler(wsproxy.WebsocketProxy(r))
+
+	return compression.NewHandler(config.C.ApplicationServer.ExternalAPI.Compression, root)
 }
 
 func getJSONGateway(ctx context.Context) (http.Handler, error) {
@@ -394,19 +724,23 @@ func getJSONGateway(ctx context.Context) (http.Handler, error) {
 		RootCAs:            cp,
 	}))}
 
-	bindParts := strings.SplitN(config.C.ApplicationServer.ExternalAPI.Bind, ":", 2)
-	if len(bindParts) != 2 {
-		log.Fatal("get port from bind failed")
+	apiEndpoint, err := netutil.LoopbackAddress(config.C.ApplicationServer.ExternalAPI.Bind)
+	if err != nil {
+		return nil, errors.Wrap(err, "get external api endpoint error")
 	}
-	apiEndpoint := fmt.Sprintf("localhost:%s", bindParts[1])
+	grpcDialOpts = append(grpcDialOpts, netutil.WithDialer())
 
-	mux := runtime.NewServeMux(runtime.WithMarshalerOption(
-		runtime.MIMEWildcard,
-		&runtime.JSONPb{
-			EnumsAsInts:  false,
-			EmitDefaults: true,
-		},
-	))
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(
+			runtime.MIMEWildcard,
+			&runtime.JSONPb{
+				EnumsAsInts:  false,
+				EmitDefaults: true,
+			},
+		),
+		runtime.WithIncomingHeaderMatcher(correlation.IncomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(correlation.OutgoingHeaderMatcher),
+	)
 
 	if err := pb.RegisterApplicationServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
 		return nil, errors.Wrap(err, "register application handler error")
@@ -444,6 +778,12 @@ func getJSONGateway(ctx context.Context) (http.Handler, error) {
 	if err := pb.RegisterMulticastGroupServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
 		return nil, errors.Wrap(err, "register multicast-group handler error")
 	}
+	if err := pb.RegisterRegistrationServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register registration handler error")
+	}
+	if err := pb.RegisterEventServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register event handler error")
+	}
 
 	return mux, nil
 }
@@ -0,0 +1,221 @@
+package cmd
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/brocaar/lora-app-server/internal/api"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/join"
+	"github.com/brocaar/lora-app-server/internal/simulator"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+var simulateOpts struct {
+	applicationID   int64
+	deviceProfileID string
+	deviceCount     int
+	interval        time.Duration
+	uplinkCount     int
+	fPort           int
+	payload         string
+	payloadSize     int
+	netID           string
+	keepDevices     bool
+}
+
+var simulateCmd = &cobra.Command{
+	Use:   "simulate",
+	Short: "Simulate devices sending OTAA joins and periodic uplinks",
+	Long: `Simulate devices sending OTAA joins and periodic uplinks.
+	The simulated devices are created under the given application and
+	device-profile. Their frames are handled by a mock network-server, which
+	forwards the join-requests to the join-server and the uplinks to the
+	application-server, so that payload codecs and integrations can be tested
+	without hardware. Unless --keep-devices is set, the simulated devices
+	are deleted when the simulation ends.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		tasks := []func() error{
+			setLogLevel,
+			setPostgreSQLConnection,
+			setRedisPool,
+			setHandler,
+			setNetworkServerClient,
+			setGeolocationBackend,
+			runDatabaseMigrations,
+			simulate,
+		}
+
+		for _, t := range tasks {
+			if err := t(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	simulateCmd.Flags().Int64Var(&simulateOpts.applicationID, "application-id", 0, "application id of the simulated devices")
+	simulateCmd.Flags().StringVar(&simulateOpts.deviceProfileID, "device-profile-id", "", "device-profile id of the simulated devices (must support OTAA)")
+	simulateCmd.Flags().IntVar(&simulateOpts.deviceCount, "devices", 1, "number of devices to simulate")
+	simulateCmd.Flags().DurationVar(&simulateOpts.interval, "interval", time.Minute, "uplink interval")
+	simulateCmd.Flags().IntVar(&simulateOpts.uplinkCount, "uplinks", 0, "number of uplinks per device (0 = until interrupted)")
+	simulateCmd.Flags().IntVar(&simulateOpts.fPort, "f-port", 1, "fPort of the uplinks")
+	simulateCmd.Flags().StringVar(&simulateOpts.payload, "payload", "", "HEX encoded uplink payload (random when not set)")
+	simulateCmd.Flags().IntVar(&simulateOpts.payloadSize, "payload-size", 8, "size of the random uplink payload")
+	simulateCmd.Flags().StringVar(&simulateOpts.netID, "net-id", "000000", "NetID of the mock network-server")
+	simulateCmd.Flags().BoolVar(&simulateOpts.keepDevices, "keep-devices", false, "do not delete the simulated devices when the simulation ends")
+}
+
+func simulate() error {
+	if simulateOpts.deviceCount < 1 {
+		return errors.New("at least one device must be simulated")
+	}
+
+	if simulateOpts.fPort < 1 || simulateOpts.fPort > 223 {
+		return errors.New("f-port must be between 1 and 223")
+	}
+
+	dpID, err := uuid.FromString(simulateOpts.deviceProfileID)
+	if err != nil {
+		return errors.Wrap(err, "invalid device-profile id")
+	}
+
+	var netID lorawan.NetID
+	if err := netID.UnmarshalText([]byte(simulateOpts.netID)); err != nil {
+		return errors.Wrap(err, "invalid net-id")
+	}
+
+	var payload []byte
+	if simulateOpts.payload != "" {
+		payload, err = hex.DecodeString(simulateOpts.payload)
+		if err != nil {
+			return errors.Wrap(err, "invalid payload")
+		}
+	}
+
+	if _, err := storage.GetApplication(config.C.PostgreSQL.DB, simulateOpts.applicationID, false); err != nil {
+		return errors.Wrap(err, "get application error")
+	}
+
+	dp, err := storage.GetDeviceProfile(config.C.PostgreSQL.DB, dpID)
+	if err != nil {
+		return errors.Wrap(err, "get device-profile error")
+	}
+	if !dp.DeviceProfile.SupportsJoin {
+		return errors.New("the device-profile must support OTAA")
+	}
+
+	devices, err := createSimulatedDevices(dpID, dp.DeviceProfile.MacVersion)
+	if err != nil {
+		return err
+	}
+
+	if !simulateOpts.keepDevices {
+		defer deleteSimulatedDevices(devices)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		log.WithField("signal", <-sigChan).Info("signal received, stopping simulation")
+		cancel()
+	}()
+
+	ns := simulator.NetworkServer{
+		NetID:             netID,
+		Frequency:         868100000,
+		DR:                5,
+		JoinServer:        simulator.JoinServerFunc(join.HandleJoinRequest),
+		ApplicationServer: api.NewApplicationServerAPI(),
+	}
+
+	log.WithFields(log.Fields{
+		"application_id": simulateOpts.applicationID,
+		"devices":        len(devices),
+		"interval":       simulateOpts.interval,
+	}).Info("starting simulation")
+
+	return simulator.Run(ctx, &ns, devices, simulator.Config{
+		Interval:    simulateOpts.interval,
+		UplinkCount: simulateOpts.uplinkCount,
+		FPort:       uint8(simulateOpts.fPort),
+		Payload:     payload,
+		PayloadSize: simulateOpts.payloadSize,
+	})
+}
+
+// createSimulatedDevices creates the devices (and their keys) to simulate,
+// using random DevEUIs and keys.
+func createSimulatedDevices(dpID uuid.UUID, macVersion string) ([]*simulator.Device, error) {
+	var devices []*simulator.Device
+
+	for i := 0; i < simulateOpts.deviceCount; i++ {
+		d := simulator.Device{
+			MACVersion: macVersion,
+		}
+
+		for _, b := range [][]byte{d.DevEUI[:], d.NwkKey[:], d.AppKey[:]} {
+			if _, err := rand.Read(b); err != nil {
+				return devices, errors.Wrap(err, "read random bytes error")
+			}
+		}
+
+		err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+			if err := storage.CreateDevice(tx, &storage.Device{
+				DevEUI:          d.DevEUI,
+				ApplicationID:   simulateOpts.applicationID,
+				DeviceProfileID: dpID,
+				Name:            fmt.Sprintf("simulator-%s", d.DevEUI),
+				Description:     "Simulated device",
+			}); err != nil {
+				return errors.Wrap(err, "create device error")
+			}
+
+			if err := storage.CreateDeviceKeys(tx, &storage.DeviceKeys{
+				DevEUI: d.DevEUI,
+				NwkKey: d.NwkKey,
+				AppKey: d.AppKey,
+			}); err != nil {
+				return errors.Wrap(err, "create device-keys error")
+			}
+
+			return nil
+		})
+		if err != nil {
+			return devices, err
+		}
+
+		devices = append(devices, &d)
+	}
+
+	return devices, nil
+}
+
+func deleteSimulatedDevices(devices []*simulator.Device) {
+	for _, d := range devices {
+		if err := storage.DeleteDevice(config.C.PostgreSQL.DB, d.DevEUI); err != nil {
+			log.WithError(err).WithField("dev_eui", d.DevEUI).Error("delete simulated device error")
+		}
+	}
+
+	log.WithField("devices", len(devices)).Info("simulated devices deleted")
+}
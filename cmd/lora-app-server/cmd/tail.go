@@ -0,0 +1,176 @@
+package cmd
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/tail"
+)
+
+var tailOpts struct {
+	server             string
+	caCert             string
+	insecureSkipVerify bool
+	username           string
+	password           string
+	token              string
+	devEUIs            []string
+	applicationID      int64
+	maxDevices         int
+	types              []string
+	filters            []string
+	output             string
+}
+
+var tailCmd = &cobra.Command{
+	Use:   "tail",
+	Short: "Tail the events of a device or application",
+	Long: `Tail the events of a device or application.
+	This connects to the external API of a running LoRa App Server instance
+	and prints the events (uplinks, joins, ACKs, errors, ...) of the given
+	device(s) or of the devices of the given application. Authentication is
+	done using the given API token (or the LORA_APP_SERVER_TOKEN environment
+	variable) or using the given username and password.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		tasks := []func() error{
+			setLogLevel,
+			runTail,
+		}
+
+		for _, t := range tasks {
+			if err := t(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	tailCmd.Flags().StringVar(&tailOpts.server, "server", "localhost:8080", "hostname:port of the external API")
+	tailCmd.Flags().StringVar(&tailOpts.caCert, "ca-cert", "", "ca certificate to verify the external API certificate (optional, system CAs are used when not set)")
+	tailCmd.Flags().BoolVar(&tailOpts.insecureSkipVerify, "insecure-skip-verify", false, "do not verify the external API certificate")
+	tailCmd.Flags().StringVar(&tailOpts.username, "username", "", "username used for authentication")
+	tailCmd.Flags().StringVar(&tailOpts.password, "password", "", "password used for authentication")
+	tailCmd.Flags().StringVar(&tailOpts.token, "token", os.Getenv("LORA_APP_SERVER_TOKEN"), "API token used for authentication")
+	tailCmd.Flags().StringSliceVar(&tailOpts.devEUIs, "dev-eui", nil, "DevEUI of the device to tail (can be repeated)")
+	tailCmd.Flags().Int64Var(&tailOpts.applicationID, "application-id", 0, "tail the devices of the given application")
+	tailCmd.Flags().IntVar(&tailOpts.maxDevices, "max-devices", 100, "max. number of devices to tail for an application")
+	tailCmd.Flags().StringSliceVar(&tailOpts.types, "type", nil, "event type to include, e.g. uplink, join, ack, error, status, location (can be repeated)")
+	tailCmd.Flags().StringSliceVar(&tailOpts.filters, "filter", nil, "payload field filter in the format key=value, e.g. fPort=10 (can be repeated)")
+	tailCmd.Flags().StringVar(&tailOpts.output, "output", tail.PrettyOutput, "output format (pretty or json)")
+}
+
+func runTail() error {
+	if len(tailOpts.devEUIs) == 0 && tailOpts.applicationID == 0 {
+		return errors.New("dev-eui or application-id must be set")
+	}
+
+	if tailOpts.output != tail.PrettyOutput && tailOpts.output != tail.JSONOutput {
+		return errors.New("output must be pretty or json")
+	}
+
+	fields, err := tail.ParseFieldFilters(tailOpts.filters)
+	if err != nil {
+		return err
+	}
+	filter := tail.Filter{
+		Types:  tailOpts.types,
+		Fields: fields,
+	}
+
+	tlsConfig := tls.Config{
+		InsecureSkipVerify: tailOpts.insecureSkipVerify,
+	}
+	if tailOpts.caCert != "" {
+		b, err := ioutil.ReadFile(tailOpts.caCert)
+		if err != nil {
+			return errors.Wrap(err, "read ca certificate error")
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(b) {
+			return errors.New("append ca certificate error")
+		}
+	}
+
+	dialCtx, dialCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer dialCancel()
+	conn, err := grpc.DialContext(dialCtx, tailOpts.server,
+		grpc.WithBlock(),
+		grpc.WithTransportCredentials(credentials.NewTLS(&tlsConfig)),
+	)
+	if err != nil {
+		return errors.Wrap(err, "dial external api error")
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigChan
+		cancel()
+	}()
+
+	token := tailOpts.token
+	if token == "" {
+		if tailOpts.username == "" {
+			return errors.New("token or username and password must be set")
+		}
+
+		resp, err := pb.NewInternalServiceClient(conn).Login(ctx, &pb.LoginRequest{
+			Username: tailOpts.username,
+			Password: tailOpts.password,
+		})
+		if err != nil {
+			return errors.Wrap(err, "login error")
+		}
+		token = resp.Jwt
+	}
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+	deviceClient := pb.NewDeviceServiceClient(conn)
+
+	devEUIs := tailOpts.devEUIs
+	if tailOpts.applicationID != 0 {
+		appDevEUIs, err := tail.GetApplicationDevEUIs(ctx, deviceClient, tailOpts.applicationID, tailOpts.maxDevices)
+		if err != nil {
+			return err
+		}
+		devEUIs = append(devEUIs, appDevEUIs...)
+	}
+
+	if len(devEUIs) == 0 {
+		return errors.New("no devices to tail")
+	}
+
+	log.WithField("devices", len(devEUIs)).Info("tailing device events")
+
+	events := make(chan tail.Event)
+	go tail.Stream(ctx, deviceClient, devEUIs, filter, events)
+
+	for e := range events {
+		if err := tail.Write(os.Stdout, tailOpts.output, e); err != nil {
+			return errors.Wrap(err, "write event error")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,260 @@
+// Package accesslog implements the structured access logging of the
+// external API. Each API call is logged with the method, the user, the
+// remote address, the status code and the duration. Optionally the request
+// and response messages are logged, in which case the keys, passwords,
+// tokens, e-mail addresses and payload data are redacted, so that the access
+// log can be retained (e.g. for compliance) without exposing sensitive data.
+package accesslog
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/brocaar/lora-app-server/internal/correlation"
+)
+
+// Redacted defines the value replacing the redacted fields.
+const Redacted = "[redacted]"
+
+// redactedSuffixes contains the (lower-case) suffixes of the field names
+// which are redacted, e.g. appKey, nwkSEncKey, kek, password, jwtSecret,
+// jwtToken and email. Fields containing an embedded JSON document (e.g.
+// dataJSON and payloadJSON) are redacted as a whole, as these could contain
+// any of the above.
+var redactedSuffixes = []string{"key", "keys", "kek", "password", "secret", "token", "jwt", "email", "json"}
+
+// redactedFields contains the (lower-case) names of the payload fields
+// which are redacted.
+var redactedFields = map[string]bool{
+	"data":       true,
+	"object":     true,
+	"jsonobject": true,
+	"payload":    true,
+	"phypayload": true,
+	"frmpayload": true,
+}
+
+// Config defines the access-log configuration.
+type Config struct {
+	// Enabled enables the access log.
+	Enabled bool `mapstructure:"enabled"`
+
+	// File defines the file to which the access log is appended. When not
+	// set, the access log is written to the application log.
+	File string `mapstructure:"file"`
+
+	// Services contains the endpoint groups (gRPC services, e.g.
+	// DeviceService) to log. When empty, all services are logged.
+	Services []string `mapstructure:"services"`
+
+	// MessageServices contains the endpoint groups for which the (redacted)
+	// request and response messages are logged. Use * for all services.
+	MessageServices []string `mapstructure:"message_services"`
+}
+
+// UserGetter defines the interface for retrieving the user of the API
+// request (implemented by the auth.Validator).
+type UserGetter interface {
+	GetUsername(ctx context.Context) (string, error)
+}
+
+// Logger implements the access logger.
+type Logger struct {
+	log             *log.Logger
+	users           UserGetter
+	services        map[string]bool
+	messageServices map[string]bool
+}
+
+// NewLogger creates a new Logger given the configuration. The given
+// UserGetter is used to log the user of each API call.
+func NewLogger(conf Config, users UserGetter) (*Logger, error) {
+	l := Logger{
+		log:             log.StandardLogger(),
+		users:           users,
+		services:        make(map[string]bool),
+		messageServices: make(map[string]bool),
+	}
+
+	if conf.File != "" {
+		f, err := os.OpenFile(conf.File, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0640)
+		if err != nil {
+			return nil, errors.Wrap(err, "open access-log file error")
+		}
+
+		l.log = log.New()
+		l.log.Out = f
+		l.log.Formatter = &log.JSONFormatter{TimestampFormat: time.RFC3339Nano}
+	}
+
+	for _, s := range conf.Services {
+		l.services[s] = true
+	}
+	for _, s := range conf.MessageServices {
+		l.messageServices[s] = true
+	}
+
+	return &l, nil
+}
+
+// UnaryServerInterceptor returns the interceptor logging the unary API
+// calls.
+func (l *Logger) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		service, method := splitMethod(info.FullMethod)
+		if !l.logService(service) {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		fields := l.fields(ctx, service, method, start, err)
+		if l.messageServices["*"] || l.messageServices[service] {
+			fields["request"] = Redact(req)
+			if err == nil {
+				fields["response"] = Redact(resp)
+			}
+		}
+		l.log.WithFields(fields).Info("api access")
+
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns the interceptor logging the streaming
+// API calls. The streamed messages are not logged.
+func (l *Logger) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		service, method := splitMethod(info.FullMethod)
+		if !l.logService(service) {
+			return handler(srv, stream)
+		}
+
+		start := time.Now()
+		err := handler(srv, stream)
+
+		l.log.WithFields(l.fields(stream.Context(), service, method, start, err)).Info("api access")
+
+		return err
+	}
+}
+
+func (l *Logger) logService(service string) bool {
+	return len(l.services) == 0 || l.services[service]
+}
+
+// fields returns the log fields of the given API call.
+func (l *Logger) fields(ctx context.Context, service, method string, start time.Time, err error) log.Fields {
+	fields := log.Fields{
+		"grpc.service": service,
+		"grpc.method":  method,
+		"grpc.code":    grpc.Code(err).String(),
+		"grpc.time_ms": float32(time.Since(start).Nanoseconds()/1000) / 1000,
+	}
+
+	if id := correlation.FromContext(ctx); id != "" {
+		fields[correlation.LogField] = id
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		fields["peer.address"] = p.Addr.String()
+	}
+
+	if l.users != nil {
+		if username, err := l.users.GetUsername(ctx); err == nil {
+			fields["user"] = username
+		}
+	}
+
+	return fields
+}
+
+// Redact returns the JSON representation (as map) of the given message,
+// with the sensitive fields replaced by Redacted. It returns nil when the
+// message can not be represented.
+func Redact(msg interface{}) interface{} {
+	pb, ok := msg.(proto.Message)
+	if !ok || pb == nil {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, pb); err != nil {
+		return nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(buf.Bytes(), &v); err != nil {
+		return nil
+	}
+
+	return redact(v)
+}
+
+func redact(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		// the value of a key / value pair (e.g. a HTTP integration header)
+		// could contain a secret
+		_, hasKey := v["key"]
+
+		for k, val := range v {
+			if hasKey && k == "value" {
+				v[k] = Redacted
+				continue
+			}
+			if _, isBool := val.(bool); !isBool && isRedacted(k) {
+				v[k] = Redacted
+				continue
+			}
+			v[k] = redact(val)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = redact(v[i])
+		}
+	}
+	return v
+}
+
+// isRedacted returns true when the field with the given name must be
+// redacted.
+func isRedacted(field string) bool {
+	field = strings.ToLower(field)
+
+	if redactedFields[field] {
+		return true
+	}
+
+	for _, suffix := range redactedSuffixes {
+		if strings.HasSuffix(field, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// splitMethod returns the service (without package) and method name of the
+// given (full) gRPC method name, e.g. /api.DeviceService/Get returns
+// DeviceService and Get.
+func splitMethod(fullMethod string) (string, string) {
+	service := path.Dir(fullMethod)
+	if i := strings.LastIndex(service, "."); i != -1 {
+		service = service[i+1:]
+	}
+	return strings.TrimPrefix(service, "/"), path.Base(fullMethod)
+}
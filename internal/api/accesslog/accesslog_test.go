@@ -0,0 +1,231 @@
+package accesslog
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+)
+
+type testUserGetter struct{}
+
+func (testUserGetter) GetUsername(ctx context.Context) (string, error) {
+	return "admin", nil
+}
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Message  interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Name:    "password",
+			Message: &pb.LoginRequest{Username: "admin", Password: "secret"},
+			Expected: map[string]interface{}{
+				"username": "admin",
+				"password": Redacted,
+			},
+		},
+		{
+			Name: "nested keys",
+			Message: &pb.GetDeviceKeysResponse{
+				DeviceKeys: &pb.DeviceKeys{DevEui: "0102030405060708", NwkKey: "01020304050607080102030405060708"},
+			},
+			Expected: map[string]interface{}{
+				"deviceKeys": Redacted,
+			},
+		},
+		{
+			Name: "payload",
+			Message: &pb.EnqueueDeviceQueueItemRequest{
+				DeviceQueueItem: &pb.DeviceQueueItem{DevEui: "0102030405060708", Confirmed: true, FPort: 10, Data: []byte{1, 2, 3}},
+			},
+			Expected: map[string]interface{}{
+				"deviceQueueItem": map[string]interface{}{
+					"devEUI":    "0102030405060708",
+					"confirmed": true,
+					"fPort":     float64(10),
+					"data":      Redacted,
+				},
+			},
+		},
+		{
+			Name:    "kek",
+			Message: &pb.NetworkServer{Name: "ns", KekLabel: "label", Kek: "01020304050607080102030405060708"},
+			Expected: map[string]interface{}{
+				"name":     "ns",
+				"kekLabel": "label",
+				"kek":      Redacted,
+			},
+		},
+		{
+			Name:    "frm payload",
+			Message: &pb.DecryptedDeviceUplink{DevAddr: "01020304", FrmPayload: "010203"},
+			Expected: map[string]interface{}{
+				"devAddr":    "01020304",
+				"frmPayload": Redacted,
+			},
+		},
+		{
+			Name:    "embedded json",
+			Message: &pb.ExportUserDataResponse{DataJson: `{"email":"admin@example.com"}`},
+			Expected: map[string]interface{}{
+				"dataJSON": Redacted,
+			},
+		},
+		{
+			Name:    "email",
+			Message: &pb.User{Username: "admin", Email: "admin@example.com"},
+			Expected: map[string]interface{}{
+				"username": "admin",
+				"email":    Redacted,
+			},
+		},
+		{
+			Name: "key / value pair",
+			Message: &pb.HTTPIntegration{
+				Headers: []*pb.HTTPIntegrationHeader{
+					{Key: "Authorization", Value: "Bearer token"},
+				},
+			},
+			Expected: map[string]interface{}{
+				"headers": []interface{}{
+					map[string]interface{}{
+						"key":   Redacted,
+						"value": Redacted,
+					},
+				},
+			},
+		},
+		{
+			Name:    "not a proto message",
+			Message: "test",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			v := Redact(test.Message)
+			if test.Expected == nil {
+				require.Nil(t, v)
+				return
+			}
+			require.Equal(t, test.Expected, v)
+		})
+	}
+}
+
+func TestSplitMethod(t *testing.T) {
+	service, method := splitMethod("/api.DeviceService/Get")
+	require.Equal(t, "DeviceService", service)
+	require.Equal(t, "Get", method)
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	var buf bytes.Buffer
+
+	newLogger := func(conf Config) *Logger {
+		l, err := NewLogger(conf, testUserGetter{})
+		require.NoError(t, err)
+		l.log = log.New()
+		l.log.Out = &buf
+		l.log.Formatter = &log.JSONFormatter{}
+		return l
+	}
+
+	call := func(l *Logger, fullMethod string) map[string]interface{} {
+		buf.Reset()
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, grpc.Errorf(codes.PermissionDenied, "denied")
+		}
+
+		_, err := l.UnaryServerInterceptor()(context.Background(), &pb.LoginRequest{Username: "admin", Password: "secret"}, &grpc.UnaryServerInfo{FullMethod: fullMethod}, handler)
+		require.Error(t, err)
+
+		if buf.Len() == 0 {
+			return nil
+		}
+
+		var out map[string]interface{}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+		return out
+	}
+
+	t.Run("All services", func(t *testing.T) {
+		out := call(newLogger(Config{}), "/api.InternalService/Login")
+		require.NotNil(t, out)
+		require.Equal(t, "InternalService", out["grpc.service"])
+		require.Equal(t, "Login", out["grpc.method"])
+		require.Equal(t, "PermissionDenied", out["grpc.code"])
+		require.Equal(t, "admin", out["user"])
+		require.Nil(t, out["request"])
+	})
+
+	t.Run("Filtered services", func(t *testing.T) {
+		l := newLogger(Config{Services: []string{"DeviceService"}})
+		require.Nil(t, call(l, "/api.InternalService/Login"))
+		require.NotNil(t, call(l, "/api.DeviceService/Get"))
+	})
+
+	t.Run("Messages", func(t *testing.T) {
+		out := call(newLogger(Config{MessageServices: []string{"*"}}), "/api.InternalService/Login")
+		require.Equal(t, map[string]interface{}{
+			"username": "admin",
+			"password": Redacted,
+		}, out["request"])
+		require.Nil(t, out["response"])
+	})
+
+	t.Run("Export responses", func(t *testing.T) {
+		l := newLogger(Config{MessageServices: []string{"*"}})
+
+		tests := []struct {
+			Name       string
+			FullMethod string
+			Response   interface{}
+			Secrets    []string
+		}{
+			{
+				Name:       "device data",
+				FullMethod: "/api.DeviceService/ExportData",
+				Response: &pb.ExportDeviceDataResponse{
+					DataJson: `{"deviceKeys":{"nwkKey":"01020304050607080102030405060708"},"deviceActivation":{"appSKey":"08070605040302010807060504030201"}}`,
+				},
+				Secrets: []string{"01020304050607080102030405060708", "08070605040302010807060504030201"},
+			},
+			{
+				Name:       "user data",
+				FullMethod: "/api.UserService/ExportData",
+				Response: &pb.ExportUserDataResponse{
+					DataJson: `{"user":{"username":"admin","email":"admin@example.com"}}`,
+				},
+				Secrets: []string{"admin@example.com"},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.Name, func(t *testing.T) {
+				buf.Reset()
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					return test.Response, nil
+				}
+
+				_, err := l.UnaryServerInterceptor()(context.Background(), &pb.ExportDeviceDataRequest{DevEui: "0102030405060708"}, &grpc.UnaryServerInfo{FullMethod: test.FullMethod}, handler)
+				require.NoError(t, err)
+
+				require.Contains(t, buf.String(), Redacted)
+				for _, secret := range test.Secrets {
+					require.NotContains(t, buf.String(), secret)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,40 @@
+package api
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/correlation"
+	"github.com/brocaar/lora-app-server/internal/handler"
+)
+
+// sendAdminEvent sends the given admin-plane event to the integrations.
+// This happens asynchronously, after the change has been made, so that a
+// slow or failing integration does not affect the API request. Errors are
+// therefore logged and not returned.
+func sendAdminEvent(ctx context.Context, validator auth.Validator, pl handler.AdminEvent) {
+	h := config.C.ApplicationServer.Integration.Handler
+	if h == nil {
+		return
+	}
+
+	if username, err := validator.GetUsername(ctx); err == nil {
+		pl.Username = username
+	}
+	pl.Time = time.Now()
+	pl.CorrelationID = correlation.FromContext(ctx)
+
+	go func() {
+		if err := h.SendAdminEvent(pl); err != nil {
+			correlation.Log(ctx).WithFields(log.Fields{
+				"entity": pl.Entity,
+				"action": pl.Action,
+				"id":     pl.ID,
+			}).WithError(err).Error("send admin event error")
+		}
+	}()
+}
@@ -1,10 +1,12 @@
 package api
 
 import (
-	"encoding/json"
-	"strings"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 
 	"github.com/jmoiron/sqlx"
@@ -16,10 +18,15 @@ import (
 	"github.com/brocaar/lora-app-server/internal/api/auth"
 	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/deadletter"
+	"github.com/brocaar/lora-app-server/internal/deliverylog"
 	"github.com/brocaar/lora-app-server/internal/handler"
-	"github.com/brocaar/lora-app-server/internal/handler/httphandler"
-	"github.com/brocaar/lora-app-server/internal/handler/influxdbhandler"
+	"github.com/brocaar/lora-app-server/internal/handler/mqtthandler"
+	"github.com/brocaar/lora-app-server/internal/quarantine"
+	"github.com/brocaar/lora-app-server/internal/securityevent"
 	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/uplinkfilter"
+	"github.com/brocaar/lorawan"
 )
 
 // ApplicationAPI exports the Application related functions.
@@ -51,6 +58,10 @@ func (a *ApplicationAPI) Create(ctx context.Context, req *pb.CreateApplicationRe
 		return nil, errToRPCError(err)
 	}
 
+	if err := uplinkfilter.Validate(req.Application.UplinkFilterScript); err != nil {
+		return nil, errToRPCError(err)
+	}
+
 	app := storage.Application{
 		Name:                 req.Application.Name,
 		Description:          req.Application.Description,
@@ -59,12 +70,28 @@ func (a *ApplicationAPI) Create(ctx context.Context, req *pb.CreateApplicationRe
 		PayloadCodec:         codec.Type(req.Application.PayloadCodec),
 		PayloadEncoderScript: req.Application.PayloadEncoderScript,
 		PayloadDecoderScript: req.Application.PayloadDecoderScript,
+
+		GeolocationBufferFrames: int(req.Application.GeolocationBufferFrames),
+		GeolocationMinInterval:  int(req.Application.GeolocationMinInterval),
+
+		DisableOrganizationIntegrations: req.Application.DisableOrganizationIntegrations,
+		FieldMappings:                   fieldMappingsFromPB(req.Application.FieldMappings),
+		UplinkFilterScript:              req.Application.UplinkFilterScript,
+		MQTTTopicTemplates:              mqttTopicTemplatesFromPB(req.Application.MqttTopicTemplates),
 	}
 
 	if err := storage.CreateApplication(config.C.PostgreSQL.DB, &app); err != nil {
 		return nil, errToRPCError(err)
 	}
 
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:         handler.ApplicationEntity,
+		Action:         handler.CreateAction,
+		ID:             strconv.FormatInt(app.ID, 10),
+		OrganizationID: app.OrganizationID,
+		ApplicationID:  app.ID,
+	})
+
 	return &pb.CreateApplicationResponse{
 		Id: app.ID,
 	}, nil
@@ -78,21 +105,19 @@ func (a *ApplicationAPI) Get(ctx context.Context, req *pb.GetApplicationRequest)
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	app, err := storage.GetApplication(config.C.PostgreSQL.DB, req.Id)
+	app, err := storage.GetApplication(config.C.PostgreSQL.DB, req.Id, false)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	droppedCount, err := uplinkfilter.GetDroppedCount(config.C.Redis.Pool, app.ID)
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
+
 	resp := pb.GetApplicationResponse{
-		Application: &pb.Application{
-			Id:                   app.ID,
-			Name:                 app.Name,
-			Description:          app.Description,
-			OrganizationId:       app.OrganizationID,
-			ServiceProfileId:     app.ServiceProfileID.String(),
-			PayloadCodec:         string(app.PayloadCodec),
-			PayloadEncoderScript: app.PayloadEncoderScript,
-			PayloadDecoderScript: app.PayloadDecoderScript,
-		},
+		Application:        applicationToProto(app),
+		DroppedUplinkCount: droppedCount,
 	}
 
 	return &resp, nil
@@ -110,54 +135,231 @@ func (a *ApplicationAPI) Update(ctx context.Context, req *pb.UpdateApplicationRe
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	app, err := storage.GetApplication(config.C.PostgreSQL.DB, req.Application.Id)
+	var app storage.Application
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		var err error
+		app, err = storage.GetApplication(tx, req.Application.Id, true)
+		if err != nil {
+			return errToRPCError(err)
+		}
+
+		// in case of a partial update, merge the given fields into the
+		// current application
+		if req.UpdateMask != nil {
+			current := applicationToProto(app)
+			if err := applyFieldMask(current, req.Application, req.UpdateMask); err != nil {
+				return err
+			}
+			req.Application = current
+		}
+
+		spID, err := uuid.FromString(req.Application.ServiceProfileId)
+		if err != nil {
+			return errToRPCError(err)
+		}
+
+		if err := uplinkfilter.Validate(req.Application.UplinkFilterScript); err != nil {
+			return errToRPCError(err)
+		}
+
+		// update the fields
+		app.Name = req.Application.Name
+		app.Description = req.Application.Description
+		app.ServiceProfileID = spID
+		app.PayloadCodec = codec.Type(req.Application.PayloadCodec)
+		app.PayloadEncoderScript = req.Application.PayloadEncoderScript
+		app.PayloadDecoderScript = req.Application.PayloadDecoderScript
+		app.GeolocationBufferFrames = int(req.Application.GeolocationBufferFrames)
+		app.GeolocationMinInterval = int(req.Application.GeolocationMinInterval)
+		app.DisableOrganizationIntegrations = req.Application.DisableOrganizationIntegrations
+		app.FieldMappings = fieldMappingsFromPB(req.Application.FieldMappings)
+		app.UplinkFilterScript = req.Application.UplinkFilterScript
+		app.MQTTTopicTemplates = mqttTopicTemplatesFromPB(req.Application.MqttTopicTemplates)
+
+		if err := storage.UpdateApplication(tx, app); err != nil {
+			return errToRPCError(err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return nil, errToRPCError(err)
+		return nil, err
 	}
 
-	spID, err := uuid.FromString(req.Application.ServiceProfileId)
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:         handler.ApplicationEntity,
+		Action:         handler.UpdateAction,
+		ID:             strconv.FormatInt(app.ID, 10),
+		OrganizationID: app.OrganizationID,
+		ApplicationID:  app.ID,
+	})
+
+	return &empty.Empty{}, nil
+}
+
+// Delete deletes the given application.
+func (a *ApplicationAPI) Delete(ctx context.Context, req *pb.DeleteApplicationRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(req.Id, auth.Delete),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	var app storage.Application
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		var err error
+		app, err = storage.GetApplication(tx, req.Id, true)
+		if err != nil {
+			return errToRPCError(err)
+		}
+
+		err = storage.DeleteApplication(tx, req.Id)
+		if err != nil {
+			return errToRPCError(err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, errToRPCError(err)
+		return nil, err
+	}
+
+	// the event is sent after deletion, therefore the application
+	// integrations will not receive this event
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:         handler.ApplicationEntity,
+		Action:         handler.DeleteAction,
+		ID:             strconv.FormatInt(app.ID, 10),
+		OrganizationID: app.OrganizationID,
+	})
+
+	return &empty.Empty{}, nil
+}
+
+// Archive archives the given application.
+func (a *ApplicationAPI) Archive(ctx context.Context, req *pb.ArchiveApplicationRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(req.Id, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	return a.setArchived(ctx, req.Id, true)
+}
+
+// Unarchive unarchives the given application.
+func (a *ApplicationAPI) Unarchive(ctx context.Context, req *pb.UnarchiveApplicationRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(req.Id, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	// update the fields
-	app.Name = req.Application.Name
-	app.Description = req.Application.Description
-	app.ServiceProfileID = spID
-	app.PayloadCodec = codec.Type(req.Application.PayloadCodec)
-	app.PayloadEncoderScript = req.Application.PayloadEncoderScript
-	app.PayloadDecoderScript = req.Application.PayloadDecoderScript
+	return a.setArchived(ctx, req.Id, false)
+}
+
+func (a *ApplicationAPI) setArchived(ctx context.Context, id int64, archived bool) (*empty.Empty, error) {
+	var app storage.Application
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		var err error
+		app, err = storage.GetApplication(tx, id, true)
+		if err != nil {
+			return errToRPCError(err)
+		}
 
-	err = storage.UpdateApplication(config.C.PostgreSQL.DB, app)
+		if archived {
+			err = storage.ArchiveApplication(tx, id)
+		} else {
+			err = storage.UnarchiveApplication(tx, id)
+		}
+		if err != nil {
+			return errToRPCError(err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, errToRPCError(err)
+		return nil, err
 	}
 
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:         handler.ApplicationEntity,
+		Action:         handler.UpdateAction,
+		ID:             strconv.FormatInt(app.ID, 10),
+		OrganizationID: app.OrganizationID,
+		ApplicationID:  app.ID,
+	})
+
 	return &empty.Empty{}, nil
 }
 
-// Delete deletes the given application.
-func (a *ApplicationAPI) Delete(ctx context.Context, req *pb.DeleteApplicationRequest) (*empty.Empty, error) {
+// SetLegalHold places the given application under legal hold.
+func (a *ApplicationAPI) SetLegalHold(ctx context.Context, req *pb.SetApplicationLegalHoldRequest) (*empty.Empty, error) {
 	if err := a.validator.Validate(ctx,
-		auth.ValidateApplicationAccess(req.Id, auth.Delete),
+		auth.ValidateIsAdmin(),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	return a.setLegalHold(ctx, req.Id, true)
+}
+
+// ClearLegalHold clears the legal hold of the given application.
+func (a *ApplicationAPI) ClearLegalHold(ctx context.Context, req *pb.ClearApplicationLegalHoldRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateIsAdmin(),
 	); err != nil {
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	return a.setLegalHold(ctx, req.Id, false)
+}
+
+func (a *ApplicationAPI) setLegalHold(ctx context.Context, id int64, hold bool) (*empty.Empty, error) {
+	var app storage.Application
 	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
-		err := storage.DeleteApplication(tx, req.Id)
+		var err error
+		app, err = storage.GetApplication(tx, id, true)
 		if err != nil {
 			return errToRPCError(err)
 		}
+
+		if err := storage.SetApplicationLegalHold(tx, id, hold); err != nil {
+			return errToRPCError(err)
+		}
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	username, _ := a.validator.GetUsername(ctx)
+	securityevent.Log(storage.SecurityEvent{
+		Type:           securityevent.LegalHoldChange,
+		Username:       username,
+		RemoteAddr:     securityevent.RemoteAddr(ctx),
+		OrganizationID: &app.OrganizationID,
+		Description:    fmt.Sprintf("legal hold %s for application %d", legalHoldAction(hold), app.ID),
+	})
+
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:         handler.ApplicationEntity,
+		Action:         handler.UpdateAction,
+		ID:             strconv.FormatInt(app.ID, 10),
+		OrganizationID: app.OrganizationID,
+		ApplicationID:  app.ID,
+	})
+
 	return &empty.Empty{}, nil
 }
 
+// legalHoldAction returns the action, as included in the legal-hold change
+// security events.
+func legalHoldAction(hold bool) string {
+	if hold {
+		return "set"
+	}
+	return "cleared"
+}
+
 // List lists the available applications.
 func (a *ApplicationAPI) List(ctx context.Context, req *pb.ListApplicationRequest) (*pb.ListApplicationResponse, error) {
 	if err := a.validator.Validate(ctx,
@@ -176,45 +378,54 @@ func (a *ApplicationAPI) List(ctx context.Context, req *pb.ListApplicationReques
 		return nil, errToRPCError(err)
 	}
 
+	tag, err := listETag(isAdmin, username, req.OrganizationId, storage.GetApplicationListVersion,
+		req.Limit, req.Offset, req.Search, req.IncludeArchived)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	if notModified(ctx, tag) {
+		return &pb.ListApplicationResponse{}, nil
+	}
+
 	var count int
 	var apps []storage.ApplicationListItem
 
 	if req.OrganizationId == 0 {
 		if isAdmin {
-			apps, err = storage.GetApplications(config.C.PostgreSQL.DB, int(req.Limit), int(req.Offset), req.Search)
+			apps, err = storage.GetApplications(config.C.PostgreSQL.DB, int(req.Limit), int(req.Offset), req.Search, req.IncludeArchived)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
-			count, err = storage.GetApplicationCount(config.C.PostgreSQL.DB, req.Search)
+			count, err = storage.GetApplicationCount(config.C.PostgreSQL.DB, req.Search, req.IncludeArchived)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
 		} else {
-			apps, err = storage.GetApplicationsForUser(config.C.PostgreSQL.DB, username, 0, int(req.Limit), int(req.Offset), req.Search)
+			apps, err = storage.GetApplicationsForUser(config.C.PostgreSQL.DB, username, 0, int(req.Limit), int(req.Offset), req.Search, req.IncludeArchived)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
-			count, err = storage.GetApplicationCountForUser(config.C.PostgreSQL.DB, username, 0, req.Search)
+			count, err = storage.GetApplicationCountForUser(config.C.PostgreSQL.DB, username, 0, req.Search, req.IncludeArchived)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
 		}
 	} else {
 		if isAdmin {
-			apps, err = storage.GetApplicationsForOrganizationID(config.C.PostgreSQL.DB, req.OrganizationId, int(req.Limit), int(req.Offset), req.Search)
+			apps, err = storage.GetApplicationsForOrganizationID(config.C.PostgreSQL.DB, req.OrganizationId, int(req.Limit), int(req.Offset), req.Search, req.IncludeArchived)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
-			count, err = storage.GetApplicationCountForOrganizationID(config.C.PostgreSQL.DB, req.OrganizationId, req.Search)
+			count, err = storage.GetApplicationCountForOrganizationID(config.C.PostgreSQL.DB, req.OrganizationId, req.Search, req.IncludeArchived)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
 		} else {
-			apps, err = storage.GetApplicationsForUser(config.C.PostgreSQL.DB, username, req.OrganizationId, int(req.Limit), int(req.Offset), req.Search)
+			apps, err = storage.GetApplicationsForUser(config.C.PostgreSQL.DB, username, req.OrganizationId, int(req.Limit), int(req.Offset), req.Search, req.IncludeArchived)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
-			count, err = storage.GetApplicationCountForUser(config.C.PostgreSQL.DB, username, req.OrganizationId, req.Search)
+			count, err = storage.GetApplicationCountForUser(config.C.PostgreSQL.DB, username, req.OrganizationId, req.Search, req.IncludeArchived)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
@@ -232,6 +443,7 @@ func (a *ApplicationAPI) List(ctx context.Context, req *pb.ListApplicationReques
 			OrganizationId:     app.OrganizationID,
 			ServiceProfileId:   app.ServiceProfileID.String(),
 			ServiceProfileName: app.ServiceProfileName,
+			Archived:           app.IsArchived(),
 		}
 
 		resp.Result = append(resp.Result, &item)
@@ -245,6 +457,9 @@ func (a *ApplicationAPI) CreateHTTPIntegration(ctx context.Context, in *pb.Creat
 	if in.Integration == nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, "integration must not be nil")
 	}
+	if in.Integration.SecurityEventUrl != "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "security_event_url can only be set for an organization integration")
+	}
 
 	if err := a.validator.Validate(ctx,
 		auth.ValidateApplicationAccess(in.Integration.ApplicationId, auth.Update),
@@ -252,25 +467,7 @@ func (a *ApplicationAPI) CreateHTTPIntegration(ctx context.Context, in *pb.Creat
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	headers := make(map[string]string)
-	for _, h := range in.Integration.Headers {
-		headers[h.Key] = h.Value
-	}
-
-	conf := httphandler.HandlerConfig{
-		Headers:                 headers,
-		DataUpURL:               in.Integration.UplinkDataUrl,
-		JoinNotificationURL:     in.Integration.JoinNotificationUrl,
-		ACKNotificationURL:      in.Integration.AckNotificationUrl,
-		ErrorNotificationURL:    in.Integration.ErrorNotificationUrl,
-		StatusNotificationURL:   in.Integration.StatusNotificationUrl,
-		LocationNotificationURL: in.Integration.LocationNotificationUrl,
-	}
-	if err := conf.Validate(); err != nil {
-		return nil, errToRPCError(err)
-	}
-
-	confJSON, err := json.Marshal(conf)
+	settings, err := httpIntegrationSettings(in.Integration, nil)
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
@@ -278,12 +475,14 @@ func (a *ApplicationAPI) CreateHTTPIntegration(ctx context.Context, in *pb.Creat
 	integration := storage.Integration{
 		ApplicationID: in.Integration.ApplicationId,
 		Kind:          handler.HTTPHandlerKind,
-		Settings:      confJSON,
+		Settings:      settings,
 	}
 	if err = storage.CreateIntegration(config.C.PostgreSQL.DB, &integration); err != nil {
 		return nil, errToRPCError(err)
 	}
 
+	a.sendIntegrationEvent(ctx, integration, handler.CreateAction)
+
 	return &empty.Empty{}, nil
 }
 
@@ -300,31 +499,14 @@ func (a *ApplicationAPI) GetHTTPIntegration(ctx context.Context, in *pb.GetHTTPI
 		return nil, errToRPCError(err)
 	}
 
-	var conf httphandler.HandlerConfig
-	if err = json.Unmarshal(integration.Settings, &conf); err != nil {
+	out, err := httpIntegrationFromSettings(integration.Settings)
+	if err != nil {
 		return nil, errToRPCError(err)
 	}
-
-	var headers []*pb.HTTPIntegrationHeader
-	for k, v := range conf.Headers {
-		headers = append(headers, &pb.HTTPIntegrationHeader{
-			Key:   k,
-			Value: v,
-		})
-
-	}
+	out.ApplicationId = integration.ApplicationID
 
 	return &pb.GetHTTPIntegrationResponse{
-		Integration: &pb.HTTPIntegration{
-			ApplicationId:           integration.ApplicationID,
-			Headers:                 headers,
-			UplinkDataUrl:           conf.DataUpURL,
-			JoinNotificationUrl:     conf.JoinNotificationURL,
-			AckNotificationUrl:      conf.ACKNotificationURL,
-			ErrorNotificationUrl:    conf.ErrorNotificationURL,
-			StatusNotificationUrl:   conf.StatusNotificationURL,
-			LocationNotificationUrl: conf.LocationNotificationURL,
-		},
+		Integration: out,
 	}, nil
 }
 
@@ -333,6 +515,9 @@ func (a *ApplicationAPI) UpdateHTTPIntegration(ctx context.Context, in *pb.Updat
 	if in.Integration == nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, "integration must not be nil")
 	}
+	if in.Integration.SecurityEventUrl != "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "security_event_url can only be set for an organization integration")
+	}
 
 	if err := a.validator.Validate(ctx,
 		auth.ValidateApplicationAccess(in.Integration.ApplicationId, auth.Update),
@@ -345,34 +530,17 @@ func (a *ApplicationAPI) UpdateHTTPIntegration(ctx context.Context, in *pb.Updat
 		return nil, errToRPCError(err)
 	}
 
-	headers := make(map[string]string)
-	for _, h := range in.Integration.Headers {
-		headers[h.Key] = h.Value
-	}
-
-	conf := httphandler.HandlerConfig{
-		Headers:                 headers,
-		DataUpURL:               in.Integration.UplinkDataUrl,
-		JoinNotificationURL:     in.Integration.JoinNotificationUrl,
-		ACKNotificationURL:      in.Integration.AckNotificationUrl,
-		ErrorNotificationURL:    in.Integration.ErrorNotificationUrl,
-		StatusNotificationURL:   in.Integration.StatusNotificationUrl,
-		LocationNotificationURL: in.Integration.LocationNotificationUrl,
-	}
-	if err := conf.Validate(); err != nil {
-		return nil, errToRPCError(err)
-	}
-
-	confJSON, err := json.Marshal(conf)
+	integration.Settings, err = httpIntegrationSettings(in.Integration, integration.Settings)
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
-	integration.Settings = confJSON
 
 	if err = storage.UpdateIntegration(config.C.PostgreSQL.DB, &integration); err != nil {
 		return nil, errToRPCError(err)
 	}
 
+	a.sendIntegrationEvent(ctx, integration, handler.UpdateAction)
+
 	return &empty.Empty{}, nil
 }
 
@@ -393,6 +561,8 @@ func (a *ApplicationAPI) DeleteHTTPIntegration(ctx context.Context, in *pb.Delet
 		return nil, errToRPCError(err)
 	}
 
+	a.sendIntegrationEvent(ctx, integration, handler.DeleteAction)
+
 	return &empty.Empty{}, nil
 }
 
@@ -408,19 +578,7 @@ func (a *ApplicationAPI) CreateInfluxDBIntegration(ctx context.Context, in *pb.C
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	conf := influxdbhandler.HandlerConfig{
-		Endpoint:            in.Integration.Endpoint,
-		DB:                  in.Integration.Db,
-		Username:            in.Integration.Username,
-		Password:            in.Integration.Password,
-		RetentionPolicyName: in.Integration.RetentionPolicyName,
-		Precision:           strings.ToLower(in.Integration.Precision.String()),
-	}
-	if err := conf.Validate(); err != nil {
-		return nil, errToRPCError(err)
-	}
-
-	confJSON, err := json.Marshal(conf)
+	settings, err := influxDBIntegrationSettings(in.Integration)
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
@@ -428,12 +586,14 @@ func (a *ApplicationAPI) CreateInfluxDBIntegration(ctx context.Context, in *pb.C
 	integration := storage.Integration{
 		ApplicationID: in.Integration.ApplicationId,
 		Kind:          handler.InfluxDBHandlerKind,
-		Settings:      confJSON,
+		Settings:      settings,
 	}
 	if err := storage.CreateIntegration(config.C.PostgreSQL.DB, &integration); err != nil {
 		return nil, errToRPCError(err)
 	}
 
+	a.sendIntegrationEvent(ctx, integration, handler.CreateAction)
+
 	return &empty.Empty{}, nil
 }
 
@@ -450,23 +610,14 @@ func (a *ApplicationAPI) GetInfluxDBIntegration(ctx context.Context, in *pb.GetI
 		return nil, errToRPCError(err)
 	}
 
-	var conf influxdbhandler.HandlerConfig
-	if err = json.Unmarshal(integration.Settings, &conf); err != nil {
+	out, err := influxDBIntegrationFromSettings(integration.Settings)
+	if err != nil {
 		return nil, errToRPCError(err)
 	}
-
-	prec, _ := pb.InfluxDBPrecision_value[strings.ToUpper(conf.Precision)]
+	out.ApplicationId = in.ApplicationId
 
 	return &pb.GetInfluxDBIntegrationResponse{
-		Integration: &pb.InfluxDBIntegration{
-			ApplicationId:       in.ApplicationId,
-			Endpoint:            conf.Endpoint,
-			Db:                  conf.DB,
-			Username:            conf.Username,
-			Password:            conf.Password,
-			RetentionPolicyName: conf.RetentionPolicyName,
-			Precision:           pb.InfluxDBPrecision(prec),
-		},
+		Integration: out,
 	}, nil
 }
 
@@ -487,28 +638,17 @@ func (a *ApplicationAPI) UpdateInfluxDBIntegration(ctx context.Context, in *pb.U
 		return nil, errToRPCError(err)
 	}
 
-	conf := influxdbhandler.HandlerConfig{
-		Endpoint:            in.Integration.Endpoint,
-		DB:                  in.Integration.Db,
-		Username:            in.Integration.Username,
-		Password:            in.Integration.Password,
-		RetentionPolicyName: in.Integration.RetentionPolicyName,
-		Precision:           strings.ToLower(in.Integration.Precision.String()),
-	}
-	if err := conf.Validate(); err != nil {
-		return nil, errToRPCError(err)
-	}
-
-	confJSON, err := json.Marshal(conf)
+	integration.Settings, err = influxDBIntegrationSettings(in.Integration)
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
 
-	integration.Settings = confJSON
 	if err = storage.UpdateIntegration(config.C.PostgreSQL.DB, &integration); err != nil {
 		return nil, errToRPCError(err)
 	}
 
+	a.sendIntegrationEvent(ctx, integration, handler.UpdateAction)
+
 	return &empty.Empty{}, nil
 }
 
@@ -529,36 +669,986 @@ func (a *ApplicationAPI) DeleteInfluxDBIntegration(ctx context.Context, in *pb.D
 		return nil, errToRPCError(err)
 	}
 
+	a.sendIntegrationEvent(ctx, integration, handler.DeleteAction)
+
 	return &empty.Empty{}, nil
 }
 
-// ListIntegrations lists all configured integrations.
-func (a *ApplicationAPI) ListIntegrations(ctx context.Context, in *pb.ListIntegrationRequest) (*pb.ListIntegrationResponse, error) {
+// CreateAzureIntegration creates an Azure application-integration.
+func (a *ApplicationAPI) CreateAzureIntegration(ctx context.Context, in *pb.CreateAzureIntegrationRequest) (*empty.Empty, error) {
+	if in.Integration == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "integration must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.Integration.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	settings, err := azureIntegrationSettings(in.Integration)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	integration := storage.Integration{
+		ApplicationID: in.Integration.ApplicationId,
+		Kind:          handler.AzureHandlerKind,
+		Settings:      settings,
+	}
+	if err := storage.CreateIntegration(config.C.PostgreSQL.DB, &integration); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	a.sendIntegrationEvent(ctx, integration, handler.CreateAction)
+
+	return &empty.Empty{}, nil
+}
+
+// GetAzureIntegration returns the Azure application-integration.
+func (a *ApplicationAPI) GetAzureIntegration(ctx context.Context, in *pb.GetAzureIntegrationRequest) (*pb.GetAzureIntegrationResponse, error) {
 	if err := a.validator.Validate(ctx,
 		auth.ValidateApplicationAccess(in.ApplicationId, auth.Update),
 	); err != nil {
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	integrations, err := storage.GetIntegrationsForApplicationID(config.C.PostgreSQL.DB, in.ApplicationId)
+	integration, err := storage.GetIntegrationByApplicationID(config.C.PostgreSQL.DB, in.ApplicationId, handler.AzureHandlerKind)
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
 
-	out := pb.ListIntegrationResponse{
-		TotalCount: int64(len(integrations)),
+	out, err := azureIntegrationFromSettings(integration.Settings)
+	if err != nil {
+		return nil, errToRPCError(err)
 	}
+	out.ApplicationId = in.ApplicationId
 
-	for _, integration := range integrations {
-		switch integration.Kind {
-		case handler.HTTPHandlerKind:
-			out.Result = append(out.Result, &pb.IntegrationListItem{Kind: pb.IntegrationKind_HTTP})
-		case handler.InfluxDBHandlerKind:
-			out.Result = append(out.Result, &pb.IntegrationListItem{Kind: pb.IntegrationKind_INFLUXDB})
-		default:
-			return nil, grpc.Errorf(codes.Internal, "unknown integration kind: %s", integration.Kind)
-		}
+	return &pb.GetAzureIntegrationResponse{
+		Integration: out,
+	}, nil
+}
+
+// UpdateAzureIntegration updates the Azure application-integration.
+func (a *ApplicationAPI) UpdateAzureIntegration(ctx context.Context, in *pb.UpdateAzureIntegrationRequest) (*empty.Empty, error) {
+	if in.Integration == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "integration must not be nil")
 	}
 
-	return &out, nil
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.Integration.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetIntegrationByApplicationID(config.C.PostgreSQL.DB, in.Integration.ApplicationId, handler.AzureHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	integration.Settings, err = azureIntegrationSettings(in.Integration)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err = storage.UpdateIntegration(config.C.PostgreSQL.DB, &integration); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	a.sendIntegrationEvent(ctx, integration, handler.UpdateAction)
+
+	return &empty.Empty{}, nil
+}
+
+// DeleteAzureIntegration deletes the Azure application-integration.
+func (a *ApplicationAPI) DeleteAzureIntegration(ctx context.Context, in *pb.DeleteAzureIntegrationRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetIntegrationByApplicationID(config.C.PostgreSQL.DB, in.ApplicationId, handler.AzureHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err = storage.DeleteIntegration(config.C.PostgreSQL.DB, integration.ID); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	a.sendIntegrationEvent(ctx, integration, handler.DeleteAction)
+
+	return &empty.Empty{}, nil
+}
+
+// CreateThingsBoardIntegration creates a ThingsBoard application-integration.
+func (a *ApplicationAPI) CreateThingsBoardIntegration(ctx context.Context, in *pb.CreateThingsBoardIntegrationRequest) (*empty.Empty, error) {
+	if in.Integration == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "integration must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.Integration.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	settings, err := thingsBoardIntegrationSettings(in.Integration)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	integration := storage.Integration{
+		ApplicationID: in.Integration.ApplicationId,
+		Kind:          handler.ThingsBoardHandlerKind,
+		Settings:      settings,
+	}
+	if err := storage.CreateIntegration(config.C.PostgreSQL.DB, &integration); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	a.sendIntegrationEvent(ctx, integration, handler.CreateAction)
+
+	return &empty.Empty{}, nil
+}
+
+// GetThingsBoardIntegration returns the ThingsBoard application-integration.
+func (a *ApplicationAPI) GetThingsBoardIntegration(ctx context.Context, in *pb.GetThingsBoardIntegrationRequest) (*pb.GetThingsBoardIntegrationResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetIntegrationByApplicationID(config.C.PostgreSQL.DB, in.ApplicationId, handler.ThingsBoardHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	out, err := thingsBoardIntegrationFromSettings(integration.Settings)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	out.ApplicationId = in.ApplicationId
+
+	return &pb.GetThingsBoardIntegrationResponse{
+		Integration: out,
+	}, nil
+}
+
+// UpdateThingsBoardIntegration updates the ThingsBoard application-integration.
+func (a *ApplicationAPI) UpdateThingsBoardIntegration(ctx context.Context, in *pb.UpdateThingsBoardIntegrationRequest) (*empty.Empty, error) {
+	if in.Integration == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "integration must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.Integration.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetIntegrationByApplicationID(config.C.PostgreSQL.DB, in.Integration.ApplicationId, handler.ThingsBoardHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	integration.Settings, err = thingsBoardIntegrationSettings(in.Integration)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err = storage.UpdateIntegration(config.C.PostgreSQL.DB, &integration); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	a.sendIntegrationEvent(ctx, integration, handler.UpdateAction)
+
+	return &empty.Empty{}, nil
+}
+
+// DeleteThingsBoardIntegration deletes the ThingsBoard application-integration.
+func (a *ApplicationAPI) DeleteThingsBoardIntegration(ctx context.Context, in *pb.DeleteThingsBoardIntegrationRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetIntegrationByApplicationID(config.C.PostgreSQL.DB, in.ApplicationId, handler.ThingsBoardHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err = storage.DeleteIntegration(config.C.PostgreSQL.DB, integration.ID); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	a.sendIntegrationEvent(ctx, integration, handler.DeleteAction)
+
+	return &empty.Empty{}, nil
+}
+
+// CreateMyDevicesIntegration creates a myDevices application-integration.
+func (a *ApplicationAPI) CreateMyDevicesIntegration(ctx context.Context, in *pb.CreateMyDevicesIntegrationRequest) (*empty.Empty, error) {
+	if in.Integration == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "integration must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.Integration.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	settings, err := myDevicesIntegrationSettings(in.Integration)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	integration := storage.Integration{
+		ApplicationID: in.Integration.ApplicationId,
+		Kind:          handler.MyDevicesHandlerKind,
+		Settings:      settings,
+	}
+	if err := storage.CreateIntegration(config.C.PostgreSQL.DB, &integration); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	a.sendIntegrationEvent(ctx, integration, handler.CreateAction)
+
+	return &empty.Empty{}, nil
+}
+
+// GetMyDevicesIntegration returns the myDevices application-integration.
+func (a *ApplicationAPI) GetMyDevicesIntegration(ctx context.Context, in *pb.GetMyDevicesIntegrationRequest) (*pb.GetMyDevicesIntegrationResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetIntegrationByApplicationID(config.C.PostgreSQL.DB, in.ApplicationId, handler.MyDevicesHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	out, err := myDevicesIntegrationFromSettings(integration.Settings)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	out.ApplicationId = in.ApplicationId
+
+	return &pb.GetMyDevicesIntegrationResponse{
+		Integration: out,
+	}, nil
+}
+
+// UpdateMyDevicesIntegration updates the myDevices application-integration.
+func (a *ApplicationAPI) UpdateMyDevicesIntegration(ctx context.Context, in *pb.UpdateMyDevicesIntegrationRequest) (*empty.Empty, error) {
+	if in.Integration == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "integration must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.Integration.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetIntegrationByApplicationID(config.C.PostgreSQL.DB, in.Integration.ApplicationId, handler.MyDevicesHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	integration.Settings, err = myDevicesIntegrationSettings(in.Integration)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err = storage.UpdateIntegration(config.C.PostgreSQL.DB, &integration); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	a.sendIntegrationEvent(ctx, integration, handler.UpdateAction)
+
+	return &empty.Empty{}, nil
+}
+
+// DeleteMyDevicesIntegration deletes the myDevices application-integration.
+func (a *ApplicationAPI) DeleteMyDevicesIntegration(ctx context.Context, in *pb.DeleteMyDevicesIntegrationRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetIntegrationByApplicationID(config.C.PostgreSQL.DB, in.ApplicationId, handler.MyDevicesHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err = storage.DeleteIntegration(config.C.PostgreSQL.DB, integration.ID); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	a.sendIntegrationEvent(ctx, integration, handler.DeleteAction)
+
+	return &empty.Empty{}, nil
+}
+
+// CreateLoRaCloudIntegration creates a LoRa Cloud application-integration.
+func (a *ApplicationAPI) CreateLoRaCloudIntegration(ctx context.Context, in *pb.CreateLoRaCloudIntegrationRequest) (*empty.Empty, error) {
+	if in.Integration == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "integration must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.Integration.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	settings, err := loRaCloudIntegrationSettings(in.Integration)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	integration := storage.Integration{
+		ApplicationID: in.Integration.ApplicationId,
+		Kind:          handler.LoRaCloudHandlerKind,
+		Settings:      settings,
+	}
+	if err := storage.CreateIntegration(config.C.PostgreSQL.DB, &integration); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	a.sendIntegrationEvent(ctx, integration, handler.CreateAction)
+
+	return &empty.Empty{}, nil
+}
+
+// GetLoRaCloudIntegration returns the LoRa Cloud application-integration.
+func (a *ApplicationAPI) GetLoRaCloudIntegration(ctx context.Context, in *pb.GetLoRaCloudIntegrationRequest) (*pb.GetLoRaCloudIntegrationResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetIntegrationByApplicationID(config.C.PostgreSQL.DB, in.ApplicationId, handler.LoRaCloudHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	out, err := loRaCloudIntegrationFromSettings(integration.Settings)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	out.ApplicationId = in.ApplicationId
+
+	return &pb.GetLoRaCloudIntegrationResponse{
+		Integration: out,
+	}, nil
+}
+
+// UpdateLoRaCloudIntegration updates the LoRa Cloud application-integration.
+func (a *ApplicationAPI) UpdateLoRaCloudIntegration(ctx context.Context, in *pb.UpdateLoRaCloudIntegrationRequest) (*empty.Empty, error) {
+	if in.Integration == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "integration must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.Integration.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetIntegrationByApplicationID(config.C.PostgreSQL.DB, in.Integration.ApplicationId, handler.LoRaCloudHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	integration.Settings, err = loRaCloudIntegrationSettings(in.Integration)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err = storage.UpdateIntegration(config.C.PostgreSQL.DB, &integration); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	a.sendIntegrationEvent(ctx, integration, handler.UpdateAction)
+
+	return &empty.Empty{}, nil
+}
+
+// DeleteLoRaCloudIntegration deletes the LoRa Cloud application-integration.
+func (a *ApplicationAPI) DeleteLoRaCloudIntegration(ctx context.Context, in *pb.DeleteLoRaCloudIntegrationRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetIntegrationByApplicationID(config.C.PostgreSQL.DB, in.ApplicationId, handler.LoRaCloudHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err = storage.DeleteIntegration(config.C.PostgreSQL.DB, integration.ID); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	a.sendIntegrationEvent(ctx, integration, handler.DeleteAction)
+
+	return &empty.Empty{}, nil
+}
+
+// ListIntegrations lists all configured integrations, including the
+// integrations inherited from the organization.
+func (a *ApplicationAPI) ListIntegrations(ctx context.Context, in *pb.ListIntegrationRequest) (*pb.ListIntegrationResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(in.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integrations, err := storage.GetEffectiveIntegrationsForApplicationID(config.C.PostgreSQL.DB, in.ApplicationId)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	out := pb.ListIntegrationResponse{
+		TotalCount: int64(len(integrations)),
+	}
+
+	for _, integration := range integrations {
+		item, err := integrationListItem(integration.Kind, integration.Inherited)
+		if err != nil {
+			return nil, err
+		}
+		out.Result = append(out.Result, item)
+	}
+
+	return &out, nil
+}
+
+// GetUplinkStats returns the uplink statistics (payload size, FPort and
+// spreading-factor distribution) of the devices of the application.
+func (a *ApplicationAPI) GetUplinkStats(ctx context.Context, req *pb.GetApplicationUplinkStatsRequest) (*pb.GetApplicationUplinkStatsResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(req.ApplicationId, auth.Read),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	filter := storage.UplinkStatsFilter{
+		ApplicationID: req.ApplicationId,
+		End:           time.Now(),
+	}
+
+	if req.EndTimestamp != nil {
+		end, err := ptypes.Timestamp(req.EndTimestamp)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "end_timestamp: %s", err)
+		}
+		filter.End = end
+	}
+
+	filter.Start = filter.End.AddDate(0, 0, -7)
+	if req.StartTimestamp != nil {
+		start, err := ptypes.Timestamp(req.StartTimestamp)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "start_timestamp: %s", err)
+		}
+		filter.Start = start
+	}
+
+	loc, err := getLocation(req.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	filter.Start, filter.End = getDayRange(filter.Start, filter.End, loc)
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = 10
+	}
+
+	var resp pb.GetApplicationUplinkStatsResponse
+
+	for _, c := range []struct {
+		column string
+		out    *[]*pb.UplinkStatsCount
+	}{
+		{"payload_size", &resp.PayloadSizes},
+		{"f_port", &resp.FPorts},
+		{"spreading_factor", &resp.SpreadingFactors},
+	} {
+		counts, err := storage.GetUplinkStatsCounts(config.C.PostgreSQL.DB, filter, c.column)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		for _, count := range counts {
+			*c.out = append(*c.out, &pb.UplinkStatsCount{
+				Value:        int64(count.Value),
+				UplinkCount:  count.UplinkCount,
+				PayloadBytes: count.PayloadBytes,
+				Airtime:      count.Airtime,
+			})
+		}
+	}
+
+	// the totals are the same for each of the distributions
+	for _, count := range resp.FPorts {
+		resp.UplinkCount += count.UplinkCount
+		resp.PayloadBytes += count.PayloadBytes
+		resp.Airtime += count.Airtime
+	}
+
+	devices, err := storage.GetTopDevicesByAirtime(config.C.PostgreSQL.DB, filter, limit)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	for _, d := range devices {
+		row := pb.DeviceUplinkStats{
+			DevEui:             d.DevEUI.String(),
+			Name:               d.Name,
+			UplinkCount:        d.UplinkCount,
+			PayloadBytes:       d.PayloadBytes,
+			Airtime:            d.Airtime,
+			MaxSpreadingFactor: uint32(d.MaxSpreadingFactor),
+		}
+		if d.UplinkCount != 0 {
+			row.AvgPayloadSize = float64(d.PayloadBytes) / float64(d.UplinkCount)
+		}
+		resp.Devices = append(resp.Devices, &row)
+	}
+
+	return &resp, nil
+}
+
+// GetDeliveryReport returns the delivery-rate (received vs expected uplinks)
+// of the devices of the application.
+func (a *ApplicationAPI) GetDeliveryReport(ctx context.Context, req *pb.GetApplicationDeliveryReportRequest) (*pb.GetApplicationDeliveryReportResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(req.ApplicationId, auth.Read),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	filter := storage.DeliveryReportFilter{
+		ApplicationID: req.ApplicationId,
+		End:           time.Now(),
+		Timezone:      req.Timezone,
+	}
+
+	if req.EndTimestamp != nil {
+		end, err := ptypes.Timestamp(req.EndTimestamp)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "end_timestamp: %s", err)
+		}
+		filter.End = end
+	}
+
+	filter.Start = filter.End.AddDate(0, 0, -7)
+	if req.StartTimestamp != nil {
+		start, err := ptypes.Timestamp(req.StartTimestamp)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "start_timestamp: %s", err)
+		}
+		filter.Start = start
+	}
+
+	loc, err := getLocation(req.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	filter.Start, filter.End = getDayRange(filter.Start, filter.End, loc)
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = 10
+	}
+
+	var resp pb.GetApplicationDeliveryReportResponse
+
+	rates, err := storage.GetDeliveryRates(config.C.PostgreSQL.DB, filter)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	for _, r := range rates {
+		date, err := ptypes.TimestampProto(r.Date)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		resp.Days = append(resp.Days, &pb.DeliveryRate{
+			Date:          date,
+			ReceivedCount: r.ReceivedCount,
+			ExpectedCount: r.ExpectedCount,
+			DeliveryRate:  deliveryRate(r.ReceivedCount, r.ExpectedCount),
+		})
+
+		resp.ReceivedCount += r.ReceivedCount
+		resp.ExpectedCount += r.ExpectedCount
+	}
+	resp.DeliveryRate = deliveryRate(resp.ReceivedCount, resp.ExpectedCount)
+
+	devices, err := storage.GetDeviceDeliveryRates(config.C.PostgreSQL.DB, filter, limit)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	for _, d := range devices {
+		resp.Devices = append(resp.Devices, &pb.DeviceDeliveryRate{
+			DevEui:        d.DevEUI.String(),
+			Name:          d.Name,
+			ReceivedCount: d.ReceivedCount,
+			ExpectedCount: d.ExpectedCount,
+			DeliveryRate:  deliveryRate(d.ReceivedCount, d.ExpectedCount),
+		})
+	}
+
+	return &resp, nil
+}
+
+// ListDeliveryLog returns the recent delivery attempts of the HTTP
+// integrations of the application.
+func (a *ApplicationAPI) ListDeliveryLog(ctx context.Context, req *pb.ListApplicationDeliveryLogRequest) (*pb.ListApplicationDeliveryLogResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(req.ApplicationId, auth.Read),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = config.C.ApplicationServer.Integration.DeliveryLog.MaxEntries
+	}
+
+	entries, err := deliverylog.Get(config.C.Redis.Pool, req.ApplicationId, limit)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	var resp pb.ListApplicationDeliveryLogResponse
+	for _, e := range entries {
+		ts, err := ptypes.TimestampProto(e.Time)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		entry := pb.DeliveryLogEntry{
+			Timestamp:    ts,
+			Integration:  e.Integration,
+			EventType:    e.EventType,
+			Url:          e.URL,
+			StatusCode:   uint32(e.StatusCode),
+			Latency:      ptypes.DurationProto(e.Latency),
+			ResponseBody: e.ResponseBody,
+			Error:        e.Error,
+		}
+		if e.DevEUI != (lorawan.EUI64{}) {
+			entry.DevEui = e.DevEUI.String()
+		}
+
+		resp.Result = append(resp.Result, &entry)
+	}
+
+	return &resp, nil
+}
+
+// ListQuarantinedFrames returns the uplink frames of the application which
+// have been quarantined because they could not be decrypted.
+func (a *ApplicationAPI) ListQuarantinedFrames(ctx context.Context, req *pb.ListApplicationQuarantinedFramesRequest) (*pb.ListApplicationQuarantinedFramesResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(req.ApplicationId, auth.Read),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = config.C.ApplicationServer.DecryptQuarantine.MaxFrames
+	}
+
+	frames, err := quarantine.Get(config.C.Redis.Pool, req.ApplicationId, limit)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	var resp pb.ListApplicationQuarantinedFramesResponse
+	for _, f := range frames {
+		ts, err := ptypes.TimestampProto(f.Time)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		frame := pb.QuarantinedFrame{
+			Timestamp: ts,
+			DevEui:    f.DevEUI.String(),
+			FCnt:      f.FCnt,
+			FPort:     uint32(f.FPort),
+			Data:      f.Data,
+			Error:     f.Error,
+		}
+		if f.DevAddr != (lorawan.DevAddr{}) {
+			frame.DevAddr = f.DevAddr.String()
+		}
+
+		resp.Result = append(resp.Result, &frame)
+	}
+
+	return &resp, nil
+}
+
+// ClearQuarantinedFrames removes the quarantined uplink frames of the
+// application.
+func (a *ApplicationAPI) ClearQuarantinedFrames(ctx context.Context, req *pb.ClearApplicationQuarantinedFramesRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(req.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := quarantine.Clear(config.C.Redis.Pool, req.ApplicationId); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// ListDeadLetters returns the integration events of the application which
+// could not be delivered within the max. number of delivery attempts.
+func (a *ApplicationAPI) ListDeadLetters(ctx context.Context, req *pb.ListApplicationDeadLettersRequest) (*pb.ListApplicationDeadLettersResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(req.ApplicationId, auth.Read),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = config.C.ApplicationServer.Integration.Delivery.DeadLetter.MaxEntries
+	}
+
+	entries, err := deadletter.Get(config.C.Redis.Pool, req.ApplicationId, limit)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	var resp pb.ListApplicationDeadLettersResponse
+	for _, e := range entries {
+		ts, err := ptypes.TimestampProto(e.Time)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		resp.Result = append(resp.Result, &pb.DeadLetter{
+			Timestamp:   ts,
+			Integration: e.Integration,
+			EventType:   e.EventType,
+			PayloadJson: string(e.Payload),
+			Attempts:    uint32(e.Attempts),
+			Error:       e.Error,
+		})
+	}
+
+	return &resp, nil
+}
+
+// ClearDeadLetters removes the dead-letters of the application.
+func (a *ApplicationAPI) ClearDeadLetters(ctx context.Context, req *pb.ClearApplicationDeadLettersRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(req.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := deadletter.Clear(config.C.Redis.Pool, req.ApplicationId); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// GenerateMQTTIntegrationClientCertificate generates an application ID
+// specific TLS certificate to connect to the MQTT broker.
+func (a *ApplicationAPI) GenerateMQTTIntegrationClientCertificate(ctx context.Context, req *pb.GenerateMQTTIntegrationClientCertificateRequest) (*pb.GenerateMQTTIntegrationClientCertificateResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(req.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if _, err := storage.GetApplication(config.C.PostgreSQL.DB, req.ApplicationId, false); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	cert, err := mqtthandler.GenerateClientCertificate(config.C.ApplicationServer.Integration.MQTT.Client, req.ApplicationId)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.GenerateMQTTIntegrationClientCertificateResponse{
+		TlsCert: string(cert.TLSCert),
+		TlsKey:  string(cert.TLSKey),
+		CaCert:  string(cert.CACert),
+	}
+
+	resp.ExpiresAt, err = ptypes.TimestampProto(cert.ExpiresAt)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &resp, nil
+}
+
+// GenerateMQTTIntegrationCredentials generates an application ID specific
+// username and password to connect to the MQTT broker.
+func (a *ApplicationAPI) GenerateMQTTIntegrationCredentials(ctx context.Context, req *pb.GenerateMQTTIntegrationCredentialsRequest) (*pb.GenerateMQTTIntegrationCredentialsResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(req.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	c, password, err := storage.GenerateApplicationMQTTCredentials(config.C.PostgreSQL.DB, req.ApplicationId)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.GenerateMQTTIntegrationCredentialsResponse{
+		Username: c.Username,
+		Password: password,
+	}, nil
+}
+
+// DeleteMQTTIntegrationCredentials revokes the application ID specific
+// username and password.
+func (a *ApplicationAPI) DeleteMQTTIntegrationCredentials(ctx context.Context, req *pb.DeleteMQTTIntegrationCredentialsRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationAccess(req.ApplicationId, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteApplicationMQTTCredentials(config.C.PostgreSQL.DB, req.ApplicationId); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// deliveryRate returns the ratio between the received and expected uplinks
+// (0 when no uplinks are expected).
+func deliveryRate(received, expected int64) float64 {
+	if expected == 0 {
+		return 0
+	}
+	return float64(received) / float64(expected)
+}
+
+func applicationToProto(app storage.Application) *pb.Application {
+	return &pb.Application{
+		Id:                   app.ID,
+		Name:                 app.Name,
+		Description:          app.Description,
+		OrganizationId:       app.OrganizationID,
+		ServiceProfileId:     app.ServiceProfileID.String(),
+		PayloadCodec:         string(app.PayloadCodec),
+		PayloadEncoderScript: app.PayloadEncoderScript,
+		PayloadDecoderScript: app.PayloadDecoderScript,
+
+		GeolocationBufferFrames: uint32(app.GeolocationBufferFrames),
+		GeolocationMinInterval:  uint32(app.GeolocationMinInterval),
+
+		DisableOrganizationIntegrations: app.DisableOrganizationIntegrations,
+		Archived:                        app.IsArchived(),
+		FieldMappings:                   fieldMappingsToPB(app.FieldMappings),
+		UplinkFilterScript:              app.UplinkFilterScript,
+		MqttTopicTemplates:              mqttTopicTemplatesToPB(app.MQTTTopicTemplates),
+		LegalHold:                       app.HasLegalHold(),
+	}
+}
+
+func fieldMappingsFromPB(mappings []*pb.ApplicationFieldMapping) codec.FieldMappings {
+	var out codec.FieldMappings
+	for _, m := range mappings {
+		out = append(out, codec.FieldMapping{
+			Field:     m.Field,
+			Name:      m.Name,
+			Scale:     m.Scale,
+			Offset:    m.Offset,
+			FromUnit:  m.FromUnit,
+			ToUnit:    m.ToUnit,
+			Round:     m.Round,
+			Precision: int(m.Precision),
+		})
+	}
+	return out
+}
+
+func fieldMappingsToPB(mappings codec.FieldMappings) []*pb.ApplicationFieldMapping {
+	var out []*pb.ApplicationFieldMapping
+	for _, m := range mappings {
+		out = append(out, &pb.ApplicationFieldMapping{
+			Field:     m.Field,
+			Name:      m.Name,
+			Scale:     m.Scale,
+			Offset:    m.Offset,
+			FromUnit:  m.FromUnit,
+			ToUnit:    m.ToUnit,
+			Round:     m.Round,
+			Precision: uint32(m.Precision),
+		})
+	}
+	return out
+}
+
+func mqttTopicTemplatesFromPB(t *pb.ApplicationMQTTTopicTemplates) storage.ApplicationMQTTTopicTemplates {
+	if t == nil {
+		return storage.ApplicationMQTTTopicTemplates{}
+	}
+	return storage.ApplicationMQTTTopicTemplates{
+		Uplink:   t.Uplink,
+		Downlink: t.Downlink,
+		Join:     t.Join,
+		Ack:      t.Ack,
+		Error:    t.Error,
+		Status:   t.Status,
+		Location: t.Location,
+	}
+}
+
+func mqttTopicTemplatesToPB(t storage.ApplicationMQTTTopicTemplates) *pb.ApplicationMQTTTopicTemplates {
+	if t == (storage.ApplicationMQTTTopicTemplates{}) {
+		return nil
+	}
+	return &pb.ApplicationMQTTTopicTemplates{
+		Uplink:   t.Uplink,
+		Downlink: t.Downlink,
+		Join:     t.Join,
+		Ack:      t.Ack,
+		Error:    t.Error,
+		Status:   t.Status,
+		Location: t.Location,
+	}
+}
+
+// sendIntegrationEvent sends the admin-plane event for the given
+// integration.
+func (a *ApplicationAPI) sendIntegrationEvent(ctx context.Context, integration storage.Integration, action string) {
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:        handler.IntegrationEntity,
+		Action:        action,
+		ID:            integration.Kind,
+		ApplicationID: integration.ApplicationID,
+	})
 }
@@ -17,17 +17,32 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 
+	"github.com/brocaar/lora-app-server/internal/availability"
+	"github.com/brocaar/lora-app-server/internal/backpressure"
 	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/correlation"
+	"github.com/brocaar/lora-app-server/internal/devicetwin"
+	"github.com/brocaar/lora-app-server/internal/downlinktrace"
 	"github.com/brocaar/lora-app-server/internal/eventlog"
+	"github.com/brocaar/lora-app-server/internal/geolocation"
 	"github.com/brocaar/lora-app-server/internal/gwping"
 	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/jointrace"
+	"github.com/brocaar/lora-app-server/internal/securityevent"
+	"github.com/brocaar/lora-app-server/internal/signalstats"
 	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/uplinkstats"
 	"github.com/brocaar/loraserver/api/as"
 	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/loraserver/api/gw"
 	"github.com/brocaar/lorawan"
 )
 
+// disabledDeviceTrafficInterval defines the interval in which the uplinks
+// of a device of an archived application are logged as security event (once).
+const disabledDeviceTrafficInterval = time.Hour
+
 // ApplicationServerAPI implements the as.ApplicationServerServer interface.
 type ApplicationServerAPI struct {
 }
@@ -43,6 +58,12 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 		return nil, grpc.Errorf(codes.InvalidArgument, "tx_info must not be nil")
 	}
 
+	// reject the uplink when the integrations can not keep up, so that the
+	// network-server retries it later
+	if err := backpressure.Check(ctx, config.C.ApplicationServer.Integration.Delivery.BackPressure); err != nil {
+		return nil, err
+	}
+
 	var err error
 	var d storage.Device
 	var appEUI, devEUI lorawan.EUI64
@@ -69,40 +90,62 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 		return nil, err
 	}
 
-	app, err := storage.GetApplication(config.C.PostgreSQL.DB, d.ApplicationID)
+	app, err := storage.GetApplication(config.C.PostgreSQL.DB, d.ApplicationID, false)
 	if err != nil {
 		errStr := fmt.Sprintf("get application error: %s", err)
-		log.WithField("id", d.ApplicationID).Error(errStr)
+		correlation.Log(ctx).WithField("id", d.ApplicationID).Error(errStr)
 		return nil, grpc.Errorf(codes.Internal, errStr)
 	}
 
 	if req.DeviceActivationContext != nil {
-		if err := handleDeviceActivation(d, app, req.DeviceActivationContext); err != nil {
+		if err := handleDeviceActivation(ctx, d, app, req.DeviceActivationContext); err != nil {
 			return nil, errToRPCError(err)
 		}
 	}
 
+	detectFCntAnomaly(d, app, req.FCnt, req.DeviceActivationContext != nil)
+
+	if err := availability.Record(config.C.PostgreSQL.DB, config.C.Redis.Pool, d.DevEUI, req.FCnt, req.DeviceActivationContext != nil, time.Now()); err != nil {
+		correlation.Log(ctx).WithField("dev_eui", d.DevEUI).WithError(err).Error("record device availability error")
+	}
+
 	da, err := storage.GetLastDeviceActivationForDevEUI(config.C.PostgreSQL.DB, d.DevEUI)
 	if err != nil {
 		errStr := fmt.Sprintf("get device-activation error: %s", err)
-		log.WithField("dev_eui", d.DevEUI).Error(errStr)
+		correlation.Log(ctx).WithField("dev_eui", d.DevEUI).Error(errStr)
+
+		// without device-activation, the uplink can not be decrypted
+		if errors.Cause(err) == storage.ErrDoesNotExist && quarantineUplink(ctx, d, app, lorawan.DevAddr{}, req, err) {
+			return &empty.Empty{}, nil
+		}
 		return nil, grpc.Errorf(codes.Internal, errStr)
 	}
 
 	b, err := lorawan.EncryptFRMPayload(da.AppSKey, true, da.DevAddr, req.FCnt, req.Data)
 	if err != nil {
-		log.WithFields(log.Fields{
+		correlation.Log(ctx).WithFields(log.Fields{
 			"dev_eui": devEUI,
 			"f_cnt":   req.FCnt,
 		}).Errorf("decrypt payload error: %s", err)
+
+		if quarantineUplink(ctx, d, app, da.DevAddr, req, err) {
+			return &empty.Empty{}, nil
+		}
 		return nil, grpc.Errorf(codes.Internal, "decrypt payload error: %s", err)
 	}
+	resetDecryptErrorCount(ctx, d)
+
+	// uplinks exceeding the service-profile limits are dropped
+	if err := validateUplinkLimits(d, app, req.FCnt, b); err != nil {
+		correlation.Log(ctx).WithField("dev_eui", d.DevEUI).WithError(err).Error("validate service-profile limits error")
+		return nil, errToRPCError(err)
+	}
 
 	var object interface{}
 	codecPL := codec.NewPayload(app.PayloadCodec, uint8(req.FPort), app.PayloadEncoderScript, app.PayloadDecoderScript)
 	if codecPL != nil {
 		if err := codecPL.DecodeBytes(b); err != nil {
-			log.WithFields(log.Fields{
+			correlation.Log(ctx).WithFields(log.Fields{
 				"codec":          app.PayloadCodec,
 				"application_id": app.ID,
 				"f_port":         req.FPort,
@@ -118,20 +161,76 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 				Type:            "CODEC",
 				Error:           err.Error(),
 				FCnt:            req.FCnt,
+				CorrelationID:   correlation.FromContext(ctx),
+			}
+			if scriptErr, ok := err.(*codec.ScriptError); ok {
+				errNotification.CodecError = scriptErr
 			}
 
 			if err := eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
 				Type:    eventlog.Error,
 				Payload: errNotification,
 			}); err != nil {
-				log.WithError(err).Error("log event for device error")
+				correlation.Log(ctx).WithError(err).Error("log event for device error")
 			}
 
-			if err := config.C.ApplicationServer.Integration.Handler.SendErrorNotification(errNotification); err != nil {
-				log.WithError(err).Error("send error notification to handler error")
+			if !app.IsArchived() {
+				if err := config.C.ApplicationServer.Integration.Handler.SendErrorNotification(errNotification); err != nil {
+					correlation.Log(ctx).WithError(err).Error("send error notification to handler error")
+				}
 			}
+
+			detectAppSKeyMismatch(d, app, req.FCnt, b, false)
 		} else {
-			object = codecPL.Object()
+			object = applyFieldMappings(ctx, d, app, req.FCnt, codecPL.Object())
+			detectAppSKeyMismatch(d, app, req.FCnt, b, true)
+		}
+	}
+
+	// the region is informative, failing to retrieve it must not fail
+	// the uplink
+	var region string
+	n, err := storage.GetNetworkServerForDevEUI(config.C.PostgreSQL.DB, d.DevEUI)
+	if err == nil {
+		region, err = storage.GetNetworkServerRegion(config.C.Redis.Pool, n)
+	}
+	if err != nil {
+		correlation.Log(ctx).WithField("dev_eui", d.DevEUI).WithError(err).Error("get network-server region error")
+	}
+
+	if err := uplinkstats.Record(config.C.PostgreSQL.DB, d.DevEUI, region, int(req.Dr), uint8(req.FPort), len(b), time.Now()); err != nil {
+		correlation.Log(ctx).WithField("dev_eui", d.DevEUI).WithError(err).Error("record uplink stats error")
+	}
+
+	if err := devicetwin.UpdateReported(config.C.PostgreSQL.DB, d.DevEUI, object, time.Now()); err != nil {
+		correlation.Log(ctx).WithField("dev_eui", d.DevEUI).WithError(err).Error("update device twin reported state error")
+	}
+
+	if precision := config.C.ApplicationServer.GatewaySignalStats.GeohashPrecision; precision != 0 && d.Latitude != nil && d.Longitude != nil {
+		if err := signalstats.Record(config.C.PostgreSQL.DB, *d.Latitude, *d.Longitude, precision, req.RxInfo, time.Now()); err != nil {
+			correlation.Log(ctx).WithField("dev_eui", d.DevEUI).WithError(err).Error("record gateway signal stats error")
+		}
+	}
+
+	for _, rx := range req.RxInfo {
+		if rx == nil {
+			continue
+		}
+
+		gd := storage.GatewayDevice{
+			DevEUI:     d.DevEUI,
+			LastSeenAt: time.Now(),
+			RSSI:       int(rx.Rssi),
+			LoRaSNR:    rx.LoraSnr,
+		}
+		copy(gd.GatewayMAC[:], rx.GatewayId)
+
+		// gateways which are not known by LoRa App Server are ignored
+		if err := storage.SetGatewayDeviceLastSeen(config.C.PostgreSQL.DB, gd); err != nil && errors.Cause(err) != storage.ErrDoesNotExist {
+			correlation.Log(ctx).WithFields(log.Fields{
+				"dev_eui":    d.DevEUI,
+				"gateway_id": gd.GatewayMAC,
+			}).WithError(err).Error("set gateway device last seen error")
 		}
 	}
 
@@ -145,11 +244,14 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 			Frequency: int(req.TxInfo.Frequency),
 			DR:        int(req.Dr),
 		},
-		ADR:    req.Adr,
-		FCnt:   req.FCnt,
-		FPort:  uint8(req.FPort),
-		Data:   b,
-		Object: object,
+		ADR:           req.Adr,
+		FCnt:          req.FCnt,
+		FPort:         uint8(req.FPort),
+		Data:          b,
+		Object:        object,
+		Region:        region,
+		PortLabel:     getFPortLabel(d, app, req.FCnt, uint8(req.FPort)),
+		CorrelationID: correlation.FromContext(ctx),
 	}
 
 	// collect gateway data of receiving gateways (e.g. gateway name)
@@ -189,7 +291,7 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 		if rxInfo.Time != nil {
 			ts, err := ptypes.Timestamp(rxInfo.Time)
 			if err != nil {
-				log.WithField("dev_eui", devEUI).WithError(err).Error("parse timestamp error")
+				correlation.Log(ctx).WithField("dev_eui", devEUI).WithError(err).Error("parse timestamp error")
 			} else {
 				row.Time = &ts
 			}
@@ -203,14 +305,34 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 		Payload: pl,
 	})
 	if err != nil {
-		log.WithError(err).Error("log event for device error")
+		correlation.Log(ctx).WithError(err).Error("log event for device error")
 	}
 
-	err = config.C.ApplicationServer.Integration.Handler.SendDataUp(pl)
-	if err != nil {
-		log.WithError(err).Error("send uplink data to handler error")
-		return nil, grpc.Errorf(codes.Internal, err.Error())
-	}
+	// the devices of an archived application don't generate integration
+	// traffic, the event is only logged
+	if !app.IsArchived() {
+		if passUplinkFilter(ctx, app, pl) {
+			err = config.C.ApplicationServer.Integration.Handler.SendDataUp(pl)
+			if err != nil {
+				correlation.Log(ctx).WithError(err).Error("send uplink data to handler error")
+				return nil, grpc.Errorf(codes.Internal, err.Error())
+			}
+		}
+	} else {
+		securityevent.LogOnce(d.DevEUI.String(), disabledDeviceTrafficInterval, storage.SecurityEvent{
+			Type:        securityevent.DisabledDeviceTraffic,
+			DevEUI:      &d.DevEUI,
+			Description: fmt.Sprintf("uplink received for device of archived application %d", app.ID),
+		})
+	}
+
+	// resolving the location might take some time, therefore this is
+	// handled async
+	go func(d storage.Device, app storage.Application, fPort uint8, data []byte, rxInfo []*gw.UplinkRXInfo) {
+		if err := geolocation.HandleUplink(context.Background(), d, app, fPort, data, rxInfo); err != nil {
+			correlation.Log(ctx).WithError(err).WithField("dev_eui", d.DevEUI).Error("geolocation error")
+		}
+	}(d, app, uint8(req.FPort), b, req.RxInfo)
 
 	return &empty.Empty{}, nil
 }
@@ -223,20 +345,31 @@ func (a *ApplicationServerAPI) HandleDownlinkACK(ctx context.Context, req *as.Ha
 	d, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI, false, true)
 	if err != nil {
 		errStr := fmt.Sprintf("get device error: %s", err)
-		log.WithField("dev_eui", devEUI).Error(errStr)
+		correlation.Log(ctx).WithField("dev_eui", devEUI).Error(errStr)
 		return nil, grpc.Errorf(codes.Internal, errStr)
 	}
-	app, err := storage.GetApplication(config.C.PostgreSQL.DB, d.ApplicationID)
+	app, err := storage.GetApplication(config.C.PostgreSQL.DB, d.ApplicationID, false)
 	if err != nil {
 		errStr := fmt.Sprintf("get application error: %s", err)
-		log.WithField("id", d.ApplicationID).Error(errStr)
+		correlation.Log(ctx).WithField("id", d.ApplicationID).Error(errStr)
 		return nil, grpc.Errorf(codes.Internal, errStr)
 	}
 
-	log.WithFields(log.Fields{
+	correlation.Log(ctx).WithFields(log.Fields{
 		"dev_eui": devEUI,
 	}).Info("downlink device-queue item acknowledged")
 
+	traceEvent := downlinktrace.Event{
+		Type: downlinktrace.ACK,
+		FCnt: req.FCnt,
+	}
+	if !req.Acknowledged {
+		traceEvent.Type = downlinktrace.NACK
+	}
+	if err := downlinktrace.Log(config.C.Redis.Pool, devEUI, traceEvent); err != nil {
+		correlation.Log(ctx).WithError(err).Error("log downlink trace event error")
+	}
+
 	pl := handler.ACKNotification{
 		ApplicationID:   app.ID,
 		ApplicationName: app.Name,
@@ -244,6 +377,7 @@ func (a *ApplicationServerAPI) HandleDownlinkACK(ctx context.Context, req *as.Ha
 		DevEUI:          devEUI,
 		Acknowledged:    req.Acknowledged,
 		FCnt:            req.FCnt,
+		CorrelationID:   correlation.FromContext(ctx),
 	}
 
 	err = eventlog.LogEventForDevice(devEUI, eventlog.EventLog{
@@ -251,12 +385,14 @@ func (a *ApplicationServerAPI) HandleDownlinkACK(ctx context.Context, req *as.Ha
 		Payload: pl,
 	})
 	if err != nil {
-		log.WithError(err).Error("log event for device error")
+		correlation.Log(ctx).WithError(err).Error("log event for device error")
 	}
 
-	err = config.C.ApplicationServer.Integration.Handler.SendACKNotification(pl)
-	if err != nil {
-		log.Errorf("send ack notification to handler error: %s", err)
+	if !app.IsArchived() {
+		err = config.C.ApplicationServer.Integration.Handler.SendACKNotification(pl)
+		if err != nil {
+			correlation.Log(ctx).Errorf("send ack notification to handler error: %s", err)
+		}
 	}
 
 	return &empty.Empty{}, nil
@@ -270,21 +406,39 @@ func (a *ApplicationServerAPI) HandleError(ctx context.Context, req *as.HandleEr
 	d, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI, false, true)
 	if err != nil {
 		errStr := fmt.Sprintf("get device error: %s", err)
-		log.WithField("dev_eui", devEUI).Error(errStr)
+		correlation.Log(ctx).WithField("dev_eui", devEUI).Error(errStr)
 		return nil, grpc.Errorf(codes.Internal, errStr)
 	}
-	app, err := storage.GetApplication(config.C.PostgreSQL.DB, d.ApplicationID)
+	app, err := storage.GetApplication(config.C.PostgreSQL.DB, d.ApplicationID, false)
 	if err != nil {
 		errStr := fmt.Sprintf("get application error: %s", err)
-		log.WithField("id", d.ApplicationID).Error(errStr)
+		correlation.Log(ctx).WithField("id", d.ApplicationID).Error(errStr)
 		return nil, grpc.Errorf(codes.Internal, errStr)
 	}
 
-	log.WithFields(log.Fields{
+	correlation.Log(ctx).WithFields(log.Fields{
 		"type":    req.Type,
 		"dev_eui": devEUI,
 	}).Error(req.Error)
 
+	switch req.Type {
+	case as.ErrorType_DEVICE_QUEUE_ITEM_SIZE, as.ErrorType_DEVICE_QUEUE_ITEM_FCNT:
+		if err := downlinktrace.Log(config.C.Redis.Pool, devEUI, downlinktrace.Event{
+			Type:  downlinktrace.NSError,
+			FCnt:  req.FCnt,
+			Error: req.Error,
+		}); err != nil {
+			correlation.Log(ctx).WithError(err).Error("log downlink trace event error")
+		}
+	case as.ErrorType_OTAA:
+		if err := jointrace.Log(config.C.Redis.Pool, devEUI, jointrace.Attempt{
+			Result: jointrace.NSError,
+			Error:  req.Error,
+		}); err != nil {
+			correlation.Log(ctx).WithError(err).Error("log join attempt error")
+		}
+	}
+
 	pl := handler.ErrorNotification{
 		ApplicationID:   app.ID,
 		ApplicationName: app.Name,
@@ -293,6 +447,7 @@ func (a *ApplicationServerAPI) HandleError(ctx context.Context, req *as.HandleEr
 		Type:            req.Type.String(),
 		Error:           req.Error,
 		FCnt:            req.FCnt,
+		CorrelationID:   correlation.FromContext(ctx),
 	}
 
 	err = eventlog.LogEventForDevice(devEUI, eventlog.EventLog{
@@ -300,14 +455,16 @@ func (a *ApplicationServerAPI) HandleError(ctx context.Context, req *as.HandleEr
 		Payload: pl,
 	})
 	if err != nil {
-		log.WithError(err).Error("log event for device error")
+		correlation.Log(ctx).WithError(err).Error("log event for device error")
 	}
 
-	err = config.C.ApplicationServer.Integration.Handler.SendErrorNotification(pl)
-	if err != nil {
-		errStr := fmt.Sprintf("send error notification to handler error: %s", err)
-		log.Error(errStr)
-		return nil, grpc.Errorf(codes.Internal, errStr)
+	if !app.IsArchived() {
+		err = config.C.ApplicationServer.Integration.Handler.SendErrorNotification(pl)
+		if err != nil {
+			errStr := fmt.Sprintf("send error notification to handler error: %s", err)
+			correlation.Log(ctx).Error(errStr)
+			return nil, grpc.Errorf(codes.Internal, errStr)
+		}
 	}
 
 	return &empty.Empty{}, nil
@@ -322,7 +479,7 @@ func (a *ApplicationServerAPI) HandleProprietaryUplink(ctx context.Context, req
 	err := gwping.HandleReceivedPing(req)
 	if err != nil {
 		errStr := fmt.Sprintf("handle received ping error: %s", err)
-		log.Error(errStr)
+		correlation.Log(ctx).Error(errStr)
 		return nil, grpc.Errorf(codes.Internal, errStr)
 	}
 
@@ -359,7 +516,7 @@ func (a *ApplicationServerAPI) SetDeviceStatus(ctx context.Context, req *as.SetD
 		return nil, err
 	}
 
-	app, err := storage.GetApplication(config.C.PostgreSQL.DB, d.ApplicationID)
+	app, err := storage.GetApplication(config.C.PostgreSQL.DB, d.ApplicationID, false)
 	if err != nil {
 		return nil, errToRPCError(errors.Wrap(err, "get application error"))
 	}
@@ -371,18 +528,21 @@ func (a *ApplicationServerAPI) SetDeviceStatus(ctx context.Context, req *as.SetD
 		DevEUI:          d.DevEUI,
 		Battery:         int(req.Battery),
 		Margin:          int(req.Margin),
+		CorrelationID:   correlation.FromContext(ctx),
 	}
 	err = eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
 		Type:    eventlog.Status,
 		Payload: pl,
 	})
 	if err != nil {
-		log.WithError(err).Error("log event for device error")
+		correlation.Log(ctx).WithError(err).Error("log event for device error")
 	}
 
-	err = config.C.ApplicationServer.Integration.Handler.SendStatusNotification(pl)
-	if err != nil {
-		return nil, errToRPCError(errors.Wrap(err, "send status notification to handler error"))
+	if !app.IsArchived() {
+		err = config.C.ApplicationServer.Integration.Handler.SendStatusNotification(pl)
+		if err != nil {
+			return nil, errToRPCError(errors.Wrap(err, "send status notification to handler error"))
+		}
 	}
 
 	return &empty.Empty{}, nil
@@ -414,13 +574,25 @@ func (a *ApplicationServerAPI) SetDeviceLocation(ctx context.Context, req *as.Se
 			return errToRPCError(errors.Wrap(err, "update device error"))
 		}
 
+		err = storage.CreateDeviceLocation(tx, &storage.DeviceLocation{
+			DevEUI:    d.DevEUI,
+			Latitude:  req.Location.Latitude,
+			Longitude: req.Location.Longitude,
+			Altitude:  req.Location.Altitude,
+			Accuracy:  int(req.Location.Accuracy),
+			Source:    req.Location.Source.String(),
+		})
+		if err != nil {
+			return errToRPCError(errors.Wrap(err, "create device-location error"))
+		}
+
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	app, err := storage.GetApplication(config.C.PostgreSQL.DB, d.ApplicationID)
+	app, err := storage.GetApplication(config.C.PostgreSQL.DB, d.ApplicationID, false)
 	if err != nil {
 		return nil, errToRPCError(errors.Wrap(err, "get application error"))
 	}
@@ -435,6 +607,7 @@ func (a *ApplicationServerAPI) SetDeviceLocation(ctx context.Context, req *as.Se
 			Longitude: req.Location.Longitude,
 			Altitude:  req.Location.Altitude,
 		},
+		CorrelationID: correlation.FromContext(ctx),
 	}
 
 	err = eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
@@ -442,12 +615,14 @@ func (a *ApplicationServerAPI) SetDeviceLocation(ctx context.Context, req *as.Se
 		Payload: pl,
 	})
 	if err != nil {
-		log.WithError(err).Error("log event for device error")
+		correlation.Log(ctx).WithError(err).Error("log event for device error")
 	}
 
-	err = config.C.ApplicationServer.Integration.Handler.SendLocationNotification(pl)
-	if err != nil {
-		return nil, errToRPCError(errors.Wrap(err, "send location notification to handler error"))
+	if !app.IsArchived() {
+		err = config.C.ApplicationServer.Integration.Handler.SendLocationNotification(pl)
+		if err != nil {
+			return nil, errToRPCError(errors.Wrap(err, "send location notification to handler error"))
+		}
 	}
 
 	return &empty.Empty{}, nil
@@ -501,12 +676,17 @@ func getSKey(typ byte, appkey lorawan.AES128Key, netID lorawan.NetID, appNonce [
 	return key, nil
 }
 
-func handleDeviceActivation(d storage.Device, app storage.Application, daCtx *as.DeviceActivationContext) error {
+func handleDeviceActivation(ctx context.Context, d storage.Device, app storage.Application, daCtx *as.DeviceActivationContext) error {
 	if daCtx.AppSKey == nil {
 		return errors.New("AppSKey must not be nil")
 	}
 
-	key, err := unwrapASKey(daCtx.AppSKey)
+	n, err := storage.GetNetworkServerForDevEUI(config.C.PostgreSQL.DB, d.DevEUI)
+	if err != nil {
+		return errors.Wrap(err, "get network-server error")
+	}
+
+	key, err := unwrapASKey(n, daCtx.AppSKey)
 	if err != nil {
 		return errors.Wrap(err, "unwrap appSKey error")
 	}
@@ -527,6 +707,7 @@ func handleDeviceActivation(d storage.Device, app storage.Application, daCtx *as
 		DevEUI:          d.DevEUI,
 		DeviceName:      d.Name,
 		DevAddr:         da.DevAddr,
+		CorrelationID:   correlation.FromContext(ctx),
 	}
 
 	err = eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
@@ -534,18 +715,23 @@ func handleDeviceActivation(d storage.Device, app storage.Application, daCtx *as
 		Payload: pl,
 	})
 	if err != nil {
-		log.WithError(err).Error("log event for device error")
+		correlation.Log(ctx).WithError(err).Error("log event for device error")
 	}
 
-	err = config.C.ApplicationServer.Integration.Handler.SendJoinNotification(pl)
-	if err != nil {
-		return errors.Wrap(err, "send join notification error")
+	if !app.IsArchived() {
+		err = config.C.ApplicationServer.Integration.Handler.SendJoinNotification(pl)
+		if err != nil {
+			return errors.Wrap(err, "send join notification error")
+		}
 	}
 
 	return nil
 }
 
-func unwrapASKey(ke *common.KeyEnvelope) (lorawan.AES128Key, error) {
+// unwrapASKey unwraps the given key-envelope. When the KEK label matches
+// the KEK label of the given network-server, the network-server KEK is used.
+// Else, the KEK is looked up in the configured KEK set.
+func unwrapASKey(n storage.NetworkServer, ke *common.KeyEnvelope) (lorawan.AES128Key, error) {
 	var key lorawan.AES128Key
 
 	if ke.KekLabel == "" {
@@ -553,6 +739,10 @@ func unwrapASKey(ke *common.KeyEnvelope) (lorawan.AES128Key, error) {
 		return key, nil
 	}
 
+	if n.KEKLabel != "" && n.KEKLabel == ke.KekLabel {
+		return unwrapKey(n.KEK, ke.AesKey)
+	}
+
 	for i := range config.C.JoinServer.KEK.Set {
 		if config.C.JoinServer.KEK.Set[i].Label == ke.KekLabel {
 			kek, err := hex.DecodeString(config.C.JoinServer.KEK.Set[i].KEK)
@@ -560,20 +750,26 @@ func unwrapASKey(ke *common.KeyEnvelope) (lorawan.AES128Key, error) {
 				return key, errors.Wrap(err, "decode kek error")
 			}
 
-			block, err := aes.NewCipher(kek)
-			if err != nil {
-				return key, errors.Wrap(err, "new cipher error")
-			}
-
-			b, err := keywrap.Unwrap(block, ke.AesKey)
-			if err != nil {
-				return key, errors.Wrap(err, "key unwrap error")
-			}
-
-			copy(key[:], b)
-			return key, nil
+			return unwrapKey(kek, ke.AesKey)
 		}
 	}
 
 	return key, fmt.Errorf("unknown kek label: %s", ke.KekLabel)
 }
+
+func unwrapKey(kek, b []byte) (lorawan.AES128Key, error) {
+	var key lorawan.AES128Key
+
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return key, errors.Wrap(err, "new cipher error")
+	}
+
+	b, err = keywrap.Unwrap(block, b)
+	if err != nil {
+		return key, errors.Wrap(err, "key unwrap error")
+	}
+
+	copy(key[:], b)
+	return key, nil
+}
@@ -13,9 +13,11 @@ import (
 	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/lora-app-server/internal/config"
 	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/quarantine"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lora-app-server/internal/test"
 	"github.com/brocaar/lora-app-server/internal/test/testhandler"
+	"github.com/brocaar/lora-app-server/internal/uplinkfilter"
 	"github.com/brocaar/loraserver/api/as"
 	"github.com/brocaar/loraserver/api/common"
 	gwPB "github.com/brocaar/loraserver/api/gw"
@@ -194,6 +196,51 @@ func TestApplicationServerAPI(t *testing.T) {
 			})
 		})
 
+		Convey("Given the device is not activated and the decryption error quarantine is enabled", func() {
+			test.MustFlushRedis(config.C.Redis.Pool)
+			config.C.ApplicationServer.DecryptQuarantine.ErrorCount = 2
+			config.C.ApplicationServer.DecryptQuarantine.MaxFrames = 10
+			config.C.ApplicationServer.DecryptQuarantine.TTL = time.Minute
+
+			req := as.HandleUplinkDataRequest{
+				DevEui: d.DevEUI[:],
+				FCnt:   10,
+				FPort:  3,
+				Data:   []byte{1, 2, 3, 4},
+				TxInfo: &gwPB.UplinkTXInfo{},
+			}
+
+			Convey("Then the first uplink returns an error", func() {
+				_, err := api.HandleUplinkData(ctx, &req)
+				So(err, ShouldNotBeNil)
+
+				frames, err := quarantine.Get(config.C.Redis.Pool, app.ID, 10)
+				So(err, ShouldBeNil)
+				So(frames, ShouldHaveLength, 0)
+
+				Convey("Then the second uplink is quarantined", func() {
+					_, err := api.HandleUplinkData(ctx, &req)
+					So(err, ShouldBeNil)
+
+					frames, err := quarantine.Get(config.C.Redis.Pool, app.ID, 10)
+					So(err, ShouldBeNil)
+					So(frames, ShouldHaveLength, 1)
+					So(frames[0].DevEUI, ShouldEqual, d.DevEUI)
+					So(frames[0].FCnt, ShouldEqual, 10)
+					So(frames[0].Data, ShouldResemble, []byte{1, 2, 3, 4})
+
+					So(h.SendErrorNotificationChan, ShouldHaveLength, 1)
+					pl := <-h.SendErrorNotificationChan
+					So(pl.Type, ShouldEqual, decryptQuarantine)
+					So(h.SendDataUpChan, ShouldHaveLength, 0)
+				})
+			})
+
+			Reset(func() {
+				config.C.ApplicationServer.DecryptQuarantine.ErrorCount = 0
+			})
+		})
+
 		Convey("Given the device is activated", func() {
 			da := storage.DeviceActivation{
 				DevEUI:  d.DevEUI,
@@ -256,6 +303,62 @@ func TestApplicationServerAPI(t *testing.T) {
 				})
 			})
 
+			Convey("When calling HandleUplinkData for an archived application", func() {
+				So(storage.ArchiveApplication(config.C.PostgreSQL.DB, app.ID), ShouldBeNil)
+
+				_, err := api.HandleUplinkData(ctx, &req)
+				So(err, ShouldBeNil)
+
+				Convey("Then no payload was sent to the handler", func() {
+					So(h.SendDataUpChan, ShouldHaveLength, 0)
+				})
+
+				Convey("Then the device was updated", func() {
+					d, err := storage.GetDevice(config.C.PostgreSQL.DB, d.DevEUI, false, true)
+					So(err, ShouldBeNil)
+					So(time.Now().Sub(*d.LastSeenAt), ShouldBeLessThan, time.Second)
+				})
+			})
+
+			Convey("When calling HandleUplinkData (uplink filter drops the uplink)", func() {
+				test.MustFlushRedis(config.C.Redis.Pool)
+				app.UplinkFilterScript = `
+					function Filter(fPort, bytes, obj, prevObj) {
+						return fPort != 3;
+					}
+				`
+				So(storage.UpdateApplication(config.C.PostgreSQL.DB, app), ShouldBeNil)
+
+				_, err := api.HandleUplinkData(ctx, &req)
+				So(err, ShouldBeNil)
+
+				Convey("Then no payload was sent to the handler", func() {
+					So(h.SendDataUpChan, ShouldHaveLength, 0)
+				})
+
+				Convey("Then the dropped uplink was counted", func() {
+					count, err := uplinkfilter.GetDroppedCount(config.C.Redis.Pool, app.ID)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 1)
+				})
+			})
+
+			Convey("When calling HandleUplinkData (uplink filter passes the uplink)", func() {
+				app.UplinkFilterScript = `
+					function Filter(fPort, bytes, obj, prevObj) {
+						return bytes.length > 0;
+					}
+				`
+				So(storage.UpdateApplication(config.C.PostgreSQL.DB, app), ShouldBeNil)
+
+				_, err := api.HandleUplinkData(ctx, &req)
+				So(err, ShouldBeNil)
+
+				Convey("Then a payload was sent to the handler", func() {
+					So(h.SendDataUpChan, ShouldHaveLength, 1)
+				})
+			})
+
 			Convey("When calling HandleUplinkData (Custom JS codec configured)", func() {
 				app.PayloadCodec = codec.CustomJSType
 				app.PayloadDecoderScript = `
@@ -310,10 +413,53 @@ func TestApplicationServerAPI(t *testing.T) {
 							Frequency: 868100000,
 							DR:        6,
 						},
-						ADR:   true,
-						FCnt:  10,
-						FPort: 3,
-						Data:  []byte{67, 216, 236, 205},
+						ADR:    true,
+						FCnt:   10,
+						FPort:  3,
+						Data:   []byte{67, 216, 236, 205},
+						Region: "EU868",
+					})
+				})
+			})
+
+			Convey("Given the device-profile declares the expected fPorts", func() {
+				dp.FPorts = storage.FPorts{
+					{FPort: 3, Label: "telemetry"},
+				}
+				So(storage.UpdateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+
+				Convey("When calling HandleUplinkData", func() {
+					_, err := api.HandleUplinkData(ctx, &req)
+					So(err, ShouldBeNil)
+
+					Convey("Then the port label was set in the payload", func() {
+						So(h.SendDataUpChan, ShouldHaveLength, 1)
+						pl := <-h.SendDataUpChan
+						So(pl.PortLabel, ShouldEqual, "telemetry")
+						So(h.SendErrorNotificationChan, ShouldHaveLength, 0)
+					})
+				})
+
+				Convey("When calling HandleUplinkData on an unexpected fPort", func() {
+					req.FPort = 4
+					_, err := api.HandleUplinkData(ctx, &req)
+					So(err, ShouldBeNil)
+
+					Convey("Then an unexpected fPort warning was sent to the handler", func() {
+						So(h.SendErrorNotificationChan, ShouldHaveLength, 1)
+						So(<-h.SendErrorNotificationChan, ShouldResemble, handler.ErrorNotification{
+							ApplicationID:   app.ID,
+							ApplicationName: "test-app",
+							DeviceName:      "test-node",
+							DevEUI:          d.DevEUI,
+							Type:            "UNEXPECTED_FPORT_WARNING",
+							Error:           "uplink received on unexpected fPort 4",
+							FCnt:            10,
+						})
+
+						So(h.SendDataUpChan, ShouldHaveLength, 1)
+						pl := <-h.SendDataUpChan
+						So(pl.PortLabel, ShouldEqual, "")
 					})
 				})
 			})
@@ -2,8 +2,10 @@ package api
 
 import (
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
+	"google.golang.org/genproto/protobuf/field_mask"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -15,6 +17,8 @@ import (
 	"github.com/brocaar/lora-app-server/internal/config"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lora-app-server/internal/uplinkfilter"
+	"github.com/brocaar/lorawan"
 )
 
 func TestApplicationAPI(t *testing.T) {
@@ -27,10 +31,12 @@ func TestApplicationAPI(t *testing.T) {
 	nsClient := test.NewNetworkServerClient()
 
 	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL, 10, 0)
 	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
 
 	Convey("Given a clean database with an organization and an api instance", t, func() {
 		test.MustResetDB(config.C.PostgreSQL.DB)
+		test.MustFlushRedis(config.C.Redis.Pool)
 
 		ctx := context.Background()
 		validator := &TestValidator{}
@@ -190,6 +196,82 @@ func TestApplicationAPI(t *testing.T) {
 				})
 			})
 
+			Convey("When archiving the application", func() {
+				_, err := api.Archive(ctx, &pb.ArchiveApplicationRequest{
+					Id: createResp.Id,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+				Convey("Then the application is archived", func() {
+					app, err := api.Get(ctx, &pb.GetApplicationRequest{Id: createResp.Id})
+					So(err, ShouldBeNil)
+					So(app.Application.Archived, ShouldBeTrue)
+				})
+
+				Convey("Then the application is hidden from the default listing", func() {
+					apps, err := api.List(ctx, &pb.ListApplicationRequest{Limit: 10})
+					So(err, ShouldBeNil)
+					So(apps.TotalCount, ShouldEqual, 0)
+					So(apps.Result, ShouldHaveLength, 0)
+
+					apps, err = api.List(ctx, &pb.ListApplicationRequest{Limit: 10, IncludeArchived: true})
+					So(err, ShouldBeNil)
+					So(apps.TotalCount, ShouldEqual, 1)
+					So(apps.Result, ShouldHaveLength, 1)
+					So(apps.Result[0].Archived, ShouldBeTrue)
+				})
+
+				Convey("When unarchiving the application", func() {
+					_, err := api.Unarchive(ctx, &pb.UnarchiveApplicationRequest{
+						Id: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+
+					Convey("Then the application is listed again", func() {
+						apps, err := api.List(ctx, &pb.ListApplicationRequest{Limit: 10})
+						So(err, ShouldBeNil)
+						So(apps.TotalCount, ShouldEqual, 1)
+						So(apps.Result[0].Archived, ShouldBeFalse)
+					})
+				})
+			})
+
+			Convey("When placing the application under legal hold", func() {
+				_, err := api.SetLegalHold(ctx, &pb.SetApplicationLegalHoldRequest{
+					Id: createResp.Id,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+				Convey("Then the application is under legal hold", func() {
+					app, err := api.Get(ctx, &pb.GetApplicationRequest{Id: createResp.Id})
+					So(err, ShouldBeNil)
+					So(app.Application.LegalHold, ShouldBeTrue)
+				})
+
+				Convey("Then the application can not be deleted", func() {
+					_, err := api.Delete(ctx, &pb.DeleteApplicationRequest{
+						Id: createResp.Id,
+					})
+					So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+				})
+
+				Convey("When clearing the legal hold", func() {
+					_, err := api.ClearLegalHold(ctx, &pb.ClearApplicationLegalHoldRequest{
+						Id: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+
+					Convey("Then the application can be deleted", func() {
+						_, err := api.Delete(ctx, &pb.DeleteApplicationRequest{
+							Id: createResp.Id,
+						})
+						So(err, ShouldBeNil)
+					})
+				})
+			})
+
 			Convey("When deleting the application", func() {
 				_, err := api.Delete(ctx, &pb.DeleteApplicationRequest{
 					Id: createResp.Id,
@@ -205,6 +287,257 @@ func TestApplicationAPI(t *testing.T) {
 				})
 			})
 
+			Convey("Given a device with uplink stats", func() {
+				dp := storage.DeviceProfile{
+					Name:            "test-dp",
+					OrganizationID:  org.ID,
+					NetworkServerID: n.ID,
+				}
+				So(storage.CreateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+				dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+				So(err, ShouldBeNil)
+
+				d := storage.Device{
+					DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+					ApplicationID:   createResp.Id,
+					DeviceProfileID: dpID,
+					Name:            "test-device",
+				}
+				So(storage.CreateDevice(config.C.PostgreSQL.DB, &d), ShouldBeNil)
+
+				for _, size := range []int{10, 10, 20} {
+					So(storage.IncrementDeviceUplinkStats(config.C.PostgreSQL.DB, storage.DeviceUplinkStats{
+						DevEUI:          d.DevEUI,
+						Hour:            time.Now(),
+						FPort:           1,
+						DR:              5,
+						PayloadSize:     size,
+						SpreadingFactor: 7,
+						UplinkCount:     1,
+						Airtime:         0.5,
+					}), ShouldBeNil)
+				}
+
+				Convey("Then GetUplinkStats returns the uplink stats", func() {
+					resp, err := api.GetUplinkStats(ctx, &pb.GetApplicationUplinkStatsRequest{
+						ApplicationId: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+					So(resp, ShouldResemble, &pb.GetApplicationUplinkStatsResponse{
+						UplinkCount:  3,
+						PayloadBytes: 40,
+						Airtime:      1.5,
+						PayloadSizes: []*pb.UplinkStatsCount{
+							{Value: 10, UplinkCount: 2, PayloadBytes: 20, Airtime: 1},
+							{Value: 20, UplinkCount: 1, PayloadBytes: 20, Airtime: 0.5},
+						},
+						FPorts: []*pb.UplinkStatsCount{
+							{Value: 1, UplinkCount: 3, PayloadBytes: 40, Airtime: 1.5},
+						},
+						SpreadingFactors: []*pb.UplinkStatsCount{
+							{Value: 7, UplinkCount: 3, PayloadBytes: 40, Airtime: 1.5},
+						},
+						Devices: []*pb.DeviceUplinkStats{
+							{DevEui: "0102030405060708", Name: "test-device", UplinkCount: 3, PayloadBytes: 40, AvgPayloadSize: 40.0 / 3, Airtime: 1.5, MaxSpreadingFactor: 7},
+						},
+					})
+				})
+			})
+
+			Convey("Given a device with availability records", func() {
+				dp := storage.DeviceProfile{
+					Name:            "test-dp",
+					OrganizationID:  org.ID,
+					NetworkServerID: n.ID,
+				}
+				So(storage.CreateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+				dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+				So(err, ShouldBeNil)
+
+				d := storage.Device{
+					DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+					ApplicationID:   createResp.Id,
+					DeviceProfileID: dpID,
+					Name:            "test-device",
+				}
+				So(storage.CreateDevice(config.C.PostgreSQL.DB, &d), ShouldBeNil)
+
+				So(storage.IncrementDeviceAvailability(config.C.PostgreSQL.DB, storage.DeviceAvailability{
+					DevEUI:        d.DevEUI,
+					Hour:          time.Now(),
+					ReceivedCount: 3,
+					ExpectedCount: 4,
+				}), ShouldBeNil)
+
+				Convey("Then GetDeliveryReport returns the delivery report", func() {
+					resp, err := api.GetDeliveryReport(ctx, &pb.GetApplicationDeliveryReportRequest{
+						ApplicationId: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+					So(resp.ReceivedCount, ShouldEqual, 3)
+					So(resp.ExpectedCount, ShouldEqual, 4)
+					So(resp.DeliveryRate, ShouldEqual, 0.75)
+					So(resp.Days, ShouldHaveLength, 1)
+					So(resp.Devices, ShouldResemble, []*pb.DeviceDeliveryRate{
+						{DevEui: "0102030405060708", Name: "test-device", ReceivedCount: 3, ExpectedCount: 4, DeliveryRate: 0.75},
+					})
+				})
+			})
+
+			Convey("When creating a HTTP organization-integration", func() {
+				orgAPI := NewOrganizationAPI(validator)
+				req := pb.CreateHTTPIntegrationRequest{
+					Integration: &pb.HTTPIntegration{
+						OrganizationId: org.ID,
+						UplinkDataUrl:  "http://up",
+					},
+				}
+				_, err := orgAPI.CreateHTTPIntegration(ctx, &req)
+				So(err, ShouldBeNil)
+
+				Convey("Then the organization-integration can be retrieved", func() {
+					i, err := orgAPI.GetHTTPIntegration(ctx, &pb.GetOrganizationIntegrationRequest{OrganizationId: org.ID})
+					So(err, ShouldBeNil)
+					So(i.Integration, ShouldResemble, req.Integration)
+				})
+
+				Convey("Then it is listed as inherited integration of the application", func() {
+					resp, err := api.ListIntegrations(ctx, &pb.ListIntegrationRequest{ApplicationId: createResp.Id})
+					So(err, ShouldBeNil)
+					So(resp.TotalCount, ShouldEqual, 1)
+					So(resp.Result[0], ShouldResemble, &pb.IntegrationListItem{
+						Kind:      pb.IntegrationKind_HTTP,
+						Inherited: true,
+					})
+				})
+
+				Convey("When disabling the organization integrations for the application", func() {
+					_, err := api.Update(ctx, &pb.UpdateApplicationRequest{
+						Application: &pb.Application{
+							Id:                              createResp.Id,
+							DisableOrganizationIntegrations: true,
+						},
+						UpdateMask: &field_mask.FieldMask{Paths: []string{"disable_organization_integrations"}},
+					})
+					So(err, ShouldBeNil)
+
+					Convey("Then the integration is not inherited", func() {
+						resp, err := api.ListIntegrations(ctx, &pb.ListIntegrationRequest{ApplicationId: createResp.Id})
+						So(err, ShouldBeNil)
+						So(resp.TotalCount, ShouldEqual, 0)
+					})
+				})
+
+				Convey("Then the organization-integration can be deleted", func() {
+					_, err := orgAPI.DeleteHTTPIntegration(ctx, &pb.DeleteOrganizationIntegrationRequest{OrganizationId: org.ID})
+					So(err, ShouldBeNil)
+
+					_, err = orgAPI.GetHTTPIntegration(ctx, &pb.GetOrganizationIntegrationRequest{OrganizationId: org.ID})
+					So(grpc.Code(err), ShouldEqual, codes.NotFound)
+				})
+			})
+
+			Convey("When updating the field mappings", func() {
+				mappings := []*pb.ApplicationFieldMapping{
+					{Field: "temperatureSensor.1", Name: "temperature", FromUnit: "F", ToUnit: "C", Round: true, Precision: 1},
+				}
+				_, err := api.Update(ctx, &pb.UpdateApplicationRequest{
+					Application: &pb.Application{
+						Id:            createResp.Id,
+						FieldMappings: mappings,
+					},
+					UpdateMask: &field_mask.FieldMask{Paths: []string{"field_mappings"}},
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then the field mappings are returned", func() {
+					app, err := api.Get(ctx, &pb.GetApplicationRequest{Id: createResp.Id})
+					So(err, ShouldBeNil)
+					So(app.Application.FieldMappings, ShouldResemble, mappings)
+				})
+
+				Convey("Then invalid field mappings are rejected", func() {
+					_, err := api.Update(ctx, &pb.UpdateApplicationRequest{
+						Application: &pb.Application{
+							Id: createResp.Id,
+							FieldMappings: []*pb.ApplicationFieldMapping{
+								{Field: "temperature", FromUnit: "C", ToUnit: "hPa"},
+							},
+						},
+						UpdateMask: &field_mask.FieldMask{Paths: []string{"field_mappings"}},
+					})
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				})
+			})
+
+			Convey("When updating the uplink filter script", func() {
+				script := "function Filter(fPort, bytes, obj, prevObj) { return fPort != 2; }"
+				_, err := api.Update(ctx, &pb.UpdateApplicationRequest{
+					Application: &pb.Application{
+						Id:                 createResp.Id,
+						UplinkFilterScript: script,
+					},
+					UpdateMask: &field_mask.FieldMask{Paths: []string{"uplink_filter_script"}},
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then the uplink filter script and dropped count are returned", func() {
+					So(uplinkfilter.IncrDroppedCount(config.C.Redis.Pool, createResp.Id), ShouldBeNil)
+
+					app, err := api.Get(ctx, &pb.GetApplicationRequest{Id: createResp.Id})
+					So(err, ShouldBeNil)
+					So(app.Application.UplinkFilterScript, ShouldEqual, script)
+					So(app.DroppedUplinkCount, ShouldEqual, 1)
+				})
+
+				Convey("Then an invalid uplink filter script is rejected", func() {
+					_, err := api.Update(ctx, &pb.UpdateApplicationRequest{
+						Application: &pb.Application{
+							Id:                 createResp.Id,
+							UplinkFilterScript: "function Filter(fPort, bytes {",
+						},
+						UpdateMask: &field_mask.FieldMask{Paths: []string{"uplink_filter_script"}},
+					})
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				})
+			})
+
+			Convey("When updating the mqtt topic templates", func() {
+				templates := pb.ApplicationMQTTTopicTemplates{
+					Uplink:   "tenant-a/{{ .DevEUI }}/rx",
+					Downlink: "tenant-a/{{ .DevEUI }}/tx",
+				}
+				_, err := api.Update(ctx, &pb.UpdateApplicationRequest{
+					Application: &pb.Application{
+						Id:                 createResp.Id,
+						MqttTopicTemplates: &templates,
+					},
+					UpdateMask: &field_mask.FieldMask{Paths: []string{"mqtt_topic_templates.uplink", "mqtt_topic_templates.downlink"}},
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then the mqtt topic templates are returned", func() {
+					app, err := api.Get(ctx, &pb.GetApplicationRequest{Id: createResp.Id})
+					So(err, ShouldBeNil)
+					So(app.Application.MqttTopicTemplates, ShouldResemble, &templates)
+				})
+
+				Convey("Then a downlink topic template without DevEUI is rejected", func() {
+					_, err := api.Update(ctx, &pb.UpdateApplicationRequest{
+						Application: &pb.Application{
+							Id: createResp.Id,
+							MqttTopicTemplates: &pb.ApplicationMQTTTopicTemplates{
+								Downlink: "tenant-a/tx",
+							},
+						},
+						UpdateMask: &field_mask.FieldMask{Paths: []string{"mqtt_topic_templates"}},
+					})
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				})
+			})
+
 			Convey("When creating a HTTP integration", func() {
 				req := pb.CreateHTTPIntegrationRequest{
 					Integration: &pb.HTTPIntegration{
@@ -334,6 +667,274 @@ func TestApplicationAPI(t *testing.T) {
 					So(grpc.Code(err), ShouldEqual, codes.NotFound)
 				})
 			})
+
+			Convey("When creating an Azure integration with an invalid connection string", func() {
+				_, err := api.CreateAzureIntegration(ctx, &pb.CreateAzureIntegrationRequest{
+					Integration: &pb.AzureIntegration{
+						ApplicationId:    createResp.Id,
+						ConnectionString: "Endpoint=sb://test.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=secret",
+					},
+				})
+				Convey("Then an invalid argument error is returned", func() {
+					So(err, ShouldNotBeNil)
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				})
+			})
+
+			Convey("When creating an Azure integration", func() {
+				createReq := pb.CreateAzureIntegrationRequest{
+					Integration: &pb.AzureIntegration{
+						ApplicationId:    createResp.Id,
+						ConnectionString: "Endpoint=sb://test.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=secret;EntityPath=events",
+					},
+				}
+				_, err := api.CreateAzureIntegration(ctx, &createReq)
+				So(err, ShouldBeNil)
+
+				Convey("Then the integration can be retrieved", func() {
+					i, err := api.GetAzureIntegration(ctx, &pb.GetAzureIntegrationRequest{
+						ApplicationId: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(i.Integration, ShouldResemble, createReq.Integration)
+				})
+
+				Convey("Then the integrations can be listed", func() {
+					resp, err := api.ListIntegrations(ctx, &pb.ListIntegrationRequest{ApplicationId: createResp.Id})
+					So(err, ShouldBeNil)
+					So(resp.TotalCount, ShouldEqual, 1)
+					So(resp.Result[0].Kind, ShouldEqual, pb.IntegrationKind_AZURE)
+				})
+
+				Convey("Then the integration can be updated", func() {
+					updateReq := pb.UpdateAzureIntegrationRequest{
+						Integration: &pb.AzureIntegration{
+							ApplicationId:    createResp.Id,
+							ConnectionString: "HostName=test.azure-devices.net;SharedAccessKeyName=device;SharedAccessKey=c2VjcmV0",
+							ProxyUrl:         "http://proxy:3128",
+						},
+					}
+					_, err := api.UpdateAzureIntegration(ctx, &updateReq)
+					So(err, ShouldBeNil)
+
+					i, err := api.GetAzureIntegration(ctx, &pb.GetAzureIntegrationRequest{
+						ApplicationId: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(i.Integration, ShouldResemble, updateReq.Integration)
+				})
+
+				Convey("Then the integration can be deleted", func() {
+					_, err := api.DeleteAzureIntegration(ctx, &pb.DeleteAzureIntegrationRequest{ApplicationId: createResp.Id})
+					So(err, ShouldBeNil)
+
+					_, err = api.GetAzureIntegration(ctx, &pb.GetAzureIntegrationRequest{ApplicationId: createResp.Id})
+					So(err, ShouldNotBeNil)
+					So(grpc.Code(err), ShouldEqual, codes.NotFound)
+				})
+			})
+
+			Convey("When creating a ThingsBoard integration with an invalid server", func() {
+				_, err := api.CreateThingsBoardIntegration(ctx, &pb.CreateThingsBoardIntegrationRequest{
+					Integration: &pb.ThingsBoardIntegration{
+						ApplicationId: createResp.Id,
+						Server:        "thingsboard.example.com",
+					},
+				})
+				Convey("Then an invalid argument error is returned", func() {
+					So(err, ShouldNotBeNil)
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				})
+			})
+
+			Convey("When creating a ThingsBoard integration", func() {
+				createReq := pb.CreateThingsBoardIntegrationRequest{
+					Integration: &pb.ThingsBoardIntegration{
+						ApplicationId: createResp.Id,
+						Server:        "https://thingsboard.example.com",
+					},
+				}
+				_, err := api.CreateThingsBoardIntegration(ctx, &createReq)
+				So(err, ShouldBeNil)
+
+				Convey("Then the integration can be retrieved", func() {
+					i, err := api.GetThingsBoardIntegration(ctx, &pb.GetThingsBoardIntegrationRequest{
+						ApplicationId: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(i.Integration, ShouldResemble, createReq.Integration)
+				})
+
+				Convey("Then the integrations can be listed", func() {
+					resp, err := api.ListIntegrations(ctx, &pb.ListIntegrationRequest{ApplicationId: createResp.Id})
+					So(err, ShouldBeNil)
+					So(resp.TotalCount, ShouldEqual, 1)
+					So(resp.Result[0].Kind, ShouldEqual, pb.IntegrationKind_THINGSBOARD)
+				})
+
+				Convey("Then the integration can be updated", func() {
+					updateReq := pb.UpdateThingsBoardIntegrationRequest{
+						Integration: &pb.ThingsBoardIntegration{
+							ApplicationId: createResp.Id,
+							Server:        "http://thingsboard:8080",
+							Events:        []string{"up", "status"},
+						},
+					}
+					_, err := api.UpdateThingsBoardIntegration(ctx, &updateReq)
+					So(err, ShouldBeNil)
+
+					i, err := api.GetThingsBoardIntegration(ctx, &pb.GetThingsBoardIntegrationRequest{
+						ApplicationId: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(i.Integration, ShouldResemble, updateReq.Integration)
+				})
+
+				Convey("Then the integration can be deleted", func() {
+					_, err := api.DeleteThingsBoardIntegration(ctx, &pb.DeleteThingsBoardIntegrationRequest{ApplicationId: createResp.Id})
+					So(err, ShouldBeNil)
+
+					_, err = api.GetThingsBoardIntegration(ctx, &pb.GetThingsBoardIntegrationRequest{ApplicationId: createResp.Id})
+					So(err, ShouldNotBeNil)
+					So(grpc.Code(err), ShouldEqual, codes.NotFound)
+				})
+			})
+
+			Convey("When creating a myDevices integration with an invalid endpoint", func() {
+				_, err := api.CreateMyDevicesIntegration(ctx, &pb.CreateMyDevicesIntegrationRequest{
+					Integration: &pb.MyDevicesIntegration{
+						ApplicationId: createResp.Id,
+						Endpoint:      "lora.mydevices.com",
+					},
+				})
+				Convey("Then an invalid argument error is returned", func() {
+					So(err, ShouldNotBeNil)
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				})
+			})
+
+			Convey("When creating a myDevices integration", func() {
+				createReq := pb.CreateMyDevicesIntegrationRequest{
+					Integration: &pb.MyDevicesIntegration{
+						ApplicationId: createResp.Id,
+						Endpoint:      "https://lora.mydevices.com/v1/networks/loraserverio/uplink",
+					},
+				}
+				_, err := api.CreateMyDevicesIntegration(ctx, &createReq)
+				So(err, ShouldBeNil)
+
+				Convey("Then the integration can be retrieved", func() {
+					i, err := api.GetMyDevicesIntegration(ctx, &pb.GetMyDevicesIntegrationRequest{
+						ApplicationId: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(i.Integration, ShouldResemble, createReq.Integration)
+				})
+
+				Convey("Then the integrations can be listed", func() {
+					resp, err := api.ListIntegrations(ctx, &pb.ListIntegrationRequest{ApplicationId: createResp.Id})
+					So(err, ShouldBeNil)
+					So(resp.TotalCount, ShouldEqual, 1)
+					So(resp.Result[0].Kind, ShouldEqual, pb.IntegrationKind_MY_DEVICES)
+				})
+
+				Convey("Then the integration can be updated", func() {
+					updateReq := pb.UpdateMyDevicesIntegrationRequest{
+						Integration: &pb.MyDevicesIntegration{
+							ApplicationId: createResp.Id,
+							Endpoint:      "http://mydevices:8080/uplink",
+							Events:        []string{"up"},
+						},
+					}
+					_, err := api.UpdateMyDevicesIntegration(ctx, &updateReq)
+					So(err, ShouldBeNil)
+
+					i, err := api.GetMyDevicesIntegration(ctx, &pb.GetMyDevicesIntegrationRequest{
+						ApplicationId: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(i.Integration, ShouldResemble, updateReq.Integration)
+				})
+
+				Convey("Then the integration can be deleted", func() {
+					_, err := api.DeleteMyDevicesIntegration(ctx, &pb.DeleteMyDevicesIntegrationRequest{ApplicationId: createResp.Id})
+					So(err, ShouldBeNil)
+
+					_, err = api.GetMyDevicesIntegration(ctx, &pb.GetMyDevicesIntegrationRequest{ApplicationId: createResp.Id})
+					So(err, ShouldNotBeNil)
+					So(grpc.Code(err), ShouldEqual, codes.NotFound)
+				})
+			})
+
+			Convey("When creating a LoRa Cloud integration without token", func() {
+				_, err := api.CreateLoRaCloudIntegration(ctx, &pb.CreateLoRaCloudIntegrationRequest{
+					Integration: &pb.LoRaCloudIntegration{
+						ApplicationId: createResp.Id,
+						ModemPort:     199,
+					},
+				})
+				Convey("Then an invalid argument error is returned", func() {
+					So(err, ShouldNotBeNil)
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				})
+			})
+
+			Convey("When creating a LoRa Cloud integration", func() {
+				createReq := pb.CreateLoRaCloudIntegrationRequest{
+					Integration: &pb.LoRaCloudIntegration{
+						ApplicationId: createResp.Id,
+						Token:         "secret",
+						ModemPort:     199,
+					},
+				}
+				_, err := api.CreateLoRaCloudIntegration(ctx, &createReq)
+				So(err, ShouldBeNil)
+
+				Convey("Then the integration can be retrieved", func() {
+					i, err := api.GetLoRaCloudIntegration(ctx, &pb.GetLoRaCloudIntegrationRequest{
+						ApplicationId: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(i.Integration, ShouldResemble, createReq.Integration)
+				})
+
+				Convey("Then the integrations can be listed", func() {
+					resp, err := api.ListIntegrations(ctx, &pb.ListIntegrationRequest{ApplicationId: createResp.Id})
+					So(err, ShouldBeNil)
+					So(resp.TotalCount, ShouldEqual, 1)
+					So(resp.Result[0].Kind, ShouldEqual, pb.IntegrationKind_LORA_CLOUD)
+				})
+
+				Convey("Then the integration can be updated", func() {
+					updateReq := pb.UpdateLoRaCloudIntegrationRequest{
+						Integration: &pb.LoRaCloudIntegration{
+							ApplicationId: createResp.Id,
+							Token:         "secret",
+							Server:        "http://das:8080",
+							ModemPort:     199,
+							GnssPort:      198,
+							WifiPort:      197,
+						},
+					}
+					_, err := api.UpdateLoRaCloudIntegration(ctx, &updateReq)
+					So(err, ShouldBeNil)
+
+					i, err := api.GetLoRaCloudIntegration(ctx, &pb.GetLoRaCloudIntegrationRequest{
+						ApplicationId: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(i.Integration, ShouldResemble, updateReq.Integration)
+				})
+
+				Convey("Then the integration can be deleted", func() {
+					_, err := api.DeleteLoRaCloudIntegration(ctx, &pb.DeleteLoRaCloudIntegrationRequest{ApplicationId: createResp.Id})
+					So(err, ShouldBeNil)
+
+					_, err = api.GetLoRaCloudIntegration(ctx, &pb.GetLoRaCloudIntegrationRequest{ApplicationId: createResp.Id})
+					So(err, ShouldNotBeNil)
+					So(grpc.Code(err), ShouldEqual, codes.NotFound)
+				})
+			})
 		})
 	})
 }
@@ -3,7 +3,9 @@ package auth
 import (
 	"fmt"
 	"regexp"
+	"time"
 
+	"github.com/brocaar/lora-app-server/internal/securityevent"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	jwt "github.com/dgrijalva/jwt-go"
 	"github.com/jmoiron/sqlx"
@@ -39,6 +41,10 @@ type Validator interface {
 
 	// GetIsAdmin returns if the authenticated user is a global admin.
 	GetIsAdmin(context.Context) (bool, error)
+
+	// GetExpiresAt returns the expiration time of the token. A zero time is
+	// returned when the token does not expire.
+	GetExpiresAt(context.Context) (time.Time, error)
 }
 
 // ValidatorFunc defines the signature of a claim validator function.
@@ -67,6 +73,13 @@ func NewJWTValidator(db sqlx.Ext, algorithm, secret string) *JWTValidator {
 func (v JWTValidator) Validate(ctx context.Context, funcs ...ValidatorFunc) error {
 	claims, err := v.getClaims(ctx)
 	if err != nil {
+		if isInvalidToken(err) {
+			securityevent.Log(storage.SecurityEvent{
+				Type:        securityevent.InvalidToken,
+				RemoteAddr:  securityevent.RemoteAddr(ctx),
+				Description: "invalid api token: " + errors.Cause(err).Error(),
+			})
+		}
 		return err
 	}
 
@@ -100,6 +113,11 @@ func (v JWTValidator) GetIsAdmin(ctx context.Context) (bool, error) {
 		return false, err
 	}
 
+	// service accounts can not be global admin
+	if storage.IsServiceAccountUsername(claims.Username) {
+		return false, nil
+	}
+
 	user, err := storage.GetUserByUsername(v.db, claims.Username)
 	if err != nil {
 		return false, errors.Wrap(err, "get user by username error")
@@ -108,6 +126,21 @@ func (v JWTValidator) GetIsAdmin(ctx context.Context) (bool, error) {
 	return user.IsAdmin, nil
 }
 
+// GetExpiresAt returns the expiration time of the token (zero when the token
+// does not have an exp claim).
+func (v JWTValidator) GetExpiresAt(ctx context.Context) (time.Time, error) {
+	claims, err := v.getClaims(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if claims.ExpiresAt == 0 {
+		return time.Time{}, nil
+	}
+
+	return time.Unix(claims.ExpiresAt, 0), nil
+}
+
 func (v JWTValidator) getClaims(ctx context.Context) (*Claims, error) {
 	tokenStr, err := getTokenFromContext(ctx)
 	if err != nil {
@@ -137,6 +170,21 @@ func (v JWTValidator) getClaims(ctx context.Context) (*Claims, error) {
 	return claims, nil
 }
 
+// isInvalidToken returns true when the given getClaims error is caused by
+// a token which could not be validated (e.g. an invalid signature). Missing
+// tokens and tokens which are only invalid because of their time-based
+// claims (e.g. expired tokens) are not considered invalid.
+func isInvalidToken(err error) bool {
+	timeErrors := jwt.ValidationErrorExpired | jwt.ValidationErrorNotValidYet | jwt.ValidationErrorIssuedAt
+
+	switch cause := errors.Cause(err).(type) {
+	case *jwt.ValidationError:
+		return cause.Errors&^timeErrors != 0
+	default:
+		return cause == ErrInvalidToken
+	}
+}
+
 func getTokenFromContext(ctx context.Context) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -12,6 +12,10 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
 )
 
 func testValidator(pass bool, err error) ValidatorFunc {
@@ -21,7 +25,17 @@ func testValidator(pass bool, err error) ValidatorFunc {
 }
 
 func TestJWTValidator(t *testing.T) {
+	conf := test.GetConfig()
+
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+
 	Convey("Given a JWT validator", t, func() {
+		test.MustResetDB(db)
+
 		v := JWTValidator{
 			secret:    "verysecret",
 			algorithm: "HS256",
@@ -33,6 +47,7 @@ func TestJWTValidator(t *testing.T) {
 			Claims        Claims
 			ValidatorFunc ValidatorFunc
 			Error         string
+			SecurityEvent bool
 		}{
 			{
 				Description:   "valid key and passing validation",
@@ -53,6 +68,7 @@ func TestJWTValidator(t *testing.T) {
 				Claims:        Claims{},
 				ValidatorFunc: testValidator(true, nil),
 				Error:         "signature is invalid",
+				SecurityEvent: true,
 			},
 			{
 				Description:   "valid key but failing validation",
@@ -88,7 +104,36 @@ func TestJWTValidator(t *testing.T) {
 					So(err, ShouldBeNil)
 					So(username, ShouldEqual, test.Claims.Username)
 				}
+
+				count, err := storage.GetSecurityEventCount(db, storage.SecurityEventFilters{Type: "invalid_token"})
+				So(err, ShouldBeNil)
+				So(count == 1, ShouldEqual, test.SecurityEvent)
 			})
 		}
+
+		Convey("Then GetExpiresAt returns the expiration time of the token", func() {
+			expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+			for _, claims := range []Claims{
+				{StandardClaims: jwt.StandardClaims{ExpiresAt: expiresAt.Unix()}},
+				{},
+			} {
+				token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+				ss, err := token.SignedString([]byte(v.secret))
+				So(err, ShouldBeNil)
+
+				ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{
+					"authorization": []string{ss},
+				})
+
+				exp, err := v.GetExpiresAt(ctx)
+				So(err, ShouldBeNil)
+				if claims.ExpiresAt == 0 {
+					So(exp.IsZero(), ShouldBeTrue)
+				} else {
+					So(exp.Equal(expiresAt), ShouldBeTrue)
+				}
+			}
+		})
 	})
 }
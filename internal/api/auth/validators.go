@@ -19,6 +19,21 @@ type Flag int
 // context of the setup be a privacy issue.
 var DisableAssignExistingUsers = false
 
+// Session-key policies.
+const (
+	SessionKeyPolicyViewKeys = "view_keys"
+	SessionKeyPolicyOrgAdmin = "org_admin"
+	SessionKeyPolicyAdmin    = "admin"
+	SessionKeyPolicyNever    = "never"
+)
+
+// SessionKeyPolicy controls which users are able to retrieve the session
+// keys of a device through the API. With view_keys (default), these are
+// global admins, organization admins and organization users allowed to view
+// keys. With org_admin only global and organization admins, with admin only
+// global admins and with never, the session keys are never returned.
+var SessionKeyPolicy = SessionKeyPolicyViewKeys
+
 // Authorization flags.
 const (
 	Create Flag = iota
@@ -27,12 +42,18 @@ const (
 	Delete
 	List
 	UpdateProfile
+	ReadKeys
+	Export
+	ReadSessionKeys
 )
 
+// userQuery joins the authenticated principal (user or service account)
+// with the organizations, gateways, applications, ... it has access to.
+// See the principal and principal_organization views.
 const userQuery = `
 	select count(*)
-	from "user" u
-	left join organization_user ou
+	from principal u
+	left join principal_organization ou
 		on u.id = ou.user_id
 	left join organization o
 		on o.id = ou.organization_id
@@ -44,6 +65,8 @@ const userQuery = `
 		on sp.organization_id = o.id
 	left join device_profile dp
 		on dp.organization_id = o.id
+	left join gateway_profile gp
+		on gp.organization_id = o.id
 	left join network_server ns
 		on ns.id = sp.network_server_id or ns.id = dp.network_server_id
 	left join device d
@@ -344,6 +367,46 @@ func ValidateNodeAccess(devEUI lorawan.EUI64, flag Flag) ValidatorFunc {
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
 			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "d.dev_eui = $2"},
 		}
+	case ReadKeys:
+		// global admin
+		// organization admin
+		// organization user allowed to view keys
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "d.dev_eui = $2"},
+			{"u.username = $1", "u.is_active = true", "ou.can_view_keys = true", "d.dev_eui = $2"},
+		}
+	case Export:
+		// global admin
+		// organization admin
+		// organization user allowed to export
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "d.dev_eui = $2"},
+			{"u.username = $1", "u.is_active = true", "ou.can_export = true", "d.dev_eui = $2"},
+		}
+	case ReadSessionKeys:
+		// depends on the session-key policy
+		switch SessionKeyPolicy {
+		case SessionKeyPolicyNever:
+			return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+				return false, nil
+			}
+		case SessionKeyPolicyAdmin:
+			// global admin
+			where = [][]string{
+				{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			}
+		case SessionKeyPolicyOrgAdmin:
+			// global admin
+			// organization admin
+			where = [][]string{
+				{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+				{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "d.dev_eui = $2"},
+			}
+		default:
+			return ValidateNodeAccess(devEUI, ReadKeys)
+		}
 	default:
 		panic("unsupported flag")
 	}
@@ -359,7 +422,14 @@ func ValidateDeviceQueueAccess(devEUI lorawan.EUI64, flag Flag) ValidatorFunc {
 	var where = [][]string{}
 
 	switch flag {
-	case Create, List, Delete:
+	case Create, Delete:
+		// global admin
+		// organization user (non-auditor)
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_auditor = false", "d.dev_eui = $2"},
+		}
+	case List:
 		// global admin
 		// organization user
 		where = [][]string{
@@ -582,22 +652,133 @@ func ValidateOrganizationUserAccess(flag Flag, organizationID, userID int64) Val
 	}
 }
 
+// ValidateServiceAccountsAccess validates if the client has access to the
+// service accounts of the given organization.
+func ValidateServiceAccountsAccess(flag Flag, organizationID int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create, List:
+		// global admin
+		// organization admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "o.id = $2", "ou.is_admin = true"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, userQuery, where, claims.Username, organizationID)
+	}
+}
+
+// ValidateServiceAccountAccess validates if the client has access to the
+// given service account.
+func ValidateServiceAccountAccess(flag Flag, id int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Read, Update, Delete:
+		// global admin
+		// organization admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "o.id = (select sa.organization_id from service_account sa where sa.id = $2)"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, userQuery, where, claims.Username, id)
+	}
+}
+
+// ValidateGatewayProfilesAccess validates if the client has access to the
+// gateway-profiles. When the organization ID is 0, this validates the
+// access to the global gateway-profiles.
+func ValidateGatewayProfilesAccess(flag Flag, organizationID int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create:
+		// global admin
+		// organization admin (when organization id is given)
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "$2 > 0", "o.id = $2", "ou.is_admin = true"},
+		}
+	case List:
+		// global admin
+		// organization user (when organization id is given)
+		// any active user (global gateway-profiles)
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "$2 > 0", "o.id = $2"},
+			{"u.username = $1", "u.is_active = true", "$2 = 0"},
+		}
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, userQuery, where, claims.Username, organizationID)
+	}
+}
+
 // ValidateGatewayProfileAccess validates if the client has access
-// to the gateway-profiles.
-func ValidateGatewayProfileAccess(flag Flag) ValidatorFunc {
+// to the given gateway-profile.
+func ValidateGatewayProfileAccess(flag Flag, id uuid.UUID) ValidatorFunc {
 	var where = [][]string{}
 
 	switch flag {
-	case Create, Update, Delete:
+	case Read:
 		// global admin
+		// any active user (global gateway-profile)
+		// organization users
 		where = [][]string{
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "not exists (select 1 from gateway_profile where gateway_profile_id = $2 and organization_id is not null)"},
+			{"u.username = $1", "u.is_active = true", "gp.gateway_profile_id = $2"},
 		}
-	case Read, List:
-		// any active user
+	case Update, Delete:
+		// global admin
+		// organization admin users
 		where = [][]string{
-			{"u.username = $1", "u.is_active = true"},
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "gp.gateway_profile_id = $2"},
+		}
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, userQuery, where, claims.Username, id)
+	}
+}
+
+// ValidateIsAdmin validates if the client is a global admin user.
+func ValidateIsAdmin() ValidatorFunc {
+	where := [][]string{
+		{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, userQuery, where, claims.Username)
+	}
+}
+
+// ValidateRegistrationsAccess validates if the client has access to the
+// (self-service) registrations and registration invites.
+func ValidateRegistrationsAccess(flag Flag) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create, Read, Update, Delete, List:
+		// global admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
 		}
+	default:
+		panic("unsupported flag")
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
@@ -848,8 +1029,15 @@ func ValidateMulticastGroupQueueAccess(flag Flag, multicastGroupID uuid.UUID) Va
 	var where = [][]string{}
 
 	switch flag {
-	case Create, List, Delete:
-		// global admi
+	case Create, Delete:
+		// global admin
+		// organization user (non-auditor)
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_auditor = false", "mg.id = $2"},
+		}
+	case List:
+		// global admin
 		// organization user
 		where = [][]string{
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
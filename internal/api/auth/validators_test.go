@@ -119,6 +119,19 @@ func TestValidators(t *testing.T) {
 		deviceProfilesIDs = append(deviceProfilesIDs, dpID)
 	}
 
+	gatewayProfiles := []storage.GatewayProfile{
+		{Name: "test-gp-1", NetworkServerID: networkServers[0].ID},
+		{Name: "test-gp-2", NetworkServerID: networkServers[0].ID, OrganizationID: &organizations[0].ID},
+	}
+	var gatewayProfilesIDs []uuid.UUID
+	for i := range gatewayProfiles {
+		if err := storage.CreateGatewayProfile(db, &gatewayProfiles[i]); err != nil {
+			t.Fatal(err)
+		}
+		gpID, _ := uuid.FromBytes(gatewayProfiles[i].GatewayProfile.Id)
+		gatewayProfilesIDs = append(gatewayProfilesIDs, gpID)
+	}
+
 	applications := []storage.Application{
 		{OrganizationID: organizations[0].ID, Name: "application-1", ServiceProfileID: serviceProfilesIDs[0]},
 		{OrganizationID: organizations[1].ID, Name: "application-2", ServiceProfileID: serviceProfilesIDs[0]},
@@ -158,6 +171,8 @@ func TestValidators(t *testing.T) {
 		{ID: 20, Username: "user10", IsActive: true},
 		{ID: 21, Username: "user11", IsActive: false},
 		{ID: 22, Username: "user12", IsActive: true},
+		{ID: 23, Username: "user13", IsActive: true},
+		{ID: 24, Username: "user14", IsActive: true},
 	}
 	for _, user := range users {
 		_, err = db.Exec(`insert into "user" (id, created_at, updated_at, username, password_hash, session_ttl, is_active, is_admin) values ($1, now(), now(), $2, '', 0, $3, $4)`, user.ID, user.Username, user.IsActive, user.IsAdmin)
@@ -170,16 +185,33 @@ func TestValidators(t *testing.T) {
 		UserID         int64
 		OrganizationID int64
 		IsAdmin        bool
+		Permissions    storage.OrganizationUserPermissions
 	}{
 		{UserID: users[8].ID, OrganizationID: organizations[0].ID, IsAdmin: false},
 		{UserID: users[9].ID, OrganizationID: organizations[0].ID, IsAdmin: true},
 		{UserID: users[10].ID, OrganizationID: organizations[0].ID, IsAdmin: false},
 		{UserID: users[11].ID, OrganizationID: organizations[1].ID, IsAdmin: true},
+		{UserID: users[12].ID, OrganizationID: organizations[0].ID, Permissions: storage.OrganizationUserPermissions{CanViewKeys: true, CanExport: true}},
+		{UserID: users[13].ID, OrganizationID: organizations[0].ID, Permissions: storage.OrganizationUserPermissions{IsAuditor: true}},
 	}
 	for _, orgUser := range orgUsers {
 		if err := storage.CreateOrganizationUser(db, orgUser.OrganizationID, orgUser.UserID, orgUser.IsAdmin); err != nil {
 			t.Fatal(err)
 		}
+		if err := storage.UpdateOrganizationUserPermissions(db, orgUser.OrganizationID, orgUser.UserID, orgUser.Permissions); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	serviceAccounts := []storage.ServiceAccount{
+		{OrganizationID: organizations[0].ID, Name: "sa-member", IsActive: true},
+		{OrganizationID: organizations[0].ID, Name: "sa-admin", IsActive: true, IsAdmin: true},
+		{OrganizationID: organizations[0].ID, Name: "sa-inactive", IsActive: false, IsAdmin: true},
+	}
+	for i := range serviceAccounts {
+		if _, err := storage.CreateServiceAccount(db, &serviceAccounts[i]); err != nil {
+			t.Fatal(err)
+		}
 	}
 
 	gateways := []storage.Gateway{
@@ -449,11 +481,139 @@ func TestValidators(t *testing.T) {
 					Claims:     Claims{Username: "user4"},
 					ExpectedOK: false,
 				},
+				{
+					Name:       "global admin users can read keys and export",
+					Validators: []ValidatorFunc{ValidateNodeAccess(devices[0].DevEUI, ReadKeys), ValidateNodeAccess(devices[0].DevEUI, Export)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can read keys and export",
+					Validators: []ValidatorFunc{ValidateNodeAccess(devices[0].DevEUI, ReadKeys), ValidateNodeAccess(devices[0].DevEUI, Export)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users with permissions can read keys and export",
+					Validators: []ValidatorFunc{ValidateNodeAccess(devices[0].DevEUI, ReadKeys), ValidateNodeAccess(devices[0].DevEUI, Export)},
+					Claims:     Claims{Username: "user13"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users without permissions can not read keys",
+					Validators: []ValidatorFunc{ValidateNodeAccess(devices[0].DevEUI, ReadKeys)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "organization users without permissions can not export",
+					Validators: []ValidatorFunc{ValidateNodeAccess(devices[0].DevEUI, Export)},
+					Claims:     Claims{Username: "user14"},
+					ExpectedOK: false,
+				},
 			}
 
 			runTests(tests, db)
 		})
 
+		Convey("When testing ValidateNodeAccess (ReadSessionKeys)", func() {
+			Reset(func() {
+				SessionKeyPolicy = SessionKeyPolicyViewKeys
+			})
+
+			Convey("Given the view_keys session-key policy", func() {
+				SessionKeyPolicy = SessionKeyPolicyViewKeys
+				tests := []validatorTest{
+					{
+						Name:       "global admin users can read session keys",
+						Validators: []ValidatorFunc{ValidateNodeAccess(devices[0].DevEUI, ReadSessionKeys)},
+						Claims:     Claims{Username: "user1"},
+						ExpectedOK: true,
+					},
+					{
+						Name:       "organization admin users can read session keys",
+						Validators: []ValidatorFunc{ValidateNodeAccess(devices[0].DevEUI, ReadSessionKeys)},
+						Claims:     Claims{Username: "user10"},
+						ExpectedOK: true,
+					},
+					{
+						Name:       "organization users with permissions can read session keys",
+						Validators: []ValidatorFunc{ValidateNodeAccess(devices[0].DevEUI, ReadSessionKeys)},
+						Claims:     Claims{Username: "user13"},
+						ExpectedOK: true,
+					},
+					{
+						Name:       "organization users without permissions can not read session keys",
+						Validators: []ValidatorFunc{ValidateNodeAccess(devices[0].DevEUI, ReadSessionKeys)},
+						Claims:     Claims{Username: "user9"},
+						ExpectedOK: false,
+					},
+				}
+
+				runTests(tests, db)
+			})
+
+			Convey("Given the org_admin session-key policy", func() {
+				SessionKeyPolicy = SessionKeyPolicyOrgAdmin
+				tests := []validatorTest{
+					{
+						Name:       "global admin users can read session keys",
+						Validators: []ValidatorFunc{ValidateNodeAccess(devices[0].DevEUI, ReadSessionKeys)},
+						Claims:     Claims{Username: "user1"},
+						ExpectedOK: true,
+					},
+					{
+						Name:       "organization admin users can read session keys",
+						Validators: []ValidatorFunc{ValidateNodeAccess(devices[0].DevEUI, ReadSessionKeys)},
+						Claims:     Claims{Username: "user10"},
+						ExpectedOK: true,
+					},
+					{
+						Name:       "organization users with permissions can not read session keys",
+						Validators: []ValidatorFunc{ValidateNodeAccess(devices[0].DevEUI, ReadSessionKeys)},
+						Claims:     Claims{Username: "user13"},
+						ExpectedOK: false,
+					},
+				}
+
+				runTests(tests, db)
+			})
+
+			Convey("Given the admin session-key policy", func() {
+				SessionKeyPolicy = SessionKeyPolicyAdmin
+				tests := []validatorTest{
+					{
+						Name:       "global admin users can read session keys",
+						Validators: []ValidatorFunc{ValidateNodeAccess(devices[0].DevEUI, ReadSessionKeys)},
+						Claims:     Claims{Username: "user1"},
+						ExpectedOK: true,
+					},
+					{
+						Name:       "organization admin users can not read session keys",
+						Validators: []ValidatorFunc{ValidateNodeAccess(devices[0].DevEUI, ReadSessionKeys)},
+						Claims:     Claims{Username: "user10"},
+						ExpectedOK: false,
+					},
+				}
+
+				runTests(tests, db)
+			})
+
+			Convey("Given the never session-key policy", func() {
+				SessionKeyPolicy = SessionKeyPolicyNever
+				tests := []validatorTest{
+					{
+						Name:       "global admin users can not read session keys",
+						Validators: []ValidatorFunc{ValidateNodeAccess(devices[0].DevEUI, ReadSessionKeys)},
+						Claims:     Claims{Username: "user1"},
+						ExpectedOK: false,
+					},
+				}
+
+				runTests(tests, db)
+			})
+		})
+
 		Convey("When testing ValidateDeviceQueueAccess", func() {
 			tests := []validatorTest{
 				{
@@ -462,6 +622,24 @@ func TestValidators(t *testing.T) {
 					Claims:     Claims{Username: "user1"},
 					ExpectedOK: true,
 				},
+				{
+					Name:       "organization users can read, list, update and delete",
+					Validators: []ValidatorFunc{ValidateDeviceQueueAccess(devices[0].DevEUI, Create), ValidateDeviceQueueAccess(devices[0].DevEUI, List), ValidateDeviceQueueAccess(devices[0].DevEUI, Delete)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "auditor organization users can list",
+					Validators: []ValidatorFunc{ValidateDeviceQueueAccess(devices[0].DevEUI, List)},
+					Claims:     Claims{Username: "user14"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "auditor organization users can not create and delete",
+					Validators: []ValidatorFunc{ValidateDeviceQueueAccess(devices[0].DevEUI, Create), ValidateDeviceQueueAccess(devices[0].DevEUI, Delete)},
+					Claims:     Claims{Username: "user14"},
+					ExpectedOK: false,
+				},
 				{
 					Name:       "other users can not read, list, update and delete",
 					Validators: []ValidatorFunc{ValidateDeviceQueueAccess(devices[0].DevEUI, Create), ValidateDeviceQueueAccess(devices[0].DevEUI, List), ValidateDeviceQueueAccess(devices[0].DevEUI, Delete)},
@@ -777,23 +955,152 @@ func TestValidators(t *testing.T) {
 			runTests(tests, db)
 		})
 
-		Convey("WHen testing ValidateGatewayProfileAccess", func() {
+		Convey("When testing ValidateGatewayProfilesAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can create and list",
+					Validators: []ValidatorFunc{ValidateGatewayProfilesAccess(Create, 0), ValidateGatewayProfilesAccess(List, 0), ValidateGatewayProfilesAccess(Create, organizations[0].ID), ValidateGatewayProfilesAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can create and list",
+					Validators: []ValidatorFunc{ValidateGatewayProfilesAccess(Create, organizations[0].ID), ValidateGatewayProfilesAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can not create global gateway-profiles",
+					Validators: []ValidatorFunc{ValidateGatewayProfilesAccess(Create, 0)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "organization users can list",
+					Validators: []ValidatorFunc{ValidateGatewayProfilesAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not create",
+					Validators: []ValidatorFunc{ValidateGatewayProfilesAccess(Create, organizations[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "normal users can list global gateway-profiles",
+					Validators: []ValidatorFunc{ValidateGatewayProfilesAccess(List, 0)},
+					Claims:     Claims{Username: "user4"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "normal users can not create or list organization gateway-profiles",
+					Validators: []ValidatorFunc{ValidateGatewayProfilesAccess(Create, 0), ValidateGatewayProfilesAccess(Create, organizations[0].ID), ValidateGatewayProfilesAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user4"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateGatewayProfileAccess", func() {
 			tests := []validatorTest{
 				{
-					Name:       "global admin users can create, update, delete read and list",
-					Validators: []ValidatorFunc{ValidateGatewayProfileAccess(Create), ValidateGatewayProfileAccess(Update), ValidateGatewayProfileAccess(Delete), ValidateGatewayProfileAccess(Read), ValidateGatewayProfileAccess(List)},
+					Name:       "global admin users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateGatewayProfileAccess(Read, gatewayProfilesIDs[0]), ValidateGatewayProfileAccess(Update, gatewayProfilesIDs[0]), ValidateGatewayProfileAccess(Delete, gatewayProfilesIDs[0]), ValidateGatewayProfileAccess(Read, gatewayProfilesIDs[1]), ValidateGatewayProfileAccess(Update, gatewayProfilesIDs[1]), ValidateGatewayProfileAccess(Delete, gatewayProfilesIDs[1])},
 					Claims:     Claims{Username: "user1"},
 					ExpectedOK: true,
 				},
 				{
-					Name:       "normal users can read and list",
-					Validators: []ValidatorFunc{ValidateGatewayProfileAccess(Read), ValidateGatewayProfileAccess(List)},
+					Name:       "organization admin users can read, update and delete organization gateway-profiles",
+					Validators: []ValidatorFunc{ValidateGatewayProfileAccess(Read, gatewayProfilesIDs[1]), ValidateGatewayProfileAccess(Update, gatewayProfilesIDs[1]), ValidateGatewayProfileAccess(Delete, gatewayProfilesIDs[1])},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can not update and delete global gateway-profiles",
+					Validators: []ValidatorFunc{ValidateGatewayProfileAccess(Update, gatewayProfilesIDs[0]), ValidateGatewayProfileAccess(Delete, gatewayProfilesIDs[0])},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "organization users can read",
+					Validators: []ValidatorFunc{ValidateGatewayProfileAccess(Read, gatewayProfilesIDs[0]), ValidateGatewayProfileAccess(Read, gatewayProfilesIDs[1])},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not update and delete",
+					Validators: []ValidatorFunc{ValidateGatewayProfileAccess(Update, gatewayProfilesIDs[1]), ValidateGatewayProfileAccess(Delete, gatewayProfilesIDs[1])},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "admin users of an other organization can not read, update and delete",
+					Validators: []ValidatorFunc{ValidateGatewayProfileAccess(Read, gatewayProfilesIDs[1]), ValidateGatewayProfileAccess(Update, gatewayProfilesIDs[1]), ValidateGatewayProfileAccess(Delete, gatewayProfilesIDs[1])},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "normal users can read global gateway-profiles",
+					Validators: []ValidatorFunc{ValidateGatewayProfileAccess(Read, gatewayProfilesIDs[0])},
 					Claims:     Claims{Username: "user4"},
 					ExpectedOK: true,
 				},
 				{
-					Name:       "normal users can not create, update and delete",
-					Validators: []ValidatorFunc{ValidateGatewayProfileAccess(Create), ValidateGatewayProfileAccess(Update), ValidateGatewayProfileAccess(Delete)},
+					Name:       "normal users can not read organization gateway-profiles or update and delete",
+					Validators: []ValidatorFunc{ValidateGatewayProfileAccess(Read, gatewayProfilesIDs[1]), ValidateGatewayProfileAccess(Update, gatewayProfilesIDs[0]), ValidateGatewayProfileAccess(Delete, gatewayProfilesIDs[0])},
+					Claims:     Claims{Username: "user4"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateIsAdmin", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users are admin",
+					Validators: []ValidatorFunc{ValidateIsAdmin()},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users are not admin",
+					Validators: []ValidatorFunc{ValidateIsAdmin()},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "normal users are not admin",
+					Validators: []ValidatorFunc{ValidateIsAdmin()},
+					Claims:     Claims{Username: "user4"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateRegistrationsAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can create, read, update, delete and list",
+					Validators: []ValidatorFunc{ValidateRegistrationsAccess(Create), ValidateRegistrationsAccess(Read), ValidateRegistrationsAccess(Update), ValidateRegistrationsAccess(Delete), ValidateRegistrationsAccess(List)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can not create, read, update, delete or list",
+					Validators: []ValidatorFunc{ValidateRegistrationsAccess(Create), ValidateRegistrationsAccess(Read), ValidateRegistrationsAccess(Update), ValidateRegistrationsAccess(Delete), ValidateRegistrationsAccess(List)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "normal users can not create, read, update, delete or list",
+					Validators: []ValidatorFunc{ValidateRegistrationsAccess(Create), ValidateRegistrationsAccess(Read), ValidateRegistrationsAccess(Update), ValidateRegistrationsAccess(Delete), ValidateRegistrationsAccess(List)},
 					Claims:     Claims{Username: "user4"},
 					ExpectedOK: false,
 				},
@@ -1175,6 +1482,12 @@ func TestValidators(t *testing.T) {
 					Claims:     Claims{Username: "user9"},
 					ExpectedOK: true,
 				},
+				{
+					Name:       "auditor organization users can not create and delete",
+					Validators: []ValidatorFunc{ValidateMulticastGroupQueueAccess(Create, multicastGroupsIDs[0]), ValidateMulticastGroupQueueAccess(Delete, multicastGroupsIDs[0])},
+					Claims:     Claims{Username: "user14"},
+					ExpectedOK: false,
+				},
 				{
 					Name:       "non-organization users can not create, list and delete",
 					Validators: []ValidatorFunc{ValidateMulticastGroupQueueAccess(Create, multicastGroupsIDs[0]), ValidateMulticastGroupQueueAccess(List, multicastGroupsIDs[0]), ValidateMulticastGroupQueueAccess(Delete, multicastGroupsIDs[0])},
@@ -1185,6 +1498,111 @@ func TestValidators(t *testing.T) {
 
 			runTests(tests, db)
 		})
+
+		Convey("When testing ValidateServiceAccountsAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can create and list",
+					Validators: []ValidatorFunc{ValidateServiceAccountsAccess(Create, organizations[0].ID), ValidateServiceAccountsAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can create and list",
+					Validators: []ValidatorFunc{ValidateServiceAccountsAccess(Create, organizations[0].ID), ValidateServiceAccountsAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin service accounts can create and list",
+					Validators: []ValidatorFunc{ValidateServiceAccountsAccess(Create, organizations[0].ID), ValidateServiceAccountsAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: serviceAccounts[1].Username()},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not create and list",
+					Validators: []ValidatorFunc{ValidateServiceAccountsAccess(Create, organizations[0].ID), ValidateServiceAccountsAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "admin users of other organizations can not create and list",
+					Validators: []ValidatorFunc{ValidateServiceAccountsAccess(Create, organizations[0].ID), ValidateServiceAccountsAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateServiceAccountAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateServiceAccountAccess(Read, serviceAccounts[0].ID), ValidateServiceAccountAccess(Update, serviceAccounts[0].ID), ValidateServiceAccountAccess(Delete, serviceAccounts[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateServiceAccountAccess(Read, serviceAccounts[0].ID), ValidateServiceAccountAccess(Update, serviceAccounts[0].ID), ValidateServiceAccountAccess(Delete, serviceAccounts[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not read, update and delete",
+					Validators: []ValidatorFunc{ValidateServiceAccountAccess(Read, serviceAccounts[0].ID), ValidateServiceAccountAccess(Update, serviceAccounts[0].ID), ValidateServiceAccountAccess(Delete, serviceAccounts[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "admin users of other organizations can not read, update and delete",
+					Validators: []ValidatorFunc{ValidateServiceAccountAccess(Read, serviceAccounts[0].ID), ValidateServiceAccountAccess(Update, serviceAccounts[0].ID), ValidateServiceAccountAccess(Delete, serviceAccounts[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing the service account principals", func() {
+			tests := []validatorTest{
+				{
+					Name:       "service accounts can read the applications of their organization",
+					Validators: []ValidatorFunc{ValidateActiveUser(), ValidateApplicationAccess(applications[0].ID, Read)},
+					Claims:     Claims{Username: serviceAccounts[0].Username()},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "service accounts can not read the applications of other organizations",
+					Validators: []ValidatorFunc{ValidateApplicationAccess(applications[1].ID, Read)},
+					Claims:     Claims{Username: serviceAccounts[0].Username()},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "organization admin service accounts can update the organization",
+					Validators: []ValidatorFunc{ValidateOrganizationAccess(Update, organizations[0].ID)},
+					Claims:     Claims{Username: serviceAccounts[1].Username()},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "service accounts are never global admin",
+					Validators: []ValidatorFunc{ValidateIsAdmin(), ValidateOrganizationsAccess(Create)},
+					Claims:     Claims{Username: serviceAccounts[1].Username()},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "inactive service accounts have no access",
+					Validators: []ValidatorFunc{ValidateActiveUser(), ValidateOrganizationAccess(Update, organizations[0].ID)},
+					Claims:     Claims{Username: serviceAccounts[2].Username()},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
 	})
 }
 
@@ -0,0 +1,175 @@
+// Package compression implements gzip and deflate compression of HTTP
+// responses, based on the Accept-Encoding header of the request.
+package compression
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Config defines the compression configuration.
+type Config struct {
+	// Enabled enables response compression.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Level defines the compression level, from 1 (best speed) to 9 (best
+	// compression). -1 selects the default compression level.
+	Level int `mapstructure:"level"`
+}
+
+// compressor defines the interface implemented by the gzip and flate
+// writers.
+type compressor interface {
+	io.WriteCloser
+	Flush() error
+}
+
+// NewHandler returns a http.Handler which compresses the responses of the
+// given handler. When compression is disabled, the given handler is
+// returned.
+func NewHandler(conf Config, next http.Handler) (http.Handler, error) {
+	if !conf.Enabled {
+		return next, nil
+	}
+
+	if _, err := gzip.NewWriterLevel(ioutil.Discard, conf.Level); err != nil {
+		return nil, errors.Wrap(err, "compression level error")
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+
+		// websocket connections are hijacked and can't be compressed
+		encoding := negotiate(r.Header.Get("Accept-Encoding"))
+		if encoding == "" || r.Method == http.MethodHead || r.Header.Get("Upgrade") != "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rw := &responseWriter{
+			ResponseWriter: w,
+			encoding:       encoding,
+			level:          conf.Level,
+		}
+		defer rw.close()
+		next.ServeHTTP(rw, r)
+	}), nil
+}
+
+// negotiate returns the preferred supported encoding given the
+// Accept-Encoding header or an empty string when none is supported.
+func negotiate(acceptEncoding string) string {
+	var deflate bool
+
+	for _, enc := range strings.Split(acceptEncoding, ",") {
+		parts := strings.Split(enc, ";")
+		name := strings.ToLower(strings.TrimSpace(parts[0]))
+
+		// encodings with q=0 are not acceptable
+		if len(parts) > 1 {
+			q := strings.TrimSpace(parts[1])
+			if strings.HasPrefix(q, "q=") {
+				if v, err := strconv.ParseFloat(q[2:], 64); err == nil && v == 0 {
+					continue
+				}
+			}
+		}
+
+		switch name {
+		case "gzip":
+			return "gzip"
+		case "deflate":
+			deflate = true
+		}
+	}
+
+	if deflate {
+		return "deflate"
+	}
+	return ""
+}
+
+// responseWriter compresses the response body. Whether the response is
+// compressed is decided when the headers are written.
+type responseWriter struct {
+	http.ResponseWriter
+	encoding    string
+	level       int
+	wroteHeader bool
+	writer      compressor
+}
+
+// WriteHeader implements the http.ResponseWriter interface.
+func (rw *responseWriter) WriteHeader(code int) {
+	if rw.wroteHeader {
+		return
+	}
+	rw.wroteHeader = true
+
+	h := rw.Header()
+	if h.Get("Content-Encoding") == "" && code != http.StatusNoContent && code != http.StatusNotModified && code != http.StatusPartialContent {
+		h.Set("Content-Encoding", rw.encoding)
+		h.Del("Content-Length")
+
+		// the level has been validated by NewHandler
+		switch rw.encoding {
+		case "gzip":
+			rw.writer, _ = gzip.NewWriterLevel(rw.ResponseWriter, rw.level)
+		case "deflate":
+			rw.writer, _ = flate.NewWriter(rw.ResponseWriter, rw.level)
+		}
+	}
+
+	rw.ResponseWriter.WriteHeader(code)
+}
+
+// Write implements the http.ResponseWriter interface.
+func (rw *responseWriter) Write(b []byte) (int, error) {
+	if !rw.wroteHeader {
+		if rw.Header().Get("Content-Type") == "" {
+			// the content-type must be detected on the uncompressed data
+			rw.Header().Set("Content-Type", http.DetectContentType(b))
+		}
+		rw.WriteHeader(http.StatusOK)
+	}
+
+	if rw.writer == nil {
+		return rw.ResponseWriter.Write(b)
+	}
+
+	return rw.writer.Write(b)
+}
+
+// Flush implements the http.Flusher interface, which is required for
+// streaming responses.
+func (rw *responseWriter) Flush() {
+	if rw.writer != nil {
+		rw.writer.Flush()
+	}
+
+	if f, ok := rw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// CloseNotify implements the http.CloseNotifier interface.
+func (rw *responseWriter) CloseNotify() <-chan bool {
+	if cn, ok := rw.ResponseWriter.(http.CloseNotifier); ok {
+		return cn.CloseNotify()
+	}
+	return make(chan bool)
+}
+
+// close writes the remaining compressed data.
+func (rw *responseWriter) close() {
+	if rw.writer != nil {
+		rw.writer.Close()
+	}
+}
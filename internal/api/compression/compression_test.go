@@ -0,0 +1,124 @@
+package compression
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		AcceptEncoding string
+		Expected       string
+	}{
+		{"", ""},
+		{"br", ""},
+		{"gzip", "gzip"},
+		{"deflate, gzip", "gzip"},
+		{"deflate", "deflate"},
+		{"gzip;q=0, deflate", "deflate"},
+		{"gzip; q=0.0", ""},
+		{"GZIP;q=0.5", "gzip"},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.Expected, negotiate(test.AcceptEncoding), test.AcceptEncoding)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	body := strings.Repeat(`{"devEUI":"0102030405060708"}`, 10)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/not-modified":
+			w.WriteHeader(http.StatusNotModified)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, body)
+		}
+	})
+
+	t.Run("Invalid level", func(t *testing.T) {
+		_, err := NewHandler(Config{Enabled: true, Level: 10}, next)
+		require.Error(t, err)
+	})
+
+	handler, err := NewHandler(Config{Enabled: true, Level: -1}, next)
+	require.NoError(t, err)
+
+	tests := []struct {
+		Name             string
+		Path             string
+		Headers          map[string]string
+		ExpectedEncoding string
+	}{
+		{
+			Name: "no accept-encoding",
+			Path: "/",
+		},
+		{
+			Name:             "gzip",
+			Path:             "/",
+			Headers:          map[string]string{"Accept-Encoding": "gzip, deflate"},
+			ExpectedEncoding: "gzip",
+		},
+		{
+			Name:             "deflate",
+			Path:             "/",
+			Headers:          map[string]string{"Accept-Encoding": "deflate"},
+			ExpectedEncoding: "deflate",
+		},
+		{
+			Name:    "websocket",
+			Path:    "/",
+			Headers: map[string]string{"Accept-Encoding": "gzip", "Upgrade": "websocket"},
+		},
+		{
+			Name:    "not modified",
+			Path:    "/not-modified",
+			Headers: map[string]string{"Accept-Encoding": "gzip"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			r := httptest.NewRequest(http.MethodGet, test.Path, nil)
+			for k, v := range test.Headers {
+				r.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			assert.Equal(test.ExpectedEncoding, w.Header().Get("Content-Encoding"))
+			assert.Equal("Accept-Encoding", w.Header().Get("Vary"))
+
+			var rd io.Reader = w.Body
+			switch test.ExpectedEncoding {
+			case "gzip":
+				rd, err = gzip.NewReader(w.Body)
+				assert.NoError(err)
+			case "deflate":
+				rd = flate.NewReader(w.Body)
+			}
+
+			b, err := ioutil.ReadAll(rd)
+			assert.NoError(err)
+			if w.Code == http.StatusOK {
+				assert.Equal(body, string(b))
+			} else {
+				assert.Len(b, 0)
+			}
+		})
+	}
+}
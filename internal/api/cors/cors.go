@@ -0,0 +1,132 @@
+// Package cors implements Cross-Origin Resource Sharing (CORS) for the
+// external API, so that browser applications served from other origins are
+// able to use the REST and gRPC-Web API without a proxy in front.
+package cors
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config defines the CORS configuration.
+type Config struct {
+	// AllowedOrigins contains the origins allowed to make cross-origin
+	// requests. An empty list disables CORS, "*" allows all origins and
+	// "https://*.example.com" allows all sub-domains of example.com.
+	AllowedOrigins []string `mapstructure:"allowed_origins"`
+
+	// AllowedMethods contains the methods allowed for cross-origin requests.
+	AllowedMethods []string `mapstructure:"allowed_methods"`
+
+	// AllowedHeaders contains the (non-simple) request headers allowed for
+	// cross-origin requests.
+	AllowedHeaders []string `mapstructure:"allowed_headers"`
+
+	// ExposedHeaders contains the response headers that browsers are
+	// allowed to access.
+	ExposedHeaders []string `mapstructure:"exposed_headers"`
+
+	// AllowCredentials allows cookies and authorization headers to be sent.
+	AllowCredentials bool `mapstructure:"allow_credentials"`
+
+	// MaxAge defines how long the result of a preflight request may be
+	// cached.
+	MaxAge time.Duration `mapstructure:"max_age"`
+}
+
+// NewHandler returns a http.Handler which handles the CORS headers and
+// preflight requests for the given handler. When no origins are configured,
+// the given handler is returned.
+func NewHandler(conf Config, next http.Handler) http.Handler {
+	if len(conf.AllowedOrigins) == 0 {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" || !conf.originAllowed(origin) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		h := w.Header()
+		h.Add("Vary", "Origin")
+
+		// preflight request
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			h.Add("Vary", "Access-Control-Request-Method")
+			h.Add("Vary", "Access-Control-Request-Headers")
+
+			if !contains(conf.AllowedMethods, r.Header.Get("Access-Control-Request-Method")) {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			conf.setOriginHeaders(h, origin)
+			h.Set("Access-Control-Allow-Methods", strings.Join(conf.AllowedMethods, ", "))
+			if reqHeaders := r.Header.Get("Access-Control-Request-Headers"); reqHeaders != "" {
+				if contains(conf.AllowedHeaders, "*") {
+					h.Set("Access-Control-Allow-Headers", reqHeaders)
+				} else {
+					h.Set("Access-Control-Allow-Headers", strings.Join(conf.AllowedHeaders, ", "))
+				}
+			}
+			if conf.MaxAge > 0 {
+				h.Set("Access-Control-Max-Age", strconv.Itoa(int(conf.MaxAge/time.Second)))
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		conf.setOriginHeaders(h, origin)
+		if len(conf.ExposedHeaders) != 0 {
+			h.Set("Access-Control-Expose-Headers", strings.Join(conf.ExposedHeaders, ", "))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (c Config) originAllowed(origin string) bool {
+	origin = strings.ToLower(origin)
+
+	for _, allowed := range c.AllowedOrigins {
+		allowed = strings.ToLower(allowed)
+
+		if allowed == "*" || allowed == origin {
+			return true
+		}
+
+		if i := strings.Index(allowed, "*"); i != -1 {
+			prefix, suffix := allowed[:i], allowed[i+1:]
+			if len(origin) > len(prefix)+len(suffix) && strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (c Config) setOriginHeaders(h http.Header, origin string) {
+	// the wildcard can't be used in combination with credentials
+	if contains(c.AllowedOrigins, "*") && !c.AllowCredentials {
+		h.Set("Access-Control-Allow-Origin", "*")
+	} else {
+		h.Set("Access-Control-Allow-Origin", origin)
+	}
+
+	if c.AllowCredentials {
+		h.Set("Access-Control-Allow-Credentials", "true")
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
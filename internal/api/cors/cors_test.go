@@ -0,0 +1,161 @@
+package cors
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	conf := Config{
+		AllowedOrigins: []string{"https://app.example.com", "https://*.example.org"},
+		AllowedMethods: []string{"GET", "POST"},
+		AllowedHeaders: []string{"Authorization", "Content-Type"},
+		ExposedHeaders: []string{"Grpc-Status", "Grpc-Message"},
+		MaxAge:         10 * time.Minute,
+	}
+
+	tests := []struct {
+		Name            string
+		Config          Config
+		Method          string
+		Headers         map[string]string
+		ExpectedCode    int
+		ExpectedHeaders map[string]string
+	}{
+		{
+			Name:         "no origin",
+			Config:       conf,
+			Method:       http.MethodGet,
+			ExpectedCode: http.StatusOK,
+			ExpectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin": "",
+			},
+		},
+		{
+			Name:         "origin not allowed",
+			Config:       conf,
+			Method:       http.MethodGet,
+			Headers:      map[string]string{"Origin": "https://evil.example.com"},
+			ExpectedCode: http.StatusOK,
+			ExpectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin": "",
+			},
+		},
+		{
+			Name:         "origin allowed",
+			Config:       conf,
+			Method:       http.MethodGet,
+			Headers:      map[string]string{"Origin": "https://app.example.com"},
+			ExpectedCode: http.StatusOK,
+			ExpectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin":   "https://app.example.com",
+				"Access-Control-Expose-Headers": "Grpc-Status, Grpc-Message",
+				"Vary":                          "Origin",
+			},
+		},
+		{
+			Name:         "wildcard sub-domain",
+			Config:       conf,
+			Method:       http.MethodGet,
+			Headers:      map[string]string{"Origin": "https://foo.example.org"},
+			ExpectedCode: http.StatusOK,
+			ExpectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin": "https://foo.example.org",
+			},
+		},
+		{
+			Name: "wildcard origin",
+			Config: Config{
+				AllowedOrigins: []string{"*"},
+			},
+			Method:       http.MethodGet,
+			Headers:      map[string]string{"Origin": "https://foo.example.com"},
+			ExpectedCode: http.StatusOK,
+			ExpectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin": "*",
+			},
+		},
+		{
+			Name: "wildcard origin with credentials",
+			Config: Config{
+				AllowedOrigins:   []string{"*"},
+				AllowCredentials: true,
+			},
+			Method:       http.MethodGet,
+			Headers:      map[string]string{"Origin": "https://foo.example.com"},
+			ExpectedCode: http.StatusOK,
+			ExpectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin":      "https://foo.example.com",
+				"Access-Control-Allow-Credentials": "true",
+			},
+		},
+		{
+			Name:   "preflight",
+			Config: conf,
+			Method: http.MethodOptions,
+			Headers: map[string]string{
+				"Origin":                         "https://app.example.com",
+				"Access-Control-Request-Method":  "POST",
+				"Access-Control-Request-Headers": "authorization",
+			},
+			ExpectedCode: http.StatusNoContent,
+			ExpectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin":  "https://app.example.com",
+				"Access-Control-Allow-Methods": "GET, POST",
+				"Access-Control-Allow-Headers": "Authorization, Content-Type",
+				"Access-Control-Max-Age":       "600",
+			},
+		},
+		{
+			Name:   "preflight method not allowed",
+			Config: conf,
+			Method: http.MethodOptions,
+			Headers: map[string]string{
+				"Origin":                        "https://app.example.com",
+				"Access-Control-Request-Method": "DELETE",
+			},
+			ExpectedCode: http.StatusNoContent,
+			ExpectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin":  "",
+				"Access-Control-Allow-Methods": "",
+			},
+		},
+		{
+			Name:         "disabled",
+			Config:       Config{},
+			Method:       http.MethodGet,
+			Headers:      map[string]string{"Origin": "https://app.example.com"},
+			ExpectedCode: http.StatusOK,
+			ExpectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin": "",
+				"Vary":                        "",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			r := httptest.NewRequest(test.Method, "/api/devices", nil)
+			for k, v := range test.Headers {
+				r.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			NewHandler(test.Config, next).ServeHTTP(w, r)
+
+			assert.Equal(test.ExpectedCode, w.Code)
+			for k, v := range test.ExpectedHeaders {
+				assert.Equal(v, w.Header().Get(k), k)
+			}
+		})
+	}
+}
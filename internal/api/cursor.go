@@ -0,0 +1,95 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/brocaar/lorawan"
+)
+
+// Cursor kinds, used to validate that a cursor is used for the list it was
+// created for.
+const (
+	deviceCursorKind      = "device"
+	gatewayCursorKind     = "gateway"
+	deviceQueueCursorKind = "deviceQueue"
+	deviceTrackCursorKind = "deviceTrack"
+	deviceEventCursorKind = "deviceEvent"
+)
+
+// cursor defines the (opaque) cursor used for cursor-based pagination. It
+// contains the sort key of the last item of the previous page, so that
+// iterating is stable under concurrent modifications (unlike offsets).
+type cursor struct {
+	Kind     string          `json:"k"`
+	Position json.RawMessage `json:"p"`
+}
+
+type deviceCursor struct {
+	Name   string        `json:"name"`
+	DevEUI lorawan.EUI64 `json:"devEUI"`
+}
+
+type gatewayCursor struct {
+	Name string        `json:"name"`
+	MAC  lorawan.EUI64 `json:"mac"`
+}
+
+type deviceQueueCursor struct {
+	FCnt uint32 `json:"fCnt"`
+}
+
+type deviceTrackCursor struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ID        int64     `json:"id"`
+}
+
+// deviceEventCursor is used as resume token of the device event stream.
+type deviceEventCursor struct {
+	DevEUI lorawan.EUI64 `json:"devEUI"`
+	Seq    uint64        `json:"seq"`
+}
+
+// encodeCursor returns the cursor for the given kind and position.
+func encodeCursor(kind string, pos interface{}) (string, error) {
+	b, err := json.Marshal(pos)
+	if err != nil {
+		return "", errors.Wrap(err, "marshal cursor position error")
+	}
+
+	b, err = json.Marshal(cursor{Kind: kind, Position: b})
+	if err != nil {
+		return "", errors.Wrap(err, "marshal cursor error")
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// decodeCursor decodes the given cursor into the position. An InvalidArgument
+// error is returned when the cursor is invalid or of a different kind.
+func decodeCursor(s, kind string, pos interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "cursor: %s", err)
+	}
+
+	var c cursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "cursor: %s", err)
+	}
+
+	if c.Kind != kind {
+		return grpc.Errorf(codes.InvalidArgument, "cursor: invalid cursor for this list")
+	}
+
+	if err := json.Unmarshal(c.Position, pos); err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "cursor: %s", err)
+	}
+
+	return nil
+}
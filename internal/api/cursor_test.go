@@ -0,0 +1,49 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/brocaar/lorawan"
+)
+
+func TestCursor(t *testing.T) {
+	t.Run("Encode and decode", func(t *testing.T) {
+		assert := require.New(t)
+
+		pos := deviceTrackCursor{
+			CreatedAt: time.Date(2018, 10, 1, 12, 30, 0, 0, time.UTC),
+			ID:        123,
+		}
+
+		s, err := encodeCursor(deviceTrackCursorKind, pos)
+		assert.NoError(err)
+
+		var out deviceTrackCursor
+		assert.NoError(decodeCursor(s, deviceTrackCursorKind, &out))
+		assert.Equal(pos, out)
+	})
+
+	t.Run("Different kind", func(t *testing.T) {
+		assert := require.New(t)
+
+		s, err := encodeCursor(deviceCursorKind, deviceCursor{Name: "test", DevEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}})
+		assert.NoError(err)
+
+		var out gatewayCursor
+		err = decodeCursor(s, gatewayCursorKind, &out)
+		assert.Equal(codes.InvalidArgument, grpc.Code(err))
+	})
+
+	t.Run("Invalid cursor", func(t *testing.T) {
+		assert := require.New(t)
+
+		var out deviceCursor
+		err := decodeCursor("not a cursor!", deviceCursorKind, &out)
+		assert.Equal(codes.InvalidArgument, grpc.Code(err))
+	})
+}
@@ -0,0 +1,104 @@
+package api
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/correlation"
+	"github.com/brocaar/lora-app-server/internal/eventlog"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/quarantine"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/loraserver/api/as"
+	"github.com/brocaar/lorawan"
+)
+
+// decryptQuarantine defines the notification type of a quarantined uplink.
+const decryptQuarantine = "DECRYPT_QUARANTINE"
+
+// quarantineUplink keeps track of the consecutive decryption errors of the
+// given device. Once the configured number of consecutive errors has been
+// reached, the (encrypted) uplink is quarantined, a notification is sent
+// and true is returned. In this case the uplink must be acknowledged to the
+// network-server.
+func quarantineUplink(ctx context.Context, d storage.Device, app storage.Application, devAddr lorawan.DevAddr, req *as.HandleUplinkDataRequest, decryptErr error) bool {
+	conf := config.C.ApplicationServer.DecryptQuarantine
+	if conf.ErrorCount == 0 {
+		return false
+	}
+
+	count, err := quarantine.IncrErrorCount(config.C.Redis.Pool, d.DevEUI)
+	if err != nil {
+		correlation.Log(ctx).WithError(err).WithField("dev_eui", d.DevEUI).Error("increment decrypt error count error")
+		return false
+	}
+
+	if count < conf.ErrorCount {
+		return false
+	}
+
+	err = quarantine.Add(config.C.Redis.Pool, app.ID, quarantine.Frame{
+		Time:    time.Now(),
+		DevEUI:  d.DevEUI,
+		DevAddr: devAddr,
+		FCnt:    req.FCnt,
+		FPort:   uint8(req.FPort),
+		Data:    req.Data,
+		Error:   decryptErr.Error(),
+	}, conf.MaxFrames, conf.TTL)
+	if err != nil {
+		correlation.Log(ctx).WithError(err).WithField("dev_eui", d.DevEUI).Error("quarantine uplink error")
+		return false
+	}
+
+	errStr := fmt.Sprintf("uplink quarantined after %d consecutive decryption errors: %s", count, decryptErr)
+
+	correlation.Log(ctx).WithFields(log.Fields{
+		"dev_eui":        d.DevEUI,
+		"application_id": app.ID,
+		"f_cnt":          req.FCnt,
+		"type":           decryptQuarantine,
+	}).Warning(errStr)
+
+	errNotification := handler.ErrorNotification{
+		ApplicationID:   app.ID,
+		ApplicationName: app.Name,
+		DeviceName:      d.Name,
+		DevEUI:          d.DevEUI,
+		Type:            decryptQuarantine,
+		Error:           errStr,
+		FCnt:            req.FCnt,
+		CorrelationID:   correlation.FromContext(ctx),
+	}
+
+	if err := eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
+		Type:    eventlog.Error,
+		Payload: errNotification,
+	}); err != nil {
+		correlation.Log(ctx).WithError(err).Error("log event for device error")
+	}
+
+	if !app.IsArchived() {
+		if err := config.C.ApplicationServer.Integration.Handler.SendErrorNotification(errNotification); err != nil {
+			correlation.Log(ctx).WithError(err).Error("send error notification to handler error")
+		}
+	}
+
+	return true
+}
+
+// resetDecryptErrorCount resets the counter of consecutive decryption
+// errors of the given device, after an uplink has been decrypted.
+func resetDecryptErrorCount(ctx context.Context, d storage.Device) {
+	if config.C.ApplicationServer.DecryptQuarantine.ErrorCount == 0 {
+		return
+	}
+
+	if err := quarantine.ResetErrorCount(config.C.Redis.Pool, d.DevEUI); err != nil {
+		correlation.Log(ctx).WithError(err).WithField("dev_eui", d.DevEUI).Error("reset decrypt error count error")
+	}
+}
@@ -1,12 +1,24 @@
 package api
 
 import (
+	"crypto/aes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
 	"encoding/hex"
 	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"time"
 
+	keywrap "github.com/NickBall/go-aes-key-wrap"
 	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
+	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -16,8 +28,17 @@ import (
 
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/devicetwin"
+	"github.com/brocaar/lora-app-server/internal/downlink"
 	"github.com/brocaar/lora-app-server/internal/eventlog"
+	"github.com/brocaar/lora-app-server/internal/framecapture"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/jointrace"
+	"github.com/brocaar/lora-app-server/internal/limits"
+	"github.com/brocaar/lora-app-server/internal/quarantine"
+	"github.com/brocaar/lora-app-server/internal/securityevent"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/loraserver/api/common"
 	"github.com/brocaar/loraserver/api/gw"
@@ -64,24 +85,67 @@ func (a *DeviceAPI) Create(ctx context.Context, req *pb.CreateDeviceRequest) (*e
 	}
 
 	d := storage.Device{
-		DevEUI:            devEUI,
-		ApplicationID:     req.Device.ApplicationId,
-		DeviceProfileID:   dpID,
-		Name:              req.Device.Name,
-		Description:       req.Device.Description,
-		SkipFCntCheck:     req.Device.SkipFCntCheck,
-		ReferenceAltitude: req.Device.ReferenceAltitude,
+		DevEUI:                devEUI,
+		ApplicationID:         req.Device.ApplicationId,
+		DeviceProfileID:       dpID,
+		Name:                  req.Device.Name,
+		Description:           req.Device.Description,
+		SkipFCntCheck:         req.Device.SkipFCntCheck,
+		ReferenceAltitude:     req.Device.ReferenceAltitude,
+		SuppressFCntAnomalies: req.Device.SuppressFCntAnomalies,
+		Variables:             req.Device.Variables,
 	}
 
+	var app storage.Application
+	var spDeviceCountStatus limits.Status
+
 	// as this also performs a remote call to create the node on the
 	// network-server, wrap it in a transaction
 	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		app, err = storage.GetApplication(tx, d.ApplicationID, false)
+		if err != nil {
+			return err
+		}
+
+		// this locks the organization and therefore also serializes the
+		// creates for the service-profiles of this organization
+		if err := storage.ValidateOrganizationDeviceQuota(tx, app.OrganizationID); err != nil {
+			return err
+		}
+
+		sp, err := storage.GetServiceProfile(tx, app.ServiceProfileID, true)
+		if err != nil {
+			return err
+		}
+
+		count, err := storage.GetDeviceCount(tx, storage.DeviceFilters{ServiceProfileID: app.ServiceProfileID})
+		if err != nil {
+			return err
+		}
+
+		spDeviceCountStatus = limits.GetStatus(count+1, sp.MaxDeviceCount, config.C.ApplicationServer.ServiceProfileLimits.WarningThreshold)
+		if spDeviceCountStatus == limits.ExceedingLimit {
+			return storage.ErrServiceProfileMaxDeviceCount
+		}
+
 		return storage.CreateDevice(tx, &d)
 	})
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
 
+	if spDeviceCountStatus == limits.ApproachingLimit {
+		sendServiceProfileLimitNotification(d, app, spMaxDeviceCountWarning, 0,
+			"the max number of devices for the service-profile is being approached")
+	}
+
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:        handler.DeviceEntity,
+		Action:        handler.CreateAction,
+		ID:            d.DevEUI.String(),
+		ApplicationID: d.ApplicationID,
+	})
+
 	return &empty.Empty{}, nil
 }
 
@@ -97,20 +161,35 @@ func (a *DeviceAPI) Get(ctx context.Context, req *pb.GetDeviceRequest) (*pb.GetD
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	d, err := storage.GetDevice(config.C.PostgreSQL.DB, eui, false, false)
+	// the local device is updated on every change (including uplinks), so
+	// its updated_at timestamp can be used to validate the ETag without
+	// calling the network-server
+	d, err := storage.GetDevice(config.C.PostgreSQL.DB, eui, false, true)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if notModified(ctx, newETag(d.DevEUI, d.UpdatedAt.UnixNano())) {
+		return &pb.GetDeviceResponse{}, nil
+	}
+
+	d, err = storage.GetDevice(config.C.PostgreSQL.DB, eui, false, false)
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
 
 	resp := pb.GetDeviceResponse{
 		Device: &pb.Device{
-			DevEui:            d.DevEUI.String(),
-			Name:              d.Name,
-			ApplicationId:     d.ApplicationID,
-			Description:       d.Description,
-			DeviceProfileId:   d.DeviceProfileID.String(),
-			SkipFCntCheck:     d.SkipFCntCheck,
-			ReferenceAltitude: d.ReferenceAltitude,
+			DevEui:                d.DevEUI.String(),
+			Name:                  d.Name,
+			ApplicationId:         d.ApplicationID,
+			Description:           d.Description,
+			DeviceProfileId:       d.DeviceProfileID.String(),
+			SkipFCntCheck:         d.SkipFCntCheck,
+			ReferenceAltitude:     d.ReferenceAltitude,
+			SuppressFCntAnomalies: d.SuppressFCntAnomalies,
+			LegalHold:             d.HasLegalHold(),
+			Variables:             d.Variables,
 		},
 
 		DeviceStatusBattery: 256,
@@ -139,6 +218,22 @@ func (a *DeviceAPI) Get(ctx context.Context, req *pb.GetDeviceRequest) (*pb.GetD
 		}
 	}
 
+	dp, err := storage.GetDeviceProfile(config.C.PostgreSQL.DB, d.DeviceProfileID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	resp.MacVersion = dp.DeviceProfile.MacVersion
+	resp.RegParamsRevision = dp.DeviceProfile.RegParamsRevision
+
+	n, err := storage.GetNetworkServer(config.C.PostgreSQL.DB, dp.NetworkServerID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	resp.Region, err = storage.GetNetworkServerRegion(config.C.Redis.Pool, n)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
 	return &resp, nil
 }
 
@@ -168,6 +263,17 @@ func (a *DeviceAPI) List(ctx context.Context, req *pb.ListDeviceRequest) (*pb.Li
 		}
 	}
 
+	if req.Cursor != "" {
+		var c deviceCursor
+		if err := decodeCursor(req.Cursor, deviceCursorKind, &c); err != nil {
+			return nil, err
+		}
+
+		filters.Offset = 0
+		filters.AfterName = c.Name
+		filters.AfterDevEUI = &c.DevEUI
+	}
+
 	if filters.ApplicationID != 0 {
 		idFilter = true
 
@@ -223,7 +329,24 @@ func (a *DeviceAPI) List(ctx context.Context, req *pb.ListDeviceRequest) (*pb.Li
 		return nil, errToRPCError(err)
 	}
 
-	return a.returnList(count, devices)
+	resp, err := a.returnList(count, devices)
+	if err != nil {
+		return nil, err
+	}
+
+	// a full page means that there might be more devices
+	if len(devices) != 0 && len(devices) == filters.Limit {
+		last := devices[len(devices)-1]
+		resp.NextCursor, err = encodeCursor(deviceCursorKind, deviceCursor{
+			Name:   last.Name,
+			DevEUI: last.DevEUI,
+		})
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	return resp, nil
 }
 
 // Update updates the device matching the given DevEUI.
@@ -237,27 +360,51 @@ func (a *DeviceAPI) Update(ctx context.Context, req *pb.UpdateDeviceRequest) (*e
 		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	dpID, err := uuid.FromString(req.Device.DeviceProfileId)
-	if err != nil {
-		return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
-	}
-
 	if err := a.validator.Validate(ctx,
 		auth.ValidateNodeAccess(devEUI, auth.Update)); err != nil {
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
-		d, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI, true, false)
+	var d storage.Device
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		var err error
+		d, err = storage.GetDevice(tx, devEUI, true, false)
 		if err != nil {
 			return errToRPCError(err)
 		}
 
+		// in case of a partial update, merge the given fields into the
+		// current device
+		if req.UpdateMask != nil {
+			current := &pb.Device{
+				DevEui:                d.DevEUI.String(),
+				Name:                  d.Name,
+				ApplicationId:         d.ApplicationID,
+				Description:           d.Description,
+				DeviceProfileId:       d.DeviceProfileID.String(),
+				SkipFCntCheck:         d.SkipFCntCheck,
+				ReferenceAltitude:     d.ReferenceAltitude,
+				SuppressFCntAnomalies: d.SuppressFCntAnomalies,
+				Variables:             d.Variables,
+			}
+			if err := applyFieldMask(current, req.Device, req.UpdateMask); err != nil {
+				return err
+			}
+			req.Device = current
+		}
+
+		dpID, err := uuid.FromString(req.Device.DeviceProfileId)
+		if err != nil {
+			return grpc.Errorf(codes.InvalidArgument, err.Error())
+		}
+
 		d.DeviceProfileID = dpID
 		d.Name = req.Device.Name
 		d.Description = req.Device.Description
 		d.SkipFCntCheck = req.Device.SkipFCntCheck
 		d.ReferenceAltitude = req.Device.ReferenceAltitude
+		d.SuppressFCntAnomalies = req.Device.SuppressFCntAnomalies
+		d.Variables = req.Device.Variables
 
 		if err := storage.UpdateDevice(tx, &d, false); err != nil {
 			return errToRPCError(err)
@@ -269,6 +416,13 @@ func (a *DeviceAPI) Update(ctx context.Context, req *pb.UpdateDeviceRequest) (*e
 		return nil, err
 	}
 
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:        handler.DeviceEntity,
+		Action:        handler.UpdateAction,
+		ID:            d.DevEUI.String(),
+		ApplicationID: d.ApplicationID,
+	})
+
 	return &empty.Empty{}, nil
 }
 
@@ -286,13 +440,27 @@ func (a *DeviceAPI) Delete(ctx context.Context, req *pb.DeleteDeviceRequest) (*e
 
 	// as this also performs a remote call to delete the node from the
 	// network-server, wrap it in a transaction
+	var d storage.Device
 	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		var err error
+		d, err = storage.GetDevice(tx, eui, true, true)
+		if err != nil {
+			return err
+		}
+
 		return storage.DeleteDevice(tx, eui)
 	})
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
 
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:        handler.DeviceEntity,
+		Action:        handler.DeleteAction,
+		ID:            eui.String(),
+		ApplicationID: d.ApplicationID,
+	})
+
 	return &empty.Empty{}, nil
 }
 
@@ -326,6 +494,10 @@ func (a *DeviceAPI) CreateKeys(ctx context.Context, req *pb.CreateDeviceKeysRequ
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	if err := validateDeviceKeys(eui, req.DeviceKeys.AppKey); err != nil {
+		return nil, err
+	}
+
 	err := storage.CreateDeviceKeys(config.C.PostgreSQL.DB, &storage.DeviceKeys{
 		DevEUI: eui,
 		NwkKey: nwkKey,
@@ -346,7 +518,7 @@ func (a *DeviceAPI) GetKeys(ctx context.Context, req *pb.GetDeviceKeysRequest) (
 	}
 
 	if err := a.validator.Validate(ctx,
-		auth.ValidateNodeAccess(eui, auth.Update),
+		auth.ValidateNodeAccess(eui, auth.ReadKeys),
 	); err != nil {
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
@@ -395,6 +567,10 @@ func (a *DeviceAPI) UpdateKeys(ctx context.Context, req *pb.UpdateDeviceKeysRequ
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	if err := validateDeviceKeys(eui, req.DeviceKeys.AppKey); err != nil {
+		return nil, err
+	}
+
 	dk, err := storage.GetDeviceKeys(config.C.PostgreSQL.DB, eui)
 	if err != nil {
 		return nil, errToRPCError(err)
@@ -410,6 +586,27 @@ func (a *DeviceAPI) UpdateKeys(ctx context.Context, req *pb.UpdateDeviceKeysRequ
 	return &empty.Empty{}, nil
 }
 
+// validateDeviceKeys validates the given device-keys against the MAC version
+// of the device-profile. LoRaWAN 1.1 devices require an AppKey in addition
+// to the NwkKey.
+func validateDeviceKeys(devEUI lorawan.EUI64, appKey string) error {
+	d, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI, false, true)
+	if err != nil {
+		return errToRPCError(err)
+	}
+
+	dp, err := storage.GetDeviceProfile(config.C.PostgreSQL.DB, d.DeviceProfileID)
+	if err != nil {
+		return errToRPCError(err)
+	}
+
+	if dp.IsLoRaWAN11() && appKey == "" {
+		return grpc.Errorf(codes.InvalidArgument, "app_key must be set for LoRaWAN 1.1 devices")
+	}
+
+	return nil
+}
+
 // DeleteKeys deletes the device-keys for the given DevEUI.
 func (a *DeviceAPI) DeleteKeys(ctx context.Context, req *pb.DeleteDeviceKeysRequest) (*empty.Empty, error) {
 	var eui lorawan.EUI64
@@ -530,7 +727,8 @@ func (a *DeviceAPI) Activate(ctx context.Context, req *pb.ActivateDeviceRequest)
 	return &empty.Empty{}, nil
 }
 
-// GetActivation returns the device activation for the given DevEUI.
+// GetActivation returns the device activation for the given DevEUI. The
+// session keys are only included when the user is allowed to view keys.
 func (a *DeviceAPI) GetActivation(ctx context.Context, req *pb.GetDeviceActivationRequest) (*pb.GetDeviceActivationResponse, error) {
 	var devAddr lorawan.DevAddr
 	var devEUI lorawan.EUI64
@@ -579,7 +777,7 @@ func (a *DeviceAPI) GetActivation(ctx context.Context, req *pb.GetDeviceActivati
 	copy(sNwkSIntKey[:], devAct.DeviceActivation.SNwkSIntKey)
 	copy(fNwkSIntKey[:], devAct.DeviceActivation.FNwkSIntKey)
 
-	return &pb.GetDeviceActivationResponse{
+	resp := pb.GetDeviceActivationResponse{
 		DeviceActivation: &pb.DeviceActivation{
 			DevEui:      da.DevEUI.String(),
 			DevAddr:     devAddr.String(),
@@ -591,129 +789,1114 @@ func (a *DeviceAPI) GetActivation(ctx context.Context, req *pb.GetDeviceActivati
 			NFCntDown:   devAct.DeviceActivation.NFCntDown,
 			AFCntDown:   devAct.DeviceActivation.AFCntDown,
 		},
-	}, nil
+	}
+
+	// the session keys are only returned to users allowed by the
+	// session-key policy
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.ReadSessionKeys)); err != nil {
+		resp.DeviceActivation.AppSKey = ""
+		resp.DeviceActivation.NwkSEncKey = ""
+		resp.DeviceActivation.SNwkSIntKey = ""
+		resp.DeviceActivation.FNwkSIntKey = ""
+	} else {
+		logSessionKeyAccess(ctx, a.validator, devEUI, fmt.Sprintf("session keys of device %s retrieved", devEUI))
+	}
+
+	return &resp, nil
 }
 
-// StreamFrameLogs streams the uplink and downlink frame-logs for the given DevEUI.
-// Note: these are the raw LoRaWAN frames and this endpoint is intended for debugging.
-func (a *DeviceAPI) StreamFrameLogs(req *pb.StreamDeviceFrameLogsRequest, srv pb.DeviceService_StreamFrameLogsServer) error {
+// GetWrappedAppSKey returns the current AppSKey of the device, wrapped using
+// the given KEK or encrypted using the given RSA public key.
+func (a *DeviceAPI) GetWrappedAppSKey(ctx context.Context, req *pb.GetWrappedAppSKeyRequest) (*pb.GetWrappedAppSKeyResponse, error) {
 	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if (req.Kek == "") == (req.PublicKey == "") {
+		return nil, grpc.Errorf(codes.InvalidArgument, "either kek or public_key must be set")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.ReadSessionKeys)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	da, err := storage.GetLastDeviceActivationForDevEUI(config.C.PostgreSQL.DB, devEUI)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	var b []byte
+	if req.Kek != "" {
+		b, err = wrapAppSKeyWithKEK(req.Kek, da.AppSKey)
+	} else {
+		b, err = encryptAppSKeyWithPublicKey(req.PublicKey, da.AppSKey)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logSessionKeyAccess(ctx, a.validator, devEUI, fmt.Sprintf("wrapped appSKey of device %s retrieved (kek label: %s)", devEUI, req.KekLabel))
+
+	return &pb.GetWrappedAppSKeyResponse{
+		DevAddr:  da.DevAddr.String(),
+		KekLabel: req.KekLabel,
+		AppSKey:  hex.EncodeToString(b),
+	}, nil
+}
 
+// decryptUplinkMaxActivations defines the max. number of device-activations
+// that can be used by DecryptUplink.
+const decryptUplinkMaxActivations = 10
+
+// DecryptUplink decrypts the FRMPayload of the stored (captured or
+// quarantined) uplink with the given frame-counter using the recorded
+// AppSKey(s) of the device.
+func (a *DeviceAPI) DecryptUplink(ctx context.Context, req *pb.DecryptDeviceUplinkRequest) (*pb.DecryptDeviceUplinkResponse, error) {
+	var devEUI lorawan.EUI64
 	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
-		return grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
 	}
 
-	if err := a.validator.Validate(srv.Context(),
-		auth.ValidateNodeAccess(devEUI, auth.Read)); err != nil {
-		return grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	activationCount := int(req.ActivationCount)
+	if activationCount == 0 {
+		activationCount = 1
+	}
+	if activationCount > decryptUplinkMaxActivations {
+		return nil, grpc.Errorf(codes.InvalidArgument, "activation_count must not exceed %d", decryptUplinkMaxActivations)
 	}
 
-	n, err := storage.GetNetworkServerForDevEUI(config.C.PostgreSQL.DB, devEUI)
+	if err := a.validator.Validate(ctx,
+		auth.ValidateIsAdmin()); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	username, err := a.validator.GetUsername(ctx)
 	if err != nil {
-		return errToRPCError(err)
+		return nil, errToRPCError(err)
 	}
 
-	nsClient, err := config.C.NetworkServer.Pool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	d, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI, false, true)
 	if err != nil {
-		return errToRPCError(err)
+		return nil, errToRPCError(err)
 	}
 
-	streamClient, err := nsClient.StreamFrameLogsForDevice(srv.Context(), &ns.StreamFrameLogsForDeviceRequest{
-		DevEui: devEUI[:],
-	})
+	app, err := storage.GetApplication(config.C.PostgreSQL.DB, d.ApplicationID, false)
 	if err != nil {
-		return err
+		return nil, errToRPCError(err)
 	}
 
-	for {
-		resp, err := streamClient.Recv()
+	// only stored uplinks can be decrypted, so that this can not be used to
+	// decrypt arbitrary payloads
+	devAddr, fPort, frmPayload, err := getStoredUplink(d.ApplicationID, devEUI, req.FCnt)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	activations, err := storage.GetDeviceActivationsForDevEUI(config.C.PostgreSQL.DB, devEUI, activationCount)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	securityevent.Log(storage.SecurityEvent{
+		Type:        securityevent.UplinkDecryption,
+		Username:    username,
+		RemoteAddr:  securityevent.RemoteAddr(ctx),
+		DevEUI:      &devEUI,
+		Description: fmt.Sprintf("uplink (f_cnt %d) of device %s decrypted using %d device-activation(s)", req.FCnt, devEUI, len(activations)),
+	})
+
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:        handler.DeviceEntity,
+		Action:        handler.DecryptUplinkAction,
+		ID:            devEUI.String(),
+		ApplicationID: d.ApplicationID,
+	})
+
+	var resp pb.DecryptDeviceUplinkResponse
+	for _, da := range activations {
+		// the frame can only have been sent using an activation with the
+		// same DevAddr
+		if da.DevAddr != devAddr {
+			continue
+		}
+
+		b, err := lorawan.EncryptFRMPayload(da.AppSKey, true, da.DevAddr, req.FCnt, frmPayload)
 		if err != nil {
-			return err
+			return nil, errToRPCError(errors.Wrap(err, "decrypt payload error"))
 		}
 
-		up, down, err := convertUplinkAndDownlinkFrames(resp.GetUplinkFrameSet(), resp.GetDownlinkFrame(), true)
+		item := pb.DecryptedDeviceUplink{
+			DevAddr:    da.DevAddr.String(),
+			FrmPayload: hex.EncodeToString(b),
+		}
+
+		item.ActivatedAt, err = ptypes.TimestampProto(da.CreatedAt)
 		if err != nil {
-			return errToRPCError(err)
+			return nil, errToRPCError(err)
 		}
 
-		var frameResp pb.StreamDeviceFrameLogsResponse
-		if up != nil {
-			frameResp.Frame = &pb.StreamDeviceFrameLogsResponse_UplinkFrame{
-				UplinkFrame: up,
+		codecPL := codec.NewPayload(app.PayloadCodec, fPort, app.PayloadEncoderScript, app.PayloadDecoderScript)
+		if codecPL != nil {
+			if err := codecPL.DecodeBytes(b); err != nil {
+				item.CodecError = err.Error()
+				if scriptErr, ok := err.(*codec.ScriptError); ok {
+					item.CodecErrorDetails = &pb.CodecErrorDetails{
+						Message:    scriptErr.Message,
+						Line:       uint32(scriptErr.Line),
+						Column:     uint32(scriptErr.Column),
+						StackTrace: scriptErr.StackTrace,
+						Input:      scriptErr.Input,
+					}
+				}
+			} else {
+				object, err := app.FieldMappings.Apply(codecPL.Object())
+				if err != nil {
+					item.CodecError = errors.Wrap(err, "apply field mappings error").Error()
+					object = codecPL.Object()
+				}
+
+				objB, err := json.Marshal(object)
+				if err != nil {
+					return nil, errToRPCError(errors.Wrap(err, "marshal json error"))
+				}
+				item.ObjectJson = string(objB)
 			}
 		}
 
-		if down != nil {
-			frameResp.Frame = &pb.StreamDeviceFrameLogsResponse_DownlinkFrame{
-				DownlinkFrame: down,
+		resp.Result = append(resp.Result, &item)
+	}
+
+	return &resp, nil
+}
+
+// getStoredUplink returns the DevAddr, FPort and encrypted FRMPayload of
+// the uplink of the given device with the given frame-counter. The uplink is
+// looked up in the frame capture of the device and in the decryption error
+// quarantine of the application.
+func getStoredUplink(applicationID int64, devEUI lorawan.EUI64, fCnt uint32) (lorawan.DevAddr, uint8, []byte, error) {
+	macPL, err := framecapture.GetUplinkMACPayload(config.C.Redis.Pool, devEUI, fCnt)
+	if err == nil {
+		var frmPayload []byte
+		if len(macPL.FRMPayload) != 0 {
+			dataPL, ok := macPL.FRMPayload[0].(*lorawan.DataPayload)
+			if !ok {
+				return lorawan.DevAddr{}, 0, nil, fmt.Errorf("expected *lorawan.DataPayload, got: %T", macPL.FRMPayload[0])
 			}
+			frmPayload = dataPL.Bytes
 		}
+		return macPL.FHDR.DevAddr, *macPL.FPort, frmPayload, nil
+	}
+	if errors.Cause(err) != framecapture.ErrFrameDoesNotExist {
+		return lorawan.DevAddr{}, 0, nil, err
+	}
 
-		err = srv.Send(&frameResp)
-		if err != nil {
-			return err
+	frames, err := quarantine.Get(config.C.Redis.Pool, applicationID, config.C.ApplicationServer.DecryptQuarantine.MaxFrames)
+	if err != nil {
+		return lorawan.DevAddr{}, 0, nil, err
+	}
+	for _, f := range frames {
+		if f.DevEUI == devEUI && f.FCnt == fCnt {
+			return f.DevAddr, f.FPort, f.Data, nil
 		}
 	}
+
+	return lorawan.DevAddr{}, 0, nil, framecapture.ErrFrameDoesNotExist
 }
 
-// StreamEventLogs stream the device events (uplink payloads, ACKs, joins, errors).
-// Note: this endpoint is intended for debugging and should not be used for building
-// integrations.
-func (a *DeviceAPI) StreamEventLogs(req *pb.StreamDeviceEventLogsRequest, srv pb.DeviceService_StreamEventLogsServer) error {
-	var devEUI lorawan.EUI64
+func wrapAppSKeyWithKEK(kekStr string, key lorawan.AES128Key) ([]byte, error) {
+	kek, err := hex.DecodeString(kekStr)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "kek: %s", err)
+	}
 
-	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
-		return grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "kek: %s", err)
 	}
 
-	if err := a.validator.Validate(srv.Context(),
-		auth.ValidateNodeAccess(devEUI, auth.Read)); err != nil {
-		return grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	b, err := keywrap.Wrap(block, key[:])
+	if err != nil {
+		return nil, errToRPCError(errors.Wrap(err, "key wrap error"))
 	}
 
-	eventLogChan := make(chan eventlog.EventLog)
-	go func() {
-		err := eventlog.GetEventLogForDevice(srv.Context(), devEUI, eventLogChan)
-		if err != nil {
-			log.WithError(err).Error("get event-log for device error")
-		}
-		close(eventLogChan)
-	}()
+	return b, nil
+}
 
-	for el := range eventLogChan {
-		b, err := json.Marshal(el.Payload)
-		if err != nil {
-			return grpc.Errorf(codes.Internal, "marshal json error: %s", err)
-		}
+func encryptAppSKeyWithPublicKey(publicKey string, key lorawan.AES128Key) ([]byte, error) {
+	block, _ := pem.Decode([]byte(publicKey))
+	if block == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "public_key: pem decode error")
+	}
 
-		resp := pb.StreamDeviceEventLogsResponse{
-			Type:        el.Type,
-			PayloadJson: string(b),
-		}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "public_key: %s", err)
+	}
 
-		err = srv.Send(&resp)
-		if err != nil {
-			log.WithError(err).Error("error sending event-log response")
-		}
+	rsaPub, ok := pub.(*rsa.PublicKey)
+	if !ok {
+		return nil, grpc.Errorf(codes.InvalidArgument, "public_key: rsa public key expected")
 	}
 
-	return nil
+	b, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, rsaPub, key[:], nil)
+	if err != nil {
+		return nil, errToRPCError(errors.Wrap(err, "encrypt error"))
+	}
+
+	return b, nil
 }
 
-// GetRandomDevAddr returns a random DevAddr taking the NwkID prefix into account.
-func (a *DeviceAPI) GetRandomDevAddr(ctx context.Context, req *pb.GetRandomDevAddrRequest) (*pb.GetRandomDevAddrResponse, error) {
+// Deactivate de-activates the device. It removes the device-activation(s)
+// and instructs the network-server to drop the device-session.
+func (a *DeviceAPI) Deactivate(ctx context.Context, req *pb.DeactivateDeviceRequest) (*empty.Empty, error) {
 	var devEUI lorawan.EUI64
-
 	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
 	}
 
-	n, err := storage.GetNetworkServerForDevEUI(config.C.PostgreSQL.DB, devEUI)
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Update)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	d, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI, false, true)
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
 
-	nsClient, err := config.C.NetworkServer.Pool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	n, err := storage.GetNetworkServerForDevEUI(config.C.PostgreSQL.DB, devEUI)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	nsClient, err := config.C.NetworkServer.Pool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		if err := storage.DeleteDeviceActivationsForDevice(tx, d.DevEUI); err != nil {
+			return err
+		}
+
+		_, err := nsClient.DeactivateDevice(context.Background(), &ns.DeactivateDeviceRequest{
+			DevEui: d.DevEUI[:],
+		})
+		if err != nil && grpc.Code(err) != codes.NotFound {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	log.WithField("dev_eui", d.DevEUI).Info("device deactivated")
+
+	return &empty.Empty{}, nil
+}
+
+// ListDevNonces returns the DevNonces used by the given device.
+func (a *DeviceAPI) ListDevNonces(ctx context.Context, req *pb.ListDeviceDevNoncesRequest) (*pb.ListDeviceDevNoncesResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Read)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	dns, err := storage.GetDeviceDevNoncesForDevEUI(config.C.PostgreSQL.DB, devEUI)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	var resp pb.ListDeviceDevNoncesResponse
+	for _, dn := range dns {
+		item := pb.DeviceDevNonce{
+			DevNonce: uint32(dn.DevNonce),
+		}
+
+		item.CreatedAt, err = ptypes.TimestampProto(dn.CreatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		resp.Result = append(resp.Result, &item)
+	}
+
+	return &resp, nil
+}
+
+// GetJoinDiagnostics returns the recent join attempts of the given device
+// and the probable causes in case the device fails to join.
+func (a *DeviceAPI) GetJoinDiagnostics(ctx context.Context, req *pb.GetDeviceJoinDiagnosticsRequest) (*pb.GetDeviceJoinDiagnosticsResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Read)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	d, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI, false, true)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	_, err = storage.GetLastDeviceActivationForDevEUI(config.C.PostgreSQL.DB, devEUI)
+	if err != nil && errors.Cause(err) != storage.ErrDoesNotExist {
+		return nil, errToRPCError(err)
+	}
+
+	state := jointrace.DeviceState{
+		Activated:  err == nil,
+		LastSeenAt: d.LastSeenAt,
+	}
+
+	resp := pb.GetDeviceJoinDiagnosticsResponse{
+		Activated: state.Activated,
+	}
+
+	if d.LastSeenAt != nil {
+		resp.LastSeenAt, err = ptypes.TimestampProto(*d.LastSeenAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	attempts, err := jointrace.Get(config.C.Redis.Pool, devEUI)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	for _, at := range attempts {
+		item := pb.DeviceJoinAttempt{
+			JoinType: at.JoinType,
+			Result:   string(at.Result),
+			Error:    at.Error,
+		}
+
+		if at.DevNonce != nil {
+			item.DevNonce = uint32(*at.DevNonce)
+			item.HasDevNonce = true
+		}
+
+		item.Time, err = ptypes.TimestampProto(at.Time)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		resp.Attempts = append(resp.Attempts, &item)
+	}
+
+	for _, diag := range jointrace.Diagnose(attempts, state) {
+		resp.Diagnoses = append(resp.Diagnoses, &pb.DeviceJoinDiagnosis{
+			Cause:       string(diag.Cause),
+			Count:       uint32(diag.Count),
+			Description: diag.Description,
+		})
+	}
+
+	return &resp, nil
+}
+
+// DeleteDevNonces deletes the DevNonces used by the given device.
+func (a *DeviceAPI) DeleteDevNonces(ctx context.Context, req *pb.DeleteDeviceDevNoncesRequest) (*empty.Empty, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Update)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteDeviceDevNoncesForDevEUI(config.C.PostgreSQL.DB, devEUI); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// GetTwin returns the device twin.
+func (a *DeviceAPI) GetTwin(ctx context.Context, req *pb.GetDeviceTwinRequest) (*pb.GetDeviceTwinResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Read)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	// the twin is returned empty when it does not exist, make sure that
+	// the device exists
+	if _, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI, false, true); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	twin, err := storage.GetDeviceTwin(config.C.PostgreSQL.DB, devEUI, false)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.GetDeviceTwinResponse{
+		Version: twin.Version,
+	}
+
+	if !twin.UpdatedAt.IsZero() {
+		resp.UpdatedAt, err = ptypes.TimestampProto(twin.UpdatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	for _, doc := range []struct {
+		dst **structpb.Struct
+		src storage.DeviceTwinDocument
+	}{
+		{&resp.Reported, twin.Reported},
+		{&resp.ReportedMetadata, twin.ReportedMetadata},
+		{&resp.Desired, twin.Desired},
+		{&resp.DesiredMetadata, twin.DesiredMetadata},
+		{&resp.Delta, devicetwin.Delta(twin.Desired, twin.Reported)},
+	} {
+		*doc.dst, err = twinDocumentToStruct(doc.src)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	return &resp, nil
+}
+
+// UpdateTwinDesiredState updates the desired state of the device twin and
+// enqueues the delta with the reported state as downlink.
+func (a *DeviceAPI) UpdateTwinDesiredState(ctx context.Context, req *pb.UpdateDeviceTwinDesiredStateRequest) (*pb.UpdateDeviceTwinDesiredStateResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDeviceQueueAccess(devEUI, auth.Create)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if req.Desired == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "desired must not be nil")
+	}
+
+	update, err := structToTwinDocument(req.Desired)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "desired: %s", err)
+	}
+
+	var resp pb.UpdateDeviceTwinDesiredStateResponse
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		// Lock the device to avoid concurrent enqueue actions for the same
+		// device as this would result in re-use of the same frame-counter.
+		d, err := storage.GetDevice(tx, devEUI, true, true)
+		if err != nil {
+			return errToRPCError(err)
+		}
+
+		twin, err := storage.GetDeviceTwin(tx, devEUI, true)
+		if err != nil {
+			return errToRPCError(err)
+		}
+
+		if req.Version != 0 && req.Version != twin.Version {
+			return grpc.Errorf(codes.FailedPrecondition, "version mismatch, the current version is %d", twin.Version)
+		}
+
+		devicetwin.Merge(twin.Desired, twin.DesiredMetadata, update, time.Now())
+		if err := storage.SetDeviceTwin(tx, &twin); err != nil {
+			return errToRPCError(err)
+		}
+
+		delta := devicetwin.Delta(twin.Desired, twin.Reported)
+
+		resp.Version = twin.Version
+		resp.Delta, err = twinDocumentToStruct(delta)
+		if err != nil {
+			return errToRPCError(err)
+		}
+
+		if len(delta) == 0 {
+			return nil
+		}
+
+		if req.FPort == 0 {
+			return grpc.Errorf(codes.InvalidArgument, "f_port must be > 0")
+		}
+
+		app, err := storage.GetApplication(tx, d.ApplicationID, false)
+		if err != nil {
+			return errToRPCError(err)
+		}
+
+		codecPL := codec.NewPayload(app.PayloadCodec, uint8(req.FPort), app.PayloadEncoderScript, app.PayloadDecoderScript)
+		if codecPL == nil {
+			return grpc.Errorf(codes.FailedPrecondition, "no or invalid codec configured for application")
+		}
+
+		b, err := json.Marshal(delta)
+		if err != nil {
+			return errToRPCError(err)
+		}
+
+		if err := json.Unmarshal(b, &codecPL); err != nil {
+			return errToRPCError(err)
+		}
+
+		data, err := codecPL.EncodeToBytes()
+		if err != nil {
+			return errToRPCError(err)
+		}
+
+		resp.FCnt, err = downlink.EnqueueDownlinkPayload(tx, devEUI, req.Confirmed, uint8(req.FPort), data)
+		if err != nil {
+			return grpc.Errorf(codes.Internal, "enqueue downlink payload error: %s", err)
+		}
+		resp.Enqueued = true
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetTrack returns the location track of the given device within the given
+// time-range.
+func (a *DeviceAPI) GetTrack(ctx context.Context, req *pb.GetDeviceTrackRequest) (*pb.GetDeviceTrackResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Export)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	var start, end time.Time
+	var err error
+
+	if req.StartTimestamp != nil {
+		start, err = ptypes.Timestamp(req.StartTimestamp)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "startTimestamp: %s", err)
+		}
+	}
+
+	if req.EndTimestamp != nil {
+		end, err = ptypes.Timestamp(req.EndTimestamp)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "endTimestamp: %s", err)
+		}
+	}
+
+	filters := storage.DeviceLocationFilters{
+		Start: start,
+		End:   end,
+		Limit: int(req.Limit),
+	}
+
+	if req.Cursor != "" {
+		var c deviceTrackCursor
+		if err := decodeCursor(req.Cursor, deviceTrackCursorKind, &c); err != nil {
+			return nil, err
+		}
+
+		filters.AfterCreatedAt = c.CreatedAt
+		filters.AfterID = c.ID
+	}
+
+	if _, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI, false, true); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	dls, err := storage.GetDeviceLocationsForDevEUI(config.C.PostgreSQL.DB, devEUI, filters)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	var resp pb.GetDeviceTrackResponse
+	for _, dl := range dls {
+		item := pb.DeviceTrackPoint{
+			Location: &common.Location{
+				Latitude:  dl.Latitude,
+				Longitude: dl.Longitude,
+				Altitude:  dl.Altitude,
+				Accuracy:  uint32(dl.Accuracy),
+				Source:    common.LocationSource(common.LocationSource_value[dl.Source]),
+			},
+		}
+
+		item.CreatedAt, err = ptypes.TimestampProto(dl.CreatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		resp.Result = append(resp.Result, &item)
+	}
+
+	resp.GeoJson, err = deviceTrackToGeoJSON(devEUI, dls)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	// a full page means that there might be more locations
+	if len(dls) != 0 && len(dls) == filters.Limit {
+		last := dls[len(dls)-1]
+		resp.NextCursor, err = encodeCursor(deviceTrackCursorKind, deviceTrackCursor{
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		})
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	return &resp, nil
+}
+
+// StartFrameCapture starts a raw frame capture for the given device.
+func (a *DeviceAPI) StartFrameCapture(ctx context.Context, req *pb.StartDeviceFrameCaptureRequest) (*empty.Empty, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Update)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	conf := config.C.ApplicationServer.FrameCapture
+
+	duration := time.Duration(req.Duration) * time.Second
+	if duration == 0 || duration > conf.MaxDuration {
+		duration = conf.MaxDuration
+	}
+
+	n, err := storage.GetNetworkServerForDevEUI(config.C.PostgreSQL.DB, devEUI)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	nsClient, err := config.C.NetworkServer.Pool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	capture, err := framecapture.Start(config.C.Redis.Pool, devEUI, duration, conf.Retention)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	go func() {
+		if err := framecapture.Run(context.Background(), config.C.Redis.Pool, nsClient, devEUI, capture, conf.MaxFrames, conf.Retention); err != nil {
+			log.WithError(err).WithField("dev_eui", devEUI).Error("frame capture error")
+		}
+	}()
+
+	log.WithFields(log.Fields{
+		"dev_eui":  devEUI,
+		"duration": duration,
+	}).Info("frame capture started")
+
+	return &empty.Empty{}, nil
+}
+
+// StopFrameCapture stops the raw frame capture of the given device.
+func (a *DeviceAPI) StopFrameCapture(ctx context.Context, req *pb.StopDeviceFrameCaptureRequest) (*empty.Empty, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Update)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := framecapture.Stop(config.C.Redis.Pool, devEUI, config.C.ApplicationServer.FrameCapture.Retention); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// GetFrameCapture returns the raw frame capture of the given device,
+// including the captured frames.
+func (a *DeviceAPI) GetFrameCapture(ctx context.Context, req *pb.GetDeviceFrameCaptureRequest) (*pb.GetDeviceFrameCaptureResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Export)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	capture, err := framecapture.Get(config.C.Redis.Pool, devEUI)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	frames, err := framecapture.GetFrames(config.C.Redis.Pool, devEUI)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.GetDeviceFrameCaptureResponse{
+		Active: capture.Active(),
+	}
+
+	resp.StartedAt, err = ptypes.TimestampProto(capture.StartedAt)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp.EndsAt, err = ptypes.TimestampProto(capture.EndsAt)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	for _, f := range frames {
+		var up *gw.UplinkFrameSet
+		var down *gw.DownlinkFrame
+
+		if len(f.UplinkFrameSet) != 0 {
+			up = &gw.UplinkFrameSet{}
+			if err := proto.Unmarshal(f.UplinkFrameSet, up); err != nil {
+				return nil, errToRPCError(errors.Wrap(err, "unmarshal uplink frame-set error"))
+			}
+		}
+
+		if len(f.DownlinkFrame) != 0 {
+			down = &gw.DownlinkFrame{}
+			if err := proto.Unmarshal(f.DownlinkFrame, down); err != nil {
+				return nil, errToRPCError(errors.Wrap(err, "unmarshal downlink frame error"))
+			}
+		}
+
+		var item pb.CapturedFrame
+		item.UplinkFrame, item.DownlinkFrame, err = convertUplinkAndDownlinkFrames(up, down, true)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		item.ReceivedAt, err = ptypes.TimestampProto(f.ReceivedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		resp.Frames = append(resp.Frames, &item)
+	}
+
+	return &resp, nil
+}
+
+// ExportData exports all data stored for the given device.
+func (a *DeviceAPI) ExportData(ctx context.Context, req *pb.ExportDeviceDataRequest) (*pb.ExportDeviceDataResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Export)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	export, err := storage.ExportDeviceData(config.C.PostgreSQL.DB, devEUI)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	// the keys are only exported to users allowed to view keys, the session
+	// keys only to users allowed by the session-key policy
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.ReadKeys)); err != nil {
+		export.Keys = nil
+	}
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.ReadSessionKeys)); err != nil {
+		for i := range export.Activations {
+			export.Activations[i].AppSKey = lorawan.AES128Key{}
+		}
+	} else if len(export.Activations) != 0 {
+		logSessionKeyAccess(ctx, a.validator, devEUI, fmt.Sprintf("session keys of device %s exported", devEUI))
+	}
+
+	b, err := json.Marshal(export)
+	if err != nil {
+		return nil, errToRPCError(errors.Wrap(err, "marshal json error"))
+	}
+
+	return &pb.ExportDeviceDataResponse{
+		DataJson: string(b),
+	}, nil
+}
+
+// EraseData irreversibly erases all data stored for the given device.
+func (a *DeviceAPI) EraseData(ctx context.Context, req *pb.EraseDeviceDataRequest) (*empty.Empty, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateNodeAccess(devEUI, auth.Delete)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	// as this also performs a remote call to delete the node from the
+	// network-server, wrap it in a transaction
+	var d storage.Device
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		var err error
+		d, err = storage.GetDevice(tx, devEUI, true, true)
+		if err != nil {
+			return err
+		}
+
+		_, err = storage.EraseDeviceData(tx, devEUI)
+		return err
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	// the Redis data is deleted after the transaction has been committed,
+	// so that it is not deleted when the transaction is rolled back
+	if err := storage.DeleteDeviceRedisData(config.C.Redis.Pool, d.ApplicationID, devEUI); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:        handler.DeviceEntity,
+		Action:        handler.DeleteAction,
+		ID:            devEUI.String(),
+		ApplicationID: d.ApplicationID,
+	})
+
+	return &empty.Empty{}, nil
+}
+
+// SetLegalHold places the given device under legal hold.
+func (a *DeviceAPI) SetLegalHold(ctx context.Context, req *pb.SetDeviceLegalHoldRequest) (*empty.Empty, error) {
+	return a.setLegalHold(ctx, req.DevEui, true)
+}
+
+// ClearLegalHold clears the legal hold of the given device.
+func (a *DeviceAPI) ClearLegalHold(ctx context.Context, req *pb.ClearDeviceLegalHoldRequest) (*empty.Empty, error) {
+	return a.setLegalHold(ctx, req.DevEui, false)
+}
+
+func (a *DeviceAPI) setLegalHold(ctx context.Context, devEUIStr string, hold bool) (*empty.Empty, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(devEUIStr)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateIsAdmin()); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	var d storage.Device
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		var err error
+		d, err = storage.GetDevice(tx, devEUI, true, true)
+		if err != nil {
+			return err
+		}
+
+		return storage.SetDeviceLegalHold(tx, devEUI, hold)
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	username, _ := a.validator.GetUsername(ctx)
+	securityevent.Log(storage.SecurityEvent{
+		Type:        securityevent.LegalHoldChange,
+		Username:    username,
+		RemoteAddr:  securityevent.RemoteAddr(ctx),
+		DevEUI:      &devEUI,
+		Description: fmt.Sprintf("legal hold %s for device %s", legalHoldAction(hold), devEUI),
+	})
+
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:        handler.DeviceEntity,
+		Action:        handler.UpdateAction,
+		ID:            devEUI.String(),
+		ApplicationID: d.ApplicationID,
+	})
+
+	return &empty.Empty{}, nil
+}
+
+// StreamFrameLogs streams the uplink and downlink frame-logs for the given DevEUI.
+// Note: these are the raw LoRaWAN frames and this endpoint is intended for debugging.
+func (a *DeviceAPI) StreamFrameLogs(req *pb.StreamDeviceFrameLogsRequest, srv pb.DeviceService_StreamFrameLogsServer) error {
+	var devEUI lorawan.EUI64
+
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(srv.Context(),
+		auth.ValidateNodeAccess(devEUI, auth.Read)); err != nil {
+		return grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	n, err := storage.GetNetworkServerForDevEUI(config.C.PostgreSQL.DB, devEUI)
+	if err != nil {
+		return errToRPCError(err)
+	}
+
+	nsClient, err := config.C.NetworkServer.Pool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return errToRPCError(err)
+	}
+
+	streamClient, err := nsClient.StreamFrameLogsForDevice(srv.Context(), &ns.StreamFrameLogsForDeviceRequest{
+		DevEui: devEUI[:],
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := streamClient.Recv()
+		if err != nil {
+			return err
+		}
+
+		up, down, err := convertUplinkAndDownlinkFrames(resp.GetUplinkFrameSet(), resp.GetDownlinkFrame(), true)
+		if err != nil {
+			return errToRPCError(err)
+		}
+
+		var frameResp pb.StreamDeviceFrameLogsResponse
+		if up != nil {
+			frameResp.Frame = &pb.StreamDeviceFrameLogsResponse_UplinkFrame{
+				UplinkFrame: up,
+			}
+		}
+
+		if down != nil {
+			frameResp.Frame = &pb.StreamDeviceFrameLogsResponse_DownlinkFrame{
+				DownlinkFrame: down,
+			}
+		}
+
+		err = srv.Send(&frameResp)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// StreamEventLogs stream the device events (uplink payloads, ACKs, joins, errors).
+// Note: this endpoint is intended for debugging and should not be used for building
+// integrations.
+// When a resume token is given, the buffered events after the event of the
+// token are sent first.
+func (a *DeviceAPI) StreamEventLogs(req *pb.StreamDeviceEventLogsRequest, srv pb.DeviceService_StreamEventLogsServer) error {
+	var devEUI lorawan.EUI64
+
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(srv.Context(),
+		auth.ValidateNodeAccess(devEUI, auth.Read)); err != nil {
+		return grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	var resume deviceEventCursor
+	if req.ResumeToken != "" {
+		if err := decodeCursor(req.ResumeToken, deviceEventCursorKind, &resume); err != nil {
+			return err
+		}
+		if resume.DevEUI != devEUI {
+			return grpc.Errorf(codes.InvalidArgument, "cursor: invalid cursor for this device")
+		}
+	}
+
+	eventLogChan := make(chan eventlog.EventLog)
+	go func() {
+		err := eventlog.GetEventLogForDevice(srv.Context(), devEUI, resume.Seq, eventLogChan)
+		if err != nil {
+			log.WithError(err).Error("get event-log for device error")
+		}
+		close(eventLogChan)
+	}()
+
+	for el := range eventLogChan {
+		b, err := json.Marshal(el.Payload)
+		if err != nil {
+			return grpc.Errorf(codes.Internal, "marshal json error: %s", err)
+		}
+
+		resp := pb.StreamDeviceEventLogsResponse{
+			Type:        el.Type,
+			PayloadJson: string(b),
+			Seq:         el.Seq,
+		}
+
+		resp.ResumeToken, err = encodeCursor(deviceEventCursorKind, deviceEventCursor{
+			DevEUI: devEUI,
+			Seq:    el.Seq,
+		})
+		if err != nil {
+			return errToRPCError(err)
+		}
+
+		err = srv.Send(&resp)
+		if err != nil {
+			log.WithError(err).Error("error sending event-log response")
+		}
+	}
+
+	return nil
+}
+
+// GetRandomDevAddr returns a random DevAddr taking the NwkID prefix into account.
+func (a *DeviceAPI) GetRandomDevAddr(ctx context.Context, req *pb.GetRandomDevAddrRequest) (*pb.GetRandomDevAddrResponse, error) {
+	var devEUI lorawan.EUI64
+
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	n, err := storage.GetNetworkServerForDevEUI(config.C.PostgreSQL.DB, devEUI)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	nsClient, err := config.C.NetworkServer.Pool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
@@ -767,44 +1950,46 @@ func (a *DeviceAPI) returnList(count int, devices []storage.DeviceListItem) (*pb
 }
 
 func convertUplinkAndDownlinkFrames(up *gw.UplinkFrameSet, down *gw.DownlinkFrame, decodeMACCommands bool) (*pb.UplinkFrameLog, *pb.DownlinkFrameLog, error) {
-	var phy lorawan.PHYPayload
-
+	var phyPayload []byte
 	if up != nil {
-		if err := phy.UnmarshalBinary(up.PhyPayload); err != nil {
-			return nil, nil, errors.Wrap(err, "unmarshal phypayload error")
-		}
+		phyPayload = up.PhyPayload
 	}
-
 	if down != nil {
-		if err := phy.UnmarshalBinary(down.PhyPayload); err != nil {
-			return nil, nil, errors.Wrap(err, "unmarshal phypayload error")
-		}
+		phyPayload = down.PhyPayload
 	}
 
-	if decodeMACCommands {
-		switch v := phy.MACPayload.(type) {
-		case *lorawan.MACPayload:
-			if err := phy.DecodeFOptsToMACCommands(); err != nil {
-				return nil, nil, errors.Wrap(err, "decode fopts to mac-commands error")
-			}
+	// Frames which can not be decoded (e.g. proprietary or corrupted frames
+	// relayed by the gateway) are returned without the decoded PHYPayload,
+	// so that they do not end the stream.
+	var phyJSON []byte
+	var phy lorawan.PHYPayload
+	if err := phy.UnmarshalBinary(phyPayload); err == nil {
+		if decodeMACCommands {
+			switch v := phy.MACPayload.(type) {
+			case *lorawan.MACPayload:
+				if err := phy.DecodeFOptsToMACCommands(); err != nil {
+					return nil, nil, errors.Wrap(err, "decode fopts to mac-commands error")
+				}
 
-			if v.FPort != nil && *v.FPort == 0 {
-				if err := phy.DecodeFRMPayloadToMACCommands(); err != nil {
-					return nil, nil, errors.Wrap(err, "decode frmpayload to mac-commands error")
+				if v.FPort != nil && *v.FPort == 0 {
+					if err := phy.DecodeFRMPayloadToMACCommands(); err != nil {
+						return nil, nil, errors.Wrap(err, "decode frmpayload to mac-commands error")
+					}
 				}
 			}
 		}
-	}
 
-	phyJSON, err := json.Marshal(phy)
-	if err != nil {
-		return nil, nil, errors.Wrap(err, "marshal phypayload error")
+		phyJSON, err = json.Marshal(phy)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "marshal phypayload error")
+		}
 	}
 
 	if up != nil {
 		uplinkFrameLog := pb.UplinkFrameLog{
 			TxInfo:         up.TxInfo,
 			PhyPayloadJson: string(phyJSON),
+			PhyPayload:     up.PhyPayload,
 		}
 
 		for _, rxInfo := range up.RxInfo {
@@ -856,6 +2041,7 @@ func convertUplinkAndDownlinkFrames(up *gw.UplinkFrameSet, down *gw.DownlinkFram
 	if down != nil {
 		downlinkFrameLog := pb.DownlinkFrameLog{
 			PhyPayloadJson: string(phyJSON),
+			PhyPayload:     down.PhyPayload,
 		}
 
 		if down.TxInfo != nil {
@@ -892,3 +2078,91 @@ func convertUplinkAndDownlinkFrames(up *gw.UplinkFrameSet, down *gw.DownlinkFram
 
 	return nil, nil, nil
 }
+
+// deviceTrackToGeoJSON returns the given device-locations as GeoJSON Feature
+// with LineString geometry.
+func deviceTrackToGeoJSON(devEUI lorawan.EUI64, dls []storage.DeviceLocation) (*structpb.Struct, error) {
+	coordinates := make([][]float64, 0, len(dls))
+	timestamps := make([]time.Time, 0, len(dls))
+	sources := make([]string, 0, len(dls))
+	accuracies := make([]int, 0, len(dls))
+
+	for _, dl := range dls {
+		coordinates = append(coordinates, []float64{dl.Longitude, dl.Latitude, dl.Altitude})
+		timestamps = append(timestamps, dl.CreatedAt)
+		sources = append(sources, dl.Source)
+		accuracies = append(accuracies, dl.Accuracy)
+	}
+
+	feature := map[string]interface{}{
+		"type": "Feature",
+		"geometry": map[string]interface{}{
+			"type":        "LineString",
+			"coordinates": coordinates,
+		},
+		"properties": map[string]interface{}{
+			"devEUI":     devEUI,
+			"coordTimes": timestamps,
+			"sources":    sources,
+			"accuracies": accuracies,
+		},
+	}
+
+	b, err := json.Marshal(feature)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal json error")
+	}
+
+	var out structpb.Struct
+	if err := jsonpb.UnmarshalString(string(b), &out); err != nil {
+		return nil, errors.Wrap(err, "unmarshal geojson error")
+	}
+
+	return &out, nil
+}
+
+func twinDocumentToStruct(doc storage.DeviceTwinDocument) (*structpb.Struct, error) {
+	if doc == nil {
+		doc = storage.DeviceTwinDocument{}
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal json error")
+	}
+
+	var out structpb.Struct
+	if err := jsonpb.UnmarshalString(string(b), &out); err != nil {
+		return nil, errors.Wrap(err, "unmarshal struct error")
+	}
+
+	return &out, nil
+}
+
+func structToTwinDocument(s *structpb.Struct) (storage.DeviceTwinDocument, error) {
+	var m jsonpb.Marshaler
+	str, err := m.MarshalToString(s)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal struct error")
+	}
+
+	var doc storage.DeviceTwinDocument
+	if err := json.Unmarshal([]byte(str), &doc); err != nil {
+		return nil, errors.Wrap(err, "unmarshal json error")
+	}
+
+	return doc, nil
+}
+
+// logSessionKeyAccess logs a security event for the retrieval of the
+// session key(s) of the given device.
+func logSessionKeyAccess(ctx context.Context, validator auth.Validator, devEUI lorawan.EUI64, description string) {
+	username, _ := validator.GetUsername(ctx)
+	securityevent.Log(storage.SecurityEvent{
+		Type:        securityevent.SessionKeyAccess,
+		Username:    username,
+		RemoteAddr:  securityevent.RemoteAddr(ctx),
+		DevEUI:      &devEUI,
+		Description: description,
+	})
+}
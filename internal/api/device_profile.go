@@ -1,6 +1,8 @@
 package api
 
 import (
+	"encoding/hex"
+
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/gofrs/uuid"
@@ -42,7 +44,14 @@ func (a *DeviceProfileServiceAPI) Create(ctx context.Context, req *pb.CreateDevi
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	joinAccept, err := joinAcceptFromPB(req.DeviceProfile.JoinAccept)
+	if err != nil {
+		return nil, err
+	}
+
 	dp := storage.DeviceProfile{
+		JoinAccept:      joinAccept,
+		FPorts:          fPortsFromPB(req.DeviceProfile.FPorts),
 		OrganizationID:  req.DeviceProfile.OrganizationId,
 		NetworkServerID: req.DeviceProfile.NetworkServerId,
 		Name:            req.DeviceProfile.Name,
@@ -71,7 +80,7 @@ func (a *DeviceProfileServiceAPI) Create(ctx context.Context, req *pb.CreateDevi
 
 	// as this also performs a remote call to create the device-profile
 	// on the network-server, wrap it in a transaction
-	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
 		return storage.CreateDeviceProfile(tx, &dp)
 	})
 	if err != nil {
@@ -131,6 +140,8 @@ func (a *DeviceProfileServiceAPI) Get(ctx context.Context, req *pb.GetDeviceProf
 			RfRegion:           dp.DeviceProfile.RfRegion,
 			Supports_32BitFCnt: dp.DeviceProfile.Supports_32BitFCnt,
 			FactoryPresetFreqs: dp.DeviceProfile.FactoryPresetFreqs,
+			JoinAccept:         joinAcceptToPB(dp.JoinAccept),
+			FPorts:             fPortsToPB(dp.FPorts),
 		},
 	}
 
@@ -168,6 +179,12 @@ func (a *DeviceProfileServiceAPI) Update(ctx context.Context, req *pb.UpdateDevi
 		return nil, errToRPCError(err)
 	}
 
+	dp.JoinAccept, err = joinAcceptFromPB(req.DeviceProfile.JoinAccept)
+	if err != nil {
+		return nil, err
+	}
+
+	dp.FPorts = fPortsFromPB(req.DeviceProfile.FPorts)
 	dp.Name = req.DeviceProfile.Name
 	dp.DeviceProfile = ns.DeviceProfile{
 		Id:                 dpID.Bytes(),
@@ -328,3 +345,58 @@ func (a *DeviceProfileServiceAPI) List(ctx context.Context, req *pb.ListDevicePr
 
 	return &resp, nil
 }
+
+func joinAcceptFromPB(ja *pb.DeviceProfileJoinAccept) (*storage.JoinAccept, error) {
+	if ja == nil {
+		return nil, nil
+	}
+
+	cFList, err := hex.DecodeString(ja.CfList)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "cfList: %s", err)
+	}
+
+	return &storage.JoinAccept{
+		RXDelay:     int(ja.RxDelay),
+		RX1DROffset: int(ja.Rx1DrOffset),
+		RX2DR:       int(ja.Rx2Dr),
+		CFList:      cFList,
+	}, nil
+}
+
+func joinAcceptToPB(ja *storage.JoinAccept) *pb.DeviceProfileJoinAccept {
+	if ja == nil {
+		return nil
+	}
+
+	return &pb.DeviceProfileJoinAccept{
+		RxDelay:     uint32(ja.RXDelay),
+		Rx1DrOffset: uint32(ja.RX1DROffset),
+		Rx2Dr:       uint32(ja.RX2DR),
+		CfList:      hex.EncodeToString(ja.CFList),
+	}
+}
+
+func fPortsFromPB(fPorts []*pb.DeviceProfileFPort) storage.FPorts {
+	var out storage.FPorts
+	for _, p := range fPorts {
+		out = append(out, storage.FPort{
+			FPort:       int(p.FPort),
+			Label:       p.Label,
+			Description: p.Description,
+		})
+	}
+	return out
+}
+
+func fPortsToPB(fPorts storage.FPorts) []*pb.DeviceProfileFPort {
+	var out []*pb.DeviceProfileFPort
+	for _, p := range fPorts {
+		out = append(out, &pb.DeviceProfileFPort{
+			FPort:       uint32(p.FPort),
+			Label:       p.Label,
+			Description: p.Description,
+		})
+	}
+	return out
+}
@@ -3,8 +3,10 @@ package api
 import (
 	"encoding/json"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -13,7 +15,9 @@ import (
 	"github.com/brocaar/lora-app-server/internal/api/auth"
 	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/correlation"
 	"github.com/brocaar/lora-app-server/internal/downlink"
+	"github.com/brocaar/lora-app-server/internal/downlinktrace"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/loraserver/api/ns"
 	"github.com/brocaar/lorawan"
@@ -63,7 +67,7 @@ func (d *DeviceQueueAPI) Enqueue(ctx context.Context, req *pb.EnqueueDeviceQueue
 
 		// if JSON object is set, try to encode it to bytes
 		if req.DeviceQueueItem.JsonObject != "" {
-			app, err := storage.GetApplication(config.C.PostgreSQL.DB, dev.ApplicationID)
+			app, err := storage.GetApplication(config.C.PostgreSQL.DB, dev.ApplicationID, false)
 			if err != nil {
 				return errToRPCError(err)
 			}
@@ -129,6 +133,12 @@ func (d *DeviceQueueAPI) Flush(ctx context.Context, req *pb.FlushDeviceQueueRequ
 		return nil, err
 	}
 
+	if err := downlinktrace.Log(config.C.Redis.Pool, devEUI, downlinktrace.Event{
+		Type: downlinktrace.Flushed,
+	}); err != nil {
+		correlation.Log(ctx).WithError(err).WithField("dev_eui", devEUI).Error("log downlink trace event error")
+	}
+
 	return &empty.Empty{}, nil
 }
 
@@ -144,6 +154,14 @@ func (d *DeviceQueueAPI) List(ctx context.Context, req *pb.ListDeviceQueueItemsR
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	var after *deviceQueueCursor
+	if req.Cursor != "" {
+		after = &deviceQueueCursor{}
+		if err := decodeCursor(req.Cursor, deviceQueueCursorKind, after); err != nil {
+			return nil, err
+		}
+	}
+
 	da, err := storage.GetLastDeviceActivationForDevEUI(config.C.PostgreSQL.DB, devEUI)
 	if err != nil {
 		return nil, errToRPCError(err)
@@ -166,8 +184,22 @@ func (d *DeviceQueueAPI) List(ctx context.Context, req *pb.ListDeviceQueueItemsR
 		return nil, err
 	}
 
+	// the queue-items are returned by the network-server ordered by
+	// frame-counter
+	items := queueItemsResp.Items
+	if after != nil {
+		i := 0
+		for i < len(items) && items[i].FCnt <= after.FCnt {
+			i++
+		}
+		items = items[i:]
+	}
+	if req.Limit > 0 && int64(len(items)) > req.Limit {
+		items = items[:req.Limit]
+	}
+
 	var resp pb.ListDeviceQueueItemsResponse
-	for _, qi := range queueItemsResp.Items {
+	for _, qi := range items {
 		b, err := lorawan.EncryptFRMPayload(da.AppSKey, false, da.DevAddr, qi.FCnt, qi.FrmPayload)
 		if err != nil {
 			return nil, errToRPCError(err)
@@ -182,5 +214,113 @@ func (d *DeviceQueueAPI) List(ctx context.Context, req *pb.ListDeviceQueueItemsR
 		})
 	}
 
+	// a full page means that there might be more queue-items
+	if len(items) != 0 && int64(len(items)) == req.Limit {
+		resp.NextCursor, err = encodeCursor(deviceQueueCursorKind, deviceQueueCursor{
+			FCnt: items[len(items)-1].FCnt,
+		})
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	return &resp, nil
+}
+
+// GetDiagnostics returns the diagnostics of the downlink path, explaining
+// for each of the recent downlinks where it is (or was) stuck.
+func (d *DeviceQueueAPI) GetDiagnostics(ctx context.Context, req *pb.GetDeviceQueueDiagnosticsRequest) (*pb.GetDeviceQueueDiagnosticsResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := d.validator.Validate(ctx,
+		auth.ValidateDeviceQueueAccess(devEUI, auth.List)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	device, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI, false, true)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	dp, err := storage.GetDeviceProfile(config.C.PostgreSQL.DB, device.DeviceProfileID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	var resp pb.GetDeviceQueueDiagnosticsResponse
+	resp.ClassA = !dp.DeviceProfile.SupportsClassB && !dp.DeviceProfile.SupportsClassC
+
+	if device.LastSeenAt != nil {
+		resp.LastSeenAt, err = ptypes.TimestampProto(*device.LastSeenAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	_, err = storage.GetLastDeviceActivationForDevEUI(config.C.PostgreSQL.DB, devEUI)
+	if err != nil && errors.Cause(err) != storage.ErrDoesNotExist {
+		return nil, errToRPCError(err)
+	}
+	resp.Activated = err == nil
+
+	n, err := storage.GetNetworkServerForDevEUI(config.C.PostgreSQL.DB, devEUI)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	nsClient, err := config.C.NetworkServer.Pool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	queueItemsResp, err := nsClient.GetDeviceQueueItemsForDevEUI(ctx, &ns.GetDeviceQueueItemsForDevEUIRequest{
+		DevEui: devEUI[:],
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	state := downlinktrace.DeviceState{
+		LastSeenAt: device.LastSeenAt,
+		ClassA:     resp.ClassA,
+	}
+	for _, qi := range queueItemsResp.Items {
+		state.QueueFCnts = append(state.QueueFCnts, qi.FCnt)
+	}
+	resp.QueueSize = uint32(len(state.QueueFCnts))
+
+	events, err := downlinktrace.Get(config.C.Redis.Pool, devEUI)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	for _, dl := range downlinktrace.Diagnose(events, state) {
+		dd := pb.DownlinkDiagnostics{
+			FCnt:      dl.FCnt,
+			FPort:     uint32(dl.FPort),
+			Confirmed: dl.Confirmed,
+			Stage:     string(dl.Stage),
+			Reason:    dl.Reason,
+		}
+
+		for _, e := range dl.Events {
+			ts, err := ptypes.TimestampProto(e.Time)
+			if err != nil {
+				return nil, errToRPCError(err)
+			}
+
+			dd.Events = append(dd.Events, &pb.DownlinkTraceEvent{
+				Time:  ts,
+				Type:  string(e.Type),
+				Error: e.Error,
+			})
+		}
+
+		resp.Downlinks = append(resp.Downlinks, &dd)
+	}
+
 	return &resp, nil
 }
@@ -3,12 +3,14 @@ package api
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
 
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/downlinktrace"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lora-app-server/internal/test"
 	"github.com/brocaar/loraserver/api/ns"
@@ -24,9 +26,11 @@ func TestDownlinkQueueAPI(t *testing.T) {
 	}
 
 	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL, 10, 0)
 
 	Convey("Given a clean database, an organization, application + node and api instance", t, func() {
 		test.MustResetDB(config.C.PostgreSQL.DB)
+		test.MustFlushRedis(config.C.Redis.Pool)
 
 		nsClient := test.NewNetworkServerClient()
 		nsClient.GetNextDownlinkFCntForDevEUIResponse = ns.GetNextDownlinkFCntForDevEUIResponse{
@@ -196,6 +200,31 @@ func TestDownlinkQueueAPI(t *testing.T) {
 					Data:      []byte{1, 2, 3, 4},
 				})
 			})
+
+			Convey("Then the diagnostics report the enqueued item as waiting in the queue", func() {
+				nsClient.GetDeviceProfileResponse = ns.GetDeviceProfileResponse{
+					DeviceProfile: &dp.DeviceProfile,
+				}
+				So(downlinktrace.Log(config.C.Redis.Pool, d.DevEUI, downlinktrace.Event{
+					Time:      time.Now(),
+					Type:      downlinktrace.Enqueued,
+					FCnt:      12,
+					FPort:     10,
+					Confirmed: true,
+				}), ShouldBeNil)
+
+				resp, err := api.GetDiagnostics(ctx, &pb.GetDeviceQueueDiagnosticsRequest{
+					DevEui: d.DevEUI.String(),
+				})
+				So(err, ShouldBeNil)
+				So(resp.Activated, ShouldBeTrue)
+				So(resp.ClassA, ShouldBeTrue)
+				So(resp.QueueSize, ShouldEqual, 1)
+				So(resp.Downlinks, ShouldHaveLength, 1)
+				So(resp.Downlinks[0].FCnt, ShouldEqual, 12)
+				So(resp.Downlinks[0].Stage, ShouldEqual, string(downlinktrace.StageNSQueue))
+				So(resp.Downlinks[0].Events, ShouldHaveLength, 1)
+			})
 		})
 
 		Convey("When calling Flush", func() {
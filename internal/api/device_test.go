@@ -1,11 +1,15 @@
 package api
 
 import (
+	"crypto/aes"
+	"encoding/hex"
 	"net"
 	"testing"
 	"time"
 
+	keywrap "github.com/NickBall/go-aes-key-wrap"
 	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/proto"
 	. "github.com/smartystreets/goconvey/convey"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
@@ -13,10 +17,16 @@ import (
 
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/devicetwin"
 	"github.com/brocaar/lora-app-server/internal/eventlog"
+	"github.com/brocaar/lora-app-server/internal/framecapture"
+	"github.com/brocaar/lora-app-server/internal/jointrace"
+	"github.com/brocaar/lora-app-server/internal/quarantine"
+	"github.com/brocaar/lora-app-server/internal/securityevent"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lora-app-server/internal/test"
 	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/loraserver/api/gw"
 	"github.com/brocaar/loraserver/api/ns"
 	"github.com/brocaar/lorawan"
 )
@@ -32,6 +42,8 @@ func TestNodeAPI(t *testing.T) {
 
 	config.C.PostgreSQL.DB = db
 	config.C.Redis.Pool = p
+	config.C.ApplicationServer.ExternalAPI.EventStream.ResumeBufferSize = 10
+	config.C.ApplicationServer.ExternalAPI.EventStream.ResumeBufferTTL = time.Minute
 
 	Convey("Given a clean database with an organization, application and api instance", t, func() {
 		test.MustResetDB(config.C.PostgreSQL.DB)
@@ -39,7 +51,13 @@ func TestNodeAPI(t *testing.T) {
 
 		nsClient := test.NewNetworkServerClient()
 		nsClient.GetDeviceProfileResponse = ns.GetDeviceProfileResponse{
-			DeviceProfile: &ns.DeviceProfile{},
+			DeviceProfile: &ns.DeviceProfile{
+				MacVersion:        "1.0.2",
+				RegParamsRevision: "B",
+			},
+		}
+		nsClient.GetVersionResponse = ns.GetVersionResponse{
+			Region: common.Region_US915,
 		}
 		config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
 
@@ -131,6 +149,9 @@ func TestNodeAPI(t *testing.T) {
 					DeviceProfileId:   dpID.String(),
 					SkipFCntCheck:     true,
 					ReferenceAltitude: 5.6,
+					Variables: map[string]string{
+						"ThingsBoardAccessToken": "secret-token",
+					},
 				},
 			}
 
@@ -154,6 +175,9 @@ func TestNodeAPI(t *testing.T) {
 				So(d.LastSeenAt, ShouldBeNil)
 				So(d.DeviceStatusBattery, ShouldEqual, 256)
 				So(d.DeviceStatusMargin, ShouldEqual, 256)
+				So(d.Region, ShouldEqual, "US915")
+				So(d.MacVersion, ShouldEqual, "1.0.2")
+				So(d.RegParamsRevision, ShouldEqual, "B")
 
 				Convey("When setting the device-status battery and margin", func() {
 					ten := 10
@@ -376,6 +400,140 @@ func TestNodeAPI(t *testing.T) {
 				})
 			})
 
+			Convey("Given a used dev-nonce", func() {
+				So(storage.CreateDeviceDevNonce(config.C.PostgreSQL.DB, &storage.DeviceDevNonce{
+					DevEUI:   lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+					DevNonce: 258,
+				}), ShouldBeNil)
+
+				Convey("Then ListDevNonces returns the dev-nonce", func() {
+					resp, err := api.ListDevNonces(ctx, &pb.ListDeviceDevNoncesRequest{
+						DevEui: "0807060504030201",
+					})
+					So(err, ShouldBeNil)
+					So(resp.Result, ShouldHaveLength, 1)
+					So(resp.Result[0].DevNonce, ShouldEqual, 258)
+				})
+
+				Convey("Then DeleteDevNonces deletes the dev-nonces", func() {
+					_, err := api.DeleteDevNonces(ctx, &pb.DeleteDeviceDevNoncesRequest{
+						DevEui: "0807060504030201",
+					})
+					So(err, ShouldBeNil)
+
+					resp, err := api.ListDevNonces(ctx, &pb.ListDeviceDevNoncesRequest{
+						DevEui: "0807060504030201",
+					})
+					So(err, ShouldBeNil)
+					So(resp.Result, ShouldHaveLength, 0)
+				})
+			})
+
+			Convey("Given a join attempt with an invalid MIC", func() {
+				devNonce := 258
+				So(jointrace.Log(config.C.Redis.Pool, lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, jointrace.Attempt{
+					JoinType: lorawan.JoinRequestType.String(),
+					DevNonce: &devNonce,
+					Result:   jointrace.InvalidMIC,
+					Error:    "invalid mic",
+				}), ShouldBeNil)
+
+				Convey("Then GetJoinDiagnostics returns the attempt and the wrong key cause", func() {
+					resp, err := api.GetJoinDiagnostics(ctx, &pb.GetDeviceJoinDiagnosticsRequest{
+						DevEui: "0807060504030201",
+					})
+					So(err, ShouldBeNil)
+					So(resp.Attempts, ShouldHaveLength, 1)
+					So(resp.Attempts[0].DevNonce, ShouldEqual, 258)
+					So(resp.Attempts[0].HasDevNonce, ShouldBeTrue)
+					So(resp.Attempts[0].Result, ShouldEqual, "INVALID_MIC")
+					So(resp.Diagnoses, ShouldHaveLength, 1)
+					So(resp.Diagnoses[0].Cause, ShouldEqual, "WRONG_KEY")
+					So(resp.Diagnoses[0].Count, ShouldEqual, 1)
+				})
+			})
+
+			Convey("Given a reported state", func() {
+				So(devicetwin.UpdateReported(config.C.PostgreSQL.DB, lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, map[string]interface{}{
+					"interval": 60,
+				}, time.Now()), ShouldBeNil)
+
+				Convey("When setting the same desired state", func() {
+					desired, err := twinDocumentToStruct(storage.DeviceTwinDocument{"interval": 60})
+					So(err, ShouldBeNil)
+
+					resp, err := api.UpdateTwinDesiredState(ctx, &pb.UpdateDeviceTwinDesiredStateRequest{
+						DevEui:  "0807060504030201",
+						Desired: desired,
+						Version: 1,
+					})
+					So(err, ShouldBeNil)
+					So(resp.Version, ShouldEqual, 2)
+					So(resp.Enqueued, ShouldBeFalse)
+					So(resp.Delta.Fields, ShouldHaveLength, 0)
+
+					Convey("Then GetTwin returns the reported and desired state", func() {
+						resp, err := api.GetTwin(ctx, &pb.GetDeviceTwinRequest{
+							DevEui: "0807060504030201",
+						})
+						So(err, ShouldBeNil)
+						So(resp.Version, ShouldEqual, 2)
+						So(resp.Reported.Fields["interval"].GetNumberValue(), ShouldEqual, 60)
+						So(resp.Desired.Fields["interval"].GetNumberValue(), ShouldEqual, 60)
+						So(resp.ReportedMetadata.Fields["interval"].GetStructValue().Fields, ShouldContainKey, "timestamp")
+						So(resp.Delta.Fields, ShouldHaveLength, 0)
+					})
+
+					Convey("Then an update with an outdated version is rejected", func() {
+						_, err := api.UpdateTwinDesiredState(ctx, &pb.UpdateDeviceTwinDesiredStateRequest{
+							DevEui:  "0807060504030201",
+							Desired: desired,
+							Version: 1,
+						})
+						So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+					})
+				})
+
+				Convey("Then setting a different desired state without codec fails", func() {
+					desired, err := twinDocumentToStruct(storage.DeviceTwinDocument{"interval": 30})
+					So(err, ShouldBeNil)
+
+					_, err = api.UpdateTwinDesiredState(ctx, &pb.UpdateDeviceTwinDesiredStateRequest{
+						DevEui:  "0807060504030201",
+						Desired: desired,
+						FPort:   10,
+					})
+					So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+				})
+			})
+
+			Convey("Given two device-locations", func() {
+				for i := 0; i < 2; i++ {
+					So(storage.CreateDeviceLocation(config.C.PostgreSQL.DB, &storage.DeviceLocation{
+						DevEUI:    lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+						Latitude:  1.123 + float64(i),
+						Longitude: 2.123,
+						Altitude:  3.123,
+						Accuracy:  10,
+						Source:    "GEO_RESOLVER",
+					}), ShouldBeNil)
+				}
+
+				Convey("Then GetTrack returns the track", func() {
+					resp, err := api.GetTrack(ctx, &pb.GetDeviceTrackRequest{
+						DevEui: "0807060504030201",
+					})
+					So(err, ShouldBeNil)
+					So(resp.Result, ShouldHaveLength, 2)
+					So(resp.Result[1].Location.Latitude, ShouldEqual, 2.123)
+					So(resp.Result[1].Location.Source, ShouldEqual, common.LocationSource_GEO_RESOLVER)
+
+					geometry := resp.GeoJson.Fields["geometry"].GetStructValue()
+					So(geometry.Fields["type"].GetStringValue(), ShouldEqual, "LineString")
+					So(geometry.Fields["coordinates"].GetListValue().Values, ShouldHaveLength, 2)
+				})
+			})
+
 			Convey("When activating the device (ABP)", func() {
 				activateReq := pb.ActivateDeviceRequest{
 					DeviceActivation: &pb.DeviceActivation{
@@ -424,6 +582,124 @@ func TestNodeAPI(t *testing.T) {
 					So(da.AppSKey, ShouldEqual, lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8})
 					So(da.DevAddr, ShouldEqual, lorawan.DevAddr{1, 2, 3, 4})
 				})
+
+				Convey("Then GetWrappedAppSKey returns the AppSKey wrapped using the given KEK", func() {
+					resp, err := api.GetWrappedAppSKey(ctx, &pb.GetWrappedAppSKeyRequest{
+						DevEui:   "0807060504030201",
+						KekLabel: "test-kek",
+						Kek:      "08070605040302010807060504030201",
+					})
+					So(err, ShouldBeNil)
+					So(resp.DevAddr, ShouldEqual, "01020304")
+					So(resp.KekLabel, ShouldEqual, "test-kek")
+
+					b, err := hex.DecodeString(resp.AppSKey)
+					So(err, ShouldBeNil)
+					block, err := aes.NewCipher([]byte{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1})
+					So(err, ShouldBeNil)
+					key, err := keywrap.Unwrap(block, b)
+					So(err, ShouldBeNil)
+					So(key, ShouldResemble, []byte{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8})
+				})
+
+				Convey("Then DecryptUplink decrypts the captured uplink using the recorded AppSKey", func() {
+					b, err := lorawan.EncryptFRMPayload(lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}, true, lorawan.DevAddr{1, 2, 3, 4}, 10, []byte{1, 2, 3, 4})
+					So(err, ShouldBeNil)
+
+					fPort := uint8(1)
+					phy := lorawan.PHYPayload{
+						MHDR: lorawan.MHDR{
+							MType: lorawan.UnconfirmedDataUp,
+							Major: lorawan.LoRaWANR1,
+						},
+						MACPayload: &lorawan.MACPayload{
+							FHDR: lorawan.FHDR{
+								DevAddr: lorawan.DevAddr{1, 2, 3, 4},
+								FCnt:    10,
+							},
+							FPort:      &fPort,
+							FRMPayload: []lorawan.Payload{&lorawan.DataPayload{Bytes: b}},
+						},
+					}
+					phyB, err := phy.MarshalBinary()
+					So(err, ShouldBeNil)
+					upB, err := proto.Marshal(&gw.UplinkFrameSet{PhyPayload: phyB})
+					So(err, ShouldBeNil)
+					So(framecapture.AddFrame(config.C.Redis.Pool, lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, framecapture.Frame{ReceivedAt: time.Now(), UplinkFrameSet: upB}, 10, time.Hour), ShouldBeNil)
+
+					resp, err := api.DecryptUplink(ctx, &pb.DecryptDeviceUplinkRequest{
+						DevEui: "0807060504030201",
+						FCnt:   10,
+					})
+					So(err, ShouldBeNil)
+					So(resp.Result, ShouldHaveLength, 1)
+					So(resp.Result[0].DevAddr, ShouldEqual, "01020304")
+					So(resp.Result[0].FrmPayload, ShouldEqual, "01020304")
+
+					count, err := storage.GetSecurityEventCount(config.C.PostgreSQL.DB, storage.SecurityEventFilters{Type: securityevent.UplinkDecryption})
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 1)
+				})
+
+				Convey("Then DecryptUplink decrypts the quarantined uplink using the recorded AppSKey", func() {
+					b, err := lorawan.EncryptFRMPayload(lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}, true, lorawan.DevAddr{1, 2, 3, 4}, 70000, []byte{1, 2, 3, 4})
+					So(err, ShouldBeNil)
+
+					d, err := storage.GetDevice(config.C.PostgreSQL.DB, lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, false, true)
+					So(err, ShouldBeNil)
+					So(quarantine.Add(config.C.Redis.Pool, d.ApplicationID, quarantine.Frame{
+						DevEUI:  d.DevEUI,
+						DevAddr: lorawan.DevAddr{1, 2, 3, 4},
+						FCnt:    70000,
+						FPort:   1,
+						Data:    b,
+					}, 10, time.Hour), ShouldBeNil)
+
+					resp, err := api.DecryptUplink(ctx, &pb.DecryptDeviceUplinkRequest{
+						DevEui: "0807060504030201",
+						FCnt:   70000,
+					})
+					So(err, ShouldBeNil)
+					So(resp.Result, ShouldHaveLength, 1)
+					So(resp.Result[0].FrmPayload, ShouldEqual, "01020304")
+				})
+
+				Convey("Then DecryptUplink returns an error when the uplink has not been stored", func() {
+					_, err := api.DecryptUplink(ctx, &pb.DecryptDeviceUplinkRequest{
+						DevEui: "0807060504030201",
+						FCnt:   10,
+					})
+					So(grpc.Code(err), ShouldEqual, codes.NotFound)
+				})
+
+				Convey("Then DecryptUplink returns an error when the activation_count is too high", func() {
+					_, err := api.DecryptUplink(ctx, &pb.DecryptDeviceUplinkRequest{
+						DevEui:          "0807060504030201",
+						ActivationCount: decryptUplinkMaxActivations + 1,
+					})
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				})
+
+				Convey("When deactivating the device", func() {
+					<-nsClient.DeactivateDeviceChan
+
+					_, err := api.Deactivate(ctx, &pb.DeactivateDeviceRequest{
+						DevEui: "0807060504030201",
+					})
+					So(err, ShouldBeNil)
+
+					Convey("Then the device-session was deactivated", func() {
+						So(nsClient.DeactivateDeviceChan, ShouldHaveLength, 1)
+						So(<-nsClient.DeactivateDeviceChan, ShouldResemble, ns.DeactivateDeviceRequest{
+							DevEui: []byte{8, 7, 6, 5, 4, 3, 2, 1},
+						})
+					})
+
+					Convey("Then the activation was removed", func() {
+						_, err := storage.GetLastDeviceActivationForDevEUI(config.C.PostgreSQL.DB, [8]byte{8, 7, 6, 5, 4, 3, 2, 1})
+						So(err, ShouldEqual, storage.ErrDoesNotExist)
+					})
+				})
 			})
 
 			Convey("When calling StreamEventLogs", func() {
@@ -455,7 +731,44 @@ func TestNodeAPI(t *testing.T) {
 					Convey("Then the event was received by the client", func() {
 						resp := <-respChan
 						So(resp.Type, ShouldEqual, eventlog.Join)
+						So(resp.Seq, ShouldBeGreaterThan, 0)
+						So(resp.ResumeToken, ShouldNotEqual, "")
+
+						Convey("When resuming the stream with the resume token", func() {
+							So(eventlog.LogEventForDevice(lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, eventlog.EventLog{
+								Type: eventlog.Status,
+							}), ShouldBeNil)
+
+							client, err := api.StreamEventLogs(ctx, &pb.StreamDeviceEventLogsRequest{
+								DevEui:      "0807060504030201",
+								ResumeToken: resp.ResumeToken,
+							})
+							So(err, ShouldBeNil)
+
+							Convey("Then the events after the resume token are received", func() {
+								resumed, err := client.Recv()
+								So(err, ShouldBeNil)
+								So(resumed.Type, ShouldEqual, eventlog.Status)
+								So(resumed.Seq, ShouldEqual, resp.Seq+1)
+							})
+						})
+					})
+				})
+
+				Convey("Then StreamEventLogs returns an error for a resume token of an other device", func() {
+					token, err := encodeCursor(deviceEventCursorKind, deviceEventCursor{
+						DevEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+						Seq:    1,
 					})
+					So(err, ShouldBeNil)
+
+					client, err := api.StreamEventLogs(ctx, &pb.StreamDeviceEventLogsRequest{
+						DevEui:      "0807060504030201",
+						ResumeToken: token,
+					})
+					So(err, ShouldBeNil)
+					_, err = client.Recv()
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
 				})
 			})
 		})
@@ -1,29 +1,79 @@
 package api
 
 import (
+	"github.com/brocaar/lora-app-server/internal/framecapture"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/handler/azurehandler"
 	"github.com/brocaar/lora-app-server/internal/handler/httphandler"
 	"github.com/brocaar/lora-app-server/internal/handler/influxdbhandler"
+	"github.com/brocaar/lora-app-server/internal/handler/loracloudhandler"
+	"github.com/brocaar/lora-app-server/internal/handler/mqtthandler"
+	"github.com/brocaar/lora-app-server/internal/handler/mydeviceshandler"
+	"github.com/brocaar/lora-app-server/internal/handler/thingsboardhandler"
+	"github.com/brocaar/lora-app-server/internal/proxy"
 	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/uplinkfilter"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 )
 
 var errToCode = map[error]codes.Code{
-	storage.ErrAlreadyExists:                   codes.AlreadyExists,
-	storage.ErrDoesNotExist:                    codes.NotFound,
-	storage.ErrUsedByOtherObjects:              codes.FailedPrecondition,
-	storage.ErrApplicationInvalidName:          codes.InvalidArgument,
-	storage.ErrNodeInvalidName:                 codes.InvalidArgument,
-	storage.ErrNodeMaxRXDelay:                  codes.InvalidArgument,
-	storage.ErrCFListTooManyChannels:           codes.InvalidArgument,
-	storage.ErrUserInvalidUsername:             codes.InvalidArgument,
-	storage.ErrUserPasswordLength:              codes.InvalidArgument,
-	storage.ErrInvalidUsernameOrPassword:       codes.Unauthenticated,
-	storage.ErrInvalidEmail:                    codes.InvalidArgument,
-	storage.ErrInvalidGatewayDiscoveryInterval: codes.InvalidArgument,
-	httphandler.ErrInvalidHeaderName:           codes.InvalidArgument,
-	influxdbhandler.ErrInvalidPrecision:        codes.InvalidArgument,
+	storage.ErrAlreadyExists:                         codes.AlreadyExists,
+	storage.ErrDoesNotExist:                          codes.NotFound,
+	storage.ErrUsedByOtherObjects:                    codes.FailedPrecondition,
+	storage.ErrApplicationInvalidName:                codes.InvalidArgument,
+	storage.ErrApplicationInvalidGeolocationSettings: codes.InvalidArgument,
+	storage.ErrApplicationInvalidFieldMappings:       codes.InvalidArgument,
+	storage.ErrApplicationInvalidMQTTTopicTemplates:  codes.InvalidArgument,
+	storage.ErrNodeInvalidName:                       codes.InvalidArgument,
+	storage.ErrNodeMaxRXDelay:                        codes.InvalidArgument,
+	storage.ErrCFListTooManyChannels:                 codes.InvalidArgument,
+	storage.ErrUserInvalidUsername:                   codes.InvalidArgument,
+	storage.ErrUserPasswordLength:                    codes.InvalidArgument,
+	storage.ErrInvalidUsernameOrPassword:             codes.Unauthenticated,
+	storage.ErrInvalidEmail:                          codes.InvalidArgument,
+	storage.ErrInvalidGatewayDiscoveryInterval:       codes.InvalidArgument,
+	storage.ErrInvalidKEK:                            codes.InvalidArgument,
+	storage.ErrInvalidJoinAcceptDLSettings:           codes.InvalidArgument,
+	storage.ErrInvalidCFList:                         codes.InvalidArgument,
+	storage.ErrInvalidFPort:                          codes.InvalidArgument,
+	storage.ErrInvalidFPortLabel:                     codes.InvalidArgument,
+	storage.ErrInvalidRetention:                      codes.InvalidArgument,
+	storage.ErrInvalidColor:                          codes.InvalidArgument,
+	storage.ErrInvalidRegion:                         codes.InvalidArgument,
+	storage.ErrInvalidURL:                            codes.InvalidArgument,
+	storage.ErrInvalidLink:                           codes.InvalidArgument,
+	storage.ErrOrganizationInvalidQuota:              codes.InvalidArgument,
+	storage.ErrOrganizationUserAdminAuditor:          codes.InvalidArgument,
+	storage.ErrServiceAccountInvalidName:             codes.InvalidArgument,
+	storage.ErrServiceAccountInvalidPublicKey:        codes.InvalidArgument,
+	storage.ErrLegalHold:                             codes.FailedPrecondition,
+	storage.ErrOrganizationMaxDeviceCount:            codes.ResourceExhausted,
+	storage.ErrOrganizationMaxGatewayCount:           codes.ResourceExhausted,
+	storage.ErrRegistrationDisabled:                  codes.FailedPrecondition,
+	storage.ErrRegistrationInvalidInvite:             codes.PermissionDenied,
+	storage.ErrRegistrationDomainNotAllowed:          codes.PermissionDenied,
+	storage.ErrRegistrationApproved:                  codes.FailedPrecondition,
+	storage.ErrServiceProfileInvalidLimits:           codes.InvalidArgument,
+	storage.ErrServiceProfileMaxDeviceCount:          codes.ResourceExhausted,
+	storage.ErrServiceProfileMaxUplinkRate:           codes.ResourceExhausted,
+	storage.ErrServiceProfileMaxPayloadSize:          codes.ResourceExhausted,
+	handler.ErrInvalidEventType:                      codes.InvalidArgument,
+	httphandler.ErrInvalidHeaderName:                 codes.InvalidArgument,
+	httphandler.ErrInvalidHeaderURL:                  codes.InvalidArgument,
+	influxdbhandler.ErrInvalidPrecision:              codes.InvalidArgument,
+	mqtthandler.ErrClientCANotConfigured:             codes.FailedPrecondition,
+	azurehandler.ErrInvalidConnectionString:          codes.InvalidArgument,
+	thingsboardhandler.ErrInvalidServer:              codes.InvalidArgument,
+	mydeviceshandler.ErrInvalidEndpoint:              codes.InvalidArgument,
+	loracloudhandler.ErrInvalidToken:                 codes.InvalidArgument,
+	loracloudhandler.ErrInvalidServer:                codes.InvalidArgument,
+	loracloudhandler.ErrInvalidFPort:                 codes.InvalidArgument,
+	uplinkfilter.ErrInvalidScript:                    codes.InvalidArgument,
+	framecapture.ErrDoesNotExist:                     codes.NotFound,
+	framecapture.ErrFrameDoesNotExist:                codes.NotFound,
+	proxy.ErrInvalidURL:                              codes.InvalidArgument,
 }
 
 func errToRPCError(err error) error {
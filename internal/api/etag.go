@@ -0,0 +1,80 @@
+package api
+
+import (
+	"crypto/sha1"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/brocaar/lora-app-server/internal/api/etag"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// ifNoneMatchMetadataKeys contains the metadata keys containing the
+// If-None-Match value of the request. The first is used by gRPC clients,
+// the second is set by the gRPC gateway.
+var ifNoneMatchMetadataKeys = []string{"if-none-match", "grpcgateway-if-none-match"}
+
+// newETag returns an ETag for the given version parts. The parts must
+// describe everything the response depends on, including the request
+// parameters and the authenticated user.
+func newETag(parts ...interface{}) string {
+	h := sha1.New()
+	for _, p := range parts {
+		fmt.Fprintf(h, "%v\x00", p)
+	}
+	return fmt.Sprintf(`"%x"`, h.Sum(nil))
+}
+
+// notModified sets the given ETag as response header and returns true when
+// it matches the If-None-Match value of the request. In that case the
+// response will be discarded and an empty response can be returned.
+func notModified(ctx context.Context, tag string) bool {
+	// this fails when not called within a gRPC request (e.g. in tests), in
+	// which case there is no header to set
+	grpc.SetHeader(ctx, metadata.Pairs(etag.MetadataKey, tag))
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+
+	for _, key := range ifNoneMatchMetadataKeys {
+		for _, v := range md[key] {
+			if etag.Match(v, tag) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// listVersionFunc returns the version of a list of objects for the given
+// organization ID (0 for all organizations).
+type listVersionFunc func(db sqlx.Queryer, organizationID int64) (storage.ListVersion, error)
+
+// listETag returns the ETag for a list of objects. For non-admin users, the
+// organization memberships are taken into account as these define which
+// objects the user is able to see.
+func listETag(isAdmin bool, username string, organizationID int64, getVersion listVersionFunc, params ...interface{}) (string, error) {
+	v, err := getVersion(config.C.PostgreSQL.DB, organizationID)
+	if err != nil {
+		return "", err
+	}
+
+	parts := []interface{}{username, isAdmin, organizationID, v}
+	if !isAdmin {
+		uv, err := storage.GetOrganizationUserListVersionForUser(config.C.PostgreSQL.DB, username)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, uv)
+	}
+
+	return newETag(append(parts, params...)...), nil
+}
@@ -0,0 +1,111 @@
+// Package etag implements conditional GET requests for the JSON REST API.
+//
+// API endpoints supporting ETags return the ETag as gRPC header metadata,
+// which is exposed by the gRPC gateway as Grpc-Metadata-Etag header. This
+// handler exposes it as ETag header and responds with 304 Not Modified when
+// it matches the If-None-Match header of the request.
+package etag
+
+import (
+	"net/http"
+	"strings"
+)
+
+// MetadataKey defines the gRPC metadata key containing the ETag.
+const MetadataKey = "etag"
+
+const gatewayHeader = "Grpc-Metadata-Etag"
+
+// Match returns true when the given If-None-Match header value matches the
+// given ETag. As defined for If-None-Match, the weak comparison is used.
+func Match(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" || etag == "" {
+		return false
+	}
+
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewHandler returns a http.Handler which handles the ETag and If-None-Match
+// headers for GET requests to the given handler.
+func NewHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		next.ServeHTTP(&responseWriter{
+			ResponseWriter: w,
+			ifNoneMatch:    r.Header.Get("If-None-Match"),
+		}, r)
+	})
+}
+
+// responseWriter sets the ETag header and discards the body in case the
+// response has not been modified.
+type responseWriter struct {
+	http.ResponseWriter
+	ifNoneMatch string
+	wroteHeader bool
+	notModified bool
+}
+
+// WriteHeader implements the http.ResponseWriter interface.
+func (rw *responseWriter) WriteHeader(code int) {
+	if rw.wroteHeader {
+		return
+	}
+	rw.wroteHeader = true
+
+	h := rw.Header()
+	if etag := h.Get(gatewayHeader); etag != "" {
+		h.Del(gatewayHeader)
+		h.Set("ETag", etag)
+
+		if code == http.StatusOK && Match(rw.ifNoneMatch, etag) {
+			rw.notModified = true
+			h.Del("Content-Type")
+			h.Del("Content-Length")
+			code = http.StatusNotModified
+		}
+	}
+
+	rw.ResponseWriter.WriteHeader(code)
+}
+
+// Write implements the http.ResponseWriter interface.
+func (rw *responseWriter) Write(b []byte) (int, error) {
+	if !rw.wroteHeader {
+		rw.WriteHeader(http.StatusOK)
+	}
+
+	if rw.notModified {
+		return len(b), nil
+	}
+
+	return rw.ResponseWriter.Write(b)
+}
+
+// Flush implements the http.Flusher interface, which is required for
+// streaming responses.
+func (rw *responseWriter) Flush() {
+	if f, ok := rw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// CloseNotify implements the http.CloseNotifier interface.
+func (rw *responseWriter) CloseNotify() <-chan bool {
+	if cn, ok := rw.ResponseWriter.(http.CloseNotifier); ok {
+		return cn.CloseNotify()
+	}
+	return make(chan bool)
+}
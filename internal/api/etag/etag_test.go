@@ -0,0 +1,128 @@
+package etag
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		IfNoneMatch string
+		ETag        string
+		Expected    bool
+	}{
+		{``, `"abc"`, false},
+		{`"abc"`, ``, false},
+		{`"abc"`, `"abc"`, true},
+		{`"abd"`, `"abc"`, false},
+		{`"foo", "abc"`, `"abc"`, true},
+		{`W/"abc"`, `"abc"`, true},
+		{`*`, `"abc"`, true},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.Expected, Match(test.IfNoneMatch, test.ETag), test.IfNoneMatch)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	handler := NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/etag":
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set(gatewayHeader, `"abc"`)
+			fmt.Fprint(w, `{"totalCount":"1"}`)
+		case "/error":
+			w.Header().Set(gatewayHeader, `"abc"`)
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error":"object does not exist","code":5}`)
+		default:
+			fmt.Fprint(w, `{}`)
+		}
+	}))
+
+	tests := []struct {
+		Name         string
+		Method       string
+		URL          string
+		IfNoneMatch  string
+		ExpectedCode int
+		ExpectedETag string
+		ExpectedBody string
+	}{
+		{
+			Name:         "no etag",
+			Method:       http.MethodGet,
+			URL:          "/",
+			IfNoneMatch:  `"abc"`,
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: `{}`,
+		},
+		{
+			Name:         "etag without if-none-match",
+			Method:       http.MethodGet,
+			URL:          "/etag",
+			ExpectedCode: http.StatusOK,
+			ExpectedETag: `"abc"`,
+			ExpectedBody: `{"totalCount":"1"}`,
+		},
+		{
+			Name:         "etag modified",
+			Method:       http.MethodGet,
+			URL:          "/etag",
+			IfNoneMatch:  `"abd"`,
+			ExpectedCode: http.StatusOK,
+			ExpectedETag: `"abc"`,
+			ExpectedBody: `{"totalCount":"1"}`,
+		},
+		{
+			Name:         "etag not modified",
+			Method:       http.MethodGet,
+			URL:          "/etag",
+			IfNoneMatch:  `"abc"`,
+			ExpectedCode: http.StatusNotModified,
+			ExpectedETag: `"abc"`,
+		},
+		{
+			Name:         "error response",
+			Method:       http.MethodGet,
+			URL:          "/error",
+			IfNoneMatch:  `"abc"`,
+			ExpectedCode: http.StatusNotFound,
+			ExpectedETag: `"abc"`,
+			ExpectedBody: `{"error":"object does not exist","code":5}`,
+		},
+		{
+			Name:         "non-GET request",
+			Method:       http.MethodPost,
+			URL:          "/etag",
+			IfNoneMatch:  `"abc"`,
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: `{"totalCount":"1"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			r := httptest.NewRequest(test.Method, test.URL, nil)
+			if test.IfNoneMatch != "" {
+				r.Header.Set("If-None-Match", test.IfNoneMatch)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			b, err := ioutil.ReadAll(w.Body)
+			assert.NoError(err)
+			assert.Equal(test.ExpectedCode, w.Code)
+			assert.Equal(test.ExpectedETag, w.Header().Get("ETag"))
+			assert.Equal(test.ExpectedBody, string(b))
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestNotModified(t *testing.T) {
+	assert := require.New(t)
+
+	tag := newETag("user", true, int64(1))
+	assert.Equal(tag, newETag("user", true, int64(1)))
+	assert.NotEqual(tag, newETag("user", false, int64(1)))
+	assert.NotEqual(newETag("ab", "c"), newETag("a", "bc"))
+
+	tests := []struct {
+		Name     string
+		Metadata metadata.MD
+		Expected bool
+	}{
+		{"no metadata", nil, false},
+		{"grpc", metadata.Pairs("if-none-match", tag), true},
+		{"grpc gateway", metadata.Pairs("grpcgateway-if-none-match", tag), true},
+		{"modified", metadata.Pairs("if-none-match", newETag("foo")), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx := context.Background()
+			if test.Metadata != nil {
+				ctx = metadata.NewIncomingContext(ctx, test.Metadata)
+			}
+			require.Equal(t, test.Expected, notModified(ctx, tag))
+		})
+	}
+}
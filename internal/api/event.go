@@ -0,0 +1,138 @@
+package api
+
+import (
+	"encoding/json"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/eventlog"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// EventAPI exports the event stream related functions.
+type EventAPI struct {
+	validator auth.Validator
+}
+
+// NewEventAPI creates a new EventAPI.
+func NewEventAPI(validator auth.Validator) *EventAPI {
+	return &EventAPI{
+		validator: validator,
+	}
+}
+
+// Stream streams the device events of the given application and / or
+// devices. When streaming the events of an application, the devices of the
+// application are resolved when the stream is opened. Devices added to the
+// application afterwards are not included.
+func (a *EventAPI) Stream(req *pb.StreamEventsRequest, srv pb.EventService_StreamServer) error {
+	if req.ApplicationId == 0 && len(req.DevEuis) == 0 {
+		return grpc.Errorf(codes.InvalidArgument, "applicationID or devEUIs must be set")
+	}
+
+	var devEUIs []lorawan.EUI64
+	for _, s := range req.DevEuis {
+		var devEUI lorawan.EUI64
+		if err := devEUI.UnmarshalText([]byte(s)); err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+		}
+		devEUIs = append(devEUIs, devEUI)
+	}
+
+	// applications contains the application ID by DevEUI of the devices
+	// of which the events are streamed.
+	applications := make(map[lorawan.EUI64]int64)
+
+	if len(devEUIs) == 0 {
+		if err := a.validator.Validate(srv.Context(),
+			auth.ValidateApplicationAccess(req.ApplicationId, auth.Read)); err != nil {
+			return grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+		}
+
+		var err error
+		devEUIs, err = storage.GetDevEUIsForApplicationID(config.C.PostgreSQL.DB, req.ApplicationId)
+		if err != nil {
+			return errToRPCError(err)
+		}
+		for _, devEUI := range devEUIs {
+			applications[devEUI] = req.ApplicationId
+		}
+	} else {
+		for _, devEUI := range devEUIs {
+			if err := a.validator.Validate(srv.Context(),
+				auth.ValidateNodeAccess(devEUI, auth.Read)); err != nil {
+				return grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+			}
+
+			d, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI, false, true)
+			if err != nil {
+				return errToRPCError(err)
+			}
+			if req.ApplicationId != 0 && d.ApplicationID != req.ApplicationId {
+				return grpc.Errorf(codes.InvalidArgument, "device %s does not belong to application %d", devEUI, req.ApplicationId)
+			}
+			applications[devEUI] = d.ApplicationID
+		}
+	}
+
+	types := make(map[string]struct{})
+	for _, t := range req.Types {
+		types[t] = struct{}{}
+	}
+
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+
+	eventLogChan := make(chan eventlog.DeviceEventLog)
+	go func() {
+		err := eventlog.GetEventLogs(ctx, devEUIs, eventLogChan)
+		if err != nil {
+			log.WithError(err).Error("get event-logs error")
+		}
+		close(eventLogChan)
+	}()
+
+	var sendErr error
+	for el := range eventLogChan {
+		// the event channel must be drained until it has been closed
+		if sendErr != nil {
+			continue
+		}
+
+		if _, ok := types[el.Type]; len(types) != 0 && !ok {
+			continue
+		}
+
+		applicationID, ok := applications[el.DevEUI]
+		if !ok {
+			continue
+		}
+
+		b, err := json.Marshal(el.Payload)
+		if err != nil {
+			return grpc.Errorf(codes.Internal, "marshal json error: %s", err)
+		}
+
+		err = srv.Send(&pb.StreamEventsResponse{
+			DevEui:        el.DevEUI.String(),
+			ApplicationId: applicationID,
+			Type:          el.Type,
+			PayloadJson:   string(b),
+			Seq:           el.Seq,
+		})
+		if err != nil {
+			log.WithError(err).Error("error sending event-log response")
+			sendErr = err
+			cancel()
+		}
+	}
+
+	return sendErr
+}
@@ -0,0 +1,192 @@
+package api
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/eventlog"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestEventAPI(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := storage.NewRedisPool(conf.RedisURL, 10, 0)
+
+	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = p
+
+	Convey("Given a clean database with two applications with a device and an api instance", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+		test.MustFlushRedis(p)
+
+		nsClient := test.NewNetworkServerClient()
+		config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		validator := &TestValidator{}
+
+		grpcServer := grpc.NewServer()
+		pb.RegisterEventServiceServer(grpcServer, NewEventAPI(validator))
+
+		ln, err := net.Listen("tcp", "localhost:0")
+		So(err, ShouldBeNil)
+		go grpcServer.Serve(ln)
+		defer func() {
+			grpcServer.Stop()
+			ln.Close()
+		}()
+
+		apiClient, err := grpc.Dial(ln.Addr().String(), grpc.WithInsecure(), grpc.WithBlock())
+		So(err, ShouldBeNil)
+		defer apiClient.Close()
+
+		api := pb.NewEventServiceClient(apiClient)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			Name:            "test-sp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		So(storage.CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+		spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+		So(err, ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			Name:            "test-dp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		So(storage.CreateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+		dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+		So(err, ShouldBeNil)
+
+		var apps []storage.Application
+		var devices []storage.Device
+		for i, name := range []string{"test-app-1", "test-app-2"} {
+			app := storage.Application{
+				OrganizationID:   org.ID,
+				Name:             name,
+				ServiceProfileID: spID,
+			}
+			So(storage.CreateApplication(config.C.PostgreSQL.DB, &app), ShouldBeNil)
+			apps = append(apps, app)
+
+			d := storage.Device{
+				ApplicationID:   app.ID,
+				DeviceProfileID: dpID,
+				Name:            name + "-device",
+				DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, byte(i)},
+			}
+			So(storage.CreateDevice(config.C.PostgreSQL.DB, &d), ShouldBeNil)
+			devices = append(devices, d)
+		}
+
+		stream := func(req pb.StreamEventsRequest) chan *pb.StreamEventsResponse {
+			respChan := make(chan *pb.StreamEventsResponse)
+
+			client, err := api.Stream(ctx, &req)
+			So(err, ShouldBeNil)
+
+			// some time for subscribing
+			time.Sleep(100 * time.Millisecond)
+
+			go func() {
+				for {
+					resp, err := client.Recv()
+					if err != nil {
+						break
+					}
+					respChan <- resp
+				}
+			}()
+
+			return respChan
+		}
+
+		logEvents := func() {
+			So(eventlog.LogEventForDevice(devices[1].DevEUI, eventlog.EventLog{Type: eventlog.Join}), ShouldBeNil)
+			So(eventlog.LogEventForDevice(devices[0].DevEUI, eventlog.EventLog{Type: eventlog.Join}), ShouldBeNil)
+			So(eventlog.LogEventForDevice(devices[0].DevEUI, eventlog.EventLog{Type: eventlog.Uplink, Payload: map[string]string{"foo": "bar"}}), ShouldBeNil)
+		}
+
+		Convey("When streaming the events of an application", func() {
+			respChan := stream(pb.StreamEventsRequest{
+				ApplicationId: apps[0].ID,
+			})
+			logEvents()
+
+			Convey("Then only the events of the devices of the application are received", func() {
+				resp := <-respChan
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(resp.DevEui, ShouldEqual, devices[0].DevEUI.String())
+				So(resp.ApplicationId, ShouldEqual, apps[0].ID)
+				So(resp.Type, ShouldEqual, eventlog.Join)
+
+				resp = <-respChan
+				So(resp.Type, ShouldEqual, eventlog.Uplink)
+				So(resp.PayloadJson, ShouldEqual, `{"foo":"bar"}`)
+				So(resp.Seq, ShouldEqual, 2)
+			})
+		})
+
+		Convey("When streaming the uplink events of the given devices", func() {
+			respChan := stream(pb.StreamEventsRequest{
+				DevEuis: []string{devices[0].DevEUI.String(), devices[1].DevEUI.String()},
+				Types:   []string{eventlog.Uplink},
+			})
+			logEvents()
+
+			Convey("Then only the uplink events are received", func() {
+				resp := <-respChan
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(resp.DevEui, ShouldEqual, devices[0].DevEUI.String())
+				So(resp.Type, ShouldEqual, eventlog.Uplink)
+			})
+		})
+
+		Convey("Then an error is returned when not setting the application ID or DevEUIs", func() {
+			client, err := api.Stream(ctx, &pb.StreamEventsRequest{})
+			So(err, ShouldBeNil)
+			_, err = client.Recv()
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+
+		Convey("Then an error is returned when the device does not belong to the application", func() {
+			client, err := api.Stream(ctx, &pb.StreamEventsRequest{
+				ApplicationId: apps[0].ID,
+				DevEuis:       []string{devices[1].DevEUI.String()},
+			})
+			So(err, ShouldBeNil)
+			_, err = client.Recv()
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+	})
+}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/eventlog"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// unexpectedFPortWarning defines the notification type of an uplink
+// received on an FPort which is not declared by the device-profile.
+const unexpectedFPortWarning = "UNEXPECTED_FPORT_WARNING"
+
+// getFPortLabel returns the label of the given FPort, as declared by the
+// device-profile of the given device. When the device-profile declares
+// its expected FPorts and the given FPort is not one of them, a warning
+// notification is sent. Failing to retrieve the FPorts does not fail the
+// uplink.
+func getFPortLabel(d storage.Device, app storage.Application, fCnt uint32, fPort uint8) string {
+	fPorts, err := storage.GetDeviceProfileFPortsForDevEUI(config.C.PostgreSQL.DB, d.DevEUI)
+	if err != nil {
+		log.WithField("dev_eui", d.DevEUI).WithError(err).Error("get device-profile fPorts error")
+		return ""
+	}
+
+	if len(fPorts) == 0 {
+		return ""
+	}
+
+	if p, ok := fPorts.Get(int(fPort)); ok {
+		return p.Label
+	}
+
+	errStr := fmt.Sprintf("uplink received on unexpected fPort %d", fPort)
+
+	log.WithFields(log.Fields{
+		"dev_eui":        d.DevEUI,
+		"application_id": app.ID,
+		"f_port":         fPort,
+		"type":           unexpectedFPortWarning,
+	}).Warning(errStr)
+
+	errNotification := handler.ErrorNotification{
+		ApplicationID:   app.ID,
+		ApplicationName: app.Name,
+		DeviceName:      d.Name,
+		DevEUI:          d.DevEUI,
+		Type:            unexpectedFPortWarning,
+		Error:           errStr,
+		FCnt:            fCnt,
+	}
+
+	if err := eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
+		Type:    eventlog.Error,
+		Payload: errNotification,
+	}); err != nil {
+		log.WithError(err).Error("log event for device error")
+	}
+
+	if !app.IsArchived() {
+		if err := config.C.ApplicationServer.Integration.Handler.SendErrorNotification(errNotification); err != nil {
+			log.WithError(err).Error("send error notification to handler error")
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/eventlog"
+	"github.com/brocaar/lora-app-server/internal/fcntanomaly"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/securityevent"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// Frame-counter anomaly notification types.
+const (
+	fCntJumpWarning  = "FCNT_JUMP_WARNING"
+	fCntResetWarning = "FCNT_RESET_WARNING"
+)
+
+// detectFCntAnomaly compares the frame-counter of the given uplink with the
+// previous frame-counter of the device and sends a warning notification on
+// a large jump or a reset. When the uplink is the first uplink after a
+// (re)activation, the frame-counter is not validated.
+func detectFCntAnomaly(d storage.Device, app storage.Application, fCnt uint32, activated bool) {
+	conf := config.C.ApplicationServer.FCntAnomalyDetection
+	if !conf.Enabled {
+		return
+	}
+
+	prev, found, err := fcntanomaly.GetAndSetFCnt(config.C.Redis.Pool, d.DevEUI, fCnt)
+	if err != nil {
+		log.WithError(err).WithField("dev_eui", d.DevEUI).Error("get and set frame-counter error")
+		return
+	}
+
+	if !found || activated || d.SuppressFCntAnomalies {
+		return
+	}
+
+	var typ, errStr string
+	switch fcntanomaly.Detect(prev, fCnt, conf.MaxGap) {
+	case fcntanomaly.CounterJump:
+		typ = fCntJumpWarning
+		errStr = fmt.Sprintf("frame-counter jumped from %d to %d (possible replay or counter manipulation)", prev, fCnt)
+	case fcntanomaly.CounterReset:
+		typ = fCntResetWarning
+		errStr = fmt.Sprintf("frame-counter decreased from %d to %d (possible device reset, replay or counter rollover)", prev, fCnt)
+	default:
+		return
+	}
+
+	suppressed, err := fcntanomaly.Suppress(config.C.Redis.Pool, d.DevEUI, conf.SuppressionInterval)
+	if err != nil {
+		log.WithError(err).WithField("dev_eui", d.DevEUI).Error("suppress frame-counter anomaly error")
+		return
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":        d.DevEUI,
+		"application_id": app.ID,
+		"type":           typ,
+		"suppressed":     suppressed,
+	}).Warning(errStr)
+
+	if suppressed {
+		return
+	}
+
+	securityevent.Log(storage.SecurityEvent{
+		Type:        securityevent.FCntAnomaly,
+		DevEUI:      &d.DevEUI,
+		Description: errStr,
+	})
+
+	errNotification := handler.ErrorNotification{
+		ApplicationID:   app.ID,
+		ApplicationName: app.Name,
+		DeviceName:      d.Name,
+		DevEUI:          d.DevEUI,
+		Type:            typ,
+		Error:           errStr,
+		FCnt:            fCnt,
+	}
+
+	if err := eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
+		Type:    eventlog.Error,
+		Payload: errNotification,
+	}); err != nil {
+		log.WithError(err).Error("log event for device error")
+	}
+
+	if !app.IsArchived() {
+		if err := config.C.ApplicationServer.Integration.Handler.SendErrorNotification(errNotification); err != nil {
+			log.WithError(err).Error("send error notification to handler error")
+		}
+	}
+}
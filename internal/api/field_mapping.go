@@ -0,0 +1,59 @@
+package api
+
+import (
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/correlation"
+	"github.com/brocaar/lora-app-server/internal/eventlog"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// fieldMappingError defines the notification type of a decoded object to
+// which the application field mappings could not be applied.
+const fieldMappingError = "FIELD_MAPPING"
+
+// applyFieldMappings applies the field mappings of the given application to
+// the decoded object. When the mappings can not be applied (e.g. a value
+// which must be converted is not a number), an error notification is sent
+// and the object is returned unmapped.
+func applyFieldMappings(ctx context.Context, d storage.Device, app storage.Application, fCnt uint32, object interface{}) interface{} {
+	out, err := app.FieldMappings.Apply(object)
+	if err == nil {
+		return out
+	}
+
+	correlation.Log(ctx).WithFields(log.Fields{
+		"application_id": app.ID,
+		"dev_eui":        d.DevEUI,
+		"f_cnt":          fCnt,
+	}).WithError(err).Error("apply field mappings error")
+
+	errNotification := handler.ErrorNotification{
+		ApplicationID:   app.ID,
+		ApplicationName: app.Name,
+		DeviceName:      d.Name,
+		DevEUI:          d.DevEUI,
+		Type:            fieldMappingError,
+		Error:           err.Error(),
+		FCnt:            fCnt,
+		CorrelationID:   correlation.FromContext(ctx),
+	}
+
+	if err := eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
+		Type:    eventlog.Error,
+		Payload: errNotification,
+	}); err != nil {
+		correlation.Log(ctx).WithError(err).Error("log event for device error")
+	}
+
+	if !app.IsArchived() {
+		if err := config.C.ApplicationServer.Integration.Handler.SendErrorNotification(errNotification); err != nil {
+			correlation.Log(ctx).WithError(err).Error("send error notification to handler error")
+		}
+	}
+
+	return object
+}
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// applyFieldMask copies the fields given by the field mask from src to dst.
+// This is used to implement partial updates: dst is the current object,
+// src the object as given in the update request. The paths may be given
+// either as the proto field name (e.g. "service_profile_id") or as the
+// JSON name (e.g. "serviceProfileID"). Nested fields are separated by a
+// dot (e.g. "location.altitude"). An InvalidArgument error is returned in
+// case of an unknown path.
+func applyFieldMask(dst, src proto.Message, mask *field_mask.FieldMask) error {
+	dstVal := reflect.ValueOf(dst)
+	srcVal := reflect.ValueOf(src)
+	if dstVal.Type() != srcVal.Type() || dstVal.Kind() != reflect.Ptr {
+		return errors.New("dst and src must be pointers of the same type")
+	}
+
+	for _, path := range mask.GetPaths() {
+		if err := copyPath(dstVal.Elem(), srcVal.Elem(), strings.Split(path, ".")); err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "update_mask: %s: %s", path, err)
+		}
+	}
+
+	return nil
+}
+
+func copyPath(dst, src reflect.Value, path []string) error {
+	i, ok := fieldByName(dst.Type(), path[0])
+	if !ok {
+		return errors.New("unknown field")
+	}
+
+	dstField := dst.Field(i)
+	srcField := src.Field(i)
+
+	if len(path) == 1 {
+		dstField.Set(srcField)
+		return nil
+	}
+
+	// nested message field
+	if dstField.Kind() != reflect.Ptr || dstField.Type().Elem().Kind() != reflect.Struct {
+		return errors.New("field has no sub-fields")
+	}
+
+	if srcField.IsNil() {
+		srcField = reflect.New(srcField.Type().Elem())
+	}
+	if dstField.IsNil() {
+		dstField.Set(reflect.New(dstField.Type().Elem()))
+	}
+
+	return copyPath(dstField.Elem(), srcField.Elem(), path[1:])
+}
+
+// fieldByName returns the index of the struct field matching the given
+// proto or JSON name.
+func fieldByName(typ reflect.Type, name string) (int, bool) {
+	for i := 0; i < typ.NumField(); i++ {
+		tag := typ.Field(i).Tag.Get("protobuf")
+		if tag == "" {
+			continue
+		}
+
+		for _, opt := range strings.Split(tag, ",") {
+			if opt == "name="+name || opt == "json="+name {
+				return i, true
+			}
+		}
+	}
+
+	return 0, false
+}
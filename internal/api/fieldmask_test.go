@@ -0,0 +1,106 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/brocaar/loraserver/api/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+)
+
+func TestApplyFieldMask(t *testing.T) {
+	current := func() *pb.Gateway {
+		return &pb.Gateway{
+			Id:          "0102030405060708",
+			Name:        "gateway",
+			Description: "description",
+			Location: &common.Location{
+				Latitude:  1,
+				Longitude: 2,
+				Altitude:  3,
+			},
+		}
+	}
+
+	tests := []struct {
+		Name          string
+		Update        *pb.Gateway
+		Paths         []string
+		Expected      *pb.Gateway
+		ExpectedError string
+	}{
+		{
+			Name:   "proto name",
+			Update: &pb.Gateway{Name: "new-name", Description: "ignored"},
+			Paths:  []string{"name"},
+			Expected: func() *pb.Gateway {
+				gw := current()
+				gw.Name = "new-name"
+				return gw
+			}(),
+		},
+		{
+			Name:   "json name",
+			Update: &pb.Gateway{OrganizationId: 10},
+			Paths:  []string{"organizationID"},
+			Expected: func() *pb.Gateway {
+				gw := current()
+				gw.OrganizationId = 10
+				return gw
+			}(),
+		},
+		{
+			Name:   "nested field",
+			Update: &pb.Gateway{Location: &common.Location{Altitude: 10}},
+			Paths:  []string{"location.altitude"},
+			Expected: func() *pb.Gateway {
+				gw := current()
+				gw.Location.Altitude = 10
+				return gw
+			}(),
+		},
+		{
+			Name:   "clear field",
+			Update: &pb.Gateway{},
+			Paths:  []string{"description"},
+			Expected: func() *pb.Gateway {
+				gw := current()
+				gw.Description = ""
+				return gw
+			}(),
+		},
+		{
+			Name:          "unknown field",
+			Update:        &pb.Gateway{},
+			Paths:         []string{"foo"},
+			ExpectedError: "update_mask: foo: unknown field",
+		},
+		{
+			Name:          "sub-field of scalar",
+			Update:        &pb.Gateway{},
+			Paths:         []string{"name.foo"},
+			ExpectedError: "update_mask: name.foo: field has no sub-fields",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			gw := current()
+			err := applyFieldMask(gw, test.Update, &field_mask.FieldMask{Paths: test.Paths})
+			if test.ExpectedError != "" {
+				assert.Equal(codes.InvalidArgument, grpc.Code(err))
+				assert.Equal(test.ExpectedError, grpc.ErrorDesc(err))
+				return
+			}
+
+			assert.NoError(err)
+			assert.Equal(test.Expected, gw)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/loraserver/api/gw"
+	"github.com/brocaar/lorawan"
+)
+
+func TestConvertUplinkAndDownlinkFrames(t *testing.T) {
+	phy := lorawan.PHYPayload{
+		MHDR: lorawan.MHDR{
+			MType: lorawan.UnconfirmedDataUp,
+			Major: lorawan.LoRaWANR1,
+		},
+		MACPayload: &lorawan.MACPayload{
+			FHDR: lorawan.FHDR{
+				DevAddr: lorawan.DevAddr{1, 2, 3, 4},
+				FCnt:    10,
+			},
+		},
+	}
+	phyB, err := phy.MarshalBinary()
+	require.NoError(t, err)
+
+	t.Run("Uplink", func(t *testing.T) {
+		assert := require.New(t)
+
+		up, down, err := convertUplinkAndDownlinkFrames(&gw.UplinkFrameSet{
+			PhyPayload: phyB,
+			RxInfo: []*gw.UplinkRXInfo{
+				{GatewayId: []byte{1, 2, 3, 4, 5, 6, 7, 8}, Rssi: -60},
+			},
+		}, nil, false)
+		assert.NoError(err)
+		assert.Nil(down)
+		assert.NotNil(up)
+		assert.Equal(phyB, up.PhyPayload)
+		assert.Contains(up.PhyPayloadJson, `"devAddr":"01020304"`)
+		assert.Len(up.RxInfo, 1)
+		assert.Equal("0102030405060708", up.RxInfo[0].GatewayId)
+	})
+
+	t.Run("Undecodable downlink", func(t *testing.T) {
+		assert := require.New(t)
+
+		up, down, err := convertUplinkAndDownlinkFrames(nil, &gw.DownlinkFrame{
+			PhyPayload: []byte{1, 2},
+			TxInfo: &gw.DownlinkTXInfo{
+				GatewayId: []byte{1, 2, 3, 4, 5, 6, 7, 8},
+			},
+		}, false)
+		assert.NoError(err)
+		assert.Nil(up)
+		assert.NotNil(down)
+		assert.Equal([]byte{1, 2}, down.PhyPayload)
+		assert.Equal("", down.PhyPayloadJson)
+		assert.Equal("0102030405060708", down.TxInfo.GatewayId)
+	})
+}
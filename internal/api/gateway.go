@@ -2,11 +2,13 @@ package api
 
 import (
 	"strings"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -14,6 +16,8 @@ import (
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/api/auth"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/signalstats"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/loraserver/api/ns"
 	"github.com/brocaar/lorawan"
@@ -69,6 +73,9 @@ func (a *GatewayAPI) Create(ctx context.Context, req *pb.CreateGatewayRequest) (
 		if err != nil {
 			return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
 		}
+		if err := validateGatewayProfileOrganization(gpID, req.Gateway.OrganizationId); err != nil {
+			return nil, err
+		}
 		createReq.Gateway.GatewayProfileId = gpID.Bytes()
 	}
 
@@ -95,6 +102,10 @@ func (a *GatewayAPI) Create(ctx context.Context, req *pb.CreateGatewayRequest) (
 	}
 
 	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		if err := storage.ValidateOrganizationGatewayQuota(tx, req.Gateway.OrganizationId); err != nil {
+			return errToRPCError(err)
+		}
+
 		err = storage.CreateGateway(tx, &storage.Gateway{
 			MAC:             mac,
 			Name:            req.Gateway.Name,
@@ -128,6 +139,13 @@ func (a *GatewayAPI) Create(ctx context.Context, req *pb.CreateGatewayRequest) (
 		return nil, err
 	}
 
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:         handler.GatewayEntity,
+		Action:         handler.CreateAction,
+		ID:             mac.String(),
+		OrganizationID: req.Gateway.OrganizationId,
+	})
+
 	return &empty.Empty{}, nil
 }
 
@@ -214,6 +232,28 @@ func (a *GatewayAPI) Get(ctx context.Context, req *pb.GetGatewayRequest) (*pb.Ge
 		resp.Gateway.Boards = append(resp.Gateway.Boards, &gwBoard)
 	}
 
+	md, err := storage.GetGatewayMetadata(config.C.PostgreSQL.DB, mac)
+	if err != nil && errors.Cause(err) != storage.ErrDoesNotExist {
+		return nil, errToRPCError(err)
+	}
+	if err == nil {
+		resp.Metadata = &pb.GatewayMetadata{
+			Ip:                     md.IP,
+			ConfigVersion:          md.ConfigVersion,
+			PacketForwarderVersion: md.PacketForwarderVersion,
+			Platform:               md.Platform,
+			Properties:             md.Properties,
+		}
+		if md.Temperature != nil {
+			resp.Metadata.HasTemperature = true
+			resp.Metadata.Temperature = *md.Temperature
+		}
+		resp.Metadata.UpdatedAt, err = ptypes.TimestampProto(md.UpdatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
 	return &resp, err
 }
 
@@ -224,15 +264,44 @@ func (a *GatewayAPI) List(ctx context.Context, req *pb.ListGatewayRequest) (*pb.
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	isAdmin, err := a.validator.GetIsAdmin(ctx)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	username, err := a.validator.GetUsername(ctx)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	tag, err := listETag(isAdmin, username, req.OrganizationId, storage.GetGatewayListVersion,
+		req.Limit, req.Offset, req.Search, req.Cursor)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	if notModified(ctx, tag) {
+		return &pb.ListGatewayResponse{}, nil
+	}
+
 	var count int
 	var gws []storage.Gateway
+	var after *storage.GatewayCursor
+	offset := int(req.Offset)
 
-	if req.OrganizationId == 0 {
-		isAdmin, err := a.validator.GetIsAdmin(ctx)
-		if err != nil {
-			return nil, errToRPCError(err)
+	if req.Cursor != "" {
+		var c gatewayCursor
+		if err := decodeCursor(req.Cursor, gatewayCursorKind, &c); err != nil {
+			return nil, err
+		}
+
+		offset = 0
+		after = &storage.GatewayCursor{
+			Name: c.Name,
+			MAC:  c.MAC,
 		}
+	}
 
+	if req.OrganizationId == 0 {
 		if isAdmin {
 			// in case of admin user list all gateways
 			count, err = storage.GetGatewayCount(config.C.PostgreSQL.DB, req.Search)
@@ -240,21 +309,17 @@ func (a *GatewayAPI) List(ctx context.Context, req *pb.ListGatewayRequest) (*pb.
 				return nil, errToRPCError(err)
 			}
 
-			gws, err = storage.GetGateways(config.C.PostgreSQL.DB, int(req.Limit), int(req.Offset), req.Search)
+			gws, err = storage.GetGateways(config.C.PostgreSQL.DB, int(req.Limit), offset, req.Search, after)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
 		} else {
 			// filter result based on user
-			username, err := a.validator.GetUsername(ctx)
-			if err != nil {
-				return nil, errToRPCError(err)
-			}
 			count, err = storage.GetGatewayCountForUser(config.C.PostgreSQL.DB, username, req.Search)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
-			gws, err = storage.GetGatewaysForUser(config.C.PostgreSQL.DB, username, int(req.Limit), int(req.Offset), req.Search)
+			gws, err = storage.GetGatewaysForUser(config.C.PostgreSQL.DB, username, int(req.Limit), offset, req.Search, after)
 			if err != nil {
 				return nil, errToRPCError(err)
 			}
@@ -264,7 +329,7 @@ func (a *GatewayAPI) List(ctx context.Context, req *pb.ListGatewayRequest) (*pb.
 		if err != nil {
 			return nil, errToRPCError(err)
 		}
-		gws, err = storage.GetGatewaysForOrganizationID(config.C.PostgreSQL.DB, req.OrganizationId, int(req.Limit), int(req.Offset), req.Search)
+		gws, err = storage.GetGatewaysForOrganizationID(config.C.PostgreSQL.DB, req.OrganizationId, int(req.Limit), offset, req.Search, after)
 		if err != nil {
 			return nil, errToRPCError(err)
 		}
@@ -295,6 +360,18 @@ func (a *GatewayAPI) List(ctx context.Context, req *pb.ListGatewayRequest) (*pb.
 		resp.Result = append(resp.Result, &row)
 	}
 
+	// a full page means that there might be more gateways
+	if len(gws) != 0 && len(gws) == int(req.Limit) {
+		last := gws[len(gws)-1]
+		resp.NextCursor, err = encodeCursor(gatewayCursorKind, gatewayCursor{
+			Name: last.Name,
+			MAC:  last.MAC,
+		})
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
 	return &resp, nil
 }
 
@@ -304,7 +381,7 @@ func (a *GatewayAPI) Update(ctx context.Context, req *pb.UpdateGatewayRequest) (
 		return nil, grpc.Errorf(codes.InvalidArgument, "gateway must not be nil")
 	}
 
-	if req.Gateway.Location == nil {
+	if req.Gateway.Location == nil && req.UpdateMask == nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, "gateway.location must not be nil")
 	}
 
@@ -318,12 +395,31 @@ func (a *GatewayAPI) Update(ctx context.Context, req *pb.UpdateGatewayRequest) (
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	var gw storage.Gateway
 	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
-		gw, err := storage.GetGateway(tx, mac, true)
+		var err error
+		gw, err = storage.GetGateway(tx, mac, true)
 		if err != nil {
 			return errToRPCError(err)
 		}
 
+		// in case of a partial update, merge the given fields into the
+		// current gateway
+		if req.UpdateMask != nil {
+			current, err := a.Get(ctx, &pb.GetGatewayRequest{Id: req.Gateway.Id})
+			if err != nil {
+				return err
+			}
+			if err := applyFieldMask(current.Gateway, req.Gateway, req.UpdateMask); err != nil {
+				return err
+			}
+			req.Gateway = current.Gateway
+
+			if req.Gateway.Location == nil {
+				return grpc.Errorf(codes.InvalidArgument, "gateway.location must not be nil")
+			}
+		}
+
 		gw.Name = req.Gateway.Name
 		gw.Description = req.Gateway.Description
 		gw.Ping = req.Gateway.DiscoveryEnabled
@@ -345,6 +441,9 @@ func (a *GatewayAPI) Update(ctx context.Context, req *pb.UpdateGatewayRequest) (
 			if err != nil {
 				return grpc.Errorf(codes.InvalidArgument, err.Error())
 			}
+			if err := validateGatewayProfileOrganization(gpID, gw.OrganizationID); err != nil {
+				return err
+			}
 			updateReq.Gateway.GatewayProfileId = gpID.Bytes()
 		}
 
@@ -390,6 +489,13 @@ func (a *GatewayAPI) Update(ctx context.Context, req *pb.UpdateGatewayRequest) (
 		return nil, err
 	}
 
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:         handler.GatewayEntity,
+		Action:         handler.UpdateAction,
+		ID:             mac.String(),
+		OrganizationID: gw.OrganizationID,
+	})
+
 	return &empty.Empty{}, nil
 }
 
@@ -405,7 +511,13 @@ func (a *GatewayAPI) Delete(ctx context.Context, req *pb.DeleteGatewayRequest) (
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	var gw storage.Gateway
 	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		gw, err = storage.GetGateway(tx, mac, true)
+		if err != nil {
+			return errToRPCError(err)
+		}
+
 		err = storage.DeleteGateway(tx, mac)
 		if err != nil {
 			return errToRPCError(err)
@@ -417,6 +529,13 @@ func (a *GatewayAPI) Delete(ctx context.Context, req *pb.DeleteGatewayRequest) (
 		return nil, err
 	}
 
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:         handler.GatewayEntity,
+		Action:         handler.DeleteAction,
+		ID:             mac.String(),
+		OrganizationID: gw.OrganizationID,
+	})
+
 	return &empty.Empty{}, nil
 }
 
@@ -458,6 +577,42 @@ func (a *GatewayAPI) GetStats(ctx context.Context, req *pb.GetGatewayStatsReques
 		StartTimestamp: req.StartTimestamp,
 		EndTimestamp:   req.EndTimestamp,
 	}
+
+	loc, err := getLocation(req.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	// the network-server aggregates in its own timezone, therefore the
+	// hourly stats are aggregated into the requested timezone
+	if req.Timezone != "" && statsReq.Interval >= ns.AggregationInterval_DAY {
+		if req.StartTimestamp != nil {
+			start, err := ptypes.Timestamp(req.StartTimestamp)
+			if err != nil {
+				return nil, grpc.Errorf(codes.InvalidArgument, "start_timestamp: %s", err)
+			}
+			statsReq.StartTimestamp, err = ptypes.TimestampProto(truncateToInterval(start, statsReq.Interval, loc))
+			if err != nil {
+				return nil, errToRPCError(err)
+			}
+		}
+		statsReq.Interval = ns.AggregationInterval_HOUR
+
+		stats, err := nsClient.GetGatewayStats(ctx, &statsReq)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := aggregateGatewayStats(stats.Result, ns.AggregationInterval(interval), loc)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.GetGatewayStatsResponse{
+			Result: result,
+		}, nil
+	}
+
 	stats, err := nsClient.GetGatewayStats(ctx, &statsReq)
 	if err != nil {
 		return nil, err
@@ -520,6 +675,192 @@ func (a *GatewayAPI) GetLastPing(ctx context.Context, req *pb.GetLastPingRequest
 	return &resp, nil
 }
 
+// ListDevices lists the devices which were recently received by the gateway.
+// Unless the user is a global admin, only the devices of the organization of
+// the gateway are returned, the other devices are only counted.
+func (a *GatewayAPI) ListDevices(ctx context.Context, req *pb.ListGatewayDevicesRequest) (*pb.ListGatewayDevicesResponse, error) {
+	var mac lorawan.EUI64
+	if err := mac.UnmarshalText([]byte(req.GatewayId)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "bad gateway mac: %s", err)
+	}
+
+	err := a.validator.Validate(ctx, auth.ValidateGatewayAccess(auth.Read, mac))
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	isAdmin, err := a.validator.GetIsAdmin(ctx)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	filters := storage.GatewayDeviceFilters{
+		GatewayMAC: mac,
+		Since:      time.Now().AddDate(0, 0, -7),
+		Limit:      int(req.Limit),
+		Offset:     int(req.Offset),
+	}
+
+	if req.Since != nil {
+		filters.Since, err = ptypes.Timestamp(req.Since)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "since: %s", err)
+		}
+	}
+
+	allCount, err := storage.GetGatewayDeviceCount(config.C.PostgreSQL.DB, filters)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if !isAdmin {
+		gw, err := storage.GetGateway(config.C.PostgreSQL.DB, mac, false)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+		filters.OrganizationID = gw.OrganizationID
+	}
+
+	count, err := storage.GetGatewayDeviceCount(config.C.PostgreSQL.DB, filters)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListGatewayDevicesResponse{
+		TotalCount:  int64(count),
+		HiddenCount: int64(allCount - count),
+	}
+
+	devices, err := storage.GetGatewayDevices(config.C.PostgreSQL.DB, filters)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	for _, d := range devices {
+		item := pb.GatewayDeviceListItem{
+			DevEui:          d.DevEUI.String(),
+			DeviceName:      d.DeviceName,
+			ApplicationId:   d.ApplicationID,
+			ApplicationName: d.ApplicationName,
+			OrganizationId:  d.OrganizationID,
+			Rssi:            int32(d.RSSI),
+			LoraSnr:         d.LoRaSNR,
+		}
+
+		item.LastSeenAt, err = ptypes.TimestampProto(d.LastSeenAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		resp.Result = append(resp.Result, &item)
+	}
+
+	return &resp, nil
+}
+
+// GetSignalHeatmap returns the received-signal statistics (RSSI and SNR) of
+// the gateway, aggregated per geohash cell of the device locations.
+func (a *GatewayAPI) GetSignalHeatmap(ctx context.Context, req *pb.GetGatewaySignalHeatmapRequest) (*pb.GetGatewaySignalHeatmapResponse, error) {
+	var mac lorawan.EUI64
+	if err := mac.UnmarshalText([]byte(req.GatewayId)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "bad gateway mac: %s", err)
+	}
+
+	err := a.validator.Validate(ctx, auth.ValidateGatewayAccess(auth.Read, mac))
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if req.Precision > signalstats.MaxPrecision {
+		return nil, grpc.Errorf(codes.InvalidArgument, "precision must be between 1 and %d", signalstats.MaxPrecision)
+	}
+
+	filter := storage.GatewaySignalStatsFilter{
+		GatewayMAC: mac,
+		End:        time.Now(),
+		Precision:  int(req.Precision),
+	}
+	if filter.Precision == 0 {
+		filter.Precision = signalstats.MaxPrecision
+	}
+
+	if req.EndTimestamp != nil {
+		end, err := ptypes.Timestamp(req.EndTimestamp)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "end_timestamp: %s", err)
+		}
+		filter.End = end
+	}
+
+	filter.Start = filter.End.AddDate(0, 0, -7)
+	if req.StartTimestamp != nil {
+		start, err := ptypes.Timestamp(req.StartTimestamp)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "start_timestamp: %s", err)
+		}
+		filter.Start = start
+	}
+
+	n, err := storage.GetNetworkServerForGatewayMAC(config.C.PostgreSQL.DB, mac)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	nsClient, err := config.C.NetworkServer.Pool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	getResp, err := nsClient.GetGateway(ctx, &ns.GetGatewayRequest{
+		Id: mac[:],
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cells, err := storage.GetGatewaySignalCells(config.C.PostgreSQL.DB, filter)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	var resp pb.GetGatewaySignalHeatmapResponse
+
+	// a location of 0, 0 means that the gateway location is not set
+	loc := getResp.Gateway.Location
+	if loc != nil && (loc.Latitude != 0 || loc.Longitude != 0) {
+		resp.Location = loc
+	}
+
+	for _, c := range cells {
+		lat, lon, err := signalstats.DecodeGeohash(c.Geohash)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		cell := pb.GatewaySignalCell{
+			Geohash:     c.Geohash,
+			Latitude:    lat,
+			Longitude:   lon,
+			UplinkCount: c.UplinkCount,
+			RssiAvg:     c.RSSIAvg,
+			RssiMin:     int32(c.RSSIMin),
+			RssiMax:     int32(c.RSSIMax),
+			SnrAvg:      c.SNRAvg,
+			SnrMin:      c.SNRMin,
+			SnrMax:      c.SNRMax,
+		}
+
+		if resp.Location != nil {
+			cell.Distance = signalstats.Distance(resp.Location.Latitude, resp.Location.Longitude, lat, lon)
+			cell.Bearing = signalstats.Bearing(resp.Location.Latitude, resp.Location.Longitude, lat, lon)
+		}
+
+		resp.Cells = append(resp.Cells, &cell)
+	}
+
+	return &resp, nil
+}
+
 // StreamFrameLogs streams the uplink and downlink frame-logs for the given mac.
 // Note: these are the raw LoRaWAN frames and this endpoint is intended for debugging.
 func (a *GatewayAPI) StreamFrameLogs(req *pb.StreamGatewayFrameLogsRequest, srv pb.GatewayService_StreamFrameLogsServer) error {
@@ -4,19 +4,47 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/gofrs/uuid"
 
+	"github.com/brocaar/loraserver/api/common"
 	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/band"
 
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/api/auth"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/correlation"
+	"github.com/brocaar/lora-app-server/internal/handler"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jmoiron/sqlx"
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 )
 
+// gatewayProfileRegion defines the LoRaWAN band and the frequency range (Hz)
+// of a network-server region, used to validate the gateway-profile channels.
+type gatewayProfileRegion struct {
+	band    band.Name
+	minFreq uint32
+	maxFreq uint32
+}
+
+// gatewayProfileRegions maps the network-server regions to the LoRaWAN bands.
+var gatewayProfileRegions = map[string]gatewayProfileRegion{
+	"EU868": {band.EU_863_870, 863000000, 870000000},
+	"US915": {band.US_902_928, 902000000, 928000000},
+	"CN779": {band.CN_779_787, 779000000, 787000000},
+	"EU433": {band.EU_433, 433175000, 434665000},
+	"AU915": {band.AU_915_928, 915000000, 928000000},
+	"CN470": {band.CN_470_510, 470000000, 510000000},
+	"AS923": {band.AS_923, 915000000, 928000000},
+	"KR920": {band.KR_920_923, 920900000, 923300000},
+	"IN865": {band.IN_865_867, 865000000, 867000000},
+	"RU864": {band.RU_864_870, 864000000, 870000000},
+}
+
 // GatewayProfileAPI exports the GatewayProfile related functions.
 type GatewayProfileAPI struct {
 	validator auth.Validator
@@ -36,7 +64,7 @@ func (a *GatewayProfileAPI) Create(ctx context.Context, req *pb.CreateGatewayPro
 	}
 
 	if err := a.validator.Validate(ctx,
-		auth.ValidateGatewayProfileAccess(auth.Create),
+		auth.ValidateGatewayProfilesAccess(auth.Create, req.GatewayProfile.OrganizationId),
 	); err != nil {
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
@@ -49,6 +77,17 @@ func (a *GatewayProfileAPI) Create(ctx context.Context, req *pb.CreateGatewayPro
 		},
 	}
 
+	if req.GatewayProfile.OrganizationId != 0 {
+		// also validate that the network-server is accessible for the given organization
+		if err := a.validator.Validate(ctx,
+			auth.ValidateOrganizationNetworkServerAccess(auth.Read, req.GatewayProfile.OrganizationId, req.GatewayProfile.NetworkServerId),
+		); err != nil {
+			return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+		}
+
+		gp.OrganizationID = &req.GatewayProfile.OrganizationId
+	}
+
 	for _, ec := range req.GatewayProfile.ExtraChannels {
 		gp.GatewayProfile.ExtraChannels = append(gp.GatewayProfile.ExtraChannels, &ns.GatewayProfileExtraChannel{
 			Frequency:        ec.Frequency,
@@ -59,6 +98,10 @@ func (a *GatewayProfileAPI) Create(ctx context.Context, req *pb.CreateGatewayPro
 		})
 	}
 
+	if err := validateGatewayProfileChannels(gp.NetworkServerID, gp.GatewayProfile); err != nil {
+		return nil, err
+	}
+
 	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
 		return storage.CreateGatewayProfile(tx, &gp)
 	})
@@ -78,17 +121,17 @@ func (a *GatewayProfileAPI) Create(ctx context.Context, req *pb.CreateGatewayPro
 
 // Get returns the gateway-profile matching the given id.
 func (a *GatewayProfileAPI) Get(ctx context.Context, req *pb.GetGatewayProfileRequest) (*pb.GetGatewayProfileResponse, error) {
-	if err := a.validator.Validate(ctx,
-		auth.ValidateGatewayProfileAccess(auth.Read),
-	); err != nil {
-		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
-	}
-
 	gpID, err := uuid.FromString(req.Id)
 	if err != nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, "uuid error: %s", err)
 	}
 
+	if err := a.validator.Validate(ctx,
+		auth.ValidateGatewayProfileAccess(auth.Read, gpID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
 	gp, err := storage.GetGatewayProfile(config.C.PostgreSQL.DB, gpID)
 	if err != nil {
 		return nil, errToRPCError(err)
@@ -103,6 +146,10 @@ func (a *GatewayProfileAPI) Get(ctx context.Context, req *pb.GetGatewayProfileRe
 		},
 	}
 
+	if gp.OrganizationID != nil {
+		out.GatewayProfile.OrganizationId = *gp.OrganizationID
+	}
+
 	out.CreatedAt, err = ptypes.TimestampProto(gp.CreatedAt)
 	if err != nil {
 		return nil, errToRPCError(err)
@@ -131,17 +178,17 @@ func (a *GatewayProfileAPI) Update(ctx context.Context, req *pb.UpdateGatewayPro
 		return nil, grpc.Errorf(codes.InvalidArgument, "gateway_profile must not be nil")
 	}
 
-	if err := a.validator.Validate(ctx,
-		auth.ValidateGatewayProfileAccess(auth.Update),
-	); err != nil {
-		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
-	}
-
 	gpID, err := uuid.FromString(req.GatewayProfile.Id)
 	if err != nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, "uuid error: %s", err)
 	}
 
+	if err := a.validator.Validate(ctx,
+		auth.ValidateGatewayProfileAccess(auth.Update, gpID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
 	gp, err := storage.GetGatewayProfile(config.C.PostgreSQL.DB, gpID)
 	if err != nil {
 		return nil, errToRPCError(err)
@@ -161,6 +208,10 @@ func (a *GatewayProfileAPI) Update(ctx context.Context, req *pb.UpdateGatewayPro
 		})
 	}
 
+	if err := validateGatewayProfileChannels(gp.NetworkServerID, gp.GatewayProfile); err != nil {
+		return nil, err
+	}
+
 	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
 		return storage.UpdateGatewayProfile(tx, &gp)
 	})
@@ -173,17 +224,17 @@ func (a *GatewayProfileAPI) Update(ctx context.Context, req *pb.UpdateGatewayPro
 
 // Delete deletes the gateway-profile matching the given id.
 func (a *GatewayProfileAPI) Delete(ctx context.Context, req *pb.DeleteGatewayProfileRequest) (*empty.Empty, error) {
-	if err := a.validator.Validate(ctx,
-		auth.ValidateGatewayProfileAccess(auth.Delete),
-	); err != nil {
-		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
-	}
-
 	gpID, err := uuid.FromString(req.Id)
 	if err != nil {
 		return nil, grpc.Errorf(codes.InvalidArgument, "uuid error: %s", err)
 	}
 
+	if err := a.validator.Validate(ctx,
+		auth.ValidateGatewayProfileAccess(auth.Delete, gpID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
 	err = storage.DeleteGatewayProfile(config.C.PostgreSQL.DB, gpID)
 	if err != nil {
 		return nil, errToRPCError(err)
@@ -195,32 +246,50 @@ func (a *GatewayProfileAPI) Delete(ctx context.Context, req *pb.DeleteGatewayPro
 // List returns the existing gateway-profiles.
 func (a *GatewayProfileAPI) List(ctx context.Context, req *pb.ListGatewayProfilesRequest) (*pb.ListGatewayProfilesResponse, error) {
 	if err := a.validator.Validate(ctx,
-		auth.ValidateGatewayProfileAccess(auth.List),
+		auth.ValidateGatewayProfilesAccess(auth.List, req.OrganizationId),
 	); err != nil {
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	var err error
+	isAdmin, err := a.validator.GetIsAdmin(ctx)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
 	var count int
 	var gps []storage.GatewayProfileMeta
 
-	if req.NetworkServerId == 0 {
-		count, err = storage.GetGatewayProfileCount(config.C.PostgreSQL.DB)
-		if err != nil {
-			return nil, errToRPCError(err)
-		}
-
-		gps, err = storage.GetGatewayProfiles(config.C.PostgreSQL.DB, int(req.Limit), int(req.Offset))
-		if err != nil {
-			return nil, errToRPCError(err)
+	if req.OrganizationId == 0 && isAdmin {
+		if req.NetworkServerId == 0 {
+			count, err = storage.GetGatewayProfileCount(config.C.PostgreSQL.DB)
+			if err != nil {
+				return nil, errToRPCError(err)
+			}
+
+			gps, err = storage.GetGatewayProfiles(config.C.PostgreSQL.DB, int(req.Limit), int(req.Offset))
+			if err != nil {
+				return nil, errToRPCError(err)
+			}
+		} else {
+			count, err = storage.GetGatewayProfileCountForNetworkServerID(config.C.PostgreSQL.DB, req.NetworkServerId)
+			if err != nil {
+				return nil, errToRPCError(err)
+			}
+
+			gps, err = storage.GetGatewayProfilesForNetworkServerID(config.C.PostgreSQL.DB, req.NetworkServerId, int(req.Limit), int(req.Offset))
+			if err != nil {
+				return nil, errToRPCError(err)
+			}
 		}
 	} else {
-		count, err = storage.GetGatewayProfileCountForNetworkServerID(config.C.PostgreSQL.DB, req.NetworkServerId)
+		// when no organization ID is given, this only returns the global
+		// gateway-profiles
+		count, err = storage.GetGatewayProfileCountForOrganizationID(config.C.PostgreSQL.DB, req.OrganizationId, req.NetworkServerId)
 		if err != nil {
 			return nil, errToRPCError(err)
 		}
 
-		gps, err = storage.GetGatewayProfilesForNetworkServerID(config.C.PostgreSQL.DB, req.NetworkServerId, int(req.Limit), int(req.Offset))
+		gps, err = storage.GetGatewayProfilesForOrganizationID(config.C.PostgreSQL.DB, req.OrganizationId, req.NetworkServerId, int(req.Limit), int(req.Offset))
 		if err != nil {
 			return nil, errToRPCError(err)
 		}
@@ -238,6 +307,10 @@ func (a *GatewayProfileAPI) List(ctx context.Context, req *pb.ListGatewayProfile
 			NetworkServerId:   gp.NetworkServerID,
 		}
 
+		if gp.OrganizationID != nil {
+			row.OrganizationId = *gp.OrganizationID
+		}
+
 		row.CreatedAt, err = ptypes.TimestampProto(gp.CreatedAt)
 		if err != nil {
 			return nil, errToRPCError(err)
@@ -252,3 +325,231 @@ func (a *GatewayProfileAPI) List(ctx context.Context, req *pb.ListGatewayProfile
 
 	return &out, nil
 }
+
+// AssignToGateways assigns the gateway-profile to all the gateways matching
+// the given filters. For every processed gateway, a progress report is
+// streamed.
+func (a *GatewayProfileAPI) AssignToGateways(req *pb.AssignGatewayProfileToGatewaysRequest, srv pb.GatewayProfileService_AssignToGatewaysServer) error {
+	ctx := srv.Context()
+
+	gpID, err := uuid.FromString(req.GatewayProfileId)
+	if err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "uuid error: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateGatewayProfileAccess(auth.Read, gpID),
+	); err != nil {
+		return grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if req.OrganizationId != 0 {
+		err = a.validator.Validate(ctx, auth.ValidateIsOrganizationAdmin(req.OrganizationId))
+	} else {
+		err = a.validator.Validate(ctx, auth.ValidateIsAdmin())
+	}
+	if err != nil {
+		return grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	gp, err := storage.GetGatewayProfileMeta(config.C.PostgreSQL.DB, gpID)
+	if err != nil {
+		return errToRPCError(err)
+	}
+
+	filters := storage.GatewayFilters{
+		OrganizationID:  req.OrganizationId,
+		NetworkServerID: gp.NetworkServerID,
+		Search:          req.Search,
+	}
+
+	// organization gateway-profiles can only be assigned to the gateways
+	// of the organization
+	if gp.OrganizationID != nil {
+		if filters.OrganizationID != 0 && filters.OrganizationID != *gp.OrganizationID {
+			return grpc.Errorf(codes.InvalidArgument, "gateway-profile %s belongs to an other organization", gpID)
+		}
+		filters.OrganizationID = *gp.OrganizationID
+	}
+
+	gatewayIDs := make(map[lorawan.EUI64]struct{})
+	for _, id := range req.GatewayIds {
+		var mac lorawan.EUI64
+		if err := mac.UnmarshalText([]byte(id)); err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "gateway_ids: %s", err)
+		}
+		gatewayIDs[mac] = struct{}{}
+	}
+
+	gws, err := storage.GetGatewaysForFilters(config.C.PostgreSQL.DB, filters)
+	if err != nil {
+		return errToRPCError(err)
+	}
+
+	if len(gatewayIDs) != 0 {
+		var filtered []storage.Gateway
+		for _, gw := range gws {
+			if _, ok := gatewayIDs[gw.MAC]; ok {
+				filtered = append(filtered, gw)
+			}
+		}
+		gws = filtered
+	}
+
+	n, err := storage.GetNetworkServer(config.C.PostgreSQL.DB, gp.NetworkServerID)
+	if err != nil {
+		return errToRPCError(err)
+	}
+
+	nsClient, err := config.C.NetworkServer.Pool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return errToRPCError(err)
+	}
+
+	resp := pb.AssignGatewayProfileToGatewaysResponse{
+		TotalCount: int64(len(gws)),
+	}
+
+	for _, gw := range gws {
+		if err := ctx.Err(); err != nil {
+			return grpc.Errorf(codes.Canceled, "%s", err)
+		}
+
+		resp.GatewayId = gw.MAC.String()
+		resp.GatewayName = gw.Name
+		resp.Error = ""
+		resp.ProcessedCount++
+
+		if err := assignGatewayProfile(ctx, nsClient, gpID, gw.MAC); err != nil {
+			correlation.Log(ctx).WithError(err).WithFields(log.Fields{
+				"gateway_id":         gw.MAC,
+				"gateway_profile_id": gpID,
+			}).Error("assign gateway-profile error")
+
+			resp.Error = grpc.ErrorDesc(err)
+			resp.FailedCount++
+		} else {
+			sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+				Entity:         handler.GatewayEntity,
+				Action:         handler.UpdateAction,
+				ID:             gw.MAC.String(),
+				OrganizationID: gw.OrganizationID,
+			})
+		}
+
+		if err := srv.Send(&resp); err != nil {
+			return err
+		}
+	}
+
+	correlation.Log(ctx).WithFields(log.Fields{
+		"gateway_profile_id": gpID,
+		"total_count":        resp.TotalCount,
+		"failed_count":       resp.FailedCount,
+	}).Info("gateway-profile assigned to gateways")
+
+	return nil
+}
+
+// assignGatewayProfile assigns the given gateway-profile to the given
+// gateway, keeping the other gateway settings (e.g. location and boards)
+// as-is.
+func assignGatewayProfile(ctx context.Context, nsClient ns.NetworkServerServiceClient, gatewayProfileID uuid.UUID, mac lorawan.EUI64) error {
+	resp, err := nsClient.GetGateway(ctx, &ns.GetGatewayRequest{
+		Id: mac[:],
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Gateway == nil {
+		return grpc.Errorf(codes.Internal, "gateway must not be nil")
+	}
+
+	gw := *resp.Gateway
+	gw.GatewayProfileId = gatewayProfileID.Bytes()
+
+	_, err = nsClient.UpdateGateway(ctx, &ns.UpdateGatewayRequest{
+		Gateway: &gw,
+	})
+	return err
+}
+
+// validateGatewayProfileChannels validates the channels of the given
+// gateway-profile against the region of the given network-server. The
+// default channels must be defined by the LoRaWAN Regional Parameters of
+// the region and the extra channels must be within the band of the region.
+func validateGatewayProfileChannels(networkServerID int64, gp ns.GatewayProfile) error {
+	n, err := storage.GetNetworkServer(config.C.PostgreSQL.DB, networkServerID)
+	if err != nil {
+		return errToRPCError(err)
+	}
+
+	region, err := storage.GetNetworkServerRegion(config.C.Redis.Pool, n)
+	if err != nil {
+		return errToRPCError(err)
+	}
+
+	r, ok := gatewayProfileRegions[region]
+	if !ok {
+		return grpc.Errorf(codes.FailedPrecondition, "unknown network-server region: %s", region)
+	}
+
+	b, err := band.GetConfig(r.band, false, lorawan.DwellTimeNoLimit)
+	if err != nil {
+		return errToRPCError(err)
+	}
+
+	defaultChannels := make(map[uint32]struct{})
+	for _, i := range b.GetStandardUplinkChannelIndices() {
+		defaultChannels[uint32(i)] = struct{}{}
+	}
+
+	for _, c := range gp.Channels {
+		if _, ok := defaultChannels[c]; !ok {
+			return grpc.Errorf(codes.InvalidArgument, "channel %d is not a default channel of region %s", c, region)
+		}
+	}
+
+	for _, ec := range gp.ExtraChannels {
+		if ec.Frequency < r.minFreq || ec.Frequency > r.maxFreq {
+			return grpc.Errorf(codes.InvalidArgument, "extra channel frequency %d is outside the band of region %s (%d - %d)", ec.Frequency, region, r.minFreq, r.maxFreq)
+		}
+
+		switch ec.Modulation {
+		case common.Modulation_LORA:
+			if ec.Bandwidth != 125 && ec.Bandwidth != 250 && ec.Bandwidth != 500 {
+				return grpc.Errorf(codes.InvalidArgument, "extra channel %d: bandwidth must be 125, 250 or 500", ec.Frequency)
+			}
+			if len(ec.SpreadingFactors) == 0 {
+				return grpc.Errorf(codes.InvalidArgument, "extra channel %d: spreading factors must be set", ec.Frequency)
+			}
+			for _, sf := range ec.SpreadingFactors {
+				if sf < 7 || sf > 12 {
+					return grpc.Errorf(codes.InvalidArgument, "extra channel %d: spreading factor %d must be between 7 and 12", ec.Frequency, sf)
+				}
+			}
+		case common.Modulation_FSK:
+			if ec.Bitrate == 0 {
+				return grpc.Errorf(codes.InvalidArgument, "extra channel %d: bitrate must be set", ec.Frequency)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateGatewayProfileOrganization validates that the given gateway-profile
+// can be assigned to a gateway of the given organization, this is the case
+// for global gateway-profiles and the gateway-profiles of the organization.
+func validateGatewayProfileOrganization(gatewayProfileID uuid.UUID, organizationID int64) error {
+	gp, err := storage.GetGatewayProfileMeta(config.C.PostgreSQL.DB, gatewayProfileID)
+	if err != nil {
+		return errToRPCError(err)
+	}
+
+	if gp.OrganizationID != nil && *gp.OrganizationID != organizationID {
+		return grpc.Errorf(codes.InvalidArgument, "gateway-profile %s belongs to an other organization", gatewayProfileID)
+	}
+
+	return nil
+}
@@ -16,8 +16,24 @@ import (
 	"github.com/brocaar/lora-app-server/internal/config"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
 )
 
+type testAssignToGatewaysServer struct {
+	grpc.ServerStream
+	ctx   context.Context
+	resps []pb.AssignGatewayProfileToGatewaysResponse
+}
+
+func (s *testAssignToGatewaysServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testAssignToGatewaysServer) Send(resp *pb.AssignGatewayProfileToGatewaysResponse) error {
+	s.resps = append(s.resps, *resp)
+	return nil
+}
+
 func TestGatewayProfileTest(t *testing.T) {
 	conf := test.GetConfig()
 	db, err := storage.OpenDatabase(conf.PostgresDSN)
@@ -25,9 +41,11 @@ func TestGatewayProfileTest(t *testing.T) {
 		t.Fatal(err)
 	}
 	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL, 10, 0)
 
 	Convey("Given a clean database and api instance", t, func() {
 		test.MustResetDB(db)
+		test.MustFlushRedis(config.C.Redis.Pool)
 
 		nsClient := test.NewNetworkServerClient()
 		config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
@@ -163,6 +181,177 @@ func TestGatewayProfileTest(t *testing.T) {
 				So(listResp.Result[0].Name, ShouldEqual, createReq.GatewayProfile.Name)
 				So(listResp.Result[0].NetworkServerId, ShouldEqual, n.ID)
 			})
+
+			Convey("Given two gateways", func() {
+				org := storage.Organization{
+					Name:            "test-org",
+					CanHaveGateways: true,
+				}
+				So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+				gws := []storage.Gateway{
+					{MAC: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, Name: "gw-1", OrganizationID: org.ID, NetworkServerID: n.ID},
+					{MAC: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, Name: "gw-2", OrganizationID: org.ID, NetworkServerID: n.ID},
+				}
+				for i := range gws {
+					So(storage.CreateGateway(config.C.PostgreSQL.DB, &gws[i]), ShouldBeNil)
+				}
+
+				nsClient.GetGatewayResponse = ns.GetGatewayResponse{
+					Gateway: &ns.Gateway{
+						Location: &common.Location{Latitude: 1.123},
+					},
+				}
+
+				Convey("Then AssignToGateways assigns the gateway-profile to the matching gateways", func() {
+					srv := testAssignToGatewaysServer{ctx: ctx}
+					So(api.AssignToGateways(&pb.AssignGatewayProfileToGatewaysRequest{
+						GatewayProfileId: createResp.Id,
+						OrganizationId:   org.ID,
+					}, &srv), ShouldBeNil)
+
+					So(srv.resps, ShouldHaveLength, 2)
+					So(srv.resps[1], ShouldResemble, pb.AssignGatewayProfileToGatewaysResponse{
+						GatewayId:      gws[1].MAC.String(),
+						GatewayName:    "gw-2",
+						ProcessedCount: 2,
+						TotalCount:     2,
+					})
+
+					gpID, err := uuid.FromString(createResp.Id)
+					So(err, ShouldBeNil)
+					So(nsClient.UpdateGatewayChan, ShouldHaveLength, 2)
+					for range gws {
+						updateReq := <-nsClient.UpdateGatewayChan
+						So(updateReq.Gateway.GatewayProfileId, ShouldResemble, gpID.Bytes())
+						So(updateReq.Gateway.Location, ShouldResemble, &common.Location{Latitude: 1.123})
+					}
+				})
+
+				Convey("Then AssignToGateways given gateway IDs only assigns the gateway-profile to these gateways", func() {
+					srv := testAssignToGatewaysServer{ctx: ctx}
+					So(api.AssignToGateways(&pb.AssignGatewayProfileToGatewaysRequest{
+						GatewayProfileId: createResp.Id,
+						OrganizationId:   org.ID,
+						GatewayIds:       []string{gws[0].MAC.String()},
+					}, &srv), ShouldBeNil)
+
+					So(srv.resps, ShouldHaveLength, 1)
+					So(srv.resps[0].GatewayId, ShouldEqual, gws[0].MAC.String())
+					So(srv.resps[0].TotalCount, ShouldEqual, 1)
+					So(nsClient.UpdateGatewayChan, ShouldHaveLength, 1)
+				})
+			})
+
+			Convey("Given an organization gateway-profile", func() {
+				org := storage.Organization{
+					Name: "test-org",
+				}
+				So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+				orgCreateReq := pb.CreateGatewayProfileRequest{
+					GatewayProfile: &pb.GatewayProfile{
+						Name:            "test-org-gp",
+						NetworkServerId: n.ID,
+						OrganizationId:  org.ID,
+						Channels:        []uint32{0, 1, 2},
+					},
+				}
+				orgCreateResp, err := api.Create(ctx, &orgCreateReq)
+				So(err, ShouldBeNil)
+				<-nsClient.CreateGatewayProfileChan
+
+				Convey("Then List given the organization ID lists the global and organization gateway-profiles", func() {
+					listResp, err := api.List(ctx, &pb.ListGatewayProfilesRequest{
+						OrganizationId: org.ID,
+						Limit:          10,
+					})
+					So(err, ShouldBeNil)
+					So(listResp.TotalCount, ShouldEqual, 2)
+					So(listResp.Result, ShouldHaveLength, 2)
+					So(listResp.Result[1].Id, ShouldEqual, orgCreateResp.Id)
+					So(listResp.Result[1].OrganizationId, ShouldEqual, org.ID)
+				})
+
+				Convey("Then List given no organization ID lists the global gateway-profiles for non-admin users", func() {
+					listResp, err := api.List(ctx, &pb.ListGatewayProfilesRequest{
+						Limit: 10,
+					})
+					So(err, ShouldBeNil)
+					So(listResp.TotalCount, ShouldEqual, 1)
+					So(listResp.Result[0].Id, ShouldEqual, createResp.Id)
+				})
+
+				Convey("Then List given no organization ID lists all gateway-profiles for global admin users", func() {
+					validator.returnIsAdmin = true
+					listResp, err := api.List(ctx, &pb.ListGatewayProfilesRequest{
+						Limit: 10,
+					})
+					So(err, ShouldBeNil)
+					So(listResp.TotalCount, ShouldEqual, 2)
+				})
+
+				Convey("Then the gateway-profile can only be assigned to gateways of the organization", func() {
+					orgGPID, err := uuid.FromString(orgCreateResp.Id)
+					So(err, ShouldBeNil)
+					globalGPID, err := uuid.FromString(createResp.Id)
+					So(err, ShouldBeNil)
+
+					So(validateGatewayProfileOrganization(orgGPID, org.ID), ShouldBeNil)
+					So(validateGatewayProfileOrganization(globalGPID, org.ID), ShouldBeNil)
+
+					err = validateGatewayProfileOrganization(orgGPID, org.ID+1)
+					So(err, ShouldNotBeNil)
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				})
+			})
+		})
+
+		Convey("Then Create validates the channels against the network-server region", func() {
+			tests := []struct {
+				Name          string
+				Channels      []uint32
+				ExtraChannels []*pb.GatewayProfileExtraChannel
+			}{
+				{
+					Name:     "invalid default channel",
+					Channels: []uint32{0, 1, 2, 3},
+				},
+				{
+					Name:     "extra channel outside band",
+					Channels: []uint32{0, 1, 2},
+					ExtraChannels: []*pb.GatewayProfileExtraChannel{
+						{Modulation: common.Modulation_LORA, Frequency: 915200000, Bandwidth: 125, SpreadingFactors: []uint32{7}},
+					},
+				},
+				{
+					Name:     "invalid spreading factor",
+					Channels: []uint32{0, 1, 2},
+					ExtraChannels: []*pb.GatewayProfileExtraChannel{
+						{Modulation: common.Modulation_LORA, Frequency: 867100000, Bandwidth: 125, SpreadingFactors: []uint32{6}},
+					},
+				},
+				{
+					Name:     "missing fsk bitrate",
+					Channels: []uint32{0, 1, 2},
+					ExtraChannels: []*pb.GatewayProfileExtraChannel{
+						{Modulation: common.Modulation_FSK, Frequency: 868800000},
+					},
+				},
+			}
+
+			for _, tst := range tests {
+				_, err := api.Create(ctx, &pb.CreateGatewayProfileRequest{
+					GatewayProfile: &pb.GatewayProfile{
+						Name:            tst.Name,
+						NetworkServerId: n.ID,
+						Channels:        tst.Channels,
+						ExtraChannels:   tst.ExtraChannels,
+					},
+				})
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			}
+			So(nsClient.CreateGatewayProfileChan, ShouldHaveLength, 0)
 		})
 	})
 }
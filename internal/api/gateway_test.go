@@ -12,6 +12,7 @@ import (
 
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/signalstats"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lora-app-server/internal/test"
 	"github.com/brocaar/loraserver/api/common"
@@ -84,6 +85,38 @@ func (ts *APITestSuite) TestGateway() {
 			assert.Equal(createReq.Gateway, getResp.Gateway)
 			assert.NotEqual("", getResp.CreatedAt)
 			assert.NotEqual("", getResp.UpdatedAt)
+			assert.Nil(getResp.Metadata)
+
+			t.Run("With metadata", func(t *testing.T) {
+				assert := require.New(t)
+
+				temp := 42.5
+				md := storage.GatewayMetadata{
+					GatewayMAC:             lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+					IP:                     "192.168.1.10",
+					ConfigVersion:          "1.2.3",
+					PacketForwarderVersion: "5.0.1",
+					Platform:               "rpi",
+					Temperature:            &temp,
+					Properties: storage.GatewayMetadataProperties{
+						"platform": "rpi",
+					},
+				}
+				assert.NoError(storage.SetGatewayMetadata(ts.DB(), &md))
+
+				getResp, err := api.Get(ctx, &pb.GetGatewayRequest{
+					Id: createReq.Gateway.Id,
+				})
+				assert.NoError(err)
+				assert.NotNil(getResp.Metadata)
+				assert.Equal("192.168.1.10", getResp.Metadata.Ip)
+				assert.Equal("1.2.3", getResp.Metadata.ConfigVersion)
+				assert.Equal("5.0.1", getResp.Metadata.PacketForwarderVersion)
+				assert.Equal("rpi", getResp.Metadata.Platform)
+				assert.True(getResp.Metadata.HasTemperature)
+				assert.Equal(42.5, getResp.Metadata.Temperature)
+				assert.Equal(map[string]string{"platform": "rpi"}, getResp.Metadata.Properties)
+			})
 		})
 
 		t.Run("List", func(t *testing.T) {
@@ -245,6 +278,49 @@ func (ts *APITestSuite) TestGateway() {
 			}, nsReq)
 		})
 
+		t.Run("GetSignalHeatmap", func(t *testing.T) {
+			assert := require.New(t)
+
+			mac := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+			for _, rssi := range []int{-60, -80} {
+				assert.NoError(storage.IncrementGatewaySignalStats(ts.DB(), storage.GatewaySignalStats{
+					GatewayMAC:  mac,
+					Date:        time.Now(),
+					Geohash:     signalstats.EncodeGeohash(1.2, 2.2, 7),
+					UplinkCount: 1,
+					RSSISum:     float64(rssi),
+					RSSIMin:     rssi,
+					RSSIMax:     rssi,
+					SNRSum:      5,
+					SNRMin:      5,
+					SNRMax:      5,
+				}))
+			}
+
+			resp, err := api.GetSignalHeatmap(ctx, &pb.GetGatewaySignalHeatmapRequest{
+				GatewayId: createReq.Gateway.Id,
+				Precision: 5,
+			})
+			assert.NoError(err)
+			assert.NotNil(resp.Location)
+			assert.Len(resp.Cells, 1)
+
+			cell := resp.Cells[0]
+			assert.Equal(signalstats.EncodeGeohash(1.2, 2.2, 5), cell.Geohash)
+			assert.EqualValues(2, cell.UplinkCount)
+			assert.Equal(-70.0, cell.RssiAvg)
+			assert.EqualValues(-80, cell.RssiMin)
+			assert.EqualValues(-60, cell.RssiMax)
+			assert.Equal(5.0, cell.SnrAvg)
+			assert.Equal(signalstats.Distance(resp.Location.Latitude, resp.Location.Longitude, cell.Latitude, cell.Longitude), cell.Distance)
+
+			_, err = api.GetSignalHeatmap(ctx, &pb.GetGatewaySignalHeatmapRequest{
+				GatewayId: createReq.Gateway.Id,
+				Precision: 13,
+			})
+			assert.Equal(codes.InvalidArgument, grpc.Code(err))
+		})
+
 		t.Run("GetLastPing", func(t *testing.T) {
 			assert := require.New(t)
 
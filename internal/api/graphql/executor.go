@@ -0,0 +1,232 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+)
+
+type executor struct {
+	schema    *Schema
+	doc       *document
+	variables map[string]interface{}
+	errors    []Error
+}
+
+// collectedField contains a field of the response, merging the selections of
+// all the fields with the same response key.
+type collectedField struct {
+	*field
+	selections []selection
+}
+
+func (e *executor) addError(path []interface{}, err error) {
+	e.errors = append(e.errors, Error{
+		Message: errorMessage(err).Error(),
+		Path:    path,
+	})
+}
+
+// executeSelectionSet executes the given selections on the source object of
+// the given type.
+func (e *executor) executeSelectionSet(ctx context.Context, typ string, fields map[string]*Field, source interface{}, sels []selection, path []interface{}) interface{} {
+	out := newOrderedMap()
+
+	collected, err := e.collectFields(typ, sels)
+	if err != nil {
+		e.addError(path, err)
+		return nil
+	}
+
+	for _, f := range collected {
+		key := f.responseKey()
+		fieldPath := appendPath(path, key)
+
+		if f.name == "__typename" {
+			out.set(key, typ)
+			continue
+		}
+
+		if fd, ok := fields[f.name]; ok {
+			val, err := fd.Resolve(ctx, source, e.arguments(f.arguments))
+			if err != nil {
+				e.addError(fieldPath, err)
+				out.set(key, nil)
+				continue
+			}
+
+			out.set(key, e.completeValue(ctx, fd.Type, val, f.selections, fieldPath))
+			continue
+		}
+
+		obj, ok := source.(map[string]interface{})
+		if !ok || typ == queryType {
+			e.addError(fieldPath, fmt.Errorf("cannot query field %q on type %q", f.name, typ))
+			out.set(key, nil)
+			continue
+		}
+
+		out.set(key, e.completeValue(ctx, "", obj[f.name], f.selections, fieldPath))
+	}
+
+	return out
+}
+
+// completeValue completes the given (resolved) value by executing the
+// selections on objects and lists of objects.
+func (e *executor) completeValue(ctx context.Context, typ string, val interface{}, sels []selection, path []interface{}) interface{} {
+	switch v := val.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i := range v {
+			out[i] = e.completeValue(ctx, typ, v[i], sels, appendPath(path, i))
+		}
+		return out
+	case map[string]interface{}:
+		if len(sels) == 0 {
+			if typ != "" {
+				e.addError(path, fmt.Errorf("field of type %q must have a selection of subfields", typ))
+				return nil
+			}
+
+			// untyped objects are returned as-is when no selection is given
+			return v
+		}
+
+		return e.executeSelectionSet(ctx, typ, e.schema.Types[typ], v, sels, path)
+	default:
+		if len(sels) != 0 {
+			e.addError(path, fmt.Errorf("field of scalar type must not have a selection of subfields"))
+			return nil
+		}
+		return v
+	}
+}
+
+// collectFields returns the fields of the given selections, taking the
+// fragments and the skip and include directives into account.
+func (e *executor) collectFields(typ string, sels []selection) ([]*collectedField, error) {
+	var out []*collectedField
+	byKey := make(map[string]*collectedField)
+	visited := make(map[string]bool)
+
+	var collect func(sels []selection) error
+	collect = func(sels []selection) error {
+		for _, sel := range sels {
+			switch s := sel.(type) {
+			case *field:
+				include, err := e.include(s.directives)
+				if err != nil {
+					return err
+				}
+				if !include {
+					continue
+				}
+
+				if cf, ok := byKey[s.responseKey()]; ok {
+					if cf.name != s.name {
+						return fmt.Errorf("fields %q and %q conflict, use different aliases", cf.name, s.name)
+					}
+					cf.selections = append(cf.selections, s.selections...)
+					continue
+				}
+
+				cf := collectedField{
+					field:      s,
+					selections: append([]selection{}, s.selections...),
+				}
+				byKey[s.responseKey()] = &cf
+				out = append(out, &cf)
+			case *fragmentSpread:
+				include, err := e.include(s.directives)
+				if err != nil {
+					return err
+				}
+				if !include || visited[s.name] {
+					continue
+				}
+				visited[s.name] = true
+
+				frag, ok := e.doc.fragments[s.name]
+				if !ok {
+					return fmt.Errorf("unknown fragment %q", s.name)
+				}
+				if !typeMatches(typ, frag.typeCondition) {
+					continue
+				}
+				if err := collect(frag.selections); err != nil {
+					return err
+				}
+			case *inlineFragment:
+				include, err := e.include(s.directives)
+				if err != nil {
+					return err
+				}
+				if !include || !typeMatches(typ, s.typeCondition) {
+					continue
+				}
+				if err := collect(s.selections); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	if err := collect(sels); err != nil {
+		return nil, err
+	}
+
+	// the fields of the collected field must be replaced by the merged
+	// selections
+	for _, cf := range out {
+		f := *cf.field
+		f.selections = cf.selections
+		cf.field = &f
+	}
+
+	return out, nil
+}
+
+// include returns false when the skip or include directive excludes the
+// selection.
+func (e *executor) include(dirs []directive) (bool, error) {
+	for _, d := range dirs {
+		if d.name != "skip" && d.name != "include" {
+			continue
+		}
+
+		args := e.arguments(d.arguments)
+		cond, ok := args["if"].(bool)
+		if !ok {
+			return false, fmt.Errorf("directive @%s requires a boolean if argument", d.name)
+		}
+
+		if (d.name == "skip" && cond) || (d.name == "include" && !cond) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (e *executor) arguments(args []argument) map[string]interface{} {
+	out := make(map[string]interface{}, len(args))
+	for _, arg := range args {
+		out[arg.name] = valueToInterface(arg.value, e.variables)
+	}
+	return out
+}
+
+// typeMatches returns true when the type condition applies to the given
+// type. As untyped objects do not have a type, any condition applies.
+func typeMatches(typ, typeCondition string) bool {
+	return typeCondition == "" || typ == "" || typ == typeCondition
+}
+
+func appendPath(path []interface{}, elem interface{}) []interface{} {
+	out := make([]interface{}, len(path), len(path)+1)
+	copy(out, path)
+	return append(out, elem)
+}
@@ -0,0 +1,283 @@
+// Package graphql implements a GraphQL endpoint for the external API.
+//
+// The schema is loosely typed: objects are the JSON representation of the
+// external API messages, of which any (nested) field can be selected. Object
+// types only need to define the fields which do not map directly to the
+// JSON representation, e.g. relations to other objects. Mutations and
+// introspection are not supported.
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/grpc/status"
+)
+
+const (
+	queryType        = "Query"
+	subscriptionType = "Subscription"
+)
+
+// ResolveFunc resolves the value of a field. The source is the value of the
+// parent object (nil for root fields). Objects must be returned as
+// map[string]interface{} and lists as []interface{}.
+type ResolveFunc func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error)
+
+// SubscribeFunc subscribes to the events of a subscription field. The
+// returned channel must be closed when the subscription ends. An error
+// sent over the channel is returned as error to the subscriber.
+type SubscribeFunc func(ctx context.Context, args map[string]interface{}) (<-chan interface{}, error)
+
+// Field defines a field of an object type.
+type Field struct {
+	// Type of the resolved object (or of its items when a list is
+	// resolved). Leave empty for untyped objects and scalars.
+	Type string
+
+	// Resolve resolves the field value.
+	Resolve ResolveFunc
+}
+
+// SubscriptionField defines a root field of the subscription type.
+type SubscriptionField struct {
+	// Type of the event objects. Leave empty for untyped objects.
+	Type string
+
+	// Subscribe subscribes to the events.
+	Subscribe SubscribeFunc
+}
+
+// Schema defines the GraphQL schema.
+type Schema struct {
+	// Query contains the root fields of the query type.
+	Query map[string]*Field
+
+	// Subscription contains the root fields of the subscription type.
+	Subscription map[string]*SubscriptionField
+
+	// Types contains the fields of the object types.
+	Types map[string]map[string]*Field
+}
+
+// Request defines a GraphQL request.
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Response defines a GraphQL response.
+type Response struct {
+	Data   interface{} `json:"data"`
+	Errors []Error     `json:"errors,omitempty"`
+}
+
+// Error defines a GraphQL error.
+type Error struct {
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path,omitempty"`
+}
+
+// Execute executes the given query request.
+func (s *Schema) Execute(ctx context.Context, req Request) *Response {
+	doc, op, err := s.prepare(req)
+	if err != nil {
+		return errorResponse(err)
+	}
+
+	if op.kind != "query" {
+		return errorResponse(fmt.Errorf("%s operations are not supported by execute", op.kind))
+	}
+
+	e := executor{
+		schema:    s,
+		doc:       doc,
+		variables: variablesWithDefaults(op, req.Variables),
+	}
+	data := e.executeSelectionSet(ctx, queryType, s.Query, nil, op.selections, nil)
+
+	return &Response{
+		Data:   data,
+		Errors: e.errors,
+	}
+}
+
+// Subscribe executes the given subscription request. A response is returned
+// for every event, the channel is closed when the subscription ends (e.g.
+// when the context is cancelled). For query requests, the channel returns
+// a single response.
+func (s *Schema) Subscribe(ctx context.Context, req Request) (<-chan *Response, error) {
+	doc, op, err := s.prepare(req)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *Response, 1)
+
+	switch op.kind {
+	case "query":
+		out <- s.Execute(ctx, req)
+		close(out)
+		return out, nil
+	case "subscription":
+	default:
+		return nil, fmt.Errorf("%s operations are not supported", op.kind)
+	}
+
+	e := executor{
+		schema:    s,
+		doc:       doc,
+		variables: variablesWithDefaults(op, req.Variables),
+	}
+
+	fields, err := e.collectFields(subscriptionType, op.selections)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) != 1 {
+		return nil, fmt.Errorf("subscription must select exactly one root field")
+	}
+
+	f := fields[0]
+	sf, ok := s.Subscription[f.name]
+	if !ok {
+		return nil, fmt.Errorf("cannot query field %q on type %q", f.name, subscriptionType)
+	}
+
+	events, err := sf.Subscribe(ctx, e.arguments(f.arguments))
+	if err != nil {
+		return nil, errorMessage(err)
+	}
+
+	go func() {
+		defer close(out)
+
+		for event := range events {
+			var resp Response
+
+			if err, ok := event.(error); ok {
+				resp.Errors = []Error{{Message: errorMessage(err).Error(), Path: []interface{}{f.responseKey()}}}
+			} else {
+				e.errors = nil
+				data := newOrderedMap()
+				data.set(f.responseKey(), e.completeValue(ctx, sf.Type, event, f.selections, []interface{}{f.responseKey()}))
+				resp.Data = data
+				resp.Errors = e.errors
+			}
+
+			select {
+			case out <- &resp:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// prepare parses the request and returns the operation to execute.
+func (s *Schema) prepare(req Request) (*document, *operation, error) {
+	doc, err := parse(req.Query)
+	if err != nil {
+		return nil, nil, fmt.Errorf("syntax error: %s", err)
+	}
+
+	if req.OperationName == "" {
+		if len(doc.operations) != 1 {
+			return nil, nil, fmt.Errorf("operationName is required when the document contains multiple operations")
+		}
+		return doc, doc.operations[0], nil
+	}
+
+	for _, op := range doc.operations {
+		if op.name == req.OperationName {
+			return doc, op, nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("unknown operation %q", req.OperationName)
+}
+
+func variablesWithDefaults(op *operation, vars map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{})
+	for _, vd := range op.variables {
+		if vd.hasDefault {
+			out[vd.name] = valueToInterface(vd.defaultValue, nil)
+		}
+	}
+	for k, v := range vars {
+		out[k] = v
+	}
+	return out
+}
+
+func errorResponse(err error) *Response {
+	return &Response{
+		Errors: []Error{{Message: err.Error()}},
+	}
+}
+
+// errorMessage strips the gRPC status from the given error (if any), as
+// these errors are returned by the external API services.
+func errorMessage(err error) error {
+	if s, ok := status.FromError(err); ok {
+		return fmt.Errorf("%s", s.Message())
+	}
+	return err
+}
+
+// orderedMap is a map which is JSON encoded in insertion order, as the
+// response fields must be returned in the order they were requested.
+type orderedMap struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+func newOrderedMap() *orderedMap {
+	return &orderedMap{
+		values: make(map[string]interface{}),
+	}
+}
+
+func (m *orderedMap) set(key string, val interface{}) {
+	if _, ok := m.values[key]; !ok {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = val
+}
+
+func (m *orderedMap) get(key string) interface{} {
+	return m.values[key]
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (m *orderedMap) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	for i, k := range m.keys {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+
+		kb, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(kb)
+		buf.WriteByte(':')
+
+		vb, err := json.Marshal(m.values[k])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(vb)
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
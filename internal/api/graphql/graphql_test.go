@@ -0,0 +1,231 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func testSchema() *Schema {
+	items := []interface{}{
+		map[string]interface{}{"id": "1", "name": "foo", "nested": map[string]interface{}{"a": 1.0, "b": 2.0}},
+		map[string]interface{}{"id": "2", "name": "bar", "nested": map[string]interface{}{"a": 3.0, "b": 4.0}},
+	}
+
+	return &Schema{
+		Query: map[string]*Field{
+			"items": {
+				Type: "Item",
+				Resolve: func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+					limit, err := int64Arg(args, "limit", 10)
+					if err != nil {
+						return nil, err
+					}
+					if limit > int64(len(items)) {
+						limit = int64(len(items))
+					}
+					return items[:limit], nil
+				},
+			},
+			"failing": {
+				Resolve: func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+					return nil, fmt.Errorf("boom")
+				},
+			},
+		},
+		Subscription: map[string]*SubscriptionField{
+			"events": {
+				Subscribe: func(ctx context.Context, args map[string]interface{}) (<-chan interface{}, error) {
+					out := make(chan interface{}, 3)
+					out <- map[string]interface{}{"type": "up", "payload": map[string]interface{}{"fCnt": 1.0}}
+					out <- map[string]interface{}{"type": "up", "payload": map[string]interface{}{"fCnt": 2.0}}
+					out <- fmt.Errorf("stream error")
+					close(out)
+					return out, nil
+				},
+			},
+		},
+		Types: map[string]map[string]*Field{
+			"Item": {
+				"upperName": {
+					Resolve: func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+						return fmt.Sprintf("%s!", sourceField(source, "name")), nil
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestExecute(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Request  Request
+		Expected string
+	}{
+		{
+			Name:     "field selection",
+			Request:  Request{Query: `{ items { id name } }`},
+			Expected: `{"data":{"items":[{"id":"1","name":"foo"},{"id":"2","name":"bar"}]}}`,
+		},
+		{
+			Name:     "aliases, arguments and resolved fields",
+			Request:  Request{Query: `query { first: items(limit: 1) { upperName, n: name } }`},
+			Expected: `{"data":{"first":[{"upperName":"foo!","n":"foo"}]}}`,
+		},
+		{
+			Name: "variables and fragments",
+			Request: Request{
+				Query:     `query Items($limit: Int = 2, $skip: Boolean!) { items(limit: $limit) { ...F nested { a b @skip(if: $skip) } } } fragment F on Item { id __typename }`,
+				Variables: map[string]interface{}{"limit": json.Number("1"), "skip": true},
+			},
+			Expected: `{"data":{"items":[{"id":"1","__typename":"Item","nested":{"a":1}}]}}`,
+		},
+		{
+			Name:     "untyped object without selection",
+			Request:  Request{Query: `{ items(limit: 1) { nested } }`},
+			Expected: `{"data":{"items":[{"nested":{"a":1,"b":2}}]}}`,
+		},
+		{
+			Name:     "resolve error",
+			Request:  Request{Query: `{ failing items(limit: 1) { id } }`},
+			Expected: `{"data":{"failing":null,"items":[{"id":"1"}]},"errors":[{"message":"boom","path":["failing"]}]}`,
+		},
+		{
+			Name:     "missing selection",
+			Request:  Request{Query: `{ items }`},
+			Expected: `{"data":{"items":[null,null]},"errors":[{"message":"field of type \"Item\" must have a selection of subfields","path":["items",0]},{"message":"field of type \"Item\" must have a selection of subfields","path":["items",1]}]}`,
+		},
+		{
+			Name:     "unknown field",
+			Request:  Request{Query: `{ unknown }`},
+			Expected: `{"data":{"unknown":null},"errors":[{"message":"cannot query field \"unknown\" on type \"Query\"","path":["unknown"]}]}`,
+		},
+		{
+			Name:     "syntax error",
+			Request:  Request{Query: `{ items { id }`},
+			Expected: `{"data":null,"errors":[{"message":"syntax error: unexpected end of document at position 14"}]}`,
+		},
+		{
+			Name:     "mutation",
+			Request:  Request{Query: `mutation { items { id } }`},
+			Expected: `{"data":null,"errors":[{"message":"mutation operations are not supported by execute"}]}`,
+		},
+	}
+
+	s := testSchema()
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			b, err := json.Marshal(s.Execute(context.Background(), test.Request))
+			assert.NoError(err)
+			assert.Equal(test.Expected, string(b))
+		})
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	assert := require.New(t)
+	s := testSchema()
+
+	responses, err := s.Subscribe(context.Background(), Request{
+		Query: `subscription { e: events { type payload { fCnt } } }`,
+	})
+	assert.NoError(err)
+
+	var out []string
+	for resp := range responses {
+		b, err := json.Marshal(resp)
+		assert.NoError(err)
+		out = append(out, string(b))
+	}
+
+	assert.Equal([]string{
+		`{"data":{"e":{"type":"up","payload":{"fCnt":1}}}}`,
+		`{"data":{"e":{"type":"up","payload":{"fCnt":2}}}}`,
+		`{"data":null,"errors":[{"message":"stream error","path":["e"]}]}`,
+	}, out)
+
+	_, err = s.Subscribe(context.Background(), Request{
+		Query: `subscription { events { type } other: events { type } }`,
+	})
+	assert.EqualError(err, "subscription must select exactly one root field")
+}
+
+// testTokenValidator returns the expiration time of the tokens in the map,
+// other tokens are invalid.
+type testTokenValidator map[string]time.Time
+
+func (v testTokenValidator) GetExpiresAt(ctx context.Context) (time.Time, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if exp, ok := v[strings.Join(md["authorization"], "")]; ok {
+		return exp, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid token")
+}
+
+func TestWebsocketTokenRefresh(t *testing.T) {
+	assert := require.New(t)
+
+	// dial returns a connection to a new server, of which the short token
+	// expires after 200ms
+	dial := func() (*httptest.Server, *websocket.Conn) {
+		server := httptest.NewServer(NewHandler(testSchema(), testTokenValidator{
+			"Bearer short": time.Now().Add(200 * time.Millisecond),
+			"Bearer long":  time.Now().Add(time.Hour),
+		}))
+
+		dialer := websocket.Dialer{Subprotocols: []string{wsProtocol}}
+		conn, _, err := dialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+		assert.NoError(err)
+		return server, conn
+	}
+
+	send := func(conn *websocket.Conn, typ, payload string) wsMessage {
+		assert.NoError(conn.WriteJSON(wsMessage{Type: typ, Payload: json.RawMessage(payload)}))
+
+		var msg wsMessage
+		assert.NoError(conn.ReadJSON(&msg))
+		return msg
+	}
+
+	t.Run("refreshed token", func(t *testing.T) {
+		server, conn := dial()
+		defer server.Close()
+		defer conn.Close()
+
+		assert.Equal(wsConnectionAck, send(conn, wsConnectionInit, `{"authorization": "Bearer short"}`).Type)
+		assert.Equal(wsConnectionError, send(conn, wsConnectionRefresh, `{"authorization": "Bearer invalid"}`).Type)
+		assert.Equal(wsConnectionAck, send(conn, wsConnectionRefresh, `{"authorization": "Bearer long"}`).Type)
+
+		time.Sleep(300 * time.Millisecond)
+
+		msg := send(conn, wsStart, `{"query": "subscription { events { type } }"}`)
+		assert.Equal(wsData, msg.Type)
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		server, conn := dial()
+		defer server.Close()
+		defer conn.Close()
+
+		assert.Equal(wsConnectionAck, send(conn, wsConnectionInit, `{"authorization": "Bearer short"}`).Type)
+
+		var msg wsMessage
+		assert.NoError(conn.ReadJSON(&msg))
+		assert.Equal(wsConnectionError, msg.Type)
+		assert.JSONEq(`{"message": "token expired"}`, string(msg.Payload))
+
+		assert.Error(conn.ReadJSON(&msg))
+	})
+}
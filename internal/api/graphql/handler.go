@@ -0,0 +1,338 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+)
+
+// maxRequestSize defines the max. size of a (POST) request body.
+const maxRequestSize = 1 << 20
+
+// graphql-ws protocol message types.
+const (
+	wsProtocol            = "graphql-ws"
+	wsConnectionInit      = "connection_init"
+	wsConnectionAck       = "connection_ack"
+	wsConnectionError     = "connection_error"
+	wsConnectionTerminate = "connection_terminate"
+	wsConnectionRefresh   = "connection_refresh"
+	wsStart               = "start"
+	wsStop                = "stop"
+	wsData                = "data"
+	wsError               = "error"
+	wsComplete            = "complete"
+)
+
+type wsMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// TokenValidator defines the interface used for validating the token of
+// websocket connections.
+type TokenValidator interface {
+	// GetExpiresAt returns the expiration time of the token in the given
+	// context. A zero time is returned when the token does not expire.
+	GetExpiresAt(context.Context) (time.Time, error)
+}
+
+type handler struct {
+	schema    *Schema
+	validator TokenValidator
+	upgrader  websocket.Upgrader
+}
+
+// NewHandler returns a http.Handler for the given schema. It handles queries
+// over GET and POST requests and subscriptions over websocket connections
+// (using the graphql-ws protocol).
+//
+// The authorization token must be set using the Authorization header
+// ("Bearer <token>"). For websocket connections, the token can also be set
+// using the Sec-WebSocket-Protocol header ("Bearer", "<token>") or the
+// authorization field of the connection_init payload.
+//
+// When a validator is given, websocket connections are closed when their
+// token expires. Before that, the token can be replaced using the
+// authorization field of a connection_refresh message, which is
+// acknowledged by a connection_ack message.
+func NewHandler(s *Schema, v TokenValidator) http.Handler {
+	return &handler{
+		schema:    s,
+		validator: v,
+		upgrader: websocket.Upgrader{
+			Subprotocols: []string{wsProtocol, "Bearer"},
+			// requests are authorized by token, not by cookie
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+	}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if websocket.IsWebSocketUpgrade(r) {
+		h.serveWebsocket(w, r)
+		return
+	}
+
+	var req Request
+
+	switch r.Method {
+	case http.MethodGet:
+		req.Query = r.URL.Query().Get("query")
+		req.OperationName = r.URL.Query().Get("operationName")
+		if vars := r.URL.Query().Get("variables"); vars != "" {
+			if err := decodeJSON([]byte(vars), &req.Variables); err != nil {
+				writeJSON(w, http.StatusBadRequest, errorResponse(err))
+				return
+			}
+		}
+	case http.MethodPost:
+		b, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestSize))
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, errorResponse(err))
+			return
+		}
+
+		contentType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if contentType == "application/graphql" {
+			req.Query = string(b)
+		} else if err := decodeJSON(b, &req); err != nil {
+			writeJSON(w, http.StatusBadRequest, errorResponse(err))
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := ContextWithAuthorization(r.Context(), getAuthorization(r))
+	resp := h.schema.Execute(ctx, req)
+
+	status := http.StatusOK
+	if resp.Data == nil && len(resp.Errors) != 0 {
+		status = http.StatusBadRequest
+	}
+
+	writeJSON(w, status, resp)
+}
+
+func (h *handler) serveWebsocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.WithError(err).Error("graphql: websocket upgrade error")
+		return
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	var writeMu sync.Mutex
+	write := func(msg wsMessage) {
+		writeMu.Lock()
+		defer writeMu.Unlock()
+
+		if err := conn.WriteJSON(msg); err != nil {
+			log.WithError(err).Error("graphql: websocket write error")
+		}
+	}
+
+	// the connection is closed when the token expires, unless it has been
+	// refreshed before
+	var expiry *time.Timer
+	setAuthorization := func(auth string) error {
+		if h.validator == nil {
+			return nil
+		}
+
+		expiresAt, err := h.validator.GetExpiresAt(ContextWithAuthorization(ctx, auth))
+		if err != nil {
+			return err
+		}
+
+		if expiry != nil {
+			expiry.Stop()
+			expiry = nil
+		}
+
+		if !expiresAt.IsZero() {
+			expiry = time.AfterFunc(time.Until(expiresAt), func() {
+				write(wsMessage{Type: wsConnectionError, Payload: marshalPayload(Error{Message: "token expired"})})
+				conn.Close()
+			})
+		}
+
+		return nil
+	}
+
+	// an invalid token is not rejected here, as it can still be replaced
+	// by the connection_init payload (subscriptions using the invalid token
+	// are rejected by the api)
+	authorization := getAuthorization(r)
+	if authorization != "" {
+		setAuthorization(authorization)
+	}
+
+	subscriptions := make(map[string]context.CancelFunc)
+	var wg sync.WaitGroup
+
+	defer func() {
+		if expiry != nil {
+			expiry.Stop()
+		}
+		cancel()
+		wg.Wait()
+	}()
+
+	for {
+		var msg wsMessage
+		if err := conn.ReadJSON(&msg); err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				log.WithError(err).Error("graphql: websocket read error")
+			}
+			return
+		}
+
+		switch msg.Type {
+		case wsConnectionInit:
+			var payload struct {
+				Authorization string `json:"authorization"`
+			}
+			if len(msg.Payload) != 0 {
+				if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+					write(wsMessage{Type: wsConnectionError, Payload: marshalPayload(Error{Message: err.Error()})})
+					continue
+				}
+			}
+			if payload.Authorization != "" {
+				if err := setAuthorization(payload.Authorization); err != nil {
+					write(wsMessage{Type: wsConnectionError, Payload: marshalPayload(Error{Message: err.Error()})})
+					continue
+				}
+				authorization = payload.Authorization
+			}
+			write(wsMessage{Type: wsConnectionAck})
+		case wsConnectionRefresh:
+			// running subscriptions are not affected, the new token is used
+			// for the subscriptions started after the refresh
+			var payload struct {
+				Authorization string `json:"authorization"`
+			}
+			if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+				write(wsMessage{Type: wsConnectionError, Payload: marshalPayload(Error{Message: err.Error()})})
+				continue
+			}
+			if payload.Authorization == "" {
+				write(wsMessage{Type: wsConnectionError, Payload: marshalPayload(Error{Message: "authorization must be set"})})
+				continue
+			}
+			if err := setAuthorization(payload.Authorization); err != nil {
+				write(wsMessage{Type: wsConnectionError, Payload: marshalPayload(Error{Message: err.Error()})})
+				continue
+			}
+			authorization = payload.Authorization
+			write(wsMessage{Type: wsConnectionAck})
+		case wsConnectionTerminate:
+			return
+		case wsStart:
+			var req Request
+			if err := decodeJSON(msg.Payload, &req); err != nil {
+				write(wsMessage{ID: msg.ID, Type: wsError, Payload: marshalPayload(Error{Message: err.Error()})})
+				continue
+			}
+
+			if stop, ok := subscriptions[msg.ID]; ok {
+				stop()
+			}
+
+			subCtx, subCancel := context.WithCancel(ContextWithAuthorization(ctx, authorization))
+			subscriptions[msg.ID] = subCancel
+
+			responses, err := h.schema.Subscribe(subCtx, req)
+			if err != nil {
+				write(wsMessage{ID: msg.ID, Type: wsError, Payload: marshalPayload(Error{Message: err.Error()})})
+				continue
+			}
+
+			wg.Add(1)
+			go func(id string) {
+				defer wg.Done()
+
+				for resp := range responses {
+					write(wsMessage{ID: id, Type: wsData, Payload: marshalPayload(resp)})
+				}
+
+				if subCtx.Err() == nil {
+					write(wsMessage{ID: id, Type: wsComplete})
+				}
+			}(msg.ID)
+		case wsStop:
+			if stop, ok := subscriptions[msg.ID]; ok {
+				stop()
+				delete(subscriptions, msg.ID)
+			}
+		default:
+			write(wsMessage{ID: msg.ID, Type: wsError, Payload: marshalPayload(Error{Message: "unknown message type: " + msg.Type})})
+		}
+	}
+}
+
+// getAuthorization returns the authorization from the given request.
+func getAuthorization(r *http.Request) string {
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		return auth
+	}
+
+	// this header is also used by the grpc-gateway
+	if auth := r.Header.Get("Grpc-Metadata-Authorization"); auth != "" {
+		return auth
+	}
+
+	// browsers are not able to set headers on websocket connections,
+	// therefore the token can be passed as sub-protocol
+	protocols := websocket.Subprotocols(r)
+	for i := range protocols {
+		if protocols[i] == "Bearer" && i+1 < len(protocols) {
+			return "Bearer " + strings.TrimSpace(protocols[i+1])
+		}
+	}
+
+	return ""
+}
+
+// decodeJSON decodes the given JSON, using json.Number for numbers so that
+// int64 values are not truncated.
+func decodeJSON(b []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+func marshalPayload(v interface{}) json.RawMessage {
+	b, err := json.Marshal(v)
+	if err != nil {
+		log.WithError(err).Error("graphql: marshal json error")
+		b, _ = json.Marshal(Error{Message: "marshal json error"})
+	}
+	return b
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.WithError(err).Error("graphql: write response error")
+	}
+}
@@ -0,0 +1,189 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenPunct
+	tokenName
+	tokenInt
+	tokenFloat
+	tokenString
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+// lex splits the given GraphQL document into tokens.
+func lex(src string) ([]token, error) {
+	var tokens []token
+	i := 0
+
+	for i < len(src) {
+		c := src[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			i++
+		case c == '#':
+			for i < len(src) && src[i] != '\n' && src[i] != '\r' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "\ufeff"):
+			i += len("\ufeff")
+		case strings.HasPrefix(src[i:], "..."):
+			tokens = append(tokens, token{kind: tokenPunct, value: "...", pos: i})
+			i += 3
+		case strings.IndexByte("!$&():=@[]{}|", c) != -1:
+			tokens = append(tokens, token{kind: tokenPunct, value: string(c), pos: i})
+			i++
+		case c == '_' || isLetter(c):
+			start := i
+			for i < len(src) && (src[i] == '_' || isLetter(src[i]) || isDigit(src[i])) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenName, value: src[start:i], pos: start})
+		case c == '-' || isDigit(c):
+			t, err := lexNumber(src, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, t)
+			i += len(t.value)
+		case strings.HasPrefix(src[i:], `"""`):
+			end := strings.Index(src[i+3:], `"""`)
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated block string at position %d", i)
+			}
+			tokens = append(tokens, token{kind: tokenString, value: src[i+3 : i+3+end], pos: i})
+			i += end + 6
+		case c == '"':
+			s, n, err := lexString(src, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenString, value: s, pos: i})
+			i += n
+		default:
+			r, _ := utf8.DecodeRuneInString(src[i:])
+			return nil, fmt.Errorf("unexpected character %q at position %d", r, i)
+		}
+	}
+
+	tokens = append(tokens, token{kind: tokenEOF, pos: len(src)})
+	return tokens, nil
+}
+
+func lexNumber(src string, start int) (token, error) {
+	i := start
+	kind := tokenInt
+
+	if src[i] == '-' {
+		i++
+	}
+
+	digits := func() bool {
+		n := i
+		for i < len(src) && isDigit(src[i]) {
+			i++
+		}
+		return i > n
+	}
+
+	if !digits() {
+		return token{}, fmt.Errorf("invalid number at position %d", start)
+	}
+
+	if i < len(src) && src[i] == '.' {
+		kind = tokenFloat
+		i++
+		if !digits() {
+			return token{}, fmt.Errorf("invalid number at position %d", start)
+		}
+	}
+
+	if i < len(src) && (src[i] == 'e' || src[i] == 'E') {
+		kind = tokenFloat
+		i++
+		if i < len(src) && (src[i] == '+' || src[i] == '-') {
+			i++
+		}
+		if !digits() {
+			return token{}, fmt.Errorf("invalid number at position %d", start)
+		}
+	}
+
+	return token{kind: kind, value: src[start:i], pos: start}, nil
+}
+
+// lexString returns the unescaped string starting at the given position and
+// the number of bytes consumed.
+func lexString(src string, start int) (string, int, error) {
+	var b strings.Builder
+	i := start + 1
+
+	for i < len(src) {
+		c := src[i]
+		switch c {
+		case '"':
+			return b.String(), i - start + 1, nil
+		case '\n', '\r':
+			return "", 0, fmt.Errorf("unterminated string at position %d", start)
+		case '\\':
+			if i+1 >= len(src) {
+				return "", 0, fmt.Errorf("unterminated string at position %d", start)
+			}
+			i++
+			switch src[i] {
+			case '"', '\\', '/':
+				b.WriteByte(src[i])
+			case 'b':
+				b.WriteByte('\b')
+			case 'f':
+				b.WriteByte('\f')
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case 'u':
+				if i+4 >= len(src) {
+					return "", 0, fmt.Errorf("invalid unicode escape at position %d", i)
+				}
+				r, err := strconv.ParseUint(src[i+1:i+5], 16, 32)
+				if err != nil {
+					return "", 0, fmt.Errorf("invalid unicode escape at position %d", i)
+				}
+				b.WriteRune(rune(r))
+				i += 4
+			default:
+				return "", 0, fmt.Errorf("invalid escape sequence at position %d", i)
+			}
+			i++
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+
+	return "", 0, fmt.Errorf("unterminated string at position %d", start)
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,562 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+)
+
+type document struct {
+	operations []*operation
+	fragments  map[string]*fragmentDefinition
+}
+
+type operation struct {
+	kind       string
+	name       string
+	variables  []variableDefinition
+	selections []selection
+}
+
+type variableDefinition struct {
+	name         string
+	defaultValue value
+	hasDefault   bool
+}
+
+type fragmentDefinition struct {
+	name          string
+	typeCondition string
+	selections    []selection
+}
+
+// selection is one of *field, *fragmentSpread or *inlineFragment.
+type selection interface{}
+
+type field struct {
+	alias      string
+	name       string
+	arguments  []argument
+	directives []directive
+	selections []selection
+}
+
+// responseKey returns the key of the field in the response.
+func (f *field) responseKey() string {
+	if f.alias != "" {
+		return f.alias
+	}
+	return f.name
+}
+
+type fragmentSpread struct {
+	name       string
+	directives []directive
+}
+
+type inlineFragment struct {
+	typeCondition string
+	directives    []directive
+	selections    []selection
+}
+
+type argument struct {
+	name  string
+	value value
+}
+
+type directive struct {
+	name      string
+	arguments []argument
+}
+
+// value is one of variable, enumValue, listValue, objectValue or a scalar
+// (int64, float64, string, bool or nil).
+type value interface{}
+
+type variable string
+
+type enumValue string
+
+type listValue []value
+
+type objectValue []argument
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// parse parses the given GraphQL document.
+func parse(src string) (*document, error) {
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := parser{tokens: tokens}
+	doc := document{
+		fragments: make(map[string]*fragmentDefinition),
+	}
+
+	for p.peek().kind != tokenEOF {
+		t := p.peek()
+
+		switch {
+		case t.kind == tokenPunct && t.value == "{":
+			sels, err := p.parseSelectionSet()
+			if err != nil {
+				return nil, err
+			}
+			doc.operations = append(doc.operations, &operation{
+				kind:       "query",
+				selections: sels,
+			})
+		case t.kind == tokenName && (t.value == "query" || t.value == "mutation" || t.value == "subscription"):
+			op, err := p.parseOperation()
+			if err != nil {
+				return nil, err
+			}
+			doc.operations = append(doc.operations, op)
+		case t.kind == tokenName && t.value == "fragment":
+			frag, err := p.parseFragmentDefinition()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := doc.fragments[frag.name]; ok {
+				return nil, fmt.Errorf("fragment %q is defined more than once", frag.name)
+			}
+			doc.fragments[frag.name] = frag
+		default:
+			return nil, p.unexpected(t)
+		}
+	}
+
+	if len(doc.operations) == 0 {
+		return nil, fmt.Errorf("document does not contain any operation")
+	}
+
+	return &doc, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) isPunct(v string) bool {
+	t := p.peek()
+	return t.kind == tokenPunct && t.value == v
+}
+
+func (p *parser) skipPunct(v string) bool {
+	if p.isPunct(v) {
+		p.next()
+		return true
+	}
+	return false
+}
+
+func (p *parser) expectPunct(v string) error {
+	t := p.next()
+	if t.kind != tokenPunct || t.value != v {
+		return fmt.Errorf("expected %q at position %d, got %s", v, t.pos, describe(t))
+	}
+	return nil
+}
+
+func (p *parser) expectName() (string, error) {
+	t := p.next()
+	if t.kind != tokenName {
+		return "", fmt.Errorf("expected name at position %d, got %s", t.pos, describe(t))
+	}
+	return t.value, nil
+}
+
+func (p *parser) unexpected(t token) error {
+	return fmt.Errorf("unexpected %s at position %d", describe(t), t.pos)
+}
+
+func describe(t token) string {
+	if t.kind == tokenEOF {
+		return "end of document"
+	}
+	return strconv.Quote(t.value)
+}
+
+func (p *parser) parseOperation() (*operation, error) {
+	op := operation{
+		kind: p.next().value,
+	}
+
+	if p.peek().kind == tokenName {
+		op.name = p.next().value
+	}
+
+	if p.skipPunct("(") {
+		for !p.skipPunct(")") {
+			vd, err := p.parseVariableDefinition()
+			if err != nil {
+				return nil, err
+			}
+			op.variables = append(op.variables, vd)
+		}
+	}
+
+	if _, err := p.parseDirectives(); err != nil {
+		return nil, err
+	}
+
+	sels, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+	op.selections = sels
+
+	return &op, nil
+}
+
+func (p *parser) parseVariableDefinition() (variableDefinition, error) {
+	var vd variableDefinition
+
+	if err := p.expectPunct("$"); err != nil {
+		return vd, err
+	}
+
+	name, err := p.expectName()
+	if err != nil {
+		return vd, err
+	}
+	vd.name = name
+
+	if err := p.expectPunct(":"); err != nil {
+		return vd, err
+	}
+
+	if err := p.parseType(); err != nil {
+		return vd, err
+	}
+
+	if p.skipPunct("=") {
+		v, err := p.parseValue(true)
+		if err != nil {
+			return vd, err
+		}
+		vd.defaultValue = v
+		vd.hasDefault = true
+	}
+
+	return vd, nil
+}
+
+// parseType parses (and discards) a type reference, as variables are
+// coerced by the resolvers.
+func (p *parser) parseType() error {
+	if p.skipPunct("[") {
+		if err := p.parseType(); err != nil {
+			return err
+		}
+		if err := p.expectPunct("]"); err != nil {
+			return err
+		}
+	} else if _, err := p.expectName(); err != nil {
+		return err
+	}
+
+	p.skipPunct("!")
+	return nil
+}
+
+func (p *parser) parseFragmentDefinition() (*fragmentDefinition, error) {
+	p.next() // fragment
+
+	name, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+	if name == "on" {
+		return nil, fmt.Errorf("invalid fragment name %q", name)
+	}
+
+	if on, err := p.expectName(); err != nil || on != "on" {
+		return nil, fmt.Errorf("expected type condition for fragment %q", name)
+	}
+
+	typeCondition, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := p.parseDirectives(); err != nil {
+		return nil, err
+	}
+
+	sels, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+
+	return &fragmentDefinition{
+		name:          name,
+		typeCondition: typeCondition,
+		selections:    sels,
+	}, nil
+}
+
+func (p *parser) parseSelectionSet() ([]selection, error) {
+	if err := p.expectPunct("{"); err != nil {
+		return nil, err
+	}
+
+	var sels []selection
+	for !p.skipPunct("}") {
+		if p.peek().kind == tokenEOF {
+			return nil, p.unexpected(p.peek())
+		}
+
+		sel, err := p.parseSelection()
+		if err != nil {
+			return nil, err
+		}
+		sels = append(sels, sel)
+	}
+
+	if len(sels) == 0 {
+		return nil, fmt.Errorf("selection set must not be empty")
+	}
+
+	return sels, nil
+}
+
+func (p *parser) parseSelection() (selection, error) {
+	if p.skipPunct("...") {
+		t := p.peek()
+		if t.kind == tokenName && t.value != "on" {
+			p.next()
+			dirs, err := p.parseDirectives()
+			if err != nil {
+				return nil, err
+			}
+			return &fragmentSpread{name: t.value, directives: dirs}, nil
+		}
+
+		var frag inlineFragment
+		if t.kind == tokenName && t.value == "on" {
+			p.next()
+			name, err := p.expectName()
+			if err != nil {
+				return nil, err
+			}
+			frag.typeCondition = name
+		}
+
+		dirs, err := p.parseDirectives()
+		if err != nil {
+			return nil, err
+		}
+		frag.directives = dirs
+
+		sels, err := p.parseSelectionSet()
+		if err != nil {
+			return nil, err
+		}
+		frag.selections = sels
+
+		return &frag, nil
+	}
+
+	return p.parseField()
+}
+
+func (p *parser) parseField() (*field, error) {
+	var f field
+
+	name, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.skipPunct(":") {
+		f.alias = name
+		name, err = p.expectName()
+		if err != nil {
+			return nil, err
+		}
+	}
+	f.name = name
+
+	if p.isPunct("(") {
+		f.arguments, err = p.parseArguments(false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	f.directives, err = p.parseDirectives()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.isPunct("{") {
+		f.selections, err = p.parseSelectionSet()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &f, nil
+}
+
+func (p *parser) parseArguments(isConst bool) ([]argument, error) {
+	if err := p.expectPunct("("); err != nil {
+		return nil, err
+	}
+
+	var args []argument
+	for !p.skipPunct(")") {
+		name, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expectPunct(":"); err != nil {
+			return nil, err
+		}
+
+		v, err := p.parseValue(isConst)
+		if err != nil {
+			return nil, err
+		}
+
+		args = append(args, argument{name: name, value: v})
+	}
+
+	return args, nil
+}
+
+func (p *parser) parseDirectives() ([]directive, error) {
+	var dirs []directive
+
+	for p.skipPunct("@") {
+		name, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+
+		d := directive{name: name}
+		if p.isPunct("(") {
+			d.arguments, err = p.parseArguments(false)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		dirs = append(dirs, d)
+	}
+
+	return dirs, nil
+}
+
+func (p *parser) parseValue(isConst bool) (value, error) {
+	t := p.next()
+
+	switch t.kind {
+	case tokenPunct:
+		switch t.value {
+		case "$":
+			if isConst {
+				return nil, fmt.Errorf("unexpected variable at position %d", t.pos)
+			}
+			name, err := p.expectName()
+			if err != nil {
+				return nil, err
+			}
+			return variable(name), nil
+		case "[":
+			list := listValue{}
+			for !p.skipPunct("]") {
+				v, err := p.parseValue(isConst)
+				if err != nil {
+					return nil, err
+				}
+				list = append(list, v)
+			}
+			return list, nil
+		case "{":
+			obj := objectValue{}
+			for !p.skipPunct("}") {
+				name, err := p.expectName()
+				if err != nil {
+					return nil, err
+				}
+				if err := p.expectPunct(":"); err != nil {
+					return nil, err
+				}
+				v, err := p.parseValue(isConst)
+				if err != nil {
+					return nil, err
+				}
+				obj = append(obj, argument{name: name, value: v})
+			}
+			return obj, nil
+		}
+	case tokenInt:
+		i, err := strconv.ParseInt(t.value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid int at position %d: %s", t.pos, err)
+		}
+		return i, nil
+	case tokenFloat:
+		f, err := strconv.ParseFloat(t.value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid float at position %d: %s", t.pos, err)
+		}
+		return f, nil
+	case tokenString:
+		return t.value, nil
+	case tokenName:
+		switch t.value {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		default:
+			return enumValue(t.value), nil
+		}
+	}
+
+	return nil, p.unexpected(t)
+}
+
+// valueToInterface returns the Go representation of the given value,
+// substituting the given variables.
+func valueToInterface(v value, vars map[string]interface{}) interface{} {
+	switch v := v.(type) {
+	case variable:
+		return vars[string(v)]
+	case enumValue:
+		return string(v)
+	case listValue:
+		out := make([]interface{}, len(v))
+		for i := range v {
+			out[i] = valueToInterface(v[i], vars)
+		}
+		return out
+	case objectValue:
+		out := make(map[string]interface{}, len(v))
+		for _, f := range v {
+			out[f.name] = valueToInterface(f.value, vars)
+		}
+		return out
+	default:
+		return v
+	}
+}
@@ -0,0 +1,497 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/brocaar/lora-app-server/api"
+)
+
+// defaultLimit defines the default number of items returned by list fields.
+const defaultLimit = 10
+
+// NewSchema returns the schema of the external API. The queries are executed
+// by the given API services, meaning that the same authorization rules
+// apply as for the gRPC and REST API.
+func NewSchema(organizationAPI pb.OrganizationServiceServer, applicationAPI pb.ApplicationServiceServer, deviceAPI pb.DeviceServiceServer, gatewayAPI pb.GatewayServiceServer) *Schema {
+	organizations := func(ctx context.Context, args map[string]interface{}) (interface{}, error) {
+		limit, offset, err := paginationArgs(args)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := organizationAPI.List(ctx, &pb.ListOrganizationRequest{
+			Limit:  limit,
+			Offset: offset,
+			Search: stringArg(args, "search"),
+		})
+		return toValue(resp, err)
+	}
+
+	applications := func(ctx context.Context, organizationID int64, args map[string]interface{}) (interface{}, error) {
+		limit, offset, err := paginationArgs(args)
+		if err != nil {
+			return nil, err
+		}
+
+		if organizationID == 0 {
+			organizationID, err = int64Arg(args, "organizationID", 0)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := applicationAPI.List(ctx, &pb.ListApplicationRequest{
+			Limit:          limit,
+			Offset:         offset,
+			OrganizationId: organizationID,
+			Search:         stringArg(args, "search"),
+		})
+		return toValue(resp, err)
+	}
+
+	devices := func(ctx context.Context, applicationID int64, args map[string]interface{}) (interface{}, error) {
+		limit, offset, err := paginationArgs(args)
+		if err != nil {
+			return nil, err
+		}
+
+		if applicationID == 0 {
+			applicationID, err = int64Arg(args, "applicationID", 0)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := deviceAPI.List(ctx, &pb.ListDeviceRequest{
+			Limit:         limit,
+			Offset:        offset,
+			ApplicationId: applicationID,
+			Search:        stringArg(args, "search"),
+			Cursor:        stringArg(args, "cursor"),
+		})
+		return toValue(resp, err)
+	}
+
+	gateways := func(ctx context.Context, organizationID int64, args map[string]interface{}) (interface{}, error) {
+		limit, offset, err := paginationArgs(args)
+		if err != nil {
+			return nil, err
+		}
+
+		if organizationID == 0 {
+			organizationID, err = int64Arg(args, "organizationID", 0)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := gatewayAPI.List(ctx, &pb.ListGatewayRequest{
+			Limit:          int32(limit),
+			Offset:         int32(offset),
+			OrganizationId: organizationID,
+			Search:         stringArg(args, "search"),
+			Cursor:         stringArg(args, "cursor"),
+		})
+		return toValue(resp, err)
+	}
+
+	organization := func(ctx context.Context, id int64) (interface{}, error) {
+		resp, err := organizationAPI.Get(ctx, &pb.GetOrganizationRequest{Id: id})
+		return flatten(toValue(resp, err))("organization")
+	}
+
+	application := func(ctx context.Context, id int64) (interface{}, error) {
+		resp, err := applicationAPI.Get(ctx, &pb.GetApplicationRequest{Id: id})
+		return flatten(toValue(resp, err))("application")
+	}
+
+	device := func(ctx context.Context, devEUI string) (interface{}, error) {
+		resp, err := deviceAPI.Get(ctx, &pb.GetDeviceRequest{DevEui: devEUI})
+		return flatten(toValue(resp, err))("device")
+	}
+
+	gateway := func(ctx context.Context, id string) (interface{}, error) {
+		resp, err := gatewayAPI.Get(ctx, &pb.GetGatewayRequest{Id: id})
+		return flatten(toValue(resp, err))("gateway")
+	}
+
+	gatewayStats := func(ctx context.Context, gatewayID string, args map[string]interface{}) (interface{}, error) {
+		start, err := timestampArg(args, "startTimestamp")
+		if err != nil {
+			return nil, err
+		}
+		end, err := timestampArg(args, "endTimestamp")
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := gatewayAPI.GetStats(ctx, &pb.GetGatewayStatsRequest{
+			GatewayId:      gatewayID,
+			Interval:       stringArg(args, "interval"),
+			StartTimestamp: start,
+			EndTimestamp:   end,
+		})
+		return pick(toValue(resp, err))("result")
+	}
+
+	deviceTrack := func(ctx context.Context, devEUI string, args map[string]interface{}) (interface{}, error) {
+		start, err := timestampArg(args, "startTimestamp")
+		if err != nil {
+			return nil, err
+		}
+		end, err := timestampArg(args, "endTimestamp")
+		if err != nil {
+			return nil, err
+		}
+
+		limit, err := int64Arg(args, "limit", 0)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := deviceAPI.GetTrack(ctx, &pb.GetDeviceTrackRequest{
+			DevEui:         devEUI,
+			StartTimestamp: start,
+			EndTimestamp:   end,
+			Limit:          limit,
+			Cursor:         stringArg(args, "cursor"),
+		})
+		return toValue(resp, err)
+	}
+
+	// resultField returns the result items of a list response.
+	resultField := &Field{
+		Resolve: func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+			return sourceField(source, "result"), nil
+		},
+	}
+
+	return &Schema{
+		Query: map[string]*Field{
+			"organizations": {
+				Type: "OrganizationList",
+				Resolve: func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+					return organizations(ctx, args)
+				},
+			},
+			"organization": {
+				Type: "Organization",
+				Resolve: func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+					id, err := int64Arg(args, "id", 0)
+					if err != nil {
+						return nil, err
+					}
+					return organization(ctx, id)
+				},
+			},
+			"applications": {
+				Type: "ApplicationList",
+				Resolve: func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+					return applications(ctx, 0, args)
+				},
+			},
+			"application": {
+				Type: "Application",
+				Resolve: func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+					id, err := int64Arg(args, "id", 0)
+					if err != nil {
+						return nil, err
+					}
+					return application(ctx, id)
+				},
+			},
+			"devices": {
+				Type: "DeviceList",
+				Resolve: func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+					return devices(ctx, 0, args)
+				},
+			},
+			"device": {
+				Type: "Device",
+				Resolve: func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+					return device(ctx, stringArg(args, "devEUI"))
+				},
+			},
+			"gateways": {
+				Type: "GatewayList",
+				Resolve: func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+					return gateways(ctx, 0, args)
+				},
+			},
+			"gateway": {
+				Type: "Gateway",
+				Resolve: func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+					return gateway(ctx, stringArg(args, "id"))
+				},
+			},
+			"gatewayStats": {
+				Resolve: func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+					return gatewayStats(ctx, stringArg(args, "gatewayID"), args)
+				},
+			},
+		},
+		Subscription: map[string]*SubscriptionField{
+			"deviceEvents": {
+				Subscribe: func(ctx context.Context, args map[string]interface{}) (<-chan interface{}, error) {
+					return subscribeDeviceEvents(ctx, deviceAPI, stringArg(args, "devEUI"), stringArg(args, "resumeToken"))
+				},
+			},
+			"deviceFrames": {
+				Subscribe: func(ctx context.Context, args map[string]interface{}) (<-chan interface{}, error) {
+					return subscribeDeviceFrames(ctx, deviceAPI, stringArg(args, "devEUI"))
+				},
+			},
+			"gatewayFrames": {
+				Subscribe: func(ctx context.Context, args map[string]interface{}) (<-chan interface{}, error) {
+					return subscribeGatewayFrames(ctx, gatewayAPI, stringArg(args, "gatewayID"))
+				},
+			},
+		},
+		Types: map[string]map[string]*Field{
+			"OrganizationList": {
+				"result": {Type: "Organization", Resolve: resultField.Resolve},
+			},
+			"Organization": {
+				"applications": {
+					Type: "ApplicationList",
+					Resolve: func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+						id, err := sourceInt64(source, "id")
+						if err != nil {
+							return nil, err
+						}
+						return applications(ctx, id, args)
+					},
+				},
+				"gateways": {
+					Type: "GatewayList",
+					Resolve: func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+						id, err := sourceInt64(source, "id")
+						if err != nil {
+							return nil, err
+						}
+						return gateways(ctx, id, args)
+					},
+				},
+			},
+			"ApplicationList": {
+				"result": {Type: "Application", Resolve: resultField.Resolve},
+			},
+			"Application": {
+				"organization": {
+					Type: "Organization",
+					Resolve: func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+						id, err := sourceInt64(source, "organizationID")
+						if err != nil {
+							return nil, err
+						}
+						return organization(ctx, id)
+					},
+				},
+				"devices": {
+					Type: "DeviceList",
+					Resolve: func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+						id, err := sourceInt64(source, "id")
+						if err != nil {
+							return nil, err
+						}
+						return devices(ctx, id, args)
+					},
+				},
+			},
+			"DeviceList": {
+				"result": {Type: "Device", Resolve: resultField.Resolve},
+			},
+			"Device": {
+				"application": {
+					Type: "Application",
+					Resolve: func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+						id, err := sourceInt64(source, "applicationID")
+						if err != nil {
+							return nil, err
+						}
+						return application(ctx, id)
+					},
+				},
+				"track": {
+					Resolve: func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+						devEUI, _ := sourceField(source, "devEUI").(string)
+						return deviceTrack(ctx, devEUI, args)
+					},
+				},
+			},
+			"GatewayList": {
+				"result": {Type: "Gateway", Resolve: resultField.Resolve},
+			},
+			"Gateway": {
+				"organization": {
+					Type: "Organization",
+					Resolve: func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+						id, err := sourceInt64(source, "organizationID")
+						if err != nil {
+							return nil, err
+						}
+						return organization(ctx, id)
+					},
+				},
+				"stats": {
+					Resolve: func(ctx context.Context, source interface{}, args map[string]interface{}) (interface{}, error) {
+						id, _ := sourceField(source, "id").(string)
+						return gatewayStats(ctx, id, args)
+					},
+				},
+			},
+		},
+	}
+}
+
+// ContextWithAuthorization returns a context containing the given
+// authorization (e.g. "Bearer <token>") as gRPC metadata, so that it is
+// validated by the API services.
+func ContextWithAuthorization(ctx context.Context, authorization string) context.Context {
+	return metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", authorization))
+}
+
+// toValue returns the JSON representation of the given API message as
+// map[string]interface{}.
+func toValue(msg proto.Message, err error) (interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	m := jsonpb.Marshaler{
+		EmitDefaults: true,
+	}
+
+	var buf bytes.Buffer
+	if err := m.Marshal(&buf, msg); err != nil {
+		return nil, fmt.Errorf("marshal json error: %s", err)
+	}
+
+	var out map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		return nil, fmt.Errorf("unmarshal json error: %s", err)
+	}
+
+	return out, nil
+}
+
+// flatten returns a function which merges the fields of the given nested
+// object into the parent object. This is used for the Get responses, which
+// contain the object and some additional fields (e.g. timestamps).
+func flatten(val interface{}, err error) func(key string) (interface{}, error) {
+	return func(key string) (interface{}, error) {
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := val.(map[string]interface{})
+		if !ok {
+			return val, nil
+		}
+
+		if nested, ok := m[key].(map[string]interface{}); ok {
+			delete(m, key)
+			for k, v := range nested {
+				m[k] = v
+			}
+		}
+
+		return m, nil
+	}
+}
+
+// pick returns a function which returns the given field of the object.
+func pick(val interface{}, err error) func(key string) (interface{}, error) {
+	return func(key string) (interface{}, error) {
+		if err != nil {
+			return nil, err
+		}
+		return sourceField(val, key), nil
+	}
+}
+
+func sourceField(source interface{}, key string) interface{} {
+	m, ok := source.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return m[key]
+}
+
+// sourceInt64 returns the given int64 field of the object. Note that int64
+// values are encoded as string in the JSON representation.
+func sourceInt64(source interface{}, key string) (int64, error) {
+	switch v := sourceField(source, key).(type) {
+	case string:
+		return strconv.ParseInt(v, 10, 64)
+	case float64:
+		return int64(v), nil
+	default:
+		return 0, fmt.Errorf("field %s is missing", key)
+	}
+}
+
+func paginationArgs(args map[string]interface{}) (int64, int64, error) {
+	limit, err := int64Arg(args, "limit", defaultLimit)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	offset, err := int64Arg(args, "offset", 0)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return limit, offset, nil
+}
+
+func stringArg(args map[string]interface{}, name string) string {
+	s, _ := args[name].(string)
+	return s
+}
+
+func int64Arg(args map[string]interface{}, name string, def int64) (int64, error) {
+	switch v := args[name].(type) {
+	case nil:
+		return def, nil
+	case int64:
+		return v, nil
+	case float64:
+		return int64(v), nil
+	case json.Number:
+		return v.Int64()
+	case string:
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("argument %s: %s", name, err)
+		}
+		return i, nil
+	default:
+		return 0, fmt.Errorf("argument %s must be an integer", name)
+	}
+}
+
+func timestampArg(args map[string]interface{}, name string) (*timestamp.Timestamp, error) {
+	s := stringArg(args, name)
+	if s == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return nil, fmt.Errorf("argument %s: %s", name, err)
+	}
+
+	return ptypes.TimestampProto(t)
+}
@@ -0,0 +1,127 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/brocaar/lora-app-server/api"
+)
+
+// serverStream implements the grpc.ServerStream interface, so that the
+// streaming methods of the API services can be used for subscriptions.
+type serverStream struct {
+	ctx  context.Context
+	send func(proto.Message) error
+}
+
+func (s *serverStream) SetHeader(metadata.MD) error  { return nil }
+func (s *serverStream) SendHeader(metadata.MD) error { return nil }
+func (s *serverStream) SetTrailer(metadata.MD)       {}
+func (s *serverStream) Context() context.Context     { return s.ctx }
+func (s *serverStream) SendMsg(m interface{}) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return fmt.Errorf("expected proto.Message, got %T", m)
+	}
+	return s.send(msg)
+}
+func (s *serverStream) RecvMsg(m interface{}) error {
+	return fmt.Errorf("receiving messages is not supported")
+}
+
+type deviceEventLogsServer struct{ *serverStream }
+
+func (s deviceEventLogsServer) Send(m *pb.StreamDeviceEventLogsResponse) error { return s.SendMsg(m) }
+
+type deviceFrameLogsServer struct{ *serverStream }
+
+func (s deviceFrameLogsServer) Send(m *pb.StreamDeviceFrameLogsResponse) error { return s.SendMsg(m) }
+
+type gatewayFrameLogsServer struct{ *serverStream }
+
+func (s gatewayFrameLogsServer) Send(m *pb.StreamGatewayFrameLogsResponse) error { return s.SendMsg(m) }
+
+// stream runs the given streaming method and returns its messages, converted
+// by the given function, over the returned channel. The error returned by
+// the streaming method (e.g. when the request is not authorized) is sent
+// as the last item.
+func stream(ctx context.Context, convert func(proto.Message) (interface{}, error), run func(*serverStream) error) <-chan interface{} {
+	out := make(chan interface{})
+
+	ss := serverStream{
+		ctx: ctx,
+		send: func(msg proto.Message) error {
+			val, err := convert(msg)
+			if err != nil {
+				return err
+			}
+
+			select {
+			case out <- val:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		},
+	}
+
+	go func() {
+		defer close(out)
+
+		if err := run(&ss); err != nil && ctx.Err() == nil {
+			select {
+			case out <- err:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	return out
+}
+
+func subscribeDeviceEvents(ctx context.Context, api pb.DeviceServiceServer, devEUI, resumeToken string) (<-chan interface{}, error) {
+	convert := func(msg proto.Message) (interface{}, error) {
+		resp := msg.(*pb.StreamDeviceEventLogsResponse)
+
+		var payload interface{}
+		if err := json.Unmarshal([]byte(resp.PayloadJson), &payload); err != nil {
+			return nil, fmt.Errorf("unmarshal json error: %s", err)
+		}
+
+		return map[string]interface{}{
+			"type":        resp.Type,
+			"payload":     payload,
+			"seq":         strconv.FormatUint(resp.Seq, 10),
+			"resumeToken": resp.ResumeToken,
+		}, nil
+	}
+
+	return stream(ctx, convert, func(ss *serverStream) error {
+		return api.StreamEventLogs(&pb.StreamDeviceEventLogsRequest{DevEui: devEUI, ResumeToken: resumeToken}, deviceEventLogsServer{ss})
+	}), nil
+}
+
+func subscribeDeviceFrames(ctx context.Context, api pb.DeviceServiceServer, devEUI string) (<-chan interface{}, error) {
+	convert := func(msg proto.Message) (interface{}, error) {
+		return toValue(msg, nil)
+	}
+
+	return stream(ctx, convert, func(ss *serverStream) error {
+		return api.StreamFrameLogs(&pb.StreamDeviceFrameLogsRequest{DevEui: devEUI}, deviceFrameLogsServer{ss})
+	}), nil
+}
+
+func subscribeGatewayFrames(ctx context.Context, api pb.GatewayServiceServer, gatewayID string) (<-chan interface{}, error) {
+	convert := func(msg proto.Message) (interface{}, error) {
+		return toValue(msg, nil)
+	}
+
+	return stream(ctx, convert, func(ss *serverStream) error {
+		return api.StreamFrameLogs(&pb.StreamGatewayFrameLogsRequest{GatewayId: gatewayID}, gatewayFrameLogsServer{ss})
+	}), nil
+}
@@ -0,0 +1,214 @@
+// Package grpcweb implements a gRPC-Web handler wrapping a gRPC server, so
+// that the gRPC API can be used directly by browser applications without
+// the need of a proxy (e.g. Envoy).
+//
+// The handler translates gRPC-Web requests into (HTTP/2) gRPC requests and
+// writes the gRPC trailers as the last frame of the response body, as
+// browsers do not expose HTTP trailers. Both the binary
+// (application/grpc-web) and base64 (application/grpc-web-text) encodings
+// are supported, including server-side streaming.
+package grpcweb
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	contentTypeGRPC    = "application/grpc"
+	contentTypeWeb     = "application/grpc-web"
+	contentTypeWebText = "application/grpc-web-text"
+
+	// trailerPrefix is used by the gRPC server to set undeclared trailers
+	// (see golang.org/x/net/http2.TrailerPrefix).
+	trailerPrefix = "Trailer:"
+
+	// trailerFrameFlag is the flag set on the frame containing the trailers.
+	trailerFrameFlag = 0x80
+)
+
+// IsGRPCWebRequest returns true when the given request is a gRPC-Web request.
+func IsGRPCWebRequest(r *http.Request) bool {
+	return r.Method == http.MethodPost && strings.HasPrefix(r.Header.Get("Content-Type"), contentTypeWeb)
+}
+
+// NewHandler returns a http.Handler translating gRPC-Web requests into
+// gRPC requests for the given gRPC server (e.g. *grpc.Server).
+func NewHandler(grpcServer http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !IsGRPCWebRequest(r) {
+			http.Error(w, "gRPC-Web request expected", http.StatusUnsupportedMediaType)
+			return
+		}
+
+		contentType := r.Header.Get("Content-Type")
+		isText := strings.HasPrefix(contentType, contentTypeWebText)
+
+		req := r.WithContext(r.Context())
+		req.ProtoMajor = 2
+		req.ProtoMinor = 0
+		req.Header = cloneHeader(r.Header)
+		req.Header.Del("Content-Length")
+		req.ContentLength = -1
+
+		// application/grpc-web(-text)+proto => application/grpc+proto
+		if isText {
+			req.Header.Set("Content-Type", contentTypeGRPC+strings.TrimPrefix(contentType, contentTypeWebText))
+			req.Body = struct {
+				io.Reader
+				io.Closer
+			}{base64.NewDecoder(base64.StdEncoding, r.Body), r.Body}
+		} else {
+			req.Header.Set("Content-Type", contentTypeGRPC+strings.TrimPrefix(contentType, contentTypeWeb))
+		}
+
+		rw := newResponseWriter(w, isText)
+		grpcServer.ServeHTTP(rw, req)
+		rw.finish()
+	})
+}
+
+// responseWriter translates the gRPC response into a gRPC-Web response.
+type responseWriter struct {
+	w             http.ResponseWriter
+	header        http.Header
+	isText        bool
+	wroteHeader   bool
+	buf           bytes.Buffer
+	closeNotifyCh <-chan bool
+}
+
+func newResponseWriter(w http.ResponseWriter, isText bool) *responseWriter {
+	return &responseWriter{
+		w:      w,
+		header: make(http.Header),
+		isText: isText,
+	}
+}
+
+// Header implements the http.ResponseWriter interface.
+func (rw *responseWriter) Header() http.Header {
+	return rw.header
+}
+
+// WriteHeader implements the http.ResponseWriter interface.
+func (rw *responseWriter) WriteHeader(code int) {
+	if rw.wroteHeader {
+		return
+	}
+	rw.wroteHeader = true
+
+	// the (pre-declared) trailers are sent as part of the body
+	trailers := rw.declaredTrailers()
+	for k, v := range rw.header {
+		if k == "Trailer" || trailers[k] || strings.HasPrefix(k, trailerPrefix) {
+			continue
+		}
+		rw.w.Header()[k] = v
+	}
+
+	contentType := rw.header.Get("Content-Type")
+	if rw.isText {
+		rw.w.Header().Set("Content-Type", contentTypeWebText+strings.TrimPrefix(contentType, contentTypeGRPC))
+	} else {
+		rw.w.Header().Set("Content-Type", contentTypeWeb+strings.TrimPrefix(contentType, contentTypeGRPC))
+	}
+
+	rw.w.WriteHeader(code)
+}
+
+// Write implements the http.ResponseWriter interface.
+func (rw *responseWriter) Write(b []byte) (int, error) {
+	if !rw.wroteHeader {
+		rw.WriteHeader(http.StatusOK)
+	}
+
+	if rw.isText {
+		// the data is base64 encoded on flush, so that complete frames are
+		// encoded
+		return rw.buf.Write(b)
+	}
+
+	return rw.w.Write(b)
+}
+
+// Flush implements the http.Flusher interface.
+func (rw *responseWriter) Flush() {
+	if !rw.wroteHeader {
+		rw.WriteHeader(http.StatusOK)
+	}
+
+	if rw.isText && rw.buf.Len() != 0 {
+		rw.w.Write([]byte(base64.StdEncoding.EncodeToString(rw.buf.Bytes())))
+		rw.buf.Reset()
+	}
+
+	if f, ok := rw.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// CloseNotify implements the http.CloseNotifier interface, which is
+// required by the gRPC server.
+func (rw *responseWriter) CloseNotify() <-chan bool {
+	if cn, ok := rw.w.(http.CloseNotifier); ok {
+		return cn.CloseNotify()
+	}
+
+	if rw.closeNotifyCh == nil {
+		rw.closeNotifyCh = make(chan bool)
+	}
+	return rw.closeNotifyCh
+}
+
+// finish writes the trailers frame.
+func (rw *responseWriter) finish() {
+	var trailers bytes.Buffer
+	declared := rw.declaredTrailers()
+
+	for k, vv := range rw.header {
+		name := k
+		if strings.HasPrefix(k, trailerPrefix) {
+			name = strings.TrimPrefix(k, trailerPrefix)
+		} else if !declared[k] {
+			continue
+		}
+
+		for _, v := range vv {
+			fmt.Fprintf(&trailers, "%s: %s\r\n", strings.ToLower(name), v)
+		}
+	}
+
+	frame := make([]byte, 5, 5+trailers.Len())
+	frame[0] = trailerFrameFlag
+	binary.BigEndian.PutUint32(frame[1:], uint32(trailers.Len()))
+	frame = append(frame, trailers.Bytes()...)
+
+	rw.Write(frame)
+	rw.Flush()
+}
+
+// declaredTrailers returns the (canonical) names of the trailers declared
+// in the Trailer header.
+func (rw *responseWriter) declaredTrailers() map[string]bool {
+	out := make(map[string]bool)
+	for _, v := range rw.header["Trailer"] {
+		for _, k := range strings.Split(v, ",") {
+			out[http.CanonicalHeaderKey(strings.TrimSpace(k))] = true
+		}
+	}
+	return out
+}
+
+func cloneHeader(h http.Header) http.Header {
+	out := make(http.Header, len(h))
+	for k, v := range h {
+		out[k] = append([]string(nil), v...)
+	}
+	return out
+}
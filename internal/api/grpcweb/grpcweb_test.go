@@ -0,0 +1,176 @@
+package grpcweb
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/brocaar/lora-app-server/api"
+)
+
+type testInternalService struct {
+	pb.InternalServiceServer
+}
+
+func (s *testInternalService) Branding(ctx context.Context, req *empty.Empty) (*pb.BrandingResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return &pb.BrandingResponse{
+		Logo: md["authorization"][0],
+	}, nil
+}
+
+func (s *testInternalService) Profile(ctx context.Context, req *empty.Empty) (*pb.ProfileResponse, error) {
+	return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed")
+}
+
+type testDeviceService struct {
+	pb.DeviceServiceServer
+}
+
+func (s *testDeviceService) StreamEventLogs(req *pb.StreamDeviceEventLogsRequest, srv pb.DeviceService_StreamEventLogsServer) error {
+	for _, typ := range []string{"up", "join"} {
+		if err := srv.Send(&pb.StreamDeviceEventLogsResponse{Type: typ}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type frame struct {
+	flag byte
+	data []byte
+}
+
+func encodeFrame(msg proto.Message) []byte {
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	out := make([]byte, 5, 5+len(b))
+	binary.BigEndian.PutUint32(out[1:], uint32(len(b)))
+	return append(out, b...)
+}
+
+func decodeFrames(b []byte) []frame {
+	var out []frame
+	for len(b) >= 5 {
+		l := binary.BigEndian.Uint32(b[1:5])
+		out = append(out, frame{flag: b[0], data: b[5 : 5+l]})
+		b = b[5+l:]
+	}
+	return out
+}
+
+func TestHandler(t *testing.T) {
+	assert := require.New(t)
+
+	grpcServer := grpc.NewServer()
+	pb.RegisterInternalServiceServer(grpcServer, &testInternalService{})
+	pb.RegisterDeviceServiceServer(grpcServer, &testDeviceService{})
+
+	server := httptest.NewServer(NewHandler(grpcServer))
+	defer server.Close()
+
+	do := func(method, contentType string, body []byte) (*http.Response, []byte) {
+		req, err := http.NewRequest(http.MethodPost, server.URL+method, bytes.NewReader(body))
+		assert.NoError(err)
+		req.Header.Set("Content-Type", contentType)
+		req.Header.Set("Authorization", "Bearer secret")
+
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(err)
+		defer resp.Body.Close()
+
+		b, err := ioutil.ReadAll(resp.Body)
+		assert.NoError(err)
+
+		return resp, b
+	}
+
+	t.Run("unary", func(t *testing.T) {
+		assert := require.New(t)
+
+		resp, body := do("/api.InternalService/Branding", "application/grpc-web+proto", encodeFrame(&empty.Empty{}))
+		assert.Equal(http.StatusOK, resp.StatusCode)
+		assert.Equal("application/grpc-web+proto", resp.Header.Get("Content-Type"))
+
+		frames := decodeFrames(body)
+		assert.Len(frames, 2)
+
+		var branding pb.BrandingResponse
+		assert.NoError(proto.Unmarshal(frames[0].data, &branding))
+		assert.Equal("Bearer secret", branding.Logo)
+
+		assert.EqualValues(trailerFrameFlag, frames[1].flag)
+		assert.Contains(string(frames[1].data), "grpc-status: 0\r\n")
+	})
+
+	t.Run("error", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, body := do("/api.InternalService/Profile", "application/grpc-web", encodeFrame(&empty.Empty{}))
+
+		frames := decodeFrames(body)
+		assert.Len(frames, 1)
+		assert.EqualValues(trailerFrameFlag, frames[0].flag)
+		assert.Contains(string(frames[0].data), "grpc-status: 16\r\n")
+		assert.Contains(string(frames[0].data), "grpc-message: authentication failed\r\n")
+	})
+
+	t.Run("text encoding", func(t *testing.T) {
+		assert := require.New(t)
+
+		reqBody := []byte(base64.StdEncoding.EncodeToString(encodeFrame(&empty.Empty{})))
+		resp, body := do("/api.InternalService/Branding", "application/grpc-web-text", reqBody)
+		assert.Equal("application/grpc-web-text", resp.Header.Get("Content-Type"))
+
+		// every flush is encoded separately
+		var decoded []byte
+		for len(body) > 0 {
+			n := bytes.Index(body, []byte("=")) + 1
+			for n > 0 && n < len(body) && body[n] == '=' {
+				n++
+			}
+			if n == 0 {
+				n = len(body)
+			}
+			b, err := base64.StdEncoding.DecodeString(string(body[:n]))
+			assert.NoError(err)
+			decoded = append(decoded, b...)
+			body = body[n:]
+		}
+
+		frames := decodeFrames(decoded)
+		assert.Len(frames, 2)
+		assert.Contains(string(frames[1].data), "grpc-status: 0\r\n")
+	})
+
+	t.Run("server streaming", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, body := do("/api.DeviceService/StreamEventLogs", "application/grpc-web+proto", encodeFrame(&pb.StreamDeviceEventLogsRequest{}))
+
+		frames := decodeFrames(body)
+		assert.Len(frames, 3)
+
+		for i, typ := range []string{"up", "join"} {
+			var msg pb.StreamDeviceEventLogsResponse
+			assert.NoError(proto.Unmarshal(frames[i].data, &msg))
+			assert.Equal(typ, msg.Type)
+		}
+		assert.Contains(string(frames[2].data), "grpc-status: 0\r\n")
+	})
+}
@@ -0,0 +1,347 @@
+package api
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/handler/azurehandler"
+	"github.com/brocaar/lora-app-server/internal/handler/httphandler"
+	"github.com/brocaar/lora-app-server/internal/handler/influxdbhandler"
+	"github.com/brocaar/lora-app-server/internal/handler/loracloudhandler"
+	"github.com/brocaar/lora-app-server/internal/handler/mydeviceshandler"
+	"github.com/brocaar/lora-app-server/internal/handler/thingsboardhandler"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// maskedSigningSecret is returned instead of the signing secret of the HTTP
+// integration. When an update contains this value, the current signing
+// secret is kept.
+const maskedSigningSecret = "********"
+
+// httpIntegrationSettings returns the (validated) integration settings for
+// the given HTTP integration. The current settings (nil on create) are used
+// to keep the current signing secret when the masked value is given.
+func httpIntegrationSettings(in *pb.HTTPIntegration, current json.RawMessage) (json.RawMessage, error) {
+	signingSecret := in.SigningSecret
+	if signingSecret == maskedSigningSecret {
+		signingSecret = ""
+		if current != nil {
+			var conf httphandler.HandlerConfig
+			if err := json.Unmarshal(current, &conf); err != nil {
+				return nil, err
+			}
+			conf, err := conf.TransformSecrets(storage.DecryptSecret)
+			if err != nil {
+				return nil, errors.Wrap(err, "decrypt secrets error")
+			}
+			signingSecret = conf.SigningSecret
+		}
+	}
+
+	headers := make(map[string]string)
+	endpointHeaders := make(map[string]map[string]string)
+	for _, h := range in.Headers {
+		if h.Url == "" {
+			headers[h.Key] = h.Value
+			continue
+		}
+		if endpointHeaders[h.Url] == nil {
+			endpointHeaders[h.Url] = make(map[string]string)
+		}
+		endpointHeaders[h.Url][h.Key] = h.Value
+	}
+	if len(endpointHeaders) == 0 {
+		endpointHeaders = nil
+	}
+
+	conf := httphandler.HandlerConfig{
+		Headers:                 headers,
+		DataUpURL:               in.UplinkDataUrl,
+		JoinNotificationURL:     in.JoinNotificationUrl,
+		ACKNotificationURL:      in.AckNotificationUrl,
+		ErrorNotificationURL:    in.ErrorNotificationUrl,
+		StatusNotificationURL:   in.StatusNotificationUrl,
+		LocationNotificationURL: in.LocationNotificationUrl,
+		AdminEventURL:           in.AdminEventUrl,
+		ProxyURL:                in.ProxyUrl,
+		ChangedFieldsOnly:       in.ChangedFieldsOnly,
+		SecurityEventURL:        in.SecurityEventUrl,
+		SigningSecret:           signingSecret,
+		EndpointHeaders:         endpointHeaders,
+		Events:                  in.Events,
+	}
+	if err := conf.Validate(); err != nil {
+		return nil, err
+	}
+
+	// the header values (e.g. bearer tokens) and signing secret are stored
+	// encrypted
+	conf, err := conf.TransformSecrets(storage.EncryptSecret)
+	if err != nil {
+		return nil, errors.Wrap(err, "encrypt secrets error")
+	}
+
+	return json.Marshal(conf)
+}
+
+// httpIntegrationFromSettings returns the HTTP integration for the given
+// integration settings. The signing secret is masked.
+func httpIntegrationFromSettings(settings json.RawMessage) (*pb.HTTPIntegration, error) {
+	var conf httphandler.HandlerConfig
+	if err := json.Unmarshal(settings, &conf); err != nil {
+		return nil, err
+	}
+
+	conf, err := conf.TransformSecrets(storage.DecryptSecret)
+	if err != nil {
+		return nil, errors.Wrap(err, "decrypt secrets error")
+	}
+
+	if conf.SigningSecret != "" {
+		conf.SigningSecret = maskedSigningSecret
+	}
+
+	var headers []*pb.HTTPIntegrationHeader
+	for k, v := range conf.Headers {
+		headers = append(headers, &pb.HTTPIntegrationHeader{
+			Key:   k,
+			Value: v,
+		})
+	}
+	for url, h := range conf.EndpointHeaders {
+		for k, v := range h {
+			headers = append(headers, &pb.HTTPIntegrationHeader{
+				Key:   k,
+				Value: v,
+				Url:   url,
+			})
+		}
+	}
+	sort.Slice(headers, func(i, j int) bool {
+		if headers[i].Url != headers[j].Url {
+			return headers[i].Url < headers[j].Url
+		}
+		return headers[i].Key < headers[j].Key
+	})
+
+	return &pb.HTTPIntegration{
+		Headers:                 headers,
+		UplinkDataUrl:           conf.DataUpURL,
+		JoinNotificationUrl:     conf.JoinNotificationURL,
+		AckNotificationUrl:      conf.ACKNotificationURL,
+		ErrorNotificationUrl:    conf.ErrorNotificationURL,
+		StatusNotificationUrl:   conf.StatusNotificationURL,
+		LocationNotificationUrl: conf.LocationNotificationURL,
+		AdminEventUrl:           conf.AdminEventURL,
+		ProxyUrl:                conf.ProxyURL,
+		ChangedFieldsOnly:       conf.ChangedFieldsOnly,
+		SecurityEventUrl:        conf.SecurityEventURL,
+		SigningSecret:           conf.SigningSecret,
+		Events:                  conf.Events,
+	}, nil
+}
+
+// influxDBIntegrationSettings returns the (validated) integration settings
+// for the given InfluxDB integration.
+func influxDBIntegrationSettings(in *pb.InfluxDBIntegration) (json.RawMessage, error) {
+	conf := influxdbhandler.HandlerConfig{
+		Endpoint:            in.Endpoint,
+		DB:                  in.Db,
+		Username:            in.Username,
+		Password:            in.Password,
+		RetentionPolicyName: in.RetentionPolicyName,
+		Precision:           strings.ToLower(in.Precision.String()),
+		ProxyURL:            in.ProxyUrl,
+		ChangedFieldsOnly:   in.ChangedFieldsOnly,
+		Events:              in.Events,
+	}
+	if err := conf.Validate(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(conf)
+}
+
+// influxDBIntegrationFromSettings returns the InfluxDB integration for the
+// given integration settings.
+func influxDBIntegrationFromSettings(settings json.RawMessage) (*pb.InfluxDBIntegration, error) {
+	var conf influxdbhandler.HandlerConfig
+	if err := json.Unmarshal(settings, &conf); err != nil {
+		return nil, err
+	}
+
+	prec, _ := pb.InfluxDBPrecision_value[strings.ToUpper(conf.Precision)]
+
+	return &pb.InfluxDBIntegration{
+		Endpoint:            conf.Endpoint,
+		Db:                  conf.DB,
+		Username:            conf.Username,
+		Password:            conf.Password,
+		RetentionPolicyName: conf.RetentionPolicyName,
+		Precision:           pb.InfluxDBPrecision(prec),
+		ProxyUrl:            conf.ProxyURL,
+		ChangedFieldsOnly:   conf.ChangedFieldsOnly,
+		Events:              conf.Events,
+	}, nil
+}
+
+// azureIntegrationSettings returns the (validated) integration settings for
+// the given Azure integration.
+func azureIntegrationSettings(in *pb.AzureIntegration) (json.RawMessage, error) {
+	conf := azurehandler.HandlerConfig{
+		ConnectionString:  in.ConnectionString,
+		ProxyURL:          in.ProxyUrl,
+		ChangedFieldsOnly: in.ChangedFieldsOnly,
+		Events:            in.Events,
+	}
+	if err := conf.Validate(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(conf)
+}
+
+// azureIntegrationFromSettings returns the Azure integration for the given
+// integration settings.
+func azureIntegrationFromSettings(settings json.RawMessage) (*pb.AzureIntegration, error) {
+	var conf azurehandler.HandlerConfig
+	if err := json.Unmarshal(settings, &conf); err != nil {
+		return nil, err
+	}
+
+	return &pb.AzureIntegration{
+		ConnectionString:  conf.ConnectionString,
+		ProxyUrl:          conf.ProxyURL,
+		ChangedFieldsOnly: conf.ChangedFieldsOnly,
+		Events:            conf.Events,
+	}, nil
+}
+
+// thingsBoardIntegrationSettings returns the (validated) integration
+// settings for the given ThingsBoard integration.
+func thingsBoardIntegrationSettings(in *pb.ThingsBoardIntegration) (json.RawMessage, error) {
+	conf := thingsboardhandler.HandlerConfig{
+		Server:   in.Server,
+		ProxyURL: in.ProxyUrl,
+		Events:   in.Events,
+	}
+	if err := conf.Validate(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(conf)
+}
+
+// thingsBoardIntegrationFromSettings returns the ThingsBoard integration for
+// the given integration settings.
+func thingsBoardIntegrationFromSettings(settings json.RawMessage) (*pb.ThingsBoardIntegration, error) {
+	var conf thingsboardhandler.HandlerConfig
+	if err := json.Unmarshal(settings, &conf); err != nil {
+		return nil, err
+	}
+
+	return &pb.ThingsBoardIntegration{
+		Server:   conf.Server,
+		ProxyUrl: conf.ProxyURL,
+		Events:   conf.Events,
+	}, nil
+}
+
+// myDevicesIntegrationSettings returns the (validated) integration settings
+// for the given myDevices integration.
+func myDevicesIntegrationSettings(in *pb.MyDevicesIntegration) (json.RawMessage, error) {
+	conf := mydeviceshandler.HandlerConfig{
+		Endpoint: in.Endpoint,
+		ProxyURL: in.ProxyUrl,
+		Events:   in.Events,
+	}
+	if err := conf.Validate(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(conf)
+}
+
+// myDevicesIntegrationFromSettings returns the myDevices integration for
+// the given integration settings.
+func myDevicesIntegrationFromSettings(settings json.RawMessage) (*pb.MyDevicesIntegration, error) {
+	var conf mydeviceshandler.HandlerConfig
+	if err := json.Unmarshal(settings, &conf); err != nil {
+		return nil, err
+	}
+
+	return &pb.MyDevicesIntegration{
+		Endpoint: conf.Endpoint,
+		ProxyUrl: conf.ProxyURL,
+		Events:   conf.Events,
+	}, nil
+}
+
+// loRaCloudIntegrationSettings returns the (validated) integration settings
+// for the given LoRa Cloud integration.
+func loRaCloudIntegrationSettings(in *pb.LoRaCloudIntegration) (json.RawMessage, error) {
+	for _, p := range []uint32{in.ModemPort, in.GnssPort, in.WifiPort} {
+		if p > 255 {
+			return nil, loracloudhandler.ErrInvalidFPort
+		}
+	}
+
+	conf := loracloudhandler.HandlerConfig{
+		Token:     in.Token,
+		Server:    in.Server,
+		ModemPort: uint8(in.ModemPort),
+		GNSSPort:  uint8(in.GnssPort),
+		WifiPort:  uint8(in.WifiPort),
+		ProxyURL:  in.ProxyUrl,
+	}
+	if err := conf.Validate(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(conf)
+}
+
+// loRaCloudIntegrationFromSettings returns the LoRa Cloud integration for
+// the given integration settings.
+func loRaCloudIntegrationFromSettings(settings json.RawMessage) (*pb.LoRaCloudIntegration, error) {
+	var conf loracloudhandler.HandlerConfig
+	if err := json.Unmarshal(settings, &conf); err != nil {
+		return nil, err
+	}
+
+	return &pb.LoRaCloudIntegration{
+		Token:     conf.Token,
+		Server:    conf.Server,
+		ModemPort: uint32(conf.ModemPort),
+		GnssPort:  uint32(conf.GNSSPort),
+		WifiPort:  uint32(conf.WifiPort),
+		ProxyUrl:  conf.ProxyURL,
+	}, nil
+}
+
+// integrationListItem returns the list item for the given integration kind.
+func integrationListItem(kind string, inherited bool) (*pb.IntegrationListItem, error) {
+	switch kind {
+	case handler.HTTPHandlerKind:
+		return &pb.IntegrationListItem{Kind: pb.IntegrationKind_HTTP, Inherited: inherited}, nil
+	case handler.InfluxDBHandlerKind:
+		return &pb.IntegrationListItem{Kind: pb.IntegrationKind_INFLUXDB, Inherited: inherited}, nil
+	case handler.AzureHandlerKind:
+		return &pb.IntegrationListItem{Kind: pb.IntegrationKind_AZURE, Inherited: inherited}, nil
+	case handler.ThingsBoardHandlerKind:
+		return &pb.IntegrationListItem{Kind: pb.IntegrationKind_THINGSBOARD, Inherited: inherited}, nil
+	case handler.MyDevicesHandlerKind:
+		return &pb.IntegrationListItem{Kind: pb.IntegrationKind_MY_DEVICES, Inherited: inherited}, nil
+	case handler.LoRaCloudHandlerKind:
+		return &pb.IntegrationListItem{Kind: pb.IntegrationKind_LORA_CLOUD, Inherited: inherited}, nil
+	default:
+		return nil, grpc.Errorf(codes.Internal, "unknown integration kind: %s", kind)
+	}
+}
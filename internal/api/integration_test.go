@@ -0,0 +1,158 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/handler/httphandler"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+func TestIntegrationSettings(t *testing.T) {
+	t.Run("HTTP", func(t *testing.T) {
+		assert := require.New(t)
+
+		in := pb.HTTPIntegration{
+			Headers: []*pb.HTTPIntegrationHeader{
+				{Key: "Foo", Value: "bar"},
+				{Key: "Authorization", Value: "Bearer join", Url: "http://join"},
+				{Key: "Authorization", Value: "Bearer up", Url: "http://up"},
+			},
+			UplinkDataUrl:       "http://up",
+			JoinNotificationUrl: "http://join",
+			AdminEventUrl:       "http://admin",
+			ProxyUrl:            "socks5://proxy:1080",
+		}
+
+		settings, err := httpIntegrationSettings(&in, nil)
+		assert.NoError(err)
+
+		out, err := httpIntegrationFromSettings(settings)
+		assert.NoError(err)
+		assert.Equal(&in, out)
+
+		_, err = httpIntegrationSettings(&pb.HTTPIntegration{
+			Headers: []*pb.HTTPIntegrationHeader{
+				{Key: "Foo Bar", Value: "bar"},
+			},
+		}, nil)
+		assert.Error(err)
+
+		_, err = httpIntegrationSettings(&pb.HTTPIntegration{
+			Headers: []*pb.HTTPIntegrationHeader{
+				{Key: "Foo", Value: "bar", Url: "http://unknown"},
+			},
+			UplinkDataUrl: "http://up",
+		}, nil)
+		assert.Equal(httphandler.ErrInvalidHeaderURL, err)
+
+		_, err = httpIntegrationSettings(&pb.HTTPIntegration{
+			ProxyUrl: "ftp://proxy",
+		}, nil)
+		assert.Error(err)
+
+		t.Run("Encrypted headers", func(t *testing.T) {
+			assert := require.New(t)
+
+			config.C.JoinServer.KEK.StorageKEKLabel = "storage"
+			config.C.JoinServer.KEK.Set = []struct {
+				Label string `mapstructure:"label"`
+				KEK   string `mapstructure:"kek"`
+			}{
+				{Label: "storage", KEK: "01020304050607080102030405060708"},
+			}
+			defer func() {
+				config.C.JoinServer.KEK.StorageKEKLabel = ""
+				config.C.JoinServer.KEK.Set = nil
+			}()
+
+			settings, err := httpIntegrationSettings(&in, nil)
+			assert.NoError(err)
+			assert.NotContains(string(settings), "Bearer")
+
+			out, err := httpIntegrationFromSettings(settings)
+			assert.NoError(err)
+			assert.Equal(&in, out)
+		})
+
+		t.Run("Signing secret", func(t *testing.T) {
+			assert := require.New(t)
+
+			config.C.JoinServer.KEK.StorageKEKLabel = "storage"
+			config.C.JoinServer.KEK.Set = []struct {
+				Label string `mapstructure:"label"`
+				KEK   string `mapstructure:"kek"`
+			}{
+				{Label: "storage", KEK: "01020304050607080102030405060708"},
+			}
+			defer func() {
+				config.C.JoinServer.KEK.StorageKEKLabel = ""
+				config.C.JoinServer.KEK.Set = nil
+			}()
+
+			in := pb.HTTPIntegration{
+				UplinkDataUrl: "http://up",
+				SigningSecret: "hmac-secret",
+			}
+
+			settings, err := httpIntegrationSettings(&in, nil)
+			assert.NoError(err)
+			assert.NotContains(string(settings), "hmac-secret")
+
+			out, err := httpIntegrationFromSettings(settings)
+			assert.NoError(err)
+			assert.Equal(maskedSigningSecret, out.SigningSecret)
+
+			// updating using the masked value keeps the current secret
+			updated, err := httpIntegrationSettings(out, settings)
+			assert.NoError(err)
+
+			var conf httphandler.HandlerConfig
+			assert.NoError(json.Unmarshal(updated, &conf))
+			conf, err = conf.TransformSecrets(storage.DecryptSecret)
+			assert.NoError(err)
+			assert.Equal("hmac-secret", conf.SigningSecret)
+
+			// the secret can be removed
+			out.SigningSecret = ""
+			updated, err = httpIntegrationSettings(out, settings)
+			assert.NoError(err)
+			out, err = httpIntegrationFromSettings(updated)
+			assert.NoError(err)
+			assert.Equal("", out.SigningSecret)
+		})
+	})
+
+	t.Run("InfluxDB", func(t *testing.T) {
+		assert := require.New(t)
+
+		in := pb.InfluxDBIntegration{
+			Endpoint:  "http://localhost:8086/write",
+			Db:        "loraserver",
+			Precision: pb.InfluxDBPrecision_MS,
+			ProxyUrl:  "http://proxy:3128",
+		}
+
+		settings, err := influxDBIntegrationSettings(&in)
+		assert.NoError(err)
+
+		out, err := influxDBIntegrationFromSettings(settings)
+		assert.NoError(err)
+		assert.Equal(&in, out)
+	})
+
+	t.Run("List item", func(t *testing.T) {
+		assert := require.New(t)
+
+		item, err := integrationListItem("INFLUXDB", true)
+		assert.NoError(err)
+		assert.Equal(&pb.IntegrationListItem{Kind: pb.IntegrationKind_INFLUXDB, Inherited: true}, item)
+
+		_, err = integrationListItem("FOO", false)
+		assert.Error(err)
+	})
+}
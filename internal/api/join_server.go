@@ -49,6 +49,8 @@ func (a *JoinServerAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		a.handleJoinReq(w, b)
 	case backend.RejoinReq:
 		a.handleRejoinReq(w, b)
+	case backend.HomeNSReq:
+		a.handleHomeNSReq(w, b)
 	default:
 		a.returnError(w, http.StatusBadRequest, backend.Other, fmt.Sprintf("invalid MessageType: %s", basePL.MessageType))
 	}
@@ -127,3 +129,24 @@ func (a *JoinServerAPI) handleRejoinReq(w http.ResponseWriter, b []byte) {
 
 	a.returnPayload(w, http.StatusOK, ans)
 }
+
+func (a *JoinServerAPI) handleHomeNSReq(w http.ResponseWriter, b []byte) {
+	var homeNSReqPL backend.HomeNSReqPayload
+	err := json.Unmarshal(b, &homeNSReqPL)
+	if err != nil {
+		a.returnError(w, http.StatusBadRequest, backend.Other, err.Error())
+		return
+	}
+
+	ans := join.HandleHomeNSRequest(homeNSReqPL)
+
+	log.WithFields(log.Fields{
+		"message_type":   ans.BasePayload.MessageType,
+		"sender_id":      ans.BasePayload.SenderID,
+		"receiver_id":    ans.BasePayload.ReceiverID,
+		"transaction_id": ans.BasePayload.TransactionID,
+		"result_code":    ans.Result.ResultCode,
+	}).Info("js: sending response")
+
+	a.returnPayload(w, http.StatusOK, ans)
+}
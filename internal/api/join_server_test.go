@@ -275,21 +275,64 @@ func TestJoinServerAPI(t *testing.T) {
 							ResultCode: backend.Success,
 						},
 						SNwkSIntKey: &backend.KeyEnvelope{
-							AESKey: []byte{84, 115, 118, 176, 7, 14, 169, 150, 78, 61, 226, 98, 252, 231, 85, 145},
+							AESKey: []byte{196, 241, 177, 106, 4, 104, 232, 32, 112, 182, 173, 125, 238, 108, 162, 61},
 						},
 						FNwkSIntKey: &backend.KeyEnvelope{
-							AESKey: []byte{15, 235, 84, 189, 47, 133, 75, 254, 195, 103, 254, 91, 27, 132, 16, 55},
+							AESKey: []byte{122, 174, 145, 180, 33, 205, 39, 58, 21, 115, 209, 237, 245, 178, 62, 117},
 						},
 						NwkSEncKey: &backend.KeyEnvelope{
-							AESKey: []byte{212, 9, 208, 87, 17, 14, 159, 221, 5, 199, 126, 12, 85, 63, 119, 244},
+							AESKey: []byte{178, 182, 238, 54, 180, 166, 131, 251, 103, 226, 231, 209, 27, 255, 202, 148},
 						},
 						AppSKey: &backend.KeyEnvelope{
-							AESKey: []byte{11, 25, 22, 151, 83, 252, 60, 31, 222, 161, 118, 106, 12, 34, 117, 225},
+							AESKey: []byte{254, 53, 126, 206, 230, 223, 123, 124, 26, 23, 131, 36, 219, 29, 26, 122},
 						},
 						PHYPayload: backend.HEXBytes([]byte{32, 119, 168, 146, 89, 229, 41, 109, 112, 191, 64, 133, 175, 89, 101, 194, 76, 190, 109, 70, 29, 106, 9, 76, 214, 165, 255, 143, 250, 27, 248, 233, 75}),
 					})
 				})
 			})
+
+			Convey("When making a HomeNSReq call", func() {
+				config.C.JoinServer.HomeNetID = "010203"
+				defer func() { config.C.JoinServer.HomeNetID = "" }()
+
+				homeNSReqPayload := backend.HomeNSReqPayload{
+					BasePayload: backend.BasePayload{
+						ProtocolVersion: backend.ProtocolVersion1_0,
+						SenderID:        "030201",
+						ReceiverID:      "0807060504030201",
+						TransactionID:   1234,
+						MessageType:     backend.HomeNSReq,
+					},
+					DevEUI: d.DevEUI,
+				}
+				homeNSReqPayloadJSON, err := json.Marshal(homeNSReqPayload)
+				So(err, ShouldBeNil)
+
+				req, err := http.NewRequest("POST", server.URL, bytes.NewReader(homeNSReqPayloadJSON))
+				So(err, ShouldBeNil)
+
+				resp, err := http.DefaultClient.Do(req)
+				So(err, ShouldBeNil)
+				So(resp.StatusCode, ShouldEqual, http.StatusOK)
+
+				Convey("Then the expected response is returned", func() {
+					var homeNSAnsPayload backend.HomeNSAnsPayload
+					So(json.NewDecoder(resp.Body).Decode(&homeNSAnsPayload), ShouldBeNil)
+					So(homeNSAnsPayload, ShouldResemble, backend.HomeNSAnsPayload{
+						BasePayload: backend.BasePayload{
+							ProtocolVersion: backend.ProtocolVersion1_0,
+							SenderID:        "0807060504030201",
+							ReceiverID:      "030201",
+							TransactionID:   1234,
+							MessageType:     backend.HomeNSAns,
+						},
+						Result: backend.Result{
+							ResultCode: backend.Success,
+						},
+						HNetID: lorawan.NetID{1, 2, 3},
+					})
+				})
+			})
 		})
 	})
 }
@@ -0,0 +1,222 @@
+// Package jsonfields implements sparse field selection for the JSON REST API.
+//
+// Using the fields query parameter, callers of GET endpoints can request
+// only the fields they need (e.g. ?fields=totalCount,result.devEUI), which
+// reduces response sizes for mobile and otherwise constrained clients.
+// Nested fields are separated by a dot and arrays are transparent, meaning
+// that the selection is applied to each item of the array. Streaming
+// responses (e.g. device events) are filtered per message.
+package jsonfields
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// QueryParameter defines the query parameter containing the (comma separated)
+// fields to return.
+const QueryParameter = "fields"
+
+// errorField is always returned, so that errors of streaming responses are
+// never filtered out.
+const errorField = "error"
+
+// selection holds the selected fields. A nil value means that the whole
+// field is selected.
+type selection map[string]selection
+
+// parseSelection parses the given comma separated list of fields.
+func parseSelection(s string) selection {
+	sel := make(selection)
+
+	for _, path := range strings.Split(s, ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+
+		cur := sel
+		parts := strings.Split(path, ".")
+		for i, part := range parts {
+			if i == len(parts)-1 {
+				cur[part] = nil
+				break
+			}
+
+			child, ok := cur[part]
+			if ok && child == nil {
+				// the whole field has already been selected
+				break
+			}
+			if !ok {
+				child = make(selection)
+				cur[part] = child
+			}
+			cur = child
+		}
+	}
+
+	return sel
+}
+
+// apply returns the given (decoded) JSON value, containing only the selected
+// fields.
+func (s selection) apply(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{})
+		for k, sub := range s {
+			val, ok := v[k]
+			if !ok {
+				continue
+			}
+
+			if sub == nil {
+				out[k] = val
+			} else {
+				out[k] = sub.apply(val)
+			}
+		}
+		return out
+	case []interface{}:
+		for i := range v {
+			v[i] = s.apply(v[i])
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// filter filters the given JSON document. In case the document can't be
+// decoded, it is returned as-is.
+func (s selection) filter(b []byte) []byte {
+	if len(bytes.TrimSpace(b)) == 0 {
+		return b
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return b
+	}
+
+	out := s.apply(v)
+	if m, ok := v.(map[string]interface{}); ok {
+		if e, ok := m[errorField]; ok {
+			out.(map[string]interface{})[errorField] = e
+		}
+	}
+
+	filtered, err := json.Marshal(out)
+	if err != nil {
+		return b
+	}
+
+	if bytes.HasSuffix(b, []byte("\n")) {
+		filtered = append(filtered, '\n')
+	}
+
+	return filtered
+}
+
+// NewHandler returns a http.Handler which filters the JSON responses of the
+// given handler when the fields query parameter is set on GET requests.
+func NewHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fields := r.URL.Query().Get(QueryParameter)
+		if r.Method != http.MethodGet || fields == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// remove the fields parameter, as it is not part of the request
+		// message
+		query := r.URL.Query()
+		query.Del(QueryParameter)
+		req := r.WithContext(r.Context())
+		u := *r.URL
+		u.RawQuery = query.Encode()
+		req.URL = &u
+
+		rw := &responseWriter{
+			ResponseWriter: w,
+			sel:            parseSelection(fields),
+		}
+		next.ServeHTTP(rw, req)
+		rw.finish()
+	})
+}
+
+// responseWriter buffers the response and filters each (newline delimited)
+// JSON document on flush.
+type responseWriter struct {
+	http.ResponseWriter
+	sel    selection
+	status int
+	buf    bytes.Buffer
+}
+
+// WriteHeader implements the http.ResponseWriter interface.
+func (rw *responseWriter) WriteHeader(code int) {
+	if rw.status != 0 {
+		return
+	}
+	rw.status = code
+	rw.Header().Del("Content-Length")
+	rw.ResponseWriter.WriteHeader(code)
+}
+
+// Write implements the http.ResponseWriter interface.
+func (rw *responseWriter) Write(b []byte) (int, error) {
+	if rw.status == 0 {
+		rw.WriteHeader(http.StatusOK)
+	}
+
+	// error responses are not filtered
+	if rw.status != http.StatusOK {
+		return rw.ResponseWriter.Write(b)
+	}
+
+	return rw.buf.Write(b)
+}
+
+// Flush implements the http.Flusher interface, which is required for
+// streaming responses.
+func (rw *responseWriter) Flush() {
+	if i := bytes.LastIndexByte(rw.buf.Bytes(), '\n'); i != -1 {
+		rw.writeFiltered(rw.buf.Next(i + 1))
+	}
+
+	if f, ok := rw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// CloseNotify implements the http.CloseNotifier interface.
+func (rw *responseWriter) CloseNotify() <-chan bool {
+	if cn, ok := rw.ResponseWriter.(http.CloseNotifier); ok {
+		return cn.CloseNotify()
+	}
+	return make(chan bool)
+}
+
+// finish writes the remaining (buffered) data.
+func (rw *responseWriter) finish() {
+	if rw.buf.Len() != 0 {
+		rw.writeFiltered(rw.buf.Bytes())
+		rw.buf.Reset()
+	}
+}
+
+func (rw *responseWriter) writeFiltered(b []byte) {
+	for _, line := range bytes.SplitAfter(b, []byte("\n")) {
+		if len(line) != 0 {
+			rw.ResponseWriter.Write(rw.sel.filter(line))
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package jsonfields
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	var query string
+
+	handler := NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+
+		switch r.URL.Path {
+		case "/list":
+			fmt.Fprint(w, `{"totalCount":"2","result":[{"devEUI":"0102030405060708","name":"a","description":"x"},{"devEUI":"0807060504030201","name":"b","description":"y"}]}`)
+		case "/nested":
+			fmt.Fprint(w, `{"gateway":{"id":"0102030405060708","location":{"latitude":1.123,"longitude":2,"altitude":3}},"lastSeenAt":null}`)
+		case "/stream":
+			for _, typ := range []string{"up", "join"} {
+				fmt.Fprintf(w, `{"result":{"type":"%s","payloadJSON":"{}"}}`+"\n", typ)
+				w.(http.Flusher).Flush()
+			}
+			fmt.Fprint(w, `{"error":{"grpcCode":14,"message":"unavailable"}}`+"\n")
+		case "/error":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error":"object does not exist","code":5}`)
+		}
+	}))
+
+	tests := []struct {
+		Name     string
+		Method   string
+		URL      string
+		Query    string
+		Expected string
+	}{
+		{
+			Name:     "no fields",
+			Method:   http.MethodGet,
+			URL:      "/list?limit=10",
+			Query:    "limit=10",
+			Expected: `{"totalCount":"2","result":[{"devEUI":"0102030405060708","name":"a","description":"x"},{"devEUI":"0807060504030201","name":"b","description":"y"}]}`,
+		},
+		{
+			Name:     "array items",
+			Method:   http.MethodGet,
+			URL:      "/list?limit=10&fields=totalCount,result.devEUI",
+			Query:    "limit=10",
+			Expected: `{"result":[{"devEUI":"0102030405060708"},{"devEUI":"0807060504030201"}],"totalCount":"2"}`,
+		},
+		{
+			Name:     "nested fields",
+			Method:   http.MethodGet,
+			URL:      "/nested?fields=gateway.location.latitude,gateway.location,unknown",
+			Expected: `{"gateway":{"location":{"altitude":3,"latitude":1.123,"longitude":2}}}`,
+		},
+		{
+			Name:     "stream",
+			Method:   http.MethodGet,
+			URL:      "/stream?fields=result.type",
+			Expected: `{"result":{"type":"up"}}` + "\n" + `{"result":{"type":"join"}}` + "\n" + `{"error":{"grpcCode":14,"message":"unavailable"}}` + "\n",
+		},
+		{
+			Name:     "error response",
+			Method:   http.MethodGet,
+			URL:      "/error?fields=result",
+			Expected: `{"error":"object does not exist","code":5}`,
+		},
+		{
+			Name:     "non-GET request",
+			Method:   http.MethodPost,
+			URL:      "/nested?fields=gateway.id",
+			Query:    "fields=gateway.id",
+			Expected: `{"gateway":{"id":"0102030405060708","location":{"latitude":1.123,"longitude":2,"altitude":3}},"lastSeenAt":null}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(test.Method, test.URL, nil))
+
+			b, err := ioutil.ReadAll(w.Body)
+			assert.NoError(err)
+			assert.Equal(test.Expected, string(b))
+			assert.Equal(test.Query, query)
+		})
+	}
+}
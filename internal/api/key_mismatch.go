@@ -0,0 +1,77 @@
+package api
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/eventlog"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/keymismatch"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// appSKeyMismatchWarning defines the notification type of a possible
+// AppSKey mismatch.
+const appSKeyMismatchWarning = "APPSKEY_MISMATCH_WARNING"
+
+// detectAppSKeyMismatch keeps track of the consecutive uplinks of the given
+// device which failed to decode by the payload codec and of which the
+// decrypted payload looks random. It sends a warning notification once
+// the configured number of consecutive uplinks has been reached.
+func detectAppSKeyMismatch(d storage.Device, app storage.Application, fCnt uint32, data []byte, decoded bool) {
+	uplinkCount := config.C.ApplicationServer.KeyMismatchDetection.UplinkCount
+	if uplinkCount == 0 {
+		return
+	}
+
+	if decoded || !keymismatch.IsRandom(data) {
+		if err := keymismatch.ResetFailureCount(config.C.Redis.Pool, d.DevEUI); err != nil {
+			log.WithError(err).WithField("dev_eui", d.DevEUI).Error("reset key-mismatch failure count error")
+		}
+		return
+	}
+
+	count, err := keymismatch.IncrFailureCount(config.C.Redis.Pool, d.DevEUI)
+	if err != nil {
+		log.WithError(err).WithField("dev_eui", d.DevEUI).Error("increment key-mismatch failure count error")
+		return
+	}
+
+	// only warn once per series of suspicious uplinks
+	if count != uplinkCount {
+		return
+	}
+
+	errStr := fmt.Sprintf("possible AppSKey mismatch: %d consecutive uplinks failed to decode and look like random data", count)
+
+	log.WithFields(log.Fields{
+		"dev_eui":        d.DevEUI,
+		"application_id": app.ID,
+		"type":           appSKeyMismatchWarning,
+	}).Warning(errStr)
+
+	errNotification := handler.ErrorNotification{
+		ApplicationID:   app.ID,
+		ApplicationName: app.Name,
+		DeviceName:      d.Name,
+		DevEUI:          d.DevEUI,
+		Type:            appSKeyMismatchWarning,
+		Error:           errStr,
+		FCnt:            fCnt,
+	}
+
+	if err := eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
+		Type:    eventlog.Error,
+		Payload: errNotification,
+	}); err != nil {
+		log.WithError(err).Error("log event for device error")
+	}
+
+	if !app.IsArchived() {
+		if err := config.C.ApplicationServer.Integration.Handler.SendErrorNotification(errNotification); err != nil {
+			log.WithError(err).Error("send error notification to handler error")
+		}
+	}
+}
@@ -350,7 +350,7 @@ func (a *MulticastGroupAPI) AddDevice(ctx context.Context, req *pb.AddDeviceToMu
 		return nil, errToRPCError(err)
 	}
 
-	app, err := storage.GetApplication(a.db, dev.ApplicationID)
+	app, err := storage.GetApplication(a.db, dev.ApplicationID, false)
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
@@ -1,6 +1,8 @@
 package api
 
 import (
+	"encoding/hex"
+
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jmoiron/sqlx"
@@ -11,7 +13,9 @@ import (
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/api/auth"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/nsclient"
 	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
 )
 
 // NetworkServerAPI exports the NetworkServer related functions.
@@ -51,6 +55,11 @@ func (a *NetworkServerAPI) Create(ctx context.Context, req *pb.CreateNetworkServ
 		GatewayDiscoveryInterval:    int(req.NetworkServer.GatewayDiscoveryInterval),
 		GatewayDiscoveryTXFrequency: int(req.NetworkServer.GatewayDiscoveryTxFrequency),
 		GatewayDiscoveryDR:          int(req.NetworkServer.GatewayDiscoveryDr),
+		KEKLabel:                    req.NetworkServer.KekLabel,
+	}
+
+	if err := setNetworkServerKEK(&ns, req.NetworkServer); err != nil {
+		return nil, err
 	}
 
 	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
@@ -103,11 +112,16 @@ func (a *NetworkServerAPI) Get(ctx context.Context, req *pb.GetNetworkServerRequ
 			GatewayDiscoveryInterval:    uint32(n.GatewayDiscoveryInterval),
 			GatewayDiscoveryTxFrequency: uint32(n.GatewayDiscoveryTXFrequency),
 			GatewayDiscoveryDr:          uint32(n.GatewayDiscoveryDR),
+			KekLabel:                    n.KEKLabel,
 		},
 		Region:  region,
 		Version: version,
 	}
 
+	if n.NetID != nil {
+		resp.NetworkServer.NetId = n.NetID.String()
+	}
+
 	resp.CreatedAt, err = ptypes.TimestampProto(n.CreatedAt)
 	if err != nil {
 		return nil, errToRPCError(err)
@@ -117,6 +131,22 @@ func (a *NetworkServerAPI) Get(ctx context.Context, req *pb.GetNetworkServerRequ
 		return nil, errToRPCError(err)
 	}
 
+	if pool, ok := config.C.NetworkServer.Pool.(nsclient.StatsPool); ok {
+		if stats, ok := pool.GetStats(n.Server); ok {
+			resp.Connection = &pb.NetworkServerConnectionStats{
+				State:          stats.State,
+				ReconnectCount: uint32(stats.Reconnects),
+			}
+
+			if !stats.LastReconnectAt.IsZero() {
+				resp.Connection.LastReconnectAt, err = ptypes.TimestampProto(stats.LastReconnectAt)
+				if err != nil {
+					return nil, errToRPCError(err)
+				}
+			}
+		}
+	}
+
 	return &resp, nil
 }
 
@@ -147,6 +177,11 @@ func (a *NetworkServerAPI) Update(ctx context.Context, req *pb.UpdateNetworkServ
 	ns.GatewayDiscoveryInterval = int(req.NetworkServer.GatewayDiscoveryInterval)
 	ns.GatewayDiscoveryTXFrequency = int(req.NetworkServer.GatewayDiscoveryTxFrequency)
 	ns.GatewayDiscoveryDR = int(req.NetworkServer.GatewayDiscoveryDr)
+	ns.KEKLabel = req.NetworkServer.KekLabel
+
+	if err := setNetworkServerKEK(&ns, req.NetworkServer); err != nil {
+		return nil, err
+	}
 
 	if req.NetworkServer.TlsKey != "" {
 		ns.TLSKey = req.NetworkServer.TlsKey
@@ -172,6 +207,33 @@ func (a *NetworkServerAPI) Update(ctx context.Context, req *pb.UpdateNetworkServ
 	return &empty.Empty{}, nil
 }
 
+// setNetworkServerKEK sets the NetID and KEK of the given network-server.
+// As the KEK is not returned by the API, an empty KEK keeps the current KEK
+// (unless the KEK label has been removed).
+func setNetworkServerKEK(n *storage.NetworkServer, req *pb.NetworkServer) error {
+	n.NetID = nil
+	if req.NetId != "" {
+		var netID lorawan.NetID
+		if err := netID.UnmarshalText([]byte(req.NetId)); err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "net_id: %s", err)
+		}
+		n.NetID = &netID
+	}
+
+	if req.Kek != "" {
+		kek, err := hex.DecodeString(req.Kek)
+		if err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "kek: %s", err)
+		}
+		n.KEK = kek
+	}
+	if n.KEKLabel == "" {
+		n.KEK = nil
+	}
+
+	return nil
+}
+
 // Delete deletes the network-server matching the given id.
 func (a *NetworkServerAPI) Delete(ctx context.Context, req *pb.DeleteNetworkServerRequest) (*empty.Empty, error) {
 	if err := a.validator.Validate(ctx,
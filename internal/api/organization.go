@@ -1,6 +1,9 @@
 package api
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jmoiron/sqlx"
@@ -11,6 +14,8 @@ import (
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/api/auth"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/securityevent"
 	"github.com/brocaar/lora-app-server/internal/storage"
 )
 
@@ -41,6 +46,8 @@ func (a *OrganizationAPI) Create(ctx context.Context, req *pb.CreateOrganization
 		Name:            req.Organization.Name,
 		DisplayName:     req.Organization.DisplayName,
 		CanHaveGateways: req.Organization.CanHaveGateways,
+		MaxDeviceCount:  int(req.Organization.MaxDeviceCount),
+		MaxGatewayCount: int(req.Organization.MaxGatewayCount),
 	}
 
 	err := storage.CreateOrganization(config.C.PostgreSQL.DB, &org)
@@ -48,6 +55,13 @@ func (a *OrganizationAPI) Create(ctx context.Context, req *pb.CreateOrganization
 		return nil, errToRPCError(err)
 	}
 
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:         handler.OrganizationEntity,
+		Action:         handler.CreateAction,
+		ID:             strconv.FormatInt(org.ID, 10),
+		OrganizationID: org.ID,
+	})
+
 	return &pb.CreateOrganizationResponse{
 		Id: org.ID,
 	}, nil
@@ -71,6 +85,8 @@ func (a *OrganizationAPI) Get(ctx context.Context, req *pb.GetOrganizationReques
 			Name:            org.Name,
 			DisplayName:     org.DisplayName,
 			CanHaveGateways: org.CanHaveGateways,
+			MaxDeviceCount:  int64(org.MaxDeviceCount),
+			MaxGatewayCount: int64(org.MaxGatewayCount),
 		},
 	}
 
@@ -178,6 +194,8 @@ func (a *OrganizationAPI) Update(ctx context.Context, req *pb.UpdateOrganization
 	org.DisplayName = req.Organization.DisplayName
 	if isAdmin {
 		org.CanHaveGateways = req.Organization.CanHaveGateways
+		org.MaxDeviceCount = int(req.Organization.MaxDeviceCount)
+		org.MaxGatewayCount = int(req.Organization.MaxGatewayCount)
 	}
 
 	err = storage.UpdateOrganization(config.C.PostgreSQL.DB, &org)
@@ -185,6 +203,13 @@ func (a *OrganizationAPI) Update(ctx context.Context, req *pb.UpdateOrganization
 		return nil, errToRPCError(err)
 	}
 
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:         handler.OrganizationEntity,
+		Action:         handler.UpdateAction,
+		ID:             strconv.FormatInt(org.ID, 10),
+		OrganizationID: org.ID,
+	})
+
 	return &empty.Empty{}, nil
 }
 
@@ -210,6 +235,13 @@ func (a *OrganizationAPI) Delete(ctx context.Context, req *pb.DeleteOrganization
 		return nil, err
 	}
 
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:         handler.OrganizationEntity,
+		Action:         handler.DeleteAction,
+		ID:             strconv.FormatInt(req.Id, 10),
+		OrganizationID: req.Id,
+	})
+
 	return &empty.Empty{}, nil
 }
 
@@ -236,9 +268,12 @@ func (a *OrganizationAPI) ListUsers(ctx context.Context, req *pb.ListOrganizatio
 
 	for _, u := range users {
 		row := pb.OrganizationUserListItem{
-			UserId:   u.UserID,
-			Username: u.Username,
-			IsAdmin:  u.IsAdmin,
+			UserId:      u.UserID,
+			Username:    u.Username,
+			IsAdmin:     u.IsAdmin,
+			CanViewKeys: u.CanViewKeys,
+			CanExport:   u.CanExport,
+			IsAuditor:   u.IsAuditor,
 		}
 
 		row.CreatedAt, err = ptypes.TimestampProto(u.CreatedAt)
@@ -267,11 +302,23 @@ func (a *OrganizationAPI) AddUser(ctx context.Context, req *pb.AddOrganizationUs
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	err := storage.CreateOrganizationUser(config.C.PostgreSQL.DB, req.OrganizationUser.OrganizationId, req.OrganizationUser.UserId, req.OrganizationUser.IsAdmin)
+	perms := organizationUserPermissions(req.OrganizationUser)
+	if err := perms.Validate(req.OrganizationUser.IsAdmin); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		if err := storage.CreateOrganizationUser(tx, req.OrganizationUser.OrganizationId, req.OrganizationUser.UserId, req.OrganizationUser.IsAdmin); err != nil {
+			return err
+		}
+		return storage.UpdateOrganizationUserPermissions(tx, req.OrganizationUser.OrganizationId, req.OrganizationUser.UserId, perms)
+	})
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
 
+	a.logPermissionChange(ctx, req.OrganizationUser.OrganizationId, fmt.Sprintf("user %d added to organization (%s)", req.OrganizationUser.UserId, formatOrganizationUserPermissions(req.OrganizationUser)))
+
 	return &empty.Empty{}, nil
 }
 
@@ -286,11 +333,23 @@ func (a *OrganizationAPI) UpdateUser(ctx context.Context, req *pb.UpdateOrganiza
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	err := storage.UpdateOrganizationUser(config.C.PostgreSQL.DB, req.OrganizationUser.OrganizationId, req.OrganizationUser.UserId, req.OrganizationUser.IsAdmin)
+	perms := organizationUserPermissions(req.OrganizationUser)
+	if err := perms.Validate(req.OrganizationUser.IsAdmin); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		if err := storage.UpdateOrganizationUser(tx, req.OrganizationUser.OrganizationId, req.OrganizationUser.UserId, req.OrganizationUser.IsAdmin); err != nil {
+			return err
+		}
+		return storage.UpdateOrganizationUserPermissions(tx, req.OrganizationUser.OrganizationId, req.OrganizationUser.UserId, perms)
+	})
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
 
+	a.logPermissionChange(ctx, req.OrganizationUser.OrganizationId, fmt.Sprintf("organization user %d updated (%s)", req.OrganizationUser.UserId, formatOrganizationUserPermissions(req.OrganizationUser)))
+
 	return &empty.Empty{}, nil
 }
 
@@ -306,9 +365,41 @@ func (a *OrganizationAPI) DeleteUser(ctx context.Context, req *pb.DeleteOrganiza
 		return nil, errToRPCError(err)
 	}
 
+	a.logPermissionChange(ctx, req.OrganizationId, fmt.Sprintf("user %d removed from organization", req.UserId))
+
 	return &empty.Empty{}, nil
 }
 
+// organizationUserPermissions returns the permissions of the given
+// organization user.
+func organizationUserPermissions(u *pb.OrganizationUser) storage.OrganizationUserPermissions {
+	return storage.OrganizationUserPermissions{
+		CanViewKeys: u.CanViewKeys,
+		CanExport:   u.CanExport,
+		IsAuditor:   u.IsAuditor,
+	}
+}
+
+// formatOrganizationUserPermissions returns the permissions of the given
+// organization user, as included in the permission-change security events.
+func formatOrganizationUserPermissions(u *pb.OrganizationUser) string {
+	return fmt.Sprintf("admin: %t, view keys: %t, export: %t, auditor: %t", u.IsAdmin, u.CanViewKeys, u.CanExport, u.IsAuditor)
+}
+
+// logPermissionChange logs a permission-change security event for the given
+// organization, on behalf of the user of the request.
+func (a *OrganizationAPI) logPermissionChange(ctx context.Context, organizationID int64, description string) {
+	username, _ := a.validator.GetUsername(ctx)
+
+	securityevent.Log(storage.SecurityEvent{
+		Type:           securityevent.PermissionChange,
+		Username:       username,
+		RemoteAddr:     securityevent.RemoteAddr(ctx),
+		OrganizationID: &organizationID,
+		Description:    description,
+	})
+}
+
 // GetUser returns the user details for the given user ID.
 func (a *OrganizationAPI) GetUser(ctx context.Context, req *pb.GetOrganizationUserRequest) (*pb.GetOrganizationUserResponse, error) {
 	if err := a.validator.Validate(ctx,
@@ -327,6 +418,9 @@ func (a *OrganizationAPI) GetUser(ctx context.Context, req *pb.GetOrganizationUs
 			UserId:         req.UserId,
 			IsAdmin:        user.IsAdmin,
 			Username:       user.Username,
+			CanViewKeys:    user.CanViewKeys,
+			CanExport:      user.CanExport,
+			IsAuditor:      user.IsAuditor,
 		},
 	}
 
@@ -341,3 +435,653 @@ func (a *OrganizationAPI) GetUser(ctx context.Context, req *pb.GetOrganizationUs
 
 	return &resp, nil
 }
+
+// CreateHTTPIntegration creates a HTTP organization-integration.
+func (a *OrganizationAPI) CreateHTTPIntegration(ctx context.Context, in *pb.CreateHTTPIntegrationRequest) (*empty.Empty, error) {
+	if in.Integration == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "integration must not be nil")
+	}
+	if in.Integration.ApplicationId != 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "application_id must not be set for an organization integration")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, in.Integration.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	settings, err := httpIntegrationSettings(in.Integration, nil)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	integration := storage.OrganizationIntegration{
+		OrganizationID: in.Integration.OrganizationId,
+		Kind:           handler.HTTPHandlerKind,
+		Settings:       settings,
+	}
+	if err := storage.CreateOrganizationIntegration(config.C.PostgreSQL.DB, &integration); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	a.sendIntegrationEvent(ctx, integration, handler.CreateAction)
+
+	return &empty.Empty{}, nil
+}
+
+// GetHTTPIntegration returns the HTTP organization-integration.
+func (a *OrganizationAPI) GetHTTPIntegration(ctx context.Context, in *pb.GetOrganizationIntegrationRequest) (*pb.GetHTTPIntegrationResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, in.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetOrganizationIntegration(config.C.PostgreSQL.DB, in.OrganizationId, handler.HTTPHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	out, err := httpIntegrationFromSettings(integration.Settings)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	out.OrganizationId = integration.OrganizationID
+
+	return &pb.GetHTTPIntegrationResponse{
+		Integration: out,
+	}, nil
+}
+
+// UpdateHTTPIntegration updates the HTTP organization-integration.
+func (a *OrganizationAPI) UpdateHTTPIntegration(ctx context.Context, in *pb.UpdateHTTPIntegrationRequest) (*empty.Empty, error) {
+	if in.Integration == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "integration must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, in.Integration.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetOrganizationIntegration(config.C.PostgreSQL.DB, in.Integration.OrganizationId, handler.HTTPHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	integration.Settings, err = httpIntegrationSettings(in.Integration, integration.Settings)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err = storage.UpdateOrganizationIntegration(config.C.PostgreSQL.DB, &integration); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	a.sendIntegrationEvent(ctx, integration, handler.UpdateAction)
+
+	return &empty.Empty{}, nil
+}
+
+// DeleteHTTPIntegration deletes the HTTP organization-integration.
+func (a *OrganizationAPI) DeleteHTTPIntegration(ctx context.Context, in *pb.DeleteOrganizationIntegrationRequest) (*empty.Empty, error) {
+	return a.deleteIntegration(ctx, in.OrganizationId, handler.HTTPHandlerKind)
+}
+
+// CreateInfluxDBIntegration creates an InfluxDB organization-integration.
+func (a *OrganizationAPI) CreateInfluxDBIntegration(ctx context.Context, in *pb.CreateInfluxDBIntegrationRequest) (*empty.Empty, error) {
+	if in.Integration == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "integration must not be nil")
+	}
+	if in.Integration.ApplicationId != 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "application_id must not be set for an organization integration")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, in.Integration.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	settings, err := influxDBIntegrationSettings(in.Integration)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	integration := storage.OrganizationIntegration{
+		OrganizationID: in.Integration.OrganizationId,
+		Kind:           handler.InfluxDBHandlerKind,
+		Settings:       settings,
+	}
+	if err := storage.CreateOrganizationIntegration(config.C.PostgreSQL.DB, &integration); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	a.sendIntegrationEvent(ctx, integration, handler.CreateAction)
+
+	return &empty.Empty{}, nil
+}
+
+// GetInfluxDBIntegration returns the InfluxDB organization-integration.
+func (a *OrganizationAPI) GetInfluxDBIntegration(ctx context.Context, in *pb.GetOrganizationIntegrationRequest) (*pb.GetInfluxDBIntegrationResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, in.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetOrganizationIntegration(config.C.PostgreSQL.DB, in.OrganizationId, handler.InfluxDBHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	out, err := influxDBIntegrationFromSettings(integration.Settings)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	out.OrganizationId = integration.OrganizationID
+
+	return &pb.GetInfluxDBIntegrationResponse{
+		Integration: out,
+	}, nil
+}
+
+// UpdateInfluxDBIntegration updates the InfluxDB organization-integration.
+func (a *OrganizationAPI) UpdateInfluxDBIntegration(ctx context.Context, in *pb.UpdateInfluxDBIntegrationRequest) (*empty.Empty, error) {
+	if in.Integration == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "integration must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, in.Integration.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetOrganizationIntegration(config.C.PostgreSQL.DB, in.Integration.OrganizationId, handler.InfluxDBHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	integration.Settings, err = influxDBIntegrationSettings(in.Integration)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err = storage.UpdateOrganizationIntegration(config.C.PostgreSQL.DB, &integration); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	a.sendIntegrationEvent(ctx, integration, handler.UpdateAction)
+
+	return &empty.Empty{}, nil
+}
+
+// DeleteInfluxDBIntegration deletes the InfluxDB organization-integration.
+func (a *OrganizationAPI) DeleteInfluxDBIntegration(ctx context.Context, in *pb.DeleteOrganizationIntegrationRequest) (*empty.Empty, error) {
+	return a.deleteIntegration(ctx, in.OrganizationId, handler.InfluxDBHandlerKind)
+}
+
+// ListIntegrations lists all configured organization-integrations.
+func (a *OrganizationAPI) ListIntegrations(ctx context.Context, in *pb.ListOrganizationIntegrationsRequest) (*pb.ListIntegrationResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, in.OrganizationId),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integrations, err := storage.GetOrganizationIntegrations(config.C.PostgreSQL.DB, in.OrganizationId)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	out := pb.ListIntegrationResponse{
+		TotalCount: int64(len(integrations)),
+	}
+
+	for _, integration := range integrations {
+		item, err := integrationListItem(integration.Kind, false)
+		if err != nil {
+			return nil, err
+		}
+		out.Result = append(out.Result, item)
+	}
+
+	return &out, nil
+}
+
+// deleteIntegration deletes the organization-integration of the given kind.
+func (a *OrganizationAPI) deleteIntegration(ctx context.Context, organizationID int64, kind string) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, organizationID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetOrganizationIntegration(config.C.PostgreSQL.DB, organizationID, kind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err = storage.DeleteOrganizationIntegration(config.C.PostgreSQL.DB, integration.ID); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	a.sendIntegrationEvent(ctx, integration, handler.DeleteAction)
+
+	return &empty.Empty{}, nil
+}
+
+// sendIntegrationEvent sends the admin-plane event for the given
+// organization-integration.
+func (a *OrganizationAPI) sendIntegrationEvent(ctx context.Context, integration storage.OrganizationIntegration, action string) {
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:         handler.IntegrationEntity,
+		Action:         action,
+		ID:             integration.Kind,
+		OrganizationID: integration.OrganizationID,
+	})
+}
+
+// GetSettings returns the (white-label) settings of the organization.
+func (a *OrganizationAPI) GetSettings(ctx context.Context, req *pb.GetOrganizationSettingsRequest) (*pb.GetOrganizationSettingsResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Read, req.OrganizationId)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	// the settings are returned with their default values when they have
+	// never been updated, therefore make sure the organization exists
+	if _, err := storage.GetOrganization(config.C.PostgreSQL.DB, req.OrganizationId); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	s, err := storage.GetOrganizationSettings(config.C.PostgreSQL.DB, req.OrganizationId)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.GetOrganizationSettingsResponse{
+		Settings: &pb.OrganizationSettings{
+			OrganizationId: s.OrganizationID,
+			LogoUrl:        s.LogoURL,
+			PrimaryColor:   s.PrimaryColor,
+			SecondaryColor: s.SecondaryColor,
+			DefaultRegion:  s.DefaultRegion,
+			ContactName:    s.ContactName,
+			ContactEmail:   s.ContactEmail,
+			ContactPhone:   s.ContactPhone,
+		},
+	}
+
+	for _, l := range s.CustomLinks {
+		resp.Settings.CustomLinks = append(resp.Settings.CustomLinks, &pb.OrganizationCustomLink{
+			Title: l.Title,
+			Url:   l.URL,
+		})
+	}
+
+	if !s.CreatedAt.IsZero() {
+		resp.CreatedAt, err = ptypes.TimestampProto(s.CreatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+		resp.UpdatedAt, err = ptypes.TimestampProto(s.UpdatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	return &resp, nil
+}
+
+// UpdateSettings updates the (white-label) settings of the organization.
+func (a *OrganizationAPI) UpdateSettings(ctx context.Context, req *pb.UpdateOrganizationSettingsRequest) (*empty.Empty, error) {
+	if req.Settings == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "settings must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, req.Settings.OrganizationId)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	s := storage.OrganizationSettings{
+		OrganizationID: req.Settings.OrganizationId,
+		LogoURL:        req.Settings.LogoUrl,
+		PrimaryColor:   req.Settings.PrimaryColor,
+		SecondaryColor: req.Settings.SecondaryColor,
+		DefaultRegion:  req.Settings.DefaultRegion,
+		ContactName:    req.Settings.ContactName,
+		ContactEmail:   req.Settings.ContactEmail,
+		ContactPhone:   req.Settings.ContactPhone,
+		CustomLinks:    storage.OrganizationCustomLinks{},
+	}
+
+	for _, l := range req.Settings.CustomLinks {
+		s.CustomLinks = append(s.CustomLinks, storage.OrganizationCustomLink{
+			Title: l.Title,
+			URL:   l.Url,
+		})
+	}
+
+	if err := storage.SetOrganizationSettings(config.C.PostgreSQL.DB, &s); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:         handler.OrganizationEntity,
+		Action:         handler.UpdateAction,
+		ID:             strconv.FormatInt(s.OrganizationID, 10),
+		OrganizationID: s.OrganizationID,
+	})
+
+	return &empty.Empty{}, nil
+}
+
+// GetRetention returns the data retention and the storage usage of the
+// organization.
+func (a *OrganizationAPI) GetRetention(ctx context.Context, req *pb.GetOrganizationRetentionRequest) (*pb.GetOrganizationRetentionResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Read, req.OrganizationId)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	// the retention is returned with its default values when it has never
+	// been updated, therefore make sure the organization exists
+	if _, err := storage.GetOrganization(config.C.PostgreSQL.DB, req.OrganizationId); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	r, err := storage.GetOrganizationRetention(config.C.PostgreSQL.DB, req.OrganizationId)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	u, err := storage.GetOrganizationStorageUsage(config.C.PostgreSQL.DB, req.OrganizationId)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	defaults := config.C.ApplicationServer.Retention
+
+	resp := pb.GetOrganizationRetentionResponse{
+		Retention: &pb.OrganizationRetention{
+			OrganizationId:     r.OrganizationID,
+			SecurityEventDays:  uint32(r.SecurityEventDays),
+			DeviceLocationDays: uint32(r.DeviceLocationDays),
+			GatewayPingDays:    uint32(r.GatewayPingDays),
+		},
+		DefaultRetention: &pb.OrganizationRetention{
+			OrganizationId:     r.OrganizationID,
+			SecurityEventDays:  uint32(defaults.SecurityEventDays),
+			DeviceLocationDays: uint32(defaults.DeviceLocationDays),
+			GatewayPingDays:    uint32(defaults.GatewayPingDays),
+		},
+		Usage: &pb.OrganizationStorageUsage{
+			SecurityEventCount:  u.SecurityEventCount,
+			DeviceLocationCount: u.DeviceLocationCount,
+			GatewayPingCount:    u.GatewayPingCount,
+		},
+	}
+
+	if !r.CreatedAt.IsZero() {
+		resp.CreatedAt, err = ptypes.TimestampProto(r.CreatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+		resp.UpdatedAt, err = ptypes.TimestampProto(r.UpdatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	return &resp, nil
+}
+
+// UpdateRetention updates the data retention of the organization.
+func (a *OrganizationAPI) UpdateRetention(ctx context.Context, req *pb.UpdateOrganizationRetentionRequest) (*empty.Empty, error) {
+	if req.Retention == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "retention must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationAccess(auth.Update, req.Retention.OrganizationId)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	r := storage.OrganizationRetention{
+		OrganizationID:     req.Retention.OrganizationId,
+		SecurityEventDays:  int(req.Retention.SecurityEventDays),
+		DeviceLocationDays: int(req.Retention.DeviceLocationDays),
+		GatewayPingDays:    int(req.Retention.GatewayPingDays),
+	}
+
+	if err := storage.SetOrganizationRetention(config.C.PostgreSQL.DB, &r); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:         handler.OrganizationEntity,
+		Action:         handler.UpdateAction,
+		ID:             strconv.FormatInt(r.OrganizationID, 10),
+		OrganizationID: r.OrganizationID,
+	})
+
+	return &empty.Empty{}, nil
+}
+
+// CreateServiceAccount creates a service account within the organization.
+func (a *OrganizationAPI) CreateServiceAccount(ctx context.Context, req *pb.CreateServiceAccountRequest) (*pb.CreateServiceAccountResponse, error) {
+	if req.ServiceAccount == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "service_account must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateServiceAccountsAccess(auth.Create, req.ServiceAccount.OrganizationId)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	sa := serviceAccountFromPB(req.ServiceAccount)
+	secret, err := storage.CreateServiceAccount(config.C.PostgreSQL.DB, &sa)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	a.logPermissionChange(ctx, sa.OrganizationID, fmt.Sprintf("service account %s created (%s)", sa.Username(), formatServiceAccountPermissions(sa)))
+
+	return &pb.CreateServiceAccountResponse{
+		Id:           sa.ID,
+		ClientId:     sa.ClientID.String(),
+		ClientSecret: secret,
+	}, nil
+}
+
+// GetServiceAccount returns the service account for the given ID.
+func (a *OrganizationAPI) GetServiceAccount(ctx context.Context, req *pb.GetServiceAccountRequest) (*pb.GetServiceAccountResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateServiceAccountAccess(auth.Read, req.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	sa, err := storage.GetServiceAccount(config.C.PostgreSQL.DB, req.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.GetServiceAccountResponse{
+		ServiceAccount: &pb.ServiceAccount{
+			Id:             sa.ID,
+			OrganizationId: sa.OrganizationID,
+			Name:           sa.Name,
+			Description:    sa.Description,
+			ClientId:       sa.ClientID.String(),
+			PublicKey:      sa.PublicKey,
+			IsActive:       sa.IsActive,
+			IsAdmin:        sa.IsAdmin,
+			CanViewKeys:    sa.CanViewKeys,
+			CanExport:      sa.CanExport,
+			IsAuditor:      sa.IsAuditor,
+		},
+	}
+
+	resp.CreatedAt, err = ptypes.TimestampProto(sa.CreatedAt)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	resp.UpdatedAt, err = ptypes.TimestampProto(sa.UpdatedAt)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &resp, nil
+}
+
+// UpdateServiceAccount updates the given service account.
+func (a *OrganizationAPI) UpdateServiceAccount(ctx context.Context, req *pb.UpdateServiceAccountRequest) (*empty.Empty, error) {
+	if req.ServiceAccount == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "service_account must not be nil")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateServiceAccountAccess(auth.Update, req.ServiceAccount.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	sa, err := storage.GetServiceAccount(config.C.PostgreSQL.DB, req.ServiceAccount.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	// the organization and client id can not be changed
+	update := serviceAccountFromPB(req.ServiceAccount)
+	update.OrganizationID = sa.OrganizationID
+	update.ClientID = sa.ClientID
+
+	if err := storage.UpdateServiceAccount(config.C.PostgreSQL.DB, &update); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	a.logPermissionChange(ctx, update.OrganizationID, fmt.Sprintf("service account %s updated (%s)", update.Username(), formatServiceAccountPermissions(update)))
+
+	return &empty.Empty{}, nil
+}
+
+// DeleteServiceAccount deletes the service account for the given ID.
+func (a *OrganizationAPI) DeleteServiceAccount(ctx context.Context, req *pb.DeleteServiceAccountRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateServiceAccountAccess(auth.Delete, req.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	sa, err := storage.GetServiceAccount(config.C.PostgreSQL.DB, req.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err := storage.DeleteServiceAccount(config.C.PostgreSQL.DB, req.Id); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	a.logPermissionChange(ctx, sa.OrganizationID, fmt.Sprintf("service account %s deleted", sa.Username()))
+
+	return &empty.Empty{}, nil
+}
+
+// ListServiceAccounts lists the service accounts of the organization.
+func (a *OrganizationAPI) ListServiceAccounts(ctx context.Context, req *pb.ListServiceAccountsRequest) (*pb.ListServiceAccountsResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateServiceAccountsAccess(auth.List, req.OrganizationId)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetServiceAccountCount(config.C.PostgreSQL.DB, req.OrganizationId)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	items, err := storage.GetServiceAccounts(config.C.PostgreSQL.DB, req.OrganizationId, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListServiceAccountsResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, sa := range items {
+		row := pb.ServiceAccountListItem{
+			Id:       sa.ID,
+			Name:     sa.Name,
+			ClientId: sa.ClientID.String(),
+			IsActive: sa.IsActive,
+			IsAdmin:  sa.IsAdmin,
+		}
+
+		row.CreatedAt, err = ptypes.TimestampProto(sa.CreatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+		row.UpdatedAt, err = ptypes.TimestampProto(sa.UpdatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		resp.Result = append(resp.Result, &row)
+	}
+
+	return &resp, nil
+}
+
+// RegenerateServiceAccountSecret generates a new client secret for the
+// given service account.
+func (a *OrganizationAPI) RegenerateServiceAccountSecret(ctx context.Context, req *pb.RegenerateServiceAccountSecretRequest) (*pb.RegenerateServiceAccountSecretResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateServiceAccountAccess(auth.Update, req.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	sa, err := storage.GetServiceAccount(config.C.PostgreSQL.DB, req.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	secret, err := storage.RegenerateServiceAccountSecret(config.C.PostgreSQL.DB, req.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	a.logPermissionChange(ctx, sa.OrganizationID, fmt.Sprintf("service account %s secret regenerated", sa.Username()))
+
+	return &pb.RegenerateServiceAccountSecretResponse{
+		ClientId:     sa.ClientID.String(),
+		ClientSecret: secret,
+	}, nil
+}
+
+// serviceAccountFromPB returns the storage service account for the given
+// API service account.
+func serviceAccountFromPB(sa *pb.ServiceAccount) storage.ServiceAccount {
+	return storage.ServiceAccount{
+		ID:             sa.Id,
+		OrganizationID: sa.OrganizationId,
+		Name:           sa.Name,
+		Description:    sa.Description,
+		PublicKey:      sa.PublicKey,
+		IsActive:       sa.IsActive,
+		IsAdmin:        sa.IsAdmin,
+		OrganizationUserPermissions: storage.OrganizationUserPermissions{
+			CanViewKeys: sa.CanViewKeys,
+			CanExport:   sa.CanExport,
+			IsAuditor:   sa.IsAuditor,
+		},
+	}
+}
+
+// formatServiceAccountPermissions returns the permissions of the given
+// service account, as included in the permission-change security events.
+func formatServiceAccountPermissions(sa storage.ServiceAccount) string {
+	return fmt.Sprintf("active: %t, admin: %t, view keys: %t, export: %t, auditor: %t", sa.IsActive, sa.IsAdmin, sa.CanViewKeys, sa.CanExport, sa.IsAuditor)
+}
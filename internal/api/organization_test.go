@@ -5,9 +5,12 @@ import (
 
 	. "github.com/smartystreets/goconvey/convey"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/securityevent"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lora-app-server/internal/test"
 )
@@ -104,6 +107,207 @@ func TestOrganizationAPI(t *testing.T) {
 
 				})
 
+				Convey("Then the settings are returned with their default values", func() {
+					resp, err := api.GetSettings(ctx, &pb.GetOrganizationSettingsRequest{
+						OrganizationId: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(resp.Settings, ShouldResemble, &pb.OrganizationSettings{
+						OrganizationId: createResp.Id,
+					})
+					So(resp.CreatedAt, ShouldBeNil)
+				})
+
+				Convey("When updating the organization settings", func() {
+					settings := pb.OrganizationSettings{
+						OrganizationId: createResp.Id,
+						LogoUrl:        "https://example.com/logo.png",
+						PrimaryColor:   "#112233",
+						SecondaryColor: "#aabbcc",
+						DefaultRegion:  "EU_863_870",
+						ContactName:    "Support",
+						ContactEmail:   "support@example.com",
+						ContactPhone:   "+31 20 1234567",
+						CustomLinks: []*pb.OrganizationCustomLink{
+							{Title: "Documentation", Url: "https://example.com/docs"},
+						},
+					}
+					_, err := api.UpdateSettings(ctx, &pb.UpdateOrganizationSettingsRequest{
+						Settings: &settings,
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+					Convey("Then the settings have been updated", func() {
+						resp, err := api.GetSettings(ctx, &pb.GetOrganizationSettingsRequest{
+							OrganizationId: createResp.Id,
+						})
+						So(err, ShouldBeNil)
+						So(resp.Settings, ShouldResemble, &settings)
+						So(resp.CreatedAt, ShouldNotBeNil)
+						So(resp.UpdatedAt, ShouldNotBeNil)
+					})
+				})
+
+				Convey("When updating the organization settings with an invalid color", func() {
+					_, err := api.UpdateSettings(ctx, &pb.UpdateOrganizationSettingsRequest{
+						Settings: &pb.OrganizationSettings{
+							OrganizationId: createResp.Id,
+							PrimaryColor:   "red",
+						},
+					})
+
+					Convey("Then an InvalidArgument error is returned", func() {
+						So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+					})
+				})
+
+				Convey("Then the retention is returned with the server defaults", func() {
+					config.C.ApplicationServer.Retention.DeviceLocationDays = 90
+
+					resp, err := api.GetRetention(ctx, &pb.GetOrganizationRetentionRequest{
+						OrganizationId: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(resp.Retention, ShouldResemble, &pb.OrganizationRetention{
+						OrganizationId: createResp.Id,
+					})
+					So(resp.DefaultRetention, ShouldResemble, &pb.OrganizationRetention{
+						OrganizationId:     createResp.Id,
+						DeviceLocationDays: 90,
+					})
+					So(resp.Usage, ShouldResemble, &pb.OrganizationStorageUsage{})
+					So(resp.CreatedAt, ShouldBeNil)
+
+					config.C.ApplicationServer.Retention.DeviceLocationDays = 0
+				})
+
+				Convey("When updating the organization retention", func() {
+					retention := pb.OrganizationRetention{
+						OrganizationId:     createResp.Id,
+						SecurityEventDays:  30,
+						DeviceLocationDays: 7,
+						GatewayPingDays:    1,
+					}
+					_, err := api.UpdateRetention(ctx, &pb.UpdateOrganizationRetentionRequest{
+						Retention: &retention,
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+					Convey("Then the retention has been updated", func() {
+						resp, err := api.GetRetention(ctx, &pb.GetOrganizationRetentionRequest{
+							OrganizationId: createResp.Id,
+						})
+						So(err, ShouldBeNil)
+						So(resp.Retention, ShouldResemble, &retention)
+						So(resp.CreatedAt, ShouldNotBeNil)
+						So(resp.UpdatedAt, ShouldNotBeNil)
+					})
+				})
+
+				Convey("When creating a service account", func() {
+					saReq := pb.CreateServiceAccountRequest{
+						ServiceAccount: &pb.ServiceAccount{
+							OrganizationId: createResp.Id,
+							Name:           "automation",
+							Description:    "ci pipeline",
+							IsActive:       true,
+							CanExport:      true,
+						},
+					}
+					saResp, err := api.CreateServiceAccount(ctx, &saReq)
+					So(err, ShouldBeNil)
+					So(saResp.ClientId, ShouldNotEqual, "")
+					So(saResp.ClientSecret, ShouldNotEqual, "")
+
+					Convey("Then the service account has been created", func() {
+						resp, err := api.GetServiceAccount(ctx, &pb.GetServiceAccountRequest{
+							Id: saResp.Id,
+						})
+						So(err, ShouldBeNil)
+
+						saReq.ServiceAccount.Id = saResp.Id
+						saReq.ServiceAccount.ClientId = saResp.ClientId
+						So(resp.ServiceAccount, ShouldResemble, saReq.ServiceAccount)
+
+						sa, err := storage.GetServiceAccount(config.C.PostgreSQL.DB, saResp.Id)
+						So(err, ShouldBeNil)
+						So(sa.ValidateClientSecret(saResp.ClientSecret), ShouldBeTrue)
+					})
+
+					Convey("Then the service account is listed", func() {
+						resp, err := api.ListServiceAccounts(ctx, &pb.ListServiceAccountsRequest{
+							OrganizationId: createResp.Id,
+							Limit:          10,
+						})
+						So(err, ShouldBeNil)
+						So(resp.TotalCount, ShouldEqual, 1)
+						So(resp.Result, ShouldHaveLength, 1)
+						So(resp.Result[0].ClientId, ShouldEqual, saResp.ClientId)
+					})
+
+					Convey("Then a permission-change security event has been logged", func() {
+						events, err := storage.GetSecurityEvents(config.C.PostgreSQL.DB, storage.SecurityEventFilters{
+							Type:  securityevent.PermissionChange,
+							Limit: 10,
+						})
+						So(err, ShouldBeNil)
+						So(events, ShouldHaveLength, 1)
+						So(*events[0].OrganizationID, ShouldEqual, createResp.Id)
+					})
+
+					Convey("When updating the service account", func() {
+						saReq.ServiceAccount.Id = saResp.Id
+						saReq.ServiceAccount.Name = "automation-2"
+						saReq.ServiceAccount.IsAdmin = true
+						saReq.ServiceAccount.ClientId = "ignored"
+						_, err := api.UpdateServiceAccount(ctx, &pb.UpdateServiceAccountRequest{
+							ServiceAccount: saReq.ServiceAccount,
+						})
+						So(err, ShouldBeNil)
+
+						Convey("Then the service account has been updated", func() {
+							resp, err := api.GetServiceAccount(ctx, &pb.GetServiceAccountRequest{
+								Id: saResp.Id,
+							})
+							So(err, ShouldBeNil)
+							So(resp.ServiceAccount.Name, ShouldEqual, "automation-2")
+							So(resp.ServiceAccount.IsAdmin, ShouldBeTrue)
+							So(resp.ServiceAccount.ClientId, ShouldEqual, saResp.ClientId)
+						})
+					})
+
+					Convey("When regenerating the secret", func() {
+						resp, err := api.RegenerateServiceAccountSecret(ctx, &pb.RegenerateServiceAccountSecretRequest{
+							Id: saResp.Id,
+						})
+						So(err, ShouldBeNil)
+						So(resp.ClientId, ShouldEqual, saResp.ClientId)
+
+						Convey("Then only the new secret is valid", func() {
+							sa, err := storage.GetServiceAccount(config.C.PostgreSQL.DB, saResp.Id)
+							So(err, ShouldBeNil)
+							So(sa.ValidateClientSecret(saResp.ClientSecret), ShouldBeFalse)
+							So(sa.ValidateClientSecret(resp.ClientSecret), ShouldBeTrue)
+						})
+					})
+
+					Convey("When deleting the service account", func() {
+						_, err := api.DeleteServiceAccount(ctx, &pb.DeleteServiceAccountRequest{
+							Id: saResp.Id,
+						})
+						So(err, ShouldBeNil)
+
+						Convey("Then the service account has been deleted", func() {
+							_, err := api.GetServiceAccount(ctx, &pb.GetServiceAccountRequest{
+								Id: saResp.Id,
+							})
+							So(grpc.Code(err), ShouldEqual, codes.NotFound)
+						})
+					})
+				})
+
 				// Add a new user for adding to the organization.
 				Convey("When adding a user", func() {
 					userReq := &pb.CreateUserRequest{
@@ -171,6 +375,17 @@ func TestOrganizationAPI(t *testing.T) {
 							So(orgUsers.Result[0].IsAdmin, ShouldEqual, addOrgUser.OrganizationUser.IsAdmin)
 						})
 
+						Convey("Then a permission-change security event has been logged", func() {
+							events, err := storage.GetSecurityEvents(config.C.PostgreSQL.DB, storage.SecurityEventFilters{
+								Type:  securityevent.PermissionChange,
+								Limit: 10,
+							})
+							So(err, ShouldBeNil)
+							So(events, ShouldHaveLength, 1)
+							So(events[0].OrganizationID, ShouldNotBeNil)
+							So(*events[0].OrganizationID, ShouldEqual, createResp.Id)
+						})
+
 						Convey("When updating the user in the organization", func() {
 							updOrgUser := &pb.UpdateOrganizationUserRequest{
 								OrganizationUser: &pb.OrganizationUser{
@@ -198,6 +413,42 @@ func TestOrganizationAPI(t *testing.T) {
 
 						})
 
+						Convey("When updating the permissions of the user in the organization", func() {
+							updOrgUser := &pb.UpdateOrganizationUserRequest{
+								OrganizationUser: &pb.OrganizationUser{
+									OrganizationId: createResp.Id,
+									UserId:         addOrgUser.OrganizationUser.UserId,
+									CanViewKeys:    true,
+									IsAuditor:      true,
+								},
+							}
+							_, err := api.UpdateUser(ctx, updOrgUser)
+							So(err, ShouldBeNil)
+
+							Convey("Then the permissions have been updated", func() {
+								orgUser, err := api.GetUser(ctx, &pb.GetOrganizationUserRequest{
+									OrganizationId: createResp.Id,
+									UserId:         addOrgUser.OrganizationUser.UserId,
+								})
+								So(err, ShouldBeNil)
+								So(orgUser.OrganizationUser.CanViewKeys, ShouldBeTrue)
+								So(orgUser.OrganizationUser.CanExport, ShouldBeFalse)
+								So(orgUser.OrganizationUser.IsAuditor, ShouldBeTrue)
+							})
+						})
+
+						Convey("Then an organization admin user can not be an auditor", func() {
+							_, err := api.UpdateUser(ctx, &pb.UpdateOrganizationUserRequest{
+								OrganizationUser: &pb.OrganizationUser{
+									OrganizationId: createResp.Id,
+									UserId:         addOrgUser.OrganizationUser.UserId,
+									IsAdmin:        true,
+									IsAuditor:      true,
+								},
+							})
+							So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+						})
+
 						Convey("When removing the user from the organization", func() {
 							delOrgUser := &pb.DeleteOrganizationUserRequest{
 								OrganizationId: createResp.Id,
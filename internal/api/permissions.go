@@ -0,0 +1,176 @@
+package api
+
+import (
+	"strconv"
+
+	"github.com/gofrs/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lorawan"
+)
+
+// permissionCheck defines a permission and the validator used to check if
+// the authenticated user has this permission.
+type permissionCheck struct {
+	permission string
+	validator  auth.ValidatorFunc
+}
+
+// getPermissionChecks returns the permission checks for the given resource.
+// The checks use the same validators as the API endpoints, so that the
+// returned permissions match the actual authorization of these endpoints.
+func getPermissionChecks(typ pb.ResourceType, resourceID string) ([]permissionCheck, error) {
+	switch typ {
+	case pb.ResourceType_NONE:
+		return []permissionCheck{
+			{"organizations:list", auth.ValidateOrganizationsAccess(auth.List)},
+			{"organizations:create", auth.ValidateOrganizationsAccess(auth.Create)},
+			{"users:list", auth.ValidateUsersAccess(auth.List)},
+			{"users:create", auth.ValidateUsersAccess(auth.Create)},
+			{"gateway-profiles:list", auth.ValidateGatewayProfilesAccess(auth.List, 0)},
+			{"gateway-profiles:create", auth.ValidateGatewayProfilesAccess(auth.Create, 0)},
+		}, nil
+	case pb.ResourceType_ORGANIZATION:
+		id, err := strconv.ParseInt(resourceID, 10, 64)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "resource_id: %s", err)
+		}
+
+		return []permissionCheck{
+			{"read", auth.ValidateOrganizationAccess(auth.Read, id)},
+			{"update", auth.ValidateOrganizationAccess(auth.Update, id)},
+			{"delete", auth.ValidateOrganizationAccess(auth.Delete, id)},
+			{"users:list", auth.ValidateOrganizationUsersAccess(auth.List, id)},
+			{"users:create", auth.ValidateOrganizationUsersAccess(auth.Create, id)},
+			{"applications:list", auth.ValidateApplicationsAccess(auth.List, id)},
+			{"applications:create", auth.ValidateApplicationsAccess(auth.Create, id)},
+			{"gateways:list", auth.ValidateGatewaysAccess(auth.List, id)},
+			{"gateways:create", auth.ValidateGatewaysAccess(auth.Create, id)},
+			{"network-servers:list", auth.ValidateNetworkServersAccess(auth.List, id)},
+			{"network-servers:create", auth.ValidateNetworkServersAccess(auth.Create, id)},
+			{"service-profiles:list", auth.ValidateServiceProfilesAccess(auth.List, id)},
+			{"service-profiles:create", auth.ValidateServiceProfilesAccess(auth.Create, id)},
+			{"device-profiles:list", auth.ValidateDeviceProfilesAccess(auth.List, id, 0)},
+			{"device-profiles:create", auth.ValidateDeviceProfilesAccess(auth.Create, id, 0)},
+			{"gateway-profiles:list", auth.ValidateGatewayProfilesAccess(auth.List, id)},
+			{"gateway-profiles:create", auth.ValidateGatewayProfilesAccess(auth.Create, id)},
+			{"multicast-groups:list", auth.ValidateMulticastGroupsAccess(auth.List, id)},
+			{"multicast-groups:create", auth.ValidateMulticastGroupsAccess(auth.Create, id)},
+		}, nil
+	case pb.ResourceType_APPLICATION:
+		id, err := strconv.ParseInt(resourceID, 10, 64)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "resource_id: %s", err)
+		}
+
+		return []permissionCheck{
+			{"read", auth.ValidateApplicationAccess(id, auth.Read)},
+			{"update", auth.ValidateApplicationAccess(id, auth.Update)},
+			{"delete", auth.ValidateApplicationAccess(id, auth.Delete)},
+			{"users:list", auth.ValidateApplicationUsersAccess(id, auth.List)},
+			{"users:create", auth.ValidateApplicationUsersAccess(id, auth.Create)},
+			{"devices:list", auth.ValidateNodesAccess(id, auth.List)},
+			{"devices:create", auth.ValidateNodesAccess(id, auth.Create)},
+		}, nil
+	case pb.ResourceType_DEVICE:
+		var devEUI lorawan.EUI64
+		if err := devEUI.UnmarshalText([]byte(resourceID)); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "resource_id: %s", err)
+		}
+
+		return []permissionCheck{
+			{"read", auth.ValidateNodeAccess(devEUI, auth.Read)},
+			{"update", auth.ValidateNodeAccess(devEUI, auth.Update)},
+			{"delete", auth.ValidateNodeAccess(devEUI, auth.Delete)},
+			{"queue:list", auth.ValidateDeviceQueueAccess(devEUI, auth.List)},
+			{"queue:create", auth.ValidateDeviceQueueAccess(devEUI, auth.Create)},
+			{"queue:delete", auth.ValidateDeviceQueueAccess(devEUI, auth.Delete)},
+		}, nil
+	case pb.ResourceType_GATEWAY:
+		var mac lorawan.EUI64
+		if err := mac.UnmarshalText([]byte(resourceID)); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "resource_id: %s", err)
+		}
+
+		return []permissionCheck{
+			{"read", auth.ValidateGatewayAccess(auth.Read, mac)},
+			{"update", auth.ValidateGatewayAccess(auth.Update, mac)},
+			{"delete", auth.ValidateGatewayAccess(auth.Delete, mac)},
+		}, nil
+	case pb.ResourceType_MULTICAST_GROUP:
+		id, err := uuid.FromString(resourceID)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "resource_id: %s", err)
+		}
+
+		return []permissionCheck{
+			{"read", auth.ValidateMulticastGroupAccess(auth.Read, id)},
+			{"update", auth.ValidateMulticastGroupAccess(auth.Update, id)},
+			{"delete", auth.ValidateMulticastGroupAccess(auth.Delete, id)},
+			{"queue:list", auth.ValidateMulticastGroupQueueAccess(auth.List, id)},
+			{"queue:create", auth.ValidateMulticastGroupQueueAccess(auth.Create, id)},
+			{"queue:delete", auth.ValidateMulticastGroupQueueAccess(auth.Delete, id)},
+		}, nil
+	case pb.ResourceType_SERVICE_PROFILE:
+		id, err := uuid.FromString(resourceID)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "resource_id: %s", err)
+		}
+
+		return []permissionCheck{
+			{"read", auth.ValidateServiceProfileAccess(auth.Read, id)},
+			{"update", auth.ValidateServiceProfileAccess(auth.Update, id)},
+			{"delete", auth.ValidateServiceProfileAccess(auth.Delete, id)},
+		}, nil
+	case pb.ResourceType_DEVICE_PROFILE:
+		id, err := uuid.FromString(resourceID)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "resource_id: %s", err)
+		}
+
+		return []permissionCheck{
+			{"read", auth.ValidateDeviceProfileAccess(auth.Read, id)},
+			{"update", auth.ValidateDeviceProfileAccess(auth.Update, id)},
+			{"delete", auth.ValidateDeviceProfileAccess(auth.Delete, id)},
+		}, nil
+	case pb.ResourceType_GATEWAY_PROFILE:
+		id, err := uuid.FromString(resourceID)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "resource_id: %s", err)
+		}
+
+		return []permissionCheck{
+			{"read", auth.ValidateGatewayProfileAccess(auth.Read, id)},
+			{"update", auth.ValidateGatewayProfileAccess(auth.Update, id)},
+			{"delete", auth.ValidateGatewayProfileAccess(auth.Delete, id)},
+		}, nil
+	case pb.ResourceType_NETWORK_SERVER:
+		id, err := strconv.ParseInt(resourceID, 10, 64)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "resource_id: %s", err)
+		}
+
+		return []permissionCheck{
+			{"read", auth.ValidateNetworkServerAccess(auth.Read, id)},
+			{"update", auth.ValidateNetworkServerAccess(auth.Update, id)},
+			{"delete", auth.ValidateNetworkServerAccess(auth.Delete, id)},
+		}, nil
+	case pb.ResourceType_USER:
+		id, err := strconv.ParseInt(resourceID, 10, 64)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "resource_id: %s", err)
+		}
+
+		return []permissionCheck{
+			{"read", auth.ValidateUserAccess(id, auth.Read)},
+			{"update", auth.ValidateUserAccess(id, auth.Update)},
+			{"delete", auth.ValidateUserAccess(id, auth.Delete)},
+			{"profile:update", auth.ValidateUserAccess(id, auth.UpdateProfile)},
+		}, nil
+	default:
+		return nil, grpc.Errorf(codes.InvalidArgument, "unknown resource_type: %s", typ)
+	}
+}
@@ -0,0 +1,76 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+)
+
+func TestGetPermissionChecks(t *testing.T) {
+	tests := []struct {
+		ResourceType pb.ResourceType
+		ResourceID   string
+		Permissions  []string
+	}{
+		{pb.ResourceType_NONE, "", []string{"organizations:list", "organizations:create", "users:list", "users:create", "gateway-profiles:list", "gateway-profiles:create"}},
+		{pb.ResourceType_APPLICATION, "1", []string{"read", "update", "delete", "users:list", "users:create", "devices:list", "devices:create"}},
+		{pb.ResourceType_DEVICE, "0102030405060708", []string{"read", "update", "delete", "queue:list", "queue:create", "queue:delete"}},
+		{pb.ResourceType_GATEWAY, "0102030405060708", []string{"read", "update", "delete"}},
+		{pb.ResourceType_SERVICE_PROFILE, "c6b2b4ef-3bba-4f5f-a3c5-dba0b8c1d2e3", []string{"read", "update", "delete"}},
+		{pb.ResourceType_USER, "1", []string{"read", "update", "delete", "profile:update"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.ResourceType.String(), func(t *testing.T) {
+			assert := require.New(t)
+
+			checks, err := getPermissionChecks(test.ResourceType, test.ResourceID)
+			assert.NoError(err)
+
+			var permissions []string
+			for _, check := range checks {
+				assert.NotNil(check.validator)
+				permissions = append(permissions, check.permission)
+			}
+			assert.Equal(test.Permissions, permissions)
+		})
+	}
+
+	t.Run("All resource types", func(t *testing.T) {
+		assert := require.New(t)
+
+		ids := map[pb.ResourceType]string{
+			pb.ResourceType_DEVICE:          "0102030405060708",
+			pb.ResourceType_GATEWAY:         "0102030405060708",
+			pb.ResourceType_MULTICAST_GROUP: "c6b2b4ef-3bba-4f5f-a3c5-dba0b8c1d2e3",
+			pb.ResourceType_SERVICE_PROFILE: "c6b2b4ef-3bba-4f5f-a3c5-dba0b8c1d2e3",
+			pb.ResourceType_DEVICE_PROFILE:  "c6b2b4ef-3bba-4f5f-a3c5-dba0b8c1d2e3",
+		}
+
+		for v := range pb.ResourceType_name {
+			typ := pb.ResourceType(v)
+			id, ok := ids[typ]
+			if !ok {
+				id = "1"
+			}
+
+			checks, err := getPermissionChecks(typ, id)
+			assert.NoError(err, typ.String())
+			assert.NotEmpty(checks, typ.String())
+		}
+	})
+
+	t.Run("Invalid resource ID", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := getPermissionChecks(pb.ResourceType_DEVICE, "foo")
+		assert.Equal(codes.InvalidArgument, grpc.Code(err))
+
+		_, err = getPermissionChecks(pb.ResourceType_ORGANIZATION, "foo")
+		assert.Equal(codes.InvalidArgument, grpc.Code(err))
+	})
+}
@@ -0,0 +1,402 @@
+package api
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// registrationModes maps the configured registration mode to the
+// RegistrationMode. Unknown modes are handled as disabled.
+var registrationModes = map[string]pb.RegistrationMode{
+	"disabled": pb.RegistrationMode_DISABLED,
+	"open":     pb.RegistrationMode_OPEN,
+	"invite":   pb.RegistrationMode_INVITE,
+	"domain":   pb.RegistrationMode_DOMAIN,
+}
+
+// RegistrationAPI exports the registration related functions.
+type RegistrationAPI struct {
+	validator auth.Validator
+}
+
+// NewRegistrationAPI creates a new RegistrationAPI.
+func NewRegistrationAPI(validator auth.Validator) *RegistrationAPI {
+	return &RegistrationAPI{
+		validator: validator,
+	}
+}
+
+// Register registers a new user and organization.
+func (a *RegistrationAPI) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
+	conf := config.C.ApplicationServer.Registration
+	mode := getRegistrationMode()
+
+	if mode == pb.RegistrationMode_DISABLED {
+		return nil, errToRPCError(storage.ErrRegistrationDisabled)
+	}
+
+	if err := storage.ValidateEmail(req.Email); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if mode == pb.RegistrationMode_DOMAIN {
+		if err := validateEmailDomain(req.Email, conf.AllowedDomains); err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	user := storage.User{
+		Username: req.Username,
+		IsActive: !conf.RequireApproval,
+		Email:    req.Email,
+	}
+
+	org := storage.Organization{
+		Name:            req.OrganizationName,
+		DisplayName:     req.OrganizationDisplayName,
+		CanHaveGateways: conf.Organization.CanHaveGateways,
+		MaxDeviceCount:  conf.Organization.MaxDeviceCount,
+		MaxGatewayCount: conf.Organization.MaxGatewayCount,
+	}
+
+	reg := storage.Registration{
+		Approved: !conf.RequireApproval,
+	}
+
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		if mode == pb.RegistrationMode_INVITE {
+			invite, err := storage.GetRegistrationInviteForToken(tx, req.InviteToken, true)
+			if err != nil {
+				if errors.Cause(err) == storage.ErrDoesNotExist {
+					return storage.ErrRegistrationInvalidInvite
+				}
+				return err
+			}
+
+			if err := invite.Validate(req.Email); err != nil {
+				return err
+			}
+
+			if err := storage.UseRegistrationInvite(tx, invite.ID); err != nil {
+				return err
+			}
+
+			reg.InviteID = &invite.ID
+		}
+
+		if _, err := storage.CreateUser(tx, &user, req.Password); err != nil {
+			return err
+		}
+
+		if err := storage.CreateOrganization(tx, &org); err != nil {
+			return err
+		}
+
+		if err := storage.CreateOrganizationUser(tx, org.ID, user.ID, true); err != nil {
+			return err
+		}
+
+		reg.UserID = user.ID
+		reg.OrganizationID = org.ID
+		return storage.CreateRegistration(tx, &reg)
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:         handler.RegistrationEntity,
+		Action:         handler.CreateAction,
+		ID:             strconv.FormatInt(reg.ID, 10),
+		OrganizationID: org.ID,
+		Username:       user.Username,
+	})
+
+	return &pb.RegisterResponse{
+		Id:             reg.ID,
+		UserId:         user.ID,
+		OrganizationId: org.ID,
+		Approved:       reg.Approved,
+	}, nil
+}
+
+// GetSettings returns the registration settings.
+func (a *RegistrationAPI) GetSettings(ctx context.Context, req *empty.Empty) (*pb.GetRegistrationSettingsResponse, error) {
+	conf := config.C.ApplicationServer.Registration
+	resp := pb.GetRegistrationSettingsResponse{
+		Mode:            getRegistrationMode(),
+		RequireApproval: conf.RequireApproval,
+	}
+
+	if resp.Mode == pb.RegistrationMode_DOMAIN {
+		resp.AllowedDomains = conf.AllowedDomains
+	}
+
+	return &resp, nil
+}
+
+// List lists the registrations.
+func (a *RegistrationAPI) List(ctx context.Context, req *pb.ListRegistrationRequest) (*pb.ListRegistrationResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateRegistrationsAccess(auth.List)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetRegistrationCount(config.C.PostgreSQL.DB, req.PendingOnly)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	regs, err := storage.GetRegistrations(config.C.PostgreSQL.DB, req.PendingOnly, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListRegistrationResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, reg := range regs {
+		row := pb.RegistrationListItem{
+			Id:               reg.ID,
+			UserId:           reg.UserID,
+			Username:         reg.Username,
+			Email:            reg.Email,
+			OrganizationId:   reg.OrganizationID,
+			OrganizationName: reg.OrganizationName,
+			Approved:         reg.Approved,
+		}
+
+		row.CreatedAt, err = ptypes.TimestampProto(reg.CreatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+		row.UpdatedAt, err = ptypes.TimestampProto(reg.UpdatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		resp.Result = append(resp.Result, &row)
+	}
+
+	return &resp, nil
+}
+
+// Approve approves the given registration.
+func (a *RegistrationAPI) Approve(ctx context.Context, req *pb.ApproveRegistrationRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateRegistrationsAccess(auth.Update)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	var reg storage.Registration
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		var err error
+		reg, err = storage.GetRegistration(tx, req.Id, true)
+		if err != nil {
+			return err
+		}
+
+		if reg.Approved {
+			return storage.ErrRegistrationApproved
+		}
+
+		return storage.ApproveRegistration(tx, reg.ID)
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:         handler.RegistrationEntity,
+		Action:         handler.UpdateAction,
+		ID:             strconv.FormatInt(reg.ID, 10),
+		OrganizationID: reg.OrganizationID,
+	})
+
+	return &empty.Empty{}, nil
+}
+
+// Reject rejects the given registration. This deletes the registered user
+// and organization.
+func (a *RegistrationAPI) Reject(ctx context.Context, req *pb.RejectRegistrationRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateRegistrationsAccess(auth.Delete)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	var reg storage.Registration
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		var err error
+		reg, err = storage.GetRegistration(tx, req.Id, true)
+		if err != nil {
+			return err
+		}
+
+		if reg.Approved {
+			return storage.ErrRegistrationApproved
+		}
+
+		if err := storage.DeleteOrganization(tx, reg.OrganizationID); err != nil {
+			return errors.Wrap(err, "delete organization error")
+		}
+
+		return storage.DeleteUser(tx, reg.UserID)
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	sendAdminEvent(ctx, a.validator, handler.AdminEvent{
+		Entity:         handler.RegistrationEntity,
+		Action:         handler.DeleteAction,
+		ID:             strconv.FormatInt(reg.ID, 10),
+		OrganizationID: reg.OrganizationID,
+	})
+
+	return &empty.Empty{}, nil
+}
+
+// CreateInvite creates a registration invite.
+func (a *RegistrationAPI) CreateInvite(ctx context.Context, req *pb.CreateRegistrationInviteRequest) (*pb.CreateRegistrationInviteResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateRegistrationsAccess(auth.Create)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	invite := storage.RegistrationInvite{
+		Email: req.Email,
+	}
+
+	if ttl := config.C.ApplicationServer.Registration.InviteTTL; ttl != 0 {
+		expiresAt := time.Now().Add(ttl)
+		invite.ExpiresAt = &expiresAt
+	}
+
+	if err := storage.CreateRegistrationInvite(config.C.PostgreSQL.DB, &invite); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	pbInvite, err := registrationInviteToProto(invite)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.CreateRegistrationInviteResponse{
+		Invite: pbInvite,
+	}, nil
+}
+
+// ListInvites lists the registration invites.
+func (a *RegistrationAPI) ListInvites(ctx context.Context, req *pb.ListRegistrationInviteRequest) (*pb.ListRegistrationInviteResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateRegistrationsAccess(auth.List)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetRegistrationInviteCount(config.C.PostgreSQL.DB)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	invites, err := storage.GetRegistrationInvites(config.C.PostgreSQL.DB, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListRegistrationInviteResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, invite := range invites {
+		pbInvite, err := registrationInviteToProto(invite)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+		resp.Result = append(resp.Result, pbInvite)
+	}
+
+	return &resp, nil
+}
+
+// DeleteInvite deletes the given registration invite.
+func (a *RegistrationAPI) DeleteInvite(ctx context.Context, req *pb.DeleteRegistrationInviteRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateRegistrationsAccess(auth.Delete)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteRegistrationInvite(config.C.PostgreSQL.DB, req.Id); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// getRegistrationMode returns the configured registration mode.
+func getRegistrationMode() pb.RegistrationMode {
+	return registrationModes[strings.ToLower(config.C.ApplicationServer.Registration.Mode)]
+}
+
+// validateEmailDomain validates that the domain of the given e-mail address
+// is one of the given domains.
+func validateEmailDomain(email string, domains []string) error {
+	i := strings.LastIndex(email, "@")
+	if i == -1 {
+		return storage.ErrInvalidEmail
+	}
+	domain := email[i+1:]
+
+	for _, d := range domains {
+		if strings.EqualFold(strings.TrimPrefix(d, "@"), domain) {
+			return nil
+		}
+	}
+
+	return storage.ErrRegistrationDomainNotAllowed
+}
+
+func registrationInviteToProto(invite storage.RegistrationInvite) (*pb.RegistrationInvite, error) {
+	var err error
+	out := pb.RegistrationInvite{
+		Id:    invite.ID,
+		Token: invite.Token,
+		Email: invite.Email,
+	}
+
+	out.CreatedAt, err = ptypes.TimestampProto(invite.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if invite.ExpiresAt != nil {
+		out.ExpiresAt, err = ptypes.TimestampProto(*invite.ExpiresAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if invite.UsedAt != nil {
+		out.UsedAt, err = ptypes.TimestampProto(*invite.UsedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &out, nil
+}
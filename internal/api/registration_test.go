@@ -0,0 +1,235 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func TestValidateEmailDomain(t *testing.T) {
+	domains := []string{"example.com", "@Example.net"}
+
+	tests := []struct {
+		Email string
+		Error error
+	}{
+		{"user@example.com", nil},
+		{"user@EXAMPLE.com", nil},
+		{"user@example.net", nil},
+		{"user@sub.example.com", storage.ErrRegistrationDomainNotAllowed},
+		{"user@example.org", storage.ErrRegistrationDomainNotAllowed},
+		{"user@evil.com@example.org", storage.ErrRegistrationDomainNotAllowed},
+		{"user", storage.ErrInvalidEmail},
+	}
+
+	for _, tst := range tests {
+		if err := validateEmailDomain(tst.Email, domains); err != tst.Error {
+			t.Errorf("%s: expected error %v, got %v", tst.Email, tst.Error, err)
+		}
+	}
+}
+
+func TestRegistrationAPI(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database and api instance", t, func() {
+		db, err := storage.OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		config.C.PostgreSQL.DB = db
+		test.MustResetDB(config.C.PostgreSQL.DB)
+
+		ctx := context.Background()
+		validator := &TestValidator{}
+		api := NewRegistrationAPI(validator)
+
+		config.C.ApplicationServer.Registration.Mode = "disabled"
+		config.C.ApplicationServer.Registration.RequireApproval = false
+		config.C.ApplicationServer.Registration.AllowedDomains = nil
+		config.C.ApplicationServer.Registration.Organization.MaxDeviceCount = 10
+
+		registerReq := pb.RegisterRequest{
+			Username:                "testuser",
+			Password:                "secret123",
+			Email:                   "user@example.com",
+			OrganizationName:        "test-org",
+			OrganizationDisplayName: "Test organization",
+		}
+
+		Convey("When registration is disabled", func() {
+			_, err := api.Register(ctx, &registerReq)
+
+			Convey("Then FailedPrecondition is returned", func() {
+				So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+			})
+		})
+
+		Convey("When registration is open", func() {
+			config.C.ApplicationServer.Registration.Mode = "open"
+
+			Convey("Then the settings return the open mode", func() {
+				resp, err := api.GetSettings(ctx, &empty.Empty{})
+				So(err, ShouldBeNil)
+				So(resp.Mode, ShouldEqual, pb.RegistrationMode_OPEN)
+			})
+
+			Convey("When registering", func() {
+				resp, err := api.Register(ctx, &registerReq)
+				So(err, ShouldBeNil)
+				So(resp.Approved, ShouldBeTrue)
+
+				Convey("Then the user has been created as an active user", func() {
+					user, err := storage.GetUser(config.C.PostgreSQL.DB, resp.UserId)
+					So(err, ShouldBeNil)
+					So(user.Username, ShouldEqual, "testuser")
+					So(user.IsActive, ShouldBeTrue)
+				})
+
+				Convey("Then the organization has been created with the default quotas", func() {
+					org, err := storage.GetOrganization(config.C.PostgreSQL.DB, resp.OrganizationId)
+					So(err, ShouldBeNil)
+					So(org.Name, ShouldEqual, "test-org")
+					So(org.MaxDeviceCount, ShouldEqual, 10)
+
+					orgUser, err := storage.GetOrganizationUser(config.C.PostgreSQL.DB, org.ID, resp.UserId)
+					So(err, ShouldBeNil)
+					So(orgUser.IsAdmin, ShouldBeTrue)
+				})
+
+				Convey("Then the registration is listed", func() {
+					listResp, err := api.List(ctx, &pb.ListRegistrationRequest{Limit: 10})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+					So(listResp.TotalCount, ShouldEqual, 1)
+					So(listResp.Result, ShouldHaveLength, 1)
+					So(listResp.Result[0].Username, ShouldEqual, "testuser")
+					So(listResp.Result[0].OrganizationName, ShouldEqual, "test-org")
+				})
+
+				Convey("Then it can not be rejected", func() {
+					_, err := api.Reject(ctx, &pb.RejectRegistrationRequest{Id: resp.Id})
+					So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+				})
+			})
+		})
+
+		Convey("When registration is open and requires approval", func() {
+			config.C.ApplicationServer.Registration.Mode = "open"
+			config.C.ApplicationServer.Registration.RequireApproval = true
+
+			resp, err := api.Register(ctx, &registerReq)
+			So(err, ShouldBeNil)
+			So(resp.Approved, ShouldBeFalse)
+
+			Convey("Then the user is inactive", func() {
+				user, err := storage.GetUser(config.C.PostgreSQL.DB, resp.UserId)
+				So(err, ShouldBeNil)
+				So(user.IsActive, ShouldBeFalse)
+			})
+
+			Convey("Then the registration is listed as pending", func() {
+				listResp, err := api.List(ctx, &pb.ListRegistrationRequest{Limit: 10, PendingOnly: true})
+				So(err, ShouldBeNil)
+				So(listResp.TotalCount, ShouldEqual, 1)
+			})
+
+			Convey("When approving the registration", func() {
+				_, err := api.Approve(ctx, &pb.ApproveRegistrationRequest{Id: resp.Id})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+				Convey("Then the user is active", func() {
+					user, err := storage.GetUser(config.C.PostgreSQL.DB, resp.UserId)
+					So(err, ShouldBeNil)
+					So(user.IsActive, ShouldBeTrue)
+				})
+
+				Convey("Then the registration is no longer pending", func() {
+					listResp, err := api.List(ctx, &pb.ListRegistrationRequest{Limit: 10, PendingOnly: true})
+					So(err, ShouldBeNil)
+					So(listResp.TotalCount, ShouldEqual, 0)
+				})
+			})
+
+			Convey("When rejecting the registration", func() {
+				_, err := api.Reject(ctx, &pb.RejectRegistrationRequest{Id: resp.Id})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+				Convey("Then the user and organization have been deleted", func() {
+					_, err := storage.GetUser(config.C.PostgreSQL.DB, resp.UserId)
+					So(err, ShouldEqual, storage.ErrDoesNotExist)
+
+					_, err = storage.GetOrganization(config.C.PostgreSQL.DB, resp.OrganizationId)
+					So(err, ShouldEqual, storage.ErrDoesNotExist)
+				})
+			})
+		})
+
+		Convey("When registration is restricted by domain", func() {
+			config.C.ApplicationServer.Registration.Mode = "domain"
+			config.C.ApplicationServer.Registration.AllowedDomains = []string{"example.net"}
+
+			Convey("Then registering with a different domain returns PermissionDenied", func() {
+				_, err := api.Register(ctx, &registerReq)
+				So(grpc.Code(err), ShouldEqual, codes.PermissionDenied)
+			})
+
+			Convey("Then registering with an allowed domain succeeds", func() {
+				registerReq.Email = "user@example.net"
+				_, err := api.Register(ctx, &registerReq)
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When registration is invite-only", func() {
+			config.C.ApplicationServer.Registration.Mode = "invite"
+
+			Convey("Then registering without invite returns PermissionDenied", func() {
+				_, err := api.Register(ctx, &registerReq)
+				So(grpc.Code(err), ShouldEqual, codes.PermissionDenied)
+			})
+
+			Convey("When creating an invite", func() {
+				inviteResp, err := api.CreateInvite(ctx, &pb.CreateRegistrationInviteRequest{})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(inviteResp.Invite.Token, ShouldNotEqual, "")
+
+				Convey("Then the invite is listed", func() {
+					listResp, err := api.ListInvites(ctx, &pb.ListRegistrationInviteRequest{Limit: 10})
+					So(err, ShouldBeNil)
+					So(listResp.TotalCount, ShouldEqual, 1)
+					So(listResp.Result[0].Token, ShouldEqual, inviteResp.Invite.Token)
+				})
+
+				Convey("Then the invite can be used only once", func() {
+					registerReq.InviteToken = inviteResp.Invite.Token
+					_, err := api.Register(ctx, &registerReq)
+					So(err, ShouldBeNil)
+
+					registerReq.Username = "testuser2"
+					registerReq.OrganizationName = "test-org-2"
+					_, err = api.Register(ctx, &registerReq)
+					So(grpc.Code(err), ShouldEqual, codes.PermissionDenied)
+				})
+
+				Convey("Then the invite can be deleted", func() {
+					_, err := api.DeleteInvite(ctx, &pb.DeleteRegistrationInviteRequest{Id: inviteResp.Invite.Id})
+					So(err, ShouldBeNil)
+
+					_, err = api.DeleteInvite(ctx, &pb.DeleteRegistrationInviteRequest{Id: inviteResp.Invite.Id})
+					So(grpc.Code(err), ShouldEqual, codes.NotFound)
+				})
+			})
+		})
+	})
+}
@@ -45,6 +45,9 @@ func (a *ServiceProfileServiceAPI) Create(ctx context.Context, req *pb.CreateSer
 		OrganizationID:  req.ServiceProfile.OrganizationId,
 		NetworkServerID: req.ServiceProfile.NetworkServerId,
 		Name:            req.ServiceProfile.Name,
+		MaxDeviceCount:  int(req.ServiceProfile.MaxDeviceCount),
+		MaxUplinkRate:   int(req.ServiceProfile.MaxUplinkRate),
+		MaxPayloadSize:  int(req.ServiceProfile.MaxPayloadSize),
 		ServiceProfile: ns.ServiceProfile{
 			UlRate:                 req.ServiceProfile.UlRate,
 			UlBucketSize:           req.ServiceProfile.UlBucketSize,
@@ -130,6 +133,9 @@ func (a *ServiceProfileServiceAPI) Get(ctx context.Context, req *pb.GetServicePr
 			MinGwDiversity: sp.ServiceProfile.MinGwDiversity,
 			UlRatePolicy:   pb.RatePolicy(sp.ServiceProfile.UlRatePolicy),
 			DlRatePolicy:   pb.RatePolicy(sp.ServiceProfile.DlRatePolicy),
+			MaxDeviceCount: uint32(sp.MaxDeviceCount),
+			MaxUplinkRate:  uint32(sp.MaxUplinkRate),
+			MaxPayloadSize: uint32(sp.MaxPayloadSize),
 		},
 	}
 
@@ -168,6 +174,9 @@ func (a *ServiceProfileServiceAPI) Update(ctx context.Context, req *pb.UpdateSer
 	}
 
 	sp.Name = req.ServiceProfile.Name
+	sp.MaxDeviceCount = int(req.ServiceProfile.MaxDeviceCount)
+	sp.MaxUplinkRate = int(req.ServiceProfile.MaxUplinkRate)
+	sp.MaxPayloadSize = int(req.ServiceProfile.MaxPayloadSize)
 	sp.ServiceProfile = ns.ServiceProfile{
 		Id:                     spID.Bytes(),
 		UlRate:                 req.ServiceProfile.UlRate,
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/eventlog"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/limits"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// Service-profile limit notification types.
+const (
+	spMaxDeviceCountWarning = "SERVICE_PROFILE_MAX_DEVICE_COUNT_WARNING"
+	spMaxUplinkRateWarning  = "SERVICE_PROFILE_MAX_UPLINK_RATE_WARNING"
+	spMaxUplinkRate         = "SERVICE_PROFILE_MAX_UPLINK_RATE"
+	spMaxPayloadSize        = "SERVICE_PROFILE_MAX_PAYLOAD_SIZE"
+)
+
+// validateUplinkLimits validates the uplink of the given device against
+// the limits of the service-profile of the application. It sends a warning
+// notification when the max uplink rate is being approached and returns an
+// error when a limit has been exceeded.
+func validateUplinkLimits(d storage.Device, app storage.Application, fCnt uint32, data []byte) error {
+	sp, err := storage.GetServiceProfile(config.C.PostgreSQL.DB, app.ServiceProfileID, true)
+	if err != nil {
+		return errors.Wrap(err, "get service-profile error")
+	}
+
+	if sp.MaxPayloadSize != 0 && len(data) > sp.MaxPayloadSize {
+		sendServiceProfileLimitNotification(d, app, spMaxPayloadSize, fCnt,
+			fmt.Sprintf("payload size of %d bytes exceeds the max payload size of %d bytes", len(data), sp.MaxPayloadSize))
+		return storage.ErrServiceProfileMaxPayloadSize
+	}
+
+	if sp.MaxUplinkRate == 0 {
+		return nil
+	}
+
+	count, err := limits.IncrUplinkCount(config.C.Redis.Pool, d.DevEUI, time.Now())
+	if err != nil {
+		return errors.Wrap(err, "increment uplink count error")
+	}
+
+	threshold := config.C.ApplicationServer.ServiceProfileLimits.WarningThreshold
+	switch limits.GetStatus(count, sp.MaxUplinkRate, threshold) {
+	case limits.ExceedingLimit:
+		sendServiceProfileLimitNotification(d, app, spMaxUplinkRate, fCnt,
+			fmt.Sprintf("max uplink rate of %d uplinks per hour exceeded", sp.MaxUplinkRate))
+		return storage.ErrServiceProfileMaxUplinkRate
+	case limits.ApproachingLimit:
+		// only warn once per hour, when the threshold is reached
+		if count == limits.WarningValue(sp.MaxUplinkRate, threshold) {
+			sendServiceProfileLimitNotification(d, app, spMaxUplinkRateWarning, fCnt,
+				fmt.Sprintf("%d of max %d uplinks per hour used", count, sp.MaxUplinkRate))
+		}
+	}
+
+	return nil
+}
+
+// sendServiceProfileLimitNotification logs and sends an error notification
+// for the given device about a service-profile limit.
+func sendServiceProfileLimitNotification(d storage.Device, app storage.Application, typ string, fCnt uint32, errStr string) {
+	log.WithFields(log.Fields{
+		"dev_eui":            d.DevEUI,
+		"application_id":     app.ID,
+		"service_profile_id": app.ServiceProfileID,
+		"type":               typ,
+	}).Warning(errStr)
+
+	errNotification := handler.ErrorNotification{
+		ApplicationID:   app.ID,
+		ApplicationName: app.Name,
+		DeviceName:      d.Name,
+		DevEUI:          d.DevEUI,
+		Type:            typ,
+		Error:           errStr,
+		FCnt:            fCnt,
+	}
+
+	if err := eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
+		Type:    eventlog.Error,
+		Payload: errNotification,
+	}); err != nil {
+		log.WithError(err).Error("log event for device error")
+	}
+
+	if !app.IsArchived() {
+		if err := config.C.ApplicationServer.Integration.Handler.SendErrorNotification(errNotification); err != nil {
+			log.WithError(err).Error("send error notification to handler error")
+		}
+	}
+}
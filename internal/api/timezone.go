@@ -0,0 +1,97 @@
+package api
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/loraserver/api/ns"
+)
+
+// getLocation returns the location of the given (IANA) timezone, used to
+// aggregate statistics. It returns UTC when no timezone is given.
+func getLocation(timezone string) (*time.Location, error) {
+	// Local is the timezone of the server, which is not meaningful to
+	// the client (and unknown to PostgreSQL)
+	if timezone == "" || timezone == "UTC" {
+		return time.UTC, nil
+	}
+	if timezone == "Local" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "timezone: unknown time zone %s", timezone)
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "timezone: %s", err)
+	}
+	return loc, nil
+}
+
+// getDayRange returns the [start, end) time range covering the full days
+// (in the given location) of the given start and end timestamps.
+func getDayRange(start, end time.Time, loc *time.Location) (time.Time, time.Time) {
+	start = start.In(loc)
+	end = end.In(loc)
+
+	return time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc),
+		time.Date(end.Year(), end.Month(), end.Day()+1, 0, 0, 0, 0, loc)
+}
+
+// truncateToInterval returns the start of the aggregation interval (in the
+// given location) containing the given timestamp. Weeks start on Monday.
+func truncateToInterval(t time.Time, interval ns.AggregationInterval, loc *time.Location) time.Time {
+	t = t.In(loc)
+	year, month, day := t.Date()
+
+	switch interval {
+	case ns.AggregationInterval_DAY:
+		return time.Date(year, month, day, 0, 0, 0, 0, loc)
+	case ns.AggregationInterval_WEEK:
+		weekday := (int(t.Weekday()) + 6) % 7 // days since Monday
+		return time.Date(year, month, day-weekday, 0, 0, 0, 0, loc)
+	case ns.AggregationInterval_MONTH:
+		return time.Date(year, month, 1, 0, 0, 0, 0, loc)
+	case ns.AggregationInterval_QUARTER:
+		return time.Date(year, month-(month-1)%3, 1, 0, 0, 0, 0, loc)
+	case ns.AggregationInterval_YEAR:
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	default:
+		return t
+	}
+}
+
+// aggregateGatewayStats aggregates the given (hourly) gateway stats into
+// the given interval, in the given location. The stats must be ordered by
+// timestamp.
+func aggregateGatewayStats(stats []*ns.GatewayStats, interval ns.AggregationInterval, loc *time.Location) ([]*pb.GatewayStats, error) {
+	var out []*pb.GatewayStats
+	var last time.Time
+
+	for _, stat := range stats {
+		ts, err := ptypes.Timestamp(stat.Timestamp)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+		ts = truncateToInterval(ts, interval, loc)
+
+		if len(out) == 0 || !ts.Equal(last) {
+			tsProto, err := ptypes.TimestampProto(ts)
+			if err != nil {
+				return nil, errToRPCError(err)
+			}
+			out = append(out, &pb.GatewayStats{Timestamp: tsProto})
+			last = ts
+		}
+
+		s := out[len(out)-1]
+		s.RxPacketsReceived += stat.RxPacketsReceived
+		s.RxPacketsReceivedOk += stat.RxPacketsReceivedOk
+		s.TxPacketsReceived += stat.TxPacketsReceived
+		s.TxPacketsEmitted += stat.TxPacketsEmitted
+	}
+
+	return out, nil
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/loraserver/api/ns"
+)
+
+func TestGetLocation(t *testing.T) {
+	assert := require.New(t)
+
+	loc, err := getLocation("")
+	assert.NoError(err)
+	assert.Equal(time.UTC, loc)
+
+	loc, err = getLocation("Europe/Amsterdam")
+	assert.NoError(err)
+	assert.Equal("Europe/Amsterdam", loc.String())
+
+	_, err = getLocation("Local")
+	assert.Error(err)
+
+	_, err = getLocation("Mars/Olympus_Mons")
+	assert.Error(err)
+}
+
+func TestGetDayRange(t *testing.T) {
+	assert := require.New(t)
+
+	loc, err := time.LoadLocation("America/New_York")
+	assert.NoError(err)
+
+	// 2018-06-02 02:00 UTC is still 2018-06-01 in New York (UTC-4)
+	start, end := getDayRange(
+		time.Date(2018, 6, 2, 2, 0, 0, 0, time.UTC),
+		time.Date(2018, 6, 3, 12, 0, 0, 0, time.UTC),
+		loc,
+	)
+	assert.True(start.Equal(time.Date(2018, 6, 1, 4, 0, 0, 0, time.UTC)), start.String())
+	assert.True(end.Equal(time.Date(2018, 6, 4, 4, 0, 0, 0, time.UTC)), end.String())
+}
+
+func TestTruncateToInterval(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Amsterdam")
+	require.NoError(t, err)
+
+	// Wednesday 2018-08-15 23:30 in Amsterdam (UTC+2)
+	ts := time.Date(2018, 8, 15, 21, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		Interval ns.AggregationInterval
+		Expected time.Time
+	}{
+		{ns.AggregationInterval_DAY, time.Date(2018, 8, 15, 0, 0, 0, 0, loc)},
+		{ns.AggregationInterval_WEEK, time.Date(2018, 8, 13, 0, 0, 0, 0, loc)},
+		{ns.AggregationInterval_MONTH, time.Date(2018, 8, 1, 0, 0, 0, 0, loc)},
+		{ns.AggregationInterval_QUARTER, time.Date(2018, 7, 1, 0, 0, 0, 0, loc)},
+		{ns.AggregationInterval_YEAR, time.Date(2018, 1, 1, 0, 0, 0, 0, loc)},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Interval.String(), func(t *testing.T) {
+			assert := require.New(t)
+			out := truncateToInterval(ts, tst.Interval, loc)
+			assert.True(tst.Expected.Equal(out), out.String())
+		})
+	}
+}
+
+func TestAggregateGatewayStats(t *testing.T) {
+	assert := require.New(t)
+
+	loc, err := time.LoadLocation("Europe/Amsterdam")
+	assert.NoError(err)
+
+	// hourly stats from 2018-08-15 21:00 until 23:00 UTC, the last two
+	// hours being the next day in Amsterdam
+	var stats []*ns.GatewayStats
+	for i := 0; i < 3; i++ {
+		ts, err := ptypes.TimestampProto(time.Date(2018, 8, 15, 21+i, 0, 0, 0, time.UTC))
+		assert.NoError(err)
+		stats = append(stats, &ns.GatewayStats{
+			Timestamp:           ts,
+			RxPacketsReceived:   2,
+			RxPacketsReceivedOk: 1,
+			TxPacketsReceived:   1,
+			TxPacketsEmitted:    1,
+		})
+	}
+
+	out, err := aggregateGatewayStats(stats, ns.AggregationInterval_DAY, loc)
+	assert.NoError(err)
+	assert.Len(out, 2)
+
+	day, err := ptypes.Timestamp(out[0].Timestamp)
+	assert.NoError(err)
+	assert.True(day.Equal(time.Date(2018, 8, 15, 0, 0, 0, 0, loc)))
+	assert.EqualValues(2, out[0].RxPacketsReceived)
+	assert.EqualValues(1, out[0].RxPacketsReceivedOk)
+
+	day, err = ptypes.Timestamp(out[1].Timestamp)
+	assert.NoError(err)
+	assert.True(day.Equal(time.Date(2018, 8, 16, 0, 0, 0, 0, loc)))
+	assert.EqualValues(4, out[1].RxPacketsReceived)
+	assert.EqualValues(2, out[1].TxPacketsEmitted)
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/correlation"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/uplinkfilter"
+)
+
+// passUplinkFilter evaluates the uplink filter script of the given
+// application and returns false when the uplink must not be sent to the
+// integrations. Dropped uplinks are counted. In case the script fails, the
+// uplink is passed so that a broken script does not result in data loss.
+func passUplinkFilter(ctx context.Context, app storage.Application, pl handler.DataUpPayload) bool {
+	if app.UplinkFilterScript == "" {
+		return true
+	}
+
+	logFields := log.Fields{
+		"application_id": app.ID,
+		"dev_eui":        pl.DevEUI,
+		"f_cnt":          pl.FCnt,
+	}
+
+	prevObject, err := uplinkfilter.GetLastObject(config.C.Redis.Pool, pl.DevEUI)
+	if err != nil {
+		correlation.Log(ctx).WithFields(logFields).WithError(err).Error("get uplink filter last object error")
+	}
+
+	pass, err := uplinkfilter.Filter(app.UplinkFilterScript, pl.FPort, pl.Data, pl.Object, prevObject)
+	if err != nil {
+		correlation.Log(ctx).WithFields(logFields).WithError(err).Error("uplink filter error")
+		return true
+	}
+
+	if !pass {
+		correlation.Log(ctx).WithFields(logFields).Info("uplink dropped by uplink filter")
+
+		if err := uplinkfilter.IncrDroppedCount(config.C.Redis.Pool, app.ID); err != nil {
+			correlation.Log(ctx).WithFields(logFields).WithError(err).Error("increment uplink filter dropped count error")
+		}
+		return false
+	}
+
+	if pl.Object != nil {
+		if err := uplinkfilter.SetLastObject(config.C.Redis.Pool, pl.DevEUI, pl.Object); err != nil {
+			correlation.Log(ctx).WithFields(logFields).WithError(err).Error("set uplink filter last object error")
+		}
+	}
+
+	return true
+}
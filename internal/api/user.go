@@ -1,17 +1,27 @@
 package api
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/backpressure"
+	"github.com/brocaar/lora-app-server/internal/certexpiry"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/readonly"
+	"github.com/brocaar/lora-app-server/internal/securityevent"
 	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
 )
 
 // UserAPI exports the User related functions.
@@ -235,6 +245,47 @@ func (a *UserAPI) UpdatePassword(ctx context.Context, req *pb.UpdateUserPassword
 	return &empty.Empty{}, nil
 }
 
+// ExportData exports all data stored for the user matching the given ID.
+func (a *UserAPI) ExportData(ctx context.Context, req *pb.ExportUserDataRequest) (*pb.ExportUserDataResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateUserAccess(req.Id, auth.Read)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	export, err := storage.ExportUserData(config.C.PostgreSQL.DB, req.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	b, err := json.Marshal(export)
+	if err != nil {
+		return nil, errToRPCError(errors.Wrap(err, "marshal json error"))
+	}
+
+	return &pb.ExportUserDataResponse{
+		DataJson: string(b),
+	}, nil
+}
+
+// EraseData irreversibly erases all data stored for the user matching the
+// given ID.
+func (a *UserAPI) EraseData(ctx context.Context, req *pb.EraseUserDataRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateUserAccess(req.Id, auth.Delete)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		_, err := storage.EraseUserData(tx, req.Id)
+		return err
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
 // NewInternalUserAPI creates a new InternalUserAPI.
 func NewInternalUserAPI(validator auth.Validator) *InternalUserAPI {
 	return &InternalUserAPI{
@@ -246,6 +297,14 @@ func NewInternalUserAPI(validator auth.Validator) *InternalUserAPI {
 func (a *InternalUserAPI) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
 	jwt, err := storage.LoginUser(config.C.PostgreSQL.DB, req.Username, req.Password)
 	if nil != err {
+		if err == storage.ErrInvalidUsernameOrPassword {
+			securityevent.Log(storage.SecurityEvent{
+				Type:        securityevent.FailedLogin,
+				Username:    req.Username,
+				RemoteAddr:  securityevent.RemoteAddr(ctx),
+				Description: "login failed: " + err.Error(),
+			})
+		}
 		return nil, errToRPCError(err)
 	}
 
@@ -314,6 +373,39 @@ func (a *InternalUserAPI) Profile(ctx context.Context, req *empty.Empty) (*pb.Pr
 	return &resp, nil
 }
 
+// WhoAmI returns the authenticated user, its organization memberships and
+// the permissions it has on the given resource.
+func (a *InternalUserAPI) WhoAmI(ctx context.Context, req *pb.WhoAmIRequest) (*pb.WhoAmIResponse, error) {
+	checks, err := getPermissionChecks(req.ResourceType, req.ResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	prof, err := a.Profile(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := pb.WhoAmIResponse{
+		User:          prof.User,
+		Organizations: prof.Organizations,
+	}
+
+	for _, check := range checks {
+		err := a.validator.Validate(ctx, check.validator)
+		if err == nil {
+			resp.Permissions = append(resp.Permissions, check.permission)
+			continue
+		}
+
+		if errors.Cause(err) != auth.ErrNotAuthorized {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	return &resp, nil
+}
+
 // Branding returns UI branding.
 func (a *InternalUserAPI) Branding(ctx context.Context, req *empty.Empty) (*pb.BrandingResponse, error) {
 	resp := pb.BrandingResponse{
@@ -388,3 +480,226 @@ func (a *InternalUserAPI) GlobalSearch(ctx context.Context, req *pb.GlobalSearch
 
 	return &out, nil
 }
+
+// ListSecurityEvents lists the security events.
+func (a *InternalUserAPI) ListSecurityEvents(ctx context.Context, req *pb.ListSecurityEventsRequest) (*pb.ListSecurityEventsResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateIsAdmin()); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	filters := storage.SecurityEventFilters{
+		Type:   req.Type,
+		Limit:  int(req.Limit),
+		Offset: int(req.Offset),
+	}
+
+	if req.DevEui != "" {
+		var devEUI lorawan.EUI64
+		if err := devEUI.UnmarshalText([]byte(req.DevEui)); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, err.Error())
+		}
+		filters.DevEUI = &devEUI
+	}
+
+	count, err := storage.GetSecurityEventCount(config.C.PostgreSQL.DB, filters)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	events, err := storage.GetSecurityEvents(config.C.PostgreSQL.DB, filters)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListSecurityEventsResponse{
+		TotalCount: int64(count),
+	}
+
+	for _, e := range events {
+		row := pb.SecurityEvent{
+			Id:          e.ID,
+			Type:        e.Type,
+			Severity:    uint32(e.Severity),
+			Username:    e.Username,
+			RemoteAddr:  e.RemoteAddr,
+			Description: e.Description,
+		}
+
+		row.CreatedAt, err = ptypes.TimestampProto(e.CreatedAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		if e.DevEUI != nil {
+			row.DevEui = e.DevEUI.String()
+		}
+
+		resp.Result = append(resp.Result, &row)
+	}
+
+	return &resp, nil
+}
+
+// GetStorageUsage returns the storage usage per table and per organization.
+func (a *InternalUserAPI) GetStorageUsage(ctx context.Context, req *pb.GetStorageUsageRequest) (*pb.GetStorageUsageResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateIsAdmin()); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	tables, err := storage.GetTableStorageUsage(config.C.PostgreSQL.DB)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	reports, err := storage.GetOrganizationStorageUsageReports(config.C.PostgreSQL.DB)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if req.Limit > 0 && int64(len(reports)) > req.Limit {
+		reports = reports[:req.Limit]
+	}
+
+	var resp pb.GetStorageUsageResponse
+	resp.Tables = tableStorageUsageToPB(tables)
+
+	for _, r := range reports {
+		resp.Organizations = append(resp.Organizations, &pb.OrganizationStorageUsageReport{
+			OrganizationId:   r.OrganizationID,
+			OrganizationName: r.OrganizationName,
+			Size:             r.Size,
+			Tables:           tableStorageUsageToPB(r.Tables),
+		})
+	}
+
+	return &resp, nil
+}
+
+// GetReadOnlyMode returns the server-wide read-only (maintenance) mode.
+func (a *InternalUserAPI) GetReadOnlyMode(ctx context.Context, req *empty.Empty) (*pb.GetReadOnlyModeResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateActiveUser()); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	m, err := readonly.Get(config.C.Redis.Pool)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.GetReadOnlyModeResponse{
+		Enabled: m.Enabled,
+		Reason:  m.Reason,
+	}
+
+	if m.Enabled {
+		resp.Since, err = ptypes.TimestampProto(m.Since)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	return &resp, nil
+}
+
+// SetReadOnlyMode enables or disables the server-wide read-only
+// (maintenance) mode.
+func (a *InternalUserAPI) SetReadOnlyMode(ctx context.Context, req *pb.SetReadOnlyModeRequest) (*empty.Empty, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateIsAdmin()); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	err := readonly.Set(config.C.Redis.Pool, readonly.Mode{
+		Enabled: req.Enabled,
+		Reason:  req.Reason,
+		Since:   time.Now(),
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	username, _ := a.validator.GetUsername(ctx)
+	log.WithFields(log.Fields{
+		"enabled":  req.Enabled,
+		"reason":   req.Reason,
+		"username": username,
+	}).Warning("read-only mode updated")
+
+	return &empty.Empty{}, nil
+}
+
+// ListCertificates lists the configured TLS certificates and their expiry.
+func (a *InternalUserAPI) ListCertificates(ctx context.Context, req *empty.Empty) (*pb.ListCertificatesResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateIsAdmin()); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	certs, err := certexpiry.GetCertificates(config.C.PostgreSQL.DB)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	threshold := config.C.ApplicationServer.CertificateExpiry.WarningThreshold
+
+	var resp pb.ListCertificatesResponse
+	for _, c := range certs {
+		cert := pb.Certificate{
+			Name:        c.Name,
+			ExpiresSoon: c.ExpiresSoon(threshold),
+			Expired:     c.Expired(),
+		}
+
+		cert.ExpiresAt, err = ptypes.TimestampProto(c.ExpiresAt)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		resp.Result = append(resp.Result, &cert)
+	}
+
+	return &resp, nil
+}
+
+// GetBackPressure returns the back-pressure state of the integration
+// delivery.
+func (a *InternalUserAPI) GetBackPressure(ctx context.Context, req *empty.Empty) (*pb.GetBackPressureResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateIsAdmin()); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	state := backpressure.GetState(config.C.ApplicationServer.Integration.Delivery.BackPressure)
+
+	resp := pb.GetBackPressureResponse{
+		Overloaded:    state.Overloaded,
+		QueueUsage:    state.QueueUsage,
+		SpoolSize:     int64(state.SpoolSize),
+		RejectedCount: int64(state.RejectedCount),
+	}
+
+	if !state.OverloadedSince.IsZero() {
+		var err error
+		resp.OverloadedSince, err = ptypes.TimestampProto(state.OverloadedSince)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	return &resp, nil
+}
+
+func tableStorageUsageToPB(tables []storage.TableStorageUsage) []*pb.TableStorageUsage {
+	var out []*pb.TableStorageUsage
+	for _, t := range tables {
+		out = append(out, &pb.TableStorageUsage{
+			Name:     t.Name,
+			RowCount: t.RowCount,
+			Size:     t.Size,
+		})
+	}
+	return out
+}
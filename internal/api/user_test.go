@@ -1,12 +1,16 @@
 package api
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/brocaar/loraserver/api/ns"
+	"github.com/golang/protobuf/ptypes/empty"
 
 	. "github.com/smartystreets/goconvey/convey"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 
 	pb "github.com/brocaar/lora-app-server/api"
 	"github.com/brocaar/lora-app-server/internal/config"
@@ -22,9 +26,11 @@ func TestUserAPI(t *testing.T) {
 	}
 
 	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL, 10, 0)
 
 	Convey("Given a clean database and api instance", t, func() {
 		test.MustResetDB(config.C.PostgreSQL.DB)
+		test.MustFlushRedis(config.C.Redis.Pool)
 
 		nsClient := test.NewNetworkServerClient()
 		nsClient.GetDeviceProfileResponse = ns.GetDeviceProfileResponse{
@@ -111,6 +117,83 @@ func TestUserAPI(t *testing.T) {
 					So(jwt, ShouldNotBeNil)
 				})
 
+				Convey("When login in with an invalid password", func() {
+					_, err := apiInternal.Login(ctx, &pb.LoginRequest{
+						Username: createReq.User.Username,
+						Password: "invalid",
+					})
+					So(err, ShouldNotBeNil)
+
+					Convey("Then a failed_login security event has been created", func() {
+						resp, err := apiInternal.ListSecurityEvents(ctx, &pb.ListSecurityEventsRequest{
+							Limit: 10,
+							Type:  "failed_login",
+						})
+						So(err, ShouldBeNil)
+						So(validator.validatorFuncs, ShouldHaveLength, 1)
+						So(resp.TotalCount, ShouldEqual, 1)
+						So(resp.Result, ShouldHaveLength, 1)
+						So(resp.Result[0].Username, ShouldEqual, createReq.User.Username)
+						So(resp.Result[0].Severity, ShouldEqual, 5)
+					})
+				})
+
+				Convey("When getting the storage usage", func() {
+					resp, err := apiInternal.GetStorageUsage(ctx, &pb.GetStorageUsageRequest{})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+					Convey("Then the storage usage per table is returned", func() {
+						var found bool
+						for _, t := range resp.Tables {
+							if t.Name == "user" {
+								found = true
+							}
+						}
+						So(found, ShouldBeTrue)
+					})
+				})
+
+				Convey("When listing the certificates", func() {
+					resp, err := apiInternal.ListCertificates(ctx, &empty.Empty{})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+					Convey("Then no certificates are returned", func() {
+						So(resp.Result, ShouldHaveLength, 0)
+					})
+				})
+
+				Convey("When enabling the read-only mode", func() {
+					_, err := apiInternal.SetReadOnlyMode(ctx, &pb.SetReadOnlyModeRequest{
+						Enabled: true,
+						Reason:  "database upgrade",
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+					Convey("Then the read-only mode is enabled", func() {
+						resp, err := apiInternal.GetReadOnlyMode(ctx, &empty.Empty{})
+						So(err, ShouldBeNil)
+						So(validator.validatorFuncs, ShouldHaveLength, 1)
+						So(resp.Enabled, ShouldBeTrue)
+						So(resp.Reason, ShouldEqual, "database upgrade")
+						So(resp.Since, ShouldNotBeNil)
+					})
+
+					Convey("When disabling the read-only mode", func() {
+						_, err := apiInternal.SetReadOnlyMode(ctx, &pb.SetReadOnlyModeRequest{})
+						So(err, ShouldBeNil)
+
+						Convey("Then the read-only mode is disabled", func() {
+							resp, err := apiInternal.GetReadOnlyMode(ctx, &empty.Empty{})
+							So(err, ShouldBeNil)
+							So(resp.Enabled, ShouldBeFalse)
+							So(resp.Since, ShouldBeNil)
+						})
+					})
+				})
+
 				Convey("When updating the user", func() {
 					updateReq := pb.UpdateUserRequest{
 						User: &pb.User{
@@ -171,6 +254,36 @@ func TestUserAPI(t *testing.T) {
 						So(users.TotalCount, ShouldEqual, 1)
 					})
 				})
+
+				Convey("When exporting the user data", func() {
+					resp, err := api.ExportData(ctx, &pb.ExportUserDataRequest{
+						Id: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+					Convey("Then the export contains the user", func() {
+						var export storage.UserDataExport
+						So(json.Unmarshal([]byte(resp.DataJson), &export), ShouldBeNil)
+						So(export.User.Username, ShouldEqual, "username")
+						So(export.Email, ShouldEqual, "foo@bar.com")
+					})
+				})
+
+				Convey("When erasing the user data", func() {
+					_, err := api.EraseData(ctx, &pb.EraseUserDataRequest{
+						Id: createResp.Id,
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+					Convey("Then the user has been deleted", func() {
+						_, err := api.Get(ctx, &pb.GetUserRequest{
+							Id: createResp.Id,
+						})
+						So(grpc.Code(err), ShouldEqual, codes.NotFound)
+					})
+				})
 			})
 		})
 	})
@@ -1,6 +1,8 @@
 package api
 
 import (
+	"time"
+
 	"github.com/brocaar/lora-app-server/internal/api/auth"
 	"golang.org/x/net/context"
 )
@@ -11,6 +13,7 @@ type TestValidator struct {
 	returnError    error
 	returnUsername string
 	returnIsAdmin  bool
+	returnExpires  time.Time
 }
 
 func (v *TestValidator) Validate(ctx context.Context, funcs ...auth.ValidatorFunc) error {
@@ -26,3 +29,7 @@ func (v *TestValidator) GetUsername(ctx context.Context) (string, error) {
 func (v *TestValidator) GetIsAdmin(ctx context.Context) (bool, error) {
 	return v.returnIsAdmin, v.returnError
 }
+
+func (v *TestValidator) GetExpiresAt(ctx context.Context) (time.Time, error) {
+	return v.returnExpires, v.returnError
+}
@@ -0,0 +1,104 @@
+// Package availability records the number of received and expected uplinks
+// of the devices, so that the delivery-rate (the ratio between the received
+// and expected uplinks) can be reported. The number of expected uplinks is
+// derived from the gaps in the uplink frame-counter.
+package availability
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// fCntKeyTempl defines the key template of the last seen uplink
+// frame-counter of a device.
+const fCntKeyTempl = "lora:as:device:%s:availability_f_cnt_up"
+
+// fCntTTL defines the expiration of the last seen frame-counter. The first
+// uplink of a device which has not been seen within this duration is
+// counted as a single expected uplink.
+const fCntTTL = 30 * 24 * time.Hour
+
+// maxFCntGap defines the max. frame-counter gap which is counted as lost
+// uplinks (MAX_FCNT_GAP of LoRaWAN 1.0). Larger gaps are considered a
+// frame-counter jump (e.g. caused by a device reset).
+const maxFCntGap = 16384
+
+// Count returns the number of received and expected uplinks for the given
+// frame-counter, compared to the previous frame-counter of the device. An
+// uplink with the same frame-counter as the previous uplink is a
+// retransmission and is not counted. On the first uplink after a
+// (re)activation or a frame-counter reset, a single uplink is expected.
+func Count(prev, fCnt uint32, found, activated bool) (int64, int64) {
+	if !found || activated || fCnt < prev || fCnt-prev > maxFCntGap {
+		return 1, 1
+	}
+
+	if fCnt == prev {
+		return 0, 0
+	}
+
+	return 1, int64(fCnt - prev)
+}
+
+// getAndSetFCnt stores the given frame-counter as the last seen uplink
+// frame-counter of the given device and returns the previous value. The
+// returned bool is false when there is no previous value.
+func getAndSetFCnt(p *redis.Pool, devEUI lorawan.EUI64, fCnt uint32) (uint32, bool, error) {
+	c := p.Get()
+	defer c.Close()
+
+	key := fmt.Sprintf(fCntKeyTempl, devEUI)
+
+	c.Send("MULTI")
+	c.Send("GET", key)
+	c.Send("PSETEX", key, int64(fCntTTL/time.Millisecond), fCnt)
+	values, err := redis.Values(c.Do("EXEC"))
+	if err != nil {
+		return 0, false, errors.Wrap(err, "get and set frame-counter error")
+	}
+
+	if values[0] == nil {
+		return 0, false, nil
+	}
+
+	prev, err := redis.Uint64(values[0], nil)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "read frame-counter error")
+	}
+
+	return uint32(prev), true, nil
+}
+
+// Record records the given uplink in the availability of the device.
+// Activated must be set to true for the first uplink after a
+// (re)activation.
+func Record(db sqlx.Execer, p *redis.Pool, devEUI lorawan.EUI64, fCnt uint32, activated bool, t time.Time) error {
+	prev, found, err := getAndSetFCnt(p, devEUI, fCnt)
+	if err != nil {
+		return err
+	}
+
+	received, expected := Count(prev, fCnt, found, activated)
+	if expected == 0 {
+		return nil
+	}
+
+	err = storage.IncrementDeviceAvailability(db, storage.DeviceAvailability{
+		DevEUI:        devEUI,
+		Hour:          t,
+		ReceivedCount: received,
+		ExpectedCount: expected,
+	})
+	if err != nil {
+		return errors.Wrap(err, "increment device availability error")
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package availability
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestCount(t *testing.T) {
+	tests := []struct {
+		Name      string
+		Prev      uint32
+		FCnt      uint32
+		Found     bool
+		Activated bool
+		Received  int64
+		Expected  int64
+	}{
+		{"first uplink", 0, 10, false, false, 1, 1},
+		{"increment", 10, 11, true, false, 1, 1},
+		{"gap", 10, 15, true, false, 1, 5},
+		{"retransmission", 10, 10, true, false, 0, 0},
+		{"reset", 10, 0, true, false, 1, 1},
+		{"jump", 10, 10 + maxFCntGap + 1, true, false, 1, 1},
+		{"activation", 10, 0, true, true, 1, 1},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			received, expected := Count(tst.Prev, tst.FCnt, tst.Found, tst.Activated)
+			assert.Equal(tst.Received, received)
+			assert.Equal(tst.Expected, expected)
+		})
+	}
+}
+
+func TestGetAndSetFCnt(t *testing.T) {
+	assert := require.New(t)
+
+	conf := test.GetConfig()
+	p := storage.NewRedisPool(conf.RedisURL, 10, 0)
+	test.MustFlushRedis(p)
+
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+	_, found, err := getAndSetFCnt(p, devEUI, 10)
+	assert.NoError(err)
+	assert.False(found)
+
+	prev, found, err := getAndSetFCnt(p, devEUI, 12)
+	assert.NoError(err)
+	assert.True(found)
+	assert.EqualValues(10, prev)
+
+}
@@ -0,0 +1,149 @@
+// Package backpressure implements the back-pressure signaling to the
+// network-server. When the integration delivery queue or the (at-least-once)
+// spools exceed the configured thresholds, uplinks are rejected with
+// ResourceExhausted and a retry-after hint, so that the network-server
+// retry behavior is well defined when LoRa App Server is overloaded.
+package backpressure
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// RetryAfterKey defines the gRPC trailer key containing the number of
+// seconds after which a rejected request should be retried.
+const RetryAfterKey = "retry-after"
+
+// Config defines the back-pressure configuration.
+type Config struct {
+	// QueueThreshold defines the usage (0 - 1) of the delivery queue above
+	// which the uplinks are rejected. Set to 0 to disable.
+	QueueThreshold float64 `mapstructure:"queue_threshold"`
+
+	// SpoolThreshold defines the number of spooled events (of a single
+	// spool) above which the uplinks are rejected. Set to 0 to disable.
+	SpoolThreshold int `mapstructure:"spool_threshold"`
+
+	// RetryAfter defines the retry-after hint returned to the
+	// network-server.
+	RetryAfter time.Duration `mapstructure:"retry_after"`
+}
+
+// State contains the back-pressure state.
+type State struct {
+	// Overloaded is set when one of the thresholds has been exceeded.
+	Overloaded bool
+
+	// OverloadedSince contains the time since when the thresholds are
+	// exceeded.
+	OverloadedSince time.Time
+
+	// QueueUsage contains the usage (0 - 1) of the delivery queue.
+	QueueUsage float64
+
+	// SpoolSize contains the size of the largest spool.
+	SpoolSize int
+
+	// RejectedCount contains the number of rejected uplinks since start.
+	RejectedCount int
+}
+
+var (
+	mu             sync.Mutex
+	queueUsageFunc func() float64
+	spoolSizes     = make(map[string]int)
+	state          State
+)
+
+// SetQueueUsageFunc sets the function returning the usage (0 - 1) of the
+// delivery queue.
+func SetQueueUsageFunc(f func() float64) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	queueUsageFunc = f
+}
+
+// SetSpoolSize sets the size of the spool of the given target.
+func SetSpoolSize(target string, size int) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if size == 0 {
+		delete(spoolSizes, target)
+		return
+	}
+	spoolSizes[target] = size
+}
+
+// GetState returns the back-pressure state for the given configuration.
+func GetState(c Config) State {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return updateState(c)
+}
+
+// Check returns a ResourceExhausted error when the thresholds of the given
+// configuration are exceeded. In this case, the retry-after hint is set as
+// trailer of the given (gRPC request) context.
+func Check(ctx context.Context, c Config) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	s := updateState(c)
+	if !s.Overloaded {
+		return nil
+	}
+
+	state.RejectedCount++
+
+	retryAfter := strconv.Itoa(int(c.RetryAfter / time.Second))
+	grpc.SetTrailer(ctx, metadata.Pairs(RetryAfterKey, retryAfter))
+
+	return grpc.Errorf(codes.ResourceExhausted, "back-pressure: queue usage %.2f, spool size %d, retry after %ss", s.QueueUsage, s.SpoolSize, retryAfter)
+}
+
+// updateState updates and returns the back-pressure state. The caller must
+// hold the lock.
+func updateState(c Config) State {
+	state.QueueUsage = 0
+	if queueUsageFunc != nil {
+		state.QueueUsage = queueUsageFunc()
+	}
+
+	state.SpoolSize = 0
+	for _, size := range spoolSizes {
+		if size > state.SpoolSize {
+			state.SpoolSize = size
+		}
+	}
+
+	overloaded := (c.QueueThreshold > 0 && state.QueueUsage >= c.QueueThreshold) ||
+		(c.SpoolThreshold > 0 && state.SpoolSize >= c.SpoolThreshold)
+
+	switch {
+	case overloaded && !state.Overloaded:
+		state.OverloadedSince = time.Now()
+		log.WithFields(log.Fields{
+			"queue_usage": state.QueueUsage,
+			"spool_size":  state.SpoolSize,
+		}).Warning("backpressure: thresholds exceeded, rejecting uplinks")
+	case !overloaded && state.Overloaded:
+		state.OverloadedSince = time.Time{}
+		log.WithFields(log.Fields{
+			"queue_usage": state.QueueUsage,
+			"spool_size":  state.SpoolSize,
+		}).Info("backpressure: back below thresholds, accepting uplinks")
+	}
+	state.Overloaded = overloaded
+
+	return state
+}
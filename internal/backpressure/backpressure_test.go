@@ -0,0 +1,75 @@
+package backpressure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestBackPressure(t *testing.T) {
+	conf := Config{
+		QueueThreshold: 0.9,
+		SpoolThreshold: 100,
+		RetryAfter:     10 * time.Second,
+	}
+
+	var queueUsage float64
+	SetQueueUsageFunc(func() float64 { return queueUsage })
+
+	t.Run("Below thresholds", func(t *testing.T) {
+		assert := require.New(t)
+
+		queueUsage = 0.5
+		SetSpoolSize("default", 10)
+
+		assert.NoError(Check(context.Background(), conf))
+		state := GetState(conf)
+		assert.False(state.Overloaded)
+		assert.Equal(0.5, state.QueueUsage)
+		assert.Equal(10, state.SpoolSize)
+	})
+
+	t.Run("Queue threshold exceeded", func(t *testing.T) {
+		assert := require.New(t)
+
+		queueUsage = 0.95
+
+		err := Check(context.Background(), conf)
+		assert.Equal(codes.ResourceExhausted, grpc.Code(err))
+
+		state := GetState(conf)
+		assert.True(state.Overloaded)
+		assert.False(state.OverloadedSince.IsZero())
+		assert.Equal(1, state.RejectedCount)
+
+		queueUsage = 0
+	})
+
+	t.Run("Spool threshold exceeded", func(t *testing.T) {
+		assert := require.New(t)
+
+		SetSpoolSize("http:1:1", 150)
+		err := Check(context.Background(), conf)
+		assert.Equal(codes.ResourceExhausted, grpc.Code(err))
+
+		SetSpoolSize("http:1:1", 0)
+		assert.NoError(Check(context.Background(), conf))
+
+		state := GetState(conf)
+		assert.False(state.Overloaded)
+		assert.True(state.OverloadedSince.IsZero())
+		assert.Equal(2, state.RejectedCount)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		assert := require.New(t)
+
+		queueUsage = 1
+		SetSpoolSize("default", 1000)
+		assert.NoError(Check(context.Background(), Config{}))
+	})
+}
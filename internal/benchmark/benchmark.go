@@ -0,0 +1,400 @@
+// Package benchmark implements a load-test for the application-server API.
+// It sends uplinks for a set of (activated) devices to a running
+// application-server at a configurable rate and measures the throughput and
+// latency, while probing the PostgreSQL and Redis round-trip times to detect
+// bottlenecks.
+package benchmark
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/brocaar/loraserver/api/as"
+	"github.com/brocaar/loraserver/api/gw"
+	"github.com/brocaar/lorawan"
+)
+
+// baselineSamples defines the number of probe samples taken before the
+// load is applied.
+const baselineSamples = 10
+
+// UplinkClient defines the application-server client interface used to
+// send the uplinks.
+type UplinkClient interface {
+	HandleUplinkData(ctx context.Context, in *as.HandleUplinkDataRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+// PayloadSize defines an uplink payload size and its relative weight in
+// the payload size distribution.
+type PayloadSize struct {
+	Size   int
+	Weight int
+}
+
+// ParsePayloadSizes parses a payload size distribution in the format
+// size:weight[,size:weight...], e.g. "11:50,24:35,51:15".
+func ParsePayloadSizes(s string) ([]PayloadSize, error) {
+	var out []PayloadSize
+
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		var ps PayloadSize
+		var err error
+		kv := strings.SplitN(part, ":", 2)
+
+		ps.Size, err = strconv.Atoi(kv[0])
+		if err != nil || ps.Size < 0 || ps.Size > 242 {
+			return nil, fmt.Errorf("invalid payload size: %s", kv[0])
+		}
+
+		ps.Weight = 1
+		if len(kv) == 2 {
+			ps.Weight, err = strconv.Atoi(kv[1])
+			if err != nil || ps.Weight < 1 {
+				return nil, fmt.Errorf("invalid payload size weight: %s", kv[1])
+			}
+		}
+
+		out = append(out, ps)
+	}
+
+	if len(out) == 0 {
+		return nil, errors.New("at least one payload size must be given")
+	}
+
+	return out, nil
+}
+
+// randomPayloadSize returns a random payload size, according to the
+// weights of the given distribution.
+func randomPayloadSize(sizes []PayloadSize) int {
+	var total int
+	for _, ps := range sizes {
+		total += ps.Weight
+	}
+
+	n := rand.Intn(total)
+	for _, ps := range sizes {
+		if n < ps.Weight {
+			return ps.Size
+		}
+		n -= ps.Weight
+	}
+
+	return sizes[len(sizes)-1].Size
+}
+
+// Device defines an activated device used for the benchmark.
+type Device struct {
+	DevEUI  lorawan.EUI64
+	DevAddr lorawan.DevAddr
+	AppSKey lorawan.AES128Key
+
+	fCnt uint32
+}
+
+// Probe defines a round-trip probe (e.g. a PostgreSQL or Redis ping) which
+// is sampled before and during the benchmark.
+type Probe struct {
+	Name string
+	Func func() error
+}
+
+// Config defines the benchmark configuration.
+type Config struct {
+	// Rate defines the number of uplinks per second.
+	Rate float64
+
+	// Duration defines the duration of the benchmark.
+	Duration time.Duration
+
+	// Concurrency defines the max. number of uplinks in flight.
+	Concurrency int
+
+	// FPort defines the FPort used for the uplinks.
+	FPort uint8
+
+	// PayloadSizes defines the payload size distribution.
+	PayloadSizes []PayloadSize
+
+	// ProbeInterval defines the interval in which the probes are sampled
+	// during the benchmark.
+	ProbeInterval time.Duration
+}
+
+// Latency contains the latency distribution of a set of samples.
+type Latency struct {
+	Count int
+	Min   time.Duration
+	P50   time.Duration
+	P90   time.Duration
+	P95   time.Duration
+	P99   time.Duration
+	Max   time.Duration
+}
+
+// newLatency returns the latency distribution of the given samples.
+func newLatency(samples []time.Duration) Latency {
+	if len(samples) == 0 {
+		return Latency{}
+	}
+
+	sorted := make([]time.Duration, len(samples))
+	copy(sorted, samples)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	return Latency{
+		Count: len(sorted),
+		Min:   sorted[0],
+		P50:   percentile(sorted, 50),
+		P90:   percentile(sorted, 90),
+		P95:   percentile(sorted, 95),
+		P99:   percentile(sorted, 99),
+		Max:   sorted[len(sorted)-1],
+	}
+}
+
+// percentile returns the p-th percentile (nearest-rank) of the given sorted
+// samples.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	rank := int(p/100*float64(len(sorted))+0.5) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= len(sorted) {
+		rank = len(sorted) - 1
+	}
+
+	return sorted[rank]
+}
+
+// ProbeResult contains the round-trip latency of a probe before (baseline)
+// and during the benchmark (under load).
+type ProbeResult struct {
+	Name      string
+	Baseline  Latency
+	UnderLoad Latency
+	Errors    int
+}
+
+// Degradation returns the ratio between the P95 latency under load and the
+// baseline P95 latency.
+func (r ProbeResult) Degradation() float64 {
+	if r.Baseline.P95 == 0 {
+		return 0
+	}
+	return float64(r.UnderLoad.P95) / float64(r.Baseline.P95)
+}
+
+// Result contains the benchmark result.
+type Result struct {
+	Duration   time.Duration
+	Sent       int
+	Errors     int
+	ErrorCodes map[codes.Code]int
+	Latency    Latency
+	Probes     []ProbeResult
+}
+
+// Throughput returns the number of successfully handled uplinks per second.
+func (r Result) Throughput() float64 {
+	if r.Duration == 0 {
+		return 0
+	}
+	return float64(r.Sent-r.Errors) / r.Duration.Seconds()
+}
+
+// Run runs the benchmark against the given client until the configured
+// duration has passed or the context has been cancelled. The uplinks are
+// sent round-robin for the given devices.
+func Run(ctx context.Context, client UplinkClient, devices []*Device, conf Config, probes []Probe) (Result, error) {
+	if len(devices) == 0 {
+		return Result{}, errors.New("at least one device is required")
+	}
+	if conf.Rate <= 0 {
+		return Result{}, errors.New("rate must be greater than 0")
+	}
+	if conf.Duration <= 0 {
+		return Result{}, errors.New("duration must be greater than 0")
+	}
+	if conf.Concurrency < 1 {
+		return Result{}, errors.New("concurrency must be at least 1")
+	}
+	if len(conf.PayloadSizes) == 0 {
+		return Result{}, errors.New("at least one payload size is required")
+	}
+
+	result := Result{
+		ErrorCodes: make(map[codes.Code]int),
+	}
+
+	probeResults := make([]ProbeResult, len(probes))
+	for i, p := range probes {
+		probeResults[i].Name = p.Name
+		var samples []time.Duration
+		for j := 0; j < baselineSamples; j++ {
+			d, err := sampleProbe(p)
+			if err != nil {
+				probeResults[i].Errors++
+				continue
+			}
+			samples = append(samples, d)
+		}
+		probeResults[i].Baseline = newLatency(samples)
+	}
+
+	// uplinks in flight when the duration has passed are not cancelled
+	reqCtx := ctx
+	ctx, cancel := context.WithTimeout(ctx, conf.Duration)
+	defer cancel()
+
+	var mu sync.Mutex
+	var latencies []time.Duration
+	var wg sync.WaitGroup
+	jobs := make(chan *Device)
+
+	for i := 0; i < conf.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for d := range jobs {
+				start := time.Now()
+				err := sendUplink(reqCtx, client, d, conf)
+				duration := time.Since(start)
+
+				mu.Lock()
+				result.Sent++
+				if err != nil {
+					result.Errors++
+					result.ErrorCodes[grpc.Code(err)]++
+				} else {
+					latencies = append(latencies, duration)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	probeSamples := make([][]time.Duration, len(probes))
+	probeDone := make(chan struct{})
+	go func() {
+		defer close(probeDone)
+		if len(probes) == 0 || conf.ProbeInterval <= 0 {
+			return
+		}
+
+		ticker := time.NewTicker(conf.ProbeInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			for i, p := range probes {
+				d, err := sampleProbe(p)
+				if err != nil {
+					probeResults[i].Errors++
+					continue
+				}
+				probeSamples[i] = append(probeSamples[i], d)
+			}
+		}
+	}()
+
+	start := time.Now()
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / conf.Rate))
+	defer ticker.Stop()
+
+loop:
+	for i := 0; ; i++ {
+		select {
+		case <-ctx.Done():
+			break loop
+		case jobs <- devices[i%len(devices)]:
+		}
+
+		select {
+		case <-ctx.Done():
+			break loop
+		case <-ticker.C:
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+	<-probeDone
+
+	result.Duration = time.Since(start)
+	result.Latency = newLatency(latencies)
+
+	for i := range probeResults {
+		probeResults[i].UnderLoad = newLatency(probeSamples[i])
+	}
+	result.Probes = probeResults
+
+	return result, nil
+}
+
+func sendUplink(ctx context.Context, client UplinkClient, d *Device, conf Config) error {
+	fCnt := atomic.AddUint32(&d.fCnt, 1) - 1
+
+	data := make([]byte, randomPayloadSize(conf.PayloadSizes))
+	if _, err := rand.Read(data); err != nil {
+		return errors.Wrap(err, "read random bytes error")
+	}
+
+	b, err := lorawan.EncryptFRMPayload(d.AppSKey, true, d.DevAddr, fCnt, data)
+	if err != nil {
+		return errors.Wrap(err, "encrypt payload error")
+	}
+
+	_, err = client.HandleUplinkData(ctx, &as.HandleUplinkDataRequest{
+		DevEui: d.DevEUI[:],
+		FCnt:   fCnt,
+		FPort:  uint32(conf.FPort),
+		Dr:     5,
+		TxInfo: &gw.UplinkTXInfo{
+			Frequency: 868100000,
+		},
+		RxInfo: []*gw.UplinkRXInfo{
+			{
+				GatewayId: []byte{0, 0, 0, 0, 0, 0, 0, 0},
+				Rssi:      int32(-60 - rand.Intn(60)),
+				LoraSnr:   float64(rand.Intn(20) - 10),
+			},
+		},
+		Data: b,
+	})
+	return err
+}
+
+func sampleProbe(p Probe) (time.Duration, error) {
+	start := time.Now()
+	if err := p.Func(); err != nil {
+		return 0, err
+	}
+	return time.Since(start), nil
+}
@@ -0,0 +1,154 @@
+package benchmark
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/brocaar/loraserver/api/as"
+	"github.com/brocaar/lorawan"
+)
+
+type testUplinkClient struct {
+	mu       sync.Mutex
+	requests []as.HandleUplinkDataRequest
+	err      error
+}
+
+func (c *testUplinkClient) HandleUplinkData(ctx context.Context, in *as.HandleUplinkDataRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.requests = append(c.requests, *in)
+	return &empty.Empty{}, c.err
+}
+
+func TestParsePayloadSizes(t *testing.T) {
+	Convey("Given a set of payload size distributions", t, func() {
+		tests := []struct {
+			In       string
+			Expected []PayloadSize
+			Error    bool
+		}{
+			{
+				In:       "11:50,24:35,51:15",
+				Expected: []PayloadSize{{11, 50}, {24, 35}, {51, 15}},
+			},
+			{
+				In:       "12",
+				Expected: []PayloadSize{{12, 1}},
+			},
+			{
+				In:    "",
+				Error: true,
+			},
+			{
+				In:    "243:1",
+				Error: true,
+			},
+			{
+				In:    "12:0",
+				Error: true,
+			},
+		}
+
+		for _, test := range tests {
+			Convey("Then "+test.In+" is parsed as expected", func() {
+				out, err := ParsePayloadSizes(test.In)
+				if test.Error {
+					So(err, ShouldNotBeNil)
+				} else {
+					So(err, ShouldBeNil)
+					So(out, ShouldResemble, test.Expected)
+				}
+			})
+		}
+	})
+}
+
+func TestNewLatency(t *testing.T) {
+	Convey("Given 100 samples of 1 - 100ms", t, func() {
+		var samples []time.Duration
+		for i := 100; i > 0; i-- {
+			samples = append(samples, time.Duration(i)*time.Millisecond)
+		}
+
+		Convey("Then the percentiles are calculated as expected", func() {
+			l := newLatency(samples)
+			So(l, ShouldResemble, Latency{
+				Count: 100,
+				Min:   time.Millisecond,
+				P50:   50 * time.Millisecond,
+				P90:   90 * time.Millisecond,
+				P95:   95 * time.Millisecond,
+				P99:   99 * time.Millisecond,
+				Max:   100 * time.Millisecond,
+			})
+		})
+	})
+}
+
+func TestRun(t *testing.T) {
+	Convey("Given a test client, a device and a probe", t, func() {
+		client := testUplinkClient{}
+		d := Device{
+			DevEUI:  lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			DevAddr: lorawan.DevAddr{1, 2, 3, 4},
+			AppSKey: lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+		}
+		probe := Probe{
+			Name: "test",
+			Func: func() error { return nil },
+		}
+		conf := Config{
+			Rate:          100,
+			Duration:      100 * time.Millisecond,
+			Concurrency:   2,
+			FPort:         10,
+			PayloadSizes:  []PayloadSize{{Size: 5, Weight: 1}},
+			ProbeInterval: 10 * time.Millisecond,
+		}
+
+		Convey("Then Run sends encrypted uplinks and reports the result", func() {
+			res, err := Run(context.Background(), &client, []*Device{&d}, conf, []Probe{probe})
+			So(err, ShouldBeNil)
+			So(res.Sent, ShouldBeGreaterThan, 0)
+			So(res.Sent, ShouldEqual, len(client.requests))
+			So(res.Errors, ShouldEqual, 0)
+			So(res.Latency.Count, ShouldEqual, res.Sent)
+			So(res.Probes, ShouldHaveLength, 1)
+			So(res.Probes[0].Baseline.Count, ShouldEqual, baselineSamples)
+
+			fCnts := make(map[uint32]struct{})
+			for _, req := range client.requests {
+				So(req.DevEui, ShouldResemble, d.DevEUI[:])
+				So(req.FPort, ShouldEqual, 10)
+				So(req.Data, ShouldHaveLength, 5)
+				fCnts[req.FCnt] = struct{}{}
+			}
+			So(fCnts, ShouldHaveLength, len(client.requests))
+		})
+
+		Convey("Then errors are counted by code", func() {
+			client.err = grpc.Errorf(codes.Internal, "test error")
+			res, err := Run(context.Background(), &client, []*Device{&d}, conf, nil)
+			So(err, ShouldBeNil)
+			So(res.Errors, ShouldEqual, res.Sent)
+			So(res.ErrorCodes[codes.Internal], ShouldEqual, res.Sent)
+			So(res.Throughput(), ShouldEqual, 0)
+		})
+
+		Convey("Then probe errors are counted", func() {
+			probe.Func = func() error { return errors.New("test error") }
+			res, err := Run(context.Background(), &client, []*Device{&d}, conf, []Probe{probe})
+			So(err, ShouldBeNil)
+			So(res.Probes[0].Errors, ShouldBeGreaterThanOrEqualTo, baselineSamples)
+		})
+	})
+}
@@ -0,0 +1,194 @@
+// Package certexpiry implements the monitoring of the expiry of the
+// configured TLS certificates (APIs, MQTT integration, join-server and
+// network-server connections). Certificates expiring within the configured
+// warning threshold are logged, reported as security event and sent as
+// admin-plane event to the integrations.
+package certexpiry
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/certutil"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/securityevent"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// lockKey is used to make sure that only one instance checks the
+// certificates within the check interval.
+const lockKey = "lora:as:certexpiry:lock"
+
+// Certificate contains the expiry of a configured certificate.
+type Certificate struct {
+	Name      string
+	ExpiresAt time.Time
+}
+
+// ExpiresSoon returns true when the certificate expires within the given
+// threshold.
+func (c Certificate) ExpiresSoon(threshold time.Duration) bool {
+	return time.Until(c.ExpiresAt) < threshold
+}
+
+// Expired returns true when the certificate has expired.
+func (c Certificate) Expired() bool {
+	return time.Now().After(c.ExpiresAt)
+}
+
+// GetCertificates returns the expiry of the configured certificates,
+// ordered by expiry. This includes the certificates of the configured
+// network-servers. Certificates which can not be read are logged and
+// skipped.
+func GetCertificates(db sqlx.Queryer) ([]Certificate, error) {
+	var out []Certificate
+
+	files := []struct {
+		name string
+		path string
+	}{
+		{"application_server.api.ca_cert", config.C.ApplicationServer.API.CACert},
+		{"application_server.api.tls_cert", config.C.ApplicationServer.API.TLSCert},
+		{"application_server.external_api.tls_cert", config.C.ApplicationServer.ExternalAPI.TLSCert},
+		{"application_server.integration.mqtt.ca_cert", config.C.ApplicationServer.Integration.MQTT.CACert},
+		{"application_server.integration.mqtt.tls_cert", config.C.ApplicationServer.Integration.MQTT.TLSCert},
+		{"join_server.ca_cert", config.C.JoinServer.CACert},
+		{"join_server.tls_cert", config.C.JoinServer.TLSCert},
+	}
+
+	for _, f := range files {
+		if f.path == "" {
+			continue
+		}
+
+		expiresAt, err := certutil.GetFileExpiry(f.path)
+		if err != nil {
+			log.WithError(err).WithFields(log.Fields{
+				"name": f.name,
+				"path": f.path,
+			}).Error("certexpiry: get certificate expiry error")
+			continue
+		}
+
+		out = append(out, Certificate{Name: f.name, ExpiresAt: expiresAt})
+	}
+
+	if !config.C.NetworkServer.Mock.Enabled {
+		servers, err := storage.GetNetworkServers(db, 1000, 0)
+		if err != nil {
+			return nil, errors.Wrap(err, "get network-servers error")
+		}
+
+		for _, n := range servers {
+			for _, c := range []struct {
+				name string
+				pem  string
+			}{
+				{"ca_cert", n.CACert},
+				{"tls_cert", n.TLSCert},
+				{"routing_profile_ca_cert", n.RoutingProfileCACert},
+				{"routing_profile_tls_cert", n.RoutingProfileTLSCert},
+			} {
+				if c.pem == "" {
+					continue
+				}
+
+				name := fmt.Sprintf("network_server.%d.%s", n.ID, c.name)
+				expiresAt, err := certutil.GetExpiry([]byte(c.pem))
+				if err != nil {
+					log.WithError(err).WithField("name", name).Error("certexpiry: get certificate expiry error")
+					continue
+				}
+
+				out = append(out, Certificate{Name: name, ExpiresAt: expiresAt})
+			}
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].ExpiresAt.Before(out[j].ExpiresAt) })
+
+	return out, nil
+}
+
+// CheckLoop is a never returning function checking the expiry of the
+// certificates within the configured check interval.
+func CheckLoop() {
+	interval := config.C.ApplicationServer.CertificateExpiry.CheckInterval
+
+	for {
+		locked, err := acquireLock(interval)
+		if err != nil {
+			log.WithError(err).Error("certexpiry: acquire lock error")
+		} else if locked {
+			if err := Check(); err != nil {
+				log.WithError(err).Error("certexpiry: check error")
+			}
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// Check reports the certificates which expire within the configured
+// warning threshold or which have expired.
+func Check() error {
+	certs, err := GetCertificates(config.C.PostgreSQL.DB)
+	if err != nil {
+		return err
+	}
+
+	threshold := config.C.ApplicationServer.CertificateExpiry.WarningThreshold
+
+	for _, c := range certs {
+		if !c.ExpiresSoon(threshold) {
+			continue
+		}
+
+		action := "expiring"
+		description := fmt.Sprintf("certificate %s expires at %s", c.Name, c.ExpiresAt.Format(time.RFC3339))
+		if c.Expired() {
+			action = "expired"
+			description = fmt.Sprintf("certificate %s expired at %s", c.Name, c.ExpiresAt.Format(time.RFC3339))
+		}
+
+		securityevent.Log(storage.SecurityEvent{
+			Type:        securityevent.CertificateExpiry,
+			Description: description,
+		})
+
+		if h := config.C.ApplicationServer.Integration.Handler; h != nil {
+			err := h.SendAdminEvent(handler.AdminEvent{
+				Entity: "certificate",
+				Action: action,
+				ID:     c.Name,
+				Time:   time.Now(),
+			})
+			if err != nil {
+				log.WithError(err).WithField("name", c.Name).Error("certexpiry: send admin event error")
+			}
+		}
+	}
+
+	return nil
+}
+
+func acquireLock(interval time.Duration) (bool, error) {
+	c := config.C.Redis.Pool.Get()
+	defer c.Close()
+
+	_, err := redis.String(c.Do("SET", lockKey, "1", "PX", int64(interval/time.Millisecond), "NX"))
+	if err == redis.ErrNil {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
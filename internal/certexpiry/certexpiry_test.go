@@ -0,0 +1,108 @@
+package certexpiry
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/securityevent"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lora-app-server/internal/test/testhandler"
+)
+
+func newCertificate(t *testing.T, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    notAfter.Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+
+	b, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: b})
+}
+
+func TestCertificateExpiry(t *testing.T) {
+	assert := require.New(t)
+
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	assert.NoError(err)
+	test.MustResetDB(db)
+	config.C.PostgreSQL.DB = db
+
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(test.NewNetworkServerClient())
+	config.C.ApplicationServer.CertificateExpiry.WarningThreshold = 30 * 24 * time.Hour
+
+	h := testhandler.NewTestHandler()
+	config.C.ApplicationServer.Integration.Handler = h
+
+	apiExpiresAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	nsExpiresAt := time.Now().Add(90 * 24 * time.Hour).UTC().Truncate(time.Second)
+
+	f, err := ioutil.TempFile("", "cert")
+	assert.NoError(err)
+	defer os.Remove(f.Name())
+	_, err = f.Write(newCertificate(t, apiExpiresAt))
+	assert.NoError(err)
+	assert.NoError(f.Close())
+	config.C.ApplicationServer.API.TLSCert = f.Name()
+
+	n := storage.NetworkServer{
+		Name:    "test",
+		Server:  "test:1234",
+		TLSCert: string(newCertificate(t, nsExpiresAt)),
+	}
+	assert.NoError(storage.CreateNetworkServer(db, &n))
+
+	t.Run("GetCertificates", func(t *testing.T) {
+		assert := require.New(t)
+
+		certs, err := GetCertificates(db)
+		assert.NoError(err)
+		assert.Len(certs, 2)
+
+		assert.Equal("application_server.api.tls_cert", certs[0].Name)
+		assert.True(apiExpiresAt.Equal(certs[0].ExpiresAt))
+		assert.True(certs[0].ExpiresSoon(config.C.ApplicationServer.CertificateExpiry.WarningThreshold))
+		assert.False(certs[0].Expired())
+
+		assert.True(nsExpiresAt.Equal(certs[1].ExpiresAt))
+		assert.False(certs[1].ExpiresSoon(config.C.ApplicationServer.CertificateExpiry.WarningThreshold))
+	})
+
+	t.Run("Check", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.NoError(Check())
+
+		events, err := storage.GetSecurityEvents(db, storage.SecurityEventFilters{
+			Type:  securityevent.CertificateExpiry,
+			Limit: 10,
+		})
+		assert.NoError(err)
+		assert.Len(events, 1)
+
+		ae := <-h.SendAdminEventChan
+		assert.Equal("certificate", ae.Entity)
+		assert.Equal("expiring", ae.Action)
+		assert.Equal("application_server.api.tls_cert", ae.ID)
+	})
+}
@@ -0,0 +1,57 @@
+// Package certutil contains helpers for inspecting the configured TLS
+// certificates.
+package certutil
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// GetExpiry returns the expiry (NotAfter) of the given PEM encoded
+// certificate(s). In case of multiple certificates (e.g. a certificate
+// chain), the earliest expiry is returned.
+func GetExpiry(b []byte) (time.Time, error) {
+	var expiry time.Time
+
+	for {
+		var block *pem.Block
+		block, b = pem.Decode(b)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return expiry, errors.Wrap(err, "parse certificate error")
+		}
+
+		if expiry.IsZero() || cert.NotAfter.Before(expiry) {
+			expiry = cert.NotAfter
+		}
+	}
+
+	if expiry.IsZero() {
+		return expiry, errors.New("no certificate found")
+	}
+
+	return expiry, nil
+}
+
+// GetFileExpiry returns the expiry of the PEM encoded certificate(s) in the
+// given file.
+func GetFileExpiry(path string) (time.Time, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "read certificate error")
+	}
+
+	return GetExpiry(b)
+}
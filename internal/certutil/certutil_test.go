@@ -0,0 +1,97 @@
+package certutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newCertificate(t *testing.T, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    notAfter.Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+
+	b, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: b})
+}
+
+func TestGetExpiry(t *testing.T) {
+	notAfter1 := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	notAfter2 := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Second)
+
+	tests := []struct {
+		Name   string
+		PEM    []byte
+		Expiry time.Time
+		Error  bool
+	}{
+		{
+			Name:   "single certificate",
+			PEM:    newCertificate(t, notAfter1),
+			Expiry: notAfter1,
+		},
+		{
+			Name:   "certificate chain returns the earliest expiry",
+			PEM:    append(newCertificate(t, notAfter2), newCertificate(t, notAfter1)...),
+			Expiry: notAfter1,
+		},
+		{
+			Name:  "no certificate",
+			PEM:   []byte("foo"),
+			Error: true,
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			expiry, err := GetExpiry(tst.PEM)
+			if tst.Error {
+				assert.Error(err)
+				return
+			}
+			assert.NoError(err)
+			assert.True(tst.Expiry.Equal(expiry))
+		})
+	}
+}
+
+func TestGetFileExpiry(t *testing.T) {
+	assert := require.New(t)
+
+	notAfter := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+
+	f, err := ioutil.TempFile("", "cert")
+	assert.NoError(err)
+	defer os.Remove(f.Name())
+
+	_, err = f.Write(newCertificate(t, notAfter))
+	assert.NoError(err)
+	assert.NoError(f.Close())
+
+	expiry, err := GetFileExpiry(f.Name())
+	assert.NoError(err)
+	assert.True(notAfter.Equal(expiry))
+
+	_, err = GetFileExpiry("/does/not/exist")
+	assert.Error(err)
+}
@@ -0,0 +1,115 @@
+// Package changedfields implements the changed-fields event mode of the
+// integrations. In this mode, the decoded object only contains the fields
+// which changed since the previous uplink of the device which was sent to
+// the integration. The previous object is stored in Redis, per integration.
+package changedfields
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+)
+
+const (
+	// lastObjectKeyTempl defines the key template of the last (full)
+	// decoded object of a device, per integration.
+	lastObjectKeyTempl = "lora:as:device:%s:changed_fields:%s:last_object"
+
+	// lastObjectTTL defines the expiration of the last object. After
+	// this period, all fields are included again.
+	lastObjectTTL = 7 * 24 * time.Hour
+)
+
+// Get returns the fields of the given object which changed since the
+// previous object of the device for the given integration and stores the
+// given object as the previous object. When there is no previous object or
+// when the object is not a JSON object, the object is returned as-is.
+func Get(p *redis.Pool, integration string, devEUI lorawan.EUI64, object interface{}) (interface{}, error) {
+	b, err := json.Marshal(object)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal json error")
+	}
+
+	var cur interface{}
+	if err := json.Unmarshal(b, &cur); err != nil {
+		return nil, errors.Wrap(err, "unmarshal json error")
+	}
+
+	key := fmt.Sprintf(lastObjectKeyTempl, devEUI, integration)
+
+	c := p.Get()
+	defer c.Close()
+
+	c.Send("MULTI")
+	c.Send("GET", key)
+	c.Send("PSETEX", key, int64(lastObjectTTL/time.Millisecond), b)
+	values, err := redis.Values(c.Do("EXEC"))
+	if err != nil {
+		return nil, errors.Wrap(err, "get and set last object error")
+	}
+
+	prevB, err := redis.Bytes(values[0], nil)
+	if err != nil {
+		if err == redis.ErrNil {
+			return object, nil
+		}
+		return nil, errors.Wrap(err, "read last object error")
+	}
+
+	var prev interface{}
+	if err := json.Unmarshal(prevB, &prev); err != nil {
+		return nil, errors.Wrap(err, "unmarshal json error")
+	}
+
+	if _, ok := cur.(map[string]interface{}); !ok {
+		return object, nil
+	}
+
+	return Diff(prev, cur), nil
+}
+
+// Diff returns the fields of cur which are not present or have a different
+// value in prev. Nested objects are compared field by field, all other
+// values (including arrays) are compared as a whole. Fields which are no
+// longer present are not included. When prev or cur is not an object, cur
+// is returned.
+func Diff(prev, cur interface{}) interface{} {
+	prevMap, ok := prev.(map[string]interface{})
+	if !ok {
+		return cur
+	}
+	curMap, ok := cur.(map[string]interface{})
+	if !ok {
+		return cur
+	}
+
+	out := make(map[string]interface{})
+	for k, v := range curMap {
+		pv, ok := prevMap[k]
+		if !ok {
+			out[k] = v
+			continue
+		}
+
+		if reflect.DeepEqual(pv, v) {
+			continue
+		}
+
+		_, prevIsMap := pv.(map[string]interface{})
+		_, curIsMap := v.(map[string]interface{})
+		if prevIsMap && curIsMap {
+			out[k] = Diff(pv, v)
+			continue
+		}
+
+		out[k] = v
+	}
+
+	return out
+}
@@ -0,0 +1,109 @@
+package changedfields
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestDiff(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Name     string
+			Prev     interface{}
+			Cur      interface{}
+			Expected interface{}
+		}{
+			{
+				Name:     "no previous object",
+				Cur:      map[string]interface{}{"temperature": 21.5},
+				Expected: map[string]interface{}{"temperature": 21.5},
+			},
+			{
+				Name:     "unchanged fields",
+				Prev:     map[string]interface{}{"temperature": 21.5, "humidity": 60.0},
+				Cur:      map[string]interface{}{"temperature": 21.5, "humidity": 60.0},
+				Expected: map[string]interface{}{},
+			},
+			{
+				Name:     "changed and new fields",
+				Prev:     map[string]interface{}{"temperature": 21.5, "humidity": 60.0},
+				Cur:      map[string]interface{}{"temperature": 22.0, "humidity": 60.0, "battery": 95.0},
+				Expected: map[string]interface{}{"temperature": 22.0, "battery": 95.0},
+			},
+			{
+				Name:     "removed fields",
+				Prev:     map[string]interface{}{"temperature": 21.5, "humidity": 60.0},
+				Cur:      map[string]interface{}{"temperature": 21.5},
+				Expected: map[string]interface{}{},
+			},
+			{
+				Name: "nested objects",
+				Prev: map[string]interface{}{
+					"sensor": map[string]interface{}{"a": 1.0, "b": 2.0},
+				},
+				Cur: map[string]interface{}{
+					"sensor": map[string]interface{}{"a": 1.0, "b": 3.0},
+				},
+				Expected: map[string]interface{}{
+					"sensor": map[string]interface{}{"b": 3.0},
+				},
+			},
+			{
+				Name:     "arrays",
+				Prev:     map[string]interface{}{"values": []interface{}{1.0, 2.0}},
+				Cur:      map[string]interface{}{"values": []interface{}{1.0, 3.0}},
+				Expected: map[string]interface{}{"values": []interface{}{1.0, 3.0}},
+			},
+			{
+				Name:     "not an object",
+				Prev:     []interface{}{1.0},
+				Cur:      []interface{}{1.0},
+				Expected: []interface{}{1.0},
+			},
+		}
+
+		for _, tst := range tests {
+			Convey("Testing: "+tst.Name, func() {
+				So(Diff(tst.Prev, tst.Cur), ShouldResemble, tst.Expected)
+			})
+		}
+	})
+}
+
+func TestGet(t *testing.T) {
+	conf := test.GetConfig()
+	p := storage.NewRedisPool(conf.RedisURL, 10, 0)
+
+	Convey("Given a clean Redis database", t, func() {
+		test.MustFlushRedis(p)
+		devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+		type object struct {
+			Temperature float64 `json:"temperature"`
+			Humidity    float64 `json:"humidity"`
+		}
+
+		Convey("Then the first object is returned as-is", func() {
+			obj, err := Get(p, "http:1:1", devEUI, object{21.5, 60})
+			So(err, ShouldBeNil)
+			So(obj, ShouldResemble, object{21.5, 60})
+
+			Convey("Then the next object only contains the changed fields", func() {
+				obj, err := Get(p, "http:1:1", devEUI, object{22.0, 60})
+				So(err, ShouldBeNil)
+				So(obj, ShouldResemble, map[string]interface{}{"temperature": 22.0})
+			})
+
+			Convey("Then the previous object is stored per integration", func() {
+				obj, err := Get(p, "http:1:2", devEUI, object{22.0, 60})
+				So(err, ShouldBeNil)
+				So(obj, ShouldResemble, object{22.0, 60})
+			})
+		})
+	})
+}
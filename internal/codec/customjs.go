@@ -2,9 +2,13 @@ package codec
 
 import (
 	"encoding/gob"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -19,6 +23,67 @@ func init() {
 // run.
 var CodecMaxExecTime = 10 * time.Millisecond
 
+var (
+	// e.g. "Decode (3:15)" or "3:15"
+	stackFrameLocation = regexp.MustCompile(`(\d+):(\d+)\)?$`)
+	// e.g. "(anonymous): Line 3:15 Unexpected token )"
+	syntaxErrorLocation = regexp.MustCompile(`Line (\d+):(\d+)`)
+)
+
+// ScriptError contains the details of an error returned by a custom JS
+// codec script, e.g. when the script throws an exception.
+type ScriptError struct {
+	Message    string   `json:"message"`
+	Line       int      `json:"line,omitempty"`
+	Column     int      `json:"column,omitempty"`
+	StackTrace []string `json:"stackTrace,omitempty"`
+	Input      string   `json:"input,omitempty"`
+}
+
+// Error implements the error interface.
+func (e *ScriptError) Error() string {
+	return e.Message
+}
+
+// newScriptError returns a ScriptError for the given error. The line and
+// column are only set when they are within the given script (and not in the
+// code calling the Decode or Encode function).
+func newScriptError(err error, script string, input []byte) *ScriptError {
+	e := ScriptError{
+		Message: err.Error(),
+		Input:   hex.EncodeToString(input),
+	}
+	scriptLines := strings.Count(script, "\n") + 1
+
+	setLocation := func(match []string) {
+		if match == nil || e.Line != 0 {
+			return
+		}
+		line, _ := strconv.Atoi(match[1])
+		column, _ := strconv.Atoi(match[2])
+		if line > scriptLines {
+			return
+		}
+		e.Line = line
+		e.Column = column
+	}
+
+	switch cause := errors.Cause(err).(type) {
+	case *otto.Error:
+		// the first line contains the error message
+		lines := strings.Split(strings.TrimSpace(cause.String()), "\n")
+		for _, line := range lines[1:] {
+			frame := strings.TrimPrefix(strings.TrimSpace(line), "at ")
+			e.StackTrace = append(e.StackTrace, frame)
+			setLocation(stackFrameLocation.FindStringSubmatch(frame))
+		}
+	default:
+		setLocation(syntaxErrorLocation.FindStringSubmatch(cause.Error()))
+	}
+
+	return &e
+}
+
 // CustomJS is a scriptable JS codec.
 type CustomJS struct {
 	fPort        uint8
@@ -51,12 +116,16 @@ func (c *CustomJS) UnmarshalJSON(text []byte) error {
 	return json.Unmarshal(text, &c.Data)
 }
 
-// DecodeBytes decodes the payload from a slice of bytes.
+// DecodeBytes decodes the payload from a slice of bytes. On error, a
+// *ScriptError is returned.
 func (c *CustomJS) DecodeBytes(data []byte) (err error) {
 	defer func() {
 		if caught := recover(); caught != nil {
 			err = fmt.Errorf("%s", caught)
 		}
+		if err != nil {
+			err = newScriptError(err, c.decodeScript, data)
+		}
 	}()
 
 	script := c.decodeScript + "\n\nDecode(fPort, bytes);\n"
@@ -92,12 +161,16 @@ func (c *CustomJS) DecodeBytes(data []byte) (err error) {
 	return nil
 }
 
-// EncodeToBytes encodes the payload to a slice of bytes.
+// EncodeToBytes encodes the payload to a slice of bytes. On error, a
+// *ScriptError is returned.
 func (c CustomJS) EncodeToBytes() (b []byte, err error) {
 	defer func() {
 		if caught := recover(); caught != nil {
 			err = fmt.Errorf("%s", caught)
 		}
+		if err != nil {
+			err = newScriptError(err, c.encodeScript, nil)
+		}
 	}()
 
 	script := c.encodeScript + "\n\nEncode(fPort, obj);\n"
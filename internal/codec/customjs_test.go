@@ -1,6 +1,7 @@
 package codec
 
 import (
+	"encoding/hex"
 	"fmt"
 	"testing"
 
@@ -80,6 +81,65 @@ func TestCustomJSDecode(t *testing.T) {
 	})
 }
 
+func TestCustomJSScriptError(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Name            string
+			Script          string
+			Payload         []byte
+			ExpectedMessage string
+			ExpectedLine    int
+			StackTrace      bool
+		}{
+			{
+				Name: "exception",
+				Script: `function Decode(fPort, bytes) {
+	if (bytes.length < 2) {
+		throw new Error("expected at least 2 bytes");
+	}
+	return {};
+}`,
+				Payload:         []byte{1},
+				ExpectedMessage: "js vm error: Error: expected at least 2 bytes",
+				ExpectedLine:    3,
+				StackTrace:      true,
+			},
+			{
+				Name: "syntax error",
+				Script: `function Decode(fPort, bytes) {
+	return bytes[0] +;
+}`,
+				Payload:      []byte{1, 2},
+				ExpectedLine: 2,
+			},
+			{
+				Name:            "function error",
+				Script:          ``,
+				Payload:         []byte{1, 2, 3},
+				ExpectedMessage: "js vm error: ReferenceError: 'Decode' is not defined",
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+				js := NewCustomJS(10, "", test.Script)
+				err := js.DecodeBytes(test.Payload)
+				So(err, ShouldHaveSameTypeAs, &ScriptError{})
+
+				scriptErr := err.(*ScriptError)
+				if test.ExpectedMessage != "" {
+					So(scriptErr.Message, ShouldEqual, test.ExpectedMessage)
+				}
+				So(scriptErr.Line, ShouldEqual, test.ExpectedLine)
+				So(scriptErr.Input, ShouldEqual, hex.EncodeToString(test.Payload))
+				if test.StackTrace {
+					So(scriptErr.StackTrace, ShouldNotBeEmpty)
+				}
+			})
+		}
+	})
+}
+
 func TestCustomEncodeJS(t *testing.T) {
 	Convey("Given a set of tests", t, func() {
 		tests := []struct {
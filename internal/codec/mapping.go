@@ -0,0 +1,285 @@
+package codec
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// maxPrecision defines the max. number of decimals to round to.
+const maxPrecision = 15
+
+// unit defines a unit of measurement as a linear conversion to the base
+// unit of its dimension (base = value * factor + offset).
+type unit struct {
+	dimension string
+	factor    float64
+	offset    float64
+}
+
+// units contains the supported units for unit conversion.
+var units = map[string]unit{
+	// temperature (base: C)
+	"C": {"temperature", 1, 0},
+	"F": {"temperature", 5.0 / 9.0, -32 * 5.0 / 9.0},
+	"K": {"temperature", 1, -273.15},
+
+	// pressure (base: Pa)
+	"Pa":   {"pressure", 1, 0},
+	"hPa":  {"pressure", 100, 0},
+	"kPa":  {"pressure", 1000, 0},
+	"mbar": {"pressure", 100, 0},
+	"bar":  {"pressure", 100000, 0},
+	"psi":  {"pressure", 6894.757293168, 0},
+	"mmHg": {"pressure", 133.322387415, 0},
+
+	// length (base: m)
+	"mm": {"length", 0.001, 0},
+	"cm": {"length", 0.01, 0},
+	"m":  {"length", 1, 0},
+	"km": {"length", 1000, 0},
+	"in": {"length", 0.0254, 0},
+	"ft": {"length", 0.3048, 0},
+	"mi": {"length", 1609.344, 0},
+
+	// speed (base: m/s)
+	"m/s":  {"speed", 1, 0},
+	"km/h": {"speed", 1 / 3.6, 0},
+	"mph":  {"speed", 0.44704, 0},
+	"kn":   {"speed", 1852.0 / 3600.0, 0},
+
+	// volume (base: l)
+	"ml":  {"volume", 0.001, 0},
+	"l":   {"volume", 1, 0},
+	"m3":  {"volume", 1000, 0},
+	"gal": {"volume", 3.785411784, 0},
+
+	// mass (base: g)
+	"g":  {"mass", 1, 0},
+	"kg": {"mass", 1000, 0},
+	"lb": {"mass", 453.59237, 0},
+
+	// energy (base: Wh)
+	"J":   {"energy", 1.0 / 3600.0, 0},
+	"Wh":  {"energy", 1, 0},
+	"kWh": {"energy", 1000, 0},
+
+	// voltage (base: V)
+	"mV": {"voltage", 0.001, 0},
+	"V":  {"voltage", 1, 0},
+
+	// current (base: A)
+	"mA": {"current", 0.001, 0},
+	"A":  {"current", 1, 0},
+}
+
+// FieldMapping defines a mapping rule which is applied to the decoded
+// object, e.g. to normalize the objects of a heterogeneous device fleet.
+// The value is first scaled, then converted to the target unit and then
+// rounded, after which the field is renamed.
+type FieldMapping struct {
+	// Field holds the path of the field, using dots as separator (e.g.
+	// "temperatureSensor.1"). Array elements are addressed by index.
+	Field string `json:"field"`
+
+	// Name holds the new name of the field (optional).
+	Name string `json:"name"`
+
+	// Scale and Offset define the linear transformation of the value
+	// (value * scale + offset). A scale of 0 is handled as 1.
+	Scale  float64 `json:"scale"`
+	Offset float64 `json:"offset"`
+
+	// FromUnit and ToUnit define the unit conversion (optional).
+	FromUnit string `json:"fromUnit"`
+	ToUnit   string `json:"toUnit"`
+
+	// Round enables the rounding of the value to Precision decimals.
+	Round     bool `json:"round"`
+	Precision int  `json:"precision"`
+}
+
+// Validate validates the FieldMapping.
+func (m FieldMapping) Validate() error {
+	if m.Field == "" {
+		return errors.New("field must be set")
+	}
+	if strings.Contains(m.Name, ".") {
+		return errors.New("name must not contain a dot")
+	}
+
+	if m.FromUnit != "" || m.ToUnit != "" {
+		from, ok := units[m.FromUnit]
+		if !ok {
+			return fmt.Errorf("unknown unit: %s", m.FromUnit)
+		}
+		to, ok := units[m.ToUnit]
+		if !ok {
+			return fmt.Errorf("unknown unit: %s", m.ToUnit)
+		}
+		if from.dimension != to.dimension {
+			return fmt.Errorf("can not convert %s to %s", m.FromUnit, m.ToUnit)
+		}
+	}
+
+	if m.Round && (m.Precision < 0 || m.Precision > maxPrecision) {
+		return fmt.Errorf("precision must be between 0 and %d", maxPrecision)
+	}
+
+	return nil
+}
+
+// FieldMappings defines the list of field mappings of an application.
+type FieldMappings []FieldMapping
+
+// Validate validates the FieldMappings.
+func (f FieldMappings) Validate() error {
+	for _, m := range f {
+		if err := m.Validate(); err != nil {
+			return errors.Wrapf(err, "field %s", m.Field)
+		}
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (f FieldMappings) Value() (driver.Value, error) {
+	if f == nil {
+		f = FieldMappings{}
+	}
+	return json.Marshal(f)
+}
+
+// Scan implements the sql.Scanner interface.
+func (f *FieldMappings) Scan(src interface{}) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("expected []byte, got %T", src)
+	}
+	return json.Unmarshal(b, f)
+}
+
+// Apply applies the field mappings (in order) to the given decoded object
+// and returns the mapped object. As the object is mapped using its JSON
+// representation, the returned object is a generic JSON object. Mappings of
+// fields which are not present in the object are ignored.
+func (f FieldMappings) Apply(object interface{}) (interface{}, error) {
+	if len(f) == 0 || object == nil {
+		return object, nil
+	}
+
+	b, err := json.Marshal(object)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal json error")
+	}
+
+	var out interface{}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, errors.Wrap(err, "unmarshal json error")
+	}
+
+	for _, m := range f {
+		if err := m.apply(out); err != nil {
+			return nil, errors.Wrapf(err, "field %s", m.Field)
+		}
+	}
+
+	return out, nil
+}
+
+func (m FieldMapping) apply(object interface{}) error {
+	path := strings.Split(m.Field, ".")
+
+	// lookup the parent of the field
+	parent := object
+	for _, key := range path[:len(path)-1] {
+		var ok bool
+		if parent, ok = getElement(parent, key); !ok {
+			return nil
+		}
+	}
+
+	key := path[len(path)-1]
+	v, ok := getElement(parent, key)
+	if !ok {
+		return nil
+	}
+
+	if m.Scale != 0 || m.Offset != 0 || m.FromUnit != "" || m.Round {
+		f, ok := v.(float64)
+		if !ok {
+			return fmt.Errorf("expected number, got %T", v)
+		}
+		v = m.convert(f)
+	}
+
+	if m.Name == "" || m.Name == key {
+		return setElement(parent, key, v)
+	}
+
+	obj, ok := parent.(map[string]interface{})
+	if !ok {
+		return errors.New("only object fields can be renamed")
+	}
+	delete(obj, key)
+	obj[m.Name] = v
+
+	return nil
+}
+
+func (m FieldMapping) convert(v float64) float64 {
+	if m.Scale != 0 {
+		v = v * m.Scale
+	}
+	v = v + m.Offset
+
+	if m.FromUnit != m.ToUnit {
+		from := units[m.FromUnit]
+		to := units[m.ToUnit]
+		v = ((v*from.factor + from.offset) - to.offset) / to.factor
+	}
+
+	if m.Round {
+		pow := math.Pow(10, float64(m.Precision))
+		v = math.Round(v*pow) / pow
+	}
+
+	return v
+}
+
+func getElement(v interface{}, key string) (interface{}, bool) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out, ok := v[key]
+		return out, ok
+	case []interface{}:
+		i, err := strconv.Atoi(key)
+		if err != nil || i < 0 || i >= len(v) {
+			return nil, false
+		}
+		return v[i], true
+	default:
+		return nil, false
+	}
+}
+
+func setElement(v interface{}, key string, value interface{}) error {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		v[key] = value
+	case []interface{}:
+		i, err := strconv.Atoi(key)
+		if err != nil || i < 0 || i >= len(v) {
+			return fmt.Errorf("invalid index: %s", key)
+		}
+		v[i] = value
+	default:
+		return fmt.Errorf("expected object or array, got %T", v)
+	}
+	return nil
+}
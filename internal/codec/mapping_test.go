@@ -0,0 +1,160 @@
+package codec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldMappingsValidate(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Mappings FieldMappings
+		Valid    bool
+	}{
+		{
+			Name:  "empty",
+			Valid: true,
+		},
+		{
+			Name: "valid",
+			Mappings: FieldMappings{
+				{Field: "temperatureSensor.1", Name: "temperature", FromUnit: "F", ToUnit: "C", Round: true, Precision: 1},
+				{Field: "battery", Scale: 0.001},
+			},
+			Valid: true,
+		},
+		{
+			Name:     "field not set",
+			Mappings: FieldMappings{{Name: "temperature"}},
+		},
+		{
+			Name:     "name contains dot",
+			Mappings: FieldMappings{{Field: "temp", Name: "a.b"}},
+		},
+		{
+			Name:     "unknown unit",
+			Mappings: FieldMappings{{Field: "temp", FromUnit: "C", ToUnit: "Ra"}},
+		},
+		{
+			Name:     "only to unit",
+			Mappings: FieldMappings{{Field: "temp", ToUnit: "C"}},
+		},
+		{
+			Name:     "incompatible units",
+			Mappings: FieldMappings{{Field: "temp", FromUnit: "C", ToUnit: "hPa"}},
+		},
+		{
+			Name:     "invalid precision",
+			Mappings: FieldMappings{{Field: "temp", Round: true, Precision: 16}},
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+			err := tst.Mappings.Validate()
+			if tst.Valid {
+				assert.NoError(err)
+			} else {
+				assert.Error(err)
+			}
+		})
+	}
+}
+
+func TestFieldMappingsApply(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Mappings FieldMappings
+		Object   interface{}
+		Expected interface{}
+		Error    bool
+	}{
+		{
+			Name:     "no mappings",
+			Object:   map[string]interface{}{"temp": 20},
+			Expected: map[string]interface{}{"temp": 20},
+		},
+		{
+			Name: "scale, offset and round",
+			Mappings: FieldMappings{
+				{Field: "battery", Scale: 0.001, Offset: 0.1, Round: true, Precision: 2},
+			},
+			Object:   map[string]interface{}{"battery": 3299},
+			Expected: map[string]interface{}{"battery": 3.4},
+		},
+		{
+			Name: "unit conversion and rename",
+			Mappings: FieldMappings{
+				{Field: "sensor.tempF", Name: "temperature", FromUnit: "F", ToUnit: "C", Round: true, Precision: 1},
+				{Field: "sensor.pressure", FromUnit: "Pa", ToUnit: "hPa"},
+			},
+			Object: map[string]interface{}{
+				"sensor": map[string]interface{}{"tempF": 70, "pressure": 101325},
+			},
+			Expected: map[string]interface{}{
+				"sensor": map[string]interface{}{"temperature": 21.1, "pressure": 1013.25},
+			},
+		},
+		{
+			Name: "kelvin to celsius",
+			Mappings: FieldMappings{
+				{Field: "temp", FromUnit: "K", ToUnit: "C", Round: true, Precision: 2},
+			},
+			Object:   map[string]interface{}{"temp": 293.15},
+			Expected: map[string]interface{}{"temp": 20.0},
+		},
+		{
+			Name: "array element",
+			Mappings: FieldMappings{
+				{Field: "values.1", Scale: 10},
+			},
+			Object:   map[string]interface{}{"values": []int{1, 2, 3}},
+			Expected: map[string]interface{}{"values": []interface{}{1.0, 20.0, 3.0}},
+		},
+		{
+			Name: "cayenne lpp",
+			Mappings: FieldMappings{
+				{Field: "temperatureSensor.3", Name: "temperature"},
+			},
+			Object: &CayenneLPP{
+				TemperatureSensor: map[byte]float64{3: 21.5},
+			},
+			Expected: map[string]interface{}{
+				"temperatureSensor": map[string]interface{}{"temperature": 21.5},
+			},
+		},
+		{
+			Name: "missing field is ignored",
+			Mappings: FieldMappings{
+				{Field: "humidity", Scale: 0.5},
+				{Field: "sensor.humidity", Scale: 0.5},
+			},
+			Object:   map[string]interface{}{"temp": 20},
+			Expected: map[string]interface{}{"temp": 20.0},
+		},
+		{
+			Name: "not a number",
+			Mappings: FieldMappings{
+				{Field: "status", Scale: 2},
+			},
+			Object: map[string]interface{}{"status": "ok"},
+			Error:  true,
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			out, err := tst.Mappings.Apply(tst.Object)
+			if tst.Error {
+				assert.Error(err)
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(tst.Expected, out)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"context"
 	"database/sql"
 	"time"
 
@@ -12,16 +13,29 @@ import (
 // duration.
 type DBLogger struct {
 	*sqlx.DB
+
+	// FaultInjector is an optional hook which is called before each query
+	// (used for fault-injection). When it returns an error, the query is
+	// not executed and the error is returned instead.
+	FaultInjector func() error
 }
 
 // Beginx returns a transaction with logging.
 func (db *DBLogger) Beginx() (*TxLogger, error) {
+	if err := injectFault(db.FaultInjector); err != nil {
+		return nil, err
+	}
+
 	tx, err := db.DB.Beginx()
-	return &TxLogger{tx}, err
+	return &TxLogger{Tx: tx, FaultInjector: db.FaultInjector}, err
 }
 
 // Query logs the queries executed by the Query method.
 func (db *DBLogger) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	if err := injectFault(db.FaultInjector); err != nil {
+		return nil, err
+	}
+
 	start := time.Now()
 	rows, err := db.DB.Query(query, args...)
 	logQuery(query, time.Since(start), args...)
@@ -30,6 +44,10 @@ func (db *DBLogger) Query(query string, args ...interface{}) (*sql.Rows, error)
 
 // Queryx logs the queries executed by the Queryx method.
 func (db *DBLogger) Queryx(query string, args ...interface{}) (*sqlx.Rows, error) {
+	if err := injectFault(db.FaultInjector); err != nil {
+		return nil, err
+	}
+
 	start := time.Now()
 	rows, err := db.DB.Queryx(query, args...)
 	logQuery(query, time.Since(start), args...)
@@ -38,6 +56,10 @@ func (db *DBLogger) Queryx(query string, args ...interface{}) (*sqlx.Rows, error
 
 // QueryRowx logs the queries executed by the QueryRowx method.
 func (db *DBLogger) QueryRowx(query string, args ...interface{}) *sqlx.Row {
+	if err := injectFault(db.FaultInjector); err != nil {
+		return failedRow(db.DB, query, args...)
+	}
+
 	start := time.Now()
 	row := db.DB.QueryRowx(query, args...)
 	logQuery(query, time.Since(start), args...)
@@ -46,6 +68,10 @@ func (db *DBLogger) QueryRowx(query string, args ...interface{}) *sqlx.Row {
 
 // Exec logs the queries executed by the Exec method.
 func (db *DBLogger) Exec(query string, args ...interface{}) (sql.Result, error) {
+	if err := injectFault(db.FaultInjector); err != nil {
+		return nil, err
+	}
+
 	start := time.Now()
 	res, err := db.DB.Exec(query, args...)
 	logQuery(query, time.Since(start), args...)
@@ -55,10 +81,18 @@ func (db *DBLogger) Exec(query string, args ...interface{}) (sql.Result, error)
 // TxLogger logs the executed sql queries and their duration.
 type TxLogger struct {
 	*sqlx.Tx
+
+	// FaultInjector is an optional hook which is called before each query
+	// (see DBLogger).
+	FaultInjector func() error
 }
 
 // Query logs the queries executed by the Query method.
 func (q *TxLogger) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	if err := injectFault(q.FaultInjector); err != nil {
+		return nil, err
+	}
+
 	start := time.Now()
 	rows, err := q.Tx.Query(query, args...)
 	logQuery(query, time.Since(start), args...)
@@ -67,6 +101,10 @@ func (q *TxLogger) Query(query string, args ...interface{}) (*sql.Rows, error) {
 
 // Queryx logs the queries executed by the Queryx method.
 func (q *TxLogger) Queryx(query string, args ...interface{}) (*sqlx.Rows, error) {
+	if err := injectFault(q.FaultInjector); err != nil {
+		return nil, err
+	}
+
 	start := time.Now()
 	rows, err := q.Tx.Queryx(query, args...)
 	logQuery(query, time.Since(start), args...)
@@ -75,6 +113,10 @@ func (q *TxLogger) Queryx(query string, args ...interface{}) (*sqlx.Rows, error)
 
 // QueryRowx logs the queries executed by the QueryRowx method.
 func (q *TxLogger) QueryRowx(query string, args ...interface{}) *sqlx.Row {
+	if err := injectFault(q.FaultInjector); err != nil {
+		return failedRow(q.Tx, query, args...)
+	}
+
 	start := time.Now()
 	row := q.Tx.QueryRowx(query, args...)
 	logQuery(query, time.Since(start), args...)
@@ -83,12 +125,43 @@ func (q *TxLogger) QueryRowx(query string, args ...interface{}) *sqlx.Row {
 
 // Exec logs the queries executed by the Exec method.
 func (q *TxLogger) Exec(query string, args ...interface{}) (sql.Result, error) {
+	if err := injectFault(q.FaultInjector); err != nil {
+		return nil, err
+	}
+
 	start := time.Now()
 	res, err := q.Tx.Exec(query, args...)
 	logQuery(query, time.Since(start), args...)
 	return res, err
 }
 
+func injectFault(f func() error) error {
+	if f == nil {
+		return nil
+	}
+
+	if err := f(); err != nil {
+		log.WithError(err).Warning("injecting storage failure")
+		return err
+	}
+
+	return nil
+}
+
+// rowQueryer is implemented by both sqlx.DB and sqlx.Tx.
+type rowQueryer interface {
+	QueryRowxContext(ctx context.Context, query string, args ...interface{}) *sqlx.Row
+}
+
+// failedRow returns a row which returns an error on Scan. As the error of
+// sqlx.Row can't be set directly, the query is executed using a cancelled
+// context, which makes the row return the context error.
+func failedRow(q rowQueryer, query string, args ...interface{}) *sqlx.Row {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return q.QueryRowxContext(ctx, query, args...)
+}
+
 func logQuery(query string, duration time.Duration, args ...interface{}) {
 	log.WithFields(log.Fields{
 		"query":    query,
@@ -5,23 +5,33 @@ import (
 
 	"github.com/gomodule/redigo/redis"
 
+	"github.com/brocaar/lora-app-server/internal/api/accesslog"
+	"github.com/brocaar/lora-app-server/internal/api/compression"
+	"github.com/brocaar/lora-app-server/internal/api/cors"
+	"github.com/brocaar/lora-app-server/internal/backpressure"
 	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/faultinject"
 	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/handler/amqphandler"
+	"github.com/brocaar/lora-app-server/internal/handler/kafkahandler"
 	"github.com/brocaar/lora-app-server/internal/handler/mqtthandler"
+	"github.com/brocaar/lora-app-server/internal/handler/pluginhandler"
 	"github.com/brocaar/lora-app-server/internal/nsclient"
 )
 
 // Config defines the configuration structure.
 type Config struct {
 	General struct {
-		LogLevel               int `mapstructure:"log_level"`
-		PasswordHashIterations int `mapstructure:"password_hash_iterations"`
+		LogLevel               int    `mapstructure:"log_level"`
+		PasswordHashIterations int    `mapstructure:"password_hash_iterations"`
+		AddressFamily          string `mapstructure:"address_family"`
 	}
 
 	PostgreSQL struct {
-		DSN         string `mapstructure:"dsn"`
-		Automigrate bool
-		DB          *common.DBLogger `mapstructure:"db"`
+		DSN                 string `mapstructure:"dsn"`
+		Automigrate         bool
+		AutomigrateContract bool             `mapstructure:"automigrate_contract"`
+		DB                  *common.DBLogger `mapstructure:"db"`
 	} `mapstructure:"postgresql"`
 
 	Redis struct {
@@ -36,11 +46,46 @@ type Config struct {
 
 		Integration struct {
 			Handler handler.Handler
-			MQTT    mqtthandler.Config `mapstructure:"mqtt"`
+			MQTT    mqtthandler.Config     `mapstructure:"mqtt"`
+			Kafka   kafkahandler.Config    `mapstructure:"kafka"`
+			AMQP    amqphandler.Config     `mapstructure:"amqp"`
+			Plugins []pluginhandler.Config `mapstructure:"plugins"`
+
+			Delivery struct {
+				Workers          int           `mapstructure:"workers"`
+				QueueSize        int           `mapstructure:"queue_size"`
+				AtLeastOnce      bool          `mapstructure:"at_least_once"`
+				RetryInterval    time.Duration `mapstructure:"retry_interval"`
+				MaxRetryInterval time.Duration `mapstructure:"max_retry_interval"`
+				MaxAttempts      int           `mapstructure:"max_attempts"`
+				MaxSpoolSize     int           `mapstructure:"max_spool_size"`
+
+				BackPressure backpressure.Config `mapstructure:"back_pressure"`
+
+				DeadLetter struct {
+					MaxEntries int           `mapstructure:"max_entries"`
+					TTL        time.Duration `mapstructure:"ttl"`
+				} `mapstructure:"dead_letter"`
+			} `mapstructure:"delivery"`
+
+			Proxy struct {
+				URL string `mapstructure:"url"`
+			} `mapstructure:"proxy"`
+
+			DeliveryLog struct {
+				MaxEntries int           `mapstructure:"max_entries"`
+				TTL        time.Duration `mapstructure:"ttl"`
+			} `mapstructure:"delivery_log"`
+
+			AdminEvents struct {
+				HTTPURL     string            `mapstructure:"http_url"`
+				HTTPHeaders map[string]string `mapstructure:"http_headers"`
+			} `mapstructure:"admin_events"`
 		}
 
 		API struct {
 			Bind       string
+			SocketMode string `mapstructure:"socket_mode"`
 			CACert     string `mapstructure:"ca_cert"`
 			TLSCert    string `mapstructure:"tls_cert"`
 			TLSKey     string `mapstructure:"tls_key"`
@@ -49,10 +94,22 @@ type Config struct {
 
 		ExternalAPI struct {
 			Bind                       string
-			TLSCert                    string `mapstructure:"tls_cert"`
-			TLSKey                     string `mapstructure:"tls_key"`
-			JWTSecret                  string `mapstructure:"jwt_secret"`
-			DisableAssignExistingUsers bool   `mapstructure:"disable_assign_existing_users"`
+			SocketMode                 string        `mapstructure:"socket_mode"`
+			TLSCert                    string        `mapstructure:"tls_cert"`
+			TLSKey                     string        `mapstructure:"tls_key"`
+			JWTSecret                  string        `mapstructure:"jwt_secret"`
+			DisableAssignExistingUsers bool          `mapstructure:"disable_assign_existing_users"`
+			SessionKeyPolicy           string        `mapstructure:"session_key_policy"`
+			ServiceAccountTokenTTL     time.Duration `mapstructure:"service_account_token_ttl"`
+
+			CORS        cors.Config        `mapstructure:"cors"`
+			Compression compression.Config `mapstructure:"compression"`
+			AccessLog   accesslog.Config   `mapstructure:"access_log"`
+
+			EventStream struct {
+				ResumeBufferSize int           `mapstructure:"resume_buffer_size"`
+				ResumeBufferTTL  time.Duration `mapstructure:"resume_buffer_ttl"`
+			} `mapstructure:"event_stream"`
 		} `mapstructure:"external_api"`
 
 		Branding struct {
@@ -60,6 +117,108 @@ type Config struct {
 			Footer       string
 			Registration string
 		}
+
+		Registration struct {
+			Mode            string        `mapstructure:"mode"`
+			AllowedDomains  []string      `mapstructure:"allowed_domains"`
+			RequireApproval bool          `mapstructure:"require_approval"`
+			InviteTTL       time.Duration `mapstructure:"invite_ttl"`
+
+			Organization struct {
+				CanHaveGateways bool `mapstructure:"can_have_gateways"`
+				MaxDeviceCount  int  `mapstructure:"max_device_count"`
+				MaxGatewayCount int  `mapstructure:"max_gateway_count"`
+			} `mapstructure:"organization"`
+		} `mapstructure:"registration"`
+
+		SCIM struct {
+			BearerToken string `mapstructure:"bearer_token"`
+		} `mapstructure:"scim"`
+
+		ServiceProfileLimits struct {
+			WarningThreshold float64 `mapstructure:"warning_threshold"`
+		} `mapstructure:"service_profile_limits"`
+
+		KeyMismatchDetection struct {
+			UplinkCount int `mapstructure:"uplink_count"`
+		} `mapstructure:"key_mismatch_detection"`
+
+		DecryptQuarantine struct {
+			ErrorCount int           `mapstructure:"error_count"`
+			MaxFrames  int           `mapstructure:"max_frames"`
+			TTL        time.Duration `mapstructure:"ttl"`
+		} `mapstructure:"decrypt_quarantine"`
+
+		FrameCapture struct {
+			MaxDuration time.Duration `mapstructure:"max_duration"`
+			MaxFrames   int           `mapstructure:"max_frames"`
+			Retention   time.Duration `mapstructure:"retention"`
+		} `mapstructure:"frame_capture"`
+
+		GatewayStats struct {
+			Server     string `mapstructure:"server"`
+			Username   string `mapstructure:"username"`
+			Password   string `mapstructure:"password"`
+			ClientID   string `mapstructure:"client_id"`
+			CACert     string `mapstructure:"ca_cert"`
+			TLSCert    string `mapstructure:"tls_cert"`
+			TLSKey     string `mapstructure:"tls_key"`
+			StatsTopic string `mapstructure:"stats_topic"`
+			Marshaler  string `mapstructure:"marshaler"`
+		} `mapstructure:"gateway_stats"`
+
+		GatewaySignalStats struct {
+			GeohashPrecision int `mapstructure:"geohash_precision"`
+		} `mapstructure:"gateway_signal_stats"`
+
+		FCntAnomalyDetection struct {
+			Enabled             bool          `mapstructure:"enabled"`
+			MaxGap              uint32        `mapstructure:"max_gap"`
+			SuppressionInterval time.Duration `mapstructure:"suppression_interval"`
+		} `mapstructure:"f_cnt_anomaly_detection"`
+
+		SecurityEvents struct {
+			Syslog struct {
+				Enabled bool   `mapstructure:"enabled"`
+				Network string `mapstructure:"network"`
+				Address string `mapstructure:"address"`
+				Tag     string `mapstructure:"tag"`
+				Format  string `mapstructure:"format"`
+			} `mapstructure:"syslog"`
+		} `mapstructure:"security_events"`
+
+		CertificateExpiry struct {
+			CheckInterval    time.Duration `mapstructure:"check_interval"`
+			WarningThreshold time.Duration `mapstructure:"warning_threshold"`
+		} `mapstructure:"certificate_expiry"`
+
+		Retention struct {
+			PruneInterval          time.Duration `mapstructure:"prune_interval"`
+			SecurityEventDays      int           `mapstructure:"security_event_days"`
+			DeviceLocationDays     int           `mapstructure:"device_location_days"`
+			GatewayPingDays        int           `mapstructure:"gateway_ping_days"`
+			DeviceUplinkStatsDays  int           `mapstructure:"device_uplink_stats_days"`
+			DeviceAvailabilityDays int           `mapstructure:"device_availability_days"`
+			GatewaySignalStatsDays int           `mapstructure:"gateway_signal_stats_days"`
+		} `mapstructure:"retention"`
+
+		Geolocation struct {
+			Backend        string        `mapstructure:"backend"`
+			URI            string        `mapstructure:"uri"`
+			Token          string        `mapstructure:"token"`
+			RequestTimeout time.Duration `mapstructure:"request_timeout"`
+			FrameBufferTTL time.Duration `mapstructure:"frame_buffer_ttl"`
+			WifiFPorts     []int         `mapstructure:"wifi_fports"`
+			GNSSFPorts     []int         `mapstructure:"gnss_fports"`
+			GNSSURI        string        `mapstructure:"gnss_uri"`
+
+			RSSIFallback struct {
+				Enabled          bool    `mapstructure:"enabled"`
+				PathLossExponent float64 `mapstructure:"path_loss_exponent"`
+				ReferenceRSSI    float64 `mapstructure:"reference_rssi"`
+				MinGateways      int     `mapstructure:"min_gateways"`
+			} `mapstructure:"rssi_fallback"`
+		} `mapstructure:"geolocation"`
 	} `mapstructure:"application_server"`
 
 	JoinServer struct {
@@ -68,8 +227,12 @@ type Config struct {
 		TLSCert string `mapstructure:"tls_cert"`
 		TLSKey  string `mapstructure:"tls_key"`
 
+		HomeNetID string `mapstructure:"home_net_id"`
+
 		KEK struct {
-			ASKEKLabel string `mapstructure:"as_kek_label"`
+			ASKEKLabel           string `mapstructure:"as_kek_label"`
+			StorageKEKLabel      string `mapstructure:"storage_kek_label"`
+			OrganizationDataKeys bool   `mapstructure:"organization_data_keys"`
 
 			Set []struct {
 				Label string `mapstructure:"label"`
@@ -79,8 +242,19 @@ type Config struct {
 	} `mapstructure:"join_server"`
 
 	NetworkServer struct {
-		Pool nsclient.Pool
+		Pool   nsclient.Pool
+		Client nsclient.Config `mapstructure:"client"`
+
+		Mock struct {
+			Enabled bool   `mapstructure:"enabled"`
+			Region  string `mapstructure:"region"`
+		} `mapstructure:"mock"`
 	} `mapstructure:"network_server"`
+
+	FaultInjection struct {
+		Integration faultinject.Config `mapstructure:"integration"`
+		Storage     faultinject.Config `mapstructure:"storage"`
+	} `mapstructure:"fault_injection"`
 }
 
 // C holds the global configuration.
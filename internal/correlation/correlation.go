@@ -0,0 +1,133 @@
+// Package correlation implements the request-scoped correlation IDs. Each
+// API request is assigned a correlation ID, either propagated from the
+// request metadata (or HTTP header) or generated, which is included in the
+// log lines, the integration events and the outgoing network-server
+// requests, so that a request can be traced across systems.
+package correlation
+
+import (
+	"net/textproto"
+	"regexp"
+
+	"github.com/gofrs/uuid"
+	"github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey defines the gRPC metadata key of the correlation ID.
+const MetadataKey = "x-correlation-id"
+
+// HTTPHeader defines the HTTP header of the correlation ID.
+const HTTPHeader = "X-Correlation-ID"
+
+// LogField defines the log field of the correlation ID.
+const LogField = "correlation_id"
+
+// idValidator validates correlation IDs received from clients, so that
+// arbitrary data does not end up in the logs and integration headers.
+var idValidator = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+type contextKey struct{}
+
+// NewID returns a new correlation ID.
+func NewID() string {
+	return uuid.Must(uuid.NewV4()).String()
+}
+
+// NewContext returns a new context with the given correlation ID.
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the correlation ID from the given context. It returns
+// an empty string when the context does not contain a correlation ID.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// Log returns a log entry containing the correlation ID of the given
+// context.
+func Log(ctx context.Context) *log.Entry {
+	if id := FromContext(ctx); id != "" {
+		return log.WithField(LogField, id)
+	}
+	return log.NewEntry(log.StandardLogger())
+}
+
+// UnaryServerInterceptor returns an interceptor setting the correlation ID
+// of each request. The correlation ID is added to the request tags (and
+// therefore to the request log) and returned in the response header.
+// This interceptor must be chained after the tags interceptor.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx = newRequestContext(ctx)
+		grpc.SetHeader(ctx, metadata.Pairs(MetadataKey, FromContext(ctx)))
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns the streaming variant of
+// UnaryServerInterceptor.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = newRequestContext(stream.Context())
+		stream.SetHeader(metadata.Pairs(MetadataKey, FromContext(wrapped.WrappedContext)))
+		return handler(srv, wrapped)
+	}
+}
+
+// UnaryClientInterceptor returns an interceptor propagating the correlation
+// ID of the context to the server.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if id := FromContext(ctx); id != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, MetadataKey, id)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// IncomingHeaderMatcher maps the correlation ID HTTP header to the gRPC
+// metadata, for use with the JSON gateway. Other headers are handled by
+// the default header matcher.
+func IncomingHeaderMatcher(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == textproto.CanonicalMIMEHeaderKey(HTTPHeader) {
+		return MetadataKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// OutgoingHeaderMatcher maps the correlation ID gRPC header to the HTTP
+// response header, for use with the JSON gateway. Other headers are
+// prefixed as the gateway does by default.
+func OutgoingHeaderMatcher(key string) (string, bool) {
+	if key == MetadataKey {
+		return HTTPHeader, true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// newRequestContext returns a new context containing the correlation ID of
+// the incoming request, or a generated correlation ID when it is missing or
+// invalid.
+func newRequestContext(ctx context.Context) context.Context {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(MetadataKey); len(ids) != 0 && idValidator.MatchString(ids[0]) {
+			id = ids[0]
+		}
+	}
+	if id == "" {
+		id = NewID()
+	}
+
+	grpc_ctxtags.Extract(ctx).Set(LogField, id)
+	return NewContext(ctx, id)
+}
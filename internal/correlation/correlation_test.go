@@ -0,0 +1,65 @@
+package correlation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestContext(t *testing.T) {
+	assert := require.New(t)
+
+	assert.Equal("", FromContext(context.Background()))
+	assert.Equal("abc", FromContext(NewContext(context.Background(), "abc")))
+}
+
+func TestNewRequestContext(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Metadata   metadata.MD
+		ExpectedID string
+	}{
+		{"no metadata", nil, ""},
+		{"valid id", metadata.Pairs(MetadataKey, "req-1234"), "req-1234"},
+		{"invalid id", metadata.Pairs(MetadataKey, "req 1234\n"), ""},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			ctx := context.Background()
+			if tst.Metadata != nil {
+				ctx = metadata.NewIncomingContext(ctx, tst.Metadata)
+			}
+
+			id := FromContext(newRequestContext(ctx))
+			if tst.ExpectedID != "" {
+				assert.Equal(tst.ExpectedID, id)
+			} else {
+				assert.Len(id, 36)
+			}
+		})
+	}
+}
+
+func TestHeaderMatchers(t *testing.T) {
+	assert := require.New(t)
+
+	key, ok := IncomingHeaderMatcher("x-correlation-id")
+	assert.True(ok)
+	assert.Equal(MetadataKey, key)
+
+	key, ok = IncomingHeaderMatcher("Grpc-Metadata-Foo")
+	assert.True(ok)
+	assert.Equal("Foo", key)
+
+	_, ok = IncomingHeaderMatcher("X-Foo")
+	assert.False(ok)
+
+	key, ok = OutgoingHeaderMatcher(MetadataKey)
+	assert.True(ok)
+	assert.Equal(HTTPHeader, key)
+}
@@ -0,0 +1,275 @@
+// Package dbmigrate implements the database migrations, using the
+// expand / contract pattern to support rolling upgrades.
+//
+// Expand migrations (the default) only make backwards compatible schema
+// changes (e.g. adding a table or a nullable column), so that they can be
+// applied while the previous version of LoRa App Server is still running.
+// Contract migrations (marked with the contractMarker) make backwards
+// incompatible changes (e.g. dropping or renaming a column) and must only be
+// applied once all instances have been upgraded. Expand migrations must not
+// depend on the contract migrations of the same release, as these might be
+// applied later.
+package dbmigrate
+
+import (
+	"bytes"
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	migrate "github.com/rubenv/sql-migrate"
+
+	"github.com/brocaar/lora-app-server/internal/migrations"
+)
+
+// Migration phases.
+const (
+	Expand   = "expand"
+	Contract = "contract"
+)
+
+// contractMarker marks a migration as contract migration. It must be
+// included in the migration file, e.g. above the +migrate Up annotation.
+const contractMarker = "-- +lora-app-server contract"
+
+// ErrUnknownMigrations is returned when the database contains migrations
+// which are unknown to this version, e.g. when it has been migrated by a
+// newer version during a rolling upgrade.
+var ErrUnknownMigrations = errors.New("database contains migrations unknown to this version")
+
+// Migration defines a database migration.
+type Migration struct {
+	ID        string
+	Phase     string
+	AppliedAt *time.Time
+}
+
+// Applied returns true when the migration has been applied.
+func (m Migration) Applied() bool {
+	return m.AppliedAt != nil
+}
+
+// Report contains the state of the database migrations.
+type Report struct {
+	// Migrations contains all migrations known by this version.
+	Migrations []Migration
+
+	// Unknown contains the applied migrations which are not known by this
+	// version. This means that the database has been migrated by a newer
+	// version.
+	Unknown []string
+}
+
+// Pending returns the migrations which have not yet been applied.
+func (r Report) Pending() []Migration {
+	var out []Migration
+	for _, m := range r.Migrations {
+		if !m.Applied() {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// Incompatible returns the pending migrations which are incompatible with
+// the previous version (the contract migrations).
+func (r Report) Incompatible() []Migration {
+	var out []Migration
+	for _, m := range r.Pending() {
+		if m.Phase == Contract {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// source returns the migration source containing all migrations.
+func source() *migrate.AssetMigrationSource {
+	return &migrate.AssetMigrationSource{
+		Asset:    migrations.Asset,
+		AssetDir: migrations.AssetDir,
+		Dir:      "",
+	}
+}
+
+// phase returns the phase of the given migration.
+func phase(id string) (string, error) {
+	b, err := migrations.Asset(id)
+	if err != nil {
+		return "", errors.Wrap(err, "read migration error")
+	}
+
+	if bytes.Contains(b, []byte(contractMarker)) {
+		return Contract, nil
+	}
+	return Expand, nil
+}
+
+// GetReport returns the state of the database migrations.
+func GetReport(db *sql.DB) (Report, error) {
+	var r Report
+
+	migs, err := source().FindMigrations()
+	if err != nil {
+		return r, errors.Wrap(err, "find migrations error")
+	}
+
+	records, err := migrate.GetMigrationRecords(db, "postgres")
+	if err != nil {
+		return r, errors.Wrap(err, "get migration records error")
+	}
+
+	applied := make(map[string]time.Time)
+	for _, rec := range records {
+		applied[rec.Id] = rec.AppliedAt
+	}
+
+	known := make(map[string]bool)
+	for _, mig := range migs {
+		known[mig.Id] = true
+
+		m := Migration{
+			ID: mig.Id,
+		}
+		m.Phase, err = phase(mig.Id)
+		if err != nil {
+			return r, err
+		}
+		if t, ok := applied[mig.Id]; ok {
+			m.AppliedAt = &t
+		}
+
+		r.Migrations = append(r.Migrations, m)
+	}
+
+	for _, rec := range records {
+		if !known[rec.Id] {
+			r.Unknown = append(r.Unknown, rec.Id)
+		}
+	}
+	sort.Strings(r.Unknown)
+
+	return r, nil
+}
+
+// PlannedMigration defines a migration which is planned to be applied or
+// rolled back.
+type PlannedMigration struct {
+	Migration
+
+	// Queries contains the queries which will be executed.
+	Queries []string
+}
+
+// PlanUp returns the migrations which will be applied by Up.
+func PlanUp(db *sql.DB, contract bool, max int) ([]PlannedMigration, error) {
+	return plan(db, migrate.Up, contract, max)
+}
+
+// PlanDown returns the migrations which will be rolled back by Down.
+func PlanDown(db *sql.DB, max int) ([]PlannedMigration, error) {
+	return plan(db, migrate.Down, false, max)
+}
+
+// Up applies the pending migrations and returns the number of applied
+// migrations. When contract is false, the pending contract migrations are
+// skipped, so that the previous version can keep running. When max is
+// greater than 0, at most max migrations are applied.
+func Up(db *sql.DB, contract bool, max int) (int, error) {
+	ms, err := migrationSource(db, migrate.Up, contract)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := migrate.ExecMax(db, "postgres", ms, migrate.Up, max)
+	if err != nil {
+		return n, errors.Wrap(err, "applying migrations error")
+	}
+
+	return n, nil
+}
+
+// Down rolls back the last applied migrations and returns the number of
+// rolled back migrations. When max is greater than 0, at most max migrations
+// are rolled back.
+func Down(db *sql.DB, max int) (int, error) {
+	ms, err := migrationSource(db, migrate.Down, false)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := migrate.ExecMax(db, "postgres", ms, migrate.Down, max)
+	if err != nil {
+		return n, errors.Wrap(err, "rolling back migrations error")
+	}
+
+	return n, nil
+}
+
+func plan(db *sql.DB, dir migrate.MigrationDirection, contract bool, max int) ([]PlannedMigration, error) {
+	ms, err := migrationSource(db, dir, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	planned, _, err := migrate.PlanMigration(db, "postgres", ms, dir, max)
+	if err != nil {
+		return nil, errors.Wrap(err, "plan migrations error")
+	}
+
+	var out []PlannedMigration
+	for _, pm := range planned {
+		m := PlannedMigration{
+			Migration: Migration{
+				ID: pm.Id,
+			},
+			Queries: pm.Queries,
+		}
+		m.Phase, err = phase(pm.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, m)
+	}
+
+	return out, nil
+}
+
+// migrationSource returns the source of the migrations to plan in the given
+// direction. Migrations which are not applied are excluded when rolling back,
+// as sql-migrate would otherwise apply these first. Pending contract
+// migrations are excluded, unless contract is true.
+func migrationSource(db *sql.DB, dir migrate.MigrationDirection, contract bool) (migrate.MemoryMigrationSource, error) {
+	var ms migrate.MemoryMigrationSource
+
+	r, err := GetReport(db)
+	if err != nil {
+		return ms, err
+	}
+
+	if len(r.Unknown) != 0 {
+		return ms, ErrUnknownMigrations
+	}
+
+	include := make(map[string]bool)
+	for _, m := range r.Migrations {
+		if m.Applied() || (dir == migrate.Up && (contract || m.Phase == Expand)) {
+			include[m.ID] = true
+		}
+	}
+
+	migs, err := source().FindMigrations()
+	if err != nil {
+		return ms, errors.Wrap(err, "find migrations error")
+	}
+
+	for _, mig := range migs {
+		if include[mig.Id] {
+			ms.Migrations = append(ms.Migrations, mig)
+		}
+	}
+
+	return ms, nil
+}
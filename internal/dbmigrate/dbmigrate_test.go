@@ -0,0 +1,102 @@
+package dbmigrate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func TestReport(t *testing.T) {
+	assert := require.New(t)
+
+	now := time.Now()
+	r := Report{
+		Migrations: []Migration{
+			{ID: "0001_initial.sql", Phase: Expand, AppliedAt: &now},
+			{ID: "0002_drop_column.sql", Phase: Contract, AppliedAt: &now},
+			{ID: "0003_add_table.sql", Phase: Expand},
+			{ID: "0004_drop_table.sql", Phase: Contract},
+		},
+	}
+
+	assert.Equal([]Migration{r.Migrations[2], r.Migrations[3]}, r.Pending())
+	assert.Equal([]Migration{r.Migrations[3]}, r.Incompatible())
+}
+
+func TestMigrations(t *testing.T) {
+	assert := require.New(t)
+
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	assert.NoError(err)
+	test.MustResetDB(db)
+
+	t.Run("All migrations have been applied", func(t *testing.T) {
+		assert := require.New(t)
+
+		r, err := GetReport(db.DB.DB)
+		assert.NoError(err)
+		assert.NotEmpty(r.Migrations)
+		assert.Len(r.Pending(), 0)
+		assert.Len(r.Unknown, 0)
+
+		n, err := Up(db.DB.DB, false, 0)
+		assert.NoError(err)
+		assert.Equal(0, n)
+	})
+
+	t.Run("Roll back and re-apply the last migration", func(t *testing.T) {
+		assert := require.New(t)
+
+		r, err := GetReport(db.DB.DB)
+		assert.NoError(err)
+		last := r.Migrations[len(r.Migrations)-1]
+
+		planned, err := PlanDown(db.DB.DB, 1)
+		assert.NoError(err)
+		assert.Len(planned, 1)
+		assert.Equal(last.ID, planned[0].ID)
+		assert.NotEmpty(planned[0].Queries)
+
+		n, err := Down(db.DB.DB, 1)
+		assert.NoError(err)
+		assert.Equal(1, n)
+
+		r, err = GetReport(db.DB.DB)
+		assert.NoError(err)
+		assert.Len(r.Pending(), 1)
+		assert.Equal(last.ID, r.Pending()[0].ID)
+
+		planned, err = PlanUp(db.DB.DB, false, 0)
+		assert.NoError(err)
+		assert.Len(planned, 1)
+		assert.Equal(last.ID, planned[0].ID)
+
+		n, err = Up(db.DB.DB, false, 1)
+		assert.NoError(err)
+		assert.Equal(1, n)
+
+		r, err = GetReport(db.DB.DB)
+		assert.NoError(err)
+		assert.Len(r.Pending(), 0)
+	})
+
+	t.Run("Database migrated by a newer version", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := db.Exec(`insert into gorp_migrations (id, applied_at) values ('9999_newer_version.sql', now())`)
+		assert.NoError(err)
+		defer db.Exec(`delete from gorp_migrations where id = '9999_newer_version.sql'`)
+
+		r, err := GetReport(db.DB.DB)
+		assert.NoError(err)
+		assert.Equal([]string{"9999_newer_version.sql"}, r.Unknown)
+
+		_, err = Up(db.DB.DB, true, 0)
+		assert.Equal(ErrUnknownMigrations, err)
+	})
+}
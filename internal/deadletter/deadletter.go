@@ -0,0 +1,89 @@
+// Package deadletter implements the per-application dead-letter list of the
+// integration events which could not be delivered within the configured
+// max. number of delivery attempts. These events are removed from the
+// delivery spool (so that the following events can be delivered) and are
+// kept in this list, so that users can inspect them using the API.
+package deadletter
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+)
+
+// entriesKeyTempl defines the key template of the dead-letter list of an
+// application.
+const entriesKeyTempl = "lora:as:application:%d:dead_letters"
+
+// Entry defines an event which could not be delivered.
+type Entry struct {
+	Time        time.Time       `json:"time"`
+	Integration string          `json:"integration"`
+	EventType   string          `json:"eventType"`
+	Payload     json.RawMessage `json:"payload"`
+	Attempts    int             `json:"attempts"`
+	Error       string          `json:"error"`
+}
+
+// Add adds the given entry to the dead-letter list of the given
+// application. Only the last maxEntries entries are kept and the list
+// expires after the given TTL (counted from the last entry).
+func Add(p *redis.Pool, applicationID int64, e Entry, maxEntries int, ttl time.Duration) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	c := p.Get()
+	defer c.Close()
+
+	key := fmt.Sprintf(entriesKeyTempl, applicationID)
+
+	c.Send("MULTI")
+	c.Send("LPUSH", key, b)
+	c.Send("LTRIM", key, 0, maxEntries-1)
+	c.Send("PEXPIRE", key, int64(ttl/time.Millisecond))
+	if _, err := c.Do("EXEC"); err != nil {
+		return errors.Wrap(err, "add dead-letter error")
+	}
+
+	return nil
+}
+
+// Get returns the last (max. limit) entries of the dead-letter list of the
+// given application, newest first.
+func Get(p *redis.Pool, applicationID int64, limit int) ([]Entry, error) {
+	c := p.Get()
+	defer c.Close()
+
+	values, err := redis.ByteSlices(c.Do("LRANGE", fmt.Sprintf(entriesKeyTempl, applicationID), 0, limit-1))
+	if err != nil {
+		return nil, errors.Wrap(err, "get dead-letters error")
+	}
+
+	out := make([]Entry, 0, len(values))
+	for _, b := range values {
+		var e Entry
+		if err := json.Unmarshal(b, &e); err != nil {
+			return nil, errors.Wrap(err, "unmarshal json error")
+		}
+		out = append(out, e)
+	}
+
+	return out, nil
+}
+
+// Clear removes the dead-letter list of the given application.
+func Clear(p *redis.Pool, applicationID int64) error {
+	c := p.Get()
+	defer c.Close()
+
+	if _, err := c.Do("DEL", fmt.Sprintf(entriesKeyTempl, applicationID)); err != nil {
+		return errors.Wrap(err, "delete dead-letters error")
+	}
+
+	return nil
+}
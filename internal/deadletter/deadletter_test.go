@@ -0,0 +1,43 @@
+package deadletter
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func TestDeadLetter(t *testing.T) {
+	assert := require.New(t)
+
+	conf := test.GetConfig()
+	p := storage.NewRedisPool(conf.RedisURL, 10, 0)
+	test.MustFlushRedis(p)
+
+	entries := []Entry{
+		{Time: time.Now().UTC().Truncate(time.Millisecond), Integration: "HTTP", EventType: "up", Payload: json.RawMessage(`{"fCnt":1}`), Attempts: 5, Error: "http request error"},
+		{Time: time.Now().UTC().Truncate(time.Millisecond), Integration: "HTTP", EventType: "up", Payload: json.RawMessage(`{"fCnt":2}`), Attempts: 5, Error: "expected 2XX response, got: 500"},
+		{Time: time.Now().UTC().Truncate(time.Millisecond), Integration: "HTTP", EventType: "join", Payload: json.RawMessage(`{"devAddr":"01020304"}`), Attempts: 5, Error: "expected 2XX response, got: 500"},
+	}
+
+	for _, e := range entries {
+		assert.NoError(Add(p, 1, e, 2, time.Minute))
+	}
+
+	out, err := Get(p, 1, 10)
+	assert.NoError(err)
+	assert.Equal([]Entry{entries[2], entries[1]}, out)
+
+	out, err = Get(p, 2, 10)
+	assert.NoError(err)
+	assert.Len(out, 0)
+
+	assert.NoError(Clear(p, 1))
+	out, err = Get(p, 1, 10)
+	assert.NoError(err)
+	assert.Len(out, 0)
+}
@@ -0,0 +1,81 @@
+// Package deliverylog implements a rolling log of the integration delivery
+// attempts per application, so that users can diagnose failing
+// integrations (e.g. a HTTP endpoint which does not receive any data)
+// without access to the server logs.
+package deliverylog
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+)
+
+// deliveryLogKeyTempl defines the key template of the delivery log of an
+// application.
+const deliveryLogKeyTempl = "lora:as:application:%d:delivery_log"
+
+// Entry defines a delivery attempt.
+type Entry struct {
+	Time         time.Time     `json:"time"`
+	Integration  string        `json:"integration"`
+	EventType    string        `json:"eventType"`
+	DevEUI       lorawan.EUI64 `json:"devEUI"`
+	URL          string        `json:"url"`
+	StatusCode   int           `json:"statusCode"`
+	Latency      time.Duration `json:"latency"`
+	ResponseBody string        `json:"responseBody"`
+	Error        string        `json:"error"`
+}
+
+// Log adds the given entry to the delivery log of the given application.
+// Only the last maxEntries entries are kept and the log expires after the
+// given TTL (counted from the last entry).
+func Log(p *redis.Pool, applicationID int64, e Entry, maxEntries int, ttl time.Duration) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	c := p.Get()
+	defer c.Close()
+
+	key := fmt.Sprintf(deliveryLogKeyTempl, applicationID)
+
+	c.Send("MULTI")
+	c.Send("LPUSH", key, b)
+	c.Send("LTRIM", key, 0, maxEntries-1)
+	c.Send("PEXPIRE", key, int64(ttl/time.Millisecond))
+	if _, err := c.Do("EXEC"); err != nil {
+		return errors.Wrap(err, "log delivery error")
+	}
+
+	return nil
+}
+
+// Get returns the last (max. limit) entries of the delivery log of the
+// given application, newest first.
+func Get(p *redis.Pool, applicationID int64, limit int) ([]Entry, error) {
+	c := p.Get()
+	defer c.Close()
+
+	values, err := redis.ByteSlices(c.Do("LRANGE", fmt.Sprintf(deliveryLogKeyTempl, applicationID), 0, limit-1))
+	if err != nil {
+		return nil, errors.Wrap(err, "get delivery log error")
+	}
+
+	out := make([]Entry, 0, len(values))
+	for _, b := range values {
+		var e Entry
+		if err := json.Unmarshal(b, &e); err != nil {
+			return nil, errors.Wrap(err, "unmarshal json error")
+		}
+		out = append(out, e)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,50 @@
+package deliverylog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestDeliveryLog(t *testing.T) {
+	assert := require.New(t)
+
+	conf := test.GetConfig()
+	p := storage.NewRedisPool(conf.RedisURL, 10, 0)
+	test.MustFlushRedis(p)
+
+	entries := []Entry{
+		{Time: time.Now().UTC().Truncate(time.Millisecond), Integration: "HTTP", EventType: "up", DevEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, URL: "http://localhost/up", StatusCode: 200, Latency: time.Millisecond},
+		{Time: time.Now().UTC().Truncate(time.Millisecond), Integration: "HTTP", EventType: "join", URL: "http://localhost/join", StatusCode: 500, ResponseBody: "boom", Error: "expected 2XX response, got: 500"},
+		{Time: time.Now().UTC().Truncate(time.Millisecond), Integration: "HTTP", EventType: "ack", URL: "http://localhost/ack", Error: "connection refused"},
+	}
+
+	for _, e := range entries {
+		assert.NoError(Log(p, 1, e, 2, time.Minute))
+	}
+
+	t.Run("Get returns the last entries, newest first", func(t *testing.T) {
+		assert := require.New(t)
+
+		out, err := Get(p, 1, 10)
+		assert.NoError(err)
+		assert.Equal([]Entry{entries[2], entries[1]}, out)
+
+		out, err = Get(p, 1, 1)
+		assert.NoError(err)
+		assert.Equal([]Entry{entries[2]}, out)
+	})
+
+	t.Run("Get returns no entries for an other application", func(t *testing.T) {
+		assert := require.New(t)
+
+		out, err := Get(p, 2, 10)
+		assert.NoError(err)
+		assert.Len(out, 0)
+	})
+}
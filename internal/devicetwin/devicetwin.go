@@ -0,0 +1,131 @@
+// Package devicetwin implements the device twin, a (JSON) document per
+// device containing the latest reported value of each decoded field and the
+// desired state of the device. Changes to the desired state which differ
+// from the reported state (the delta) are sent to the device as downlink.
+package devicetwin
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// timestampKey defines the key of the metadata object containing the
+// timestamp of the last update of a field.
+const timestampKey = "timestamp"
+
+// ToDocument returns the given object (e.g. a decoded object) as document.
+// It returns false when the object is not a JSON object.
+func ToDocument(object interface{}) (storage.DeviceTwinDocument, bool, error) {
+	if object == nil {
+		return nil, false, nil
+	}
+
+	b, err := json.Marshal(object)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "marshal json error")
+	}
+
+	var doc storage.DeviceTwinDocument
+	if err := json.Unmarshal(b, &doc); err != nil {
+		// e.g. an array or a single value
+		return nil, false, nil
+	}
+
+	return doc, doc != nil, nil
+}
+
+// Merge merges the given update into the document and sets the timestamp
+// of the updated fields in the metadata document. Objects are merged
+// recursively, other values are replaced. A null value removes the field.
+func Merge(doc, metadata, update storage.DeviceTwinDocument, t time.Time) {
+	ts := t.UTC().Format(time.RFC3339Nano)
+
+	for k, v := range update {
+		if v == nil {
+			delete(doc, k)
+			delete(metadata, k)
+			continue
+		}
+
+		if obj, ok := v.(map[string]interface{}); ok {
+			subDoc, ok := doc[k].(map[string]interface{})
+			if !ok {
+				subDoc = make(map[string]interface{})
+			}
+			subMeta, ok := metadata[k].(map[string]interface{})
+			if !ok || !isObject(doc[k]) {
+				subMeta = make(map[string]interface{})
+			}
+
+			Merge(subDoc, subMeta, obj, t)
+			doc[k] = subDoc
+			metadata[k] = subMeta
+			continue
+		}
+
+		doc[k] = v
+		metadata[k] = map[string]interface{}{timestampKey: ts}
+	}
+}
+
+// Delta returns the fields of the desired document of which the value
+// differs from the reported document (recursively).
+func Delta(desired, reported storage.DeviceTwinDocument) storage.DeviceTwinDocument {
+	out := make(storage.DeviceTwinDocument)
+
+	for k, dv := range desired {
+		rv, ok := reported[k]
+
+		dObj, dIsObj := dv.(map[string]interface{})
+		rObj, rIsObj := rv.(map[string]interface{})
+		if dIsObj && rIsObj {
+			if sub := Delta(dObj, rObj); len(sub) != 0 {
+				out[k] = map[string]interface{}(sub)
+			}
+			continue
+		}
+
+		if !ok || !reflect.DeepEqual(dv, rv) {
+			out[k] = dv
+		}
+	}
+
+	return out
+}
+
+// UpdateReported merges the given (decoded) object into the reported state
+// of the device twin. Objects which are not JSON objects are ignored.
+func UpdateReported(db *common.DBLogger, devEUI lorawan.EUI64, object interface{}, t time.Time) error {
+	doc, ok, err := ToDocument(object)
+	if err != nil || !ok {
+		return err
+	}
+
+	return storage.Transaction(db, func(tx sqlx.Ext) error {
+		twin, err := storage.GetDeviceTwin(tx, devEUI, true)
+		if err != nil {
+			return errors.Wrap(err, "get device twin error")
+		}
+
+		Merge(twin.Reported, twin.ReportedMetadata, doc, t)
+
+		if err := storage.SetDeviceTwin(tx, &twin); err != nil {
+			return errors.Wrap(err, "set device twin error")
+		}
+
+		return nil
+	})
+}
+
+func isObject(v interface{}) bool {
+	_, ok := v.(map[string]interface{})
+	return ok
+}
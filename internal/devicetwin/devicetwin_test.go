@@ -0,0 +1,114 @@
+package devicetwin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+func TestToDocument(t *testing.T) {
+	assert := require.New(t)
+
+	doc, ok, err := ToDocument(struct {
+		Temperature float64 `json:"temperature"`
+	}{21.5})
+	assert.NoError(err)
+	assert.True(ok)
+	assert.Equal(storage.DeviceTwinDocument{"temperature": 21.5}, doc)
+
+	for _, obj := range []interface{}{nil, []int{1, 2}, 3} {
+		_, ok, err = ToDocument(obj)
+		assert.NoError(err)
+		assert.False(ok)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	assert := require.New(t)
+
+	t1 := time.Date(2018, 8, 1, 10, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Minute)
+	ts1 := t1.Format(time.RFC3339Nano)
+	ts2 := t2.Format(time.RFC3339Nano)
+
+	doc := storage.DeviceTwinDocument{}
+	meta := storage.DeviceTwinDocument{}
+
+	Merge(doc, meta, storage.DeviceTwinDocument{
+		"temperature": 21.5,
+		"battery":     3.3,
+		"led":         map[string]interface{}{"red": true, "green": false},
+	}, t1)
+
+	Merge(doc, meta, storage.DeviceTwinDocument{
+		"temperature": 22.0,
+		"battery":     nil,
+		"led":         map[string]interface{}{"green": true},
+	}, t2)
+
+	assert.Equal(storage.DeviceTwinDocument{
+		"temperature": 22.0,
+		"led":         map[string]interface{}{"red": true, "green": true},
+	}, doc)
+
+	assert.Equal(storage.DeviceTwinDocument{
+		"temperature": map[string]interface{}{"timestamp": ts2},
+		"led": map[string]interface{}{
+			"red":   map[string]interface{}{"timestamp": ts1},
+			"green": map[string]interface{}{"timestamp": ts2},
+		},
+	}, meta)
+
+	// replace a value by an object
+	Merge(doc, meta, storage.DeviceTwinDocument{
+		"temperature": map[string]interface{}{"value": 22.5},
+	}, t2)
+	assert.Equal(map[string]interface{}{"value": 22.5}, doc["temperature"])
+	assert.Equal(map[string]interface{}{
+		"value": map[string]interface{}{"timestamp": ts2},
+	}, meta["temperature"])
+}
+
+func TestDelta(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Desired  storage.DeviceTwinDocument
+		Reported storage.DeviceTwinDocument
+		Expected storage.DeviceTwinDocument
+	}{
+		{
+			Name:     "in sync",
+			Desired:  storage.DeviceTwinDocument{"interval": 60.0},
+			Reported: storage.DeviceTwinDocument{"interval": 60.0, "temperature": 21.5},
+			Expected: storage.DeviceTwinDocument{},
+		},
+		{
+			Name:     "changed and missing",
+			Desired:  storage.DeviceTwinDocument{"interval": 30.0, "mode": "eco"},
+			Reported: storage.DeviceTwinDocument{"interval": 60.0},
+			Expected: storage.DeviceTwinDocument{"interval": 30.0, "mode": "eco"},
+		},
+		{
+			Name: "nested",
+			Desired: storage.DeviceTwinDocument{
+				"led": map[string]interface{}{"red": true, "green": true},
+			},
+			Reported: storage.DeviceTwinDocument{
+				"led": map[string]interface{}{"red": true, "green": false},
+			},
+			Expected: storage.DeviceTwinDocument{
+				"led": map[string]interface{}{"green": true},
+			},
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+			assert.Equal(tst.Expected, Delta(tst.Desired, tst.Reported))
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/downlinktrace"
 	"github.com/brocaar/lora-app-server/internal/eventlog"
 	"github.com/brocaar/lora-app-server/internal/handler"
 	"github.com/brocaar/lora-app-server/internal/storage"
@@ -50,39 +51,55 @@ func handleDataDownPayload(pl handler.DataDownPayload) error {
 			return errors.New("enqueue downlink payload: device does not exist for given application")
 		}
 
-		// if Object is set, try to encode it to bytes using the application codec
-		if pl.Object != nil {
-			app, err := storage.GetApplication(tx, d.ApplicationID)
-			if err != nil {
-				return errors.Wrap(err, "get application error")
-			}
+		// errors from this point are related to the device and are logged
+		// to its downlink trace
+		if err := enqueueDataDownPayload(tx, d, pl); err != nil {
+			logTraceEvent(d.DevEUI, downlinktrace.Event{
+				Type:      downlinktrace.EnqueueError,
+				FPort:     pl.FPort,
+				Confirmed: pl.Confirmed,
+				Error:     err.Error(),
+			})
+			return err
+		}
 
-			// get the codec payload configured for the application
-			codecPL := codec.NewPayload(app.PayloadCodec, pl.FPort, app.PayloadEncoderScript, app.PayloadDecoderScript)
-			if codecPL == nil {
-				logCodecError(app, d, errors.New("no or invalid codec configured for application"))
-				return errors.New("no or invalid codec configured for application")
-			}
+		return nil
+	})
+}
 
-			err = json.Unmarshal(pl.Object, &codecPL)
-			if err != nil {
-				logCodecError(app, d, err)
-				return errors.Wrap(err, "unmarshal to codec payload error")
-			}
+func enqueueDataDownPayload(tx sqlx.Ext, d storage.Device, pl handler.DataDownPayload) error {
+	// if Object is set, try to encode it to bytes using the application codec
+	if pl.Object != nil {
+		app, err := storage.GetApplication(tx, d.ApplicationID, false)
+		if err != nil {
+			return errors.Wrap(err, "get application error")
+		}
 
-			pl.Data, err = codecPL.EncodeToBytes()
-			if err != nil {
-				logCodecError(app, d, err)
-				return errors.Wrap(err, "marshal codec payload to binary error")
-			}
+		// get the codec payload configured for the application
+		codecPL := codec.NewPayload(app.PayloadCodec, pl.FPort, app.PayloadEncoderScript, app.PayloadDecoderScript)
+		if codecPL == nil {
+			logCodecError(app, d, errors.New("no or invalid codec configured for application"))
+			return errors.New("no or invalid codec configured for application")
 		}
 
-		if _, err := EnqueueDownlinkPayload(tx, pl.DevEUI, pl.Confirmed, pl.FPort, pl.Data); err != nil {
-			return errors.Wrap(err, "enqueue downlink device-queue item error")
+		err = json.Unmarshal(pl.Object, &codecPL)
+		if err != nil {
+			logCodecError(app, d, err)
+			return errors.Wrap(err, "unmarshal to codec payload error")
 		}
 
-		return nil
-	})
+		pl.Data, err = codecPL.EncodeToBytes()
+		if err != nil {
+			logCodecError(app, d, err)
+			return errors.Wrap(err, "marshal codec payload to binary error")
+		}
+	}
+
+	if _, err := EnqueueDownlinkPayload(tx, pl.DevEUI, pl.Confirmed, pl.FPort, pl.Data); err != nil {
+		return errors.Wrap(err, "enqueue downlink device-queue item error")
+	}
+
+	return nil
 }
 
 // EnqueueDownlinkPayload adds the downlink payload to the network-server
@@ -138,9 +155,24 @@ func EnqueueDownlinkPayload(db sqlx.Ext, devEUI lorawan.EUI64, confirmed bool, f
 		"confirmed": confirmed,
 	}).Info("downlink device-queue item handled")
 
+	logTraceEvent(devEUI, downlinktrace.Event{
+		Type:      downlinktrace.Enqueued,
+		FCnt:      resp.FCnt,
+		FPort:     fPort,
+		Confirmed: confirmed,
+	})
+
 	return resp.FCnt, nil
 }
 
+// logTraceEvent logs the given event to the downlink trace of the device.
+// As the trace is only used for diagnostics, errors are only logged.
+func logTraceEvent(devEUI lorawan.EUI64, e downlinktrace.Event) {
+	if err := downlinktrace.Log(config.C.Redis.Pool, devEUI, e); err != nil {
+		log.WithError(err).WithField("dev_eui", devEUI).Error("log downlink trace event error")
+	}
+}
+
 func logCodecError(a storage.Application, d storage.Device, err error) {
 	errNotification := handler.ErrorNotification{
 		ApplicationID:   a.ID,
@@ -150,6 +182,9 @@ func logCodecError(a storage.Application, d storage.Device, err error) {
 		Type:            "CODEC",
 		Error:           err.Error(),
 	}
+	if scriptErr, ok := err.(*codec.ScriptError); ok {
+		errNotification.CodecError = scriptErr
+	}
 
 	if err := eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
 		Type:    eventlog.Error,
@@ -158,6 +193,10 @@ func logCodecError(a storage.Application, d storage.Device, err error) {
 		log.WithError(err).Error("log event for device error")
 	}
 
+	if a.IsArchived() {
+		return
+	}
+
 	if err := config.C.ApplicationServer.Integration.Handler.SendErrorNotification(errNotification); err != nil {
 		log.WithError(err).Error("send error notification to handler error")
 	}
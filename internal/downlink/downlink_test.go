@@ -25,6 +25,7 @@ func TestHandleDownlinkQueueItem(t *testing.T) {
 		t.Fatal(err)
 	}
 	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL, 10, 0)
 
 	Convey("Given a clean database an organization, application + node", t, func() {
 		test.MustResetDB(config.C.PostgreSQL.DB)
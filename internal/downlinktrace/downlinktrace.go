@@ -0,0 +1,240 @@
+// Package downlinktrace implements a rolling trace of the downlink events
+// per device (enqueued by LoRa App Server, rejected by the network-server,
+// acknowledged by the device). Combined with the network-server
+// device-queue, this trace is used to diagnose where a downlink is stuck.
+package downlinktrace
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+)
+
+// traceKeyTempl defines the key template of the downlink trace of a device.
+const traceKeyTempl = "lora:as:device:%s:downlink_trace"
+
+// maxEvents defines the max. number of events kept per device.
+const maxEvents = 100
+
+// traceTTL defines the expiration of the trace (counted from the last
+// event).
+const traceTTL = 7 * 24 * time.Hour
+
+// EventType defines the downlink event type.
+type EventType string
+
+// Downlink event types.
+const (
+	Enqueued     EventType = "ENQUEUED"
+	EnqueueError EventType = "ENQUEUE_ERROR"
+	NSError      EventType = "NS_ERROR"
+	ACK          EventType = "ACK"
+	NACK         EventType = "NACK"
+	Flushed      EventType = "FLUSHED"
+)
+
+// Stage defines the stage of a downlink.
+type Stage string
+
+// Downlink stages.
+const (
+	StageASError         Stage = "AS_ERROR"
+	StageNSQueue         Stage = "NS_QUEUE"
+	StageNSError         Stage = "NS_ERROR"
+	StageSent            Stage = "SENT"
+	StageAcknowledged    Stage = "ACKNOWLEDGED"
+	StageNotAcknowledged Stage = "NOT_ACKNOWLEDGED"
+	StageFlushed         Stage = "FLUSHED"
+)
+
+// Event defines a downlink event.
+type Event struct {
+	Time      time.Time `json:"time"`
+	Type      EventType `json:"type"`
+	FCnt      uint32    `json:"fCnt"`
+	FPort     uint8     `json:"fPort"`
+	Confirmed bool      `json:"confirmed"`
+	Error     string    `json:"error"`
+}
+
+// Downlink contains the diagnosis of a single downlink.
+type Downlink struct {
+	FCnt      uint32
+	FPort     uint8
+	Confirmed bool
+	Stage     Stage
+	Reason    string
+	Events    []Event // oldest first
+}
+
+// DeviceState contains the state of the device, used to diagnose the
+// downlinks.
+type DeviceState struct {
+	// QueueFCnts contains the frame-counters of the items in the
+	// network-server device-queue.
+	QueueFCnts []uint32
+
+	// LastSeenAt contains the last time an uplink was received from the
+	// device.
+	LastSeenAt *time.Time
+
+	// ClassA is set when the device only supports class-A, meaning that
+	// downlinks can only be sent after an uplink.
+	ClassA bool
+}
+
+// Log adds the given event to the downlink trace of the given device.
+func Log(p *redis.Pool, devEUI lorawan.EUI64, e Event) error {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+
+	b, err := json.Marshal(e)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	c := p.Get()
+	defer c.Close()
+
+	key := fmt.Sprintf(traceKeyTempl, devEUI)
+
+	c.Send("MULTI")
+	c.Send("LPUSH", key, b)
+	c.Send("LTRIM", key, 0, maxEvents-1)
+	c.Send("PEXPIRE", key, int64(traceTTL/time.Millisecond))
+	if _, err := c.Do("EXEC"); err != nil {
+		return errors.Wrap(err, "log downlink event error")
+	}
+
+	return nil
+}
+
+// Get returns the downlink trace of the given device, newest first.
+func Get(p *redis.Pool, devEUI lorawan.EUI64) ([]Event, error) {
+	c := p.Get()
+	defer c.Close()
+
+	values, err := redis.ByteSlices(c.Do("LRANGE", fmt.Sprintf(traceKeyTempl, devEUI), 0, -1))
+	if err != nil {
+		return nil, errors.Wrap(err, "get downlink trace error")
+	}
+
+	out := make([]Event, 0, len(values))
+	for _, b := range values {
+		var e Event
+		if err := json.Unmarshal(b, &e); err != nil {
+			return nil, errors.Wrap(err, "unmarshal json error")
+		}
+		out = append(out, e)
+	}
+
+	return out, nil
+}
+
+// Diagnose returns the diagnosis of each traced downlink, given the trace
+// (newest first) and the device state. The downlinks are returned newest
+// first. Note that the transmission by the gateway is not reported to LoRa
+// App Server, a downlink removed from the network-server device-queue is
+// considered sent.
+func Diagnose(events []Event, state DeviceState) []Downlink {
+	var out []Downlink
+	var flushes []time.Time
+	byFCnt := make(map[uint32]int)
+
+	// iterate oldest first, so that the events of each downlink are ordered
+	for i := len(events) - 1; i >= 0; i-- {
+		e := events[i]
+
+		switch e.Type {
+		case Enqueued:
+			// a re-used frame-counter (e.g. after a re-activation) starts
+			// a new downlink
+			byFCnt[e.FCnt] = len(out)
+			out = append(out, Downlink{
+				FCnt:      e.FCnt,
+				FPort:     e.FPort,
+				Confirmed: e.Confirmed,
+				Events:    []Event{e},
+			})
+		case Flushed:
+			flushes = append(flushes, e.Time)
+		case EnqueueError:
+			// the downlink never reached the network-server, therefore it
+			// does not have a frame-counter
+			out = append(out, Downlink{
+				FPort:     e.FPort,
+				Confirmed: e.Confirmed,
+				Stage:     StageASError,
+				Reason:    e.Error,
+				Events:    []Event{e},
+			})
+		default:
+			if j, ok := byFCnt[e.FCnt]; ok {
+				out[j].Events = append(out[j].Events, e)
+			}
+		}
+	}
+
+	queuePos := make(map[uint32]int)
+	for i, fCnt := range state.QueueFCnts {
+		queuePos[fCnt] = i
+	}
+
+	for i := range out {
+		if out[i].Stage == StageASError {
+			continue
+		}
+		out[i].Stage, out[i].Reason = diagnose(out[i], state, queuePos, flushes)
+	}
+
+	// newest first
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Events[0].Time.After(out[j].Events[0].Time)
+	})
+
+	return out
+}
+
+func diagnose(d Downlink, state DeviceState, queuePos map[uint32]int, flushes []time.Time) (Stage, string) {
+	enqueuedAt := d.Events[0].Time
+
+	for _, e := range d.Events {
+		switch e.Type {
+		case NSError:
+			return StageNSError, e.Error
+		case ACK:
+			return StageAcknowledged, "the downlink has been acknowledged by the device"
+		case NACK:
+			return StageNotAcknowledged, "the device did not acknowledge the downlink"
+		}
+	}
+
+	if pos, ok := queuePos[d.FCnt]; ok {
+		if state.ClassA && (state.LastSeenAt == nil || state.LastSeenAt.Before(enqueuedAt)) {
+			return StageNSQueue, "waiting for an uplink, the (class-A) device has not been seen since the downlink was enqueued"
+		}
+		if pos != 0 {
+			return StageNSQueue, fmt.Sprintf("queued behind %d other downlink(s)", pos)
+		}
+		return StageNSQueue, "waiting for the next downlink opportunity"
+	}
+
+	// the flush event does not contain the flushed frame-counters
+	for _, t := range flushes {
+		if t.After(enqueuedAt) {
+			return StageFlushed, "the device-queue has been flushed after the downlink was enqueued (the downlink might have been sent before)"
+		}
+	}
+
+	if d.Confirmed {
+		return StageSent, "sent by the network-server, waiting for the acknowledgement of the device"
+	}
+	return StageSent, "sent by the network-server"
+}
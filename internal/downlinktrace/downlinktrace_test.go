@@ -0,0 +1,168 @@
+package downlinktrace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestDownlinkTrace(t *testing.T) {
+	assert := require.New(t)
+
+	conf := test.GetConfig()
+	p := storage.NewRedisPool(conf.RedisURL, 10, 0)
+	test.MustFlushRedis(p)
+
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+	events := []Event{
+		{Time: time.Now().UTC().Truncate(time.Millisecond), Type: Enqueued, FCnt: 10, FPort: 1, Confirmed: true},
+		{Time: time.Now().UTC().Truncate(time.Millisecond), Type: ACK, FCnt: 10},
+	}
+	for _, e := range events {
+		assert.NoError(Log(p, devEUI, e))
+	}
+
+	out, err := Get(p, devEUI)
+	assert.NoError(err)
+	assert.Equal([]Event{events[1], events[0]}, out)
+
+	out, err = Get(p, lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1})
+	assert.NoError(err)
+	assert.Len(out, 0)
+}
+
+func TestDiagnose(t *testing.T) {
+	now := time.Now()
+	before := now.Add(-time.Hour)
+	after := now.Add(time.Minute)
+
+	tests := []struct {
+		Name   string
+		Events []Event // oldest first
+		State  DeviceState
+		Stage  Stage
+		Reason string
+	}{
+		{
+			Name:   "enqueue error",
+			Events: []Event{{Time: now, Type: EnqueueError, FPort: 1, Error: "codec error"}},
+			Stage:  StageASError,
+			Reason: "codec error",
+		},
+		{
+			Name:   "waiting for class-a uplink",
+			Events: []Event{{Time: now, Type: Enqueued, FCnt: 10, FPort: 1}},
+			State:  DeviceState{QueueFCnts: []uint32{10}, LastSeenAt: &before, ClassA: true},
+			Stage:  StageNSQueue,
+			Reason: "waiting for an uplink, the (class-A) device has not been seen since the downlink was enqueued",
+		},
+		{
+			Name:   "queued behind other items",
+			Events: []Event{{Time: now, Type: Enqueued, FCnt: 11, FPort: 1}},
+			State:  DeviceState{QueueFCnts: []uint32{9, 10, 11}, LastSeenAt: &after, ClassA: true},
+			Stage:  StageNSQueue,
+			Reason: "queued behind 2 other downlink(s)",
+		},
+		{
+			Name:   "first in queue of class-c device",
+			Events: []Event{{Time: now, Type: Enqueued, FCnt: 10, FPort: 1}},
+			State:  DeviceState{QueueFCnts: []uint32{10}},
+			Stage:  StageNSQueue,
+			Reason: "waiting for the next downlink opportunity",
+		},
+		{
+			Name: "ns error",
+			Events: []Event{
+				{Time: now, Type: Enqueued, FCnt: 10, FPort: 1},
+				{Time: after, Type: NSError, FCnt: 10, Error: "payload exceeds max size"},
+			},
+			Stage:  StageNSError,
+			Reason: "payload exceeds max size",
+		},
+		{
+			Name:   "sent unconfirmed",
+			Events: []Event{{Time: now, Type: Enqueued, FCnt: 10, FPort: 1}},
+			Stage:  StageSent,
+			Reason: "sent by the network-server",
+		},
+		{
+			Name:   "sent confirmed",
+			Events: []Event{{Time: now, Type: Enqueued, FCnt: 10, FPort: 1, Confirmed: true}},
+			Stage:  StageSent,
+			Reason: "sent by the network-server, waiting for the acknowledgement of the device",
+		},
+		{
+			Name: "acknowledged",
+			Events: []Event{
+				{Time: now, Type: Enqueued, FCnt: 10, FPort: 1, Confirmed: true},
+				{Time: after, Type: ACK, FCnt: 10},
+			},
+			Stage:  StageAcknowledged,
+			Reason: "the downlink has been acknowledged by the device",
+		},
+		{
+			Name: "not acknowledged",
+			Events: []Event{
+				{Time: now, Type: Enqueued, FCnt: 10, FPort: 1, Confirmed: true},
+				{Time: after, Type: NACK, FCnt: 10},
+			},
+			Stage:  StageNotAcknowledged,
+			Reason: "the device did not acknowledge the downlink",
+		},
+		{
+			Name: "flushed",
+			Events: []Event{
+				{Time: now, Type: Enqueued, FCnt: 10, FPort: 1},
+				{Time: after, Type: Flushed},
+			},
+			Stage:  StageFlushed,
+			Reason: "the device-queue has been flushed after the downlink was enqueued (the downlink might have been sent before)",
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			// the trace is stored newest first
+			var events []Event
+			for i := len(tst.Events) - 1; i >= 0; i-- {
+				events = append(events, tst.Events[i])
+			}
+
+			out := Diagnose(events, tst.State)
+			assert.Len(out, 1)
+			assert.Equal(tst.Stage, out[0].Stage)
+			assert.Equal(tst.Reason, out[0].Reason)
+			assert.Len(out[0].Events, len(tst.Events)-countFlushes(tst.Events))
+		})
+	}
+
+	t.Run("Newest first", func(t *testing.T) {
+		assert := require.New(t)
+
+		out := Diagnose([]Event{
+			{Time: after, Type: Enqueued, FCnt: 11, FPort: 1},
+			{Time: now, Type: Enqueued, FCnt: 10, FPort: 1},
+		}, DeviceState{})
+		assert.Len(out, 2)
+		assert.EqualValues(11, out[0].FCnt)
+		assert.EqualValues(10, out[1].FCnt)
+	})
+}
+
+func countFlushes(events []Event) int {
+	var count int
+	for _, e := range events {
+		if e.Type == Flushed {
+			count++
+		}
+	}
+	return count
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gomodule/redigo/redis"
@@ -16,6 +17,8 @@ import (
 
 const (
 	deviceEventUplinkPubSubKeyTempl = "lora:as:device:%s:pubsub:event"
+	deviceEventSeqKeyTempl          = "lora:as:device:%s:event:seq"
+	deviceEventBufferKeyTempl       = "lora:as:device:%s:event:buffer"
 )
 
 // Event types.
@@ -30,31 +33,98 @@ const (
 
 // EventLog contains an event log.
 type EventLog struct {
+	// Seq contains the sequence number of the event. It is set by
+	// LogEventForDevice and is incremented by one for every event of the
+	// device, so that a gap indicates missed events.
+	Seq     uint64
 	Type    string
 	Payload interface{}
 }
 
-// LogEventForDevice logs an event for the given device.
+// DeviceEventLog contains an event log of a device.
+type DeviceEventLog struct {
+	EventLog
+
+	DevEUI lorawan.EUI64
+}
+
+// LogEventForDevice logs an event for the given device. Besides publishing
+// the event, it is added to the resume buffer of the device, so that
+// subscribers are able to resume after a disconnect.
 func LogEventForDevice(devEUI lorawan.EUI64, el EventLog) error {
 	c := config.C.Redis.Pool.Get()
 	defer c.Close()
 
+	// the sequence key does not expire, as a reset of the sequence would
+	// invalidate the resume tokens handed out to the subscribers
+	seq, err := redis.Uint64(c.Do("INCR", fmt.Sprintf(deviceEventSeqKeyTempl, devEUI)))
+	if err != nil {
+		return errors.Wrap(err, "increment event sequence number error")
+	}
+	el.Seq = seq
+
 	key := fmt.Sprintf(deviceEventUplinkPubSubKeyTempl, devEUI)
 	b, err := json.Marshal(el)
 	if err != nil {
 		return errors.Wrap(err, "gob encode error")
 	}
 
-	if _, err := c.Do("PUBLISH", key, b); err != nil {
+	bufferKey := fmt.Sprintf(deviceEventBufferKeyTempl, devEUI)
+	bufferSize := config.C.ApplicationServer.ExternalAPI.EventStream.ResumeBufferSize
+	bufferTTL := config.C.ApplicationServer.ExternalAPI.EventStream.ResumeBufferTTL
+
+	c.Send("MULTI")
+	if bufferSize > 0 && bufferTTL > 0 {
+		c.Send("ZADD", bufferKey, seq, b)
+		c.Send("ZREMRANGEBYRANK", bufferKey, 0, -bufferSize-1)
+		c.Send("PEXPIRE", bufferKey, int64(bufferTTL/time.Millisecond))
+	}
+	c.Send("PUBLISH", key, b)
+	if _, err := c.Do("EXEC"); err != nil {
 		return errors.Wrap(err, "publish device event error")
 	}
 
 	return nil
 }
 
+// GetBufferedEventLogsForDevice returns the buffered events of the given
+// device with a sequence number greater than afterSeq, oldest first. When
+// afterSeq is greater than the last sequence number (e.g. the sequence has
+// been reset), all buffered events are returned.
+func GetBufferedEventLogsForDevice(devEUI lorawan.EUI64, afterSeq uint64) ([]EventLog, error) {
+	c := config.C.Redis.Pool.Get()
+	defer c.Close()
+
+	seq, err := redis.Uint64(c.Do("GET", fmt.Sprintf(deviceEventSeqKeyTempl, devEUI)))
+	if err != nil && err != redis.ErrNil {
+		return nil, errors.Wrap(err, "get event sequence number error")
+	}
+	if afterSeq > seq {
+		afterSeq = 0
+	}
+
+	values, err := redis.ByteSlices(c.Do("ZRANGEBYSCORE", fmt.Sprintf(deviceEventBufferKeyTempl, devEUI), fmt.Sprintf("(%d", afterSeq), "+inf"))
+	if err != nil {
+		return nil, errors.Wrap(err, "get buffered events error")
+	}
+
+	out := make([]EventLog, 0, len(values))
+	for _, b := range values {
+		var el EventLog
+		if err := json.Unmarshal(b, &el); err != nil {
+			return nil, errors.Wrap(err, "unmarshal json error")
+		}
+		out = append(out, el)
+	}
+
+	return out, nil
+}
+
 // GetEventLogForDevice subscribes to the device events for the given DevEUI
-// and sends this to the given channel.
-func GetEventLogForDevice(ctx context.Context, devEUI lorawan.EUI64, eventsChan chan EventLog) error {
+// and sends this to the given channel. When afterSeq is set, the buffered
+// events with a greater sequence number are sent first (see
+// GetBufferedEventLogsForDevice).
+func GetEventLogForDevice(ctx context.Context, devEUI lorawan.EUI64, afterSeq uint64, eventsChan chan EventLog) error {
 	c := config.C.Redis.Pool.Get()
 	defer c.Close()
 
@@ -67,15 +137,87 @@ func GetEventLogForDevice(ctx context.Context, devEUI lorawan.EUI64, eventsChan
 	done := make(chan error, 1)
 
 	go func() {
+		var lastSeq uint64
+
 		for {
 			switch v := psc.Receive().(type) {
 			case redis.Message:
 				el, err := redisMessageToEventLog(v)
 				if err != nil {
 					log.WithError(err).Error("decode message errror")
-				} else {
+				} else if el.Seq == 0 || el.Seq > lastSeq {
 					eventsChan <- el
 				}
+			case redis.Subscription:
+				// the buffered events are read after subscribing, so that
+				// no events are lost in between (duplicates are skipped
+				// using the sequence number)
+				if v.Kind == "subscribe" && afterSeq != 0 {
+					els, err := GetBufferedEventLogsForDevice(devEUI, afterSeq)
+					if err != nil {
+						done <- err
+						return
+					}
+					for _, el := range els {
+						eventsChan <- el
+						lastSeq = el.Seq
+					}
+				}
+
+				if v.Count == 0 {
+					done <- nil
+					return
+				}
+			case error:
+				done <- v
+				return
+			}
+		}
+	}()
+
+	return keepAlive(ctx, psc, done)
+}
+
+// GetEventLogs subscribes to the device events of the given DevEUIs and
+// sends these to the given channel. When no DevEUIs are given, it blocks
+// until the context is cancelled.
+func GetEventLogs(ctx context.Context, devEUIs []lorawan.EUI64, eventsChan chan DeviceEventLog) error {
+	if len(devEUIs) == 0 {
+		<-ctx.Done()
+		return nil
+	}
+
+	c := config.C.Redis.Pool.Get()
+	defer c.Close()
+
+	psc := redis.PubSubConn{Conn: c}
+
+	var keys []interface{}
+	for _, devEUI := range devEUIs {
+		keys = append(keys, fmt.Sprintf(deviceEventUplinkPubSubKeyTempl, devEUI))
+	}
+	if err := psc.Subscribe(keys...); err != nil {
+		return errors.Wrap(err, "subscribe error")
+	}
+
+	done := make(chan error, 1)
+
+	go func() {
+		for {
+			switch v := psc.Receive().(type) {
+			case redis.Message:
+				var el DeviceEventLog
+				var err error
+
+				el.EventLog, err = redisMessageToEventLog(v)
+				if err == nil {
+					el.DevEUI, err = devEUIFromPubSubKey(v.Channel)
+				}
+				if err != nil {
+					log.WithError(err).WithField("channel", v.Channel).Error("decode message error")
+					continue
+				}
+				eventsChan <- el
 			case redis.Subscription:
 				if v.Count == 0 {
 					done <- nil
@@ -88,6 +230,13 @@ func GetEventLogForDevice(ctx context.Context, devEUI lorawan.EUI64, eventsChan
 		}
 	}()
 
+	return keepAlive(ctx, psc, done)
+}
+
+// keepAlive pings the given subscription until the context is cancelled,
+// the ping fails or the receive loop returns (using the done channel).
+// Unless the receive loop returned, it unsubscribes and waits for the receive loop to return.
+func keepAlive(ctx context.Context, psc redis.PubSubConn, done chan error) error {
 	// todo: make this a config value?
 	ticker := time.NewTicker(30 * time.Second)
 	defer ticker.Stop()
@@ -114,6 +263,20 @@ loop:
 	return <-done
 }
 
+// devEUIFromPubSubKey returns the DevEUI of the given device event pub-sub
+// key.
+func devEUIFromPubSubKey(key string) (lorawan.EUI64, error) {
+	var devEUI lorawan.EUI64
+	parts := strings.SplitN(deviceEventUplinkPubSubKeyTempl, "%s", 2)
+	if !strings.HasPrefix(key, parts[0]) || !strings.HasSuffix(key, parts[1]) {
+		return devEUI, fmt.Errorf("invalid device event key: %s", key)
+	}
+	if err := devEUI.UnmarshalText([]byte(strings.TrimSuffix(strings.TrimPrefix(key, parts[0]), parts[1]))); err != nil {
+		return devEUI, errors.Wrap(err, "unmarshal deveui error")
+	}
+	return devEUI, nil
+}
+
 func redisMessageToEventLog(msg redis.Message) (EventLog, error) {
 	var el EventLog
 	if err := json.Unmarshal(msg.Data, &el); err != nil {
@@ -18,6 +18,8 @@ func TestEventLog(t *testing.T) {
 	conf := test.GetConfig()
 	p := storage.NewRedisPool(conf.RedisURL, 10, 0)
 	config.C.Redis.Pool = p
+	config.C.ApplicationServer.ExternalAPI.EventStream.ResumeBufferSize = 2
+	config.C.ApplicationServer.ExternalAPI.EventStream.ResumeBufferTTL = time.Minute
 
 	Convey("Given a clean Redis database", t, func() {
 		test.MustFlushRedis(p)
@@ -30,7 +32,7 @@ func TestEventLog(t *testing.T) {
 			defer cancel()
 
 			go func() {
-				if err := GetEventLogForDevice(cctx, devEUI, logChannel); err != nil {
+				if err := GetEventLogForDevice(cctx, devEUI, 0, logChannel); err != nil {
 					log.Fatal(err)
 				}
 			}()
@@ -50,6 +52,7 @@ func TestEventLog(t *testing.T) {
 
 				Convey("Then the event has been logged", func() {
 					So(<-logChannel, ShouldResemble, EventLog{
+						Seq:  1,
 						Type: Join,
 						Payload: map[string]interface{}{
 							"foo": "bar",
@@ -58,5 +61,100 @@ func TestEventLog(t *testing.T) {
 				})
 			})
 		})
+
+		Convey("Testing GetEventLogs", func() {
+			devEUI1 := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+			devEUI2 := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+
+			Convey("When subscribing for the given devices", func() {
+				logChannel := make(chan DeviceEventLog, 2)
+				cctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+
+				go func() {
+					if err := GetEventLogs(cctx, []lorawan.EUI64{devEUI1, devEUI2}, logChannel); err != nil {
+						log.Fatal(err)
+					}
+				}()
+
+				// some time to subscribe
+				time.Sleep(time.Millisecond * 100)
+
+				So(LogEventForDevice(devEUI1, EventLog{Type: Uplink}), ShouldBeNil)
+				So(LogEventForDevice(devEUI2, EventLog{Type: Join}), ShouldBeNil)
+
+				Convey("Then the events of both devices are received", func() {
+					So(<-logChannel, ShouldResemble, DeviceEventLog{DevEUI: devEUI1, EventLog: EventLog{Seq: 1, Type: Uplink}})
+					So(<-logChannel, ShouldResemble, DeviceEventLog{DevEUI: devEUI2, EventLog: EventLog{Seq: 1, Type: Join}})
+				})
+			})
+
+			Convey("When subscribing without devices", func() {
+				logChannel := make(chan DeviceEventLog, 2)
+				cctx, cancel := context.WithCancel(context.Background())
+				done := make(chan error, 1)
+
+				go func() {
+					done <- GetEventLogs(cctx, nil, logChannel)
+				}()
+
+				So(LogEventForDevice(devEUI1, EventLog{Type: Uplink}), ShouldBeNil)
+
+				Convey("Then no events are received and it returns when the context is cancelled", func() {
+					cancel()
+					So(<-done, ShouldBeNil)
+					So(logChannel, ShouldHaveLength, 0)
+				})
+			})
+		})
+
+		Convey("Given three logged events", func() {
+			devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+			for _, t := range []string{Uplink, ACK, Status} {
+				So(LogEventForDevice(devEUI, EventLog{Type: t}), ShouldBeNil)
+			}
+
+			Convey("Then GetBufferedEventLogsForDevice returns the buffered events after the given sequence number", func() {
+				els, err := GetBufferedEventLogsForDevice(devEUI, 2)
+				So(err, ShouldBeNil)
+				So(els, ShouldResemble, []EventLog{
+					{Seq: 3, Type: Status},
+				})
+			})
+
+			Convey("Then only the last ResumeBufferSize events are buffered", func() {
+				els, err := GetBufferedEventLogsForDevice(devEUI, 0)
+				So(err, ShouldBeNil)
+				So(els, ShouldHaveLength, 2)
+				So(els[0].Seq, ShouldEqual, 2)
+			})
+
+			Convey("Then all buffered events are returned when the sequence number is ahead", func() {
+				els, err := GetBufferedEventLogsForDevice(devEUI, 10)
+				So(err, ShouldBeNil)
+				So(els, ShouldHaveLength, 2)
+			})
+
+			Convey("When calling GetEventLogForDevice with a sequence number", func() {
+				logChannel := make(chan EventLog, 3)
+				cctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+
+				go func() {
+					if err := GetEventLogForDevice(cctx, devEUI, 2, logChannel); err != nil {
+						log.Fatal(err)
+					}
+				}()
+
+				// some time to subscribe
+				time.Sleep(time.Millisecond * 100)
+				So(LogEventForDevice(devEUI, EventLog{Type: Join}), ShouldBeNil)
+
+				Convey("Then the buffered events are sent before the new events", func() {
+					So((<-logChannel).Seq, ShouldEqual, 3)
+					So((<-logChannel).Seq, ShouldEqual, 4)
+				})
+			})
+		})
 	})
 }
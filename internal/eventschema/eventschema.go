@@ -0,0 +1,301 @@
+// Package eventschema generates the JSON Schemas and Protocol Buffers
+// definitions describing the event payloads emitted by the integrations.
+// The schemas are generated from the payload types, so that they always
+// match the payloads of the running version.
+package eventschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/plugin"
+	"github.com/brocaar/lorawan"
+)
+
+// Version defines the version of the event schemas. It must be incremented
+// on backwards incompatible changes of the event payloads (e.g. a removed or
+// renamed field).
+const Version = 1
+
+// Event defines an integration event type and its payload.
+type Event struct {
+	Type        string
+	Description string
+	Payload     interface{}
+}
+
+// Events contains all event types emitted by the integrations.
+var Events = []Event{
+	{plugin.UplinkEvent, "Received uplink data", handler.DataUpPayload{}},
+	{plugin.JoinEvent, "Join notification", handler.JoinNotification{}},
+	{plugin.ACKEvent, "ACK notification", handler.ACKNotification{}},
+	{plugin.ErrorEvent, "Error notification", handler.ErrorNotification{}},
+	{plugin.StatusEvent, "Status notification", handler.StatusNotification{}},
+	{plugin.LocationEvent, "Location notification", handler.LocationNotification{}},
+	{plugin.AdminEvent, "Admin-plane event", handler.AdminEvent{}},
+}
+
+// GetEvent returns the event for the given type.
+func GetEvent(eventType string) (Event, bool) {
+	for _, e := range Events {
+		if e.Type == eventType {
+			return e, true
+		}
+	}
+	return Event{}, false
+}
+
+var (
+	timeType    = reflect.TypeOf(time.Time{})
+	eui64Type   = reflect.TypeOf(lorawan.EUI64{})
+	devAddrType = reflect.TypeOf(lorawan.DevAddr{})
+	bytesType   = reflect.TypeOf([]byte{})
+)
+
+// field defines a (JSON encoded) struct field.
+type field struct {
+	Name      string
+	Type      reflect.Type
+	OmitEmpty bool
+	String    bool
+}
+
+// getFields returns the JSON encoded fields of the given struct type.
+func getFields(t reflect.Type) []field {
+	var out []field
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" {
+			continue
+		}
+
+		tag := strings.Split(sf.Tag.Get("json"), ",")
+		if tag[0] == "-" {
+			continue
+		}
+
+		f := field{
+			Name: tag[0],
+			Type: sf.Type,
+		}
+		if f.Name == "" {
+			f.Name = sf.Name
+		}
+		for _, opt := range tag[1:] {
+			switch opt {
+			case "omitempty":
+				f.OmitEmpty = true
+			case "string":
+				f.String = true
+			}
+		}
+
+		out = append(out, f)
+	}
+
+	return out
+}
+
+// JSONSchema returns the JSON Schema for the payload of the given event.
+func JSONSchema(e Event, id string) ([]byte, error) {
+	t := reflect.TypeOf(e.Payload)
+
+	schema := jsonSchemaForStruct(t)
+	schema["$schema"] = "http://json-schema.org/draft-07/schema#"
+	schema["$id"] = id
+	schema["title"] = t.Name()
+	schema["description"] = e.Description
+
+	return json.MarshalIndent(schema, "", "    ")
+}
+
+func jsonSchemaForStruct(t reflect.Type) map[string]interface{} {
+	properties := make(map[string]interface{})
+	required := []string{}
+
+	for _, f := range getFields(t) {
+		properties[f.Name] = jsonSchemaForField(f)
+		if !f.OmitEmpty {
+			required = append(required, f.Name)
+		}
+	}
+
+	return map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+		"required":   required,
+	}
+}
+
+func jsonSchemaForField(f field) map[string]interface{} {
+	t := f.Type
+	var nullable bool
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+		nullable = !f.OmitEmpty
+	}
+
+	var schema map[string]interface{}
+	if f.String {
+		schema = map[string]interface{}{
+			"type":    "string",
+			"pattern": "^-?[0-9]+$",
+		}
+	} else {
+		schema = jsonSchemaForType(t)
+	}
+
+	if nullable {
+		schema["type"] = []interface{}{schema["type"], "null"}
+	}
+
+	return schema
+}
+
+func jsonSchemaForType(t reflect.Type) map[string]interface{} {
+	switch t {
+	case timeType:
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	case eui64Type:
+		return map[string]interface{}{"type": "string", "pattern": "^[0-9a-f]{16}$"}
+	case devAddrType:
+		return map[string]interface{}{"type": "string", "pattern": "^[0-9a-f]{8}$"}
+	case bytesType:
+		return map[string]interface{}{"type": "string", "contentEncoding": "base64"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer", "minimum": 0}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice:
+		return map[string]interface{}{"type": "array", "items": jsonSchemaForType(t.Elem())}
+	case reflect.Struct:
+		return jsonSchemaForStruct(t)
+	default:
+		// e.g. the decoded object, which can contain any JSON value
+		return map[string]interface{}{}
+	}
+}
+
+// Proto returns the Protocol Buffers definitions for the payloads of all
+// events. The JSON mapping of these messages (see json_name) matches the
+// JSON encoded payloads.
+func Proto() string {
+	var messages []reflect.Type
+	seen := make(map[reflect.Type]bool)
+
+	var collect func(t reflect.Type)
+	collect = func(t reflect.Type) {
+		for t.Kind() == reflect.Ptr || (t.Kind() == reflect.Slice && t != bytesType) {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct || t == timeType || seen[t] {
+			return
+		}
+		seen[t] = true
+		messages = append(messages, t)
+
+		for _, f := range getFields(t) {
+			collect(f.Type)
+		}
+	}
+
+	for _, e := range Events {
+		collect(reflect.TypeOf(e.Payload))
+	}
+
+	sort.Slice(messages, func(i, j int) bool { return messages[i].Name() < messages[j].Name() })
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Event payload definitions (schema version %d).\n", Version)
+	buf.WriteString("syntax = \"proto3\";\n\n")
+	fmt.Fprintf(&buf, "package events.v%d;\n\n", Version)
+	buf.WriteString("import \"google/protobuf/struct.proto\";\n")
+	buf.WriteString("import \"google/protobuf/timestamp.proto\";\n")
+
+	for _, t := range messages {
+		fmt.Fprintf(&buf, "\nmessage %s {\n", t.Name())
+		for i, f := range getFields(t) {
+			fmt.Fprintf(&buf, "    %s %s = %d [json_name = \"%s\"];\n", protoType(f.Type), toSnakeCase(f.Name), i+1, f.Name)
+		}
+		buf.WriteString("}\n")
+	}
+
+	return buf.String()
+}
+
+func protoType(t reflect.Type) string {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t {
+	case timeType:
+		return "google.protobuf.Timestamp"
+	case eui64Type, devAddrType:
+		return "string"
+	case bytesType:
+		return "bytes"
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return "bool"
+	case reflect.Int, reflect.Int64:
+		return "int64"
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return "int32"
+	case reflect.Uint, reflect.Uint64:
+		return "uint64"
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return "uint32"
+	case reflect.Float32:
+		return "float"
+	case reflect.Float64:
+		return "double"
+	case reflect.String:
+		return "string"
+	case reflect.Slice:
+		return "repeated " + protoType(t.Elem())
+	case reflect.Struct:
+		return t.Name()
+	default:
+		return "google.protobuf.Value"
+	}
+}
+
+// toSnakeCase converts the given (lower camel-case) JSON field name to a
+// snake-case proto field name, e.g. devEUI becomes dev_eui.
+func toSnakeCase(s string) string {
+	runes := []rune(s)
+	var out []rune
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prevLower := unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevLower || (unicode.IsUpper(runes[i-1]) && nextLower) {
+				out = append(out, '_')
+			}
+		}
+		out = append(out, unicode.ToLower(r))
+	}
+
+	return string(out)
+}
@@ -0,0 +1,145 @@
+package eventschema
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/handler"
+)
+
+func TestJSONSchema(t *testing.T) {
+	t.Run("All payload fields are described", func(t *testing.T) {
+		assert := require.New(t)
+		now := time.Now()
+
+		payloads := map[string]interface{}{
+			"up": handler.DataUpPayload{
+				RXInfo: []handler.RXInfo{{Time: &now}},
+				Object: map[string]interface{}{"temperature": 21.5},
+				Region: "EU868",
+			},
+			"admin": handler.AdminEvent{OrganizationID: 1, ApplicationID: 2},
+		}
+
+		for eventType, pl := range payloads {
+			e, ok := GetEvent(eventType)
+			assert.True(ok)
+
+			b, err := JSONSchema(e, "test")
+			assert.NoError(err)
+
+			var schema struct {
+				Properties map[string]json.RawMessage `json:"properties"`
+				Required   []string                   `json:"required"`
+			}
+			assert.NoError(json.Unmarshal(b, &schema))
+
+			b, err = json.Marshal(pl)
+			assert.NoError(err)
+			var fields map[string]json.RawMessage
+			assert.NoError(json.Unmarshal(b, &fields))
+
+			for k := range fields {
+				assert.Contains(schema.Properties, k, eventType)
+			}
+			for _, k := range schema.Required {
+				assert.Contains(fields, k, eventType)
+			}
+		}
+	})
+
+	t.Run("Field types", func(t *testing.T) {
+		assert := require.New(t)
+		e, _ := GetEvent("up")
+
+		b, err := JSONSchema(e, "test")
+		assert.NoError(err)
+
+		var schema struct {
+			Properties map[string]map[string]interface{} `json:"properties"`
+		}
+		assert.NoError(json.Unmarshal(b, &schema))
+
+		assert.Equal(map[string]interface{}{"type": "string", "pattern": "^-?[0-9]+$"}, schema.Properties["applicationID"])
+		assert.Equal(map[string]interface{}{"type": "string", "pattern": "^[0-9a-f]{16}$"}, schema.Properties["devEUI"])
+		assert.Equal(map[string]interface{}{"type": "string", "contentEncoding": "base64"}, schema.Properties["data"])
+		assert.Equal(map[string]interface{}{"type": "integer", "minimum": float64(0)}, schema.Properties["fCnt"])
+		assert.Equal("array", schema.Properties["rxInfo"]["type"])
+	})
+}
+
+func TestProto(t *testing.T) {
+	assert := require.New(t)
+	p := Proto()
+
+	assert.Contains(p, "package events.v1;")
+	assert.Contains(p, "message DataUpPayload {")
+	assert.Contains(p, "message RXInfo {")
+	assert.Contains(p, `string dev_eui = 4 [json_name = "devEUI"];`)
+	assert.Contains(p, `repeated RXInfo rx_info = 5 [json_name = "rxInfo"];`)
+	assert.Contains(p, `google.protobuf.Value object = 11 [json_name = "object"];`)
+	assert.Contains(p, `google.protobuf.Timestamp time = 7 [json_name = "time"];`)
+	assert.Equal(1, strings.Count(p, "message Location {"))
+}
+
+func TestToSnakeCase(t *testing.T) {
+	assert := require.New(t)
+
+	for in, out := range map[string]string{
+		"devEUI":        "dev_eui",
+		"applicationID": "application_id",
+		"fCnt":          "f_cnt",
+		"rxInfo":        "rx_info",
+		"dr":            "dr",
+	} {
+		assert.Equal(out, toSnakeCase(in))
+	}
+}
+
+func TestHandler(t *testing.T) {
+	h := NewHandler("/api/schemas", "1.2.3")
+
+	tests := []struct {
+		Path        string
+		Status      int
+		ContentType string
+	}{
+		{"/api/schemas", http.StatusOK, "application/json"},
+		{"/api/schemas/v1/up.json", http.StatusOK, "application/schema+json"},
+		{"/api/schemas/v1/events.proto", http.StatusOK, "text/plain; charset=utf-8"},
+		{"/api/schemas/v1/unknown.json", http.StatusNotFound, ""},
+		{"/api/schemas/v2/up.json", http.StatusNotFound, ""},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Path, func(t *testing.T) {
+			assert := require.New(t)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest("GET", tst.Path, nil))
+			assert.Equal(tst.Status, w.Code)
+			if tst.ContentType != "" {
+				assert.Equal(tst.ContentType, w.Header().Get("Content-Type"))
+			}
+		})
+	}
+
+	t.Run("Index", func(t *testing.T) {
+		assert := require.New(t)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest("GET", "/api/schemas", nil))
+
+		var index Index
+		assert.NoError(json.Unmarshal(w.Body.Bytes(), &index))
+		assert.Equal(Version, index.Version)
+		assert.Equal("1.2.3", index.ServerVersion)
+		assert.Equal("/api/schemas/v1/events.proto", index.Proto)
+		assert.Len(index.Events, len(Events))
+		assert.Equal(IndexEvent{Type: "up", Description: "Received uplink data", Schema: "/api/schemas/v1/up.json"}, index.Events[0])
+	})
+}
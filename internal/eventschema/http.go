@@ -0,0 +1,113 @@
+package eventschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// IndexEvent defines an event within the schema index.
+type IndexEvent struct {
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	Schema      string `json:"schema"`
+}
+
+// Index defines the schema index.
+type Index struct {
+	Version       int          `json:"version"`
+	ServerVersion string       `json:"serverVersion"`
+	Events        []IndexEvent `json:"events"`
+	Proto         string       `json:"proto"`
+}
+
+// Handler serves the event schemas. Under the configured prefix, it serves:
+//
+//	/                         the schema index
+//	/v{version}/{type}.json   the JSON Schema for the given event type
+//	/v{version}/events.proto  the Protocol Buffers definitions
+type Handler struct {
+	prefix        string
+	serverVersion string
+}
+
+// NewHandler creates a new Handler, serving the schemas under the given
+// path prefix (e.g. /api/schemas).
+func NewHandler(prefix, serverVersion string) *Handler {
+	return &Handler{
+		prefix:        strings.TrimSuffix(prefix, "/"),
+		serverVersion: serverVersion,
+	}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, h.prefix)
+	versionPrefix := fmt.Sprintf("/v%d/", Version)
+
+	switch {
+	case path == "" || path == "/":
+		h.serveJSON(w, h.index())
+	case path == versionPrefix+"events.proto":
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Write([]byte(Proto()))
+	case strings.HasPrefix(path, versionPrefix) && strings.HasSuffix(path, ".json"):
+		e, ok := GetEvent(strings.TrimSuffix(strings.TrimPrefix(path, versionPrefix), ".json"))
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		b, err := JSONSchema(e, h.schemaPath(e.Type))
+		if err != nil {
+			log.WithError(err).WithField("type", e.Type).Error("eventschema: generate json schema error")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/schema+json")
+		w.Write(b)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (h *Handler) index() Index {
+	index := Index{
+		Version:       Version,
+		ServerVersion: h.serverVersion,
+		Proto:         fmt.Sprintf("%s/v%d/events.proto", h.prefix, Version),
+	}
+
+	for _, e := range Events {
+		index.Events = append(index.Events, IndexEvent{
+			Type:        e.Type,
+			Description: e.Description,
+			Schema:      h.schemaPath(e.Type),
+		})
+	}
+
+	return index
+}
+
+func (h *Handler) schemaPath(eventType string) string {
+	return fmt.Sprintf("%s/v%d/%s.json", h.prefix, Version, eventType)
+}
+
+func (h *Handler) serveJSON(w http.ResponseWriter, v interface{}) {
+	b, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		log.WithError(err).Error("eventschema: marshal json error")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(b)
+}
@@ -0,0 +1,135 @@
+// Package faultinject implements fault-injection for testing the behavior
+// of LoRa App Server and its integrations under degraded conditions. It
+// injects a configurable latency and failure-rate into integration
+// deliveries and storage calls.
+package faultinject
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/handler"
+)
+
+// ErrInjected is returned for injected failures.
+var ErrInjected = errors.New("injected fault")
+
+// Config defines the fault-injection configuration.
+type Config struct {
+	Enabled     bool          `mapstructure:"enabled"`
+	Latency     time.Duration `mapstructure:"latency"`
+	Jitter      time.Duration `mapstructure:"jitter"`
+	FailureRate float64       `mapstructure:"failure_rate"`
+}
+
+// Inject sleeps for the configured latency (plus a random jitter) and
+// returns ErrInjected with the configured failure-rate (0 - 1). It is a no-op
+// when fault-injection is disabled.
+func (c Config) Inject() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	latency := c.Latency
+	if c.Jitter > 0 {
+		latency += time.Duration(rand.Int63n(int64(c.Jitter)))
+	}
+	if latency > 0 {
+		time.Sleep(latency)
+	}
+
+	if c.FailureRate > 0 && rand.Float64() < c.FailureRate {
+		return ErrInjected
+	}
+
+	return nil
+}
+
+// IntegrationHandler wraps an integration handler and injects faults
+// before each delivery. Failed deliveries are not forwarded to the wrapped
+// handler.
+type IntegrationHandler struct {
+	conf    Config
+	handler handler.IntegrationHandler
+}
+
+// NewIntegrationHandler creates a new IntegrationHandler.
+func NewIntegrationHandler(conf Config, h handler.IntegrationHandler) *IntegrationHandler {
+	return &IntegrationHandler{
+		conf:    conf,
+		handler: h,
+	}
+}
+
+// SendDataUp sends a data-up payload.
+func (h *IntegrationHandler) SendDataUp(pl handler.DataUpPayload) error {
+	if err := h.inject(); err != nil {
+		return err
+	}
+	return h.handler.SendDataUp(pl)
+}
+
+// SendJoinNotification sends a join notification.
+func (h *IntegrationHandler) SendJoinNotification(pl handler.JoinNotification) error {
+	if err := h.inject(); err != nil {
+		return err
+	}
+	return h.handler.SendJoinNotification(pl)
+}
+
+// SendACKNotification sends an ACK notification.
+func (h *IntegrationHandler) SendACKNotification(pl handler.ACKNotification) error {
+	if err := h.inject(); err != nil {
+		return err
+	}
+	return h.handler.SendACKNotification(pl)
+}
+
+// SendErrorNotification sends an error notification.
+func (h *IntegrationHandler) SendErrorNotification(pl handler.ErrorNotification) error {
+	if err := h.inject(); err != nil {
+		return err
+	}
+	return h.handler.SendErrorNotification(pl)
+}
+
+// SendStatusNotification sends a status notification.
+func (h *IntegrationHandler) SendStatusNotification(pl handler.StatusNotification) error {
+	if err := h.inject(); err != nil {
+		return err
+	}
+	return h.handler.SendStatusNotification(pl)
+}
+
+// SendLocationNotification sends a location notification.
+func (h *IntegrationHandler) SendLocationNotification(pl handler.LocationNotification) error {
+	if err := h.inject(); err != nil {
+		return err
+	}
+	return h.handler.SendLocationNotification(pl)
+}
+
+// SendAdminEvent sends an admin-plane event.
+func (h *IntegrationHandler) SendAdminEvent(pl handler.AdminEvent) error {
+	if err := h.inject(); err != nil {
+		return err
+	}
+	return h.handler.SendAdminEvent(pl)
+}
+
+// Close closes the wrapped handler.
+func (h *IntegrationHandler) Close() error {
+	return h.handler.Close()
+}
+
+func (h *IntegrationHandler) inject() error {
+	if err := h.conf.Inject(); err != nil {
+		log.WithField("handler", fmt.Sprintf("%T", h.handler)).Warning("faultinject: injecting integration failure")
+		return errors.Wrapf(err, "%T", h.handler)
+	}
+	return nil
+}
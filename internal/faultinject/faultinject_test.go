@@ -0,0 +1,65 @@
+package faultinject
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/test/testhandler"
+)
+
+func TestInject(t *testing.T) {
+	Convey("Given a fault-injection config", t, func() {
+		conf := Config{
+			Latency:     10 * time.Millisecond,
+			FailureRate: 1,
+		}
+
+		Convey("Then Inject is a no-op when disabled", func() {
+			start := time.Now()
+			So(conf.Inject(), ShouldBeNil)
+			So(time.Since(start), ShouldBeLessThan, conf.Latency)
+		})
+
+		Convey("When enabled", func() {
+			conf.Enabled = true
+
+			Convey("Then Inject adds the latency and returns ErrInjected", func() {
+				start := time.Now()
+				So(conf.Inject(), ShouldEqual, ErrInjected)
+				So(time.Since(start), ShouldBeGreaterThanOrEqualTo, conf.Latency)
+			})
+
+			Convey("Then Inject does not fail when the failure-rate is 0", func() {
+				conf.FailureRate = 0
+				So(conf.Inject(), ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestIntegrationHandler(t *testing.T) {
+	Convey("Given a test handler wrapped by the fault-injection handler", t, func() {
+		h := testhandler.NewTestHandler()
+		conf := Config{
+			Enabled: true,
+		}
+
+		Convey("Then payloads are forwarded when no failure is injected", func() {
+			fh := NewIntegrationHandler(conf, h)
+			So(fh.SendDataUp(handler.DataUpPayload{ApplicationID: 1}), ShouldBeNil)
+			So(<-h.SendDataUpChan, ShouldResemble, handler.DataUpPayload{ApplicationID: 1})
+		})
+
+		Convey("Then payloads are not forwarded when a failure is injected", func() {
+			conf.FailureRate = 1
+			fh := NewIntegrationHandler(conf, h)
+			err := fh.SendDataUp(handler.DataUpPayload{ApplicationID: 1})
+			So(errors.Cause(err), ShouldEqual, ErrInjected)
+			So(h.SendDataUpChan, ShouldHaveLength, 0)
+		})
+	})
+}
@@ -0,0 +1,123 @@
+// Package fcntanomaly implements the detection of frame-counter anomalies
+// (large jumps or resets of the uplink frame-counter of a device), which
+// could indicate a device reset, a replay or a counter rollover.
+package fcntanomaly
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+)
+
+// fCntKeyTempl defines the key template of the last seen uplink
+// frame-counter of a device.
+const fCntKeyTempl = "lora:as:device:%s:f_cnt_up"
+
+// suppressKeyTempl defines the key template used to suppress repeated
+// anomaly events of a device.
+const suppressKeyTempl = "lora:as:device:%s:f_cnt_anomaly_suppress"
+
+// fCntTTL defines the expiration of the last seen frame-counter. Uplinks
+// of devices which have not been seen within this duration are not
+// validated.
+const fCntTTL = 30 * 24 * time.Hour
+
+// Anomaly defines a frame-counter anomaly.
+type Anomaly int
+
+// Available anomalies.
+const (
+	// NoAnomaly is returned when no anomaly has been detected.
+	NoAnomaly Anomaly = iota
+
+	// CounterJump is returned when the frame-counter increased more than
+	// the max. gap.
+	CounterJump
+
+	// CounterReset is returned when the frame-counter decreased.
+	CounterReset
+)
+
+// Detect returns the anomaly for the given frame-counter, compared to the
+// previous frame-counter of the device. A maxGap of 0 disables the
+// detection of frame-counter jumps.
+func Detect(prev, fCnt, maxGap uint32) Anomaly {
+	if fCnt < prev {
+		return CounterReset
+	}
+
+	if maxGap != 0 && fCnt-prev > maxGap {
+		return CounterJump
+	}
+
+	return NoAnomaly
+}
+
+// GetAndSetFCnt stores the given frame-counter as the last seen uplink
+// frame-counter of the given device and returns the previous value. The
+// returned bool is false when there is no previous value.
+func GetAndSetFCnt(p *redis.Pool, devEUI lorawan.EUI64, fCnt uint32) (uint32, bool, error) {
+	c := p.Get()
+	defer c.Close()
+
+	key := fmt.Sprintf(fCntKeyTempl, devEUI)
+
+	c.Send("MULTI")
+	c.Send("GET", key)
+	c.Send("PSETEX", key, int64(fCntTTL/time.Millisecond), fCnt)
+	values, err := redis.Values(c.Do("EXEC"))
+	if err != nil {
+		return 0, false, errors.Wrap(err, "get and set frame-counter error")
+	}
+
+	if values[0] == nil {
+		return 0, false, nil
+	}
+
+	prev, err := redis.Uint64(values[0], nil)
+	if err != nil {
+		return 0, false, errors.Wrap(err, "read frame-counter error")
+	}
+
+	return uint32(prev), true, nil
+}
+
+// DeleteFCnt deletes the last seen uplink frame-counter of the given
+// device, e.g. after a (re)activation.
+func DeleteFCnt(p *redis.Pool, devEUI lorawan.EUI64) error {
+	c := p.Get()
+	defer c.Close()
+
+	if _, err := c.Do("DEL", fmt.Sprintf(fCntKeyTempl, devEUI)); err != nil {
+		return errors.Wrap(err, "delete frame-counter error")
+	}
+
+	return nil
+}
+
+// Suppress returns true when an anomaly event has already been emitted
+// for the given device within the given interval. Otherwise it returns
+// false and suppresses the anomaly events of the device for the given
+// interval. An interval of 0 disables the suppression.
+func Suppress(p *redis.Pool, devEUI lorawan.EUI64, interval time.Duration) (bool, error) {
+	if interval == 0 {
+		return false, nil
+	}
+
+	c := p.Get()
+	defer c.Close()
+
+	_, err := redis.String(c.Do("SET", fmt.Sprintf(suppressKeyTempl, devEUI), "1", "PX", int64(interval/time.Millisecond), "NX"))
+	if err == redis.ErrNil {
+		return true, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "set suppress key error")
+	}
+
+	return false, nil
+}
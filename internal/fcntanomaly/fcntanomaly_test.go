@@ -0,0 +1,87 @@
+package fcntanomaly
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestDetect(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Name     string
+			Prev     uint32
+			FCnt     uint32
+			MaxGap   uint32
+			Expected Anomaly
+		}{
+			{"increment", 10, 11, 100, NoAnomaly},
+			{"same frame-counter", 10, 10, 100, NoAnomaly},
+			{"gap equal to max gap", 10, 110, 100, NoAnomaly},
+			{"gap exceeding max gap", 10, 111, 100, CounterJump},
+			{"gap with jump detection disabled", 10, 1000, 0, NoAnomaly},
+			{"reset", 10, 0, 100, CounterReset},
+			{"rollover", 65535, 1, 100, CounterReset},
+		}
+
+		for _, tst := range tests {
+			Convey("Then the "+tst.Name+" returns the expected anomaly", func() {
+				So(Detect(tst.Prev, tst.FCnt, tst.MaxGap), ShouldEqual, tst.Expected)
+			})
+		}
+	})
+}
+
+func TestFCnt(t *testing.T) {
+	conf := test.GetConfig()
+	p := storage.NewRedisPool(conf.RedisURL, 10, 0)
+
+	Convey("Given a clean Redis database", t, func() {
+		test.MustFlushRedis(p)
+		devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+		Convey("Then GetAndSetFCnt returns no previous frame-counter", func() {
+			_, found, err := GetAndSetFCnt(p, devEUI, 10)
+			So(err, ShouldBeNil)
+			So(found, ShouldBeFalse)
+
+			Convey("Then GetAndSetFCnt returns the previous frame-counter", func() {
+				prev, found, err := GetAndSetFCnt(p, devEUI, 11)
+				So(err, ShouldBeNil)
+				So(found, ShouldBeTrue)
+				So(prev, ShouldEqual, 10)
+			})
+
+			Convey("Then DeleteFCnt deletes the frame-counter", func() {
+				So(DeleteFCnt(p, devEUI), ShouldBeNil)
+
+				_, found, err := GetAndSetFCnt(p, devEUI, 0)
+				So(err, ShouldBeNil)
+				So(found, ShouldBeFalse)
+			})
+		})
+
+		Convey("Then Suppress suppresses repeated events", func() {
+			suppressed, err := Suppress(p, devEUI, time.Hour)
+			So(err, ShouldBeNil)
+			So(suppressed, ShouldBeFalse)
+
+			suppressed, err = Suppress(p, devEUI, time.Hour)
+			So(err, ShouldBeNil)
+			So(suppressed, ShouldBeTrue)
+		})
+
+		Convey("Then Suppress with interval 0 never suppresses", func() {
+			for i := 0; i < 2; i++ {
+				suppressed, err := Suppress(p, devEUI, 0)
+				So(err, ShouldBeNil)
+				So(suppressed, ShouldBeFalse)
+			}
+		})
+	})
+}
@@ -0,0 +1,312 @@
+// Package framecapture implements the (per device) raw frame capture. When
+// a capture is started, the uplink and downlink frames of the device
+// (including the PHYPayload and the RX / TX information) are received from
+// the network-server and stored for a bounded time window, so that they can
+// be downloaded as a bundle (e.g. for vendor escalations).
+package framecapture
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+
+	"github.com/brocaar/loraserver/api/gw"
+	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
+)
+
+// ErrDoesNotExist is returned when the device has no frame capture.
+var ErrDoesNotExist = errors.New("frame capture does not exist")
+
+// ErrFrameDoesNotExist is returned when the captured frames of the device
+// do not contain the requested uplink.
+var ErrFrameDoesNotExist = errors.New("captured uplink frame does not exist")
+
+// checkInterval defines the interval in which a running capture checks if
+// it has been stopped.
+var checkInterval = 10 * time.Second
+
+const (
+	// captureKeyTempl defines the key template of the frame capture of a
+	// device.
+	captureKeyTempl = "lora:as:device:%s:frame_capture"
+
+	// framesKeyTempl defines the key template of the captured frames of a
+	// device.
+	framesKeyTempl = "lora:as:device:%s:frame_capture:frames"
+)
+
+// Capture defines a frame capture.
+type Capture struct {
+	StartedAt time.Time `json:"startedAt"`
+	EndsAt    time.Time `json:"endsAt"`
+}
+
+// Active returns true when the capture window has not yet ended.
+func (c Capture) Active() bool {
+	return time.Now().Before(c.EndsAt)
+}
+
+// Frame defines a captured frame. The frames are stored as received from
+// the network-server, either UplinkFrameSet or DownlinkFrame is set
+// (containing the protobuf encoded gw.UplinkFrameSet or gw.DownlinkFrame).
+type Frame struct {
+	ReceivedAt     time.Time `json:"receivedAt"`
+	UplinkFrameSet []byte    `json:"uplinkFrameSet,omitempty"`
+	DownlinkFrame  []byte    `json:"downlinkFrame,omitempty"`
+}
+
+// Start starts a new capture for the given device, replacing the previous
+// capture and its frames. The capture and its frames are kept until the
+// given retention after the end of the capture window.
+func Start(p *redis.Pool, devEUI lorawan.EUI64, duration, retention time.Duration) (Capture, error) {
+	now := time.Now()
+	capture := Capture{
+		StartedAt: now,
+		EndsAt:    now.Add(duration),
+	}
+
+	b, err := json.Marshal(capture)
+	if err != nil {
+		return capture, errors.Wrap(err, "marshal json error")
+	}
+
+	c := p.Get()
+	defer c.Close()
+
+	c.Send("MULTI")
+	c.Send("DEL", fmt.Sprintf(framesKeyTempl, devEUI))
+	c.Send("PSETEX", fmt.Sprintf(captureKeyTempl, devEUI), int64((duration+retention)/time.Millisecond), b)
+	if _, err := c.Do("EXEC"); err != nil {
+		return capture, errors.Wrap(err, "start frame capture error")
+	}
+
+	return capture, nil
+}
+
+// Stop ends the capture window of the given device. The captured frames
+// are kept until the given retention.
+func Stop(p *redis.Pool, devEUI lorawan.EUI64, retention time.Duration) error {
+	capture, err := Get(p, devEUI)
+	if err != nil {
+		return err
+	}
+
+	if !capture.Active() {
+		return nil
+	}
+	capture.EndsAt = time.Now()
+
+	b, err := json.Marshal(capture)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	c := p.Get()
+	defer c.Close()
+
+	c.Send("MULTI")
+	c.Send("PSETEX", fmt.Sprintf(captureKeyTempl, devEUI), int64(retention/time.Millisecond), b)
+	c.Send("PEXPIRE", fmt.Sprintf(framesKeyTempl, devEUI), int64(retention/time.Millisecond))
+	if _, err := c.Do("EXEC"); err != nil {
+		return errors.Wrap(err, "stop frame capture error")
+	}
+
+	return nil
+}
+
+// Get returns the capture of the given device.
+func Get(p *redis.Pool, devEUI lorawan.EUI64) (Capture, error) {
+	var capture Capture
+
+	c := p.Get()
+	defer c.Close()
+
+	b, err := redis.Bytes(c.Do("GET", fmt.Sprintf(captureKeyTempl, devEUI)))
+	if err != nil {
+		if err == redis.ErrNil {
+			return capture, ErrDoesNotExist
+		}
+		return capture, errors.Wrap(err, "get frame capture error")
+	}
+
+	if err := json.Unmarshal(b, &capture); err != nil {
+		return capture, errors.Wrap(err, "unmarshal json error")
+	}
+
+	return capture, nil
+}
+
+// AddFrame adds the given frame to the captured frames of the given device.
+// Once maxFrames frames have been captured, new frames are discarded. The
+// frames expire after the given TTL.
+func AddFrame(p *redis.Pool, devEUI lorawan.EUI64, f Frame, maxFrames int, ttl time.Duration) error {
+	b, err := json.Marshal(f)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	c := p.Get()
+	defer c.Close()
+
+	key := fmt.Sprintf(framesKeyTempl, devEUI)
+
+	c.Send("MULTI")
+	c.Send("RPUSH", key, b)
+	c.Send("LTRIM", key, 0, maxFrames-1)
+	c.Send("PEXPIRE", key, int64(ttl/time.Millisecond))
+	if _, err := c.Do("EXEC"); err != nil {
+		return errors.Wrap(err, "add captured frame error")
+	}
+
+	return nil
+}
+
+// GetFrames returns the captured frames of the given device, oldest first.
+func GetFrames(p *redis.Pool, devEUI lorawan.EUI64) ([]Frame, error) {
+	c := p.Get()
+	defer c.Close()
+
+	values, err := redis.ByteSlices(c.Do("LRANGE", fmt.Sprintf(framesKeyTempl, devEUI), 0, -1))
+	if err != nil {
+		return nil, errors.Wrap(err, "get captured frames error")
+	}
+
+	out := make([]Frame, 0, len(values))
+	for _, b := range values {
+		var f Frame
+		if err := json.Unmarshal(b, &f); err != nil {
+			return nil, errors.Wrap(err, "unmarshal json error")
+		}
+		out = append(out, f)
+	}
+
+	return out, nil
+}
+
+// GetUplinkMACPayload returns the MACPayload of the most recent captured
+// data uplink of the given device with the given frame-counter. As the
+// PHYPayload only contains the 16 least-significant bits of the
+// frame-counter, only these bits are compared.
+func GetUplinkMACPayload(p *redis.Pool, devEUI lorawan.EUI64, fCnt uint32) (*lorawan.MACPayload, error) {
+	frames, err := GetFrames(p, devEUI)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(frames) - 1; i >= 0; i-- {
+		if len(frames[i].UplinkFrameSet) == 0 {
+			continue
+		}
+
+		var up gw.UplinkFrameSet
+		if err := proto.Unmarshal(frames[i].UplinkFrameSet, &up); err != nil {
+			return nil, errors.Wrap(err, "unmarshal uplink frame-set error")
+		}
+
+		var phy lorawan.PHYPayload
+		if err := phy.UnmarshalBinary(up.PhyPayload); err != nil {
+			continue
+		}
+
+		if phy.MHDR.MType != lorawan.UnconfirmedDataUp && phy.MHDR.MType != lorawan.ConfirmedDataUp {
+			continue
+		}
+
+		macPL, ok := phy.MACPayload.(*lorawan.MACPayload)
+		if !ok || macPL.FHDR.FCnt != fCnt&0xffff || macPL.FPort == nil {
+			continue
+		}
+
+		return macPL, nil
+	}
+
+	return nil, ErrFrameDoesNotExist
+}
+
+// Run captures the frames of the given device, received from the given
+// network-server client, until the end of the capture window or until the
+// capture has been stopped or replaced. This blocks until the capture ends.
+func Run(ctx context.Context, p *redis.Pool, client ns.NetworkServerServiceClient, devEUI lorawan.EUI64, capture Capture, maxFrames int, retention time.Duration) error {
+	ctx, cancel := context.WithDeadline(ctx, capture.EndsAt)
+	defer cancel()
+
+	// stop the capture when it has been stopped or replaced, e.g. by an
+	// other instance
+	go func() {
+		ticker := time.NewTicker(checkInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if !isCurrent(p, devEUI, capture) {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	streamClient, err := client.StreamFrameLogsForDevice(ctx, &ns.StreamFrameLogsForDeviceRequest{
+		DevEui: devEUI[:],
+	})
+	if err != nil {
+		return errors.Wrap(err, "stream frame-logs for device error")
+	}
+
+	for {
+		resp, err := streamClient.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return errors.Wrap(err, "receive frame-log error")
+		}
+
+		if !isCurrent(p, devEUI, capture) {
+			return nil
+		}
+
+		f := Frame{
+			ReceivedAt: time.Now(),
+		}
+		if up := resp.GetUplinkFrameSet(); up != nil {
+			f.UplinkFrameSet, err = proto.Marshal(up)
+		}
+		if down := resp.GetDownlinkFrame(); down != nil {
+			f.DownlinkFrame, err = proto.Marshal(down)
+		}
+		if err != nil {
+			return errors.Wrap(err, "marshal protobuf error")
+		}
+
+		if err := AddFrame(p, devEUI, f, maxFrames, capture.EndsAt.Sub(time.Now())+retention); err != nil {
+			log.WithError(err).WithField("dev_eui", devEUI).Error("frame capture error")
+		}
+	}
+}
+
+// isCurrent returns true when the given capture is still the active capture
+// of the device.
+func isCurrent(p *redis.Pool, devEUI lorawan.EUI64, capture Capture) bool {
+	current, err := Get(p, devEUI)
+	if err != nil {
+		if errors.Cause(err) != ErrDoesNotExist {
+			log.WithError(err).WithField("dev_eui", devEUI).Error("get frame capture error")
+			// keep capturing on Redis errors
+			return true
+		}
+		return false
+	}
+
+	return current.StartedAt.Equal(capture.StartedAt) && current.Active()
+}
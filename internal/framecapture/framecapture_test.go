@@ -0,0 +1,146 @@
+package framecapture
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/loraserver/api/gw"
+	"github.com/brocaar/lorawan"
+)
+
+func TestFrameCapture(t *testing.T) {
+	conf := test.GetConfig()
+	p := storage.NewRedisPool(conf.RedisURL, 10, 0)
+
+	Convey("Given a clean Redis database", t, func() {
+		test.MustFlushRedis(p)
+
+		devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+		Convey("Then Get returns ErrDoesNotExist", func() {
+			_, err := Get(p, devEUI)
+			So(errors.Cause(err), ShouldEqual, ErrDoesNotExist)
+		})
+
+		Convey("Then Stop returns ErrDoesNotExist", func() {
+			So(errors.Cause(Stop(p, devEUI, time.Hour)), ShouldEqual, ErrDoesNotExist)
+		})
+
+		Convey("When starting a capture", func() {
+			capture, err := Start(p, devEUI, time.Minute, time.Hour)
+			So(err, ShouldBeNil)
+			So(capture.Active(), ShouldBeTrue)
+			So(capture.EndsAt.Sub(capture.StartedAt), ShouldEqual, time.Minute)
+
+			Convey("Then Get returns the capture", func() {
+				c, err := Get(p, devEUI)
+				So(err, ShouldBeNil)
+				So(c.StartedAt.Equal(capture.StartedAt), ShouldBeTrue)
+				So(c.EndsAt.Equal(capture.EndsAt), ShouldBeTrue)
+				So(isCurrent(p, devEUI, capture), ShouldBeTrue)
+			})
+
+			Convey("When adding frames", func() {
+				frames := []Frame{
+					{ReceivedAt: time.Now().Round(time.Second), UplinkFrameSet: []byte{1, 2, 3}},
+					{ReceivedAt: time.Now().Round(time.Second), DownlinkFrame: []byte{4, 5, 6}},
+					{ReceivedAt: time.Now().Round(time.Second), UplinkFrameSet: []byte{7, 8, 9}},
+				}
+				for _, f := range frames {
+					So(AddFrame(p, devEUI, f, 2, time.Hour), ShouldBeNil)
+				}
+
+				Convey("Then GetFrames returns the first max. frames", func() {
+					out, err := GetFrames(p, devEUI)
+					So(err, ShouldBeNil)
+					So(out, ShouldHaveLength, 2)
+					So(out[0].UplinkFrameSet, ShouldResemble, frames[0].UplinkFrameSet)
+					So(out[1].DownlinkFrame, ShouldResemble, frames[1].DownlinkFrame)
+					So(out[0].ReceivedAt.Equal(frames[0].ReceivedAt), ShouldBeTrue)
+				})
+
+				Convey("When starting a new capture", func() {
+					newCapture, err := Start(p, devEUI, time.Minute, time.Hour)
+					So(err, ShouldBeNil)
+
+					Convey("Then the frames have been removed", func() {
+						out, err := GetFrames(p, devEUI)
+						So(err, ShouldBeNil)
+						So(out, ShouldHaveLength, 0)
+					})
+
+					Convey("Then only the new capture is current", func() {
+						So(isCurrent(p, devEUI, capture), ShouldBeFalse)
+						So(isCurrent(p, devEUI, newCapture), ShouldBeTrue)
+					})
+				})
+			})
+
+			Convey("When adding captured uplinks", func() {
+				for _, fCnt := range []uint32{10, 11, 65546} {
+					// the fPort is used to identify the frame
+					fPort := uint8(fCnt>>16) + 1
+					phy := lorawan.PHYPayload{
+						MHDR: lorawan.MHDR{
+							MType: lorawan.UnconfirmedDataUp,
+							Major: lorawan.LoRaWANR1,
+						},
+						MACPayload: &lorawan.MACPayload{
+							FHDR: lorawan.FHDR{
+								DevAddr: lorawan.DevAddr{1, 2, 3, 4},
+								FCnt:    fCnt,
+							},
+							FPort:      &fPort,
+							FRMPayload: []lorawan.Payload{&lorawan.DataPayload{Bytes: []byte{1, 2, 3, 4}}},
+						},
+					}
+					phyB, err := phy.MarshalBinary()
+					So(err, ShouldBeNil)
+					upB, err := proto.Marshal(&gw.UplinkFrameSet{PhyPayload: phyB})
+					So(err, ShouldBeNil)
+					So(AddFrame(p, devEUI, Frame{ReceivedAt: time.Now(), UplinkFrameSet: upB}, 10, time.Hour), ShouldBeNil)
+				}
+
+				Convey("Then GetUplinkMACPayload returns the most recent uplink with the given frame-counter", func() {
+					tests := []struct {
+						FCnt          uint32
+						ExpectedFPort uint8
+						ExpectedError error
+					}{
+						{FCnt: 11, ExpectedFPort: 1},
+						{FCnt: 10, ExpectedFPort: 2},
+						{FCnt: 65546, ExpectedFPort: 2},
+						{FCnt: 12, ExpectedError: ErrFrameDoesNotExist},
+					}
+
+					for _, tst := range tests {
+						macPL, err := GetUplinkMACPayload(p, devEUI, tst.FCnt)
+						So(errors.Cause(err), ShouldEqual, tst.ExpectedError)
+						if tst.ExpectedError == nil {
+							So(*macPL.FPort, ShouldEqual, tst.ExpectedFPort)
+							So(macPL.FHDR.DevAddr, ShouldEqual, lorawan.DevAddr{1, 2, 3, 4})
+						}
+					}
+				})
+			})
+
+			Convey("When stopping the capture", func() {
+				So(Stop(p, devEUI, time.Hour), ShouldBeNil)
+
+				Convey("Then the capture is no longer active", func() {
+					c, err := Get(p, devEUI)
+					So(err, ShouldBeNil)
+					So(c.Active(), ShouldBeFalse)
+					So(c.StartedAt.Equal(capture.StartedAt), ShouldBeTrue)
+					So(isCurrent(p, devEUI, capture), ShouldBeFalse)
+				})
+			})
+		})
+	})
+}
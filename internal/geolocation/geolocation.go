@@ -0,0 +1,365 @@
+// Package geolocation implements the geolocation of devices, based on the
+// uplink meta-data (e.g. fine-timestamps) of one or multiple uplink frames.
+package geolocation
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/gomodule/redigo/redis"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/eventlog"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/loraserver/api/geo"
+	"github.com/brocaar/loraserver/api/gw"
+	"github.com/brocaar/lorawan"
+)
+
+const (
+	frameBufferKeyTempl   = "lora:as:geoloc:%s"
+	resolveLockKeyTempl   = "lora:as:geoloc:%s:lock"
+	defaultFrameBufferTTL = time.Hour
+)
+
+// minTDOAGateways defines the minimum number of gateways (with
+// fine-timestamp) needed to resolve the location using TDOA.
+const minTDOAGateways = 3
+
+// ErrNoLocation is returned by a backend when no location could be resolved.
+var ErrNoLocation = errors.New("no location resolved")
+
+// Backend defines the interface of a geolocation backend (resolver).
+type Backend interface {
+	// ResolveTDOA resolves the device location using the TDOA of the
+	// given frames. Frames are ordered from old to new.
+	ResolveTDOA(ctx context.Context, devEUI lorawan.EUI64, frames []geo.FrameRXInfo) (common.Location, error)
+}
+
+var backend Backend
+
+// SetBackend sets the geolocation backend. When set to nil, geolocation
+// is disabled.
+func SetBackend(b Backend) {
+	backend = b
+}
+
+// HandleUplink buffers the RX meta-data of the given uplink and resolves the
+// device location using the configured backend when sufficient meta-data is
+// available. Uplinks received on the FPorts configured for Wi-Fi scan or
+// GNSS-NAV payloads are resolved using the (decrypted) payload instead.
+// The resolved location is stored as the device location and is sent as
+// location notification to the integrations.
+func HandleUplink(ctx context.Context, d storage.Device, app storage.Application, fPort uint8, data []byte, rxInfo []*gw.UplinkRXInfo) error {
+	if backend != nil && fPort != 0 {
+		if isWifiFPort(fPort) {
+			return handleWifiScan(ctx, d, app, data, rxInfo)
+		}
+		if isGNSSFPort(fPort) {
+			return handleGNSS(ctx, d, app, data)
+		}
+	}
+
+	rssiFallback := config.C.ApplicationServer.Geolocation.RSSIFallback
+
+	frame := geo.FrameRXInfo{
+		RxInfo: getTDOARXInfo(rxInfo),
+	}
+	if backend == nil || len(frame.RxInfo) < minTDOAGateways {
+		if !rssiFallback.Enabled {
+			return nil
+		}
+		return handleRSSIFallback(d, app, rxInfo)
+	}
+
+	frames := []geo.FrameRXInfo{frame}
+
+	// when the application requires more than one frame, the frames must be
+	// buffered, also when no frame buffer TTL has been configured
+	ttl := config.C.ApplicationServer.Geolocation.FrameBufferTTL
+	if ttl == 0 && app.GeolocationBufferFrames > 1 {
+		ttl = defaultFrameBufferTTL
+	}
+
+	if ttl != 0 {
+		if err := saveFrame(config.C.Redis.Pool, d.DevEUI, frame, ttl); err != nil {
+			return errors.Wrap(err, "save frame error")
+		}
+
+		var err error
+		frames, err = getFrames(config.C.Redis.Pool, d.DevEUI, ttl)
+		if err != nil {
+			return errors.Wrap(err, "get frames error")
+		}
+	}
+
+	if len(frames) < app.GeolocationBufferFrames {
+		log.WithFields(log.Fields{
+			"dev_eui":       d.DevEUI,
+			"frames":        len(frames),
+			"buffer_frames": app.GeolocationBufferFrames,
+		}).Debug("geolocation: waiting for more frames")
+		return nil
+	}
+
+	if ok, err := acquireResolveLock(config.C.Redis.Pool, d.DevEUI, app); err != nil || !ok {
+		return err
+	}
+
+	// the buffered frames have been consumed, the next resolve must wait
+	// for a new set of frames
+	if app.GeolocationBufferFrames > 1 {
+		if err := deleteFrames(config.C.Redis.Pool, d.DevEUI); err != nil {
+			return errors.Wrap(err, "delete frames error")
+		}
+	}
+
+	loc, err := backend.ResolveTDOA(ctx, d.DevEUI, frames)
+	if err != nil {
+		if errors.Cause(err) == ErrNoLocation {
+			log.WithField("dev_eui", d.DevEUI).Debug("geolocation: no location resolved")
+			return nil
+		}
+		return errors.Wrap(err, "resolve tdoa error")
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":  d.DevEUI,
+		"frames":   len(frames),
+		"accuracy": loc.Accuracy,
+	}).Info("geolocation: location resolved")
+
+	return setDeviceLocation(d, app, loc)
+}
+
+// handleRSSIFallback estimates the device location using the RSSI of the
+// receiving gateways. This is used when no (or not enough) fine-timestamp
+// data is available.
+func handleRSSIFallback(d storage.Device, app storage.Application, rxInfo []*gw.UplinkRXInfo) error {
+	conf := config.C.ApplicationServer.Geolocation.RSSIFallback
+
+	loc, err := resolveRSSI(rssiConfig{
+		PathLossExponent: conf.PathLossExponent,
+		ReferenceRSSI:    conf.ReferenceRSSI,
+		MinGateways:      conf.MinGateways,
+	}, rxInfo)
+	if err != nil {
+		if err == ErrNoLocation {
+			return nil
+		}
+		return errors.Wrap(err, "resolve rssi error")
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":  d.DevEUI,
+		"accuracy": loc.Accuracy,
+	}).Info("geolocation: location estimated using rssi")
+
+	return setDeviceLocation(d, app, loc)
+}
+
+// getTDOARXInfo returns the RX meta-data which can be used for TDOA, meaning
+// that both the gateway location and the fine-timestamp are available.
+func getTDOARXInfo(rxInfo []*gw.UplinkRXInfo) []*gw.UplinkRXInfo {
+	var out []*gw.UplinkRXInfo
+	for i := range rxInfo {
+		if rxInfo[i].Location == nil || rxInfo[i].FineTimestamp == nil {
+			continue
+		}
+		out = append(out, rxInfo[i])
+	}
+	return out
+}
+
+// SetDeviceLocation stores the given location, resolved by an external
+// service (e.g. the LoRa Cloud integration), as the location of the given
+// device and sends it as location notification to the integrations.
+func SetDeviceLocation(devEUI lorawan.EUI64, loc common.Location) error {
+	d, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI, false, true)
+	if err != nil {
+		return errors.Wrap(err, "get device error")
+	}
+
+	app, err := storage.GetApplication(config.C.PostgreSQL.DB, d.ApplicationID, false)
+	if err != nil {
+		return errors.Wrap(err, "get application error")
+	}
+
+	return setDeviceLocation(d, app, loc)
+}
+
+func setDeviceLocation(d storage.Device, app storage.Application, loc common.Location) error {
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		var err error
+		d, err = storage.GetDevice(tx, d.DevEUI, true, true)
+		if err != nil {
+			return errors.Wrap(err, "get device error")
+		}
+
+		d.Latitude = &loc.Latitude
+		d.Longitude = &loc.Longitude
+		d.Altitude = &loc.Altitude
+
+		if err = storage.UpdateDevice(tx, &d, true); err != nil {
+			return errors.Wrap(err, "update device error")
+		}
+
+		err = storage.CreateDeviceLocation(tx, &storage.DeviceLocation{
+			DevEUI:    d.DevEUI,
+			Latitude:  loc.Latitude,
+			Longitude: loc.Longitude,
+			Altitude:  loc.Altitude,
+			Accuracy:  int(loc.Accuracy),
+			Source:    loc.Source.String(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "create device-location error")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	pl := handler.LocationNotification{
+		ApplicationID:   app.ID,
+		ApplicationName: app.Name,
+		DeviceName:      d.Name,
+		DevEUI:          d.DevEUI,
+		Location: handler.Location{
+			Latitude:  loc.Latitude,
+			Longitude: loc.Longitude,
+			Altitude:  loc.Altitude,
+			Accuracy:  loc.Accuracy,
+			Source:    loc.Source.String(),
+		},
+	}
+
+	err = eventlog.LogEventForDevice(d.DevEUI, eventlog.EventLog{
+		Type:    eventlog.Location,
+		Payload: pl,
+	})
+	if err != nil {
+		log.WithError(err).Error("log event for device error")
+	}
+
+	if !app.IsArchived() {
+		err = config.C.ApplicationServer.Integration.Handler.SendLocationNotification(pl)
+		if err != nil {
+			return errors.Wrap(err, "send location notification to handler error")
+		}
+	}
+
+	return nil
+}
+
+// saveFrame adds the given frame to the frame buffer of the device.
+// Frames older than the given TTL are removed from the buffer.
+func saveFrame(p *redis.Pool, devEUI lorawan.EUI64, frame geo.FrameRXInfo, ttl time.Duration) error {
+	b, err := proto.Marshal(&frame)
+	if err != nil {
+		return errors.Wrap(err, "protobuf marshal error")
+	}
+
+	c := p.Get()
+	defer c.Close()
+
+	key := fmt.Sprintf(frameBufferKeyTempl, devEUI)
+	now := time.Now()
+
+	// prefix the frame with the timestamp so that identical frames are
+	// stored as separate members
+	member := make([]byte, 8, 8+len(b))
+	binary.BigEndian.PutUint64(member, uint64(now.UnixNano()))
+	member = append(member, b...)
+
+	c.Send("MULTI")
+	c.Send("ZADD", key, now.UnixNano(), member)
+	c.Send("ZREMRANGEBYSCORE", key, 0, now.Add(-ttl).UnixNano())
+	c.Send("PEXPIRE", key, int64(ttl/time.Millisecond))
+	if _, err := c.Do("EXEC"); err != nil {
+		return errors.Wrap(err, "redis exec error")
+	}
+
+	return nil
+}
+
+// deleteFrames deletes the frame buffer of the device.
+func deleteFrames(p *redis.Pool, devEUI lorawan.EUI64) error {
+	c := p.Get()
+	defer c.Close()
+
+	_, err := c.Do("DEL", fmt.Sprintf(frameBufferKeyTempl, devEUI))
+	if err != nil {
+		return errors.Wrap(err, "redis del error")
+	}
+
+	return nil
+}
+
+// acquireResolveLock returns true when the location of the device may be
+// resolved, taking the minimum resolve interval of the application into
+// account. When true is returned, the next resolve is blocked until the
+// interval has expired.
+func acquireResolveLock(p *redis.Pool, devEUI lorawan.EUI64, app storage.Application) (bool, error) {
+	if app.GeolocationMinInterval == 0 {
+		return true, nil
+	}
+
+	c := p.Get()
+	defer c.Close()
+
+	key := fmt.Sprintf(resolveLockKeyTempl, devEUI)
+	interval := time.Duration(app.GeolocationMinInterval) * time.Second
+
+	_, err := redis.String(c.Do("SET", key, "lock", "PX", int64(interval/time.Millisecond), "NX"))
+	if err != nil {
+		if err == redis.ErrNil {
+			log.WithFields(log.Fields{
+				"dev_eui":      devEUI,
+				"min_interval": interval,
+			}).Debug("geolocation: min resolve interval has not yet expired")
+			return false, nil
+		}
+		return false, errors.Wrap(err, "acquire resolve lock error")
+	}
+
+	return true, nil
+}
+
+// getFrames returns the buffered frames of the device which are not older
+// than the given TTL.
+func getFrames(p *redis.Pool, devEUI lorawan.EUI64, ttl time.Duration) ([]geo.FrameRXInfo, error) {
+	c := p.Get()
+	defer c.Close()
+
+	key := fmt.Sprintf(frameBufferKeyTempl, devEUI)
+	bs, err := redis.ByteSlices(c.Do("ZRANGEBYSCORE", key, time.Now().Add(-ttl).UnixNano(), "+inf"))
+	if err != nil {
+		return nil, errors.Wrap(err, "redis zrangebyscore error")
+	}
+
+	var out []geo.FrameRXInfo
+	for _, b := range bs {
+		if len(b) < 8 {
+			continue
+		}
+
+		var frame geo.FrameRXInfo
+		if err := proto.Unmarshal(b[8:], &frame); err != nil {
+			return nil, errors.Wrap(err, "protobuf unmarshal error")
+		}
+		out = append(out, frame)
+	}
+
+	return out, nil
+}
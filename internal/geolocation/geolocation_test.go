@@ -0,0 +1,252 @@
+package geolocation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lora-app-server/internal/test/testhandler"
+	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/loraserver/api/geo"
+	"github.com/brocaar/loraserver/api/gw"
+	"github.com/brocaar/lorawan"
+)
+
+type testBackend struct {
+	frames   [][]geo.FrameRXInfo
+	location common.Location
+	err      error
+}
+
+func (b *testBackend) ResolveTDOA(ctx context.Context, devEUI lorawan.EUI64, frames []geo.FrameRXInfo) (common.Location, error) {
+	b.frames = append(b.frames, frames)
+	return b.location, b.err
+}
+
+func TestHandleUplink(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nsClient := test.NewNetworkServerClient()
+
+	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL, 10, 0)
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	Convey("Given a clean database with a device and a test backend", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+		test.MustFlushRedis(config.C.Redis.Pool)
+
+		h := testhandler.NewTestHandler()
+		config.C.ApplicationServer.Integration.Handler = h
+		config.C.ApplicationServer.Geolocation.FrameBufferTTL = 0
+		config.C.ApplicationServer.Geolocation.RSSIFallback.Enabled = false
+
+		b := testBackend{
+			location: common.Location{
+				Latitude:  1.123,
+				Longitude: 2.123,
+				Altitude:  3.123,
+				Accuracy:  10,
+				Source:    common.LocationSource_GEO_RESOLVER,
+			},
+		}
+		SetBackend(&b)
+		defer SetBackend(nil)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			Name:            "test-sp",
+		}
+		So(storage.CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+		spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+		So(err, ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			Name:            "test-dp",
+		}
+		So(storage.CreateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+		dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+		So(err, ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: spID,
+			Name:             "test-app",
+		}
+		So(storage.CreateApplication(config.C.PostgreSQL.DB, &app), ShouldBeNil)
+
+		d := storage.Device{
+			ApplicationID:   app.ID,
+			Name:            "test-node",
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			DeviceProfileID: dpID,
+		}
+		So(storage.CreateDevice(config.C.PostgreSQL.DB, &d), ShouldBeNil)
+
+		var rxInfo []*gw.UplinkRXInfo
+		for i := 0; i < 3; i++ {
+			rxInfo = append(rxInfo, &gw.UplinkRXInfo{
+				GatewayId: []byte{byte(i), 2, 3, 4, 5, 6, 7, 8},
+				Location:  &common.Location{},
+				FineTimestamp: &gw.UplinkRXInfo_EncryptedFineTimestamp{
+					EncryptedFineTimestamp: &gw.EncryptedFineTimestamp{
+						EncryptedNs: []byte{byte(i)},
+					},
+				},
+			})
+		}
+
+		Convey("When less than three gateways provide a fine-timestamp", func() {
+			rxInfo[0].FineTimestamp = nil
+			So(HandleUplink(context.Background(), d, app, 1, nil, rxInfo), ShouldBeNil)
+
+			Convey("Then the backend was not called", func() {
+				So(b.frames, ShouldHaveLength, 0)
+				So(h.SendLocationNotificationChan, ShouldHaveLength, 0)
+			})
+		})
+
+		Convey("Given the RSSI fallback is enabled", func() {
+			config.C.ApplicationServer.Geolocation.RSSIFallback.Enabled = true
+			config.C.ApplicationServer.Geolocation.RSSIFallback.PathLossExponent = 2.7
+			config.C.ApplicationServer.Geolocation.RSSIFallback.ReferenceRSSI = -40
+			config.C.ApplicationServer.Geolocation.RSSIFallback.MinGateways = 1
+
+			Convey("When no gateway provides a fine-timestamp", func() {
+				for i := range rxInfo {
+					rxInfo[i].FineTimestamp = nil
+					rxInfo[i].Rssi = -120
+					rxInfo[i].Location = &common.Location{
+						Latitude:  52.3740364 + float64(i)*0.01,
+						Longitude: 4.9144401,
+					}
+				}
+				So(HandleUplink(context.Background(), d, app, 1, nil, rxInfo), ShouldBeNil)
+
+				Convey("Then the backend was not called", func() {
+					So(b.frames, ShouldHaveLength, 0)
+				})
+
+				Convey("Then a location notification with accuracy was sent", func() {
+					So(h.SendLocationNotificationChan, ShouldHaveLength, 1)
+					pl := <-h.SendLocationNotificationChan
+					So(pl.Location.Source, ShouldEqual, "GEO_RESOLVER")
+					So(pl.Location.Accuracy, ShouldBeGreaterThan, 0)
+				})
+			})
+		})
+
+		Convey("When three gateways provide a fine-timestamp", func() {
+			So(HandleUplink(context.Background(), d, app, 1, nil, rxInfo), ShouldBeNil)
+
+			Convey("Then the backend was called with a single frame", func() {
+				So(b.frames, ShouldHaveLength, 1)
+				So(b.frames[0], ShouldHaveLength, 1)
+			})
+
+			Convey("Then the location notification was sent", func() {
+				So(h.SendLocationNotificationChan, ShouldHaveLength, 1)
+				So(<-h.SendLocationNotificationChan, ShouldResemble, handler.LocationNotification{
+					ApplicationID:   app.ID,
+					ApplicationName: app.Name,
+					DeviceName:      d.Name,
+					DevEUI:          d.DevEUI,
+					Location: handler.Location{
+						Latitude:  1.123,
+						Longitude: 2.123,
+						Altitude:  3.123,
+						Accuracy:  10,
+						Source:    "GEO_RESOLVER",
+					},
+				})
+			})
+
+			Convey("Then the device location was updated", func() {
+				d, err := storage.GetDevice(config.C.PostgreSQL.DB, d.DevEUI, false, true)
+				So(err, ShouldBeNil)
+				So(*d.Latitude, ShouldEqual, 1.123)
+				So(*d.Longitude, ShouldEqual, 2.123)
+				So(*d.Altitude, ShouldEqual, 3.123)
+			})
+		})
+
+		Convey("When the backend does not resolve a location", func() {
+			b.err = ErrNoLocation
+			So(HandleUplink(context.Background(), d, app, 1, nil, rxInfo), ShouldBeNil)
+
+			Convey("Then no location notification was sent", func() {
+				So(h.SendLocationNotificationChan, ShouldHaveLength, 0)
+			})
+		})
+
+		Convey("Given the application requires two buffered frames", func() {
+			app.GeolocationBufferFrames = 2
+
+			Convey("When handling three uplinks", func() {
+				So(HandleUplink(context.Background(), d, app, 1, nil, rxInfo), ShouldBeNil)
+				So(b.frames, ShouldHaveLength, 0)
+				So(HandleUplink(context.Background(), d, app, 1, nil, rxInfo), ShouldBeNil)
+				So(HandleUplink(context.Background(), d, app, 1, nil, rxInfo), ShouldBeNil)
+
+				Convey("Then the backend was called once with both frames", func() {
+					So(b.frames, ShouldHaveLength, 1)
+					So(b.frames[0], ShouldHaveLength, 2)
+				})
+			})
+		})
+
+		Convey("Given the application has a min resolve interval", func() {
+			app.GeolocationMinInterval = 60
+
+			Convey("When handling two uplinks", func() {
+				So(HandleUplink(context.Background(), d, app, 1, nil, rxInfo), ShouldBeNil)
+				So(HandleUplink(context.Background(), d, app, 1, nil, rxInfo), ShouldBeNil)
+
+				Convey("Then the backend was called once", func() {
+					So(b.frames, ShouldHaveLength, 1)
+					So(h.SendLocationNotificationChan, ShouldHaveLength, 1)
+				})
+			})
+		})
+
+		Convey("Given a frame buffer TTL", func() {
+			config.C.ApplicationServer.Geolocation.FrameBufferTTL = time.Minute
+
+			Convey("When handling two uplinks", func() {
+				So(HandleUplink(context.Background(), d, app, 1, nil, rxInfo), ShouldBeNil)
+				So(HandleUplink(context.Background(), d, app, 1, nil, rxInfo), ShouldBeNil)
+
+				Convey("Then the second resolve contains both frames", func() {
+					So(b.frames, ShouldHaveLength, 2)
+					So(b.frames[0], ShouldHaveLength, 1)
+					So(b.frames[1], ShouldHaveLength, 2)
+				})
+			})
+		})
+	})
+}
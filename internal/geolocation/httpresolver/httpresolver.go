@@ -0,0 +1,202 @@
+// Package httpresolver implements a plug-in geolocation backend, forwarding
+// the frame meta-data to a HTTP endpoint which resolves the location.
+//
+// The endpoint receives a POST request containing the request type ("tdoa",
+// "wifi" or "gnss"), the DevEUI and the data to resolve (e.g. the JSON encoded
+// geo.FrameRXInfo of each frame for TDOA). It is expected to respond with a
+// JSON encoded geo.ResolveTDOAResponse. An empty result means that the
+// location could not be resolved.
+package httpresolver
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lora-app-server/internal/geolocation"
+	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/loraserver/api/geo"
+	"github.com/brocaar/loraserver/api/gw"
+	"github.com/brocaar/lorawan"
+)
+
+// Config contains the HTTP resolver configuration.
+type Config struct {
+	// URL of the resolver endpoint.
+	URL string
+
+	// Headers to add to each request.
+	Headers map[string]string
+
+	// RequestTimeout defines the timeout of each request.
+	RequestTimeout time.Duration
+}
+
+// Backend implements the HTTP resolver geolocation backend.
+type Backend struct {
+	config Config
+}
+
+// NewBackend creates a new HTTP resolver geolocation backend.
+func NewBackend(conf Config) *Backend {
+	return &Backend{
+		config: conf,
+	}
+}
+
+const (
+	requestTypeTDOA = "tdoa"
+	requestTypeWifi = "wifi"
+	requestTypeGNSS = "gnss"
+)
+
+type resolveTDOARequest struct {
+	Type   string            `json:"type"`
+	DevEUI lorawan.EUI64     `json:"devEUI"`
+	Frames []json.RawMessage `json:"frames"`
+}
+
+type resolveWifiRequest struct {
+	Type         string            `json:"type"`
+	DevEUI       lorawan.EUI64     `json:"devEUI"`
+	AccessPoints []wifiAccessPoint `json:"accessPoints"`
+	RXInfo       []json.RawMessage `json:"rxInfo"`
+}
+
+type wifiAccessPoint struct {
+	MACAddress string `json:"macAddress"`
+	RSSI       int    `json:"rssi"`
+}
+
+type resolveGNSSRequest struct {
+	Type           string          `json:"type"`
+	DevEUI         lorawan.EUI64   `json:"devEUI"`
+	Payload        string          `json:"payload"`
+	AssistLocation json.RawMessage `json:"assistLocation,omitempty"`
+}
+
+// ResolveTDOA resolves the device location using the TDOA of the given
+// frames.
+func (b *Backend) ResolveTDOA(ctx context.Context, devEUI lorawan.EUI64, frames []geo.FrameRXInfo) (common.Location, error) {
+	var m jsonpb.Marshaler
+	req := resolveTDOARequest{
+		Type:   requestTypeTDOA,
+		DevEUI: devEUI,
+	}
+
+	for i := range frames {
+		str, err := m.MarshalToString(&frames[i])
+		if err != nil {
+			return common.Location{}, errors.Wrap(err, "marshal frame error")
+		}
+		req.Frames = append(req.Frames, json.RawMessage(str))
+	}
+
+	return b.resolve(ctx, req)
+}
+
+// ResolveWifi resolves the device location using the given Wi-Fi
+// access-points.
+func (b *Backend) ResolveWifi(ctx context.Context, devEUI lorawan.EUI64, accessPoints []geolocation.WifiAccessPoint, rxInfo []*gw.UplinkRXInfo) (common.Location, error) {
+	var m jsonpb.Marshaler
+	req := resolveWifiRequest{
+		Type:   requestTypeWifi,
+		DevEUI: devEUI,
+	}
+
+	for _, ap := range accessPoints {
+		req.AccessPoints = append(req.AccessPoints, wifiAccessPoint{
+			MACAddress: ap.String(),
+			RSSI:       ap.RSSI,
+		})
+	}
+
+	for i := range rxInfo {
+		str, err := m.MarshalToString(rxInfo[i])
+		if err != nil {
+			return common.Location{}, errors.Wrap(err, "marshal rx-info error")
+		}
+		req.RXInfo = append(req.RXInfo, json.RawMessage(str))
+	}
+
+	return b.resolve(ctx, req)
+}
+
+// ResolveGNSS resolves the device location using the given GNSS-NAV
+// payload.
+func (b *Backend) ResolveGNSS(ctx context.Context, devEUI lorawan.EUI64, payload []byte, assist *common.Location) (common.Location, error) {
+	var m jsonpb.Marshaler
+	req := resolveGNSSRequest{
+		Type:    requestTypeGNSS,
+		DevEUI:  devEUI,
+		Payload: hex.EncodeToString(payload),
+	}
+
+	if assist != nil {
+		str, err := m.MarshalToString(assist)
+		if err != nil {
+			return common.Location{}, errors.Wrap(err, "marshal assist location error")
+		}
+		req.AssistLocation = json.RawMessage(str)
+	}
+
+	return b.resolve(ctx, req)
+}
+
+func (b *Backend) resolve(ctx context.Context, req interface{}) (common.Location, error) {
+	bb, err := json.Marshal(req)
+	if err != nil {
+		return common.Location{}, errors.Wrap(err, "marshal json error")
+	}
+
+	httpReq, err := http.NewRequest("POST", b.config.URL, bytes.NewReader(bb))
+	if err != nil {
+		return common.Location{}, errors.Wrap(err, "new request error")
+	}
+
+	if b.config.RequestTimeout != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, b.config.RequestTimeout)
+		defer cancel()
+	}
+
+	httpReq = httpReq.WithContext(ctx)
+	httpReq.Header.Set("Content-Type", "application/json")
+	for k, v := range b.config.Headers {
+		httpReq.Header.Set(k, v)
+	}
+
+	httpResp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return common.Location{}, errors.Wrap(err, "http request error")
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode < 200 || httpResp.StatusCode >= 300 {
+		return common.Location{}, fmt.Errorf("expected 2XX response, got: %d", httpResp.StatusCode)
+	}
+
+	var resp geo.ResolveTDOAResponse
+	u := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err := u.Unmarshal(httpResp.Body, &resp); err != nil {
+		return common.Location{}, errors.Wrap(err, "unmarshal json error")
+	}
+
+	if resp.Result == nil || resp.Result.Location == nil {
+		return common.Location{}, geolocation.ErrNoLocation
+	}
+
+	loc := *resp.Result.Location
+	if loc.Source == common.LocationSource_UNKNOWN {
+		loc.Source = common.LocationSource_GEO_RESOLVER
+	}
+
+	return loc, nil
+}
@@ -0,0 +1,126 @@
+package httpresolver
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/geolocation"
+	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/loraserver/api/geo"
+	"github.com/brocaar/loraserver/api/gw"
+	"github.com/brocaar/lorawan"
+)
+
+type testServer struct {
+	request  *http.Request
+	body     []byte
+	response string
+}
+
+func (ts *testServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ts.request = r
+	ts.body, _ = ioutil.ReadAll(r.Body)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(ts.response))
+}
+
+func TestResolveTDOA(t *testing.T) {
+	Convey("Given a test server and HTTP resolver backend", t, func() {
+		ts := testServer{}
+		server := httptest.NewServer(&ts)
+		defer server.Close()
+
+		b := NewBackend(Config{
+			URL: server.URL + "/resolve",
+			Headers: map[string]string{
+				"Authorization": "Bearer secret",
+			},
+		})
+
+		frame := geo.FrameRXInfo{
+			RxInfo: []*gw.UplinkRXInfo{
+				{
+					GatewayId: []byte{1, 2, 3, 4, 5, 6, 7, 8},
+					Rssi:      -60,
+				},
+			},
+		}
+
+		Convey("When the resolver returns a location", func() {
+			ts.response = `{"result": {"location": {"latitude": 1.1, "longitude": 2.2, "altitude": 3.3, "accuracy": 15}}}`
+			loc, err := b.ResolveTDOA(context.Background(), lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, []geo.FrameRXInfo{frame})
+			So(err, ShouldBeNil)
+
+			Convey("Then the expected request was made", func() {
+				So(ts.request.URL.Path, ShouldEqual, "/resolve")
+				So(ts.request.Header.Get("Authorization"), ShouldEqual, "Bearer secret")
+
+				var req struct {
+					Type   string            `json:"type"`
+					DevEUI string            `json:"devEUI"`
+					Frames []json.RawMessage `json:"frames"`
+				}
+				So(json.Unmarshal(ts.body, &req), ShouldBeNil)
+				So(req.Type, ShouldEqual, "tdoa")
+				So(req.DevEUI, ShouldEqual, "0102030405060708")
+				So(req.Frames, ShouldHaveLength, 1)
+				So(string(req.Frames[0]), ShouldEqual, `{"rxInfo":[{"gatewayID":"AQIDBAUGBwg=","rssi":-60}]}`)
+			})
+
+			Convey("Then the expected location is returned", func() {
+				So(loc, ShouldResemble, common.Location{
+					Latitude:  1.1,
+					Longitude: 2.2,
+					Altitude:  3.3,
+					Accuracy:  15,
+					Source:    common.LocationSource_GEO_RESOLVER,
+				})
+			})
+		})
+
+		Convey("When the resolver returns an empty result", func() {
+			ts.response = `{}`
+			_, err := b.ResolveTDOA(context.Background(), lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, []geo.FrameRXInfo{frame})
+
+			Convey("Then ErrNoLocation is returned", func() {
+				So(err, ShouldEqual, geolocation.ErrNoLocation)
+			})
+		})
+	})
+}
+
+func TestResolveGNSS(t *testing.T) {
+	Convey("Given a test server and HTTP resolver backend", t, func() {
+		ts := testServer{}
+		server := httptest.NewServer(&ts)
+		defer server.Close()
+
+		b := NewBackend(Config{
+			URL: server.URL + "/resolve",
+		})
+
+		Convey("When resolving a GNSS-NAV payload", func() {
+			ts.response = `{"result": {"location": {"latitude": 1.1, "longitude": 2.2, "source": "GPS"}}}`
+			loc, err := b.ResolveGNSS(context.Background(), lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, []byte{1, 2, 3}, &common.Location{Latitude: 1, Longitude: 2})
+			So(err, ShouldBeNil)
+
+			Convey("Then the expected request was made", func() {
+				So(string(ts.body), ShouldEqual, `{"type":"gnss","devEUI":"0102030405060708","payload":"010203","assistLocation":{"latitude":1,"longitude":2}}`)
+			})
+
+			Convey("Then the expected location is returned", func() {
+				So(loc, ShouldResemble, common.Location{
+					Latitude:  1.1,
+					Longitude: 2.2,
+					Source:    common.LocationSource_GPS,
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,251 @@
+// Package loracloud implements a geolocation backend for the Semtech LoRa
+// Cloud Geolocation API. As this API is Collos compatible, this backend can
+// also be used with Collos (compatible) geolocation services.
+package loracloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lora-app-server/internal/geolocation"
+	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/loraserver/api/geo"
+	"github.com/brocaar/loraserver/api/gw"
+	"github.com/brocaar/lorawan"
+)
+
+const (
+	tdoaSingleFrameEndpoint = "/tdoa"
+	tdoaMultiFrameEndpoint  = "/tdoaMultiframe"
+	wifiEndpoint            = "/loraWifi"
+)
+
+// Config contains the LoRa Cloud backend configuration.
+type Config struct {
+	// URI of the API (e.g. https://gls.loracloud.com/api/v2).
+	URI string
+
+	// GNSSURI defines the URI of the GNSS solver API (e.g.
+	// https://gls.loracloud.com/api/v3/solve/gnss_lr1110_singleframe).
+	// When empty, GNSS geolocation is not available.
+	GNSSURI string
+
+	// Token (subscription key).
+	Token string
+
+	// RequestTimeout defines the timeout of each API request.
+	RequestTimeout time.Duration
+}
+
+// Backend implements the LoRa Cloud geolocation backend.
+type Backend struct {
+	config Config
+}
+
+// NewBackend creates a new LoRa Cloud geolocation backend.
+func NewBackend(conf Config) *Backend {
+	conf.URI = strings.TrimRight(conf.URI, "/")
+
+	return &Backend{
+		config: conf,
+	}
+}
+
+// ResolveTDOA resolves the device location using the TDOA of the given
+// frames. When more than one frame is given, the multi-frame API is used.
+func (b *Backend) ResolveTDOA(ctx context.Context, devEUI lorawan.EUI64, frames []geo.FrameRXInfo) (common.Location, error) {
+	if len(frames) == 0 {
+		return common.Location{}, geolocation.ErrNoLocation
+	}
+
+	var endpoint string
+	var req interface{}
+
+	if len(frames) == 1 {
+		endpoint = tdoaSingleFrameEndpoint
+		req = tdoaSingleFrameRequest{
+			LoRaWAN: getUplinkTDOA(frames[0].RxInfo),
+		}
+	} else {
+		endpoint = tdoaMultiFrameEndpoint
+		mf := tdoaMultiFrameRequest{}
+		for _, f := range frames {
+			mf.LoRaWAN = append(mf.LoRaWAN, getUplinkTDOA(f.RxInfo))
+		}
+		req = mf
+	}
+
+	var resp response
+	if err := b.request(ctx, b.config.URI+endpoint, req, &resp); err != nil {
+		return common.Location{}, err
+	}
+
+	if len(resp.Errors) != 0 {
+		return common.Location{}, fmt.Errorf("api returned errors: %s", strings.Join(resp.Errors, ", "))
+	}
+
+	if resp.Result == nil {
+		return common.Location{}, geolocation.ErrNoLocation
+	}
+
+	return common.Location{
+		Latitude:  resp.Result.Latitude,
+		Longitude: resp.Result.Longitude,
+		Altitude:  resp.Result.Altitude,
+		Accuracy:  uint32(resp.Result.Accuracy),
+		Source:    common.LocationSource_GEO_RESOLVER,
+	}, nil
+}
+
+// ResolveWifi resolves the device location using the given Wi-Fi
+// access-points.
+func (b *Backend) ResolveWifi(ctx context.Context, devEUI lorawan.EUI64, accessPoints []geolocation.WifiAccessPoint, rxInfo []*gw.UplinkRXInfo) (common.Location, error) {
+	req := wifiRequest{
+		LoRaWAN: getUplinkTDOA(rxInfo),
+	}
+	for _, ap := range accessPoints {
+		req.WifiAccessPoints = append(req.WifiAccessPoints, wifiAccessPoint{
+			MACAddress:     ap.String(),
+			SignalStrength: ap.RSSI,
+		})
+	}
+
+	var resp response
+	if err := b.request(ctx, b.config.URI+wifiEndpoint, req, &resp); err != nil {
+		return common.Location{}, err
+	}
+
+	if len(resp.Errors) != 0 {
+		return common.Location{}, fmt.Errorf("api returned errors: %s", strings.Join(resp.Errors, ", "))
+	}
+
+	if resp.Result == nil {
+		return common.Location{}, geolocation.ErrNoLocation
+	}
+
+	return common.Location{
+		Latitude:  resp.Result.Latitude,
+		Longitude: resp.Result.Longitude,
+		Altitude:  resp.Result.Altitude,
+		Accuracy:  uint32(resp.Result.Accuracy),
+		Source:    common.LocationSource_GEO_RESOLVER,
+	}, nil
+}
+
+// ResolveGNSS resolves the device location using the given GNSS-NAV
+// payload.
+func (b *Backend) ResolveGNSS(ctx context.Context, devEUI lorawan.EUI64, payload []byte, assist *common.Location) (common.Location, error) {
+	if b.config.GNSSURI == "" {
+		return common.Location{}, errors.New("gnss uri is not configured")
+	}
+
+	req := gnssRequest{
+		Payload: hex.EncodeToString(payload),
+	}
+	if assist != nil {
+		req.GNSSAssistPosition = []float64{assist.Latitude, assist.Longitude}
+	}
+
+	var resp gnssResponse
+	if err := b.request(ctx, b.config.GNSSURI, req, &resp); err != nil {
+		return common.Location{}, err
+	}
+
+	if len(resp.Errors) != 0 {
+		return common.Location{}, fmt.Errorf("api returned errors: %s", strings.Join(resp.Errors, ", "))
+	}
+
+	if resp.Result == nil || len(resp.Result.LLH) != 3 {
+		return common.Location{}, geolocation.ErrNoLocation
+	}
+
+	return common.Location{
+		Latitude:  resp.Result.LLH[0],
+		Longitude: resp.Result.LLH[1],
+		Altitude:  resp.Result.LLH[2],
+		Accuracy:  uint32(resp.Result.Accuracy),
+		Source:    common.LocationSource_GPS,
+	}, nil
+}
+
+func (b *Backend) request(ctx context.Context, url string, req, resp interface{}) error {
+	bb, err := json.Marshal(req)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	httpReq, err := http.NewRequest("POST", url, bytes.NewReader(bb))
+	if err != nil {
+		return errors.Wrap(err, "new request error")
+	}
+
+	if b.config.RequestTimeout != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, b.config.RequestTimeout)
+		defer cancel()
+	}
+
+	httpReq = httpReq.WithContext(ctx)
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Ocp-Apim-Subscription-Key", b.config.Token)
+
+	httpResp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return errors.Wrap(err, "http request error")
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return fmt.Errorf("expected 200, got: %d", httpResp.StatusCode)
+	}
+
+	if err := json.NewDecoder(httpResp.Body).Decode(resp); err != nil {
+		return errors.Wrap(err, "unmarshal json error")
+	}
+
+	return nil
+}
+
+func getUplinkTDOA(rxInfo []*gw.UplinkRXInfo) []uplinkTDOA {
+	var out []uplinkTDOA
+
+	for _, rx := range rxInfo {
+		u := uplinkTDOA{
+			GatewayID: hex.EncodeToString(rx.GatewayId),
+			AntennaID: int(rx.Antenna),
+			RSSI:      float64(rx.Rssi),
+			SNR:       rx.LoraSnr,
+		}
+
+		if rx.Location != nil {
+			u.AntennaLocation = antennaLocation{
+				Latitude:  rx.Location.Latitude,
+				Longitude: rx.Location.Longitude,
+				Altitude:  rx.Location.Altitude,
+			}
+		}
+
+		switch ts := rx.FineTimestamp.(type) {
+		case *gw.UplinkRXInfo_PlainFineTimestamp:
+			if t, err := ptypes.Timestamp(ts.PlainFineTimestamp.GetTime()); err == nil {
+				toa := uint32(t.Nanosecond())
+				u.TOA = &toa
+			}
+		case *gw.UplinkRXInfo_EncryptedFineTimestamp:
+			u.EncryptedTOA = ts.EncryptedFineTimestamp.EncryptedNs
+		}
+
+		out = append(out, u)
+	}
+
+	return out
+}
@@ -0,0 +1,276 @@
+package loracloud
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/geolocation"
+	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/loraserver/api/geo"
+	"github.com/brocaar/loraserver/api/gw"
+	"github.com/brocaar/lorawan"
+)
+
+type testServer struct {
+	request  *http.Request
+	body     []byte
+	response string
+}
+
+func (ts *testServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ts.request = r
+	ts.body, _ = ioutil.ReadAll(r.Body)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(ts.response))
+}
+
+func TestResolveTDOA(t *testing.T) {
+	Convey("Given a test server and LoRa Cloud backend", t, func() {
+		ts := testServer{}
+		server := httptest.NewServer(&ts)
+		defer server.Close()
+
+		b := NewBackend(Config{
+			URI:            server.URL + "/",
+			Token:          "secret",
+			RequestTimeout: time.Second,
+		})
+
+		fineTime := time.Date(2018, 10, 15, 12, 0, 0, 1234, time.UTC)
+		fineTimeProto, err := ptypes.TimestampProto(fineTime)
+		So(err, ShouldBeNil)
+
+		frame := geo.FrameRXInfo{
+			RxInfo: []*gw.UplinkRXInfo{
+				{
+					GatewayId: []byte{1, 2, 3, 4, 5, 6, 7, 8},
+					Rssi:      -60,
+					LoraSnr:   5.5,
+					Antenna:   1,
+					Location: &common.Location{
+						Latitude:  1.123,
+						Longitude: 2.123,
+						Altitude:  3.123,
+					},
+					FineTimestampType: gw.FineTimestampType_PLAIN,
+					FineTimestamp: &gw.UplinkRXInfo_PlainFineTimestamp{
+						PlainFineTimestamp: &gw.PlainFineTimestamp{
+							Time: fineTimeProto,
+						},
+					},
+				},
+				{
+					GatewayId: []byte{2, 2, 3, 4, 5, 6, 7, 8},
+					Rssi:      -70,
+					LoraSnr:   2,
+					Location: &common.Location{
+						Latitude:  4.123,
+						Longitude: 5.123,
+						Altitude:  6.123,
+					},
+					FineTimestampType: gw.FineTimestampType_ENCRYPTED,
+					FineTimestamp: &gw.UplinkRXInfo_EncryptedFineTimestamp{
+						EncryptedFineTimestamp: &gw.EncryptedFineTimestamp{
+							EncryptedNs: []byte{1, 2, 3, 4},
+						},
+					},
+				},
+			},
+		}
+
+		toa := uint32(1234)
+		expectedUplinkTDOA := []uplinkTDOA{
+			{
+				GatewayID: "0102030405060708",
+				AntennaID: 1,
+				RSSI:      -60,
+				SNR:       5.5,
+				TOA:       &toa,
+				AntennaLocation: antennaLocation{
+					Latitude:  1.123,
+					Longitude: 2.123,
+					Altitude:  3.123,
+				},
+			},
+			{
+				GatewayID:    "0202030405060708",
+				RSSI:         -70,
+				SNR:          2,
+				EncryptedTOA: []byte{1, 2, 3, 4},
+				AntennaLocation: antennaLocation{
+					Latitude:  4.123,
+					Longitude: 5.123,
+					Altitude:  6.123,
+				},
+			},
+		}
+
+		Convey("When resolving a single frame", func() {
+			ts.response = `{"result": {"latitude": 1.1, "longitude": 2.2, "altitude": 3.3, "accuracy": 10.5}}`
+			loc, err := b.ResolveTDOA(context.Background(), lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, []geo.FrameRXInfo{frame})
+			So(err, ShouldBeNil)
+
+			Convey("Then the expected request was made", func() {
+				So(ts.request.URL.Path, ShouldEqual, "/tdoa")
+				So(ts.request.Header.Get("Ocp-Apim-Subscription-Key"), ShouldEqual, "secret")
+
+				var req tdoaSingleFrameRequest
+				So(json.Unmarshal(ts.body, &req), ShouldBeNil)
+				So(req, ShouldResemble, tdoaSingleFrameRequest{
+					LoRaWAN: expectedUplinkTDOA,
+				})
+			})
+
+			Convey("Then the expected location is returned", func() {
+				So(loc, ShouldResemble, common.Location{
+					Latitude:  1.1,
+					Longitude: 2.2,
+					Altitude:  3.3,
+					Accuracy:  10,
+					Source:    common.LocationSource_GEO_RESOLVER,
+				})
+			})
+		})
+
+		Convey("When resolving multiple frames", func() {
+			ts.response = `{"result": {"latitude": 1.1, "longitude": 2.2, "altitude": 3.3, "accuracy": 5}}`
+			_, err := b.ResolveTDOA(context.Background(), lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, []geo.FrameRXInfo{frame, frame})
+			So(err, ShouldBeNil)
+
+			Convey("Then the multi-frame API was used", func() {
+				So(ts.request.URL.Path, ShouldEqual, "/tdoaMultiframe")
+
+				var req tdoaMultiFrameRequest
+				So(json.Unmarshal(ts.body, &req), ShouldBeNil)
+				So(req, ShouldResemble, tdoaMultiFrameRequest{
+					LoRaWAN: [][]uplinkTDOA{expectedUplinkTDOA, expectedUplinkTDOA},
+				})
+			})
+		})
+
+		Convey("When the API does not return a result", func() {
+			ts.response = `{"result": null, "warnings": ["not enough gateways"]}`
+			_, err := b.ResolveTDOA(context.Background(), lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, []geo.FrameRXInfo{frame})
+
+			Convey("Then ErrNoLocation is returned", func() {
+				So(err, ShouldEqual, geolocation.ErrNoLocation)
+			})
+		})
+
+		Convey("When the API returns errors", func() {
+			ts.response = `{"result": null, "errors": ["invalid request"]}`
+			_, err := b.ResolveTDOA(context.Background(), lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, []geo.FrameRXInfo{frame})
+
+			Convey("Then an error is returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "api returned errors: invalid request")
+			})
+		})
+	})
+}
+
+func TestResolveWifi(t *testing.T) {
+	Convey("Given a test server and LoRa Cloud backend", t, func() {
+		ts := testServer{}
+		server := httptest.NewServer(&ts)
+		defer server.Close()
+
+		b := NewBackend(Config{
+			URI:   server.URL,
+			Token: "secret",
+		})
+
+		aps := []geolocation.WifiAccessPoint{
+			{MACAddress: [6]byte{1, 2, 3, 4, 5, 6}, RSSI: -58},
+			{MACAddress: [6]byte{0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}, RSSI: -72},
+		}
+
+		rxInfo := []*gw.UplinkRXInfo{
+			{
+				GatewayId: []byte{1, 2, 3, 4, 5, 6, 7, 8},
+				Rssi:      -60,
+			},
+		}
+
+		Convey("When resolving the Wi-Fi access-points", func() {
+			ts.response = `{"result": {"latitude": 1.1, "longitude": 2.2, "altitude": 3.3, "accuracy": 25.4}}`
+			loc, err := b.ResolveWifi(context.Background(), lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, aps, rxInfo)
+			So(err, ShouldBeNil)
+
+			Convey("Then the expected request was made", func() {
+				So(ts.request.URL.Path, ShouldEqual, "/loraWifi")
+				So(ts.request.Header.Get("Ocp-Apim-Subscription-Key"), ShouldEqual, "secret")
+
+				var req wifiRequest
+				So(json.Unmarshal(ts.body, &req), ShouldBeNil)
+				So(req.LoRaWAN, ShouldHaveLength, 1)
+				So(req.WifiAccessPoints, ShouldResemble, []wifiAccessPoint{
+					{MACAddress: "01:02:03:04:05:06", SignalStrength: -58},
+					{MACAddress: "aa:bb:cc:dd:ee:ff", SignalStrength: -72},
+				})
+			})
+
+			Convey("Then the expected location is returned", func() {
+				So(loc, ShouldResemble, common.Location{
+					Latitude:  1.1,
+					Longitude: 2.2,
+					Altitude:  3.3,
+					Accuracy:  25,
+					Source:    common.LocationSource_GEO_RESOLVER,
+				})
+			})
+		})
+	})
+}
+
+func TestResolveGNSS(t *testing.T) {
+	Convey("Given a test server and LoRa Cloud backend", t, func() {
+		ts := testServer{}
+		server := httptest.NewServer(&ts)
+		defer server.Close()
+
+		b := NewBackend(Config{
+			URI:     server.URL,
+			GNSSURI: server.URL + "/solve/gnss_lr1110_singleframe",
+			Token:   "secret",
+		})
+
+		Convey("When resolving a GNSS-NAV payload with assist location", func() {
+			ts.response = `{"result": {"llh": [1.1, 2.2, 3.3], "accuracy": 10.2}}`
+			loc, err := b.ResolveGNSS(context.Background(), lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, []byte{1, 2, 3}, &common.Location{Latitude: 1, Longitude: 2})
+			So(err, ShouldBeNil)
+
+			Convey("Then the expected request was made", func() {
+				So(ts.request.URL.Path, ShouldEqual, "/solve/gnss_lr1110_singleframe")
+				So(string(ts.body), ShouldEqual, `{"payload":"010203","gnss_assist_position":[1,2]}`)
+			})
+
+			Convey("Then the expected location is returned", func() {
+				So(loc, ShouldResemble, common.Location{
+					Latitude:  1.1,
+					Longitude: 2.2,
+					Altitude:  3.3,
+					Accuracy:  10,
+					Source:    common.LocationSource_GPS,
+				})
+			})
+		})
+
+		Convey("When the API does not return a result", func() {
+			ts.response = `{"result": null}`
+			_, err := b.ResolveGNSS(context.Background(), lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}, []byte{1, 2, 3}, nil)
+
+			Convey("Then ErrNoLocation is returned", func() {
+				So(err, ShouldEqual, geolocation.ErrNoLocation)
+			})
+		})
+	})
+}
@@ -0,0 +1,67 @@
+package loracloud
+
+type tdoaSingleFrameRequest struct {
+	LoRaWAN []uplinkTDOA `json:"lorawan"`
+}
+
+type tdoaMultiFrameRequest struct {
+	LoRaWAN [][]uplinkTDOA `json:"lorawan"`
+}
+
+type wifiRequest struct {
+	LoRaWAN          []uplinkTDOA      `json:"lorawan"`
+	WifiAccessPoints []wifiAccessPoint `json:"wifiAccessPoints"`
+}
+
+type wifiAccessPoint struct {
+	MACAddress     string `json:"macAddress"`
+	SignalStrength int    `json:"signalStrength"`
+}
+
+type gnssRequest struct {
+	Payload            string    `json:"payload"`
+	GNSSAssistPosition []float64 `json:"gnss_assist_position,omitempty"`
+}
+
+type gnssResponse struct {
+	Result   *gnssResult `json:"result"`
+	Errors   []string    `json:"errors"`
+	Warnings []string    `json:"warnings"`
+}
+
+type gnssResult struct {
+	LLH      []float64 `json:"llh"`
+	Accuracy float64   `json:"accuracy"`
+}
+
+type uplinkTDOA struct {
+	GatewayID       string          `json:"gatewayId"`
+	AntennaID       int             `json:"antennaId"`
+	RSSI            float64         `json:"rssi"`
+	SNR             float64         `json:"snr"`
+	TOA             *uint32         `json:"toa,omitempty"`
+	EncryptedTOA    []byte          `json:"encryptedToa,omitempty"`
+	AntennaLocation antennaLocation `json:"antennaLocation"`
+}
+
+type antennaLocation struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Altitude  float64 `json:"altitude"`
+}
+
+type response struct {
+	Result   *locationResult `json:"result"`
+	Errors   []string        `json:"errors"`
+	Warnings []string        `json:"warnings"`
+}
+
+type locationResult struct {
+	Latitude                 float64 `json:"latitude"`
+	Longitude                float64 `json:"longitude"`
+	Altitude                 float64 `json:"altitude"`
+	Accuracy                 float64 `json:"accuracy"`
+	AlgorithmType            string  `json:"algorithmType"`
+	NumberOfGatewaysReceived int     `json:"numberOfGatewaysReceived"`
+	NumberOfGatewaysUsed     int     `json:"numberOfGatewaysUsed"`
+}
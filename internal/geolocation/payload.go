@@ -0,0 +1,165 @@
+package geolocation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/loraserver/api/gw"
+	"github.com/brocaar/lorawan"
+)
+
+// wifiAccessPointSize defines the size (in bytes) of a single access-point
+// in a Wi-Fi scan payload (1 byte RSSI + 6 bytes MAC address).
+const wifiAccessPointSize = 7
+
+// WifiAccessPoint contains a single access-point of a Wi-Fi scan.
+type WifiAccessPoint struct {
+	MACAddress [6]byte
+	RSSI       int
+}
+
+// String returns the MAC address of the access-point as colon separated
+// HEX string.
+func (ap WifiAccessPoint) String() string {
+	m := ap.MACAddress
+	return fmt.Sprintf("%02x:%02x:%02x:%02x:%02x:%02x", m[0], m[1], m[2], m[3], m[4], m[5])
+}
+
+// WifiBackend defines the interface of a geolocation backend which is able
+// to resolve the location using a Wi-Fi scan.
+type WifiBackend interface {
+	// ResolveWifi resolves the device location using the given Wi-Fi
+	// access-points.
+	ResolveWifi(ctx context.Context, devEUI lorawan.EUI64, accessPoints []WifiAccessPoint, rxInfo []*gw.UplinkRXInfo) (common.Location, error)
+}
+
+// GNSSBackend defines the interface of a geolocation backend which is able
+// to resolve the location using a GNSS-NAV message (e.g. as sent by a
+// LR1110 based tracker).
+type GNSSBackend interface {
+	// ResolveGNSS resolves the device location using the given GNSS-NAV
+	// payload. The assist location (the last known location of the device)
+	// is optional.
+	ResolveGNSS(ctx context.Context, devEUI lorawan.EUI64, payload []byte, assist *common.Location) (common.Location, error)
+}
+
+// isWifiFPort returns true when the given FPort is configured for Wi-Fi
+// scan payloads.
+func isWifiFPort(fPort uint8) bool {
+	for _, p := range config.C.ApplicationServer.Geolocation.WifiFPorts {
+		if p == int(fPort) {
+			return true
+		}
+	}
+	return false
+}
+
+// isGNSSFPort returns true when the given FPort is configured for GNSS-NAV
+// payloads.
+func isGNSSFPort(fPort uint8) bool {
+	for _, p := range config.C.ApplicationServer.Geolocation.GNSSFPorts {
+		if p == int(fPort) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseWifiScanPayload parses the given Wi-Fi scan payload. The payload
+// is a sequence of access-points, each consisting of the RSSI (1 byte,
+// signed) followed by the MAC address (6 bytes).
+func parseWifiScanPayload(b []byte) ([]WifiAccessPoint, error) {
+	if len(b) == 0 || len(b)%wifiAccessPointSize != 0 {
+		return nil, fmt.Errorf("wifi scan payload must be a multiple of %d bytes", wifiAccessPointSize)
+	}
+
+	var out []WifiAccessPoint
+	for i := 0; i < len(b); i += wifiAccessPointSize {
+		ap := WifiAccessPoint{
+			RSSI: int(int8(b[i])),
+		}
+		copy(ap.MACAddress[:], b[i+1:i+wifiAccessPointSize])
+		out = append(out, ap)
+	}
+
+	return out, nil
+}
+
+// handleWifiScan resolves the device location using the given Wi-Fi scan
+// payload.
+func handleWifiScan(ctx context.Context, d storage.Device, app storage.Application, b []byte, rxInfo []*gw.UplinkRXInfo) error {
+	wb, ok := backend.(WifiBackend)
+	if !ok {
+		log.WithField("dev_eui", d.DevEUI).Warning("geolocation: backend does not support wifi geolocation")
+		return nil
+	}
+
+	aps, err := parseWifiScanPayload(b)
+	if err != nil {
+		return errors.Wrap(err, "parse wifi scan payload error")
+	}
+
+	if ok, err := acquireResolveLock(config.C.Redis.Pool, d.DevEUI, app); err != nil || !ok {
+		return err
+	}
+
+	loc, err := wb.ResolveWifi(ctx, d.DevEUI, aps, rxInfo)
+	if err != nil {
+		if errors.Cause(err) == ErrNoLocation {
+			log.WithField("dev_eui", d.DevEUI).Debug("geolocation: no location resolved")
+			return nil
+		}
+		return errors.Wrap(err, "resolve wifi error")
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":       d.DevEUI,
+		"access_points": len(aps),
+		"accuracy":      loc.Accuracy,
+	}).Info("geolocation: location resolved using wifi")
+
+	return setDeviceLocation(d, app, loc)
+}
+
+// handleGNSS resolves the device location using the given GNSS-NAV payload.
+func handleGNSS(ctx context.Context, d storage.Device, app storage.Application, b []byte) error {
+	gb, ok := backend.(GNSSBackend)
+	if !ok {
+		log.WithField("dev_eui", d.DevEUI).Warning("geolocation: backend does not support gnss geolocation")
+		return nil
+	}
+
+	if ok, err := acquireResolveLock(config.C.Redis.Pool, d.DevEUI, app); err != nil || !ok {
+		return err
+	}
+
+	var assist *common.Location
+	if d.Latitude != nil && d.Longitude != nil {
+		assist = &common.Location{
+			Latitude:  *d.Latitude,
+			Longitude: *d.Longitude,
+		}
+	}
+
+	loc, err := gb.ResolveGNSS(ctx, d.DevEUI, b, assist)
+	if err != nil {
+		if errors.Cause(err) == ErrNoLocation {
+			log.WithField("dev_eui", d.DevEUI).Debug("geolocation: no location resolved")
+			return nil
+		}
+		return errors.Wrap(err, "resolve gnss error")
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":  d.DevEUI,
+		"accuracy": loc.Accuracy,
+	}).Info("geolocation: location resolved using gnss")
+
+	return setDeviceLocation(d, app, loc)
+}
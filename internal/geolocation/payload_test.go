@@ -0,0 +1,56 @@
+package geolocation
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParseWifiScanPayload(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Name           string
+			Payload        []byte
+			ExpectedAPs    []WifiAccessPoint
+			ExpectedString []string
+			ExpectedError  string
+		}{
+			{
+				Name:    "two access-points",
+				Payload: []byte{0xc6, 1, 2, 3, 4, 5, 6, 0xb8, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff},
+				ExpectedAPs: []WifiAccessPoint{
+					{MACAddress: [6]byte{1, 2, 3, 4, 5, 6}, RSSI: -58},
+					{MACAddress: [6]byte{0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}, RSSI: -72},
+				},
+				ExpectedString: []string{"01:02:03:04:05:06", "aa:bb:cc:dd:ee:ff"},
+			},
+			{
+				Name:          "invalid length",
+				Payload:       []byte{0xc6, 1, 2, 3, 4, 5},
+				ExpectedError: "wifi scan payload must be a multiple of 7 bytes",
+			},
+			{
+				Name:          "empty payload",
+				ExpectedError: "wifi scan payload must be a multiple of 7 bytes",
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+				aps, err := parseWifiScanPayload(test.Payload)
+				if test.ExpectedError != "" {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, test.ExpectedError)
+					return
+				}
+				So(err, ShouldBeNil)
+				So(aps, ShouldResemble, test.ExpectedAPs)
+
+				for j := range aps {
+					So(aps[j].String(), ShouldEqual, test.ExpectedString[j])
+				}
+			})
+		}
+	})
+}
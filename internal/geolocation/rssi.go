@@ -0,0 +1,184 @@
+package geolocation
+
+import (
+	"math"
+
+	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/loraserver/api/gw"
+)
+
+const (
+	earthRadius = 6371000.0 // meters
+
+	// rssiRangingError defines the expected (relative) ranging error of an
+	// RSSI based distance estimate, caused by shadowing and multi-path.
+	rssiRangingError = 0.5
+
+	rssiIterations = 50
+)
+
+// rssiConfig contains the configuration of the RSSI based location
+// estimator.
+type rssiConfig struct {
+	// PathLossExponent is the path-loss exponent of the log-distance
+	// path-loss model (2 for free space, 2.7 - 3.5 for urban areas).
+	PathLossExponent float64
+
+	// ReferenceRSSI is the expected RSSI at a distance of 1 meter.
+	ReferenceRSSI float64
+
+	// MinGateways defines the minimum number of gateways needed.
+	MinGateways int
+}
+
+// rssiDistance returns the estimated distance (in meters) for the given
+// RSSI, using the log-distance path-loss model.
+func rssiDistance(conf rssiConfig, rssi float64) float64 {
+	return math.Pow(10, (conf.ReferenceRSSI-rssi)/(10*conf.PathLossExponent))
+}
+
+// resolveRSSI estimates the device location using RSSI weighted
+// multilateration from the gateway locations. It returns a coarse location
+// with an accuracy estimate (in meters) so that consumers can discount poor
+// fixes. ErrNoLocation is returned when not enough gateways (with location)
+// are available.
+func resolveRSSI(conf rssiConfig, rxInfo []*gw.UplinkRXInfo) (common.Location, error) {
+	type anchor struct {
+		x, y float64 // local plane coordinates (meters)
+		d    float64 // estimated distance (meters)
+		w    float64 // weight
+	}
+
+	var anchors []anchor
+	var lat0, lon0 float64
+
+	for _, rx := range rxInfo {
+		if rx.Location == nil || (rx.Location.Latitude == 0 && rx.Location.Longitude == 0) {
+			continue
+		}
+
+		if len(anchors) == 0 {
+			lat0 = rx.Location.Latitude
+			lon0 = rx.Location.Longitude
+		}
+
+		x, y := toPlane(lat0, lon0, rx.Location.Latitude, rx.Location.Longitude)
+		d := rssiDistance(conf, float64(rx.Rssi))
+		anchors = append(anchors, anchor{
+			x: x,
+			y: y,
+			d: d,
+			w: 1 / (d * d),
+		})
+	}
+
+	minGateways := conf.MinGateways
+	if minGateways < 1 {
+		minGateways = 1
+	}
+	if len(anchors) < minGateways {
+		return common.Location{}, ErrNoLocation
+	}
+
+	// initial estimate: weighted centroid
+	var x, y, wSum, dSum float64
+	for _, a := range anchors {
+		x += a.x * a.w
+		y += a.y * a.w
+		wSum += a.w
+		dSum += a.d
+	}
+	x = x / wSum
+	y = y / wSum
+
+	// refine using weighted least-squares (Gauss-Newton), this needs at least
+	// three gateways to be meaningful
+	if len(anchors) >= 3 {
+		for i := 0; i < rssiIterations; i++ {
+			var a11, a12, a22, b1, b2 float64
+			for _, a := range anchors {
+				dx := x - a.x
+				dy := y - a.y
+				r := math.Hypot(dx, dy)
+				if r < 1 {
+					r = 1
+				}
+				jx := dx / r
+				jy := dy / r
+				res := r - a.d
+
+				a11 += a.w * jx * jx
+				a12 += a.w * jx * jy
+				a22 += a.w * jy * jy
+				b1 += a.w * jx * res
+				b2 += a.w * jy * res
+			}
+
+			det := a11*a22 - a12*a12
+			if math.Abs(det) < 1e-20 {
+				break
+			}
+
+			sx := (a22*b1 - a12*b2) / det
+			sy := (a11*b2 - a12*b1) / det
+			x -= sx
+			y -= sy
+
+			if math.Hypot(sx, sy) < 0.1 {
+				break
+			}
+		}
+	}
+
+	// accuracy: weighted rms of the residuals, combined with the expected
+	// ranging error of the RSSI based distance estimates
+	var resSum float64
+	for _, a := range anchors {
+		res := math.Hypot(x-a.x, y-a.y) - a.d
+		resSum += a.w * res * res
+	}
+	rms := math.Sqrt(resSum / wSum)
+	ranging := rssiRangingError * (dSum / float64(len(anchors))) / math.Sqrt(float64(len(anchors)))
+
+	// with less than three gateways the location can't be determined, the
+	// device can be anywhere within range of the gateways
+	if len(anchors) < 3 {
+		for _, a := range anchors {
+			rms = math.Max(rms, a.d)
+		}
+	}
+
+	accuracy := math.Sqrt(rms*rms + ranging*ranging)
+
+	lat, lon := fromPlane(lat0, lon0, x, y)
+
+	return common.Location{
+		Latitude:  lat,
+		Longitude: lon,
+		Accuracy:  uint32(math.Ceil(accuracy)),
+		Source:    common.LocationSource_GEO_RESOLVER,
+	}, nil
+}
+
+// toPlane projects the given coordinates on a local plane (meters) with
+// lat0, lon0 as origin (equirectangular projection).
+func toPlane(lat0, lon0, lat, lon float64) (float64, float64) {
+	x := toRadians(lon-lon0) * math.Cos(toRadians(lat0)) * earthRadius
+	y := toRadians(lat-lat0) * earthRadius
+	return x, y
+}
+
+// fromPlane is the inverse of toPlane.
+func fromPlane(lat0, lon0, x, y float64) (float64, float64) {
+	lat := lat0 + toDegrees(y/earthRadius)
+	lon := lon0 + toDegrees(x/(earthRadius*math.Cos(toRadians(lat0))))
+	return lat, lon
+}
+
+func toRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func toDegrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
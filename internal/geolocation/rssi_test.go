@@ -0,0 +1,119 @@
+package geolocation
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/loraserver/api/gw"
+)
+
+func TestResolveRSSI(t *testing.T) {
+	Convey("Given an RSSI config and a device location", t, func() {
+		conf := rssiConfig{
+			PathLossExponent: 2.7,
+			ReferenceRSSI:    -40,
+			MinGateways:      1,
+		}
+
+		devLat, devLon := 52.3740364, 4.9144401
+
+		// rxInfoFor returns the rx-info of a gateway at the given offset (in
+		// meters) from the device, with the RSSI matching the distance.
+		rxInfoFor := func(dx, dy float64) *gw.UplinkRXInfo {
+			lat, lon := fromPlane(devLat, devLon, dx, dy)
+			d := math.Hypot(dx, dy)
+			rssi := conf.ReferenceRSSI - 10*conf.PathLossExponent*math.Log10(d)
+
+			return &gw.UplinkRXInfo{
+				Rssi: int32(math.Round(rssi)),
+				Location: &common.Location{
+					Latitude:  lat,
+					Longitude: lon,
+				},
+			}
+		}
+
+		// distance returns the distance (in meters) between the resolved
+		// location and the device location.
+		distance := func(loc common.Location) float64 {
+			x, y := toPlane(devLat, devLon, loc.Latitude, loc.Longitude)
+			return math.Hypot(x, y)
+		}
+
+		tests := []struct {
+			Name        string
+			MinGateways int
+			RXInfo      []*gw.UplinkRXInfo
+			ExpectedErr error
+		}{
+			{
+				Name: "three gateways",
+				RXInfo: []*gw.UplinkRXInfo{
+					rxInfoFor(1000, 0),
+					rxInfoFor(-500, 800),
+					rxInfoFor(-300, -1200),
+				},
+			},
+			{
+				Name: "four gateways",
+				RXInfo: []*gw.UplinkRXInfo{
+					rxInfoFor(1000, 0),
+					rxInfoFor(-500, 800),
+					rxInfoFor(-300, -1200),
+					rxInfoFor(2000, 2000),
+				},
+			},
+			{
+				Name: "two gateways",
+				RXInfo: []*gw.UplinkRXInfo{
+					rxInfoFor(1000, 0),
+					rxInfoFor(-500, 800),
+				},
+			},
+			{
+				Name: "single gateway",
+				RXInfo: []*gw.UplinkRXInfo{
+					rxInfoFor(400, 300),
+				},
+			},
+			{
+				Name:        "not enough gateways",
+				MinGateways: 3,
+				RXInfo: []*gw.UplinkRXInfo{
+					rxInfoFor(1000, 0),
+					rxInfoFor(-500, 800),
+				},
+				ExpectedErr: ErrNoLocation,
+			},
+			{
+				Name: "gateways without location are ignored",
+				RXInfo: []*gw.UplinkRXInfo{
+					{Rssi: -80},
+				},
+				ExpectedErr: ErrNoLocation,
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+				if test.MinGateways != 0 {
+					conf.MinGateways = test.MinGateways
+				}
+
+				loc, err := resolveRSSI(conf, test.RXInfo)
+				So(err, ShouldEqual, test.ExpectedErr)
+				if err != nil {
+					return
+				}
+
+				So(loc.Source, ShouldEqual, common.LocationSource_GEO_RESOLVER)
+				So(loc.Accuracy, ShouldBeGreaterThan, 0)
+				So(distance(loc), ShouldBeLessThanOrEqualTo, float64(loc.Accuracy))
+			})
+		}
+	})
+}
@@ -0,0 +1,169 @@
+// Package gwstats implements the consumption of the gateway stats messages
+// published by LoRa Gateway Bridge. The metadata included in these messages
+// (e.g. the IP address, the packet-forwarder version and the platform) is
+// persisted, so that the gateway inventory stays up-to-date automatically.
+package gwstats
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/handler/mqtthandler"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/loraserver/api/gw"
+)
+
+// Marshalers
+const (
+	MarshalerJSON     = "json"
+	MarshalerProtobuf = "protobuf"
+)
+
+// Metadata keys which are mapped to the dedicated metadata fields.
+const (
+	packetForwarderVersionKey = "packet_forwarder_version"
+	platformKey               = "platform"
+	temperatureKey            = "temperature"
+)
+
+// Setup connects to the MQTT broker and subscribes to the gateway stats
+// topic. Nothing is done when no server is configured. As the gateway stats
+// are not critical, connection errors are retried in the background.
+func Setup() error {
+	conf := config.C.ApplicationServer.GatewayStats
+	if conf.Server == "" {
+		return nil
+	}
+
+	if conf.Marshaler != MarshalerJSON && conf.Marshaler != MarshalerProtobuf {
+		return errors.Errorf("gwstats: unknown marshaler: %s", conf.Marshaler)
+	}
+
+	opts := mqtt.NewClientOptions()
+	opts.AddBroker(conf.Server)
+	opts.SetUsername(conf.Username)
+	opts.SetPassword(conf.Password)
+	opts.SetClientID(conf.ClientID)
+	opts.SetCleanSession(true)
+	opts.SetOnConnectHandler(onConnected)
+	opts.SetConnectionLostHandler(func(c mqtt.Client, reason error) {
+		log.WithError(reason).Error("gwstats: mqtt connection error")
+	})
+
+	tlsConfig, err := mqtthandler.NewTLSConfig(conf.CACert, conf.TLSCert, conf.TLSKey)
+	if err != nil {
+		return errors.Wrap(err, "gwstats: load tls config error")
+	}
+	if tlsConfig != nil {
+		opts.SetTLSConfig(tlsConfig)
+	}
+
+	log.WithField("server", conf.Server).Info("gwstats: connecting to mqtt broker")
+	conn := mqtt.NewClient(opts)
+	go func() {
+		for {
+			if token := conn.Connect(); token.Wait() && token.Error() != nil {
+				log.WithError(token.Error()).Error("gwstats: connecting to broker error, will retry in 2s")
+				time.Sleep(2 * time.Second)
+				continue
+			}
+			return
+		}
+	}()
+
+	return nil
+}
+
+func onConnected(c mqtt.Client) {
+	topic := config.C.ApplicationServer.GatewayStats.StatsTopic
+	log.WithField("topic", topic).Info("gwstats: connected to mqtt broker, subscribing to stats topic")
+
+	for {
+		if token := c.Subscribe(topic, 0, handleStats); token.Wait() && token.Error() != nil {
+			log.WithError(token.Error()).WithField("topic", topic).Error("gwstats: subscribe error")
+			time.Sleep(time.Second)
+			continue
+		}
+		return
+	}
+}
+
+func handleStats(c mqtt.Client, msg mqtt.Message) {
+	m, err := decodeStats(config.C.ApplicationServer.GatewayStats.Marshaler, msg.Payload())
+	if err != nil {
+		log.WithError(err).WithField("topic", msg.Topic()).Error("gwstats: decode stats error")
+		return
+	}
+
+	if err := storage.SetGatewayMetadata(config.C.PostgreSQL.DB, &m); err != nil {
+		// the gateway might be managed by an other application-server
+		if errors.Cause(err) == storage.ErrDoesNotExist {
+			log.WithField("gateway_id", m.GatewayMAC).Debug("gwstats: ignoring stats of unknown gateway")
+			return
+		}
+		log.WithError(err).WithField("gateway_id", m.GatewayMAC).Error("gwstats: set gateway metadata error")
+		return
+	}
+
+	log.WithFields(log.Fields{
+		"gateway_id": m.GatewayMAC,
+		"ip":         m.IP,
+	}).Debug("gwstats: gateway metadata updated")
+}
+
+// decodeStats decodes the given gateway stats message into the gateway
+// metadata. The metadata key / value pairs (metaData) can only be decoded
+// from JSON messages.
+func decodeStats(marshaler string, b []byte) (storage.GatewayMetadata, error) {
+	var m storage.GatewayMetadata
+	var stats gw.GatewayStats
+	var metaData map[string]string
+
+	switch marshaler {
+	case MarshalerProtobuf:
+		if err := proto.Unmarshal(b, &stats); err != nil {
+			return m, errors.Wrap(err, "unmarshal protobuf error")
+		}
+	default:
+		u := jsonpb.Unmarshaler{AllowUnknownFields: true}
+		if err := u.Unmarshal(bytes.NewReader(b), &stats); err != nil {
+			return m, errors.Wrap(err, "unmarshal json error")
+		}
+
+		var md struct {
+			MetaData map[string]string `json:"metaData"`
+		}
+		if err := json.Unmarshal(b, &md); err != nil {
+			return m, errors.Wrap(err, "unmarshal json error")
+		}
+		metaData = md.MetaData
+	}
+
+	if len(stats.GatewayId) != len(m.GatewayMAC) {
+		return m, errors.New("invalid gateway id")
+	}
+	copy(m.GatewayMAC[:], stats.GatewayId)
+
+	m.IP = stats.Ip
+	m.ConfigVersion = stats.ConfigVersion
+	m.PacketForwarderVersion = metaData[packetForwarderVersionKey]
+	m.Platform = metaData[platformKey]
+	m.Properties = metaData
+
+	if s, ok := metaData[temperatureKey]; ok {
+		if temp, err := strconv.ParseFloat(s, 64); err == nil {
+			m.Temperature = &temp
+		}
+	}
+
+	return m, nil
+}
@@ -0,0 +1,98 @@
+package gwstats
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/loraserver/api/gw"
+	"github.com/brocaar/lorawan"
+)
+
+func TestDecodeStats(t *testing.T) {
+	temp := 42.5
+
+	pbStats, err := proto.Marshal(&gw.GatewayStats{
+		GatewayId:     []byte{1, 2, 3, 4, 5, 6, 7, 8},
+		Ip:            "192.168.1.10",
+		ConfigVersion: "1.2.3",
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		Name          string
+		Marshaler     string
+		Payload       []byte
+		Expected      storage.GatewayMetadata
+		ExpectedError bool
+	}{
+		{
+			Name:      "json with metadata",
+			Marshaler: MarshalerJSON,
+			Payload:   []byte(`{"gatewayID":"AQIDBAUGBwg=","ip":"192.168.1.10","configVersion":"1.2.3","rxPacketsReceived":10,"metaData":{"packet_forwarder_version":"5.0.1","platform":"rpi","temperature":"42.5","serial":"abc"}}`),
+			Expected: storage.GatewayMetadata{
+				GatewayMAC:             lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				IP:                     "192.168.1.10",
+				ConfigVersion:          "1.2.3",
+				PacketForwarderVersion: "5.0.1",
+				Platform:               "rpi",
+				Temperature:            &temp,
+				Properties: storage.GatewayMetadataProperties{
+					"packet_forwarder_version": "5.0.1",
+					"platform":                 "rpi",
+					"temperature":              "42.5",
+					"serial":                   "abc",
+				},
+			},
+		},
+		{
+			Name:      "json with invalid temperature",
+			Marshaler: MarshalerJSON,
+			Payload:   []byte(`{"gatewayID":"AQIDBAUGBwg=","metaData":{"temperature":"hot"}}`),
+			Expected: storage.GatewayMetadata{
+				GatewayMAC: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				Properties: storage.GatewayMetadataProperties{
+					"temperature": "hot",
+				},
+			},
+		},
+		{
+			Name:      "protobuf",
+			Marshaler: MarshalerProtobuf,
+			Payload:   pbStats,
+			Expected: storage.GatewayMetadata{
+				GatewayMAC:    lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				IP:            "192.168.1.10",
+				ConfigVersion: "1.2.3",
+			},
+		},
+		{
+			Name:          "missing gateway id",
+			Marshaler:     MarshalerJSON,
+			Payload:       []byte(`{"ip":"192.168.1.10"}`),
+			ExpectedError: true,
+		},
+		{
+			Name:          "invalid json",
+			Marshaler:     MarshalerJSON,
+			Payload:       []byte(`{`),
+			ExpectedError: true,
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			m, err := decodeStats(tst.Marshaler, tst.Payload)
+			if tst.ExpectedError {
+				assert.Error(err)
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(tst.Expected, m)
+		})
+	}
+}
@@ -0,0 +1,328 @@
+// Package amqphandler implements an integration handler publishing the
+// events of all applications to an AMQP exchange (e.g. RabbitMQ). Publisher
+// confirms are enabled, a publish only succeeds after it has been confirmed
+// by the broker. When the connection is lost, the handler reconnects in the
+// background.
+package amqphandler
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/streadway/amqp"
+
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/handler/mqtthandler"
+	"github.com/brocaar/lorawan"
+)
+
+// Event types (matching the last topic level of the MQTT integration).
+const (
+	uplinkEvent   = "rx"
+	joinEvent     = "join"
+	ackEvent      = "ack"
+	errorEvent    = "error"
+	statusEvent   = "status"
+	locationEvent = "location"
+	adminEvent    = "admin"
+)
+
+const (
+	defaultTimeout           = 10 * time.Second
+	defaultReconnectInterval = 5 * time.Second
+
+	// confirmBufferSize defines the size of the publisher confirms buffer.
+	// Confirms of publishes which timed out are received late, these are
+	// discarded on the next publish.
+	confirmBufferSize = 100
+)
+
+// Config holds the configuration for the AMQP handler.
+type Config struct {
+	URL                string        `mapstructure:"url"`
+	Exchange           string        `mapstructure:"exchange"`
+	RoutingKeyTemplate string        `mapstructure:"routing_key_template"`
+	Timeout            time.Duration `mapstructure:"timeout"`
+	ReconnectInterval  time.Duration `mapstructure:"reconnect_interval"`
+	CACert             string        `mapstructure:"ca_cert"`
+	TLSCert            string        `mapstructure:"tls_cert"`
+	TLSKey             string        `mapstructure:"tls_key"`
+}
+
+// Handler implements an AMQP handler.
+type Handler struct {
+	config             Config
+	routingKeyTemplate *template.Template
+
+	mu          sync.Mutex
+	closed      bool
+	conn        *amqp.Connection
+	ch          *amqp.Channel
+	confirms    chan amqp.Confirmation
+	deliveryTag uint64
+}
+
+// NewHandler creates a new AMQP handler. When the connection to the broker
+// can not be established, it is retried in the background.
+func NewHandler(c Config) (*Handler, error) {
+	if c.URL == "" {
+		return nil, errors.New("url must be configured")
+	}
+	if c.Timeout == 0 {
+		c.Timeout = defaultTimeout
+	}
+	if c.ReconnectInterval == 0 {
+		c.ReconnectInterval = defaultReconnectInterval
+	}
+
+	h := Handler{
+		config: c,
+	}
+
+	var err error
+	h.routingKeyTemplate, err = template.New("routing_key").Parse(c.RoutingKeyTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse routing-key template error")
+	}
+
+	// validate the certificate files before connecting
+	if _, err := mqtthandler.NewTLSConfig(c.CACert, c.TLSCert, c.TLSKey); err != nil {
+		return nil, errors.Wrap(err, "load tls config error")
+	}
+
+	if err := h.connect(); err != nil {
+		log.WithError(err).Errorf("handler/amqp: connecting to broker error, will retry in %s", c.ReconnectInterval)
+		go h.reconnect()
+	}
+
+	return &h, nil
+}
+
+// SendDataUp sends a DataUpPayload.
+func (h *Handler) SendDataUp(pl handler.DataUpPayload) error {
+	return h.publish(uplinkEvent, pl.ApplicationID, pl.DevEUI, pl)
+}
+
+// SendJoinNotification sends a JoinNotification.
+func (h *Handler) SendJoinNotification(pl handler.JoinNotification) error {
+	return h.publish(joinEvent, pl.ApplicationID, pl.DevEUI, pl)
+}
+
+// SendACKNotification sends an ACKNotification.
+func (h *Handler) SendACKNotification(pl handler.ACKNotification) error {
+	return h.publish(ackEvent, pl.ApplicationID, pl.DevEUI, pl)
+}
+
+// SendErrorNotification sends an ErrorNotification.
+func (h *Handler) SendErrorNotification(pl handler.ErrorNotification) error {
+	return h.publish(errorEvent, pl.ApplicationID, pl.DevEUI, pl)
+}
+
+// SendStatusNotification sends a StatusNotification.
+func (h *Handler) SendStatusNotification(pl handler.StatusNotification) error {
+	return h.publish(statusEvent, pl.ApplicationID, pl.DevEUI, pl)
+}
+
+// SendLocationNotification sends a LocationNotification.
+func (h *Handler) SendLocationNotification(pl handler.LocationNotification) error {
+	return h.publish(locationEvent, pl.ApplicationID, pl.DevEUI, pl)
+}
+
+// SendAdminEvent sends an AdminEvent. As admin events are not related to a
+// device, the DevEUI of the routing-key is empty (all zeros).
+func (h *Handler) SendAdminEvent(pl handler.AdminEvent) error {
+	return h.publish(adminEvent, pl.ApplicationID, lorawan.EUI64{}, pl)
+}
+
+// Close closes the connection to the broker.
+func (h *Handler) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	log.Info("handler/amqp: closing handler")
+
+	h.closed = true
+	if h.conn == nil {
+		return nil
+	}
+
+	conn := h.conn
+	h.conn = nil
+	h.ch = nil
+
+	if err := conn.Close(); err != nil && err != amqp.ErrClosed {
+		return errors.Wrap(err, "close connection error")
+	}
+	return nil
+}
+
+// connect connects to the broker and opens a channel in confirm mode.
+func (h *Handler) connect() error {
+	tlsConfig, err := mqtthandler.NewTLSConfig(h.config.CACert, h.config.TLSCert, h.config.TLSKey)
+	if err != nil {
+		return errors.Wrap(err, "load tls config error")
+	}
+
+	conn, err := amqp.DialTLS(h.config.URL, tlsConfig)
+	if err != nil {
+		return errors.Wrap(err, "dial error")
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		conn.Close()
+		return errors.Wrap(err, "open channel error")
+	}
+
+	if err := ch.Confirm(false); err != nil {
+		conn.Close()
+		return errors.Wrap(err, "enable publisher confirms error")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		conn.Close()
+		return errors.New("handler is closed")
+	}
+
+	h.conn = conn
+	h.ch = ch
+	h.confirms = ch.NotifyPublish(make(chan amqp.Confirmation, confirmBufferSize))
+	h.deliveryTag = 0
+
+	// The channel is also closed when the connection is closed or on a
+	// channel exception (e.g. when the exchange does not exist).
+	go h.watch(ch.NotifyClose(make(chan *amqp.Error, 1)))
+
+	log.WithField("exchange", h.config.Exchange).Info("handler/amqp: connected to amqp broker")
+
+	return nil
+}
+
+// watch waits until the given channel is closed and then reconnects.
+func (h *Handler) watch(closeChan chan *amqp.Error) {
+	amqpErr := <-closeChan
+
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		return
+	}
+	conn := h.conn
+	h.conn = nil
+	h.ch = nil
+	h.mu.Unlock()
+
+	log.WithField("error", amqpErr).Errorf("handler/amqp: channel closed, will reconnect in %s", h.config.ReconnectInterval)
+
+	// the connection might still be open on a channel exception
+	conn.Close()
+
+	h.reconnect()
+}
+
+// reconnect retries to connect to the broker until it succeeds or until the
+// handler is closed.
+func (h *Handler) reconnect() {
+	for {
+		time.Sleep(h.config.ReconnectInterval)
+
+		h.mu.Lock()
+		closed := h.closed
+		h.mu.Unlock()
+		if closed {
+			return
+		}
+
+		if err := h.connect(); err != nil {
+			log.WithError(err).Errorf("handler/amqp: connecting to broker error, will retry in %s", h.config.ReconnectInterval)
+			continue
+		}
+		return
+	}
+}
+
+func (h *Handler) publish(event string, applicationID int64, devEUI lorawan.EUI64, v interface{}) error {
+	routingKey, err := h.getRoutingKey(event, applicationID, devEUI)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.ch == nil {
+		return errors.New("not connected to amqp broker")
+	}
+
+	log.WithFields(log.Fields{
+		"exchange":    h.config.Exchange,
+		"routing_key": routingKey,
+		"event":       event,
+	}).Info("handler/amqp: publishing message")
+
+	err = h.ch.Publish(h.config.Exchange, routingKey, false, false, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		Timestamp:    time.Now(),
+		Type:         event,
+		Body:         b,
+	})
+	if err != nil {
+		return errors.Wrap(err, "publish error")
+	}
+	h.deliveryTag++
+
+	return h.waitForConfirm(h.deliveryTag)
+}
+
+// waitForConfirm waits for the confirmation of the given delivery-tag.
+// Confirmations of earlier (timed out) publishes are discarded.
+func (h *Handler) waitForConfirm(deliveryTag uint64) error {
+	timeout := time.NewTimer(h.config.Timeout)
+	defer timeout.Stop()
+
+	for {
+		select {
+		case confirm, ok := <-h.confirms:
+			if !ok {
+				return errors.New("channel closed before publish was confirmed")
+			}
+			if confirm.DeliveryTag < deliveryTag {
+				continue
+			}
+			if !confirm.Ack {
+				return errors.New("publish was rejected by the broker")
+			}
+			return nil
+		case <-timeout.C:
+			return errors.New("publish confirmation timeout")
+		}
+	}
+}
+
+// getRoutingKey returns the routing-key of the given event.
+func (h *Handler) getRoutingKey(event string, applicationID int64, devEUI lorawan.EUI64) (string, error) {
+	key := bytes.NewBuffer(nil)
+	err := h.routingKeyTemplate.Execute(key, struct {
+		ApplicationID int64
+		DevEUI        lorawan.EUI64
+		EventType     string
+	}{applicationID, devEUI, event})
+	if err != nil {
+		return "", errors.Wrap(err, "execute template error")
+	}
+	return key.String(), nil
+}
@@ -0,0 +1,306 @@
+// Package azurehandler implements an Azure integration handler, which sends
+// the events either to a Service Bus queue / topic or to an IoT Hub (as
+// device-to-cloud messages), depending on the configured connection string.
+// The REST APIs are used so that the handler is able to use the configured
+// (HTTP) proxy.
+package azurehandler
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/proxy"
+	"github.com/brocaar/lorawan"
+)
+
+// Event types (matching the last topic level of the MQTT integration).
+const (
+	uplinkEvent   = "rx"
+	joinEvent     = "join"
+	ackEvent      = "ack"
+	errorEvent    = "error"
+	statusEvent   = "status"
+	locationEvent = "location"
+	adminEvent    = "admin"
+)
+
+const (
+	iotHubAPIVersion = "2018-06-30"
+	tokenTTL         = time.Hour
+)
+
+// scheme is the scheme used for the REST endpoints (overwritten in tests).
+var scheme = "https"
+
+// HandlerConfig contains the configuration for an Azure handler.
+type HandlerConfig struct {
+	ConnectionString  string `json:"connectionString"`
+	ProxyURL          string `json:"proxyURL,omitempty"`
+	ChangedFieldsOnly bool   `json:"changedFieldsOnly,omitempty"`
+
+	// Events contains the event types (e.g. up and join) which are
+	// forwarded by the integration. When empty, all events are forwarded.
+	Events []string `json:"events,omitempty"`
+}
+
+// Validate validates the HandlerConfig data.
+func (c HandlerConfig) Validate() error {
+	if _, err := parseConnectionString(c.ConnectionString); err != nil {
+		return err
+	}
+	if err := handler.ValidateEventTypes(c.Events); err != nil {
+		return err
+	}
+	return proxy.Validate(c.ProxyURL)
+}
+
+// connectionString holds the parsed connection string. In case of a
+// Service Bus connection string, EntityPath is set, in case of an IoT Hub
+// connection string, HostName is set.
+type connectionString struct {
+	Endpoint            string
+	HostName            string
+	SharedAccessKeyName string
+	SharedAccessKey     string
+	EntityPath          string
+}
+
+func (c connectionString) isIoTHub() bool {
+	return c.HostName != ""
+}
+
+func parseConnectionString(s string) (connectionString, error) {
+	var cs connectionString
+
+	for _, part := range strings.Split(s, ";") {
+		if part == "" {
+			continue
+		}
+
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return cs, ErrInvalidConnectionString
+		}
+
+		switch kv[0] {
+		case "Endpoint":
+			cs.Endpoint = kv[1]
+		case "HostName":
+			cs.HostName = kv[1]
+		case "SharedAccessKeyName":
+			cs.SharedAccessKeyName = kv[1]
+		case "SharedAccessKey":
+			cs.SharedAccessKey = kv[1]
+		case "EntityPath":
+			cs.EntityPath = kv[1]
+		}
+	}
+
+	if cs.SharedAccessKeyName == "" || cs.SharedAccessKey == "" {
+		return cs, ErrInvalidConnectionString
+	}
+
+	if cs.isIoTHub() {
+		if cs.Endpoint != "" {
+			return cs, ErrInvalidConnectionString
+		}
+		if _, err := base64.StdEncoding.DecodeString(cs.SharedAccessKey); err != nil {
+			return cs, ErrInvalidConnectionString
+		}
+		return cs, nil
+	}
+
+	u, err := url.Parse(cs.Endpoint)
+	if err != nil || u.Scheme != "sb" || u.Host == "" || cs.EntityPath == "" {
+		return cs, ErrInvalidConnectionString
+	}
+	cs.Endpoint = u.Host
+
+	return cs, nil
+}
+
+// Handler implements an Azure handler.
+type Handler struct {
+	config HandlerConfig
+	conn   connectionString
+}
+
+// NewHandler creates a new Azure handler.
+func NewHandler(conf HandlerConfig) (*Handler, error) {
+	conn, err := parseConnectionString(conf.ConnectionString)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Handler{
+		config: conf,
+		conn:   conn,
+	}, nil
+}
+
+// Close closes the handler.
+func (h *Handler) Close() error {
+	return nil
+}
+
+// SendDataUp sends a DataUpPayload.
+func (h *Handler) SendDataUp(pl handler.DataUpPayload) error {
+	return h.send(uplinkEvent, pl.ApplicationID, pl.DevEUI, pl)
+}
+
+// SendJoinNotification sends a JoinNotification.
+func (h *Handler) SendJoinNotification(pl handler.JoinNotification) error {
+	return h.send(joinEvent, pl.ApplicationID, pl.DevEUI, pl)
+}
+
+// SendACKNotification sends an ACKNotification.
+func (h *Handler) SendACKNotification(pl handler.ACKNotification) error {
+	return h.send(ackEvent, pl.ApplicationID, pl.DevEUI, pl)
+}
+
+// SendErrorNotification sends an ErrorNotification.
+func (h *Handler) SendErrorNotification(pl handler.ErrorNotification) error {
+	return h.send(errorEvent, pl.ApplicationID, pl.DevEUI, pl)
+}
+
+// SendStatusNotification sends a StatusNotification.
+func (h *Handler) SendStatusNotification(pl handler.StatusNotification) error {
+	return h.send(statusEvent, pl.ApplicationID, pl.DevEUI, pl)
+}
+
+// SendLocationNotification sends a LocationNotification.
+func (h *Handler) SendLocationNotification(pl handler.LocationNotification) error {
+	return h.send(locationEvent, pl.ApplicationID, pl.DevEUI, pl)
+}
+
+// SendAdminEvent sends an AdminEvent. As IoT Hub messages are always sent
+// on behalf of a device, admin events are only sent to Service Bus.
+func (h *Handler) SendAdminEvent(pl handler.AdminEvent) error {
+	if h.conn.isIoTHub() {
+		return nil
+	}
+	return h.send(adminEvent, pl.ApplicationID, lorawan.EUI64{}, pl)
+}
+
+func (h *Handler) send(event string, applicationID int64, devEUI lorawan.EUI64, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	props := map[string]string{
+		"event":          event,
+		"application_id": strconv.FormatInt(applicationID, 10),
+		"dev_eui":        devEUI.String(),
+	}
+
+	var req *http.Request
+	if h.conn.isIoTHub() {
+		req, err = h.newIoTHubRequest(devEUI, props, b)
+	} else {
+		req, err = h.newServiceBusRequest(props, b)
+	}
+	if err != nil {
+		return err
+	}
+
+	client, err := proxy.GetHTTPClient(h.config.ProxyURL)
+	if err != nil {
+		return errors.Wrap(err, "get http client error")
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "http request error")
+	}
+	defer resp.Body.Close()
+
+	// check that response is in 200 range
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("expected 2xx response, got: %d (%s)", resp.StatusCode, string(b))
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui": devEUI,
+		"event":   event,
+		"url":     req.URL.String(),
+	}).Info("handler/azure: event sent")
+
+	return nil
+}
+
+// newServiceBusRequest returns the request for sending a message to the
+// Service Bus queue or topic. The properties are sent as custom (user)
+// properties of the message.
+func (h *Handler) newServiceBusRequest(props map[string]string, b []byte) (*http.Request, error) {
+	resourceURI := fmt.Sprintf("%s://%s/%s", scheme, h.conn.Endpoint, h.conn.EntityPath)
+
+	req, err := http.NewRequest("POST", resourceURI+"/messages", bytes.NewReader(b))
+	if err != nil {
+		return nil, errors.Wrap(err, "new request error")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", sasToken(resourceURI, h.conn.SharedAccessKeyName, []byte(h.conn.SharedAccessKey), time.Now().Add(tokenTTL)))
+	for k, v := range props {
+		// string values of custom properties must be quoted, the header
+		// map is used directly to preserve the case of the property name
+		req.Header[k] = []string{strconv.Quote(v)}
+	}
+
+	return req, nil
+}
+
+// newIoTHubRequest returns the request for sending a device-to-cloud
+// message on behalf of the device. The properties are sent as application
+// properties of the message.
+func (h *Handler) newIoTHubRequest(devEUI lorawan.EUI64, props map[string]string, b []byte) (*http.Request, error) {
+	resourceURI := fmt.Sprintf("%s/devices/%s", h.conn.HostName, devEUI)
+
+	key, err := base64.StdEncoding.DecodeString(h.conn.SharedAccessKey)
+	if err != nil {
+		return nil, ErrInvalidConnectionString
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s://%s/messages/events?api-version=%s", scheme, resourceURI, iotHubAPIVersion), bytes.NewReader(b))
+	if err != nil {
+		return nil, errors.Wrap(err, "new request error")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("iothub-contenttype", "application/json")
+	req.Header.Set("iothub-contentencoding", "utf-8")
+	req.Header.Set("Authorization", sasToken(resourceURI, h.conn.SharedAccessKeyName, key, time.Now().Add(tokenTTL)))
+	for k, v := range props {
+		req.Header["iothub-app-"+k] = []string{v}
+	}
+
+	return req, nil
+}
+
+// sasToken returns the shared access signature token for the given resource.
+func sasToken(resourceURI, keyName string, key []byte, expiry time.Time) string {
+	sr := url.QueryEscape(resourceURI)
+	se := strconv.FormatInt(expiry.Unix(), 10)
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(sr + "\n" + se))
+	sig := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	return fmt.Sprintf("SharedAccessSignature sr=%s&sig=%s&se=%s&skn=%s", sr, url.QueryEscape(sig), se, url.QueryEscape(keyName))
+}
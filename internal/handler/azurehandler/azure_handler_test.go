@@ -0,0 +1,167 @@
+package azurehandler
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lorawan"
+)
+
+type testHTTPHandler struct {
+	requests chan *http.Request
+}
+
+func (h *testHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b, _ := ioutil.ReadAll(r.Body)
+	r.Body = ioutil.NopCloser(bytes.NewReader(b))
+	h.requests <- r
+	w.WriteHeader(http.StatusCreated)
+}
+
+func TestParseConnectionString(t *testing.T) {
+	Convey("Given a set of connection strings", t, func() {
+		tests := []struct {
+			Name          string
+			Value         string
+			ExpectedError error
+			IoTHub        bool
+		}{
+			{
+				Name:   "service bus",
+				Value:  "Endpoint=sb://test.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=c2VjcmV0;EntityPath=events",
+				IoTHub: false,
+			},
+			{
+				Name:   "iot hub",
+				Value:  "HostName=test.azure-devices.net;SharedAccessKeyName=device;SharedAccessKey=c2VjcmV0",
+				IoTHub: true,
+			},
+			{
+				Name:          "service bus without entity path",
+				Value:         "Endpoint=sb://test.servicebus.windows.net/;SharedAccessKeyName=send;SharedAccessKey=c2VjcmV0",
+				ExpectedError: ErrInvalidConnectionString,
+			},
+			{
+				Name:          "iot hub with invalid key",
+				Value:         "HostName=test.azure-devices.net;SharedAccessKeyName=device;SharedAccessKey=not base64",
+				ExpectedError: ErrInvalidConnectionString,
+			},
+			{
+				Name:          "missing key",
+				Value:         "HostName=test.azure-devices.net;SharedAccessKeyName=device",
+				ExpectedError: ErrInvalidConnectionString,
+			},
+			{
+				Name:          "garbage",
+				Value:         "foo",
+				ExpectedError: ErrInvalidConnectionString,
+			},
+		}
+
+		for _, test := range tests {
+			Convey("Testing: "+test.Name, func() {
+				cs, err := parseConnectionString(test.Value)
+				So(err, ShouldEqual, test.ExpectedError)
+				if err == nil {
+					So(cs.isIoTHub(), ShouldEqual, test.IoTHub)
+				}
+			})
+		}
+	})
+}
+
+func TestHandler(t *testing.T) {
+	Convey("Given a test HTTP server", t, func() {
+		httpHandler := testHTTPHandler{
+			requests: make(chan *http.Request, 100),
+		}
+		server := httptest.NewServer(&httpHandler)
+		defer server.Close()
+
+		host := strings.TrimPrefix(server.URL, "http://")
+		scheme = "http"
+		defer func() { scheme = "https" }()
+
+		devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+		Convey("Given a handler for a Service Bus queue", func() {
+			h, err := NewHandler(HandlerConfig{
+				ConnectionString: "Endpoint=sb://" + host + "/;SharedAccessKeyName=send;SharedAccessKey=secret;EntityPath=events",
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then SendDataUp posts the payload to the queue", func() {
+				So(h.SendDataUp(handler.DataUpPayload{
+					ApplicationID: 1,
+					DevEUI:        devEUI,
+					Data:          []byte{1, 2, 3, 4},
+				}), ShouldBeNil)
+
+				req := <-httpHandler.requests
+				So(req.URL.Path, ShouldEqual, "/events/messages")
+				So(req.Header.Get("Content-Type"), ShouldEqual, "application/json")
+				So(req.Header.Get("event"), ShouldEqual, `"rx"`)
+				So(req.Header.Get("application_id"), ShouldEqual, `"1"`)
+				So(req.Header.Get("dev_eui"), ShouldEqual, `"0102030405060708"`)
+				So(req.Header.Get("Authorization"), ShouldStartWith, "SharedAccessSignature sr="+url.QueryEscape("http://"+host+"/events")+"&sig=")
+				So(req.Header.Get("Authorization"), ShouldEndWith, "&skn=send")
+
+				var pl handler.DataUpPayload
+				So(json.NewDecoder(req.Body).Decode(&pl), ShouldBeNil)
+				So(pl.DevEUI, ShouldEqual, devEUI)
+				So(pl.Data, ShouldResemble, []byte{1, 2, 3, 4})
+			})
+
+			Convey("Then SendAdminEvent posts the event to the queue", func() {
+				So(h.SendAdminEvent(handler.AdminEvent{
+					ApplicationID: 1,
+				}), ShouldBeNil)
+
+				req := <-httpHandler.requests
+				So(req.Header.Get("event"), ShouldEqual, `"admin"`)
+			})
+		})
+
+		Convey("Given a handler for an IoT Hub", func() {
+			h, err := NewHandler(HandlerConfig{
+				ConnectionString: "HostName=" + host + ";SharedAccessKeyName=device;SharedAccessKey=c2VjcmV0",
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then SendStatusNotification posts the payload as device-to-cloud message", func() {
+				So(h.SendStatusNotification(handler.StatusNotification{
+					ApplicationID: 1,
+					DevEUI:        devEUI,
+					Battery:       123,
+				}), ShouldBeNil)
+
+				req := <-httpHandler.requests
+				So(req.URL.Path, ShouldEqual, "/devices/0102030405060708/messages/events")
+				So(req.URL.Query().Get("api-version"), ShouldEqual, iotHubAPIVersion)
+				So(req.Header.Get("iothub-app-event"), ShouldEqual, "status")
+				So(req.Header.Get("iothub-app-dev_eui"), ShouldEqual, "0102030405060708")
+				So(req.Header.Get("Authorization"), ShouldStartWith, "SharedAccessSignature sr="+url.QueryEscape(host+"/devices/0102030405060708")+"&sig=")
+
+				var pl handler.StatusNotification
+				So(json.NewDecoder(req.Body).Decode(&pl), ShouldBeNil)
+				So(pl.Battery, ShouldEqual, 123)
+			})
+
+			Convey("Then SendAdminEvent is a no-op", func() {
+				So(h.SendAdminEvent(handler.AdminEvent{
+					ApplicationID: 1,
+				}), ShouldBeNil)
+				So(httpHandler.requests, ShouldHaveLength, 0)
+			})
+		})
+	})
+}
@@ -0,0 +1,8 @@
+package azurehandler
+
+import "errors"
+
+// errors
+var (
+	ErrInvalidConnectionString = errors.New("invalid connection string (expected a Service Bus connection string with EntityPath or an IoT Hub connection string)")
+)
@@ -1,9 +1,23 @@
 package handler
 
+import (
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lora-app-server/plugin"
+)
+
+// ErrInvalidEventType is returned when the event filter of an integration
+// contains an unknown event type.
+var ErrInvalidEventType = errors.New("invalid event type, valid event types are up, join, ack, error, status and location")
+
 // Handler kinds
 const (
-	HTTPHandlerKind     = "HTTP"
-	InfluxDBHandlerKind = "INFLUXDB"
+	HTTPHandlerKind        = "HTTP"
+	InfluxDBHandlerKind    = "INFLUXDB"
+	AzureHandlerKind       = "AZURE"
+	ThingsBoardHandlerKind = "THINGSBOARD"
+	MyDevicesHandlerKind   = "MY_DEVICES"
+	LoRaCloudHandlerKind   = "LORA_CLOUD"
 )
 
 // Handler defines the interface of a handler backend.
@@ -20,5 +34,19 @@ type IntegrationHandler interface {
 	SendErrorNotification(payload ErrorNotification) error       // send error notification
 	SendStatusNotification(payload StatusNotification) error     // send status notification
 	SendLocationNotification(payload LocationNotification) error // send location notofication
+	SendAdminEvent(payload AdminEvent) error                     // send admin-plane event
 	Close() error                                                // closes the handler
 }
+
+// ValidateEventTypes validates the given event types, used to select the
+// events which are forwarded by an integration.
+func ValidateEventTypes(eventTypes []string) error {
+	for _, t := range eventTypes {
+		switch t {
+		case plugin.UplinkEvent, plugin.JoinEvent, plugin.ACKEvent, plugin.ErrorEvent, plugin.StatusEvent, plugin.LocationEvent:
+		default:
+			return ErrInvalidEventType
+		}
+	}
+	return nil
+}
@@ -5,4 +5,5 @@ import "errors"
 // errors
 var (
 	ErrInvalidHeaderName = errors.New("Invalid header name")
+	ErrInvalidHeaderURL  = errors.New("Header URL does not match any of the integration URLs")
 )
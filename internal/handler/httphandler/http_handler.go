@@ -3,17 +3,38 @@ package httphandler
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"regexp"
+	"time"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/brocaar/lora-app-server/internal/correlation"
 	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/proxy"
+	"github.com/brocaar/lora-app-server/plugin"
+	"github.com/brocaar/lorawan"
 )
 
+// securityEventType defines the event type of the security event
+// deliveries.
+const securityEventType = "security"
+
+// SignatureHeader defines the header containing the payload signature.
+const SignatureHeader = "X-Signature"
+
+// maxResponseBodySize defines the max. size of the response body included
+// in the Delivery (in bytes).
+const maxResponseBodySize = 1024
+
 var headerNameValidator = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
 
 // HandlerConfig contains the configuration for a HTTP handler.
@@ -25,6 +46,20 @@ type HandlerConfig struct {
 	ErrorNotificationURL    string            `json:"errorNotificationURL"`
 	StatusNotificationURL   string            `json:"statusNotificationURL"`
 	LocationNotificationURL string            `json:"locationNotificationURL"`
+	AdminEventURL           string            `json:"adminEventURL"`
+	SecurityEventURL        string            `json:"securityEventURL,omitempty"`
+	ProxyURL                string            `json:"proxyURL,omitempty"`
+	ChangedFieldsOnly       bool              `json:"changedFieldsOnly,omitempty"`
+	SigningSecret           string            `json:"signingSecret,omitempty"`
+
+	// Events contains the event types (e.g. up and join) which are
+	// forwarded by the integration. When empty, all events are forwarded.
+	Events []string `json:"events,omitempty"`
+
+	// EndpointHeaders contains the headers per endpoint URL. These are
+	// set after (and thus override) the Headers, which are set for all
+	// URLs.
+	EndpointHeaders map[string]map[string]string `json:"endpointHeaders,omitempty"`
 }
 
 // Validate validates the HandlerConfig data.
@@ -34,13 +69,100 @@ func (c HandlerConfig) Validate() error {
 			return ErrInvalidHeaderName
 		}
 	}
-	return nil
+
+	urls := make(map[string]bool)
+	for _, url := range []string{c.DataUpURL, c.JoinNotificationURL, c.ACKNotificationURL, c.ErrorNotificationURL, c.StatusNotificationURL, c.LocationNotificationURL, c.AdminEventURL, c.SecurityEventURL} {
+		if url != "" {
+			urls[url] = true
+		}
+	}
+
+	for url, headers := range c.EndpointHeaders {
+		if !urls[url] {
+			return ErrInvalidHeaderURL
+		}
+		for k := range headers {
+			if !headerNameValidator.MatchString(k) {
+				return ErrInvalidHeaderName
+			}
+		}
+	}
+
+	if err := handler.ValidateEventTypes(c.Events); err != nil {
+		return err
+	}
+
+	return proxy.Validate(c.ProxyURL)
+}
+
+// TransformSecrets returns a copy of the configuration in which the given
+// function has been applied to all header values and to the signing secret,
+// e.g. to encrypt these before storing the configuration.
+func (c HandlerConfig) TransformSecrets(f func(string) (string, error)) (HandlerConfig, error) {
+	transform := func(headers map[string]string) (map[string]string, error) {
+		if headers == nil {
+			return nil, nil
+		}
+
+		out := make(map[string]string, len(headers))
+		for k, v := range headers {
+			var err error
+			out[k], err = f(v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "transform header %s error", k)
+			}
+		}
+		return out, nil
+	}
+
+	var err error
+	c.Headers, err = transform(c.Headers)
+	if err != nil {
+		return c, err
+	}
+
+	if c.EndpointHeaders != nil {
+		endpointHeaders := make(map[string]map[string]string, len(c.EndpointHeaders))
+		for url, headers := range c.EndpointHeaders {
+			endpointHeaders[url], err = transform(headers)
+			if err != nil {
+				return c, err
+			}
+		}
+		c.EndpointHeaders = endpointHeaders
+	}
+
+	if c.SigningSecret != "" {
+		c.SigningSecret, err = f(c.SigningSecret)
+		if err != nil {
+			return c, errors.Wrap(err, "transform signing secret error")
+		}
+	}
+
+	return c, nil
+}
+
+// Delivery contains the result of a delivery attempt.
+type Delivery struct {
+	ApplicationID int64
+	DevEUI        lorawan.EUI64
+	EventType     string
+	CorrelationID string
+	URL           string
+	StatusCode    int
+	Latency       time.Duration
+	ResponseBody  []byte // truncated to maxResponseBodySize
+	Error         error
 }
 
+// DeliveryFunc defines the function called after each delivery attempt.
+type DeliveryFunc func(Delivery)
+
 // Handler implements a HTTP handler for sending and notifying a HTTP
 // endpoint.
 type Handler struct {
-	config HandlerConfig
+	config     HandlerConfig
+	onDelivery DeliveryFunc
 }
 
 // NewHandler creates a new HTTPHandler.
@@ -50,7 +172,30 @@ func NewHandler(conf HandlerConfig) (*Handler, error) {
 	}, nil
 }
 
-func (h *Handler) send(url string, payload interface{}) error {
+// SetDeliveryFunc sets the function which is called after each delivery
+// attempt, e.g. for logging the delivery.
+func (h *Handler) SetDeliveryFunc(f DeliveryFunc) {
+	h.onDelivery = f
+}
+
+func (h *Handler) send(url string, d Delivery, payload interface{}) error {
+	d.URL = url
+	start := time.Now()
+
+	err := h.post(url, payload, &d)
+	d.Latency = time.Since(start)
+	d.Error = err
+
+	if h.onDelivery != nil {
+		h.onDelivery(d)
+	}
+
+	return err
+}
+
+// post posts the given payload to the given URL. The status code and
+// (truncated) response body are stored in the given Delivery.
+func (h *Handler) post(url string, payload interface{}, d *Delivery) error {
 	b, err := json.Marshal(payload)
 	if err != nil {
 		return errors.Wrap(err, "marshal json error")
@@ -65,13 +210,33 @@ func (h *Handler) send(url string, payload interface{}) error {
 	for k, v := range h.config.Headers {
 		req.Header.Set(k, v)
 	}
+	for k, v := range h.config.EndpointHeaders[url] {
+		req.Header.Set(k, v)
+	}
+	if d.CorrelationID != "" {
+		req.Header.Set(correlation.HTTPHeader, d.CorrelationID)
+	}
+	if h.config.SigningSecret != "" {
+		req.Header.Set(SignatureHeader, Sign(h.config.SigningSecret, b))
+	}
 
-	resp, err := http.DefaultClient.Do(req)
+	client, err := proxy.GetHTTPClient(h.config.ProxyURL)
+	if err != nil {
+		return errors.Wrap(err, "get http client error")
+	}
+
+	resp, err := client.Do(req)
 	if err != nil {
 		return errors.Wrap(err, "http request error")
 	}
 	defer resp.Body.Close()
 
+	d.StatusCode = resp.StatusCode
+	d.ResponseBody, err = ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
+	if err != nil {
+		return errors.Wrap(err, "read response body error")
+	}
+
 	// check that response is in 200 range
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		return fmt.Errorf("expected 2XX response, got: %d", resp.StatusCode)
@@ -80,6 +245,15 @@ func (h *Handler) send(url string, payload interface{}) error {
 	return nil
 }
 
+// Sign returns the signature of the given payload, using the given secret.
+// The signature is the hex encoded HMAC-SHA256 of the payload, prefixed
+// by sha256= (e.g. sha256=6e1a...).
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
 // Close closes the handler.
 func (h *Handler) Close() error {
 	return nil
@@ -95,7 +269,7 @@ func (h *Handler) SendDataUp(pl handler.DataUpPayload) error {
 		"url":     h.config.DataUpURL,
 		"dev_eui": pl.DevEUI,
 	}).Info("handler/http: publishing data-up payload")
-	return h.send(h.config.DataUpURL, pl)
+	return h.send(h.config.DataUpURL, Delivery{ApplicationID: pl.ApplicationID, DevEUI: pl.DevEUI, EventType: plugin.UplinkEvent, CorrelationID: pl.CorrelationID}, pl)
 }
 
 // SendJoinNotification sends a join notification.
@@ -108,7 +282,7 @@ func (h *Handler) SendJoinNotification(pl handler.JoinNotification) error {
 		"url":     h.config.JoinNotificationURL,
 		"dev_eui": pl.DevEUI,
 	}).Info("handler/http: publishing join notification")
-	return h.send(h.config.JoinNotificationURL, pl)
+	return h.send(h.config.JoinNotificationURL, Delivery{ApplicationID: pl.ApplicationID, DevEUI: pl.DevEUI, EventType: plugin.JoinEvent, CorrelationID: pl.CorrelationID}, pl)
 }
 
 // SendACKNotification sends an ACK notification.
@@ -121,7 +295,7 @@ func (h *Handler) SendACKNotification(pl handler.ACKNotification) error {
 		"url":     h.config.ACKNotificationURL,
 		"dev_eui": pl.DevEUI,
 	}).Info("handler/http: publishing ack notification")
-	return h.send(h.config.ACKNotificationURL, pl)
+	return h.send(h.config.ACKNotificationURL, Delivery{ApplicationID: pl.ApplicationID, DevEUI: pl.DevEUI, EventType: plugin.ACKEvent, CorrelationID: pl.CorrelationID}, pl)
 }
 
 // SendErrorNotification sends an error notification.
@@ -134,7 +308,7 @@ func (h *Handler) SendErrorNotification(pl handler.ErrorNotification) error {
 		"url":     h.config.ErrorNotificationURL,
 		"dev_eui": pl.DevEUI,
 	}).Info("handler/http: publishing error notification")
-	return h.send(h.config.ErrorNotificationURL, pl)
+	return h.send(h.config.ErrorNotificationURL, Delivery{ApplicationID: pl.ApplicationID, DevEUI: pl.DevEUI, EventType: plugin.ErrorEvent, CorrelationID: pl.CorrelationID}, pl)
 }
 
 // SendStatusNotification sends a status notification.
@@ -147,7 +321,7 @@ func (h *Handler) SendStatusNotification(pl handler.StatusNotification) error {
 		"url":     h.config.StatusNotificationURL,
 		"dev_eui": pl.DevEUI,
 	}).Info("handler/http: publishing status notification")
-	return h.send(h.config.StatusNotificationURL, pl)
+	return h.send(h.config.StatusNotificationURL, Delivery{ApplicationID: pl.ApplicationID, DevEUI: pl.DevEUI, EventType: plugin.StatusEvent, CorrelationID: pl.CorrelationID}, pl)
 }
 
 // SendLocationNotification sends a location notification.
@@ -160,5 +334,33 @@ func (h *Handler) SendLocationNotification(pl handler.LocationNotification) erro
 		"url":     h.config.LocationNotificationURL,
 		"dev_eui": pl.DevEUI,
 	}).Info("handler/http: publishing location notification")
-	return h.send(h.config.LocationNotificationURL, pl)
+	return h.send(h.config.LocationNotificationURL, Delivery{ApplicationID: pl.ApplicationID, DevEUI: pl.DevEUI, EventType: plugin.LocationEvent, CorrelationID: pl.CorrelationID}, pl)
+}
+
+// SendAdminEvent sends an admin-plane event.
+func (h *Handler) SendAdminEvent(pl handler.AdminEvent) error {
+	if h.config.AdminEventURL == "" {
+		return nil
+	}
+
+	log.WithFields(log.Fields{
+		"url":    h.config.AdminEventURL,
+		"entity": pl.Entity,
+		"action": pl.Action,
+		"id":     pl.ID,
+	}).Info("handler/http: publishing admin event")
+	return h.send(h.config.AdminEventURL, Delivery{ApplicationID: pl.ApplicationID, EventType: plugin.AdminEvent, CorrelationID: pl.CorrelationID}, pl)
+}
+
+// SendSecurityEvent sends a (JSON encoded) security event. Security events
+// are only sent by organization integrations.
+func (h *Handler) SendSecurityEvent(pl json.RawMessage) error {
+	if h.config.SecurityEventURL == "" {
+		return nil
+	}
+
+	log.WithFields(log.Fields{
+		"url": h.config.SecurityEventURL,
+	}).Info("handler/http: publishing security event")
+	return h.send(h.config.SecurityEventURL, Delivery{EventType: securityEventType}, pl)
 }
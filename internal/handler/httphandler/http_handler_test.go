@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	. "github.com/smartystreets/goconvey/convey"
 
@@ -52,6 +53,50 @@ func TestHandlerConfig(t *testing.T) {
 				},
 				Valid: false,
 			},
+			{
+				Name: "Valid endpoint headers",
+				HandlerConfig: HandlerConfig{
+					DataUpURL: "http://up",
+					EndpointHeaders: map[string]map[string]string{
+						"http://up": {"Authorization": "Bearer up"},
+					},
+				},
+				Valid: true,
+			},
+			{
+				Name: "Endpoint headers for unknown URL",
+				HandlerConfig: HandlerConfig{
+					DataUpURL: "http://up",
+					EndpointHeaders: map[string]map[string]string{
+						"http://join": {"Authorization": "Bearer join"},
+					},
+				},
+				Valid: false,
+			},
+			{
+				Name: "Invalid endpoint header name",
+				HandlerConfig: HandlerConfig{
+					DataUpURL: "http://up",
+					EndpointHeaders: map[string]map[string]string{
+						"http://up": {"Invalid Header": "Test"},
+					},
+				},
+				Valid: false,
+			},
+			{
+				Name: "Valid events",
+				HandlerConfig: HandlerConfig{
+					Events: []string{"up", "join"},
+				},
+				Valid: true,
+			},
+			{
+				Name: "Invalid event",
+				HandlerConfig: HandlerConfig{
+					Events: []string{"up", "unknown"},
+				},
+				Valid: false,
+			},
 		}
 
 		for i, test := range testTable {
@@ -85,6 +130,8 @@ func TestHandler(t *testing.T) {
 			ErrorNotificationURL:    server.URL + "/error",
 			StatusNotificationURL:   server.URL + "/status",
 			LocationNotificationURL: server.URL + "/location",
+			AdminEventURL:           server.URL + "/admin",
+			SecurityEventURL:        server.URL + "/security",
 		}
 		h, err := NewHandler(conf)
 		So(err, ShouldBeNil)
@@ -188,5 +235,184 @@ func TestHandler(t *testing.T) {
 			So(req.Header.Get("Foo"), ShouldEqual, "Bar")
 			So(req.Header.Get("Content-Type"), ShouldEqual, "application/json")
 		})
+
+		Convey("Then SendAdminEvent sends the correct event", func() {
+			reqPL := handler.AdminEvent{
+				Entity:        handler.DeviceEntity,
+				Action:        handler.CreateAction,
+				ID:            "0102030405060708",
+				ApplicationID: 1,
+				Time:          time.Now().UTC().Truncate(time.Second),
+			}
+			So(h.SendAdminEvent(reqPL), ShouldBeNil)
+
+			req := <-httpHandler.requests
+			So(req.URL.Path, ShouldEqual, "/admin")
+
+			var pl handler.AdminEvent
+			So(json.NewDecoder(req.Body).Decode(&pl), ShouldBeNil)
+			So(pl, ShouldResemble, reqPL)
+			So(req.Header.Get("Foo"), ShouldEqual, "Bar")
+			So(req.Header.Get("Content-Type"), ShouldEqual, "application/json")
+		})
+
+		Convey("Then SendSecurityEvent sends the event as-is", func() {
+			reqPL := json.RawMessage(`{"id":1,"type":"failed_login"}`)
+			So(h.SendSecurityEvent(reqPL), ShouldBeNil)
+
+			req := <-httpHandler.requests
+			So(req.URL.Path, ShouldEqual, "/security")
+
+			b, err := ioutil.ReadAll(req.Body)
+			So(err, ShouldBeNil)
+			So(string(b), ShouldEqual, string(reqPL))
+			So(req.Header.Get("Foo"), ShouldEqual, "Bar")
+			So(req.Header.Get(SignatureHeader), ShouldEqual, "")
+		})
+	})
+}
+
+func TestSign(t *testing.T) {
+	Convey("Then Sign returns the expected HMAC-SHA256 signature", t, func() {
+		So(Sign("key", []byte("The quick brown fox jumps over the lazy dog")), ShouldEqual, "sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8")
+	})
+}
+
+func TestHandlerSigning(t *testing.T) {
+	Convey("Given a test HTTP server and a Handler with signing secret", t, func() {
+		httpHandler := testHTTPHandler{
+			requests: make(chan *http.Request, 100),
+		}
+		server := httptest.NewServer(&httpHandler)
+		defer server.Close()
+
+		h, err := NewHandler(HandlerConfig{
+			DataUpURL:     server.URL + "/dataup",
+			SigningSecret: "secret",
+		})
+		So(err, ShouldBeNil)
+
+		Convey("Then SendDataUp sets the signature of the payload", func() {
+			So(h.SendDataUp(handler.DataUpPayload{Data: []byte{1, 2, 3, 4}}), ShouldBeNil)
+
+			req := <-httpHandler.requests
+			b, err := ioutil.ReadAll(req.Body)
+			So(err, ShouldBeNil)
+			So(req.Header.Get(SignatureHeader), ShouldEqual, Sign("secret", b))
+		})
+	})
+}
+
+func TestHandlerEndpointHeaders(t *testing.T) {
+	Convey("Given a test HTTP server and a Handler with endpoint headers", t, func() {
+		httpHandler := testHTTPHandler{
+			requests: make(chan *http.Request, 100),
+		}
+		server := httptest.NewServer(&httpHandler)
+		defer server.Close()
+
+		h, err := NewHandler(HandlerConfig{
+			Headers: map[string]string{
+				"Foo":           "Bar",
+				"Authorization": "Bearer default",
+			},
+			DataUpURL:           server.URL + "/dataup",
+			JoinNotificationURL: server.URL + "/join",
+			EndpointHeaders: map[string]map[string]string{
+				server.URL + "/dataup": {"Authorization": "Bearer dataup"},
+			},
+		})
+		So(err, ShouldBeNil)
+
+		Convey("Then SendDataUp sets the endpoint headers", func() {
+			So(h.SendDataUp(handler.DataUpPayload{}), ShouldBeNil)
+
+			req := <-httpHandler.requests
+			So(req.Header.Get("Foo"), ShouldEqual, "Bar")
+			So(req.Header.Get("Authorization"), ShouldEqual, "Bearer dataup")
+		})
+
+		Convey("Then SendJoinNotification only sets the shared headers", func() {
+			So(h.SendJoinNotification(handler.JoinNotification{}), ShouldBeNil)
+
+			req := <-httpHandler.requests
+			So(req.Header.Get("Foo"), ShouldEqual, "Bar")
+			So(req.Header.Get("Authorization"), ShouldEqual, "Bearer default")
+		})
+	})
+}
+
+func TestTransformSecrets(t *testing.T) {
+	Convey("Given a HandlerConfig with headers and a signing secret", t, func() {
+		conf := HandlerConfig{
+			Headers: map[string]string{"Foo": "bar"},
+			EndpointHeaders: map[string]map[string]string{
+				"http://up": {"Authorization": "token"},
+			},
+			SigningSecret: "secret",
+		}
+
+		Convey("Then TransformSecrets applies the function to all values", func() {
+			out, err := conf.TransformSecrets(func(v string) (string, error) {
+				return "x-" + v, nil
+			})
+			So(err, ShouldBeNil)
+			So(out.Headers, ShouldResemble, map[string]string{"Foo": "x-bar"})
+			So(out.EndpointHeaders, ShouldResemble, map[string]map[string]string{
+				"http://up": {"Authorization": "x-token"},
+			})
+			So(out.SigningSecret, ShouldEqual, "x-secret")
+
+			Convey("Then the original config is not modified", func() {
+				So(conf.Headers["Foo"], ShouldEqual, "bar")
+				So(conf.SigningSecret, ShouldEqual, "secret")
+				So(conf.EndpointHeaders["http://up"]["Authorization"], ShouldEqual, "token")
+			})
+		})
+
+		Convey("Then TransformSecrets returns the error of the function", func() {
+			_, err := conf.TransformSecrets(func(v string) (string, error) {
+				return "", fmt.Errorf("error")
+			})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestHandlerDeliveryFunc(t *testing.T) {
+	Convey("Given a test HTTP server returning an error and a Handler with delivery func", t, func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write(bytes.Repeat([]byte("x"), 2*maxResponseBodySize))
+		}))
+		defer server.Close()
+
+		h, err := NewHandler(HandlerConfig{
+			DataUpURL: server.URL + "/dataup",
+		})
+		So(err, ShouldBeNil)
+
+		var deliveries []Delivery
+		h.SetDeliveryFunc(func(d Delivery) {
+			deliveries = append(deliveries, d)
+		})
+
+		Convey("Then SendDataUp calls the delivery func with the result", func() {
+			So(h.SendDataUp(handler.DataUpPayload{
+				ApplicationID: 1,
+				DevEUI:        lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			}), ShouldNotBeNil)
+
+			So(deliveries, ShouldHaveLength, 1)
+			d := deliveries[0]
+			So(d.ApplicationID, ShouldEqual, 1)
+			So(d.DevEUI, ShouldEqual, lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8})
+			So(d.EventType, ShouldEqual, "up")
+			So(d.URL, ShouldEqual, server.URL+"/dataup")
+			So(d.StatusCode, ShouldEqual, http.StatusInternalServerError)
+			So(d.ResponseBody, ShouldHaveLength, maxResponseBodySize)
+			So(d.Latency, ShouldBeGreaterThan, 0)
+			So(d.Error, ShouldNotBeNil)
+		})
 	})
 }
@@ -18,6 +18,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/proxy"
 )
 
 var precisionValidator = regexp.MustCompile(`^(ns|u|ms|s|m|h)$`)
@@ -30,6 +31,12 @@ type HandlerConfig struct {
 	Password            string `json:"password"`
 	RetentionPolicyName string `json:"retentionPolicyName"`
 	Precision           string `json:"precision"`
+	ProxyURL            string `json:"proxyURL,omitempty"`
+	ChangedFieldsOnly   bool   `json:"changedFieldsOnly,omitempty"`
+
+	// Events contains the event types (e.g. up and status) which are
+	// forwarded by the integration. When empty, all events are forwarded.
+	Events []string `json:"events,omitempty"`
 }
 
 type measurement struct {
@@ -81,7 +88,10 @@ func (c HandlerConfig) Validate() error {
 	if !precisionValidator.MatchString(c.Precision) {
 		return ErrInvalidPrecision
 	}
-	return nil
+	if err := handler.ValidateEventTypes(c.Events); err != nil {
+		return err
+	}
+	return proxy.Validate(c.ProxyURL)
 }
 
 // Handler implements an InfluxDB handler for writing received sensor-data into
@@ -122,7 +132,12 @@ func (h *Handler) send(measurements []measurement) error {
 		req.SetBasicAuth(h.config.Username, h.config.Password)
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	client, err := proxy.GetHTTPClient(h.config.ProxyURL)
+	if err != nil {
+		return errors.Wrap(err, "get http client error")
+	}
+
+	resp, err := client.Do(req)
 	if err != nil {
 		return errors.Wrap(err, "http request error")
 	}
@@ -165,6 +180,23 @@ func (h *Handler) SendDataUp(pl handler.DataUpPayload) error {
 		},
 	})
 
+	// add the signal measurements of each receiving gateway
+	for _, rx := range pl.RXInfo {
+		measurements = append(measurements, measurement{
+			Name: "device_uplink_rx_info",
+			Tags: map[string]string{
+				"application_name": pl.ApplicationName,
+				"device_name":      pl.DeviceName,
+				"dev_eui":          pl.DevEUI.String(),
+				"gateway_id":       rx.GatewayID.String(),
+			},
+			Values: map[string]interface{}{
+				"rssi":     rx.RSSI,
+				"lora_snr": rx.LoRaSNR,
+			},
+		})
+	}
+
 	// parse object to measurements
 	measurements = append(measurements, objectToMeasurements(pl, "device_frmpayload_data", pl.Object)...)
 
@@ -242,6 +274,11 @@ func (h *Handler) SendLocationNotification(pl handler.LocationNotification) erro
 	return nil
 }
 
+// SendAdminEvent is not implemented.
+func (h *Handler) SendAdminEvent(pl handler.AdminEvent) error {
+	return nil
+}
+
 func objectToMeasurements(pl handler.DataUpPayload, prefix string, obj interface{}) []measurement {
 	var out []measurement
 
@@ -122,6 +122,39 @@ device_frmpayload_data_humidity,application_name=test-app,dev_eui=01020304050607
 device_frmpayload_data_status,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev,f_port=20 value="on"
 device_frmpayload_data_temperature,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev,f_port=20 value=25.400000
 device_uplink,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev,dr=2,frequency=868100000 value=1i`,
+				},
+				{
+					Name: "One level depth + rx info",
+					Payload: handler.DataUpPayload{
+						ApplicationName: "test-app",
+						DeviceName:      "test-dev",
+						DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+						FCnt:            10,
+						FPort:           20,
+						RXInfo: []handler.RXInfo{
+							{
+								GatewayID: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+								RSSI:      -60,
+								LoRaSNR:   5.5,
+							},
+							{
+								GatewayID: lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1},
+								RSSI:      -110,
+								LoRaSNR:   -7.25,
+							},
+						},
+						TXInfo: handler.TXInfo{
+							Frequency: 868100000,
+							DR:        2,
+						},
+						Object: map[string]interface{}{
+							"temperature": 25.4,
+						},
+					},
+					ExpectedBody: `device_frmpayload_data_temperature,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev,f_port=20 value=25.400000
+device_uplink,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev,dr=2,frequency=868100000 value=1i
+device_uplink_rx_info,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev,gateway_id=0101010101010101 lora_snr=-7.250000,rssi=-110i
+device_uplink_rx_info,application_name=test-app,dev_eui=0102030405060708,device_name=test-dev,gateway_id=0807060504030201 lora_snr=5.500000,rssi=-60i`,
 				},
 				{
 					Name: "Mixed level depth",
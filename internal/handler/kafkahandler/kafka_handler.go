@@ -0,0 +1,284 @@
+// Package kafkahandler implements an integration handler producing the
+// events of all applications to a Kafka cluster, so that they can be
+// consumed by stream-processing pipelines. The messages are keyed by
+// DevEUI, so that the events of a device end up in the same partition (and
+// are therefore ordered).
+package kafkahandler
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+	kafka "github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/handler/mqtthandler"
+	"github.com/brocaar/lorawan"
+)
+
+// EventHeader defines the Kafka message header containing the event type.
+const EventHeader = "event"
+
+// Event types.
+const (
+	uplinkEvent   = "up"
+	joinEvent     = "join"
+	ackEvent      = "ack"
+	errorEvent    = "error"
+	statusEvent   = "status"
+	locationEvent = "location"
+	adminEvent    = "admin"
+)
+
+const (
+	defaultTimeout = 10 * time.Second
+
+	// batchTimeout defines the max. time a message is buffered by the
+	// writer before the batch is sent. The writes are synchronous, therefore
+	// this adds to the delivery latency of each event.
+	batchTimeout = 10 * time.Millisecond
+)
+
+// SASL mechanisms.
+const (
+	SASLPlain       = "PLAIN"
+	SASLSCRAMSHA256 = "SCRAM-SHA-256"
+	SASLSCRAMSHA512 = "SCRAM-SHA-512"
+)
+
+// Config holds the configuration for the Kafka handler.
+type Config struct {
+	Brokers       []string      `mapstructure:"brokers"`
+	TopicTemplate string        `mapstructure:"topic_template"`
+	ClientID      string        `mapstructure:"client_id"`
+	Timeout       time.Duration `mapstructure:"timeout"`
+	TLS           bool          `mapstructure:"tls"`
+	CACert        string        `mapstructure:"ca_cert"`
+	TLSCert       string        `mapstructure:"tls_cert"`
+	TLSKey        string        `mapstructure:"tls_key"`
+	SASLMechanism string        `mapstructure:"sasl_mechanism"`
+	Username      string        `mapstructure:"username"`
+	Password      string        `mapstructure:"password"`
+}
+
+// Handler implements a Kafka handler.
+type Handler struct {
+	config        Config
+	topicTemplate *template.Template
+	dialer        *kafka.Dialer
+
+	mu      sync.Mutex
+	writers map[string]*kafka.Writer
+}
+
+// NewHandler creates a new Kafka handler.
+func NewHandler(c Config) (*Handler, error) {
+	if len(c.Brokers) == 0 {
+		return nil, errors.New("at least one broker must be configured")
+	}
+	if c.Timeout == 0 {
+		c.Timeout = defaultTimeout
+	}
+
+	h := Handler{
+		config:  c,
+		writers: make(map[string]*kafka.Writer),
+		dialer: &kafka.Dialer{
+			ClientID:  c.ClientID,
+			Timeout:   c.Timeout,
+			DualStack: true,
+		},
+	}
+
+	var err error
+	h.topicTemplate, err = template.New("topic").Parse(c.TopicTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse topic template error")
+	}
+
+	h.dialer.TLS, err = newTLSConfig(c)
+	if err != nil {
+		return nil, errors.Wrap(err, "load tls config error")
+	}
+
+	h.dialer.SASLMechanism, err = newSASLMechanism(c)
+	if err != nil {
+		return nil, errors.Wrap(err, "setup sasl mechanism error")
+	}
+
+	log.WithField("brokers", c.Brokers).Info("handler/kafka: kafka handler configured")
+
+	return &h, nil
+}
+
+// newTLSConfig returns the TLS configuration. It returns nil when TLS is
+// not enabled.
+func newTLSConfig(c Config) (*tls.Config, error) {
+	tlsConfig, err := mqtthandler.NewTLSConfig(c.CACert, c.TLSCert, c.TLSKey)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig == nil && c.TLS {
+		// use the system root CAs
+		tlsConfig = &tls.Config{}
+	}
+	return tlsConfig, nil
+}
+
+// newSASLMechanism returns the configured SASL mechanism. It returns nil
+// when SASL is not enabled.
+func newSASLMechanism(c Config) (sasl.Mechanism, error) {
+	switch c.SASLMechanism {
+	case "":
+		return nil, nil
+	case SASLPlain:
+		return plain.Mechanism{
+			Username: c.Username,
+			Password: c.Password,
+		}, nil
+	case SASLSCRAMSHA256:
+		return scram.Mechanism(scram.SHA256, c.Username, c.Password)
+	case SASLSCRAMSHA512:
+		return scram.Mechanism(scram.SHA512, c.Username, c.Password)
+	default:
+		return nil, errors.Errorf("unknown sasl mechanism: %s", c.SASLMechanism)
+	}
+}
+
+// SendDataUp sends a DataUpPayload.
+func (h *Handler) SendDataUp(pl handler.DataUpPayload) error {
+	return h.publish(uplinkEvent, pl.ApplicationID, pl.DevEUI, pl)
+}
+
+// SendJoinNotification sends a JoinNotification.
+func (h *Handler) SendJoinNotification(pl handler.JoinNotification) error {
+	return h.publish(joinEvent, pl.ApplicationID, pl.DevEUI, pl)
+}
+
+// SendACKNotification sends an ACKNotification.
+func (h *Handler) SendACKNotification(pl handler.ACKNotification) error {
+	return h.publish(ackEvent, pl.ApplicationID, pl.DevEUI, pl)
+}
+
+// SendErrorNotification sends an ErrorNotification.
+func (h *Handler) SendErrorNotification(pl handler.ErrorNotification) error {
+	return h.publish(errorEvent, pl.ApplicationID, pl.DevEUI, pl)
+}
+
+// SendStatusNotification sends a StatusNotification.
+func (h *Handler) SendStatusNotification(pl handler.StatusNotification) error {
+	return h.publish(statusEvent, pl.ApplicationID, pl.DevEUI, pl)
+}
+
+// SendLocationNotification sends a LocationNotification.
+func (h *Handler) SendLocationNotification(pl handler.LocationNotification) error {
+	return h.publish(locationEvent, pl.ApplicationID, pl.DevEUI, pl)
+}
+
+// SendAdminEvent sends an AdminEvent. As admin events are not related to a
+// device, these messages are produced without key.
+func (h *Handler) SendAdminEvent(pl handler.AdminEvent) error {
+	return h.publish(adminEvent, pl.ApplicationID, lorawan.EUI64{}, pl)
+}
+
+// Close closes the writers.
+func (h *Handler) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	log.Info("handler/kafka: closing handler")
+
+	var err error
+	for topic, w := range h.writers {
+		if closeErr := w.Close(); closeErr != nil {
+			err = errors.Wrapf(closeErr, "close writer for topic %s error", topic)
+		}
+		delete(h.writers, topic)
+	}
+
+	return err
+}
+
+func (h *Handler) publish(event string, applicationID int64, devEUI lorawan.EUI64, v interface{}) error {
+	topic, err := h.getTopic(event, applicationID, devEUI)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	msg := kafka.Message{
+		Value: b,
+		Headers: []kafka.Header{
+			{Key: EventHeader, Value: []byte(event)},
+		},
+	}
+	if devEUI != (lorawan.EUI64{}) {
+		msg.Key = []byte(devEUI.String())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.config.Timeout)
+	defer cancel()
+
+	log.WithFields(log.Fields{
+		"topic":   topic,
+		"event":   event,
+		"dev_eui": devEUI,
+	}).Info("handler/kafka: publishing message")
+
+	if err := h.getWriter(topic).WriteMessages(ctx, msg); err != nil {
+		return errors.Wrap(err, "write message error")
+	}
+
+	return nil
+}
+
+// getTopic returns the topic of the given event.
+func (h *Handler) getTopic(event string, applicationID int64, devEUI lorawan.EUI64) (string, error) {
+	topic := bytes.NewBuffer(nil)
+	err := h.topicTemplate.Execute(topic, struct {
+		ApplicationID int64
+		DevEUI        lorawan.EUI64
+		EventType     string
+	}{applicationID, devEUI, event})
+	if err != nil {
+		return "", errors.Wrap(err, "execute template error")
+	}
+	return topic.String(), nil
+}
+
+// getWriter returns the writer for the given topic, as a writer can only
+// write to a single topic.
+func (h *Handler) getWriter(topic string) *kafka.Writer {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	w, ok := h.writers[topic]
+	if !ok {
+		w = kafka.NewWriter(kafka.WriterConfig{
+			Brokers:      h.config.Brokers,
+			Topic:        topic,
+			Dialer:       h.dialer,
+			Balancer:     &kafka.Hash{},
+			BatchTimeout: batchTimeout,
+			ReadTimeout:  h.config.Timeout,
+			WriteTimeout: h.config.Timeout,
+		})
+		h.writers[topic] = w
+	}
+
+	return w
+}
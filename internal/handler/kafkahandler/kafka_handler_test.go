@@ -0,0 +1,77 @@
+package kafkahandler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lorawan"
+)
+
+func TestNewHandler(t *testing.T) {
+	t.Run("Without brokers", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := NewHandler(Config{TopicTemplate: "events"})
+		assert.Error(err)
+	})
+
+	t.Run("Invalid SASL mechanism", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := NewHandler(Config{
+			Brokers:       []string{"localhost:9092"},
+			TopicTemplate: "events",
+			SASLMechanism: "GSSAPI",
+		})
+		assert.Error(err)
+	})
+
+	t.Run("SASL and TLS", func(t *testing.T) {
+		assert := require.New(t)
+
+		for _, mechanism := range []string{SASLPlain, SASLSCRAMSHA256, SASLSCRAMSHA512} {
+			h, err := NewHandler(Config{
+				Brokers:       []string{"localhost:9092"},
+				TopicTemplate: "events",
+				TLS:           true,
+				SASLMechanism: mechanism,
+				Username:      "user",
+				Password:      "secret",
+			})
+			assert.NoError(err)
+			assert.NotNil(h.dialer.TLS)
+			assert.Equal(mechanism, h.dialer.SASLMechanism.Name())
+		}
+	})
+
+	t.Run("Without SASL and TLS", func(t *testing.T) {
+		assert := require.New(t)
+
+		h, err := NewHandler(Config{
+			Brokers:       []string{"localhost:9092"},
+			TopicTemplate: "events",
+		})
+		assert.NoError(err)
+		assert.Nil(h.dialer.TLS)
+		assert.Nil(h.dialer.SASLMechanism)
+	})
+}
+
+func TestGetTopic(t *testing.T) {
+	assert := require.New(t)
+
+	h, err := NewHandler(Config{
+		Brokers:       []string{"localhost:9092"},
+		TopicTemplate: "application.{{ .ApplicationID }}.device.{{ .DevEUI }}.{{ .EventType }}",
+	})
+	assert.NoError(err)
+
+	topic, err := h.getTopic(uplinkEvent, 123, lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8})
+	assert.NoError(err)
+	assert.Equal("application.123.device.0102030405060708.up", topic)
+
+	w := h.getWriter(topic)
+	assert.True(w == h.getWriter(topic), "writer must be re-used for the same topic")
+	assert.NoError(h.Close())
+}
@@ -0,0 +1,10 @@
+package loracloudhandler
+
+import "errors"
+
+// errors
+var (
+	ErrInvalidToken  = errors.New("token must not be empty")
+	ErrInvalidServer = errors.New("invalid server (expected an http:// or https:// url)")
+	ErrInvalidFPort  = errors.New("invalid fport (expected a value between 1 and 223, each fport can be used only once)")
+)
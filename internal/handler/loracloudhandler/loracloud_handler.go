@@ -0,0 +1,265 @@
+// Package loracloudhandler implements a Semtech LoRa Cloud device &
+// application services integration handler. Uplinks received on the
+// configured modem, GNSS and WiFi FPorts are forwarded to LoRa Cloud. When
+// LoRa Cloud returns a position solution (e.g. resolved from a GNSS or WiFi
+// scan), this position is stored as the device location.
+package loracloudhandler
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/proxy"
+	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/lorawan"
+)
+
+// DefaultServer defines the default LoRa Cloud device & application
+// services server.
+const DefaultServer = "https://das.loracloud.com"
+
+const uplinkSendEndpoint = "/api/v1/uplink/send"
+
+// Message types.
+const (
+	modemMsgType = "modem"
+	gnssMsgType  = "gnss"
+	wifiMsgType  = "wifi"
+)
+
+// LocationFunc defines the function used to store the location resolved
+// by LoRa Cloud as the location of the given device.
+type LocationFunc func(devEUI lorawan.EUI64, loc common.Location) error
+
+// HandlerConfig contains the configuration for a LoRa Cloud handler.
+type HandlerConfig struct {
+	Token     string `json:"token"`
+	Server    string `json:"server,omitempty"`
+	ModemPort uint8  `json:"modemPort,omitempty"`
+	GNSSPort  uint8  `json:"gnssPort,omitempty"`
+	WifiPort  uint8  `json:"wifiPort,omitempty"`
+	ProxyURL  string `json:"proxyURL,omitempty"`
+}
+
+// Validate validates the HandlerConfig data.
+func (c HandlerConfig) Validate() error {
+	if c.Token == "" {
+		return ErrInvalidToken
+	}
+
+	if c.Server != "" {
+		u, err := url.Parse(c.Server)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return ErrInvalidServer
+		}
+	}
+
+	ports := make(map[uint8]struct{})
+	for _, p := range []uint8{c.ModemPort, c.GNSSPort, c.WifiPort} {
+		if p == 0 {
+			continue
+		}
+		if _, ok := ports[p]; ok || p > 223 {
+			return ErrInvalidFPort
+		}
+		ports[p] = struct{}{}
+	}
+
+	return proxy.Validate(c.ProxyURL)
+}
+
+// Handler implements a LoRa Cloud handler.
+type Handler struct {
+	config      HandlerConfig
+	setLocation LocationFunc
+}
+
+// NewHandler creates a new LoRa Cloud handler. The given function is used
+// to store the locations resolved by LoRa Cloud.
+func NewHandler(conf HandlerConfig, setLocation LocationFunc) (*Handler, error) {
+	if conf.Server == "" {
+		conf.Server = DefaultServer
+	}
+	conf.Server = strings.TrimRight(conf.Server, "/")
+
+	return &Handler{
+		config:      conf,
+		setLocation: setLocation,
+	}, nil
+}
+
+// Close closes the handler.
+func (h *Handler) Close() error {
+	return nil
+}
+
+// SendDataUp forwards the uplink to LoRa Cloud when it was received on one
+// of the configured FPorts.
+func (h *Handler) SendDataUp(pl handler.DataUpPayload) error {
+	msgType := h.getMsgType(pl.FPort)
+	if msgType == "" {
+		return nil
+	}
+
+	req := uplinkRequest{
+		formatDevEUI(pl.DevEUI): uplinkMsg{
+			MsgType:   msgType,
+			FCnt:      pl.FCnt,
+			Port:      pl.FPort,
+			Payload:   hex.EncodeToString(pl.Data),
+			DR:        pl.TXInfo.DR,
+			Freq:      pl.TXInfo.Frequency,
+			Timestamp: float64(time.Now().UnixNano()) / float64(time.Second),
+		},
+	}
+
+	var resp uplinkResponse
+	if err := h.send(uplinkSendEndpoint, req, &resp); err != nil {
+		return err
+	}
+
+	item, ok := resp.Result[formatDevEUI(pl.DevEUI)]
+	if !ok {
+		return nil
+	}
+	if item.Error != "" {
+		return fmt.Errorf("lora cloud error: %s", item.Error)
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":  pl.DevEUI,
+		"msg_type": msgType,
+	}).Info("handler/loracloud: uplink sent")
+
+	if item.Result == nil || item.Result.PositionSolution == nil || len(item.Result.PositionSolution.LLH) != 3 {
+		return nil
+	}
+
+	loc := common.Location{
+		Latitude:  item.Result.PositionSolution.LLH[0],
+		Longitude: item.Result.PositionSolution.LLH[1],
+		Altitude:  item.Result.PositionSolution.LLH[2],
+		Accuracy:  uint32(item.Result.PositionSolution.Accuracy),
+		Source:    common.LocationSource_GEO_RESOLVER,
+	}
+
+	// The location is stored asynchronously, as storing the location
+	// results in a location notification, which is delivered by the same
+	// (integration) worker which is calling this handler.
+	go func(devEUI lorawan.EUI64) {
+		if err := h.setLocation(devEUI, loc); err != nil {
+			log.WithError(err).WithField("dev_eui", devEUI).Error("handler/loracloud: set device location error")
+		}
+	}(pl.DevEUI)
+
+	return nil
+}
+
+// SendJoinNotification is not implemented.
+func (h *Handler) SendJoinNotification(pl handler.JoinNotification) error {
+	return nil
+}
+
+// SendACKNotification is not implemented.
+func (h *Handler) SendACKNotification(pl handler.ACKNotification) error {
+	return nil
+}
+
+// SendErrorNotification is not implemented.
+func (h *Handler) SendErrorNotification(pl handler.ErrorNotification) error {
+	return nil
+}
+
+// SendStatusNotification is not implemented.
+func (h *Handler) SendStatusNotification(pl handler.StatusNotification) error {
+	return nil
+}
+
+// SendLocationNotification is not implemented, as this would send the
+// locations resolved by LoRa Cloud back to LoRa Cloud.
+func (h *Handler) SendLocationNotification(pl handler.LocationNotification) error {
+	return nil
+}
+
+// SendAdminEvent is not implemented, as admin events are not related to
+// LoRa Cloud.
+func (h *Handler) SendAdminEvent(pl handler.AdminEvent) error {
+	return nil
+}
+
+// getMsgType returns the LoRa Cloud message type for the given FPort or an
+// empty string when the FPort is not configured.
+func (h *Handler) getMsgType(fPort uint8) string {
+	if fPort == 0 {
+		return ""
+	}
+
+	switch fPort {
+	case h.config.ModemPort:
+		return modemMsgType
+	case h.config.GNSSPort:
+		return gnssMsgType
+	case h.config.WifiPort:
+		return wifiMsgType
+	default:
+		return ""
+	}
+}
+
+func (h *Handler) send(endpoint string, v, out interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	req, err := http.NewRequest("POST", h.config.Server+endpoint, bytes.NewReader(b))
+	if err != nil {
+		return errors.Wrap(err, "new request error")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", h.config.Token)
+
+	client, err := proxy.GetHTTPClient(h.config.ProxyURL)
+	if err != nil {
+		return errors.Wrap(err, "get http client error")
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "http request error")
+	}
+	defer resp.Body.Close()
+
+	// check that response is in 200 range
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("expected 2xx response, got: %d (%s)", resp.StatusCode, string(b))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return errors.Wrap(err, "decode response error")
+	}
+
+	return nil
+}
+
+// formatDevEUI formats the DevEUI as expected by LoRa Cloud
+// (01-02-03-04-05-06-07-08).
+func formatDevEUI(devEUI lorawan.EUI64) string {
+	out := make([]string, len(devEUI))
+	for i := range devEUI {
+		out[i] = hex.EncodeToString(devEUI[i : i+1])
+	}
+	return strings.Join(out, "-")
+}
@@ -0,0 +1,176 @@
+package loracloudhandler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/lorawan"
+)
+
+type testHTTPHandler struct {
+	requests chan *http.Request
+	bodies   chan uplinkRequest
+	response string
+}
+
+func (h *testHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req uplinkRequest
+	json.NewDecoder(r.Body).Decode(&req)
+	h.requests <- r
+	h.bodies <- req
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(h.response))
+}
+
+type location struct {
+	devEUI lorawan.EUI64
+	loc    common.Location
+}
+
+func TestHandlerConfig(t *testing.T) {
+	Convey("Given a set of handler configurations", t, func() {
+		tests := []struct {
+			Name          string
+			Config        HandlerConfig
+			ExpectedError error
+		}{
+			{
+				Name:   "valid",
+				Config: HandlerConfig{Token: "secret", ModemPort: 199, GNSSPort: 198, WifiPort: 197},
+			},
+			{
+				Name:          "missing token",
+				Config:        HandlerConfig{ModemPort: 199},
+				ExpectedError: ErrInvalidToken,
+			},
+			{
+				Name:          "invalid server",
+				Config:        HandlerConfig{Token: "secret", Server: "das.loracloud.com"},
+				ExpectedError: ErrInvalidServer,
+			},
+			{
+				Name:          "duplicate fport",
+				Config:        HandlerConfig{Token: "secret", ModemPort: 199, GNSSPort: 199},
+				ExpectedError: ErrInvalidFPort,
+			},
+			{
+				Name:          "invalid fport",
+				Config:        HandlerConfig{Token: "secret", ModemPort: 224},
+				ExpectedError: ErrInvalidFPort,
+			},
+		}
+
+		for _, test := range tests {
+			Convey("Testing: "+test.Name, func() {
+				So(test.Config.Validate(), ShouldEqual, test.ExpectedError)
+			})
+		}
+	})
+}
+
+func TestHandler(t *testing.T) {
+	Convey("Given a test HTTP server", t, func() {
+		httpHandler := testHTTPHandler{
+			requests: make(chan *http.Request, 100),
+			bodies:   make(chan uplinkRequest, 100),
+		}
+		server := httptest.NewServer(&httpHandler)
+		defer server.Close()
+
+		locations := make(chan location, 10)
+		h, err := NewHandler(HandlerConfig{
+			Token:     "secret",
+			Server:    server.URL,
+			ModemPort: 199,
+			GNSSPort:  198,
+		}, func(devEUI lorawan.EUI64, loc common.Location) error {
+			locations <- location{devEUI: devEUI, loc: loc}
+			return nil
+		})
+		So(err, ShouldBeNil)
+
+		devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+		Convey("Then SendDataUp forwards modem uplinks", func() {
+			httpHandler.response = `{"result": {"01-02-03-04-05-06-07-08": {"result": {}, "error": ""}}}`
+
+			So(h.SendDataUp(handler.DataUpPayload{
+				DevEUI: devEUI,
+				FCnt:   10,
+				FPort:  199,
+				Data:   []byte{1, 2, 3, 4},
+				TXInfo: handler.TXInfo{
+					Frequency: 868100000,
+					DR:        5,
+				},
+			}), ShouldBeNil)
+
+			req := <-httpHandler.requests
+			So(req.URL.Path, ShouldEqual, "/api/v1/uplink/send")
+			So(req.Header.Get("Authorization"), ShouldEqual, "secret")
+
+			body := <-httpHandler.bodies
+			msg, ok := body["01-02-03-04-05-06-07-08"]
+			So(ok, ShouldBeTrue)
+			So(msg.Timestamp, ShouldBeGreaterThan, 0)
+			msg.Timestamp = 0
+			So(msg, ShouldResemble, uplinkMsg{
+				MsgType: "modem",
+				FCnt:    10,
+				Port:    199,
+				Payload: "01020304",
+				DR:      5,
+				Freq:    868100000,
+			})
+			So(locations, ShouldHaveLength, 0)
+		})
+
+		Convey("Then SendDataUp forwards GNSS scans and stores the resolved position", func() {
+			httpHandler.response = `{"result": {"01-02-03-04-05-06-07-08": {"result": {"position_solution": {"llh": [1.123, 2.123, 3], "accuracy": 10}}, "error": ""}}}`
+
+			So(h.SendDataUp(handler.DataUpPayload{
+				DevEUI: devEUI,
+				FPort:  198,
+				Data:   []byte{1, 2, 3, 4},
+			}), ShouldBeNil)
+
+			body := <-httpHandler.bodies
+			So(body["01-02-03-04-05-06-07-08"].MsgType, ShouldEqual, "gnss")
+
+			loc := <-locations
+			So(loc, ShouldResemble, location{
+				devEUI: devEUI,
+				loc: common.Location{
+					Latitude:  1.123,
+					Longitude: 2.123,
+					Altitude:  3,
+					Accuracy:  10,
+					Source:    common.LocationSource_GEO_RESOLVER,
+				},
+			})
+		})
+
+		Convey("Then SendDataUp returns the LoRa Cloud error", func() {
+			httpHandler.response = `{"result": {"01-02-03-04-05-06-07-08": {"result": null, "error": "unknown device"}}}`
+
+			So(h.SendDataUp(handler.DataUpPayload{
+				DevEUI: devEUI,
+				FPort:  199,
+			}), ShouldNotBeNil)
+		})
+
+		Convey("Then SendDataUp ignores uplinks on other fports", func() {
+			So(h.SendDataUp(handler.DataUpPayload{
+				DevEUI: devEUI,
+				FPort:  10,
+			}), ShouldBeNil)
+			So(httpHandler.requests, ShouldHaveLength, 0)
+		})
+	})
+}
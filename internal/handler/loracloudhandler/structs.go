@@ -0,0 +1,33 @@
+package loracloudhandler
+
+// uplinkRequest contains the uplinks to send, by DevEUI (formatted as
+// 01-02-03-04-05-06-07-08).
+type uplinkRequest map[string]uplinkMsg
+
+type uplinkMsg struct {
+	MsgType   string  `json:"msgtype"`
+	FCnt      uint32  `json:"fcnt"`
+	Port      uint8   `json:"port"`
+	Payload   string  `json:"payload"`
+	DR        int     `json:"dr"`
+	Freq      int     `json:"freq"`
+	Timestamp float64 `json:"timestamp"`
+}
+
+type uplinkResponse struct {
+	Result map[string]uplinkResponseItem `json:"result"`
+}
+
+type uplinkResponseItem struct {
+	Result *uplinkResult `json:"result"`
+	Error  string        `json:"error"`
+}
+
+type uplinkResult struct {
+	PositionSolution *positionSolution `json:"position_solution"`
+}
+
+type positionSolution struct {
+	LLH      []float64 `json:"llh"`
+	Accuracy float64   `json:"accuracy"`
+}
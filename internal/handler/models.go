@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/brocaar/lora-app-server/internal/codec"
 	"github.com/brocaar/lorawan"
 )
 
@@ -15,6 +16,7 @@ func init() {
 	gob.Register(ErrorNotification{})
 	gob.Register(StatusNotification{})
 	gob.Register(LocationNotification{})
+	gob.Register(AdminEvent{})
 }
 
 // Location details.
@@ -22,6 +24,8 @@ type Location struct {
 	Latitude  float64 `json:"latitude"`
 	Longitude float64 `json:"longitude"`
 	Altitude  float64 `json:"altitude"`
+	Accuracy  uint32  `json:"accuracy,omitempty"` // accuracy in meters (when known)
+	Source    string  `json:"source,omitempty"`   // location source (when known)
 }
 
 // RXInfo contains the RX information.
@@ -53,6 +57,9 @@ type DataUpPayload struct {
 	FPort           uint8         `json:"fPort"`
 	Data            []byte        `json:"data"`
 	Object          interface{}   `json:"object,omitempty"`
+	Region          string        `json:"region,omitempty"`
+	PortLabel       string        `json:"portLabel,omitempty"`
+	CorrelationID   string        `json:"correlationID,omitempty"`
 }
 
 // DataDownPayload represents a data-down payload.
@@ -73,6 +80,7 @@ type JoinNotification struct {
 	DeviceName      string          `json:"deviceName"`
 	DevEUI          lorawan.EUI64   `json:"devEUI"`
 	DevAddr         lorawan.DevAddr `json:"devAddr"`
+	CorrelationID   string          `json:"correlationID,omitempty"`
 }
 
 // ACKNotification defines the payload sent to the application
@@ -84,6 +92,7 @@ type ACKNotification struct {
 	DevEUI          lorawan.EUI64 `json:"devEUI"`
 	Acknowledged    bool          `json:"acknowledged"`
 	FCnt            uint32        `json:"fCnt"`
+	CorrelationID   string        `json:"correlationID,omitempty"`
 }
 
 // ErrorNotification defines the payload sent to the application
@@ -96,6 +105,11 @@ type ErrorNotification struct {
 	Type            string        `json:"type"`
 	Error           string        `json:"error"`
 	FCnt            uint32        `json:"fCnt,omitempty"`
+	CorrelationID   string        `json:"correlationID,omitempty"`
+
+	// CodecError is set when the codec script failed, it contains the
+	// location within the script, the stack trace and the input bytes.
+	CodecError *codec.ScriptError `json:"codecError,omitempty"`
 }
 
 // StatusNotification defines the payload sent to the application
@@ -107,6 +121,7 @@ type StatusNotification struct {
 	DevEUI          lorawan.EUI64 `json:"devEUI"`
 	Battery         int           `json:"battery"`
 	Margin          int           `json:"margin"`
+	CorrelationID   string        `json:"correlationID,omitempty"`
 }
 
 // LocationNotification defines the payload sent to the application after
@@ -117,4 +132,39 @@ type LocationNotification struct {
 	DeviceName      string        `json:"deviceName"`
 	DevEUI          lorawan.EUI64 `json:"devEUI"`
 	Location        Location      `json:"location"`
+	CorrelationID   string        `json:"correlationID,omitempty"`
+}
+
+// Admin-plane entities.
+const (
+	OrganizationEntity = "organization"
+	ApplicationEntity  = "application"
+	DeviceEntity       = "device"
+	GatewayEntity      = "gateway"
+	IntegrationEntity  = "integration"
+	RegistrationEntity = "registration"
+)
+
+// Admin-plane actions.
+const (
+	CreateAction = "create"
+	UpdateAction = "update"
+	DeleteAction = "delete"
+
+	// DecryptUplinkAction is used when an uplink has been decrypted using
+	// the recorded AppSKey of a device (see the DecryptUplink API).
+	DecryptUplinkAction = "decrypt_uplink"
+)
+
+// AdminEvent defines the payload sent when an admin-plane entity (e.g. a
+// device or gateway) has been created, updated or deleted.
+type AdminEvent struct {
+	Entity         string    `json:"entity"`
+	Action         string    `json:"action"`
+	ID             string    `json:"id"`
+	OrganizationID int64     `json:"organizationID,string,omitempty"`
+	ApplicationID  int64     `json:"applicationID,string,omitempty"`
+	Username       string    `json:"username,omitempty"`
+	Time           time.Time `json:"time"`
+	CorrelationID  string    `json:"correlationID,omitempty"`
 }
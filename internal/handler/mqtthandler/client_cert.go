@@ -0,0 +1,120 @@
+package mqtthandler
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrClientCANotConfigured is returned when generating a client certificate
+// without a configured client CA certificate and key.
+var ErrClientCANotConfigured = errors.New("mqtt client ca certificate and key are not configured")
+
+// ClientConfig holds the configuration for generating the per-application
+// client certificates.
+type ClientConfig struct {
+	CACert             string        `mapstructure:"ca_cert"`
+	CAKey              string        `mapstructure:"ca_key"`
+	ClientCertLifetime time.Duration `mapstructure:"client_cert_lifetime"`
+}
+
+// ClientCertificate holds a generated client certificate.
+type ClientCertificate struct {
+	// CACert holds the PEM encoded CA certificate.
+	CACert []byte
+
+	// TLSCert holds the PEM encoded client certificate.
+	TLSCert []byte
+
+	// TLSKey holds the PEM encoded client key.
+	TLSKey []byte
+
+	// ExpiresAt holds the expiry of the client certificate.
+	ExpiresAt time.Time
+}
+
+// GenerateClientCertificate generates a client certificate for the given
+// application, signed by the configured client CA. The common name of the
+// certificate is set to the application ID, so that the MQTT broker can
+// use it as username and restrict the topics to those of the application.
+func GenerateClientCertificate(c ClientConfig, applicationID int64) (ClientCertificate, error) {
+	var out ClientCertificate
+
+	if c.CACert == "" || c.CAKey == "" {
+		return out, ErrClientCANotConfigured
+	}
+
+	caCert, err := ioutil.ReadFile(c.CACert)
+	if err != nil {
+		return out, errors.Wrap(err, "read ca certificate error")
+	}
+
+	caKey, err := ioutil.ReadFile(c.CAKey)
+	if err != nil {
+		return out, errors.Wrap(err, "read ca key error")
+	}
+
+	return generateClientCertificate(caCert, caKey, c.ClientCertLifetime, applicationID)
+}
+
+func generateClientCertificate(caCertPEM, caKeyPEM []byte, lifetime time.Duration, applicationID int64) (ClientCertificate, error) {
+	out := ClientCertificate{
+		CACert:    caCertPEM,
+		ExpiresAt: time.Now().Add(lifetime),
+	}
+
+	caKeyPair, err := tls.X509KeyPair(caCertPEM, caKeyPEM)
+	if err != nil {
+		return out, errors.Wrap(err, "load ca key-pair error")
+	}
+
+	caCert, err := x509.ParseCertificate(caKeyPair.Certificate[0])
+	if err != nil {
+		return out, errors.Wrap(err, "parse ca certificate error")
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return out, errors.Wrap(err, "generate serial number error")
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return out, errors.Wrap(err, "generate key error")
+	}
+
+	tmpl := x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName: strconv.FormatInt(applicationID, 10),
+		},
+		NotBefore:   time.Now().Add(-time.Minute),
+		NotAfter:    out.ExpiresAt,
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	b, err := x509.CreateCertificate(rand.Reader, &tmpl, caCert, &key.PublicKey, caKeyPair.PrivateKey)
+	if err != nil {
+		return out, errors.Wrap(err, "create certificate error")
+	}
+	out.TLSCert = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: b})
+
+	kb, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return out, errors.Wrap(err, "marshal key error")
+	}
+	out.TLSKey = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: kb})
+
+	return out, nil
+}
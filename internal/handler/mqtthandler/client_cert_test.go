@@ -0,0 +1,73 @@
+package mqtthandler
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCA(t *testing.T) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	b, err := x509.CreateCertificate(rand.Reader, &tmpl, &tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	kb, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: b}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: kb})
+}
+
+func TestGenerateClientCertificate(t *testing.T) {
+	t.Run("CA not configured", func(t *testing.T) {
+		_, err := GenerateClientCertificate(ClientConfig{}, 1)
+		require.Equal(t, ErrClientCANotConfigured, err)
+	})
+
+	t.Run("Generate", func(t *testing.T) {
+		assert := require.New(t)
+		caCert, caKey := newTestCA(t)
+
+		cert, err := generateClientCertificate(caCert, caKey, time.Hour, 123)
+		assert.NoError(err)
+		assert.Equal(caCert, cert.CACert)
+
+		_, err = tls.X509KeyPair(cert.TLSCert, cert.TLSKey)
+		assert.NoError(err)
+
+		block, _ := pem.Decode(cert.TLSCert)
+		assert.NotNil(block)
+		c, err := x509.ParseCertificate(block.Bytes)
+		assert.NoError(err)
+		assert.Equal("123", c.Subject.CommonName)
+		assert.Equal([]x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, c.ExtKeyUsage)
+
+		pool := x509.NewCertPool()
+		assert.True(pool.AppendCertsFromPEM(caCert))
+		_, err = c.Verify(x509.VerifyOptions{
+			Roots:     pool,
+			KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		})
+		assert.NoError(err)
+	})
+}
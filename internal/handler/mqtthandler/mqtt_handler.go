@@ -10,6 +10,7 @@ import (
 	"io/ioutil"
 	"regexp"
 	"strconv"
+	"strings"
 	"sync"
 	"text/template"
 	"time"
@@ -25,24 +26,78 @@ import (
 
 const downlinkLockTTL = time.Millisecond * 100
 
+// applicationTopicsSyncInterval defines the interval in which the
+// application specific downlink topics are (un)subscribed.
+var applicationTopicsSyncInterval = time.Minute
+
 // Config holds the configuration for the MQTT handler.
 type Config struct {
-	Server                string
-	Username              string
-	Password              string
-	QOS                   uint8  `mapstructure:"qos"`
-	CleanSession          bool   `mapstructure:"clean_session"`
-	ClientID              string `mapstructure:"client_id"`
-	CACert                string `mapstructure:"ca_cert"`
-	TLSCert               string `mapstructure:"tls_cert"`
-	TLSKey                string `mapstructure:"tls_key"`
-	UplinkTopicTemplate   string `mapstructure:"uplink_topic_template"`
-	DownlinkTopicTemplate string `mapstructure:"downlink_topic_template"`
-	JoinTopicTemplate     string `mapstructure:"join_topic_template"`
-	AckTopicTemplate      string `mapstructure:"ack_topic_template"`
-	ErrorTopicTemplate    string `mapstructure:"error_topic_template"`
-	StatusTopicTemplate   string `mapstructure:"status_topic_template"`
-	LocationTopicTemplate string `mapstructure:"location_topic_template"`
+	Server                  string
+	Username                string
+	Password                string
+	QOS                     uint8  `mapstructure:"qos"`
+	CleanSession            bool   `mapstructure:"clean_session"`
+	ClientID                string `mapstructure:"client_id"`
+	CACert                  string `mapstructure:"ca_cert"`
+	TLSCert                 string `mapstructure:"tls_cert"`
+	TLSKey                  string `mapstructure:"tls_key"`
+	UplinkTopicTemplate     string `mapstructure:"uplink_topic_template"`
+	DownlinkTopicTemplate   string `mapstructure:"downlink_topic_template"`
+	JoinTopicTemplate       string `mapstructure:"join_topic_template"`
+	AckTopicTemplate        string `mapstructure:"ack_topic_template"`
+	ErrorTopicTemplate      string `mapstructure:"error_topic_template"`
+	StatusTopicTemplate     string `mapstructure:"status_topic_template"`
+	LocationTopicTemplate   string `mapstructure:"location_topic_template"`
+	AdminEventTopicTemplate string `mapstructure:"admin_event_topic_template"`
+
+	// Client holds the configuration for generating the per-application
+	// client certificates.
+	Client ClientConfig `mapstructure:"client"`
+}
+
+// TopicTemplates holds the topic templates of an application. Empty
+// templates fall back to the configured topic templates.
+type TopicTemplates struct {
+	Uplink   string
+	Downlink string
+	Join     string
+	Ack      string
+	Error    string
+	Status   string
+	Location string
+}
+
+// get returns the topic template for the given template name.
+func (t TopicTemplates) get(name string) string {
+	switch name {
+	case "uplink":
+		return t.Uplink
+	case "downlink":
+		return t.Downlink
+	case "join":
+		return t.Join
+	case "ack":
+		return t.Ack
+	case "error":
+		return t.Error
+	case "status":
+		return t.Status
+	case "location":
+		return t.Location
+	default:
+		return ""
+	}
+}
+
+// TopicTemplateStore provides the application specific topic templates.
+type TopicTemplateStore interface {
+	// GetTopicTemplates returns the topic templates of the given
+	// application.
+	GetTopicTemplates(applicationID int64) (TopicTemplates, error)
+
+	// GetDownlinkTopicTemplates returns the downlink topic templates, by
+	// application ID, of the applications with a downlink topic template.
+	GetDownlinkTopicTemplates() (map[int64]string, error)
 }
 
 // MQTTHandler implements a MQTT handler for sending and receiving data by
@@ -60,17 +115,28 @@ type MQTTHandler struct {
 	errorTemplate    *template.Template
 	statusTemplate   *template.Template
 	locationTemplate *template.Template
+	adminTemplate    *template.Template
 	downlinkTopic    string
 	downlinkRegexp   *regexp.Regexp
+
+	store             TopicTemplateStore
+	applicationTopics map[int64]string
+	applicationMux    sync.Mutex
+	closed            chan struct{}
 }
 
-// NewHandler creates a new MQTT handler.
-func NewHandler(p *redis.Pool, c Config) (handler.Handler, error) {
+// NewHandler creates a new MQTT handler. The (optional) store provides the
+// application specific topic templates, which override the configured
+// topic templates.
+func NewHandler(p *redis.Pool, c Config, store TopicTemplateStore) (handler.Handler, error) {
 	var err error
 	h := MQTTHandler{
-		dataDownChan: make(chan handler.DataDownPayload),
-		redisPool:    p,
-		config:       c,
+		dataDownChan:      make(chan handler.DataDownPayload),
+		redisPool:         p,
+		config:            c,
+		store:             store,
+		applicationTopics: make(map[int64]string),
+		closed:            make(chan struct{}),
 	}
 
 	h.uplinkTemplate, err = template.New("uplink").Parse(h.config.UplinkTopicTemplate)
@@ -101,6 +167,10 @@ func NewHandler(p *redis.Pool, c Config) (handler.Handler, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "parse location template error")
 	}
+	h.adminTemplate, err = template.New("admin").Parse(h.config.AdminEventTopicTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse admin event template error")
+	}
 
 	// generate downlink topic matching all applications and devices
 	topic := bytes.NewBuffer(nil)
@@ -136,7 +206,7 @@ func NewHandler(p *redis.Pool, c Config) (handler.Handler, error) {
 	opts.SetOnConnectHandler(h.onConnected)
 	opts.SetConnectionLostHandler(h.onConnectionLost)
 
-	tlsconfig, err := newTLSConfig(h.config.CACert, h.config.TLSCert, h.config.TLSKey)
+	tlsconfig, err := NewTLSConfig(h.config.CACert, h.config.TLSCert, h.config.TLSKey)
 	if err != nil {
 		log.WithError(err).WithFields(log.Fields{
 			"ca_cert":  h.config.CACert,
@@ -158,10 +228,55 @@ func NewHandler(p *redis.Pool, c Config) (handler.Handler, error) {
 			break
 		}
 	}
+
+	if h.store != nil {
+		go h.applicationTopicsLoop()
+	}
+
 	return &h, nil
 }
 
-func newTLSConfig(cafile, certFile, certKeyFile string) (*tls.Config, error) {
+// CheckConnection validates the given configuration (e.g. the credentials)
+// by connecting to the MQTT broker and disconnecting again. To not affect
+// the session of a running instance, a different client ID and a clean
+// session are used.
+func CheckConnection(c Config, timeout time.Duration) error {
+	opts := mqtt.NewClientOptions()
+	opts.AddBroker(c.Server)
+	opts.SetUsername(c.Username)
+	opts.SetPassword(c.Password)
+	opts.SetCleanSession(true)
+	opts.SetAutoReconnect(false)
+	opts.SetConnectTimeout(timeout)
+	if c.ClientID != "" {
+		opts.SetClientID(c.ClientID + "-check")
+	}
+
+	tlsconfig, err := NewTLSConfig(c.CACert, c.TLSCert, c.TLSKey)
+	if err != nil {
+		return errors.Wrap(err, "load tls config error")
+	}
+	if tlsconfig != nil {
+		opts.SetTLSConfig(tlsconfig)
+	}
+
+	conn := mqtt.NewClient(opts)
+	token := conn.Connect()
+	if !token.WaitTimeout(timeout) {
+		return errors.New("connect to mqtt broker timeout")
+	}
+	if err := token.Error(); err != nil {
+		return errors.Wrap(err, "connect to mqtt broker error")
+	}
+	conn.Disconnect(0)
+
+	return nil
+}
+
+// NewTLSConfig returns the TLS configuration for connecting to a MQTT broker,
+// given the CA certificate and / or the client certificate and key files.
+// It returns nil when no files are given.
+func NewTLSConfig(cafile, certFile, certKeyFile string) (*tls.Config, error) {
 	// Here are three valid options:
 	//   - Only CA
 	//   - TLS cert + key
@@ -203,9 +318,20 @@ func newTLSConfig(cafile, certFile, certKeyFile string) (*tls.Config, error) {
 // Close stops the handler.
 func (h *MQTTHandler) Close() error {
 	log.Info("handler/mqtt: closing handler")
-	log.WithField("topic", h.downlinkTopic).Info("handler/mqtt: unsubscribing from tx topic")
-	if token := h.conn.Unsubscribe(h.downlinkTopic); token.Wait() && token.Error() != nil {
-		return fmt.Errorf("handler/mqtt: unsubscribe from %s error: %s", h.downlinkTopic, token.Error())
+	close(h.closed)
+
+	topics := []string{h.downlinkTopic}
+	h.applicationMux.Lock()
+	for _, topic := range h.applicationTopics {
+		topics = append(topics, topic)
+	}
+	h.applicationMux.Unlock()
+
+	for _, topic := range topics {
+		log.WithField("topic", topic).Info("handler/mqtt: unsubscribing from tx topic")
+		if token := h.conn.Unsubscribe(topic); token.Wait() && token.Error() != nil {
+			return fmt.Errorf("handler/mqtt: unsubscribe from %s error: %s", topic, token.Error())
+		}
 	}
 	log.Info("handler/mqtt: handling last items in queue")
 	h.wg.Wait()
@@ -243,12 +369,56 @@ func (h *MQTTHandler) SendLocationNotification(payload handler.LocationNotificat
 	return h.publish(payload.ApplicationID, payload.DevEUI, h.locationTemplate, payload)
 }
 
+// SendAdminEvent sends an AdminEvent. Nothing is published when no admin
+// event topic template has been configured.
+func (h *MQTTHandler) SendAdminEvent(payload handler.AdminEvent) error {
+	if h.config.AdminEventTopicTemplate == "" {
+		return nil
+	}
+	return h.publishTemplate(h.adminTemplate, payload, payload)
+}
+
 func (h *MQTTHandler) publish(applicationID int64, devEUI lorawan.EUI64, topicTemplate *template.Template, v interface{}) error {
-	topic := bytes.NewBuffer(nil)
-	err := topicTemplate.Execute(topic, struct {
+	topicTemplate, err := h.getApplicationTemplate(applicationID, topicTemplate)
+	if err != nil {
+		return err
+	}
+
+	return h.publishTemplate(topicTemplate, struct {
 		ApplicationID int64
 		DevEUI        lorawan.EUI64
-	}{applicationID, devEUI})
+	}{applicationID, devEUI}, v)
+}
+
+// getApplicationTemplate returns the application specific topic template
+// for the given (configured) topic template. It returns the given template
+// when the application has no topic template for it.
+func (h *MQTTHandler) getApplicationTemplate(applicationID int64, topicTemplate *template.Template) (*template.Template, error) {
+	if h.store == nil {
+		return topicTemplate, nil
+	}
+
+	templates, err := h.store.GetTopicTemplates(applicationID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get topic templates error")
+	}
+
+	s := templates.get(topicTemplate.Name())
+	if s == "" {
+		return topicTemplate, nil
+	}
+
+	tmpl, err := template.New(topicTemplate.Name()).Parse(s)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse application template error")
+	}
+
+	return tmpl, nil
+}
+
+func (h *MQTTHandler) publishTemplate(topicTemplate *template.Template, topicData interface{}, v interface{}) error {
+	topic := bytes.NewBuffer(nil)
+	err := topicTemplate.Execute(topic, topicData)
 	if err != nil {
 		return errors.Wrap(err, "execute template error")
 	}
@@ -320,6 +490,38 @@ func (h *MQTTHandler) txPayloadHandler(c mqtt.Client, msg mqtt.Message) {
 		return
 	}
 
+	h.handleDataDown(msg, topicApplicationID, topicDevEUI)
+}
+
+// applicationTXPayloadHandler returns the handler for the application
+// specific downlink topic. The DevEUI is matched by the given regexp.
+func (h *MQTTHandler) applicationTXPayloadHandler(applicationID int64, re *regexp.Regexp) mqtt.MessageHandler {
+	return func(c mqtt.Client, msg mqtt.Message) {
+		h.wg.Add(1)
+		defer h.wg.Done()
+
+		log.WithFields(log.Fields{
+			"topic":          msg.Topic(),
+			"application_id": applicationID,
+		}).Info("handler/mqtt: data-down payload received")
+
+		match := re.FindStringSubmatch(msg.Topic())
+		if len(match) != 2 {
+			log.WithField("topic", msg.Topic()).Warning("handler/mqtt: topic regex match error")
+			return
+		}
+
+		var devEUI lorawan.EUI64
+		if err := devEUI.UnmarshalText([]byte(match[1])); err != nil {
+			log.WithError(err).WithField("topic", msg.Topic()).Warning("handler/mqtt: parse deveui error")
+			return
+		}
+
+		h.handleDataDown(msg, applicationID, devEUI)
+	}
+}
+
+func (h *MQTTHandler) handleDataDown(msg mqtt.Message, topicApplicationID int64, topicDevEUI lorawan.EUI64) {
 	var pl handler.DataDownPayload
 	dec := json.NewDecoder(bytes.NewReader(msg.Payload()))
 	if err := dec.Decode(&pl); err != nil {
@@ -348,7 +550,7 @@ func (h *MQTTHandler) txPayloadHandler(c mqtt.Client, msg mqtt.Message) {
 	redisConn := h.redisPool.Get()
 	defer redisConn.Close()
 
-	_, err = redis.String(redisConn.Do("SET", key, "lock", "PX", int64(downlinkLockTTL/time.Millisecond), "NX"))
+	_, err := redis.String(redisConn.Do("SET", key, "lock", "PX", int64(downlinkLockTTL/time.Millisecond), "NX"))
 	if err != nil {
 		if err == redis.ErrNil {
 			// the payload is already being processed by an other instance
@@ -373,8 +575,118 @@ func (h *MQTTHandler) onConnected(c mqtt.Client) {
 			time.Sleep(time.Second)
 			continue
 		}
-		return
+		break
+	}
+
+	if h.store != nil {
+		// the subscriptions must be renewed in case of a clean session
+		h.applicationMux.Lock()
+		h.applicationTopics = make(map[int64]string)
+		h.applicationMux.Unlock()
+
+		if err := h.syncApplicationTopics(); err != nil {
+			log.WithError(err).Error("handler/mqtt: sync application tx topics error")
+		}
+	}
+}
+
+// applicationTopicsLoop periodically syncs the application specific
+// downlink topics, until the handler is closed.
+func (h *MQTTHandler) applicationTopicsLoop() {
+	ticker := time.NewTicker(applicationTopicsSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.closed:
+			return
+		case <-ticker.C:
+			if err := h.syncApplicationTopics(); err != nil {
+				log.WithError(err).Error("handler/mqtt: sync application tx topics error")
+			}
+		}
+	}
+}
+
+// syncApplicationTopics subscribes to the downlink topics of the
+// applications with a downlink topic template and unsubscribes from the
+// topics which are no longer used.
+func (h *MQTTHandler) syncApplicationTopics() error {
+	templates, err := h.store.GetDownlinkTopicTemplates()
+	if err != nil {
+		return errors.Wrap(err, "get downlink topic templates error")
+	}
+
+	h.applicationMux.Lock()
+	defer h.applicationMux.Unlock()
+
+	for applicationID, topic := range h.applicationTopics {
+		newTopic, _, _ := getApplicationDownlinkTopic(applicationID, templates[applicationID])
+		if newTopic == topic {
+			continue
+		}
+
+		log.WithField("topic", topic).Info("handler/mqtt: unsubscribing from application tx topic")
+		if token := h.conn.Unsubscribe(topic); token.Wait() && token.Error() != nil {
+			return errors.Wrapf(token.Error(), "unsubscribe from %s error", topic)
+		}
+		delete(h.applicationTopics, applicationID)
 	}
+
+	for applicationID, s := range templates {
+		if _, ok := h.applicationTopics[applicationID]; ok {
+			continue
+		}
+
+		topic, re, err := getApplicationDownlinkTopic(applicationID, s)
+		if err != nil {
+			log.WithError(err).WithField("application_id", applicationID).Error("handler/mqtt: invalid application downlink topic template")
+			continue
+		}
+
+		log.WithFields(log.Fields{
+			"topic": topic,
+			"qos":   h.config.QOS,
+		}).Info("handler/mqtt: subscribing to application tx topic")
+		if token := h.conn.Subscribe(topic, h.config.QOS, h.applicationTXPayloadHandler(applicationID, re)); token.Wait() && token.Error() != nil {
+			return errors.Wrapf(token.Error(), "subscribe to %s error", topic)
+		}
+		h.applicationTopics[applicationID] = topic
+	}
+
+	return nil
+}
+
+// getApplicationDownlinkTopic returns the downlink topic (matching all
+// devices) and the regexp for matching the DevEUI, given the application
+// specific downlink topic template.
+func getApplicationDownlinkTopic(applicationID int64, s string) (string, *regexp.Regexp, error) {
+	const devEUIPlaceholder = "__DEV_EUI__"
+
+	tmpl, err := template.New("downlink").Parse(s)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "parse template error")
+	}
+
+	topic := bytes.NewBuffer(nil)
+	err = tmpl.Execute(topic, struct {
+		ApplicationID int64
+		DevEUI        string
+	}{applicationID, devEUIPlaceholder})
+	if err != nil {
+		return "", nil, errors.Wrap(err, "execute template error")
+	}
+
+	if !strings.Contains(topic.String(), devEUIPlaceholder) {
+		return "", nil, errors.New("downlink topic template must contain the DevEUI")
+	}
+
+	re, err := regexp.Compile("^" + strings.Replace(regexp.QuoteMeta(topic.String()), devEUIPlaceholder, `(\w+)`, 1) + "$")
+	if err != nil {
+		return "", nil, errors.Wrap(err, "compile regexp error")
+	}
+
+	return strings.Replace(topic.String(), devEUIPlaceholder, "+", -1), re, nil
 }
 
 func (h *MQTTHandler) onConnectionLost(c mqtt.Client, reason error) {
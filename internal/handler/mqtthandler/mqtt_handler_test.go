@@ -17,6 +17,24 @@ import (
 	"github.com/brocaar/lorawan"
 )
 
+type testTopicTemplateStore struct {
+	templates map[int64]TopicTemplates
+}
+
+func (s testTopicTemplateStore) GetTopicTemplates(applicationID int64) (TopicTemplates, error) {
+	return s.templates[applicationID], nil
+}
+
+func (s testTopicTemplateStore) GetDownlinkTopicTemplates() (map[int64]string, error) {
+	out := make(map[int64]string)
+	for id, t := range s.templates {
+		if t.Downlink != "" {
+			out[id] = t.Downlink
+		}
+	}
+	return out, nil
+}
+
 type MQTTHandlerTestSuite struct {
 	suite.Suite
 
@@ -81,6 +99,16 @@ func (ts *MQTTHandlerTestSuite) SetupSuite() {
 			ErrorTopicTemplate:    "application/{{ .ApplicationID }}/device/{{ .DevEUI }}/error",
 			StatusTopicTemplate:   "application/{{ .ApplicationID }}/device/{{ .DevEUI }}/status",
 			LocationTopicTemplate: "application/{{ .ApplicationID }}/device/{{ .DevEUI }}/location",
+
+			AdminEventTopicTemplate: "admin/{{ .Entity }}/{{ .ID }}/{{ .Action }}",
+		},
+		testTopicTemplateStore{
+			templates: map[int64]TopicTemplates{
+				456: {
+					Uplink:   "tenant-a/{{ .DevEUI }}/up",
+					Downlink: "tenant-a/{{ .DevEUI }}/down",
+				},
+			},
 		},
 	)
 	assert.NoError(err)
@@ -242,6 +270,30 @@ func (ts *MQTTHandlerTestSuite) TestLocation() {
 	assert.Equal(pl, <-locationChan)
 }
 
+func (ts *MQTTHandlerTestSuite) TestAdminEvent() {
+	assert := require.New(ts.T())
+
+	adminChan := make(chan handler.AdminEvent, 1)
+	token := ts.mqttClient.Subscribe("admin/gateway/0102030405060708/delete", 0, func(c paho.Client, msg paho.Message) {
+		var pl handler.AdminEvent
+		assert.NoError(json.Unmarshal(msg.Payload(), &pl))
+		adminChan <- pl
+	})
+	token.Wait()
+	assert.NoError(token.Error())
+
+	pl := handler.AdminEvent{
+		Entity:         handler.GatewayEntity,
+		Action:         handler.DeleteAction,
+		ID:             "0102030405060708",
+		OrganizationID: 1,
+		Username:       "admin",
+		Time:           time.Now().UTC().Truncate(time.Second),
+	}
+	assert.NoError(ts.handler.SendAdminEvent(pl))
+	assert.Equal(pl, <-adminChan)
+}
+
 func (ts *MQTTHandlerTestSuite) TestDownlink() {
 	assert := require.New(ts.T())
 
@@ -282,6 +334,81 @@ func (ts *MQTTHandlerTestSuite) TestDownlink() {
 	})
 }
 
+func (ts *MQTTHandlerTestSuite) TestApplicationTopicTemplates() {
+	assert := require.New(ts.T())
+
+	ts.T().Run("uplink", func(t *testing.T) {
+		assert := require.New(t)
+
+		uplinkChan := make(chan handler.DataUpPayload, 1)
+		token := ts.mqttClient.Subscribe("tenant-a/0102030405060708/up", 0, func(c paho.Client, msg paho.Message) {
+			var pl handler.DataUpPayload
+			assert.NoError(json.Unmarshal(msg.Payload(), &pl))
+			uplinkChan <- pl
+		})
+		token.Wait()
+		assert.NoError(token.Error())
+
+		pl := handler.DataUpPayload{
+			ApplicationID: 456,
+			DevEUI:        lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		}
+		assert.NoError(ts.handler.SendDataUp(pl))
+		assert.Equal(pl, <-uplinkChan)
+	})
+
+	ts.T().Run("fallback to configured template", func(t *testing.T) {
+		assert := require.New(t)
+
+		joinChan := make(chan handler.JoinNotification, 1)
+		token := ts.mqttClient.Subscribe("application/456/device/0102030405060708/join", 0, func(c paho.Client, msg paho.Message) {
+			var pl handler.JoinNotification
+			assert.NoError(json.Unmarshal(msg.Payload(), &pl))
+			joinChan <- pl
+		})
+		token.Wait()
+		assert.NoError(token.Error())
+
+		pl := handler.JoinNotification{
+			ApplicationID: 456,
+			DevEUI:        lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		}
+		assert.NoError(ts.handler.SendJoinNotification(pl))
+		assert.Equal(pl, <-joinChan)
+	})
+
+	pl := handler.DataDownPayload{
+		FPort: 1,
+		Data:  []byte("hello"),
+	}
+	b, err := json.Marshal(pl)
+	assert.NoError(err)
+
+	token := ts.mqttClient.Publish("tenant-a/0102030405060708/down", 0, false, b)
+	token.Wait()
+	assert.NoError(token.Error())
+	assert.Equal(handler.DataDownPayload{
+		ApplicationID: 456,
+		DevEUI:        lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		FPort:         1,
+		Data:          []byte("hello"),
+		Object:        json.RawMessage("null"),
+	}, <-ts.handler.DataDownChan())
+}
+
+func TestGetApplicationDownlinkTopic(t *testing.T) {
+	assert := require.New(t)
+
+	topic, re, err := getApplicationDownlinkTopic(123, "tenant.a/{{ .ApplicationID }}/{{ .DevEUI }}/down")
+	assert.NoError(err)
+	assert.Equal("tenant.a/123/+/down", topic)
+	assert.Equal([]string{"tenant.a/123/0102030405060708/down", "0102030405060708"}, re.FindStringSubmatch("tenant.a/123/0102030405060708/down"))
+	assert.Nil(re.FindStringSubmatch("tenant.a/124/0102030405060708/down"))
+
+	_, _, err = getApplicationDownlinkTopic(123, "tenant-a/{{ .ApplicationID }}/down")
+	assert.Error(err)
+}
+
 func TestMQTTHandler(t *testing.T) {
 	suite.Run(t, new(MQTTHandlerTestSuite))
 }
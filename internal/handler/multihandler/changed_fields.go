@@ -0,0 +1,65 @@
+package multihandler
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/changedfields"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// changedFieldsHandler wraps an integration handler so that the decoded
+// object of the uplink payloads only contains the fields which changed
+// since the previous uplink of the device sent to this integration. All
+// other events and payload fields (e.g. the device identifiers) are sent
+// as-is.
+type changedFieldsHandler struct {
+	handler.IntegrationHandler
+	integration string
+}
+
+// newChangedFieldsHandler wraps the given handler of the given integration
+// when enabled. Otherwise the handler is returned as-is.
+func newChangedFieldsHandler(intg storage.Integration, enabled bool, h handler.IntegrationHandler) handler.IntegrationHandler {
+	if !enabled {
+		return h
+	}
+
+	return &changedFieldsHandler{
+		IntegrationHandler: h,
+		integration:        getChangedFieldsIntegration(intg),
+	}
+}
+
+// getChangedFieldsIntegration returns the identifier under which the
+// previous objects of the given integration are stored.
+func getChangedFieldsIntegration(intg storage.Integration) string {
+	kind := strings.ToLower(intg.Kind)
+	if intg.Inherited {
+		return fmt.Sprintf("%s:%d:org:%d", kind, intg.ApplicationID, intg.ID)
+	}
+	return fmt.Sprintf("%s:%d:%d", kind, intg.ApplicationID, intg.ID)
+}
+
+// SendDataUp sends the data-up payload, containing only the changed fields
+// of the decoded object. In case the changed fields can't be determined,
+// the complete object is sent.
+func (h *changedFieldsHandler) SendDataUp(pl handler.DataUpPayload) error {
+	if pl.Object != nil {
+		object, err := changedfields.Get(config.C.Redis.Pool, h.integration, pl.DevEUI, pl.Object)
+		if err != nil {
+			log.WithError(err).WithFields(log.Fields{
+				"dev_eui":     pl.DevEUI,
+				"integration": h.integration,
+			}).Error("get changed fields error")
+		} else {
+			pl.Object = object
+		}
+	}
+
+	return h.IntegrationHandler.SendDataUp(pl)
+}
@@ -0,0 +1,101 @@
+package multihandler
+
+import (
+	"hash/fnv"
+	"sync"
+)
+
+// deliveryPool delivers the integration events using a fixed number of
+// workers. The events are partitioned by key (e.g. the DevEUI), so that
+// events with the same key are always delivered by the same worker, in the
+// order in which they were queued.
+type deliveryPool struct {
+	mu     sync.RWMutex
+	closed bool
+	queues []chan func()
+	wg     sync.WaitGroup
+}
+
+// newDeliveryPool creates and starts a new delivery pool. It returns nil
+// when workers is 0, in which case events are delivered synchronously.
+func newDeliveryPool(workers, queueSize int) *deliveryPool {
+	if workers <= 0 {
+		return nil
+	}
+
+	p := deliveryPool{
+		queues: make([]chan func(), workers),
+	}
+
+	for i := range p.queues {
+		p.queues[i] = make(chan func(), queueSize)
+		p.wg.Add(1)
+		go func(queue chan func()) {
+			defer p.wg.Done()
+			for f := range queue {
+				f()
+			}
+		}(p.queues[i])
+	}
+
+	return &p
+}
+
+// deliver queues f for the worker handling the given key. It blocks when
+// the queue of this worker is full. After the pool has been closed (or
+// when there is no pool), f is called directly.
+func (p *deliveryPool) deliver(key string, f func()) {
+	if p == nil {
+		f()
+		return
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.closed {
+		f()
+		return
+	}
+
+	p.queues[partition(key, len(p.queues))] <- f
+}
+
+// usage returns the usage (0 - 1) of the fullest worker queue.
+func (p *deliveryPool) usage() float64 {
+	var out float64
+	for _, queue := range p.queues {
+		if cap(queue) == 0 {
+			continue
+		}
+		if u := float64(len(queue)) / float64(cap(queue)); u > out {
+			out = u
+		}
+	}
+	return out
+}
+
+// close stops the workers after the queued events have been delivered.
+func (p *deliveryPool) close() {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		for _, queue := range p.queues {
+			close(queue)
+		}
+	}
+	p.mu.Unlock()
+
+	p.wg.Wait()
+}
+
+// partition returns the partition (0 - n-1) for the given key.
+func partition(key string, n int) int {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return int(h.Sum32() % uint32(n))
+}
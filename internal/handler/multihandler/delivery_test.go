@@ -0,0 +1,104 @@
+package multihandler
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeliveryPool(t *testing.T) {
+	t.Run("events are delivered in order per key", func(t *testing.T) {
+		assert := require.New(t)
+		p := newDeliveryPool(4, 10)
+
+		var mu sync.Mutex
+		delivered := make(map[string][]int)
+
+		for i := 0; i < 100; i++ {
+			for _, key := range []string{"a", "b", "c", "d", "e"} {
+				key, i := key, i
+				p.deliver(key, func() {
+					// delay the first events, so that later events would
+					// overtake these without ordering
+					if i < 5 {
+						time.Sleep(time.Millisecond)
+					}
+					mu.Lock()
+					delivered[key] = append(delivered[key], i)
+					mu.Unlock()
+				})
+			}
+		}
+		p.close()
+
+		assert.Len(delivered, 5)
+		for key, events := range delivered {
+			assert.Len(events, 100, key)
+			for i, e := range events {
+				assert.Equal(i, e, key)
+			}
+		}
+	})
+
+	t.Run("close delivers the queued events", func(t *testing.T) {
+		assert := require.New(t)
+		p := newDeliveryPool(2, 100)
+
+		var mu sync.Mutex
+		var count int
+		for i := 0; i < 50; i++ {
+			p.deliver(fmt.Sprintf("%d", i), func() {
+				mu.Lock()
+				count++
+				mu.Unlock()
+			})
+		}
+		p.close()
+		assert.Equal(50, count)
+
+		// after close, events are delivered synchronously
+		p.deliver("a", func() { count++ })
+		assert.Equal(51, count)
+	})
+
+	t.Run("without workers events are delivered synchronously", func(t *testing.T) {
+		assert := require.New(t)
+		p := newDeliveryPool(0, 10)
+		assert.Nil(p)
+
+		var called bool
+		p.deliver("a", func() { called = true })
+		assert.True(called)
+		p.close()
+	})
+
+	t.Run("partition", func(t *testing.T) {
+		assert := require.New(t)
+		for i := 0; i < 100; i++ {
+			key := fmt.Sprintf("%016x", i)
+			n := partition(key, 8)
+			assert.True(n >= 0 && n < 8)
+			assert.Equal(n, partition(key, 8))
+		}
+	})
+}
+
+func TestDeliveryPoolUsage(t *testing.T) {
+	assert := require.New(t)
+
+	p := &deliveryPool{
+		queues: []chan func(){
+			make(chan func(), 4),
+			make(chan func(), 4),
+		},
+	}
+	assert.Equal(0.0, p.usage())
+
+	p.queues[1] <- func() {}
+	p.queues[1] <- func() {}
+	p.queues[0] <- func() {}
+	assert.Equal(0.5, p.usage())
+}
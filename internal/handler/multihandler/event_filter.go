@@ -0,0 +1,78 @@
+package multihandler
+
+import (
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/plugin"
+)
+
+// eventFilterHandler wraps an integration handler so that only the selected
+// event types are forwarded. Admin events are always forwarded.
+type eventFilterHandler struct {
+	handler.IntegrationHandler
+	events map[string]bool
+}
+
+// newEventFilterHandler wraps the given handler when event types have been
+// selected. Otherwise the handler is returned as-is.
+func newEventFilterHandler(events []string, h handler.IntegrationHandler) handler.IntegrationHandler {
+	if len(events) == 0 {
+		return h
+	}
+
+	f := eventFilterHandler{
+		IntegrationHandler: h,
+		events:             make(map[string]bool),
+	}
+	for _, e := range events {
+		f.events[e] = true
+	}
+	return &f
+}
+
+// SendDataUp sends the data-up payload when selected.
+func (h *eventFilterHandler) SendDataUp(pl handler.DataUpPayload) error {
+	if !h.events[plugin.UplinkEvent] {
+		return nil
+	}
+	return h.IntegrationHandler.SendDataUp(pl)
+}
+
+// SendJoinNotification sends the join notification when selected.
+func (h *eventFilterHandler) SendJoinNotification(pl handler.JoinNotification) error {
+	if !h.events[plugin.JoinEvent] {
+		return nil
+	}
+	return h.IntegrationHandler.SendJoinNotification(pl)
+}
+
+// SendACKNotification sends the ACK notification when selected.
+func (h *eventFilterHandler) SendACKNotification(pl handler.ACKNotification) error {
+	if !h.events[plugin.ACKEvent] {
+		return nil
+	}
+	return h.IntegrationHandler.SendACKNotification(pl)
+}
+
+// SendErrorNotification sends the error notification when selected.
+func (h *eventFilterHandler) SendErrorNotification(pl handler.ErrorNotification) error {
+	if !h.events[plugin.ErrorEvent] {
+		return nil
+	}
+	return h.IntegrationHandler.SendErrorNotification(pl)
+}
+
+// SendStatusNotification sends the status notification when selected.
+func (h *eventFilterHandler) SendStatusNotification(pl handler.StatusNotification) error {
+	if !h.events[plugin.StatusEvent] {
+		return nil
+	}
+	return h.IntegrationHandler.SendStatusNotification(pl)
+}
+
+// SendLocationNotification sends the location notification when selected.
+func (h *eventFilterHandler) SendLocationNotification(pl handler.LocationNotification) error {
+	if !h.events[plugin.LocationEvent] {
+		return nil
+	}
+	return h.IntegrationHandler.SendLocationNotification(pl)
+}
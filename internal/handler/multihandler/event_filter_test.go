@@ -0,0 +1,40 @@
+package multihandler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/test/testhandler"
+	"github.com/brocaar/lora-app-server/plugin"
+)
+
+func TestEventFilterHandler(t *testing.T) {
+	t.Run("No events selected", func(t *testing.T) {
+		h := testhandler.NewTestHandler()
+		require.Equal(t, h, newEventFilterHandler(nil, h))
+	})
+
+	t.Run("Events selected", func(t *testing.T) {
+		assert := require.New(t)
+		h := testhandler.NewTestHandler()
+		f := newEventFilterHandler([]string{plugin.UplinkEvent, plugin.StatusEvent}, h)
+
+		assert.NoError(f.SendDataUp(handler.DataUpPayload{ApplicationID: 1}))
+		assert.NoError(f.SendJoinNotification(handler.JoinNotification{ApplicationID: 1}))
+		assert.NoError(f.SendACKNotification(handler.ACKNotification{ApplicationID: 1}))
+		assert.NoError(f.SendErrorNotification(handler.ErrorNotification{ApplicationID: 1}))
+		assert.NoError(f.SendStatusNotification(handler.StatusNotification{ApplicationID: 1}))
+		assert.NoError(f.SendLocationNotification(handler.LocationNotification{ApplicationID: 1}))
+		assert.NoError(f.SendAdminEvent(handler.AdminEvent{ApplicationID: 1}))
+
+		assert.Len(h.SendDataUpChan, 1)
+		assert.Len(h.SendJoinNotificationChan, 0)
+		assert.Len(h.SendACKNotificationChan, 0)
+		assert.Len(h.SendErrorNotificationChan, 0)
+		assert.Len(h.SendStatusNotificationChan, 1)
+		assert.Len(h.SendLocationNotificationChan, 0)
+		assert.Len(h.SendAdminEventChan, 1)
+	})
+}
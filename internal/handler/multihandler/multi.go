@@ -5,171 +5,429 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/brocaar/lora-app-server/internal/backpressure"
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/deliverylog"
+	"github.com/brocaar/lora-app-server/internal/faultinject"
+	"github.com/brocaar/lora-app-server/internal/geolocation"
 	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/handler/azurehandler"
 	"github.com/brocaar/lora-app-server/internal/handler/httphandler"
 	"github.com/brocaar/lora-app-server/internal/handler/influxdbhandler"
+	"github.com/brocaar/lora-app-server/internal/handler/loracloudhandler"
+	"github.com/brocaar/lora-app-server/internal/handler/mydeviceshandler"
+	"github.com/brocaar/lora-app-server/internal/handler/thingsboardhandler"
 	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
 )
 
 // Handler kinds
 const (
-	HTTPHandlerKind     = "HTTP"
-	InfluxDBHandlerKind = "INFLUXDB"
+	HTTPHandlerKind        = "HTTP"
+	InfluxDBHandlerKind    = "INFLUXDB"
+	AzureHandlerKind       = "AZURE"
+	ThingsBoardHandlerKind = "THINGSBOARD"
+	MyDevicesHandlerKind   = "MY_DEVICES"
+	LoRaCloudHandlerKind   = "LORA_CLOUD"
 )
 
 // Handler wraps multiple handlers inside a single handler so that
 // data can be sent to multiple endpoints simultaneously.
 // Note that errors are logged, but not returned.
+//
+// Events are delivered asynchronously by a pool of workers, partitioned by
+// DevEUI so that the events of a device are delivered in order.
 type Handler struct {
 	defaultHandler handler.Handler
+	globalHandlers []handler.IntegrationHandler
+	pool           *deliveryPool
+	done           chan struct{}
 }
 
 // SendDataUp sends a data-up payload.
 func (w Handler) SendDataUp(pl handler.DataUpPayload) error {
-	handlers, err := w.getHandlersForApplicationID(pl.ApplicationID)
-	if err != nil {
-		log.Errorf("get handlers for application-id error: %s", err)
-		handlers = []handler.IntegrationHandler{w.defaultHandler}
-	}
+	w.pool.deliver(pl.DevEUI.String(), func() {
+		handlers, err := w.getHandlersForApplicationID(pl.ApplicationID)
+		if err != nil {
+			log.Errorf("get handlers for application-id error: %s", err)
+			handlers = w.getGlobalHandlers()
+		}
 
-	for _, h := range handlers {
-		if err := h.SendDataUp(pl); err != nil {
-			log.Errorf("handler %T error: %s", h, err)
+		for _, h := range injectFaults(handlers) {
+			if err := h.SendDataUp(pl); err != nil {
+				log.Errorf("handler %T error: %s", h, err)
+			}
 		}
-	}
+	})
 	return nil
 }
 
 // SendJoinNotification sends a join notification.
 func (w Handler) SendJoinNotification(pl handler.JoinNotification) error {
-	handlers, err := w.getHandlersForApplicationID(pl.ApplicationID)
-	if err != nil {
-		log.Errorf("get handlers for application-id error: %s", err)
-		handlers = []handler.IntegrationHandler{w.defaultHandler}
-	}
+	w.pool.deliver(pl.DevEUI.String(), func() {
+		handlers, err := w.getHandlersForApplicationID(pl.ApplicationID)
+		if err != nil {
+			log.Errorf("get handlers for application-id error: %s", err)
+			handlers = w.getGlobalHandlers()
+		}
 
-	for _, h := range handlers {
-		if err := h.SendJoinNotification(pl); err != nil {
-			log.Errorf("handler %T error: %s", h, err)
+		for _, h := range injectFaults(handlers) {
+			if err := h.SendJoinNotification(pl); err != nil {
+				log.Errorf("handler %T error: %s", h, err)
+			}
 		}
-	}
+	})
 	return nil
 }
 
 // SendACKNotification sends an ACK notification.
 func (w Handler) SendACKNotification(pl handler.ACKNotification) error {
-	handlers, err := w.getHandlersForApplicationID(pl.ApplicationID)
-	if err != nil {
-		log.Errorf("get handlers for application-id error: %s", err)
-		handlers = []handler.IntegrationHandler{w.defaultHandler}
-	}
+	w.pool.deliver(pl.DevEUI.String(), func() {
+		handlers, err := w.getHandlersForApplicationID(pl.ApplicationID)
+		if err != nil {
+			log.Errorf("get handlers for application-id error: %s", err)
+			handlers = w.getGlobalHandlers()
+		}
 
-	for _, h := range handlers {
-		if err := h.SendACKNotification(pl); err != nil {
-			log.Errorf("handler %T error: %s", h, err)
+		for _, h := range injectFaults(handlers) {
+			if err := h.SendACKNotification(pl); err != nil {
+				log.Errorf("handler %T error: %s", h, err)
+			}
 		}
-	}
+	})
 	return nil
 }
 
 // SendErrorNotification sends an error notification.
 func (w Handler) SendErrorNotification(pl handler.ErrorNotification) error {
-	handlers, err := w.getHandlersForApplicationID(pl.ApplicationID)
-	if err != nil {
-		log.Errorf("get handlers for application-id error: %s", err)
-		handlers = []handler.IntegrationHandler{w.defaultHandler}
-	}
+	w.pool.deliver(pl.DevEUI.String(), func() {
+		handlers, err := w.getHandlersForApplicationID(pl.ApplicationID)
+		if err != nil {
+			log.Errorf("get handlers for application-id error: %s", err)
+			handlers = w.getGlobalHandlers()
+		}
 
-	for _, h := range handlers {
-		if err := h.SendErrorNotification(pl); err != nil {
-			log.Errorf("handler %T error: %s", h, err)
+		for _, h := range injectFaults(handlers) {
+			if err := h.SendErrorNotification(pl); err != nil {
+				log.Errorf("handler %T error: %s", h, err)
+			}
 		}
-	}
+	})
 	return nil
 }
 
 // SendStatusNotification sends a status notification.
 func (w Handler) SendStatusNotification(pl handler.StatusNotification) error {
-	handlers, err := w.getHandlersForApplicationID(pl.ApplicationID)
-	if err != nil {
-		log.Errorf("get handlers for application-id error: %s", err)
-		handlers = []handler.IntegrationHandler{w.defaultHandler}
-	}
+	w.pool.deliver(pl.DevEUI.String(), func() {
+		handlers, err := w.getHandlersForApplicationID(pl.ApplicationID)
+		if err != nil {
+			log.Errorf("get handlers for application-id error: %s", err)
+			handlers = w.getGlobalHandlers()
+		}
 
-	for _, h := range handlers {
-		if err := h.SendStatusNotification(pl); err != nil {
-			log.Errorf("handler %T error: %s", h, err)
+		for _, h := range injectFaults(handlers) {
+			if err := h.SendStatusNotification(pl); err != nil {
+				log.Errorf("handler %T error: %s", h, err)
+			}
 		}
-	}
+	})
 	return nil
 }
 
 // SendLocationNotification sends a location notification.
 func (w Handler) SendLocationNotification(pl handler.LocationNotification) error {
-	handlers, err := w.getHandlersForApplicationID(pl.ApplicationID)
-	if err != nil {
-		log.Errorf("get handlers for application-id error: %s", err)
-		handlers = []handler.IntegrationHandler{w.defaultHandler}
-	}
+	w.pool.deliver(pl.DevEUI.String(), func() {
+		handlers, err := w.getHandlersForApplicationID(pl.ApplicationID)
+		if err != nil {
+			log.Errorf("get handlers for application-id error: %s", err)
+			handlers = w.getGlobalHandlers()
+		}
 
-	for _, h := range handlers {
-		if err := h.SendLocationNotification(pl); err != nil {
-			log.Errorf("handler %T error: %s", h, err)
+		for _, h := range injectFaults(handlers) {
+			if err := h.SendLocationNotification(pl); err != nil {
+				log.Errorf("handler %T error: %s", h, err)
+			}
 		}
-	}
+	})
+	return nil
+}
+
+// SendAdminEvent sends an admin-plane event. The events are ordered per
+// entity, like the device events are ordered per DevEUI. The event is sent to the
+// default and global handlers, the admin event HTTP endpoint (when configured) and in
+// case the event relates to an application, to the integrations of this
+// application.
+func (w Handler) SendAdminEvent(pl handler.AdminEvent) error {
+	w.pool.deliver(pl.Entity+pl.ID, func() {
+		handlers := w.getGlobalHandlers()
+		if pl.ApplicationID != 0 {
+			var err error
+			handlers, err = w.getHandlersForApplicationID(pl.ApplicationID)
+			if err != nil {
+				log.Errorf("get handlers for application-id error: %s", err)
+				handlers = w.getGlobalHandlers()
+			}
+		}
+
+		if conf := config.C.ApplicationServer.Integration.AdminEvents; conf.HTTPURL != "" {
+			h, err := newAdminEventHandler()
+			if err != nil {
+				log.Errorf("new admin event http handler error: %s", err)
+			} else {
+				handlers = append(handlers, newSpooledHandler(adminEventsSpoolTarget, h))
+			}
+		}
+
+		for _, h := range injectFaults(handlers) {
+			if err := h.SendAdminEvent(pl); err != nil {
+				log.Errorf("handler %T error: %s", h, err)
+			}
+		}
+	})
 	return nil
 }
 
-// Close closes the handlers.
+// Close delivers the queued events and closes the handlers.
 func (w Handler) Close() error {
+	if w.done != nil {
+		close(w.done)
+	}
+	w.pool.close()
+
+	for _, h := range w.globalHandlers {
+		if err := h.Close(); err != nil {
+			log.Errorf("close handler %T error: %s", h, err)
+		}
+	}
 	return w.defaultHandler.Close()
 }
 
+// getGlobalHandlers returns the default handler and the global handlers
+// (e.g. plugins), which receive the events of all applications.
+func (w Handler) getGlobalHandlers() []handler.IntegrationHandler {
+	handlers := []handler.IntegrationHandler{newSpooledHandler(defaultSpoolTarget, w.defaultHandler)}
+	return append(handlers, w.globalHandlers...)
+}
+
 // getHandlersForApplicationID returns all handlers (including the default
-// handler for the given application ID.
+// and global handlers and the handlers inherited from the organization) for
+// the given application ID.
 func (w Handler) getHandlersForApplicationID(id int64) ([]handler.IntegrationHandler, error) {
-	handlers := []handler.IntegrationHandler{w.defaultHandler}
+	handlers := w.getGlobalHandlers()
 
 	// read integrations
-	integrations, err := storage.GetIntegrationsForApplicationID(config.C.PostgreSQL.DB, id)
+	integrations, err := storage.GetEffectiveIntegrationsForApplicationID(config.C.PostgreSQL.DB, id)
 	if err != nil {
 		return nil, errors.Wrap(err, "get integrtions for application id error")
 	}
 
 	// map integration to handler + config
 	for _, intg := range integrations {
-		switch intg.Kind {
-		case HTTPHandlerKind:
-			var conf httphandler.HandlerConfig
-			if err := json.NewDecoder(bytes.NewReader(intg.Settings)).Decode(&conf); err != nil {
-				return nil, errors.Wrap(err, "decode http handler config error")
-			}
-			h, err := httphandler.NewHandler(conf)
-			if err != nil {
-				return nil, err
-			}
-			handlers = append(handlers, h)
-		case InfluxDBHandlerKind:
-			var conf influxdbhandler.HandlerConfig
-			if err := json.NewDecoder(bytes.NewReader(intg.Settings)).Decode(&conf); err != nil {
-				return nil, errors.Wrap(err, "decode influxdb handler config error")
+		h, err := newIntegrationHandler(intg)
+		if err != nil {
+			return nil, err
+		}
+		handlers = append(handlers, h)
+	}
+
+	return handlers, nil
+}
+
+// newIntegrationHandler returns the handler for the given integration.
+func newIntegrationHandler(intg storage.Integration) (handler.IntegrationHandler, error) {
+	switch intg.Kind {
+	case HTTPHandlerKind:
+		conf, err := decodeHTTPHandlerConfig(intg.Settings)
+		if err != nil {
+			return nil, err
+		}
+		h, err := httphandler.NewHandler(conf)
+		if err != nil {
+			return nil, err
+		}
+		h.SetDeliveryFunc(logDelivery)
+		return newEventFilterHandler(conf.Events, newChangedFieldsHandler(intg, conf.ChangedFieldsOnly, newSpooledHandler(getHTTPSpoolTarget(intg.ApplicationID, intg.ID, intg.Inherited), h))), nil
+	case InfluxDBHandlerKind:
+		var conf influxdbhandler.HandlerConfig
+		if err := json.NewDecoder(bytes.NewReader(intg.Settings)).Decode(&conf); err != nil {
+			return nil, errors.Wrap(err, "decode influxdb handler config error")
+		}
+		h, err := influxdbhandler.NewHandler(conf)
+		if err != nil {
+			return nil, err
+		}
+		return newEventFilterHandler(conf.Events, newChangedFieldsHandler(intg, conf.ChangedFieldsOnly, h)), nil
+	case AzureHandlerKind:
+		var conf azurehandler.HandlerConfig
+		if err := json.NewDecoder(bytes.NewReader(intg.Settings)).Decode(&conf); err != nil {
+			return nil, errors.Wrap(err, "decode azure handler config error")
+		}
+		h, err := azurehandler.NewHandler(conf)
+		if err != nil {
+			return nil, err
+		}
+		return newEventFilterHandler(conf.Events, newChangedFieldsHandler(intg, conf.ChangedFieldsOnly, h)), nil
+	case ThingsBoardHandlerKind:
+		var conf thingsboardhandler.HandlerConfig
+		if err := json.NewDecoder(bytes.NewReader(intg.Settings)).Decode(&conf); err != nil {
+			return nil, errors.Wrap(err, "decode thingsboard handler config error")
+		}
+		h, err := thingsboardhandler.NewHandler(conf, getDeviceVariables)
+		if err != nil {
+			return nil, err
+		}
+		return newEventFilterHandler(conf.Events, h), nil
+	case MyDevicesHandlerKind:
+		var conf mydeviceshandler.HandlerConfig
+		if err := json.NewDecoder(bytes.NewReader(intg.Settings)).Decode(&conf); err != nil {
+			return nil, errors.Wrap(err, "decode mydevices handler config error")
+		}
+		h, err := mydeviceshandler.NewHandler(conf)
+		if err != nil {
+			return nil, err
+		}
+		return newEventFilterHandler(conf.Events, h), nil
+	case LoRaCloudHandlerKind:
+		var conf loracloudhandler.HandlerConfig
+		if err := json.NewDecoder(bytes.NewReader(intg.Settings)).Decode(&conf); err != nil {
+			return nil, errors.Wrap(err, "decode loracloud handler config error")
+		}
+		return loracloudhandler.NewHandler(conf, geolocation.SetDeviceLocation)
+	default:
+		return nil, fmt.Errorf("unknown integration %s", intg.Kind)
+	}
+}
+
+// getDeviceVariables returns the variables of the given device.
+func getDeviceVariables(devEUI lorawan.EUI64) (map[string]string, error) {
+	d, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI, false, true)
+	if err != nil {
+		return nil, errors.Wrap(err, "get device error")
+	}
+	return d.Variables, nil
+}
+
+// decodeHTTPHandlerConfig decodes the given HTTP integration settings and
+// decrypts the (encrypted stored) header values and signing secret.
+func decodeHTTPHandlerConfig(settings []byte) (httphandler.HandlerConfig, error) {
+	var conf httphandler.HandlerConfig
+	if err := json.NewDecoder(bytes.NewReader(settings)).Decode(&conf); err != nil {
+		return conf, errors.Wrap(err, "decode http handler config error")
+	}
+
+	conf, err := conf.TransformSecrets(storage.DecryptSecret)
+	if err != nil {
+		return conf, errors.Wrap(err, "decrypt http handler secrets error")
+	}
+
+	return conf, nil
+}
+
+// logDelivery logs the given HTTP integration delivery attempt in the
+// delivery log of the application.
+func logDelivery(d httphandler.Delivery) {
+	conf := config.C.ApplicationServer.Integration.DeliveryLog
+	if conf.MaxEntries == 0 || d.ApplicationID == 0 {
+		return
+	}
+
+	e := deliverylog.Entry{
+		Time:         time.Now(),
+		Integration:  HTTPHandlerKind,
+		EventType:    d.EventType,
+		DevEUI:       d.DevEUI,
+		URL:          d.URL,
+		StatusCode:   d.StatusCode,
+		Latency:      d.Latency,
+		ResponseBody: string(d.ResponseBody),
+	}
+	if d.Error != nil {
+		e.Error = d.Error.Error()
+	}
+
+	if err := deliverylog.Log(config.C.Redis.Pool, d.ApplicationID, e, conf.MaxEntries, conf.TTL); err != nil {
+		log.WithError(err).WithField("application_id", d.ApplicationID).Error("log integration delivery error")
+	}
+}
+
+// newAdminEventHandler returns the handler for the admin event HTTP
+// endpoint.
+func newAdminEventHandler() (handler.IntegrationHandler, error) {
+	conf := config.C.ApplicationServer.Integration.AdminEvents
+	return httphandler.NewHandler(httphandler.HandlerConfig{
+		Headers:       conf.HTTPHeaders,
+		AdminEventURL: conf.HTTPURL,
+	})
+}
+
+// getSpoolTargetHandler returns the (unspooled) handler for the given spool
+// target. It returns nil when the target does no longer exist.
+func (w Handler) getSpoolTargetHandler(target string) (handler.IntegrationHandler, error) {
+	switch {
+	case target == defaultSpoolTarget:
+		return w.defaultHandler, nil
+	case target == adminEventsSpoolTarget:
+		if config.C.ApplicationServer.Integration.AdminEvents.HTTPURL == "" {
+			return nil, nil
+		}
+		return newAdminEventHandler()
+	case strings.HasPrefix(target, "http:"):
+		applicationID, integrationID, inherited, err := parseHTTPSpoolTarget(target)
+		if err != nil {
+			return nil, err
+		}
+
+		integrations, err := storage.GetEffectiveIntegrationsForApplicationID(config.C.PostgreSQL.DB, applicationID)
+		if err != nil {
+			return nil, errors.Wrap(err, "get integrations for application id error")
+		}
+
+		for _, intg := range integrations {
+			if intg.Kind != HTTPHandlerKind || intg.ID != integrationID || intg.Inherited != inherited {
+				continue
 			}
-			h, err := influxdbhandler.NewHandler(conf)
+
+			conf, err := decodeHTTPHandlerConfig(intg.Settings)
 			if err != nil {
 				return nil, err
 			}
-			handlers = append(handlers, h)
-		default:
-			return nil, fmt.Errorf("unknown integration %s", intg.Kind)
+			return httphandler.NewHandler(conf)
 		}
+
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown spool target: %s", target)
 	}
+}
 
-	return handlers, nil
+// injectFaults wraps the given handlers with the fault-injection handler,
+// when fault-injection has been enabled for integrations.
+func injectFaults(handlers []handler.IntegrationHandler) []handler.IntegrationHandler {
+	conf := config.C.FaultInjection.Integration
+	if !conf.Enabled {
+		return handlers
+	}
+
+	out := make([]handler.IntegrationHandler, 0, len(handlers))
+	for _, h := range handlers {
+		// faults are injected in the delivery of spooled events, so that
+		// failed deliveries remain spooled
+		if sh, ok := h.(*spooledHandler); ok {
+			out = append(out, &spooledHandler{
+				target:  sh.target,
+				handler: faultinject.NewIntegrationHandler(conf, sh.handler),
+			})
+			continue
+		}
+		out = append(out, faultinject.NewIntegrationHandler(conf, h))
+	}
+	return out
 }
 
 // DataDownChan returns the channel containing the received DataDownPayload.
@@ -177,9 +435,25 @@ func (w Handler) DataDownChan() chan handler.DataDownPayload {
 	return w.defaultHandler.DataDownChan()
 }
 
-// NewHandler returns a new MultiHandler.
-func NewHandler(defaultHandler handler.Handler) handler.Handler {
-	return Handler{
+// NewHandler returns a new MultiHandler. The global handlers receive the
+// events of all applications, like the default handler.
+func NewHandler(defaultHandler handler.Handler, globalHandlers ...handler.IntegrationHandler) handler.Handler {
+	conf := config.C.ApplicationServer.Integration.Delivery
+
+	h := Handler{
 		defaultHandler: defaultHandler,
+		globalHandlers: globalHandlers,
+		pool:           newDeliveryPool(conf.Workers, conf.QueueSize),
+	}
+
+	if h.pool != nil {
+		backpressure.SetQueueUsageFunc(h.pool.usage)
 	}
+
+	if conf.AtLeastOnce && conf.RetryInterval > 0 {
+		h.done = make(chan struct{})
+		go h.spoolRetryLoop(h.done)
+	}
+
+	return h
 }
@@ -81,7 +81,7 @@ func TestHandler(t *testing.T) {
 			ErrorTopicTemplate:    "application/{{ .ApplicationID }}/node/{{ .DevEUI }}/error",
 			StatusTopicTemplate:   "application/{{ .ApplicationID }}/node/{{ .DevEUI }}/status",
 			LocationTopicTemplate: "application/{{ .ApplicationID }}/node/{{ .DevEUI }}/location",
-		})
+		}, nil)
 		So(err, ShouldBeNil)
 
 		Convey("Given an organization, application with http integration and node", func() {
@@ -168,6 +168,56 @@ func TestHandler(t *testing.T) {
 				})
 			})
 
+			Convey("Given a HTTP integration with changed-fields only enabled", func() {
+				handlerConfig := httphandler.HandlerConfig{
+					DataUpURL:         server.URL + "/rx",
+					ChangedFieldsOnly: true,
+				}
+				configJSON, err := json.Marshal(handlerConfig)
+				So(err, ShouldBeNil)
+
+				So(storage.CreateIntegration(db, &storage.Integration{
+					ApplicationID: app.ID,
+					Kind:          HTTPHandlerKind,
+					Settings:      configJSON,
+				}), ShouldBeNil)
+
+				Convey("Calling SendDataUp twice", func() {
+					multiHandler := NewHandler(mqttHandler)
+					defer multiHandler.Close()
+
+					for _, temp := range []float64{21.5, 22.0} {
+						So(multiHandler.SendDataUp(handler.DataUpPayload{
+							ApplicationID: app.ID,
+							DevEUI:        device.DevEUI,
+							Object:        map[string]interface{}{"temperature": temp, "humidity": 60.0},
+						}), ShouldBeNil)
+					}
+
+					Convey("Then the MQTT handler received the complete objects", func() {
+						for _, expected := range []string{`{"humidity":60,"temperature":21.5}`, `{"humidity":60,"temperature":22}`} {
+							var pl struct {
+								Object json.RawMessage `json:"object"`
+							}
+							msg := <-mqttMessages
+							So(json.Unmarshal(msg.Payload(), &pl), ShouldBeNil)
+							So(string(pl.Object), ShouldEqual, expected)
+						}
+					})
+
+					Convey("Then the HTTP handler only received the changed fields", func() {
+						for _, expected := range []string{`{"humidity":60,"temperature":21.5}`, `{"temperature":22}`} {
+							var pl struct {
+								Object json.RawMessage `json:"object"`
+							}
+							req := <-h.requests
+							So(json.NewDecoder(req.Body).Decode(&pl), ShouldBeNil)
+							So(string(pl.Object), ShouldEqual, expected)
+						}
+					})
+				})
+			})
+
 			Convey("Given a HTTP integration", func() {
 				handlerConfig := httphandler.HandlerConfig{
 					DataUpURL:               server.URL + "/rx",
@@ -0,0 +1,154 @@
+package multihandler
+
+import (
+	"encoding/json"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/handler/httphandler"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// GetHTTPIntegrationSecretsToRewrapCount returns the number of (application
+// and organization) HTTP integrations of which the stored header values or
+// signing secret are not encrypted using the configured storage KEK.
+func GetHTTPIntegrationSecretsToRewrapCount(db sqlx.Queryer) (int, error) {
+	settings, err := getHTTPIntegrationSettings(db, false)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	for _, s := range settings {
+		ok, err := httpSecretsNeedRewrap(s.settings)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// RewrapHTTPIntegrationSecrets re-encrypts the stored header values and
+// signing secret of (at most) batchSize HTTP integrations which are not
+// encrypted using the configured storage KEK. It returns the number of
+// updated integrations.
+func RewrapHTTPIntegrationSecrets(db sqlx.Ext, batchSize int) (int, error) {
+	settings, err := getHTTPIntegrationSettings(db, true)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	for _, s := range settings {
+		if count == batchSize {
+			break
+		}
+
+		ok, err := httpSecretsNeedRewrap(s.settings)
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			continue
+		}
+
+		var conf httphandler.HandlerConfig
+		if err := json.Unmarshal(s.settings, &conf); err != nil {
+			return 0, errors.Wrap(err, "unmarshal http handler config error")
+		}
+
+		conf, err = conf.TransformSecrets(storage.RewrapSecret)
+		if err != nil {
+			return 0, errors.Wrap(err, "re-encrypt http handler secrets error")
+		}
+
+		b, err := json.Marshal(conf)
+		if err != nil {
+			return 0, errors.Wrap(err, "marshal http handler config error")
+		}
+
+		if err := s.update(db, b); err != nil {
+			return 0, err
+		}
+		count++
+	}
+
+	log.WithFields(log.Fields{
+		"count":     count,
+		"kek_label": config.C.JoinServer.KEK.StorageKEKLabel,
+	}).Info("http integration secrets re-encrypted")
+
+	return count, nil
+}
+
+// httpIntegrationSettings contains the stored settings of an application
+// or organization HTTP integration.
+type httpIntegrationSettings struct {
+	settings json.RawMessage
+	update   func(db sqlx.Execer, settings json.RawMessage) error
+}
+
+// getHTTPIntegrationSettings returns the settings of all application and
+// organization HTTP integrations.
+func getHTTPIntegrationSettings(db sqlx.Queryer, forUpdate bool) ([]httpIntegrationSettings, error) {
+	var out []httpIntegrationSettings
+
+	is, err := storage.GetIntegrationsForKind(db, HTTPHandlerKind, forUpdate)
+	if err != nil {
+		return nil, errors.Wrap(err, "get integrations error")
+	}
+	for i := range is {
+		in := is[i]
+		out = append(out, httpIntegrationSettings{
+			settings: in.Settings,
+			update: func(db sqlx.Execer, settings json.RawMessage) error {
+				in.Settings = settings
+				return storage.UpdateIntegration(db, &in)
+			},
+		})
+	}
+
+	ois, err := storage.GetOrganizationIntegrationsForKind(db, HTTPHandlerKind, forUpdate)
+	if err != nil {
+		return nil, errors.Wrap(err, "get organization integrations error")
+	}
+	for i := range ois {
+		in := ois[i]
+		out = append(out, httpIntegrationSettings{
+			settings: in.Settings,
+			update: func(db sqlx.Execer, settings json.RawMessage) error {
+				in.Settings = settings
+				return storage.UpdateOrganizationIntegration(db, &in)
+			},
+		})
+	}
+
+	return out, nil
+}
+
+// httpSecretsNeedRewrap returns true when one of the header values or the
+// signing secret of the given HTTP integration settings is not encrypted
+// using the configured storage KEK.
+func httpSecretsNeedRewrap(settings json.RawMessage) (bool, error) {
+	var conf httphandler.HandlerConfig
+	if err := json.Unmarshal(settings, &conf); err != nil {
+		return false, errors.Wrap(err, "unmarshal http handler config error")
+	}
+
+	var needsRewrap bool
+	_, err := conf.TransformSecrets(func(s string) (string, error) {
+		if storage.SecretNeedsRewrap(s) {
+			needsRewrap = true
+		}
+		return s, nil
+	})
+
+	return needsRewrap, err
+}
@@ -0,0 +1,86 @@
+package multihandler
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/handler/httphandler"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func TestRewrapHTTPIntegrationSecrets(t *testing.T) {
+	assert := require.New(t)
+
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	assert.NoError(err)
+	config.C.PostgreSQL.DB = db
+	test.MustResetDB(db)
+
+	config.C.JoinServer.KEK.Set = []struct {
+		Label string `mapstructure:"label"`
+		KEK   string `mapstructure:"kek"`
+	}{
+		{Label: "old", KEK: "01020304050607080102030405060708"},
+		{Label: "new", KEK: "08070605040302010807060504030201"},
+	}
+	defer func() {
+		config.C.JoinServer.KEK.StorageKEKLabel = ""
+		config.C.JoinServer.KEK.Set = nil
+	}()
+
+	org := storage.Organization{Name: "test-org"}
+	assert.NoError(storage.CreateOrganization(db, &org))
+
+	// store the secrets encrypted using the old storage kek
+	config.C.JoinServer.KEK.StorageKEKLabel = "old"
+	httpConf, err := httphandler.HandlerConfig{
+		Headers:       map[string]string{"Authorization": "Bearer token"},
+		SigningSecret: "hmac-secret",
+	}.TransformSecrets(storage.EncryptSecret)
+	assert.NoError(err)
+	b, err := json.Marshal(httpConf)
+	assert.NoError(err)
+
+	assert.NoError(storage.CreateOrganizationIntegration(db, &storage.OrganizationIntegration{
+		OrganizationID: org.ID,
+		Kind:           HTTPHandlerKind,
+		Settings:       b,
+	}))
+
+	count, err := GetHTTPIntegrationSecretsToRewrapCount(db)
+	assert.NoError(err)
+	assert.Equal(0, count)
+
+	// rotate the storage kek
+	config.C.JoinServer.KEK.StorageKEKLabel = "new"
+
+	count, err = GetHTTPIntegrationSecretsToRewrapCount(db)
+	assert.NoError(err)
+	assert.Equal(1, count)
+
+	n, err := RewrapHTTPIntegrationSecrets(db, 10)
+	assert.NoError(err)
+	assert.Equal(1, n)
+
+	count, err = GetHTTPIntegrationSecretsToRewrapCount(db)
+	assert.NoError(err)
+	assert.Equal(0, count)
+
+	i, err := storage.GetOrganizationIntegration(db, org.ID, HTTPHandlerKind)
+	assert.NoError(err)
+	assert.Contains(string(i.Settings), "enc:new:")
+	assert.NotContains(string(i.Settings), "enc:old:")
+
+	// the old kek is no longer needed
+	config.C.JoinServer.KEK.Set = config.C.JoinServer.KEK.Set[1:]
+
+	out, err := decodeHTTPHandlerConfig(i.Settings)
+	assert.NoError(err)
+	assert.Equal("Bearer token", out.Headers["Authorization"])
+	assert.Equal("hmac-secret", out.SigningSecret)
+}
@@ -0,0 +1,543 @@
+package multihandler
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/backpressure"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/deadletter"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/plugin"
+)
+
+const (
+	spoolKeyPrefix     = "lora:as:integration:spool:"
+	spoolKeyTempl      = spoolKeyPrefix + "%s"
+	spoolLockKeyTempl  = "lora:as:integration:spool-lock:%s"
+	spoolRetryKeyTempl = "lora:as:integration:spool-retry:%s"
+
+	// spoolLockTTL defines the max. duration for which a spool is locked
+	// for delivery by a single worker.
+	spoolLockTTL = time.Minute
+
+	// spoolRetryTTL defines the duration (in addition to the backoff) after
+	// which the retry state of a spool expires.
+	spoolRetryTTL = time.Hour
+
+	// defaultSpoolTarget is the spool target of the default (MQTT) handler.
+	defaultSpoolTarget = "default"
+
+	// adminEventsSpoolTarget is the spool target of the admin event HTTP
+	// endpoint.
+	adminEventsSpoolTarget = "admin_events"
+)
+
+// spoolEntry defines a spooled integration event.
+type spoolEntry struct {
+	ID      uuid.UUID       `json:"id"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// spoolRetry defines the retry state of a spool. It contains the number of
+// failed delivery attempts of the first spooled event and the time before
+// which the event must not be retried (exponential backoff).
+type spoolRetry struct {
+	EntryID   uuid.UUID `json:"entryID"`
+	Attempts  int       `json:"attempts"`
+	NextRetry time.Time `json:"nextRetry"`
+}
+
+// spooledHandler implements at-least-once delivery for the wrapped handler.
+// Each event is appended to the (Redis) spool of the target and is only
+// removed from the spool after it has been acknowledged by the wrapped
+// handler (e.g. the MQTT broker acknowledged the QoS 1 publish or the HTTP
+// endpoint returned a 2xx response). Events are delivered from the spool in
+// order, failed deliveries are retried by the spool retry loop using an
+// exponential backoff.
+type spooledHandler struct {
+	target  string
+	handler handler.IntegrationHandler
+}
+
+// newSpooledHandler wraps the given handler for at-least-once delivery,
+// when enabled. Otherwise the handler is returned as-is.
+func newSpooledHandler(target string, h handler.IntegrationHandler) handler.IntegrationHandler {
+	if !config.C.ApplicationServer.Integration.Delivery.AtLeastOnce {
+		return h
+	}
+
+	return &spooledHandler{
+		target:  target,
+		handler: h,
+	}
+}
+
+// getHTTPSpoolTarget returns the spool target of the given HTTP
+// integration of the given application.
+func getHTTPSpoolTarget(applicationID, integrationID int64, inherited bool) string {
+	if inherited {
+		return fmt.Sprintf("http:%d:org:%d", applicationID, integrationID)
+	}
+	return fmt.Sprintf("http:%d:%d", applicationID, integrationID)
+}
+
+// parseHTTPSpoolTarget parses the given HTTP spool target.
+func parseHTTPSpoolTarget(target string) (applicationID, integrationID int64, inherited bool, err error) {
+	parts := strings.Split(target, ":")
+	if len(parts) == 4 && parts[2] == "org" {
+		inherited = true
+		parts = []string{parts[0], parts[1], parts[3]}
+	}
+	if len(parts) != 3 || parts[0] != "http" {
+		return 0, 0, false, fmt.Errorf("invalid http spool target: %s", target)
+	}
+
+	applicationID, err = strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, false, errors.Wrap(err, "parse application id error")
+	}
+	integrationID, err = strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return 0, 0, false, errors.Wrap(err, "parse integration id error")
+	}
+
+	return applicationID, integrationID, inherited, nil
+}
+
+// SendDataUp sends a data-up payload.
+func (h *spooledHandler) SendDataUp(pl handler.DataUpPayload) error {
+	return h.send(plugin.UplinkEvent, pl)
+}
+
+// SendJoinNotification sends a join notification.
+func (h *spooledHandler) SendJoinNotification(pl handler.JoinNotification) error {
+	return h.send(plugin.JoinEvent, pl)
+}
+
+// SendACKNotification sends an ACK notification.
+func (h *spooledHandler) SendACKNotification(pl handler.ACKNotification) error {
+	return h.send(plugin.ACKEvent, pl)
+}
+
+// SendErrorNotification sends an error notification.
+func (h *spooledHandler) SendErrorNotification(pl handler.ErrorNotification) error {
+	return h.send(plugin.ErrorEvent, pl)
+}
+
+// SendStatusNotification sends a status notification.
+func (h *spooledHandler) SendStatusNotification(pl handler.StatusNotification) error {
+	return h.send(plugin.StatusEvent, pl)
+}
+
+// SendLocationNotification sends a location notification.
+func (h *spooledHandler) SendLocationNotification(pl handler.LocationNotification) error {
+	return h.send(plugin.LocationEvent, pl)
+}
+
+// SendAdminEvent sends an admin-plane event.
+func (h *spooledHandler) SendAdminEvent(pl handler.AdminEvent) error {
+	return h.send(plugin.AdminEvent, pl)
+}
+
+// Close closes the wrapped handler.
+func (h *spooledHandler) Close() error {
+	return h.handler.Close()
+}
+
+func (h *spooledHandler) send(eventType string, pl interface{}) error {
+	b, err := json.Marshal(pl)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return errors.Wrap(err, "new uuid error")
+	}
+
+	if err := appendSpoolEntry(h.target, spoolEntry{ID: id, Type: eventType, Payload: b}); err != nil {
+		return errors.Wrap(err, "append spool entry error")
+	}
+
+	return deliverSpool(h.target, h.handler)
+}
+
+// appendSpoolEntry appends the given entry to the spool of the given
+// target. When the spool exceeds the max. spool size, the oldest entries
+// are dropped.
+func appendSpoolEntry(target string, e spoolEntry) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	c := config.C.Redis.Pool.Get()
+	defer c.Close()
+
+	key := fmt.Sprintf(spoolKeyTempl, target)
+	size, err := redis.Int(c.Do("RPUSH", key, b))
+	if err != nil {
+		return errors.Wrap(err, "rpush error")
+	}
+
+	max := config.C.ApplicationServer.Integration.Delivery.MaxSpoolSize
+	if max > 0 && size > max {
+		if _, err := c.Do("LTRIM", key, size-max, -1); err != nil {
+			return errors.Wrap(err, "ltrim error")
+		}
+		log.WithFields(log.Fields{
+			"target":  target,
+			"dropped": size - max,
+		}).Error("handler/multi: spool is full, oldest events dropped")
+		size = max
+	}
+	backpressure.SetSpoolSize(target, size)
+
+	return nil
+}
+
+// deliverSpool delivers the spooled events of the given target in order,
+// until the spool is empty or a delivery failed. When the spool is locked,
+// the events are delivered by the lock holder. When the spool is backing
+// off after a failed delivery, the events are delivered by the spool retry
+// loop once the backoff has expired.
+func deliverSpool(target string, h handler.IntegrationHandler) error {
+	key := fmt.Sprintf(spoolKeyTempl, target)
+	lockKey := fmt.Sprintf(spoolLockKeyTempl, target)
+
+	c := config.C.Redis.Pool.Get()
+	defer c.Close()
+
+	for {
+		retry, err := getSpoolRetry(c, target)
+		if err != nil {
+			return errors.Wrap(err, "get spool retry error")
+		}
+		if time.Now().Before(retry.NextRetry) {
+			return nil
+		}
+
+		_, err = redis.String(c.Do("SET", lockKey, "lock", "PX", int64(spoolLockTTL/time.Millisecond), "NX"))
+		if err != nil {
+			if err == redis.ErrNil {
+				return nil
+			}
+			return errors.Wrap(err, "acquire lock error")
+		}
+
+		err = deliverSpoolLocked(c, target, h)
+		if _, delErr := c.Do("DEL", lockKey); delErr != nil {
+			log.WithError(delErr).WithField("target", target).Error("handler/multi: release spool lock error")
+		}
+
+		// an event might have been appended after the spool was empty,
+		// but before the lock was released
+		size, lenErr := redis.Int(c.Do("LLEN", key))
+		if lenErr == nil {
+			backpressure.SetSpoolSize(target, size)
+		}
+		if err != nil {
+			return err
+		}
+		if lenErr != nil {
+			return errors.Wrap(lenErr, "llen error")
+		}
+		if size == 0 {
+			return nil
+		}
+	}
+}
+
+func deliverSpoolLocked(c redis.Conn, target string, h handler.IntegrationHandler) error {
+	key := fmt.Sprintf(spoolKeyTempl, target)
+
+	// the retry state might have been updated by an other instance before
+	// the lock was acquired
+	retry, err := getSpoolRetry(c, target)
+	if err != nil {
+		return errors.Wrap(err, "get spool retry error")
+	}
+
+	for {
+		b, err := redis.Bytes(c.Do("LINDEX", key, 0))
+		if err != nil {
+			if err == redis.ErrNil {
+				return nil
+			}
+			return errors.Wrap(err, "lindex error")
+		}
+
+		var e spoolEntry
+		if err := json.Unmarshal(b, &e); err != nil {
+			log.WithError(err).WithField("key", key).Error("handler/multi: unmarshal spool entry error, dropping entry")
+		} else if err := sendSpoolEntry(h, e); err != nil {
+			deadLettered, retryErr := handleSpoolFailure(c, target, e, retry, err)
+			if retryErr != nil {
+				log.WithError(retryErr).WithField("target", target).Error("handler/multi: handle failed delivery error")
+			}
+			if !deadLettered {
+				return errors.Wrapf(err, "deliver spooled %s event error", e.Type)
+			}
+			retry = spoolRetry{}
+		} else if retry.Attempts != 0 {
+			if _, err := c.Do("DEL", fmt.Sprintf(spoolRetryKeyTempl, target)); err != nil {
+				return errors.Wrap(err, "delete spool retry error")
+			}
+			retry = spoolRetry{}
+		}
+
+		// the entry is only removed after it has been acknowledged
+		if _, err := c.Do("LREM", key, 1, b); err != nil {
+			return errors.Wrap(err, "lrem error")
+		}
+	}
+}
+
+// getSpoolRetry returns the retry state of the given spool target. An empty
+// state is returned when the last delivery did not fail.
+func getSpoolRetry(c redis.Conn, target string) (spoolRetry, error) {
+	var retry spoolRetry
+
+	b, err := redis.Bytes(c.Do("GET", fmt.Sprintf(spoolRetryKeyTempl, target)))
+	if err != nil {
+		if err == redis.ErrNil {
+			return retry, nil
+		}
+		return retry, errors.Wrap(err, "get error")
+	}
+
+	if err := json.Unmarshal(b, &retry); err != nil {
+		return retry, errors.Wrap(err, "unmarshal json error")
+	}
+
+	return retry, nil
+}
+
+// handleSpoolFailure handles the failed delivery of the given (first)
+// spooled event. When the max. number of delivery attempts has been
+// reached, the event is moved to the dead-letter list and true is returned.
+// Otherwise the next attempt is scheduled using an exponential backoff.
+func handleSpoolFailure(c redis.Conn, target string, e spoolEntry, retry spoolRetry, deliveryErr error) (bool, error) {
+	conf := config.C.ApplicationServer.Integration.Delivery
+	retryKey := fmt.Sprintf(spoolRetryKeyTempl, target)
+
+	attempts := 1
+	if retry.EntryID == e.ID {
+		attempts = retry.Attempts + 1
+	}
+
+	if conf.MaxAttempts > 0 && attempts >= conf.MaxAttempts && strings.HasPrefix(target, "http:") {
+		if err := addDeadLetter(target, e, attempts, deliveryErr); err != nil {
+			return false, errors.Wrap(err, "add dead-letter error")
+		}
+		if _, err := c.Do("DEL", retryKey); err != nil {
+			return true, errors.Wrap(err, "delete spool retry error")
+		}
+		return true, nil
+	}
+
+	backoff := getSpoolBackoff(attempts)
+	b, err := json.Marshal(spoolRetry{
+		EntryID:   e.ID,
+		Attempts:  attempts,
+		NextRetry: time.Now().Add(backoff),
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "marshal json error")
+	}
+
+	if _, err := c.Do("PSETEX", retryKey, int64((backoff+spoolRetryTTL)/time.Millisecond), b); err != nil {
+		return false, errors.Wrap(err, "set spool retry error")
+	}
+
+	return false, nil
+}
+
+// getSpoolBackoff returns the backoff after the given number of failed
+// delivery attempts. The retry interval is doubled after each attempt,
+// until the max. retry interval is reached.
+func getSpoolBackoff(attempts int) time.Duration {
+	conf := config.C.ApplicationServer.Integration.Delivery
+
+	backoff := conf.RetryInterval
+	for i := 1; i < attempts; i++ {
+		if conf.MaxRetryInterval > 0 && backoff >= conf.MaxRetryInterval {
+			break
+		}
+		backoff *= 2
+	}
+
+	if conf.MaxRetryInterval > 0 && backoff > conf.MaxRetryInterval {
+		return conf.MaxRetryInterval
+	}
+	return backoff
+}
+
+// addDeadLetter adds the given event, of the given HTTP spool target, to the
+// dead-letter list of the application. When the dead-letter list is
+// disabled, the event is dropped.
+func addDeadLetter(target string, e spoolEntry, attempts int, deliveryErr error) error {
+	conf := config.C.ApplicationServer.Integration.Delivery.DeadLetter
+
+	applicationID, _, _, err := parseHTTPSpoolTarget(target)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"target":     target,
+		"event_type": e.Type,
+		"attempts":   attempts,
+	}).Error("handler/multi: max. delivery attempts reached, moving event to dead-letter list")
+
+	if conf.MaxEntries == 0 {
+		return nil
+	}
+
+	return deadletter.Add(config.C.Redis.Pool, applicationID, deadletter.Entry{
+		Time:        time.Now(),
+		Integration: HTTPHandlerKind,
+		EventType:   e.Type,
+		Payload:     e.Payload,
+		Attempts:    attempts,
+		Error:       deliveryErr.Error(),
+	}, conf.MaxEntries, conf.TTL)
+}
+
+// sendSpoolEntry sends the given spooled event to the given handler.
+func sendSpoolEntry(h handler.IntegrationHandler, e spoolEntry) error {
+	switch e.Type {
+	case plugin.UplinkEvent:
+		var pl handler.DataUpPayload
+		if err := json.Unmarshal(e.Payload, &pl); err != nil {
+			return errors.Wrap(err, "unmarshal json error")
+		}
+		return h.SendDataUp(pl)
+	case plugin.JoinEvent:
+		var pl handler.JoinNotification
+		if err := json.Unmarshal(e.Payload, &pl); err != nil {
+			return errors.Wrap(err, "unmarshal json error")
+		}
+		return h.SendJoinNotification(pl)
+	case plugin.ACKEvent:
+		var pl handler.ACKNotification
+		if err := json.Unmarshal(e.Payload, &pl); err != nil {
+			return errors.Wrap(err, "unmarshal json error")
+		}
+		return h.SendACKNotification(pl)
+	case plugin.ErrorEvent:
+		var pl handler.ErrorNotification
+		if err := json.Unmarshal(e.Payload, &pl); err != nil {
+			return errors.Wrap(err, "unmarshal json error")
+		}
+		return h.SendErrorNotification(pl)
+	case plugin.StatusEvent:
+		var pl handler.StatusNotification
+		if err := json.Unmarshal(e.Payload, &pl); err != nil {
+			return errors.Wrap(err, "unmarshal json error")
+		}
+		return h.SendStatusNotification(pl)
+	case plugin.LocationEvent:
+		var pl handler.LocationNotification
+		if err := json.Unmarshal(e.Payload, &pl); err != nil {
+			return errors.Wrap(err, "unmarshal json error")
+		}
+		return h.SendLocationNotification(pl)
+	case plugin.AdminEvent:
+		var pl handler.AdminEvent
+		if err := json.Unmarshal(e.Payload, &pl); err != nil {
+			return errors.Wrap(err, "unmarshal json error")
+		}
+		return h.SendAdminEvent(pl)
+	default:
+		return fmt.Errorf("unknown event type: %s", e.Type)
+	}
+}
+
+// spoolRetryLoop re-delivers the spooled events until done is closed.
+func (w Handler) spoolRetryLoop(done chan struct{}) {
+	ticker := time.NewTicker(config.C.ApplicationServer.Integration.Delivery.RetryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+
+		if err := w.retrySpools(); err != nil {
+			log.WithError(err).Error("handler/multi: retry spooled events error")
+		}
+	}
+}
+
+// retrySpools re-delivers the events of all spools.
+func (w Handler) retrySpools() error {
+	keys, err := getSpoolKeys()
+	if err != nil {
+		return errors.Wrap(err, "get spool keys error")
+	}
+
+	for _, key := range keys {
+		target := strings.TrimPrefix(key, spoolKeyPrefix)
+
+		h, err := w.getSpoolTargetHandler(target)
+		if err != nil {
+			log.WithError(err).WithField("target", target).Error("handler/multi: get spool target handler error")
+			continue
+		}
+
+		if h == nil {
+			log.WithField("target", target).Warning("handler/multi: spool target no longer exists, dropping spooled events")
+			c := config.C.Redis.Pool.Get()
+			_, err := c.Do("DEL", key, fmt.Sprintf(spoolRetryKeyTempl, target))
+			c.Close()
+			if err != nil {
+				log.WithError(err).WithField("target", target).Error("handler/multi: delete spool error")
+			}
+			continue
+		}
+
+		if err := deliverSpool(target, h); err != nil {
+			log.WithError(err).WithField("target", target).Warning("handler/multi: deliver spooled events error, will retry")
+		}
+	}
+
+	return nil
+}
+
+// getSpoolKeys returns the keys of all spools.
+func getSpoolKeys() ([]string, error) {
+	c := config.C.Redis.Pool.Get()
+	defer c.Close()
+
+	var keys []string
+	cursor := 0
+	for {
+		values, err := redis.Values(c.Do("SCAN", cursor, "MATCH", spoolKeyPrefix+"*"))
+		if err != nil {
+			return nil, errors.Wrap(err, "scan error")
+		}
+
+		var page []string
+		if _, err := redis.Scan(values, &cursor, &page); err != nil {
+			return nil, errors.Wrap(err, "scan values error")
+		}
+		keys = append(keys, page...)
+
+		if cursor == 0 {
+			return keys, nil
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package multihandler
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/deadletter"
+	"github.com/brocaar/lora-app-server/internal/faultinject"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lora-app-server/internal/test/testhandler"
+	"github.com/brocaar/lora-app-server/plugin"
+	"github.com/brocaar/lorawan"
+)
+
+func TestHTTPSpoolTarget(t *testing.T) {
+	assert := require.New(t)
+
+	for _, inherited := range []bool{false, true} {
+		target := getHTTPSpoolTarget(10, 20, inherited)
+
+		applicationID, integrationID, inh, err := parseHTTPSpoolTarget(target)
+		assert.NoError(err)
+		assert.Equal(int64(10), applicationID)
+		assert.Equal(int64(20), integrationID)
+		assert.Equal(inherited, inh)
+	}
+
+	_, _, _, err := parseHTTPSpoolTarget("default")
+	assert.Error(err)
+}
+
+func TestSendSpoolEntry(t *testing.T) {
+	assert := require.New(t)
+	h := testhandler.NewTestHandler()
+
+	pl := handler.DataUpPayload{
+		ApplicationID:   1,
+		ApplicationName: "test-app",
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		FCnt:            10,
+		FPort:           20,
+		Data:            []byte{1, 2, 3},
+	}
+	b, err := json.Marshal(pl)
+	assert.NoError(err)
+
+	assert.NoError(sendSpoolEntry(h, spoolEntry{Type: plugin.UplinkEvent, Payload: b}))
+	assert.Equal(pl, <-h.SendDataUpChan)
+
+	assert.Error(sendSpoolEntry(h, spoolEntry{Type: "unknown", Payload: b}))
+}
+
+func TestSpooledHandler(t *testing.T) {
+	assert := require.New(t)
+
+	conf := test.GetConfig()
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL, 10, 0)
+	test.MustFlushRedis(config.C.Redis.Pool)
+
+	config.C.ApplicationServer.Integration.Delivery.AtLeastOnce = true
+	defer func() {
+		config.C.ApplicationServer.Integration.Delivery.AtLeastOnce = false
+		config.C.ApplicationServer.Integration.Delivery.MaxSpoolSize = 0
+		config.C.ApplicationServer.Integration.Delivery.RetryInterval = 0
+		config.C.ApplicationServer.Integration.Delivery.MaxRetryInterval = 0
+		config.C.ApplicationServer.Integration.Delivery.MaxAttempts = 0
+	}()
+
+	spoolSize := func(target string) int {
+		c := config.C.Redis.Pool.Get()
+		defer c.Close()
+		size, err := redis.Int(c.Do("LLEN", fmt.Sprintf(spoolKeyTempl, target)))
+		assert.NoError(err)
+		return size
+	}
+
+	th := testhandler.NewTestHandler()
+
+	t.Run("Acknowledged events are removed from the spool", func(t *testing.T) {
+		h := newSpooledHandler("test", th)
+		assert.NoError(h.SendDataUp(handler.DataUpPayload{ApplicationID: 1, FCnt: 1}))
+		assert.Equal(handler.DataUpPayload{ApplicationID: 1, FCnt: 1}, <-th.SendDataUpChan)
+		assert.Equal(0, spoolSize("test"))
+	})
+
+	t.Run("Failed events remain spooled and are delivered in order", func(t *testing.T) {
+		failing := faultinject.NewIntegrationHandler(faultinject.Config{
+			Enabled:     true,
+			FailureRate: 1,
+		}, th)
+
+		h := newSpooledHandler("test", failing)
+		assert.Error(h.SendDataUp(handler.DataUpPayload{ApplicationID: 1, FCnt: 2}))
+		assert.Error(h.SendDataUp(handler.DataUpPayload{ApplicationID: 1, FCnt: 3}))
+		assert.Len(th.SendDataUpChan, 0)
+		assert.Equal(2, spoolSize("test"))
+
+		assert.NoError(deliverSpool("test", th))
+		assert.Equal(handler.DataUpPayload{ApplicationID: 1, FCnt: 2}, <-th.SendDataUpChan)
+		assert.Equal(handler.DataUpPayload{ApplicationID: 1, FCnt: 3}, <-th.SendDataUpChan)
+		assert.Equal(0, spoolSize("test"))
+	})
+
+	t.Run("The oldest events are dropped when the spool is full", func(t *testing.T) {
+		config.C.ApplicationServer.Integration.Delivery.MaxSpoolSize = 2
+
+		for i := 0; i < 3; i++ {
+			assert.NoError(appendSpoolEntry("full", spoolEntry{Type: plugin.UplinkEvent, Payload: json.RawMessage(fmt.Sprintf(`{"fCnt":%d}`, i))}))
+		}
+		assert.Equal(2, spoolSize("full"))
+
+		assert.NoError(deliverSpool("full", th))
+		assert.Equal(uint32(1), (<-th.SendDataUpChan).FCnt)
+		assert.Equal(uint32(2), (<-th.SendDataUpChan).FCnt)
+	})
+	t.Run("Failed deliveries are retried using an exponential backoff", func(t *testing.T) {
+		config.C.ApplicationServer.Integration.Delivery.RetryInterval = time.Minute
+		config.C.ApplicationServer.Integration.Delivery.MaxRetryInterval = 4 * time.Minute
+		defer func() {
+			config.C.ApplicationServer.Integration.Delivery.RetryInterval = 0
+			config.C.ApplicationServer.Integration.Delivery.MaxRetryInterval = 0
+		}()
+
+		for i, backoff := range []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 4 * time.Minute} {
+			assert.Equal(backoff, getSpoolBackoff(i+1))
+		}
+
+		failing := faultinject.NewIntegrationHandler(faultinject.Config{
+			Enabled:     true,
+			FailureRate: 1,
+		}, th)
+
+		h := newSpooledHandler("backoff", failing)
+		assert.Error(h.SendDataUp(handler.DataUpPayload{ApplicationID: 1, FCnt: 4}))
+
+		// the spool is backing off, the event is only spooled
+		assert.NoError(h.SendDataUp(handler.DataUpPayload{ApplicationID: 1, FCnt: 5}))
+		assert.NoError(deliverSpool("backoff", th))
+		assert.Len(th.SendDataUpChan, 0)
+		assert.Equal(2, spoolSize("backoff"))
+	})
+
+	t.Run("Events exceeding the max. attempts are moved to the dead-letter list", func(t *testing.T) {
+		config.C.ApplicationServer.Integration.Delivery.MaxAttempts = 2
+		config.C.ApplicationServer.Integration.Delivery.DeadLetter.MaxEntries = 10
+		config.C.ApplicationServer.Integration.Delivery.DeadLetter.TTL = time.Minute
+
+		failing := faultinject.NewIntegrationHandler(faultinject.Config{
+			Enabled:     true,
+			FailureRate: 1,
+		}, th)
+
+		target := getHTTPSpoolTarget(1, 2, false)
+		h := newSpooledHandler(target, failing)
+		assert.Error(h.SendDataUp(handler.DataUpPayload{ApplicationID: 1, FCnt: 6}))
+		assert.Equal(1, spoolSize(target))
+
+		assert.NoError(deliverSpool(target, failing))
+		assert.Equal(0, spoolSize(target))
+
+		entries, err := deadletter.Get(config.C.Redis.Pool, 1, 10)
+		assert.NoError(err)
+		assert.Len(entries, 1)
+		assert.Equal(HTTPHandlerKind, entries[0].Integration)
+		assert.Equal(plugin.UplinkEvent, entries[0].EventType)
+		assert.Equal(2, entries[0].Attempts)
+
+		var pl handler.DataUpPayload
+		assert.NoError(json.Unmarshal(entries[0].Payload, &pl))
+		assert.Equal(uint32(6), pl.FCnt)
+	})
+}
@@ -0,0 +1,8 @@
+package mydeviceshandler
+
+import "errors"
+
+// errors
+var (
+	ErrInvalidEndpoint = errors.New("invalid endpoint (expected an http:// or https:// url)")
+)
@@ -0,0 +1,186 @@
+// Package mydeviceshandler implements a myDevices (Cayenne) integration
+// handler. The uplinks are forwarded to the configured endpoint in the format
+// expected by the Cayenne network endpoint. Decoding of the payload is
+// handled by Cayenne, based on the device template selected in Cayenne.
+package mydeviceshandler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/proxy"
+	"github.com/brocaar/lorawan"
+)
+
+// CayenneEndpoint defines the Cayenne endpoint for LoRa App Server.
+const CayenneEndpoint = "https://lora.mydevices.com/v1/networks/loraserverio/uplink"
+
+// HandlerConfig contains the configuration for a myDevices handler.
+type HandlerConfig struct {
+	Endpoint string `json:"endpoint"`
+	ProxyURL string `json:"proxyURL,omitempty"`
+
+	// Events contains the event types (e.g. up and join) which are
+	// forwarded by the integration. When empty, all events are forwarded.
+	Events []string `json:"events,omitempty"`
+}
+
+// Validate validates the HandlerConfig data.
+func (c HandlerConfig) Validate() error {
+	u, err := url.Parse(c.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidEndpoint
+	}
+	if err := handler.ValidateEventTypes(c.Events); err != nil {
+		return err
+	}
+	return proxy.Validate(c.ProxyURL)
+}
+
+// uplinkPayload defines the uplink payload in the format expected by the
+// Cayenne network endpoint.
+type uplinkPayload struct {
+	CorrelationID string         `json:"correlationID,omitempty"`
+	DevEUI        lorawan.EUI64  `json:"devEUI"`
+	Data          []byte         `json:"data"`
+	Port          uint8          `json:"port"`
+	Counter       uint32         `json:"counter"`
+	DataRate      int            `json:"dataRate"`
+	Frequency     int            `json:"frequency"`
+	Timestamp     int64          `json:"timestamp"`
+	Gateways      []uplinkRXInfo `json:"gateways"`
+}
+
+// uplinkRXInfo contains the reception details of a single gateway.
+type uplinkRXInfo struct {
+	GatewayID lorawan.EUI64 `json:"gatewayID"`
+	RSSI      int           `json:"rssi"`
+	SNR       float64       `json:"snr"`
+	Latitude  *float64      `json:"latitude,omitempty"`
+	Longitude *float64      `json:"longitude,omitempty"`
+	Altitude  *float64      `json:"altitude,omitempty"`
+}
+
+// Handler implements a myDevices handler.
+type Handler struct {
+	config HandlerConfig
+}
+
+// NewHandler creates a new myDevices handler.
+func NewHandler(conf HandlerConfig) (*Handler, error) {
+	return &Handler{
+		config: conf,
+	}, nil
+}
+
+// Close closes the handler.
+func (h *Handler) Close() error {
+	return nil
+}
+
+// SendDataUp sends the uplink to the configured endpoint.
+func (h *Handler) SendDataUp(pl handler.DataUpPayload) error {
+	up := uplinkPayload{
+		CorrelationID: pl.CorrelationID,
+		DevEUI:        pl.DevEUI,
+		Data:          pl.Data,
+		Port:          pl.FPort,
+		Counter:       pl.FCnt,
+		DataRate:      pl.TXInfo.DR,
+		Frequency:     pl.TXInfo.Frequency,
+		Timestamp:     time.Now().Unix(),
+		Gateways:      []uplinkRXInfo{},
+	}
+
+	for _, rxInfo := range pl.RXInfo {
+		gw := uplinkRXInfo{
+			GatewayID: rxInfo.GatewayID,
+			RSSI:      rxInfo.RSSI,
+			SNR:       rxInfo.LoRaSNR,
+		}
+		if rxInfo.Location != nil {
+			gw.Latitude = &rxInfo.Location.Latitude
+			gw.Longitude = &rxInfo.Location.Longitude
+			gw.Altitude = &rxInfo.Location.Altitude
+		}
+		up.Gateways = append(up.Gateways, gw)
+	}
+
+	return h.send(pl.DevEUI, up)
+}
+
+// SendJoinNotification is not implemented.
+func (h *Handler) SendJoinNotification(pl handler.JoinNotification) error {
+	return nil
+}
+
+// SendACKNotification is not implemented.
+func (h *Handler) SendACKNotification(pl handler.ACKNotification) error {
+	return nil
+}
+
+// SendErrorNotification is not implemented.
+func (h *Handler) SendErrorNotification(pl handler.ErrorNotification) error {
+	return nil
+}
+
+// SendStatusNotification is not implemented.
+func (h *Handler) SendStatusNotification(pl handler.StatusNotification) error {
+	return nil
+}
+
+// SendLocationNotification is not implemented.
+func (h *Handler) SendLocationNotification(pl handler.LocationNotification) error {
+	return nil
+}
+
+// SendAdminEvent is not implemented, as admin events are not related to
+// a myDevices device.
+func (h *Handler) SendAdminEvent(pl handler.AdminEvent) error {
+	return nil
+}
+
+func (h *Handler) send(devEUI lorawan.EUI64, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	req, err := http.NewRequest("POST", h.config.Endpoint, bytes.NewReader(b))
+	if err != nil {
+		return errors.Wrap(err, "new request error")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client, err := proxy.GetHTTPClient(h.config.ProxyURL)
+	if err != nil {
+		return errors.Wrap(err, "get http client error")
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "http request error")
+	}
+	defer resp.Body.Close()
+
+	// check that response is in 200 range
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("expected 2xx response, got: %d (%s)", resp.StatusCode, string(b))
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui": devEUI,
+	}).Info("handler/mydevices: uplink sent")
+
+	return nil
+}
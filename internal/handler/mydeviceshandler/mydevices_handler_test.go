@@ -0,0 +1,136 @@
+package mydeviceshandler
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lorawan"
+)
+
+type testHTTPHandler struct {
+	requests chan *http.Request
+}
+
+func (h *testHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b, _ := ioutil.ReadAll(r.Body)
+	r.Body = ioutil.NopCloser(bytes.NewReader(b))
+	h.requests <- r
+	w.WriteHeader(http.StatusOK)
+}
+
+func TestHandlerConfig(t *testing.T) {
+	Convey("Given a set of handler configurations", t, func() {
+		tests := []struct {
+			Name          string
+			Config        HandlerConfig
+			ExpectedError error
+		}{
+			{
+				Name:   "valid",
+				Config: HandlerConfig{Endpoint: CayenneEndpoint},
+			},
+			{
+				Name:          "missing endpoint",
+				ExpectedError: ErrInvalidEndpoint,
+			},
+			{
+				Name:          "invalid scheme",
+				Config:        HandlerConfig{Endpoint: "tcp://lora.mydevices.com"},
+				ExpectedError: ErrInvalidEndpoint,
+			},
+			{
+				Name: "invalid event type",
+				Config: HandlerConfig{
+					Endpoint: CayenneEndpoint,
+					Events:   []string{"foo"},
+				},
+				ExpectedError: handler.ErrInvalidEventType,
+			},
+		}
+
+		for _, test := range tests {
+			Convey("Testing: "+test.Name, func() {
+				So(test.Config.Validate(), ShouldEqual, test.ExpectedError)
+			})
+		}
+	})
+}
+
+func TestHandler(t *testing.T) {
+	Convey("Given a test HTTP server", t, func() {
+		httpHandler := testHTTPHandler{
+			requests: make(chan *http.Request, 100),
+		}
+		server := httptest.NewServer(&httpHandler)
+		defer server.Close()
+
+		h, err := NewHandler(HandlerConfig{Endpoint: server.URL + "/uplink"})
+		So(err, ShouldBeNil)
+
+		Convey("Then SendDataUp sends the uplink in the Cayenne format", func() {
+			So(h.SendDataUp(handler.DataUpPayload{
+				DevEUI: lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				FCnt:   10,
+				FPort:  20,
+				Data:   []byte{1, 2, 3, 4},
+				TXInfo: handler.TXInfo{
+					Frequency: 868100000,
+					DR:        5,
+				},
+				RXInfo: []handler.RXInfo{
+					{
+						GatewayID: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+						RSSI:      -60,
+						LoRaSNR:   5.5,
+						Location: &handler.Location{
+							Latitude:  1.123,
+							Longitude: 2.123,
+							Altitude:  3,
+						},
+					},
+				},
+			}), ShouldBeNil)
+
+			req := <-httpHandler.requests
+			So(req.URL.Path, ShouldEqual, "/uplink")
+			So(req.Header.Get("Content-Type"), ShouldEqual, "application/json")
+
+			var pl uplinkPayload
+			So(json.NewDecoder(req.Body).Decode(&pl), ShouldBeNil)
+			So(pl.Timestamp, ShouldBeGreaterThan, 0)
+			pl.Timestamp = 0
+
+			lat, lon, alt := 1.123, 2.123, 3.0
+			So(pl, ShouldResemble, uplinkPayload{
+				DevEUI:    lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				Data:      []byte{1, 2, 3, 4},
+				Port:      20,
+				Counter:   10,
+				DataRate:  5,
+				Frequency: 868100000,
+				Gateways: []uplinkRXInfo{
+					{
+						GatewayID: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+						RSSI:      -60,
+						SNR:       5.5,
+						Latitude:  &lat,
+						Longitude: &lon,
+						Altitude:  &alt,
+					},
+				},
+			})
+		})
+
+		Convey("Then SendStatusNotification is a no-op", func() {
+			So(h.SendStatusNotification(handler.StatusNotification{}), ShouldBeNil)
+			So(httpHandler.requests, ShouldHaveLength, 0)
+		})
+	})
+}
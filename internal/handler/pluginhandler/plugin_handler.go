@@ -0,0 +1,344 @@
+// Package pluginhandler implements an integration handler forwarding the
+// events to an integration plugin. The plugin is either started (and
+// restarted when it exits or becomes unhealthy) by LoRa App Server, or is
+// managed externally (e.g. as sidecar container).
+package pluginhandler
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	pb "github.com/brocaar/lora-app-server/api"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/netutil"
+	"github.com/brocaar/lora-app-server/plugin"
+)
+
+const (
+	defaultTimeout             = 5 * time.Second
+	defaultHealthCheckInterval = 10 * time.Second
+
+	// unhealthyRestartCount defines the number of health check intervals
+	// after which an unhealthy started plugin is restarted, with a minimum
+	// of minUnhealthyRestartTimeout to give the plugin time to start.
+	unhealthyRestartCount      = 3
+	minUnhealthyRestartTimeout = 30 * time.Second
+
+	// restartDelay defines the delay before restarting an exited plugin.
+	restartDelay = 5 * time.Second
+
+	// stopTimeout defines the time a plugin is given to exit after the
+	// interrupt signal, before it is killed.
+	stopTimeout = 5 * time.Second
+)
+
+// ErrUnhealthy is returned when sending an event to an unhealthy plugin.
+var ErrUnhealthy = errors.New("plugin is unhealthy")
+
+// Config defines the plugin configuration.
+type Config struct {
+	Name                string        `mapstructure:"name"`
+	Command             string        `mapstructure:"command"`
+	Args                []string      `mapstructure:"args"`
+	Address             string        `mapstructure:"address"`
+	Timeout             time.Duration `mapstructure:"timeout"`
+	HealthCheckInterval time.Duration `mapstructure:"health_check_interval"`
+}
+
+// Handler implements a plugin handler.
+type Handler struct {
+	conf   Config
+	conn   *grpc.ClientConn
+	client pb.PluginServiceClient
+	health grpc_health_v1.HealthClient
+
+	mu      sync.Mutex
+	healthy bool
+	cmd     *exec.Cmd
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewHandler creates a new plugin handler. When a command is configured,
+// the plugin process is started.
+func NewHandler(conf Config) (*Handler, error) {
+	if conf.Name == "" || conf.Address == "" {
+		return nil, errors.New("plugin name and address must be set")
+	}
+	if conf.Timeout == 0 {
+		conf.Timeout = defaultTimeout
+	}
+	if conf.HealthCheckInterval == 0 {
+		conf.HealthCheckInterval = defaultHealthCheckInterval
+	}
+
+	// the plugin might not be serving yet, therefore the connection is
+	// not blocking and re-connects are not delayed for long
+	conn, err := grpc.Dial(conf.Address,
+		grpc.WithInsecure(),
+		grpc.WithBackoffMaxDelay(time.Second),
+		netutil.WithDialer(),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "dial plugin error")
+	}
+
+	h := Handler{
+		conf:   conf,
+		conn:   conn,
+		client: pb.NewPluginServiceClient(conn),
+		health: grpc_health_v1.NewHealthClient(conn),
+		done:   make(chan struct{}),
+	}
+
+	if conf.Command != "" {
+		if err := h.start(); err != nil {
+			conn.Close()
+			return nil, err
+		}
+
+		h.wg.Add(1)
+		go h.superviseLoop()
+	}
+
+	h.wg.Add(1)
+	go h.healthCheckLoop()
+
+	log.WithFields(log.Fields{
+		"name":    conf.Name,
+		"address": conf.Address,
+		"command": conf.Command,
+	}).Info("handler/plugin: plugin configured")
+
+	return &h, nil
+}
+
+// Healthy returns true when the last health check succeeded.
+func (h *Handler) Healthy() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.healthy
+}
+
+// SendDataUp sends a data-up payload.
+func (h *Handler) SendDataUp(pl handler.DataUpPayload) error {
+	return h.sendEvent(plugin.UplinkEvent, pl.ApplicationID, pl)
+}
+
+// SendJoinNotification sends a join notification.
+func (h *Handler) SendJoinNotification(pl handler.JoinNotification) error {
+	return h.sendEvent(plugin.JoinEvent, pl.ApplicationID, pl)
+}
+
+// SendACKNotification sends an ACK notification.
+func (h *Handler) SendACKNotification(pl handler.ACKNotification) error {
+	return h.sendEvent(plugin.ACKEvent, pl.ApplicationID, pl)
+}
+
+// SendErrorNotification sends an error notification.
+func (h *Handler) SendErrorNotification(pl handler.ErrorNotification) error {
+	return h.sendEvent(plugin.ErrorEvent, pl.ApplicationID, pl)
+}
+
+// SendStatusNotification sends a status notification.
+func (h *Handler) SendStatusNotification(pl handler.StatusNotification) error {
+	return h.sendEvent(plugin.StatusEvent, pl.ApplicationID, pl)
+}
+
+// SendLocationNotification sends a location notification.
+func (h *Handler) SendLocationNotification(pl handler.LocationNotification) error {
+	return h.sendEvent(plugin.LocationEvent, pl.ApplicationID, pl)
+}
+
+// SendAdminEvent sends an admin-plane event.
+func (h *Handler) SendAdminEvent(pl handler.AdminEvent) error {
+	return h.sendEvent(plugin.AdminEvent, pl.ApplicationID, pl)
+}
+
+// Close stops the plugin (when started by the handler) and closes the
+// connection.
+func (h *Handler) Close() error {
+	close(h.done)
+	h.stop()
+	h.wg.Wait()
+	return h.conn.Close()
+}
+
+func (h *Handler) sendEvent(eventType string, applicationID int64, pl interface{}) error {
+	if !h.Healthy() {
+		return errors.Wrap(ErrUnhealthy, h.conf.Name)
+	}
+
+	b, err := json.Marshal(pl)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), h.conf.Timeout)
+	defer cancel()
+
+	_, err = h.client.HandleEvent(ctx, &pb.HandlePluginEventRequest{
+		Type:          eventType,
+		ApplicationId: applicationID,
+		PayloadJson:   b,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "plugin %s: handle event error", h.conf.Name)
+	}
+
+	return nil
+}
+
+func (h *Handler) start() error {
+	cmd := exec.Command(h.conf.Command, h.conf.Args...)
+	cmd.Env = append(os.Environ(), fmt.Sprintf("%s=%s", plugin.AddressEnvVar, h.conf.Address))
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Start(); err != nil {
+		return errors.Wrapf(err, "start plugin %s error", h.conf.Name)
+	}
+
+	h.mu.Lock()
+	h.cmd = cmd
+	h.mu.Unlock()
+
+	log.WithFields(log.Fields{
+		"name": h.conf.Name,
+		"pid":  cmd.Process.Pid,
+	}).Info("handler/plugin: plugin started")
+
+	return nil
+}
+
+// stop interrupts the plugin process and kills it when it did not exit
+// within the stop timeout.
+func (h *Handler) stop() {
+	h.mu.Lock()
+	cmd := h.cmd
+	h.mu.Unlock()
+
+	if cmd == nil || cmd.Process == nil {
+		return
+	}
+
+	if err := cmd.Process.Signal(os.Interrupt); err != nil {
+		return
+	}
+
+	// killing an already exited process is a no-op
+	time.AfterFunc(stopTimeout, func() {
+		cmd.Process.Kill()
+	})
+}
+
+// superviseLoop waits for the plugin process to exit and restarts it,
+// until the handler is closed.
+func (h *Handler) superviseLoop() {
+	defer h.wg.Done()
+
+	for {
+		h.mu.Lock()
+		cmd := h.cmd
+		h.mu.Unlock()
+
+		err := cmd.Wait()
+
+		select {
+		case <-h.done:
+			return
+		default:
+		}
+
+		log.WithError(err).WithField("name", h.conf.Name).Errorf("handler/plugin: plugin exited, restarting in %s", restartDelay)
+
+		for {
+			select {
+			case <-h.done:
+				return
+			case <-time.After(restartDelay):
+			}
+
+			if err := h.start(); err != nil {
+				log.WithError(err).WithField("name", h.conf.Name).Error("handler/plugin: restart plugin error")
+				continue
+			}
+			break
+		}
+	}
+}
+
+// healthCheckLoop checks the health of the plugin within the configured
+// interval, or every second while the plugin is unhealthy. A started plugin
+// is restarted when it has been unhealthy for multiple health check
+// intervals.
+func (h *Handler) healthCheckLoop() {
+	defer h.wg.Done()
+
+	unhealthySince := time.Now()
+	restartTimeout := unhealthyRestartCount * h.conf.HealthCheckInterval
+	if restartTimeout < minUnhealthyRestartTimeout {
+		restartTimeout = minUnhealthyRestartTimeout
+	}
+
+	for {
+		healthy := h.checkHealth()
+
+		h.mu.Lock()
+		changed := h.healthy != healthy
+		h.healthy = healthy
+		h.mu.Unlock()
+
+		if changed {
+			log.WithFields(log.Fields{
+				"name":    h.conf.Name,
+				"healthy": healthy,
+			}).Info("handler/plugin: plugin health changed")
+		}
+
+		interval := h.conf.HealthCheckInterval
+		if healthy {
+			unhealthySince = time.Now()
+		} else {
+			if interval > time.Second {
+				interval = time.Second
+			}
+
+			if h.conf.Command != "" && time.Since(unhealthySince) > restartTimeout {
+				log.WithField("name", h.conf.Name).Warning("handler/plugin: plugin is unhealthy, stopping it for restart")
+				unhealthySince = time.Now()
+				h.stop()
+			}
+		}
+
+		select {
+		case <-h.done:
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (h *Handler) checkHealth() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), h.conf.Timeout)
+	defer cancel()
+
+	resp, err := h.health.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		log.WithError(err).WithField("name", h.conf.Name).Debug("handler/plugin: health check error")
+		return false
+	}
+
+	return resp.Status == grpc_health_v1.HealthCheckResponse_SERVING
+}
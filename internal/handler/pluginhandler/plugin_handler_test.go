@@ -0,0 +1,101 @@
+package pluginhandler
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/plugin"
+	"github.com/brocaar/lorawan"
+)
+
+type testEvent struct {
+	eventType     string
+	applicationID int64
+	payload       []byte
+}
+
+type testPlugin struct {
+	events chan testEvent
+}
+
+func (p *testPlugin) HandleEvent(eventType string, applicationID int64, payload []byte) error {
+	p.events <- testEvent{eventType, applicationID, payload}
+	return nil
+}
+
+func TestHandler(t *testing.T) {
+	assert := require.New(t)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(err)
+
+	p := testPlugin{events: make(chan testEvent, 10)}
+	server := plugin.NewServer(&p)
+	go server.Serve(ln)
+	defer server.Stop()
+
+	h, err := NewHandler(Config{
+		Name:                "test",
+		Address:             ln.Addr().String(),
+		HealthCheckInterval: 100 * time.Millisecond,
+	})
+	assert.NoError(err)
+	defer h.Close()
+
+	for i := 0; i < 50 && !h.Healthy(); i++ {
+		time.Sleep(20 * time.Millisecond)
+	}
+	assert.True(h.Healthy())
+
+	t.Run("SendDataUp", func(t *testing.T) {
+		assert := require.New(t)
+
+		pl := handler.DataUpPayload{
+			ApplicationID: 1,
+			DevEUI:        lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			FPort:         10,
+		}
+		assert.NoError(h.SendDataUp(pl))
+
+		e := <-p.events
+		assert.Equal(plugin.UplinkEvent, e.eventType)
+		assert.EqualValues(1, e.applicationID)
+
+		var out handler.DataUpPayload
+		assert.NoError(json.Unmarshal(e.payload, &out))
+		assert.Equal(pl.DevEUI, out.DevEUI)
+		assert.Equal(pl.FPort, out.FPort)
+	})
+
+	t.Run("SendErrorNotification", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.NoError(h.SendErrorNotification(handler.ErrorNotification{
+			ApplicationID: 2,
+			Type:          "CODEC",
+		}))
+
+		e := <-p.events
+		assert.Equal(plugin.ErrorEvent, e.eventType)
+		assert.EqualValues(2, e.applicationID)
+	})
+
+	t.Run("Unhealthy", func(t *testing.T) {
+		assert := require.New(t)
+
+		server.Stop()
+		for i := 0; i < 50 && h.Healthy(); i++ {
+			time.Sleep(20 * time.Millisecond)
+		}
+		assert.False(h.Healthy())
+
+		err := h.SendDataUp(handler.DataUpPayload{ApplicationID: 1})
+		assert.Equal(ErrUnhealthy, errors.Cause(err))
+	})
+}
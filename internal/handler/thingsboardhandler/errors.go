@@ -0,0 +1,8 @@
+package thingsboardhandler
+
+import "errors"
+
+// errors
+var (
+	ErrInvalidServer = errors.New("invalid server (expected an http:// or https:// url)")
+)
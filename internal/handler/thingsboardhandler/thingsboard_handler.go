@@ -0,0 +1,262 @@
+// Package thingsboardhandler implements a ThingsBoard integration handler.
+// The handler uses the ThingsBoard device HTTP API, for which the access
+// token of the device must be stored as ThingsBoardAccessToken device
+// variable. The decoded payload object is sent as telemetry, the
+// application and device details are sent as (client-side) attributes.
+package thingsboardhandler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/proxy"
+	"github.com/brocaar/lorawan"
+)
+
+// AccessTokenVariable defines the name of the device variable holding the
+// ThingsBoard device access token.
+const AccessTokenVariable = "ThingsBoardAccessToken"
+
+// DeviceVariablesFunc defines the function returning the variables of the
+// given device.
+type DeviceVariablesFunc func(devEUI lorawan.EUI64) (map[string]string, error)
+
+// HandlerConfig contains the configuration for a ThingsBoard handler.
+type HandlerConfig struct {
+	Server   string `json:"server"`
+	ProxyURL string `json:"proxyURL,omitempty"`
+
+	// Events contains the event types (e.g. up and join) which are
+	// forwarded by the integration. When empty, all events are forwarded.
+	Events []string `json:"events,omitempty"`
+}
+
+// Validate validates the HandlerConfig data.
+func (c HandlerConfig) Validate() error {
+	u, err := url.Parse(c.Server)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidServer
+	}
+	if err := handler.ValidateEventTypes(c.Events); err != nil {
+		return err
+	}
+	return proxy.Validate(c.ProxyURL)
+}
+
+// Handler implements a ThingsBoard handler.
+type Handler struct {
+	config       HandlerConfig
+	getVariables DeviceVariablesFunc
+}
+
+// NewHandler creates a new ThingsBoard handler. The given function is used
+// to retrieve the ThingsBoardAccessToken variable of a device.
+func NewHandler(conf HandlerConfig, getVariables DeviceVariablesFunc) (*Handler, error) {
+	return &Handler{
+		config:       conf,
+		getVariables: getVariables,
+	}, nil
+}
+
+// Close closes the handler.
+func (h *Handler) Close() error {
+	return nil
+}
+
+// SendDataUp sends the decoded payload object as telemetry and the
+// application and device details as attributes.
+func (h *Handler) SendDataUp(pl handler.DataUpPayload) error {
+	token, err := h.getAccessToken(pl.DevEUI)
+	if err != nil || token == "" {
+		return err
+	}
+
+	attributes := map[string]interface{}{
+		"application_id":   strconv.FormatInt(pl.ApplicationID, 10),
+		"application_name": pl.ApplicationName,
+		"device_name":      pl.DeviceName,
+		"dev_eui":          pl.DevEUI.String(),
+	}
+	if err := h.send(token, "attributes", pl.DevEUI, attributes); err != nil {
+		return err
+	}
+
+	values := map[string]interface{}{
+		"f_cnt":  pl.FCnt,
+		"f_port": pl.FPort,
+		"dr":     pl.TXInfo.DR,
+	}
+	if len(pl.RXInfo) != 0 {
+		rssi, snr := pl.RXInfo[0].RSSI, pl.RXInfo[0].LoRaSNR
+		for _, rxInfo := range pl.RXInfo[1:] {
+			if rxInfo.RSSI > rssi {
+				rssi = rxInfo.RSSI
+			}
+			if rxInfo.LoRaSNR > snr {
+				snr = rxInfo.LoRaSNR
+			}
+		}
+		values["rssi"] = rssi
+		values["snr"] = snr
+	}
+
+	if pl.Object != nil {
+		b, err := json.Marshal(pl.Object)
+		if err != nil {
+			return errors.Wrap(err, "marshal object error")
+		}
+		var obj interface{}
+		if err := json.Unmarshal(b, &obj); err != nil {
+			return errors.Wrap(err, "unmarshal object error")
+		}
+		flatten("data", obj, values)
+	}
+
+	return h.sendTelemetry(token, pl.DevEUI, values)
+}
+
+// SendStatusNotification sends the device-status as telemetry.
+func (h *Handler) SendStatusNotification(pl handler.StatusNotification) error {
+	token, err := h.getAccessToken(pl.DevEUI)
+	if err != nil || token == "" {
+		return err
+	}
+
+	return h.sendTelemetry(token, pl.DevEUI, map[string]interface{}{
+		"status_battery": pl.Battery,
+		"status_margin":  pl.Margin,
+	})
+}
+
+// SendLocationNotification sends the device location as telemetry.
+func (h *Handler) SendLocationNotification(pl handler.LocationNotification) error {
+	token, err := h.getAccessToken(pl.DevEUI)
+	if err != nil || token == "" {
+		return err
+	}
+
+	return h.sendTelemetry(token, pl.DevEUI, map[string]interface{}{
+		"location_latitude":  pl.Location.Latitude,
+		"location_longitude": pl.Location.Longitude,
+		"location_altitude":  pl.Location.Altitude,
+	})
+}
+
+// SendJoinNotification is not implemented.
+func (h *Handler) SendJoinNotification(pl handler.JoinNotification) error {
+	return nil
+}
+
+// SendACKNotification is not implemented.
+func (h *Handler) SendACKNotification(pl handler.ACKNotification) error {
+	return nil
+}
+
+// SendErrorNotification is not implemented.
+func (h *Handler) SendErrorNotification(pl handler.ErrorNotification) error {
+	return nil
+}
+
+// SendAdminEvent is not implemented, as admin events are not related to
+// a ThingsBoard device.
+func (h *Handler) SendAdminEvent(pl handler.AdminEvent) error {
+	return nil
+}
+
+// getAccessToken returns the ThingsBoard access token of the given device.
+// When the device does not have an access token, a warning is logged and
+// an empty token is returned.
+func (h *Handler) getAccessToken(devEUI lorawan.EUI64) (string, error) {
+	vars, err := h.getVariables(devEUI)
+	if err != nil {
+		return "", errors.Wrap(err, "get device variables error")
+	}
+
+	token := vars[AccessTokenVariable]
+	if token == "" {
+		log.WithFields(log.Fields{
+			"dev_eui":  devEUI,
+			"variable": AccessTokenVariable,
+		}).Warning("handler/thingsboard: device does not have an access token variable")
+	}
+
+	return token, nil
+}
+
+func (h *Handler) sendTelemetry(token string, devEUI lorawan.EUI64, values map[string]interface{}) error {
+	return h.send(token, "telemetry", devEUI, struct {
+		TS     int64                  `json:"ts"`
+		Values map[string]interface{} `json:"values"`
+	}{
+		TS:     time.Now().UnixNano() / int64(time.Millisecond),
+		Values: values,
+	})
+}
+
+func (h *Handler) send(token, endpoint string, devEUI lorawan.EUI64, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	u := fmt.Sprintf("%s/api/v1/%s/%s", strings.TrimRight(h.config.Server, "/"), url.PathEscape(token), endpoint)
+	req, err := http.NewRequest("POST", u, bytes.NewReader(b))
+	if err != nil {
+		return errors.Wrap(err, "new request error")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client, err := proxy.GetHTTPClient(h.config.ProxyURL)
+	if err != nil {
+		return errors.Wrap(err, "get http client error")
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "http request error")
+	}
+	defer resp.Body.Close()
+
+	// check that response is in 200 range
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("expected 2xx response, got: %d (%s)", resp.StatusCode, string(b))
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":  devEUI,
+		"endpoint": endpoint,
+	}).Info("handler/thingsboard: event sent")
+
+	return nil
+}
+
+// flatten flattens the given (decoded JSON) value into the given values
+// map, as ThingsBoard telemetry does not support nested objects. The keys
+// of nested objects and the indices of arrays are joined using an
+// underscore, e.g. data_temperature or data_sensors_0_value.
+func flatten(prefix string, v interface{}, values map[string]interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, vv := range v {
+			flatten(prefix+"_"+k, vv, values)
+		}
+	case []interface{}:
+		for i, vv := range v {
+			flatten(prefix+"_"+strconv.Itoa(i), vv, values)
+		}
+	default:
+		values[prefix] = v
+	}
+}
@@ -0,0 +1,164 @@
+package thingsboardhandler
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lorawan"
+)
+
+type testHTTPHandler struct {
+	requests chan *http.Request
+}
+
+func (h *testHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b, _ := ioutil.ReadAll(r.Body)
+	r.Body = ioutil.NopCloser(bytes.NewReader(b))
+	h.requests <- r
+	w.WriteHeader(http.StatusOK)
+}
+
+func TestHandlerConfig(t *testing.T) {
+	Convey("Given a set of handler configurations", t, func() {
+		tests := []struct {
+			Name          string
+			Config        HandlerConfig
+			ExpectedError error
+		}{
+			{
+				Name:   "valid",
+				Config: HandlerConfig{Server: "https://thingsboard.example.com"},
+			},
+			{
+				Name:          "missing server",
+				ExpectedError: ErrInvalidServer,
+			},
+			{
+				Name:          "invalid scheme",
+				Config:        HandlerConfig{Server: "tcp://thingsboard.example.com"},
+				ExpectedError: ErrInvalidServer,
+			},
+			{
+				Name: "invalid event type",
+				Config: HandlerConfig{
+					Server: "https://thingsboard.example.com",
+					Events: []string{"foo"},
+				},
+				ExpectedError: handler.ErrInvalidEventType,
+			},
+		}
+
+		for _, test := range tests {
+			Convey("Testing: "+test.Name, func() {
+				So(test.Config.Validate(), ShouldEqual, test.ExpectedError)
+			})
+		}
+	})
+}
+
+func TestHandler(t *testing.T) {
+	Convey("Given a test HTTP server", t, func() {
+		httpHandler := testHTTPHandler{
+			requests: make(chan *http.Request, 100),
+		}
+		server := httptest.NewServer(&httpHandler)
+		defer server.Close()
+
+		devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		vars := map[lorawan.EUI64]map[string]string{
+			devEUI: {AccessTokenVariable: "secret-token"},
+		}
+
+		h, err := NewHandler(HandlerConfig{Server: server.URL + "/"}, func(devEUI lorawan.EUI64) (map[string]string, error) {
+			return vars[devEUI], nil
+		})
+		So(err, ShouldBeNil)
+
+		Convey("Then SendDataUp sends the attributes and telemetry", func() {
+			So(h.SendDataUp(handler.DataUpPayload{
+				ApplicationID:   1,
+				ApplicationName: "test-app",
+				DeviceName:      "test-device",
+				DevEUI:          devEUI,
+				FCnt:            10,
+				FPort:           20,
+				RXInfo: []handler.RXInfo{
+					{RSSI: -120, LoRaSNR: 1.5},
+					{RSSI: -80, LoRaSNR: -3},
+				},
+				Object: map[string]interface{}{
+					"temperature": 21.5,
+					"sensors": []interface{}{
+						map[string]interface{}{"value": 3},
+					},
+				},
+			}), ShouldBeNil)
+
+			req := <-httpHandler.requests
+			So(req.URL.Path, ShouldEqual, "/api/v1/secret-token/attributes")
+			So(req.Header.Get("Content-Type"), ShouldEqual, "application/json")
+
+			var attributes map[string]interface{}
+			So(json.NewDecoder(req.Body).Decode(&attributes), ShouldBeNil)
+			So(attributes, ShouldResemble, map[string]interface{}{
+				"application_id":   "1",
+				"application_name": "test-app",
+				"device_name":      "test-device",
+				"dev_eui":          "0102030405060708",
+			})
+
+			req = <-httpHandler.requests
+			So(req.URL.Path, ShouldEqual, "/api/v1/secret-token/telemetry")
+
+			var telemetry struct {
+				TS     int64                  `json:"ts"`
+				Values map[string]interface{} `json:"values"`
+			}
+			So(json.NewDecoder(req.Body).Decode(&telemetry), ShouldBeNil)
+			So(telemetry.TS, ShouldBeGreaterThan, 0)
+			So(telemetry.Values, ShouldResemble, map[string]interface{}{
+				"f_cnt":                float64(10),
+				"f_port":               float64(20),
+				"dr":                   float64(0),
+				"rssi":                 float64(-80),
+				"snr":                  1.5,
+				"data_temperature":     21.5,
+				"data_sensors_0_value": float64(3),
+			})
+		})
+
+		Convey("Then SendStatusNotification sends the status as telemetry", func() {
+			So(h.SendStatusNotification(handler.StatusNotification{
+				DevEUI:  devEUI,
+				Battery: 123,
+				Margin:  10,
+			}), ShouldBeNil)
+
+			req := <-httpHandler.requests
+			So(req.URL.Path, ShouldEqual, "/api/v1/secret-token/telemetry")
+
+			var telemetry struct {
+				Values map[string]interface{} `json:"values"`
+			}
+			So(json.NewDecoder(req.Body).Decode(&telemetry), ShouldBeNil)
+			So(telemetry.Values, ShouldResemble, map[string]interface{}{
+				"status_battery": float64(123),
+				"status_margin":  float64(10),
+			})
+		})
+
+		Convey("Then SendDataUp for a device without access token is a no-op", func() {
+			So(h.SendDataUp(handler.DataUpPayload{
+				DevEUI: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+			}), ShouldBeNil)
+			So(httpHandler.requests, ShouldHaveLength, 0)
+		})
+	})
+}
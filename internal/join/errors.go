@@ -4,5 +4,6 @@ import "github.com/pkg/errors"
 
 // Errors
 var (
-	ErrInvalidMIC = errors.New("invalid mic")
+	ErrInvalidMIC          = errors.New("invalid mic")
+	ErrDevNonceAlreadyUsed = errors.New("dev-nonce has already been used")
 )
@@ -5,8 +5,11 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/jointrace"
+	"github.com/brocaar/lora-app-server/internal/securityevent"
 	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lorawan"
 	"github.com/brocaar/lorawan/backend"
@@ -18,9 +21,12 @@ type context struct {
 	rejoinReqPayload backend.RejoinReqPayload
 	rejoinAnsPaylaod backend.RejoinAnsPayload
 	joinType         lorawan.JoinType
+	macVersion       string
+	optNeg           bool
 	phyPayload       lorawan.PHYPayload
 	application      storage.Application
 	deviceKeys       storage.DeviceKeys
+	joinAccept       *storage.JoinAccept
 	devNonce         lorawan.DevNonce
 	joinNonce        lorawan.JoinNonce
 	netID            lorawan.NetID
@@ -34,8 +40,11 @@ type context struct {
 
 var joinTasks = []func(*context) error{
 	setJoinContext,
+	setMACVersion,
 	getDeviceKeys,
+	getJoinAccept,
 	validateMIC,
+	validateDevNonce,
 	setJoinNonce,
 	setSessionKeys,
 	createJoinAnsPayload,
@@ -43,6 +52,8 @@ var joinTasks = []func(*context) error{
 
 var rejoinTasks = []func(*context) error{
 	setRejoinContext,
+	setMACVersion,
+	validateRejoinMACVersion,
 	getDeviceKeys,
 	setJoinNonce,
 	setSessionKeys,
@@ -56,10 +67,12 @@ func handleJoinRequest(pl backend.JoinReqPayload) (backend.JoinAnsPayload, error
 
 	for _, t := range joinTasks {
 		if err := t(&ctx); err != nil {
+			logJoinAttempt(&ctx, pl.DevEUI, err)
 			return ctx.joinAnsPayload, err
 		}
 	}
 
+	logJoinAttempt(&ctx, pl.DevEUI, nil)
 	return ctx.joinAnsPayload, nil
 }
 
@@ -70,13 +83,59 @@ func handleRejoinRequest(pl backend.RejoinReqPayload) (backend.RejoinAnsPayload,
 
 	for _, t := range rejoinTasks {
 		if err := t(&ctx); err != nil {
+			logJoinAttempt(&ctx, pl.DevEUI, err)
 			return ctx.rejoinAnsPaylaod, err
 		}
 	}
 
+	logJoinAttempt(&ctx, pl.DevEUI, nil)
 	return ctx.rejoinAnsPaylaod, nil
 }
 
+// logJoinAttempt adds the (re)join attempt to the join trace of the device.
+// The join type and dev-nonce are read from the PHYPayload, as the context
+// might not be (fully) set when the request failed.
+func logJoinAttempt(ctx *context, devEUI lorawan.EUI64, err error) {
+	a := jointrace.Attempt{
+		Result: jointrace.Accepted,
+	}
+
+	var devNonce int
+	switch v := ctx.phyPayload.MACPayload.(type) {
+	case *lorawan.JoinRequestPayload:
+		a.JoinType = lorawan.JoinRequestType.String()
+		devNonce = int(v.DevNonce)
+		a.DevNonce = &devNonce
+	case *lorawan.RejoinRequestType02Payload:
+		a.JoinType = v.RejoinType.String()
+		devNonce = int(v.RJCount0)
+		a.DevNonce = &devNonce
+	case *lorawan.RejoinRequestType1Payload:
+		a.JoinType = v.RejoinType.String()
+		devNonce = int(v.RJCount1)
+		a.DevNonce = &devNonce
+	}
+
+	if err != nil {
+		a.Error = err.Error()
+
+		switch errors.Cause(err) {
+		case storage.ErrDoesNotExist:
+			a.Result = jointrace.UnknownDevice
+		case ErrInvalidMIC:
+			a.Result = jointrace.InvalidMIC
+		case ErrDevNonceAlreadyUsed:
+			a.Result = jointrace.DevNonceReused
+		default:
+			a.Result = jointrace.JSError
+		}
+	}
+
+	if err := jointrace.Log(config.C.Redis.Pool, devEUI, a); err != nil {
+		log.WithError(err).WithField("dev_eui", devEUI).Error("log join attempt error")
+	}
+}
+
 // HandleJoinRequest handles a given join-request and returns a join-answer
 // payload.
 func HandleJoinRequest(pl backend.JoinReqPayload) backend.JoinAnsPayload {
@@ -97,6 +156,8 @@ func HandleJoinRequest(pl backend.JoinReqPayload) backend.JoinAnsPayload {
 			resCode = backend.UnknownDevEUI
 		case ErrInvalidMIC:
 			resCode = backend.MICFailed
+		case ErrDevNonceAlreadyUsed:
+			resCode = backend.JoinReqFailed
 		default:
 			resCode = backend.Other
 		}
@@ -151,6 +212,62 @@ func HandleRejoinRequest(pl backend.RejoinReqPayload) backend.RejoinAnsPayload {
 	return rjaPL
 }
 
+// HandleHomeNSRequest handles a given home-ns request and returns a
+// home-ns answer payload, containing the NetID of the home network-server.
+func HandleHomeNSRequest(pl backend.HomeNSReqPayload) backend.HomeNSAnsPayload {
+	basePayload := backend.BasePayload{
+		ProtocolVersion: backend.ProtocolVersion1_0,
+		SenderID:        pl.ReceiverID,
+		ReceiverID:      pl.SenderID,
+		TransactionID:   pl.TransactionID,
+		MessageType:     backend.HomeNSAns,
+	}
+
+	hnsPL, err := handleHomeNSRequest(pl)
+	if err != nil {
+		var resCode backend.ResultCode
+
+		switch errors.Cause(err) {
+		case storage.ErrDoesNotExist:
+			resCode = backend.UnknownDevEUI
+		default:
+			resCode = backend.Other
+		}
+
+		hnsPL = backend.HomeNSAnsPayload{
+			Result: backend.Result{
+				ResultCode:  resCode,
+				Description: err.Error(),
+			},
+		}
+	}
+
+	hnsPL.BasePayload = basePayload
+	return hnsPL
+}
+
+func handleHomeNSRequest(pl backend.HomeNSReqPayload) (backend.HomeNSAnsPayload, error) {
+	var ans backend.HomeNSAnsPayload
+
+	if _, err := storage.GetDevice(config.C.PostgreSQL.DB, pl.DevEUI, false, true); err != nil {
+		return ans, errors.Wrap(err, "get device error")
+	}
+
+	if config.C.JoinServer.HomeNetID == "" {
+		return ans, errors.New("home netid is not configured")
+	}
+
+	if err := ans.HNetID.UnmarshalText([]byte(config.C.JoinServer.HomeNetID)); err != nil {
+		return ans, errors.Wrap(err, "unmarshal home netid error")
+	}
+
+	ans.Result = backend.Result{
+		ResultCode: backend.Success,
+	}
+
+	return ans, nil
+}
+
 func setJoinContext(ctx *context) error {
 	if err := ctx.phyPayload.UnmarshalBinary(ctx.joinReqPayload.PHYPayload[:]); err != nil {
 		return errors.Wrap(err, "unmarshal phypayload error")
@@ -166,6 +283,8 @@ func setJoinContext(ctx *context) error {
 
 	ctx.devEUI = ctx.joinReqPayload.DevEUI
 	ctx.joinType = lorawan.JoinRequestType
+	ctx.macVersion = ctx.joinReqPayload.MACVersion
+	ctx.optNeg = ctx.joinReqPayload.DLSettings.OptNeg
 
 	switch v := ctx.phyPayload.MACPayload.(type) {
 	case *lorawan.JoinRequestPayload:
@@ -202,10 +321,31 @@ func setRejoinContext(ctx *context) error {
 	}
 
 	ctx.devEUI = ctx.rejoinReqPayload.DevEUI
+	ctx.macVersion = ctx.rejoinReqPayload.MACVersion
+	ctx.optNeg = ctx.rejoinReqPayload.DLSettings.OptNeg
 
 	return nil
 }
 
+// setMACVersion selects the LoRaWAN version based on the MAC version of the
+// device-profile (as forwarded by the network-server). When no MAC version
+// is given, the OptNeg flag of the request is used.
+func setMACVersion(ctx *context) error {
+	if ctx.macVersion != "" {
+		ctx.optNeg = storage.IsLoRaWAN11MACVersion(ctx.macVersion)
+	}
+	return nil
+}
+
+// validateRejoinMACVersion validates that the device implements LoRaWAN 1.1
+// as rejoin-requests are not supported by earlier versions.
+func validateRejoinMACVersion(ctx *context) error {
+	if !ctx.optNeg {
+		return errors.New("rejoin-request requires LoRaWAN 1.1")
+	}
+	return nil
+}
+
 func getDeviceKeys(ctx *context) error {
 	dk, err := storage.GetDeviceKeys(config.C.PostgreSQL.DB, ctx.devEUI)
 	if err != nil {
@@ -215,6 +355,18 @@ func getDeviceKeys(ctx *context) error {
 	return nil
 }
 
+// getJoinAccept gets the join-accept parameters configured on the
+// device-profile. When set, these override the parameters of the
+// join-request.
+func getJoinAccept(ctx *context) error {
+	ja, err := storage.GetDeviceProfileJoinAcceptForDevEUI(config.C.PostgreSQL.DB, ctx.devEUI)
+	if err != nil {
+		return errors.Wrap(err, "get device-profile join-accept error")
+	}
+	ctx.joinAccept = ja
+	return nil
+}
+
 func validateMIC(ctx *context) error {
 	ok, err := ctx.phyPayload.ValidateUplinkJoinMIC(ctx.deviceKeys.NwkKey)
 	if err != nil {
@@ -226,6 +378,25 @@ func validateMIC(ctx *context) error {
 	return nil
 }
 
+func validateDevNonce(ctx *context) error {
+	err := storage.CreateDeviceDevNonce(config.C.PostgreSQL.DB, &storage.DeviceDevNonce{
+		DevEUI:   ctx.devEUI,
+		DevNonce: int(ctx.devNonce),
+	})
+	if err != nil {
+		if err == storage.ErrAlreadyExists {
+			securityevent.Log(storage.SecurityEvent{
+				Type:        securityevent.JoinReplay,
+				DevEUI:      &ctx.devEUI,
+				Description: fmt.Sprintf("join-request with already used dev-nonce %d", ctx.devNonce),
+			})
+			return ErrDevNonceAlreadyUsed
+		}
+		return errors.Wrap(err, "create device dev-nonce error")
+	}
+	return nil
+}
+
 func setJoinNonce(ctx *context) error {
 	ctx.deviceKeys.JoinNonce++
 	if ctx.deviceKeys.JoinNonce > (1<<24)-1 {
@@ -243,29 +414,29 @@ func setJoinNonce(ctx *context) error {
 func setSessionKeys(ctx *context) error {
 	var err error
 
-	ctx.fNwkSIntKey, err = getFNwkSIntKey(ctx.joinReqPayload.DLSettings.OptNeg, ctx.deviceKeys.NwkKey, ctx.netID, ctx.joinEUI, ctx.joinNonce, ctx.devNonce)
+	ctx.fNwkSIntKey, err = getFNwkSIntKey(ctx.optNeg, ctx.deviceKeys.NwkKey, ctx.netID, ctx.joinEUI, ctx.joinNonce, ctx.devNonce)
 	if err != nil {
 		return errors.Wrap(err, "get FNwkSIntKey error")
 	}
 
-	if ctx.joinReqPayload.DLSettings.OptNeg {
-		ctx.appSKey, err = getAppSKey(ctx.joinReqPayload.DLSettings.OptNeg, ctx.deviceKeys.AppKey, ctx.netID, ctx.joinEUI, ctx.joinNonce, ctx.devNonce)
+	if ctx.optNeg {
+		ctx.appSKey, err = getAppSKey(ctx.optNeg, ctx.deviceKeys.AppKey, ctx.netID, ctx.joinEUI, ctx.joinNonce, ctx.devNonce)
 		if err != nil {
 			return errors.Wrap(err, "get AppSKey error")
 		}
 	} else {
-		ctx.appSKey, err = getAppSKey(ctx.joinReqPayload.DLSettings.OptNeg, ctx.deviceKeys.NwkKey, ctx.netID, ctx.joinEUI, ctx.joinNonce, ctx.devNonce)
+		ctx.appSKey, err = getAppSKey(ctx.optNeg, ctx.deviceKeys.NwkKey, ctx.netID, ctx.joinEUI, ctx.joinNonce, ctx.devNonce)
 		if err != nil {
 			return errors.Wrap(err, "get AppSKey error")
 		}
 	}
 
-	ctx.sNwkSIntKey, err = getSNwkSIntKey(ctx.joinReqPayload.DLSettings.OptNeg, ctx.deviceKeys.NwkKey, ctx.netID, ctx.joinEUI, ctx.joinNonce, ctx.devNonce)
+	ctx.sNwkSIntKey, err = getSNwkSIntKey(ctx.optNeg, ctx.deviceKeys.NwkKey, ctx.netID, ctx.joinEUI, ctx.joinNonce, ctx.devNonce)
 	if err != nil {
 		return errors.Wrap(err, "get SNwkSIntKey error")
 	}
 
-	ctx.nwkSEncKey, err = getNwkSEncKey(ctx.joinReqPayload.DLSettings.OptNeg, ctx.deviceKeys.NwkKey, ctx.netID, ctx.joinEUI, ctx.joinNonce, ctx.devNonce)
+	ctx.nwkSEncKey, err = getNwkSEncKey(ctx.optNeg, ctx.deviceKeys.NwkKey, ctx.netID, ctx.joinEUI, ctx.joinNonce, ctx.devNonce)
 	if err != nil {
 		return errors.Wrap(err, "get NwkSEncKey error")
 	}
@@ -274,10 +445,24 @@ func setSessionKeys(ctx *context) error {
 }
 
 func createJoinAnsPayload(ctx *context) error {
+	cFListB := ctx.joinReqPayload.CFList[:]
+	dlSettings := ctx.joinReqPayload.DLSettings
+	dlSettings.OptNeg = ctx.optNeg
+	rxDelay := uint8(ctx.joinReqPayload.RxDelay)
+
+	if ja := ctx.joinAccept; ja != nil {
+		dlSettings.RX1DROffset = uint8(ja.RX1DROffset)
+		dlSettings.RX2DataRate = uint8(ja.RX2DR)
+		rxDelay = uint8(ja.RXDelay)
+		if len(ja.CFList) != 0 {
+			cFListB = ja.CFList
+		}
+	}
+
 	var cFList *lorawan.CFList
-	if len(ctx.joinReqPayload.CFList[:]) != 0 {
+	if len(cFListB) != 0 {
 		cFList = new(lorawan.CFList)
-		if err := cFList.UnmarshalBinary(ctx.joinReqPayload.CFList[:]); err != nil {
+		if err := cFList.UnmarshalBinary(cFListB); err != nil {
 			return errors.Wrap(err, "unmarshal cflist error")
 		}
 	}
@@ -291,13 +476,13 @@ func createJoinAnsPayload(ctx *context) error {
 			JoinNonce:  ctx.joinNonce,
 			HomeNetID:  ctx.netID,
 			DevAddr:    ctx.joinReqPayload.DevAddr,
-			DLSettings: ctx.joinReqPayload.DLSettings,
-			RXDelay:    uint8(ctx.joinReqPayload.RxDelay),
+			DLSettings: dlSettings,
+			RXDelay:    rxDelay,
 			CFList:     cFList,
 		},
 	}
 
-	if ctx.joinReqPayload.DLSettings.OptNeg {
+	if ctx.optNeg {
 		jsIntKey, err := getJSIntKey(ctx.deviceKeys.NwkKey, ctx.devEUI)
 		if err != nil {
 			return err
@@ -333,7 +518,7 @@ func createJoinAnsPayload(ctx *context) error {
 		return err
 	}
 
-	if ctx.joinReqPayload.DLSettings.OptNeg {
+	if ctx.optNeg {
 		// LoRaWAN 1.1+
 		ctx.joinAnsPayload.FNwkSIntKey, err = getNSKeyEnvelope(ctx.netID, ctx.fNwkSIntKey)
 		if err != nil {
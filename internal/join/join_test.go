@@ -190,6 +190,36 @@ func TestJoin(t *testing.T) {
 					},
 				},
 			}
+			validJAPHYJoinAccept := lorawan.PHYPayload{
+				MHDR: lorawan.MHDR{
+					MType: lorawan.JoinAccept,
+					Major: lorawan.LoRaWANR1,
+				},
+				MACPayload: &lorawan.JoinAcceptPayload{
+					JoinNonce: 65536,
+					HomeNetID: lorawan.NetID{1, 2, 3},
+					DevAddr:   lorawan.DevAddr{1, 2, 3, 4},
+					DLSettings: lorawan.DLSettings{
+						RX2DataRate: 3,
+						RX1DROffset: 2,
+					},
+					RXDelay: 5,
+					CFList: &lorawan.CFList{
+						CFListType: lorawan.CFListChannel,
+						Payload: &lorawan.CFListChannelPayload{
+							Channels: [5]uint32{
+								868700000,
+								868900000,
+							},
+						},
+					},
+				},
+			}
+			So(validJAPHYJoinAccept.SetDownlinkJoinMIC(lorawan.JoinRequestType, lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, 258, dk.NwkKey), ShouldBeNil)
+			So(validJAPHYJoinAccept.EncryptJoinAcceptPayload(dk.NwkKey), ShouldBeNil)
+			validJAPHYJoinAcceptBytes, err := validJAPHYJoinAccept.MarshalBinary()
+			So(err, ShouldBeNil)
+
 			jsIntKey, err := getJSIntKey(dk.NwkKey, d.DevEUI)
 			So(err, ShouldBeNil)
 			So(validJAPHYLW11.SetDownlinkJoinMIC(lorawan.JoinRequestType, lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, 258, jsIntKey), ShouldBeNil)
@@ -244,6 +274,55 @@ func TestJoin(t *testing.T) {
 						},
 					},
 				},
+				{
+					Name: "valid join-request with device-profile join-accept parameters",
+					PreRun: func() error {
+						dp.JoinAccept = &storage.JoinAccept{
+							RXDelay:     5,
+							RX1DROffset: 2,
+							RX2DR:       3,
+						}
+						return storage.UpdateDeviceProfile(config.C.PostgreSQL.DB, &dp)
+					},
+					RequestPayload: backend.JoinReqPayload{
+						BasePayload: backend.BasePayload{
+							ProtocolVersion: backend.ProtocolVersion1_0,
+							SenderID:        "010203",
+							ReceiverID:      "0807060504030201",
+							TransactionID:   1234,
+							MessageType:     backend.JoinReq,
+						},
+						MACVersion: "1.0.2",
+						PHYPayload: backend.HEXBytes(validJRPHYBytes),
+						DevEUI:     d.DevEUI,
+						DevAddr:    lorawan.DevAddr{1, 2, 3, 4},
+						DLSettings: lorawan.DLSettings{
+							RX2DataRate: 5,
+							RX1DROffset: 1,
+						},
+						RxDelay: 1,
+						CFList:  backend.HEXBytes(cFListB),
+					},
+					ExpectedPayload: backend.JoinAnsPayload{
+						BasePayload: backend.BasePayload{
+							ProtocolVersion: backend.ProtocolVersion1_0,
+							SenderID:        "0807060504030201",
+							ReceiverID:      "010203",
+							TransactionID:   1234,
+							MessageType:     backend.JoinAns,
+						},
+						Result: backend.Result{
+							ResultCode: backend.Success,
+						},
+						PHYPayload: backend.HEXBytes(validJAPHYJoinAcceptBytes),
+						NwkSKey: &backend.KeyEnvelope{
+							AESKey: []byte{223, 83, 195, 95, 48, 52, 204, 206, 208, 255, 53, 76, 112, 222, 4, 223},
+						},
+						AppSKey: &backend.KeyEnvelope{
+							AESKey: []byte{146, 123, 156, 145, 17, 131, 207, 254, 76, 178, 255, 75, 117, 84, 95, 109},
+						},
+					},
+				},
 				{
 					Name: "valid join-request (LoRaWAN 1.1)",
 					RequestPayload: backend.JoinReqPayload{
@@ -470,6 +549,47 @@ func TestJoin(t *testing.T) {
 						},
 					},
 				},
+				{
+					Name: "join-request with already used dev-nonce",
+					PreRun: func() error {
+						return storage.CreateDeviceDevNonce(config.C.PostgreSQL.DB, &storage.DeviceDevNonce{
+							DevEUI:   d.DevEUI,
+							DevNonce: 258,
+						})
+					},
+					RequestPayload: backend.JoinReqPayload{
+						BasePayload: backend.BasePayload{
+							ProtocolVersion: backend.ProtocolVersion1_0,
+							SenderID:        "010203",
+							ReceiverID:      "0807060504030201",
+							TransactionID:   1234,
+							MessageType:     backend.JoinReq,
+						},
+						MACVersion: "1.0.2",
+						PHYPayload: backend.HEXBytes(validJRPHYBytes),
+						DevEUI:     d.DevEUI,
+						DevAddr:    lorawan.DevAddr{1, 2, 3, 4},
+						DLSettings: lorawan.DLSettings{
+							RX2DataRate: 5,
+							RX1DROffset: 1,
+						},
+						RxDelay: 1,
+						CFList:  backend.HEXBytes(cFListB),
+					},
+					ExpectedPayload: backend.JoinAnsPayload{
+						BasePayload: backend.BasePayload{
+							ProtocolVersion: backend.ProtocolVersion1_0,
+							SenderID:        "0807060504030201",
+							ReceiverID:      "010203",
+							TransactionID:   1234,
+							MessageType:     backend.JoinAns,
+						},
+						Result: backend.Result{
+							ResultCode:  backend.JoinReqFailed,
+							Description: "dev-nonce has already been used",
+						},
+					},
+				},
 			}
 
 			for i, test := range tests {
@@ -663,16 +783,16 @@ func TestJoin(t *testing.T) {
 						},
 						PHYPayload: backend.HEXBytes(ja0PHYBytes),
 						SNwkSIntKey: &backend.KeyEnvelope{
-							AESKey: []byte{84, 115, 118, 176, 7, 14, 169, 150, 78, 61, 226, 98, 252, 231, 85, 145},
+							AESKey: []byte{196, 241, 177, 106, 4, 104, 232, 32, 112, 182, 173, 125, 238, 108, 162, 61},
 						},
 						FNwkSIntKey: &backend.KeyEnvelope{
-							AESKey: []byte{15, 235, 84, 189, 47, 133, 75, 254, 195, 103, 254, 91, 27, 132, 16, 55},
+							AESKey: []byte{122, 174, 145, 180, 33, 205, 39, 58, 21, 115, 209, 237, 245, 178, 62, 117},
 						},
 						NwkSEncKey: &backend.KeyEnvelope{
-							AESKey: []byte{212, 9, 208, 87, 17, 14, 159, 221, 5, 199, 126, 12, 85, 63, 119, 244},
+							AESKey: []byte{178, 182, 238, 54, 180, 166, 131, 251, 103, 226, 231, 209, 27, 255, 202, 148},
 						},
 						AppSKey: &backend.KeyEnvelope{
-							AESKey: []byte{11, 25, 22, 151, 83, 252, 60, 31, 222, 161, 118, 106, 12, 34, 117, 225},
+							AESKey: []byte{254, 53, 126, 206, 230, 223, 123, 124, 26, 23, 131, 36, 219, 29, 26, 122},
 						},
 					},
 				},
@@ -711,16 +831,16 @@ func TestJoin(t *testing.T) {
 						},
 						PHYPayload: backend.HEXBytes(ja1PHYBytes),
 						SNwkSIntKey: &backend.KeyEnvelope{
-							AESKey: []byte{84, 115, 118, 176, 7, 14, 169, 150, 78, 61, 226, 98, 252, 231, 85, 145},
+							AESKey: []byte{196, 241, 177, 106, 4, 104, 232, 32, 112, 182, 173, 125, 238, 108, 162, 61},
 						},
 						FNwkSIntKey: &backend.KeyEnvelope{
-							AESKey: []byte{15, 235, 84, 189, 47, 133, 75, 254, 195, 103, 254, 91, 27, 132, 16, 55},
+							AESKey: []byte{122, 174, 145, 180, 33, 205, 39, 58, 21, 115, 209, 237, 245, 178, 62, 117},
 						},
 						NwkSEncKey: &backend.KeyEnvelope{
-							AESKey: []byte{212, 9, 208, 87, 17, 14, 159, 221, 5, 199, 126, 12, 85, 63, 119, 244},
+							AESKey: []byte{178, 182, 238, 54, 180, 166, 131, 251, 103, 226, 231, 209, 27, 255, 202, 148},
 						},
 						AppSKey: &backend.KeyEnvelope{
-							AESKey: []byte{11, 25, 22, 151, 83, 252, 60, 31, 222, 161, 118, 106, 12, 34, 117, 225},
+							AESKey: []byte{254, 53, 126, 206, 230, 223, 123, 124, 26, 23, 131, 36, 219, 29, 26, 122},
 						},
 					},
 				},
@@ -758,16 +878,50 @@ func TestJoin(t *testing.T) {
 						},
 						PHYPayload: backend.HEXBytes(ja2PHYBytes),
 						SNwkSIntKey: &backend.KeyEnvelope{
-							AESKey: []byte{84, 115, 118, 176, 7, 14, 169, 150, 78, 61, 226, 98, 252, 231, 85, 145},
+							AESKey: []byte{196, 241, 177, 106, 4, 104, 232, 32, 112, 182, 173, 125, 238, 108, 162, 61},
 						},
 						FNwkSIntKey: &backend.KeyEnvelope{
-							AESKey: []byte{15, 235, 84, 189, 47, 133, 75, 254, 195, 103, 254, 91, 27, 132, 16, 55},
+							AESKey: []byte{122, 174, 145, 180, 33, 205, 39, 58, 21, 115, 209, 237, 245, 178, 62, 117},
 						},
 						NwkSEncKey: &backend.KeyEnvelope{
-							AESKey: []byte{212, 9, 208, 87, 17, 14, 159, 221, 5, 199, 126, 12, 85, 63, 119, 244},
+							AESKey: []byte{178, 182, 238, 54, 180, 166, 131, 251, 103, 226, 231, 209, 27, 255, 202, 148},
 						},
 						AppSKey: &backend.KeyEnvelope{
-							AESKey: []byte{11, 25, 22, 151, 83, 252, 60, 31, 222, 161, 118, 106, 12, 34, 117, 225},
+							AESKey: []byte{254, 53, 126, 206, 230, 223, 123, 124, 26, 23, 131, 36, 219, 29, 26, 122},
+						},
+					},
+				},
+				{
+					Name: "rejoin-request for LoRaWAN 1.0 device",
+					RequestPayload: backend.RejoinReqPayload{
+						BasePayload: backend.BasePayload{
+							ProtocolVersion: backend.ProtocolVersion1_0,
+							SenderID:        "010203",
+							ReceiverID:      "0807060504030201",
+							TransactionID:   1234,
+							MessageType:     backend.RejoinReq,
+						},
+						MACVersion: "1.0.2",
+						PHYPayload: backend.HEXBytes(rj2PHYBytes),
+						DevEUI:     d.DevEUI,
+						DevAddr:    lorawan.DevAddr{1, 2, 3, 4},
+						DLSettings: lorawan.DLSettings{
+							RX2DataRate: 5,
+							RX1DROffset: 1,
+						},
+						RxDelay: 1,
+					},
+					ExpectedPayload: backend.RejoinAnsPayload{
+						BasePayload: backend.BasePayload{
+							ProtocolVersion: backend.ProtocolVersion1_0,
+							SenderID:        "0807060504030201",
+							ReceiverID:      "010203",
+							TransactionID:   1234,
+							MessageType:     backend.RejoinAns,
+						},
+						Result: backend.Result{
+							ResultCode:  backend.Other,
+							Description: "rejoin-request requires LoRaWAN 1.1",
 						},
 					},
 				},
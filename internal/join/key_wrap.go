@@ -9,15 +9,25 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
 	"github.com/brocaar/lorawan"
 	"github.com/brocaar/lorawan/backend"
 )
 
 // getNSKeyEnvelope returns the KeyEnvelope for the given NS related key.
-// When the join-server configuration has a KEK configured for the given
-// NetID, it will wrap the key using this KEK.
+// When a network-server with the given NetID and a KEK is stored in the
+// database, it will wrap the key using this KEK. Else, when the join-server
+// configuration has a KEK configured for the given NetID, it will wrap the
+// key using this KEK.
 func getNSKeyEnvelope(netID lorawan.NetID, key lorawan.AES128Key) (*backend.KeyEnvelope, error) {
-	var err error
+	n, err := storage.GetNetworkServerForNetID(config.C.PostgreSQL.DB, netID)
+	if err != nil && err != storage.ErrDoesNotExist {
+		return nil, errors.Wrap(err, "get network-server error")
+	}
+	if err == nil && n.KEKLabel != "" {
+		return wrapKey(n.KEKLabel, n.KEK, key)
+	}
+
 	var kek []byte
 
 	for i := range config.C.JoinServer.KEK.Set {
@@ -35,6 +45,11 @@ func getNSKeyEnvelope(netID lorawan.NetID, key lorawan.AES128Key) (*backend.KeyE
 		}, nil
 	}
 
+	return wrapKey(netID.String(), kek, key)
+}
+
+// wrapKey wraps the given key using the given KEK.
+func wrapKey(label string, kek []byte, key lorawan.AES128Key) (*backend.KeyEnvelope, error) {
 	block, err := aes.NewCipher(kek)
 	if err != nil {
 		return nil, errors.Wrap(err, "new cipher error")
@@ -46,7 +61,7 @@ func getNSKeyEnvelope(netID lorawan.NetID, key lorawan.AES128Key) (*backend.KeyE
 	}
 
 	return &backend.KeyEnvelope{
-		KEKLabel: netID.String(),
+		KEKLabel: label,
 		AESKey:   backend.HEXBytes(b),
 	}, nil
 }
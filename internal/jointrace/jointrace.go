@@ -0,0 +1,190 @@
+// Package jointrace implements a rolling trace of the join attempts per
+// device (handled by the join-server or rejected by the network-server).
+// Combined with the device state, this trace is used to diagnose the most
+// common causes of devices not being able to join.
+package jointrace
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+)
+
+// traceKeyTempl defines the key template of the join trace of a device.
+const traceKeyTempl = "lora:as:device:%s:join_trace"
+
+// maxAttempts defines the max. number of attempts kept per device.
+const maxAttempts = 50
+
+// traceTTL defines the expiration of the trace (counted from the last
+// attempt).
+const traceTTL = 7 * 24 * time.Hour
+
+// Result defines the result of a join attempt.
+type Result string
+
+// Join attempt results.
+const (
+	Accepted       Result = "ACCEPTED"
+	InvalidMIC     Result = "INVALID_MIC"
+	DevNonceReused Result = "DEV_NONCE_REUSED"
+	UnknownDevice  Result = "UNKNOWN_DEVICE"
+	JSError        Result = "JS_ERROR"
+	NSError        Result = "NS_ERROR"
+)
+
+// Cause defines a (probable) cause of a failing join.
+type Cause string
+
+// Join failure causes.
+const (
+	CauseNoJoinRequests        Cause = "NO_JOIN_REQUESTS"
+	CauseMissingKeys           Cause = "MISSING_KEYS"
+	CauseWrongKey              Cause = "WRONG_KEY"
+	CauseDevNonceReused        Cause = "DEV_NONCE_REUSED"
+	CauseJoinAcceptNotReceived Cause = "JOIN_ACCEPT_NOT_RECEIVED"
+	CauseJSError               Cause = "JS_ERROR"
+	CauseNSError               Cause = "NS_ERROR"
+)
+
+// Attempt defines a join attempt.
+type Attempt struct {
+	Time     time.Time `json:"time"`
+	JoinType string    `json:"joinType"`
+	DevNonce *int      `json:"devNonce"` // not set for network-server errors
+	Result   Result    `json:"result"`
+	Error    string    `json:"error"`
+}
+
+// Diagnosis contains a (probable) cause of a failing join.
+type Diagnosis struct {
+	Cause       Cause
+	Count       int // number of attempts matching the cause
+	Description string
+}
+
+// DeviceState contains the state of the device, used to diagnose the join
+// attempts.
+type DeviceState struct {
+	// Activated is set when the device has an activation.
+	Activated bool
+
+	// LastSeenAt contains the last time an uplink was received from the
+	// device.
+	LastSeenAt *time.Time
+}
+
+// causes defines the order in which the causes are reported and maps the
+// join attempt results to these causes.
+var causes = []struct {
+	result      Result
+	cause       Cause
+	description string
+}{
+	{UnknownDevice, CauseMissingKeys, "the join-server does not have the (OTAA) keys of the device, make sure the device is provisioned with keys"},
+	{InvalidMIC, CauseWrongKey, "the MIC of the join-request is invalid, the AppKey (LoRaWAN 1.0) or NwkKey (LoRaWAN 1.1) of the device does not match the configured key (or the LoRaWAN MAC version of the device-profile is wrong)"},
+	{DevNonceReused, CauseDevNonceReused, "the device re-used a DevNonce (e.g. after a factory-reset), delete the used DevNonces of the device when this is expected"},
+	{JSError, CauseJSError, "the join-server failed to handle the join-request"},
+	{NSError, CauseNSError, "the network-server reported an error for the join-request"},
+}
+
+// Log adds the given attempt to the join trace of the given device.
+func Log(p *redis.Pool, devEUI lorawan.EUI64, a Attempt) error {
+	if a.Time.IsZero() {
+		a.Time = time.Now()
+	}
+
+	b, err := json.Marshal(a)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	c := p.Get()
+	defer c.Close()
+
+	key := fmt.Sprintf(traceKeyTempl, devEUI)
+
+	c.Send("MULTI")
+	c.Send("LPUSH", key, b)
+	c.Send("LTRIM", key, 0, maxAttempts-1)
+	c.Send("PEXPIRE", key, int64(traceTTL/time.Millisecond))
+	if _, err := c.Do("EXEC"); err != nil {
+		return errors.Wrap(err, "log join attempt error")
+	}
+
+	return nil
+}
+
+// Get returns the join trace of the given device, newest first.
+func Get(p *redis.Pool, devEUI lorawan.EUI64) ([]Attempt, error) {
+	c := p.Get()
+	defer c.Close()
+
+	values, err := redis.ByteSlices(c.Do("LRANGE", fmt.Sprintf(traceKeyTempl, devEUI), 0, -1))
+	if err != nil {
+		return nil, errors.Wrap(err, "get join trace error")
+	}
+
+	out := make([]Attempt, 0, len(values))
+	for _, b := range values {
+		var a Attempt
+		if err := json.Unmarshal(b, &a); err != nil {
+			return nil, errors.Wrap(err, "unmarshal json error")
+		}
+		out = append(out, a)
+	}
+
+	return out, nil
+}
+
+// Diagnose returns the (probable) causes of a failing join, given the trace
+// (newest first) and the device state. Only the attempts after the last
+// successful join (an accepted join-request followed by an uplink) are
+// taken into account. Nil is returned when the device has joined
+// successfully.
+func Diagnose(attempts []Attempt, state DeviceState) []Diagnosis {
+	if len(attempts) == 0 {
+		if state.Activated {
+			return nil
+		}
+		return []Diagnosis{{
+			Cause:       CauseNoJoinRequests,
+			Description: "no join-request has been received, make sure the device is in range of a gateway, uses the same frequency band (regional parameters) as the network-server and that the DevEUI and JoinEUI (AppEUI) are correct",
+		}}
+	}
+
+	counts := make(map[Result]int)
+	for _, a := range attempts {
+		if a.Result == Accepted && state.LastSeenAt != nil && !state.LastSeenAt.Before(a.Time) {
+			break
+		}
+		counts[a.Result]++
+	}
+
+	var out []Diagnosis
+	for _, c := range causes {
+		if n := counts[c.result]; n != 0 {
+			out = append(out, Diagnosis{
+				Cause:       c.cause,
+				Count:       n,
+				Description: c.description,
+			})
+		}
+	}
+
+	// a single accepted join-request might still be followed by an uplink
+	if n := counts[Accepted]; n > 1 {
+		out = append(out, Diagnosis{
+			Cause:       CauseJoinAcceptNotReceived,
+			Count:       n,
+			Description: "the join-requests have been accepted, but the device keeps re-joining without sending an uplink, the join-accept is probably not received by the device (e.g. because of a frequency band or RX parameters mismatch, or a gateway which is unable to transmit)",
+		})
+	}
+
+	return out
+}
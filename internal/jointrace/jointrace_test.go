@@ -0,0 +1,117 @@
+package jointrace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestJoinTrace(t *testing.T) {
+	assert := require.New(t)
+
+	conf := test.GetConfig()
+	p := storage.NewRedisPool(conf.RedisURL, 10, 0)
+	test.MustFlushRedis(p)
+
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	devNonce := 123
+
+	attempts := []Attempt{
+		{Time: time.Now().UTC().Truncate(time.Millisecond), JoinType: "JoinRequest", DevNonce: &devNonce, Result: InvalidMIC, Error: "invalid mic"},
+		{Time: time.Now().UTC().Truncate(time.Millisecond), Result: NSError, Error: "join error"},
+	}
+	for _, a := range attempts {
+		assert.NoError(Log(p, devEUI, a))
+	}
+
+	out, err := Get(p, devEUI)
+	assert.NoError(err)
+	assert.Equal([]Attempt{attempts[1], attempts[0]}, out)
+
+	out, err = Get(p, lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1})
+	assert.NoError(err)
+	assert.Len(out, 0)
+}
+
+func TestDiagnose(t *testing.T) {
+	now := time.Now()
+	before := now.Add(-time.Hour)
+	after := now.Add(time.Minute)
+
+	tests := []struct {
+		Name     string
+		Attempts []Attempt // newest first
+		State    DeviceState
+		Causes   []Cause
+	}{
+		{
+			Name:   "no join-requests",
+			Causes: []Cause{CauseNoJoinRequests},
+		},
+		{
+			Name:  "no join-requests, activated",
+			State: DeviceState{Activated: true},
+		},
+		{
+			Name: "joined",
+			Attempts: []Attempt{
+				{Time: now, Result: Accepted},
+				{Time: before, Result: InvalidMIC},
+			},
+			State: DeviceState{Activated: true, LastSeenAt: &after},
+		},
+		{
+			Name: "wrong key and missing keys",
+			Attempts: []Attempt{
+				{Time: now, Result: InvalidMIC},
+				{Time: now, Result: InvalidMIC},
+				{Time: before, Result: UnknownDevice},
+			},
+			Causes: []Cause{CauseMissingKeys, CauseWrongKey},
+		},
+		{
+			Name: "errors before last successful join are ignored",
+			Attempts: []Attempt{
+				{Time: after, Result: DevNonceReused},
+				{Time: before, Result: Accepted},
+				{Time: before, Result: InvalidMIC},
+			},
+			State:  DeviceState{Activated: true, LastSeenAt: &now},
+			Causes: []Cause{CauseDevNonceReused},
+		},
+		{
+			Name: "single accept, waiting for uplink",
+			Attempts: []Attempt{
+				{Time: now, Result: Accepted},
+			},
+			State: DeviceState{Activated: true, LastSeenAt: &before},
+		},
+		{
+			Name: "join-accept not received",
+			Attempts: []Attempt{
+				{Time: after, Result: Accepted},
+				{Time: now, Result: Accepted},
+				{Time: before, Result: NSError},
+			},
+			State:  DeviceState{Activated: true},
+			Causes: []Cause{CauseNSError, CauseJoinAcceptNotReceived},
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			var causes []Cause
+			for _, d := range Diagnose(tst.Attempts, tst.State) {
+				causes = append(causes, d.Cause)
+			}
+			assert.Equal(tst.Causes, causes)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// Package keymismatch implements the heuristics used to detect a possible
+// AppSKey mismatch between a device and LoRa App Server. When the keys do
+// not match, the decrypted payloads look random and fail to decode by the
+// payload codec of the application.
+package keymismatch
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+)
+
+// failureCountKeyTempl defines the key template of the counter of
+// consecutive suspicious uplinks of a device.
+const failureCountKeyTempl = "lora:as:device:%s:key_mismatch_count"
+
+// failureCountTTL defines the expiration of the counter, after which the
+// series of suspicious uplinks is considered broken.
+const failureCountTTL = 24 * time.Hour
+
+// minPayloadSize defines the min. payload size for which the entropy is
+// evaluated. Smaller payloads do not contain enough bytes to tell random
+// data apart.
+const minPayloadSize = 4
+
+// entropyThreshold defines the normalized entropy at or above which a
+// payload is considered random.
+const entropyThreshold = 0.9
+
+// Entropy returns the Shannon entropy of the given bytes, normalized to
+// 0 - 1 using the max. entropy possible for the number of bytes.
+func Entropy(b []byte) float64 {
+	if len(b) < 2 {
+		return 0
+	}
+
+	var counts [256]int
+	for _, v := range b {
+		counts[v]++
+	}
+
+	var h float64
+	for _, c := range counts {
+		if c == 0 {
+			continue
+		}
+		p := float64(c) / float64(len(b))
+		h -= p * math.Log2(p)
+	}
+
+	n := len(b)
+	if n > 256 {
+		n = 256
+	}
+
+	return h / math.Log2(float64(n))
+}
+
+// IsRandom returns true when the given (decrypted) payload looks like
+// random data.
+func IsRandom(b []byte) bool {
+	return len(b) >= minPayloadSize && Entropy(b) >= entropyThreshold
+}
+
+// IncrFailureCount increments the counter of consecutive suspicious
+// uplinks of the given device and returns the updated count.
+func IncrFailureCount(p *redis.Pool, devEUI lorawan.EUI64) (int, error) {
+	c := p.Get()
+	defer c.Close()
+
+	key := fmt.Sprintf(failureCountKeyTempl, devEUI)
+
+	c.Send("MULTI")
+	c.Send("INCR", key)
+	c.Send("PEXPIRE", key, int64(failureCountTTL/time.Millisecond))
+	values, err := redis.Values(c.Do("EXEC"))
+	if err != nil {
+		return 0, errors.Wrap(err, "increment failure count error")
+	}
+
+	count, err := redis.Int(values[0], nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "read failure count error")
+	}
+
+	return count, nil
+}
+
+// ResetFailureCount resets the counter of consecutive suspicious uplinks
+// of the given device.
+func ResetFailureCount(p *redis.Pool, devEUI lorawan.EUI64) error {
+	c := p.Get()
+	defer c.Close()
+
+	if _, err := c.Do("DEL", fmt.Sprintf(failureCountKeyTempl, devEUI)); err != nil {
+		return errors.Wrap(err, "delete failure count error")
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package keymismatch
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestIsRandom(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Name     string
+			Payload  []byte
+			Expected bool
+		}{
+			{"empty payload", nil, false},
+			{"payload below the min. size", []byte{0x8f, 0x12, 0xa7}, false},
+			{"sensor payload", []byte{0x00, 0x01, 0x00, 0x1a, 0x00, 0x00, 0x03, 0xe8}, false},
+			{"repeated bytes", []byte{0x01, 0x01, 0x01, 0x01, 0x01, 0x01}, false},
+			{"random payload", []byte{0x8f, 0x12, 0xa7, 0x3c, 0xd1, 0x5e, 0x90, 0x47}, true},
+		}
+
+		for _, tst := range tests {
+			Convey("Then the "+tst.Name+" returns the expected result", func() {
+				So(IsRandom(tst.Payload), ShouldEqual, tst.Expected)
+			})
+		}
+	})
+}
+
+func TestEntropy(t *testing.T) {
+	Convey("Then the entropy is normalized", t, func() {
+		So(Entropy([]byte{1, 1, 1, 1}), ShouldEqual, 0)
+		So(Entropy([]byte{1, 2, 3, 4}), ShouldEqual, 1)
+		So(Entropy([]byte{1, 1, 2, 2}), ShouldEqual, 0.5)
+	})
+}
+
+func TestFailureCount(t *testing.T) {
+	conf := test.GetConfig()
+	p := storage.NewRedisPool(conf.RedisURL, 10, 0)
+
+	Convey("Given a clean Redis database", t, func() {
+		test.MustFlushRedis(p)
+		devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+		Convey("Then the failure count is incremented", func() {
+			for i := 1; i <= 3; i++ {
+				count, err := IncrFailureCount(p, devEUI)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, i)
+			}
+
+			Convey("Then ResetFailureCount resets the count", func() {
+				So(ResetFailureCount(p, devEUI), ShouldBeNil)
+
+				count, err := IncrFailureCount(p, devEUI)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+			})
+		})
+	})
+}
@@ -0,0 +1,84 @@
+// Package limits implements the service-profile limits which are enforced
+// by LoRa App Server (e.g. the max uplink rate of a device).
+package limits
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+)
+
+// uplinkCountKeyTempl defines the key template of the uplink counter of a
+// device. The counter is kept per device and per hour.
+const uplinkCountKeyTempl = "lora:as:device:%s:uplink_count:%d"
+
+// Status defines the status of a value compared to its limit.
+type Status int
+
+// Available statuses.
+const (
+	// WithinLimit is returned when the value is within the limit.
+	WithinLimit Status = iota
+
+	// ApproachingLimit is returned when the value has reached the warning
+	// threshold of the limit.
+	ApproachingLimit
+
+	// ExceedingLimit is returned when the value exceeds the limit.
+	ExceedingLimit
+)
+
+// GetStatus returns the status of the given value compared to the given
+// limit (0 = unlimited). The threshold (e.g. 0.8 for 80%) defines when
+// the limit is being approached, 0 disables ApproachingLimit.
+func GetStatus(value, max int, threshold float64) Status {
+	if max == 0 {
+		return WithinLimit
+	}
+
+	if value > max {
+		return ExceedingLimit
+	}
+
+	if threshold > 0 && value >= WarningValue(max, threshold) {
+		return ApproachingLimit
+	}
+
+	return WithinLimit
+}
+
+// WarningValue returns the value at which the given limit is being
+// approached, given the threshold.
+func WarningValue(max int, threshold float64) int {
+	return int(math.Ceil(float64(max) * threshold))
+}
+
+// IncrUplinkCount increments the uplink counter of the given device for the
+// hour of the given time and returns the updated count.
+func IncrUplinkCount(p *redis.Pool, devEUI lorawan.EUI64, t time.Time) (int, error) {
+	c := p.Get()
+	defer c.Close()
+
+	hour := t.Unix() / 3600
+	key := fmt.Sprintf(uplinkCountKeyTempl, devEUI, hour)
+
+	c.Send("MULTI")
+	c.Send("INCR", key)
+	c.Send("PEXPIRE", key, int64(time.Hour/time.Millisecond))
+	values, err := redis.Values(c.Do("EXEC"))
+	if err != nil {
+		return 0, errors.Wrap(err, "increment uplink count error")
+	}
+
+	count, err := redis.Int(values[0], nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "read uplink count error")
+	}
+
+	return count, nil
+}
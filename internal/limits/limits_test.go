@@ -0,0 +1,78 @@
+package limits
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestGetStatus(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Value     int
+			Max       int
+			Threshold float64
+			Expected  Status
+		}{
+			{Value: 100, Max: 0, Threshold: 0.8, Expected: WithinLimit},
+			{Value: 7, Max: 10, Threshold: 0.8, Expected: WithinLimit},
+			{Value: 8, Max: 10, Threshold: 0.8, Expected: ApproachingLimit},
+			{Value: 10, Max: 10, Threshold: 0.8, Expected: ApproachingLimit},
+			{Value: 11, Max: 10, Threshold: 0.8, Expected: ExceedingLimit},
+			{Value: 10, Max: 10, Threshold: 0, Expected: WithinLimit},
+			{Value: 11, Max: 10, Threshold: 0, Expected: ExceedingLimit},
+		}
+
+		for i, tst := range tests {
+			Convey(fmt.Sprintf("Testing value %d with max %d and threshold %f [%d]", tst.Value, tst.Max, tst.Threshold, i), func() {
+				So(GetStatus(tst.Value, tst.Max, tst.Threshold), ShouldEqual, tst.Expected)
+			})
+		}
+	})
+}
+
+func TestWarningValue(t *testing.T) {
+	Convey("Then the warning value is rounded up", t, func() {
+		So(WarningValue(10, 0.8), ShouldEqual, 8)
+		So(WarningValue(5, 0.5), ShouldEqual, 3)
+		So(WarningValue(10, 0), ShouldEqual, 0)
+	})
+}
+
+func TestIncrUplinkCount(t *testing.T) {
+	conf := test.GetConfig()
+	p := storage.NewRedisPool(conf.RedisURL, 10, 0)
+
+	Convey("Given a clean Redis database", t, func() {
+		test.MustFlushRedis(p)
+
+		devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		now := time.Now()
+
+		Convey("Then the uplink count is incremented", func() {
+			for i := 1; i <= 3; i++ {
+				count, err := IncrUplinkCount(p, devEUI, now)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, i)
+			}
+
+			Convey("Then the count is reset for the next hour", func() {
+				count, err := IncrUplinkCount(p, devEUI, now.Add(time.Hour))
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+			})
+
+			Convey("Then the count is kept per device", func() {
+				count, err := IncrUplinkCount(p, lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}, now)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+			})
+		})
+	})
+}
@@ -0,0 +1,179 @@
+// Package netutil implements the address-family aware (IPv4, IPv6 or
+// dual-stack) listeners and dialers. Next to TCP addresses, Unix domain
+// socket addresses in the unix:///path/to/socket format are supported.
+package netutil
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+// Address families.
+const (
+	DualStack = "dual_stack"
+	IPv4      = "ipv4"
+	IPv6      = "ipv6"
+)
+
+// unixPrefix defines the prefix of Unix domain socket addresses.
+const unixPrefix = "unix://"
+
+var (
+	mu      sync.RWMutex
+	network = "tcp"
+)
+
+var dialer = net.Dialer{
+	Timeout:   30 * time.Second,
+	KeepAlive: 30 * time.Second,
+}
+
+// SetAddressFamily sets the address family used by the listeners and
+// dialers. An empty family equals DualStack.
+func SetAddressFamily(family string) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	switch family {
+	case "", DualStack:
+		network = "tcp"
+	case IPv4:
+		network = "tcp4"
+	case IPv6:
+		network = "tcp6"
+	default:
+		return fmt.Errorf("invalid address family: %s (expected %s, %s or %s)", family, DualStack, IPv4, IPv6)
+	}
+
+	return nil
+}
+
+// Network returns the TCP network (tcp, tcp4 or tcp6) for the configured
+// address family.
+func Network() string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return network
+}
+
+// unixSocketPath returns the path of the given Unix domain socket address
+// and true, or false when the address is not a Unix domain socket address.
+func unixSocketPath(addr string) (string, bool) {
+	if !strings.HasPrefix(addr, unixPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(addr, unixPrefix), true
+}
+
+// ParseSocketMode parses the given (octal) Unix domain socket file mode,
+// e.g. 0660. An empty mode returns 0.
+func ParseSocketMode(mode string) (os.FileMode, error) {
+	if mode == "" {
+		return 0, nil
+	}
+
+	m, err := strconv.ParseUint(mode, 8, 32)
+	if err != nil || m > 0777 {
+		return 0, fmt.Errorf("invalid socket mode: %s (expected an octal file mode, e.g. 0660)", mode)
+	}
+
+	return os.FileMode(m), nil
+}
+
+// Listen announces on the given bind address (e.g. 0.0.0.0:8080, [::]:8080
+// or unix:///var/run/lora-app-server/api.sock) using the configured address
+// family. For Unix domain sockets, a stale socket file is removed and the
+// file mode of the socket is set to the given mode (unless 0).
+func Listen(bind string, socketMode os.FileMode) (net.Listener, error) {
+	path, ok := unixSocketPath(bind)
+	if !ok {
+		return net.Listen(Network(), bind)
+	}
+
+	if fi, err := os.Lstat(path); err == nil && fi.Mode()&os.ModeSocket != 0 {
+		if err := os.Remove(path); err != nil {
+			return nil, errors.Wrap(err, "remove stale socket error")
+		}
+	}
+
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	if socketMode != 0 {
+		if err := os.Chmod(path, socketMode); err != nil {
+			ln.Close()
+			return nil, errors.Wrap(err, "set socket mode error")
+		}
+	}
+
+	return ln, nil
+}
+
+// DialContext connects to the given address. TCP connections use the
+// configured address family, Unix domain socket addresses are dialed
+// using the unix network.
+func DialContext(ctx context.Context, nw, addr string) (net.Conn, error) {
+	if path, ok := unixSocketPath(addr); ok {
+		return dialer.DialContext(ctx, "unix", path)
+	}
+
+	if nw == "tcp" {
+		nw = Network()
+	}
+	return dialer.DialContext(ctx, nw, addr)
+}
+
+// WithDialer returns the gRPC dial option for connecting using the
+// configured address family (or to a Unix domain socket address).
+func WithDialer() grpc.DialOption {
+	return grpc.WithDialer(func(addr string, timeout time.Duration) (net.Conn, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		return DialContext(ctx, "tcp", addr)
+	})
+}
+
+// LoopbackAddress returns the address for connecting to a listener bound
+// to the given address, from the same host. For unspecified bind addresses
+// (e.g. 0.0.0.0:8080, [::]:8080 or :8080) the loopback address is returned.
+// Unix domain socket addresses are returned as-is.
+func LoopbackAddress(bind string) (string, error) {
+	if _, ok := unixSocketPath(bind); ok {
+		return bind, nil
+	}
+
+	host, port, err := net.SplitHostPort(bind)
+	if err != nil {
+		return "", errors.Wrap(err, "split host and port error")
+	}
+
+	if host == "" {
+		switch Network() {
+		case "tcp4":
+			host = "127.0.0.1"
+		case "tcp6":
+			host = "::1"
+		default:
+			host = "localhost"
+		}
+	} else if ip := net.ParseIP(host); ip != nil && ip.IsUnspecified() {
+		if ip.To4() != nil {
+			host = "127.0.0.1"
+		} else {
+			host = "::1"
+		}
+	}
+
+	return net.JoinHostPort(host, port), nil
+}
@@ -0,0 +1,160 @@
+package netutil
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetAddressFamily(t *testing.T) {
+	defer SetAddressFamily(DualStack)
+
+	tests := []struct {
+		Family  string
+		Network string
+		Error   bool
+	}{
+		{"", "tcp", false},
+		{DualStack, "tcp", false},
+		{IPv4, "tcp4", false},
+		{IPv6, "tcp6", false},
+		{"ipv5", "tcp6", true},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Family, func(t *testing.T) {
+			assert := require.New(t)
+
+			err := SetAddressFamily(tst.Family)
+			if tst.Error {
+				assert.Error(err)
+			} else {
+				assert.NoError(err)
+			}
+			assert.Equal(tst.Network, Network())
+		})
+	}
+}
+
+func TestLoopbackAddress(t *testing.T) {
+	defer SetAddressFamily(DualStack)
+
+	tests := []struct {
+		Family   string
+		Bind     string
+		Expected string
+		Error    bool
+	}{
+		{DualStack, "0.0.0.0:8080", "127.0.0.1:8080", false},
+		{DualStack, "[::]:8080", "[::1]:8080", false},
+		{DualStack, ":8080", "localhost:8080", false},
+		{IPv4, ":8080", "127.0.0.1:8080", false},
+		{IPv6, ":8080", "[::1]:8080", false},
+		{DualStack, "192.168.1.1:8080", "192.168.1.1:8080", false},
+		{DualStack, "[2001:db8::1]:8080", "[2001:db8::1]:8080", false},
+		{DualStack, "unix:///tmp/api.sock", "unix:///tmp/api.sock", false},
+		{DualStack, "8080", "", true},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Bind, func(t *testing.T) {
+			assert := require.New(t)
+			assert.NoError(SetAddressFamily(tst.Family))
+
+			addr, err := LoopbackAddress(tst.Bind)
+			if tst.Error {
+				assert.Error(err)
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(tst.Expected, addr)
+		})
+	}
+}
+
+func TestListenAndDial(t *testing.T) {
+	assert := require.New(t)
+	defer SetAddressFamily(DualStack)
+
+	assert.NoError(SetAddressFamily(IPv4))
+
+	ln, err := Listen("127.0.0.1:0", 0)
+	assert.NoError(err)
+	defer ln.Close()
+
+	conn, err := DialContext(context.Background(), "tcp", ln.Addr().String())
+	assert.NoError(err)
+	conn.Close()
+
+	// an IPv4 address can not be reached when the family is IPv6
+	assert.NoError(SetAddressFamily(IPv6))
+	_, err = DialContext(context.Background(), "tcp", ln.Addr().String())
+	assert.Error(err)
+}
+
+func TestParseSocketMode(t *testing.T) {
+	tests := []struct {
+		Mode     string
+		Expected os.FileMode
+		Error    bool
+	}{
+		{"", 0, false},
+		{"0660", 0660, false},
+		{"600", 0600, false},
+		{"0999", 0, true},
+		{"01777", 0, true},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Mode, func(t *testing.T) {
+			assert := require.New(t)
+
+			mode, err := ParseSocketMode(tst.Mode)
+			if tst.Error {
+				assert.Error(err)
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(tst.Expected, mode)
+		})
+	}
+}
+
+func TestListenAndDialUnixSocket(t *testing.T) {
+	assert := require.New(t)
+
+	dir, err := ioutil.TempDir("", "netutil")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "api.sock")
+	bind := "unix://" + path
+
+	ln, err := Listen(bind, 0600)
+	assert.NoError(err)
+
+	fi, err := os.Stat(path)
+	assert.NoError(err)
+	assert.Equal(os.FileMode(0600), fi.Mode().Perm())
+
+	conn, err := DialContext(context.Background(), "tcp", bind)
+	assert.NoError(err)
+	conn.Close()
+
+	t.Run("A stale socket is removed", func(t *testing.T) {
+		assert := require.New(t)
+
+		// simulate a crash, leaving the socket file behind
+		ln.(*net.UnixListener).SetUnlinkOnClose(false)
+		assert.NoError(ln.Close())
+
+		ln, err := Listen(bind, 0)
+		assert.NoError(err)
+		assert.NoError(ln.Close())
+	})
+}
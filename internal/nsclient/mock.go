@@ -0,0 +1,709 @@
+package nsclient
+
+import (
+	"context"
+	"crypto/rand"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/loraserver/api/ns"
+)
+
+// MockVersion defines the version returned by the mock network-server.
+const MockVersion = "mock"
+
+// object kinds stored by the mock network-server
+const (
+	serviceProfileKind = "service-profile"
+	routingProfileKind = "routing-profile"
+	deviceProfileKind  = "device-profile"
+	deviceKind         = "device"
+	gatewayKind        = "gateway"
+	gatewayProfileKind = "gateway-profile"
+	multicastGroupKind = "multicast-group"
+)
+
+type mockPool struct {
+	sync.Mutex
+	region  common.Region
+	clients map[string]*MockClient
+}
+
+// NewMockPool creates a Pool returning an in-memory mock network-server
+// client for each network-server hostname. This makes it possible to use
+// the external API without running LoRa Server, e.g. in CI or during
+// development. The state of the mock network-servers is lost on restart.
+func NewMockPool(region common.Region) Pool {
+	return &mockPool{
+		region:  region,
+		clients: make(map[string]*MockClient),
+	}
+}
+
+// Get returns the mock client for the given server (hostname:ip).
+func (p *mockPool) Get(hostname string, caCert, tlsCert, tlsKey []byte) (ns.NetworkServerServiceClient, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	c, ok := p.clients[hostname]
+	if !ok {
+		c = NewMockClient(p.region)
+		p.clients[hostname] = c
+	}
+
+	return c, nil
+}
+
+type mockObject struct {
+	object    proto.Message
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// MockClient implements an in-memory mock of the network-server API. It
+// stores the objects created through the API so that these can be retrieved,
+// updated and deleted. Calls related to the radio network (e.g. the frame
+// logs) are not supported.
+type MockClient struct {
+	mu                   sync.RWMutex
+	region               common.Region
+	objects              map[string]map[string]mockObject
+	deviceActivations    map[string]*ns.DeviceActivation
+	deviceQueues         map[string][]*ns.DeviceQueueItem
+	multicastGroupQueues map[string][]*ns.MulticastQueueItem
+	multicastGroupDevs   map[string]map[string]struct{}
+}
+
+// NewMockClient creates a new MockClient.
+func NewMockClient(region common.Region) *MockClient {
+	return &MockClient{
+		region:               region,
+		objects:              make(map[string]map[string]mockObject),
+		deviceActivations:    make(map[string]*ns.DeviceActivation),
+		deviceQueues:         make(map[string][]*ns.DeviceQueueItem),
+		multicastGroupQueues: make(map[string][]*ns.MulticastQueueItem),
+		multicastGroupDevs:   make(map[string]map[string]struct{}),
+	}
+}
+
+func (c *MockClient) create(kind string, id []byte, obj proto.Message) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.objects[kind]; !ok {
+		c.objects[kind] = make(map[string]mockObject)
+	}
+
+	if _, ok := c.objects[kind][string(id)]; ok {
+		return grpc.Errorf(codes.AlreadyExists, "%s already exists", kind)
+	}
+
+	now := time.Now()
+	c.objects[kind][string(id)] = mockObject{
+		object:    proto.Clone(obj),
+		createdAt: now,
+		updatedAt: now,
+	}
+
+	return nil
+}
+
+func (c *MockClient) get(kind string, id []byte) (proto.Message, *timestamp.Timestamp, *timestamp.Timestamp, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	o, ok := c.objects[kind][string(id)]
+	if !ok {
+		return nil, nil, nil, grpc.Errorf(codes.NotFound, "%s does not exist", kind)
+	}
+
+	createdAt, _ := ptypes.TimestampProto(o.createdAt)
+	updatedAt, _ := ptypes.TimestampProto(o.updatedAt)
+
+	return proto.Clone(o.object), createdAt, updatedAt, nil
+}
+
+func (c *MockClient) update(kind string, id []byte, obj proto.Message) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	o, ok := c.objects[kind][string(id)]
+	if !ok {
+		return grpc.Errorf(codes.NotFound, "%s does not exist", kind)
+	}
+
+	o.object = proto.Clone(obj)
+	o.updatedAt = time.Now()
+	c.objects[kind][string(id)] = o
+
+	return nil
+}
+
+func (c *MockClient) delete(kind string, id []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.objects[kind][string(id)]; !ok {
+		return grpc.Errorf(codes.NotFound, "%s does not exist", kind)
+	}
+	delete(c.objects[kind], string(id))
+
+	return nil
+}
+
+// newID returns the given id, or a random UUID when the given id is empty.
+func newID(id []byte) []byte {
+	if len(id) != 0 {
+		return id
+	}
+	return uuid.Must(uuid.NewV4()).Bytes()
+}
+
+// CreateServiceProfile creates the given service-profile.
+func (c *MockClient) CreateServiceProfile(ctx context.Context, in *ns.CreateServiceProfileRequest, opts ...grpc.CallOption) (*ns.CreateServiceProfileResponse, error) {
+	if in.ServiceProfile == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "service_profile must not be nil")
+	}
+
+	sp := *in.ServiceProfile
+	sp.Id = newID(sp.Id)
+	if err := c.create(serviceProfileKind, sp.Id, &sp); err != nil {
+		return nil, err
+	}
+
+	return &ns.CreateServiceProfileResponse{Id: sp.Id}, nil
+}
+
+// GetServiceProfile returns the service-profile matching the given id.
+func (c *MockClient) GetServiceProfile(ctx context.Context, in *ns.GetServiceProfileRequest, opts ...grpc.CallOption) (*ns.GetServiceProfileResponse, error) {
+	obj, createdAt, updatedAt, err := c.get(serviceProfileKind, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ns.GetServiceProfileResponse{
+		ServiceProfile: obj.(*ns.ServiceProfile),
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+	}, nil
+}
+
+// UpdateServiceProfile updates the given service-profile.
+func (c *MockClient) UpdateServiceProfile(ctx context.Context, in *ns.UpdateServiceProfileRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	if in.ServiceProfile == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "service_profile must not be nil")
+	}
+	return &empty.Empty{}, c.update(serviceProfileKind, in.ServiceProfile.Id, in.ServiceProfile)
+}
+
+// DeleteServiceProfile deletes the service-profile matching the given id.
+func (c *MockClient) DeleteServiceProfile(ctx context.Context, in *ns.DeleteServiceProfileRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return &empty.Empty{}, c.delete(serviceProfileKind, in.Id)
+}
+
+// CreateRoutingProfile creates the given routing-profile.
+func (c *MockClient) CreateRoutingProfile(ctx context.Context, in *ns.CreateRoutingProfileRequest, opts ...grpc.CallOption) (*ns.CreateRoutingProfileResponse, error) {
+	if in.RoutingProfile == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "routing_profile must not be nil")
+	}
+
+	rp := *in.RoutingProfile
+	rp.Id = newID(rp.Id)
+	if err := c.create(routingProfileKind, rp.Id, &rp); err != nil {
+		return nil, err
+	}
+
+	return &ns.CreateRoutingProfileResponse{Id: rp.Id}, nil
+}
+
+// GetRoutingProfile returns the routing-profile matching the given id.
+func (c *MockClient) GetRoutingProfile(ctx context.Context, in *ns.GetRoutingProfileRequest, opts ...grpc.CallOption) (*ns.GetRoutingProfileResponse, error) {
+	obj, createdAt, updatedAt, err := c.get(routingProfileKind, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ns.GetRoutingProfileResponse{
+		RoutingProfile: obj.(*ns.RoutingProfile),
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+	}, nil
+}
+
+// UpdateRoutingProfile updates the given routing-profile.
+func (c *MockClient) UpdateRoutingProfile(ctx context.Context, in *ns.UpdateRoutingProfileRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	if in.RoutingProfile == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "routing_profile must not be nil")
+	}
+	return &empty.Empty{}, c.update(routingProfileKind, in.RoutingProfile.Id, in.RoutingProfile)
+}
+
+// DeleteRoutingProfile deletes the routing-profile matching the given id.
+func (c *MockClient) DeleteRoutingProfile(ctx context.Context, in *ns.DeleteRoutingProfileRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return &empty.Empty{}, c.delete(routingProfileKind, in.Id)
+}
+
+// CreateDeviceProfile creates the given device-profile.
+func (c *MockClient) CreateDeviceProfile(ctx context.Context, in *ns.CreateDeviceProfileRequest, opts ...grpc.CallOption) (*ns.CreateDeviceProfileResponse, error) {
+	if in.DeviceProfile == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "device_profile must not be nil")
+	}
+
+	dp := *in.DeviceProfile
+	dp.Id = newID(dp.Id)
+	if err := c.create(deviceProfileKind, dp.Id, &dp); err != nil {
+		return nil, err
+	}
+
+	return &ns.CreateDeviceProfileResponse{Id: dp.Id}, nil
+}
+
+// GetDeviceProfile returns the device-profile matching the given id.
+func (c *MockClient) GetDeviceProfile(ctx context.Context, in *ns.GetDeviceProfileRequest, opts ...grpc.CallOption) (*ns.GetDeviceProfileResponse, error) {
+	obj, createdAt, updatedAt, err := c.get(deviceProfileKind, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ns.GetDeviceProfileResponse{
+		DeviceProfile: obj.(*ns.DeviceProfile),
+		CreatedAt:     createdAt,
+		UpdatedAt:     updatedAt,
+	}, nil
+}
+
+// UpdateDeviceProfile updates the given device-profile.
+func (c *MockClient) UpdateDeviceProfile(ctx context.Context, in *ns.UpdateDeviceProfileRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	if in.DeviceProfile == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "device_profile must not be nil")
+	}
+	return &empty.Empty{}, c.update(deviceProfileKind, in.DeviceProfile.Id, in.DeviceProfile)
+}
+
+// DeleteDeviceProfile deletes the device-profile matching the given id.
+func (c *MockClient) DeleteDeviceProfile(ctx context.Context, in *ns.DeleteDeviceProfileRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return &empty.Empty{}, c.delete(deviceProfileKind, in.Id)
+}
+
+// CreateDevice creates the given device.
+func (c *MockClient) CreateDevice(ctx context.Context, in *ns.CreateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	if in.Device == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "device must not be nil")
+	}
+	return &empty.Empty{}, c.create(deviceKind, in.Device.DevEui, in.Device)
+}
+
+// GetDevice returns the device matching the given DevEUI.
+func (c *MockClient) GetDevice(ctx context.Context, in *ns.GetDeviceRequest, opts ...grpc.CallOption) (*ns.GetDeviceResponse, error) {
+	obj, createdAt, updatedAt, err := c.get(deviceKind, in.DevEui)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ns.GetDeviceResponse{
+		Device:    obj.(*ns.Device),
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}, nil
+}
+
+// UpdateDevice updates the given device.
+func (c *MockClient) UpdateDevice(ctx context.Context, in *ns.UpdateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	if in.Device == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "device must not be nil")
+	}
+	return &empty.Empty{}, c.update(deviceKind, in.Device.DevEui, in.Device)
+}
+
+// DeleteDevice deletes the device matching the given DevEUI, including its
+// activation and queue.
+func (c *MockClient) DeleteDevice(ctx context.Context, in *ns.DeleteDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	if err := c.delete(deviceKind, in.DevEui); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.deviceActivations, string(in.DevEui))
+	delete(c.deviceQueues, string(in.DevEui))
+	for _, devs := range c.multicastGroupDevs {
+		delete(devs, string(in.DevEui))
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// ActivateDevice activates the device (ABP).
+func (c *MockClient) ActivateDevice(ctx context.Context, in *ns.ActivateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	if in.DeviceActivation == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "device_activation must not be nil")
+	}
+
+	if _, _, _, err := c.get(deviceKind, in.DeviceActivation.DevEui); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.deviceActivations[string(in.DeviceActivation.DevEui)] = proto.Clone(in.DeviceActivation).(*ns.DeviceActivation)
+	delete(c.deviceQueues, string(in.DeviceActivation.DevEui))
+
+	return &empty.Empty{}, nil
+}
+
+// DeactivateDevice de-activates the device.
+func (c *MockClient) DeactivateDevice(ctx context.Context, in *ns.DeactivateDeviceRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.deviceActivations, string(in.DevEui))
+	delete(c.deviceQueues, string(in.DevEui))
+
+	return &empty.Empty{}, nil
+}
+
+// GetDeviceActivation returns the device activation details.
+func (c *MockClient) GetDeviceActivation(ctx context.Context, in *ns.GetDeviceActivationRequest, opts ...grpc.CallOption) (*ns.GetDeviceActivationResponse, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	da, ok := c.deviceActivations[string(in.DevEui)]
+	if !ok {
+		return nil, grpc.Errorf(codes.NotFound, "device-activation does not exist")
+	}
+
+	return &ns.GetDeviceActivationResponse{
+		DeviceActivation: proto.Clone(da).(*ns.DeviceActivation),
+	}, nil
+}
+
+// CreateDeviceQueueItem creates the given device-queue item.
+func (c *MockClient) CreateDeviceQueueItem(ctx context.Context, in *ns.CreateDeviceQueueItemRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	if in.Item == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "item must not be nil")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.deviceActivations[string(in.Item.DevEui)]; !ok {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "device is not activated")
+	}
+
+	devEUI := string(in.Item.DevEui)
+	c.deviceQueues[devEUI] = append(c.deviceQueues[devEUI], proto.Clone(in.Item).(*ns.DeviceQueueItem))
+
+	return &empty.Empty{}, nil
+}
+
+// FlushDeviceQueueForDevEUI flushes the device-queue for the given DevEUI.
+func (c *MockClient) FlushDeviceQueueForDevEUI(ctx context.Context, in *ns.FlushDeviceQueueForDevEUIRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.deviceQueues, string(in.DevEui))
+
+	return &empty.Empty{}, nil
+}
+
+// GetDeviceQueueItemsForDevEUI returns the device-queue items for the
+// given DevEUI.
+func (c *MockClient) GetDeviceQueueItemsForDevEUI(ctx context.Context, in *ns.GetDeviceQueueItemsForDevEUIRequest, opts ...grpc.CallOption) (*ns.GetDeviceQueueItemsForDevEUIResponse, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var resp ns.GetDeviceQueueItemsForDevEUIResponse
+	for _, item := range c.deviceQueues[string(in.DevEui)] {
+		resp.Items = append(resp.Items, proto.Clone(item).(*ns.DeviceQueueItem))
+	}
+
+	return &resp, nil
+}
+
+// GetNextDownlinkFCntForDevEUI returns the next downlink frame-counter
+// for the given DevEUI, taking the queued items into account.
+func (c *MockClient) GetNextDownlinkFCntForDevEUI(ctx context.Context, in *ns.GetNextDownlinkFCntForDevEUIRequest, opts ...grpc.CallOption) (*ns.GetNextDownlinkFCntForDevEUIResponse, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	da, ok := c.deviceActivations[string(in.DevEui)]
+	if !ok {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "device is not activated")
+	}
+
+	fCnt := da.AFCntDown
+	for _, item := range c.deviceQueues[string(in.DevEui)] {
+		if item.FCnt >= fCnt {
+			fCnt = item.FCnt + 1
+		}
+	}
+
+	return &ns.GetNextDownlinkFCntForDevEUIResponse{FCnt: fCnt}, nil
+}
+
+// GetRandomDevAddr returns a random DevAddr.
+func (c *MockClient) GetRandomDevAddr(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ns.GetRandomDevAddrResponse, error) {
+	devAddr := make([]byte, 4)
+	if _, err := rand.Read(devAddr); err != nil {
+		return nil, grpc.Errorf(codes.Internal, "read random bytes error: %s", err)
+	}
+
+	return &ns.GetRandomDevAddrResponse{DevAddr: devAddr}, nil
+}
+
+// CreateMACCommandQueueItem accepts the given mac-command, which is
+// discarded.
+func (c *MockClient) CreateMACCommandQueueItem(ctx context.Context, in *ns.CreateMACCommandQueueItemRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return &empty.Empty{}, nil
+}
+
+// SendProprietaryPayload accepts the given proprietary payload, which is
+// discarded.
+func (c *MockClient) SendProprietaryPayload(ctx context.Context, in *ns.SendProprietaryPayloadRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return &empty.Empty{}, nil
+}
+
+// CreateGateway creates the given gateway.
+func (c *MockClient) CreateGateway(ctx context.Context, in *ns.CreateGatewayRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	if in.Gateway == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "gateway must not be nil")
+	}
+	return &empty.Empty{}, c.create(gatewayKind, in.Gateway.Id, in.Gateway)
+}
+
+// GetGateway returns the gateway matching the given id.
+func (c *MockClient) GetGateway(ctx context.Context, in *ns.GetGatewayRequest, opts ...grpc.CallOption) (*ns.GetGatewayResponse, error) {
+	obj, createdAt, updatedAt, err := c.get(gatewayKind, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ns.GetGatewayResponse{
+		Gateway:   obj.(*ns.Gateway),
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}, nil
+}
+
+// UpdateGateway updates the given gateway.
+func (c *MockClient) UpdateGateway(ctx context.Context, in *ns.UpdateGatewayRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	if in.Gateway == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "gateway must not be nil")
+	}
+	return &empty.Empty{}, c.update(gatewayKind, in.Gateway.Id, in.Gateway)
+}
+
+// DeleteGateway deletes the gateway matching the given id.
+func (c *MockClient) DeleteGateway(ctx context.Context, in *ns.DeleteGatewayRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return &empty.Empty{}, c.delete(gatewayKind, in.Id)
+}
+
+// CreateGatewayProfile creates the given gateway-profile.
+func (c *MockClient) CreateGatewayProfile(ctx context.Context, in *ns.CreateGatewayProfileRequest, opts ...grpc.CallOption) (*ns.CreateGatewayProfileResponse, error) {
+	if in.GatewayProfile == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "gateway_profile must not be nil")
+	}
+
+	gp := *in.GatewayProfile
+	gp.Id = newID(gp.Id)
+	if err := c.create(gatewayProfileKind, gp.Id, &gp); err != nil {
+		return nil, err
+	}
+
+	return &ns.CreateGatewayProfileResponse{Id: gp.Id}, nil
+}
+
+// GetGatewayProfile returns the gateway-profile matching the given id.
+func (c *MockClient) GetGatewayProfile(ctx context.Context, in *ns.GetGatewayProfileRequest, opts ...grpc.CallOption) (*ns.GetGatewayProfileResponse, error) {
+	obj, createdAt, updatedAt, err := c.get(gatewayProfileKind, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ns.GetGatewayProfileResponse{
+		GatewayProfile: obj.(*ns.GatewayProfile),
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+	}, nil
+}
+
+// UpdateGatewayProfile updates the given gateway-profile.
+func (c *MockClient) UpdateGatewayProfile(ctx context.Context, in *ns.UpdateGatewayProfileRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	if in.GatewayProfile == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "gateway_profile must not be nil")
+	}
+	return &empty.Empty{}, c.update(gatewayProfileKind, in.GatewayProfile.Id, in.GatewayProfile)
+}
+
+// DeleteGatewayProfile deletes the gateway-profile matching the given id.
+func (c *MockClient) DeleteGatewayProfile(ctx context.Context, in *ns.DeleteGatewayProfileRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return &empty.Empty{}, c.delete(gatewayProfileKind, in.Id)
+}
+
+// GetGatewayStats returns no stats, as the mock network-server does not
+// receive any gateway data.
+func (c *MockClient) GetGatewayStats(ctx context.Context, in *ns.GetGatewayStatsRequest, opts ...grpc.CallOption) (*ns.GetGatewayStatsResponse, error) {
+	if _, _, _, err := c.get(gatewayKind, in.GatewayId); err != nil {
+		return nil, err
+	}
+	return &ns.GetGatewayStatsResponse{}, nil
+}
+
+// StreamFrameLogsForGateway is not supported by the mock network-server.
+func (c *MockClient) StreamFrameLogsForGateway(ctx context.Context, in *ns.StreamFrameLogsForGatewayRequest, opts ...grpc.CallOption) (ns.NetworkServerService_StreamFrameLogsForGatewayClient, error) {
+	return nil, grpc.Errorf(codes.Unimplemented, "frame-logs are not supported by the mock network-server")
+}
+
+// StreamFrameLogsForDevice is not supported by the mock network-server.
+func (c *MockClient) StreamFrameLogsForDevice(ctx context.Context, in *ns.StreamFrameLogsForDeviceRequest, opts ...grpc.CallOption) (ns.NetworkServerService_StreamFrameLogsForDeviceClient, error) {
+	return nil, grpc.Errorf(codes.Unimplemented, "frame-logs are not supported by the mock network-server")
+}
+
+// CreateMulticastGroup creates the given multicast-group.
+func (c *MockClient) CreateMulticastGroup(ctx context.Context, in *ns.CreateMulticastGroupRequest, opts ...grpc.CallOption) (*ns.CreateMulticastGroupResponse, error) {
+	if in.MulticastGroup == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "multicast_group must not be nil")
+	}
+
+	mg := *in.MulticastGroup
+	mg.Id = newID(mg.Id)
+	if err := c.create(multicastGroupKind, mg.Id, &mg); err != nil {
+		return nil, err
+	}
+
+	return &ns.CreateMulticastGroupResponse{Id: mg.Id}, nil
+}
+
+// GetMulticastGroup returns the multicast-group matching the given id.
+func (c *MockClient) GetMulticastGroup(ctx context.Context, in *ns.GetMulticastGroupRequest, opts ...grpc.CallOption) (*ns.GetMulticastGroupResponse, error) {
+	obj, createdAt, updatedAt, err := c.get(multicastGroupKind, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ns.GetMulticastGroupResponse{
+		MulticastGroup: obj.(*ns.MulticastGroup),
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+	}, nil
+}
+
+// UpdateMulticastGroup updates the given multicast-group.
+func (c *MockClient) UpdateMulticastGroup(ctx context.Context, in *ns.UpdateMulticastGroupRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	if in.MulticastGroup == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "multicast_group must not be nil")
+	}
+	return &empty.Empty{}, c.update(multicastGroupKind, in.MulticastGroup.Id, in.MulticastGroup)
+}
+
+// DeleteMulticastGroup deletes the multicast-group matching the given id,
+// including its queue.
+func (c *MockClient) DeleteMulticastGroup(ctx context.Context, in *ns.DeleteMulticastGroupRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	if err := c.delete(multicastGroupKind, in.Id); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.multicastGroupQueues, string(in.Id))
+	delete(c.multicastGroupDevs, string(in.Id))
+
+	return &empty.Empty{}, nil
+}
+
+// AddDeviceToMulticastGroup adds the given device to the multicast-group.
+func (c *MockClient) AddDeviceToMulticastGroup(ctx context.Context, in *ns.AddDeviceToMulticastGroupRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	if _, _, _, err := c.get(multicastGroupKind, in.MulticastGroupId); err != nil {
+		return nil, err
+	}
+	if _, _, _, err := c.get(deviceKind, in.DevEui); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.multicastGroupDevs[string(in.MulticastGroupId)]; !ok {
+		c.multicastGroupDevs[string(in.MulticastGroupId)] = make(map[string]struct{})
+	}
+	c.multicastGroupDevs[string(in.MulticastGroupId)][string(in.DevEui)] = struct{}{}
+
+	return &empty.Empty{}, nil
+}
+
+// RemoveDeviceFromMulticastGroup removes the given device from the
+// multicast-group.
+func (c *MockClient) RemoveDeviceFromMulticastGroup(ctx context.Context, in *ns.RemoveDeviceFromMulticastGroupRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.multicastGroupDevs[string(in.MulticastGroupId)][string(in.DevEui)]; !ok {
+		return nil, grpc.Errorf(codes.NotFound, "device does not exist in multicast-group")
+	}
+	delete(c.multicastGroupDevs[string(in.MulticastGroupId)], string(in.DevEui))
+
+	return &empty.Empty{}, nil
+}
+
+// EnqueueMulticastQueueItem creates the given multicast queue-item.
+func (c *MockClient) EnqueueMulticastQueueItem(ctx context.Context, in *ns.EnqueueMulticastQueueItemRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	if in.MulticastQueueItem == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "multicast_queue_item must not be nil")
+	}
+
+	if _, _, _, err := c.get(multicastGroupKind, in.MulticastQueueItem.MulticastGroupId); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	mgID := string(in.MulticastQueueItem.MulticastGroupId)
+	c.multicastGroupQueues[mgID] = append(c.multicastGroupQueues[mgID], proto.Clone(in.MulticastQueueItem).(*ns.MulticastQueueItem))
+
+	return &empty.Empty{}, nil
+}
+
+// FlushMulticastQueueForMulticastGroup flushes the multicast-group queue.
+func (c *MockClient) FlushMulticastQueueForMulticastGroup(ctx context.Context, in *ns.FlushMulticastQueueForMulticastGroupRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.multicastGroupQueues, string(in.MulticastGroupId))
+
+	return &empty.Empty{}, nil
+}
+
+// GetMulticastQueueItemsForMulticastGroup returns the queue-items of the
+// given multicast-group.
+func (c *MockClient) GetMulticastQueueItemsForMulticastGroup(ctx context.Context, in *ns.GetMulticastQueueItemsForMulticastGroupRequest, opts ...grpc.CallOption) (*ns.GetMulticastQueueItemsForMulticastGroupResponse, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var resp ns.GetMulticastQueueItemsForMulticastGroupResponse
+	for _, item := range c.multicastGroupQueues[string(in.MulticastGroupId)] {
+		resp.MulticastQueueItems = append(resp.MulticastQueueItems, proto.Clone(item).(*ns.MulticastQueueItem))
+	}
+
+	return &resp, nil
+}
+
+// GetVersion returns the mock version and the configured region.
+func (c *MockClient) GetVersion(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ns.GetVersionResponse, error) {
+	return &ns.GetVersionResponse{
+		Version: MockVersion,
+		Region:  c.region,
+	}, nil
+}
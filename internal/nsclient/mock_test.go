@@ -0,0 +1,138 @@
+package nsclient
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/loraserver/api/ns"
+)
+
+func TestMockClient(t *testing.T) {
+	Convey("Given a mock network-server client", t, func() {
+		c := NewMockClient(common.Region_EU868)
+		ctx := context.Background()
+		devEUI := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+
+		Convey("Then GetVersion returns the mock version and region", func() {
+			resp, err := c.GetVersion(ctx, nil)
+			So(err, ShouldBeNil)
+			So(resp.Version, ShouldEqual, MockVersion)
+			So(resp.Region, ShouldEqual, common.Region_EU868)
+		})
+
+		Convey("Then GetDevice returns NotFound for an unknown device", func() {
+			_, err := c.GetDevice(ctx, &ns.GetDeviceRequest{DevEui: devEUI})
+			So(grpc.Code(err), ShouldEqual, codes.NotFound)
+		})
+
+		Convey("When creating a device", func() {
+			_, err := c.CreateDevice(ctx, &ns.CreateDeviceRequest{
+				Device: &ns.Device{
+					DevEui:        devEUI,
+					SkipFCntCheck: true,
+				},
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then creating it again returns AlreadyExists", func() {
+				_, err := c.CreateDevice(ctx, &ns.CreateDeviceRequest{
+					Device: &ns.Device{DevEui: devEUI},
+				})
+				So(grpc.Code(err), ShouldEqual, codes.AlreadyExists)
+			})
+
+			Convey("Then GetDevice returns the device", func() {
+				resp, err := c.GetDevice(ctx, &ns.GetDeviceRequest{DevEui: devEUI})
+				So(err, ShouldBeNil)
+				So(resp.Device.SkipFCntCheck, ShouldBeTrue)
+			})
+
+			Convey("Then the device can be updated", func() {
+				_, err := c.UpdateDevice(ctx, &ns.UpdateDeviceRequest{
+					Device: &ns.Device{DevEui: devEUI},
+				})
+				So(err, ShouldBeNil)
+
+				resp, err := c.GetDevice(ctx, &ns.GetDeviceRequest{DevEui: devEUI})
+				So(err, ShouldBeNil)
+				So(resp.Device.SkipFCntCheck, ShouldBeFalse)
+			})
+
+			Convey("Then enqueueing a downlink fails before activation", func() {
+				_, err := c.CreateDeviceQueueItem(ctx, &ns.CreateDeviceQueueItemRequest{
+					Item: &ns.DeviceQueueItem{DevEui: devEUI},
+				})
+				So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+			})
+
+			Convey("When the device is activated and downlinks are enqueued", func() {
+				_, err := c.ActivateDevice(ctx, &ns.ActivateDeviceRequest{
+					DeviceActivation: &ns.DeviceActivation{
+						DevEui:    devEUI,
+						AFCntDown: 10,
+					},
+				})
+				So(err, ShouldBeNil)
+
+				for _, fCnt := range []uint32{10, 11} {
+					_, err := c.CreateDeviceQueueItem(ctx, &ns.CreateDeviceQueueItemRequest{
+						Item: &ns.DeviceQueueItem{DevEui: devEUI, FCnt: fCnt},
+					})
+					So(err, ShouldBeNil)
+				}
+
+				Convey("Then the queue contains the items", func() {
+					resp, err := c.GetDeviceQueueItemsForDevEUI(ctx, &ns.GetDeviceQueueItemsForDevEUIRequest{DevEui: devEUI})
+					So(err, ShouldBeNil)
+					So(resp.Items, ShouldHaveLength, 2)
+				})
+
+				Convey("Then the next downlink frame-counter takes the queue into account", func() {
+					resp, err := c.GetNextDownlinkFCntForDevEUI(ctx, &ns.GetNextDownlinkFCntForDevEUIRequest{DevEui: devEUI})
+					So(err, ShouldBeNil)
+					So(resp.FCnt, ShouldEqual, 12)
+				})
+
+				Convey("When the device is deleted", func() {
+					_, err := c.DeleteDevice(ctx, &ns.DeleteDeviceRequest{DevEui: devEUI})
+					So(err, ShouldBeNil)
+
+					Convey("Then the device, activation and queue have been removed", func() {
+						_, err := c.GetDevice(ctx, &ns.GetDeviceRequest{DevEui: devEUI})
+						So(grpc.Code(err), ShouldEqual, codes.NotFound)
+
+						_, err = c.GetDeviceActivation(ctx, &ns.GetDeviceActivationRequest{DevEui: devEUI})
+						So(grpc.Code(err), ShouldEqual, codes.NotFound)
+
+						resp, err := c.GetDeviceQueueItemsForDevEUI(ctx, &ns.GetDeviceQueueItemsForDevEUIRequest{DevEui: devEUI})
+						So(err, ShouldBeNil)
+						So(resp.Items, ShouldHaveLength, 0)
+					})
+				})
+			})
+		})
+	})
+}
+
+func TestMockPool(t *testing.T) {
+	Convey("Given a mock network-server pool", t, func() {
+		p := NewMockPool(common.Region_US915)
+
+		Convey("Then the same client is returned for the same hostname", func() {
+			c1, err := p.Get("ns-a:8000", nil, nil, nil)
+			So(err, ShouldBeNil)
+			c2, err := p.Get("ns-a:8000", nil, nil, nil)
+			So(err, ShouldBeNil)
+			c3, err := p.Get("ns-b:8000", nil, nil, nil)
+			So(err, ShouldBeNil)
+
+			So(c1, ShouldEqual, c2)
+			So(c1, ShouldNotEqual, c3)
+		})
+	})
+}
@@ -8,12 +8,19 @@ import (
 	"sync"
 	"time"
 
+	"github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
+	"github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
 
+	"github.com/brocaar/lora-app-server/internal/correlation"
+	"github.com/brocaar/lora-app-server/internal/netutil"
 	"github.com/brocaar/loraserver/api/ns"
 )
 
@@ -22,6 +29,64 @@ type Pool interface {
 	Get(hostname string, caCert, tlsCert, tlsKey []byte) (ns.NetworkServerServiceClient, error)
 }
 
+// StatsPool defines a network-server client pool keeping connection
+// statistics.
+type StatsPool interface {
+	Pool
+
+	// GetStats returns the connection statistics for the given server. It
+	// returns false when no connection has been made to this server.
+	GetStats(hostname string) (ConnectionStats, bool)
+}
+
+// Config defines the configuration of the network-server connections.
+type Config struct {
+	// DialTimeout defines the timeout for connecting to a network-server.
+	DialTimeout time.Duration `mapstructure:"dial_timeout"`
+
+	// KeepaliveTime defines the interval after which a keepalive ping is
+	// sent when there is no activity. Keepalive pings are disabled when set
+	// to 0.
+	KeepaliveTime time.Duration `mapstructure:"keepalive_time"`
+
+	// KeepaliveTimeout defines the time to wait for the ping response,
+	// after which the connection is closed.
+	KeepaliveTimeout time.Duration `mapstructure:"keepalive_timeout"`
+
+	// KeepalivePermitWithoutStream defines if keepalive pings are sent when
+	// there are no active requests.
+	KeepalivePermitWithoutStream bool `mapstructure:"keepalive_permit_without_stream"`
+
+	// MaxConcurrentStreams defines the max. number of concurrent (unary)
+	// requests per connection. Requests exceeding this limit wait until a
+	// request has completed. There is no limit when set to 0.
+	MaxConcurrentStreams int `mapstructure:"max_concurrent_streams"`
+
+	Retry struct {
+		// MaxRetries defines the max. number of retries of a (unary) request
+		// failing because the network-server is unavailable. Requests are
+		// not retried when set to 0.
+		MaxRetries uint `mapstructure:"max_retries"`
+
+		// Backoff defines the time between the retries.
+		Backoff time.Duration `mapstructure:"backoff"`
+	} `mapstructure:"retry"`
+}
+
+// ConnectionStats contains the statistics of a network-server connection.
+type ConnectionStats struct {
+	// State contains the connection state (e.g. READY, CONNECTING or
+	// TRANSIENT_FAILURE).
+	State string
+
+	// Reconnects contains the number of times the connection has been
+	// re-established after it was lost.
+	Reconnects int
+
+	// LastReconnectAt contains the time of the last reconnect.
+	LastReconnectAt time.Time
+}
+
 type client struct {
 	client     ns.NetworkServerServiceClient
 	clientConn *grpc.ClientConn
@@ -32,16 +97,32 @@ type client struct {
 
 type pool struct {
 	sync.RWMutex
+	config  Config
 	clients map[string]client
+	stats   map[string]*ConnectionStats
 }
 
 // NewPool creates a Pool.
-func NewPool() Pool {
+func NewPool(c Config) StatsPool {
 	return &pool{
+		config:  c,
 		clients: make(map[string]client),
+		stats:   make(map[string]*ConnectionStats),
 	}
 }
 
+// GetStats returns the connection statistics for the given server.
+func (p *pool) GetStats(hostname string) (ConnectionStats, bool) {
+	p.RLock()
+	defer p.RUnlock()
+
+	stats, ok := p.stats[hostname]
+	if !ok {
+		return ConnectionStats{}, false
+	}
+	return *stats, true
+}
+
 // Get returns a NetworkServerClient for the given server (hostname:ip).
 func (p *pool) Get(hostname string, caCert, tlsCert, tlsKey []byte) (ns.NetworkServerServiceClient, error) {
 	defer p.Unlock()
@@ -74,27 +155,91 @@ func (p *pool) Get(hostname string, caCert, tlsCert, tlsKey []byte) (ns.NetworkS
 			tlsKey:     tlsKey,
 		}
 		p.clients[hostname] = c
+
+		if _, ok := p.stats[hostname]; !ok {
+			p.stats[hostname] = &ConnectionStats{}
+		}
+		p.stats[hostname].State = clientConn.GetState().String()
+		go p.watch(hostname, clientConn)
 	}
 
 	return c.client, nil
 }
 
+// watch updates the connection statistics on state changes of the given
+// connection, until the connection has been closed.
+func (p *pool) watch(hostname string, conn *grpc.ClientConn) {
+	state := conn.GetState()
+
+	for conn.WaitForStateChange(context.Background(), state) {
+		prev := state
+		state = conn.GetState()
+
+		p.Lock()
+		stats := p.stats[hostname]
+		if conn.GetState() != connectivity.Shutdown {
+			stats.State = state.String()
+		}
+		if prev != connectivity.Ready && state == connectivity.Ready {
+			stats.Reconnects++
+			stats.LastReconnectAt = time.Now()
+		}
+		p.Unlock()
+
+		switch {
+		case prev == connectivity.Ready && state != connectivity.Shutdown:
+			log.WithFields(log.Fields{
+				"server": hostname,
+				"state":  state,
+			}).Warning("network-server connection lost")
+		case state == connectivity.Ready:
+			log.WithField("server", hostname).Info("network-server connection re-established")
+		case state == connectivity.Shutdown:
+			return
+		}
+	}
+}
+
 func (p *pool) createClient(hostname string, caCert, tlsCert, tlsKey []byte) (*grpc.ClientConn, ns.NetworkServerServiceClient, error) {
 	logrusEntry := log.NewEntry(log.StandardLogger())
 	logrusOpts := []grpc_logrus.Option{
 		grpc_logrus.WithLevels(grpc_logrus.DefaultCodeToLevel),
 	}
 
+	unaryInterceptors := []grpc.UnaryClientInterceptor{
+		correlation.UnaryClientInterceptor(),
+		grpc_logrus.UnaryClientInterceptor(logrusEntry, logrusOpts...),
+	}
+	if p.config.Retry.MaxRetries > 0 {
+		unaryInterceptors = append(unaryInterceptors, grpc_retry.UnaryClientInterceptor(
+			grpc_retry.WithMax(p.config.Retry.MaxRetries),
+			grpc_retry.WithBackoff(grpc_retry.BackoffLinearWithJitter(p.config.Retry.Backoff, 0.1)),
+			grpc_retry.WithCodes(codes.Unavailable),
+		))
+	}
+	if p.config.MaxConcurrentStreams > 0 {
+		unaryInterceptors = append(unaryInterceptors, concurrencyLimitInterceptor(p.config.MaxConcurrentStreams))
+	}
+
 	nsOpts := []grpc.DialOption{
 		grpc.WithBlock(),
+		netutil.WithDialer(),
 		grpc.WithUnaryInterceptor(
-			grpc_logrus.UnaryClientInterceptor(logrusEntry, logrusOpts...),
+			grpc_middleware.ChainUnaryClient(unaryInterceptors...),
 		),
 		grpc.WithStreamInterceptor(
 			grpc_logrus.StreamClientInterceptor(logrusEntry, logrusOpts...),
 		),
 	}
 
+	if p.config.KeepaliveTime > 0 {
+		nsOpts = append(nsOpts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                p.config.KeepaliveTime,
+			Timeout:             p.config.KeepaliveTimeout,
+			PermitWithoutStream: p.config.KeepalivePermitWithoutStream,
+		}))
+	}
+
 	if len(caCert) == 0 && len(tlsCert) == 0 && len(tlsKey) == 0 {
 		nsOpts = append(nsOpts, grpc.WithInsecure())
 		log.WithField("server", hostname).Warning("creating insecure network-server client")
@@ -116,7 +261,12 @@ func (p *pool) createClient(hostname string, caCert, tlsCert, tlsKey []byte) (*g
 		})))
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	dialTimeout := p.config.DialTimeout
+	if dialTimeout == 0 {
+		dialTimeout = 500 * time.Millisecond
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
 	defer cancel()
 
 	nsClient, err := grpc.DialContext(ctx, hostname, nsOpts...)
@@ -126,3 +276,21 @@ func (p *pool) createClient(hostname string, caCert, tlsCert, tlsKey []byte) (*g
 
 	return nsClient, ns.NewNetworkServerServiceClient(nsClient), nil
 }
+
+// concurrencyLimitInterceptor returns an interceptor limiting the number of
+// concurrent requests to the given max. Requests exceeding this limit wait
+// until a request has completed or the request context is cancelled.
+func concurrencyLimitInterceptor(max int) grpc.UnaryClientInterceptor {
+	sem := make(chan struct{}, max)
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			return grpc.Errorf(codes.ResourceExhausted, "max concurrent network-server requests: %s", ctx.Err())
+		}
+		defer func() { <-sem }()
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,71 @@
+package nsclient
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestConcurrencyLimitInterceptor(t *testing.T) {
+	assert := require.New(t)
+
+	interceptor := concurrencyLimitInterceptor(1)
+
+	release := make(chan struct{})
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		<-release
+		return nil
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- interceptor(context.Background(), "/test", nil, nil, nil, invoker)
+	}()
+
+	// wait until the first request is in progress
+	time.Sleep(10 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := interceptor(ctx, "/test", nil, nil, nil, invoker)
+	assert.Equal(codes.ResourceExhausted, grpc.Code(err))
+
+	close(release)
+	assert.NoError(<-done)
+	assert.NoError(interceptor(context.Background(), "/test", nil, nil, nil, invoker))
+}
+
+func TestPool(t *testing.T) {
+	assert := require.New(t)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(err)
+
+	server := grpc.NewServer()
+	go server.Serve(ln)
+	defer server.Stop()
+
+	var conf Config
+	conf.KeepaliveTime = time.Minute
+	conf.KeepaliveTimeout = 20 * time.Second
+	conf.MaxConcurrentStreams = 10
+	conf.Retry.MaxRetries = 3
+	conf.Retry.Backoff = 10 * time.Millisecond
+	p := NewPool(conf)
+
+	_, ok := p.GetStats(ln.Addr().String())
+	assert.False(ok)
+
+	_, err = p.Get(ln.Addr().String(), nil, nil, nil)
+	assert.NoError(err)
+
+	stats, ok := p.GetStats(ln.Addr().String())
+	assert.True(ok)
+	assert.Equal("READY", stats.State)
+	assert.Equal(0, stats.Reconnects)
+}
@@ -0,0 +1,170 @@
+package oauth2
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/securityevent"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// OrganizationScopePrefix defines the prefix of the organization scopes
+// (organization:<id>).
+const OrganizationScopePrefix = "organization:"
+
+// introspectionResponse defines the OAuth2 token introspection response
+// (RFC 7662, section 2.2).
+type introspectionResponse struct {
+	Active    bool   `json:"active"`
+	Scope     string `json:"scope,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Username  string `json:"username,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	ExpiresAt int64  `json:"exp,omitempty"`
+	NotBefore int64  `json:"nbf,omitempty"`
+	Subject   string `json:"sub,omitempty"`
+	Audience  string `json:"aud,omitempty"`
+	Issuer    string `json:"iss,omitempty"`
+}
+
+// IntrospectionHandler implements the OAuth2 token introspection endpoint.
+// The caller must authenticate using the client credentials of an (active)
+// service account. Only tokens of members of the organization of this
+// service account can be introspected.
+type IntrospectionHandler struct{}
+
+// NewIntrospectionHandler creates a new IntrospectionHandler.
+func NewIntrospectionHandler() *IntrospectionHandler {
+	return &IntrospectionHandler{}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *IntrospectionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, newError(http.StatusMethodNotAllowed, errInvalidRequest, "method must be POST"))
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		writeError(w, newError(http.StatusBadRequest, errInvalidRequest, "parse form error: %s", err))
+		return
+	}
+
+	sa, clientID, err := clientCredentials(r)
+	if err != nil {
+		if terr, ok := errors.Cause(err).(tokenError); ok && terr.Code == errInvalidClient {
+			securityevent.Log(storage.SecurityEvent{
+				Type:        securityevent.FailedLogin,
+				Username:    storage.ServiceAccountUsernamePrefix + clientID,
+				RemoteAddr:  securityevent.HTTPRemoteAddr(r),
+				Description: "service account authentication failed: " + terr.Description,
+			})
+		}
+		writeError(w, err)
+		return
+	}
+
+	token := r.PostForm.Get("token")
+	if token == "" {
+		writeError(w, newError(http.StatusBadRequest, errInvalidRequest, "token is missing"))
+		return
+	}
+
+	resp, err := introspect(sa.OrganizationID, token)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// introspect returns the introspection response for the given token, as
+// seen by the given organization. The token is considered active when it is
+// valid and when the user or service account it was issued to still exists,
+// is active and is a member of the given organization. The scope reflects
+// the current permissions of the user or service account within this
+// organization, so that the memberships of other organizations are not
+// exposed.
+func introspect(organizationID int64, tokenStr string) (introspectionResponse, error) {
+	var claims auth.Claims
+	token, err := jwt.ParseWithClaims(tokenStr, &claims, func(token *jwt.Token) (interface{}, error) {
+		if token.Header["alg"] != jwt.SigningMethodHS256.Alg() {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(config.C.ApplicationServer.ExternalAPI.JWTSecret), nil
+	})
+	if err != nil || !token.Valid || claims.Username == "" {
+		return introspectionResponse{}, nil
+	}
+
+	p, err := storage.GetPrincipalByUsername(config.C.PostgreSQL.DB, claims.Username)
+	if err != nil {
+		if errors.Cause(err) == storage.ErrDoesNotExist {
+			return introspectionResponse{}, nil
+		}
+		return introspectionResponse{}, errors.Wrap(err, "get principal error")
+	}
+	if !p.IsActive {
+		return introspectionResponse{}, nil
+	}
+
+	orgs, err := storage.GetPrincipalOrganizations(config.C.PostgreSQL.DB, p.ID)
+	if err != nil {
+		return introspectionResponse{}, errors.Wrap(err, "get principal organizations error")
+	}
+
+	var org *storage.PrincipalOrganization
+	for i := range orgs {
+		if orgs[i].OrganizationID == organizationID {
+			org = &orgs[i]
+		}
+	}
+	if org == nil {
+		return introspectionResponse{}, nil
+	}
+
+	resp := introspectionResponse{
+		Active:    true,
+		Scope:     strings.Join(getScopes(*org), " "),
+		Username:  claims.Username,
+		TokenType: "bearer",
+		ExpiresAt: claims.ExpiresAt,
+		NotBefore: claims.NotBefore,
+		Subject:   claims.Subject,
+		Audience:  claims.Audience,
+		Issuer:    claims.Issuer,
+	}
+	if storage.IsServiceAccountUsername(claims.Username) {
+		resp.ClientID = strings.TrimPrefix(claims.Username, storage.ServiceAccountUsernamePrefix)
+	}
+
+	return resp, nil
+}
+
+// getScopes returns the scopes for the given organization membership.
+func getScopes(org storage.PrincipalOrganization) []string {
+	prefix := fmt.Sprintf("%s%d", OrganizationScopePrefix, org.OrganizationID)
+	scopes := []string{prefix}
+
+	if org.IsAdmin {
+		scopes = append(scopes, prefix+":admin")
+	}
+	if org.CanViewKeys {
+		scopes = append(scopes, prefix+":view_keys")
+	}
+	if org.CanExport {
+		scopes = append(scopes, prefix+":export")
+	}
+	if org.IsAuditor {
+		scopes = append(scopes, prefix+":auditor")
+	}
+
+	return scopes
+}
@@ -0,0 +1,199 @@
+package oauth2
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func TestIntrospectionHandler(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL, 10, 0)
+	config.C.ApplicationServer.ExternalAPI.JWTSecret = "secret"
+	storage.SetUserSecret("secret")
+
+	Convey("Given a clean database, a user, a service account and an introspection handler", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+
+		org := storage.Organization{Name: "test-org"}
+		So(storage.CreateOrganization(db, &org), ShouldBeNil)
+
+		user := storage.User{
+			Username: "testuser",
+			IsActive: true,
+			Email:    "foo@bar.com",
+		}
+		userID, err := storage.CreateUser(db, &user, "password123")
+		So(err, ShouldBeNil)
+		So(storage.CreateOrganizationUser(db, org.ID, userID, true), ShouldBeNil)
+
+		sa := storage.ServiceAccount{
+			OrganizationID: org.ID,
+			Name:           "resource-server",
+			IsActive:       true,
+			OrganizationUserPermissions: storage.OrganizationUserPermissions{
+				CanExport: true,
+			},
+		}
+		secret, err := storage.CreateServiceAccount(db, &sa)
+		So(err, ShouldBeNil)
+
+		h := NewIntrospectionHandler()
+
+		do := func(token string, out interface{}) *httptest.ResponseRecorder {
+			form := url.Values{"token": []string{token}}
+			r := httptest.NewRequest(http.MethodPost, "/api/oauth2/introspect", strings.NewReader(form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			r.SetBasicAuth(sa.ClientID.String(), secret)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			if out != nil {
+				So(json.Unmarshal(w.Body.Bytes(), out), ShouldBeNil)
+			}
+			return w
+		}
+
+		Convey("Then a user token is active and has the user scopes", func() {
+			token, err := storage.LoginUser(db, "testuser", "password123")
+			So(err, ShouldBeNil)
+
+			var resp introspectionResponse
+			w := do(token, &resp)
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(w.Header().Get("Cache-Control"), ShouldEqual, "no-store")
+			So(resp.Active, ShouldBeTrue)
+			So(resp.Username, ShouldEqual, "testuser")
+			So(resp.ClientID, ShouldEqual, "")
+			So(resp.Subject, ShouldEqual, "user")
+			So(resp.Scope, ShouldEqual, fmt.Sprintf("organization:%d organization:%d:admin", org.ID, org.ID))
+
+			Convey("Then the token is inactive once the user has been deactivated", func() {
+				So(storage.UpdateUser(db, storage.UserUpdate{
+					ID:       userID,
+					Username: user.Username,
+					IsActive: false,
+					Email:    user.Email,
+				}), ShouldBeNil)
+
+				var resp introspectionResponse
+				do(token, &resp)
+				So(resp, ShouldResemble, introspectionResponse{})
+			})
+		})
+
+		Convey("Then a service account token is active and has the service account scopes", func() {
+			token, err := storage.GetServiceAccountToken(sa, time.Hour)
+			So(err, ShouldBeNil)
+
+			var resp introspectionResponse
+			do(token, &resp)
+			So(resp.Active, ShouldBeTrue)
+			So(resp.Username, ShouldEqual, sa.Username())
+			So(resp.ClientID, ShouldEqual, sa.ClientID.String())
+			So(resp.Scope, ShouldEqual, fmt.Sprintf("organization:%d organization:%d:export", org.ID, org.ID))
+		})
+
+		Convey("Given an user of an other organization and a global admin user", func() {
+			org2 := storage.Organization{Name: "other-org"}
+			So(storage.CreateOrganization(db, &org2), ShouldBeNil)
+
+			user2 := storage.User{
+				Username: "otheruser",
+				IsActive: true,
+				Email:    "other@bar.com",
+			}
+			user2ID, err := storage.CreateUser(db, &user2, "password123")
+			So(err, ShouldBeNil)
+			So(storage.CreateOrganizationUser(db, org2.ID, user2ID, true), ShouldBeNil)
+
+			admin := storage.User{
+				Username: "globaladmin",
+				IsActive: true,
+				IsAdmin:  true,
+				Email:    "admin@bar.com",
+			}
+			_, err = storage.CreateUser(db, &admin, "password123")
+			So(err, ShouldBeNil)
+
+			Convey("Then the token of the user of the other organization is inactive", func() {
+				token, err := storage.LoginUser(db, "otheruser", "password123")
+				So(err, ShouldBeNil)
+
+				w := do(token, nil)
+				So(w.Code, ShouldEqual, http.StatusOK)
+				So(w.Body.String(), ShouldEqual, `{"active":false}`)
+			})
+
+			Convey("Then the token of the global admin user is inactive", func() {
+				token, err := storage.LoginUser(db, "globaladmin", "password123")
+				So(err, ShouldBeNil)
+
+				w := do(token, nil)
+				So(w.Body.String(), ShouldEqual, `{"active":false}`)
+			})
+
+			Convey("Then the token of the user of both organizations only has the scopes of the caller organization", func() {
+				So(storage.CreateOrganizationUser(db, org2.ID, userID, false), ShouldBeNil)
+				token, err := storage.LoginUser(db, "testuser", "password123")
+				So(err, ShouldBeNil)
+
+				var resp introspectionResponse
+				do(token, &resp)
+				So(resp.Active, ShouldBeTrue)
+				So(resp.Scope, ShouldEqual, fmt.Sprintf("organization:%d organization:%d:admin", org.ID, org.ID))
+			})
+		})
+
+		Convey("Then an expired token is inactive", func() {
+			token, err := storage.GetServiceAccountToken(sa, -time.Minute)
+			So(err, ShouldBeNil)
+
+			var resp introspectionResponse
+			w := do(token, &resp)
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(w.Body.String(), ShouldEqual, `{"active":false}`)
+		})
+
+		Convey("Then an invalid token is inactive", func() {
+			var resp introspectionResponse
+			do("foo", &resp)
+			So(resp.Active, ShouldBeFalse)
+		})
+
+		Convey("Then a missing token is rejected", func() {
+			var resp tokenError
+			w := do("", &resp)
+			So(w.Code, ShouldEqual, http.StatusBadRequest)
+			So(resp.Code, ShouldEqual, errInvalidRequest)
+		})
+
+		Convey("Then an unauthenticated request is rejected", func() {
+			form := url.Values{"token": []string{"foo"}}
+			r := httptest.NewRequest(http.MethodPost, "/api/oauth2/introspect", strings.NewReader(form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			var resp tokenError
+			So(json.Unmarshal(w.Body.Bytes(), &resp), ShouldBeNil)
+			So(w.Code, ShouldEqual, http.StatusUnauthorized)
+			So(resp.Code, ShouldEqual, errInvalidClient)
+		})
+	})
+}
@@ -0,0 +1,273 @@
+// Package oauth2 implements the OAuth2 token and token introspection
+// endpoints for service accounts. The token endpoint supports the client
+// credentials grant (RFC 6749), using the client ID and secret of the
+// service account, and the JWT bearer grant (RFC 7523), using a JWT
+// assertion signed with the private key of the service account. The
+// returned access token must be used as bearer token for the API, like the
+// token of a user. The introspection endpoint (RFC 7662) allows services
+// receiving a token to validate it without sharing the signing secret.
+package oauth2
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/securityevent"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// Grant types.
+const (
+	ClientCredentialsGrantType = "client_credentials"
+	JWTBearerGrantType         = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+)
+
+// assertionAudience defines the expected audience of a JWT assertion.
+const assertionAudience = "lora-app-server"
+
+// maxAssertionTTL defines the max lifetime of a JWT assertion.
+const maxAssertionTTL = time.Hour
+
+// Error codes (RFC 6749, section 5.2).
+const (
+	errInvalidRequest       = "invalid_request"
+	errInvalidClient        = "invalid_client"
+	errInvalidGrant         = "invalid_grant"
+	errUnsupportedGrantType = "unsupported_grant_type"
+)
+
+// tokenError defines an OAuth2 error response.
+type tokenError struct {
+	status      int
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+func (e tokenError) Error() string {
+	return e.Code + ": " + e.Description
+}
+
+func newError(status int, code, format string, a ...interface{}) tokenError {
+	return tokenError{
+		status:      status,
+		Code:        code,
+		Description: fmt.Sprintf(format, a...),
+	}
+}
+
+// tokenResponse defines the OAuth2 access token response.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// TokenHandler implements the OAuth2 token endpoint.
+type TokenHandler struct {
+	ttl time.Duration
+}
+
+// NewTokenHandler creates a new TokenHandler. The issued access tokens are
+// valid for the given duration.
+func NewTokenHandler(ttl time.Duration) *TokenHandler {
+	return &TokenHandler{
+		ttl: ttl,
+	}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *TokenHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, newError(http.StatusMethodNotAllowed, errInvalidRequest, "method must be POST"))
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		writeError(w, newError(http.StatusBadRequest, errInvalidRequest, "parse form error: %s", err))
+		return
+	}
+
+	var sa storage.ServiceAccount
+	var clientID string
+	var err error
+
+	switch grantType := r.PostForm.Get("grant_type"); grantType {
+	case ClientCredentialsGrantType:
+		sa, clientID, err = clientCredentials(r)
+	case JWTBearerGrantType:
+		sa, clientID, err = h.jwtBearer(r)
+	default:
+		err = newError(http.StatusBadRequest, errUnsupportedGrantType, "unsupported grant_type: %s", grantType)
+	}
+
+	if err != nil {
+		if terr, ok := errors.Cause(err).(tokenError); ok && (terr.Code == errInvalidClient || terr.Code == errInvalidGrant) {
+			securityevent.Log(storage.SecurityEvent{
+				Type:        securityevent.FailedLogin,
+				Username:    storage.ServiceAccountUsernamePrefix + clientID,
+				RemoteAddr:  securityevent.HTTPRemoteAddr(r),
+				Description: "service account authentication failed: " + terr.Description,
+			})
+		}
+		writeError(w, err)
+		return
+	}
+
+	token, err := storage.GetServiceAccountToken(sa, h.ttl)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	log.WithFields(log.Fields{
+		"client_id":       sa.ClientID,
+		"organization_id": sa.OrganizationID,
+	}).Info("oauth2: service account token issued")
+
+	writeJSON(w, http.StatusOK, tokenResponse{
+		AccessToken: token,
+		TokenType:   "bearer",
+		ExpiresIn:   int64(h.ttl / time.Second),
+	})
+}
+
+// clientCredentials authenticates the service account using the client ID
+// and secret, passed either using HTTP basic authentication or as form
+// parameters.
+func clientCredentials(r *http.Request) (storage.ServiceAccount, string, error) {
+	clientID, secret, ok := r.BasicAuth()
+	if !ok {
+		clientID = r.PostForm.Get("client_id")
+		secret = r.PostForm.Get("client_secret")
+	}
+
+	sa, err := getServiceAccount(clientID)
+	if err != nil {
+		return sa, clientID, err
+	}
+
+	if !sa.ValidateClientSecret(secret) {
+		return sa, clientID, newError(http.StatusUnauthorized, errInvalidClient, "invalid client secret")
+	}
+
+	return sa, clientID, nil
+}
+
+// jwtBearer authenticates the service account using the JWT assertion. The
+// issuer and subject of the assertion must be the client ID, the audience
+// must be lora-app-server and the assertion must expire within an hour.
+func (h *TokenHandler) jwtBearer(r *http.Request) (storage.ServiceAccount, string, error) {
+	assertion := r.PostForm.Get("assertion")
+
+	var claims jwt.StandardClaims
+	if _, _, err := new(jwt.Parser).ParseUnverified(assertion, &claims); err != nil {
+		return storage.ServiceAccount{}, "", newError(http.StatusBadRequest, errInvalidGrant, "parse assertion error: %s", err)
+	}
+	clientID := claims.Subject
+
+	sa, err := getServiceAccount(clientID)
+	if err != nil {
+		return sa, clientID, err
+	}
+
+	if sa.PublicKey == "" {
+		return sa, clientID, newError(http.StatusBadRequest, errInvalidGrant, "service account has no public key")
+	}
+
+	pub, err := sa.GetPublicKey()
+	if err != nil {
+		return sa, clientID, errors.Wrap(err, "get public key error")
+	}
+
+	_, err = jwt.ParseWithClaims(assertion, &claims, func(token *jwt.Token) (interface{}, error) {
+		switch pub.(type) {
+		case *rsa.PublicKey:
+			if _, ok := token.Method.(*jwt.SigningMethodRSA); ok {
+				return pub, nil
+			}
+		case *ecdsa.PublicKey:
+			if _, ok := token.Method.(*jwt.SigningMethodECDSA); ok {
+				return pub, nil
+			}
+		}
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	})
+	if err != nil {
+		return sa, clientID, newError(http.StatusBadRequest, errInvalidGrant, "invalid assertion: %s", err)
+	}
+
+	now := time.Now()
+	if claims.Issuer != clientID {
+		return sa, clientID, newError(http.StatusBadRequest, errInvalidGrant, "issuer must be equal to subject")
+	}
+	if !claims.VerifyAudience(assertionAudience, true) {
+		return sa, clientID, newError(http.StatusBadRequest, errInvalidGrant, "audience must be %s", assertionAudience)
+	}
+	if claims.ExpiresAt == 0 || time.Unix(claims.ExpiresAt, 0).After(now.Add(maxAssertionTTL)) {
+		return sa, clientID, newError(http.StatusBadRequest, errInvalidGrant, "assertion must expire within %s", maxAssertionTTL)
+	}
+
+	return sa, clientID, nil
+}
+
+// getServiceAccount returns the active service account for the given
+// client ID.
+func getServiceAccount(clientID string) (storage.ServiceAccount, error) {
+	id, err := uuid.FromString(clientID)
+	if err != nil {
+		return storage.ServiceAccount{}, newError(http.StatusUnauthorized, errInvalidClient, "invalid client id")
+	}
+
+	sa, err := storage.GetServiceAccountByClientID(config.C.PostgreSQL.DB, id)
+	if err != nil {
+		if errors.Cause(err) == storage.ErrDoesNotExist {
+			return sa, newError(http.StatusUnauthorized, errInvalidClient, "unknown client id")
+		}
+		return sa, errors.Wrap(err, "get service account error")
+	}
+
+	if !sa.IsActive {
+		return sa, newError(http.StatusUnauthorized, errInvalidClient, "service account is not active")
+	}
+
+	return sa, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		log.WithError(err).Error("oauth2: marshal json error")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.WriteHeader(status)
+	w.Write(b)
+}
+
+// writeError writes the given error as OAuth2 error response.
+func writeError(w http.ResponseWriter, err error) {
+	terr, ok := errors.Cause(err).(tokenError)
+	if !ok {
+		log.WithError(err).Error("oauth2: token request error")
+		terr = newError(http.StatusInternalServerError, "server_error", "internal server error")
+	}
+
+	if terr.status == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", `Basic realm="lora-app-server"`)
+	}
+
+	writeJSON(w, terr.status, terr)
+}
@@ -0,0 +1,184 @@
+package oauth2
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/api/auth"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func TestTokenHandler(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL, 10, 0)
+	storage.SetUserSecret("secret")
+
+	Convey("Given a clean database, a service account and a token handler", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+
+		priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		So(err, ShouldBeNil)
+		pubBytes, err := x509.MarshalPKIXPublicKey(&priv.PublicKey)
+		So(err, ShouldBeNil)
+
+		org := storage.Organization{Name: "test-org"}
+		So(storage.CreateOrganization(db, &org), ShouldBeNil)
+
+		sa := storage.ServiceAccount{
+			OrganizationID: org.ID,
+			Name:           "automation",
+			IsActive:       true,
+			PublicKey:      string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubBytes})),
+		}
+		secret, err := storage.CreateServiceAccount(db, &sa)
+		So(err, ShouldBeNil)
+
+		h := NewTokenHandler(time.Hour)
+
+		do := func(form url.Values, out interface{}) *httptest.ResponseRecorder {
+			r := httptest.NewRequest(http.MethodPost, "/api/oauth2/token", strings.NewReader(form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			if out != nil {
+				So(json.Unmarshal(w.Body.Bytes(), out), ShouldBeNil)
+			}
+			return w
+		}
+
+		validateToken := func(token string) {
+			var claims auth.Claims
+			_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+				return []byte("secret"), nil
+			})
+			So(err, ShouldBeNil)
+			So(claims.Username, ShouldEqual, sa.Username())
+			So(claims.ExpiresAt, ShouldBeGreaterThan, time.Now().Unix())
+		}
+
+		Convey("Then a token is issued for the client credentials grant", func() {
+			var resp tokenResponse
+			w := do(url.Values{
+				"grant_type":    []string{ClientCredentialsGrantType},
+				"client_id":     []string{sa.ClientID.String()},
+				"client_secret": []string{secret},
+			}, &resp)
+			So(w.Code, ShouldEqual, http.StatusOK)
+			So(w.Header().Get("Cache-Control"), ShouldEqual, "no-store")
+			So(resp.TokenType, ShouldEqual, "bearer")
+			So(resp.ExpiresIn, ShouldEqual, 3600)
+			validateToken(resp.AccessToken)
+		})
+
+		Convey("Then the client credentials can be passed using basic auth", func() {
+			r := httptest.NewRequest(http.MethodPost, "/api/oauth2/token", strings.NewReader("grant_type=client_credentials"))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			r.SetBasicAuth(sa.ClientID.String(), secret)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			So(w.Code, ShouldEqual, http.StatusOK)
+		})
+
+		Convey("Then an invalid client secret is rejected", func() {
+			var resp tokenError
+			w := do(url.Values{
+				"grant_type":    []string{ClientCredentialsGrantType},
+				"client_id":     []string{sa.ClientID.String()},
+				"client_secret": []string{"invalid"},
+			}, &resp)
+			So(w.Code, ShouldEqual, http.StatusUnauthorized)
+			So(resp.Code, ShouldEqual, errInvalidClient)
+		})
+
+		Convey("Then an inactive service account is rejected", func() {
+			sa.IsActive = false
+			So(storage.UpdateServiceAccount(db, &sa), ShouldBeNil)
+
+			w := do(url.Values{
+				"grant_type":    []string{ClientCredentialsGrantType},
+				"client_id":     []string{sa.ClientID.String()},
+				"client_secret": []string{secret},
+			}, nil)
+			So(w.Code, ShouldEqual, http.StatusUnauthorized)
+		})
+
+		Convey("Then an unsupported grant type is rejected", func() {
+			var resp tokenError
+			w := do(url.Values{"grant_type": []string{"password"}}, &resp)
+			So(w.Code, ShouldEqual, http.StatusBadRequest)
+			So(resp.Code, ShouldEqual, errUnsupportedGrantType)
+		})
+
+		Convey("Given a JWT assertion signed with the private key", func() {
+			claims := jwt.StandardClaims{
+				Issuer:    sa.ClientID.String(),
+				Subject:   sa.ClientID.String(),
+				Audience:  "lora-app-server",
+				ExpiresAt: time.Now().Add(5 * time.Minute).Unix(),
+			}
+
+			sign := func(claims jwt.StandardClaims) string {
+				assertion, err := jwt.NewWithClaims(jwt.SigningMethodES256, claims).SignedString(priv)
+				So(err, ShouldBeNil)
+				return assertion
+			}
+
+			Convey("Then a token is issued for the JWT bearer grant", func() {
+				var resp tokenResponse
+				w := do(url.Values{
+					"grant_type": []string{JWTBearerGrantType},
+					"assertion":  []string{sign(claims)},
+				}, &resp)
+				So(w.Code, ShouldEqual, http.StatusOK)
+				validateToken(resp.AccessToken)
+			})
+
+			Convey("Then an assertion without expiration is rejected", func() {
+				claims.ExpiresAt = 0
+
+				var resp tokenError
+				w := do(url.Values{
+					"grant_type": []string{JWTBearerGrantType},
+					"assertion":  []string{sign(claims)},
+				}, &resp)
+				So(w.Code, ShouldEqual, http.StatusBadRequest)
+				So(resp.Code, ShouldEqual, errInvalidGrant)
+			})
+
+			Convey("Then an assertion signed with an other key is rejected", func() {
+				other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				So(err, ShouldBeNil)
+				assertion, err := jwt.NewWithClaims(jwt.SigningMethodES256, claims).SignedString(other)
+				So(err, ShouldBeNil)
+
+				var resp tokenError
+				w := do(url.Values{
+					"grant_type": []string{JWTBearerGrantType},
+					"assertion":  []string{assertion},
+				}, &resp)
+				So(w.Code, ShouldEqual, http.StatusBadRequest)
+				So(resp.Code, ShouldEqual, errInvalidGrant)
+			})
+		})
+	})
+}
@@ -0,0 +1,95 @@
+// Package proxy provides the HTTP clients used by the integrations, which
+// send their requests through the configured (HTTP, HTTPS or SOCKS5)
+// outbound proxy.
+package proxy
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/netutil"
+)
+
+// ErrInvalidURL is returned when the proxy URL is invalid.
+var ErrInvalidURL = errors.New("invalid proxy url (expected http://, https:// or socks5://host:port)")
+
+var (
+	clientsMu sync.Mutex
+	clients   = make(map[string]*http.Client)
+)
+
+// Validate validates the given proxy URL. An empty URL is valid.
+func Validate(proxyURL string) error {
+	if proxyURL == "" {
+		return nil
+	}
+
+	_, err := parse(proxyURL)
+	return err
+}
+
+func parse(proxyURL string) (*url.URL, error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, ErrInvalidURL
+	}
+
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, ErrInvalidURL
+	}
+
+	if u.Host == "" {
+		return nil, ErrInvalidURL
+	}
+
+	return u, nil
+}
+
+// GetHTTPClient returns the HTTP client for the given (per-integration)
+// proxy URL. When empty, the globally configured proxy is used. When no
+// proxy is configured at all, the default HTTP client is returned, which
+// uses the proxy from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment
+// variables (if set).
+//
+// Clients are re-used per proxy URL, so that their connections are pooled.
+func GetHTTPClient(proxyURL string) (*http.Client, error) {
+	if proxyURL == "" {
+		proxyURL = config.C.ApplicationServer.Integration.Proxy.URL
+	}
+	if proxyURL == "" {
+		return http.DefaultClient, nil
+	}
+
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+
+	if c, ok := clients[proxyURL]; ok {
+		return c, nil
+	}
+
+	u, err := parse(proxyURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// same settings as http.DefaultTransport, except for the proxy
+	c := &http.Client{
+		Transport: &http.Transport{
+			Proxy:                 http.ProxyURL(u),
+			DialContext:           netutil.DialContext,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: time.Second,
+		},
+	}
+	clients[proxyURL] = c
+
+	return c, nil
+}
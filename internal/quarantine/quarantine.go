@@ -0,0 +1,138 @@
+// Package quarantine implements the quarantine of uplink frames which can
+// not be decrypted (e.g. because of a missing or broken device activation).
+// After a configured number of consecutive decryption errors, the frames of
+// the device are stored (encrypted) in a per-application buffer and
+// acknowledged to the network-server, so that the network-server does not
+// keep retrying them and the data is not lost.
+package quarantine
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+)
+
+// errorCountKeyTempl defines the key template of the counter of consecutive
+// decryption errors of a device.
+const errorCountKeyTempl = "lora:as:device:%s:decrypt_error_count"
+
+// framesKeyTempl defines the key template of the quarantined frames of an
+// application.
+const framesKeyTempl = "lora:as:application:%d:quarantine"
+
+// errorCountTTL defines the expiration of the counter, after which the
+// series of decryption errors is considered broken.
+const errorCountTTL = 24 * time.Hour
+
+// Frame defines a quarantined uplink frame.
+type Frame struct {
+	Time    time.Time       `json:"time"`
+	DevEUI  lorawan.EUI64   `json:"devEUI"`
+	DevAddr lorawan.DevAddr `json:"devAddr"`
+	FCnt    uint32          `json:"fCnt"`
+	FPort   uint8           `json:"fPort"`
+	Data    []byte          `json:"data"` // encrypted FRMPayload
+	Error   string          `json:"error"`
+}
+
+// IncrErrorCount increments the counter of consecutive decryption errors of
+// the given device and returns the updated count.
+func IncrErrorCount(p *redis.Pool, devEUI lorawan.EUI64) (int, error) {
+	c := p.Get()
+	defer c.Close()
+
+	key := fmt.Sprintf(errorCountKeyTempl, devEUI)
+
+	c.Send("MULTI")
+	c.Send("INCR", key)
+	c.Send("PEXPIRE", key, int64(errorCountTTL/time.Millisecond))
+	values, err := redis.Values(c.Do("EXEC"))
+	if err != nil {
+		return 0, errors.Wrap(err, "increment error count error")
+	}
+
+	count, err := redis.Int(values[0], nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "read error count error")
+	}
+
+	return count, nil
+}
+
+// ResetErrorCount resets the counter of consecutive decryption errors of
+// the given device.
+func ResetErrorCount(p *redis.Pool, devEUI lorawan.EUI64) error {
+	c := p.Get()
+	defer c.Close()
+
+	if _, err := c.Do("DEL", fmt.Sprintf(errorCountKeyTempl, devEUI)); err != nil {
+		return errors.Wrap(err, "delete error count error")
+	}
+
+	return nil
+}
+
+// Add adds the given frame to the quarantined frames of the given
+// application. Only the last maxFrames frames are kept and the frames
+// expire after the given TTL (counted from the last frame).
+func Add(p *redis.Pool, applicationID int64, f Frame, maxFrames int, ttl time.Duration) error {
+	b, err := json.Marshal(f)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	c := p.Get()
+	defer c.Close()
+
+	key := fmt.Sprintf(framesKeyTempl, applicationID)
+
+	c.Send("MULTI")
+	c.Send("LPUSH", key, b)
+	c.Send("LTRIM", key, 0, maxFrames-1)
+	c.Send("PEXPIRE", key, int64(ttl/time.Millisecond))
+	if _, err := c.Do("EXEC"); err != nil {
+		return errors.Wrap(err, "add quarantined frame error")
+	}
+
+	return nil
+}
+
+// Get returns the last (max. limit) quarantined frames of the given
+// application, newest first.
+func Get(p *redis.Pool, applicationID int64, limit int) ([]Frame, error) {
+	c := p.Get()
+	defer c.Close()
+
+	values, err := redis.ByteSlices(c.Do("LRANGE", fmt.Sprintf(framesKeyTempl, applicationID), 0, limit-1))
+	if err != nil {
+		return nil, errors.Wrap(err, "get quarantined frames error")
+	}
+
+	out := make([]Frame, 0, len(values))
+	for _, b := range values {
+		var f Frame
+		if err := json.Unmarshal(b, &f); err != nil {
+			return nil, errors.Wrap(err, "unmarshal json error")
+		}
+		out = append(out, f)
+	}
+
+	return out, nil
+}
+
+// Clear removes the quarantined frames of the given application.
+func Clear(p *redis.Pool, applicationID int64) error {
+	c := p.Get()
+	defer c.Close()
+
+	if _, err := c.Do("DEL", fmt.Sprintf(framesKeyTempl, applicationID)); err != nil {
+		return errors.Wrap(err, "delete quarantined frames error")
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package quarantine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestQuarantine(t *testing.T) {
+	conf := test.GetConfig()
+	p := storage.NewRedisPool(conf.RedisURL, 10, 0)
+	test.MustFlushRedis(p)
+
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+	t.Run("Error count", func(t *testing.T) {
+		assert := require.New(t)
+
+		for i := 1; i <= 3; i++ {
+			count, err := IncrErrorCount(p, devEUI)
+			assert.NoError(err)
+			assert.Equal(i, count)
+		}
+
+		assert.NoError(ResetErrorCount(p, devEUI))
+		count, err := IncrErrorCount(p, devEUI)
+		assert.NoError(err)
+		assert.Equal(1, count)
+	})
+
+	t.Run("Frames", func(t *testing.T) {
+		assert := require.New(t)
+
+		frames := []Frame{
+			{Time: time.Now().UTC().Truncate(time.Millisecond), DevEUI: devEUI, DevAddr: lorawan.DevAddr{1, 2, 3, 4}, FCnt: 10, FPort: 1, Data: []byte{1, 2, 3}, Error: "decrypt payload error"},
+			{Time: time.Now().UTC().Truncate(time.Millisecond), DevEUI: devEUI, FCnt: 11, FPort: 1, Data: []byte{4, 5, 6}, Error: "object does not exist"},
+			{Time: time.Now().UTC().Truncate(time.Millisecond), DevEUI: devEUI, FCnt: 12, FPort: 2, Data: []byte{7, 8, 9}, Error: "object does not exist"},
+		}
+
+		for _, f := range frames {
+			assert.NoError(Add(p, 1, f, 2, time.Minute))
+		}
+
+		out, err := Get(p, 1, 10)
+		assert.NoError(err)
+		assert.Equal([]Frame{frames[2], frames[1]}, out)
+
+		out, err = Get(p, 2, 10)
+		assert.NoError(err)
+		assert.Len(out, 0)
+
+		assert.NoError(Clear(p, 1))
+		out, err = Get(p, 1, 10)
+		assert.NoError(err)
+		assert.Len(out, 0)
+	})
+}
@@ -0,0 +1,165 @@
+// Package readonly implements the server-wide read-only (maintenance) mode.
+// When enabled, the mutating external API calls are rejected, while the
+// uplinks (and other network-server calls) are still processed. The mode
+// is stored in Redis, so that it applies to all LoRa App Server instances.
+package readonly
+
+import (
+	"encoding/json"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// readOnlyKey defines the key of the read-only mode.
+const readOnlyKey = "lora:as:read_only"
+
+// setModeMethod defines the API method for setting the read-only mode,
+// which must not be rejected when in read-only mode.
+const setModeMethod = "/api.InternalService/SetReadOnlyMode"
+
+// readOnlyPrefixes contains the method-name prefixes of the read-only API
+// methods.
+var readOnlyPrefixes = []string{"Get", "List", "Stream"}
+
+// readOnlyMethods contains the read-only API methods which do not match
+// one of the read-only prefixes.
+var readOnlyMethods = map[string]bool{
+	"Login":        true,
+	"Profile":      true,
+	"WhoAmI":       true,
+	"Branding":     true,
+	"GlobalSearch": true,
+}
+
+// Mode defines the read-only mode.
+type Mode struct {
+	Enabled bool      `json:"enabled"`
+	Reason  string    `json:"reason"`
+	Since   time.Time `json:"since"`
+}
+
+// Get returns the read-only mode.
+func Get(p *redis.Pool) (Mode, error) {
+	c := p.Get()
+	defer c.Close()
+
+	var m Mode
+	b, err := redis.Bytes(c.Do("GET", readOnlyKey))
+	if err != nil {
+		if err == redis.ErrNil {
+			return m, nil
+		}
+		return m, errors.Wrap(err, "get read-only mode error")
+	}
+
+	if err := json.Unmarshal(b, &m); err != nil {
+		return m, errors.Wrap(err, "unmarshal json error")
+	}
+
+	return m, nil
+}
+
+// Set sets the read-only mode.
+func Set(p *redis.Pool, m Mode) error {
+	c := p.Get()
+	defer c.Close()
+
+	if !m.Enabled {
+		if _, err := c.Do("DEL", readOnlyKey); err != nil {
+			return errors.Wrap(err, "delete read-only mode error")
+		}
+		return nil
+	}
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	if _, err := c.Do("SET", readOnlyKey, b); err != nil {
+		return errors.Wrap(err, "set read-only mode error")
+	}
+
+	return nil
+}
+
+// IsReadOnlyMethod returns true when the given (full) gRPC method name is
+// a read-only method, e.g. /api.ApplicationService/Get.
+func IsReadOnlyMethod(fullMethod string) bool {
+	method := path.Base(fullMethod)
+
+	if readOnlyMethods[method] {
+		return true
+	}
+
+	for _, prefix := range readOnlyPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UnaryServerInterceptor returns the interceptor rejecting the mutating
+// API calls when in read-only mode. When the read-only mode can not be
+// retrieved (e.g. Redis is unavailable), the call is not rejected.
+func UnaryServerInterceptor(p *redis.Pool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := check(p, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns the streaming variant of
+// UnaryServerInterceptor.
+func StreamServerInterceptor(p *redis.Pool) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := check(p, info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, stream)
+	}
+}
+
+// check returns an Unavailable error when the given (full) gRPC method
+// name must be rejected because of the read-only mode.
+func check(p *redis.Pool, fullMethod string) error {
+	if fullMethod == setModeMethod || IsReadOnlyMethod(fullMethod) {
+		return nil
+	}
+
+	m, err := Get(p)
+	if err != nil {
+		log.WithError(err).Error("readonly: get read-only mode error")
+		return nil
+	}
+
+	if m.Enabled {
+		return grpc.Errorf(codes.Unavailable, "%s", m.Message())
+	}
+
+	return nil
+}
+
+// Message returns the message returned to clients of which the request
+// is rejected because of the read-only mode.
+func (m Mode) Message() string {
+	msg := "the server is in read-only mode (maintenance), please try again later"
+	if m.Reason != "" {
+		msg += ": " + m.Reason
+	}
+	return msg
+}
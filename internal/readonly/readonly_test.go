@@ -0,0 +1,126 @@
+package readonly
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func TestIsReadOnlyMethod(t *testing.T) {
+	tests := []struct {
+		Method   string
+		ReadOnly bool
+	}{
+		{"/api.ApplicationService/Get", true},
+		{"/api.ApplicationService/List", true},
+		{"/api.ApplicationService/GetDeliveryReport", true},
+		{"/api.DeviceService/StreamEventLogs", true},
+		{"/api.InternalService/Login", true},
+		{"/api.InternalService/Profile", true},
+		{"/api.InternalService/Branding", true},
+		{"/api.ApplicationService/Create", false},
+		{"/api.ApplicationService/Update", false},
+		{"/api.ApplicationService/Delete", false},
+		{"/api.DeviceQueueService/Enqueue", false},
+		{"/api.InternalService/SetReadOnlyMode", false},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Method, func(t *testing.T) {
+			require.Equal(t, tst.ReadOnly, IsReadOnlyMethod(tst.Method))
+		})
+	}
+}
+
+func TestReadOnlyMode(t *testing.T) {
+	conf := test.GetConfig()
+	p := storage.NewRedisPool(conf.RedisURL, 10, 0)
+	test.MustFlushRedis(p)
+
+	interceptor := UnaryServerInterceptor(p)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	t.Run("Disabled by default", func(t *testing.T) {
+		assert := require.New(t)
+
+		m, err := Get(p)
+		assert.NoError(err)
+		assert.False(m.Enabled)
+
+		resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/api.ApplicationService/Create"}, handler)
+		assert.NoError(err)
+		assert.Equal("ok", resp)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		assert := require.New(t)
+
+		mode := Mode{
+			Enabled: true,
+			Reason:  "database upgrade",
+			Since:   time.Now().UTC().Truncate(time.Millisecond),
+		}
+		assert.NoError(Set(p, mode))
+
+		m, err := Get(p)
+		assert.NoError(err)
+		assert.Equal(mode, m)
+
+		t.Run("Mutating calls are rejected", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/api.ApplicationService/Create"}, handler)
+			assert.Equal(codes.Unavailable, grpc.Code(err))
+			assert.Contains(grpc.ErrorDesc(err), "database upgrade")
+		})
+
+		t.Run("Mutating streaming calls are rejected", func(t *testing.T) {
+			assert := require.New(t)
+
+			streamInterceptor := StreamServerInterceptor(p)
+			err := streamInterceptor(nil, nil, &grpc.StreamServerInfo{FullMethod: "/api.GatewayProfileService/AssignToGateways"}, func(srv interface{}, stream grpc.ServerStream) error {
+				return nil
+			})
+			assert.Equal(codes.Unavailable, grpc.Code(err))
+		})
+
+		t.Run("Read-only calls are allowed", func(t *testing.T) {
+			assert := require.New(t)
+
+			resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/api.ApplicationService/Get"}, handler)
+			assert.NoError(err)
+			assert.Equal("ok", resp)
+		})
+
+		t.Run("Setting the read-only mode is allowed", func(t *testing.T) {
+			assert := require.New(t)
+
+			resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: setModeMethod}, handler)
+			assert.NoError(err)
+			assert.Equal("ok", resp)
+		})
+
+		t.Run("Disable", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(Set(p, Mode{Enabled: false}))
+
+			m, err := Get(p)
+			assert.NoError(err)
+			assert.False(m.Enabled)
+
+			resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/api.ApplicationService/Create"}, handler)
+			assert.NoError(err)
+			assert.Equal("ok", resp)
+		})
+	})
+}
@@ -0,0 +1,88 @@
+// Package retention implements the pruning of the stored data (security
+// events, device locations and gateway pings) according to the retention
+// of each organization and the server defaults.
+package retention
+
+import (
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// lockKey is used to make sure that only one instance prunes the data
+// within the prune interval.
+const lockKey = "lora:as:retention:lock"
+
+// PruneLoop is a never returning function deleting the expired data
+// within the configured prune interval.
+func PruneLoop() {
+	interval := config.C.ApplicationServer.Retention.PruneInterval
+
+	for {
+		locked, err := acquireLock(interval)
+		if err != nil {
+			log.WithError(err).Error("retention: acquire lock error")
+		} else if locked {
+			if err := Prune(); err != nil {
+				log.WithError(err).Error("retention: prune error")
+			}
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// Prune deletes the expired security events, device locations and gateway
+// pings. The data of applications and devices under legal hold is kept.
+func Prune() error {
+	conf := config.C.ApplicationServer.Retention
+
+	prunes := []struct {
+		name        string
+		defaultDays int
+		f           func(db sqlx.Execer, defaultDays int) (int64, error)
+	}{
+		{"security_events", conf.SecurityEventDays, storage.DeleteExpiredSecurityEvents},
+		{"device_locations", conf.DeviceLocationDays, storage.DeleteExpiredDeviceLocations},
+		{"gateway_pings", conf.GatewayPingDays, storage.DeleteExpiredGatewayPings},
+		{"device_uplink_stats", conf.DeviceUplinkStatsDays, storage.DeleteExpiredDeviceUplinkStats},
+		{"device_availability", conf.DeviceAvailabilityDays, storage.DeleteExpiredDeviceAvailability},
+		{"gateway_signal_stats", conf.GatewaySignalStatsDays, storage.DeleteExpiredGatewaySignalStats},
+	}
+
+	for _, p := range prunes {
+		count, err := p.f(config.C.PostgreSQL.DB, p.defaultDays)
+		if err != nil {
+			return errors.Wrapf(err, "delete expired %s error", p.name)
+		}
+
+		if count != 0 {
+			log.WithFields(log.Fields{
+				"type":  p.name,
+				"count": count,
+			}).Info("retention: expired data deleted")
+		}
+	}
+
+	return nil
+}
+
+func acquireLock(interval time.Duration) (bool, error) {
+	c := config.C.Redis.Pool.Get()
+	defer c.Close()
+
+	_, err := redis.String(c.Do("SET", lockKey, "1", "PX", int64(interval/time.Millisecond), "NX"))
+	if err == redis.ErrNil {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,506 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/securityevent"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// invalidNameCharsRegexp matches the characters which are not allowed
+// within an organization name.
+var invalidNameCharsRegexp = regexp.MustCompile(`[^\w-]+`)
+
+// membershipChanges holds the organization memberships added and removed
+// by a request, which are logged as permission-change security events.
+type membershipChanges struct {
+	added   []int64
+	removed []int64
+}
+
+func (h *Handler) listGroups(w http.ResponseWriter, r *http.Request) error {
+	attr, value, err := parseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		return err
+	}
+	startIndex, count := getPagination(r)
+
+	resp := ListResponse{
+		Schemas:    []string{ListResponseSchema},
+		StartIndex: startIndex,
+		Resources:  []interface{}{},
+	}
+
+	var orgs []storage.Organization
+
+	switch attr {
+	case "":
+		resp.TotalResults, err = storage.GetOrganizationCount(config.C.PostgreSQL.DB, "")
+		if err != nil {
+			return errors.Wrap(err, "get organization count error")
+		}
+
+		orgs, err = storage.GetOrganizations(config.C.PostgreSQL.DB, count, startIndex-1, "")
+		if err != nil {
+			return errors.Wrap(err, "get organizations error")
+		}
+	case "displayname":
+		// the organization search matches on a part of the display name,
+		// the exact matches are selected from these results
+		n, err := storage.GetOrganizationCount(config.C.PostgreSQL.DB, value)
+		if err != nil {
+			return errors.Wrap(err, "get organization count error")
+		}
+
+		candidates, err := storage.GetOrganizations(config.C.PostgreSQL.DB, n, 0, value)
+		if err != nil {
+			return errors.Wrap(err, "get organizations error")
+		}
+
+		var matches []storage.Organization
+		for _, org := range candidates {
+			if strings.EqualFold(org.DisplayName, value) {
+				matches = append(matches, org)
+			}
+		}
+
+		resp.TotalResults = len(matches)
+		if startIndex-1 < len(matches) {
+			matches = matches[startIndex-1:]
+			if len(matches) > count {
+				matches = matches[:count]
+			}
+			orgs = matches
+		}
+	default:
+		return newError(http.StatusBadRequest, "invalidFilter", "filtering on %s is not supported", attr)
+	}
+
+	for _, org := range orgs {
+		g, err := h.getSCIMGroup(config.C.PostgreSQL.DB, org)
+		if err != nil {
+			return err
+		}
+		resp.Resources = append(resp.Resources, g)
+	}
+	resp.ItemsPerPage = len(resp.Resources)
+
+	h.writeJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+func (h *Handler) createGroup(w http.ResponseWriter, r *http.Request) error {
+	var g Group
+	if err := decode(r, &g); err != nil {
+		return err
+	}
+
+	memberIDs, err := parseMemberIDs(g.Members)
+	if err != nil {
+		return err
+	}
+
+	org := storage.Organization{
+		Name:        invalidNameCharsRegexp.ReplaceAllString(strings.TrimSpace(g.DisplayName), "-"),
+		DisplayName: g.DisplayName,
+	}
+
+	var changes membershipChanges
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		if err := storage.CreateOrganization(tx, &org); err != nil {
+			return errors.Wrap(err, "create organization error")
+		}
+
+		return setMembers(tx, org.ID, memberIDs, &changes)
+	})
+	if err != nil {
+		return err
+	}
+
+	h.logMembershipChanges(r, org.ID, changes)
+	return h.writeGroup(w, http.StatusCreated, org.ID)
+}
+
+func (h *Handler) getGroup(w http.ResponseWriter, id string) error {
+	orgID, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	return h.writeGroup(w, http.StatusOK, orgID)
+}
+
+func (h *Handler) replaceGroup(w http.ResponseWriter, r *http.Request, id string) error {
+	orgID, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	var g Group
+	if err := decode(r, &g); err != nil {
+		return err
+	}
+
+	memberIDs, err := parseMemberIDs(g.Members)
+	if err != nil {
+		return err
+	}
+
+	var changes membershipChanges
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		org, err := storage.GetOrganization(tx, orgID)
+		if err != nil {
+			return errors.Wrap(err, "get organization error")
+		}
+
+		org.DisplayName = g.DisplayName
+		if err := storage.UpdateOrganization(tx, &org); err != nil {
+			return errors.Wrap(err, "update organization error")
+		}
+
+		return setMembers(tx, orgID, memberIDs, &changes)
+	})
+	if err != nil {
+		return err
+	}
+
+	h.logMembershipChanges(r, orgID, changes)
+	return h.writeGroup(w, http.StatusOK, orgID)
+}
+
+func (h *Handler) patchGroup(w http.ResponseWriter, r *http.Request, id string) error {
+	orgID, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	var patch PatchOp
+	if err := decode(r, &patch); err != nil {
+		return err
+	}
+
+	var changes membershipChanges
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		org, err := storage.GetOrganization(tx, orgID)
+		if err != nil {
+			return errors.Wrap(err, "get organization error")
+		}
+		displayName := org.DisplayName
+
+		for _, op := range patch.Operations {
+			operation := strings.ToLower(op.Op)
+			switch operation {
+			case "add", "replace", "remove":
+			default:
+				return newError(http.StatusBadRequest, "invalidSyntax", "unsupported group patch operation: %s", op.Op)
+			}
+
+			// without path, the value contains the attributes to update
+			values := map[string]json.RawMessage{op.Path: op.Value}
+			if op.Path == "" {
+				values = make(map[string]json.RawMessage)
+				if err := json.Unmarshal(op.Value, &values); err != nil {
+					return newError(http.StatusBadRequest, "invalidValue", "invalid patch value: %s", op.Value)
+				}
+			}
+
+			for path, value := range values {
+				if err := patchGroupAttribute(tx, &org, operation, path, value, &changes); err != nil {
+					return err
+				}
+			}
+		}
+
+		if org.DisplayName != displayName {
+			if err := storage.UpdateOrganization(tx, &org); err != nil {
+				return errors.Wrap(err, "update organization error")
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	h.logMembershipChanges(r, orgID, changes)
+	return h.writeGroup(w, http.StatusOK, orgID)
+}
+
+// deleteGroup removes all the members of the organization. The organization
+// itself is not deleted, as this would also delete all its applications,
+// devices and gateways.
+func (h *Handler) deleteGroup(w http.ResponseWriter, r *http.Request, id string) error {
+	orgID, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	var changes membershipChanges
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		if _, err := storage.GetOrganization(tx, orgID); err != nil {
+			return errors.Wrap(err, "get organization error")
+		}
+
+		return setMembers(tx, orgID, nil, &changes)
+	})
+	if err != nil {
+		return err
+	}
+
+	h.logMembershipChanges(r, orgID, changes)
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// writeGroup writes the SCIM representation of the given organization.
+func (h *Handler) writeGroup(w http.ResponseWriter, status int, orgID int64) error {
+	org, err := storage.GetOrganization(config.C.PostgreSQL.DB, orgID)
+	if err != nil {
+		return errors.Wrap(err, "get organization error")
+	}
+
+	g, err := h.getSCIMGroup(config.C.PostgreSQL.DB, org)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Location", g.Meta.Location)
+	h.writeJSON(w, status, g)
+	return nil
+}
+
+// getSCIMGroup returns the SCIM representation of the given organization.
+func (h *Handler) getSCIMGroup(db sqlx.Queryer, org storage.Organization) (Group, error) {
+	users, err := getMembers(db, org.ID)
+	if err != nil {
+		return Group{}, err
+	}
+
+	g := Group{
+		Schemas:     []string{GroupSchema},
+		ID:          idString(org.ID),
+		DisplayName: org.DisplayName,
+		Members:     []Reference{},
+		Meta: &Meta{
+			ResourceType: "Group",
+			Created:      org.CreatedAt,
+			LastModified: org.UpdatedAt,
+			Location:     h.location("Group", org.ID),
+		},
+	}
+
+	for _, user := range users {
+		g.Members = append(g.Members, Reference{
+			Value:   idString(user.UserID),
+			Display: user.Username,
+			Ref:     h.location("User", user.UserID),
+		})
+	}
+
+	return g, nil
+}
+
+// logMembershipChanges logs a permission-change security event for each
+// added and removed organization membership.
+func (h *Handler) logMembershipChanges(r *http.Request, orgID int64, changes membershipChanges) {
+	for _, userID := range changes.added {
+		h.logPermissionChange(r, orgID, fmt.Sprintf("user %d added to organization by scim provisioning", userID))
+	}
+	for _, userID := range changes.removed {
+		h.logPermissionChange(r, orgID, fmt.Sprintf("user %d removed from organization by scim provisioning", userID))
+	}
+}
+
+func (h *Handler) logPermissionChange(r *http.Request, orgID int64, description string) {
+	securityevent.Log(storage.SecurityEvent{
+		Type:           securityevent.PermissionChange,
+		RemoteAddr:     securityevent.HTTPRemoteAddr(r),
+		OrganizationID: &orgID,
+		Description:    description,
+	})
+}
+
+// patchGroupAttribute applies the given patch operation to the attribute
+// of the organization matching the given path.
+func patchGroupAttribute(db sqlx.Ext, org *storage.Organization, operation, path string, value json.RawMessage, changes *membershipChanges) error {
+	lowerPath := strings.ToLower(path)
+
+	switch {
+	case lowerPath == "displayname" && operation != "remove":
+		displayName, err := unmarshalString(value)
+		if err != nil {
+			return err
+		}
+		org.DisplayName = displayName
+		return nil
+	case lowerPath == "members":
+		var refs []Reference
+		if len(value) != 0 && string(value) != "null" {
+			if err := json.Unmarshal(value, &refs); err != nil {
+				return newError(http.StatusBadRequest, "invalidValue", "invalid members value: %s", value)
+			}
+		}
+
+		ids, err := parseMemberIDs(refs)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case operation == "add":
+			return addMembers(db, org.ID, ids, changes)
+		case operation == "replace" || len(ids) == 0:
+			// removing members without value removes all members
+			return setMembers(db, org.ID, ids, changes)
+		default:
+			return removeMembers(db, org.ID, ids, changes)
+		}
+	case strings.HasPrefix(lowerPath, "members[") && strings.HasSuffix(lowerPath, "]") && operation == "remove":
+		// e.g. members[value eq "123"]
+		attr, v, err := parseFilter(path[len("members[") : len(path)-1])
+		if err != nil {
+			return err
+		}
+		if attr != "value" {
+			return newError(http.StatusBadRequest, "invalidFilter", "filtering members on %s is not supported", attr)
+		}
+
+		ids, err := parseMemberIDs([]Reference{{Value: v}})
+		if err != nil {
+			return err
+		}
+		return removeMembers(db, org.ID, ids, changes)
+	case lowerPath == "id" || lowerPath == "externalid":
+		// these are sent by some identity providers along with the
+		// attributes to update and are ignored
+		return nil
+	default:
+		return newError(http.StatusBadRequest, "invalidPath", "unsupported group attribute or operation: %s %s", operation, path)
+	}
+}
+
+// getMembers returns all the users of the given organization.
+func getMembers(db sqlx.Queryer, orgID int64) ([]storage.OrganizationUser, error) {
+	count, err := storage.GetOrganizationUserCount(db, orgID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get organization user count error")
+	}
+
+	users, err := storage.GetOrganizationUsers(db, orgID, count, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "get organization users error")
+	}
+
+	return users, nil
+}
+
+// getMemberIDs returns the ids of the users of the given organization.
+func getMemberIDs(db sqlx.Queryer, orgID int64) (map[int64]struct{}, error) {
+	users, err := getMembers(db, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[int64]struct{})
+	for _, user := range users {
+		out[user.UserID] = struct{}{}
+	}
+	return out, nil
+}
+
+// addMembers adds the given users to the organization (as non-admin user).
+// Users which are already a member are left as-is.
+func addMembers(db sqlx.Ext, orgID int64, userIDs []int64, changes *membershipChanges) error {
+	current, err := getMemberIDs(db, orgID)
+	if err != nil {
+		return err
+	}
+
+	for _, userID := range userIDs {
+		if _, ok := current[userID]; ok {
+			continue
+		}
+
+		if err := storage.CreateOrganizationUser(db, orgID, userID, false); err != nil {
+			if errors.Cause(err) == storage.ErrDoesNotExist {
+				return newError(http.StatusBadRequest, "invalidValue", "user %d does not exist", userID)
+			}
+			return errors.Wrap(err, "create organization user error")
+		}
+		current[userID] = struct{}{}
+		changes.added = append(changes.added, userID)
+	}
+
+	return nil
+}
+
+// removeMembers removes the given users from the organization. Users which
+// are not a member are ignored.
+func removeMembers(db sqlx.Ext, orgID int64, userIDs []int64, changes *membershipChanges) error {
+	current, err := getMemberIDs(db, orgID)
+	if err != nil {
+		return err
+	}
+
+	for _, userID := range userIDs {
+		if _, ok := current[userID]; !ok {
+			continue
+		}
+
+		if err := storage.DeleteOrganizationUser(db, orgID, userID); err != nil {
+			return errors.Wrap(err, "delete organization user error")
+		}
+		delete(current, userID)
+		changes.removed = append(changes.removed, userID)
+	}
+
+	return nil
+}
+
+// setMembers sets the users of the organization to the given users.
+func setMembers(db sqlx.Ext, orgID int64, userIDs []int64, changes *membershipChanges) error {
+	current, err := getMemberIDs(db, orgID)
+	if err != nil {
+		return err
+	}
+
+	keep := make(map[int64]struct{})
+	for _, userID := range userIDs {
+		keep[userID] = struct{}{}
+	}
+
+	var remove []int64
+	for userID := range current {
+		if _, ok := keep[userID]; !ok {
+			remove = append(remove, userID)
+		}
+	}
+
+	if err := removeMembers(db, orgID, remove, changes); err != nil {
+		return err
+	}
+	return addMembers(db, orgID, userIDs, changes)
+}
+
+// parseMemberIDs parses the user ids of the given member references.
+func parseMemberIDs(refs []Reference) ([]int64, error) {
+	var out []int64
+	for _, ref := range refs {
+		id, err := strconv.ParseInt(ref.Value, 10, 64)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, "invalidValue", "invalid member value: %s", ref.Value)
+		}
+		out = append(out, id)
+	}
+	return out, nil
+}
@@ -0,0 +1,204 @@
+package scim
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/readonly"
+	"github.com/brocaar/lora-app-server/internal/securityevent"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// contentType defines the SCIM content-type.
+const contentType = "application/scim+json"
+
+// Handler serves the SCIM API. Under the configured prefix, it serves:
+//
+//	/ServiceProviderConfig  the service provider configuration
+//	/Users                  the users (list and create)
+//	/Users/{id}             a single user (get, replace, patch and delete)
+//	/Groups                 the organizations (list and create)
+//	/Groups/{id}            a single organization (get, replace, patch and
+//	                        delete)
+//
+// All requests must be authenticated using the configured bearer token.
+type Handler struct {
+	prefix string
+	token  string
+}
+
+// NewHandler creates a new Handler, serving the SCIM API under the given
+// path prefix (e.g. /scim/v2).
+func NewHandler(prefix, token string) *Handler {
+	return &Handler{
+		prefix: strings.TrimSuffix(prefix, "/"),
+		token:  token,
+	}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.authenticate(r) {
+		securityevent.Log(storage.SecurityEvent{
+			Type:        securityevent.InvalidToken,
+			RemoteAddr:  securityevent.HTTPRemoteAddr(r),
+			Description: "invalid scim bearer token",
+		})
+		h.writeError(w, newError(http.StatusUnauthorized, "", "invalid bearer token"))
+		return
+	}
+
+	if r.Method != http.MethodGet {
+		m, err := readonly.Get(config.C.Redis.Pool)
+		if err != nil {
+			log.WithError(err).Error("scim: get read-only mode error")
+		} else if m.Enabled {
+			h.writeError(w, newError(http.StatusServiceUnavailable, "", "%s", m.Message()))
+			return
+		}
+	}
+
+	path := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, h.prefix), "/"), "/")
+
+	var err error
+	switch {
+	case len(path) == 1 && path[0] == "ServiceProviderConfig" && r.Method == http.MethodGet:
+		h.writeJSON(w, http.StatusOK, serviceProviderConfig())
+	case len(path) == 1 && path[0] == "Users" && r.Method == http.MethodGet:
+		err = h.listUsers(w, r)
+	case len(path) == 1 && path[0] == "Users" && r.Method == http.MethodPost:
+		err = h.createUser(w, r)
+	case len(path) == 2 && path[0] == "Users" && r.Method == http.MethodGet:
+		err = h.getUser(w, path[1])
+	case len(path) == 2 && path[0] == "Users" && r.Method == http.MethodPut:
+		err = h.replaceUser(w, r, path[1])
+	case len(path) == 2 && path[0] == "Users" && r.Method == http.MethodPatch:
+		err = h.patchUser(w, r, path[1])
+	case len(path) == 2 && path[0] == "Users" && r.Method == http.MethodDelete:
+		err = h.deleteUser(w, path[1])
+	case len(path) == 1 && path[0] == "Groups" && r.Method == http.MethodGet:
+		err = h.listGroups(w, r)
+	case len(path) == 1 && path[0] == "Groups" && r.Method == http.MethodPost:
+		err = h.createGroup(w, r)
+	case len(path) == 2 && path[0] == "Groups" && r.Method == http.MethodGet:
+		err = h.getGroup(w, path[1])
+	case len(path) == 2 && path[0] == "Groups" && r.Method == http.MethodPut:
+		err = h.replaceGroup(w, r, path[1])
+	case len(path) == 2 && path[0] == "Groups" && r.Method == http.MethodPatch:
+		err = h.patchGroup(w, r, path[1])
+	case len(path) == 2 && path[0] == "Groups" && r.Method == http.MethodDelete:
+		err = h.deleteGroup(w, r, path[1])
+	default:
+		err = newError(http.StatusNotFound, "", "endpoint not found")
+	}
+
+	if err != nil {
+		h.writeError(w, err)
+	}
+}
+
+// authenticate validates the bearer token of the given request.
+func (h *Handler) authenticate(r *http.Request) bool {
+	auth := r.Header.Get("Authorization")
+	if len(auth) < 7 || !strings.EqualFold(auth[:7], "bearer ") {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimSpace(auth[7:])), []byte(h.token)) == 1
+}
+
+// location returns the location of the given resource.
+func (h *Handler) location(resourceType string, id int64) string {
+	return h.prefix + "/" + resourceType + "s/" + idString(id)
+}
+
+func (h *Handler) writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		log.WithError(err).Error("scim: marshal json error")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	w.Write(b)
+}
+
+// writeError writes the given error as SCIM error response. Storage errors
+// are mapped to the matching HTTP status.
+func (h *Handler) writeError(w http.ResponseWriter, err error) {
+	serr, ok := errors.Cause(err).(scimError)
+	if !ok {
+		switch errors.Cause(err) {
+		case storage.ErrDoesNotExist:
+			serr = scimError{status: http.StatusNotFound, detail: errors.Cause(err).Error()}
+		case storage.ErrAlreadyExists:
+			serr = scimError{status: http.StatusConflict, scimType: "uniqueness", detail: errors.Cause(err).Error()}
+		case storage.ErrUserInvalidUsername, storage.ErrUserPasswordLength, storage.ErrInvalidEmail, storage.ErrOrganizationInvalidName:
+			serr = scimError{status: http.StatusBadRequest, scimType: "invalidValue", detail: errors.Cause(err).Error()}
+		default:
+			log.WithError(err).Error("scim: request error")
+			serr = scimError{status: http.StatusInternalServerError, detail: "internal server error"}
+		}
+	}
+
+	h.writeJSON(w, serr.status, Error{
+		Schemas:  []string{ErrorSchema},
+		ScimType: serr.scimType,
+		Detail:   serr.detail,
+		Status:   strconv.Itoa(serr.status),
+	})
+}
+
+// decode decodes the JSON body of the given request.
+func decode(r *http.Request, v interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return newError(http.StatusBadRequest, "invalidSyntax", "decode request body error: %s", err)
+	}
+	return nil
+}
+
+func serviceProviderConfig() interface{} {
+	type supported struct {
+		Supported bool `json:"supported"`
+	}
+	type filter struct {
+		Supported  bool `json:"supported"`
+		MaxResults int  `json:"maxResults"`
+	}
+	type bulk struct {
+		Supported      bool `json:"supported"`
+		MaxOperations  int  `json:"maxOperations"`
+		MaxPayloadSize int  `json:"maxPayloadSize"`
+	}
+	type authenticationScheme struct {
+		Type        string `json:"type"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+
+	return struct {
+		Schemas               []string               `json:"schemas"`
+		Patch                 supported              `json:"patch"`
+		Bulk                  bulk                   `json:"bulk"`
+		Filter                filter                 `json:"filter"`
+		ChangePassword        supported              `json:"changePassword"`
+		Sort                  supported              `json:"sort"`
+		ETag                  supported              `json:"etag"`
+		AuthenticationSchemes []authenticationScheme `json:"authenticationSchemes"`
+	}{
+		Schemas:        []string{ServiceProviderConfigSchema},
+		Patch:          supported{true},
+		Filter:         filter{true, maxResults},
+		ChangePassword: supported{true},
+		AuthenticationSchemes: []authenticationScheme{
+			{Type: "oauthbearertoken", Name: "OAuth Bearer Token", Description: "Authentication using the configured bearer token"},
+		},
+	}
+}
@@ -0,0 +1,222 @@
+// Package scim implements the SCIM 2.0 (RFC 7643 / RFC 7644) provisioning
+// API, so that an identity provider is able to create, update and
+// deactivate users and to sync the organization memberships. SCIM groups
+// map to organizations, the group members to the organization users.
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SCIM schema URNs.
+const (
+	UserSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	GroupSchema                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	ServiceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	ListResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	PatchOpSchema               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	ErrorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// maxResults defines the max number of resources returned by a single list
+// request.
+const maxResults = 100
+
+// Meta defines the resource meta-data.
+type Meta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+	Location     string    `json:"location"`
+}
+
+// Email defines an e-mail address of an user.
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// Reference defines a reference to an other resource (e.g. a group member).
+type Reference struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// User defines the SCIM user resource.
+type User struct {
+	Schemas  []string    `json:"schemas"`
+	ID       string      `json:"id,omitempty"`
+	UserName string      `json:"userName"`
+	Active   *bool       `json:"active,omitempty"`
+	Password string      `json:"password,omitempty"`
+	Emails   []Email     `json:"emails,omitempty"`
+	Groups   []Reference `json:"groups,omitempty"`
+	Meta     *Meta       `json:"meta,omitempty"`
+}
+
+// email returns the primary e-mail address of the user, or the first
+// e-mail address when none is marked as primary.
+func (u User) email() string {
+	for _, e := range u.Emails {
+		if e.Primary {
+			return e.Value
+		}
+	}
+	if len(u.Emails) != 0 {
+		return u.Emails[0].Value
+	}
+	return ""
+}
+
+// Group defines the SCIM group resource.
+type Group struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	DisplayName string      `json:"displayName"`
+	Members     []Reference `json:"members"`
+	Meta        *Meta       `json:"meta,omitempty"`
+}
+
+// ListResponse defines the response of a list (query) request.
+type ListResponse struct {
+	Schemas      []string      `json:"schemas"`
+	TotalResults int           `json:"totalResults"`
+	StartIndex   int           `json:"startIndex"`
+	ItemsPerPage int           `json:"itemsPerPage"`
+	Resources    []interface{} `json:"Resources"`
+}
+
+// PatchOp defines a patch request.
+type PatchOp struct {
+	Schemas    []string    `json:"schemas"`
+	Operations []Operation `json:"Operations"`
+}
+
+// Operation defines a single patch operation.
+type Operation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// Error defines the SCIM error response.
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+	Status   string   `json:"status"`
+}
+
+// scimError defines an error returned to the identity provider with the
+// given HTTP status and SCIM error type.
+type scimError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e scimError) Error() string {
+	return e.detail
+}
+
+func newError(status int, scimType, format string, a ...interface{}) error {
+	return scimError{
+		status:   status,
+		scimType: scimType,
+		detail:   fmt.Sprintf(format, a...),
+	}
+}
+
+// filterRegexp matches the (only) supported filter expression:
+// attribute eq "value".
+var filterRegexp = regexp.MustCompile(`(?i)^\s*([a-z.]+)\s+eq\s+"((?:[^"\\]|\\.)*)"\s*$`)
+
+// parseFilter parses the given filter, returning the (lower-case) attribute
+// name and the value. It returns an empty attribute when no filter is set.
+func parseFilter(filter string) (string, string, error) {
+	if filter == "" {
+		return "", "", nil
+	}
+
+	match := filterRegexp.FindStringSubmatch(filter)
+	if match == nil {
+		return "", "", newError(http.StatusBadRequest, "invalidFilter", "unsupported filter: %s", filter)
+	}
+
+	value, err := strconv.Unquote(`"` + match[2] + `"`)
+	if err != nil {
+		return "", "", newError(http.StatusBadRequest, "invalidFilter", "invalid filter value: %s", match[2])
+	}
+
+	return strings.ToLower(match[1]), value, nil
+}
+
+// getPagination returns the (1-based) start index and the count of the
+// given list request.
+func getPagination(r *http.Request) (int, int) {
+	startIndex, _ := strconv.Atoi(r.URL.Query().Get("startIndex"))
+	if startIndex < 1 {
+		startIndex = 1
+	}
+
+	count, err := strconv.Atoi(r.URL.Query().Get("count"))
+	if err != nil || count > maxResults {
+		count = maxResults
+	}
+	if count < 0 {
+		count = 0
+	}
+
+	return startIndex, count
+}
+
+// unmarshalBool unmarshals a boolean value. Some identity providers send
+// booleans as string (e.g. "False"), these are accepted too.
+func unmarshalBool(b json.RawMessage) (bool, error) {
+	var v bool
+	if err := json.Unmarshal(b, &v); err == nil {
+		return v, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return false, newError(http.StatusBadRequest, "invalidValue", "invalid boolean value: %s", b)
+	}
+
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, newError(http.StatusBadRequest, "invalidValue", "invalid boolean value: %s", s)
+	}
+	return v, nil
+}
+
+// unmarshalString unmarshals a string value.
+func unmarshalString(b json.RawMessage) (string, error) {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return "", newError(http.StatusBadRequest, "invalidValue", "invalid string value: %s", b)
+	}
+	return s, nil
+}
+
+// idString returns the resource id for the given database id.
+func idString(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+// parseID parses the given resource id.
+func parseID(id string) (int64, error) {
+	i, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return 0, newError(http.StatusNotFound, "", "resource %s not found", id)
+	}
+	return i, nil
+}
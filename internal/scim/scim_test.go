@@ -0,0 +1,262 @@
+package scim
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/readonly"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func TestParseFilter(t *testing.T) {
+	Convey("Given a set of filters", t, func() {
+		tests := []struct {
+			Filter string
+			Attr   string
+			Value  string
+			Error  bool
+		}{
+			{Filter: ""},
+			{Filter: `userName eq "john"`, Attr: "username", Value: "john"},
+			{Filter: `displayName EQ "Team \"A\""`, Attr: "displayname", Value: `Team "A"`},
+			{Filter: `userName sw "jo"`, Error: true},
+			{Filter: `userName eq "a" and active eq true`, Error: true},
+		}
+
+		for _, tst := range tests {
+			Convey("Then "+tst.Filter+" is parsed as expected", func() {
+				attr, value, err := parseFilter(tst.Filter)
+				So(err != nil, ShouldEqual, tst.Error)
+				So(attr, ShouldEqual, tst.Attr)
+				So(value, ShouldEqual, tst.Value)
+			})
+		}
+	})
+}
+
+func TestHandler(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL, 10, 0)
+
+	Convey("Given a clean database and a SCIM handler", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+
+		h := NewHandler("/scim/v2", "secret")
+
+		do := func(method, path, token string, body interface{}, out interface{}) int {
+			var b []byte
+			if body != nil {
+				var err error
+				b, err = json.Marshal(body)
+				So(err, ShouldBeNil)
+			}
+
+			r := httptest.NewRequest(method, "/scim/v2"+path, bytes.NewReader(b))
+			r.Header.Set("Authorization", "Bearer "+token)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			if out != nil && w.Body.Len() != 0 {
+				So(json.Unmarshal(w.Body.Bytes(), out), ShouldBeNil)
+			}
+			return w.Code
+		}
+
+		Convey("Then a request with an invalid token is rejected", func() {
+			var e Error
+			So(do("GET", "/Users", "invalid", nil, &e), ShouldEqual, http.StatusUnauthorized)
+			So(e.Status, ShouldEqual, "401")
+
+			events, err := storage.GetSecurityEvents(config.C.PostgreSQL.DB, storage.SecurityEventFilters{Limit: 10})
+			So(err, ShouldBeNil)
+			So(events, ShouldHaveLength, 1)
+		})
+
+		Convey("Then the X-Forwarded-For header is not used as remote address", func() {
+			r := httptest.NewRequest("GET", "/scim/v2/Users", nil)
+			r.Header.Set("Authorization", "Bearer invalid")
+			r.Header.Set("X-Forwarded-For", "203.0.113.1")
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+			So(w.Code, ShouldEqual, http.StatusUnauthorized)
+
+			events, err := storage.GetSecurityEvents(config.C.PostgreSQL.DB, storage.SecurityEventFilters{Limit: 10})
+			So(err, ShouldBeNil)
+			So(events, ShouldHaveLength, 1)
+			So(events[0].RemoteAddr, ShouldEqual, r.RemoteAddr)
+		})
+
+		Convey("When creating an user with an e-mail address as userName", func() {
+			var u User
+			So(do("POST", "/Users", "secret", User{
+				Schemas:  []string{UserSchema},
+				UserName: "john.doe@example.com",
+			}, &u), ShouldEqual, http.StatusCreated)
+
+			Convey("Then the user has been created", func() {
+				So(u.UserName, ShouldEqual, "john.doe@example.com")
+			})
+
+			Convey("Then the user can be found by userName", func() {
+				var resp struct {
+					TotalResults int
+					Resources    []User
+				}
+				So(do("GET", `/Users?filter=userName+eq+%22john.doe%40example.com%22`, "secret", nil, &resp), ShouldEqual, http.StatusOK)
+				So(resp.TotalResults, ShouldEqual, 1)
+				So(resp.Resources[0].ID, ShouldEqual, u.ID)
+			})
+		})
+
+		Convey("Then creating an user with an invalid userName is rejected", func() {
+			var e Error
+			So(do("POST", "/Users", "secret", User{
+				Schemas:  []string{UserSchema},
+				UserName: "john doe",
+			}, &e), ShouldEqual, http.StatusBadRequest)
+		})
+
+		Convey("Given the read-only mode is enabled", func() {
+			So(readonly.Set(config.C.Redis.Pool, readonly.Mode{Enabled: true, Reason: "upgrade"}), ShouldBeNil)
+			defer readonly.Set(config.C.Redis.Pool, readonly.Mode{})
+
+			Convey("Then creating an user is rejected", func() {
+				var e Error
+				So(do("POST", "/Users", "secret", User{
+					Schemas:  []string{UserSchema},
+					UserName: "john",
+				}, &e), ShouldEqual, http.StatusServiceUnavailable)
+				So(e.Detail, ShouldContainSubstring, "upgrade")
+			})
+
+			Convey("Then listing the users is still possible", func() {
+				So(do("GET", "/Users", "secret", nil, nil), ShouldEqual, http.StatusOK)
+			})
+		})
+
+		Convey("When creating an user", func() {
+			var u User
+			So(do("POST", "/Users", "secret", User{
+				Schemas:  []string{UserSchema},
+				UserName: "john",
+				Emails:   []Email{{Value: "john@example.com", Primary: true}},
+			}, &u), ShouldEqual, http.StatusCreated)
+
+			Convey("Then the user has been created and is active", func() {
+				So(u.ID, ShouldNotEqual, "")
+				So(u.UserName, ShouldEqual, "john")
+				So(*u.Active, ShouldBeTrue)
+				So(u.Emails, ShouldResemble, []Email{{Value: "john@example.com", Type: "work", Primary: true}})
+				So(u.Meta.Location, ShouldEqual, "/scim/v2/Users/"+u.ID)
+			})
+
+			Convey("Then creating the same user again returns a conflict", func() {
+				var e Error
+				So(do("POST", "/Users", "secret", User{UserName: "john", Emails: []Email{{Value: "john@example.com"}}}, &e), ShouldEqual, http.StatusConflict)
+				So(e.ScimType, ShouldEqual, "uniqueness")
+			})
+
+			Convey("Then the user can be found by userName", func() {
+				var resp struct {
+					TotalResults int
+					Resources    []User
+				}
+				So(do("GET", `/Users?filter=userName+eq+%22john%22`, "secret", nil, &resp), ShouldEqual, http.StatusOK)
+				So(resp.TotalResults, ShouldEqual, 1)
+				So(resp.Resources, ShouldHaveLength, 1)
+				So(resp.Resources[0].ID, ShouldEqual, u.ID)
+			})
+
+			Convey("Then the user can be deactivated", func() {
+				var out User
+				So(do("PATCH", "/Users/"+u.ID, "secret", PatchOp{
+					Schemas: []string{PatchOpSchema},
+					Operations: []Operation{
+						{Op: "Replace", Path: "active", Value: json.RawMessage(`"False"`)},
+					},
+				}, &out), ShouldEqual, http.StatusOK)
+				So(*out.Active, ShouldBeFalse)
+
+				user, err := storage.GetUserByUsername(config.C.PostgreSQL.DB, "john")
+				So(err, ShouldBeNil)
+				So(user.IsActive, ShouldBeFalse)
+			})
+
+			Convey("When creating a group with the user as member", func() {
+				var g Group
+				So(do("POST", "/Groups", "secret", Group{
+					Schemas:     []string{GroupSchema},
+					DisplayName: "Field Engineers",
+					Members:     []Reference{{Value: u.ID}},
+				}, &g), ShouldEqual, http.StatusCreated)
+
+				Convey("Then the organization has been created with the user as member", func() {
+					So(g.DisplayName, ShouldEqual, "Field Engineers")
+					So(g.Members, ShouldHaveLength, 1)
+					So(g.Members[0].Value, ShouldEqual, u.ID)
+					So(g.Members[0].Display, ShouldEqual, "john")
+
+					orgID, err := parseID(g.ID)
+					So(err, ShouldBeNil)
+					org, err := storage.GetOrganization(config.C.PostgreSQL.DB, orgID)
+					So(err, ShouldBeNil)
+					So(org.Name, ShouldEqual, "Field-Engineers")
+				})
+
+				Convey("Then the user lists the group", func() {
+					var out User
+					So(do("GET", "/Users/"+u.ID, "secret", nil, &out), ShouldEqual, http.StatusOK)
+					So(out.Groups, ShouldHaveLength, 1)
+					So(out.Groups[0].Value, ShouldEqual, g.ID)
+				})
+
+				Convey("Then the member can be removed", func() {
+					var out Group
+					So(do("PATCH", "/Groups/"+g.ID, "secret", PatchOp{
+						Schemas: []string{PatchOpSchema},
+						Operations: []Operation{
+							{Op: "remove", Path: `members[value eq "` + u.ID + `"]`},
+						},
+					}, &out), ShouldEqual, http.StatusOK)
+					So(out.Members, ShouldHaveLength, 0)
+				})
+
+				Convey("Then adding an unknown user returns an error", func() {
+					var e Error
+					So(do("PATCH", "/Groups/"+g.ID, "secret", PatchOp{
+						Schemas: []string{PatchOpSchema},
+						Operations: []Operation{
+							{Op: "add", Path: "members", Value: json.RawMessage(`[{"value": "12345"}]`)},
+						},
+					}, &e), ShouldEqual, http.StatusBadRequest)
+					So(e.ScimType, ShouldEqual, "invalidValue")
+				})
+
+				Convey("Then deleting the group removes the members but keeps the organization", func() {
+					So(do("DELETE", "/Groups/"+g.ID, "secret", nil, nil), ShouldEqual, http.StatusNoContent)
+
+					var out Group
+					So(do("GET", "/Groups/"+g.ID, "secret", nil, &out), ShouldEqual, http.StatusOK)
+					So(out.Members, ShouldHaveLength, 0)
+				})
+			})
+
+			Convey("Then the user can be deleted", func() {
+				So(do("DELETE", "/Users/"+u.ID, "secret", nil, nil), ShouldEqual, http.StatusNoContent)
+				So(do("GET", "/Users/"+u.ID, "secret", nil, nil), ShouldEqual, http.StatusNotFound)
+			})
+		})
+	})
+}
@@ -0,0 +1,318 @@
+package scim
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+func (h *Handler) listUsers(w http.ResponseWriter, r *http.Request) error {
+	attr, value, err := parseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		return err
+	}
+	startIndex, count := getPagination(r)
+
+	resp := ListResponse{
+		Schemas:    []string{ListResponseSchema},
+		StartIndex: startIndex,
+		Resources:  []interface{}{},
+	}
+
+	var users []storage.User
+
+	switch attr {
+	case "":
+		total, err := storage.GetUserCount(config.C.PostgreSQL.DB, "")
+		if err != nil {
+			return errors.Wrap(err, "get user count error")
+		}
+		resp.TotalResults = int(total)
+
+		users, err = storage.GetUsers(config.C.PostgreSQL.DB, count, startIndex-1, "")
+		if err != nil {
+			return errors.Wrap(err, "get users error")
+		}
+	case "username":
+		user, err := storage.GetUserByUsername(config.C.PostgreSQL.DB, value)
+		if err != nil && errors.Cause(err) != storage.ErrDoesNotExist {
+			return errors.Wrap(err, "get user error")
+		}
+		if err == nil {
+			resp.TotalResults = 1
+			if startIndex == 1 && count != 0 {
+				users = append(users, user)
+			}
+		}
+	default:
+		return newError(http.StatusBadRequest, "invalidFilter", "filtering on %s is not supported", attr)
+	}
+
+	for _, user := range users {
+		u, err := h.getSCIMUser(user)
+		if err != nil {
+			return err
+		}
+		resp.Resources = append(resp.Resources, u)
+	}
+	resp.ItemsPerPage = len(resp.Resources)
+
+	h.writeJSON(w, http.StatusOK, resp)
+	return nil
+}
+
+func (h *Handler) createUser(w http.ResponseWriter, r *http.Request) error {
+	var u User
+	if err := decode(r, &u); err != nil {
+		return err
+	}
+
+	password := u.Password
+	if password == "" {
+		var err error
+		password, err = randomPassword()
+		if err != nil {
+			return err
+		}
+	}
+
+	user := storage.User{
+		Username: u.UserName,
+		IsActive: u.Active == nil || *u.Active,
+		Email:    u.email(),
+	}
+
+	if _, err := storage.CreateUser(config.C.PostgreSQL.DB, &user, password); err != nil {
+		return errors.Wrap(err, "create user error")
+	}
+
+	return h.writeUser(w, http.StatusCreated, user.ID)
+}
+
+func (h *Handler) getUser(w http.ResponseWriter, id string) error {
+	userID, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	return h.writeUser(w, http.StatusOK, userID)
+}
+
+func (h *Handler) replaceUser(w http.ResponseWriter, r *http.Request, id string) error {
+	userID, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	var u User
+	if err := decode(r, &u); err != nil {
+		return err
+	}
+
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		user, err := storage.GetUser(tx, userID)
+		if err != nil {
+			return errors.Wrap(err, "get user error")
+		}
+
+		user.Username = u.UserName
+		user.IsActive = u.Active == nil || *u.Active
+		user.Email = u.email()
+
+		return updateUser(tx, user, u.Password)
+	})
+	if err != nil {
+		return err
+	}
+
+	return h.writeUser(w, http.StatusOK, userID)
+}
+
+func (h *Handler) patchUser(w http.ResponseWriter, r *http.Request, id string) error {
+	userID, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	var patch PatchOp
+	if err := decode(r, &patch); err != nil {
+		return err
+	}
+
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		user, err := storage.GetUser(tx, userID)
+		if err != nil {
+			return errors.Wrap(err, "get user error")
+		}
+
+		var password string
+		for _, op := range patch.Operations {
+			switch strings.ToLower(op.Op) {
+			case "add", "replace":
+			default:
+				return newError(http.StatusBadRequest, "invalidSyntax", "unsupported user patch operation: %s", op.Op)
+			}
+
+			// without path, the value contains the attributes to update
+			values := map[string]json.RawMessage{op.Path: op.Value}
+			if op.Path == "" {
+				values = make(map[string]json.RawMessage)
+				if err := json.Unmarshal(op.Value, &values); err != nil {
+					return newError(http.StatusBadRequest, "invalidValue", "invalid patch value: %s", op.Value)
+				}
+			}
+
+			for path, value := range values {
+				if err := patchUserAttribute(&user, &password, path, value); err != nil {
+					return err
+				}
+			}
+		}
+
+		return updateUser(tx, user, password)
+	})
+	if err != nil {
+		return err
+	}
+
+	return h.writeUser(w, http.StatusOK, userID)
+}
+
+func (h *Handler) deleteUser(w http.ResponseWriter, id string) error {
+	userID, err := parseID(id)
+	if err != nil {
+		return err
+	}
+
+	if err := storage.DeleteUser(config.C.PostgreSQL.DB, userID); err != nil {
+		return errors.Wrap(err, "delete user error")
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// writeUser writes the SCIM representation of the given user.
+func (h *Handler) writeUser(w http.ResponseWriter, status int, userID int64) error {
+	user, err := storage.GetUser(config.C.PostgreSQL.DB, userID)
+	if err != nil {
+		return errors.Wrap(err, "get user error")
+	}
+
+	u, err := h.getSCIMUser(user)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Location", u.Meta.Location)
+	h.writeJSON(w, status, u)
+	return nil
+}
+
+// getSCIMUser returns the SCIM representation of the given user, including
+// the organizations of which the user is a member.
+func (h *Handler) getSCIMUser(user storage.User) (User, error) {
+	prof, err := storage.GetProfile(config.C.PostgreSQL.DB, user.ID)
+	if err != nil {
+		return User{}, errors.Wrap(err, "get user profile error")
+	}
+
+	u := User{
+		Schemas:  []string{UserSchema},
+		ID:       idString(user.ID),
+		UserName: user.Username,
+		Active:   &user.IsActive,
+		Meta: &Meta{
+			ResourceType: "User",
+			Created:      user.CreatedAt,
+			LastModified: user.UpdatedAt,
+			Location:     h.location("User", user.ID),
+		},
+	}
+
+	if user.Email != "" {
+		u.Emails = []Email{{Value: user.Email, Type: "work", Primary: true}}
+	}
+
+	for _, org := range prof.Organizations {
+		u.Groups = append(u.Groups, Reference{
+			Value:   idString(org.ID),
+			Display: org.Name,
+			Ref:     h.location("Group", org.ID),
+		})
+	}
+
+	return u, nil
+}
+
+// patchUserAttribute sets the user attribute matching the given path. As
+// only a single e-mail address is stored, paths selecting an e-mail address
+// (e.g. emails[type eq "work"].value) all update this e-mail address.
+func patchUserAttribute(user *storage.User, password *string, path string, value json.RawMessage) error {
+	var err error
+	path = strings.ToLower(path)
+
+	switch {
+	case path == "active":
+		user.IsActive, err = unmarshalBool(value)
+	case path == "username":
+		user.Username, err = unmarshalString(value)
+	case path == "password":
+		*password, err = unmarshalString(value)
+	case path == "emails":
+		var emails []Email
+		if err := json.Unmarshal(value, &emails); err != nil {
+			return newError(http.StatusBadRequest, "invalidValue", "invalid emails value: %s", value)
+		}
+		user.Email = User{Emails: emails}.email()
+	case strings.HasPrefix(path, "emails[") && strings.HasSuffix(path, "].value"):
+		user.Email, err = unmarshalString(value)
+	default:
+		return newError(http.StatusBadRequest, "invalidPath", "unsupported user attribute: %s", path)
+	}
+
+	return err
+}
+
+// updateUser updates the given user and, when set, the password.
+func updateUser(db sqlx.Ext, user storage.User, password string) error {
+	err := storage.UpdateUser(db, storage.UserUpdate{
+		ID:         user.ID,
+		Username:   user.Username,
+		IsAdmin:    user.IsAdmin,
+		IsActive:   user.IsActive,
+		SessionTTL: user.SessionTTL,
+		Email:      user.Email,
+		Note:       user.Note,
+	})
+	if err != nil {
+		return errors.Wrap(err, "update user error")
+	}
+
+	if password != "" {
+		if err := storage.UpdatePassword(db, user.ID, password); err != nil {
+			return errors.Wrap(err, "update password error")
+		}
+	}
+
+	return nil
+}
+
+// randomPassword returns a random password, used for provisioned users
+// without password (e.g. when the identity provider does not sync
+// passwords).
+func randomPassword() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "read random bytes error")
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
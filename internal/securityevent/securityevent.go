@@ -0,0 +1,211 @@
+// Package securityevent implements the security event feed. Security
+// relevant events (failed logins, join replays, frame-counter anomalies,
+// ...) are stored so that they can be retrieved through the API and are
+// exported to the configured exporter (e.g. a syslog server), for SIEM
+// ingestion. Events related to an organization are also sent to the
+// security event URL of the HTTP integration of the organization, so that
+// tenants can feed these into their own SIEM.
+package securityevent
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/handler"
+	"github.com/brocaar/lora-app-server/internal/handler/httphandler"
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// Security event types.
+const (
+	FailedLogin           = "failed_login"
+	InvalidToken          = "invalid_token"
+	JoinReplay            = "join_replay"
+	FCntAnomaly           = "fcnt_anomaly"
+	DisabledDeviceTraffic = "disabled_device_traffic"
+	CertificateExpiry     = "certificate_expiry"
+	PermissionChange      = "permission_change"
+	LegalHoldChange       = "legal_hold_change"
+	SessionKeyAccess      = "session_key_access"
+	UplinkDecryption      = "uplink_decryption"
+)
+
+// lockKeyTempl defines the key template used to log repeated events only
+// once per interval.
+const lockKeyTempl = "lora:as:securityevent:%s:%s:lock"
+
+// severities defines the severity (0 - 10, as used by CEF) of each event
+// type.
+var severities = map[string]int{
+	FailedLogin:           5,
+	InvalidToken:          8,
+	JoinReplay:            7,
+	FCntAnomaly:           6,
+	DisabledDeviceTraffic: 4,
+	CertificateExpiry:     6,
+	PermissionChange:      5,
+	LegalHoldChange:       5,
+	SessionKeyAccess:      6,
+	UplinkDecryption:      6,
+}
+
+// Exporter defines the interface of a security event exporter.
+type Exporter interface {
+	// Export exports the given security event.
+	Export(e storage.SecurityEvent) error
+}
+
+var exporter Exporter
+
+// SetExporter sets the security event exporter. When set to nil, security
+// events are only stored.
+func SetExporter(e Exporter) {
+	exporter = e
+}
+
+// RemoteAddr returns the remote address of the request of the given
+// context. For requests proxied by the REST API gateway, the forwarded
+// address is returned.
+func RemoteAddr(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get("x-forwarded-for"); len(v) != 0 && v[0] != "" {
+			return v[0]
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+
+	return ""
+}
+
+// HTTPRemoteAddr returns the remote address of the given HTTP request. The
+// X-Forwarded-For header is not used, as it is set by the client and could
+// be used to hide the origin of the request.
+func HTTPRemoteAddr(r *http.Request) string {
+	return r.RemoteAddr
+}
+
+// Log stores the given security event and exports it using the configured
+// exporter and the HTTP integrations of the related organizations. The
+// severity is set based on the event type. Errors are logged and not
+// returned, as logging a security event must not affect the request that
+// triggered it.
+func Log(e storage.SecurityEvent) {
+	e.Severity = severities[e.Type]
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = time.Now()
+	}
+
+	log.WithFields(log.Fields{
+		"type":        e.Type,
+		"username":    e.Username,
+		"remote_addr": e.RemoteAddr,
+		"dev_eui":     e.DevEUI,
+	}).Warning("securityevent: " + e.Description)
+
+	if err := storage.CreateSecurityEvent(config.C.PostgreSQL.DB, &e); err != nil {
+		log.WithError(err).WithField("type", e.Type).Error("create security event error")
+	}
+
+	go func() {
+		if err := exportToOrganizations(e); err != nil {
+			log.WithError(err).WithField("type", e.Type).Error("export security event to organizations error")
+		}
+	}()
+
+	if exporter == nil {
+		return
+	}
+
+	go func() {
+		if err := exporter.Export(e); err != nil {
+			log.WithError(err).WithField("type", e.Type).Error("export security event error")
+		}
+	}()
+}
+
+// LogOnce logs the given security event, unless an event of the same type
+// has already been logged for the given key (e.g. the DevEUI) within the
+// given interval.
+func LogOnce(key string, interval time.Duration, e storage.SecurityEvent) {
+	c := config.C.Redis.Pool.Get()
+	defer c.Close()
+
+	_, err := redis.String(c.Do("SET", fmt.Sprintf(lockKeyTempl, e.Type, key), "1", "PX", int64(interval/time.Millisecond), "NX"))
+	if err == redis.ErrNil {
+		return
+	}
+	if err != nil {
+		log.WithError(err).WithField("type", e.Type).Error("set security event lock error")
+		return
+	}
+
+	Log(e)
+}
+
+// exportToOrganizations sends the given security event to the security
+// event URL of the HTTP integration of each organization to which the event
+// relates (see storage.GetSecurityEventOrganizationIDs).
+func exportToOrganizations(e storage.SecurityEvent) error {
+	ids, err := storage.GetSecurityEventOrganizationIDs(config.C.PostgreSQL.DB, e)
+	if err != nil {
+		return errors.Wrap(err, "get organization ids error")
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	b, err := FormatJSON(e)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		integration, err := storage.GetOrganizationIntegration(config.C.PostgreSQL.DB, id, handler.HTTPHandlerKind)
+		if err != nil {
+			if errors.Cause(err) == storage.ErrDoesNotExist {
+				continue
+			}
+			return errors.Wrap(err, "get organization integration error")
+		}
+
+		var conf httphandler.HandlerConfig
+		if err := json.Unmarshal(integration.Settings, &conf); err != nil {
+			return errors.Wrap(err, "unmarshal http handler config error")
+		}
+		if conf.SecurityEventURL == "" {
+			continue
+		}
+
+		conf, err = conf.TransformSecrets(storage.DecryptSecret)
+		if err != nil {
+			return errors.Wrap(err, "decrypt http handler secrets error")
+		}
+
+		h, err := httphandler.NewHandler(conf)
+		if err != nil {
+			return errors.Wrap(err, "new http handler error")
+		}
+
+		if err := h.SendSecurityEvent(b); err != nil {
+			log.WithError(err).WithFields(log.Fields{
+				"type":            e.Type,
+				"organization_id": id,
+			}).Error("send security event error")
+		}
+	}
+
+	return nil
+}
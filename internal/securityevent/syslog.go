@@ -0,0 +1,152 @@
+package securityevent
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/syslog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+)
+
+// Export formats.
+const (
+	CEFFormat  = "cef"
+	JSONFormat = "json"
+)
+
+// SyslogExporter exports the security events to a syslog server.
+type SyslogExporter struct {
+	writer  *syslog.Writer
+	format  string
+	version string
+}
+
+// NewSyslogExporter creates a new SyslogExporter, connecting to the given
+// syslog server (e.g. network udp and address localhost:514). When the
+// network and address are empty, it connects to the local syslog server.
+// The version is used as device version of CEF formatted events.
+func NewSyslogExporter(network, address, tag, format, version string) (*SyslogExporter, error) {
+	if format != CEFFormat && format != JSONFormat {
+		return nil, fmt.Errorf("unknown format: %s", format)
+	}
+
+	w, err := syslog.Dial(network, address, syslog.LOG_WARNING|syslog.LOG_AUTH, tag)
+	if err != nil {
+		return nil, errors.Wrap(err, "dial syslog error")
+	}
+
+	return &SyslogExporter{
+		writer:  w,
+		format:  format,
+		version: version,
+	}, nil
+}
+
+// Export exports the given security event, using the syslog priority
+// matching the event severity.
+func (s *SyslogExporter) Export(e storage.SecurityEvent) error {
+	var msg string
+	switch s.format {
+	case CEFFormat:
+		msg = FormatCEF(e, s.version)
+	case JSONFormat:
+		b, err := FormatJSON(e)
+		if err != nil {
+			return err
+		}
+		msg = string(b)
+	}
+
+	switch {
+	case e.Severity >= 8:
+		return s.writer.Err(msg)
+	case e.Severity >= 6:
+		return s.writer.Warning(msg)
+	case e.Severity >= 4:
+		return s.writer.Notice(msg)
+	default:
+		return s.writer.Info(msg)
+	}
+}
+
+// Close closes the connection to the syslog server.
+func (s *SyslogExporter) Close() error {
+	return s.writer.Close()
+}
+
+// FormatCEF formats the given security event using the ArcSight Common
+// Event Format (CEF).
+func FormatCEF(e storage.SecurityEvent, version string) string {
+	ext := []string{
+		"rt=" + strconv.FormatInt(e.CreatedAt.UnixNano()/int64(time.Millisecond), 10),
+		"msg=" + cefExtensionEscape(e.Description),
+	}
+	if e.Username != "" {
+		ext = append(ext, "suser="+cefExtensionEscape(e.Username))
+	}
+	if e.RemoteAddr != "" {
+		ext = append(ext, "src="+cefExtensionEscape(e.RemoteAddr))
+	}
+	if e.DevEUI != nil {
+		ext = append(ext, "cs1Label=devEUI", "cs1="+e.DevEUI.String())
+	}
+
+	return fmt.Sprintf("CEF:0|LoRa Server project|LoRa App Server|%s|%s|%s|%d|%s",
+		cefHeaderEscape(version),
+		cefHeaderEscape(e.Type),
+		cefHeaderEscape(strings.Replace(e.Type, "_", " ", -1)),
+		e.Severity,
+		strings.Join(ext, " "),
+	)
+}
+
+// FormatJSON formats the given security event as JSON object.
+func FormatJSON(e storage.SecurityEvent) ([]byte, error) {
+	out := struct {
+		ID             int64     `json:"id"`
+		CreatedAt      time.Time `json:"createdAt"`
+		Type           string    `json:"type"`
+		Severity       int       `json:"severity"`
+		Username       string    `json:"username,omitempty"`
+		RemoteAddr     string    `json:"remoteAddr,omitempty"`
+		DevEUI         string    `json:"devEUI,omitempty"`
+		OrganizationID int64     `json:"organizationID,string,omitempty"`
+		Description    string    `json:"description"`
+	}{
+		ID:          e.ID,
+		CreatedAt:   e.CreatedAt,
+		Type:        e.Type,
+		Severity:    e.Severity,
+		Username:    e.Username,
+		RemoteAddr:  e.RemoteAddr,
+		Description: e.Description,
+	}
+	if e.DevEUI != nil {
+		out.DevEUI = e.DevEUI.String()
+	}
+	if e.OrganizationID != nil {
+		out.OrganizationID = *e.OrganizationID
+	}
+
+	b, err := json.Marshal(out)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal json error")
+	}
+	return b, nil
+}
+
+var cefHeaderReplacer = strings.NewReplacer(`\`, `\\`, `|`, `\|`)
+var cefExtensionReplacer = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r\n", `\n`, "\n", `\n`, "\r", `\r`)
+
+func cefHeaderEscape(s string) string {
+	return cefHeaderReplacer.Replace(s)
+}
+
+func cefExtensionEscape(s string) string {
+	return cefExtensionReplacer.Replace(s)
+}
@@ -0,0 +1,79 @@
+package securityevent
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+func TestFormat(t *testing.T) {
+	Convey("Given a security event", t, func() {
+		devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		e := storage.SecurityEvent{
+			ID:          10,
+			CreatedAt:   time.Unix(1500000000, 0).UTC(),
+			Type:        JoinReplay,
+			Severity:    7,
+			Username:    "a=b",
+			RemoteAddr:  "127.0.0.1:1234",
+			DevEUI:      &devEUI,
+			Description: "dev-nonce has already been used\nfoo",
+		}
+
+		Convey("Then FormatCEF returns the expected CEF line", func() {
+			So(FormatCEF(e, "3.0|1"), ShouldEqual, `CEF:0|LoRa Server project|LoRa App Server|3.0\|1|join_replay|join replay|7|rt=1500000000000 msg=dev-nonce has already been used\nfoo suser=a\=b src=127.0.0.1:1234 cs1Label=devEUI cs1=0102030405060708`)
+		})
+
+		Convey("Then FormatJSON returns the expected JSON object", func() {
+			b, err := FormatJSON(e)
+			So(err, ShouldBeNil)
+			So(string(b), ShouldEqual, `{"id":10,"createdAt":"2017-07-14T02:40:00Z","type":"join_replay","severity":7,"username":"a=b","remoteAddr":"127.0.0.1:1234","devEUI":"0102030405060708","description":"dev-nonce has already been used\nfoo"}`)
+		})
+	})
+}
+
+func TestSyslogExporter(t *testing.T) {
+	Convey("Given a syslog server", t, func() {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		So(err, ShouldBeNil)
+		defer conn.Close()
+
+		Convey("Then an invalid format returns an error", func() {
+			_, err := NewSyslogExporter("udp", conn.LocalAddr().String(), "lora-app-server", "xml", "")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Given a SyslogExporter", func() {
+			exp, err := NewSyslogExporter("udp", conn.LocalAddr().String(), "lora-app-server", CEFFormat, "3.0.0")
+			So(err, ShouldBeNil)
+			defer exp.Close()
+
+			Convey("Then the exported event is received by the syslog server", func() {
+				So(exp.Export(storage.SecurityEvent{
+					CreatedAt:   time.Now(),
+					Type:        FailedLogin,
+					Severity:    5,
+					Username:    "admin",
+					Description: "invalid username or password",
+				}), ShouldBeNil)
+
+				buf := make([]byte, 1024)
+				conn.SetReadDeadline(time.Now().Add(time.Second))
+				n, _, err := conn.ReadFrom(buf)
+				So(err, ShouldBeNil)
+
+				msg := string(buf[:n])
+				// LOG_AUTH | LOG_NOTICE
+				So(strings.HasPrefix(msg, "<37>"), ShouldBeTrue)
+				So(msg, ShouldContainSubstring, "CEF:0|LoRa Server project|LoRa App Server|3.0.0|failed_login|failed login|5|")
+				So(msg, ShouldContainSubstring, "suser=admin")
+			})
+		})
+	})
+}
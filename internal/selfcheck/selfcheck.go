@@ -0,0 +1,376 @@
+// Package selfcheck implements the validation of the configuration and of
+// the connectivity to the external services (PostgreSQL, Redis, the
+// network-servers and the MQTT broker), so that configuration changes can be
+// validated before (re)starting LoRa App Server.
+package selfcheck
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lora-app-server/internal/certutil"
+	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/dbmigrate"
+	"github.com/brocaar/lora-app-server/internal/handler/mqtthandler"
+	"github.com/brocaar/lora-app-server/internal/netutil"
+	"github.com/brocaar/lora-app-server/internal/nsclient"
+	"github.com/brocaar/lora-app-server/internal/proxy"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	lscommon "github.com/brocaar/loraserver/api/common"
+)
+
+// Result statuses.
+const (
+	OK      = "ok"
+	Warning = "warning"
+	Error   = "error"
+)
+
+// Timeout defines the timeout of each connectivity check.
+var Timeout = 5 * time.Second
+
+// CertificateExpiryWarning defines how long before the expiry of a
+// certificate a warning is reported.
+var CertificateExpiryWarning = 30 * 24 * time.Hour
+
+// Result contains the result of a single check.
+type Result struct {
+	Check   string
+	Status  string
+	Message string
+}
+
+// Report contains the results of all checks.
+type Report []Result
+
+// HasErrors returns true when one of the checks failed.
+func (r Report) HasErrors() bool {
+	for _, res := range r {
+		if res.Status == Error {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Report) add(check, status, format string, a ...interface{}) {
+	*r = append(*r, Result{
+		Check:   check,
+		Status:  status,
+		Message: fmt.Sprintf(format, a...),
+	})
+}
+
+func (r *Report) ok(check, format string, a ...interface{}) {
+	r.add(check, OK, format, a...)
+}
+
+func (r *Report) warning(check, format string, a ...interface{}) {
+	r.add(check, Warning, format, a...)
+}
+
+func (r *Report) error(check string, err error) {
+	r.add(check, Error, "%s", err)
+}
+
+// Run validates the configuration (config.C) and the connectivity to the
+// external services and returns the report.
+func Run() Report {
+	var r Report
+
+	checkConfiguration(&r)
+	checkKEKSet(&r)
+	checkStorageKEK(&r)
+	checkCertificates(&r)
+	db := checkPostgreSQL(&r)
+	checkRedis(&r)
+	checkNetworkServers(&r, db)
+	checkMQTT(&r)
+
+	if db != nil {
+		db.Close()
+	}
+
+	return r
+}
+
+func checkConfiguration(r *Report) {
+	var failed bool
+	fail := func(err error) {
+		r.error("configuration", err)
+		failed = true
+	}
+
+	if err := netutil.SetAddressFamily(config.C.General.AddressFamily); err != nil {
+		fail(err)
+	}
+
+	if _, err := netutil.ParseSocketMode(config.C.ApplicationServer.API.SocketMode); err != nil {
+		fail(errors.Wrap(err, "application_server.api.socket_mode"))
+	}
+
+	if _, err := netutil.ParseSocketMode(config.C.ApplicationServer.ExternalAPI.SocketMode); err != nil {
+		fail(errors.Wrap(err, "application_server.external_api.socket_mode"))
+	}
+
+	if config.C.ApplicationServer.ExternalAPI.JWTSecret == "" {
+		fail(errors.New("application_server.external_api.jwt_secret must be set"))
+	}
+
+	if err := proxy.Validate(config.C.ApplicationServer.Integration.Proxy.URL); err != nil {
+		fail(errors.Wrap(err, "application_server.integration.proxy.url"))
+	}
+
+	if config.C.ApplicationServer.Integration.Delivery.AtLeastOnce {
+		conf := config.C.ApplicationServer.Integration.MQTT
+		if conf.QOS == 0 || conf.CleanSession || conf.ClientID == "" {
+			fail(errors.New("at-least-once delivery requires mqtt qos 1 or 2, clean_session=false and a client_id"))
+		}
+	}
+
+	if config.C.NetworkServer.Mock.Enabled {
+		if _, ok := lscommon.Region_value[config.C.NetworkServer.Mock.Region]; !ok {
+			fail(errors.Errorf("network_server.mock.region: invalid region %s", config.C.NetworkServer.Mock.Region))
+		}
+	}
+
+	if !failed {
+		r.ok("configuration", "valid")
+	}
+}
+
+func checkKEKSet(r *Report) {
+	conf := config.C.JoinServer.KEK
+	labels := make(map[string]bool)
+	var failed bool
+
+	for _, k := range conf.Set {
+		if labels[k.Label] {
+			r.error("join_server.kek", errors.Errorf("duplicate kek label: %s", k.Label))
+			failed = true
+		}
+		labels[k.Label] = true
+
+		kek, err := hex.DecodeString(k.KEK)
+		if err != nil {
+			r.error("join_server.kek", errors.Wrapf(err, "kek %s: decode hex error", k.Label))
+			failed = true
+			continue
+		}
+
+		switch len(kek) {
+		case 16, 24, 32:
+		default:
+			r.error("join_server.kek", errors.Errorf("kek %s: expected 16, 24 or 32 bytes, got %d", k.Label, len(kek)))
+			failed = true
+		}
+	}
+
+	for _, l := range []struct {
+		name  string
+		label string
+	}{
+		{"as_kek_label", conf.ASKEKLabel},
+		{"storage_kek_label", conf.StorageKEKLabel},
+	} {
+		if l.label != "" && !labels[l.label] {
+			r.error("join_server.kek", errors.Errorf("%s: kek %s is not in the kek set", l.name, l.label))
+			failed = true
+		}
+	}
+
+	if conf.OrganizationDataKeys && conf.StorageKEKLabel == "" {
+		r.error("join_server.kek", errors.New("organization_data_keys requires the storage_kek_label to be set"))
+		failed = true
+	}
+
+	if !failed {
+		r.ok("join_server.kek", "%d keks", len(conf.Set))
+	}
+}
+
+func checkStorageKEK(r *Report) {
+	label := config.C.JoinServer.KEK.StorageKEKLabel
+	if label == "" {
+		r.warning("join_server.kek.storage_kek_label", "not set, keys and integration secrets are stored unencrypted")
+		return
+	}
+
+	r.ok("join_server.kek.storage_kek_label", "kek %s", label)
+}
+
+// certificate defines a configured certificate.
+type certificate struct {
+	name    string
+	caCert  string
+	tlsCert string
+	tlsKey  string
+}
+
+func checkCertificates(r *Report) {
+	certs := []certificate{
+		{
+			name:    "application_server.api",
+			caCert:  config.C.ApplicationServer.API.CACert,
+			tlsCert: config.C.ApplicationServer.API.TLSCert,
+			tlsKey:  config.C.ApplicationServer.API.TLSKey,
+		},
+		{
+			name:    "application_server.external_api",
+			tlsCert: config.C.ApplicationServer.ExternalAPI.TLSCert,
+			tlsKey:  config.C.ApplicationServer.ExternalAPI.TLSKey,
+		},
+		{
+			name:    "application_server.integration.mqtt",
+			caCert:  config.C.ApplicationServer.Integration.MQTT.CACert,
+			tlsCert: config.C.ApplicationServer.Integration.MQTT.TLSCert,
+			tlsKey:  config.C.ApplicationServer.Integration.MQTT.TLSKey,
+		},
+		{
+			name:    "join_server",
+			caCert:  config.C.JoinServer.CACert,
+			tlsCert: config.C.JoinServer.TLSCert,
+			tlsKey:  config.C.JoinServer.TLSKey,
+		},
+	}
+
+	for _, c := range certs {
+		if c.tlsCert != "" || c.tlsKey != "" {
+			if _, err := tls.LoadX509KeyPair(c.tlsCert, c.tlsKey); err != nil {
+				r.error(c.name+".tls_cert", errors.Wrap(err, "load key-pair error"))
+			} else {
+				expiry, err := certutil.GetFileExpiry(c.tlsCert)
+				checkCertificateExpiry(r, c.name+".tls_cert", expiry, err)
+			}
+		}
+
+		if c.caCert != "" {
+			expiry, err := certutil.GetFileExpiry(c.caCert)
+			checkCertificateExpiry(r, c.name+".ca_cert", expiry, err)
+		}
+	}
+}
+
+func checkCertificateExpiry(r *Report, check string, expiry time.Time, err error) {
+	if err != nil {
+		r.error(check, err)
+		return
+	}
+
+	switch {
+	case time.Now().After(expiry):
+		r.error(check, errors.Errorf("certificate expired at %s", expiry.Format(time.RFC3339)))
+	case time.Until(expiry) < CertificateExpiryWarning:
+		r.warning(check, "certificate expires at %s", expiry.Format(time.RFC3339))
+	default:
+		r.ok(check, "certificate expires at %s", expiry.Format(time.RFC3339))
+	}
+}
+
+func checkPostgreSQL(r *Report) *common.DBLogger {
+	db, err := sqlx.Open("postgres", config.C.PostgreSQL.DSN)
+	if err != nil {
+		r.error("postgresql", errors.Wrap(err, "database connection error"))
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), Timeout)
+	defer cancel()
+
+	if err := db.PingContext(ctx); err != nil {
+		r.error("postgresql", errors.Wrap(err, "ping database error"))
+		db.Close()
+		return nil
+	}
+
+	rep, err := dbmigrate.GetReport(db.DB)
+	if err != nil {
+		r.error("postgresql", err)
+		return &common.DBLogger{DB: db}
+	}
+
+	switch {
+	case len(rep.Unknown) != 0:
+		r.warning("postgresql", "connected, database has been migrated by a newer version")
+	case len(rep.Pending()) != 0 && !config.C.PostgreSQL.Automigrate:
+		r.warning("postgresql", "connected, %d pending migrations (automigrate is disabled)", len(rep.Pending()))
+	case len(rep.Pending()) != 0:
+		r.ok("postgresql", "connected, %d pending migrations", len(rep.Pending()))
+	default:
+		r.ok("postgresql", "connected")
+	}
+
+	return &common.DBLogger{DB: db}
+}
+
+func checkRedis(r *Report) {
+	p := storage.NewRedisPool(config.C.Redis.URL, 1, 0)
+	defer p.Close()
+
+	c := p.Get()
+	defer c.Close()
+
+	if _, err := c.Do("PING"); err != nil {
+		r.error("redis", errors.Wrap(err, "ping redis error"))
+		return
+	}
+
+	r.ok("redis", "connected")
+}
+
+func checkNetworkServers(r *Report, db *common.DBLogger) {
+	if config.C.NetworkServer.Mock.Enabled {
+		r.warning("network_server", "mock network-server enabled")
+		return
+	}
+
+	if db == nil {
+		r.add("network_server", Error, "skipped, postgresql is unavailable")
+		return
+	}
+
+	servers, err := storage.GetNetworkServers(db, 1000, 0)
+	if err != nil {
+		r.error("network_server", errors.Wrap(err, "get network-servers error"))
+		return
+	}
+
+	if len(servers) == 0 {
+		r.warning("network_server", "no network-servers configured")
+		return
+	}
+
+	pool := nsclient.NewPool(config.C.NetworkServer.Client)
+	for _, n := range servers {
+		check := fmt.Sprintf("network_server %s (%s)", n.Name, n.Server)
+
+		if n.TLSCert != "" {
+			expiry, err := certutil.GetExpiry([]byte(n.TLSCert))
+			checkCertificateExpiry(r, check+" tls_cert", expiry, err)
+		}
+
+		if _, err := pool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey)); err != nil {
+			r.error(check, err)
+			continue
+		}
+
+		r.ok(check, "connected")
+	}
+}
+
+func checkMQTT(r *Report) {
+	conf := config.C.ApplicationServer.Integration.MQTT
+	if err := mqtthandler.CheckConnection(conf, Timeout); err != nil {
+		r.error("application_server.integration.mqtt", err)
+		return
+	}
+
+	r.ok("application_server.integration.mqtt", "connected to %s", conf.Server)
+}
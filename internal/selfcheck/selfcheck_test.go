@@ -0,0 +1,126 @@
+package selfcheck
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+)
+
+func TestCheckKEKSet(t *testing.T) {
+	type kek struct {
+		Label string `mapstructure:"label"`
+		KEK   string `mapstructure:"kek"`
+	}
+
+	tests := []struct {
+		Name                 string
+		Set                  []kek
+		ASKEKLabel           string
+		OrganizationDataKeys bool
+		ExpectedResults      Report
+	}{
+		{
+			Name: "valid kek set",
+			Set: []kek{
+				{Label: "000000", KEK: "01020304050607080102030405060708"},
+			},
+			ASKEKLabel: "000000",
+			ExpectedResults: Report{
+				{Check: "join_server.kek", Status: OK, Message: "1 keks"},
+			},
+		},
+		{
+			Name: "invalid hex and length",
+			Set: []kek{
+				{Label: "000000", KEK: "zz"},
+				{Label: "000001", KEK: "0102"},
+			},
+			ExpectedResults: Report{
+				{Check: "join_server.kek", Status: Error, Message: "kek 000000: decode hex error: encoding/hex: invalid byte: U+007A 'z'"},
+				{Check: "join_server.kek", Status: Error, Message: "kek 000001: expected 16, 24 or 32 bytes, got 2"},
+			},
+		},
+		{
+			Name:       "as kek label not in set",
+			ASKEKLabel: "000000",
+			ExpectedResults: Report{
+				{Check: "join_server.kek", Status: Error, Message: "as_kek_label: kek 000000 is not in the kek set"},
+			},
+		},
+		{
+			Name:                 "organization data-keys without storage kek",
+			OrganizationDataKeys: true,
+			ExpectedResults: Report{
+				{Check: "join_server.kek", Status: Error, Message: "organization_data_keys requires the storage_kek_label to be set"},
+			},
+		},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			config.C.JoinServer.KEK.ASKEKLabel = tst.ASKEKLabel
+			config.C.JoinServer.KEK.OrganizationDataKeys = tst.OrganizationDataKeys
+			config.C.JoinServer.KEK.Set = nil
+			for _, k := range tst.Set {
+				config.C.JoinServer.KEK.Set = append(config.C.JoinServer.KEK.Set, k)
+			}
+
+			var r Report
+			checkKEKSet(&r)
+			assert.Equal(tst.ExpectedResults, r)
+		})
+	}
+}
+
+func TestCheckStorageKEK(t *testing.T) {
+	assert := require.New(t)
+	defer func() {
+		config.C.JoinServer.KEK.StorageKEKLabel = ""
+	}()
+
+	var r Report
+	config.C.JoinServer.KEK.StorageKEKLabel = ""
+	checkStorageKEK(&r)
+	assert.Equal(Report{
+		{Check: "join_server.kek.storage_kek_label", Status: Warning, Message: "not set, keys and integration secrets are stored unencrypted"},
+	}, r)
+
+	r = nil
+	config.C.JoinServer.KEK.StorageKEKLabel = "storage"
+	checkStorageKEK(&r)
+	assert.Equal(Report{
+		{Check: "join_server.kek.storage_kek_label", Status: OK, Message: "kek storage"},
+	}, r)
+}
+
+func TestCheckCertificateExpiry(t *testing.T) {
+	tests := []struct {
+		Name           string
+		Expiry         time.Time
+		Error          error
+		ExpectedStatus string
+	}{
+		{"valid", time.Now().Add(90 * 24 * time.Hour), nil, OK},
+		{"expires soon", time.Now().Add(24 * time.Hour), nil, Warning},
+		{"expired", time.Now().Add(-time.Hour), nil, Error},
+		{"invalid", time.Time{}, errors.New("no certificate found"), Error},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			var r Report
+			checkCertificateExpiry(&r, "test", tst.Expiry, tst.Error)
+			assert.Len(r, 1)
+			assert.Equal(tst.ExpectedStatus, r[0].Status)
+			assert.Equal(tst.ExpectedStatus == Error, r.HasErrors())
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Package signalstats records the received-signal statistics (RSSI and SNR)
+// of the gateways, aggregated per geohash cell of the location of the
+// transmitting device. These statistics are used for the per-gateway
+// coverage heatmap (e.g. for antenna alignment and coverage tuning).
+package signalstats
+
+import (
+	"math"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/loraserver/api/gw"
+	"github.com/brocaar/lorawan"
+)
+
+// MaxPrecision defines the max. geohash precision (length).
+const MaxPrecision = 12
+
+// earthRadius defines the (mean) earth radius in meters.
+const earthRadius = 6371000
+
+// geohashBase32 defines the geohash alphabet.
+const geohashBase32 = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// EncodeGeohash returns the geohash of the given precision (length) for the
+// given coordinates.
+func EncodeGeohash(lat, lon float64, precision int) string {
+	latRange := [2]float64{-90, 90}
+	lonRange := [2]float64{-180, 180}
+
+	out := make([]byte, 0, precision)
+	var bit, ch int
+	even := true
+
+	for len(out) < precision {
+		r, v := &latRange, lat
+		if even {
+			r, v = &lonRange, lon
+		}
+
+		mid := (r[0] + r[1]) / 2
+		ch <<= 1
+		if v >= mid {
+			ch |= 1
+			r[0] = mid
+		} else {
+			r[1] = mid
+		}
+
+		even = !even
+		bit++
+		if bit == 5 {
+			out = append(out, geohashBase32[ch])
+			bit, ch = 0, 0
+		}
+	}
+
+	return string(out)
+}
+
+// DecodeGeohash returns the coordinates of the center of the given geohash
+// cell.
+func DecodeGeohash(hash string) (float64, float64, error) {
+	latRange := [2]float64{-90, 90}
+	lonRange := [2]float64{-180, 180}
+	even := true
+
+	for i := 0; i < len(hash); i++ {
+		ch := -1
+		for j := 0; j < len(geohashBase32); j++ {
+			if geohashBase32[j] == hash[i] {
+				ch = j
+				break
+			}
+		}
+		if ch == -1 {
+			return 0, 0, errors.Errorf("invalid geohash character: %c", hash[i])
+		}
+
+		for mask := 16; mask != 0; mask >>= 1 {
+			r := &latRange
+			if even {
+				r = &lonRange
+			}
+
+			mid := (r[0] + r[1]) / 2
+			if ch&mask != 0 {
+				r[0] = mid
+			} else {
+				r[1] = mid
+			}
+			even = !even
+		}
+	}
+
+	return (latRange[0] + latRange[1]) / 2, (lonRange[0] + lonRange[1]) / 2, nil
+}
+
+// Distance returns the (great-circle) distance in meters between the given
+// coordinates.
+func Distance(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1, phi2 := toRadians(lat1), toRadians(lat2)
+	dPhi := toRadians(lat2 - lat1)
+	dLambda := toRadians(lon2 - lon1)
+
+	a := math.Sin(dPhi/2)*math.Sin(dPhi/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLambda/2)*math.Sin(dLambda/2)
+	return earthRadius * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// Bearing returns the (initial) bearing in degrees (0 - 360, clockwise
+// from north) from the first to the second coordinates.
+func Bearing(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1, phi2 := toRadians(lat1), toRadians(lat2)
+	dLambda := toRadians(lon2 - lon1)
+
+	y := math.Sin(dLambda) * math.Cos(phi2)
+	x := math.Cos(phi1)*math.Sin(phi2) - math.Sin(phi1)*math.Cos(phi2)*math.Cos(dLambda)
+	return math.Mod(math.Atan2(y, x)*180/math.Pi+360, 360)
+}
+
+// Record records the RSSI and SNR of the given uplink for each of the
+// receiving gateways, in the geohash cell of the given (device) location.
+// Gateways which are not known by LoRa App Server are ignored.
+func Record(db sqlx.Execer, lat, lon float64, precision int, rxInfo []*gw.UplinkRXInfo, t time.Time) error {
+	geohash := EncodeGeohash(lat, lon, precision)
+
+	for _, rx := range rxInfo {
+		if rx == nil {
+			continue
+		}
+
+		var mac lorawan.EUI64
+		copy(mac[:], rx.GatewayId)
+
+		err := storage.IncrementGatewaySignalStats(db, storage.GatewaySignalStats{
+			GatewayMAC:  mac,
+			Date:        t,
+			Geohash:     geohash,
+			UplinkCount: 1,
+			RSSISum:     float64(rx.Rssi),
+			RSSIMin:     int(rx.Rssi),
+			RSSIMax:     int(rx.Rssi),
+			SNRSum:      rx.LoraSnr,
+			SNRMin:      rx.LoraSnr,
+			SNRMax:      rx.LoraSnr,
+		})
+		if err != nil {
+			if errors.Cause(err) == storage.ErrDoesNotExist {
+				continue
+			}
+			return errors.Wrap(err, "increment gateway signal stats error")
+		}
+	}
+
+	return nil
+}
+
+func toRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
@@ -0,0 +1,51 @@
+package signalstats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeohash(t *testing.T) {
+	tests := []struct {
+		Name      string
+		Latitude  float64
+		Longitude float64
+		Precision int
+		Geohash   string
+	}{
+		{"precision 11", 57.64911, 10.40744, 11, "u4pruydqqvj"},
+		{"precision 5", 57.64911, 10.40744, 5, "u4pru"},
+		{"southern hemisphere", -25.382708, -49.265506, 9, "6gkzwgjzn"},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.Equal(tst.Geohash, EncodeGeohash(tst.Latitude, tst.Longitude, tst.Precision))
+
+			lat, lon, err := DecodeGeohash(tst.Geohash)
+			assert.NoError(err)
+			assert.Equal(tst.Geohash, EncodeGeohash(lat, lon, tst.Precision))
+		})
+	}
+
+	t.Run("Invalid character", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, _, err := DecodeGeohash("u4pa")
+		assert.Error(err)
+	})
+}
+
+func TestDistanceAndBearing(t *testing.T) {
+	assert := require.New(t)
+
+	// one degree of latitude is ~111km
+	assert.InDelta(111195, Distance(52, 5, 53, 5), 1)
+	assert.InDelta(0, Bearing(52, 5, 53, 5), 0.001)
+	assert.InDelta(180, Bearing(53, 5, 52, 5), 0.001)
+	assert.InDelta(90, Bearing(0, 5, 0, 6), 0.001)
+	assert.InDelta(270, Bearing(0, 6, 0, 5), 0.001)
+}
@@ -0,0 +1,154 @@
+package simulator
+
+import (
+	"crypto/aes"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+)
+
+// Device defines a simulated (OTAA) device.
+type Device struct {
+	DevEUI  lorawan.EUI64
+	JoinEUI lorawan.EUI64
+	NwkKey  lorawan.AES128Key
+	AppKey  lorawan.AES128Key
+
+	// MACVersion defines the LoRaWAN version of the device (e.g. 1.0.2).
+	// For LoRaWAN 1.1 devices, the AppSKey is derived from the AppKey.
+	MACVersion string
+
+	devNonce lorawan.DevNonce
+	devAddr  lorawan.DevAddr
+	appSKey  lorawan.AES128Key
+	fCnt     uint32
+	joined   bool
+}
+
+// Joined returns true when the device has been activated.
+func (d *Device) Joined() bool {
+	return d.joined
+}
+
+// DevAddr returns the device address assigned on activation.
+func (d *Device) DevAddr() lorawan.DevAddr {
+	return d.devAddr
+}
+
+// joinRequest returns a new join-request PHYPayload. Each join-request uses
+// a new DevNonce.
+func (d *Device) joinRequest() (lorawan.PHYPayload, error) {
+	d.devNonce++
+
+	phy := lorawan.PHYPayload{
+		MHDR: lorawan.MHDR{
+			MType: lorawan.JoinRequest,
+			Major: lorawan.LoRaWANR1,
+		},
+		MACPayload: &lorawan.JoinRequestPayload{
+			DevEUI:   d.DevEUI,
+			JoinEUI:  d.JoinEUI,
+			DevNonce: d.devNonce,
+		},
+	}
+
+	if err := phy.SetUplinkJoinMIC(d.NwkKey); err != nil {
+		return phy, errors.Wrap(err, "set uplink join mic error")
+	}
+
+	return phy, nil
+}
+
+// handleJoinAccept decrypts the given join-accept and derives the AppSKey
+// the same way as a real device would do.
+func (d *Device) handleJoinAccept(b []byte, devAddr lorawan.DevAddr) error {
+	var phy lorawan.PHYPayload
+	if err := phy.UnmarshalBinary(b); err != nil {
+		return errors.Wrap(err, "unmarshal join-accept error")
+	}
+
+	if err := phy.DecryptJoinAcceptPayload(d.NwkKey); err != nil {
+		return errors.Wrap(err, "decrypt join-accept error")
+	}
+
+	jaPL, ok := phy.MACPayload.(*lorawan.JoinAcceptPayload)
+	if !ok {
+		return fmt.Errorf("expected *lorawan.JoinAcceptPayload, got %T", phy.MACPayload)
+	}
+
+	appSKey, err := getAppSKey(jaPL.DLSettings.OptNeg, d.NwkKey, d.AppKey, jaPL.HomeNetID, d.JoinEUI, jaPL.JoinNonce, d.devNonce)
+	if err != nil {
+		return errors.Wrap(err, "get AppSKey error")
+	}
+
+	d.devAddr = devAddr
+	d.appSKey = appSKey
+	d.fCnt = 0
+	d.joined = true
+
+	return nil
+}
+
+// uplink returns the encrypted FRMPayload for the given data and the
+// frame-counter used, after which the frame-counter is incremented.
+func (d *Device) uplink(data []byte) ([]byte, uint32, error) {
+	fCnt := d.fCnt
+
+	b, err := lorawan.EncryptFRMPayload(d.appSKey, true, d.devAddr, fCnt, data)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "encrypt frmpayload error")
+	}
+
+	d.fCnt++
+	return b, fCnt, nil
+}
+
+// getAppSKey derives the AppSKey. For LoRaWAN 1.0.x this is derived from
+// the NwkKey and NetID, for LoRaWAN 1.1 from the AppKey and JoinEUI.
+func getAppSKey(optNeg bool, nwkKey, appKey lorawan.AES128Key, netID lorawan.NetID, joinEUI lorawan.EUI64, joinNonce lorawan.JoinNonce, devNonce lorawan.DevNonce) (lorawan.AES128Key, error) {
+	var key lorawan.AES128Key
+	b := make([]byte, 16)
+	b[0] = 0x02
+
+	joinNonceB, err := joinNonce.MarshalBinary()
+	if err != nil {
+		return key, errors.Wrap(err, "marshal binary error")
+	}
+
+	devNonceB, err := devNonce.MarshalBinary()
+	if err != nil {
+		return key, errors.Wrap(err, "marshal binary error")
+	}
+
+	rootKey := nwkKey
+	copy(b[1:4], joinNonceB)
+
+	if optNeg {
+		joinEUIB, err := joinEUI.MarshalBinary()
+		if err != nil {
+			return key, errors.Wrap(err, "marshal binary error")
+		}
+
+		rootKey = appKey
+		copy(b[4:12], joinEUIB)
+		copy(b[12:14], devNonceB)
+	} else {
+		netIDB, err := netID.MarshalBinary()
+		if err != nil {
+			return key, errors.Wrap(err, "marshal binary error")
+		}
+
+		copy(b[4:7], netIDB)
+		copy(b[7:9], devNonceB)
+	}
+
+	block, err := aes.NewCipher(rootKey[:])
+	if err != nil {
+		return key, err
+	}
+	block.Encrypt(key[:], b)
+
+	return key, nil
+}
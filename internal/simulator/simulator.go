@@ -0,0 +1,261 @@
+// Package simulator implements a device simulator. It generates OTAA joins
+// and periodic uplinks for simulated devices and forwards these to the
+// join-server and application-server through a mock network-server, so that
+// codecs and integrations can be tested without hardware.
+package simulator
+
+import (
+	"crypto/rand"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+
+	"github.com/brocaar/loraserver/api/as"
+	"github.com/brocaar/loraserver/api/common"
+	"github.com/brocaar/loraserver/api/gw"
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/backend"
+)
+
+// JoinServer defines the join-server interface used by the mock
+// network-server.
+type JoinServer interface {
+	HandleJoinRequest(pl backend.JoinReqPayload) backend.JoinAnsPayload
+}
+
+// JoinServerFunc is an adapter to use an ordinary function as JoinServer.
+type JoinServerFunc func(pl backend.JoinReqPayload) backend.JoinAnsPayload
+
+// HandleJoinRequest calls f(pl).
+func (f JoinServerFunc) HandleJoinRequest(pl backend.JoinReqPayload) backend.JoinAnsPayload {
+	return f(pl)
+}
+
+// NetworkServer implements a mock network-server. It forwards the
+// join-requests of the simulated devices to the join-server and their
+// uplinks to the application-server.
+type NetworkServer struct {
+	NetID             lorawan.NetID
+	GatewayID         lorawan.EUI64
+	Frequency         int
+	DR                int
+	JoinServer        JoinServer
+	ApplicationServer as.ApplicationServerServiceServer
+
+	mu             sync.Mutex
+	transactionID  uint32
+	pendingAppSKey map[lorawan.EUI64]*common.KeyEnvelope
+}
+
+// Join performs an OTAA join for the given device.
+func (n *NetworkServer) Join(d *Device) error {
+	phy, err := d.joinRequest()
+	if err != nil {
+		return err
+	}
+
+	phyB, err := phy.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "marshal phypayload error")
+	}
+
+	var devAddr lorawan.DevAddr
+	if _, err := rand.Read(devAddr[:]); err != nil {
+		return errors.Wrap(err, "read random bytes error")
+	}
+	devAddr.SetAddrPrefix(n.NetID)
+
+	n.mu.Lock()
+	n.transactionID++
+	transactionID := n.transactionID
+	n.mu.Unlock()
+
+	ans := n.JoinServer.HandleJoinRequest(backend.JoinReqPayload{
+		BasePayload: backend.BasePayload{
+			ProtocolVersion: backend.ProtocolVersion1_0,
+			SenderID:        n.NetID.String(),
+			ReceiverID:      d.JoinEUI.String(),
+			TransactionID:   transactionID,
+			MessageType:     backend.JoinReq,
+		},
+		MACVersion: d.MACVersion,
+		PHYPayload: backend.HEXBytes(phyB),
+		DevEUI:     d.DevEUI,
+		DevAddr:    devAddr,
+		RxDelay:    1,
+	})
+	if ans.Result.ResultCode != backend.Success {
+		return fmt.Errorf("join-request failed: %s (%s)", ans.Result.ResultCode, ans.Result.Description)
+	}
+	if ans.AppSKey == nil {
+		return errors.New("join-answer does not contain AppSKey")
+	}
+
+	if err := d.handleJoinAccept(ans.PHYPayload[:], devAddr); err != nil {
+		return err
+	}
+
+	// like a real network-server, the AppSKey is forwarded to the
+	// application-server with the first uplink
+	n.mu.Lock()
+	if n.pendingAppSKey == nil {
+		n.pendingAppSKey = make(map[lorawan.EUI64]*common.KeyEnvelope)
+	}
+	n.pendingAppSKey[d.DevEUI] = &common.KeyEnvelope{
+		KekLabel: ans.AppSKey.KEKLabel,
+		AesKey:   ans.AppSKey.AESKey[:],
+	}
+	n.mu.Unlock()
+
+	return nil
+}
+
+// Uplink sends the given data as uplink for the given (activated) device.
+func (n *NetworkServer) Uplink(ctx context.Context, d *Device, fPort uint8, data []byte) error {
+	if !d.Joined() {
+		return errors.New("device has not been activated")
+	}
+
+	b, fCnt, err := d.uplink(data)
+	if err != nil {
+		return err
+	}
+
+	req := as.HandleUplinkDataRequest{
+		DevEui:  d.DevEUI[:],
+		JoinEui: d.JoinEUI[:],
+		FCnt:    fCnt,
+		FPort:   uint32(fPort),
+		Dr:      uint32(n.DR),
+		TxInfo: &gw.UplinkTXInfo{
+			Frequency: uint32(n.Frequency),
+		},
+		RxInfo: []*gw.UplinkRXInfo{
+			{
+				GatewayId: n.GatewayID[:],
+				Rssi:      -60,
+				LoraSnr:   7,
+			},
+		},
+		Data: b,
+	}
+
+	n.mu.Lock()
+	if ke, ok := n.pendingAppSKey[d.DevEUI]; ok {
+		req.DeviceActivationContext = &as.DeviceActivationContext{
+			DevAddr: d.devAddr[:],
+			AppSKey: ke,
+		}
+		delete(n.pendingAppSKey, d.DevEUI)
+	}
+	n.mu.Unlock()
+
+	if _, err := n.ApplicationServer.HandleUplinkData(ctx, &req); err != nil {
+		return errors.Wrap(err, "handle uplink data error")
+	}
+
+	return nil
+}
+
+// Config defines the simulation configuration.
+type Config struct {
+	// Interval defines the interval between uplinks.
+	Interval time.Duration
+
+	// UplinkCount defines the number of uplinks per device (0 = unlimited).
+	UplinkCount int
+
+	// FPort defines the FPort used for uplinks.
+	FPort uint8
+
+	// Payload defines the uplink payload. When not set, a random payload of
+	// PayloadSize bytes is generated for each uplink.
+	Payload     []byte
+	PayloadSize int
+}
+
+// Run simulates the given devices until the context has been cancelled or
+// all devices have sent the configured number of uplinks. Each device first
+// performs an OTAA join. The first uplink of each device is spread over the
+// interval to avoid a burst of uplinks.
+func Run(ctx context.Context, n *NetworkServer, devices []*Device, conf Config) error {
+	if conf.Interval <= 0 {
+		return errors.New("interval must be greater than 0")
+	}
+
+	var wg sync.WaitGroup
+
+	for i, d := range devices {
+		wg.Add(1)
+		delay := conf.Interval / time.Duration(len(devices)) * time.Duration(i)
+
+		go func(d *Device, delay time.Duration) {
+			defer wg.Done()
+			runDevice(ctx, n, d, conf, delay)
+		}(d, delay)
+	}
+
+	wg.Wait()
+	return nil
+}
+
+func runDevice(ctx context.Context, n *NetworkServer, d *Device, conf Config, delay time.Duration) {
+	logger := log.WithField("dev_eui", d.DevEUI)
+
+	select {
+	case <-ctx.Done():
+		return
+	case <-time.After(delay):
+	}
+
+	for !d.Joined() {
+		if err := n.Join(d); err != nil {
+			logger.WithError(err).Error("simulator: join error")
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(conf.Interval):
+			}
+			continue
+		}
+
+		logger.WithField("dev_addr", d.DevAddr()).Info("simulator: device joined")
+	}
+
+	ticker := time.NewTicker(conf.Interval)
+	defer ticker.Stop()
+
+	for i := 0; conf.UplinkCount == 0 || i < conf.UplinkCount; i++ {
+		data := conf.Payload
+		if data == nil {
+			data = make([]byte, conf.PayloadSize)
+			if _, err := rand.Read(data); err != nil {
+				logger.WithError(err).Error("simulator: read random bytes error")
+			}
+		}
+
+		if err := n.Uplink(ctx, d, conf.FPort, data); err != nil {
+			logger.WithError(err).Error("simulator: uplink error")
+		} else {
+			logger.WithFields(log.Fields{
+				"f_cnt":  d.fCnt - 1,
+				"f_port": conf.FPort,
+			}).Info("simulator: uplink sent")
+		}
+
+		if conf.UplinkCount != 0 && i == conf.UplinkCount-1 {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
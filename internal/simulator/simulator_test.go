@@ -0,0 +1,178 @@
+package simulator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+
+	"github.com/brocaar/lora-app-server/internal/api"
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/join"
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lora-app-server/internal/test/testhandler"
+	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
+)
+
+func TestSimulator(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL, 10, 0)
+
+	Convey("Given a clean database with an OTAA device", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+		test.MustFlushRedis(config.C.Redis.Pool)
+
+		nsClient := test.NewNetworkServerClient()
+		config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+		h := testhandler.NewTestHandler()
+		config.C.ApplicationServer.Integration.Handler = h
+		config.C.JoinServer.KEK.ASKEKLabel = ""
+		config.C.JoinServer.KEK.Set = nil
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			Name:            "test-sp",
+		}
+		So(storage.CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+		spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+		So(err, ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			Name:            "test-dp",
+			DeviceProfile: ns.DeviceProfile{
+				SupportsJoin: true,
+			},
+		}
+		So(storage.CreateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+		dpID, err := uuid.FromBytes(dp.DeviceProfile.Id)
+		So(err, ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: spID,
+			Name:             "test-app",
+		}
+		So(storage.CreateApplication(config.C.PostgreSQL.DB, &app), ShouldBeNil)
+
+		d := Device{
+			DevEUI:     lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			JoinEUI:    lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+			NwkKey:     lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+			MACVersion: "1.0.2",
+		}
+
+		So(storage.CreateDevice(config.C.PostgreSQL.DB, &storage.Device{
+			DevEUI:          d.DevEUI,
+			ApplicationID:   app.ID,
+			DeviceProfileID: dpID,
+			Name:            "test-device",
+		}), ShouldBeNil)
+		So(storage.CreateDeviceKeys(config.C.PostgreSQL.DB, &storage.DeviceKeys{
+			DevEUI: d.DevEUI,
+			NwkKey: d.NwkKey,
+		}), ShouldBeNil)
+
+		mockNS := NetworkServer{
+			NetID:             lorawan.NetID{1, 2, 3},
+			Frequency:         868100000,
+			JoinServer:        JoinServerFunc(join.HandleJoinRequest),
+			ApplicationServer: api.NewApplicationServerAPI(),
+		}
+
+		Convey("Then Uplink returns an error before the device has joined", func() {
+			So(mockNS.Uplink(context.Background(), &d, 10, []byte{1, 2, 3}), ShouldNotBeNil)
+		})
+
+		Convey("When the device joins", func() {
+			So(mockNS.Join(&d), ShouldBeNil)
+
+			Convey("Then the device has been activated", func() {
+				So(d.Joined(), ShouldBeTrue)
+				So(d.DevAddr().IsNetID(mockNS.NetID), ShouldBeTrue)
+			})
+
+			Convey("When the device sends an uplink", func() {
+				So(mockNS.Uplink(context.Background(), &d, 10, []byte{1, 2, 3}), ShouldBeNil)
+
+				Convey("Then a join notification was sent", func() {
+					pl := <-h.SendJoinNotificationChan
+					So(pl.DevEUI, ShouldEqual, d.DevEUI)
+					So(pl.DevAddr, ShouldEqual, d.DevAddr())
+				})
+
+				Convey("Then the decrypted uplink was sent", func() {
+					pl := <-h.SendDataUpChan
+					So(pl.DevEUI, ShouldEqual, d.DevEUI)
+					So(pl.FCnt, ShouldEqual, 0)
+					So(pl.FPort, ShouldEqual, 10)
+					So(pl.Data, ShouldResemble, []byte{1, 2, 3})
+				})
+			})
+
+			Convey("Then a second join uses a new DevNonce", func() {
+				So(mockNS.Join(&d), ShouldBeNil)
+			})
+		})
+
+		Convey("Then Run joins the device and sends the configured uplinks", func() {
+			err := Run(context.Background(), &mockNS, []*Device{&d}, Config{
+				Interval:    time.Millisecond,
+				UplinkCount: 2,
+				FPort:       1,
+				Payload:     []byte{4, 5, 6},
+			})
+			So(err, ShouldBeNil)
+			So(h.SendDataUpChan, ShouldHaveLength, 2)
+		})
+	})
+}
+
+func TestGetAppSKey(t *testing.T) {
+	Convey("Given a NwkKey, AppKey, NetID, JoinEUI, JoinNonce and DevNonce", t, func() {
+		nwkKey := lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+		appKey := lorawan.AES128Key{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1}
+		netID := lorawan.NetID{1, 2, 3}
+		joinEUI := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+
+		Convey("Then the LoRaWAN 1.0 AppSKey is derived from the NwkKey", func() {
+			k1, err := getAppSKey(false, nwkKey, appKey, netID, joinEUI, 65536, 258)
+			So(err, ShouldBeNil)
+			k2, err := getAppSKey(false, nwkKey, lorawan.AES128Key{}, netID, joinEUI, 65536, 258)
+			So(err, ShouldBeNil)
+			So(k1, ShouldEqual, k2)
+		})
+
+		Convey("Then the LoRaWAN 1.1 AppSKey is derived from the AppKey", func() {
+			k1, err := getAppSKey(true, nwkKey, appKey, netID, joinEUI, 65536, 258)
+			So(err, ShouldBeNil)
+			k2, err := getAppSKey(true, lorawan.AES128Key{}, appKey, netID, joinEUI, 65536, 258)
+			So(err, ShouldBeNil)
+			So(k1, ShouldEqual, k2)
+		})
+	})
+}
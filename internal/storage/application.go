@@ -1,10 +1,17 @@
 package storage
 
 import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"strings"
+	"text/template"
+	"time"
 
 	"github.com/brocaar/lora-app-server/internal/codec"
+	"github.com/brocaar/lorawan"
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 	uuid "github.com/gofrs/uuid"
@@ -16,6 +23,8 @@ var applicationNameRegexp = regexp.MustCompile(`^[\w-]+$`)
 // Application represents an application.
 type Application struct {
 	ID                   int64      `db:"id"`
+	CreatedAt            time.Time  `db:"created_at"`
+	UpdatedAt            time.Time  `db:"updated_at"`
 	Name                 string     `db:"name"`
 	Description          string     `db:"description"`
 	OrganizationID       int64      `db:"organization_id"`
@@ -23,6 +32,109 @@ type Application struct {
 	PayloadCodec         codec.Type `db:"payload_codec"`
 	PayloadEncoderScript string     `db:"payload_encoder_script"`
 	PayloadDecoderScript string     `db:"payload_decoder_script"`
+
+	// GeolocationBufferFrames defines the number of frames to buffer before
+	// resolving the device location (0 or 1 resolves on every frame).
+	GeolocationBufferFrames int `db:"geolocation_buffer_frames"`
+
+	// GeolocationMinInterval defines the minimum interval (in seconds)
+	// between two location resolves of a device (0 means no limit).
+	GeolocationMinInterval int `db:"geolocation_min_interval"`
+
+	// DisableOrganizationIntegrations disables the integrations inherited
+	// from the organization.
+	DisableOrganizationIntegrations bool `db:"disable_organization_integrations"`
+
+	// FieldMappings holds the mapping rules (e.g. rename, scale, unit
+	// conversion) which are applied to the decoded object.
+	FieldMappings codec.FieldMappings `db:"field_mappings"`
+
+	// UplinkFilterScript holds the (optional) script which is evaluated
+	// before an uplink is sent to the integrations.
+	UplinkFilterScript string `db:"uplink_filter_script"`
+
+	// MQTTTopicTemplates holds the (optional) MQTT topic templates which
+	// override the globally configured topic templates.
+	MQTTTopicTemplates ApplicationMQTTTopicTemplates `db:"mqtt_topic_templates"`
+
+	// ArchivedAt holds the timestamp at which the application was archived
+	// (nil when the application is not archived).
+	ArchivedAt *time.Time `db:"archived_at"`
+
+	// LegalHoldAt holds the timestamp at which the application was placed
+	// under legal hold (nil when the application is not under legal hold).
+	LegalHoldAt *time.Time `db:"legal_hold_at"`
+}
+
+// IsArchived returns true when the application is archived. The devices of
+// an archived application do not generate integration traffic.
+func (a Application) IsArchived() bool {
+	return a.ArchivedAt != nil
+}
+
+// HasLegalHold returns true when the application is under legal hold.
+func (a Application) HasLegalHold() bool {
+	return a.LegalHoldAt != nil
+}
+
+// ApplicationMQTTTopicTemplates defines the MQTT topic templates of an
+// application. Empty templates fall back to the globally configured topic
+// templates.
+type ApplicationMQTTTopicTemplates struct {
+	Uplink   string `json:"uplink,omitempty"`
+	Downlink string `json:"downlink,omitempty"`
+	Join     string `json:"join,omitempty"`
+	Ack      string `json:"ack,omitempty"`
+	Error    string `json:"error,omitempty"`
+	Status   string `json:"status,omitempty"`
+	Location string `json:"location,omitempty"`
+}
+
+// Validate validates the topic templates. As the application ID is known
+// for application specific topics, only the downlink topic template must
+// contain the DevEUI.
+func (t ApplicationMQTTTopicTemplates) Validate() error {
+	data := struct {
+		ApplicationID int64
+		DevEUI        lorawan.EUI64
+	}{}
+
+	for _, s := range []string{t.Uplink, t.Downlink, t.Join, t.Ack, t.Error, t.Status, t.Location} {
+		if s == "" {
+			continue
+		}
+
+		tmpl, err := template.New("topic").Parse(s)
+		if err != nil {
+			return err
+		}
+		if err := tmpl.Execute(bytes.NewBuffer(nil), data); err != nil {
+			return err
+		}
+		if strings.ContainsAny(s, "+#") {
+			return errors.New("topic template must not contain wildcards")
+		}
+	}
+
+	if t.Downlink != "" && !strings.Contains(t.Downlink, ".DevEUI") {
+		return errors.New("downlink topic template must contain the DevEUI")
+	}
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (t ApplicationMQTTTopicTemplates) Value() (driver.Value, error) {
+	return json.Marshal(t)
+}
+
+// Scan implements the sql.Scanner interface.
+func (t *ApplicationMQTTTopicTemplates) Scan(src interface{}) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("expected []byte, got %T", src)
+	}
+	return json.Unmarshal(b, t)
 }
 
 // ApplicationListItem devices the application as a list item.
@@ -37,6 +149,18 @@ func (a Application) Validate() error {
 		return ErrApplicationInvalidName
 	}
 
+	if a.GeolocationBufferFrames < 0 || a.GeolocationMinInterval < 0 {
+		return ErrApplicationInvalidGeolocationSettings
+	}
+
+	if err := a.FieldMappings.Validate(); err != nil {
+		return errors.Wrap(ErrApplicationInvalidFieldMappings, err.Error())
+	}
+
+	if err := a.MQTTTopicTemplates.Validate(); err != nil {
+		return errors.Wrap(ErrApplicationInvalidMQTTTopicTemplates, err.Error())
+	}
+
 	return nil
 }
 
@@ -46,16 +170,30 @@ func CreateApplication(db sqlx.Queryer, item *Application) error {
 		return errors.Wrap(err, "validate error")
 	}
 
+	now := time.Now()
+	item.CreatedAt = now
+	item.UpdatedAt = now
+
 	err := sqlx.Get(db, &item.ID, `
 		insert into application (
+			created_at,
+			updated_at,
 			name,
 			description,
 			organization_id,
 			service_profile_id,
 			payload_codec,
 			payload_encoder_script,
-			payload_decoder_script
-		) values ($1, $2, $3, $4, $5, $6, $7) returning id`,
+			payload_decoder_script,
+			geolocation_buffer_frames,
+			geolocation_min_interval,
+			disable_organization_integrations,
+			field_mappings,
+			uplink_filter_script,
+			mqtt_topic_templates
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15) returning id`,
+		item.CreatedAt,
+		item.UpdatedAt,
 		item.Name,
 		item.Description,
 		item.OrganizationID,
@@ -63,6 +201,12 @@ func CreateApplication(db sqlx.Queryer, item *Application) error {
 		item.PayloadCodec,
 		item.PayloadEncoderScript,
 		item.PayloadDecoderScript,
+		item.GeolocationBufferFrames,
+		item.GeolocationMinInterval,
+		item.DisableOrganizationIntegrations,
+		item.FieldMappings,
+		item.UplinkFilterScript,
+		item.MQTTTopicTemplates,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -77,9 +221,15 @@ func CreateApplication(db sqlx.Queryer, item *Application) error {
 }
 
 // GetApplication returns the Application for the given id.
-func GetApplication(db sqlx.Queryer, id int64) (Application, error) {
+// When forUpdate is set to true, then db must be a db transaction.
+func GetApplication(db sqlx.Queryer, id int64, forUpdate bool) (Application, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
 	var app Application
-	err := sqlx.Get(db, &app, "select * from application where id = $1", id)
+	err := sqlx.Get(db, &app, "select * from application where id = $1"+fu, id)
 	if err != nil {
 		return app, handlePSQLError(Select, err, "select error")
 	}
@@ -87,8 +237,38 @@ func GetApplication(db sqlx.Queryer, id int64) (Application, error) {
 	return app, nil
 }
 
+// GetApplicationMQTTDownlinkTopicTemplates returns the MQTT downlink topic
+// templates, by application ID, of the applications which have a downlink
+// topic template set.
+func GetApplicationMQTTDownlinkTopicTemplates(db sqlx.Queryer) (map[int64]string, error) {
+	var items []struct {
+		ID       int64  `db:"id"`
+		Template string `db:"template"`
+	}
+
+	err := sqlx.Select(db, &items, `
+		select
+			id,
+			mqtt_topic_templates->>'downlink' as template
+		from application
+		where
+			coalesce(mqtt_topic_templates->>'downlink', '') != ''`,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	out := make(map[int64]string, len(items))
+	for _, item := range items {
+		out[item.ID] = item.Template
+	}
+
+	return out, nil
+}
+
 // GetApplicationCount returns the total number of applications.
-func GetApplicationCount(db sqlx.Queryer, search string) (int, error) {
+// Archived applications are only counted when includeArchived is set.
+func GetApplicationCount(db sqlx.Queryer, search string, includeArchived bool) (int, error) {
 	var count int
 	if search != "" {
 		search = "%" + search + "%"
@@ -99,9 +279,13 @@ func GetApplicationCount(db sqlx.Queryer, search string) (int, error) {
 			count(*)
 		from application
 		where
-			$1 = ''
-			or ($1 != '' and name ilike $1)`,
+			($2 = true or archived_at is null)
+			and (
+				$1 = ''
+				or ($1 != '' and name ilike $1)
+			)`,
 		search,
+		includeArchived,
 	)
 	if err != nil {
 		return 0, errors.Wrap(err, "select error")
@@ -112,8 +296,9 @@ func GetApplicationCount(db sqlx.Queryer, search string) (int, error) {
 // GetApplicationCountForUser returns the total number of applications
 // available for the given user.
 // When an organizationID is given, the results will be filtered by this
-// organization ID.
-func GetApplicationCountForUser(db sqlx.Queryer, username string, organizationID int64, search string) (int, error) {
+// organization ID. Archived applications are only counted when
+// includeArchived is set.
+func GetApplicationCountForUser(db sqlx.Queryer, username string, organizationID int64, search string, includeArchived bool) (int, error) {
 	var count int
 	if search != "" {
 		search = "%" + search + "%"
@@ -123,9 +308,9 @@ func GetApplicationCountForUser(db sqlx.Queryer, username string, organizationID
 		select
 			count(a.*)
 		from application a
-		inner join organization_user ou
+		inner join principal_organization ou
 			on a.organization_id = ou.organization_id
-		inner join "user" u
+		inner join principal u
 			on u.id = ou.user_id
 		where
 			u.username = $1
@@ -138,7 +323,8 @@ func GetApplicationCountForUser(db sqlx.Queryer, username string, organizationID
 				$3 = ''
 				or ($3 != '' and a.name ilike $3)
 			)
-	`, username, organizationID, search)
+			and ($4 = true or a.archived_at is null)
+	`, username, organizationID, search, includeArchived)
 	if err != nil {
 		return 0, errors.Wrap(err, "select error")
 	}
@@ -146,8 +332,9 @@ func GetApplicationCountForUser(db sqlx.Queryer, username string, organizationID
 }
 
 // GetApplicationCountForOrganizationID returns the total number of
-// applications for the given organization.
-func GetApplicationCountForOrganizationID(db sqlx.Queryer, organizationID int64, search string) (int, error) {
+// applications for the given organization. Archived applications are only
+// counted when includeArchived is set.
+func GetApplicationCountForOrganizationID(db sqlx.Queryer, organizationID int64, search string, includeArchived bool) (int, error) {
 	var count int
 	if search != "" {
 		search = "%" + search + "%"
@@ -162,9 +349,11 @@ func GetApplicationCountForOrganizationID(db sqlx.Queryer, organizationID int64,
 			and (
 				$2 = ''
 				or ($2 != '' and name ilike $2)
-			)`,
+			)
+			and ($3 = true or archived_at is null)`,
 		organizationID,
 		search,
+		includeArchived,
 	)
 	if err != nil {
 		return 0, errors.Wrap(err, "select error")
@@ -173,8 +362,9 @@ func GetApplicationCountForOrganizationID(db sqlx.Queryer, organizationID int64,
 }
 
 // GetApplications returns a slice of applications, sorted by name and
-// respecting the given limit and offset.
-func GetApplications(db sqlx.Queryer, limit, offset int, search string) ([]ApplicationListItem, error) {
+// respecting the given limit and offset. Archived applications are only
+// returned when includeArchived is set.
+func GetApplications(db sqlx.Queryer, limit, offset int, search string, includeArchived bool) ([]ApplicationListItem, error) {
 	var apps []ApplicationListItem
 	if search != "" {
 		search = "%" + search + "%"
@@ -188,8 +378,11 @@ func GetApplications(db sqlx.Queryer, limit, offset int, search string) ([]Appli
 		inner join service_profile sp
 			on sp.service_profile_id = a.service_profile_id
 		where
-			$3 = ''
-			or ($3 != '' and a.name ilike $3)
+			($4 = true or a.archived_at is null)
+			and (
+				$3 = ''
+				or ($3 != '' and a.name ilike $3)
+			)
 		order by
 			name
 		limit $1
@@ -197,6 +390,7 @@ func GetApplications(db sqlx.Queryer, limit, offset int, search string) ([]Appli
 		limit,
 		offset,
 		search,
+		includeArchived,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "select error")
@@ -205,8 +399,9 @@ func GetApplications(db sqlx.Queryer, limit, offset int, search string) ([]Appli
 }
 
 // GetApplicationsForUser returns a slice of application of which the given
-// user is a member of.
-func GetApplicationsForUser(db sqlx.Queryer, username string, organizationID int64, limit, offset int, search string) ([]ApplicationListItem, error) {
+// user is a member of. Archived applications are only returned when
+// includeArchived is set.
+func GetApplicationsForUser(db sqlx.Queryer, username string, organizationID int64, limit, offset int, search string, includeArchived bool) ([]ApplicationListItem, error) {
 	var apps []ApplicationListItem
 	if search != "" {
 		search = "%" + search + "%"
@@ -219,9 +414,9 @@ func GetApplicationsForUser(db sqlx.Queryer, username string, organizationID int
 		from application a
 		inner join service_profile sp
 			on sp.service_profile_id = a.service_profile_id
-		inner join organization_user ou
+		inner join principal_organization ou
 			on a.organization_id = ou.organization_id
-		inner join "user" u
+		inner join principal u
 			on u.id = ou.user_id
 		where
 			u.username = $1
@@ -234,9 +429,10 @@ func GetApplicationsForUser(db sqlx.Queryer, username string, organizationID int
 				$5 = ''
 				or ($5 != '' and a.name ilike $5)
 			)
+			and ($6 = true or a.archived_at is null)
 		order by a.name
 		limit $3 offset $4
-	`, username, organizationID, limit, offset, search)
+	`, username, organizationID, limit, offset, search, includeArchived)
 	if err != nil {
 		return nil, errors.Wrap(err, "select error")
 	}
@@ -245,8 +441,9 @@ func GetApplicationsForUser(db sqlx.Queryer, username string, organizationID int
 }
 
 // GetApplicationsForOrganizationID returns a slice of applications for the given
-// organization.
-func GetApplicationsForOrganizationID(db sqlx.Queryer, organizationID int64, limit, offset int, search string) ([]ApplicationListItem, error) {
+// organization. Archived applications are only returned when includeArchived
+// is set.
+func GetApplicationsForOrganizationID(db sqlx.Queryer, organizationID int64, limit, offset int, search string, includeArchived bool) ([]ApplicationListItem, error) {
 	var apps []ApplicationListItem
 	if search != "" {
 		search = "%" + search + "%"
@@ -265,12 +462,14 @@ func GetApplicationsForOrganizationID(db sqlx.Queryer, organizationID int64, lim
 				$4 = ''
 				or ($4 != '' and a.name ilike $4)
 			)
+			and ($5 = true or a.archived_at is null)
 		order by a.name
 		limit $2 offset $3`,
 		organizationID,
 		limit,
 		offset,
 		search,
+		includeArchived,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "select error")
@@ -279,24 +478,58 @@ func GetApplicationsForOrganizationID(db sqlx.Queryer, organizationID int64, lim
 	return apps, nil
 }
 
+// GetApplicationListVersion returns the version of the list of applications.
+// When organizationID is 0, this covers the applications of all
+// organizations.
+func GetApplicationListVersion(db sqlx.Queryer, organizationID int64) (ListVersion, error) {
+	var v ListVersion
+	err := sqlx.Get(db, &v, `
+		select
+			count(a.*) as count,
+			greatest(max(a.updated_at), max(sp.updated_at)) as updated_at
+		from application a
+		inner join service_profile sp
+			on sp.service_profile_id = a.service_profile_id
+		where
+			$1 = 0
+			or a.organization_id = $1`,
+		organizationID,
+	)
+	if err != nil {
+		return v, errors.Wrap(err, "select error")
+	}
+
+	return v, nil
+}
+
 // UpdateApplication updates the given Application.
 func UpdateApplication(db sqlx.Execer, item Application) error {
 	if err := item.Validate(); err != nil {
-		return fmt.Errorf("validate application error: %s", err)
+		return errors.Wrap(err, "validate error")
 	}
 
+	item.UpdatedAt = time.Now()
+
 	res, err := db.Exec(`
 		update application
 		set
-			name = $2,
-			description = $3,
-			organization_id = $4,
-			service_profile_id = $5,
-			payload_codec = $6,
-			payload_encoder_script = $7,
-			payload_decoder_script = $8
+			updated_at = $2,
+			name = $3,
+			description = $4,
+			organization_id = $5,
+			service_profile_id = $6,
+			payload_codec = $7,
+			payload_encoder_script = $8,
+			payload_decoder_script = $9,
+			geolocation_buffer_frames = $10,
+			geolocation_min_interval = $11,
+			disable_organization_integrations = $12,
+			field_mappings = $13,
+			uplink_filter_script = $14,
+			mqtt_topic_templates = $15
 		where id = $1`,
 		item.ID,
+		item.UpdatedAt,
 		item.Name,
 		item.Description,
 		item.OrganizationID,
@@ -304,6 +537,12 @@ func UpdateApplication(db sqlx.Execer, item Application) error {
 		item.PayloadCodec,
 		item.PayloadEncoderScript,
 		item.PayloadDecoderScript,
+		item.GeolocationBufferFrames,
+		item.GeolocationMinInterval,
+		item.DisableOrganizationIntegrations,
+		item.FieldMappings,
+		item.UplinkFilterScript,
+		item.MQTTTopicTemplates,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
@@ -324,8 +563,57 @@ func UpdateApplication(db sqlx.Execer, item Application) error {
 	return nil
 }
 
+// ArchiveApplication archives the Application matching the given ID.
+// Archiving an already archived application is a no-op.
+func ArchiveApplication(db sqlx.Execer, id int64) error {
+	return setApplicationArchived(db, id, true)
+}
+
+// UnarchiveApplication unarchives the Application matching the given ID.
+func UnarchiveApplication(db sqlx.Execer, id int64) error {
+	return setApplicationArchived(db, id, false)
+}
+
+func setApplicationArchived(db sqlx.Execer, id int64, archived bool) error {
+	now := time.Now()
+
+	// the archived_at timestamp of an already archived application is
+	// preserved
+	res, err := db.Exec(`
+		update application
+		set
+			updated_at = $2,
+			archived_at = case when $3 then coalesce(archived_at, $2) else null end
+		where id = $1`,
+		id,
+		now,
+		archived,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"id":       id,
+		"archived": archived,
+	}).Info("application archive state updated")
+
+	return nil
+}
+
 // DeleteApplication deletes the Application matching the given ID.
 func DeleteApplication(db sqlx.Ext, id int64) error {
+	if err := checkApplicationLegalHold(db, id); err != nil {
+		return err
+	}
+
 	err := DeleteAllDevicesForApplicationID(db, id)
 	if err != nil {
 		return errors.Wrap(err, "delete all nodes error")
@@ -0,0 +1,120 @@
+package storage
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// mqttPasswordSize defines the number of random bytes of a generated
+// application MQTT password.
+const mqttPasswordSize = 24
+
+// ApplicationMQTTCredentials defines the MQTT credentials of an application.
+// The MQTT broker authenticates the application using the username and
+// password hash (e.g. using the PostgreSQL backend of an auth plugin).
+type ApplicationMQTTCredentials struct {
+	ApplicationID int64     `db:"application_id"`
+	CreatedAt     time.Time `db:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at"`
+	Username      string    `db:"username"`
+	PasswordHash  string    `db:"password_hash"`
+}
+
+// GenerateApplicationMQTTCredentials generates a random password for the
+// given application, replacing the previous credentials. The username is
+// set to the application ID. It returns the credentials and the (plaintext)
+// password, which is only available at this point.
+func GenerateApplicationMQTTCredentials(db sqlx.Queryer, applicationID int64) (ApplicationMQTTCredentials, string, error) {
+	b := make([]byte, mqttPasswordSize)
+	if _, err := rand.Read(b); err != nil {
+		return ApplicationMQTTCredentials{}, "", errors.Wrap(err, "read random bytes error")
+	}
+	password := hex.EncodeToString(b)
+
+	pwHash, err := hash(password, saltSize, HashIterations)
+	if err != nil {
+		return ApplicationMQTTCredentials{}, "", err
+	}
+
+	now := time.Now()
+	c := ApplicationMQTTCredentials{
+		ApplicationID: applicationID,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		Username:      strconv.FormatInt(applicationID, 10),
+		PasswordHash:  pwHash,
+	}
+
+	err = sqlx.Get(db, &c.CreatedAt, `
+		insert into application_mqtt_credentials (
+			application_id,
+			created_at,
+			updated_at,
+			username,
+			password_hash
+		) values ($1, $2, $3, $4, $5)
+		on conflict (application_id) do update
+		set
+			updated_at = excluded.updated_at,
+			username = excluded.username,
+			password_hash = excluded.password_hash
+		returning created_at`,
+		c.ApplicationID,
+		c.CreatedAt,
+		c.UpdatedAt,
+		c.Username,
+		c.PasswordHash,
+	)
+	if err != nil {
+		return c, "", handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"application_id": applicationID,
+	}).Info("application mqtt credentials generated")
+
+	return c, password, nil
+}
+
+// GetApplicationMQTTCredentials returns the MQTT credentials of the given
+// application.
+func GetApplicationMQTTCredentials(db sqlx.Queryer, applicationID int64) (ApplicationMQTTCredentials, error) {
+	var c ApplicationMQTTCredentials
+	err := sqlx.Get(db, &c, "select * from application_mqtt_credentials where application_id = $1", applicationID)
+	if err != nil {
+		return c, handlePSQLError(Select, err, "select error")
+	}
+	return c, nil
+}
+
+// DeleteApplicationMQTTCredentials deletes (revokes) the MQTT credentials of
+// the given application.
+func DeleteApplicationMQTTCredentials(db sqlx.Execer, applicationID int64) error {
+	res, err := db.Exec("delete from application_mqtt_credentials where application_id = $1", applicationID)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("application_id", applicationID).Info("application mqtt credentials deleted")
+
+	return nil
+}
+
+// ValidatePassword returns true when the given password matches the
+// password hash of the credentials.
+func (c ApplicationMQTTCredentials) ValidatePassword(password string) bool {
+	return hashCompare(password, c.PasswordHash)
+}
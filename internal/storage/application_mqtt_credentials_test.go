@@ -0,0 +1,85 @@
+package storage
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func (ts *StorageTestSuite) TestApplicationMQTTCredentials() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	sp := ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+	app := Application{
+		Name:           "test-app",
+		OrganizationID: org.ID,
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	ts.T().Run("Get non-existing", func(t *testing.T) {
+		_, err := GetApplicationMQTTCredentials(ts.Tx(), app.ID)
+		require.Equal(t, ErrDoesNotExist, err)
+	})
+
+	ts.T().Run("Generate", func(t *testing.T) {
+		assert := require.New(t)
+
+		c, password, err := GenerateApplicationMQTTCredentials(ts.Tx(), app.ID)
+		assert.NoError(err)
+		assert.Equal(strconv.FormatInt(app.ID, 10), c.Username)
+		assert.Len(password, 48)
+
+		c, err = GetApplicationMQTTCredentials(ts.Tx(), app.ID)
+		assert.NoError(err)
+		assert.True(c.ValidatePassword(password))
+		assert.False(c.ValidatePassword("invalid"))
+
+		t.Run("Regenerate replaces the password", func(t *testing.T) {
+			assert := require.New(t)
+
+			_, password2, err := GenerateApplicationMQTTCredentials(ts.Tx(), app.ID)
+			assert.NoError(err)
+			assert.NotEqual(password, password2)
+
+			c, err := GetApplicationMQTTCredentials(ts.Tx(), app.ID)
+			assert.NoError(err)
+			assert.False(c.ValidatePassword(password))
+			assert.True(c.ValidatePassword(password2))
+		})
+
+		t.Run("Delete", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(DeleteApplicationMQTTCredentials(ts.Tx(), app.ID))
+			assert.Equal(ErrDoesNotExist, DeleteApplicationMQTTCredentials(ts.Tx(), app.ID))
+
+			_, err := GetApplicationMQTTCredentials(ts.Tx(), app.ID)
+			assert.Equal(ErrDoesNotExist, err)
+		})
+	})
+}
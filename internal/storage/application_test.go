@@ -2,6 +2,7 @@ package storage
 
 import (
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
 
@@ -70,15 +71,32 @@ func TestApplication(t *testing.T) {
 				PayloadDecoderScript: "Decode() {}",
 			}
 			So(CreateApplication(db, &app), ShouldBeNil)
+			app.CreatedAt = app.CreatedAt.UTC().Truncate(time.Millisecond)
+			app.UpdatedAt = app.UpdatedAt.UTC().Truncate(time.Millisecond)
 
 			Convey("It can be get by id", func() {
-				app2, err := GetApplication(db, app.ID)
+				app2, err := GetApplication(db, app.ID, false)
 				So(err, ShouldBeNil)
+				app2.CreatedAt = app2.CreatedAt.UTC().Truncate(time.Millisecond)
+				app2.UpdatedAt = app2.UpdatedAt.UTC().Truncate(time.Millisecond)
 				So(app2, ShouldResemble, app)
 			})
 
+			Convey("Then the application list version contains the application", func() {
+				v, err := GetApplicationListVersion(db, 0)
+				So(err, ShouldBeNil)
+				So(v.Count, ShouldEqual, 1)
+				So(v.UpdatedAt, ShouldNotBeNil)
+				So(v.UpdatedAt.Before(app.UpdatedAt), ShouldBeFalse)
+
+				v, err = GetApplicationListVersion(db, org.ID+1)
+				So(err, ShouldBeNil)
+				So(v.Count, ShouldEqual, 0)
+				So(v.UpdatedAt, ShouldBeNil)
+			})
+
 			Convey("Then get applications returns a single application", func() {
-				apps, err := GetApplications(db, 10, 0, "")
+				apps, err := GetApplications(db, 10, 0, "", false)
 				So(err, ShouldBeNil)
 				So(apps, ShouldHaveLength, 1)
 				So(apps[0].ID, ShouldEqual, app.ID)
@@ -87,19 +105,19 @@ func TestApplication(t *testing.T) {
 			})
 
 			Convey("Then get application count returns 1", func() {
-				count, err := GetApplicationCount(db, "")
+				count, err := GetApplicationCount(db, "", false)
 				So(err, ShouldBeNil)
 				So(count, ShouldEqual, 1)
 			})
 
 			Convey("Then the application count for the organization returns 1", func() {
-				count, err := GetApplicationCountForOrganizationID(db, org.ID, "")
+				count, err := GetApplicationCountForOrganizationID(db, org.ID, "", false)
 				So(err, ShouldBeNil)
 				So(count, ShouldEqual, 1)
 			})
 
 			Convey("Then listing the applications for the organization returns the expected application", func() {
-				apps, err := GetApplicationsForOrganizationID(db, org.ID, 10, 0, "")
+				apps, err := GetApplicationsForOrganizationID(db, org.ID, 10, 0, "", false)
 				So(err, ShouldBeNil)
 				So(apps, ShouldHaveLength, 1)
 				So(apps[0].ID, ShouldEqual, app.ID)
@@ -108,20 +126,128 @@ func TestApplication(t *testing.T) {
 
 			Convey("When updating the application", func() {
 				app.Description = "some new description"
+				app.GeolocationBufferFrames = 3
+				app.GeolocationMinInterval = 60
 				So(UpdateApplication(db, app), ShouldBeNil)
 
 				Convey("Then the application has been updated", func() {
-					app2, err := GetApplication(db, app.ID)
+					app2, err := GetApplication(db, app.ID, false)
 					So(err, ShouldBeNil)
+					app2.CreatedAt = app2.CreatedAt.UTC().Truncate(time.Millisecond)
+					app2.UpdatedAt = app2.UpdatedAt.UTC().Truncate(time.Millisecond)
+					So(app2.UpdatedAt.Before(app.UpdatedAt), ShouldBeFalse)
+
+					app.UpdatedAt = app2.UpdatedAt
 					So(app2, ShouldResemble, app)
 				})
 			})
 
+			Convey("When updating the application with a negative geolocation min interval", func() {
+				app.GeolocationMinInterval = -1
+				err := UpdateApplication(db, app)
+
+				Convey("Then ErrApplicationInvalidGeolocationSettings is returned", func() {
+					So(errors.Cause(err), ShouldEqual, ErrApplicationInvalidGeolocationSettings)
+				})
+			})
+
+			Convey("When updating the application with invalid mqtt topic templates", func() {
+				for _, templates := range []ApplicationMQTTTopicTemplates{
+					{Uplink: "tenant/{{ .DevEUI }"},
+					{Uplink: "tenant/{{ .Foo }}/rx"},
+					{Uplink: "tenant/+/rx"},
+					{Downlink: "tenant/{{ .ApplicationID }}/tx"},
+				} {
+					app.MQTTTopicTemplates = templates
+					So(errors.Cause(UpdateApplication(db, app)), ShouldEqual, ErrApplicationInvalidMQTTTopicTemplates)
+				}
+			})
+
+			Convey("When updating the application with mqtt topic templates", func() {
+				app.MQTTTopicTemplates = ApplicationMQTTTopicTemplates{
+					Uplink:   "tenant/{{ .DevEUI }}/rx",
+					Downlink: "tenant/{{ .DevEUI }}/tx",
+				}
+				So(UpdateApplication(db, app), ShouldBeNil)
+
+				Convey("Then the topic templates have been stored", func() {
+					app2, err := GetApplication(db, app.ID, false)
+					So(err, ShouldBeNil)
+					So(app2.MQTTTopicTemplates, ShouldResemble, app.MQTTTopicTemplates)
+				})
+
+				Convey("Then the downlink topic template is returned", func() {
+					templates, err := GetApplicationMQTTDownlinkTopicTemplates(db)
+					So(err, ShouldBeNil)
+					So(templates, ShouldResemble, map[int64]string{
+						app.ID: "tenant/{{ .DevEUI }}/tx",
+					})
+				})
+			})
+
+			Convey("When archiving the application", func() {
+				So(ArchiveApplication(db, app.ID), ShouldBeNil)
+
+				Convey("Then the application is archived", func() {
+					app2, err := GetApplication(db, app.ID, false)
+					So(err, ShouldBeNil)
+					So(app2.IsArchived(), ShouldBeTrue)
+				})
+
+				Convey("Then the application is hidden from the default listings", func() {
+					apps, err := GetApplications(db, 10, 0, "", false)
+					So(err, ShouldBeNil)
+					So(apps, ShouldHaveLength, 0)
+
+					apps, err = GetApplicationsForOrganizationID(db, org.ID, 10, 0, "", false)
+					So(err, ShouldBeNil)
+					So(apps, ShouldHaveLength, 0)
+
+					count, err := GetApplicationCountForOrganizationID(db, org.ID, "", false)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 0)
+				})
+
+				Convey("Then the application is listed when including archived applications", func() {
+					apps, err := GetApplications(db, 10, 0, "", true)
+					So(err, ShouldBeNil)
+					So(apps, ShouldHaveLength, 1)
+					So(apps[0].IsArchived(), ShouldBeTrue)
+
+					count, err := GetApplicationCount(db, "", true)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 1)
+				})
+
+				Convey("Then updating the application does not unarchive it", func() {
+					app.Description = "some new description"
+					So(UpdateApplication(db, app), ShouldBeNil)
+
+					app2, err := GetApplication(db, app.ID, false)
+					So(err, ShouldBeNil)
+					So(app2.IsArchived(), ShouldBeTrue)
+				})
+
+				Convey("When unarchiving the application", func() {
+					So(UnarchiveApplication(db, app.ID), ShouldBeNil)
+
+					Convey("Then the application is listed again", func() {
+						app2, err := GetApplication(db, app.ID, false)
+						So(err, ShouldBeNil)
+						So(app2.IsArchived(), ShouldBeFalse)
+
+						count, err := GetApplicationCount(db, "", false)
+						So(err, ShouldBeNil)
+						So(count, ShouldEqual, 1)
+					})
+				})
+			})
+
 			Convey("When deleting the application", func() {
 				So(DeleteApplication(db, app.ID), ShouldBeNil)
 
 				Convey("Then the application count returns 0", func() {
-					count, err := GetApplicationCount(db, "")
+					count, err := GetApplicationCount(db, "", false)
 					So(err, ShouldBeNil)
 					So(count, ShouldEqual, 0)
 				})
@@ -0,0 +1,359 @@
+package storage
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// erasedUsernamePrefix defines the prefix of the pseudonym replacing the
+// username of an erased user.
+const erasedUsernamePrefix = "erased-"
+
+// DeviceDataExport contains all data stored for a device (data-subject
+// access request).
+type DeviceDataExport struct {
+	ExportedAt     time.Time
+	Device         Device
+	Keys           *DeviceKeys
+	Activations    []DeviceActivation
+	DevNonces      []DeviceDevNonce
+	Locations      []DeviceLocation
+	Twin           DeviceTwin
+	UplinkStats    []DeviceUplinkStats
+	Availability   []DeviceAvailability
+	SecurityEvents []SecurityEvent
+}
+
+// UserDataExport contains all data stored for an user (data-subject access
+// request). The password hash is not included.
+type UserDataExport struct {
+	ExportedAt     time.Time
+	User           UserProfileUser
+	Email          string
+	Note           string
+	Organizations  []UserProfileOrganization
+	Registration   *Registration
+	SecurityEvents []SecurityEvent
+}
+
+// ExportDeviceData returns all data stored for the given device.
+func ExportDeviceData(db sqlx.Queryer, devEUI lorawan.EUI64) (DeviceDataExport, error) {
+	out := DeviceDataExport{
+		ExportedAt: time.Now(),
+	}
+
+	var err error
+	out.Device, err = GetDevice(db, devEUI, false, true)
+	if err != nil {
+		return out, errors.Wrap(err, "get device error")
+	}
+
+	keys, err := GetDeviceKeys(db, devEUI)
+	if err != nil && errors.Cause(err) != ErrDoesNotExist {
+		return out, errors.Wrap(err, "get device-keys error")
+	}
+	if err == nil {
+		out.Keys = &keys
+	}
+
+	out.Twin, err = GetDeviceTwin(db, devEUI, false)
+	if err != nil {
+		return out, errors.Wrap(err, "get device twin error")
+	}
+
+	out.Activations, err = GetDeviceActivationsForDevEUI(db, devEUI, math.MaxInt32)
+	if err != nil {
+		return out, errors.Wrap(err, "get device-activations error")
+	}
+
+	queries := []struct {
+		dest  interface{}
+		query string
+	}{
+		{&out.DevNonces, "select created_at, dev_eui, dev_nonce from device_dev_nonce where dev_eui = $1 order by created_at"},
+		{&out.Locations, "select * from device_location where dev_eui = $1 order by created_at, id"},
+		{&out.UplinkStats, "select * from device_uplink_stats where dev_eui = $1 order by hour, f_port, dr, payload_size"},
+		{&out.Availability, "select * from device_availability where dev_eui = $1 order by hour"},
+		{&out.SecurityEvents, "select * from security_event where dev_eui = $1 order by created_at, id"},
+	}
+
+	for _, q := range queries {
+		if err := sqlx.Select(db, q.dest, q.query, devEUI[:]); err != nil {
+			return out, handlePSQLError(Select, err, "select error")
+		}
+	}
+
+	return out, nil
+}
+
+// EraseDeviceData irreversibly erases all data stored in the database for
+// the given device. The device is deleted (including the keys, activations,
+// locations, statistics and twin) from the database and the network-server
+// and the references in the security events (audit log) are replaced by a
+// random pseudonym. It returns the pseudonym.
+// As db is expected to be a transaction, the device data stored in Redis
+// must be deleted using DeleteDeviceRedisData after it has been committed.
+func EraseDeviceData(db sqlx.Ext, devEUI lorawan.EUI64) (lorawan.EUI64, error) {
+	var pseudonym lorawan.EUI64
+	if err := checkDeviceLegalHold(db, devEUI); err != nil {
+		return pseudonym, err
+	}
+
+	if _, err := rand.Read(pseudonym[:]); err != nil {
+		return pseudonym, errors.Wrap(err, "read random bytes error")
+	}
+
+	_, err := db.Exec(`
+		update security_event
+		set
+			dev_eui = $2,
+			description = replace(description, $3, $4)
+		where
+			dev_eui = $1`,
+		devEUI[:],
+		pseudonym[:],
+		devEUI.String(),
+		pseudonym.String(),
+	)
+	if err != nil {
+		return pseudonym, handlePSQLError(Update, err, "update error")
+	}
+
+	if err := DeleteDevice(db, devEUI); err != nil {
+		return pseudonym, errors.Wrap(err, "delete device error")
+	}
+
+	// only the pseudonym is logged, so that the log does not link the
+	// pseudonym to the erased device
+	log.WithField("pseudonym", pseudonym).Info("device data erased")
+
+	return pseudonym, nil
+}
+
+// DeleteDeviceRedisData deletes the data stored in Redis for the given
+// device (e.g. the event-log and frame-capture buffers). It also removes the
+// entries of the device from the quarantine, dead-letter and delivery-log
+// lists of the given application and from the integration spools.
+func DeleteDeviceRedisData(p *redis.Pool, applicationID int64, devEUI lorawan.EUI64) error {
+	if err := deleteKeys(p, fmt.Sprintf("lora:as:device:%s:*", devEUI), fmt.Sprintf("lora:as:geoloc:%s*", devEUI)); err != nil {
+		return err
+	}
+
+	spools, err := scanKeys(p, "lora:as:integration:spool:*")
+	if err != nil {
+		return err
+	}
+
+	return removeDeviceListEntries(p, devEUI, append([]string{
+		fmt.Sprintf("lora:as:application:%d:quarantine", applicationID),
+		fmt.Sprintf("lora:as:application:%d:dead_letters", applicationID),
+		fmt.Sprintf("lora:as:application:%d:delivery_log", applicationID),
+	}, spools...)...)
+}
+
+// ExportUserData returns all data stored for the given user.
+func ExportUserData(db sqlx.Queryer, id int64) (UserDataExport, error) {
+	out := UserDataExport{
+		ExportedAt: time.Now(),
+	}
+
+	user, err := GetUser(db, id)
+	if err != nil {
+		return out, errors.Wrap(err, "get user error")
+	}
+	out.Email = user.Email
+	out.Note = user.Note
+
+	prof, err := GetProfile(db, id)
+	if err != nil {
+		return out, errors.Wrap(err, "get user profile error")
+	}
+	out.User = prof.User
+	out.Organizations = prof.Organizations
+
+	var reg Registration
+	err = sqlx.Get(db, &reg, "select * from registration where user_id = $1", id)
+	if err != nil {
+		if err = handlePSQLError(Select, err, "select error"); err != ErrDoesNotExist {
+			return out, err
+		}
+	} else {
+		out.Registration = &reg
+	}
+
+	err = sqlx.Select(db, &out.SecurityEvents, "select * from security_event where username = $1 order by created_at, id", user.Username)
+	if err != nil {
+		return out, handlePSQLError(Select, err, "select error")
+	}
+
+	return out, nil
+}
+
+// EraseUserData irreversibly erases all data stored for the given user.
+// The user is deleted (including the organization memberships and the
+// registration) and the username and remote address of the security events
+// (audit log) of the user are replaced by a random pseudonym. It returns the
+// pseudonym.
+func EraseUserData(db sqlx.Ext, id int64) (string, error) {
+	user, err := GetUser(db, id)
+	if err != nil {
+		return "", errors.Wrap(err, "get user error")
+	}
+
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "read random bytes error")
+	}
+	pseudonym := erasedUsernamePrefix + hex.EncodeToString(b)
+
+	_, err = db.Exec(`
+		update security_event
+		set
+			username = $2,
+			remote_addr = '',
+			description = replace(description, $1, $2)
+		where
+			username = $1`,
+		user.Username,
+		pseudonym,
+	)
+	if err != nil {
+		return "", handlePSQLError(Update, err, "update error")
+	}
+
+	if err := DeleteUser(db, id); err != nil {
+		return "", errors.Wrap(err, "delete user error")
+	}
+
+	// only the pseudonym is logged, so that the log does not link the
+	// pseudonym to the erased user
+	log.WithField("pseudonym", pseudonym).Info("user data erased")
+
+	return pseudonym, nil
+}
+
+// deleteKeys deletes the Redis keys matching the given patterns.
+func deleteKeys(p *redis.Pool, patterns ...string) error {
+	keys, err := scanKeys(p, patterns...)
+	if err != nil {
+		return err
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	c := p.Get()
+	defer c.Close()
+
+	args := make([]interface{}, len(keys))
+	for i := range keys {
+		args[i] = keys[i]
+	}
+	if _, err := c.Do("DEL", args...); err != nil {
+		return errors.Wrap(err, "delete keys error")
+	}
+
+	return nil
+}
+
+// scanKeys returns the Redis keys matching the given patterns.
+func scanKeys(p *redis.Pool, patterns ...string) ([]string, error) {
+	c := p.Get()
+	defer c.Close()
+
+	var out []string
+	for _, pattern := range patterns {
+		cursor := 0
+		for {
+			values, err := redis.Values(c.Do("SCAN", cursor, "MATCH", pattern))
+			if err != nil {
+				return nil, errors.Wrap(err, "scan error")
+			}
+
+			var keys []string
+			if _, err := redis.Scan(values, &cursor, &keys); err != nil {
+				return nil, errors.Wrap(err, "scan values error")
+			}
+			out = append(out, keys...)
+
+			if cursor == 0 {
+				break
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// removeDeviceListEntries removes the entries of the given device from the
+// given Redis lists. An entry belongs to the device when its devEUI field,
+// or the devEUI field of its payload (e.g. a dead-letter or spooled
+// integration event), matches the given DevEUI. The entries are removed by
+// value, so that entries which are added in the meantime are retained.
+func removeDeviceListEntries(p *redis.Pool, devEUI lorawan.EUI64, keys ...string) error {
+	c := p.Get()
+	defer c.Close()
+
+	for _, key := range keys {
+		values, err := redis.ByteSlices(c.Do("LRANGE", key, 0, -1))
+		if err != nil {
+			return errors.Wrap(err, "read list error")
+		}
+
+		var remove [][]byte
+		for _, b := range values {
+			if listEntryDevEUI(b) == devEUI {
+				remove = append(remove, b)
+			}
+		}
+
+		if len(remove) == 0 {
+			continue
+		}
+
+		c.Send("MULTI")
+		for _, b := range remove {
+			c.Send("LREM", key, 0, b)
+		}
+		if _, err := c.Do("EXEC"); err != nil {
+			return errors.Wrap(err, "remove list entries error")
+		}
+	}
+
+	return nil
+}
+
+// listEntryDevEUI returns the DevEUI of the given (JSON encoded) list entry.
+// It returns an empty DevEUI when the entry does not contain a DevEUI.
+func listEntryDevEUI(b []byte) lorawan.EUI64 {
+	var entry struct {
+		DevEUI  lorawan.EUI64   `json:"devEUI"`
+		Payload json.RawMessage `json:"payload"`
+	}
+	if err := json.Unmarshal(b, &entry); err != nil {
+		return lorawan.EUI64{}
+	}
+
+	if entry.DevEUI == (lorawan.EUI64{}) && len(entry.Payload) != 0 {
+		var payload struct {
+			DevEUI lorawan.EUI64 `json:"devEUI"`
+		}
+		if err := json.Unmarshal(entry.Payload, &payload); err == nil {
+			return payload.DevEUI
+		}
+	}
+
+	return entry.DevEUI
+}
@@ -0,0 +1,247 @@
+package storage
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeviceDataSubject() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	sp := ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+	app := Application{
+		Name:           "test-app",
+		OrganizationID: org.ID,
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	dp := DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	var dpID uuid.UUID
+	copy(dpID[:], dp.DeviceProfile.Id)
+
+	d := Device{
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		ApplicationID:   app.ID,
+		DeviceProfileID: dpID,
+		Name:            "test-device",
+	}
+	assert.NoError(CreateDevice(ts.Tx(), &d))
+	assert.NoError(CreateDeviceKeys(ts.Tx(), &DeviceKeys{DevEUI: d.DevEUI}))
+	assert.NoError(CreateDeviceLocation(ts.Tx(), &DeviceLocation{DevEUI: d.DevEUI, Latitude: 1.123, Longitude: 2.123}))
+	assert.NoError(CreateSecurityEvent(ts.Tx(), &SecurityEvent{
+		Type:        "join_replay",
+		DevEUI:      &d.DevEUI,
+		Description: "join replay detected for device 0102030405060708",
+	}))
+
+	ts.T().Run("Export", func(t *testing.T) {
+		assert := require.New(t)
+
+		out, err := ExportDeviceData(ts.Tx(), d.DevEUI)
+		assert.NoError(err)
+		assert.Equal(d.DevEUI, out.Device.DevEUI)
+		assert.NotNil(out.Keys)
+		assert.Len(out.Locations, 1)
+		assert.Len(out.SecurityEvents, 1)
+	})
+
+	ts.T().Run("Erase", func(t *testing.T) {
+		assert := require.New(t)
+
+		c := ts.RedisPool().Get()
+		_, err := c.Do("SET", "lora:as:device:0102030405060708:join_trace", "test")
+		assert.NoError(err)
+		c.Close()
+
+		pseudonym, err := EraseDeviceData(ts.Tx(), d.DevEUI)
+		assert.NoError(err)
+		assert.NotEqual(d.DevEUI, pseudonym)
+
+		_, err = GetDevice(ts.Tx(), d.DevEUI, false, true)
+		assert.Equal(ErrDoesNotExist, errors.Cause(err))
+
+		events, err := GetSecurityEvents(ts.Tx(), SecurityEventFilters{DevEUI: &pseudonym, Limit: 10})
+		assert.NoError(err)
+		assert.Len(events, 1)
+		assert.Equal("join replay detected for device "+pseudonym.String(), events[0].Description)
+
+		c = ts.RedisPool().Get()
+		defer c.Close()
+		exists, err := redis.Bool(c.Do("EXISTS", "lora:as:device:0102030405060708:join_trace"))
+		assert.NoError(err)
+		assert.True(exists)
+
+		assert.NoError(DeleteDeviceRedisData(ts.RedisPool(), app.ID, d.DevEUI))
+		exists, err = redis.Bool(c.Do("EXISTS", "lora:as:device:0102030405060708:join_trace"))
+		assert.NoError(err)
+		assert.False(exists)
+	})
+
+	ts.T().Run("Delete list entries", func(t *testing.T) {
+		assert := require.New(t)
+
+		tests := []struct {
+			Name     string
+			Key      string
+			Entries  []string
+			Expected []string
+		}{
+			{
+				Name: "quarantine",
+				Key:  fmt.Sprintf("lora:as:application:%d:quarantine", app.ID),
+				Entries: []string{
+					`{"devEUI":"0102030405060708","fCnt":10,"data":"AQID"}`,
+					`{"devEUI":"0807060504030201","fCnt":11,"data":"AQID"}`,
+				},
+				Expected: []string{
+					`{"devEUI":"0807060504030201","fCnt":11,"data":"AQID"}`,
+				},
+			},
+			{
+				Name: "dead letters",
+				Key:  fmt.Sprintf("lora:as:application:%d:dead_letters", app.ID),
+				Entries: []string{
+					`{"integration":"http","payload":{"devEUI":"0102030405060708"}}`,
+					`{"integration":"http","payload":{"devEUI":"0807060504030201"}}`,
+				},
+				Expected: []string{
+					`{"integration":"http","payload":{"devEUI":"0807060504030201"}}`,
+				},
+			},
+			{
+				Name: "delivery log",
+				Key:  fmt.Sprintf("lora:as:application:%d:delivery_log", app.ID),
+				Entries: []string{
+					`{"integration":"http","devEUI":"0102030405060708"}`,
+					`{"integration":"http","devEUI":"0102030405060708"}`,
+				},
+			},
+			{
+				Name: "integration spool",
+				Key:  "lora:as:integration:spool:default",
+				Entries: []string{
+					`{"type":"up","payload":{"devEUI":"0102030405060708"}}`,
+					`{"type":"admin","payload":"not a device event"}`,
+				},
+				Expected: []string{
+					`{"type":"admin","payload":"not a device event"}`,
+				},
+			},
+		}
+
+		c := ts.RedisPool().Get()
+		defer c.Close()
+
+		for _, test := range tests {
+			for _, e := range test.Entries {
+				_, err := c.Do("RPUSH", test.Key, e)
+				assert.NoError(err)
+			}
+		}
+
+		assert.NoError(DeleteDeviceRedisData(ts.RedisPool(), app.ID, d.DevEUI))
+
+		for _, test := range tests {
+			t.Run(test.Name, func(t *testing.T) {
+				assert := require.New(t)
+
+				values, err := redis.Strings(c.Do("LRANGE", test.Key, 0, -1))
+				assert.NoError(err)
+				if test.Expected == nil {
+					assert.Len(values, 0)
+					return
+				}
+				assert.Equal(test.Expected, values)
+			})
+		}
+	})
+}
+
+func (ts *StorageTestSuite) TestUserDataSubject() {
+	assert := require.New(ts.T())
+
+	user := User{
+		Username: "testuser",
+		IsActive: true,
+		Email:    "foo@bar.com",
+	}
+	_, err := CreateUser(ts.Tx(), &user, "password123")
+	assert.NoError(err)
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+	assert.NoError(CreateOrganizationUser(ts.Tx(), org.ID, user.ID, false))
+
+	assert.NoError(CreateSecurityEvent(ts.Tx(), &SecurityEvent{
+		Type:        "login_failed",
+		Username:    "testuser",
+		RemoteAddr:  "127.0.0.1:1234",
+		Description: "invalid password for user testuser",
+	}))
+
+	ts.T().Run("Export", func(t *testing.T) {
+		assert := require.New(t)
+
+		out, err := ExportUserData(ts.Tx(), user.ID)
+		assert.NoError(err)
+		assert.Equal("testuser", out.User.Username)
+		assert.Equal("foo@bar.com", out.Email)
+		assert.Len(out.Organizations, 1)
+		assert.Nil(out.Registration)
+		assert.Len(out.SecurityEvents, 1)
+	})
+
+	ts.T().Run("Erase", func(t *testing.T) {
+		assert := require.New(t)
+
+		pseudonym, err := EraseUserData(ts.Tx(), user.ID)
+		assert.NoError(err)
+
+		_, err = GetUser(ts.Tx(), user.ID)
+		assert.Equal(ErrDoesNotExist, errors.Cause(err))
+
+		events, err := GetSecurityEvents(ts.Tx(), SecurityEventFilters{Limit: 10})
+		assert.NoError(err)
+		assert.Len(events, 1)
+		assert.Equal(pseudonym, events[0].Username)
+		assert.Equal("", events[0].RemoteAddr)
+		assert.Equal("invalid password for user "+pseudonym, events[0].Description)
+	})
+}
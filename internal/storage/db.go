@@ -1,10 +1,13 @@
 package storage
 
 import (
+	"context"
 	"fmt"
+	"net"
 	"time"
 
 	"github.com/brocaar/lora-app-server/internal/common"
+	"github.com/brocaar/lora-app-server/internal/netutil"
 	"github.com/gomodule/redigo/redis"
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
@@ -29,6 +32,9 @@ func NewRedisPool(redisURL string, maxIdle int, idleTimeout time.Duration) *redi
 			c, err := redis.DialURL(redisURL,
 				redis.DialReadTimeout(redisDialReadTimeout),
 				redis.DialWriteTimeout(redisDialWriteTimeout),
+				redis.DialNetDial(func(network, addr string) (net.Conn, error) {
+					return netutil.DialContext(context.Background(), network, addr)
+				}),
 			)
 			if err != nil {
 				return nil, fmt.Errorf("redis connection error: %s", err)
@@ -2,6 +2,9 @@ package storage
 
 import (
 	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
@@ -34,6 +37,55 @@ type Device struct {
 	Latitude            *float64      `db:"latitude"`
 	Longitude           *float64      `db:"longitude"`
 	Altitude            *float64      `db:"altitude"`
+
+	// SuppressFCntAnomalies disables the frame-counter anomaly events
+	// (e.g. for devices which are known to reset their frame-counter).
+	SuppressFCntAnomalies bool `db:"suppress_f_cnt_anomalies"`
+
+	// LegalHoldAt holds the timestamp at which the device was placed under
+	// legal hold (nil when the device is not under legal hold).
+	LegalHoldAt *time.Time `db:"legal_hold_at"`
+
+	// Variables contains user-defined key / value pairs, e.g. the access
+	// token of the device used by an integration.
+	Variables DeviceVariables `db:"variables"`
+}
+
+// DeviceVariables contains the user-defined key / value pairs of a device.
+type DeviceVariables map[string]string
+
+// Value implements the driver.Valuer interface.
+func (v DeviceVariables) Value() (driver.Value, error) {
+	if v == nil {
+		v = DeviceVariables{}
+	}
+	return json.Marshal(v)
+}
+
+// Scan implements the sql.Scanner interface. Empty variables are scanned
+// as nil.
+func (v *DeviceVariables) Scan(src interface{}) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("expected []byte, got %T", src)
+	}
+
+	var vars map[string]string
+	if err := json.Unmarshal(b, &vars); err != nil {
+		return err
+	}
+
+	*v = nil
+	if len(vars) != 0 {
+		*v = vars
+	}
+	return nil
+}
+
+// HasLegalHold returns true when the device is under legal hold. Note that
+// the device is also protected when its application is under legal hold.
+func (d Device) HasLegalHold() bool {
+	return d.LegalHoldAt != nil
 }
 
 // DeviceListItem defines the Device as list item.
@@ -57,6 +109,13 @@ type DeviceKeys struct {
 	JoinNonce int               `db:"join_nonce"`
 }
 
+// DeviceDevNonce defines a DevNonce used by a LoRaWAN device.
+type DeviceDevNonce struct {
+	CreatedAt time.Time     `db:"created_at"`
+	DevEUI    lorawan.EUI64 `db:"dev_eui"`
+	DevNonce  int           `db:"dev_nonce"`
+}
+
 // DeviceActivation defines the device-activation for a LoRaWAN device.
 type DeviceActivation struct {
 	ID        int64             `db:"id"`
@@ -90,8 +149,10 @@ func CreateDevice(db sqlx.Ext, d *Device) error {
 			last_seen_at,
 			latitude,
 			longitude,
-			altitude
-        ) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`,
+			altitude,
+			suppress_f_cnt_anomalies,
+			variables
+        ) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`,
 		d.DevEUI[:],
 		d.CreatedAt,
 		d.UpdatedAt,
@@ -105,12 +166,14 @@ func CreateDevice(db sqlx.Ext, d *Device) error {
 		d.Latitude,
 		d.Longitude,
 		d.Altitude,
+		d.SuppressFCntAnomalies,
+		d.Variables,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
 	}
 
-	app, err := GetApplication(db, d.ApplicationID)
+	app, err := GetApplication(db, d.ApplicationID, false)
 	if err != nil {
 		return errors.Wrap(err, "get application error")
 	}
@@ -200,6 +263,7 @@ func GetDevice(db sqlx.Queryer, devEUI lorawan.EUI64, forUpdate, localOnly bool)
 // DeviceFilters provide filters that can be used to filter on devices.
 // Note that empty values are not used as filter.
 type DeviceFilters struct {
+	OrganizationID   int64     `db:"organization_id"`
 	ApplicationID    int64     `db:"application_id"`
 	MulticastGroupID uuid.UUID `db:"multicast_group_id"`
 	ServiceProfileID uuid.UUID `db:"service_profile_id"`
@@ -209,12 +273,22 @@ type DeviceFilters struct {
 	// be given as the arguments.
 	Limit  int `db:"limit"`
 	Offset int `db:"offset"`
+
+	// AfterName and AfterDevEUI can be set for cursor-based pagination.
+	// When AfterDevEUI is set, only the devices sorted after the given name
+	// and DevEUI are returned. These are not used by GetDeviceCount.
+	AfterName   string         `db:"after_name"`
+	AfterDevEUI *lorawan.EUI64 `db:"after_dev_eui"`
 }
 
 // SQL returns the SQL filter.
 func (f DeviceFilters) SQL() string {
 	var filters []string
 
+	if f.OrganizationID != 0 {
+		filters = append(filters, "a.organization_id = :organization_id")
+	}
+
 	if f.ApplicationID != 0 {
 		filters = append(filters, "d.application_id = :application_id")
 	}
@@ -238,6 +312,20 @@ func (f DeviceFilters) SQL() string {
 	return "where " + strings.Join(filters, " and ")
 }
 
+// cursorSQL returns the SQL filter for cursor-based pagination, to be
+// appended to the SQL filter.
+func (f DeviceFilters) cursorSQL() string {
+	if f.AfterDevEUI == nil {
+		return ""
+	}
+
+	cond := "(d.name, d.dev_eui) > (:after_name, :after_dev_eui)"
+	if f.SQL() == "" {
+		return "where " + cond
+	}
+	return " and " + cond
+}
+
 // GetDeviceCount returns the number of devices.
 func GetDeviceCount(db sqlx.Queryer, filters DeviceFilters) (int, error) {
 	if filters.Search != "" {
@@ -284,9 +372,10 @@ func GetDevices(db sqlx.Queryer, filters DeviceFilters) ([]DeviceListItem, error
 			on d.application_id = a.id
 		left join device_multicast_group dmg
 			on d.dev_eui = dmg.dev_eui
-		`+filters.SQL()+`
+		`+filters.SQL()+filters.cursorSQL()+`
 		order by
-			d.name
+			d.name,
+			d.dev_eui
 		limit :limit
 		offset :offset
 	`, filters)
@@ -303,6 +392,23 @@ func GetDevices(db sqlx.Queryer, filters DeviceFilters) ([]DeviceListItem, error
 	return devices, nil
 }
 
+// GetDevEUIsForApplicationID returns the DevEUIs of the devices of the
+// given application.
+func GetDevEUIsForApplicationID(db sqlx.Queryer, applicationID int64) ([]lorawan.EUI64, error) {
+	var devEUIs []lorawan.EUI64
+	err := sqlx.Select(db, &devEUIs, `
+		select dev_eui
+		from device
+		where application_id = $1
+		order by dev_eui`,
+		applicationID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return devEUIs, nil
+}
+
 // UpdateDevice updates the given device.
 // When localOnly is set, it will not update the device on the network-server.
 func UpdateDevice(db sqlx.Ext, d *Device, localOnly bool) error {
@@ -325,7 +431,9 @@ func UpdateDevice(db sqlx.Ext, d *Device, localOnly bool) error {
 			last_seen_at = $9,
 			latitude = $10,
 			longitude = $11,
-			altitude = $12
+			altitude = $12,
+			suppress_f_cnt_anomalies = $13,
+			variables = $14
         where
             dev_eui = $1`,
 		d.DevEUI[:],
@@ -340,6 +448,8 @@ func UpdateDevice(db sqlx.Ext, d *Device, localOnly bool) error {
 		d.Latitude,
 		d.Longitude,
 		d.Altitude,
+		d.SuppressFCntAnomalies,
+		d.Variables,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
@@ -354,7 +464,7 @@ func UpdateDevice(db sqlx.Ext, d *Device, localOnly bool) error {
 
 	// update the device on the network-server
 	if !localOnly {
-		app, err := GetApplication(db, d.ApplicationID)
+		app, err := GetApplication(db, d.ApplicationID, false)
 		if err != nil {
 			return errors.Wrap(err, "get application error")
 		}
@@ -399,6 +509,10 @@ func UpdateDevice(db sqlx.Ext, d *Device, localOnly bool) error {
 
 // DeleteDevice deletes the device matching the given DevEUI.
 func DeleteDevice(db sqlx.Ext, devEUI lorawan.EUI64) error {
+	if err := checkDeviceLegalHold(db, devEUI); err != nil {
+		return err
+	}
+
 	n, err := GetNetworkServerForDevEUI(db, devEUI)
 	if err != nil {
 		return errors.Wrap(err, "get network-server error")
@@ -537,22 +651,93 @@ func DeleteDeviceKeys(db sqlx.Execer, devEUI lorawan.EUI64) error {
 	return nil
 }
 
+// CreateDeviceDevNonce stores the given DevNonce as used by the device.
+// ErrAlreadyExists is returned when the DevNonce has been used before.
+func CreateDeviceDevNonce(db sqlx.Execer, dn *DeviceDevNonce) error {
+	dn.CreatedAt = time.Now()
+
+	_, err := db.Exec(`
+		insert into device_dev_nonce (
+			dev_eui,
+			dev_nonce,
+			created_at
+		) values ($1, $2, $3)`,
+		dn.DevEUI[:],
+		dn.DevNonce,
+		dn.CreatedAt,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":   dn.DevEUI,
+		"dev_nonce": dn.DevNonce,
+	}).Info("device dev-nonce created")
+
+	return nil
+}
+
+// GetDeviceDevNoncesForDevEUI returns the used DevNonces for the given DevEUI,
+// ordered by the time they were used.
+func GetDeviceDevNoncesForDevEUI(db sqlx.Queryer, devEUI lorawan.EUI64) ([]DeviceDevNonce, error) {
+	var dns []DeviceDevNonce
+
+	err := sqlx.Select(db, &dns, `
+		select *
+		from device_dev_nonce
+		where
+			dev_eui = $1
+		order by
+			created_at`,
+		devEUI[:],
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return dns, nil
+}
+
+// DeleteDeviceDevNoncesForDevEUI deletes the used DevNonces for the given
+// DevEUI, e.g. after a factory-reset of the device.
+func DeleteDeviceDevNoncesForDevEUI(db sqlx.Execer, devEUI lorawan.EUI64) error {
+	_, err := db.Exec("delete from device_dev_nonce where dev_eui = $1", devEUI[:])
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+
+	log.WithField("dev_eui", devEUI).Info("device dev-nonces deleted")
+
+	return nil
+}
+
 // CreateDeviceActivation creates the given device-activation.
+// When a storage KEK has been configured, the AppSKey will be stored
+// wrapped using this KEK, or when enabled, using the data-key of the
+// organization of the device.
 func CreateDeviceActivation(db sqlx.Queryer, da *DeviceActivation) error {
 	da.CreatedAt = time.Now()
 
-	err := sqlx.Get(db, &da.ID, `
+	kekLabel, appSKey, err := wrapDeviceKey(db, da.DevEUI, da.AppSKey)
+	if err != nil {
+		return errors.Wrap(err, "wrap appSKey error")
+	}
+
+	err = sqlx.Get(db, &da.ID, `
         insert into device_activation (
             created_at,
             dev_eui,
             dev_addr,
-			app_s_key
-        ) values ($1, $2, $3, $4)
+			app_s_key,
+			app_s_key_kek_label
+        ) values ($1, $2, $3, $4, $5)
         returning id`,
 		da.CreatedAt,
 		da.DevEUI[:],
 		da.DevAddr[:],
-		da.AppSKey[:],
+		appSKey,
+		kekLabel,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -569,9 +754,17 @@ func CreateDeviceActivation(db sqlx.Queryer, da *DeviceActivation) error {
 // GetLastDeviceActivationForDevEUI returns the most recent device-activation for the given DevEUI.
 func GetLastDeviceActivationForDevEUI(db sqlx.Queryer, devEUI lorawan.EUI64) (DeviceActivation, error) {
 	var da DeviceActivation
+	var appSKey []byte
+	var kekLabel string
 
-	err := sqlx.Get(db, &da, `
-        select *
+	err := db.QueryRowx(`
+        select
+            id,
+            created_at,
+            dev_eui,
+            dev_addr,
+            app_s_key,
+            app_s_key_kek_label
         from device_activation
         where
             dev_eui = $1
@@ -579,14 +772,87 @@ func GetLastDeviceActivationForDevEUI(db sqlx.Queryer, devEUI lorawan.EUI64) (De
             created_at desc
         limit 1`,
 		devEUI[:],
-	)
+	).Scan(&da.ID, &da.CreatedAt, &da.DevEUI, &da.DevAddr, &appSKey, &kekLabel)
 	if err != nil {
 		return da, handlePSQLError(Select, err, "select error")
 	}
 
+	da.AppSKey, err = unwrapDeviceKey(db, kekLabel, appSKey)
+	if err != nil {
+		return da, errors.Wrap(err, "unwrap appSKey error")
+	}
+
 	return da, nil
 }
 
+// GetDeviceActivationsForDevEUI returns the most recent device-activations
+// for the given DevEUI (most recent first), limited to the given limit.
+func GetDeviceActivationsForDevEUI(db sqlx.Queryer, devEUI lorawan.EUI64, limit int) ([]DeviceActivation, error) {
+	rows, err := db.Queryx(`
+		select
+			id,
+			created_at,
+			dev_eui,
+			dev_addr,
+			app_s_key,
+			app_s_key_kek_label
+		from device_activation
+		where
+			dev_eui = $1
+		order by
+			created_at desc
+		limit $2`,
+		devEUI[:],
+		limit,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	defer rows.Close()
+
+	var out []DeviceActivation
+	var appSKeys [][]byte
+	var kekLabels []string
+	for rows.Next() {
+		var da DeviceActivation
+		var appSKey []byte
+		var kekLabel string
+
+		if err := rows.Scan(&da.ID, &da.CreatedAt, &da.DevEUI, &da.DevAddr, &appSKey, &kekLabel); err != nil {
+			return nil, handlePSQLError(Select, err, "select error")
+		}
+
+		out = append(out, da)
+		appSKeys = append(appSKeys, appSKey)
+		kekLabels = append(kekLabels, kekLabel)
+	}
+	rows.Close()
+
+	// the keys are unwrapped after reading all rows, as unwrapping might
+	// require reading the organization data-key using the same connection
+	for i := range out {
+		out[i].AppSKey, err = unwrapDeviceKey(db, kekLabels[i], appSKeys[i])
+		if err != nil {
+			return nil, errors.Wrap(err, "unwrap appSKey error")
+		}
+	}
+
+	return out, nil
+}
+
+// DeleteDeviceActivationsForDevice deletes the device-activations for the
+// given DevEUI.
+func DeleteDeviceActivationsForDevice(db sqlx.Execer, devEUI lorawan.EUI64) error {
+	_, err := db.Exec("delete from device_activation where dev_eui = $1", devEUI[:])
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+
+	log.WithField("dev_eui", devEUI).Info("device-activations deleted")
+
+	return nil
+}
+
 // DeleteAllDevicesForApplicationID deletes all devices given an application id.
 func DeleteAllDevicesForApplicationID(db sqlx.Ext, applicationID int64) error {
 	var devs []Device
@@ -0,0 +1,177 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceAvailability contains the number of received and expected uplinks
+// of a device for a single hour.
+type DeviceAvailability struct {
+	DevEUI        lorawan.EUI64 `db:"dev_eui"`
+	Hour          time.Time     `db:"hour"` // truncated to the hour on insert
+	ReceivedCount int64         `db:"received_count"`
+	ExpectedCount int64         `db:"expected_count"`
+}
+
+// DeliveryReportFilter defines the filter of the delivery report. The
+// records of which the hour is within [Start, End) are selected.
+type DeliveryReportFilter struct {
+	ApplicationID int64
+	Start         time.Time
+	End           time.Time
+
+	// Timezone defines the (IANA) timezone used to aggregate the records
+	// per day. When empty, UTC is used.
+	Timezone string
+}
+
+// DeliveryRate contains the number of received and expected uplinks for
+// a single day, Date being the start of the day in the timezone of the
+// filter.
+type DeliveryRate struct {
+	Date          time.Time `db:"date"`
+	ReceivedCount int64     `db:"received_count"`
+	ExpectedCount int64     `db:"expected_count"`
+}
+
+// DeviceDeliveryRate contains the number of received and expected uplinks
+// of a device.
+type DeviceDeliveryRate struct {
+	DevEUI        lorawan.EUI64 `db:"dev_eui"`
+	Name          string        `db:"name"`
+	ReceivedCount int64         `db:"received_count"`
+	ExpectedCount int64         `db:"expected_count"`
+}
+
+// IncrementDeviceAvailability adds the given received and expected uplink
+// counts to the stored availability of the device.
+func IncrementDeviceAvailability(db sqlx.Execer, a DeviceAvailability) error {
+	_, err := db.Exec(`
+		insert into device_availability (
+			dev_eui,
+			hour,
+			received_count,
+			expected_count
+		) values ($1, $2, $3, $4)
+		on conflict (dev_eui, hour) do update
+		set
+			received_count = device_availability.received_count + excluded.received_count,
+			expected_count = device_availability.expected_count + excluded.expected_count`,
+		a.DevEUI[:],
+		a.Hour.Truncate(time.Hour),
+		a.ReceivedCount,
+		a.ExpectedCount,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	return nil
+}
+
+// GetDeliveryRates returns the number of received and expected uplinks of
+// the devices of the given application, per day (in the timezone of the
+// filter).
+func GetDeliveryRates(db sqlx.Queryer, filter DeliveryReportFilter) ([]DeliveryRate, error) {
+	timezone := filter.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	var rates []DeliveryRate
+	err := sqlx.Select(db, &rates, `
+		select
+			date_trunc('day', a.hour at time zone $4) at time zone $4 as date,
+			sum(a.received_count) as received_count,
+			sum(a.expected_count) as expected_count
+		from device_availability a
+		inner join device d
+			on d.dev_eui = a.dev_eui
+		where
+			d.application_id = $1
+			and a.hour >= $2
+			and a.hour < $3
+		group by 1
+		order by 1`,
+		filter.ApplicationID,
+		filter.Start,
+		filter.End,
+		timezone,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return rates, nil
+}
+
+// GetDeviceDeliveryRates returns the number of received and expected
+// uplinks of the devices of the given application, ordered by delivery-rate
+// (lowest first).
+func GetDeviceDeliveryRates(db sqlx.Queryer, filter DeliveryReportFilter, limit int) ([]DeviceDeliveryRate, error) {
+	var rates []DeviceDeliveryRate
+	err := sqlx.Select(db, &rates, `
+		select
+			d.dev_eui,
+			d.name,
+			sum(a.received_count) as received_count,
+			sum(a.expected_count) as expected_count
+		from device_availability a
+		inner join device d
+			on d.dev_eui = a.dev_eui
+		where
+			d.application_id = $1
+			and a.hour >= $2
+			and a.hour < $3
+		group by d.dev_eui, d.name
+		order by
+			sum(a.received_count)::double precision / greatest(sum(a.expected_count), 1),
+			d.name
+		limit $4`,
+		filter.ApplicationID,
+		filter.Start,
+		filter.End,
+		limit,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return rates, nil
+}
+
+// DeleteExpiredDeviceAvailability deletes the device availability records
+// which are older than the given retention (in days). A retention of 0 days
+// means that the records are kept forever. The records of devices under
+// legal hold are not deleted. It returns the number of deleted records.
+func DeleteExpiredDeviceAvailability(db sqlx.Execer, days int) (int64, error) {
+	if days == 0 {
+		return 0, nil
+	}
+
+	res, err := db.Exec(`
+		delete from device_availability
+		where
+			hour < now() - $1 * interval '1 day'
+			and dev_eui not in (
+				select
+					d.dev_eui
+				from device d
+				inner join application a
+					on a.id = d.application_id
+				where
+					d.legal_hold_at is not null
+					or a.legal_hold_at is not null
+			)`,
+		days,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Delete, err, "delete error")
+	}
+
+	return res.RowsAffected()
+}
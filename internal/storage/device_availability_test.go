@@ -0,0 +1,135 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeviceAvailability() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	sp := ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+	app := Application{
+		Name:           "test-app",
+		OrganizationID: org.ID,
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	dp := DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	var dpID uuid.UUID
+	copy(dpID[:], dp.DeviceProfile.Id)
+
+	devices := []Device{
+		{
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dpID,
+			Name:            "device-1",
+		},
+		{
+			DevEUI:          lorawan.EUI64{2, 2, 3, 4, 5, 6, 7, 8},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dpID,
+			Name:            "device-2",
+		},
+	}
+	for i := range devices {
+		assert.NoError(CreateDevice(ts.Tx(), &devices[i]))
+	}
+
+	now := time.Now()
+	old := now.AddDate(0, 0, -30)
+
+	// device-1 has lost half of its uplinks, device-2 none
+	availability := []DeviceAvailability{
+		{DevEUI: devices[0].DevEUI, Hour: now, ReceivedCount: 1, ExpectedCount: 1},
+		{DevEUI: devices[0].DevEUI, Hour: now, ReceivedCount: 1, ExpectedCount: 3},
+		{DevEUI: devices[1].DevEUI, Hour: now, ReceivedCount: 2, ExpectedCount: 2},
+		{DevEUI: devices[1].DevEUI, Hour: old, ReceivedCount: 1, ExpectedCount: 5},
+	}
+	for _, a := range availability {
+		assert.NoError(IncrementDeviceAvailability(ts.Tx(), a))
+	}
+
+	filter := DeliveryReportFilter{
+		ApplicationID: app.ID,
+		Start:         now.AddDate(0, 0, -7),
+		End:           now,
+	}
+
+	ts.T().Run("GetDeliveryRates", func(t *testing.T) {
+		assert := require.New(t)
+
+		rates, err := GetDeliveryRates(ts.Tx(), filter)
+		assert.NoError(err)
+		assert.Len(rates, 1)
+		assert.EqualValues(4, rates[0].ReceivedCount)
+		assert.EqualValues(6, rates[0].ExpectedCount)
+
+		filter := filter
+		filter.Start = old
+		rates, err = GetDeliveryRates(ts.Tx(), filter)
+		assert.NoError(err)
+		assert.Len(rates, 2)
+	})
+
+	ts.T().Run("GetDeviceDeliveryRates", func(t *testing.T) {
+		assert := require.New(t)
+
+		rates, err := GetDeviceDeliveryRates(ts.Tx(), filter, 10)
+		assert.NoError(err)
+		assert.Equal([]DeviceDeliveryRate{
+			{DevEUI: devices[0].DevEUI, Name: "device-1", ReceivedCount: 2, ExpectedCount: 4},
+			{DevEUI: devices[1].DevEUI, Name: "device-2", ReceivedCount: 2, ExpectedCount: 2},
+		}, rates)
+
+		rates, err = GetDeviceDeliveryRates(ts.Tx(), filter, 1)
+		assert.NoError(err)
+		assert.Len(rates, 1)
+	})
+
+	ts.T().Run("DeleteExpiredDeviceAvailability", func(t *testing.T) {
+		assert := require.New(t)
+
+		count, err := DeleteExpiredDeviceAvailability(ts.Tx(), 0)
+		assert.NoError(err)
+		assert.EqualValues(0, count)
+
+		count, err = DeleteExpiredDeviceAvailability(ts.Tx(), 7)
+		assert.NoError(err)
+		assert.EqualValues(1, count)
+	})
+}
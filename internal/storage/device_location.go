@@ -0,0 +1,125 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceLocation defines a (resolved or reported) location of a device.
+type DeviceLocation struct {
+	ID        int64         `db:"id"`
+	CreatedAt time.Time     `db:"created_at"`
+	DevEUI    lorawan.EUI64 `db:"dev_eui"`
+	Latitude  float64       `db:"latitude"`
+	Longitude float64       `db:"longitude"`
+	Altitude  float64       `db:"altitude"`
+	Accuracy  int           `db:"accuracy"`
+	Source    string        `db:"source"`
+}
+
+// CreateDeviceLocation creates the given device-location. When the
+// CreatedAt timestamp is not set, it is set to the current time.
+func CreateDeviceLocation(db sqlx.Queryer, dl *DeviceLocation) error {
+	if dl.CreatedAt.IsZero() {
+		dl.CreatedAt = time.Now()
+	}
+
+	err := sqlx.Get(db, &dl.ID, `
+		insert into device_location (
+			created_at,
+			dev_eui,
+			latitude,
+			longitude,
+			altitude,
+			accuracy,
+			source
+		) values ($1, $2, $3, $4, $5, $6, $7)
+		returning id`,
+		dl.CreatedAt,
+		dl.DevEUI[:],
+		dl.Latitude,
+		dl.Longitude,
+		dl.Altitude,
+		dl.Accuracy,
+		dl.Source,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":      dl.ID,
+		"dev_eui": dl.DevEUI,
+		"source":  dl.Source,
+	}).Info("device-location created")
+
+	return nil
+}
+
+// DeviceLocationFilters provides filters for retrieving device-locations.
+// Note that empty values are not used as filter.
+type DeviceLocationFilters struct {
+	// Start (inclusive) and End (exclusive) of the time-range.
+	Start time.Time
+	End   time.Time
+
+	// Limit defines the max. number of device-locations to return.
+	Limit int
+
+	// AfterCreatedAt and AfterID can be set for cursor-based pagination.
+	// When AfterID is set, only the device-locations sorted after the given
+	// timestamp and ID are returned.
+	AfterCreatedAt time.Time
+	AfterID        int64
+}
+
+// GetDeviceLocationsForDevEUI returns the locations of the given DevEUI
+// matching the given filters, ordered by the time they were created.
+func GetDeviceLocationsForDevEUI(db sqlx.Queryer, devEUI lorawan.EUI64, filters DeviceLocationFilters) ([]DeviceLocation, error) {
+	var start, end, afterCreatedAt *time.Time
+	var afterID, limit *int
+	if !filters.Start.IsZero() {
+		start = &filters.Start
+	}
+	if !filters.End.IsZero() {
+		end = &filters.End
+	}
+	if filters.AfterID != 0 {
+		afterCreatedAt = &filters.AfterCreatedAt
+		id := int(filters.AfterID)
+		afterID = &id
+	}
+	if filters.Limit != 0 {
+		limit = &filters.Limit
+	}
+
+	var dls []DeviceLocation
+	err := sqlx.Select(db, &dls, `
+		select *
+		from device_location
+		where
+			dev_eui = $1
+			and ($2::timestamp with time zone is null or created_at >= $2)
+			and ($3::timestamp with time zone is null or created_at < $3)
+			and ($4::bigint is null or (created_at, id) > ($5, $4))
+		order by
+			created_at,
+			id
+		limit $6`,
+		devEUI[:],
+		start,
+		end,
+		afterID,
+		afterCreatedAt,
+		limit,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return dls, nil
+}
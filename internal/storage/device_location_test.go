@@ -0,0 +1,135 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeviceLocation() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	sp := ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+	app := Application{
+		Name:           "test-app",
+		OrganizationID: org.ID,
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	dp := DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	var dpID uuid.UUID
+	copy(dpID[:], dp.DeviceProfile.Id)
+
+	d := Device{
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		ApplicationID:   app.ID,
+		DeviceProfileID: dpID,
+		Name:            "test-device",
+	}
+	assert.NoError(CreateDevice(ts.Tx(), &d))
+
+	now := time.Now().Round(time.Second).UTC()
+	var dls []DeviceLocation
+	for i := 0; i < 3; i++ {
+		dl := DeviceLocation{
+			CreatedAt: now.Add(time.Duration(i) * time.Minute),
+			DevEUI:    d.DevEUI,
+			Latitude:  1.123 + float64(i),
+			Longitude: 2.123,
+			Altitude:  3.123,
+			Accuracy:  10,
+			Source:    "GEO_RESOLVER",
+		}
+		assert.NoError(CreateDeviceLocation(ts.Tx(), &dl))
+		assert.NotEqual(0, dl.ID)
+		dls = append(dls, dl)
+	}
+
+	ts.T().Run("Get all", func(t *testing.T) {
+		assert := require.New(t)
+
+		out, err := GetDeviceLocationsForDevEUI(ts.Tx(), d.DevEUI, DeviceLocationFilters{})
+		assert.NoError(err)
+		assert.Len(out, 3)
+
+		for i := range out {
+			out[i].CreatedAt = out[i].CreatedAt.UTC()
+		}
+		assert.Equal(dls, out)
+	})
+
+	ts.T().Run("Get time-range", func(t *testing.T) {
+		assert := require.New(t)
+
+		out, err := GetDeviceLocationsForDevEUI(ts.Tx(), d.DevEUI, DeviceLocationFilters{
+			Start: now.Add(time.Minute),
+			End:   now.Add(2 * time.Minute),
+		})
+		assert.NoError(err)
+		assert.Len(out, 1)
+		assert.Equal(dls[1].ID, out[0].ID)
+	})
+
+	ts.T().Run("Get with limit and cursor", func(t *testing.T) {
+		assert := require.New(t)
+
+		out, err := GetDeviceLocationsForDevEUI(ts.Tx(), d.DevEUI, DeviceLocationFilters{
+			Limit: 2,
+		})
+		assert.NoError(err)
+		assert.Len(out, 2)
+		assert.Equal(dls[0].ID, out[0].ID)
+		assert.Equal(dls[1].ID, out[1].ID)
+
+		out, err = GetDeviceLocationsForDevEUI(ts.Tx(), d.DevEUI, DeviceLocationFilters{
+			Limit:          2,
+			AfterCreatedAt: out[1].CreatedAt,
+			AfterID:        out[1].ID,
+		})
+		assert.NoError(err)
+		assert.Len(out, 1)
+		assert.Equal(dls[2].ID, out[0].ID)
+	})
+
+	ts.T().Run("Delete device", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.NoError(DeleteDevice(ts.Tx(), d.DevEUI))
+
+		out, err := GetDeviceLocationsForDevEUI(ts.Tx(), d.DevEUI, DeviceLocationFilters{})
+		assert.NoError(err)
+		assert.Len(out, 0)
+	})
+}
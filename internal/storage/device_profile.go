@@ -2,6 +2,11 @@ package storage
 
 import (
 	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -13,8 +18,11 @@ import (
 
 	"github.com/brocaar/lora-app-server/internal/config"
 	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
 )
 
+var fPortLabelRegexp = regexp.MustCompile(`^[\w-]+$`)
+
 // DeviceProfile defines the device-profile.
 type DeviceProfile struct {
 	NetworkServerID int64            `db:"network_server_id"`
@@ -22,9 +30,96 @@ type DeviceProfile struct {
 	CreatedAt       time.Time        `db:"created_at"`
 	UpdatedAt       time.Time        `db:"updated_at"`
 	Name            string           `db:"name"`
+	JoinAccept      *JoinAccept      `db:"-"`
+	FPorts          FPorts           `db:"-"`
 	DeviceProfile   ns.DeviceProfile `db:"-"`
 }
 
+// FPort defines an expected uplink FPort of the devices using the
+// device-profile and its meaning.
+type FPort struct {
+	FPort       int    `json:"fPort"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+}
+
+// FPorts defines the list of expected uplink FPorts. An empty list means
+// that all FPorts are expected.
+type FPorts []FPort
+
+// Get returns the FPort item for the given FPort.
+func (f FPorts) Get(fPort int) (FPort, bool) {
+	for _, p := range f {
+		if p.FPort == fPort {
+			return p, true
+		}
+	}
+	return FPort{}, false
+}
+
+// Validate validates the FPorts.
+func (f FPorts) Validate() error {
+	seen := make(map[int]bool)
+	for _, p := range f {
+		if p.FPort < 1 || p.FPort > 223 || seen[p.FPort] {
+			return ErrInvalidFPort
+		}
+		seen[p.FPort] = true
+
+		if !fPortLabelRegexp.MatchString(p.Label) {
+			return ErrInvalidFPortLabel
+		}
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (f FPorts) Value() (driver.Value, error) {
+	if f == nil {
+		f = FPorts{}
+	}
+	return json.Marshal(f)
+}
+
+// Scan implements the sql.Scanner interface.
+func (f *FPorts) Scan(src interface{}) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("expected []byte, got %T", src)
+	}
+	return json.Unmarshal(b, f)
+}
+
+// JoinAccept defines the join-accept parameters which override the
+// parameters requested by the network-server when LoRa App Server is acting
+// as join-server.
+type JoinAccept struct {
+	RXDelay     int
+	RX1DROffset int
+	RX2DR       int
+	CFList      []byte
+}
+
+// Validate validates the join-accept parameters.
+func (ja JoinAccept) Validate() error {
+	if ja.RXDelay < 0 || ja.RXDelay > 15 {
+		return ErrNodeMaxRXDelay
+	}
+	if ja.RX1DROffset < 0 || ja.RX1DROffset > 7 || ja.RX2DR < 0 || ja.RX2DR > 15 {
+		return ErrInvalidJoinAcceptDLSettings
+	}
+	if len(ja.CFList) != 0 {
+		var cFList lorawan.CFList
+		if len(ja.CFList) != 16 {
+			return ErrInvalidCFList
+		}
+		if err := cFList.UnmarshalBinary(ja.CFList); err != nil {
+			return ErrInvalidCFList
+		}
+	}
+	return nil
+}
+
 // DeviceProfileMeta defines the device-profile meta record.
 type DeviceProfileMeta struct {
 	DeviceProfileID uuid.UUID `db:"device_profile_id"`
@@ -37,9 +132,29 @@ type DeviceProfileMeta struct {
 
 // Validate validates the device-profile data.
 func (dp DeviceProfile) Validate() error {
+	if dp.JoinAccept != nil {
+		if err := dp.JoinAccept.Validate(); err != nil {
+			return err
+		}
+	}
+	if err := dp.FPorts.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
+// IsLoRaWAN11 returns true when the device-profile MAC version is
+// LoRaWAN 1.1 or later.
+func (dp DeviceProfile) IsLoRaWAN11() bool {
+	return IsLoRaWAN11MACVersion(dp.DeviceProfile.MacVersion)
+}
+
+// IsLoRaWAN11MACVersion returns true when the given MAC version is
+// LoRaWAN 1.1 or later.
+func IsLoRaWAN11MACVersion(macVersion string) bool {
+	return macVersion != "" && !strings.HasPrefix(macVersion, "1.0")
+}
+
 // CreateDeviceProfile creates the given device-profile.
 // This will create the device-profile at the network-server side and will
 // create a local reference record.
@@ -57,6 +172,7 @@ func CreateDeviceProfile(db sqlx.Ext, dp *DeviceProfile) error {
 	dp.DeviceProfile.Id = dpID.Bytes()
 	dp.CreatedAt = now
 	dp.UpdatedAt = now
+	rxDelay, rx1DROffset, rx2DR, cFList := joinAcceptValues(dp.JoinAccept)
 
 	_, err = db.Exec(`
         insert into device_profile (
@@ -65,14 +181,24 @@ func CreateDeviceProfile(db sqlx.Ext, dp *DeviceProfile) error {
             organization_id,
             created_at,
             updated_at,
-            name
-		) values ($1, $2, $3, $4, $5, $6)`,
+            name,
+            join_accept_rx_delay,
+            join_accept_rx1_dr_offset,
+            join_accept_rx2_dr,
+            join_accept_cflist,
+            f_ports
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
 		dpID,
 		dp.NetworkServerID,
 		dp.OrganizationID,
 		dp.CreatedAt,
 		dp.UpdatedAt,
 		dp.Name,
+		rxDelay,
+		rx1DROffset,
+		rx2DR,
+		cFList,
+		dp.FPorts,
 	)
 	if err != nil {
 		log.WithField("id", dpID).Errorf("create device-profile error: %s", err)
@@ -113,7 +239,12 @@ func GetDeviceProfile(db sqlx.Queryer, id uuid.UUID) (DeviceProfile, error) {
 			organization_id,
 			created_at,
 			updated_at,
-			name
+			name,
+			join_accept_rx_delay,
+			join_accept_rx1_dr_offset,
+			join_accept_rx2_dr,
+			join_accept_cflist,
+			f_ports
 		from device_profile
 		where
 			device_profile_id = $1`,
@@ -123,10 +254,13 @@ func GetDeviceProfile(db sqlx.Queryer, id uuid.UUID) (DeviceProfile, error) {
 		return dp, handlePSQLError(Select, err, "select error")
 	}
 
-	err := row.Scan(&dp.NetworkServerID, &dp.OrganizationID, &dp.CreatedAt, &dp.UpdatedAt, &dp.Name)
+	var rxDelay, rx1DROffset, rx2DR *int
+	var cFList []byte
+	err := row.Scan(&dp.NetworkServerID, &dp.OrganizationID, &dp.CreatedAt, &dp.UpdatedAt, &dp.Name, &rxDelay, &rx1DROffset, &rx2DR, &cFList, &dp.FPorts)
 	if err != nil {
 		return dp, handlePSQLError(Scan, err, "scan error")
 	}
+	dp.JoinAccept = joinAcceptFromValues(rxDelay, rx1DROffset, rx2DR, cFList)
 
 	n, err := GetNetworkServer(db, dp.NetworkServerID)
 	if err != nil {
@@ -153,6 +287,57 @@ func GetDeviceProfile(db sqlx.Queryer, id uuid.UUID) (DeviceProfile, error) {
 	return dp, nil
 }
 
+// GetDeviceProfileJoinAcceptForDevEUI returns the join-accept parameters of
+// the device-profile used by the given device. As this only reads the local
+// reference record, no call is made to the network-server. In case no
+// join-accept parameters are set, nil is returned.
+func GetDeviceProfileJoinAcceptForDevEUI(db sqlx.Queryer, devEUI lorawan.EUI64) (*JoinAccept, error) {
+	var rxDelay, rx1DROffset, rx2DR *int
+	var cFList []byte
+
+	err := db.QueryRowx(`
+		select
+			dp.join_accept_rx_delay,
+			dp.join_accept_rx1_dr_offset,
+			dp.join_accept_rx2_dr,
+			dp.join_accept_cflist
+		from device_profile dp
+		inner join device d
+			on d.device_profile_id = dp.device_profile_id
+		where
+			d.dev_eui = $1`,
+		devEUI[:],
+	).Scan(&rxDelay, &rx1DROffset, &rx2DR, &cFList)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return joinAcceptFromValues(rxDelay, rx1DROffset, rx2DR, cFList), nil
+}
+
+// GetDeviceProfileFPortsForDevEUI returns the expected FPorts of the
+// device-profile used by the given device. As this only reads the local
+// reference record, no call is made to the network-server.
+func GetDeviceProfileFPortsForDevEUI(db sqlx.Queryer, devEUI lorawan.EUI64) (FPorts, error) {
+	var fPorts FPorts
+
+	err := sqlx.Get(db, &fPorts, `
+		select
+			dp.f_ports
+		from device_profile dp
+		inner join device d
+			on d.device_profile_id = dp.device_profile_id
+		where
+			d.dev_eui = $1`,
+		devEUI[:],
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return fPorts, nil
+}
+
 // UpdateDeviceProfile updates the given device-profile.
 func UpdateDeviceProfile(db sqlx.Ext, dp *DeviceProfile) error {
 	if err := dp.Validate(); err != nil {
@@ -182,16 +367,27 @@ func UpdateDeviceProfile(db sqlx.Ext, dp *DeviceProfile) error {
 	}
 
 	dp.UpdatedAt = time.Now()
+	rxDelay, rx1DROffset, rx2DR, cFList := joinAcceptValues(dp.JoinAccept)
 
 	res, err := db.Exec(`
         update device_profile
         set
             updated_at = $2,
-            name = $3
+            name = $3,
+            join_accept_rx_delay = $4,
+            join_accept_rx1_dr_offset = $5,
+            join_accept_rx2_dr = $6,
+            join_accept_cflist = $7,
+            f_ports = $8
 		where device_profile_id = $1`,
 		dpID,
 		dp.UpdatedAt,
 		dp.Name,
+		rxDelay,
+		rx1DROffset,
+		rx2DR,
+		cFList,
+		dp.FPorts,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
@@ -279,9 +475,9 @@ func GetDeviceProfileCountForUser(db sqlx.Queryer, username string) (int, error)
 		from device_profile dp
 		inner join organization o
 			on o.id = dp.organization_id
-		inner join organization_user ou
+		inner join principal_organization ou
 			on ou.organization_id = o.id
-		inner join "user" u
+		inner join principal u
 			on u.id = ou.user_id
 		where
 			u.username = $1`,
@@ -366,9 +562,9 @@ func GetDeviceProfilesForUser(db sqlx.Queryer, username string, limit, offset in
 		from device_profile dp
 		inner join organization o
 			on o.id = dp.organization_id
-		inner join organization_user ou
+		inner join principal_organization ou
 			on ou.organization_id = o.id
-		inner join "user" u
+		inner join principal u
 			on u.id = ou.user_id
 		where
 			u.username = $1
@@ -433,3 +629,29 @@ func DeleteAllDeviceProfilesForOrganizationID(db sqlx.Ext, organizationID int64)
 
 	return nil
 }
+
+// joinAcceptValues returns the (nullable) column values for the given
+// join-accept parameters.
+func joinAcceptValues(ja *JoinAccept) (rxDelay, rx1DROffset, rx2DR *int, cFList []byte) {
+	if ja == nil {
+		return nil, nil, nil, nil
+	}
+	if len(ja.CFList) != 0 {
+		cFList = ja.CFList
+	}
+	return &ja.RXDelay, &ja.RX1DROffset, &ja.RX2DR, cFList
+}
+
+// joinAcceptFromValues returns the join-accept parameters for the given
+// (nullable) column values.
+func joinAcceptFromValues(rxDelay, rx1DROffset, rx2DR *int, cFList []byte) *JoinAccept {
+	if rxDelay == nil || rx1DROffset == nil || rx2DR == nil {
+		return nil
+	}
+	return &JoinAccept{
+		RXDelay:     *rxDelay,
+		RX1DROffset: *rx1DROffset,
+		RX2DR:       *rx2DR,
+		CFList:      cFList,
+	}
+}
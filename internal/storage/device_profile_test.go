@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
 
 	"github.com/brocaar/loraserver/api/ns"
 
@@ -53,6 +54,9 @@ func TestDeviceProfile(t *testing.T) {
 				NetworkServerID: n.ID,
 				OrganizationID:  org.ID,
 				Name:            "device-profile",
+				FPorts: FPorts{
+					{FPort: 1, Label: "telemetry", Description: "Sensor readings"},
+				},
 				DeviceProfile: ns.DeviceProfile{
 					SupportsClassB:     true,
 					ClassBTimeout:      10,
@@ -137,6 +141,82 @@ func TestDeviceProfile(t *testing.T) {
 				So(dpGet.UpdatedAt, ShouldResemble, dp.UpdatedAt)
 			})
 
+			Convey("Then UpdateDeviceProfile updates the join-accept parameters", func() {
+				dp.JoinAccept = &JoinAccept{
+					RXDelay:     3,
+					RX1DROffset: 1,
+					RX2DR:       3,
+					CFList:      []byte{0x18, 0x4f, 0x84, 0xe8, 0x56, 0x84, 0xb8, 0x5e, 0x84, 0x88, 0x66, 0x84, 0x58, 0x6e, 0x84, 0x00},
+				}
+				So(UpdateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+				<-nsClient.UpdateDeviceProfileChan
+
+				dpGet, err := GetDeviceProfile(config.C.PostgreSQL.DB, dpID)
+				So(err, ShouldBeNil)
+				So(dpGet.JoinAccept, ShouldResemble, dp.JoinAccept)
+
+				Convey("Then invalid join-accept parameters are rejected", func() {
+					dp.JoinAccept.RXDelay = 16
+					So(errors.Cause(UpdateDeviceProfile(config.C.PostgreSQL.DB, &dp)), ShouldEqual, ErrNodeMaxRXDelay)
+
+					dp.JoinAccept.RXDelay = 1
+					dp.JoinAccept.RX1DROffset = 8
+					So(errors.Cause(UpdateDeviceProfile(config.C.PostgreSQL.DB, &dp)), ShouldEqual, ErrInvalidJoinAcceptDLSettings)
+
+					dp.JoinAccept.RX1DROffset = 0
+					dp.JoinAccept.CFList = []byte{1, 2, 3}
+					So(errors.Cause(UpdateDeviceProfile(config.C.PostgreSQL.DB, &dp)), ShouldEqual, ErrInvalidCFList)
+				})
+
+				Convey("Then removing the join-accept parameters sets them to nil", func() {
+					dp.JoinAccept = nil
+					So(UpdateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+					<-nsClient.UpdateDeviceProfileChan
+
+					dpGet, err := GetDeviceProfile(config.C.PostgreSQL.DB, dpID)
+					So(err, ShouldBeNil)
+					So(dpGet.JoinAccept, ShouldBeNil)
+				})
+			})
+
+			Convey("Then UpdateDeviceProfile updates the expected fPorts", func() {
+				dp.FPorts = FPorts{
+					{FPort: 1, Label: "telemetry"},
+					{FPort: 2, Label: "config-ack"},
+					{FPort: 201, Label: "fuota", Description: "Firmware update"},
+				}
+				So(UpdateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+				<-nsClient.UpdateDeviceProfileChan
+
+				dpGet, err := GetDeviceProfile(config.C.PostgreSQL.DB, dpID)
+				So(err, ShouldBeNil)
+				So(dpGet.FPorts, ShouldResemble, dp.FPorts)
+
+				p, ok := dpGet.FPorts.Get(2)
+				So(ok, ShouldBeTrue)
+				So(p.Label, ShouldEqual, "config-ack")
+
+				_, ok = dpGet.FPorts.Get(3)
+				So(ok, ShouldBeFalse)
+
+				Convey("Then invalid fPorts are rejected", func() {
+					dp.FPorts = FPorts{{FPort: 0, Label: "telemetry"}}
+					So(errors.Cause(UpdateDeviceProfile(config.C.PostgreSQL.DB, &dp)), ShouldEqual, ErrInvalidFPort)
+
+					dp.FPorts = FPorts{{FPort: 224, Label: "telemetry"}}
+					So(errors.Cause(UpdateDeviceProfile(config.C.PostgreSQL.DB, &dp)), ShouldEqual, ErrInvalidFPort)
+
+					dp.FPorts = FPorts{{FPort: 1, Label: "telemetry"}, {FPort: 1, Label: "config-ack"}}
+					So(errors.Cause(UpdateDeviceProfile(config.C.PostgreSQL.DB, &dp)), ShouldEqual, ErrInvalidFPort)
+
+					dp.FPorts = FPorts{{FPort: 1, Label: ""}}
+					So(errors.Cause(UpdateDeviceProfile(config.C.PostgreSQL.DB, &dp)), ShouldEqual, ErrInvalidFPortLabel)
+
+					dp.FPorts = FPorts{{FPort: 1, Label: "config ack"}}
+					So(errors.Cause(UpdateDeviceProfile(config.C.PostgreSQL.DB, &dp)), ShouldEqual, ErrInvalidFPortLabel)
+				})
+			})
+
 			Convey("Then DeleteDeviceProfile deletes the device-profile", func() {
 				So(DeleteDeviceProfile(config.C.PostgreSQL.DB, dpID), ShouldBeNil)
 				So(nsClient.DeleteDeviceProfileChan, ShouldHaveLength, 1)
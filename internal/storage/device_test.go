@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
 	. "github.com/smartystreets/goconvey/convey"
 
 	"github.com/brocaar/lora-app-server/internal/config"
@@ -109,15 +110,19 @@ func TestDevice(t *testing.T) {
 			eleven := 11
 
 			d := Device{
-				DevEUI:              lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
-				ApplicationID:       app.ID,
-				DeviceProfileID:     dpID,
-				Name:                "test-device",
-				Description:         "test device",
-				DeviceStatusBattery: &ten,
-				DeviceStatusMargin:  &eleven,
-				SkipFCntCheck:       true,
-				ReferenceAltitude:   5.6,
+				DevEUI:                lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+				ApplicationID:         app.ID,
+				DeviceProfileID:       dpID,
+				Name:                  "test-device",
+				Description:           "test device",
+				DeviceStatusBattery:   &ten,
+				DeviceStatusMargin:    &eleven,
+				SkipFCntCheck:         true,
+				ReferenceAltitude:     5.6,
+				SuppressFCntAnomalies: true,
+				Variables: DeviceVariables{
+					"ThingsBoardAccessToken": "secret-token",
+				},
 			}
 			So(CreateDevice(config.C.PostgreSQL.DB, &d), ShouldBeNil)
 			d.CreatedAt = d.CreatedAt.UTC().Truncate(time.Millisecond)
@@ -148,6 +153,16 @@ func TestDevice(t *testing.T) {
 				So(count, ShouldEqual, 1)
 			})
 
+			Convey("Then listing the devices after the device returns 0 items", func() {
+				devices, err := GetDevices(db, DeviceFilters{Limit: 10, AfterName: d.Name, AfterDevEUI: &d.DevEUI})
+				So(err, ShouldBeNil)
+				So(devices, ShouldHaveLength, 0)
+
+				devices, err = GetDevices(db, DeviceFilters{Limit: 10, ApplicationID: app.ID, AfterName: "a", AfterDevEUI: &d.DevEUI})
+				So(err, ShouldBeNil)
+				So(devices, ShouldHaveLength, 1)
+			})
+
 			Convey("Then the device can be listed and counted by application id", func() {
 				devices, err := GetDevices(db, DeviceFilters{Limit: 10, ApplicationID: app.ID})
 				So(err, ShouldBeNil)
@@ -196,6 +211,9 @@ func TestDevice(t *testing.T) {
 					d.Latitude = &lat
 					d.Longitude = &long
 					d.Altitude = &alt
+					d.Variables = DeviceVariables{
+						"foo": "bar",
+					}
 
 					So(UpdateDevice(config.C.PostgreSQL.DB, &d, false), ShouldBeNil)
 					d.UpdatedAt = d.UpdatedAt.UTC().Truncate(time.Millisecond)
@@ -270,6 +288,30 @@ func TestDevice(t *testing.T) {
 					})
 				})
 
+				Convey("Then CreateDeviceDevNonce stores the dev-nonce", func() {
+					dn := DeviceDevNonce{
+						DevEUI:   d.DevEUI,
+						DevNonce: 258,
+					}
+					So(CreateDeviceDevNonce(config.C.PostgreSQL.DB, &dn), ShouldBeNil)
+
+					dns, err := GetDeviceDevNoncesForDevEUI(config.C.PostgreSQL.DB, d.DevEUI)
+					So(err, ShouldBeNil)
+					So(dns, ShouldHaveLength, 1)
+					So(dns[0].DevNonce, ShouldEqual, 258)
+
+					Convey("Then storing the same dev-nonce again returns an error", func() {
+						So(CreateDeviceDevNonce(config.C.PostgreSQL.DB, &dn), ShouldEqual, ErrAlreadyExists)
+					})
+
+					Convey("Then DeleteDeviceDevNoncesForDevEUI deletes the dev-nonces", func() {
+						So(DeleteDeviceDevNoncesForDevEUI(config.C.PostgreSQL.DB, d.DevEUI), ShouldBeNil)
+						dns, err := GetDeviceDevNoncesForDevEUI(config.C.PostgreSQL.DB, d.DevEUI)
+						So(err, ShouldBeNil)
+						So(dns, ShouldHaveLength, 0)
+					})
+				})
+
 				Convey("Then CreateDeviceActivation creates the device-activation", func() {
 					da := DeviceActivation{
 						DevEUI:  d.DevEUI,
@@ -298,6 +340,132 @@ func TestDevice(t *testing.T) {
 						daGet.CreatedAt = daGet.CreatedAt.UTC().Truncate(time.Millisecond)
 						So(daGet, ShouldResemble, da2)
 					})
+
+					Convey("Then GetDeviceActivationsForDevEUI returns the activations, most recent first", func() {
+						da2 := DeviceActivation{
+							DevEUI:  d.DevEUI,
+							DevAddr: lorawan.DevAddr{4, 3, 2, 1},
+							AppSKey: lorawan.AES128Key{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2},
+						}
+						So(CreateDeviceActivation(config.C.PostgreSQL.DB, &da2), ShouldBeNil)
+
+						das, err := GetDeviceActivationsForDevEUI(config.C.PostgreSQL.DB, d.DevEUI, 10)
+						So(err, ShouldBeNil)
+						So(das, ShouldHaveLength, 2)
+						So(das[0].DevAddr, ShouldEqual, da2.DevAddr)
+						So(das[0].AppSKey, ShouldEqual, da2.AppSKey)
+						So(das[1].DevAddr, ShouldEqual, da.DevAddr)
+						So(das[1].AppSKey, ShouldEqual, da.AppSKey)
+
+						das, err = GetDeviceActivationsForDevEUI(config.C.PostgreSQL.DB, d.DevEUI, 1)
+						So(err, ShouldBeNil)
+						So(das, ShouldHaveLength, 1)
+						So(das[0].DevAddr, ShouldEqual, da2.DevAddr)
+					})
+
+					Convey("Given a storage KEK", func() {
+						config.C.JoinServer.KEK.StorageKEKLabel = "storage"
+						config.C.JoinServer.KEK.Set = []struct {
+							Label string `mapstructure:"label"`
+							KEK   string `mapstructure:"kek"`
+						}{
+							{Label: "storage", KEK: "01020304050607080102030405060708"},
+						}
+						defer func() {
+							config.C.JoinServer.KEK.StorageKEKLabel = ""
+							config.C.JoinServer.KEK.Set = nil
+						}()
+
+						count, err := GetDeviceActivationKeysToRewrapCount(config.C.PostgreSQL.DB)
+						So(err, ShouldBeNil)
+						So(count, ShouldEqual, 1)
+
+						Convey("Then RewrapDeviceActivationKeys re-wraps the AppSKey", func() {
+							n, err := RewrapDeviceActivationKeys(config.C.PostgreSQL.DB, 10)
+							So(err, ShouldBeNil)
+							So(n, ShouldEqual, 1)
+
+							count, err := GetDeviceActivationKeysToRewrapCount(config.C.PostgreSQL.DB)
+							So(err, ShouldBeNil)
+							So(count, ShouldEqual, 0)
+
+							daGet, err := GetLastDeviceActivationForDevEUI(config.C.PostgreSQL.DB, d.DevEUI)
+							So(err, ShouldBeNil)
+							So(daGet.AppSKey, ShouldEqual, da.AppSKey)
+						})
+
+						Convey("Given per-organization data-keys", func() {
+							config.C.JoinServer.KEK.OrganizationDataKeys = true
+							defer func() {
+								config.C.JoinServer.KEK.OrganizationDataKeys = false
+							}()
+
+							Convey("Then CreateDeviceActivation wraps the AppSKey using the organization data-key", func() {
+								da2 := DeviceActivation{
+									DevEUI:  d.DevEUI,
+									DevAddr: lorawan.DevAddr{4, 3, 2, 1},
+									AppSKey: lorawan.AES128Key{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2},
+								}
+								So(CreateDeviceActivation(config.C.PostgreSQL.DB, &da2), ShouldBeNil)
+
+								var label string
+								So(sqlx.Get(config.C.PostgreSQL.DB, &label, "select app_s_key_kek_label from device_activation where id = $1", da2.ID), ShouldBeNil)
+								So(label, ShouldEqual, organizationKeyLabel(org.ID))
+
+								das, err := GetDeviceActivationsForDevEUI(config.C.PostgreSQL.DB, d.DevEUI, 10)
+								So(err, ShouldBeNil)
+								So(das, ShouldHaveLength, 2)
+								So(das[0].AppSKey, ShouldEqual, da2.AppSKey)
+								So(das[1].AppSKey, ShouldEqual, da.AppSKey)
+							})
+
+							Convey("Then RewrapDeviceActivationKeys re-wraps the AppSKey using the organization data-key", func() {
+								n, err := RewrapDeviceActivationKeys(config.C.PostgreSQL.DB, 10)
+								So(err, ShouldBeNil)
+								So(n, ShouldEqual, 1)
+
+								count, err := GetDeviceActivationKeysToRewrapCount(config.C.PostgreSQL.DB)
+								So(err, ShouldBeNil)
+								So(count, ShouldEqual, 0)
+
+								daGet, err := GetLastDeviceActivationForDevEUI(config.C.PostgreSQL.DB, d.DevEUI)
+								So(err, ShouldBeNil)
+								So(daGet.AppSKey, ShouldEqual, da.AppSKey)
+
+								Convey("When rotating the storage KEK", func() {
+									config.C.JoinServer.KEK.StorageKEKLabel = "storage2"
+									config.C.JoinServer.KEK.Set = append(config.C.JoinServer.KEK.Set, struct {
+										Label string `mapstructure:"label"`
+										KEK   string `mapstructure:"kek"`
+									}{Label: "storage2", KEK: "08070605040302010807060504030201"})
+
+									Convey("Then only the organization data-key is re-wrapped", func() {
+										count, err := GetDeviceActivationKeysToRewrapCount(config.C.PostgreSQL.DB)
+										So(err, ShouldBeNil)
+										So(count, ShouldEqual, 0)
+
+										count, err = GetOrganizationDataKeysToRewrapCount(config.C.PostgreSQL.DB)
+										So(err, ShouldBeNil)
+										So(count, ShouldEqual, 1)
+
+										n, err := RewrapOrganizationDataKeys(config.C.PostgreSQL.DB, 10)
+										So(err, ShouldBeNil)
+										So(n, ShouldEqual, 1)
+
+										daGet, err := GetLastDeviceActivationForDevEUI(config.C.PostgreSQL.DB, d.DevEUI)
+										So(err, ShouldBeNil)
+										So(daGet.AppSKey, ShouldEqual, da.AppSKey)
+									})
+								})
+							})
+						})
+					})
+
+					Convey("Then DeleteDeviceActivationsForDevice deletes the device-activations", func() {
+						So(DeleteDeviceActivationsForDevice(config.C.PostgreSQL.DB, d.DevEUI), ShouldBeNil)
+						_, err := GetLastDeviceActivationForDevEUI(config.C.PostgreSQL.DB, d.DevEUI)
+						So(err, ShouldEqual, ErrDoesNotExist)
+					})
 				})
 			})
 		})
@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceTwinDocument defines a (JSON) state document of a device twin.
+type DeviceTwinDocument map[string]interface{}
+
+// Value implements the driver.Valuer interface.
+func (d DeviceTwinDocument) Value() (driver.Value, error) {
+	if d == nil {
+		d = DeviceTwinDocument{}
+	}
+	return json.Marshal(d)
+}
+
+// Scan implements the sql.Scanner interface.
+func (d *DeviceTwinDocument) Scan(src interface{}) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("expected []byte, got %T", src)
+	}
+	return json.Unmarshal(b, d)
+}
+
+// DeviceTwin contains the reported and desired state of a device. The
+// metadata documents mirror the structure of the state documents and
+// contain the timestamp of the last update of each field.
+type DeviceTwin struct {
+	DevEUI           lorawan.EUI64      `db:"dev_eui"`
+	CreatedAt        time.Time          `db:"created_at"`
+	UpdatedAt        time.Time          `db:"updated_at"`
+	Version          int64              `db:"version"`
+	Reported         DeviceTwinDocument `db:"reported"`
+	ReportedMetadata DeviceTwinDocument `db:"reported_metadata"`
+	Desired          DeviceTwinDocument `db:"desired"`
+	DesiredMetadata  DeviceTwinDocument `db:"desired_metadata"`
+}
+
+// GetDeviceTwin returns the twin of the given device. When no twin has been
+// stored, the twin is returned with empty documents.
+// When forUpdate is set to true, then db must be a db transaction.
+func GetDeviceTwin(db sqlx.Queryer, devEUI lorawan.EUI64, forUpdate bool) (DeviceTwin, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var t DeviceTwin
+	err := sqlx.Get(db, &t, "select * from device_twin where dev_eui = $1"+fu, devEUI[:])
+	if err != nil {
+		err = handlePSQLError(Select, err, "select error")
+		if err == ErrDoesNotExist {
+			return DeviceTwin{
+				DevEUI:           devEUI,
+				Reported:         DeviceTwinDocument{},
+				ReportedMetadata: DeviceTwinDocument{},
+				Desired:          DeviceTwinDocument{},
+				DesiredMetadata:  DeviceTwinDocument{},
+			}, nil
+		}
+		return t, err
+	}
+
+	return t, nil
+}
+
+// SetDeviceTwin creates or updates the given device twin. The version of the
+// twin is incremented.
+func SetDeviceTwin(db sqlx.Queryer, t *DeviceTwin) error {
+	now := time.Now()
+	err := sqlx.Get(db, t, `
+		insert into device_twin (
+			dev_eui,
+			created_at,
+			updated_at,
+			version,
+			reported,
+			reported_metadata,
+			desired,
+			desired_metadata
+		) values ($1, $2, $2, 1, $3, $4, $5, $6)
+		on conflict (dev_eui) do update
+		set
+			updated_at = excluded.updated_at,
+			version = device_twin.version + 1,
+			reported = excluded.reported,
+			reported_metadata = excluded.reported_metadata,
+			desired = excluded.desired,
+			desired_metadata = excluded.desired_metadata
+		returning *`,
+		t.DevEUI[:],
+		now,
+		t.Reported,
+		t.ReportedMetadata,
+		t.Desired,
+		t.DesiredMetadata,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeviceTwin() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	sp := ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+	app := Application{
+		Name:           "test-app",
+		OrganizationID: org.ID,
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	dp := DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	var dpID uuid.UUID
+	copy(dpID[:], dp.DeviceProfile.Id)
+
+	d := Device{
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		ApplicationID:   app.ID,
+		DeviceProfileID: dpID,
+		Name:            "test-device",
+	}
+	assert.NoError(CreateDevice(ts.Tx(), &d))
+
+	ts.T().Run("Does not exist", func(t *testing.T) {
+		assert := require.New(t)
+
+		twin, err := GetDeviceTwin(ts.Tx(), d.DevEUI, false)
+		assert.NoError(err)
+		assert.Equal(d.DevEUI, twin.DevEUI)
+		assert.EqualValues(0, twin.Version)
+		assert.Equal(DeviceTwinDocument{}, twin.Reported)
+		assert.Equal(DeviceTwinDocument{}, twin.Desired)
+	})
+
+	ts.T().Run("Create and update", func(t *testing.T) {
+		assert := require.New(t)
+
+		twin, err := GetDeviceTwin(ts.Tx(), d.DevEUI, true)
+		assert.NoError(err)
+
+		twin.Reported["temperature"] = 21.5
+		assert.NoError(SetDeviceTwin(ts.Tx(), &twin))
+		assert.EqualValues(1, twin.Version)
+
+		twin.Desired["interval"] = 60.0
+		assert.NoError(SetDeviceTwin(ts.Tx(), &twin))
+		assert.EqualValues(2, twin.Version)
+
+		twinGet, err := GetDeviceTwin(ts.Tx(), d.DevEUI, false)
+		assert.NoError(err)
+		assert.EqualValues(2, twinGet.Version)
+		assert.Equal(DeviceTwinDocument{"temperature": 21.5}, twinGet.Reported)
+		assert.Equal(DeviceTwinDocument{"interval": 60.0}, twinGet.Desired)
+		assert.True(twinGet.UpdatedAt.After(twinGet.CreatedAt) || twinGet.UpdatedAt.Equal(twinGet.CreatedAt))
+	})
+}
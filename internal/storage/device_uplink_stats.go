@@ -0,0 +1,199 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceUplinkStats contains the aggregated uplink statistics of a device
+// for a single hour, FPort, data-rate and payload size.
+type DeviceUplinkStats struct {
+	DevEUI          lorawan.EUI64 `db:"dev_eui"`
+	Hour            time.Time     `db:"hour"` // truncated to the hour on insert
+	FPort           int           `db:"f_port"`
+	DR              int           `db:"dr"`
+	PayloadSize     int           `db:"payload_size"`
+	SpreadingFactor int           `db:"spreading_factor"`
+	UplinkCount     int64         `db:"uplink_count"`
+	Airtime         float64       `db:"airtime"` // in seconds
+}
+
+// UplinkStatsFilter defines the filter of the uplink statistics. The
+// statistics of which the hour is within [Start, End) are selected.
+type UplinkStatsFilter struct {
+	ApplicationID int64
+	Start         time.Time
+	End           time.Time
+}
+
+// UplinkStatsCount contains the number of uplinks, payload bytes and
+// airtime for a single value (e.g. FPort or payload size).
+type UplinkStatsCount struct {
+	Value        int     `db:"value"`
+	UplinkCount  int64   `db:"uplink_count"`
+	PayloadBytes int64   `db:"payload_bytes"`
+	Airtime      float64 `db:"airtime"`
+}
+
+// DeviceUplinkStatsSummary contains the uplink statistics of a device.
+type DeviceUplinkStatsSummary struct {
+	DevEUI       lorawan.EUI64 `db:"dev_eui"`
+	Name         string        `db:"name"`
+	UplinkCount  int64         `db:"uplink_count"`
+	PayloadBytes int64         `db:"payload_bytes"`
+	Airtime      float64       `db:"airtime"`
+
+	// MaxSpreadingFactor contains the highest spreading-factor used by the
+	// device (0 when unknown).
+	MaxSpreadingFactor int `db:"max_spreading_factor"`
+}
+
+// IncrementDeviceUplinkStats adds the given statistics (e.g. a single
+// uplink) to the stored statistics.
+func IncrementDeviceUplinkStats(db sqlx.Execer, s DeviceUplinkStats) error {
+	_, err := db.Exec(`
+		insert into device_uplink_stats (
+			dev_eui,
+			hour,
+			f_port,
+			dr,
+			payload_size,
+			spreading_factor,
+			uplink_count,
+			airtime
+		) values ($1, $2, $3, $4, $5, $6, $7, $8)
+		on conflict (dev_eui, hour, f_port, dr, payload_size) do update
+		set
+			spreading_factor = excluded.spreading_factor,
+			uplink_count = device_uplink_stats.uplink_count + excluded.uplink_count,
+			airtime = device_uplink_stats.airtime + excluded.airtime`,
+		s.DevEUI[:],
+		s.Hour.Truncate(time.Hour),
+		s.FPort,
+		s.DR,
+		s.PayloadSize,
+		s.SpreadingFactor,
+		s.UplinkCount,
+		s.Airtime,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	return nil
+}
+
+// uplinkStatsColumns contains the columns of the uplink statistics which
+// can be aggregated by GetUplinkStatsCounts.
+var uplinkStatsColumns = map[string]bool{
+	"f_port":           true,
+	"payload_size":     true,
+	"spreading_factor": true,
+	"dr":               true,
+}
+
+// GetUplinkStatsCounts returns the uplink statistics of the devices of the
+// given application, aggregated by the given column (f_port, payload_size,
+// spreading_factor or dr).
+func GetUplinkStatsCounts(db sqlx.Queryer, filter UplinkStatsFilter, column string) ([]UplinkStatsCount, error) {
+	if !uplinkStatsColumns[column] {
+		return nil, fmt.Errorf("invalid uplink stats column: %s", column)
+	}
+
+	var counts []UplinkStatsCount
+	err := sqlx.Select(db, &counts, `
+		select
+			s.`+pq.QuoteIdentifier(column)+` as value,
+			sum(s.uplink_count) as uplink_count,
+			sum(s.uplink_count * s.payload_size) as payload_bytes,
+			sum(s.airtime) as airtime
+		from device_uplink_stats s
+		inner join device d
+			on d.dev_eui = s.dev_eui
+		where
+			d.application_id = $1
+			and s.hour >= $2
+			and s.hour < $3
+		group by 1
+		order by 1`,
+		filter.ApplicationID,
+		filter.Start,
+		filter.End,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return counts, nil
+}
+
+// GetTopDevicesByAirtime returns the uplink statistics of the devices of
+// the given application, ordered by airtime (descending).
+func GetTopDevicesByAirtime(db sqlx.Queryer, filter UplinkStatsFilter, limit int) ([]DeviceUplinkStatsSummary, error) {
+	var devices []DeviceUplinkStatsSummary
+	err := sqlx.Select(db, &devices, `
+		select
+			d.dev_eui,
+			d.name,
+			sum(s.uplink_count) as uplink_count,
+			sum(s.uplink_count * s.payload_size) as payload_bytes,
+			sum(s.airtime) as airtime,
+			max(s.spreading_factor) as max_spreading_factor
+		from device_uplink_stats s
+		inner join device d
+			on d.dev_eui = s.dev_eui
+		where
+			d.application_id = $1
+			and s.hour >= $2
+			and s.hour < $3
+		group by d.dev_eui, d.name
+		order by airtime desc, d.name
+		limit $4`,
+		filter.ApplicationID,
+		filter.Start,
+		filter.End,
+		limit,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return devices, nil
+}
+
+// DeleteExpiredDeviceUplinkStats deletes the uplink statistics which are
+// older than the given retention (in days). A retention of 0 days means
+// that the statistics are kept forever. The statistics of devices under
+// legal hold are not deleted. It returns the number of deleted records.
+func DeleteExpiredDeviceUplinkStats(db sqlx.Execer, days int) (int64, error) {
+	if days == 0 {
+		return 0, nil
+	}
+
+	res, err := db.Exec(`
+		delete from device_uplink_stats
+		where
+			hour < now() - $1 * interval '1 day'
+			and dev_eui not in (
+				select
+					d.dev_eui
+				from device d
+				inner join application a
+					on a.id = d.application_id
+				where
+					d.legal_hold_at is not null
+					or a.legal_hold_at is not null
+			)`,
+		days,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Delete, err, "delete error")
+	}
+
+	return res.RowsAffected()
+}
@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestDeviceUplinkStats() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	sp := ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+	app := Application{
+		Name:           "test-app",
+		OrganizationID: org.ID,
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	dp := DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	var dpID uuid.UUID
+	copy(dpID[:], dp.DeviceProfile.Id)
+
+	devices := []Device{
+		{
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dpID,
+			Name:            "device-1",
+		},
+		{
+			DevEUI:          lorawan.EUI64{2, 2, 3, 4, 5, 6, 7, 8},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dpID,
+			Name:            "device-2",
+		},
+	}
+	for i := range devices {
+		assert.NoError(CreateDevice(ts.Tx(), &devices[i]))
+	}
+
+	now := time.Now()
+	old := now.AddDate(0, 0, -30)
+
+	// device-1 sends small payloads at SF7, device-2 larger payloads at SF12
+	stats := []DeviceUplinkStats{
+		{DevEUI: devices[0].DevEUI, Hour: now, FPort: 1, DR: 5, PayloadSize: 10, SpreadingFactor: 7, UplinkCount: 1, Airtime: 0.05},
+		{DevEUI: devices[0].DevEUI, Hour: now, FPort: 1, DR: 5, PayloadSize: 10, SpreadingFactor: 7, UplinkCount: 1, Airtime: 0.05},
+		{DevEUI: devices[1].DevEUI, Hour: now, FPort: 2, DR: 0, PayloadSize: 50, SpreadingFactor: 12, UplinkCount: 1, Airtime: 2},
+		{DevEUI: devices[1].DevEUI, Hour: old, FPort: 2, DR: 0, PayloadSize: 50, SpreadingFactor: 12, UplinkCount: 1, Airtime: 2},
+	}
+	for _, s := range stats {
+		assert.NoError(IncrementDeviceUplinkStats(ts.Tx(), s))
+	}
+
+	filter := UplinkStatsFilter{
+		ApplicationID: app.ID,
+		Start:         now.AddDate(0, 0, -7),
+		End:           now,
+	}
+
+	ts.T().Run("GetUplinkStatsCounts", func(t *testing.T) {
+		assert := require.New(t)
+
+		counts, err := GetUplinkStatsCounts(ts.Tx(), filter, "f_port")
+		assert.NoError(err)
+		assert.Equal([]UplinkStatsCount{
+			{Value: 1, UplinkCount: 2, PayloadBytes: 20, Airtime: 0.1},
+			{Value: 2, UplinkCount: 1, PayloadBytes: 50, Airtime: 2},
+		}, counts)
+
+		counts, err = GetUplinkStatsCounts(ts.Tx(), filter, "spreading_factor")
+		assert.NoError(err)
+		assert.Len(counts, 2)
+		assert.Equal(7, counts[0].Value)
+		assert.Equal(12, counts[1].Value)
+
+		_, err = GetUplinkStatsCounts(ts.Tx(), filter, "dev_eui")
+		assert.Error(err)
+	})
+
+	ts.T().Run("GetTopDevicesByAirtime", func(t *testing.T) {
+		assert := require.New(t)
+
+		top, err := GetTopDevicesByAirtime(ts.Tx(), filter, 10)
+		assert.NoError(err)
+		assert.Equal([]DeviceUplinkStatsSummary{
+			{DevEUI: devices[1].DevEUI, Name: "device-2", UplinkCount: 1, PayloadBytes: 50, Airtime: 2, MaxSpreadingFactor: 12},
+			{DevEUI: devices[0].DevEUI, Name: "device-1", UplinkCount: 2, PayloadBytes: 20, Airtime: 0.1, MaxSpreadingFactor: 7},
+		}, top)
+
+		top, err = GetTopDevicesByAirtime(ts.Tx(), filter, 1)
+		assert.NoError(err)
+		assert.Len(top, 1)
+	})
+
+	ts.T().Run("DeleteExpiredDeviceUplinkStats", func(t *testing.T) {
+		assert := require.New(t)
+
+		count, err := DeleteExpiredDeviceUplinkStats(ts.Tx(), 0)
+		assert.NoError(err)
+		assert.EqualValues(0, count)
+
+		count, err = DeleteExpiredDeviceUplinkStats(ts.Tx(), 7)
+		assert.NoError(err)
+		assert.EqualValues(1, count)
+	})
+}
@@ -21,20 +21,48 @@ const (
 
 // errors
 var (
-	ErrAlreadyExists                   = errors.New("object already exists")
-	ErrDoesNotExist                    = errors.New("object does not exist")
-	ErrUsedByOtherObjects              = errors.New("this object is used by other objects, remove them first")
-	ErrApplicationInvalidName          = errors.New("invalid application name")
-	ErrNodeInvalidName                 = errors.New("invalid node name")
-	ErrNodeMaxRXDelay                  = errors.New("max value of RXDelay is 15")
-	ErrCFListTooManyChannels           = errors.New("too many channels in channel-list")
-	ErrUserInvalidUsername             = errors.New("username name may only be composed of upper and lower case characters and digits")
-	ErrUserPasswordLength              = errors.New("passwords must be at least 6 characters long")
-	ErrInvalidUsernameOrPassword       = errors.New("invalid username or password")
-	ErrOrganizationInvalidName         = errors.New("invalid organization name")
-	ErrGatewayInvalidName              = errors.New("invalid gateway name")
-	ErrInvalidEmail                    = errors.New("invalid e-mail")
-	ErrInvalidGatewayDiscoveryInterval = errors.New("invalid gateway-discovery interval, it must be greater than 0")
+	ErrAlreadyExists                         = errors.New("object already exists")
+	ErrDoesNotExist                          = errors.New("object does not exist")
+	ErrUsedByOtherObjects                    = errors.New("this object is used by other objects, remove them first")
+	ErrApplicationInvalidName                = errors.New("invalid application name")
+	ErrApplicationInvalidGeolocationSettings = errors.New("invalid application geolocation settings, buffer frames and min interval must not be negative")
+	ErrApplicationInvalidFieldMappings       = errors.New("invalid application field mappings, the field must be set, the units must be known and compatible and the precision must be between 0 and 15")
+	ErrApplicationInvalidMQTTTopicTemplates  = errors.New("invalid application mqtt topic templates, the templates must be valid and the downlink topic template must contain the DevEUI")
+	ErrNodeInvalidName                       = errors.New("invalid node name")
+	ErrNodeMaxRXDelay                        = errors.New("max value of RXDelay is 15")
+	ErrCFListTooManyChannels                 = errors.New("too many channels in channel-list")
+	ErrUserInvalidUsername                   = errors.New("username may only be composed of upper and lower case characters and digits, optionally separated by one of the . _ @ + - characters")
+	ErrUserPasswordLength                    = errors.New("passwords must be at least 6 characters long")
+	ErrInvalidUsernameOrPassword             = errors.New("invalid username or password")
+	ErrOrganizationInvalidName               = errors.New("invalid organization name")
+	ErrOrganizationInvalidQuota              = errors.New("invalid organization quota, max device and gateway count must not be negative")
+	ErrOrganizationUserAdminAuditor          = errors.New("an organization admin user can not be an auditor")
+	ErrLegalHold                             = errors.New("object is under legal hold, the legal hold must be cleared first")
+	ErrOrganizationMaxDeviceCount            = errors.New("the max number of devices for this organization has been reached")
+	ErrOrganizationMaxGatewayCount           = errors.New("the max number of gateways for this organization has been reached")
+	ErrGatewayInvalidName                    = errors.New("invalid gateway name")
+	ErrInvalidEmail                          = errors.New("invalid e-mail")
+	ErrInvalidGatewayDiscoveryInterval       = errors.New("invalid gateway-discovery interval, it must be greater than 0")
+	ErrInvalidKEK                            = errors.New("invalid kek, it must be exactly 16 bytes when a kek label is set")
+	ErrInvalidJoinAcceptDLSettings           = errors.New("invalid join-accept dl-settings, max value of RX1DROffset is 7 and of RX2DR is 15")
+	ErrInvalidCFList                         = errors.New("invalid cflist, it must be exactly 16 bytes")
+	ErrInvalidColor                          = errors.New("invalid color, it must be in #rrggbb format")
+	ErrInvalidRegion                         = errors.New("invalid region")
+	ErrInvalidURL                            = errors.New("invalid url, it must be an absolute http or https url")
+	ErrInvalidLink                           = errors.New("invalid link, title and url are required")
+	ErrRegistrationDisabled                  = errors.New("registration is disabled")
+	ErrRegistrationInvalidInvite             = errors.New("invalid, expired or already used registration invite")
+	ErrRegistrationDomainNotAllowed          = errors.New("registration is not allowed for the domain of this e-mail address")
+	ErrRegistrationApproved                  = errors.New("registration has already been approved")
+	ErrServiceProfileInvalidLimits           = errors.New("invalid service-profile limits, limits must not be negative")
+	ErrServiceProfileMaxDeviceCount          = errors.New("the max number of devices for this service-profile has been reached")
+	ErrServiceProfileMaxUplinkRate           = errors.New("the max uplink rate of the service-profile has been exceeded")
+	ErrServiceProfileMaxPayloadSize          = errors.New("the max payload size of the service-profile has been exceeded")
+	ErrInvalidFPort                          = errors.New("invalid fPort, it must be between 1 and 223 and must be unique")
+	ErrInvalidFPortLabel                     = errors.New("invalid fPort label, it may only be composed of letters, digits, underscores and dashes")
+	ErrInvalidRetention                      = errors.New("invalid retention, the number of days must not be negative")
+	ErrServiceAccountInvalidName             = errors.New("invalid service account name, it must be set and may not exceed 100 characters")
+	ErrServiceAccountInvalidPublicKey        = errors.New("invalid service account public key, it must be a pem encoded rsa or ecdsa public key")
 )
 
 func handlePSQLError(action Action, err error, description string) error {
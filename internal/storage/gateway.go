@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/lib/pq"
@@ -270,32 +271,55 @@ func GetGatewayCount(db sqlx.Queryer, search string) (int, error) {
 	return count, nil
 }
 
-// GetGateways returns a slice of gateways sorted by name.
-func GetGateways(db sqlx.Queryer, limit, offset int, search string) ([]Gateway, error) {
+// GatewayCursor defines the position of a gateway within a list sorted by
+// name, used for cursor-based pagination.
+type GatewayCursor struct {
+	Name string
+	MAC  lorawan.EUI64
+}
+
+// args returns the cursor SQL arguments, which are nil when no cursor is set.
+func (c *GatewayCursor) args() (interface{}, interface{}) {
+	if c == nil {
+		return nil, nil
+	}
+	return c.Name, c.MAC[:]
+}
+
+// GetGateways returns a slice of gateways sorted by name. When after is set,
+// only the gateways sorted after the given cursor are returned.
+func GetGateways(db sqlx.Queryer, limit, offset int, search string, after *GatewayCursor) ([]Gateway, error) {
 	var gws []Gateway
 	if search != "" {
 		search = "%" + search + "%"
 	}
+	afterName, afterMAC := after.args()
 
 	err := sqlx.Select(db, &gws, `
 		select
 			*
 		from gateway
 		where
-			$3 = ''
-			or (
-				$3 != ''
-				and (
-					name ilike $3
-					or encode(mac, 'hex') ilike $3
+			(
+				$3 = ''
+				or (
+					$3 != ''
+					and (
+						name ilike $3
+						or encode(mac, 'hex') ilike $3
+					)
 				)
 			)
+			and ($4::text is null or (name, mac) > ($4, $5))
 		order by
-			name
+			name,
+			mac
 		limit $1 offset $2`,
 		limit,
 		offset,
 		search,
+		afterName,
+		afterMAC,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "select error")
@@ -331,6 +355,27 @@ func GetGatewaysForMACs(db sqlx.Queryer, macs []lorawan.EUI64) (map[lorawan.EUI6
 	return out, nil
 }
 
+// GetGatewayListVersion returns the version of the list of gateways. When
+// organizationID is 0, this covers the gateways of all organizations.
+func GetGatewayListVersion(db sqlx.Queryer, organizationID int64) (ListVersion, error) {
+	var v ListVersion
+	err := sqlx.Get(db, &v, `
+		select
+			count(*) as count,
+			max(updated_at) as updated_at
+		from gateway
+		where
+			$1 = 0
+			or organization_id = $1`,
+		organizationID,
+	)
+	if err != nil {
+		return v, errors.Wrap(err, "select error")
+	}
+
+	return v, nil
+}
+
 // GetGatewayCountForOrganizationID returns the total number of gateways
 // given an organization ID.
 func GetGatewayCountForOrganizationID(db sqlx.Queryer, organizationID int64, search string) (int, error) {
@@ -365,12 +410,14 @@ func GetGatewayCountForOrganizationID(db sqlx.Queryer, organizationID int64, sea
 }
 
 // GetGatewaysForOrganizationID returns a slice of gateways sorted by name
-// for the given organization ID.
-func GetGatewaysForOrganizationID(db sqlx.Queryer, organizationID int64, limit, offset int, search string) ([]Gateway, error) {
+// for the given organization ID. When after is set, only the gateways sorted
+// after the given cursor are returned.
+func GetGatewaysForOrganizationID(db sqlx.Queryer, organizationID int64, limit, offset int, search string, after *GatewayCursor) ([]Gateway, error) {
 	var gws []Gateway
 	if search != "" {
 		search = "%" + search + "%"
 	}
+	afterName, afterMAC := after.args()
 
 	err := sqlx.Select(db, &gws, `
 		select
@@ -388,13 +435,17 @@ func GetGatewaysForOrganizationID(db sqlx.Queryer, organizationID int64, limit,
 					)
 				)
 			)
+			and ($5::text is null or (name, mac) > ($5, $6))
 		order by
-			name
+			name,
+			mac
 		limit $2 offset $3`,
 		organizationID,
 		limit,
 		offset,
 		search,
+		afterName,
+		afterMAC,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "select error")
@@ -402,6 +453,63 @@ func GetGatewaysForOrganizationID(db sqlx.Queryer, organizationID int64, limit,
 	return gws, nil
 }
 
+// GatewayFilters provides filters that can be used to filter on gateways.
+// Note that empty values are not used as filters.
+type GatewayFilters struct {
+	OrganizationID  int64  `db:"organization_id"`
+	NetworkServerID int64  `db:"network_server_id"`
+	Search          string `db:"search"`
+}
+
+// SQL returns the SQL filter.
+func (f GatewayFilters) SQL() string {
+	var filters []string
+
+	if f.OrganizationID != 0 {
+		filters = append(filters, "organization_id = :organization_id")
+	}
+	if f.NetworkServerID != 0 {
+		filters = append(filters, "network_server_id = :network_server_id")
+	}
+	if f.Search != "" {
+		filters = append(filters, "(name ilike :search or encode(mac, 'hex') ilike :search)")
+	}
+
+	if len(filters) == 0 {
+		return ""
+	}
+
+	return "where " + strings.Join(filters, " and ")
+}
+
+// GetGatewaysForFilters returns all the gateways matching the given filters,
+// sorted by name. Note that empty values are not used as filters.
+func GetGatewaysForFilters(db sqlx.Queryer, filters GatewayFilters) ([]Gateway, error) {
+	if filters.Search != "" {
+		filters.Search = "%" + filters.Search + "%"
+	}
+
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			*
+		from gateway
+		`+filters.SQL()+`
+		order by
+			name,
+			mac`, filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "named query error")
+	}
+
+	var gws []Gateway
+	err = sqlx.Select(db, &gws, query, args...)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return gws, nil
+}
+
 // GetGatewayCountForUser returns the total number of gateways to which the
 // given user has access.
 func GetGatewayCountForUser(db sqlx.Queryer, username string, search string) (int, error) {
@@ -416,9 +524,9 @@ func GetGatewayCountForUser(db sqlx.Queryer, username string, search string) (in
 		from gateway g
 		inner join organization o
 			on o.id = g.organization_id
-		inner join organization_user ou
+		inner join principal_organization ou
 			on ou.organization_id = o.id
-		inner join "user" u
+		inner join principal u
 			on u.id = ou.user_id
 		where
 			u.username = $1
@@ -442,12 +550,14 @@ func GetGatewayCountForUser(db sqlx.Queryer, username string, search string) (in
 }
 
 // GetGatewaysForUser returns a slice of gateways sorted by name to which the
-// given user has access.
-func GetGatewaysForUser(db sqlx.Queryer, username string, limit, offset int, search string) ([]Gateway, error) {
+// given user has access. When after is set, only the gateways sorted after
+// the given cursor are returned.
+func GetGatewaysForUser(db sqlx.Queryer, username string, limit, offset int, search string, after *GatewayCursor) ([]Gateway, error) {
 	var gws []Gateway
 	if search != "" {
 		search = "%" + search + "%"
 	}
+	afterName, afterMAC := after.args()
 
 	err := sqlx.Select(db, &gws, `
 		select
@@ -455,9 +565,9 @@ func GetGatewaysForUser(db sqlx.Queryer, username string, limit, offset int, sea
 		from gateway g
 		inner join organization o
 			on o.id = g.organization_id
-		inner join organization_user ou
+		inner join principal_organization ou
 			on ou.organization_id = o.id
-		inner join "user" u
+		inner join principal u
 			on u.id = ou.user_id
 		where
 			u.username = $1
@@ -471,13 +581,17 @@ func GetGatewaysForUser(db sqlx.Queryer, username string, limit, offset int, sea
 					)
 				)
 			)
+			and ($5::text is null or (g.name, g.mac) > ($5, $6))
 		order by
-			g.name
+			g.name,
+			g.mac
 		limit $2 offset $3`,
 		username,
 		limit,
 		offset,
 		search,
+		afterName,
+		afterMAC,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "select error")
@@ -0,0 +1,155 @@
+package storage
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+)
+
+// GatewayDevice defines the last reception of a device by a gateway.
+type GatewayDevice struct {
+	GatewayMAC lorawan.EUI64 `db:"gateway_mac"`
+	DevEUI     lorawan.EUI64 `db:"dev_eui"`
+	LastSeenAt time.Time     `db:"last_seen_at"`
+	RSSI       int           `db:"rssi"`
+	LoRaSNR    float64       `db:"lora_snr"`
+}
+
+// GatewayDeviceListItem defines a device received by a gateway, for
+// listing.
+type GatewayDeviceListItem struct {
+	DevEUI          lorawan.EUI64 `db:"dev_eui"`
+	DeviceName      string        `db:"device_name"`
+	ApplicationID   int64         `db:"application_id"`
+	ApplicationName string        `db:"application_name"`
+	OrganizationID  int64         `db:"organization_id"`
+	LastSeenAt      time.Time     `db:"last_seen_at"`
+	RSSI            int           `db:"rssi"`
+	LoRaSNR         float64       `db:"lora_snr"`
+}
+
+// GatewayDeviceFilters provides filters for the devices received by a
+// gateway. Note that empty values are not used as filters.
+type GatewayDeviceFilters struct {
+	GatewayMAC     lorawan.EUI64 `db:"gateway_mac"`
+	OrganizationID int64         `db:"organization_id"`
+	Since          time.Time     `db:"since"`
+
+	// Limit and Offset are added for convenience so that this struct can
+	// be given as the arguments.
+	Limit  int `db:"limit"`
+	Offset int `db:"offset"`
+}
+
+// SQL returns the SQL filter.
+func (f GatewayDeviceFilters) SQL() string {
+	filters := []string{"gd.gateway_mac = :gateway_mac"}
+
+	if f.OrganizationID != 0 {
+		filters = append(filters, "a.organization_id = :organization_id")
+	}
+	if !f.Since.IsZero() {
+		filters = append(filters, "gd.last_seen_at >= :since")
+	}
+
+	return "where " + strings.Join(filters, " and ")
+}
+
+// SetGatewayDeviceLastSeen creates or updates the last reception of the
+// given device by the given gateway.
+func SetGatewayDeviceLastSeen(db sqlx.Execer, gd GatewayDevice) error {
+	_, err := db.Exec(`
+		insert into gateway_device (
+			gateway_mac,
+			dev_eui,
+			last_seen_at,
+			rssi,
+			lora_snr
+		) values ($1, $2, $3, $4, $5)
+		on conflict (gateway_mac, dev_eui) do update
+		set
+			last_seen_at = excluded.last_seen_at,
+			rssi = excluded.rssi,
+			lora_snr = excluded.lora_snr`,
+		gd.GatewayMAC[:],
+		gd.DevEUI[:],
+		gd.LastSeenAt,
+		gd.RSSI,
+		gd.LoRaSNR,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	return nil
+}
+
+// GetGatewayDeviceCount returns the number of devices received by the
+// gateway, given the provided filters.
+func GetGatewayDeviceCount(db sqlx.Queryer, filters GatewayDeviceFilters) (int, error) {
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			count(*)
+		from
+			gateway_device gd
+		inner join device d
+			on d.dev_eui = gd.dev_eui
+		inner join application a
+			on a.id = d.application_id
+	`+filters.SQL(), filters)
+	if err != nil {
+		return 0, errors.Wrap(err, "named query error")
+	}
+
+	var count int
+	err = sqlx.Get(db, &count, query, args...)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return count, nil
+}
+
+// GetGatewayDevices returns the devices received by the gateway, given the
+// provided filters. The devices are ordered by last reception (newest
+// first).
+func GetGatewayDevices(db sqlx.Queryer, filters GatewayDeviceFilters) ([]GatewayDeviceListItem, error) {
+	query, args, err := sqlx.BindNamed(sqlx.DOLLAR, `
+		select
+			gd.dev_eui,
+			d.name as device_name,
+			a.id as application_id,
+			a.name as application_name,
+			a.organization_id,
+			gd.last_seen_at,
+			gd.rssi,
+			gd.lora_snr
+		from
+			gateway_device gd
+		inner join device d
+			on d.dev_eui = gd.dev_eui
+		inner join application a
+			on a.id = d.application_id
+	`+filters.SQL()+`
+		order by
+			gd.last_seen_at desc,
+			d.name
+		limit :limit
+		offset :offset
+	`, filters)
+	if err != nil {
+		return nil, errors.Wrap(err, "named query error")
+	}
+
+	var devices []GatewayDeviceListItem
+	err = sqlx.Select(db, &devices, query, args...)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return devices, nil
+}
@@ -0,0 +1,150 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestGatewayDevice() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	var devices []Device
+	var orgs []Organization
+
+	for i, name := range []string{"test-org-1", "test-org-2"} {
+		org := Organization{
+			Name: name,
+		}
+		assert.NoError(CreateOrganization(ts.Tx(), &org))
+		orgs = append(orgs, org)
+
+		sp := ServiceProfile{
+			Name:            "test-sp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+		app := Application{
+			Name:           "test-app",
+			OrganizationID: org.ID,
+		}
+		copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+		assert.NoError(CreateApplication(ts.Tx(), &app))
+
+		dp := DeviceProfile{
+			Name:            "test-dp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+		var dpID uuid.UUID
+		copy(dpID[:], dp.DeviceProfile.Id)
+
+		d := Device{
+			DevEUI:          lorawan.EUI64{byte(i + 1), 2, 3, 4, 5, 6, 7, 8},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dpID,
+			Name:            "device",
+		}
+		assert.NoError(CreateDevice(ts.Tx(), &d))
+		devices = append(devices, d)
+	}
+
+	gw := Gateway{
+		MAC:             lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1},
+		Name:            "test-gw",
+		OrganizationID:  orgs[0].ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateGateway(ts.Tx(), &gw))
+
+	now := time.Now().Truncate(time.Millisecond)
+	old := now.AddDate(0, 0, -30)
+
+	assert.NoError(SetGatewayDeviceLastSeen(ts.Tx(), GatewayDevice{GatewayMAC: gw.MAC, DevEUI: devices[0].DevEUI, LastSeenAt: old, RSSI: -100, LoRaSNR: -5}))
+	assert.NoError(SetGatewayDeviceLastSeen(ts.Tx(), GatewayDevice{GatewayMAC: gw.MAC, DevEUI: devices[0].DevEUI, LastSeenAt: now, RSSI: -60, LoRaSNR: 7}))
+	assert.NoError(SetGatewayDeviceLastSeen(ts.Tx(), GatewayDevice{GatewayMAC: gw.MAC, DevEUI: devices[1].DevEUI, LastSeenAt: now.Add(-time.Hour), RSSI: -80, LoRaSNR: 2}))
+
+	ts.T().Run("All devices", func(t *testing.T) {
+		assert := require.New(t)
+
+		filters := GatewayDeviceFilters{
+			GatewayMAC: gw.MAC,
+			Limit:      10,
+		}
+
+		count, err := GetGatewayDeviceCount(ts.Tx(), filters)
+		assert.NoError(err)
+		assert.Equal(2, count)
+
+		items, err := GetGatewayDevices(ts.Tx(), filters)
+		assert.NoError(err)
+		assert.Len(items, 2)
+		assert.Equal(devices[0].DevEUI, items[0].DevEUI)
+		assert.Equal(orgs[0].ID, items[0].OrganizationID)
+		assert.Equal(devices[0].ApplicationID, items[0].ApplicationID)
+		assert.Equal(-60, items[0].RSSI)
+		assert.Equal(7.0, items[0].LoRaSNR)
+		assert.True(items[0].LastSeenAt.Equal(now))
+		assert.Equal(devices[1].DevEUI, items[1].DevEUI)
+	})
+
+	ts.T().Run("Organization filter", func(t *testing.T) {
+		assert := require.New(t)
+
+		filters := GatewayDeviceFilters{
+			GatewayMAC:     gw.MAC,
+			OrganizationID: orgs[1].ID,
+			Limit:          10,
+		}
+
+		count, err := GetGatewayDeviceCount(ts.Tx(), filters)
+		assert.NoError(err)
+		assert.Equal(1, count)
+
+		items, err := GetGatewayDevices(ts.Tx(), filters)
+		assert.NoError(err)
+		assert.Len(items, 1)
+		assert.Equal(devices[1].DevEUI, items[0].DevEUI)
+	})
+
+	ts.T().Run("Since filter", func(t *testing.T) {
+		assert := require.New(t)
+
+		count, err := GetGatewayDeviceCount(ts.Tx(), GatewayDeviceFilters{
+			GatewayMAC: gw.MAC,
+			Since:      now.Add(-time.Minute),
+		})
+		assert.NoError(err)
+		assert.Equal(1, count)
+	})
+
+	// note: this must be the last test, as the error aborts the transaction
+	ts.T().Run("Unknown gateway", func(t *testing.T) {
+		assert := require.New(t)
+
+		err := SetGatewayDeviceLastSeen(ts.Tx(), GatewayDevice{
+			GatewayMAC: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+			DevEUI:     devices[0].DevEUI,
+			LastSeenAt: now,
+		})
+		assert.Equal(ErrDoesNotExist, err)
+	})
+}
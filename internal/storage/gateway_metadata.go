@@ -0,0 +1,97 @@
+package storage
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// GatewayMetadataProperties contains the (raw) metadata key / value pairs
+// as reported by the gateway.
+type GatewayMetadataProperties map[string]string
+
+// Value implements the driver.Valuer interface.
+func (p GatewayMetadataProperties) Value() (driver.Value, error) {
+	if p == nil {
+		p = GatewayMetadataProperties{}
+	}
+	return json.Marshal(p)
+}
+
+// Scan implements the sql.Scanner interface.
+func (p *GatewayMetadataProperties) Scan(src interface{}) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("expected []byte, got %T", src)
+	}
+	return json.Unmarshal(b, p)
+}
+
+// GatewayMetadata contains the metadata of a gateway, as reported by its
+// stats messages.
+type GatewayMetadata struct {
+	GatewayMAC             lorawan.EUI64             `db:"gateway_mac"`
+	UpdatedAt              time.Time                 `db:"updated_at"`
+	IP                     string                    `db:"ip"`
+	ConfigVersion          string                    `db:"config_version"`
+	PacketForwarderVersion string                    `db:"packet_forwarder_version"`
+	Platform               string                    `db:"platform"`
+	Temperature            *float64                  `db:"temperature"`
+	Properties             GatewayMetadataProperties `db:"properties"`
+}
+
+// SetGatewayMetadata creates or updates the metadata of the given gateway.
+func SetGatewayMetadata(db sqlx.Execer, m *GatewayMetadata) error {
+	m.UpdatedAt = time.Now()
+
+	_, err := db.Exec(`
+		insert into gateway_metadata (
+			gateway_mac,
+			updated_at,
+			ip,
+			config_version,
+			packet_forwarder_version,
+			platform,
+			temperature,
+			properties
+		) values ($1, $2, $3, $4, $5, $6, $7, $8)
+		on conflict (gateway_mac) do update
+		set
+			updated_at = excluded.updated_at,
+			ip = excluded.ip,
+			config_version = excluded.config_version,
+			packet_forwarder_version = excluded.packet_forwarder_version,
+			platform = excluded.platform,
+			temperature = excluded.temperature,
+			properties = excluded.properties`,
+		m.GatewayMAC[:],
+		m.UpdatedAt,
+		m.IP,
+		m.ConfigVersion,
+		m.PacketForwarderVersion,
+		m.Platform,
+		m.Temperature,
+		m.Properties,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	return nil
+}
+
+// GetGatewayMetadata returns the metadata of the given gateway.
+func GetGatewayMetadata(db sqlx.Queryer, mac lorawan.EUI64) (GatewayMetadata, error) {
+	var m GatewayMetadata
+	err := sqlx.Get(db, &m, "select * from gateway_metadata where gateway_mac = $1", mac[:])
+	if err != nil {
+		return m, handlePSQLError(Select, err, "select error")
+	}
+
+	return m, nil
+}
@@ -0,0 +1,95 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestGatewayMetadata(t *testing.T) {
+	assert := require.New(t)
+
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	assert.NoError(err)
+	test.MustResetDB(db)
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test-ns",
+		Server: "test-ns:1234",
+	}
+	assert.NoError(CreateNetworkServer(db, &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(db, &org))
+
+	gw := Gateway{
+		MAC:             lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		Name:            "test-gw",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateGateway(db, &gw))
+
+	t.Run("Does not exist", func(t *testing.T) {
+		assert := require.New(t)
+
+		_, err := GetGatewayMetadata(db, gw.MAC)
+		assert.Equal(ErrDoesNotExist, err)
+	})
+
+	t.Run("Create and update", func(t *testing.T) {
+		assert := require.New(t)
+
+		temp := 42.5
+		m := GatewayMetadata{
+			GatewayMAC:             gw.MAC,
+			IP:                     "192.168.1.10",
+			ConfigVersion:          "1.2.3",
+			PacketForwarderVersion: "5.0.1",
+			Platform:               "rpi",
+			Temperature:            &temp,
+			Properties: GatewayMetadataProperties{
+				"packet_forwarder_version": "5.0.1",
+				"platform":                 "rpi",
+				"temperature":              "42.5",
+			},
+		}
+		assert.NoError(SetGatewayMetadata(db, &m))
+
+		mGet, err := GetGatewayMetadata(db, gw.MAC)
+		assert.NoError(err)
+		assert.Equal(m.Properties, mGet.Properties)
+		assert.Equal(m.IP, mGet.IP)
+		assert.Equal(42.5, *mGet.Temperature)
+
+		m.IP = "192.168.1.11"
+		m.Temperature = nil
+		m.Properties = nil
+		assert.NoError(SetGatewayMetadata(db, &m))
+
+		mGet, err = GetGatewayMetadata(db, gw.MAC)
+		assert.NoError(err)
+		assert.Equal("192.168.1.11", mGet.IP)
+		assert.Nil(mGet.Temperature)
+		assert.Equal(GatewayMetadataProperties{}, mGet.Properties)
+	})
+
+	t.Run("Unknown gateway", func(t *testing.T) {
+		assert := require.New(t)
+
+		m := GatewayMetadata{
+			GatewayMAC: lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+		}
+		assert.Equal(ErrDoesNotExist, SetGatewayMetadata(db, &m))
+	})
+}
@@ -29,8 +29,11 @@ type ExtraChannel struct {
 }
 
 // GatewayProfile defines a gateway-profile.
+// When OrganizationID is nil, the gateway-profile is a global gateway-profile
+// which can be used by all organizations.
 type GatewayProfile struct {
 	NetworkServerID int64             `db:"network_server_id"`
+	OrganizationID  *int64            `db:"organization_id"`
 	CreatedAt       time.Time         `db:"created_at"`
 	UpdatedAt       time.Time         `db:"updated_at"`
 	Name            string            `db:"name"`
@@ -42,6 +45,7 @@ type GatewayProfileMeta struct {
 	GatewayProfileID  uuid.UUID `db:"gateway_profile_id"`
 	NetworkServerID   int64     `db:"network_server_id"`
 	NetworkServerName string    `db:"network_server_name"`
+	OrganizationID    *int64    `db:"organization_id"`
 	CreatedAt         time.Time `db:"created_at"`
 	UpdatedAt         time.Time `db:"updated_at"`
 	Name              string    `db:"name"`
@@ -68,14 +72,16 @@ func CreateGatewayProfile(db sqlx.Ext, gp *GatewayProfile) error {
 			network_server_id,
 			created_at,
 			updated_at,
-			name
-		) values ($1, $2, $3, $4, $5)`,
+			name,
+			organization_id
+		) values ($1, $2, $3, $4, $5, $6)`,
 
 		gpID,
 		gp.NetworkServerID,
 		gp.CreatedAt,
 		gp.UpdatedAt,
 		gp.Name,
+		gp.OrganizationID,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -111,6 +117,7 @@ func GetGatewayProfile(db sqlx.Queryer, id uuid.UUID) (GatewayProfile, error) {
 	err := sqlx.Get(db, &gp, `
 		select
 			network_server_id,
+			organization_id,
 			name,
 			created_at,
 			updated_at
@@ -149,6 +156,32 @@ func GetGatewayProfile(db sqlx.Queryer, id uuid.UUID) (GatewayProfile, error) {
 	return gp, nil
 }
 
+// GetGatewayProfileMeta returns the gateway-profile meta record matching the
+// given id. Unlike GetGatewayProfile, this does not retrieve the
+// gateway-profile from the network-server.
+func GetGatewayProfileMeta(db sqlx.Queryer, id uuid.UUID) (GatewayProfileMeta, error) {
+	var gp GatewayProfileMeta
+	err := sqlx.Get(db, &gp, `
+		select
+			gp.*,
+			n.name as network_server_name
+		from
+			gateway_profile gp
+		inner join
+			network_server n
+		on
+			n.id = gp.network_server_id
+		where
+			gp.gateway_profile_id = $1`,
+		id,
+	)
+	if err != nil {
+		return gp, handlePSQLError(Select, err, "select error")
+	}
+
+	return gp, nil
+}
+
 // UpdateGatewayProfile updates the given gateway-profile.
 func UpdateGatewayProfile(db sqlx.Ext, gp *GatewayProfile) error {
 	gp.UpdatedAt = time.Now()
@@ -196,7 +229,7 @@ func UpdateGatewayProfile(db sqlx.Ext, gp *GatewayProfile) error {
 		GatewayProfile: &gp.GatewayProfile,
 	})
 	if err != nil {
-		handleGrpcError(err, "update gateway-profile error")
+		return handleGrpcError(err, "update gateway-profile error")
 	}
 
 	return nil
@@ -330,3 +363,80 @@ func GetGatewayProfilesForNetworkServerID(db sqlx.Queryer, networkServerID int64
 
 	return gps, nil
 }
+
+// GetGatewayProfileCountForOrganizationID returns the total number of
+// gateway-profiles available to the given organization ID (the global
+// gateway-profiles and the gateway-profiles of the organization). When the
+// network-server ID is 0, the gateway-profiles of all network-servers are
+// counted.
+func GetGatewayProfileCountForOrganizationID(db sqlx.Queryer, organizationID, networkServerID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select
+			count(*)
+		from gateway_profile
+		where
+			(organization_id is null or organization_id = $1)
+			and ($2 = 0 or network_server_id = $2)`,
+		organizationID,
+		networkServerID,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return count, nil
+}
+
+// GetGatewayProfilesForOrganizationID returns a slice of gateway-profiles
+// available to the given organization ID (the global gateway-profiles and
+// the gateway-profiles of the organization). When the network-server ID is
+// 0, the gateway-profiles of all network-servers are returned.
+func GetGatewayProfilesForOrganizationID(db sqlx.Queryer, organizationID, networkServerID int64, limit, offset int) ([]GatewayProfileMeta, error) {
+	var gps []GatewayProfileMeta
+	err := sqlx.Select(db, &gps, `
+		select
+			gp.*,
+			n.name as network_server_name
+		from
+			gateway_profile gp
+		inner join
+			network_server n
+		on
+			n.id = gp.network_server_id
+		where
+			(gp.organization_id is null or gp.organization_id = $1)
+			and ($2 = 0 or gp.network_server_id = $2)
+		order by
+			gp.name
+		limit $3 offset $4`,
+		organizationID,
+		networkServerID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return gps, nil
+}
+
+// DeleteAllGatewayProfilesForOrganizationID deletes all gateway-profiles
+// of the given organization ID.
+func DeleteAllGatewayProfilesForOrganizationID(db sqlx.Ext, organizationID int64) error {
+	var gps []GatewayProfileMeta
+	err := sqlx.Select(db, &gps, "select * from gateway_profile where organization_id = $1", organizationID)
+	if err != nil {
+		return handlePSQLError(Select, err, "select error")
+	}
+
+	for _, gp := range gps {
+		err = DeleteGatewayProfile(db, gp.GatewayProfileID)
+		if err != nil {
+			return errors.Wrap(err, "delete gateway-profile error")
+		}
+	}
+
+	return nil
+}
@@ -144,6 +144,75 @@ func TestGatewayProfile(t *testing.T) {
 				So(gps[0].NetworkServerName, ShouldEqual, n.Name)
 				So(gps[0].Name, ShouldEqual, gp.Name)
 			})
+
+			Convey("Given two organizations and an organization gateway-profile", func() {
+				orgs := []Organization{
+					{Name: "test-org-1"},
+					{Name: "test-org-2"},
+				}
+				for i := range orgs {
+					So(CreateOrganization(db, &orgs[i]), ShouldBeNil)
+				}
+
+				orgGP := GatewayProfile{
+					NetworkServerID: n.ID,
+					OrganizationID:  &orgs[0].ID,
+					Name:            "test-org-gateway-profile",
+					GatewayProfile: ns.GatewayProfile{
+						Channels: []uint32{0, 1, 2},
+					},
+				}
+				So(CreateGatewayProfile(db, &orgGP), ShouldBeNil)
+				<-nsClient.CreateGatewayProfileChan
+				orgGPID, err := uuid.FromBytes(orgGP.GatewayProfile.Id)
+				So(err, ShouldBeNil)
+
+				Convey("Then GetGatewayProfile returns the organization ID", func() {
+					gpGet, err := GetGatewayProfile(db, orgGPID)
+					So(err, ShouldBeNil)
+					So(gpGet.OrganizationID, ShouldNotBeNil)
+					So(*gpGet.OrganizationID, ShouldEqual, orgs[0].ID)
+				})
+
+				Convey("Then GetGatewayProfileMeta returns the meta record", func() {
+					gpMeta, err := GetGatewayProfileMeta(db, orgGPID)
+					So(err, ShouldBeNil)
+					So(gpMeta.Name, ShouldEqual, orgGP.Name)
+					So(gpMeta.NetworkServerName, ShouldEqual, n.Name)
+					So(gpMeta.OrganizationID, ShouldNotBeNil)
+					So(*gpMeta.OrganizationID, ShouldEqual, orgs[0].ID)
+				})
+
+				Convey("Then GetGatewayProfileCountForOrganizationID and GetGatewayProfilesForOrganizationID return the global and organization gateway-profiles", func() {
+					count, err := GetGatewayProfileCountForOrganizationID(db, orgs[0].ID, 0)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 2)
+
+					gps, err := GetGatewayProfilesForOrganizationID(db, orgs[0].ID, n.ID, 10, 0)
+					So(err, ShouldBeNil)
+					So(gps, ShouldHaveLength, 2)
+
+					count, err = GetGatewayProfileCountForOrganizationID(db, orgs[1].ID, 0)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 1)
+
+					gps, err = GetGatewayProfilesForOrganizationID(db, orgs[1].ID, 0, 10, 0)
+					So(err, ShouldBeNil)
+					So(gps, ShouldHaveLength, 1)
+					So(gps[0].GatewayProfileID, ShouldEqual, gpID)
+				})
+
+				Convey("Then DeleteAllGatewayProfilesForOrganizationID deletes the organization gateway-profiles", func() {
+					So(DeleteAllGatewayProfilesForOrganizationID(db, orgs[0].ID), ShouldBeNil)
+					So(<-nsClient.DeleteGatewayProfileChan, ShouldResemble, ns.DeleteGatewayProfileRequest{
+						Id: orgGP.GatewayProfile.Id,
+					})
+
+					count, err := GetGatewayProfileCount(db)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 1)
+				})
+			})
 		})
 	})
 }
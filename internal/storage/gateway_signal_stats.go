@@ -0,0 +1,148 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/brocaar/lorawan"
+)
+
+// GatewaySignalStats contains the aggregated received-signal statistics of
+// a gateway for a single day and geohash cell (the location of the
+// transmitting devices).
+type GatewaySignalStats struct {
+	GatewayMAC  lorawan.EUI64 `db:"gateway_mac"`
+	Date        time.Time     `db:"date"`
+	Geohash     string        `db:"geohash"`
+	UplinkCount int64         `db:"uplink_count"`
+	RSSISum     float64       `db:"rssi_sum"`
+	RSSIMin     int           `db:"rssi_min"`
+	RSSIMax     int           `db:"rssi_max"`
+	SNRSum      float64       `db:"snr_sum"`
+	SNRMin      float64       `db:"snr_min"`
+	SNRMax      float64       `db:"snr_max"`
+}
+
+// GatewaySignalStatsFilter defines the filter of the gateway signal
+// statistics.
+type GatewaySignalStatsFilter struct {
+	GatewayMAC lorawan.EUI64
+	Start      time.Time
+	End        time.Time
+
+	// Precision defines the geohash precision (length) of the returned
+	// cells. The stored cells are aggregated into cells of this precision.
+	Precision int
+}
+
+// GatewaySignalCell contains the received-signal statistics of a single
+// geohash cell.
+type GatewaySignalCell struct {
+	Geohash     string  `db:"geohash"`
+	UplinkCount int64   `db:"uplink_count"`
+	RSSIAvg     float64 `db:"rssi_avg"`
+	RSSIMin     int     `db:"rssi_min"`
+	RSSIMax     int     `db:"rssi_max"`
+	SNRAvg      float64 `db:"snr_avg"`
+	SNRMin      float64 `db:"snr_min"`
+	SNRMax      float64 `db:"snr_max"`
+}
+
+// IncrementGatewaySignalStats adds the given statistics (e.g. a single
+// uplink) to the stored statistics.
+func IncrementGatewaySignalStats(db sqlx.Execer, s GatewaySignalStats) error {
+	_, err := db.Exec(`
+		insert into gateway_signal_stats (
+			gateway_mac,
+			date,
+			geohash,
+			uplink_count,
+			rssi_sum,
+			rssi_min,
+			rssi_max,
+			snr_sum,
+			snr_min,
+			snr_max
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		on conflict (gateway_mac, date, geohash) do update
+		set
+			uplink_count = gateway_signal_stats.uplink_count + excluded.uplink_count,
+			rssi_sum = gateway_signal_stats.rssi_sum + excluded.rssi_sum,
+			rssi_min = least(gateway_signal_stats.rssi_min, excluded.rssi_min),
+			rssi_max = greatest(gateway_signal_stats.rssi_max, excluded.rssi_max),
+			snr_sum = gateway_signal_stats.snr_sum + excluded.snr_sum,
+			snr_min = least(gateway_signal_stats.snr_min, excluded.snr_min),
+			snr_max = greatest(gateway_signal_stats.snr_max, excluded.snr_max)`,
+		s.GatewayMAC[:],
+		s.Date,
+		s.Geohash,
+		s.UplinkCount,
+		s.RSSISum,
+		s.RSSIMin,
+		s.RSSIMax,
+		s.SNRSum,
+		s.SNRMin,
+		s.SNRMax,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	return nil
+}
+
+// GetGatewaySignalCells returns the received-signal statistics of the given
+// gateway, aggregated per geohash cell of the filter precision.
+func GetGatewaySignalCells(db sqlx.Queryer, filter GatewaySignalStatsFilter) ([]GatewaySignalCell, error) {
+	var cells []GatewaySignalCell
+	err := sqlx.Select(db, &cells, `
+		select
+			substr(geohash, 1, $4) as geohash,
+			sum(uplink_count) as uplink_count,
+			sum(rssi_sum) / sum(uplink_count) as rssi_avg,
+			min(rssi_min) as rssi_min,
+			max(rssi_max) as rssi_max,
+			sum(snr_sum) / sum(uplink_count) as snr_avg,
+			min(snr_min) as snr_min,
+			max(snr_max) as snr_max
+		from gateway_signal_stats
+		where
+			gateway_mac = $1
+			and date >= $2::date
+			and date <= $3::date
+		group by 1
+		order by 1`,
+		filter.GatewayMAC[:],
+		filter.Start,
+		filter.End,
+		filter.Precision,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return cells, nil
+}
+
+// DeleteExpiredGatewaySignalStats deletes the gateway signal statistics
+// which are older than the given retention (in days). A retention of 0
+// days means that the statistics are kept forever. It returns the number
+// of deleted records.
+func DeleteExpiredGatewaySignalStats(db sqlx.Execer, days int) (int64, error) {
+	if days == 0 {
+		return 0, nil
+	}
+
+	res, err := db.Exec(`
+		delete from gateway_signal_stats
+		where
+			date < current_date - $1 * interval '1 day'`,
+		days,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Delete, err, "delete error")
+	}
+
+	return res.RowsAffected()
+}
@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestGatewaySignalStats() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	gw := Gateway{
+		MAC:             lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		Name:            "test-gw",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateGateway(ts.Tx(), &gw))
+
+	now := time.Now()
+	old := now.AddDate(0, 0, -30)
+
+	stats := []GatewaySignalStats{
+		{GatewayMAC: gw.MAC, Date: now, Geohash: "u173zq", UplinkCount: 1, RSSISum: -60, RSSIMin: -60, RSSIMax: -60, SNRSum: 10, SNRMin: 10, SNRMax: 10},
+		{GatewayMAC: gw.MAC, Date: now, Geohash: "u173zq", UplinkCount: 1, RSSISum: -80, RSSIMin: -80, RSSIMax: -80, SNRSum: 4, SNRMin: 4, SNRMax: 4},
+		{GatewayMAC: gw.MAC, Date: now, Geohash: "u173zr", UplinkCount: 1, RSSISum: -100, RSSIMin: -100, RSSIMax: -100, SNRSum: -2, SNRMin: -2, SNRMax: -2},
+		{GatewayMAC: gw.MAC, Date: old, Geohash: "u173zr", UplinkCount: 1, RSSISum: -110, RSSIMin: -110, RSSIMax: -110, SNRSum: -5, SNRMin: -5, SNRMax: -5},
+	}
+	for _, s := range stats {
+		assert.NoError(IncrementGatewaySignalStats(ts.Tx(), s))
+	}
+
+	ts.T().Run("GetGatewaySignalCells", func(t *testing.T) {
+		assert := require.New(t)
+
+		filter := GatewaySignalStatsFilter{
+			GatewayMAC: gw.MAC,
+			Start:      now.AddDate(0, 0, -7),
+			End:        now,
+			Precision:  12,
+		}
+
+		cells, err := GetGatewaySignalCells(ts.Tx(), filter)
+		assert.NoError(err)
+		assert.Equal([]GatewaySignalCell{
+			{Geohash: "u173zq", UplinkCount: 2, RSSIAvg: -70, RSSIMin: -80, RSSIMax: -60, SNRAvg: 7, SNRMin: 4, SNRMax: 10},
+			{Geohash: "u173zr", UplinkCount: 1, RSSIAvg: -100, RSSIMin: -100, RSSIMax: -100, SNRAvg: -2, SNRMin: -2, SNRMax: -2},
+		}, cells)
+
+		filter.Precision = 5
+		cells, err = GetGatewaySignalCells(ts.Tx(), filter)
+		assert.NoError(err)
+		assert.Equal([]GatewaySignalCell{
+			{Geohash: "u173z", UplinkCount: 3, RSSIAvg: -80, RSSIMin: -100, RSSIMax: -60, SNRAvg: 4, SNRMin: -2, SNRMax: 10},
+		}, cells)
+	})
+
+	ts.T().Run("DeleteExpiredGatewaySignalStats", func(t *testing.T) {
+		assert := require.New(t)
+
+		count, err := DeleteExpiredGatewaySignalStats(ts.Tx(), 0)
+		assert.NoError(err)
+		assert.EqualValues(0, count)
+
+		count, err = DeleteExpiredGatewaySignalStats(ts.Tx(), 7)
+		assert.NoError(err)
+		assert.EqualValues(1, count)
+	})
+
+	// note: this must be the last test, as the error aborts the transaction
+	ts.T().Run("Unknown gateway", func(t *testing.T) {
+		assert := require.New(t)
+
+		err := IncrementGatewaySignalStats(ts.Tx(), GatewaySignalStats{
+			GatewayMAC:  lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+			Date:        now,
+			Geohash:     "u173zq",
+			UplinkCount: 1,
+		})
+		assert.Equal(ErrDoesNotExist, err)
+	})
+}
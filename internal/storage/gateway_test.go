@@ -88,6 +88,19 @@ func TestGateway(t *testing.T) {
 				So(errors.Cause(err), ShouldResemble, ErrDoesNotExist)
 			})
 
+			Convey("Then the gateway list version changes on update", func() {
+				v, err := GetGatewayListVersion(db, org.ID)
+				So(err, ShouldBeNil)
+				So(v.Count, ShouldEqual, 1)
+				So(v.UpdatedAt, ShouldNotBeNil)
+
+				So(UpdateGateway(db, &gw), ShouldBeNil)
+				v2, err := GetGatewayListVersion(db, 0)
+				So(err, ShouldBeNil)
+				So(v2.Count, ShouldEqual, 1)
+				So(v2.String(), ShouldNotEqual, v.String())
+			})
+
 			Convey("Then getting the total gateway count returns 1", func() {
 				c, err := GetGatewayCount(db, "")
 				So(err, ShouldBeNil)
@@ -95,12 +108,24 @@ func TestGateway(t *testing.T) {
 			})
 
 			Convey("Then getting all gateways returns the expected gateway", func() {
-				gws, err := GetGateways(db, 10, 0, "")
+				gws, err := GetGateways(db, 10, 0, "", nil)
 				So(err, ShouldBeNil)
 				So(gws, ShouldHaveLength, 1)
 				So(gws[0].MAC, ShouldEqual, gw.MAC)
 			})
 
+			Convey("Then getting the gateways after the gateway cursor returns 0 items", func() {
+				after := GatewayCursor{Name: gw.Name, MAC: gw.MAC}
+
+				gws, err := GetGateways(db, 10, 0, "", &after)
+				So(err, ShouldBeNil)
+				So(gws, ShouldHaveLength, 0)
+
+				gws, err = GetGatewaysForOrganizationID(db, org.ID, 10, 0, "", &after)
+				So(err, ShouldBeNil)
+				So(gws, ShouldHaveLength, 0)
+			})
+
 			Convey("Then getting the total gateway count for the organization returns 1", func() {
 				c, err := GetGatewayCountForOrganizationID(db, org.ID, "")
 				So(err, ShouldBeNil)
@@ -108,12 +133,33 @@ func TestGateway(t *testing.T) {
 			})
 
 			Convey("Then getting all gateways for the organization returns the exepected gateway", func() {
-				gws, err := GetGatewaysForOrganizationID(db, org.ID, 10, 0, "")
+				gws, err := GetGatewaysForOrganizationID(db, org.ID, 10, 0, "", nil)
 				So(err, ShouldBeNil)
 				So(gws, ShouldHaveLength, 1)
 				So(gws[0].MAC, ShouldEqual, gw.MAC)
 			})
 
+			Convey("Then GetGatewaysForFilters returns the gateways matching the filters", func() {
+				tests := []struct {
+					Filters     GatewayFilters
+					ExpectedLen int
+				}{
+					{GatewayFilters{}, 1},
+					{GatewayFilters{OrganizationID: org.ID, NetworkServerID: n.ID}, 1},
+					{GatewayFilters{OrganizationID: org.ID + 1}, 0},
+					{GatewayFilters{NetworkServerID: n.ID + 1}, 0},
+					{GatewayFilters{Search: gw.Name}, 1},
+					{GatewayFilters{Search: "0102"}, 1},
+					{GatewayFilters{Search: "foo"}, 0},
+				}
+
+				for _, tst := range tests {
+					gws, err := GetGatewaysForFilters(db, tst.Filters)
+					So(err, ShouldBeNil)
+					So(gws, ShouldHaveLength, tst.ExpectedLen)
+				}
+			})
+
 			Convey("When creating an user", func() {
 				user := User{
 					Username: "testuser",
@@ -130,7 +176,7 @@ func TestGateway(t *testing.T) {
 				})
 
 				Convey("Then getting the gateways for this user returns 0 items", func() {
-					gws, err := GetGatewaysForUser(db, user.Username, 10, 0, "")
+					gws, err := GetGatewaysForUser(db, user.Username, 10, 0, "", nil)
 					So(err, ShouldBeNil)
 					So(gws, ShouldHaveLength, 0)
 				})
@@ -145,7 +191,7 @@ func TestGateway(t *testing.T) {
 					})
 
 					Convey("Then getting the gateways for this user returns 1 item", func() {
-						gws, err := GetGatewaysForUser(db, user.Username, 10, 0, "")
+						gws, err := GetGatewaysForUser(db, user.Username, 10, 0, "", nil)
 						So(err, ShouldBeNil)
 						So(gws, ShouldHaveLength, 1)
 						So(gws[0].MAC, ShouldEqual, gw.MAC)
@@ -19,6 +19,10 @@ type Integration struct {
 	ApplicationID int64           `db:"application_id"`
 	Kind          string          `db:"kind"`
 	Settings      json.RawMessage `db:"settings"`
+
+	// Inherited is set when the integration is inherited from the
+	// organization, in which case ID refers to the organization integration.
+	Inherited bool `db:"inherited"`
 }
 
 // CreateIntegration creates the given Integration.
@@ -106,6 +110,29 @@ func GetIntegrationsForApplicationID(db sqlx.Queryer, applicationID int64) ([]In
 	return is, nil
 }
 
+// GetIntegrationsForKind returns the integrations of the given kind (of all
+// applications).
+// When forUpdate is set to true, then db must be a db transaction.
+func GetIntegrationsForKind(db sqlx.Queryer, kind string, forUpdate bool) ([]Integration, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var is []Integration
+	err := sqlx.Select(db, &is, `
+		select *
+		from integration
+		where kind = $1
+		order by id`+fu,
+		kind,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "select error")
+	}
+	return is, nil
+}
+
 // UpdateIntegration updates the given Integration.
 func UpdateIntegration(db sqlx.Execer, i *Integration) error {
 	now := time.Now()
@@ -0,0 +1,314 @@
+package storage
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	keywrap "github.com/NickBall/go-aes-key-wrap"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lorawan"
+)
+
+// getKEK returns the KEK for the given label.
+func getKEK(label string) ([]byte, error) {
+	for i := range config.C.JoinServer.KEK.Set {
+		if config.C.JoinServer.KEK.Set[i].Label == label {
+			kek, err := hex.DecodeString(config.C.JoinServer.KEK.Set[i].KEK)
+			if err != nil {
+				return nil, errors.Wrap(err, "decode kek error")
+			}
+			return kek, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown kek label: %s", label)
+}
+
+// wrapStorageKey wraps the given key using the storage KEK. When no storage
+// KEK label has been configured, the key is returned as-is.
+func wrapStorageKey(key lorawan.AES128Key) (string, []byte, error) {
+	label := config.C.JoinServer.KEK.StorageKEKLabel
+	if label == "" {
+		return "", key[:], nil
+	}
+
+	kek, err := getKEK(label)
+	if err != nil {
+		return "", nil, err
+	}
+
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "new cipher error")
+	}
+
+	b, err := keywrap.Wrap(block, key[:])
+	if err != nil {
+		return "", nil, errors.Wrap(err, "key wrap error")
+	}
+
+	return label, b, nil
+}
+
+// unwrapStorageKey unwraps the given key using the KEK matching the given
+// label. When the label is empty, the key is expected to be stored as-is.
+func unwrapStorageKey(label string, b []byte) (lorawan.AES128Key, error) {
+	var key lorawan.AES128Key
+
+	if label == "" {
+		if len(b) != len(key) {
+			return key, fmt.Errorf("key must be exactly %d bytes", len(key))
+		}
+		copy(key[:], b)
+		return key, nil
+	}
+
+	kek, err := getKEK(label)
+	if err != nil {
+		return key, err
+	}
+
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return key, errors.Wrap(err, "new cipher error")
+	}
+
+	out, err := keywrap.Unwrap(block, b)
+	if err != nil {
+		return key, errors.Wrap(err, "key unwrap error")
+	}
+	copy(key[:], out)
+
+	return key, nil
+}
+
+// secretPrefix defines the prefix of the encrypted secrets.
+const secretPrefix = "enc:"
+
+// EncryptSecret encrypts the given secret (e.g. an integration header
+// value) with AES-GCM, using the storage KEK. The returned value has the
+// format enc:<kek label>:<base64 encoded nonce and ciphertext>. When no
+// storage KEK label has been configured, the secret is returned as-is.
+func EncryptSecret(secret string) (string, error) {
+	label := config.C.JoinServer.KEK.StorageKEKLabel
+	if secret == "" {
+		return secret, nil
+	}
+	if label == "" {
+		log.Warning("storage: no storage kek configured, secret is stored unencrypted")
+		return secret, nil
+	}
+
+	aead, err := getSecretAEAD(label)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", errors.Wrap(err, "read random bytes error")
+	}
+
+	b := aead.Seal(nonce, nonce, []byte(secret), []byte(label))
+	return secretPrefix + label + ":" + base64.StdEncoding.EncodeToString(b), nil
+}
+
+// DecryptSecret decrypts the given secret, encrypted by EncryptSecret,
+// using the KEK matching the label of the secret. Secrets without the enc:
+// prefix (e.g. stored before a storage KEK was configured) are returned
+// as-is.
+func DecryptSecret(s string) (string, error) {
+	if !strings.HasPrefix(s, secretPrefix) {
+		return s, nil
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(s, secretPrefix), ":", 2)
+	if len(parts) != 2 {
+		return "", errors.New("invalid encrypted secret")
+	}
+	label := parts[0]
+
+	b, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", errors.Wrap(err, "decode base64 error")
+	}
+
+	aead, err := getSecretAEAD(label)
+	if err != nil {
+		return "", err
+	}
+
+	if len(b) < aead.NonceSize() {
+		return "", errors.New("invalid encrypted secret")
+	}
+
+	out, err := aead.Open(nil, b[:aead.NonceSize()], b[aead.NonceSize():], []byte(label))
+	if err != nil {
+		return "", errors.Wrap(err, "decrypt secret error")
+	}
+
+	return string(out), nil
+}
+
+// SecretNeedsRewrap returns true when the given (stored) secret is not
+// encrypted using the configured storage KEK, e.g. when it was stored
+// before the storage KEK was configured or rotated.
+func SecretNeedsRewrap(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	label := config.C.JoinServer.KEK.StorageKEKLabel
+	if label == "" {
+		return strings.HasPrefix(s, secretPrefix)
+	}
+
+	return !strings.HasPrefix(s, secretPrefix+label+":")
+}
+
+// RewrapSecret decrypts the given (stored) secret and encrypts it using
+// the configured storage KEK.
+func RewrapSecret(s string) (string, error) {
+	secret, err := DecryptSecret(s)
+	if err != nil {
+		return "", err
+	}
+	return EncryptSecret(secret)
+}
+
+// getSecretAEAD returns the AES-GCM cipher for the KEK with the given
+// label.
+func getSecretAEAD(label string) (cipher.AEAD, error) {
+	kek, err := getKEK(label)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, errors.Wrap(err, "new cipher error")
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "new gcm error")
+	}
+
+	return aead, nil
+}
+
+// GetDeviceActivationKeysToRewrapCount returns the number of stored
+// device-activation keys which are not wrapped using the configured
+// storage KEK, or when the per-organization data-keys are enabled, using
+// the data-key of the organization of the device.
+func GetDeviceActivationKeysToRewrapCount(db sqlx.Queryer) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select count(*)
+		from device_activation da
+		inner join device d
+			on d.dev_eui = da.dev_eui
+		inner join application a
+			on a.id = d.application_id
+		where
+			da.app_s_key_kek_label != case when $2 then $3 || a.organization_id else $1 end`,
+		config.C.JoinServer.KEK.StorageKEKLabel,
+		organizationDataKeysEnabled(),
+		organizationKeyLabelPrefix,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return count, nil
+}
+
+// RewrapDeviceActivationKeys re-wraps (at most) batchSize stored
+// device-activation keys which are not wrapped using the configured
+// storage KEK, or when the per-organization data-keys are enabled, using
+// the data-key of the organization of the device. It returns the number of
+// re-wrapped keys. Note that the KEK used for the previous wrapping must
+// still be present in the KEK set.
+func RewrapDeviceActivationKeys(db sqlx.Ext, batchSize int) (int, error) {
+	var rows []struct {
+		ID              int64  `db:"id"`
+		AppSKey         []byte `db:"app_s_key"`
+		AppSKeyKEKLabel string `db:"app_s_key_kek_label"`
+		OrganizationID  int64  `db:"organization_id"`
+	}
+
+	err := sqlx.Select(db, &rows, `
+		select
+			da.id,
+			da.app_s_key,
+			da.app_s_key_kek_label,
+			a.organization_id
+		from device_activation da
+		inner join device d
+			on d.dev_eui = da.dev_eui
+		inner join application a
+			on a.id = d.application_id
+		where
+			da.app_s_key_kek_label != case when $2 then $3 || a.organization_id else $1 end
+		order by da.id
+		limit $4
+		for update of da`,
+		config.C.JoinServer.KEK.StorageKEKLabel,
+		organizationDataKeysEnabled(),
+		organizationKeyLabelPrefix,
+		batchSize,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	for _, row := range rows {
+		key, err := unwrapDeviceKey(db, row.AppSKeyKEKLabel, row.AppSKey)
+		if err != nil {
+			return 0, errors.Wrapf(err, "unwrap key error (device_activation id: %d)", row.ID)
+		}
+
+		var label string
+		var b []byte
+		if organizationDataKeysEnabled() {
+			label, b, err = wrapOrganizationKey(db, row.OrganizationID, key)
+		} else {
+			label, b, err = wrapStorageKey(key)
+		}
+		if err != nil {
+			return 0, errors.Wrap(err, "wrap key error")
+		}
+
+		_, err = db.Exec(`
+			update device_activation
+			set
+				app_s_key = $2,
+				app_s_key_kek_label = $3
+			where
+				id = $1`,
+			row.ID,
+			b,
+			label,
+		)
+		if err != nil {
+			return 0, handlePSQLError(Update, err, "update error")
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"count":                  len(rows),
+		"kek_label":              config.C.JoinServer.KEK.StorageKEKLabel,
+		"organization_data_keys": organizationDataKeysEnabled(),
+	}).Info("device-activation keys re-wrapped")
+
+	return len(rows), nil
+}
@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+)
+
+func TestSecretEncryption(t *testing.T) {
+	Convey("Given no storage KEK", t, func() {
+		Convey("Then EncryptSecret returns the secret as-is", func() {
+			s, err := EncryptSecret("Bearer token")
+			So(err, ShouldBeNil)
+			So(s, ShouldEqual, "Bearer token")
+
+			s, err = DecryptSecret(s)
+			So(err, ShouldBeNil)
+			So(s, ShouldEqual, "Bearer token")
+		})
+	})
+
+	Convey("Given a storage KEK", t, func() {
+		config.C.JoinServer.KEK.StorageKEKLabel = "storage"
+		config.C.JoinServer.KEK.Set = []struct {
+			Label string `mapstructure:"label"`
+			KEK   string `mapstructure:"kek"`
+		}{
+			{Label: "storage", KEK: "01020304050607080102030405060708"},
+		}
+		defer func() {
+			config.C.JoinServer.KEK.StorageKEKLabel = ""
+			config.C.JoinServer.KEK.Set = nil
+		}()
+
+		Convey("Then EncryptSecret encrypts the secret", func() {
+			s, err := EncryptSecret("Bearer token")
+			So(err, ShouldBeNil)
+			So(strings.HasPrefix(s, "enc:storage:"), ShouldBeTrue)
+			So(s, ShouldNotContainSubstring, "token")
+
+			Convey("Then DecryptSecret returns the secret", func() {
+				out, err := DecryptSecret(s)
+				So(err, ShouldBeNil)
+				So(out, ShouldEqual, "Bearer token")
+			})
+
+			Convey("Then DecryptSecret fails on a modified secret", func() {
+				_, err := DecryptSecret(s[:len(s)-4] + "AAA=")
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Then DecryptSecret returns a plain secret as-is", func() {
+			out, err := DecryptSecret("Bearer token")
+			So(err, ShouldBeNil)
+			So(out, ShouldEqual, "Bearer token")
+		})
+	})
+}
@@ -0,0 +1,146 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// SetApplicationLegalHold places the Application matching the given ID
+// under legal hold (hold = true) or clears its legal hold. An application
+// under legal hold (including its devices) can not be deleted and its data
+// is excluded from the retention pruning.
+func SetApplicationLegalHold(db sqlx.Execer, id int64, hold bool) error {
+	// the legal_hold_at timestamp of an application already under legal
+	// hold is preserved
+	res, err := db.Exec(`
+		update application
+		set
+			updated_at = $2,
+			legal_hold_at = case when $3 then coalesce(legal_hold_at, $2) else null end
+		where id = $1`,
+		id,
+		time.Now(),
+		hold,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"id":         id,
+		"legal_hold": hold,
+	}).Info("application legal hold updated")
+
+	return nil
+}
+
+// SetDeviceLegalHold places the device matching the given DevEUI under
+// legal hold (hold = true) or clears its legal hold. A device under legal
+// hold can not be deleted or erased and its data is excluded from the
+// retention pruning.
+func SetDeviceLegalHold(db sqlx.Execer, devEUI lorawan.EUI64, hold bool) error {
+	res, err := db.Exec(`
+		update device
+		set
+			updated_at = $2,
+			legal_hold_at = case when $3 then coalesce(legal_hold_at, $2) else null end
+		where dev_eui = $1`,
+		devEUI[:],
+		time.Now(),
+		hold,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":    devEUI,
+		"legal_hold": hold,
+	}).Info("device legal hold updated")
+
+	return nil
+}
+
+// checkApplicationLegalHold returns ErrLegalHold when the given application
+// or one of its devices is under legal hold.
+func checkApplicationLegalHold(db sqlx.Queryer, id int64) error {
+	return checkLegalHold(db, `
+		select exists (
+			select
+				1
+			from application a
+			left join device d
+				on d.application_id = a.id
+			where
+				a.id = $1
+				and (a.legal_hold_at is not null or d.legal_hold_at is not null)
+		)`,
+		id,
+	)
+}
+
+// checkDeviceLegalHold returns ErrLegalHold when the given device or its
+// application is under legal hold.
+func checkDeviceLegalHold(db sqlx.Queryer, devEUI lorawan.EUI64) error {
+	return checkLegalHold(db, `
+		select exists (
+			select
+				1
+			from device d
+			inner join application a
+				on a.id = d.application_id
+			where
+				d.dev_eui = $1
+				and (d.legal_hold_at is not null or a.legal_hold_at is not null)
+		)`,
+		devEUI[:],
+	)
+}
+
+// checkOrganizationLegalHold returns ErrLegalHold when one of the
+// applications or devices of the given organization is under legal hold.
+func checkOrganizationLegalHold(db sqlx.Queryer, id int64) error {
+	return checkLegalHold(db, `
+		select exists (
+			select
+				1
+			from application a
+			left join device d
+				on d.application_id = a.id
+			where
+				a.organization_id = $1
+				and (a.legal_hold_at is not null or d.legal_hold_at is not null)
+		)`,
+		id,
+	)
+}
+
+func checkLegalHold(db sqlx.Queryer, query string, args ...interface{}) error {
+	var hold bool
+	if err := sqlx.Get(db, &hold, query, args...); err != nil {
+		return handlePSQLError(Select, err, "select error")
+	}
+	if hold {
+		return ErrLegalHold
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestLegalHold() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	sp := ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+	app := Application{
+		Name:           "test-app",
+		OrganizationID: org.ID,
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	dp := DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  org.ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	var dpID uuid.UUID
+	copy(dpID[:], dp.DeviceProfile.Id)
+
+	d := Device{
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		ApplicationID:   app.ID,
+		DeviceProfileID: dpID,
+		Name:            "test-device",
+	}
+	assert.NoError(CreateDevice(ts.Tx(), &d))
+	assert.NoError(CreateDeviceLocation(ts.Tx(), &DeviceLocation{
+		CreatedAt: time.Now().Add(-48 * time.Hour),
+		DevEUI:    d.DevEUI,
+		Latitude:  1.123,
+		Longitude: 2.123,
+	}))
+
+	ts.T().Run("Set for non-existing application", func(t *testing.T) {
+		require.Equal(t, ErrDoesNotExist, SetApplicationLegalHold(ts.Tx(), app.ID+1, true))
+	})
+
+	ts.T().Run("Application legal hold", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.NoError(SetApplicationLegalHold(ts.Tx(), app.ID, true))
+		a, err := GetApplication(ts.Tx(), app.ID, false)
+		assert.NoError(err)
+		assert.True(a.HasLegalHold())
+
+		assert.Equal(ErrLegalHold, errors.Cause(DeleteDevice(ts.Tx(), d.DevEUI)))
+		assert.Equal(ErrLegalHold, errors.Cause(DeleteApplication(ts.Tx(), app.ID)))
+		assert.Equal(ErrLegalHold, errors.Cause(DeleteOrganization(ts.Tx(), org.ID)))
+
+		count, err := DeleteExpiredDeviceLocations(ts.Tx(), 1)
+		assert.NoError(err)
+		assert.EqualValues(0, count)
+
+		t.Run("Clear", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(SetApplicationLegalHold(ts.Tx(), app.ID, false))
+			a, err := GetApplication(ts.Tx(), app.ID, false)
+			assert.NoError(err)
+			assert.False(a.HasLegalHold())
+		})
+	})
+
+	ts.T().Run("Device legal hold", func(t *testing.T) {
+		assert := require.New(t)
+
+		assert.NoError(SetDeviceLegalHold(ts.Tx(), d.DevEUI, true))
+		dev, err := GetDevice(ts.Tx(), d.DevEUI, false, true)
+		assert.NoError(err)
+		assert.True(dev.HasLegalHold())
+
+		assert.Equal(ErrLegalHold, errors.Cause(DeleteDevice(ts.Tx(), d.DevEUI)))
+		_, err = EraseDeviceData(ts.Tx(), d.DevEUI)
+		assert.Equal(ErrLegalHold, errors.Cause(err))
+		assert.Equal(ErrLegalHold, errors.Cause(DeleteApplication(ts.Tx(), app.ID)))
+
+		count, err := DeleteExpiredDeviceLocations(ts.Tx(), 1)
+		assert.NoError(err)
+		assert.EqualValues(0, count)
+
+		t.Run("Clear", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(SetDeviceLegalHold(ts.Tx(), d.DevEUI, false))
+
+			count, err := DeleteExpiredDeviceLocations(ts.Tx(), 1)
+			assert.NoError(err)
+			assert.EqualValues(1, count)
+
+			assert.NoError(DeleteDevice(ts.Tx(), d.DevEUI))
+		})
+	})
+}
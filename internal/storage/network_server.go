@@ -2,10 +2,13 @@ package storage
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/brocaar/lorawan"
 	uuid "github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/gomodule/redigo/redis"
 
 	"github.com/brocaar/lora-app-server/internal/config"
 	"github.com/brocaar/loraserver/api/ns"
@@ -15,23 +18,39 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// networkServerRegionKeyTempl defines the key template of the cached region
+// of a network-server.
+const networkServerRegionKeyTempl = "lora:as:ns:%d:region"
+
+// networkServerRegionTTL defines the duration for which the region of a
+// network-server is cached.
+const networkServerRegionTTL = 5 * time.Minute
+
 // NetworkServer defines the information to connect to a network-server.
 type NetworkServer struct {
-	ID                          int64     `db:"id"`
-	CreatedAt                   time.Time `db:"created_at"`
-	UpdatedAt                   time.Time `db:"updated_at"`
-	Name                        string    `db:"name"`
-	Server                      string    `db:"server"`
-	CACert                      string    `db:"ca_cert"`
-	TLSCert                     string    `db:"tls_cert"`
-	TLSKey                      string    `db:"tls_key"`
-	RoutingProfileCACert        string    `db:"routing_profile_ca_cert"`
-	RoutingProfileTLSCert       string    `db:"routing_profile_tls_cert"`
-	RoutingProfileTLSKey        string    `db:"routing_profile_tls_key"`
-	GatewayDiscoveryEnabled     bool      `db:"gateway_discovery_enabled"`
-	GatewayDiscoveryInterval    int       `db:"gateway_discovery_interval"`
-	GatewayDiscoveryTXFrequency int       `db:"gateway_discovery_tx_frequency"`
-	GatewayDiscoveryDR          int       `db:"gateway_discovery_dr"`
+	ID                          int64          `db:"id"`
+	CreatedAt                   time.Time      `db:"created_at"`
+	UpdatedAt                   time.Time      `db:"updated_at"`
+	Name                        string         `db:"name"`
+	Server                      string         `db:"server"`
+	CACert                      string         `db:"ca_cert"`
+	TLSCert                     string         `db:"tls_cert"`
+	TLSKey                      string         `db:"tls_key"`
+	RoutingProfileCACert        string         `db:"routing_profile_ca_cert"`
+	RoutingProfileTLSCert       string         `db:"routing_profile_tls_cert"`
+	RoutingProfileTLSKey        string         `db:"routing_profile_tls_key"`
+	GatewayDiscoveryEnabled     bool           `db:"gateway_discovery_enabled"`
+	GatewayDiscoveryInterval    int            `db:"gateway_discovery_interval"`
+	GatewayDiscoveryTXFrequency int            `db:"gateway_discovery_tx_frequency"`
+	GatewayDiscoveryDR          int            `db:"gateway_discovery_dr"`
+	NetID                       *lorawan.NetID `db:"net_id"`
+	KEKLabel                    string         `db:"kek_label"`
+	KEK                         []byte         `db:"-"`
+
+	// WrappedKEK contains the KEK as stored in the database, wrapped using
+	// the storage KEK matching KEKStorageKEKLabel.
+	WrappedKEK         []byte `db:"kek"`
+	KEKStorageKEKLabel string `db:"kek_storage_kek_label"`
 }
 
 // Validate validates the network-server data.
@@ -39,6 +58,46 @@ func (ns NetworkServer) Validate() error {
 	if ns.GatewayDiscoveryEnabled && ns.GatewayDiscoveryInterval <= 0 {
 		return ErrInvalidGatewayDiscoveryInterval
 	}
+	if ns.KEKLabel != "" && len(ns.KEK) != 16 {
+		return ErrInvalidKEK
+	}
+	return nil
+}
+
+// wrapKEK wraps the KEK of the network-server using the storage KEK.
+func (ns *NetworkServer) wrapKEK() error {
+	if len(ns.KEK) == 0 {
+		ns.WrappedKEK = nil
+		ns.KEKStorageKEKLabel = ""
+		return nil
+	}
+
+	var key lorawan.AES128Key
+	copy(key[:], ns.KEK)
+
+	label, b, err := wrapStorageKey(key)
+	if err != nil {
+		return errors.Wrap(err, "wrap kek error")
+	}
+	ns.KEKStorageKEKLabel = label
+	ns.WrappedKEK = b
+
+	return nil
+}
+
+// unwrapKEK unwraps the stored KEK of the network-server.
+func (ns *NetworkServer) unwrapKEK() error {
+	if len(ns.WrappedKEK) == 0 {
+		ns.KEK = nil
+		return nil
+	}
+
+	key, err := unwrapStorageKey(ns.KEKStorageKEKLabel, ns.WrappedKEK)
+	if err != nil {
+		return errors.Wrapf(err, "unwrap kek error (network_server id: %d)", ns.ID)
+	}
+	ns.KEK = key[:]
+
 	return nil
 }
 
@@ -48,6 +107,10 @@ func CreateNetworkServer(db sqlx.Queryer, n *NetworkServer) error {
 		return errors.Wrap(err, "validation error")
 	}
 
+	if err := n.wrapKEK(); err != nil {
+		return err
+	}
+
 	now := time.Now()
 	n.CreatedAt = now
 	n.UpdatedAt = now
@@ -67,8 +130,12 @@ func CreateNetworkServer(db sqlx.Queryer, n *NetworkServer) error {
 			gateway_discovery_enabled,
 			gateway_discovery_interval,
 			gateway_discovery_tx_frequency,
-			gateway_discovery_dr
-		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+			gateway_discovery_dr,
+			net_id,
+			kek_label,
+			kek,
+			kek_storage_kek_label
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
 		returning id`,
 		n.CreatedAt,
 		n.UpdatedAt,
@@ -84,6 +151,10 @@ func CreateNetworkServer(db sqlx.Queryer, n *NetworkServer) error {
 		n.GatewayDiscoveryInterval,
 		n.GatewayDiscoveryTXFrequency,
 		n.GatewayDiscoveryDR,
+		n.NetID,
+		n.KEKLabel,
+		n.WrappedKEK,
+		n.KEKStorageKEKLabel,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -129,6 +200,9 @@ func GetNetworkServer(db sqlx.Queryer, id int64) (NetworkServer, error) {
 		return ns, handlePSQLError(Select, err, "select error")
 	}
 
+	if err := ns.unwrapKEK(); err != nil {
+		return ns, err
+	}
 	return ns, nil
 }
 
@@ -138,6 +212,10 @@ func UpdateNetworkServer(db sqlx.Execer, n *NetworkServer) error {
 		return errors.Wrap(err, "validation error")
 	}
 
+	if err := n.wrapKEK(); err != nil {
+		return err
+	}
+
 	n.UpdatedAt = time.Now()
 
 	res, err := db.Exec(`
@@ -155,7 +233,11 @@ func UpdateNetworkServer(db sqlx.Execer, n *NetworkServer) error {
 			gateway_discovery_enabled = $11,
 			gateway_discovery_interval = $12,
 			gateway_discovery_tx_frequency = $13,
-			gateway_discovery_dr = $14
+			gateway_discovery_dr = $14,
+			net_id = $15,
+			kek_label = $16,
+			kek = $17,
+			kek_storage_kek_label = $18
 		where id = $1`,
 		n.ID,
 		n.UpdatedAt,
@@ -171,6 +253,10 @@ func UpdateNetworkServer(db sqlx.Execer, n *NetworkServer) error {
 		n.GatewayDiscoveryInterval,
 		n.GatewayDiscoveryTXFrequency,
 		n.GatewayDiscoveryDR,
+		n.NetID,
+		n.KEKLabel,
+		n.WrappedKEK,
+		n.KEKStorageKEKLabel,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
@@ -306,6 +392,12 @@ func GetNetworkServers(db sqlx.Queryer, limit, offset int) ([]NetworkServer, err
 		return nil, handlePSQLError(Select, err, "select error")
 	}
 
+	for i := range nss {
+		if err := nss[i].unwrapKEK(); err != nil {
+			return nil, err
+		}
+	}
+
 	return nss, nil
 }
 
@@ -335,6 +427,12 @@ func GetNetworkServersForOrganizationID(db sqlx.Queryer, organizationID int64, l
 		return nil, handlePSQLError(Select, err, "select error")
 	}
 
+	for i := range nss {
+		if err := nss[i].unwrapKEK(); err != nil {
+			return nil, err
+		}
+	}
+
 	return nss, nil
 }
 
@@ -357,6 +455,22 @@ func GetNetworkServerForDevEUI(db sqlx.Queryer, devEUI lorawan.EUI64) (NetworkSe
 	if err != nil {
 		return n, handlePSQLError(Select, err, "select error")
 	}
+	if err := n.unwrapKEK(); err != nil {
+		return n, err
+	}
+	return n, nil
+}
+
+// GetNetworkServerForNetID returns the network-server for the given NetID.
+func GetNetworkServerForNetID(db sqlx.Queryer, netID lorawan.NetID) (NetworkServer, error) {
+	var n NetworkServer
+	err := sqlx.Get(db, &n, "select * from network_server where net_id = $1", netID)
+	if err != nil {
+		return n, handlePSQLError(Select, err, "select error")
+	}
+	if err := n.unwrapKEK(); err != nil {
+		return n, err
+	}
 	return n, nil
 }
 
@@ -378,6 +492,9 @@ func GetNetworkServerForDeviceProfileID(db sqlx.Queryer, id uuid.UUID) (NetworkS
 	if err != nil {
 		return n, handlePSQLError(Select, err, "select error")
 	}
+	if err := n.unwrapKEK(); err != nil {
+		return n, err
+	}
 	return n, nil
 }
 
@@ -399,6 +516,9 @@ func GetNetworkServerForServiceProfileID(db sqlx.Queryer, id uuid.UUID) (Network
 	if err != nil {
 		return n, handlePSQLError(Select, err, "select error")
 	}
+	if err := n.unwrapKEK(); err != nil {
+		return n, err
+	}
 	return n, nil
 }
 
@@ -419,6 +539,9 @@ func GetNetworkServerForGatewayMAC(db sqlx.Queryer, mac lorawan.EUI64) (NetworkS
 	if err != nil {
 		return n, handlePSQLError(Select, err, "select error")
 	}
+	if err := n.unwrapKEK(); err != nil {
+		return n, err
+	}
 	return n, nil
 }
 
@@ -440,6 +563,9 @@ func GetNetworkServerForGatewayProfileID(db sqlx.Queryer, id uuid.UUID) (Network
 	if err != nil {
 		return n, handlePSQLError(Select, err, "select errror")
 	}
+	if err := n.unwrapKEK(); err != nil {
+		return n, err
+	}
 	return n, nil
 }
 
@@ -462,5 +588,116 @@ func GetNetworkServerForMulticastGroupID(db sqlx.Queryer, id uuid.UUID) (Network
 	if err != nil {
 		return n, handlePSQLError(Select, err, "select error")
 	}
+	if err := n.unwrapKEK(); err != nil {
+		return n, err
+	}
 	return n, nil
 }
+
+// GetNetworkServerRegion returns the region (e.g. EU868) configured by the
+// given network-server. As this is retrieved for every uplink, the region
+// is cached in Redis.
+func GetNetworkServerRegion(p *redis.Pool, n NetworkServer) (string, error) {
+	c := p.Get()
+	defer c.Close()
+
+	key := fmt.Sprintf(networkServerRegionKeyTempl, n.ID)
+
+	region, err := redis.String(c.Do("GET", key))
+	if err == nil {
+		return region, nil
+	}
+	if err != redis.ErrNil {
+		return "", errors.Wrap(err, "get region error")
+	}
+
+	nsClient, err := config.C.NetworkServer.Pool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return "", errors.Wrap(err, "get network-server client error")
+	}
+
+	resp, err := nsClient.GetVersion(context.Background(), &empty.Empty{})
+	if err != nil {
+		return "", errors.Wrap(err, "get version error")
+	}
+	region = resp.Region.String()
+
+	if _, err := c.Do("PSETEX", key, int64(networkServerRegionTTL/time.Millisecond), region); err != nil {
+		return "", errors.Wrap(err, "set region error")
+	}
+
+	return region, nil
+}
+
+// GetNetworkServerKEKsToRewrapCount returns the number of stored
+// network-server KEKs which are not wrapped using the configured storage
+// KEK.
+func GetNetworkServerKEKsToRewrapCount(db sqlx.Queryer) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select count(*)
+		from network_server
+		where
+			kek is not null
+			and kek_storage_kek_label != $1`,
+		config.C.JoinServer.KEK.StorageKEKLabel,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return count, nil
+}
+
+// RewrapNetworkServerKEKs re-wraps (at most) batchSize stored network-server
+// KEKs which are not wrapped using the configured storage KEK. It returns
+// the number of re-wrapped KEKs.
+func RewrapNetworkServerKEKs(db sqlx.Ext, batchSize int) (int, error) {
+	var rows []NetworkServer
+	err := sqlx.Select(db, &rows, `
+		select *
+		from network_server
+		where
+			kek is not null
+			and kek_storage_kek_label != $1
+		order by id
+		limit $2
+		for update`,
+		config.C.JoinServer.KEK.StorageKEKLabel,
+		batchSize,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	for _, row := range rows {
+		if err := row.unwrapKEK(); err != nil {
+			return 0, err
+		}
+		if err := row.wrapKEK(); err != nil {
+			return 0, err
+		}
+
+		_, err = db.Exec(`
+			update network_server
+			set
+				kek = $2,
+				kek_storage_kek_label = $3
+			where
+				id = $1`,
+			row.ID,
+			row.WrappedKEK,
+			row.KEKStorageKEKLabel,
+		)
+		if err != nil {
+			return 0, handlePSQLError(Update, err, "update error")
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"count":     len(rows),
+		"kek_label": config.C.JoinServer.KEK.StorageKEKLabel,
+	}).Info("network-server keks re-wrapped")
+
+	return len(rows), nil
+}
@@ -0,0 +1,33 @@
+package storage
+
+import (
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/loraserver/api/common"
+)
+
+func (ts *StorageTestSuite) TestGetNetworkServerRegion() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	nsClient.GetVersionResponse.Region = common.Region_EU868
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	region, err := GetNetworkServerRegion(ts.RedisPool(), n)
+	assert.NoError(err)
+	assert.Equal("EU868", region)
+
+	// the region is cached
+	nsClient.GetVersionResponse.Region = common.Region_US915
+	region, err = GetNetworkServerRegion(ts.RedisPool(), n)
+	assert.NoError(err)
+	assert.Equal("EU868", region)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/brocaar/lora-app-server/internal/config"
 	"github.com/brocaar/lora-app-server/internal/test"
 	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -40,6 +41,13 @@ func TestNetworkServer(t *testing.T) {
 				},
 				ExpectedError: ErrInvalidGatewayDiscoveryInterval,
 			},
+			{
+				NetworkServer: NetworkServer{
+					KEKLabel: "kek-label",
+					KEK:      []byte{1, 2, 3},
+				},
+				ExpectedError: ErrInvalidKEK,
+			},
 		}
 
 		for i, test := range testTable {
@@ -77,6 +85,9 @@ func TestNetworkServer(t *testing.T) {
 				GatewayDiscoveryInterval:    5,
 				GatewayDiscoveryTXFrequency: 868100000,
 				GatewayDiscoveryDR:          5,
+				NetID:                       &lorawan.NetID{1, 2, 3},
+				KEKLabel:                    "kek-label",
+				KEK:                         []byte{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
 			}
 			So(CreateNetworkServer(db, &n), ShouldBeNil)
 			n.CreatedAt = n.CreatedAt.UTC().Truncate(time.Millisecond)
@@ -101,6 +112,49 @@ func TestNetworkServer(t *testing.T) {
 				So(nsGet, ShouldResemble, n)
 			})
 
+			Convey("Given a storage KEK", func() {
+				config.C.JoinServer.KEK.StorageKEKLabel = "storage"
+				config.C.JoinServer.KEK.Set = []struct {
+					Label string `mapstructure:"label"`
+					KEK   string `mapstructure:"kek"`
+				}{
+					{Label: "storage", KEK: "01020304050607080102030405060708"},
+				}
+				defer func() {
+					config.C.JoinServer.KEK.StorageKEKLabel = ""
+					config.C.JoinServer.KEK.Set = nil
+				}()
+
+				count, err := GetNetworkServerKEKsToRewrapCount(db)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+
+				Convey("Then RewrapNetworkServerKEKs re-wraps the KEK", func() {
+					n2, err := RewrapNetworkServerKEKs(db, 10)
+					So(err, ShouldBeNil)
+					So(n2, ShouldEqual, 1)
+
+					count, err := GetNetworkServerKEKsToRewrapCount(db)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 0)
+
+					nsGet, err := GetNetworkServer(db, n.ID)
+					So(err, ShouldBeNil)
+					So(nsGet.KEKStorageKEKLabel, ShouldEqual, "storage")
+					So(nsGet.WrappedKEK, ShouldNotResemble, n.KEK)
+					So(nsGet.KEK, ShouldResemble, n.KEK)
+				})
+			})
+
+			Convey("Then GetNetworkServerForNetID returns the network-server", func() {
+				nsGet, err := GetNetworkServerForNetID(db, lorawan.NetID{1, 2, 3})
+				So(err, ShouldBeNil)
+				So(nsGet.ID, ShouldEqual, n.ID)
+
+				_, err = GetNetworkServerForNetID(db, lorawan.NetID{3, 2, 1})
+				So(err, ShouldEqual, ErrDoesNotExist)
+			})
+
 			Convey("Then GetNetworkServerCount returns 1", func() {
 				count, err := GetNetworkServerCount(db)
 				So(err, ShouldBeNil)
@@ -19,6 +19,11 @@ type Organization struct {
 	Name            string    `db:"name"`
 	DisplayName     string    `db:"display_name"`
 	CanHaveGateways bool      `db:"can_have_gateways"`
+
+	// MaxDeviceCount and MaxGatewayCount define the device and gateway
+	// quotas of the organization (0 means unlimited).
+	MaxDeviceCount  int `db:"max_device_count"`
+	MaxGatewayCount int `db:"max_gateway_count"`
 }
 
 // Validate validates the data of the Organization.
@@ -26,11 +31,16 @@ func (o Organization) Validate() error {
 	if !organizationNameRegexp.MatchString(o.Name) {
 		return ErrOrganizationInvalidName
 	}
+	if o.MaxDeviceCount < 0 || o.MaxGatewayCount < 0 {
+		return ErrOrganizationInvalidQuota
+	}
 	return nil
 }
 
 // OrganizationUser represents an organization user.
 type OrganizationUser struct {
+	OrganizationUserPermissions
+
 	UserID    int64     `db:"user_id"`
 	Username  string    `db:"username"`
 	IsAdmin   bool      `db:"is_admin"`
@@ -38,6 +48,30 @@ type OrganizationUser struct {
 	UpdatedAt time.Time `db:"updated_at"`
 }
 
+// OrganizationUserPermissions defines the permissions of a (non-admin)
+// organization user, on top of reading the organization data. Organization
+// admin users have all permissions, except for IsAuditor.
+type OrganizationUserPermissions struct {
+	// CanViewKeys allows the user to view the device (session) keys.
+	CanViewKeys bool `db:"can_view_keys"`
+
+	// CanExport allows the user to export bulk data (e.g. frame captures
+	// and location history).
+	CanExport bool `db:"can_export"`
+
+	// IsAuditor restricts the user to read-only access, e.g. the user is
+	// not able to enqueue downlink payloads.
+	IsAuditor bool `db:"is_auditor"`
+}
+
+// Validate validates the permissions of the organization user.
+func (p OrganizationUserPermissions) Validate(isAdmin bool) error {
+	if isAdmin && p.IsAuditor {
+		return ErrOrganizationUserAdminAuditor
+	}
+	return nil
+}
+
 // CreateOrganization creates the given Organization.
 func CreateOrganization(db sqlx.Queryer, org *Organization) error {
 	if err := org.Validate(); err != nil {
@@ -52,13 +86,17 @@ func CreateOrganization(db sqlx.Queryer, org *Organization) error {
 			updated_at,
 			name,
 			display_name,
-			can_have_gateways
-		) values ($1, $2, $3, $4, $5) returning id`,
+			can_have_gateways,
+			max_device_count,
+			max_gateway_count
+		) values ($1, $2, $3, $4, $5, $6, $7) returning id`,
 		now,
 		now,
 		org.Name,
 		org.DisplayName,
 		org.CanHaveGateways,
+		org.MaxDeviceCount,
+		org.MaxGatewayCount,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -82,6 +120,53 @@ func GetOrganization(db sqlx.Queryer, id int64) (Organization, error) {
 	return org, nil
 }
 
+// ValidateOrganizationDeviceQuota validates that a device can be added to
+// the given organization without exceeding its max device count. When called
+// within a transaction, the organization is locked until the transaction
+// ends so that concurrent creates can't exceed the quota.
+func ValidateOrganizationDeviceQuota(db sqlx.Queryer, organizationID int64) error {
+	var max int
+	err := sqlx.Get(db, &max, "select max_device_count from organization where id = $1 for update", organizationID)
+	if err != nil {
+		return handlePSQLError(Select, err, "select error")
+	}
+	if max == 0 {
+		return nil
+	}
+
+	count, err := GetDeviceCount(db, DeviceFilters{OrganizationID: organizationID})
+	if err != nil {
+		return errors.Wrap(err, "get device count error")
+	}
+	if count >= max {
+		return ErrOrganizationMaxDeviceCount
+	}
+	return nil
+}
+
+// ValidateOrganizationGatewayQuota validates that a gateway can be added to
+// the given organization without exceeding its max gateway count. See
+// ValidateOrganizationDeviceQuota for the locking behavior.
+func ValidateOrganizationGatewayQuota(db sqlx.Queryer, organizationID int64) error {
+	var max int
+	err := sqlx.Get(db, &max, "select max_gateway_count from organization where id = $1 for update", organizationID)
+	if err != nil {
+		return handlePSQLError(Select, err, "select error")
+	}
+	if max == 0 {
+		return nil
+	}
+
+	count, err := GetGatewayCountForOrganizationID(db, organizationID, "")
+	if err != nil {
+		return errors.Wrap(err, "get gateway count error")
+	}
+	if count >= max {
+		return ErrOrganizationMaxGatewayCount
+	}
+	return nil
+}
+
 // GetOrganizationCount returns the total number of organizations.
 func GetOrganizationCount(db sqlx.Queryer, search string) (int, error) {
 	var count int
@@ -117,9 +202,9 @@ func GetOrganizationCountForUser(db sqlx.Queryer, username string, search string
 		select
 			count(o.*)
 		from organization o
-		inner join organization_user ou
+		inner join principal_organization ou
 			on ou.organization_id = o.id
-		inner join "user" u
+		inner join principal u
 			on u.id = ou.user_id
 		where
 			u.username = $1
@@ -172,9 +257,9 @@ func GetOrganizationsForUser(db sqlx.Queryer, username string, limit, offset int
 		select
 			o.*
 		from organization o
-		inner join organization_user ou
+		inner join principal_organization ou
 			on ou.organization_id = o.id
-		inner join "user" u
+		inner join principal u
 			on u.id = ou.user_id
 		where
 			u.username = $1
@@ -208,13 +293,17 @@ func UpdateOrganization(db sqlx.Execer, org *Organization) error {
 			name = $2,
 			display_name = $3,
 			can_have_gateways = $4,
-			updated_at = $5
+			updated_at = $5,
+			max_device_count = $6,
+			max_gateway_count = $7
 		where id = $1`,
 		org.ID,
 		org.Name,
 		org.DisplayName,
 		org.CanHaveGateways,
 		now,
+		org.MaxDeviceCount,
+		org.MaxGatewayCount,
 	)
 
 	if err != nil {
@@ -238,6 +327,10 @@ func UpdateOrganization(db sqlx.Execer, org *Organization) error {
 
 // DeleteOrganization deletes the organization matching the given id.
 func DeleteOrganization(db sqlx.Ext, id int64) error {
+	if err := checkOrganizationLegalHold(db, id); err != nil {
+		return err
+	}
+
 	err := DeleteAllApplicationsForOrganizationID(db, id)
 	if err != nil {
 		return errors.Wrap(err, "delete all applications error")
@@ -253,6 +346,11 @@ func DeleteOrganization(db sqlx.Ext, id int64) error {
 		return errors.Wrap(err, "delete all device-profiles error")
 	}
 
+	err = DeleteAllGatewayProfilesForOrganizationID(db, id)
+	if err != nil {
+		return errors.Wrap(err, "delete all gateway-profiles error")
+	}
+
 	res, err := db.Exec("delete from organization where id = $1", id)
 	if err != nil {
 		return handlePSQLError(Delete, err, "delete error")
@@ -345,6 +443,40 @@ func DeleteOrganizationUser(db sqlx.Execer, organizationID, userID int64) error
 	return nil
 }
 
+// UpdateOrganizationUserPermissions updates the permissions of the given
+// organization user.
+func UpdateOrganizationUserPermissions(db sqlx.Execer, organizationID, userID int64, p OrganizationUserPermissions) error {
+	res, err := db.Exec(`
+		update organization_user
+		set
+			can_view_keys = $3,
+			can_export = $4,
+			is_auditor = $5
+		where
+			organization_id = $1
+			and user_id = $2
+	`, organizationID, userID, p.CanViewKeys, p.CanExport, p.IsAuditor)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"user_id":         userID,
+		"organization_id": organizationID,
+		"can_view_keys":   p.CanViewKeys,
+		"can_export":      p.CanExport,
+		"is_auditor":      p.IsAuditor,
+	}).Info("organization user permissions updated")
+	return nil
+}
+
 // GetOrganizationUser gets the information of the given organization user.
 func GetOrganizationUser(db sqlx.Queryer, organizationID, userID int64) (OrganizationUser, error) {
 	var u OrganizationUser
@@ -354,7 +486,10 @@ func GetOrganizationUser(db sqlx.Queryer, organizationID, userID int64) (Organiz
 			u.username as username,
 			ou.created_at as created_at,
 			ou.updated_at as updated_at,
-			ou.is_admin as is_admin
+			ou.is_admin as is_admin,
+			ou.can_view_keys as can_view_keys,
+			ou.can_export as can_export,
+			ou.is_auditor as is_auditor
 		from organization_user ou
 		inner join "user" u
 			on u.id = ou.user_id
@@ -370,6 +505,28 @@ func GetOrganizationUser(db sqlx.Queryer, organizationID, userID int64) (Organiz
 	return u, nil
 }
 
+// GetOrganizationUserListVersionForUser returns the version of the
+// organization memberships of the given user.
+func GetOrganizationUserListVersionForUser(db sqlx.Queryer, username string) (ListVersion, error) {
+	var v ListVersion
+	err := sqlx.Get(db, &v, `
+		select
+			count(ou.*) as count,
+			max(ou.updated_at) as updated_at
+		from organization_user ou
+		inner join "user" u
+			on u.id = ou.user_id
+		where
+			u.username = $1`,
+		username,
+	)
+	if err != nil {
+		return v, errors.Wrap(err, "select error")
+	}
+
+	return v, nil
+}
+
 // GetOrganizationUserCount returns the number of users for the given organization.
 func GetOrganizationUserCount(db sqlx.Queryer, organizationID int64) (int, error) {
 	var count int
@@ -395,7 +552,10 @@ func GetOrganizationUsers(db sqlx.Queryer, organizationID int64, limit, offset i
 			u.username as username,
 			ou.created_at as created_at,
 			ou.updated_at as updated_at,
-			ou.is_admin as is_admin
+			ou.is_admin as is_admin,
+			ou.can_view_keys as can_view_keys,
+			ou.can_export as can_export,
+			ou.is_auditor as is_auditor
 		from organization_user ou
 		inner join "user" u
 			on u.id = ou.user_id
@@ -0,0 +1,286 @@
+package storage
+
+import (
+	"crypto/aes"
+	"crypto/rand"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	keywrap "github.com/NickBall/go-aes-key-wrap"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lorawan"
+)
+
+// organizationKeyLabelPrefix defines the prefix of the kek label of keys
+// wrapped using an organization data-key. The prefix is followed by the
+// organization ID.
+const organizationKeyLabelPrefix = "org:"
+
+// organizationDataKeysEnabled returns true when the stored keys must be
+// wrapped using the per-organization data-keys. This requires a storage KEK,
+// as the data-keys themselves are stored wrapped using the storage KEK.
+func organizationDataKeysEnabled() bool {
+	return config.C.JoinServer.KEK.OrganizationDataKeys && config.C.JoinServer.KEK.StorageKEKLabel != ""
+}
+
+// organizationKeyLabel returns the kek label for keys wrapped using the
+// data-key of the given organization.
+func organizationKeyLabel(organizationID int64) string {
+	return organizationKeyLabelPrefix + strconv.FormatInt(organizationID, 10)
+}
+
+// getOrganizationDataKey returns the (unwrapped) data-key of the given
+// organization. When create is set to true, the data-key is created when
+// the organization does not have a data-key yet.
+func getOrganizationDataKey(db sqlx.Queryer, organizationID int64, create bool) (lorawan.AES128Key, error) {
+	var row struct {
+		KEKLabel string `db:"kek_label"`
+		DataKey  []byte `db:"data_key"`
+	}
+
+	err := sqlx.Get(db, &row, `
+		select
+			kek_label,
+			data_key
+		from organization_data_key
+		where
+			organization_id = $1`,
+		organizationID,
+	)
+	if err != nil {
+		err = handlePSQLError(Select, err, "select error")
+		if err != ErrDoesNotExist || !create {
+			return lorawan.AES128Key{}, err
+		}
+
+		return createOrganizationDataKey(db, organizationID)
+	}
+
+	return unwrapStorageKey(row.KEKLabel, row.DataKey)
+}
+
+// createOrganizationDataKey creates a random data-key for the given
+// organization, wrapped using the storage KEK. In case a concurrent call
+// created the data-key first, this data-key is returned.
+func createOrganizationDataKey(db sqlx.Queryer, organizationID int64) (lorawan.AES128Key, error) {
+	var key lorawan.AES128Key
+	if _, err := rand.Read(key[:]); err != nil {
+		return key, errors.Wrap(err, "read random bytes error")
+	}
+
+	kekLabel, b, err := wrapStorageKey(key)
+	if err != nil {
+		return key, errors.Wrap(err, "wrap data-key error")
+	}
+
+	var created bool
+	err = sqlx.Get(db, &created, `
+		insert into organization_data_key (
+			organization_id,
+			created_at,
+			kek_label,
+			data_key
+		) values ($1, $2, $3, $4)
+		on conflict (organization_id) do nothing
+		returning true`,
+		organizationID,
+		time.Now(),
+		kekLabel,
+		b,
+	)
+	if err != nil {
+		err = handlePSQLError(Insert, err, "insert error")
+		if err == ErrDoesNotExist {
+			return getOrganizationDataKey(db, organizationID, false)
+		}
+		return key, err
+	}
+
+	log.WithFields(log.Fields{
+		"organization_id": organizationID,
+		"kek_label":       kekLabel,
+	}).Info("organization data-key created")
+
+	return key, nil
+}
+
+// getOrganizationIDForDevEUI returns the organization ID of the given
+// device.
+func getOrganizationIDForDevEUI(db sqlx.Queryer, devEUI lorawan.EUI64) (int64, error) {
+	var id int64
+	err := sqlx.Get(db, &id, `
+		select
+			a.organization_id
+		from device d
+		inner join application a
+			on a.id = d.application_id
+		where
+			d.dev_eui = $1`,
+		devEUI[:],
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return id, nil
+}
+
+// wrapDeviceKey wraps the given key of the given device. When the
+// per-organization data-keys are enabled, the key is wrapped using the
+// data-key of the organization of the device, else the storage KEK is used
+// (see wrapStorageKey).
+func wrapDeviceKey(db sqlx.Queryer, devEUI lorawan.EUI64, key lorawan.AES128Key) (string, []byte, error) {
+	if !organizationDataKeysEnabled() {
+		return wrapStorageKey(key)
+	}
+
+	organizationID, err := getOrganizationIDForDevEUI(db, devEUI)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "get organization id error")
+	}
+
+	return wrapOrganizationKey(db, organizationID, key)
+}
+
+// wrapOrganizationKey wraps the given key using the data-key of the given
+// organization.
+func wrapOrganizationKey(db sqlx.Queryer, organizationID int64, key lorawan.AES128Key) (string, []byte, error) {
+	dataKey, err := getOrganizationDataKey(db, organizationID, true)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "get organization data-key error")
+	}
+
+	block, err := aes.NewCipher(dataKey[:])
+	if err != nil {
+		return "", nil, errors.Wrap(err, "new cipher error")
+	}
+
+	b, err := keywrap.Wrap(block, key[:])
+	if err != nil {
+		return "", nil, errors.Wrap(err, "key wrap error")
+	}
+
+	return organizationKeyLabel(organizationID), b, nil
+}
+
+// unwrapDeviceKey unwraps the given key. Keys wrapped using an organization
+// data-key are unwrapped using the data-key of the organization encoded in
+// the label, else the key is unwrapped using unwrapStorageKey.
+func unwrapDeviceKey(db sqlx.Queryer, label string, b []byte) (lorawan.AES128Key, error) {
+	if !strings.HasPrefix(label, organizationKeyLabelPrefix) {
+		return unwrapStorageKey(label, b)
+	}
+
+	var key lorawan.AES128Key
+
+	organizationID, err := strconv.ParseInt(strings.TrimPrefix(label, organizationKeyLabelPrefix), 10, 64)
+	if err != nil {
+		return key, fmt.Errorf("invalid kek label: %s", label)
+	}
+
+	dataKey, err := getOrganizationDataKey(db, organizationID, false)
+	if err != nil {
+		return key, errors.Wrap(err, "get organization data-key error")
+	}
+
+	block, err := aes.NewCipher(dataKey[:])
+	if err != nil {
+		return key, errors.Wrap(err, "new cipher error")
+	}
+
+	out, err := keywrap.Unwrap(block, b)
+	if err != nil {
+		return key, errors.Wrap(err, "key unwrap error")
+	}
+	copy(key[:], out)
+
+	return key, nil
+}
+
+// GetOrganizationDataKeysToRewrapCount returns the number of organization
+// data-keys which are not wrapped using the configured storage KEK.
+func GetOrganizationDataKeysToRewrapCount(db sqlx.Queryer) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select count(*)
+		from organization_data_key
+		where
+			kek_label != $1`,
+		config.C.JoinServer.KEK.StorageKEKLabel,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return count, nil
+}
+
+// RewrapOrganizationDataKeys re-wraps (at most) batchSize organization
+// data-keys which are not wrapped using the configured storage KEK. It
+// returns the number of re-wrapped data-keys. As only the data-keys are
+// re-wrapped, the keys wrapped using these data-keys are not affected.
+func RewrapOrganizationDataKeys(db sqlx.Ext, batchSize int) (int, error) {
+	var rows []struct {
+		OrganizationID int64  `db:"organization_id"`
+		KEKLabel       string `db:"kek_label"`
+		DataKey        []byte `db:"data_key"`
+	}
+
+	err := sqlx.Select(db, &rows, `
+		select
+			organization_id,
+			kek_label,
+			data_key
+		from organization_data_key
+		where
+			kek_label != $1
+		order by organization_id
+		limit $2
+		for update`,
+		config.C.JoinServer.KEK.StorageKEKLabel,
+		batchSize,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	for _, row := range rows {
+		key, err := unwrapStorageKey(row.KEKLabel, row.DataKey)
+		if err != nil {
+			return 0, errors.Wrapf(err, "unwrap data-key error (organization id: %d)", row.OrganizationID)
+		}
+
+		label, b, err := wrapStorageKey(key)
+		if err != nil {
+			return 0, errors.Wrap(err, "wrap data-key error")
+		}
+
+		_, err = db.Exec(`
+			update organization_data_key
+			set
+				kek_label = $2,
+				data_key = $3
+			where
+				organization_id = $1`,
+			row.OrganizationID,
+			label,
+			b,
+		)
+		if err != nil {
+			return 0, handlePSQLError(Update, err, "update error")
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"count":     len(rows),
+		"kek_label": config.C.JoinServer.KEK.StorageKEKLabel,
+	}).Info("organization data-keys re-wrapped")
+
+	return len(rows), nil
+}
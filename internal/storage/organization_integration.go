@@ -0,0 +1,203 @@
+package storage
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// OrganizationIntegration represents an organization integration. These
+// integrations are inherited by all applications of the organization.
+type OrganizationIntegration struct {
+	ID             int64           `db:"id"`
+	CreatedAt      time.Time       `db:"created_at"`
+	UpdatedAt      time.Time       `db:"updated_at"`
+	OrganizationID int64           `db:"organization_id"`
+	Kind           string          `db:"kind"`
+	Settings       json.RawMessage `db:"settings"`
+}
+
+// CreateOrganizationIntegration creates the given OrganizationIntegration.
+func CreateOrganizationIntegration(db sqlx.Queryer, i *OrganizationIntegration) error {
+	now := time.Now()
+	err := sqlx.Get(db, &i.ID, `
+		insert into organization_integration (
+			created_at,
+			updated_at,
+			organization_id,
+			kind,
+			settings
+		) values ($1, $2, $3, $4, $5) returning id`,
+		now,
+		now,
+		i.OrganizationID,
+		i.Kind,
+		i.Settings,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	i.CreatedAt = now
+	i.UpdatedAt = now
+	log.WithFields(log.Fields{
+		"id":              i.ID,
+		"kind":            i.Kind,
+		"organization_id": i.OrganizationID,
+	}).Info("organization integration created")
+	return nil
+}
+
+// GetOrganizationIntegration returns the OrganizationIntegration for the
+// given organization id and kind.
+func GetOrganizationIntegration(db sqlx.Queryer, organizationID int64, kind string) (OrganizationIntegration, error) {
+	var i OrganizationIntegration
+	err := sqlx.Get(db, &i, "select * from organization_integration where organization_id = $1 and kind = $2", organizationID, kind)
+	if err != nil {
+		return i, handlePSQLError(Select, err, "select error")
+	}
+	return i, nil
+}
+
+// GetOrganizationIntegrations returns the integrations for the given
+// organization id.
+func GetOrganizationIntegrations(db sqlx.Queryer, organizationID int64) ([]OrganizationIntegration, error) {
+	var is []OrganizationIntegration
+	err := sqlx.Select(db, &is, `
+		select *
+		from organization_integration
+		where organization_id = $1
+		order by kind`,
+		organizationID,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "select error")
+	}
+	return is, nil
+}
+
+// GetOrganizationIntegrationsForKind returns the organization integrations
+// of the given kind (of all organizations).
+// When forUpdate is set to true, then db must be a db transaction.
+func GetOrganizationIntegrationsForKind(db sqlx.Queryer, kind string, forUpdate bool) ([]OrganizationIntegration, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var is []OrganizationIntegration
+	err := sqlx.Select(db, &is, `
+		select *
+		from organization_integration
+		where kind = $1
+		order by id`+fu,
+		kind,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "select error")
+	}
+	return is, nil
+}
+
+// UpdateOrganizationIntegration updates the given OrganizationIntegration.
+func UpdateOrganizationIntegration(db sqlx.Execer, i *OrganizationIntegration) error {
+	now := time.Now()
+	res, err := db.Exec(`
+		update organization_integration
+		set
+			updated_at = $2,
+			settings = $3
+		where
+			id = $1`,
+		i.ID,
+		now,
+		i.Settings,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	i.UpdatedAt = now
+	log.WithFields(log.Fields{
+		"id":              i.ID,
+		"kind":            i.Kind,
+		"organization_id": i.OrganizationID,
+	}).Info("organization integration updated")
+	return nil
+}
+
+// DeleteOrganizationIntegration deletes the organization integration
+// matching the given id.
+func DeleteOrganizationIntegration(db sqlx.Execer, id int64) error {
+	res, err := db.Exec("delete from organization_integration where id = $1", id)
+	if err != nil {
+		return errors.Wrap(err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("organization integration deleted")
+	return nil
+}
+
+// GetEffectiveIntegrationsForApplicationID returns the integrations which
+// apply to the given application id. These are the integrations of the
+// application, and the integrations of the organization for which the
+// application does not have an integration of the same kind (unless the
+// organization integrations are disabled for the application).
+func GetEffectiveIntegrationsForApplicationID(db sqlx.Queryer, applicationID int64) ([]Integration, error) {
+	var is []Integration
+	err := sqlx.Select(db, &is, `
+		select
+			i.*,
+			false as inherited
+		from integration i
+		where
+			i.application_id = $1
+
+		union all
+
+		select
+			oi.id,
+			oi.created_at,
+			oi.updated_at,
+			a.id as application_id,
+			oi.kind,
+			oi.settings,
+			true as inherited
+		from organization_integration oi
+		inner join application a
+			on a.organization_id = oi.organization_id
+		where
+			a.id = $1
+			and a.disable_organization_integrations = false
+			and not exists (
+				select 1
+				from integration i
+				where
+					i.application_id = a.id
+					and i.kind = oi.kind
+			)
+		order by kind`,
+		applicationID,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "select error")
+	}
+	return is, nil
+}
@@ -0,0 +1,142 @@
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func TestOrganizationIntegration(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	Convey("Given a clean database with an organization, network-server, service-profile, and application", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(db, &org), ShouldBeNil)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		sp := ServiceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			Name:            "test-sp",
+		}
+		So(CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+		spID, err := uuid.FromBytes(sp.ServiceProfile.Id)
+		So(err, ShouldBeNil)
+
+		app := Application{
+			OrganizationID:   org.ID,
+			Name:             "test-app",
+			ServiceProfileID: spID,
+		}
+		So(CreateApplication(db, &app), ShouldBeNil)
+
+		Convey("When creating an organization integration", func() {
+			settings := testIntegrationSettings{
+				URL: "http://foo.bar/",
+				Key: 12345,
+			}
+			oi := OrganizationIntegration{
+				OrganizationID: org.ID,
+				Kind:           "REST",
+			}
+			oi.Settings, err = json.Marshal(settings)
+			So(err, ShouldBeNil)
+			So(CreateOrganizationIntegration(db, &oi), ShouldBeNil)
+
+			Convey("Then it can be retrieved by organization ID and kind", func() {
+				i, err := GetOrganizationIntegration(db, org.ID, "REST")
+				So(err, ShouldBeNil)
+				So(i.ID, ShouldEqual, oi.ID)
+
+				var s testIntegrationSettings
+				So(json.Unmarshal(i.Settings, &s), ShouldBeNil)
+				So(s, ShouldResemble, settings)
+			})
+
+			Convey("Then the organization integrations can be listed", func() {
+				is, err := GetOrganizationIntegrations(db, org.ID)
+				So(err, ShouldBeNil)
+				So(is, ShouldHaveLength, 1)
+				So(is[0].ID, ShouldEqual, oi.ID)
+			})
+
+			Convey("Then it can be updated", func() {
+				settings.URL = "http://foo.bar/updated"
+				oi.Settings, err = json.Marshal(settings)
+				So(err, ShouldBeNil)
+				So(UpdateOrganizationIntegration(db, &oi), ShouldBeNil)
+
+				i, err := GetOrganizationIntegration(db, org.ID, "REST")
+				So(err, ShouldBeNil)
+
+				var s testIntegrationSettings
+				So(json.Unmarshal(i.Settings, &s), ShouldBeNil)
+				So(s, ShouldResemble, settings)
+			})
+
+			Convey("Then it can be deleted", func() {
+				So(DeleteOrganizationIntegration(db, oi.ID), ShouldBeNil)
+				_, err := GetOrganizationIntegration(db, org.ID, "REST")
+				So(err, ShouldResemble, ErrDoesNotExist)
+			})
+
+			Convey("Then it is inherited by the application", func() {
+				is, err := GetEffectiveIntegrationsForApplicationID(db, app.ID)
+				So(err, ShouldBeNil)
+				So(is, ShouldHaveLength, 1)
+				So(is[0].ID, ShouldEqual, oi.ID)
+				So(is[0].ApplicationID, ShouldEqual, app.ID)
+				So(is[0].Inherited, ShouldBeTrue)
+			})
+
+			Convey("When the application has an integration of the same kind", func() {
+				intgr := Integration{
+					ApplicationID: app.ID,
+					Kind:          "REST",
+					Settings:      oi.Settings,
+				}
+				So(CreateIntegration(db, &intgr), ShouldBeNil)
+
+				Convey("Then the application integration overrides the organization integration", func() {
+					is, err := GetEffectiveIntegrationsForApplicationID(db, app.ID)
+					So(err, ShouldBeNil)
+					So(is, ShouldHaveLength, 1)
+					So(is[0].ID, ShouldEqual, intgr.ID)
+					So(is[0].Inherited, ShouldBeFalse)
+				})
+			})
+
+			Convey("When the organization integrations are disabled for the application", func() {
+				app.DisableOrganizationIntegrations = true
+				So(UpdateApplication(db, app), ShouldBeNil)
+
+				Convey("Then the integration is not inherited", func() {
+					is, err := GetEffectiveIntegrationsForApplicationID(db, app.ID)
+					So(err, ShouldBeNil)
+					So(is, ShouldHaveLength, 0)
+				})
+			})
+		})
+	})
+}
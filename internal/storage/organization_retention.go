@@ -0,0 +1,238 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// OrganizationRetention contains the data retention of an organization.
+// The retention is defined in days, 0 means that the server default is used.
+type OrganizationRetention struct {
+	OrganizationID     int64     `db:"organization_id"`
+	CreatedAt          time.Time `db:"created_at"`
+	UpdatedAt          time.Time `db:"updated_at"`
+	SecurityEventDays  int       `db:"security_event_days"`
+	DeviceLocationDays int       `db:"device_location_days"`
+	GatewayPingDays    int       `db:"gateway_ping_days"`
+}
+
+// Validate validates the organization retention.
+func (r OrganizationRetention) Validate() error {
+	for _, d := range []int{r.SecurityEventDays, r.DeviceLocationDays, r.GatewayPingDays} {
+		if d < 0 {
+			return ErrInvalidRetention
+		}
+	}
+	return nil
+}
+
+// OrganizationStorageUsage contains the number of stored records of an
+// organization which are subject to the data retention.
+type OrganizationStorageUsage struct {
+	SecurityEventCount  int64 `db:"security_event_count"`
+	DeviceLocationCount int64 `db:"device_location_count"`
+	GatewayPingCount    int64 `db:"gateway_ping_count"`
+}
+
+// GetOrganizationRetention returns the retention for the given organization
+// id. When no retention has been stored, the server defaults (0) are
+// returned.
+func GetOrganizationRetention(db sqlx.Queryer, organizationID int64) (OrganizationRetention, error) {
+	var r OrganizationRetention
+	err := sqlx.Get(db, &r, "select * from organization_retention where organization_id = $1", organizationID)
+	if err != nil {
+		err = handlePSQLError(Select, err, "select error")
+		if err == ErrDoesNotExist {
+			return OrganizationRetention{
+				OrganizationID: organizationID,
+			}, nil
+		}
+		return r, err
+	}
+
+	return r, nil
+}
+
+// SetOrganizationRetention creates or updates the given organization
+// retention.
+func SetOrganizationRetention(db sqlx.Queryer, r *OrganizationRetention) error {
+	if err := r.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	now := time.Now()
+	err := sqlx.Get(db, &r.CreatedAt, `
+		insert into organization_retention (
+			organization_id,
+			created_at,
+			updated_at,
+			security_event_days,
+			device_location_days,
+			gateway_ping_days
+		) values ($1, $2, $2, $3, $4, $5)
+		on conflict (organization_id) do update
+		set
+			updated_at = excluded.updated_at,
+			security_event_days = excluded.security_event_days,
+			device_location_days = excluded.device_location_days,
+			gateway_ping_days = excluded.gateway_ping_days
+		returning created_at`,
+		r.OrganizationID,
+		now,
+		r.SecurityEventDays,
+		r.DeviceLocationDays,
+		r.GatewayPingDays,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	r.UpdatedAt = now
+	log.WithField("organization_id", r.OrganizationID).Info("organization retention updated")
+	return nil
+}
+
+// GetOrganizationStorageUsage returns the number of stored records of the
+// given organization which are subject to the data retention.
+func GetOrganizationStorageUsage(db sqlx.Queryer, organizationID int64) (OrganizationStorageUsage, error) {
+	var u OrganizationStorageUsage
+	err := sqlx.Get(db, &u, `
+		select
+			(
+				select count(*)
+				from security_event e
+				inner join device d
+					on d.dev_eui = e.dev_eui
+				inner join application a
+					on a.id = d.application_id
+				where
+					a.organization_id = $1
+			) as security_event_count,
+			(
+				select count(*)
+				from device_location dl
+				inner join device d
+					on d.dev_eui = dl.dev_eui
+				inner join application a
+					on a.id = d.application_id
+				where
+					a.organization_id = $1
+			) as device_location_count,
+			(
+				select count(*)
+				from gateway_ping gp
+				inner join gateway g
+					on g.mac = gp.gateway_mac
+				where
+					g.organization_id = $1
+			) as gateway_ping_count`,
+		organizationID,
+	)
+	if err != nil {
+		return u, handlePSQLError(Select, err, "select error")
+	}
+
+	return u, nil
+}
+
+// DeleteExpiredSecurityEvents deletes the security events which are older
+// than the retention of the organization of the event (or of its device), or
+// the given default retention (in days) for events which are not related to
+// an organization or when the organization uses the default. A retention of 0 days means
+// that the events are kept forever. The events of devices under legal hold
+// are not deleted. It returns the number of deleted events.
+func DeleteExpiredSecurityEvents(db sqlx.Execer, defaultDays int) (int64, error) {
+	res, err := db.Exec(`
+		delete from security_event
+		where id in (
+			select
+				e.id
+			from security_event e
+			left join device d
+				on d.dev_eui = e.dev_eui
+			left join application a
+				on a.id = d.application_id
+			left join organization_retention r
+				on r.organization_id = coalesce(e.organization_id, a.organization_id)
+			where
+				coalesce(nullif(r.security_event_days, 0), $1) > 0
+				and e.created_at < now() - coalesce(nullif(r.security_event_days, 0), $1) * interval '1 day'
+				and d.legal_hold_at is null
+				and a.legal_hold_at is null
+		)`,
+		defaultDays,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Delete, err, "delete error")
+	}
+
+	return res.RowsAffected()
+}
+
+// DeleteExpiredDeviceLocations deletes the device locations which are
+// older than the retention of the organization, or the given default
+// retention (in days) when the organization uses the default. A retention
+// of 0 days means that the locations are kept forever. The locations of
+// devices under legal hold are not deleted. It returns the number of
+// deleted locations.
+func DeleteExpiredDeviceLocations(db sqlx.Execer, defaultDays int) (int64, error) {
+	res, err := db.Exec(`
+		delete from device_location
+		where id in (
+			select
+				dl.id
+			from device_location dl
+			inner join device d
+				on d.dev_eui = dl.dev_eui
+			inner join application a
+				on a.id = d.application_id
+			left join organization_retention r
+				on r.organization_id = a.organization_id
+			where
+				coalesce(nullif(r.device_location_days, 0), $1) > 0
+				and dl.created_at < now() - coalesce(nullif(r.device_location_days, 0), $1) * interval '1 day'
+				and d.legal_hold_at is null
+				and a.legal_hold_at is null
+		)`,
+		defaultDays,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Delete, err, "delete error")
+	}
+
+	return res.RowsAffected()
+}
+
+// DeleteExpiredGatewayPings deletes the gateway pings (and their received
+// pings) which are older than the retention of the organization, or the
+// given default retention (in days) when the organization uses the default.
+// A retention of 0 days means that the pings are kept forever. The last
+// ping of a gateway is never deleted. It returns the number of deleted
+// pings.
+func DeleteExpiredGatewayPings(db sqlx.Execer, defaultDays int) (int64, error) {
+	res, err := db.Exec(`
+		delete from gateway_ping
+		where id in (
+			select
+				gp.id
+			from gateway_ping gp
+			inner join gateway g
+				on g.mac = gp.gateway_mac
+			left join organization_retention r
+				on r.organization_id = g.organization_id
+			where
+				coalesce(nullif(r.gateway_ping_days, 0), $1) > 0
+				and gp.created_at < now() - coalesce(nullif(r.gateway_ping_days, 0), $1) * interval '1 day'
+				and (g.last_ping_id is null or g.last_ping_id != gp.id)
+		)`,
+		defaultDays,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Delete, err, "delete error")
+	}
+
+	return res.RowsAffected()
+}
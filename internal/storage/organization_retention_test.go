@@ -0,0 +1,236 @@
+package storage
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestOrganizationRetention() {
+	assert := require.New(ts.T())
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	ts.T().Run("Get default", func(t *testing.T) {
+		assert := require.New(t)
+
+		r, err := GetOrganizationRetention(ts.Tx(), org.ID)
+		assert.NoError(err)
+		assert.Equal(OrganizationRetention{OrganizationID: org.ID}, r)
+	})
+
+	ts.T().Run("Set invalid", func(t *testing.T) {
+		assert := require.New(t)
+
+		r := OrganizationRetention{
+			OrganizationID:    org.ID,
+			SecurityEventDays: -1,
+		}
+		assert.Equal(ErrInvalidRetention, errors.Cause(SetOrganizationRetention(ts.Tx(), &r)))
+	})
+
+	ts.T().Run("Set", func(t *testing.T) {
+		assert := require.New(t)
+
+		r := OrganizationRetention{
+			OrganizationID:     org.ID,
+			SecurityEventDays:  30,
+			DeviceLocationDays: 7,
+			GatewayPingDays:    1,
+		}
+		assert.NoError(SetOrganizationRetention(ts.Tx(), &r))
+		assert.False(r.CreatedAt.IsZero())
+
+		r2, err := GetOrganizationRetention(ts.Tx(), org.ID)
+		assert.NoError(err)
+		assert.Equal(r.SecurityEventDays, r2.SecurityEventDays)
+		assert.Equal(r.DeviceLocationDays, r2.DeviceLocationDays)
+		assert.Equal(r.GatewayPingDays, r2.GatewayPingDays)
+
+		t.Run("Update", func(t *testing.T) {
+			assert := require.New(t)
+
+			r.DeviceLocationDays = 0
+			assert.NoError(SetOrganizationRetention(ts.Tx(), &r))
+
+			r2, err := GetOrganizationRetention(ts.Tx(), org.ID)
+			assert.NoError(err)
+			assert.Equal(0, r2.DeviceLocationDays)
+			assert.Equal(30, r2.SecurityEventDays)
+		})
+	})
+}
+
+func (ts *StorageTestSuite) TestDeleteExpiredData() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	// org 1 overrides the retention, org 2 uses the defaults
+	var orgIDs []int64
+	var devices []Device
+	var gateways []Gateway
+	for i := 0; i < 2; i++ {
+		org := Organization{
+			Name: fmt.Sprintf("test-org-%d", i),
+		}
+		assert.NoError(CreateOrganization(ts.Tx(), &org))
+		orgIDs = append(orgIDs, org.ID)
+
+		sp := ServiceProfile{
+			Name:            "test-sp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+		app := Application{
+			Name:           "test-app",
+			OrganizationID: org.ID,
+		}
+		copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+		assert.NoError(CreateApplication(ts.Tx(), &app))
+
+		dp := DeviceProfile{
+			Name:            "test-dp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+		var dpID uuid.UUID
+		copy(dpID[:], dp.DeviceProfile.Id)
+
+		d := Device{
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, byte(i)},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dpID,
+			Name:            "test-device",
+		}
+		assert.NoError(CreateDevice(ts.Tx(), &d))
+		devices = append(devices, d)
+
+		gw := Gateway{
+			MAC:             lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, byte(i)},
+			Name:            "test-gw",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		assert.NoError(CreateGateway(ts.Tx(), &gw))
+		gateways = append(gateways, gw)
+	}
+
+	assert.NoError(SetOrganizationRetention(ts.Tx(), &OrganizationRetention{
+		OrganizationID:     orgIDs[0],
+		SecurityEventDays:  1,
+		DeviceLocationDays: 1,
+		GatewayPingDays:    1,
+	}))
+
+	old := time.Now().Add(-48 * time.Hour)
+
+	for _, d := range devices {
+		for _, createdAt := range []time.Time{old, time.Now()} {
+			devEUI := d.DevEUI
+			assert.NoError(CreateSecurityEvent(ts.Tx(), &SecurityEvent{
+				CreatedAt:   createdAt,
+				Type:        "join_replay",
+				DevEUI:      &devEUI,
+				Description: "test",
+			}))
+			assert.NoError(CreateDeviceLocation(ts.Tx(), &DeviceLocation{
+				CreatedAt: createdAt,
+				DevEUI:    d.DevEUI,
+			}))
+		}
+	}
+
+	// events not related to a device always use the default retention
+	assert.NoError(CreateSecurityEvent(ts.Tx(), &SecurityEvent{
+		CreatedAt:   old,
+		Type:        "failed_login",
+		Description: "test",
+	}))
+
+	for _, gw := range gateways {
+		// the last ping of the gateway must never be deleted
+		for i := 0; i < 2; i++ {
+			ping := GatewayPing{
+				GatewayMAC: gw.MAC,
+			}
+			assert.NoError(CreateGatewayPing(ts.Tx(), &ping))
+			_, err := ts.Tx().Exec("update gateway_ping set created_at = $1 where id = $2", old, ping.ID)
+			assert.NoError(err)
+
+			gw.LastPingID = &ping.ID
+		}
+		assert.NoError(UpdateGateway(ts.Tx(), &gw))
+	}
+
+	assertUsage := func(t *testing.T, organizationID int64, events, locations, pings int64) {
+		assert := require.New(t)
+
+		u, err := GetOrganizationStorageUsage(ts.Tx(), organizationID)
+		assert.NoError(err)
+		assert.Equal(OrganizationStorageUsage{
+			SecurityEventCount:  events,
+			DeviceLocationCount: locations,
+			GatewayPingCount:    pings,
+		}, u)
+	}
+
+	ts.T().Run("Organization retention", func(t *testing.T) {
+		assert := require.New(t)
+
+		count, err := DeleteExpiredSecurityEvents(ts.Tx(), 0)
+		assert.NoError(err)
+		assert.EqualValues(1, count)
+
+		count, err = DeleteExpiredDeviceLocations(ts.Tx(), 0)
+		assert.NoError(err)
+		assert.EqualValues(1, count)
+
+		count, err = DeleteExpiredGatewayPings(ts.Tx(), 0)
+		assert.NoError(err)
+		assert.EqualValues(1, count)
+
+		assertUsage(t, orgIDs[0], 1, 1, 1)
+		assertUsage(t, orgIDs[1], 2, 2, 2)
+
+		t.Run("Default retention", func(t *testing.T) {
+			assert := require.New(t)
+
+			count, err := DeleteExpiredSecurityEvents(ts.Tx(), 1)
+			assert.NoError(err)
+			assert.EqualValues(2, count)
+
+			count, err = DeleteExpiredDeviceLocations(ts.Tx(), 1)
+			assert.NoError(err)
+			assert.EqualValues(1, count)
+
+			count, err = DeleteExpiredGatewayPings(ts.Tx(), 1)
+			assert.NoError(err)
+			assert.EqualValues(1, count)
+
+			assertUsage(t, orgIDs[0], 1, 1, 1)
+			assertUsage(t, orgIDs[1], 1, 1, 1)
+		})
+	})
+}
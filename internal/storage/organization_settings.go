@@ -0,0 +1,211 @@
+package storage
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/brocaar/lorawan/band"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+var colorRegexp = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// regions contains the region names which can be set as default region.
+var regions = []band.Name{
+	band.AS_923,
+	band.AU_915_928,
+	band.CN_470_510,
+	band.CN_779_787,
+	band.EU_433,
+	band.EU_863_870,
+	band.IN_865_867,
+	band.KR_920_923,
+	band.US_902_928,
+	band.RU_864_870,
+}
+
+// OrganizationSettings contains the (white-label) settings of an
+// organization, e.g. its branding and contact information.
+type OrganizationSettings struct {
+	OrganizationID int64     `db:"organization_id"`
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
+
+	// Branding.
+	LogoURL        string `db:"logo_url"`
+	PrimaryColor   string `db:"primary_color"`
+	SecondaryColor string `db:"secondary_color"`
+
+	// DefaultRegion contains the region used by default for new entities
+	// of this organization (e.g. EU_863_870).
+	DefaultRegion string `db:"default_region"`
+
+	// Contact information.
+	ContactName  string `db:"contact_name"`
+	ContactEmail string `db:"contact_email"`
+	ContactPhone string `db:"contact_phone"`
+
+	// CustomLinks contains custom links (e.g. to documentation or support
+	// pages).
+	CustomLinks OrganizationCustomLinks `db:"custom_links"`
+}
+
+// OrganizationCustomLink defines a custom organization link.
+type OrganizationCustomLink struct {
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// OrganizationCustomLinks defines a list of custom organization links.
+type OrganizationCustomLinks []OrganizationCustomLink
+
+// Value implements the driver.Valuer interface.
+func (l OrganizationCustomLinks) Value() (driver.Value, error) {
+	if l == nil {
+		l = OrganizationCustomLinks{}
+	}
+	return json.Marshal(l)
+}
+
+// Scan implements the sql.Scanner interface.
+func (l *OrganizationCustomLinks) Scan(src interface{}) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("expected []byte, got %T", src)
+	}
+	return json.Unmarshal(b, l)
+}
+
+// Validate validates the organization settings.
+func (s OrganizationSettings) Validate() error {
+	for _, c := range []string{s.PrimaryColor, s.SecondaryColor} {
+		if c != "" && !colorRegexp.MatchString(c) {
+			return ErrInvalidColor
+		}
+	}
+
+	if s.DefaultRegion != "" {
+		var found bool
+		for _, r := range regions {
+			if string(r) == s.DefaultRegion {
+				found = true
+			}
+		}
+		if !found {
+			return ErrInvalidRegion
+		}
+	}
+
+	if s.ContactEmail != "" {
+		if err := ValidateEmail(s.ContactEmail); err != nil {
+			return err
+		}
+	}
+
+	if s.LogoURL != "" {
+		if err := validateURL(s.LogoURL); err != nil {
+			return err
+		}
+	}
+
+	for _, l := range s.CustomLinks {
+		if l.Title == "" || l.URL == "" {
+			return ErrInvalidLink
+		}
+		if err := validateURL(l.URL); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateURL validates that the given string is an absolute http or https
+// url.
+func validateURL(s string) error {
+	u, err := url.Parse(s)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidURL
+	}
+	return nil
+}
+
+// GetOrganizationSettings returns the settings for the given organization
+// id. When no settings have been stored, the settings are returned with
+// their default (empty) values.
+func GetOrganizationSettings(db sqlx.Queryer, organizationID int64) (OrganizationSettings, error) {
+	var s OrganizationSettings
+	err := sqlx.Get(db, &s, "select * from organization_settings where organization_id = $1", organizationID)
+	if err != nil {
+		err = handlePSQLError(Select, err, "select error")
+		if err == ErrDoesNotExist {
+			return OrganizationSettings{
+				OrganizationID: organizationID,
+				CustomLinks:    OrganizationCustomLinks{},
+			}, nil
+		}
+		return s, err
+	}
+
+	return s, nil
+}
+
+// SetOrganizationSettings creates or updates the given organization
+// settings.
+func SetOrganizationSettings(db sqlx.Queryer, s *OrganizationSettings) error {
+	if err := s.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	now := time.Now()
+	err := sqlx.Get(db, &s.CreatedAt, `
+		insert into organization_settings (
+			organization_id,
+			created_at,
+			updated_at,
+			logo_url,
+			primary_color,
+			secondary_color,
+			default_region,
+			contact_name,
+			contact_email,
+			contact_phone,
+			custom_links
+		) values ($1, $2, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		on conflict (organization_id) do update
+		set
+			updated_at = excluded.updated_at,
+			logo_url = excluded.logo_url,
+			primary_color = excluded.primary_color,
+			secondary_color = excluded.secondary_color,
+			default_region = excluded.default_region,
+			contact_name = excluded.contact_name,
+			contact_email = excluded.contact_email,
+			contact_phone = excluded.contact_phone,
+			custom_links = excluded.custom_links
+		returning created_at`,
+		s.OrganizationID,
+		now,
+		s.LogoURL,
+		s.PrimaryColor,
+		s.SecondaryColor,
+		s.DefaultRegion,
+		s.ContactName,
+		s.ContactEmail,
+		s.ContactPhone,
+		s.CustomLinks,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	s.UpdatedAt = now
+	log.WithField("organization_id", s.OrganizationID).Info("organization settings updated")
+	return nil
+}
@@ -0,0 +1,157 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func TestOrganizationSettings(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+
+	Convey("Given a clean database with an organization", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(db, &org), ShouldBeNil)
+
+		Convey("Then the default settings are returned when no settings are stored", func() {
+			s, err := GetOrganizationSettings(db, org.ID)
+			So(err, ShouldBeNil)
+			So(s.OrganizationID, ShouldEqual, org.ID)
+			So(s.CreatedAt.IsZero(), ShouldBeTrue)
+			So(s.CustomLinks, ShouldHaveLength, 0)
+		})
+
+		Convey("When setting the organization settings", func() {
+			s := OrganizationSettings{
+				OrganizationID: org.ID,
+				PrimaryColor:   "#112233",
+				DefaultRegion:  "EU_863_870",
+				ContactEmail:   "support@example.com",
+				CustomLinks: OrganizationCustomLinks{
+					{Title: "Documentation", URL: "https://example.com/docs"},
+				},
+			}
+			So(SetOrganizationSettings(db, &s), ShouldBeNil)
+
+			Convey("Then the settings can be retrieved", func() {
+				s2, err := GetOrganizationSettings(db, org.ID)
+				So(err, ShouldBeNil)
+				So(s2.PrimaryColor, ShouldEqual, s.PrimaryColor)
+				So(s2.DefaultRegion, ShouldEqual, s.DefaultRegion)
+				So(s2.ContactEmail, ShouldEqual, s.ContactEmail)
+				So(s2.CustomLinks, ShouldResemble, s.CustomLinks)
+			})
+
+			Convey("Then the settings can be updated", func() {
+				s.PrimaryColor = "#445566"
+				s.CustomLinks = nil
+				So(SetOrganizationSettings(db, &s), ShouldBeNil)
+
+				s2, err := GetOrganizationSettings(db, org.ID)
+				So(err, ShouldBeNil)
+				So(s2.PrimaryColor, ShouldEqual, "#445566")
+				So(s2.CustomLinks, ShouldHaveLength, 0)
+			})
+		})
+
+		Convey("When setting the settings for an unknown organization", func() {
+			err := SetOrganizationSettings(db, &OrganizationSettings{OrganizationID: org.ID + 1})
+
+			Convey("Then ErrDoesNotExist is returned", func() {
+				So(err, ShouldEqual, ErrDoesNotExist)
+			})
+		})
+	})
+}
+
+func TestOrganizationSettingsValidate(t *testing.T) {
+	tests := []struct {
+		Name          string
+		Settings      OrganizationSettings
+		ExpectedError error
+	}{
+		{
+			Name: "empty settings",
+		},
+		{
+			Name: "valid settings",
+			Settings: OrganizationSettings{
+				LogoURL:        "https://example.com/logo.png",
+				PrimaryColor:   "#112233",
+				SecondaryColor: "#AABBCC",
+				DefaultRegion:  "EU_863_870",
+				ContactEmail:   "support@example.com",
+				CustomLinks: OrganizationCustomLinks{
+					{Title: "Documentation", URL: "http://example.com/docs"},
+				},
+			},
+		},
+		{
+			Name: "invalid color",
+			Settings: OrganizationSettings{
+				PrimaryColor: "red",
+			},
+			ExpectedError: ErrInvalidColor,
+		},
+		{
+			Name: "invalid region",
+			Settings: OrganizationSettings{
+				DefaultRegion: "EU868",
+			},
+			ExpectedError: ErrInvalidRegion,
+		},
+		{
+			Name: "invalid e-mail",
+			Settings: OrganizationSettings{
+				ContactEmail: "support",
+			},
+			ExpectedError: ErrInvalidEmail,
+		},
+		{
+			Name: "relative logo url",
+			Settings: OrganizationSettings{
+				LogoURL: "/logo.png",
+			},
+			ExpectedError: ErrInvalidURL,
+		},
+		{
+			Name: "link without title",
+			Settings: OrganizationSettings{
+				CustomLinks: OrganizationCustomLinks{
+					{URL: "https://example.com/"},
+				},
+			},
+			ExpectedError: ErrInvalidLink,
+		},
+		{
+			Name: "link with invalid url",
+			Settings: OrganizationSettings{
+				CustomLinks: OrganizationCustomLinks{
+					{Title: "Example", URL: "javascript:alert(1)"},
+				},
+			},
+			ExpectedError: ErrInvalidURL,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			assert := require.New(t)
+			assert.Equal(test.ExpectedError, errors.Cause(test.Settings.Validate()))
+		})
+	}
+}
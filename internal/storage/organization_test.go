@@ -6,6 +6,7 @@ import (
 
 	"github.com/brocaar/lora-app-server/internal/config"
 	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
 	"github.com/pkg/errors"
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -70,6 +71,46 @@ func TestOrganization(t *testing.T) {
 				})
 			})
 
+			Convey("When setting a negative quota", func() {
+				org.MaxDeviceCount = -1
+				err := UpdateOrganization(db, &org)
+
+				Convey("Then ErrOrganizationInvalidQuota is returned", func() {
+					So(errors.Cause(err), ShouldResemble, ErrOrganizationInvalidQuota)
+				})
+			})
+
+			Convey("When setting a gateway quota of 1", func() {
+				org.MaxGatewayCount = 1
+				So(UpdateOrganization(db, &org), ShouldBeNil)
+
+				Convey("Then the quota validates while the organization has no gateways", func() {
+					So(ValidateOrganizationGatewayQuota(db, org.ID), ShouldBeNil)
+				})
+
+				Convey("When the organization has a gateway", func() {
+					n := NetworkServer{
+						Name:   "test-ns",
+						Server: "test-ns:1234",
+					}
+					So(CreateNetworkServer(db, &n), ShouldBeNil)
+					So(CreateGateway(db, &Gateway{
+						MAC:             lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+						Name:            "test-gw",
+						OrganizationID:  org.ID,
+						NetworkServerID: n.ID,
+					}), ShouldBeNil)
+
+					Convey("Then ErrOrganizationMaxGatewayCount is returned", func() {
+						So(ValidateOrganizationGatewayQuota(db, org.ID), ShouldEqual, ErrOrganizationMaxGatewayCount)
+					})
+				})
+			})
+
+			Convey("Then the device quota validates when unlimited", func() {
+				So(ValidateOrganizationDeviceQuota(db, org.ID), ShouldBeNil)
+			})
+
 			// first org is created in the migrations
 			Convey("Then get organization count returns 2", func() {
 				count, err := GetOrganizationCount(db, "")
@@ -132,6 +173,29 @@ func TestOrganization(t *testing.T) {
 					So(u.IsAdmin, ShouldBeTrue)
 				})
 
+				Convey("Then the permissions can be updated", func() {
+					p := OrganizationUserPermissions{
+						CanViewKeys: true,
+						CanExport:   true,
+						IsAuditor:   true,
+					}
+					So(UpdateOrganizationUserPermissions(db, org.ID, 1, p), ShouldBeNil) // admin user
+
+					u, err := GetOrganizationUser(db, org.ID, 1)
+					So(err, ShouldBeNil)
+					So(u.OrganizationUserPermissions, ShouldResemble, p)
+
+					users, err := GetOrganizationUsers(db, org.ID, 10, 0)
+					So(err, ShouldBeNil)
+					So(users, ShouldHaveLength, 1)
+					So(users[0].OrganizationUserPermissions, ShouldResemble, p)
+				})
+
+				Convey("Then an admin user can not be an auditor", func() {
+					So(OrganizationUserPermissions{IsAuditor: true}.Validate(true), ShouldEqual, ErrOrganizationUserAdminAuditor)
+					So(OrganizationUserPermissions{IsAuditor: true}.Validate(false), ShouldBeNil)
+				})
+
 				Convey("Then it can be deleted", func() {
 					So(DeleteOrganizationUser(db, org.ID, 1), ShouldBeNil) // admin user
 					c, err := GetOrganizationUserCount(db, org.ID)
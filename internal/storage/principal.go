@@ -0,0 +1,56 @@
+package storage
+
+import (
+	"github.com/jmoiron/sqlx"
+)
+
+// Principal represents an authenticated identity, either an user or a
+// service account. Service accounts have a negative ID.
+type Principal struct {
+	ID       int64  `db:"id"`
+	Username string `db:"username"`
+	IsActive bool   `db:"is_active"`
+	IsAdmin  bool   `db:"is_admin"`
+}
+
+// PrincipalOrganization represents the organization membership of a
+// principal.
+type PrincipalOrganization struct {
+	OrganizationUserPermissions
+
+	OrganizationID int64 `db:"organization_id"`
+	IsAdmin        bool  `db:"is_admin"`
+}
+
+// GetPrincipalByUsername returns the principal for the given username.
+func GetPrincipalByUsername(db sqlx.Queryer, username string) (Principal, error) {
+	var p Principal
+	err := sqlx.Get(db, &p, "select * from principal where username = $1", username)
+	if err != nil {
+		return p, handlePSQLError(Select, err, "select error")
+	}
+	return p, nil
+}
+
+// GetPrincipalOrganizations returns the organization memberships of the
+// principal for the given ID, sorted by organization ID.
+func GetPrincipalOrganizations(db sqlx.Queryer, principalID int64) ([]PrincipalOrganization, error) {
+	var items []PrincipalOrganization
+	err := sqlx.Select(db, &items, `
+		select
+			organization_id,
+			is_admin,
+			can_view_keys,
+			can_export,
+			is_auditor
+		from principal_organization
+		where
+			user_id = $1
+		order by organization_id`,
+		principalID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return items, nil
+}
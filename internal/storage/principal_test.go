@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func (ts *StorageTestSuite) TestPrincipal() {
+	assert := require.New(ts.T())
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	user := User{
+		Username: "testuser",
+		IsActive: true,
+		Email:    "foo@bar.com",
+	}
+	userID, err := CreateUser(ts.Tx(), &user, "password123")
+	assert.NoError(err)
+	assert.NoError(CreateOrganizationUser(ts.Tx(), org.ID, userID, true))
+
+	sa := ServiceAccount{
+		OrganizationID: org.ID,
+		Name:           "automation",
+		IsActive:       true,
+		OrganizationUserPermissions: OrganizationUserPermissions{
+			CanExport: true,
+		},
+	}
+	_, err = CreateServiceAccount(ts.Tx(), &sa)
+	assert.NoError(err)
+
+	p, err := GetPrincipalByUsername(ts.Tx(), "testuser")
+	assert.NoError(err)
+	assert.Equal(Principal{ID: userID, Username: "testuser", IsActive: true}, p)
+
+	orgs, err := GetPrincipalOrganizations(ts.Tx(), p.ID)
+	assert.NoError(err)
+	assert.Equal([]PrincipalOrganization{{OrganizationID: org.ID, IsAdmin: true}}, orgs)
+
+	p, err = GetPrincipalByUsername(ts.Tx(), sa.Username())
+	assert.NoError(err)
+	assert.Equal(Principal{ID: -sa.ID, Username: sa.Username(), IsActive: true}, p)
+
+	orgs, err = GetPrincipalOrganizations(ts.Tx(), p.ID)
+	assert.NoError(err)
+	assert.Equal([]PrincipalOrganization{{
+		OrganizationID:              org.ID,
+		OrganizationUserPermissions: OrganizationUserPermissions{CanExport: true},
+	}}, orgs)
+
+	_, err = GetPrincipalByUsername(ts.Tx(), "unknown")
+	assert.Equal(ErrDoesNotExist, errors.Cause(err))
+}
@@ -0,0 +1,343 @@
+package storage
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// inviteTokenSize defines the number of random bytes of an invite token.
+const inviteTokenSize = 16
+
+// RegistrationInvite defines an invite which can be used to self-register
+// when the registration mode is set to invite.
+type RegistrationInvite struct {
+	ID        int64      `db:"id"`
+	CreatedAt time.Time  `db:"created_at"`
+	Token     string     `db:"token"`
+	Email     string     `db:"email"`
+	ExpiresAt *time.Time `db:"expires_at"`
+	UsedAt    *time.Time `db:"used_at"`
+}
+
+// Validate validates that the invite can be used to register with the
+// given e-mail address. When the invite has been created for a specific
+// e-mail address, it must match the given address.
+func (i RegistrationInvite) Validate(email string) error {
+	if i.UsedAt != nil {
+		return ErrRegistrationInvalidInvite
+	}
+	if i.ExpiresAt != nil && i.ExpiresAt.Before(time.Now()) {
+		return ErrRegistrationInvalidInvite
+	}
+	if i.Email != "" && i.Email != email {
+		return ErrRegistrationInvalidInvite
+	}
+	return nil
+}
+
+// Registration defines a self-registration of a user and its organization.
+type Registration struct {
+	ID             int64     `db:"id"`
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
+	UserID         int64     `db:"user_id"`
+	OrganizationID int64     `db:"organization_id"`
+	InviteID       *int64    `db:"invite_id"`
+	Approved       bool      `db:"approved"`
+}
+
+// RegistrationListItem defines a registration together with the user and
+// organization details for listing.
+type RegistrationListItem struct {
+	Registration
+	Username         string `db:"username"`
+	Email            string `db:"email"`
+	OrganizationName string `db:"organization_name"`
+}
+
+// CreateRegistrationInvite creates the given registration invite. When
+// no token is set, a random token will be generated.
+func CreateRegistrationInvite(db sqlx.Queryer, i *RegistrationInvite) error {
+	if i.Email != "" {
+		if err := ValidateEmail(i.Email); err != nil {
+			return errors.Wrap(err, "validate error")
+		}
+	}
+
+	if i.Token == "" {
+		b := make([]byte, inviteTokenSize)
+		if _, err := rand.Read(b); err != nil {
+			return errors.Wrap(err, "read random bytes error")
+		}
+		i.Token = hex.EncodeToString(b)
+	}
+
+	i.CreatedAt = time.Now()
+
+	err := sqlx.Get(db, &i.ID, `
+		insert into registration_invite (
+			created_at,
+			token,
+			email,
+			expires_at,
+			used_at
+		) values ($1, $2, $3, $4, $5)
+		returning id`,
+		i.CreatedAt,
+		i.Token,
+		i.Email,
+		i.ExpiresAt,
+		i.UsedAt,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":    i.ID,
+		"email": i.Email,
+	}).Info("registration invite created")
+	return nil
+}
+
+// GetRegistrationInviteForToken returns the registration invite for the
+// given token. When forUpdate is set to true, then db must be a db
+// transaction.
+func GetRegistrationInviteForToken(db sqlx.Queryer, token string, forUpdate bool) (RegistrationInvite, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var i RegistrationInvite
+	err := sqlx.Get(db, &i, "select * from registration_invite where token = $1"+fu, token)
+	if err != nil {
+		return i, handlePSQLError(Select, err, "select error")
+	}
+
+	return i, nil
+}
+
+// GetRegistrationInviteCount returns the total number of registration
+// invites.
+func GetRegistrationInviteCount(db sqlx.Queryer) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, "select count(*) from registration_invite")
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetRegistrationInvites returns a slice of registration invites, sorted
+// by creation date (newest first).
+func GetRegistrationInvites(db sqlx.Queryer, limit, offset int) ([]RegistrationInvite, error) {
+	var invites []RegistrationInvite
+	err := sqlx.Select(db, &invites, `
+		select *
+		from registration_invite
+		order by created_at desc, id desc
+		limit $1 offset $2`,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return invites, nil
+}
+
+// UseRegistrationInvite marks the given registration invite as used.
+func UseRegistrationInvite(db sqlx.Execer, id int64) error {
+	res, err := db.Exec(`
+		update registration_invite
+		set
+			used_at = $2
+		where
+			id = $1
+			and used_at is null`,
+		id,
+		time.Now(),
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrRegistrationInvalidInvite
+	}
+
+	log.WithField("id", id).Info("registration invite used")
+	return nil
+}
+
+// DeleteRegistrationInvite deletes the registration invite matching the
+// given id.
+func DeleteRegistrationInvite(db sqlx.Execer, id int64) error {
+	res, err := db.Exec("delete from registration_invite where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("registration invite deleted")
+	return nil
+}
+
+// CreateRegistration creates the given registration.
+func CreateRegistration(db sqlx.Queryer, r *Registration) error {
+	now := time.Now()
+	r.CreatedAt = now
+	r.UpdatedAt = now
+
+	err := sqlx.Get(db, &r.ID, `
+		insert into registration (
+			created_at,
+			updated_at,
+			user_id,
+			organization_id,
+			invite_id,
+			approved
+		) values ($1, $2, $3, $4, $5, $6)
+		returning id`,
+		r.CreatedAt,
+		r.UpdatedAt,
+		r.UserID,
+		r.OrganizationID,
+		r.InviteID,
+		r.Approved,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":              r.ID,
+		"user_id":         r.UserID,
+		"organization_id": r.OrganizationID,
+		"approved":        r.Approved,
+	}).Info("registration created")
+	return nil
+}
+
+// GetRegistration returns the registration matching the given id.
+// When forUpdate is set to true, then db must be a db transaction.
+func GetRegistration(db sqlx.Queryer, id int64, forUpdate bool) (Registration, error) {
+	var fu string
+	if forUpdate {
+		fu = " for update"
+	}
+
+	var r Registration
+	err := sqlx.Get(db, &r, "select * from registration where id = $1"+fu, id)
+	if err != nil {
+		return r, handlePSQLError(Select, err, "select error")
+	}
+
+	return r, nil
+}
+
+// GetRegistrationCount returns the total number of registrations. When
+// pendingOnly is set, only the registrations awaiting approval are counted.
+func GetRegistrationCount(db sqlx.Queryer, pendingOnly bool) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select count(*)
+		from registration
+		where
+			($1 = false or approved = false)`,
+		pendingOnly,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetRegistrations returns a slice of registrations, sorted by creation
+// date (newest first). When pendingOnly is set, only the registrations
+// awaiting approval are returned.
+func GetRegistrations(db sqlx.Queryer, pendingOnly bool, limit, offset int) ([]RegistrationListItem, error) {
+	var items []RegistrationListItem
+	err := sqlx.Select(db, &items, `
+		select
+			r.*,
+			u.username as username,
+			u.email as email,
+			o.name as organization_name
+		from registration r
+		inner join "user" u
+			on u.id = r.user_id
+		inner join organization o
+			on o.id = r.organization_id
+		where
+			($1 = false or r.approved = false)
+		order by r.created_at desc, r.id desc
+		limit $2 offset $3`,
+		pendingOnly,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return items, nil
+}
+
+// ApproveRegistration approves the registration matching the given id and
+// activates the registered user.
+func ApproveRegistration(db sqlx.Execer, id int64) error {
+	now := time.Now()
+
+	res, err := db.Exec(`
+		update registration
+		set
+			approved = true,
+			updated_at = $2
+		where
+			id = $1`,
+		id,
+		now,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	_, err = db.Exec(`
+		update "user"
+		set
+			is_active = true,
+			updated_at = $2
+		where
+			id = (select user_id from registration where id = $1)`,
+		id,
+		now,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+
+	log.WithField("id", id).Info("registration approved")
+	return nil
+}
@@ -0,0 +1,156 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+)
+
+func TestRegistrationInviteValidate(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Minute)
+
+	tests := []struct {
+		Name   string
+		Invite RegistrationInvite
+		Email  string
+		Error  error
+	}{
+		{"valid", RegistrationInvite{}, "user@example.com", nil},
+		{"valid before expiration", RegistrationInvite{ExpiresAt: &future}, "user@example.com", nil},
+		{"expired", RegistrationInvite{ExpiresAt: &past}, "user@example.com", ErrRegistrationInvalidInvite},
+		{"used", RegistrationInvite{UsedAt: &past}, "user@example.com", ErrRegistrationInvalidInvite},
+		{"matching e-mail", RegistrationInvite{Email: "user@example.com"}, "user@example.com", nil},
+		{"other e-mail", RegistrationInvite{Email: "user@example.com"}, "other@example.com", ErrRegistrationInvalidInvite},
+	}
+
+	for _, tst := range tests {
+		if err := tst.Invite.Validate(tst.Email); err != tst.Error {
+			t.Errorf("%s: expected error %v, got %v", tst.Name, tst.Error, err)
+		}
+	}
+}
+
+func TestRegistration(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+
+	Convey("Given a clean database with a user and organization", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+
+		user := User{
+			Username: "testuser",
+			Email:    "user@example.com",
+		}
+		_, err := CreateUser(db, &user, "secret123")
+		So(err, ShouldBeNil)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(db, &org), ShouldBeNil)
+
+		Convey("When creating a registration invite", func() {
+			invite := RegistrationInvite{
+				Email: "user@example.com",
+			}
+			So(CreateRegistrationInvite(db, &invite), ShouldBeNil)
+			So(invite.Token, ShouldHaveLength, 2*inviteTokenSize)
+
+			Convey("Then it can be retrieved by its token", func() {
+				i, err := GetRegistrationInviteForToken(db, invite.Token, false)
+				So(err, ShouldBeNil)
+				So(i.ID, ShouldEqual, invite.ID)
+				So(i.Email, ShouldEqual, invite.Email)
+				So(i.UsedAt, ShouldBeNil)
+			})
+
+			Convey("Then the invites are listed", func() {
+				count, err := GetRegistrationInviteCount(db)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+
+				invites, err := GetRegistrationInvites(db, 10, 0)
+				So(err, ShouldBeNil)
+				So(invites, ShouldHaveLength, 1)
+			})
+
+			Convey("Then it can be used only once", func() {
+				So(UseRegistrationInvite(db, invite.ID), ShouldBeNil)
+				So(UseRegistrationInvite(db, invite.ID), ShouldEqual, ErrRegistrationInvalidInvite)
+
+				i, err := GetRegistrationInviteForToken(db, invite.Token, false)
+				So(err, ShouldBeNil)
+				So(i.Validate(invite.Email), ShouldEqual, ErrRegistrationInvalidInvite)
+			})
+
+			Convey("Then it can be deleted", func() {
+				So(DeleteRegistrationInvite(db, invite.ID), ShouldBeNil)
+				_, err := GetRegistrationInviteForToken(db, invite.Token, false)
+				So(errors.Cause(err), ShouldEqual, ErrDoesNotExist)
+			})
+		})
+
+		Convey("When creating a registration awaiting approval", func() {
+			reg := Registration{
+				UserID:         user.ID,
+				OrganizationID: org.ID,
+			}
+			So(CreateRegistration(db, &reg), ShouldBeNil)
+
+			Convey("Then it can be retrieved by its id", func() {
+				r, err := GetRegistration(db, reg.ID, false)
+				So(err, ShouldBeNil)
+				So(r.UserID, ShouldEqual, user.ID)
+				So(r.OrganizationID, ShouldEqual, org.ID)
+				So(r.Approved, ShouldBeFalse)
+			})
+
+			Convey("Then it is listed as pending", func() {
+				count, err := GetRegistrationCount(db, true)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+
+				items, err := GetRegistrations(db, true, 10, 0)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 1)
+				So(items[0].Username, ShouldEqual, user.Username)
+				So(items[0].Email, ShouldEqual, user.Email)
+				So(items[0].OrganizationName, ShouldEqual, org.Name)
+			})
+
+			Convey("When approving the registration", func() {
+				So(ApproveRegistration(db, reg.ID), ShouldBeNil)
+
+				Convey("Then the registration is approved and the user is active", func() {
+					r, err := GetRegistration(db, reg.ID, false)
+					So(err, ShouldBeNil)
+					So(r.Approved, ShouldBeTrue)
+
+					u, err := GetUser(db, user.ID)
+					So(err, ShouldBeNil)
+					So(u.IsActive, ShouldBeTrue)
+				})
+
+				Convey("Then it is no longer listed as pending", func() {
+					count, err := GetRegistrationCount(db, true)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 0)
+
+					count, err = GetRegistrationCount(db, false)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 1)
+				})
+			})
+		})
+	})
+}
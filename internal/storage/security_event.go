@@ -0,0 +1,174 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// SecurityEvent defines a security-relevant event (e.g. a failed login or
+// a join replay). The OrganizationID is set for events which relate to an
+// organization but not to a device (e.g. a permission change).
+type SecurityEvent struct {
+	ID             int64          `db:"id"`
+	CreatedAt      time.Time      `db:"created_at"`
+	Type           string         `db:"type"`
+	Severity       int            `db:"severity"`
+	Username       string         `db:"username"`
+	RemoteAddr     string         `db:"remote_addr"`
+	DevEUI         *lorawan.EUI64 `db:"dev_eui"`
+	Description    string         `db:"description"`
+	OrganizationID *int64         `db:"organization_id"`
+}
+
+// CreateSecurityEvent creates the given security event. When the CreatedAt
+// timestamp is not set, it is set to the current time.
+func CreateSecurityEvent(db sqlx.Queryer, e *SecurityEvent) error {
+	if e.CreatedAt.IsZero() {
+		e.CreatedAt = time.Now()
+	}
+
+	err := sqlx.Get(db, &e.ID, `
+		insert into security_event (
+			created_at,
+			type,
+			severity,
+			username,
+			remote_addr,
+			dev_eui,
+			description,
+			organization_id
+		) values ($1, $2, $3, $4, $5, $6, $7, $8)
+		returning id`,
+		e.CreatedAt,
+		e.Type,
+		e.Severity,
+		e.Username,
+		e.RemoteAddr,
+		e.DevEUI,
+		e.Description,
+		e.OrganizationID,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":   e.ID,
+		"type": e.Type,
+	}).Info("security event created")
+
+	return nil
+}
+
+// SecurityEventFilters provides filters for retrieving security events.
+// Note that empty values are not used as filter.
+type SecurityEventFilters struct {
+	Type   string
+	DevEUI *lorawan.EUI64
+	Limit  int
+	Offset int
+}
+
+// GetSecurityEventCount returns the number of security events matching the
+// given filters.
+func GetSecurityEventCount(db sqlx.Queryer, filters SecurityEventFilters) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select count(*)
+		from security_event
+		where
+			($1 = '' or type = $1)
+			and ($2::bytea is null or dev_eui = $2)`,
+		filters.Type,
+		filters.DevEUI,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+
+	return count, nil
+}
+
+// GetSecurityEvents returns the security events matching the given filters,
+// the most recent event first.
+func GetSecurityEvents(db sqlx.Queryer, filters SecurityEventFilters) ([]SecurityEvent, error) {
+	var events []SecurityEvent
+	err := sqlx.Select(db, &events, `
+		select *
+		from security_event
+		where
+			($1 = '' or type = $1)
+			and ($2::bytea is null or dev_eui = $2)
+		order by
+			created_at desc,
+			id desc
+		limit $3
+		offset $4`,
+		filters.Type,
+		filters.DevEUI,
+		filters.Limit,
+		filters.Offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return events, nil
+}
+
+// GetSecurityEventOrganizationIDs returns the IDs of the organizations to
+// which the given security event relates. This is the organization of the
+// event when set, else the organization of the device when the event
+// relates to a device, else the organizations of the user when the event
+// relates to a (known) username (e.g. a failed login).
+func GetSecurityEventOrganizationIDs(db sqlx.Queryer, e SecurityEvent) ([]int64, error) {
+	var ids []int64
+	err := sqlx.Select(db, &ids, `
+		select
+			organization_id
+		from (
+			select
+				$1::bigint as organization_id
+			where
+				$1::bigint is not null
+
+			union
+
+			select
+				a.organization_id
+			from device d
+			inner join application a
+				on a.id = d.application_id
+			where
+				$1::bigint is null
+				and d.dev_eui = $2
+
+			union
+
+			select
+				ou.organization_id
+			from organization_user ou
+			inner join "user" u
+				on u.id = ou.user_id
+			where
+				$1::bigint is null
+				and $2::bytea is null
+				and $3 != ''
+				and u.username = $3
+		) o
+		order by
+			organization_id`,
+		e.OrganizationID,
+		e.DevEUI,
+		e.Username,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return ids, nil
+}
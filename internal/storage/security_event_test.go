@@ -0,0 +1,176 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestSecurityEvent() {
+	assert := require.New(ts.T())
+
+	devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+	now := time.Now().Round(time.Second).UTC()
+
+	events := []SecurityEvent{
+		{
+			CreatedAt:   now,
+			Type:        "failed_login",
+			Severity:    5,
+			Username:    "admin",
+			RemoteAddr:  "127.0.0.1:1234",
+			Description: "invalid username or password",
+		},
+		{
+			CreatedAt:   now.Add(time.Minute),
+			Type:        "join_replay",
+			Severity:    7,
+			DevEUI:      &devEUI,
+			Description: "dev-nonce has already been used",
+		},
+	}
+	for i := range events {
+		assert.NoError(CreateSecurityEvent(ts.Tx(), &events[i]))
+		assert.NotEqual(0, events[i].ID)
+	}
+
+	ts.T().Run("Get all", func(t *testing.T) {
+		assert := require.New(t)
+
+		count, err := GetSecurityEventCount(ts.Tx(), SecurityEventFilters{})
+		assert.NoError(err)
+		assert.Equal(2, count)
+
+		out, err := GetSecurityEvents(ts.Tx(), SecurityEventFilters{Limit: 10})
+		assert.NoError(err)
+		assert.Len(out, 2)
+
+		for i := range out {
+			out[i].CreatedAt = out[i].CreatedAt.UTC()
+		}
+		assert.Equal([]SecurityEvent{events[1], events[0]}, out)
+	})
+
+	ts.T().Run("Filter by type", func(t *testing.T) {
+		assert := require.New(t)
+
+		count, err := GetSecurityEventCount(ts.Tx(), SecurityEventFilters{Type: "failed_login"})
+		assert.NoError(err)
+		assert.Equal(1, count)
+
+		out, err := GetSecurityEvents(ts.Tx(), SecurityEventFilters{Type: "failed_login", Limit: 10})
+		assert.NoError(err)
+		assert.Len(out, 1)
+		assert.Equal(events[0].ID, out[0].ID)
+	})
+
+	ts.T().Run("Filter by DevEUI", func(t *testing.T) {
+		assert := require.New(t)
+
+		out, err := GetSecurityEvents(ts.Tx(), SecurityEventFilters{DevEUI: &devEUI, Limit: 10})
+		assert.NoError(err)
+		assert.Len(out, 1)
+		assert.Equal(events[1].ID, out[0].ID)
+	})
+}
+
+func (ts *StorageTestSuite) TestGetSecurityEventOrganizationIDs() {
+	assert := require.New(ts.T())
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	var orgs []Organization
+	for _, name := range []string{"test-org-1", "test-org-2"} {
+		org := Organization{
+			Name: name,
+		}
+		assert.NoError(CreateOrganization(ts.Tx(), &org))
+		orgs = append(orgs, org)
+	}
+
+	sp := ServiceProfile{
+		Name:            "test-sp",
+		OrganizationID:  orgs[1].ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateServiceProfile(ts.Tx(), &sp))
+
+	app := Application{
+		Name:           "test-app",
+		OrganizationID: orgs[1].ID,
+	}
+	copy(app.ServiceProfileID[:], sp.ServiceProfile.Id)
+	assert.NoError(CreateApplication(ts.Tx(), &app))
+
+	dp := DeviceProfile{
+		Name:            "test-dp",
+		OrganizationID:  orgs[1].ID,
+		NetworkServerID: n.ID,
+	}
+	assert.NoError(CreateDeviceProfile(ts.Tx(), &dp))
+	var dpID uuid.UUID
+	copy(dpID[:], dp.DeviceProfile.Id)
+
+	d := Device{
+		DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		ApplicationID:   app.ID,
+		DeviceProfileID: dpID,
+		Name:            "test-device",
+	}
+	assert.NoError(CreateDevice(ts.Tx(), &d))
+
+	user := User{
+		Username: "testuser",
+		IsActive: true,
+		Email:    "foo@bar.com",
+	}
+	userID, err := CreateUser(ts.Tx(), &user, "password123")
+	assert.NoError(err)
+	for _, org := range orgs {
+		assert.NoError(CreateOrganizationUser(ts.Tx(), org.ID, userID, false))
+	}
+
+	tests := []struct {
+		Name     string
+		Event    SecurityEvent
+		Expected []int64
+	}{
+		{
+			Name:     "organization",
+			Event:    SecurityEvent{OrganizationID: &orgs[0].ID, Username: user.Username},
+			Expected: []int64{orgs[0].ID},
+		},
+		{
+			Name:     "device",
+			Event:    SecurityEvent{DevEUI: &d.DevEUI, Username: user.Username},
+			Expected: []int64{orgs[1].ID},
+		},
+		{
+			Name:     "user",
+			Event:    SecurityEvent{Username: user.Username},
+			Expected: []int64{orgs[0].ID, orgs[1].ID},
+		},
+		{
+			Name:  "unknown user",
+			Event: SecurityEvent{Username: "foo"},
+		},
+	}
+
+	for _, tst := range tests {
+		ts.T().Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+
+			ids, err := GetSecurityEventOrganizationIDs(ts.Tx(), tst.Event)
+			assert.NoError(err)
+			assert.Equal(tst.Expected, ids)
+		})
+	}
+}
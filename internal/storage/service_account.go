@@ -0,0 +1,364 @@
+package storage
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"strings"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/gofrs/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// ServiceAccountUsernamePrefix defines the prefix of the username with
+// which a service account is authenticated (followed by the client ID).
+// As usernames may only contain letters and digits, this can not collide
+// with the username of a user.
+const ServiceAccountUsernamePrefix = "service-account:"
+
+// serviceAccountSecretSize defines the number of random bytes of a
+// generated service account client secret.
+const serviceAccountSecretSize = 32
+
+// ServiceAccount represents a service account. A service account is a
+// non-human principal of an organization, used for machine-to-machine
+// access to the API. It authenticates using its client ID and secret or
+// using a JWT assertion signed with its private key (OAuth2 client
+// credentials and JWT bearer grants).
+type ServiceAccount struct {
+	OrganizationUserPermissions
+
+	ID               int64     `db:"id"`
+	CreatedAt        time.Time `db:"created_at"`
+	UpdatedAt        time.Time `db:"updated_at"`
+	OrganizationID   int64     `db:"organization_id"`
+	Name             string    `db:"name"`
+	Description      string    `db:"description"`
+	ClientID         uuid.UUID `db:"client_id"`
+	ClientSecretHash string    `db:"client_secret_hash"`
+	PublicKey        string    `db:"public_key"`
+	IsActive         bool      `db:"is_active"`
+	IsAdmin          bool      `db:"is_admin"`
+}
+
+// Validate validates the service account data.
+func (s ServiceAccount) Validate() error {
+	if strings.TrimSpace(s.Name) == "" || len(s.Name) > 100 {
+		return ErrServiceAccountInvalidName
+	}
+	if s.PublicKey != "" {
+		if _, err := s.GetPublicKey(); err != nil {
+			return err
+		}
+	}
+	return s.OrganizationUserPermissions.Validate(s.IsAdmin)
+}
+
+// Username returns the username with which the service account is
+// authenticated.
+func (s ServiceAccount) Username() string {
+	return ServiceAccountUsernamePrefix + s.ClientID.String()
+}
+
+// ValidateClientSecret returns true when the given secret matches the
+// client secret hash of the service account.
+func (s ServiceAccount) ValidateClientSecret(secret string) bool {
+	return hashCompare(secret, s.ClientSecretHash)
+}
+
+// GetPublicKey returns the parsed (RSA or ECDSA) public key of the service
+// account.
+func (s ServiceAccount) GetPublicKey() (interface{}, error) {
+	block, _ := pem.Decode([]byte(s.PublicKey))
+	if block == nil {
+		return nil, ErrServiceAccountInvalidPublicKey
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, ErrServiceAccountInvalidPublicKey
+	}
+	switch pub.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey:
+		return pub, nil
+	default:
+		return nil, ErrServiceAccountInvalidPublicKey
+	}
+}
+
+// IsServiceAccountUsername returns true when the given username is the
+// username of a service account.
+func IsServiceAccountUsername(username string) bool {
+	return strings.HasPrefix(username, ServiceAccountUsernamePrefix)
+}
+
+// CreateServiceAccount creates the given service account. The client ID
+// and secret are generated. It returns the (plaintext) client secret, which
+// is only available at this point.
+func CreateServiceAccount(db sqlx.Queryer, s *ServiceAccount) (string, error) {
+	if err := s.Validate(); err != nil {
+		return "", errors.Wrap(err, "validate error")
+	}
+
+	clientID, err := uuid.NewV4()
+	if err != nil {
+		return "", errors.Wrap(err, "new uuid v4 error")
+	}
+
+	secret, secretHash, err := generateServiceAccountSecret()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	s.CreatedAt = now
+	s.UpdatedAt = now
+	s.ClientID = clientID
+	s.ClientSecretHash = secretHash
+
+	err = sqlx.Get(db, &s.ID, `
+		insert into service_account (
+			created_at,
+			updated_at,
+			organization_id,
+			name,
+			description,
+			client_id,
+			client_secret_hash,
+			public_key,
+			is_active,
+			is_admin,
+			can_view_keys,
+			can_export,
+			is_auditor
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		returning id`,
+		s.CreatedAt,
+		s.UpdatedAt,
+		s.OrganizationID,
+		s.Name,
+		s.Description,
+		s.ClientID,
+		s.ClientSecretHash,
+		s.PublicKey,
+		s.IsActive,
+		s.IsAdmin,
+		s.CanViewKeys,
+		s.CanExport,
+		s.IsAuditor,
+	)
+	if err != nil {
+		return "", handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":              s.ID,
+		"organization_id": s.OrganizationID,
+		"client_id":       s.ClientID,
+	}).Info("service account created")
+
+	return secret, nil
+}
+
+// GetServiceAccount returns the service account for the given ID.
+func GetServiceAccount(db sqlx.Queryer, id int64) (ServiceAccount, error) {
+	var s ServiceAccount
+	err := sqlx.Get(db, &s, "select * from service_account where id = $1", id)
+	if err != nil {
+		return s, handlePSQLError(Select, err, "select error")
+	}
+	return s, nil
+}
+
+// GetServiceAccountByClientID returns the service account for the given
+// client ID.
+func GetServiceAccountByClientID(db sqlx.Queryer, clientID uuid.UUID) (ServiceAccount, error) {
+	var s ServiceAccount
+	err := sqlx.Get(db, &s, "select * from service_account where client_id = $1", clientID)
+	if err != nil {
+		return s, handlePSQLError(Select, err, "select error")
+	}
+	return s, nil
+}
+
+// GetServiceAccountCount returns the number of service accounts of the
+// given organization.
+func GetServiceAccountCount(db sqlx.Queryer, organizationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, "select count(*) from service_account where organization_id = $1", organizationID)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetServiceAccounts returns the service accounts of the given
+// organization, sorted by name and respecting the given limit and offset.
+func GetServiceAccounts(db sqlx.Queryer, organizationID int64, limit, offset int) ([]ServiceAccount, error) {
+	var items []ServiceAccount
+	err := sqlx.Select(db, &items, `
+		select *
+		from service_account
+		where
+			organization_id = $1
+		order by name, id
+		limit $2 offset $3`,
+		organizationID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return items, nil
+}
+
+// UpdateServiceAccount updates the given service account. The client ID
+// and secret are not updated.
+func UpdateServiceAccount(db sqlx.Execer, s *ServiceAccount) error {
+	if err := s.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	s.UpdatedAt = time.Now()
+
+	res, err := db.Exec(`
+		update service_account
+		set
+			updated_at = $2,
+			name = $3,
+			description = $4,
+			public_key = $5,
+			is_active = $6,
+			is_admin = $7,
+			can_view_keys = $8,
+			can_export = $9,
+			is_auditor = $10
+		where
+			id = $1`,
+		s.ID,
+		s.UpdatedAt,
+		s.Name,
+		s.Description,
+		s.PublicKey,
+		s.IsActive,
+		s.IsAdmin,
+		s.CanViewKeys,
+		s.CanExport,
+		s.IsAuditor,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"id":        s.ID,
+		"is_active": s.IsActive,
+		"is_admin":  s.IsAdmin,
+	}).Info("service account updated")
+
+	return nil
+}
+
+// RegenerateServiceAccountSecret generates a new client secret for the
+// given service account, revoking the previous secret. It returns the
+// (plaintext) client secret.
+func RegenerateServiceAccountSecret(db sqlx.Execer, id int64) (string, error) {
+	secret, secretHash, err := generateServiceAccountSecret()
+	if err != nil {
+		return "", err
+	}
+
+	res, err := db.Exec(`
+		update service_account
+		set
+			updated_at = $2,
+			client_secret_hash = $3
+		where
+			id = $1`,
+		id,
+		time.Now(),
+		secretHash,
+	)
+	if err != nil {
+		return "", handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return "", errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return "", ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("service account secret regenerated")
+
+	return secret, nil
+}
+
+// DeleteServiceAccount deletes the service account for the given ID.
+func DeleteServiceAccount(db sqlx.Execer, id int64) error {
+	res, err := db.Exec("delete from service_account where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("service account deleted")
+
+	return nil
+}
+
+// GetServiceAccountToken returns a JWT token for the given service account,
+// valid for the given duration.
+func GetServiceAccountToken(s ServiceAccount, ttl time.Duration) (string, error) {
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss":      "lora-app-server",
+		"aud":      "lora-app-server",
+		"nbf":      now.Unix(),
+		"exp":      now.Add(ttl).Unix(),
+		"sub":      "service_account",
+		"username": s.Username(),
+	})
+
+	jwt, err := token.SignedString(jwtsecret)
+	if err != nil {
+		return "", errors.Wrap(err, "get jwt signed string error")
+	}
+	return jwt, nil
+}
+
+func generateServiceAccountSecret() (string, string, error) {
+	b := make([]byte, serviceAccountSecretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", errors.Wrap(err, "read random bytes error")
+	}
+	secret := hex.EncodeToString(b)
+
+	secretHash, err := hash(secret, saltSize, HashIterations)
+	if err != nil {
+		return "", "", err
+	}
+
+	return secret, secretHash, nil
+}
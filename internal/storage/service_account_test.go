@@ -0,0 +1,163 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+const testServiceAccountPublicKey = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEcgWa93if+vCJ6fAz+XhOQjmT8G/x
+vKaZveQ2Cbz8qhxLeKe6yWo5d1J8r5jkIrOAmk29QuRdXEUjVZwaNwCqiQ==
+-----END PUBLIC KEY-----`
+
+func (ts *StorageTestSuite) TestServiceAccount() {
+	assert := require.New(ts.T())
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	ts.T().Run("Validate", func(t *testing.T) {
+		tests := []struct {
+			Name          string
+			SA            ServiceAccount
+			ExpectedError error
+		}{
+			{
+				Name: "valid",
+				SA:   ServiceAccount{Name: "test", PublicKey: testServiceAccountPublicKey},
+			},
+			{
+				Name:          "missing name",
+				ExpectedError: ErrServiceAccountInvalidName,
+			},
+			{
+				Name:          "invalid public key",
+				SA:            ServiceAccount{Name: "test", PublicKey: "foo"},
+				ExpectedError: ErrServiceAccountInvalidPublicKey,
+			},
+			{
+				Name: "admin auditor",
+				SA: ServiceAccount{
+					Name:                        "test",
+					IsAdmin:                     true,
+					OrganizationUserPermissions: OrganizationUserPermissions{IsAuditor: true},
+				},
+				ExpectedError: ErrOrganizationUserAdminAuditor,
+			},
+		}
+
+		for _, tst := range tests {
+			t.Run(tst.Name, func(t *testing.T) {
+				require.Equal(t, tst.ExpectedError, tst.SA.Validate())
+			})
+		}
+	})
+
+	ts.T().Run("Create", func(t *testing.T) {
+		assert := require.New(t)
+
+		sa := ServiceAccount{
+			OrganizationID: org.ID,
+			Name:           "automation",
+			Description:    "ci pipeline",
+			IsActive:       true,
+			OrganizationUserPermissions: OrganizationUserPermissions{
+				CanExport: true,
+			},
+		}
+		secret, err := CreateServiceAccount(ts.Tx(), &sa)
+		assert.NoError(err)
+		assert.Len(secret, 64)
+		assert.Equal(ServiceAccountUsernamePrefix+sa.ClientID.String(), sa.Username())
+		assert.True(IsServiceAccountUsername(sa.Username()))
+		assert.False(IsServiceAccountUsername("admin"))
+
+		sa.CreatedAt = sa.CreatedAt.Round(time.Second).UTC()
+		sa.UpdatedAt = sa.UpdatedAt.Round(time.Second).UTC()
+
+		t.Run("Get", func(t *testing.T) {
+			assert := require.New(t)
+
+			saGet, err := GetServiceAccount(ts.Tx(), sa.ID)
+			assert.NoError(err)
+			saGet.CreatedAt = saGet.CreatedAt.Round(time.Second).UTC()
+			saGet.UpdatedAt = saGet.UpdatedAt.Round(time.Second).UTC()
+			assert.Equal(sa, saGet)
+
+			assert.True(saGet.ValidateClientSecret(secret))
+			assert.False(saGet.ValidateClientSecret("invalid"))
+
+			saGet, err = GetServiceAccountByClientID(ts.Tx(), sa.ClientID)
+			assert.NoError(err)
+			assert.Equal(sa.ID, saGet.ID)
+		})
+
+		t.Run("List", func(t *testing.T) {
+			assert := require.New(t)
+
+			count, err := GetServiceAccountCount(ts.Tx(), org.ID)
+			assert.NoError(err)
+			assert.Equal(1, count)
+
+			items, err := GetServiceAccounts(ts.Tx(), org.ID, 10, 0)
+			assert.NoError(err)
+			assert.Len(items, 1)
+			assert.Equal(sa.ID, items[0].ID)
+		})
+
+		t.Run("Update", func(t *testing.T) {
+			assert := require.New(t)
+
+			sa.Name = "automation-2"
+			sa.PublicKey = testServiceAccountPublicKey
+			sa.IsAdmin = true
+			sa.IsActive = false
+			assert.NoError(UpdateServiceAccount(ts.Tx(), &sa))
+
+			saGet, err := GetServiceAccount(ts.Tx(), sa.ID)
+			assert.NoError(err)
+			assert.Equal("automation-2", saGet.Name)
+			assert.Equal(testServiceAccountPublicKey, saGet.PublicKey)
+			assert.True(saGet.IsAdmin)
+			assert.False(saGet.IsActive)
+			assert.True(saGet.ValidateClientSecret(secret))
+
+			_, err = saGet.GetPublicKey()
+			assert.NoError(err)
+		})
+
+		t.Run("Regenerate secret", func(t *testing.T) {
+			assert := require.New(t)
+
+			secret2, err := RegenerateServiceAccountSecret(ts.Tx(), sa.ID)
+			assert.NoError(err)
+			assert.NotEqual(secret, secret2)
+
+			saGet, err := GetServiceAccount(ts.Tx(), sa.ID)
+			assert.NoError(err)
+			assert.False(saGet.ValidateClientSecret(secret))
+			assert.True(saGet.ValidateClientSecret(secret2))
+		})
+
+		t.Run("Token", func(t *testing.T) {
+			token, err := GetServiceAccountToken(sa, time.Hour)
+			require.NoError(t, err)
+			require.NotEqual(t, "", token)
+		})
+
+		t.Run("Delete", func(t *testing.T) {
+			assert := require.New(t)
+
+			assert.NoError(DeleteServiceAccount(ts.Tx(), sa.ID))
+			assert.Equal(ErrDoesNotExist, DeleteServiceAccount(ts.Tx(), sa.ID))
+
+			_, err := GetServiceAccount(ts.Tx(), sa.ID)
+			assert.Equal(ErrDoesNotExist, errors.Cause(err))
+		})
+	})
+}
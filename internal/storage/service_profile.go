@@ -24,6 +24,14 @@ type ServiceProfile struct {
 	UpdatedAt       time.Time         `db:"updated_at"`
 	Name            string            `db:"name"`
 	ServiceProfile  ns.ServiceProfile `db:"-"`
+
+	// The limits below are enforced by LoRa App Server (0 = unlimited).
+	// MaxDeviceCount defines the max number of devices using this
+	// service-profile, MaxUplinkRate the max number of uplinks per device
+	// per hour and MaxPayloadSize the max uplink payload size in bytes.
+	MaxDeviceCount int `db:"max_device_count"`
+	MaxUplinkRate  int `db:"max_uplink_rate"`
+	MaxPayloadSize int `db:"max_payload_size"`
 }
 
 // ServiceProfileMeta defines the service-profile meta record.
@@ -34,10 +42,16 @@ type ServiceProfileMeta struct {
 	CreatedAt        time.Time `db:"created_at"`
 	UpdatedAt        time.Time `db:"updated_at"`
 	Name             string    `db:"name"`
+	MaxDeviceCount   int       `db:"max_device_count"`
+	MaxUplinkRate    int       `db:"max_uplink_rate"`
+	MaxPayloadSize   int       `db:"max_payload_size"`
 }
 
 // Validate validates the service-profile data.
 func (sp ServiceProfile) Validate() error {
+	if sp.MaxDeviceCount < 0 || sp.MaxUplinkRate < 0 || sp.MaxPayloadSize < 0 {
+		return ErrServiceProfileInvalidLimits
+	}
 	return nil
 }
 
@@ -64,14 +78,20 @@ func CreateServiceProfile(db sqlx.Ext, sp *ServiceProfile) error {
 			organization_id,
 			created_at,
 			updated_at,
-			name
-		) values ($1, $2, $3, $4, $5, $6)`,
+			name,
+			max_device_count,
+			max_uplink_rate,
+			max_payload_size
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
 		spID,
 		sp.NetworkServerID,
 		sp.OrganizationID,
 		sp.CreatedAt,
 		sp.UpdatedAt,
 		sp.Name,
+		sp.MaxDeviceCount,
+		sp.MaxUplinkRate,
+		sp.MaxPayloadSize,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -107,7 +127,10 @@ func GetServiceProfile(db sqlx.Queryer, id uuid.UUID, localOnly bool) (ServicePr
 			organization_id,
 			created_at,
 			updated_at,
-			name
+			name,
+			max_device_count,
+			max_uplink_rate,
+			max_payload_size
 		from service_profile
 		where
 			service_profile_id = $1`,
@@ -117,7 +140,7 @@ func GetServiceProfile(db sqlx.Queryer, id uuid.UUID, localOnly bool) (ServicePr
 		return sp, handlePSQLError(Select, err, "select error")
 	}
 
-	err := row.Scan(&sp.NetworkServerID, &sp.OrganizationID, &sp.CreatedAt, &sp.UpdatedAt, &sp.Name)
+	err := row.Scan(&sp.NetworkServerID, &sp.OrganizationID, &sp.CreatedAt, &sp.UpdatedAt, &sp.Name, &sp.MaxDeviceCount, &sp.MaxUplinkRate, &sp.MaxPayloadSize)
 	if err != nil {
 		return sp, handlePSQLError(Scan, err, "scan error")
 	}
@@ -168,11 +191,17 @@ func UpdateServiceProfile(db sqlx.Ext, sp *ServiceProfile) error {
 		update service_profile
 		set
 			updated_at = $2,
-			name = $3
+			name = $3,
+			max_device_count = $4,
+			max_uplink_rate = $5,
+			max_payload_size = $6
 		where service_profile_id = $1`,
 		spID,
 		sp.UpdatedAt,
 		sp.Name,
+		sp.MaxDeviceCount,
+		sp.MaxUplinkRate,
+		sp.MaxPayloadSize,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
@@ -274,9 +303,9 @@ func GetServiceProfileCountForUser(db sqlx.Queryer, username string) (int, error
 		from service_profile sp
 		inner join organization o
 			on o.id = sp.organization_id
-		inner join organization_user ou
+		inner join principal_organization ou
 			on ou.organization_id = o.id
-		inner join "user" u
+		inner join principal u
 			on u.id = ou.user_id
 		where
 			u.username = $1`,
@@ -338,9 +367,9 @@ func GetServiceProfilesForUser(db sqlx.Queryer, username string, limit, offset i
 		from service_profile sp
 		inner join organization o
 			on o.id = sp.organization_id
-		inner join organization_user ou
+		inner join principal_organization ou
 			on ou.organization_id = o.id
-		inner join "user" u
+		inner join principal u
 			on u.id = ou.user_id
 		where
 			u.username = $1
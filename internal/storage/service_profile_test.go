@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
 
 	"github.com/brocaar/lora-app-server/internal/config"
 	"github.com/brocaar/lora-app-server/internal/test"
@@ -51,6 +52,9 @@ func TestServiceProfile(t *testing.T) {
 				OrganizationID:  org.ID,
 				NetworkServerID: n.ID,
 				Name:            "test-service-profile",
+				MaxDeviceCount:  10,
+				MaxUplinkRate:   60,
+				MaxPayloadSize:  51,
 				ServiceProfile: ns.ServiceProfile{
 					UlRate:                 100,
 					UlBucketSize:           10,
@@ -117,6 +121,9 @@ func TestServiceProfile(t *testing.T) {
 					TargetPer:      11,
 					MinGwDiversity: 4,
 				}
+				sp.MaxDeviceCount = 20
+				sp.MaxUplinkRate = 0
+				sp.MaxPayloadSize = 11
 				So(UpdateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
 				sp.UpdatedAt = sp.UpdatedAt.UTC().Truncate(time.Millisecond)
 				So(nsClient.UpdateServiceProfileChan, ShouldHaveLength, 1)
@@ -129,6 +136,15 @@ func TestServiceProfile(t *testing.T) {
 				spGet.UpdatedAt = spGet.UpdatedAt.UTC().Truncate(time.Millisecond)
 				So(spGet.Name, ShouldEqual, "updated-service-profile")
 				So(spGet.UpdatedAt, ShouldResemble, sp.UpdatedAt)
+				So(spGet.MaxDeviceCount, ShouldEqual, 20)
+				So(spGet.MaxUplinkRate, ShouldEqual, 0)
+				So(spGet.MaxPayloadSize, ShouldEqual, 11)
+			})
+
+			Convey("Then UpdateServiceProfile returns an error on negative limits", func() {
+				sp.MaxUplinkRate = -1
+				err := UpdateServiceProfile(config.C.PostgreSQL.DB, &sp)
+				So(errors.Cause(err), ShouldEqual, ErrServiceProfileInvalidLimits)
 			})
 
 			Convey("Then DeleteServiceProfile deletes the service-profile", func() {
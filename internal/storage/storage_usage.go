@@ -0,0 +1,214 @@
+package storage
+
+import (
+	"sort"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// TableStorageUsage contains the storage usage of a table. The row count is
+// estimated by the PostgreSQL statistics collector, the size (in bytes)
+// includes the indices and TOAST data.
+type TableStorageUsage struct {
+	Name     string `db:"name"`
+	RowCount int64  `db:"row_count"`
+	Size     int64  `db:"size"`
+}
+
+// OrganizationStorageUsageReport contains the storage usage of an
+// organization, per table. The size (in bytes) is approximated using the
+// average row size of each table.
+type OrganizationStorageUsageReport struct {
+	OrganizationID   int64
+	OrganizationName string
+	Size             int64
+	Tables           []TableStorageUsage
+}
+
+// organizationTableQueries contains per table the query returning the
+// number of rows per organization id.
+var organizationTableQueries = []struct {
+	table string
+	query string
+}{
+	{"application", `
+		select organization_id, count(*)
+		from application
+		group by 1`},
+	{"device", `
+		select a.organization_id, count(*)
+		from device d
+		inner join application a
+			on a.id = d.application_id
+		group by 1`},
+	{"device_keys", `
+		select a.organization_id, count(*)
+		from device_keys dk
+		inner join device d
+			on d.dev_eui = dk.dev_eui
+		inner join application a
+			on a.id = d.application_id
+		group by 1`},
+	{"device_activation", `
+		select a.organization_id, count(*)
+		from device_activation da
+		inner join device d
+			on d.dev_eui = da.dev_eui
+		inner join application a
+			on a.id = d.application_id
+		group by 1`},
+	{"device_location", `
+		select a.organization_id, count(*)
+		from device_location dl
+		inner join device d
+			on d.dev_eui = dl.dev_eui
+		inner join application a
+			on a.id = d.application_id
+		group by 1`},
+	{"device_uplink_stats", `
+		select a.organization_id, count(*)
+		from device_uplink_stats s
+		inner join device d
+			on d.dev_eui = s.dev_eui
+		inner join application a
+			on a.id = d.application_id
+		group by 1`},
+	{"device_availability", `
+		select a.organization_id, count(*)
+		from device_availability s
+		inner join device d
+			on d.dev_eui = s.dev_eui
+		inner join application a
+			on a.id = d.application_id
+		group by 1`},
+	{"security_event", `
+		select a.organization_id, count(*)
+		from security_event e
+		inner join device d
+			on d.dev_eui = e.dev_eui
+		inner join application a
+			on a.id = d.application_id
+		group by 1`},
+	{"integration", `
+		select a.organization_id, count(*)
+		from integration i
+		inner join application a
+			on a.id = i.application_id
+		group by 1`},
+	{"gateway", `
+		select organization_id, count(*)
+		from gateway
+		group by 1`},
+	{"gateway_ping", `
+		select g.organization_id, count(*)
+		from gateway_ping gp
+		inner join gateway g
+			on g.mac = gp.gateway_mac
+		group by 1`},
+	{"gateway_ping_rx", `
+		select g.organization_id, count(*)
+		from gateway_ping_rx gpr
+		inner join gateway g
+			on g.mac = gpr.gateway_mac
+		group by 1`},
+	{"device_profile", `
+		select organization_id, count(*)
+		from device_profile
+		group by 1`},
+	{"service_profile", `
+		select organization_id, count(*)
+		from service_profile
+		group by 1`},
+	{"multicast_group", `
+		select sp.organization_id, count(*)
+		from multicast_group mg
+		inner join service_profile sp
+			on sp.service_profile_id = mg.service_profile_id
+		group by 1`},
+	{"organization_user", `
+		select organization_id, count(*)
+		from organization_user
+		group by 1`},
+}
+
+// GetTableStorageUsage returns the storage usage of all tables, ordered by
+// size.
+func GetTableStorageUsage(db sqlx.Queryer) ([]TableStorageUsage, error) {
+	var tables []TableStorageUsage
+	err := sqlx.Select(db, &tables, `
+		select
+			relname as name,
+			n_live_tup as row_count,
+			pg_total_relation_size(relid) as size
+		from pg_stat_user_tables
+		order by
+			size desc,
+			name`,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return tables, nil
+}
+
+// GetOrganizationStorageUsageReports returns the storage usage of all
+// organizations, ordered by size. As this counts the rows of each
+// organization, this can be an expensive call on large installations.
+func GetOrganizationStorageUsageReports(db sqlx.Queryer) ([]OrganizationStorageUsageReport, error) {
+	tables, err := GetTableStorageUsage(db)
+	if err != nil {
+		return nil, err
+	}
+
+	rowSizes := make(map[string]int64)
+	for _, t := range tables {
+		if t.RowCount > 0 {
+			rowSizes[t.Name] = t.Size / t.RowCount
+		}
+	}
+
+	var orgs []Organization
+	if err := sqlx.Select(db, &orgs, "select * from organization order by id"); err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	reports := make([]OrganizationStorageUsageReport, len(orgs))
+	reportIndex := make(map[int64]int)
+	for i, org := range orgs {
+		reports[i].OrganizationID = org.ID
+		reports[i].OrganizationName = org.Name
+		reportIndex[org.ID] = i
+	}
+
+	for _, q := range organizationTableQueries {
+		var counts []struct {
+			OrganizationID int64 `db:"organization_id"`
+			Count          int64 `db:"count"`
+		}
+		if err := sqlx.Select(db, &counts, q.query); err != nil {
+			return nil, handlePSQLError(Select, err, "select error")
+		}
+
+		for _, c := range counts {
+			i, ok := reportIndex[c.OrganizationID]
+			if !ok {
+				continue
+			}
+
+			size := c.Count * rowSizes[q.table]
+			reports[i].Size += size
+			reports[i].Tables = append(reports[i].Tables, TableStorageUsage{
+				Name:     q.table,
+				RowCount: c.Count,
+				Size:     size,
+			})
+		}
+	}
+
+	sort.SliceStable(reports, func(i, j int) bool {
+		return reports[i].Size > reports[j].Size
+	})
+
+	return reports, nil
+}
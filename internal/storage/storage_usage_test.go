@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"github.com/stretchr/testify/require"
+
+	"github.com/brocaar/lora-app-server/internal/config"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func (ts *StorageTestSuite) TestStorageUsage() {
+	assert := require.New(ts.T())
+
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	n := NetworkServer{
+		Name:   "test",
+		Server: "test:1234",
+	}
+	assert.NoError(CreateNetworkServer(ts.Tx(), &n))
+
+	org := Organization{
+		Name: "test-org",
+	}
+	assert.NoError(CreateOrganization(ts.Tx(), &org))
+
+	for i := 0; i < 2; i++ {
+		assert.NoError(CreateGateway(ts.Tx(), &Gateway{
+			MAC:             lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, byte(i)},
+			Name:            "test-gw",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}))
+	}
+
+	tables, err := GetTableStorageUsage(ts.Tx())
+	assert.NoError(err)
+
+	var names []string
+	for _, t := range tables {
+		names = append(names, t.Name)
+	}
+	assert.Contains(names, "organization")
+	assert.Contains(names, "gateway")
+
+	reports, err := GetOrganizationStorageUsageReports(ts.Tx())
+	assert.NoError(err)
+
+	var report *OrganizationStorageUsageReport
+	for i := range reports {
+		if reports[i].OrganizationID == org.ID {
+			report = &reports[i]
+		}
+	}
+	assert.NotNil(report)
+	assert.Equal("test-org", report.OrganizationName)
+
+	var gatewayCount int64
+	for _, t := range report.Tables {
+		if t.Name == "gateway" {
+			gatewayCount = t.RowCount
+		}
+	}
+	assert.EqualValues(2, gatewayCount)
+}
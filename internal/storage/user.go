@@ -27,8 +27,9 @@ var HashIterations = 100000
 // defaultSessionTTL defines the default session TTL
 const defaultSessionTTL = time.Hour * 24
 
-// Any upper, lower, digit characters, at least 6 characters.
-var usernameValidator = regexp.MustCompile(`^[[:alnum:]]+$`)
+// Any upper, lower, digit characters, optionally separated by one of the
+// . _ @ + - characters (so that e-mail addresses can be used as username).
+var usernameValidator = regexp.MustCompile(`^[[:alnum:]]+([._@+-][[:alnum:]]+)*$`)
 
 // Any printable characters, at least 6 characters.
 var passwordValidator = regexp.MustCompile(`^.{6,}$`)
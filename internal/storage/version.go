@@ -0,0 +1,23 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+)
+
+// ListVersion describes the state of a list of objects. It changes when
+// objects are created, updated or deleted and can be used to detect changes
+// without fetching the list itself.
+type ListVersion struct {
+	Count     int        `db:"count"`
+	UpdatedAt *time.Time `db:"updated_at"`
+}
+
+// String implements fmt.Stringer.
+func (v ListVersion) String() string {
+	var ts int64
+	if v.UpdatedAt != nil {
+		ts = v.UpdatedAt.UnixNano()
+	}
+	return fmt.Sprintf("%d:%d", v.Count, ts)
+}
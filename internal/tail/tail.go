@@ -0,0 +1,243 @@
+// Package tail implements a client for tailing the device event streams of
+// the external API, e.g. for debugging in the field.
+package tail
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	pb "github.com/brocaar/lora-app-server/api"
+)
+
+// reconnectInterval defines the interval after which a failed event stream
+// is re-opened.
+var reconnectInterval = 2 * time.Second
+
+// Event defines a device event.
+type Event struct {
+	ReceivedAt time.Time       `json:"receivedAt"`
+	DevEUI     string          `json:"devEUI"`
+	Type       string          `json:"type"`
+	Payload    json.RawMessage `json:"payload"`
+}
+
+// Filter defines the event filter. Empty fields match all events.
+type Filter struct {
+	// Types contains the event types to match (e.g. uplink, error).
+	Types []string
+
+	// Fields contains the (top-level) payload fields to match, e.g.
+	// fPort=10. Values are compared to the JSON encoded field value, with
+	// the quotes of JSON strings removed.
+	Fields map[string]string
+}
+
+// ParseFieldFilters parses the given key=value field filters.
+func ParseFieldFilters(filters []string) (map[string]string, error) {
+	out := make(map[string]string)
+	for _, f := range filters {
+		kv := strings.SplitN(f, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("invalid filter: %s (expected key=value)", f)
+		}
+		out[kv[0]] = kv[1]
+	}
+	return out, nil
+}
+
+// Match returns true when the given event matches the filter.
+func (f Filter) Match(e Event) bool {
+	if len(f.Types) != 0 {
+		var found bool
+		for _, t := range f.Types {
+			if t == e.Type {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(f.Fields) == 0 {
+		return true
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(e.Payload, &fields); err != nil {
+		return false
+	}
+
+	for k, v := range f.Fields {
+		raw, ok := fields[k]
+		if !ok || strings.Trim(string(raw), `"`) != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Output formats.
+const (
+	JSONOutput   = "json"
+	PrettyOutput = "pretty"
+)
+
+// Write writes the given event to w, using the given output format. The
+// JSON format writes one JSON object per line, the pretty format writes a
+// header line followed by the indented payload.
+func Write(w io.Writer, format string, e Event) error {
+	switch format {
+	case JSONOutput:
+		b, err := json.Marshal(e)
+		if err != nil {
+			return errors.Wrap(err, "marshal json error")
+		}
+		_, err = fmt.Fprintf(w, "%s\n", b)
+		return err
+	case PrettyOutput:
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, e.Payload, "  ", "  "); err != nil {
+			buf.Reset()
+			buf.Write(e.Payload)
+		}
+		_, err := fmt.Fprintf(w, "%s %s %s\n  %s\n",
+			e.ReceivedAt.Format(time.RFC3339),
+			e.DevEUI,
+			strings.ToUpper(e.Type),
+			buf.String(),
+		)
+		return err
+	default:
+		return fmt.Errorf("unknown output format: %s", format)
+	}
+}
+
+// DeviceEventStreamer defines the client interface used for tailing the
+// device events.
+type DeviceEventStreamer interface {
+	StreamEventLogs(ctx context.Context, in *pb.StreamDeviceEventLogsRequest, opts ...grpc.CallOption) (pb.DeviceService_StreamEventLogsClient, error)
+}
+
+// DeviceLister defines the client interface used for listing the devices
+// of an application.
+type DeviceLister interface {
+	List(ctx context.Context, in *pb.ListDeviceRequest, opts ...grpc.CallOption) (*pb.ListDeviceResponse, error)
+}
+
+// GetApplicationDevEUIs returns the DevEUIs of the given application, up to
+// the given max. number of devices.
+func GetApplicationDevEUIs(ctx context.Context, client DeviceLister, applicationID int64, max int) ([]string, error) {
+	var out []string
+
+	for len(out) < max {
+		resp, err := client.List(ctx, &pb.ListDeviceRequest{
+			ApplicationId: applicationID,
+			Limit:         int64(max - len(out)),
+			Offset:        int64(len(out)),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "list devices error")
+		}
+
+		for _, d := range resp.Result {
+			out = append(out, d.DevEui)
+		}
+
+		if len(resp.Result) == 0 || int64(len(out)) >= resp.TotalCount {
+			break
+		}
+	}
+
+	return out, nil
+}
+
+// Stream streams the events of the given devices to the given channel
+// until the context has been cancelled. Failed streams are re-opened,
+// resuming after the last received event so that no events are lost.
+// The channel is closed when Stream returns.
+func Stream(ctx context.Context, client DeviceEventStreamer, devEUIs []string, filter Filter, events chan<- Event) {
+	var wg sync.WaitGroup
+
+	for _, devEUI := range devEUIs {
+		wg.Add(1)
+		go func(devEUI string) {
+			defer wg.Done()
+
+			var resumeToken string
+
+			for {
+				err := streamDevice(ctx, client, devEUI, &resumeToken, filter, events)
+				if ctx.Err() != nil {
+					return
+				}
+
+				log.WithError(err).WithField("dev_eui", devEUI).Errorf("tail: event stream error, will retry in %s", reconnectInterval)
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(reconnectInterval):
+				}
+			}
+		}(devEUI)
+	}
+
+	wg.Wait()
+	close(events)
+}
+
+// streamDevice streams the events of the given device. The resume token is
+// updated for every received event.
+func streamDevice(ctx context.Context, client DeviceEventStreamer, devEUI string, resumeToken *string, filter Filter, events chan<- Event) error {
+	stream, err := client.StreamEventLogs(ctx, &pb.StreamDeviceEventLogsRequest{
+		DevEui:      devEUI,
+		ResumeToken: *resumeToken,
+	})
+	if err != nil {
+		return errors.Wrap(err, "stream event-logs error")
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return errors.New("stream closed by server")
+		}
+		if err != nil {
+			return errors.Wrap(err, "receive event-log error")
+		}
+
+		if resp.ResumeToken != "" {
+			*resumeToken = resp.ResumeToken
+		}
+
+		e := Event{
+			ReceivedAt: time.Now(),
+			DevEUI:     devEUI,
+			Type:       resp.Type,
+			Payload:    json.RawMessage(resp.PayloadJson),
+		}
+
+		if !filter.Match(e) {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case events <- e:
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package tail
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	pb "github.com/brocaar/lora-app-server/api"
+)
+
+type testEventStream struct {
+	grpc.ClientStream
+	responses []*pb.StreamDeviceEventLogsResponse
+	ctx       context.Context
+	eof       bool
+}
+
+func (s *testEventStream) Recv() (*pb.StreamDeviceEventLogsResponse, error) {
+	if len(s.responses) == 0 {
+		if !s.eof {
+			<-s.ctx.Done()
+		}
+		return nil, io.EOF
+	}
+
+	resp := s.responses[0]
+	s.responses = s.responses[1:]
+	return resp, nil
+}
+
+type testDeviceClient struct {
+	responses []*pb.StreamDeviceEventLogsResponse
+	devices   []string
+
+	// when set, the stream is closed after the responses have been sent
+	eof      bool
+	requests chan *pb.StreamDeviceEventLogsRequest
+}
+
+func (c *testDeviceClient) StreamEventLogs(ctx context.Context, in *pb.StreamDeviceEventLogsRequest, opts ...grpc.CallOption) (pb.DeviceService_StreamEventLogsClient, error) {
+	select {
+	case c.requests <- in:
+	default:
+	}
+	return &testEventStream{ctx: ctx, responses: c.responses, eof: c.eof}, nil
+}
+
+func (c *testDeviceClient) List(ctx context.Context, in *pb.ListDeviceRequest, opts ...grpc.CallOption) (*pb.ListDeviceResponse, error) {
+	var resp pb.ListDeviceResponse
+	resp.TotalCount = int64(len(c.devices))
+
+	for i := int(in.Offset); i < len(c.devices) && i < int(in.Offset+in.Limit); i++ {
+		resp.Result = append(resp.Result, &pb.DeviceListItem{DevEui: c.devices[i]})
+	}
+
+	return &resp, nil
+}
+
+func TestFilter(t *testing.T) {
+	Convey("Given an uplink event", t, func() {
+		e := Event{
+			Type:    "uplink",
+			Payload: json.RawMessage(`{"deviceName":"test-device","fPort":10}`),
+		}
+
+		tests := []struct {
+			Name     string
+			Filter   Filter
+			Expected bool
+		}{
+			{"empty filter", Filter{}, true},
+			{"matching type", Filter{Types: []string{"error", "uplink"}}, true},
+			{"non-matching type", Filter{Types: []string{"error"}}, false},
+			{"matching number field", Filter{Fields: map[string]string{"fPort": "10"}}, true},
+			{"matching string field", Filter{Fields: map[string]string{"deviceName": "test-device"}}, true},
+			{"non-matching field", Filter{Fields: map[string]string{"fPort": "11"}}, false},
+			{"missing field", Filter{Fields: map[string]string{"fCnt": "10"}}, false},
+		}
+
+		for _, test := range tests {
+			Convey("Then the "+test.Name+" returns the expected result", func() {
+				So(test.Filter.Match(e), ShouldEqual, test.Expected)
+			})
+		}
+	})
+}
+
+func TestParseFieldFilters(t *testing.T) {
+	Convey("Then valid field filters are parsed", t, func() {
+		out, err := ParseFieldFilters([]string{"fPort=10", "deviceName=a=b"})
+		So(err, ShouldBeNil)
+		So(out, ShouldResemble, map[string]string{"fPort": "10", "deviceName": "a=b"})
+	})
+
+	Convey("Then an invalid field filter returns an error", t, func() {
+		_, err := ParseFieldFilters([]string{"fPort"})
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestWrite(t *testing.T) {
+	Convey("Given an event", t, func() {
+		e := Event{
+			ReceivedAt: time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC),
+			DevEUI:     "0102030405060708",
+			Type:       "uplink",
+			Payload:    json.RawMessage(`{"fPort":10}`),
+		}
+		var buf bytes.Buffer
+
+		Convey("Then the JSON output contains one JSON object per line", func() {
+			So(Write(&buf, JSONOutput, e), ShouldBeNil)
+			So(buf.String(), ShouldEqual, `{"receivedAt":"2018-01-02T03:04:05Z","devEUI":"0102030405060708","type":"uplink","payload":{"fPort":10}}`+"\n")
+		})
+
+		Convey("Then the pretty output contains a header and the indented payload", func() {
+			So(Write(&buf, PrettyOutput, e), ShouldBeNil)
+			So(buf.String(), ShouldEqual, "2018-01-02T03:04:05Z 0102030405060708 UPLINK\n  {\n    \"fPort\": 10\n  }\n")
+		})
+
+		Convey("Then an unknown output format returns an error", func() {
+			So(Write(&buf, "xml", e), ShouldNotBeNil)
+		})
+	})
+}
+
+func TestGetApplicationDevEUIs(t *testing.T) {
+	Convey("Given a client with three devices", t, func() {
+		client := testDeviceClient{
+			devices: []string{"0101010101010101", "0202020202020202", "0303030303030303"},
+		}
+
+		Convey("Then all devices are returned when below max", func() {
+			devEUIs, err := GetApplicationDevEUIs(context.Background(), &client, 1, 10)
+			So(err, ShouldBeNil)
+			So(devEUIs, ShouldResemble, client.devices)
+		})
+
+		Convey("Then the number of devices is limited to max", func() {
+			devEUIs, err := GetApplicationDevEUIs(context.Background(), &client, 1, 2)
+			So(err, ShouldBeNil)
+			So(devEUIs, ShouldResemble, client.devices[:2])
+		})
+	})
+}
+
+func TestStream(t *testing.T) {
+	Convey("Given a client returning an uplink and an error event", t, func() {
+		client := testDeviceClient{
+			responses: []*pb.StreamDeviceEventLogsResponse{
+				{Type: "uplink", PayloadJson: `{"fPort":10}`},
+				{Type: "error", PayloadJson: `{"error":"test"}`},
+			},
+		}
+
+		Convey("Then only the events matching the filter are streamed", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			events := make(chan Event)
+			go Stream(ctx, &client, []string{"0102030405060708"}, Filter{Types: []string{"error"}}, events)
+
+			e := <-events
+			So(e.DevEUI, ShouldEqual, "0102030405060708")
+			So(e.Type, ShouldEqual, "error")
+			So(string(e.Payload), ShouldEqual, `{"error":"test"}`)
+
+			cancel()
+			_, ok := <-events
+			So(ok, ShouldBeFalse)
+		})
+	})
+
+	Convey("Given a client closing the stream after an uplink event", t, func() {
+		reconnectInterval = 10 * time.Millisecond
+
+		client := testDeviceClient{
+			responses: []*pb.StreamDeviceEventLogsResponse{
+				{Type: "uplink", PayloadJson: `{"fPort":10}`, Seq: 1, ResumeToken: "token-1"},
+			},
+			eof:      true,
+			requests: make(chan *pb.StreamDeviceEventLogsRequest, 2),
+		}
+
+		Convey("Then the re-opened stream resumes after the last received event", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			events := make(chan Event, 10)
+			go Stream(ctx, &client, []string{"0102030405060708"}, Filter{}, events)
+
+			So((<-client.requests).ResumeToken, ShouldEqual, "")
+			So((<-client.requests).ResumeToken, ShouldEqual, "token-1")
+
+			cancel()
+			for range events {
+			}
+		})
+	})
+}
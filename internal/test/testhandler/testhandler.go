@@ -11,6 +11,7 @@ type TestHandler struct {
 	DataDownPayloadChan          chan handler.DataDownPayload
 	SendStatusNotificationChan   chan handler.StatusNotification
 	SendLocationNotificationChan chan handler.LocationNotification
+	SendAdminEventChan           chan handler.AdminEvent
 }
 
 // NewTestHandler returns a TestHandler.
@@ -23,6 +24,7 @@ func NewTestHandler() *TestHandler {
 		DataDownPayloadChan:          make(chan handler.DataDownPayload, 100),
 		SendStatusNotificationChan:   make(chan handler.StatusNotification, 100),
 		SendLocationNotificationChan: make(chan handler.LocationNotification, 100),
+		SendAdminEventChan:           make(chan handler.AdminEvent, 100),
 	}
 }
 
@@ -71,3 +73,9 @@ func (t *TestHandler) SendLocationNotification(payload handler.LocationNotificat
 	t.SendLocationNotificationChan <- payload
 	return nil
 }
+
+// SendAdminEvent method.
+func (t *TestHandler) SendAdminEvent(payload handler.AdminEvent) error {
+	t.SendAdminEventChan <- payload
+	return nil
+}
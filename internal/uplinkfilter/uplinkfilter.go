@@ -0,0 +1,190 @@
+// Package uplinkfilter implements the (per application) uplink filter
+// scripts. The filter script is evaluated before an uplink is sent to the
+// integrations, uplinks for which the script returns false are dropped
+// (e.g. heartbeat-only frames or frames of which the decoded values did not
+// change). The dropped uplinks are counted per application.
+package uplinkfilter
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	"github.com/robertkrimen/otto"
+	"github.com/robertkrimen/otto/parser"
+
+	"github.com/brocaar/lorawan"
+)
+
+// ErrInvalidScript is returned when the filter script can not be parsed.
+var ErrInvalidScript = errors.New("invalid uplink filter script")
+
+// MaxExecTime holds the max. time the filter script is allowed to run.
+var MaxExecTime = 10 * time.Millisecond
+
+const (
+	// droppedCountKeyTempl defines the key template of the counter of
+	// dropped uplinks of an application.
+	droppedCountKeyTempl = "lora:as:application:%d:uplink_filter:dropped"
+
+	// lastObjectKeyTempl defines the key template of the last decoded
+	// object of a device which passed the filter.
+	lastObjectKeyTempl = "lora:as:device:%s:uplink_filter:last_object"
+
+	// lastObjectTTL defines the expiration of the last object.
+	lastObjectTTL = 7 * 24 * time.Hour
+)
+
+// Validate validates the given filter script. An empty script is valid.
+func Validate(script string) error {
+	if script == "" {
+		return nil
+	}
+
+	if _, err := parser.ParseFile(nil, "", script, 0); err != nil {
+		return errors.Wrap(ErrInvalidScript, err.Error())
+	}
+
+	return nil
+}
+
+// Filter evaluates the given filter script. It returns true when the uplink
+// must be sent to the integrations. The script must implement the function
+// Filter(fPort, bytes, obj, prevObj), of which obj is the decoded object
+// (null when not decoded) and prevObj is the decoded object of the last
+// uplink of the device which passed the filter (null when unknown).
+func Filter(script string, fPort uint8, data []byte, object, prevObject interface{}) (pass bool, err error) {
+	if script == "" {
+		return true, nil
+	}
+
+	defer func() {
+		if caught := recover(); caught != nil {
+			err = fmt.Errorf("%s", caught)
+		}
+	}()
+
+	script = script + "\n\nFilter(fPort, bytes, obj, prevObj);\n"
+
+	vm := otto.New()
+	vm.Interrupt = make(chan func(), 1)
+	vm.SetStackDepthLimit(32)
+	vm.Set("fPort", fPort)
+	vm.Set("bytes", data)
+
+	// the objects are set as JSON documents, so that the script is able to
+	// compare them, regardless of the (codec) type of the object
+	for name, v := range map[string]interface{}{
+		"obj":     object,
+		"prevObj": prevObject,
+	} {
+		jsVal, err := toJSValue(vm, v)
+		if err != nil {
+			return false, errors.Wrapf(err, "set %s error", name)
+		}
+		vm.Set(name, jsVal)
+	}
+
+	go func() {
+		time.Sleep(MaxExecTime)
+		vm.Interrupt <- func() {
+			panic(errors.New("execution timeout"))
+		}
+	}()
+
+	val, err := vm.Run(script)
+	if err != nil {
+		return false, errors.Wrap(err, "js vm error")
+	}
+
+	if !val.IsBoolean() {
+		return false, errors.New("function must return a boolean")
+	}
+
+	return val.ToBoolean()
+}
+
+func toJSValue(vm *otto.Otto, v interface{}) (otto.Value, error) {
+	if v == nil {
+		return otto.NullValue(), nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return otto.Value{}, errors.Wrap(err, "marshal json error")
+	}
+
+	return vm.Call("JSON.parse", nil, string(b))
+}
+
+// IncrDroppedCount increments the counter of dropped uplinks of the given
+// application.
+func IncrDroppedCount(p *redis.Pool, applicationID int64) error {
+	c := p.Get()
+	defer c.Close()
+
+	if _, err := c.Do("INCR", fmt.Sprintf(droppedCountKeyTempl, applicationID)); err != nil {
+		return errors.Wrap(err, "increment dropped count error")
+	}
+
+	return nil
+}
+
+// GetDroppedCount returns the number of dropped uplinks of the given
+// application.
+func GetDroppedCount(p *redis.Pool, applicationID int64) (int64, error) {
+	c := p.Get()
+	defer c.Close()
+
+	count, err := redis.Int64(c.Do("GET", fmt.Sprintf(droppedCountKeyTempl, applicationID)))
+	if err != nil {
+		if err == redis.ErrNil {
+			return 0, nil
+		}
+		return 0, errors.Wrap(err, "get dropped count error")
+	}
+
+	return count, nil
+}
+
+// GetLastObject returns the decoded object of the last uplink of the given
+// device which passed the filter. It returns nil when unknown.
+func GetLastObject(p *redis.Pool, devEUI lorawan.EUI64) (interface{}, error) {
+	c := p.Get()
+	defer c.Close()
+
+	b, err := redis.Bytes(c.Do("GET", fmt.Sprintf(lastObjectKeyTempl, devEUI)))
+	if err != nil {
+		if err == redis.ErrNil {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "get last object error")
+	}
+
+	var out interface{}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, errors.Wrap(err, "unmarshal json error")
+	}
+
+	return out, nil
+}
+
+// SetLastObject stores the decoded object of the last uplink of the given
+// device which passed the filter.
+func SetLastObject(p *redis.Pool, devEUI lorawan.EUI64, object interface{}) error {
+	b, err := json.Marshal(object)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	c := p.Get()
+	defer c.Close()
+
+	if _, err := c.Do("PSETEX", fmt.Sprintf(lastObjectKeyTempl, devEUI), int64(lastObjectTTL/time.Millisecond), b); err != nil {
+		return errors.Wrap(err, "set last object error")
+	}
+
+	return nil
+}
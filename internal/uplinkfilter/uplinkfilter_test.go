@@ -0,0 +1,164 @@
+package uplinkfilter
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestValidate(t *testing.T) {
+	Convey("Then an empty script is valid", t, func() {
+		So(Validate(""), ShouldBeNil)
+	})
+
+	Convey("Then a valid script is valid", t, func() {
+		So(Validate("function Filter(fPort, bytes, obj, prevObj) { return true; }"), ShouldBeNil)
+	})
+
+	Convey("Then an invalid script returns ErrInvalidScript", t, func() {
+		So(errors.Cause(Validate("function Filter(fPort, bytes {")), ShouldEqual, ErrInvalidScript)
+	})
+}
+
+func TestFilter(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Name          string
+			Script        string
+			FPort         uint8
+			Data          []byte
+			Object        interface{}
+			PrevObject    interface{}
+			ExpectedPass  bool
+			ExpectedError bool
+		}{
+			{
+				Name:         "empty script",
+				ExpectedPass: true,
+			},
+			{
+				Name:         "drop heartbeat frames",
+				Script:       "function Filter(fPort, bytes, obj, prevObj) { return fPort != 2; }",
+				FPort:        2,
+				ExpectedPass: false,
+			},
+			{
+				Name:         "drop empty frames",
+				Script:       "function Filter(fPort, bytes, obj, prevObj) { return bytes.length > 0; }",
+				FPort:        1,
+				Data:         []byte{1},
+				ExpectedPass: true,
+			},
+			{
+				Name:         "drop unchanged values",
+				Script:       "function Filter(fPort, bytes, obj, prevObj) { return prevObj === null || obj.temperature != prevObj.temperature; }",
+				Object:       map[string]interface{}{"temperature": 21.5},
+				PrevObject:   map[string]interface{}{"temperature": 21.5},
+				ExpectedPass: false,
+			},
+			{
+				Name:   "pass changed values",
+				Script: "function Filter(fPort, bytes, obj, prevObj) { return prevObj === null || obj.temperature != prevObj.temperature; }",
+				Object: struct {
+					Temperature float64 `json:"temperature"`
+				}{22.5},
+				PrevObject:   map[string]interface{}{"temperature": 21.5},
+				ExpectedPass: true,
+			},
+			{
+				Name:         "pass without previous object",
+				Script:       "function Filter(fPort, bytes, obj, prevObj) { return prevObj === null || obj.temperature != prevObj.temperature; }",
+				Object:       map[string]interface{}{"temperature": 21.5},
+				ExpectedPass: true,
+			},
+			{
+				Name:          "non boolean return value",
+				Script:        "function Filter(fPort, bytes, obj, prevObj) { return 1; }",
+				ExpectedError: true,
+			},
+			{
+				Name:          "runtime error",
+				Script:        "function Filter(fPort, bytes, obj, prevObj) { return obj.foo.bar; }",
+				ExpectedError: true,
+			},
+			{
+				Name:          "timeout",
+				Script:        "function Filter(fPort, bytes, obj, prevObj) { while (true) {} }",
+				ExpectedError: true,
+			},
+		}
+
+		for _, tst := range tests {
+			Convey("Testing: "+tst.Name, func() {
+				pass, err := Filter(tst.Script, tst.FPort, tst.Data, tst.Object, tst.PrevObject)
+				if tst.ExpectedError {
+					So(err, ShouldNotBeNil)
+					return
+				}
+				So(err, ShouldBeNil)
+				So(pass, ShouldEqual, tst.ExpectedPass)
+			})
+		}
+	})
+}
+
+func TestDroppedCount(t *testing.T) {
+	conf := test.GetConfig()
+	p := storage.NewRedisPool(conf.RedisURL, 10, 0)
+
+	Convey("Given a clean Redis database", t, func() {
+		test.MustFlushRedis(p)
+
+		Convey("Then the dropped count is 0", func() {
+			count, err := GetDroppedCount(p, 1)
+			So(err, ShouldBeNil)
+			So(count, ShouldEqual, 0)
+		})
+
+		Convey("When incrementing the dropped count", func() {
+			So(IncrDroppedCount(p, 1), ShouldBeNil)
+			So(IncrDroppedCount(p, 1), ShouldBeNil)
+
+			Convey("Then the dropped count is returned", func() {
+				count, err := GetDroppedCount(p, 1)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 2)
+
+				count, err = GetDroppedCount(p, 2)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 0)
+			})
+		})
+	})
+}
+
+func TestLastObject(t *testing.T) {
+	conf := test.GetConfig()
+	p := storage.NewRedisPool(conf.RedisURL, 10, 0)
+
+	Convey("Given a clean Redis database", t, func() {
+		test.MustFlushRedis(p)
+		devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+
+		Convey("Then GetLastObject returns nil", func() {
+			obj, err := GetLastObject(p, devEUI)
+			So(err, ShouldBeNil)
+			So(obj, ShouldBeNil)
+		})
+
+		Convey("When storing the last object", func() {
+			So(SetLastObject(p, devEUI, map[string]interface{}{"temperature": 21.5}), ShouldBeNil)
+
+			Convey("Then GetLastObject returns the object", func() {
+				obj, err := GetLastObject(p, devEUI)
+				So(err, ShouldBeNil)
+				So(obj, ShouldResemble, map[string]interface{}{"temperature": 21.5})
+			})
+		})
+	})
+}
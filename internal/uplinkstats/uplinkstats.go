@@ -0,0 +1,110 @@
+// Package uplinkstats records the uplink statistics of the devices (payload
+// size, FPort, spreading-factor and airtime), so that the devices wasting
+// airtime can be found.
+package uplinkstats
+
+import (
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/airtime"
+	"github.com/brocaar/lorawan/band"
+)
+
+// lorawanOverhead defines the LoRaWAN overhead (MHDR, DevAddr, FCtrl, FCnt,
+// FPort and MIC) of an uplink without FOpts, in bytes.
+const lorawanOverhead = 13
+
+// preambleNumber defines the number of preamble symbols of an uplink.
+const preambleNumber = 8
+
+// regions maps the network-server regions to the LoRaWAN bands.
+var regions = map[string]band.Name{
+	"EU868": band.EU_863_870,
+	"US915": band.US_902_928,
+	"CN779": band.CN_779_787,
+	"EU433": band.EU_433,
+	"AU915": band.AU_915_928,
+	"CN470": band.CN_470_510,
+	"AS923": band.AS_923,
+	"KR920": band.KR_920_923,
+	"IN865": band.IN_865_867,
+	"RU864": band.RU_864_870,
+}
+
+var (
+	bandsMu sync.Mutex
+	bands   = make(map[string]band.Band)
+)
+
+// getDataRate returns the data-rate for the given region and data-rate
+// index.
+func getDataRate(region string, dr int) (band.DataRate, error) {
+	name, ok := regions[region]
+	if !ok {
+		return band.DataRate{}, errors.Errorf("unknown region: %s", region)
+	}
+
+	bandsMu.Lock()
+	b, ok := bands[region]
+	if !ok {
+		var err error
+		b, err = band.GetConfig(name, false, lorawan.DwellTimeNoLimit)
+		if err != nil {
+			bandsMu.Unlock()
+			return band.DataRate{}, errors.Wrap(err, "get band config error")
+		}
+		bands[region] = b
+	}
+	bandsMu.Unlock()
+
+	return b.GetDataRate(dr)
+}
+
+// GetSpreadingFactorAndAirtime returns the spreading-factor and airtime of
+// an uplink with the given payload size, for the given region and
+// data-rate. It returns 0 for both when the region or data-rate is unknown,
+// or when the data-rate does not use LoRa modulation.
+func GetSpreadingFactorAndAirtime(region string, dr, payloadSize int) (int, time.Duration) {
+	dataRate, err := getDataRate(region, dr)
+	if err != nil || dataRate.Modulation != band.LoRaModulation {
+		return 0, 0
+	}
+
+	// low data-rate optimization is mandated for symbol durations
+	// exceeding 16ms (SF11 and SF12 at 125kHz)
+	ldro := dataRate.Bandwidth == 125 && dataRate.SpreadFactor >= 11
+
+	d, err := airtime.CalculateLoRaAirtime(lorawanOverhead+payloadSize, dataRate.SpreadFactor, dataRate.Bandwidth, preambleNumber, airtime.CodingRate45, true, ldro)
+	if err != nil {
+		return dataRate.SpreadFactor, 0
+	}
+
+	return dataRate.SpreadFactor, d
+}
+
+// Record records the given uplink in the uplink statistics of the device.
+func Record(db sqlx.Execer, devEUI lorawan.EUI64, region string, dr int, fPort uint8, payloadSize int, t time.Time) error {
+	sf, d := GetSpreadingFactorAndAirtime(region, dr, payloadSize)
+
+	err := storage.IncrementDeviceUplinkStats(db, storage.DeviceUplinkStats{
+		DevEUI:          devEUI,
+		Hour:            t,
+		FPort:           int(fPort),
+		DR:              dr,
+		PayloadSize:     payloadSize,
+		SpreadingFactor: sf,
+		UplinkCount:     1,
+		Airtime:         d.Seconds(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "increment device uplink stats error")
+	}
+
+	return nil
+}
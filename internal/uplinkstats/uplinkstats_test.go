@@ -0,0 +1,35 @@
+package uplinkstats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSpreadingFactorAndAirtime(t *testing.T) {
+	tests := []struct {
+		Name            string
+		Region          string
+		DR              int
+		PayloadSize     int
+		SpreadingFactor int
+		Airtime         time.Duration
+	}{
+		{"EU868 DR0", "EU868", 0, 10, 12, 1482752 * time.Microsecond},
+		{"EU868 DR5", "EU868", 5, 10, 7, 61696 * time.Microsecond},
+		{"US915 DR0", "US915", 0, 10, 10, 370688 * time.Microsecond},
+		{"EU868 FSK", "EU868", 7, 10, 0, 0},
+		{"unknown region", "", 0, 10, 0, 0},
+		{"unknown data-rate", "EU868", 15, 10, 0, 0},
+	}
+
+	for _, tst := range tests {
+		t.Run(tst.Name, func(t *testing.T) {
+			assert := require.New(t)
+			sf, d := GetSpreadingFactorAndAirtime(tst.Region, tst.DR, tst.PayloadSize)
+			assert.Equal(tst.SpreadingFactor, sf)
+			assert.Equal(tst.Airtime, d)
+		})
+	}
+}
@@ -0,0 +1,11 @@
+-- +migrate Up
+create table device_dev_nonce (
+    dev_eui bytea not null references device on delete cascade,
+    dev_nonce integer not null,
+    created_at timestamp with time zone not null,
+
+    primary key(dev_eui, dev_nonce)
+);
+
+-- +migrate Down
+drop table device_dev_nonce;
@@ -0,0 +1,11 @@
+-- +migrate Up
+alter table device_activation
+    add column app_s_key_kek_label varchar(100) not null default '';
+
+create index idx_device_activation_app_s_key_kek_label on device_activation(app_s_key_kek_label);
+
+-- +migrate Down
+drop index idx_device_activation_app_s_key_kek_label;
+
+alter table device_activation
+    drop column app_s_key_kek_label;
@@ -0,0 +1,15 @@
+-- +migrate Up
+alter table network_server
+    add column net_id bytea,
+    add column kek_label varchar(100) not null default '',
+    add column kek bytea;
+
+create unique index idx_network_server_net_id on network_server(net_id);
+
+-- +migrate Down
+drop index idx_network_server_net_id;
+
+alter table network_server
+    drop column net_id,
+    drop column kek_label,
+    drop column kek;
@@ -0,0 +1,13 @@
+-- +migrate Up
+alter table device_profile
+    add column join_accept_rx_delay smallint,
+    add column join_accept_rx1_dr_offset smallint,
+    add column join_accept_rx2_dr smallint,
+    add column join_accept_cflist bytea;
+
+-- +migrate Down
+alter table device_profile
+    drop column join_accept_rx_delay,
+    drop column join_accept_rx1_dr_offset,
+    drop column join_accept_rx2_dr,
+    drop column join_accept_cflist;
@@ -0,0 +1,9 @@
+-- +migrate Up
+alter table application
+    add column geolocation_buffer_frames integer not null default 0,
+    add column geolocation_min_interval integer not null default 0;
+
+-- +migrate Down
+alter table application
+    drop column geolocation_buffer_frames,
+    drop column geolocation_min_interval;
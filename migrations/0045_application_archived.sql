@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table application
+    add column archived_at timestamp with time zone null;
+
+-- +migrate Down
+alter table application
+    drop column archived_at;
@@ -0,0 +1,17 @@
+-- +migrate Up
+create table organization_settings (
+    organization_id bigint primary key references organization on delete cascade,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    logo_url varchar(500) not null default '',
+    primary_color varchar(7) not null default '',
+    secondary_color varchar(7) not null default '',
+    default_region varchar(20) not null default '',
+    contact_name varchar(100) not null default '',
+    contact_email varchar(255) not null default '',
+    contact_phone varchar(50) not null default '',
+    custom_links jsonb not null default '[]'
+);
+
+-- +migrate Down
+drop table organization_settings;
@@ -0,0 +1,38 @@
+-- +migrate Up
+alter table organization
+    add column max_device_count integer not null default 0,
+    add column max_gateway_count integer not null default 0;
+
+create table registration_invite (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    token varchar(64) not null unique,
+    email varchar(255) not null default '',
+    expires_at timestamp with time zone null,
+    used_at timestamp with time zone null
+);
+
+create table registration (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    user_id bigint not null unique references "user" on delete cascade,
+    organization_id bigint not null references organization on delete cascade,
+    invite_id bigint null references registration_invite on delete set null,
+    approved boolean not null
+);
+
+create index idx_registration_organization_id on registration(organization_id);
+create index idx_registration_invite_id on registration(invite_id);
+create index idx_registration_approved on registration(approved);
+
+-- +migrate Down
+drop index idx_registration_approved;
+drop index idx_registration_invite_id;
+drop index idx_registration_organization_id;
+drop table registration;
+drop table registration_invite;
+
+alter table organization
+    drop column max_gateway_count,
+    drop column max_device_count;
@@ -0,0 +1,11 @@
+-- +migrate Up
+alter table service_profile
+    add column max_device_count integer not null default 0,
+    add column max_uplink_rate integer not null default 0,
+    add column max_payload_size integer not null default 0;
+
+-- +migrate Down
+alter table service_profile
+    drop column max_payload_size,
+    drop column max_uplink_rate,
+    drop column max_device_count;
@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table device
+    add column suppress_f_cnt_anomalies boolean not null default false;
+
+-- +migrate Down
+alter table device
+    drop column suppress_f_cnt_anomalies;
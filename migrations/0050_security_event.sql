@@ -0,0 +1,21 @@
+-- +migrate Up
+create table security_event (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    type varchar(50) not null,
+    severity smallint not null,
+    username varchar(100) not null default '',
+    remote_addr varchar(100) not null default '',
+    dev_eui bytea,
+    description text not null
+);
+
+create index idx_security_event_created_at on security_event(created_at);
+create index idx_security_event_type on security_event(type);
+create index idx_security_event_dev_eui on security_event(dev_eui);
+
+-- +migrate Down
+drop index idx_security_event_dev_eui;
+drop index idx_security_event_type;
+drop index idx_security_event_created_at;
+drop table security_event;
@@ -0,0 +1,15 @@
+-- +migrate Up
+create table organization_retention (
+    organization_id bigint primary key references organization on delete cascade,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    security_event_days integer not null default 0,
+    device_location_days integer not null default 0,
+    gateway_ping_days integer not null default 0
+);
+
+create index idx_device_location_created_at on device_location(created_at);
+
+-- +migrate Down
+drop index idx_device_location_created_at;
+drop table organization_retention;
@@ -0,0 +1,18 @@
+-- +migrate Up
+create table device_uplink_stats (
+    dev_eui bytea not null references device on delete cascade,
+    date date not null,
+    f_port smallint not null,
+    dr smallint not null,
+    payload_size smallint not null,
+    spreading_factor smallint not null,
+    uplink_count bigint not null,
+    airtime double precision not null,
+    primary key (dev_eui, date, f_port, dr, payload_size)
+);
+
+create index idx_device_uplink_stats_date on device_uplink_stats(date);
+
+-- +migrate Down
+drop index idx_device_uplink_stats_date;
+drop table device_uplink_stats;
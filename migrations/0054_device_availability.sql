@@ -0,0 +1,14 @@
+-- +migrate Up
+create table device_availability (
+    dev_eui bytea not null references device on delete cascade,
+    date date not null,
+    received_count bigint not null,
+    expected_count bigint not null,
+    primary key (dev_eui, date)
+);
+
+create index idx_device_availability_date on device_availability(date);
+
+-- +migrate Down
+drop index idx_device_availability_date;
+drop table device_availability;
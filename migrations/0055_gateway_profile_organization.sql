@@ -0,0 +1,11 @@
+-- +migrate Up
+alter table gateway_profile
+    add column organization_id bigint references organization on delete cascade;
+
+create index idx_gateway_profile_organization_id on gateway_profile(organization_id);
+
+-- +migrate Down
+drop index idx_gateway_profile_organization_id;
+
+alter table gateway_profile
+    drop column organization_id;
@@ -0,0 +1,14 @@
+-- +migrate Up
+create table gateway_metadata (
+    gateway_mac bytea primary key references gateway on delete cascade,
+    updated_at timestamp with time zone not null,
+    ip varchar(100) not null default '',
+    config_version varchar(100) not null default '',
+    packet_forwarder_version varchar(100) not null default '',
+    platform varchar(100) not null default '',
+    temperature double precision,
+    properties jsonb not null default '{}'
+);
+
+-- +migrate Down
+drop table gateway_metadata;
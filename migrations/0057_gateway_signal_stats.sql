@@ -0,0 +1,20 @@
+-- +migrate Up
+create table gateway_signal_stats (
+    gateway_mac bytea not null references gateway on delete cascade,
+    date date not null,
+    geohash varchar(12) not null,
+    uplink_count bigint not null,
+    rssi_sum double precision not null,
+    rssi_min integer not null,
+    rssi_max integer not null,
+    snr_sum double precision not null,
+    snr_min double precision not null,
+    snr_max double precision not null,
+    primary key (gateway_mac, date, geohash)
+);
+
+create index idx_gateway_signal_stats_date on gateway_signal_stats(date);
+
+-- +migrate Down
+drop index idx_gateway_signal_stats_date;
+drop table gateway_signal_stats;
@@ -0,0 +1,17 @@
+-- +migrate Up
+create table gateway_device (
+    gateway_mac bytea not null references gateway on delete cascade,
+    dev_eui bytea not null references device on delete cascade,
+    last_seen_at timestamp with time zone not null,
+    rssi integer not null,
+    lora_snr double precision not null,
+    primary key (gateway_mac, dev_eui)
+);
+
+create index idx_gateway_device_dev_eui on gateway_device(dev_eui);
+create index idx_gateway_device_last_seen_at on gateway_device(last_seen_at);
+
+-- +migrate Down
+drop index idx_gateway_device_last_seen_at;
+drop index idx_gateway_device_dev_eui;
+drop table gateway_device;
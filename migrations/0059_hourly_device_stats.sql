@@ -0,0 +1,65 @@
+-- +migrate Up
+alter table device_uplink_stats rename column date to hour;
+alter table device_uplink_stats
+    alter column hour type timestamp with time zone using hour::timestamp at time zone 'UTC';
+alter index idx_device_uplink_stats_date rename to idx_device_uplink_stats_hour;
+
+alter table device_availability rename column date to hour;
+alter table device_availability
+    alter column hour type timestamp with time zone using hour::timestamp at time zone 'UTC';
+alter index idx_device_availability_date rename to idx_device_availability_hour;
+
+-- +migrate Down
+create table device_uplink_stats_daily (
+    dev_eui bytea not null references device on delete cascade,
+    date date not null,
+    f_port smallint not null,
+    dr smallint not null,
+    payload_size smallint not null,
+    spreading_factor smallint not null,
+    uplink_count bigint not null,
+    airtime double precision not null,
+    primary key (dev_eui, date, f_port, dr, payload_size)
+);
+
+insert into device_uplink_stats_daily
+    select
+        dev_eui,
+        (hour at time zone 'UTC')::date,
+        f_port,
+        dr,
+        payload_size,
+        max(spreading_factor),
+        sum(uplink_count),
+        sum(airtime)
+    from device_uplink_stats
+    group by 1, 2, 3, 4, 5;
+
+drop table device_uplink_stats;
+alter table device_uplink_stats_daily rename to device_uplink_stats;
+alter index device_uplink_stats_daily_pkey rename to device_uplink_stats_pkey;
+alter table device_uplink_stats rename constraint device_uplink_stats_daily_dev_eui_fkey to device_uplink_stats_dev_eui_fkey;
+create index idx_device_uplink_stats_date on device_uplink_stats(date);
+
+create table device_availability_daily (
+    dev_eui bytea not null references device on delete cascade,
+    date date not null,
+    received_count bigint not null,
+    expected_count bigint not null,
+    primary key (dev_eui, date)
+);
+
+insert into device_availability_daily
+    select
+        dev_eui,
+        (hour at time zone 'UTC')::date,
+        sum(received_count),
+        sum(expected_count)
+    from device_availability
+    group by 1, 2;
+
+drop table device_availability;
+alter table device_availability_daily rename to device_availability;
+alter index device_availability_daily_pkey rename to device_availability_pkey;
+alter table device_availability rename constraint device_availability_daily_dev_eui_fkey to device_availability_dev_eui_fkey;
+create index idx_device_availability_date on device_availability(date);
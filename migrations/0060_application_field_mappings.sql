@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table application
+    add column field_mappings jsonb not null default '[]';
+
+-- +migrate Down
+alter table application
+    drop column field_mappings;
@@ -0,0 +1,14 @@
+-- +migrate Up
+create table device_twin (
+    dev_eui bytea primary key references device on delete cascade,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    version bigint not null default 0,
+    reported jsonb not null default '{}',
+    reported_metadata jsonb not null default '{}',
+    desired jsonb not null default '{}',
+    desired_metadata jsonb not null default '{}'
+);
+
+-- +migrate Down
+drop table device_twin;
@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table application
+    add column uplink_filter_script text not null default '';
+
+-- +migrate Down
+alter table application
+    drop column uplink_filter_script;
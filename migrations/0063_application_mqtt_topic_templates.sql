@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table application
+    add column mqtt_topic_templates jsonb not null default '{}';
+
+-- +migrate Down
+alter table application
+    drop column mqtt_topic_templates;
@@ -0,0 +1,10 @@
+-- +migrate Up
+alter table security_event
+    add column organization_id bigint references organization on delete cascade;
+
+create index idx_security_event_organization_id on security_event(organization_id);
+
+-- +migrate Down
+drop index idx_security_event_organization_id;
+alter table security_event
+    drop column organization_id;
@@ -0,0 +1,16 @@
+-- +migrate Up
+alter table organization_user
+    add column can_view_keys boolean not null default false,
+    add column can_export boolean not null default true,
+    add column is_auditor boolean not null default false;
+
+-- existing organization users keep the export permission, new organization
+-- users must be granted this permission explicitly
+alter table organization_user
+    alter column can_export set default false;
+
+-- +migrate Down
+alter table organization_user
+    drop column is_auditor,
+    drop column can_export,
+    drop column can_view_keys;
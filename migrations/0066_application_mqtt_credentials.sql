@@ -0,0 +1,11 @@
+-- +migrate Up
+create table application_mqtt_credentials (
+	application_id bigint primary key references application on delete cascade,
+	created_at timestamp with time zone not null,
+	updated_at timestamp with time zone not null,
+	username varchar(100) not null unique,
+	password_hash varchar(200) not null
+);
+
+-- +migrate Down
+drop table application_mqtt_credentials;
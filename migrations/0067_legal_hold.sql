@@ -0,0 +1,13 @@
+-- +migrate Up
+alter table application
+    add column legal_hold_at timestamp with time zone null;
+
+alter table device
+    add column legal_hold_at timestamp with time zone null;
+
+-- +migrate Down
+alter table device
+    drop column legal_hold_at;
+
+alter table application
+    drop column legal_hold_at;
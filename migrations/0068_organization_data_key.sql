@@ -0,0 +1,13 @@
+-- +migrate Up
+create table organization_data_key (
+    organization_id bigint primary key references organization on delete cascade,
+    created_at timestamp with time zone not null,
+    kek_label varchar(100) not null default '',
+    data_key bytea not null
+);
+
+create index idx_organization_data_key_kek_label on organization_data_key(kek_label);
+
+-- +migrate Down
+drop index idx_organization_data_key_kek_label;
+drop table organization_data_key;
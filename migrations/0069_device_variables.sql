@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table device
+    add column variables jsonb not null default '{}';
+
+-- +migrate Down
+alter table device
+    drop column variables;
@@ -0,0 +1,43 @@
+-- +migrate Up
+create table service_account (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    organization_id bigint not null references organization on delete cascade,
+    name varchar(100) not null,
+    description text not null default '',
+    client_id uuid not null unique,
+    client_secret_hash varchar(200) not null,
+    public_key text not null default '',
+    is_active boolean not null default true,
+    is_admin boolean not null default false,
+    can_view_keys boolean not null default false,
+    can_export boolean not null default false,
+    is_auditor boolean not null default false
+);
+
+create index idx_service_account_organization_id on service_account(organization_id);
+
+-- principal contains the users and service accounts. Service accounts have
+-- a negative ID, so that these never match a user ID.
+create view principal as
+    select id, username, is_active, is_admin
+    from "user"
+    union all
+    select -id, 'service-account:' || client_id::text, is_active, false
+    from service_account;
+
+-- principal_organization contains the organization memberships of the
+-- users and service accounts.
+create view principal_organization as
+    select user_id, organization_id, is_admin, can_view_keys, can_export, is_auditor
+    from organization_user
+    union all
+    select -id, organization_id, is_admin, can_view_keys, can_export, is_auditor
+    from service_account;
+
+-- +migrate Down
+drop view principal_organization;
+drop view principal;
+drop index idx_service_account_organization_id;
+drop table service_account;
@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table network_server
+    add column kek_storage_kek_label varchar(100) not null default '';
+
+-- +migrate Down
+alter table network_server
+    drop column kek_storage_kek_label;
@@ -0,0 +1,110 @@
+// Package plugin implements the helpers for writing LoRa App Server
+// integration plugins. Integration plugins are separate binaries receiving
+// the integration events (uplinks, joins, ACKs, ...) over gRPC, so that
+// integrations can be implemented without forking LoRa App Server.
+//
+// A minimal plugin looks like:
+//
+//	type handler struct{}
+//
+//	func (h handler) HandleEvent(eventType string, applicationID int64, payload []byte) error {
+//		log.Printf("%s event for application %d: %s", eventType, applicationID, payload)
+//		return nil
+//	}
+//
+//	func main() {
+//		if err := plugin.Serve(handler{}); err != nil {
+//			log.Fatal(err)
+//		}
+//	}
+package plugin
+
+import (
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	pb "github.com/brocaar/lora-app-server/api"
+)
+
+// AddressEnvVar defines the environment variable containing the address
+// (hostname:port) on which the plugin must serve. It is set by LoRa App
+// Server when it starts the plugin process.
+const AddressEnvVar = "LORA_APP_SERVER_PLUGIN_ADDRESS"
+
+// Event types.
+const (
+	UplinkEvent   = "up"
+	JoinEvent     = "join"
+	ACKEvent      = "ack"
+	ErrorEvent    = "error"
+	StatusEvent   = "status"
+	LocationEvent = "location"
+	AdminEvent    = "admin"
+)
+
+// Handler defines the interface implemented by a plugin. The payload is
+// JSON encoded and is identical to the payload published by the MQTT
+// integration for the given event type.
+type Handler interface {
+	HandleEvent(eventType string, applicationID int64, payload []byte) error
+}
+
+// NewServer returns a gRPC server serving the given handler and the gRPC
+// health-checking service.
+func NewServer(h Handler) *grpc.Server {
+	server := grpc.NewServer()
+	pb.RegisterPluginServiceServer(server, &pluginServer{handler: h})
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+	grpc_health_v1.RegisterHealthServer(server, healthServer)
+
+	return server
+}
+
+// Serve serves the given handler on the address set by LoRa App Server
+// (see AddressEnvVar) until the process receives a SIGINT or SIGTERM
+// signal.
+func Serve(h Handler) error {
+	address := os.Getenv(AddressEnvVar)
+	if address == "" {
+		return errors.Errorf("%s environment variable is not set", AddressEnvVar)
+	}
+
+	ln, err := net.Listen("tcp", address)
+	if err != nil {
+		return errors.Wrap(err, "listen error")
+	}
+
+	server := NewServer(h)
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigChan
+		server.GracefulStop()
+	}()
+
+	return server.Serve(ln)
+}
+
+type pluginServer struct {
+	handler Handler
+}
+
+func (s *pluginServer) HandleEvent(ctx context.Context, req *pb.HandlePluginEventRequest) (*empty.Empty, error) {
+	if err := s.handler.HandleEvent(req.Type, req.ApplicationId, req.PayloadJson); err != nil {
+		return nil, grpc.Errorf(codes.Unknown, "%s", err)
+	}
+	return &empty.Empty{}, nil
+}
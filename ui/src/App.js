@@ -41,6 +41,8 @@ import ListUsers from "./views/users/ListUsers";
 import CreateUser from "./views/users/CreateUser";
 import UserLayout from "./views/users/UserLayout";
 import ChangeUserPassword from "./views/users/ChangeUserPassword";
+import Register from "./views/users/Register";
+import ListRegistrations from "./views/users/ListRegistrations";
 
 // service-profile
 import ListServiceProfiles from "./views/service-profiles/ListServiceProfiles";
@@ -162,6 +164,8 @@ class App extends Component {
                   <Switch>
                     <Route exact path="/" component={OrganizationRedirect} />
                     <Route exact path="/login" component={Login} />
+                    <Route exact path="/register" component={Register} />
+                    <Route exact path="/registrations" component={ListRegistrations} />
                     <Route exact path="/users" component={ListUsers} />
                     <Route exact path="/users/create" component={CreateUser} />
                     <Route exact path="/users/:userID(\d+)" component={UserLayout} />
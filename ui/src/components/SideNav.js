@@ -8,7 +8,7 @@ import ListItem from '@material-ui/core/ListItem';
 import ListItemIcon from '@material-ui/core/ListItemIcon';
 import ListItemText from '@material-ui/core/ListItemText';
 import Divider from '@material-ui/core/Divider';
-import { Domain, Account, Server, Apps, RadioTower, Tune, AccountSettingsVariant, Settings, Rss } from 'mdi-material-ui';
+import { Domain, Account, AccountPlus, Server, Apps, RadioTower, Tune, AccountSettingsVariant, Settings, Rss } from 'mdi-material-ui';
 
 import AutocompleteSelect from "./AutocompleteSelect";
 import SessionStore from "../stores/SessionStore";
@@ -168,6 +168,12 @@ class SideNav extends Component {
               </ListItemIcon>
               <ListItemText primary="All users" />
             </ListItem>
+            <ListItem button component={Link} to="/registrations">
+              <ListItemIcon>
+                <AccountPlus />
+              </ListItemIcon>
+              <ListItemText primary="Registrations" />
+            </ListItem>
           </List>
           <Divider />
         </Admin>
@@ -167,6 +167,37 @@ class OrganizationStore extends EventEmitter {
     });
   }
 
+  getSettings(organizationID, callbackFunc) {
+    this.swagger.then(client => {
+      client.apis.OrganizationService.GetSettings({
+        organization_id: organizationID,
+      })
+      .then(checkStatus)
+      .then(resp => {
+        callbackFunc(resp.obj);
+      })
+      .catch(errorHandler);
+    });
+  }
+
+  updateSettings(settings, callbackFunc) {
+    this.swagger.then(client => {
+      client.apis.OrganizationService.UpdateSettings({
+        "settings.organization_id": settings.organizationID,
+        body: {
+          settings: settings,
+        },
+      })
+      .then(checkStatus)
+      .then(resp => {
+        this.emit("settings", settings);
+        this.notify("updated");
+        callbackFunc(resp.obj);
+      })
+      .catch(errorHandler);
+    });
+  }
+
   notify(action) {
     dispatcher.dispatch({
       type: "CREATE_NOTIFICATION",
@@ -0,0 +1,145 @@
+import { EventEmitter } from "events";
+
+import Swagger from "swagger-client";
+
+import sessionStore from "./SessionStore";
+import {checkStatus, errorHandler, errorHandlerLogin } from "./helpers";
+import dispatcher from "../dispatcher";
+
+
+class RegistrationStore extends EventEmitter {
+  constructor() {
+    super();
+    this.swagger = new Swagger("/swagger/registration.swagger.json", sessionStore.getClientOpts());
+  }
+
+  register(registration, callbackFunc) {
+    this.swagger.then(client => {
+      client.apis.RegistrationService.Register({
+        body: registration,
+      })
+      .then(checkStatus)
+      .then(resp => {
+        callbackFunc(resp.obj);
+      })
+      .catch(errorHandlerLogin);
+    });
+  }
+
+  getSettings(callbackFunc) {
+    this.swagger.then(client => {
+      client.apis.RegistrationService.GetSettings({})
+      .then(checkStatus)
+      .then(resp => {
+        callbackFunc(resp.obj);
+      })
+      .catch(errorHandlerLogin);
+    });
+  }
+
+  list(pendingOnly, limit, offset, callbackFunc) {
+    this.swagger.then(client => {
+      client.apis.RegistrationService.List({
+        pending_only: pendingOnly,
+        limit: limit,
+        offset: offset,
+      })
+      .then(checkStatus)
+      .then(resp => {
+        callbackFunc(resp.obj);
+      })
+      .catch(errorHandler);
+    });
+  }
+
+  approve(id, callbackFunc) {
+    this.swagger.then(client => {
+      client.apis.RegistrationService.Approve({
+        id: id,
+        body: {},
+      })
+      .then(checkStatus)
+      .then(resp => {
+        this.notify("registration has been approved");
+        this.emit("change");
+        callbackFunc(resp.obj);
+      })
+      .catch(errorHandler);
+    });
+  }
+
+  reject(id, callbackFunc) {
+    this.swagger.then(client => {
+      client.apis.RegistrationService.Reject({
+        id: id,
+        body: {},
+      })
+      .then(checkStatus)
+      .then(resp => {
+        this.notify("registration has been rejected");
+        this.emit("change");
+        callbackFunc(resp.obj);
+      })
+      .catch(errorHandler);
+    });
+  }
+
+  createInvite(email, callbackFunc) {
+    this.swagger.then(client => {
+      client.apis.RegistrationService.CreateInvite({
+        body: {
+          email: email,
+        },
+      })
+      .then(checkStatus)
+      .then(resp => {
+        this.notify("invite has been created");
+        this.emit("change");
+        callbackFunc(resp.obj);
+      })
+      .catch(errorHandler);
+    });
+  }
+
+  listInvites(limit, offset, callbackFunc) {
+    this.swagger.then(client => {
+      client.apis.RegistrationService.ListInvites({
+        limit: limit,
+        offset: offset,
+      })
+      .then(checkStatus)
+      .then(resp => {
+        callbackFunc(resp.obj);
+      })
+      .catch(errorHandler);
+    });
+  }
+
+  deleteInvite(id, callbackFunc) {
+    this.swagger.then(client => {
+      client.apis.RegistrationService.DeleteInvite({
+        id: id,
+      })
+      .then(checkStatus)
+      .then(resp => {
+        this.notify("invite has been deleted");
+        this.emit("change");
+        callbackFunc(resp.obj);
+      })
+      .catch(errorHandler);
+    });
+  }
+
+  notify(message) {
+    dispatcher.dispatch({
+      type: "CREATE_NOTIFICATION",
+      notification: {
+        type: "success",
+        message: message,
+      },
+    });
+  }
+}
+
+const registrationStore = new RegistrationStore();
+export default registrationStore;
@@ -5,6 +5,9 @@ import TextField from '@material-ui/core/TextField';
 import FormControl from "@material-ui/core/FormControl";
 import FormLabel from "@material-ui/core/FormLabel";
 import FormHelperText from "@material-ui/core/FormHelperText";
+import FormGroup from "@material-ui/core/FormGroup";
+import FormControlLabel from "@material-ui/core/FormControlLabel";
+import Checkbox from "@material-ui/core/Checkbox";
 
 import {Controlled as CodeMirror} from "react-codemirror2";
 import "codemirror/mode/javascript/javascript";
@@ -32,6 +35,7 @@ class ApplicationForm extends FormComponent {
     this.getServiceProfileOptions = this.getServiceProfileOptions.bind(this);
     this.getPayloadCodecOptions = this.getPayloadCodecOptions.bind(this);
     this.onCodeChange = this.onCodeChange.bind(this);
+    this.onMQTTTopicTemplateChange = this.onMQTTTopicTemplateChange.bind(this);
   }
 
   getServiceProfileOption(id, callbackFunc) {
@@ -65,6 +69,17 @@ class ApplicationForm extends FormComponent {
     });
   }
 
+  onMQTTTopicTemplateChange(e) {
+    let object = this.state.object;
+    if (object.mqttTopicTemplates === undefined || object.mqttTopicTemplates === null) {
+      object.mqttTopicTemplates = {};
+    }
+    object.mqttTopicTemplates[e.target.id] = e.target.value;
+    this.setState({
+      object: object,
+    });
+  }
+
   render() {
     if (this.state.object === undefined) {
       return(<div></div>);
@@ -99,6 +114,17 @@ function Decode(fPort, bytes) {
 }`;
     }
 
+    const mqttTopicTemplates = this.state.object.mqttTopicTemplates || {};
+    const mqttTopicTemplateFields = [
+      {id: "uplink", label: "MQTT uplink topic template"},
+      {id: "downlink", label: "MQTT downlink topic template"},
+      {id: "join", label: "MQTT join topic template"},
+      {id: "ack", label: "MQTT ack topic template"},
+      {id: "error", label: "MQTT error topic template"},
+      {id: "status", label: "MQTT status topic template"},
+      {id: "location", label: "MQTT location topic template"},
+    ];
+
     return(
       <Form
         submitLabel={this.props.submitLabel}
@@ -174,6 +200,55 @@ function Decode(fPort, bytes) {
             of bytes.
           </FormHelperText>
         </FormControl>}
+        <FormControl fullWidth margin="normal">
+          <FormLabel className={this.props.classes.formLabel}>Uplink filter script</FormLabel>
+          <CodeMirror
+            value={this.state.object.uplinkFilterScript || ""}
+            options={codeMirrorOptions}
+            onBeforeChange={this.onCodeChange.bind(this, 'uplinkFilterScript')}
+            className={this.props.classes.codeMirror}
+          />
+          <FormHelperText>
+            Optional. The function must have the signature <strong>function Filter(fPort, bytes, obj, prevObj)</strong> and
+            must return a boolean. Uplinks for which it returns false are not sent to the integrations.
+          </FormHelperText>
+        </FormControl>
+        <FormControl fullWidth margin="normal">
+          <FormLabel className={this.props.classes.formLabel}>MQTT topic templates</FormLabel>
+          {mqttTopicTemplateFields.map(f => <TextField
+            key={f.id}
+            id={f.id}
+            label={f.label}
+            margin="normal"
+            value={mqttTopicTemplates[f.id] || ""}
+            onChange={this.onMQTTTopicTemplateChange}
+            placeholder="Use the configured topic template"
+            fullWidth
+          />)}
+          <FormHelperText>
+            Optional. These templates override the configured MQTT topic templates for this application,
+            e.g. <strong>{"tenant-a/{{ .DevEUI }}/rx"}</strong>. The downlink topic template must contain the DevEUI.
+          </FormHelperText>
+        </FormControl>
+        <FormControl margin="normal">
+          <FormGroup>
+            <FormControlLabel
+              label="Disable organization integrations"
+              control={
+                <Checkbox
+                  id="disableOrganizationIntegrations"
+                  checked={!!this.state.object.disableOrganizationIntegrations}
+                  onChange={this.onChange}
+                  color="primary"
+                />
+              }
+            />
+          </FormGroup>
+          <FormHelperText>
+            When checked, the integrations configured for the organization are not inherited by this application.
+            Integrations configured for this application always override the organization integration of the same kind.
+          </FormHelperText>
+        </FormControl>
       </Form>
     );
   }
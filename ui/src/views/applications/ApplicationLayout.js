@@ -6,7 +6,9 @@ import Grid from '@material-ui/core/Grid';
 import Tabs from '@material-ui/core/Tabs';
 import Tab from '@material-ui/core/Tab';
 
+import Archive from "mdi-material-ui/Archive";
 import Delete from "mdi-material-ui/Delete";
+import PackageUp from "mdi-material-ui/PackageUp";
 
 import TitleBar from "../../components/TitleBar";
 import TitleBarTitle from "../../components/TitleBarTitle";
@@ -40,6 +42,8 @@ class ApplicationLayout extends Component {
       admin: false,
     };
 
+    this.archiveApplication = this.archiveApplication.bind(this);
+    this.unarchiveApplication = this.unarchiveApplication.bind(this);
     this.deleteApplication = this.deleteApplication.bind(this);
     this.locationToTab = this.locationToTab.bind(this);
     this.onChangeTab = this.onChangeTab.bind(this);
@@ -47,11 +51,7 @@ class ApplicationLayout extends Component {
   }
 
   componentDidMount() {
-    ApplicationStore.get(this.props.match.params.applicationID, resp => {
-      this.setState({
-        application: resp,
-      });
-    });
+    this.loadData();
 
     SessionStore.on("change", this.setIsAdmin);
 
@@ -71,12 +71,34 @@ class ApplicationLayout extends Component {
     this.locationToTab();
   }
 
+  loadData() {
+    ApplicationStore.get(this.props.match.params.applicationID, resp => {
+      this.setState({
+        application: resp,
+      });
+    });
+  }
+
   setIsAdmin() {
     this.setState({
       admin: SessionStore.isAdmin() || SessionStore.isOrganizationAdmin(this.props.match.params.organizationID),
     });
   }
 
+  archiveApplication() {
+    if (window.confirm("Are you sure you want to archive this application? The devices of an archived application will no longer send data to the integrations.")) {
+      ApplicationStore.archive(this.props.match.params.applicationID, resp => {
+        this.loadData();
+      });
+    }
+  }
+
+  unarchiveApplication() {
+    ApplicationStore.unarchive(this.props.match.params.applicationID, resp => {
+      this.loadData();
+    });
+  }
+
   deleteApplication() {
     if (window.confirm("Are you sure you want to delete this application?")) {
       ApplicationStore.delete(this.props.match.params.applicationID, resp => {
@@ -115,6 +137,15 @@ class ApplicationLayout extends Component {
         <TitleBar
           buttons={
             <Admin organizationID={this.props.match.params.organizationID}>
+              {this.state.application.application.archived ? <TitleBarButton
+                label="Unarchive"
+                icon={<PackageUp />}
+                onClick={this.unarchiveApplication}
+              /> : <TitleBarButton
+                label="Archive"
+                icon={<Archive />}
+                onClick={this.archiveApplication}
+              />}
               <TitleBarButton
                 label="Delete"
                 icon={<Delete />}
@@ -127,6 +158,7 @@ class ApplicationLayout extends Component {
           <TitleBarTitle to={`/organizations/${this.props.match.params.organizationID}/applications`} title="Applications" />
           <TitleBarTitle title="/" />
           <TitleBarTitle title={this.state.application.application.name} />
+          {this.state.application.application.archived && <TitleBarTitle title="(archived)" />}
         </TitleBar>
 
         <Grid item xs={12}>
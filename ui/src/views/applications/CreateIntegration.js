@@ -50,6 +50,26 @@ class CreateIntegration extends Component {
           this.props.history.push(`/organizations/${this.props.match.params.organizationID}/applications/${this.props.match.params.applicationID}/integrations`);
         });
         break;
+      case "azure":
+        ApplicationStore.createAzureIntegration(integr, resp => {
+          this.props.history.push(`/organizations/${this.props.match.params.organizationID}/applications/${this.props.match.params.applicationID}/integrations`);
+        });
+        break;
+      case "thingsboard":
+        ApplicationStore.createThingsBoardIntegration(integr, resp => {
+          this.props.history.push(`/organizations/${this.props.match.params.organizationID}/applications/${this.props.match.params.applicationID}/integrations`);
+        });
+        break;
+      case "my_devices":
+        ApplicationStore.createMyDevicesIntegration(integr, resp => {
+          this.props.history.push(`/organizations/${this.props.match.params.organizationID}/applications/${this.props.match.params.applicationID}/integrations`);
+        });
+        break;
+      case "lora_cloud":
+        ApplicationStore.createLoRaCloudIntegration(integr, resp => {
+          this.props.history.push(`/organizations/${this.props.match.params.organizationID}/applications/${this.props.match.params.applicationID}/integrations`);
+        });
+        break;
       default:
         break;
     }
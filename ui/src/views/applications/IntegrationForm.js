@@ -7,6 +7,9 @@ import FormControl from "@material-ui/core/FormControl";
 import FormLabel from "@material-ui/core/FormLabel";
 import IconButton from '@material-ui/core/IconButton';
 import FormHelperText from "@material-ui/core/FormHelperText";
+import FormGroup from "@material-ui/core/FormGroup";
+import FormControlLabel from "@material-ui/core/FormControlLabel";
+import Checkbox from "@material-ui/core/Checkbox";
 
 import Delete from "mdi-material-ui/Delete";
 
@@ -27,6 +30,30 @@ const styles = {
 };
 
 
+function ChangedFieldsOnlyField(props) {
+  return(
+    <FormControl fullWidth margin="normal">
+      <FormGroup>
+        <FormControlLabel
+          label="Only send changed fields"
+          control={
+            <Checkbox
+              id="changedFieldsOnly"
+              checked={!!props.object.changedFieldsOnly}
+              onChange={props.onChange}
+              color="primary"
+            />
+          }
+        />
+      </FormGroup>
+      <FormHelperText>
+        When checked, the decoded object of an uplink only contains the fields which changed since the previous uplink of the device.
+      </FormHelperText>
+    </FormControl>
+  );
+}
+
+
 class HTTPIntegrationHeaderForm extends FormComponent {
   constructor() {
     super();
@@ -51,7 +78,7 @@ class HTTPIntegrationHeaderForm extends FormComponent {
 
     return(
       <Grid container spacing={24}>
-        <Grid item xs={4}>
+        <Grid item xs={3}>
           <TextField
             id="key"
             label="Header name"
@@ -61,7 +88,7 @@ class HTTPIntegrationHeaderForm extends FormComponent {
             fullWidth
           />
         </Grid>
-        <Grid item xs={7}>
+        <Grid item xs={4}>
           <TextField
             id="value"
             label="Header value"
@@ -71,6 +98,17 @@ class HTTPIntegrationHeaderForm extends FormComponent {
             fullWidth
           />
         </Grid>
+        <Grid item xs={4}>
+          <TextField
+            id="url"
+            label="URL"
+            margin="normal"
+            value={this.state.object.url || ""}
+            onChange={this.onChange}
+            helperText="When empty, the header is used for all URLs."
+            fullWidth
+          />
+        </Grid>
         <Grid item xs={1} className={this.props.classes.delete}>
           <IconButton aria-label="delete" onClick={this.onDelete}>
             <Delete />
@@ -178,6 +216,15 @@ class HTTPIntegrationForm extends FormComponent {
             margin="normal"
             fullWidth
           />
+          <TextField
+            id="adminEventURL"
+            label="Admin event URL"
+            placeholder="http://example.com/admin"
+            value={this.state.object.adminEventURL || ""}
+            onChange={this.onChange}
+            margin="normal"
+            fullWidth
+          />
           <TextField
             id="ackNotificationURL"
             label="ACK notification URL"
@@ -197,6 +244,7 @@ class HTTPIntegrationForm extends FormComponent {
             fullWidth
           />
         </FormControl>
+        <ChangedFieldsOnlyField object={this.state.object} onChange={this.onChange} />
       </div>
     );
   }
@@ -288,6 +336,7 @@ class InfluxDBIntegrationForm extends FormComponent {
             It is recommented to use the least precise precision possible as this can result in significant improvements in compression.
           </FormHelperText>
         </FormControl>
+        <ChangedFieldsOnlyField object={this.state.object} onChange={this.onChange} />
       </FormControl>
     );
   }
@@ -296,6 +345,237 @@ class InfluxDBIntegrationForm extends FormComponent {
 InfluxDBIntegrationForm = withStyles(styles)(InfluxDBIntegrationForm);
 
 
+class AzureIntegrationForm extends FormComponent {
+  onChange(e) {
+    super.onChange(e);
+    this.props.onChange(this.state.object);
+  }
+
+  render() {
+    if (this.state.object === undefined) {
+      return(<div></div>);
+    }
+
+    return(
+      <FormControl fullWidth margin="normal">
+        <FormLabel>Azure integration configuration</FormLabel>
+        <TextField
+          id="connectionString"
+          label="Connection string"
+          helperText="The connection string of a Service Bus queue or topic (including the EntityPath) or of an IoT Hub shared access policy with the device connect permission."
+          value={this.state.object.connectionString || ""}
+          onChange={this.onChange}
+          margin="normal"
+          required
+          fullWidth
+        />
+        <ChangedFieldsOnlyField object={this.state.object} onChange={this.onChange} />
+      </FormControl>
+    );
+  }
+}
+
+AzureIntegrationForm = withStyles(styles)(AzureIntegrationForm);
+
+
+class ThingsBoardIntegrationForm extends FormComponent {
+  onChange(e) {
+    super.onChange(e);
+    this.props.onChange(this.state.object);
+  }
+
+  render() {
+    if (this.state.object === undefined) {
+      return(<div></div>);
+    }
+
+    return(
+      <FormControl fullWidth margin="normal">
+        <FormLabel>ThingsBoard integration configuration</FormLabel>
+        <TextField
+          id="server"
+          label="ThingsBoard server"
+          placeholder="http://host:port"
+          helperText="Each device must have a 'ThingsBoardAccessToken' variable containing the access token of the ThingsBoard device."
+          value={this.state.object.server || ""}
+          onChange={this.onChange}
+          margin="normal"
+          required
+          fullWidth
+        />
+      </FormControl>
+    );
+  }
+}
+
+ThingsBoardIntegrationForm = withStyles(styles)(ThingsBoardIntegrationForm);
+
+
+const cayenneEndpoint = "https://lora.mydevices.com/v1/networks/loraserverio/uplink";
+
+class MyDevicesIntegrationForm extends FormComponent {
+  constructor() {
+    super();
+    this.onEndpointChange = this.onEndpointChange.bind(this);
+  }
+
+  onChange(e) {
+    super.onChange(e);
+    this.props.onChange(this.state.object);
+  }
+
+  componentDidMount() {
+    super.componentDidMount();
+
+    let endpointSelect = "cayenne";
+    if (this.props.object.endpoint !== undefined && this.props.object.endpoint !== cayenneEndpoint) {
+      endpointSelect = "custom";
+    }
+
+    this.setState({
+      endpointSelect: endpointSelect,
+    });
+
+    if (endpointSelect === "cayenne" && this.props.object.endpoint === undefined) {
+      let object = this.props.object;
+      object.endpoint = cayenneEndpoint;
+      this.props.onChange(object);
+    }
+  }
+
+  onEndpointChange(e) {
+    let object = this.state.object;
+    if (e.target.value === "cayenne") {
+      object.endpoint = cayenneEndpoint;
+    } else {
+      object.endpoint = "";
+    }
+
+    this.setState({
+      endpointSelect: e.target.value,
+      object: object,
+    });
+    this.props.onChange(object);
+  }
+
+  getEndpointOptions(search, callbackFunc) {
+    const endpointOptions = [
+      {value: "cayenne", label: "Cayenne"},
+      {value: "custom", label: "Custom endpoint URL"},
+    ];
+
+    callbackFunc(endpointOptions);
+  }
+
+  render() {
+    if (this.state.object === undefined || this.state.endpointSelect === undefined) {
+      return(<div></div>);
+    }
+
+    return(
+      <FormControl fullWidth margin="normal">
+        <FormLabel>myDevices integration configuration</FormLabel>
+        <FormControl fullWidth margin="normal">
+          <FormLabel className={this.props.classes.formLabel} required>Select endpoint</FormLabel>
+          <AutocompleteSelect
+            id="endpointSelect"
+            label="Select endpoint"
+            value={this.state.endpointSelect}
+            onChange={this.onEndpointChange}
+            getOptions={this.getEndpointOptions}
+          />
+        </FormControl>
+        {this.state.endpointSelect === "custom" && <TextField
+          id="endpoint"
+          label="Endpoint URL"
+          placeholder="https://lora.mydevices.com/v1/networks/loraserverio/uplink"
+          value={this.state.object.endpoint || ""}
+          onChange={this.onChange}
+          margin="normal"
+          required
+          fullWidth
+        />}
+      </FormControl>
+    );
+  }
+}
+
+MyDevicesIntegrationForm = withStyles(styles)(MyDevicesIntegrationForm);
+
+
+class LoRaCloudIntegrationForm extends FormComponent {
+  onChange(e) {
+    super.onChange(e);
+    this.props.onChange(this.state.object);
+  }
+
+  render() {
+    if (this.state.object === undefined) {
+      return(<div></div>);
+    }
+
+    return(
+      <FormControl fullWidth margin="normal">
+        <FormLabel>LoRa Cloud integration configuration</FormLabel>
+        <TextField
+          id="token"
+          label="Token"
+          helperText="The LoRa Cloud device & application services API token."
+          value={this.state.object.token || ""}
+          onChange={this.onChange}
+          margin="normal"
+          type="password"
+          required
+          fullWidth
+        />
+        <TextField
+          id="server"
+          label="Server"
+          placeholder="https://das.loracloud.com"
+          helperText="When left blank, https://das.loracloud.com is used."
+          value={this.state.object.server || ""}
+          onChange={this.onChange}
+          margin="normal"
+          fullWidth
+        />
+        <TextField
+          id="modemPort"
+          label="Modem FPort"
+          helperText="Uplinks received on this FPort are forwarded as modem uplinks (e.g. 199). Set to 0 to disable."
+          value={this.state.object.modemPort || 0}
+          onChange={this.onChange}
+          margin="normal"
+          type="number"
+          fullWidth
+        />
+        <TextField
+          id="gnssPort"
+          label="GNSS FPort"
+          helperText="Uplinks received on this FPort are forwarded as GNSS scans. Set to 0 to disable."
+          value={this.state.object.gnssPort || 0}
+          onChange={this.onChange}
+          margin="normal"
+          type="number"
+          fullWidth
+        />
+        <TextField
+          id="wifiPort"
+          label="WiFi FPort"
+          helperText="Uplinks received on this FPort are forwarded as WiFi scans. Set to 0 to disable."
+          value={this.state.object.wifiPort || 0}
+          onChange={this.onChange}
+          margin="normal"
+          type="number"
+          fullWidth
+        />
+      </FormControl>
+    );
+  }
+}
+
+LoRaCloudIntegrationForm = withStyles(styles)(LoRaCloudIntegrationForm);
+
+
 class IntegrationForm extends FormComponent {
   constructor() {
     super();
@@ -313,6 +593,10 @@ class IntegrationForm extends FormComponent {
     const kindOptions = [
       {value: "http", label: "HTTP integration"},
       {value: "influxdb", label: "InfluxDB integration"},
+      {value: "azure", label: "Azure integration"},
+      {value: "thingsboard", label: "ThingsBoard.io integration"},
+      {value: "my_devices", label: "myDevices integration"},
+      {value: "lora_cloud", label: "LoRa Cloud integration"},
     ];
 
     callbackFunc(kindOptions);
@@ -340,6 +624,10 @@ class IntegrationForm extends FormComponent {
         </FormControl>}
         {this.state.object.kind === "http" && <HTTPIntegrationForm object={this.state.object} onChange={this.onFormChange} />}
         {this.state.object.kind === "influxdb" && <InfluxDBIntegrationForm object={this.state.object} onChange={this.onFormChange} />}
+        {this.state.object.kind === "azure" && <AzureIntegrationForm object={this.state.object} onChange={this.onFormChange} />}
+        {this.state.object.kind === "thingsboard" && <ThingsBoardIntegrationForm object={this.state.object} onChange={this.onFormChange} />}
+        {this.state.object.kind === "my_devices" && <MyDevicesIntegrationForm object={this.state.object} onChange={this.onFormChange} />}
+        {this.state.object.kind === "lora_cloud" && <LoRaCloudIntegrationForm object={this.state.object} onChange={this.onFormChange} />}
       </Form>
     );
   }
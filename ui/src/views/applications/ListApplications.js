@@ -4,6 +4,7 @@ import Grid from "@material-ui/core/Grid";
 import TableCell from "@material-ui/core/TableCell";
 import TableRow from "@material-ui/core/TableRow";
 
+import Archive from "mdi-material-ui/Archive";
 import Plus from "mdi-material-ui/Plus";
 
 import TitleBar from "../../components/TitleBar";
@@ -18,19 +19,29 @@ import ApplicationStore from "../../stores/ApplicationStore";
 class ListApplications extends Component {
   constructor() {
     super();
+    this.state = {
+      includeArchived: false,
+    };
     this.getPage = this.getPage.bind(this);
     this.getRow = this.getRow.bind(this);
+    this.toggleArchived = this.toggleArchived.bind(this);
   }
 
   getPage(limit, offset, callbackFunc) {
-    ApplicationStore.list("", this.props.match.params.organizationID, limit, offset, callbackFunc);
+    ApplicationStore.list("", this.props.match.params.organizationID, limit, offset, callbackFunc, this.state.includeArchived);
+  }
+
+  toggleArchived() {
+    this.setState({
+      includeArchived: !this.state.includeArchived,
+    });
   }
 
   getRow(obj) {
     return(
       <TableRow key={obj.id}>
         <TableCell>{obj.id}</TableCell>
-        <TableCellLink to={`/organizations/${this.props.match.params.organizationID}/applications/${obj.id}`}>{obj.name}</TableCellLink>
+        <TableCellLink to={`/organizations/${this.props.match.params.organizationID}/applications/${obj.id}`}>{obj.name}{obj.archived && " (archived)"}</TableCellLink>
         <TableCellLink to={`/organizations/${this.props.match.params.organizationID}/service-profiles/${obj.serviceProfileID}`}>{obj.serviceProfileName}</TableCellLink>
         <TableCell>{obj.description}</TableCell>
       </TableRow>
@@ -41,15 +52,21 @@ class ListApplications extends Component {
     return(
       <Grid container spacing={24}>
         <TitleBar
-          buttons={
-            <Admin organizationID={this.props.match.params.organizationID}>
+          buttons={[
+            <TitleBarButton
+              key={1}
+              label={this.state.includeArchived ? "Hide archived" : "Show archived"}
+              icon={<Archive />}
+              onClick={this.toggleArchived}
+            />,
+            <Admin key={2} organizationID={this.props.match.params.organizationID}>
               <TitleBarButton
                 label="Create"
                 icon={<Plus />}
                 to={`/organizations/${this.props.match.params.organizationID}/applications/create`}
               />
-            </Admin>
-          }
+            </Admin>,
+          ]}
         >
           <TitleBarTitle title="Applications" />
         </TitleBar>
@@ -42,9 +42,20 @@ class ListIntegrations extends Component {
   getRow(obj) {
     const kind = obj.kind.toLowerCase();
 
+    // inherited integrations are configured at the organization level
+    if (obj.inherited) {
+      return(
+        <TableRow key={obj.kind}>
+          <TableCell>{obj.kind}</TableCell>
+          <TableCell>Organization</TableCell>
+        </TableRow>
+      );
+    }
+
     return(
       <TableRow key={obj.kind}>
         <TableCellLink to={`/organizations/${this.props.match.params.organizationID}/applications/${this.props.match.params.applicationID}/integrations/${kind}`}>{obj.kind}</TableCellLink>
+        <TableCell>Application</TableCell>
       </TableRow>
     );
   }
@@ -63,6 +74,7 @@ class ListIntegrations extends Component {
             header={
               <TableRow>
                 <TableCell>Kind</TableCell>
+                <TableCell>Configured for</TableCell>
               </TableRow>
             }
             getPage={this.getPage}
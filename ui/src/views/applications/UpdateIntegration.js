@@ -48,6 +48,46 @@ class UpdateIntegration extends Component {
           });
         });
         break;
+      case "azure":
+        ApplicationStore.getAzureIntegration(this.props.match.params.applicationID, resp => {
+          let integration = resp.integration;
+          integration.kind = "azure";
+
+          this.setState({
+            integration: integration,
+          });
+        });
+        break;
+      case "thingsboard":
+        ApplicationStore.getThingsBoardIntegration(this.props.match.params.applicationID, resp => {
+          let integration = resp.integration;
+          integration.kind = "thingsboard";
+
+          this.setState({
+            integration: integration,
+          });
+        });
+        break;
+      case "my_devices":
+        ApplicationStore.getMyDevicesIntegration(this.props.match.params.applicationID, resp => {
+          let integration = resp.integration;
+          integration.kind = "my_devices";
+
+          this.setState({
+            integration: integration,
+          });
+        });
+        break;
+      case "lora_cloud":
+        ApplicationStore.getLoRaCloudIntegration(this.props.match.params.applicationID, resp => {
+          let integration = resp.integration;
+          integration.kind = "lora_cloud";
+
+          this.setState({
+            integration: integration,
+          });
+        });
+        break;
       default:
         break;
     }
@@ -65,6 +105,26 @@ class UpdateIntegration extends Component {
           this.props.history.push(`/organizations/${this.props.match.params.organizationID}/applications/${this.props.match.params.applicationID}/integrations`);
         });
         break;
+      case "azure":
+        ApplicationStore.updateAzureIntegration(integration, resp => {
+          this.props.history.push(`/organizations/${this.props.match.params.organizationID}/applications/${this.props.match.params.applicationID}/integrations`);
+        });
+        break;
+      case "thingsboard":
+        ApplicationStore.updateThingsBoardIntegration(integration, resp => {
+          this.props.history.push(`/organizations/${this.props.match.params.organizationID}/applications/${this.props.match.params.applicationID}/integrations`);
+        });
+        break;
+      case "my_devices":
+        ApplicationStore.updateMyDevicesIntegration(integration, resp => {
+          this.props.history.push(`/organizations/${this.props.match.params.organizationID}/applications/${this.props.match.params.applicationID}/integrations`);
+        });
+        break;
+      case "lora_cloud":
+        ApplicationStore.updateLoRaCloudIntegration(integration, resp => {
+          this.props.history.push(`/organizations/${this.props.match.params.organizationID}/applications/${this.props.match.params.applicationID}/integrations`);
+        });
+        break;
       default:
         break;
     }
@@ -83,6 +143,26 @@ class UpdateIntegration extends Component {
             this.props.history.push(`/organizations/${this.props.match.params.organizationID}/applications/${this.props.match.params.applicationID}/integrations`);
           });
           break;
+        case "azure":
+          ApplicationStore.deleteAzureIntegration(this.props.match.params.applicationID, resp => {
+            this.props.history.push(`/organizations/${this.props.match.params.organizationID}/applications/${this.props.match.params.applicationID}/integrations`);
+          });
+          break;
+        case "thingsboard":
+          ApplicationStore.deleteThingsBoardIntegration(this.props.match.params.applicationID, resp => {
+            this.props.history.push(`/organizations/${this.props.match.params.organizationID}/applications/${this.props.match.params.applicationID}/integrations`);
+          });
+          break;
+        case "my_devices":
+          ApplicationStore.deleteMyDevicesIntegration(this.props.match.params.applicationID, resp => {
+            this.props.history.push(`/organizations/${this.props.match.params.organizationID}/applications/${this.props.match.params.applicationID}/integrations`);
+          });
+          break;
+        case "lora_cloud":
+          ApplicationStore.deleteLoRaCloudIntegration(this.props.match.params.applicationID, resp => {
+            this.props.history.push(`/organizations/${this.props.match.params.organizationID}/applications/${this.props.match.params.applicationID}/integrations`);
+          });
+          break;
         default:
           break;
       }
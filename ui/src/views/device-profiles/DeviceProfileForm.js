@@ -96,6 +96,26 @@ class DeviceProfileForm extends FormComponent {
         object: object,
       });
     }
+
+    if (e.target.id === "fPortsStr") {
+      let object = this.state.object;
+      let descriptions = {};
+      for (const p of object.fPorts || []) {
+        descriptions[p.fPort] = p.description;
+      }
+
+      object["fPorts"] = e.target.value.split(",").filter(p => p.trim() !== "").map((p, i) => {
+        const [fPort, label] = p.split(":");
+        return {
+          fPort: parseInt(fPort, 10),
+          label: (label || "").trim(),
+          description: descriptions[parseInt(fPort, 10)] || "",
+        };
+      });
+      this.setState({
+        object: object,
+      });
+    }
   }
 
   render() {
@@ -110,6 +130,13 @@ class DeviceProfileForm extends FormComponent {
       factoryPresetFreqsStr = this.state.object.factoryPresetFreqs.join(", ");
     }
 
+    let fPortsStr = "";
+    if (this.state.object.fPortsStr !== undefined) {
+      fPortsStr = this.state.object.fPortsStr;
+    } else if (this.state.object.fPorts !== undefined) {
+      fPortsStr = this.state.object.fPorts.map((p, i) => `${p.fPort}:${p.label}`).join(", ");
+    }
+
     return(
       <Form
         submitLabel={this.props.submitLabel}
@@ -121,6 +148,7 @@ class DeviceProfileForm extends FormComponent {
           <Tab label="Join (OTAA / ABP)" />
           <Tab label="Class-B" />
           <Tab label="Class-C" />
+          <Tab label="FPorts" />
         </Tabs>
 
         {this.state.tab === 0 && <div>
@@ -344,6 +372,18 @@ class DeviceProfileForm extends FormComponent {
             fullWidth
           />}
         </div>}
+
+        {this.state.tab === 4 && <div>
+          <TextField
+            id="fPortsStr"
+            label="Expected uplink FPorts"
+            margin="normal"
+            value={fPortsStr}
+            onChange={this.onChange}
+            helperText="List of expected uplink FPorts and their label (fPort:label), comma separated, e.g. 1:telemetry, 2:config-ack, 201:fuota. Uplinks on other FPorts generate a warning. Leave blank to accept all FPorts."
+            fullWidth
+          />
+        </div>}
       </Form>
     );
   }
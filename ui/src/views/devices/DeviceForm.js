@@ -1,6 +1,7 @@
 import React from "react";
 
 import { withStyles } from "@material-ui/core/styles";
+import Grid from "@material-ui/core/Grid";
 import TextField from '@material-ui/core/TextField';
 import FormControl from "@material-ui/core/FormControl";
 import FormControlLabel from "@material-ui/core/FormControlLabel";
@@ -8,25 +9,152 @@ import FormLabel from "@material-ui/core/FormLabel";
 import FormHelperText from "@material-ui/core/FormHelperText";
 import Checkbox from "@material-ui/core/Checkbox";
 import FormGroup from "@material-ui/core/FormGroup";
+import IconButton from '@material-ui/core/IconButton';
+import Button from "@material-ui/core/Button";
+
+import Delete from "mdi-material-ui/Delete";
 
 import FormComponent from "../../classes/FormComponent";
 import Form from "../../components/Form";
 import AutocompleteSelect from "../../components/AutocompleteSelect";
 import DeviceProfileStore from "../../stores/DeviceProfileStore";
+import theme from "../../theme";
 
 
 const styles = {
   formLabel: {
     fontSize: 12,
   },
+  delete: {
+    marginTop: 3 * theme.spacing.unit,
+  },
 };
 
 
+class DeviceVariableForm extends FormComponent {
+  constructor() {
+    super();
+
+    this.onDelete = this.onDelete.bind(this);
+  }
+
+  onChange(e) {
+    super.onChange(e);
+    this.props.onChange(this.props.index, this.state.object);
+  }
+
+  onDelete(e) {
+    e.preventDefault();
+    this.props.onDelete(this.props.index);
+  }
+
+  render() {
+    if (this.state.object === undefined) {
+      return(<div></div>);
+    }
+
+    return(
+      <Grid container spacing={24}>
+        <Grid item xs={4}>
+          <TextField
+            id="key"
+            label="Name"
+            margin="normal"
+            value={this.state.object.key || ""}
+            onChange={this.onChange}
+            fullWidth
+          />
+        </Grid>
+        <Grid item xs={7}>
+          <TextField
+            id="value"
+            label="Value"
+            margin="normal"
+            value={this.state.object.value || ""}
+            onChange={this.onChange}
+            fullWidth
+          />
+        </Grid>
+        <Grid item xs={1} className={this.props.classes.delete}>
+          <IconButton aria-label="delete" onClick={this.onDelete}>
+            <Delete />
+          </IconButton>
+        </Grid>
+      </Grid>
+    );
+  }
+}
+
+DeviceVariableForm = withStyles(styles)(DeviceVariableForm);
+
+
 class DeviceForm extends FormComponent {
   constructor() {
     super();
     this.getDeviceProfileOption = this.getDeviceProfileOption.bind(this);
     this.getDeviceProfileOptions = this.getDeviceProfileOptions.bind(this);
+    this.addVariable = this.addVariable.bind(this);
+    this.onChangeVariable = this.onChangeVariable.bind(this);
+    this.onDeleteVariable = this.onDeleteVariable.bind(this);
+  }
+
+  componentDidMount() {
+    super.componentDidMount();
+    this.setVariables(this.props.object);
+  }
+
+  componentDidUpdate(prevProps) {
+    super.componentDidUpdate(prevProps);
+    if (prevProps.object !== this.props.object) {
+      this.setVariables(this.props.object);
+    }
+  }
+
+  setVariables(object) {
+    const variables = (object || {}).variables || {};
+    this.setState({
+      variables: Object.keys(variables).map(key => {return {key: key, value: variables[key]}}),
+    });
+  }
+
+  addVariable(e) {
+    e.preventDefault();
+
+    let variables = this.state.variables;
+    variables.push({});
+    this.setState({
+      variables: variables,
+    });
+  }
+
+  onChangeVariable(index, variable) {
+    let variables = this.state.variables;
+    variables[index] = variable;
+    this.setState({
+      variables: variables,
+    });
+  }
+
+  onDeleteVariable(index) {
+    let variables = this.state.variables;
+    variables.splice(index, 1);
+    this.setState({
+      variables: variables,
+    });
+  }
+
+  onSubmit(e) {
+    e.preventDefault();
+
+    let object = this.state.object;
+    object.variables = {};
+    for (const v of this.state.variables) {
+      if (v.key !== undefined && v.key !== "") {
+        object.variables[v.key] = v.value || "";
+      }
+    }
+
+    this.props.onSubmit(object);
   }
 
   getDeviceProfileOption(id, callbackFunc) {
@@ -43,10 +171,12 @@ class DeviceForm extends FormComponent {
   }
 
   render() {
-    if (this.state.object === undefined) {
+    if (this.state.object === undefined || this.state.variables === undefined) {
       return(<div></div>);
     }
 
+    const variables = this.state.variables.map((v, i) => <DeviceVariableForm key={i} index={i} object={v} onChange={this.onChangeVariable} onDelete={this.onDeleteVariable} />);
+
     return(
       <Form
         submitLabel={this.props.submitLabel}
@@ -117,6 +247,32 @@ class DeviceForm extends FormComponent {
             Note that disabling the frame-counter validation will compromise security as it enables people to perform replay-attacks.
           </FormHelperText>
         </FormControl>
+        <FormControl margin="normal">
+          <FormGroup>
+            <FormControlLabel
+              label="Suppress frame-counter anomaly events"
+              control={
+                <Checkbox
+                  id="suppressFCntAnomalies"
+                  checked={!!this.state.object.suppressFCntAnomalies}
+                  onChange={this.onChange}
+                  color="primary"
+                />
+              }
+            />
+          </FormGroup>
+          <FormHelperText>
+            When checked, no events are emitted for large frame-counter jumps or resets of this device (e.g. for devices which are known to reset their frame-counter).
+          </FormHelperText>
+        </FormControl>
+        <FormControl fullWidth margin="normal">
+          <FormLabel className={this.props.classes.formLabel}>Variables</FormLabel>
+          {variables}
+          <FormHelperText>
+            Variables are user-defined key / value pairs, e.g. the 'ThingsBoardAccessToken' used by the ThingsBoard integration.
+          </FormHelperText>
+        </FormControl>
+        <Button variant="outlined" onClick={this.addVariable}>Add variable</Button>
       </Form>
     );
   }
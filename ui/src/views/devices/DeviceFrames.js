@@ -132,7 +132,7 @@ class DeviceFrames extends Component {
           rxInfo: frame.uplinkFrame.rxInfo,
           txInfo: frame.uplinkFrame.txInfo,
         },
-        phyPayload: JSON.parse(frame.uplinkFrame.phyPayloadJSON),
+        phyPayload: this.getPhyPayload(frame.uplinkFrame),
       });
     }
 
@@ -143,7 +143,7 @@ class DeviceFrames extends Component {
         downlinkMetaData: {
           txInfo: frame.downlinkFrame.txInfo,
         },
-        phyPayload: JSON.parse(frame.downlinkFrame.phyPayloadJSON),
+        phyPayload: this.getPhyPayload(frame.downlinkFrame),
       });
     }
 
@@ -154,6 +154,15 @@ class DeviceFrames extends Component {
     });
   }
 
+  // frames which could not be decoded by LoRa App Server only contain the
+  // raw (base64 encoded) PHYPayload
+  getPhyPayload(frame) {
+    if (frame.phyPayloadJSON) {
+      return JSON.parse(frame.phyPayloadJSON);
+    }
+    return { raw: frame.phyPayload };
+  }
+
   render() {
     const frames = this.state.frames.map((frame, i) => <LoRaWANFrameLog key={frame.id} frame={frame} />);
 
@@ -131,7 +131,7 @@ class GatewayFrames extends Component {
           rxInfo: frame.uplinkFrame.rxInfo,
           txInfo: frame.uplinkFrame.txInfo,
         },
-        phyPayload: JSON.parse(frame.uplinkFrame.phyPayloadJSON),
+        phyPayload: this.getPhyPayload(frame.uplinkFrame),
       });
     }
 
@@ -142,7 +142,7 @@ class GatewayFrames extends Component {
         downlinkMetaData: {
           txInfo: frame.downlinkFrame.txInfo,
         },
-        phyPayload: JSON.parse(frame.downlinkFrame.phyPayloadJSON),
+        phyPayload: this.getPhyPayload(frame.downlinkFrame),
       });
     }
 
@@ -153,6 +153,15 @@ class GatewayFrames extends Component {
     });
   }
 
+  // frames which could not be decoded by LoRa App Server only contain the
+  // raw (base64 encoded) PHYPayload
+  getPhyPayload(frame) {
+    if (frame.phyPayloadJSON) {
+      return JSON.parse(frame.phyPayloadJSON);
+    }
+    return { raw: frame.phyPayload };
+  }
+
   render() {
     const frames = this.state.frames.map((frame, i) => <LoRaWANFrameLog key={frame.id} frame={frame} />);
 
@@ -95,6 +95,39 @@ class AssignUserForm extends FormComponent {
               />
             }
           />
+          <FormControlLabel
+            label="Can view device keys"
+            control={
+              <Checkbox
+                id="canViewKeys"
+                checked={!!this.state.object.canViewKeys}
+                onChange={this.onChange}
+                color="primary"
+              />
+            }
+          />
+          <FormControlLabel
+            label="Can export data"
+            control={
+              <Checkbox
+                id="canExport"
+                checked={!!this.state.object.canExport}
+                onChange={this.onChange}
+                color="primary"
+              />
+            }
+          />
+          <FormControlLabel
+            label="Is auditor (read-only)"
+            control={
+              <Checkbox
+                id="isAuditor"
+                checked={!!this.state.object.isAuditor}
+                onChange={this.onChange}
+                color="primary"
+              />
+            }
+          />
         </FormGroup>
       </Form>
     );
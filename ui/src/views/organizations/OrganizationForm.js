@@ -64,6 +64,36 @@ class OrganizationForm extends FormComponent {
           </FormGroup>
           <FormHelperText>When checked, it means that organization administrators are able to add their own gateways to the network. Note that the usage of the gateways is not limited to this organization.</FormHelperText>
         </FormControl>
+        <FormControl
+          label="Quotas"
+        >
+          <TextField
+            id="maxDeviceCount"
+            label="Max. number of devices"
+            helperText="The maximum number of devices within this organization (0 = unlimited)."
+            margin="normal"
+            type="number"
+            value={this.state.object.maxDeviceCount || 0}
+            onChange={this.onChange}
+            inputProps={{
+              min: 0,
+            }}
+            fullWidth
+          />
+          <TextField
+            id="maxGatewayCount"
+            label="Max. number of gateways"
+            helperText="The maximum number of gateways within this organization (0 = unlimited)."
+            margin="normal"
+            type="number"
+            value={this.state.object.maxGatewayCount || 0}
+            onChange={this.onChange}
+            inputProps={{
+              min: 0,
+            }}
+            fullWidth
+          />
+        </FormControl>
       </Form>
     );
   }
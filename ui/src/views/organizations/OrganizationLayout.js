@@ -1,7 +1,10 @@
 import React, { Component } from "react";
-import { Route, Redirect, Switch, withRouter } from "react-router-dom";
+import { Route, Redirect, Switch, Link, withRouter } from "react-router-dom";
 
+import { withStyles } from "@material-ui/core/styles";
 import Grid from '@material-ui/core/Grid';
+import Tabs from '@material-ui/core/Tabs';
+import Tab from '@material-ui/core/Tab';
 
 import Delete from "mdi-material-ui/Delete";
 
@@ -10,18 +13,35 @@ import TitleBarTitle from "../../components/TitleBarTitle";
 import TitleBarButton from "../../components/TitleBarButton";
 import OrganizationStore from "../../stores/OrganizationStore";
 import UpdateOrganization from "./UpdateOrganization";
+import UpdateOrganizationSettings from "./UpdateOrganizationSettings";
+
+import theme from "../../theme";
+
+
+const styles = {
+  tabs: {
+    borderBottom: "1px solid " + theme.palette.divider,
+    height: "48px",
+    overflow: "visible",
+  },
+};
 
 
 class OrganizationLayout extends Component {
   constructor() {
     super();
-    this.state = {};
+    this.state = {
+      tab: 0,
+    };
     this.loadData = this.loadData.bind(this);
     this.deleteOrganization = this.deleteOrganization.bind(this);
+    this.locationToTab = this.locationToTab.bind(this);
+    this.onChangeTab = this.onChangeTab.bind(this);
   }
 
   componentDidMount() {
     this.loadData();
+    this.locationToTab();
   }
 
   componentDidUpdate(prevProps) {
@@ -30,6 +50,25 @@ class OrganizationLayout extends Component {
     }
 
     this.loadData();
+    this.locationToTab();
+  }
+
+  locationToTab() {
+    let tab = 0;
+
+    if (window.location.href.endsWith("/settings")) {
+      tab = 1;
+    }
+
+    this.setState({
+      tab: tab,
+    });
+  }
+
+  onChangeTab(e, v) {
+    this.setState({
+      tab: v,
+    });
   }
 
   loadData() {
@@ -72,10 +111,24 @@ class OrganizationLayout extends Component {
           <TitleBarTitle title={this.state.organization.organization.name} />
         </TitleBar>
 
+        <Grid item xs={12}>
+          <Tabs
+            value={this.state.tab}
+            onChange={this.onChangeTab}
+            indicatorColor="primary"
+            className={this.props.classes.tabs}
+            fullWidth
+          >
+            <Tab label="Organization configuration" component={Link} to={`${this.props.match.url}/edit`} />
+            <Tab label="Settings" component={Link} to={`${this.props.match.url}/settings`} />
+          </Tabs>
+        </Grid>
+
         <Grid item xs={12}>
           <Switch>
             <Route exact path={this.props.match.path} render={() => <Redirect to={`${this.props.match.url}/edit`} />} />
             <Route exact path={`${this.props.match.path}/edit`} render={props => <UpdateOrganization organization={this.state.organization.organization} {...props} />} />
+            <Route exact path={`${this.props.match.path}/settings`} render={props => <UpdateOrganizationSettings {...props} />} />
           </Switch>
         </Grid>
       </Grid>
@@ -84,4 +137,4 @@ class OrganizationLayout extends Component {
 }
 
 
-export default withRouter(OrganizationLayout);
+export default withStyles(styles)(withRouter(OrganizationLayout));
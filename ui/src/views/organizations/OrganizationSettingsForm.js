@@ -0,0 +1,248 @@
+import React from "react";
+
+import { withStyles } from "@material-ui/core/styles";
+import Grid from "@material-ui/core/Grid";
+import TextField from '@material-ui/core/TextField';
+import FormControl from "@material-ui/core/FormControl";
+import FormLabel from "@material-ui/core/FormLabel";
+import FormHelperText from "@material-ui/core/FormHelperText";
+import IconButton from '@material-ui/core/IconButton';
+import Button from "@material-ui/core/Button";
+
+import Delete from "mdi-material-ui/Delete";
+
+import FormComponent from "../../classes/FormComponent";
+import Form from "../../components/Form";
+import AutocompleteSelect from "../../components/AutocompleteSelect";
+import theme from "../../theme";
+
+
+const styles = {
+  delete: {
+    marginTop: 3 * theme.spacing.unit,
+  },
+  formLabel: {
+    fontSize: 12,
+  },
+};
+
+
+class OrganizationCustomLinkForm extends FormComponent {
+  constructor() {
+    super();
+
+    this.onDelete = this.onDelete.bind(this);
+  }
+
+  onChange(e) {
+    super.onChange(e);
+    this.props.onChange(this.props.index, this.state.object);
+  }
+
+  onDelete(e) {
+    e.preventDefault();
+    this.props.onDelete(this.props.index);
+  }
+
+  render() {
+    if (this.state.object === undefined) {
+      return(<div></div>);
+    }
+
+    return(
+      <Grid container spacing={24}>
+        <Grid item xs={4}>
+          <TextField
+            id="title"
+            label="Title"
+            margin="normal"
+            value={this.state.object.title || ""}
+            onChange={this.onChange}
+            required
+            fullWidth
+          />
+        </Grid>
+        <Grid item xs={7}>
+          <TextField
+            id="url"
+            label="URL"
+            margin="normal"
+            type="url"
+            value={this.state.object.url || ""}
+            onChange={this.onChange}
+            required
+            fullWidth
+          />
+        </Grid>
+        <Grid item xs={1} className={this.props.classes.delete}>
+          <IconButton aria-label="delete" onClick={this.onDelete}>
+            <Delete />
+          </IconButton>
+        </Grid>
+      </Grid>
+    );
+  }
+}
+
+OrganizationCustomLinkForm = withStyles(styles)(OrganizationCustomLinkForm);
+
+
+class OrganizationSettingsForm extends FormComponent {
+  constructor() {
+    super();
+    this.addCustomLink = this.addCustomLink.bind(this);
+    this.onDeleteCustomLink = this.onDeleteCustomLink.bind(this);
+    this.onChangeCustomLink = this.onChangeCustomLink.bind(this);
+  }
+
+  getRegionOptions(search, callbackFunc) {
+    const regionOptions = [
+      {value: "AS_923", label: "AS923"},
+      {value: "AU_915_928", label: "AU915-928"},
+      {value: "CN_470_510", label: "CN470-510"},
+      {value: "CN_779_787", label: "CN779-787"},
+      {value: "EU_433", label: "EU433"},
+      {value: "EU_863_870", label: "EU863-870"},
+      {value: "IN_865_867", label: "IN865-867"},
+      {value: "KR_920_923", label: "KR920-923"},
+      {value: "RU_864_870", label: "RU864-870"},
+      {value: "US_902_928", label: "US902-928"},
+    ];
+
+    callbackFunc(regionOptions);
+  }
+
+  addCustomLink(e) {
+    e.preventDefault();
+
+    let object = this.state.object;
+    if (object.customLinks === undefined) {
+      object.customLinks = [{}];
+    } else {
+      object.customLinks.push({});
+    }
+
+    this.setState({
+      object: object,
+    });
+  }
+
+  onDeleteCustomLink(index) {
+    let object = this.state.object;
+    object.customLinks.splice(index, 1);
+    this.setState({
+      object: object,
+    });
+  }
+
+  onChangeCustomLink(index, link) {
+    let object = this.state.object;
+    object.customLinks[index] = link;
+    this.setState({
+      object: object,
+    });
+  }
+
+  render() {
+    if (this.state.object === undefined) {
+      return(<div></div>);
+    }
+
+    let customLinks = [];
+    if (this.state.object.customLinks !== undefined) {
+      customLinks = this.state.object.customLinks.map((l, i) => <OrganizationCustomLinkForm key={i} index={i} object={l} onChange={this.onChangeCustomLink} onDelete={this.onDeleteCustomLink} />);
+    }
+
+    return(
+      <Form
+        submitLabel={this.props.submitLabel}
+        onSubmit={this.onSubmit}
+      >
+        <FormControl fullWidth margin="normal">
+          <FormLabel>Branding</FormLabel>
+          <TextField
+            id="logoURL"
+            label="Logo URL"
+            margin="normal"
+            type="url"
+            value={this.state.object.logoURL || ""}
+            onChange={this.onChange}
+            fullWidth
+          />
+          <TextField
+            id="primaryColor"
+            label="Primary color"
+            helperText="Color in #rrggbb format."
+            margin="normal"
+            value={this.state.object.primaryColor || ""}
+            onChange={this.onChange}
+            inputProps={{
+              pattern: "#[0-9a-fA-F]{6}",
+            }}
+            fullWidth
+          />
+          <TextField
+            id="secondaryColor"
+            label="Secondary color"
+            helperText="Color in #rrggbb format."
+            margin="normal"
+            value={this.state.object.secondaryColor || ""}
+            onChange={this.onChange}
+            inputProps={{
+              pattern: "#[0-9a-fA-F]{6}",
+            }}
+            fullWidth
+          />
+        </FormControl>
+        <FormControl fullWidth margin="normal">
+          <FormLabel className={this.props.classes.formLabel}>Default region</FormLabel>
+          <AutocompleteSelect
+            id="defaultRegion"
+            label="Select default region"
+            value={this.state.object.defaultRegion || ""}
+            onChange={this.onChange}
+            getOptions={this.getRegionOptions}
+          />
+          <FormHelperText>
+            The region used by default within this organization.
+          </FormHelperText>
+        </FormControl>
+        <FormControl fullWidth margin="normal">
+          <FormLabel>Contact</FormLabel>
+          <TextField
+            id="contactName"
+            label="Name"
+            margin="normal"
+            value={this.state.object.contactName || ""}
+            onChange={this.onChange}
+            fullWidth
+          />
+          <TextField
+            id="contactEmail"
+            label="E-mail address"
+            margin="normal"
+            type="email"
+            value={this.state.object.contactEmail || ""}
+            onChange={this.onChange}
+            fullWidth
+          />
+          <TextField
+            id="contactPhone"
+            label="Phone number"
+            margin="normal"
+            value={this.state.object.contactPhone || ""}
+            onChange={this.onChange}
+            fullWidth
+          />
+        </FormControl>
+        <FormControl fullWidth margin="normal">
+          <FormLabel>Custom links</FormLabel>
+          {customLinks}
+        </FormControl>
+        <Button variant="outlined" onClick={this.addCustomLink}>Add link</Button>
+      </Form>
+    );
+  }
+}
+
+export default withStyles(styles)(OrganizationSettingsForm);
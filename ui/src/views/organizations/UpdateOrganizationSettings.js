@@ -0,0 +1,56 @@
+import React, { Component } from "react";
+import { withRouter } from 'react-router-dom';
+
+import Grid from '@material-ui/core/Grid';
+import Card from '@material-ui/core/Card';
+import CardContent from "@material-ui/core/CardContent";
+
+import OrganizationStore from "../../stores/OrganizationStore";
+import OrganizationSettingsForm from "./OrganizationSettingsForm";
+
+
+class UpdateOrganizationSettings extends Component {
+  constructor() {
+    super();
+    this.state = {};
+    this.onSubmit = this.onSubmit.bind(this);
+  }
+
+  componentDidMount() {
+    OrganizationStore.getSettings(this.props.match.params.organizationID, resp => {
+      this.setState({
+        settings: resp.settings,
+      });
+    });
+  }
+
+  onSubmit(settings) {
+    OrganizationStore.updateSettings(settings, resp => {
+      this.props.history.push("/organizations");
+    });
+  }
+
+  render() {
+    if (this.state.settings === undefined) {
+      return(<div></div>);
+    }
+
+    return(
+      <Grid container spacing={24}>
+        <Grid item xs={12}>
+          <Card>
+            <CardContent>
+              <OrganizationSettingsForm
+                submitLabel="Update settings"
+                object={this.state.settings}
+                onSubmit={this.onSubmit}
+              />
+            </CardContent>
+          </Card>
+        </Grid>
+      </Grid>
+    );
+  }
+}
+
+export default withRouter(UpdateOrganizationSettings);
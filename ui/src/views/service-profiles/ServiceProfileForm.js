@@ -167,6 +167,45 @@ class ServiceProfileForm extends FormComponent {
           fullWidth
           required
         />
+        <TextField
+          id="maxDeviceCount"
+          label="Max. number of devices"
+          margin="normal"
+          type="number"
+          value={this.state.object.maxDeviceCount || 0}
+          onChange={this.onChange}
+          helperText="The maximum number of devices using this service-profile (0 = unlimited)."
+          inputProps={{
+            min: 0,
+          }}
+          fullWidth
+        />
+        <TextField
+          id="maxUplinkRate"
+          label="Max. uplink rate (uplinks/hour)"
+          margin="normal"
+          type="number"
+          value={this.state.object.maxUplinkRate || 0}
+          onChange={this.onChange}
+          helperText="The maximum number of uplinks per device per hour, uplinks exceeding this rate are dropped by the application-server (0 = unlimited)."
+          inputProps={{
+            min: 0,
+          }}
+          fullWidth
+        />
+        <TextField
+          id="maxPayloadSize"
+          label="Max. payload size (bytes)"
+          margin="normal"
+          type="number"
+          value={this.state.object.maxPayloadSize || 0}
+          onChange={this.onChange}
+          helperText="The maximum uplink payload size, larger uplinks are dropped by the application-server (0 = unlimited)."
+          inputProps={{
+            min: 0,
+          }}
+          fullWidth
+        />
       </Form>
     );
   }
@@ -0,0 +1,192 @@
+import React, { Component } from "react";
+
+import Grid from '@material-ui/core/Grid';
+import TableCell from '@material-ui/core/TableCell';
+import TableRow from '@material-ui/core/TableRow';
+import IconButton from '@material-ui/core/IconButton';
+
+import moment from "moment";
+import Check from "mdi-material-ui/Check";
+import Close from "mdi-material-ui/Close";
+import Delete from "mdi-material-ui/Delete";
+import FilterVariant from "mdi-material-ui/FilterVariant";
+import Plus from "mdi-material-ui/Plus";
+
+import TitleBar from "../../components/TitleBar";
+import TitleBarTitle from "../../components/TitleBarTitle";
+import TableCellLink from "../../components/TableCellLink";
+import TitleBarButton from "../../components/TitleBarButton";
+import DataTable from "../../components/DataTable";
+
+import RegistrationStore from "../../stores/RegistrationStore";
+
+
+class ListRegistrations extends Component {
+  constructor() {
+    super();
+    this.state = {
+      pendingOnly: true,
+    };
+
+    this.getPage = this.getPage.bind(this);
+    this.getRow = this.getRow.bind(this);
+    this.getInvitePage = this.getInvitePage.bind(this);
+    this.getInviteRow = this.getInviteRow.bind(this);
+    this.togglePending = this.togglePending.bind(this);
+    this.createInvite = this.createInvite.bind(this);
+    this.refresh = this.refresh.bind(this);
+  }
+
+  componentDidMount() {
+    RegistrationStore.on("change", this.refresh);
+  }
+
+  componentWillUnmount() {
+    RegistrationStore.removeListener("change", this.refresh);
+  }
+
+  refresh() {
+    this.forceUpdate();
+  }
+
+  getPage(limit, offset, callbackFunc) {
+    RegistrationStore.list(this.state.pendingOnly, limit, offset, callbackFunc);
+  }
+
+  getInvitePage(limit, offset, callbackFunc) {
+    RegistrationStore.listInvites(limit, offset, callbackFunc);
+  }
+
+  togglePending() {
+    this.setState({
+      pendingOnly: !this.state.pendingOnly,
+    });
+  }
+
+  createInvite() {
+    RegistrationStore.createInvite("", resp => {});
+  }
+
+  approve(id) {
+    if (window.confirm("Are you sure you want to approve this registration?")) {
+      RegistrationStore.approve(id, resp => {});
+    }
+  }
+
+  reject(id) {
+    if (window.confirm("Are you sure you want to reject this registration? This will delete the user and organization.")) {
+      RegistrationStore.reject(id, resp => {});
+    }
+  }
+
+  deleteInvite(id) {
+    if (window.confirm("Are you sure you want to delete this invite?")) {
+      RegistrationStore.deleteInvite(id, resp => {});
+    }
+  }
+
+  getRow(obj) {
+    let actions = null;
+    if (!obj.approved) {
+      actions = <div>
+        <IconButton aria-label="approve" onClick={() => this.approve(obj.id)}>
+          <Check />
+        </IconButton>
+        <IconButton aria-label="reject" onClick={() => this.reject(obj.id)}>
+          <Close />
+        </IconButton>
+      </div>;
+    }
+
+    return(
+      <TableRow key={obj.id}>
+        <TableCell>{moment(obj.createdAt).format("lll")}</TableCell>
+        <TableCellLink to={`/users/${obj.userID}`}>{obj.username}</TableCellLink>
+        <TableCell>{obj.email}</TableCell>
+        <TableCellLink to={`/organizations/${obj.organizationID}`}>{obj.organizationName}</TableCellLink>
+        <TableCell>{obj.approved ? <Check /> : <Close />}</TableCell>
+        <TableCell>{actions}</TableCell>
+      </TableRow>
+    );
+  }
+
+  getInviteRow(obj) {
+    return(
+      <TableRow key={obj.id}>
+        <TableCell>{obj.token}</TableCell>
+        <TableCell>{obj.email}</TableCell>
+        <TableCell>{obj.expiresAt ? moment(obj.expiresAt).format("lll") : "never"}</TableCell>
+        <TableCell>{obj.usedAt ? moment(obj.usedAt).format("lll") : "-"}</TableCell>
+        <TableCell>
+          <IconButton aria-label="delete" onClick={() => this.deleteInvite(obj.id)}>
+            <Delete />
+          </IconButton>
+        </TableCell>
+      </TableRow>
+    );
+  }
+
+  render() {
+    return(
+      <Grid container spacing={24}>
+        <TitleBar
+          buttons={[
+            <TitleBarButton
+              key={1}
+              label={this.state.pendingOnly ? "Show all" : "Show pending"}
+              icon={<FilterVariant />}
+              onClick={this.togglePending}
+            />,
+          ]}
+        >
+          <TitleBarTitle title="Registrations" />
+        </TitleBar>
+        <Grid item xs={12}>
+          <DataTable
+            header={
+              <TableRow>
+                <TableCell>Registered at</TableCell>
+                <TableCell>Username</TableCell>
+                <TableCell>E-mail</TableCell>
+                <TableCell>Organization</TableCell>
+                <TableCell>Approved</TableCell>
+                <TableCell></TableCell>
+              </TableRow>
+            }
+            getPage={this.getPage}
+            getRow={this.getRow}
+          />
+        </Grid>
+        <TitleBar
+          buttons={[
+            <TitleBarButton
+              key={1}
+              label="Create invite"
+              icon={<Plus />}
+              onClick={this.createInvite}
+            />,
+          ]}
+        >
+          <TitleBarTitle title="Invites" />
+        </TitleBar>
+        <Grid item xs={12}>
+          <DataTable
+            header={
+              <TableRow>
+                <TableCell>Token</TableCell>
+                <TableCell>E-mail</TableCell>
+                <TableCell>Expires at</TableCell>
+                <TableCell>Used at</TableCell>
+                <TableCell></TableCell>
+              </TableRow>
+            }
+            getPage={this.getInvitePage}
+            getRow={this.getInviteRow}
+          />
+        </Grid>
+      </Grid>
+    );
+  }
+}
+
+export default ListRegistrations;
@@ -1,5 +1,5 @@
 import React, { Component } from "react";
-import { withRouter } from "react-router-dom";
+import { withRouter, Link } from "react-router-dom";
 
 import Grid from '@material-ui/core/Grid';
 import TextField from '@material-ui/core/TextField';
@@ -12,6 +12,7 @@ import { withStyles } from "@material-ui/core/styles";
 import Form from "../../components/Form";
 import FormComponent from "../../classes/FormComponent";
 import SessionStore from "../../stores/SessionStore";
+import RegistrationStore from "../../stores/RegistrationStore";
 import theme from "../../theme";
 
 
@@ -70,6 +71,7 @@ class Login extends Component {
 
     this.state = {
       registration: null,
+      registrationEnabled: false,
     };
 
     this.onSubmit = this.onSubmit.bind(this);
@@ -85,6 +87,12 @@ class Login extends Component {
         });
       }
     });
+
+    RegistrationStore.getSettings(resp => {
+      this.setState({
+        registrationEnabled: resp.mode !== "DISABLED",
+      });
+    });
   }
 
   onSubmit(login) {
@@ -110,6 +118,11 @@ class Login extends Component {
             {this.state.registration && <CardContent>
               <Typography className={this.props.classes.link} dangerouslySetInnerHTML={{__html: this.state.registration}}></Typography>
              </CardContent>}
+            {this.state.registrationEnabled && <CardContent>
+              <Typography className={this.props.classes.link}>
+                Don't have an account? <Link to="/register">Register</Link>
+              </Typography>
+            </CardContent>}
           </Card>
         </Grid>
       </Grid>
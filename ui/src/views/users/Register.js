@@ -0,0 +1,182 @@
+import React, { Component } from "react";
+import { withRouter, Link } from "react-router-dom";
+
+import Grid from '@material-ui/core/Grid';
+import TextField from '@material-ui/core/TextField';
+import Card from '@material-ui/core/Card';
+import CardHeader from '@material-ui/core/CardHeader';
+import CardContent from '@material-ui/core/CardContent';
+import Typography from "@material-ui/core/Typography";
+import { withStyles } from "@material-ui/core/styles";
+
+import Form from "../../components/Form";
+import FormComponent from "../../classes/FormComponent";
+import RegistrationStore from "../../stores/RegistrationStore";
+import theme from "../../theme";
+
+
+const styles = {
+  link: {
+    color: theme.palette.primary.main,
+    textDecoration: "none",
+  },
+};
+
+
+class RegisterForm extends FormComponent {
+  render() {
+    if (this.state.object === undefined) {
+      return null;
+    }
+
+    let emailHelperText = "";
+    if (this.props.settings.mode === "DOMAIN") {
+      emailHelperText = "Registration is restricted to the following domains: " + this.props.settings.allowedDomains.join(", ") + ".";
+    }
+
+    return(
+      <Form
+        submitLabel={this.props.submitLabel}
+        onSubmit={this.onSubmit}
+      >
+        <TextField
+          id="username"
+          label="Username"
+          margin="normal"
+          value={this.state.object.username || ""}
+          onChange={this.onChange}
+          fullWidth
+          required
+        />
+        <TextField
+          id="email"
+          label="E-mail address"
+          helperText={emailHelperText}
+          type="email"
+          margin="normal"
+          value={this.state.object.email || ""}
+          onChange={this.onChange}
+          fullWidth
+          required
+        />
+        <TextField
+          id="password"
+          label="Password"
+          type="password"
+          margin="normal"
+          value={this.state.object.password || ""}
+          onChange={this.onChange}
+          fullWidth
+          required
+        />
+        <TextField
+          id="organizationName"
+          label="Organization name"
+          helperText="The name may only contain words, numbers and dashes."
+          margin="normal"
+          value={this.state.object.organizationName || ""}
+          onChange={this.onChange}
+          inputProps={{
+            pattern: "[\\w-]+",
+          }}
+          fullWidth
+          required
+        />
+        <TextField
+          id="organizationDisplayName"
+          label="Organization display name"
+          margin="normal"
+          value={this.state.object.organizationDisplayName || ""}
+          onChange={this.onChange}
+          fullWidth
+          required
+        />
+        {this.props.settings.mode === "INVITE" && <TextField
+          id="inviteToken"
+          label="Invite token"
+          margin="normal"
+          value={this.state.object.inviteToken || ""}
+          onChange={this.onChange}
+          fullWidth
+          required
+        />}
+      </Form>
+    );
+  }
+}
+
+
+class Register extends Component {
+  constructor() {
+    super();
+
+    this.state = {
+      pendingApproval: false,
+    };
+
+    this.onSubmit = this.onSubmit.bind(this);
+  }
+
+  componentDidMount() {
+    RegistrationStore.getSettings(resp => {
+      this.setState({
+        settings: resp,
+      });
+    });
+  }
+
+  onSubmit(registration) {
+    RegistrationStore.register(registration, resp => {
+      if (resp.approved) {
+        RegistrationStore.notify("registration completed, you can now log in");
+        this.props.history.push("/login");
+      } else {
+        this.setState({
+          pendingApproval: true,
+        });
+      }
+    });
+  }
+
+  render() {
+    if (this.state.settings === undefined) {
+      return null;
+    }
+
+    let content = null;
+
+    if (this.state.settings.mode === "DISABLED") {
+      content = <Typography>Registration is disabled.</Typography>;
+    } else if (this.state.pendingApproval) {
+      content = <Typography>Thank you for registering. Your registration must be approved by an administrator before you are able to log in.</Typography>;
+    } else {
+      content = <RegisterForm
+        submitLabel="Register"
+        settings={this.state.settings}
+        onSubmit={this.onSubmit}
+      />;
+    }
+
+    return(
+      <Grid container justify="center">
+        <Grid item xs={6} lg={4}>
+          <Card>
+            <CardHeader
+              title="Register"
+            />
+            <CardContent>
+              {content}
+            </CardContent>
+            <CardContent>
+              <Typography>
+                Already have an account? <Link className={this.props.classes.link} to="/login">Login</Link>
+              </Typography>
+            </CardContent>
+          </Card>
+        </Grid>
+      </Grid>
+    );
+  }
+}
+
+export default withStyles(styles)(withRouter(Register));